@@ -24,6 +24,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/server/config"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/store/streaming"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/version"
@@ -85,6 +86,9 @@ import (
 
 	// IBC modules
 	ica "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts"
+	icacontroller "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller"
+	icacontrollerkeeper "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/keeper"
+	icacontrollertypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
 	icahost "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host"
 	icahostkeeper "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/keeper"
 	icahosttypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
@@ -110,10 +114,14 @@ import (
 	v2_0_0 "github.com/crescent-network/crescent/v4/app/upgrades/mainnet/v2.0.0"
 	v3 "github.com/crescent-network/crescent/v4/app/upgrades/mainnet/v3"
 	v4 "github.com/crescent-network/crescent/v4/app/upgrades/mainnet/v4"
+	v5 "github.com/crescent-network/crescent/v4/app/upgrades/mainnet/v5"
 	"github.com/crescent-network/crescent/v4/app/upgrades/testnet/rc4"
 	"github.com/crescent-network/crescent/v4/x/claim"
 	claimkeeper "github.com/crescent-network/crescent/v4/x/claim/keeper"
 	claimtypes "github.com/crescent-network/crescent/v4/x/claim/types"
+	"github.com/crescent-network/crescent/v4/x/epochs"
+	epochskeeper "github.com/crescent-network/crescent/v4/x/epochs/keeper"
+	epochstypes "github.com/crescent-network/crescent/v4/x/epochs/types"
 	"github.com/crescent-network/crescent/v4/x/farming"
 	farmingclient "github.com/crescent-network/crescent/v4/x/farming/client"
 	farmingkeeper "github.com/crescent-network/crescent/v4/x/farming/keeper"
@@ -122,9 +130,13 @@ import (
 	liquidfarmingkeeper "github.com/crescent-network/crescent/v4/x/liquidfarming/keeper"
 	liquidfarmingtypes "github.com/crescent-network/crescent/v4/x/liquidfarming/types"
 	"github.com/crescent-network/crescent/v4/x/liquidity"
+	liquidityclient "github.com/crescent-network/crescent/v4/x/liquidity/client"
+	liquidityibcmiddleware "github.com/crescent-network/crescent/v4/x/liquidity/ibcmiddleware"
 	liquiditykeeper "github.com/crescent-network/crescent/v4/x/liquidity/keeper"
 	liquiditytypes "github.com/crescent-network/crescent/v4/x/liquidity/types"
 	"github.com/crescent-network/crescent/v4/x/liquidstaking"
+	liquidstakingclient "github.com/crescent-network/crescent/v4/x/liquidstaking/client"
+	liquidstakingibcmiddleware "github.com/crescent-network/crescent/v4/x/liquidstaking/ibcmiddleware"
 	liquidstakingkeeper "github.com/crescent-network/crescent/v4/x/liquidstaking/keeper"
 	liquidstakingtypes "github.com/crescent-network/crescent/v4/x/liquidstaking/types"
 	"github.com/crescent-network/crescent/v4/x/lpfarm"
@@ -136,6 +148,7 @@ import (
 	marketmakerkeeper "github.com/crescent-network/crescent/v4/x/marketmaker/keeper"
 	marketmakertypes "github.com/crescent-network/crescent/v4/x/marketmaker/types"
 	"github.com/crescent-network/crescent/v4/x/mint"
+	mintclient "github.com/crescent-network/crescent/v4/x/mint/client"
 	mintkeeper "github.com/crescent-network/crescent/v4/x/mint/keeper"
 	minttypes "github.com/crescent-network/crescent/v4/x/mint/types"
 
@@ -168,6 +181,17 @@ var (
 			farmingclient.ProposalHandler,
 			marketmakerclient.ProposalHandler,
 			lpfarmclient.ProposalHandler,
+			lpfarmclient.SpendScheduleProposalHandler,
+			liquidityclient.ProposalHandler,
+			liquidityclient.PairMetadataProposalHandler,
+			liquidityclient.PairCircuitBreakerProposalHandler,
+			liquidityclient.PairBatchWindowProposalHandler,
+			liquidityclient.PairOrderAmountLimitsProposalHandler,
+			liquidityclient.PairParamsProposalHandler,
+			liquidityclient.PoolMaxReserveAmountProposalHandler,
+			liquidstakingclient.ProposalHandler,
+			liquidstakingclient.RegisterHostZoneProposalHandler,
+			mintclient.ProposalHandler,
 		),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
@@ -188,6 +212,7 @@ var (
 		marketmaker.AppModuleBasic{},
 		lpfarm.AppModuleBasic{},
 		ica.AppModuleBasic{},
+		epochs.AppModuleBasic{},
 	)
 
 	// module account permissions
@@ -211,6 +236,11 @@ var (
 	}
 )
 
+// FlagLegacyLiquidityOrderEvents is the app config key of whether the
+// liquidity module emits the legacy order events along with the typed ones.
+// It is true by default.
+const FlagLegacyLiquidityOrderEvents = "liquidity.legacy-order-events"
+
 // Verify app interface at compile time
 var (
 	_ simapp.App              = (*App)(nil)
@@ -260,11 +290,15 @@ type App struct {
 	MarketMakerKeeper   marketmakerkeeper.Keeper
 	LPFarmKeeper        lpfarmkeeper.Keeper
 	ICAHostKeeper       icahostkeeper.Keeper
+	ICAControllerKeeper icacontrollerkeeper.Keeper
+	EpochsKeeper        epochskeeper.Keeper
 
 	// scoped keepers
-	ScopedIBCKeeper      capabilitykeeper.ScopedKeeper
-	ScopedTransferKeeper capabilitykeeper.ScopedKeeper
-	ScopedICAHostKeeper  capabilitykeeper.ScopedKeeper
+	ScopedIBCKeeper           capabilitykeeper.ScopedKeeper
+	ScopedTransferKeeper      capabilitykeeper.ScopedKeeper
+	ScopedICAHostKeeper       capabilitykeeper.ScopedKeeper
+	ScopedICAControllerKeeper capabilitykeeper.ScopedKeeper
+	ScopedLiquidStakingKeeper capabilitykeeper.ScopedKeeper
 
 	// IBC app modules
 	transferModule transfer.AppModule
@@ -336,10 +370,19 @@ func NewApp(
 		marketmakertypes.StoreKey,
 		lpfarmtypes.StoreKey,
 		icahosttypes.StoreKey,
+		icacontrollertypes.StoreKey,
+		epochstypes.StoreKey,
 	)
 	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
 	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
 
+	// configure state listening capabilities(ADR-038) using AppOptions, so that
+	// indexers can subscribe to the KV changes of the stores listed in
+	// streamers.<name>.keys.
+	if _, _, err := streaming.LoadStreamingServices(bApp, appOpts, appCodec, keys); err != nil {
+		tmos.Exit(err.Error())
+	}
+
 	app := &App{
 		BaseApp:           bApp,
 		legacyAmino:       legacyAmino,
@@ -367,6 +410,8 @@ func NewApp(
 	scopedIBCKeeper := app.CapabilityKeeper.ScopeToModule(ibchost.ModuleName)
 	scopedTransferKeeper := app.CapabilityKeeper.ScopeToModule(ibctransfertypes.ModuleName)
 	scopedICAHostKeeper := app.CapabilityKeeper.ScopeToModule(icahosttypes.SubModuleName)
+	scopedICAControllerKeeper := app.CapabilityKeeper.ScopeToModule(icacontrollertypes.SubModuleName)
+	scopedLiquidStakingKeeper := app.CapabilityKeeper.ScopeToModule(liquidstakingtypes.ModuleName)
 	app.CapabilityKeeper.Seal()
 
 	// add keepers
@@ -403,6 +448,11 @@ func NewApp(
 	)
 	app.StakingKeeper = &stakingKeeper
 
+	app.EpochsKeeper = epochskeeper.NewKeeper(
+		appCodec,
+		keys[epochstypes.StoreKey],
+	)
+
 	app.MintKeeper = mintkeeper.NewKeeper(
 		appCodec,
 		keys[minttypes.StoreKey],
@@ -443,9 +493,6 @@ func NewApp(
 
 	// register the staking hooks
 	// NOTE: stakingKeeper above is passed by reference, so that it will contain these hooks
-	app.StakingKeeper = app.StakingKeeper.SetHooks(
-		stakingtypes.NewMultiStakingHooks(app.DistrKeeper.Hooks(), app.SlashingKeeper.Hooks()),
-	)
 	app.IBCKeeper = ibckeeper.NewKeeper(
 		appCodec,
 		keys[ibchost.StoreKey],
@@ -454,6 +501,27 @@ func NewApp(
 		app.UpgradeKeeper,
 		scopedIBCKeeper,
 	)
+	app.TransferKeeper = ibctransferkeeper.NewKeeper(
+		appCodec,
+		keys[ibctransfertypes.StoreKey],
+		app.GetSubspace(ibctransfertypes.ModuleName),
+		app.IBCKeeper.ChannelKeeper,
+		app.IBCKeeper.ChannelKeeper,
+		&app.IBCKeeper.PortKeeper,
+		app.AccountKeeper,
+		app.BankKeeper,
+		scopedTransferKeeper,
+	)
+	app.ICAControllerKeeper = icacontrollerkeeper.NewKeeper(
+		appCodec,
+		keys[icacontrollertypes.StoreKey],
+		app.GetSubspace(icacontrollertypes.SubModuleName),
+		app.IBCKeeper.ChannelKeeper,
+		app.IBCKeeper.ChannelKeeper,
+		&app.IBCKeeper.PortKeeper,
+		scopedICAControllerKeeper,
+		app.MsgServiceRouter(),
+	)
 	app.BudgetKeeper = budgetkeeper.NewKeeper(
 		appCodec,
 		keys[budgettypes.StoreKey],
@@ -475,7 +543,11 @@ func NewApp(
 		app.GetSubspace(liquiditytypes.ModuleName),
 		app.AccountKeeper,
 		app.BankKeeper,
+		app.DistrKeeper,
 	)
+	if v := appOpts.Get(FlagLegacyLiquidityOrderEvents); v != nil {
+		app.LiquidityKeeper.SetLegacyOrderEventsEnabled(cast.ToBool(v))
+	}
 	app.MarketMakerKeeper = marketmakerkeeper.NewKeeper(
 		appCodec,
 		keys[marketmakertypes.StoreKey],
@@ -489,8 +561,10 @@ func NewApp(
 		app.GetSubspace(lpfarmtypes.ModuleName),
 		app.AccountKeeper,
 		app.BankKeeper,
+		app.DistrKeeper,
 		app.LiquidityKeeper,
 	)
+	app.LiquidityKeeper.SetLPFarmKeeper(app.LPFarmKeeper)
 	app.LiquidStakingKeeper = liquidstakingkeeper.NewKeeper(
 		appCodec,
 		keys[liquidstakingtypes.StoreKey],
@@ -502,6 +576,35 @@ func NewApp(
 		app.LiquidityKeeper,
 		app.LPFarmKeeper,
 		app.SlashingKeeper,
+		app.MintKeeper,
+		app.EpochsKeeper,
+		app.IBCKeeper.ClientKeeper,
+		app.IBCKeeper.ConnectionKeeper,
+		app.IBCKeeper.ChannelKeeper,
+		app.TransferKeeper,
+		app.ICAControllerKeeper,
+		scopedLiquidStakingKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+	// The gov keeper is passed by reference, since it is created later with
+	// the proposal handlers of the other modules.
+	app.ClaimKeeper = claimkeeper.NewKeeper(
+		appCodec,
+		keys[claimtypes.StoreKey],
+		app.BankKeeper,
+		app.DistrKeeper,
+		&app.GovKeeper,
+		app.LiquidityKeeper,
+		app.LiquidStakingKeeper,
+	)
+	// Register the hooks of the modules subscribing to the liquidity module here.
+	app.LiquidityKeeper.SetHooks(liquiditytypes.NewMultiLiquidityHooks(app.ClaimKeeper.Hooks()))
+	// Register the hooks of the modules subscribing to the liquidstaking module here.
+	app.LiquidStakingKeeper.SetHooks(liquidstakingtypes.NewMultiLiquidStakingHooks(app.ClaimKeeper.Hooks()))
+	// Register the hooks of the modules keying their periodic executions off the epochs here.
+	app.EpochsKeeper.SetHooks(epochstypes.NewMultiEpochHooks(app.LiquidStakingKeeper.Hooks()))
+	app.StakingKeeper = app.StakingKeeper.SetHooks(
+		stakingtypes.NewMultiStakingHooks(app.DistrKeeper.Hooks(), app.SlashingKeeper.Hooks(), app.LiquidStakingKeeper.Hooks()),
 	)
 	app.LiquidFarmingKeeper = liquidfarmingkeeper.NewKeeper(
 		appCodec,
@@ -523,7 +626,10 @@ func NewApp(
 		AddRoute(ibcclienttypes.RouterKey, ibcclient.NewClientProposalHandler(app.IBCKeeper.ClientKeeper)).
 		AddRoute(farmingtypes.RouterKey, farming.NewPublicPlanProposalHandler(app.FarmingKeeper)).
 		AddRoute(marketmakertypes.RouterKey, marketmaker.NewMarketMakerProposalHandler(app.MarketMakerKeeper)).
-		AddRoute(lpfarmtypes.RouterKey, lpfarm.NewFarmingPlanProposalHandler(app.LPFarmKeeper))
+		AddRoute(lpfarmtypes.RouterKey, lpfarm.NewFarmingPlanProposalHandler(app.LPFarmKeeper)).
+		AddRoute(liquiditytypes.RouterKey, liquidity.NewProposalHandler(app.LiquidityKeeper)).
+		AddRoute(liquidstakingtypes.RouterKey, liquidstaking.NewProposalHandler(app.LiquidStakingKeeper)).
+		AddRoute(minttypes.RouterKey, mint.NewProposalHandler(app.MintKeeper))
 
 	app.GovKeeper = govkeeper.NewKeeper(
 		appCodec,
@@ -537,30 +643,15 @@ func NewApp(
 	app.GovKeeper = *app.GovKeeper.SetHooks(
 		govtypes.NewMultiGovHooks(
 			app.LiquidStakingKeeper.Hooks(),
+			app.ClaimKeeper.Hooks(),
 		),
 	)
-	app.ClaimKeeper = claimkeeper.NewKeeper(
-		appCodec,
-		keys[claimtypes.StoreKey],
-		app.BankKeeper,
-		app.DistrKeeper,
-		app.GovKeeper,
-		app.LiquidityKeeper,
-		app.LiquidStakingKeeper,
-	)
-	app.TransferKeeper = ibctransferkeeper.NewKeeper(
-		appCodec,
-		keys[ibctransfertypes.StoreKey],
-		app.GetSubspace(ibctransfertypes.ModuleName),
-		app.IBCKeeper.ChannelKeeper,
-		app.IBCKeeper.ChannelKeeper,
-		&app.IBCKeeper.PortKeeper,
-		app.AccountKeeper,
-		app.BankKeeper,
-		scopedTransferKeeper,
-	)
 	app.transferModule = transfer.NewAppModule(app.TransferKeeper)
 	transferIBCModule := transfer.NewIBCModule(app.TransferKeeper)
+	// swap the coins received from transfers with a swap memo
+	var transferStack porttypes.IBCModule = liquidityibcmiddleware.NewIBCMiddleware(transferIBCModule, app.LiquidityKeeper)
+	// track the transfers of the liquid staking host zones
+	transferStack = liquidstakingibcmiddleware.NewIBCMiddleware(transferStack, app.LiquidStakingKeeper)
 
 	app.ICAHostKeeper = icahostkeeper.NewKeeper(
 		appCodec,
@@ -572,8 +663,11 @@ func NewApp(
 		scopedICAHostKeeper,
 		app.MsgServiceRouter(),
 	)
-	app.icaModule = ica.NewAppModule(nil, &app.ICAHostKeeper)
+	app.icaModule = ica.NewAppModule(&app.ICAControllerKeeper, &app.ICAHostKeeper)
 	icaHostIBCModule := icahost.NewIBCModule(app.ICAHostKeeper)
+	// the liquid staking module is the authentication module of the interchain accounts controller
+	icaControllerIBCModule := icacontroller.NewIBCModule(
+		app.ICAControllerKeeper, liquidstakingibcmiddleware.NewICAModule(app.LiquidStakingKeeper))
 
 	// create evidence keeper with router
 	evidenceKeeper := evidencekeeper.NewKeeper(
@@ -588,8 +682,9 @@ func NewApp(
 	ibcRouter := porttypes.NewRouter()
 
 	ibcRouter.
-		AddRoute(ibctransfertypes.ModuleName, transferIBCModule).
-		AddRoute(icahosttypes.SubModuleName, icaHostIBCModule)
+		AddRoute(ibctransfertypes.ModuleName, transferStack).
+		AddRoute(icahosttypes.SubModuleName, icaHostIBCModule).
+		AddRoute(icacontrollertypes.SubModuleName, icaControllerIBCModule)
 	app.IBCKeeper.SetRouter(ibcRouter)
 
 	/****  Module Options ****/
@@ -628,6 +723,7 @@ func NewApp(
 		claim.NewAppModule(appCodec, app.ClaimKeeper, app.AccountKeeper, app.BankKeeper, app.DistrKeeper, app.GovKeeper, app.LiquidityKeeper, app.LiquidStakingKeeper),
 		marketmaker.NewAppModule(appCodec, app.MarketMakerKeeper, app.AccountKeeper, app.BankKeeper),
 		lpfarm.NewAppModule(appCodec, app.LPFarmKeeper, app.AccountKeeper, app.BankKeeper, app.LiquidityKeeper),
+		epochs.NewAppModule(appCodec, app.EpochsKeeper),
 		app.transferModule,
 		app.icaModule,
 	)
@@ -639,6 +735,7 @@ func NewApp(
 	app.mm.SetOrderBeginBlockers(
 		upgradetypes.ModuleName,
 		capabilitytypes.ModuleName,
+		epochstypes.ModuleName,
 		minttypes.ModuleName,
 		budgettypes.ModuleName,
 		distrtypes.ModuleName,
@@ -698,6 +795,7 @@ func NewApp(
 		marketmakertypes.ModuleName,
 		lpfarmtypes.ModuleName,
 		icatypes.ModuleName,
+		epochstypes.ModuleName,
 	)
 
 	// NOTE: The genutils module must occur after staking so that pools are
@@ -728,6 +826,7 @@ func NewApp(
 		claimtypes.ModuleName,
 		marketmakertypes.ModuleName,
 		lpfarmtypes.ModuleName,
+		epochstypes.ModuleName,
 
 		// empty logic modules
 		paramstypes.ModuleName,
@@ -809,6 +908,8 @@ func NewApp(
 	app.ScopedIBCKeeper = scopedIBCKeeper
 	app.ScopedTransferKeeper = scopedTransferKeeper
 	app.ScopedICAHostKeeper = scopedICAHostKeeper
+	app.ScopedICAControllerKeeper = scopedICAControllerKeeper
+	app.ScopedLiquidStakingKeeper = scopedLiquidStakingKeeper
 
 	if loadLatest {
 		if err := app.LoadLatestVersion(); err != nil {
@@ -980,6 +1081,7 @@ func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 	paramsKeeper.Subspace(marketmakertypes.ModuleName)
 	paramsKeeper.Subspace(lpfarmtypes.ModuleName)
 	paramsKeeper.Subspace(icahosttypes.SubModuleName)
+	paramsKeeper.Subspace(icacontrollertypes.SubModuleName)
 
 	return paramsKeeper
 }
@@ -1010,6 +1112,10 @@ func (app *App) SetUpgradeStoreLoaders() {
 		// configure store loader that checks if version == upgradeHeight and applies store upgrades
 		app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &v4.StoreUpgrades))
 	}
+	if upgradeInfo.Name == v5.UpgradeName && !app.UpgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
+		// configure store loader that checks if version == upgradeHeight and applies store upgrades
+		app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &v5.StoreUpgrades))
+	}
 }
 
 func (app *App) SetUpgradeHandlers(mm *module.Manager, configurator module.Configurator) {
@@ -1028,4 +1134,8 @@ func (app *App) SetUpgradeHandlers(mm *module.Manager, configurator module.Confi
 	app.UpgradeKeeper.SetUpgradeHandler(
 		v4.UpgradeName, v4.UpgradeHandler(
 			mm, configurator, app.icaModule))
+
+	app.UpgradeKeeper.SetUpgradeHandler(
+		v5.UpgradeName, v5.UpgradeHandler(
+			mm, configurator, app.LiquidityKeeper))
 }
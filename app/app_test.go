@@ -1,11 +1,14 @@
 package app
 
 import (
+	"encoding/binary"
 	"encoding/json"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	"github.com/cosmos/cosmos-sdk/tests/mocks"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
@@ -37,9 +40,11 @@ import (
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/crescent-network/crescent/v4/x/claim"
+	"github.com/crescent-network/crescent/v4/x/epochs"
 	"github.com/crescent-network/crescent/v4/x/farming"
 	"github.com/crescent-network/crescent/v4/x/liquidfarming"
 	"github.com/crescent-network/crescent/v4/x/liquidity"
+	liquiditytypes "github.com/crescent-network/crescent/v4/x/liquidity/types"
 	"github.com/crescent-network/crescent/v4/x/liquidstaking"
 	"github.com/crescent-network/crescent/v4/x/lpfarm"
 	"github.com/crescent-network/crescent/v4/x/marketmaker"
@@ -198,6 +203,7 @@ func TestRunMigrations(t *testing.T) {
 					"claim":              claim.AppModule{}.ConsensusVersion(),
 					"marketmaker":        marketmaker.AppModule{}.ConsensusVersion(),
 					"lpfarm":             lpfarm.AppModule{}.ConsensusVersion(),
+					"epochs":             epochs.AppModule{}.ConsensusVersion(),
 					"ibc":                ibc.AppModule{}.ConsensusVersion(),
 					"transfer":           transfer.AppModule{}.ConsensusVersion(),
 					"interchainaccounts": ica.AppModule{}.ConsensusVersion(),
@@ -291,3 +297,68 @@ func TestUpgradeStateOnGenesis(t *testing.T) {
 		require.Equal(t, vm[v], i.ConsensusVersion())
 	}
 }
+
+type streamingAppOptions map[string]interface{}
+
+func (opts streamingAppOptions) Get(key string) interface{} {
+	return opts[key]
+}
+
+func TestStateStreaming(t *testing.T) {
+	encCfg := MakeTestEncodingConfig()
+	dir := t.TempDir()
+	appOpts := streamingAppOptions{
+		"store.streamers":          []string{"file"},
+		"streamers.file.keys":      []string{liquiditytypes.StoreKey},
+		"streamers.file.write_dir": dir,
+	}
+	app := NewApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, DefaultNodeHome, 0, encCfg, appOpts)
+	genesisState := NewDefaultGenesisState(encCfg.Marshaler)
+	stateBytes, err := json.MarshalIndent(genesisState, "", "  ")
+	require.NoError(t, err)
+	app.InitChain(abci.RequestInitChain{AppStateBytes: stateBytes})
+	app.Commit()
+
+	header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	ctx := app.BaseApp.NewContext(false, header)
+	pair := liquiditytypes.NewPair(1, "denom1", "denom2")
+	app.LiquidityKeeper.SetPair(ctx, pair)
+	app.EndBlock(abci.RequestEndBlock{Height: header.Height})
+	app.Commit()
+
+	// The changes of a block are written to the store at commit, so they are
+	// streamed along with the next begin block.
+	header = tmproto.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	// The begin block file consists of the length-prefixed request, the
+	// length-prefixed state changes and the length-prefixed response.
+	bz, err := os.ReadFile(filepath.Join(dir, "block-3-begin"))
+	require.NoError(t, err)
+	var msgs [][]byte
+	for len(bz) > 0 {
+		l, n := binary.Uvarint(bz)
+		require.Positive(t, n)
+		msgs = append(msgs, bz[n:n+int(l)])
+		bz = bz[n+int(l):]
+	}
+	require.GreaterOrEqual(t, len(msgs), 3)
+
+	// The pair has been updated by the end blocker since it is set.
+	pair, _ = app.LiquidityKeeper.GetPair(app.BaseApp.NewContext(true, header), pair.Id)
+	found := false
+	for _, msg := range msgs[1 : len(msgs)-1] {
+		var kvPair storetypes.StoreKVPair
+		require.NoError(t, encCfg.Marshaler.Unmarshal(msg, &kvPair))
+		require.Equal(t, liquiditytypes.StoreKey, kvPair.StoreKey)
+		change, err := liquiditytypes.DecodeStateChange(encCfg.Marshaler, kvPair)
+		require.NoError(t, err)
+		if change, ok := change.(*liquiditytypes.PairStateChange); ok {
+			require.Equal(t, pair.Id, change.PairId)
+			require.Equal(t, pair, *change.Pair)
+			found = true
+		}
+	}
+	require.True(t, found)
+}
@@ -33,6 +33,7 @@ const (
 	DefaultWeightDeleteWhitelistValidatorsProposal int = 5
 	DefaultWeightCompleteRedelegationUnbonding     int = 30
 	DefaultWeightTallyWithLiquidStaking            int = 30
+	DefaultWeightSlashLiquidValidator              int = 5
 
 	DefaultWeightMsgClaim int = 50
 
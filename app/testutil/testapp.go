@@ -0,0 +1,274 @@
+// Package testutil provides a programmable test app for integration tests
+// spanning multiple modules, such as liquidity, liquidstaking, mint, bank and
+// staking.
+//
+// A TestApp is built by TestAppBuilder with the customized genesis state, and
+// its blocks are advanced through the full BeginBlock, EndBlock and Commit of
+// the app, so the hooks and the end blockers of all modules are run as they
+// are on the chain.
+package testutil
+
+// DONTCOVER
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	chain "github.com/crescent-network/crescent/v4/app"
+	utils "github.com/crescent-network/crescent/v4/types"
+	liquiditytypes "github.com/crescent-network/crescent/v4/x/liquidity/types"
+	liquidstakingtypes "github.com/crescent-network/crescent/v4/x/liquidstaking/types"
+	minttypes "github.com/crescent-network/crescent/v4/x/mint/types"
+)
+
+var (
+	// DefaultGenesisTime is the default block time of the first block.
+	DefaultGenesisTime = utils.ParseTime("2022-01-01T00:00:00Z")
+	// DefaultBlockInterval is the default time between two blocks.
+	DefaultBlockInterval = 5 * time.Second
+)
+
+// TestAppBuilder builds a TestApp.
+type TestAppBuilder struct {
+	genesisTime      time.Time
+	blockInterval    time.Duration
+	invCheckPeriod   uint
+	genesisModifiers []func(cdc codec.JSONCodec, genState chain.GenesisState)
+}
+
+// NewTestAppBuilder returns a new TestAppBuilder with the default genesis
+// state of the app.
+// The invariants of all modules are checked at the end of every block by
+// default.
+func NewTestAppBuilder() *TestAppBuilder {
+	return &TestAppBuilder{
+		genesisTime:    DefaultGenesisTime,
+		blockInterval:  DefaultBlockInterval,
+		invCheckPeriod: 1,
+	}
+}
+
+// WithGenesisTime sets the block time of the first block.
+func (b *TestAppBuilder) WithGenesisTime(t time.Time) *TestAppBuilder {
+	b.genesisTime = t
+	return b
+}
+
+// WithBlockInterval sets the time between two blocks advanced by
+// TestApp.NextBlock and TestApp.AdvanceBlocks.
+func (b *TestAppBuilder) WithBlockInterval(interval time.Duration) *TestAppBuilder {
+	b.blockInterval = interval
+	return b
+}
+
+// WithInvariantCheckPeriod sets the period of blocks at which the invariants
+// are checked. Zero disables the invariant checks.
+func (b *TestAppBuilder) WithInvariantCheckPeriod(period uint) *TestAppBuilder {
+	b.invCheckPeriod = period
+	return b
+}
+
+// WithGenesisModifier adds a function modifying the genesis state of the app
+// before the chain is initialized.
+// The modifiers are applied in the order they are added.
+func (b *TestAppBuilder) WithGenesisModifier(modify func(cdc codec.JSONCodec, genState chain.GenesisState)) *TestAppBuilder {
+	b.genesisModifiers = append(b.genesisModifiers, modify)
+	return b
+}
+
+// withModuleGenesis adds a genesis modifier which unmarshals the module's
+// genesis state into genState, calls modify and marshals genState back.
+func (b *TestAppBuilder) withModuleGenesis(moduleName string, genState codec.ProtoMarshaler, modify func()) *TestAppBuilder {
+	return b.WithGenesisModifier(func(cdc codec.JSONCodec, appGenState chain.GenesisState) {
+		cdc.MustUnmarshalJSON(appGenState[moduleName], genState)
+		modify()
+		appGenState[moduleName] = cdc.MustMarshalJSON(genState)
+	})
+}
+
+// WithBankGenesis modifies the genesis state of the bank module.
+func (b *TestAppBuilder) WithBankGenesis(modify func(genState *banktypes.GenesisState)) *TestAppBuilder {
+	var genState banktypes.GenesisState
+	return b.withModuleGenesis(banktypes.ModuleName, &genState, func() { modify(&genState) })
+}
+
+// WithStakingGenesis modifies the genesis state of the staking module.
+func (b *TestAppBuilder) WithStakingGenesis(modify func(genState *stakingtypes.GenesisState)) *TestAppBuilder {
+	var genState stakingtypes.GenesisState
+	return b.withModuleGenesis(stakingtypes.ModuleName, &genState, func() { modify(&genState) })
+}
+
+// WithMintGenesis modifies the genesis state of the mint module.
+func (b *TestAppBuilder) WithMintGenesis(modify func(genState *minttypes.GenesisState)) *TestAppBuilder {
+	var genState minttypes.GenesisState
+	return b.withModuleGenesis(minttypes.ModuleName, &genState, func() { modify(&genState) })
+}
+
+// WithLiquidityGenesis modifies the genesis state of the liquidity module.
+func (b *TestAppBuilder) WithLiquidityGenesis(modify func(genState *liquiditytypes.GenesisState)) *TestAppBuilder {
+	var genState liquiditytypes.GenesisState
+	return b.withModuleGenesis(liquiditytypes.ModuleName, &genState, func() { modify(&genState) })
+}
+
+// WithLiquidStakingGenesis modifies the genesis state of the liquidstaking
+// module.
+func (b *TestAppBuilder) WithLiquidStakingGenesis(modify func(genState *liquidstakingtypes.GenesisState)) *TestAppBuilder {
+	var genState liquidstakingtypes.GenesisState
+	return b.withModuleGenesis(liquidstakingtypes.ModuleName, &genState, func() { modify(&genState) })
+}
+
+// Build initializes the chain with the genesis state and begins the first
+// block.
+func (b *TestAppBuilder) Build() *TestApp {
+	encCfg := chain.MakeTestEncodingConfig()
+	app := chain.NewApp(
+		log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{},
+		chain.DefaultNodeHome, b.invCheckPeriod, encCfg, chain.EmptyAppOptions{})
+
+	genState := chain.NewDefaultGenesisState(encCfg.Marshaler)
+	for _, modify := range b.genesisModifiers {
+		modify(encCfg.Marshaler, genState)
+	}
+	stateBytes, err := json.MarshalIndent(genState, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	app.InitChain(abci.RequestInitChain{
+		Time:            b.genesisTime,
+		ConsensusParams: chain.DefaultConsensusParams,
+		AppStateBytes:   stateBytes,
+	})
+
+	tApp := &TestApp{App: app, blockInterval: b.blockInterval}
+	tApp.beginBlock(tmproto.Header{Height: app.LastBlockHeight() + 1, Time: b.genesisTime})
+	return tApp
+}
+
+// TestApp is an app whose blocks are advanced manually.
+// Ctx is the context of the current block, which is replaced whenever a new
+// block begins.
+type TestApp struct {
+	*chain.App
+	Ctx sdk.Context
+
+	blockInterval time.Duration
+}
+
+func (tApp *TestApp) beginBlock(header tmproto.Header) {
+	tApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+	tApp.Ctx = tApp.BaseApp.NewContext(false, header)
+}
+
+// NextBlock ends the current block and begins the next block.
+func (tApp *TestApp) NextBlock() {
+	tApp.AdvanceBlocks(1)
+}
+
+// AdvanceBlocks advances n blocks with the block interval of the app.
+func (tApp *TestApp) AdvanceBlocks(n int) {
+	tApp.AdvanceBlocksWithTime(n, tApp.blockInterval)
+}
+
+// AdvanceBlocksWithTime advances n blocks, each of which has the block time
+// later than the previous block by interval.
+// After the call, Ctx is the context of the last block begun.
+func (tApp *TestApp) AdvanceBlocksWithTime(n int, interval time.Duration) {
+	for i := 0; i < n; i++ {
+		tApp.EndBlock(abci.RequestEndBlock{Height: tApp.Ctx.BlockHeight()})
+		tApp.Commit()
+		tApp.beginBlock(tmproto.Header{
+			Height: tApp.LastBlockHeight() + 1,
+			Time:   tApp.Ctx.BlockTime().Add(interval),
+		})
+	}
+}
+
+// Addr returns a deterministic account address for the number.
+func (tApp *TestApp) Addr(addrNum int) sdk.AccAddress {
+	addr := make(sdk.AccAddress, 20)
+	binary.PutVarint(addr, int64(addrNum))
+	return addr
+}
+
+// FundAccount mints the coins and sends them to the address.
+func (tApp *TestApp) FundAccount(addr sdk.AccAddress, amt sdk.Coins) {
+	if err := chain.FundAccount(tApp.BankKeeper, tApp.Ctx, addr, amt); err != nil {
+		panic(err)
+	}
+}
+
+// DeliverMsg handles the msg by its handler in the msg service router, like it
+// is delivered in a tx.
+// The state changes are written only if the msg succeeds.
+func (tApp *TestApp) DeliverMsg(msg sdk.Msg) (*sdk.Result, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	handler := tApp.MsgServiceRouter().Handler(msg)
+	if handler == nil {
+		return nil, fmt.Errorf("no handler for %s", sdk.MsgTypeURL(msg))
+	}
+	ctx, writeCache := tApp.Ctx.CacheContext()
+	res, err := handler(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+	writeCache()
+	return res, nil
+}
+
+// CreateValidators creates validators with self-delegations of the consensus
+// powers, and returns their operator addresses.
+// The validators are bonded at the end of the current block.
+func (tApp *TestApp) CreateValidators(powers ...int64) []sdk.ValAddress {
+	msgServer := stakingkeeper.NewMsgServerImpl(*tApp.StakingKeeper)
+	bondDenom := tApp.StakingKeeper.BondDenom(tApp.Ctx)
+	valAddrs := make([]sdk.ValAddress, len(powers))
+	for i, power := range powers {
+		pubKey := ed25519.GenPrivKey().PubKey()
+		addr := sdk.AccAddress(pubKey.Address())
+		selfDelegation := sdk.NewCoin(bondDenom, sdk.TokensFromConsensusPower(power, sdk.DefaultPowerReduction))
+		tApp.FundAccount(addr, sdk.NewCoins(selfDelegation))
+		msg, err := stakingtypes.NewMsgCreateValidator(
+			sdk.ValAddress(addr), pubKey, selfDelegation, stakingtypes.Description{Moniker: addr.String()},
+			stakingtypes.NewCommissionRates(utils.ParseDec("0.1"), utils.ParseDec("0.2"), utils.ParseDec("0.01")),
+			sdk.OneInt())
+		if err != nil {
+			panic(err)
+		}
+		if _, err := msgServer.CreateValidator(sdk.WrapSDKContext(tApp.Ctx), msg); err != nil {
+			panic(err)
+		}
+		valAddrs[i] = sdk.ValAddress(addr)
+	}
+	return valAddrs
+}
+
+// SlashValidator slashes the fraction of the validator's tokens for an
+// infraction at the current block height.
+func (tApp *TestApp) SlashValidator(valAddr sdk.ValAddress, fraction sdk.Dec) {
+	val, found := tApp.StakingKeeper.GetValidator(tApp.Ctx, valAddr)
+	if !found {
+		panic(fmt.Errorf("validator %s not found", valAddr))
+	}
+	consAddr, err := val.GetConsAddr()
+	if err != nil {
+		panic(err)
+	}
+	tApp.StakingKeeper.Slash(
+		tApp.Ctx, consAddr, tApp.Ctx.BlockHeight(), val.GetConsensusPower(sdk.DefaultPowerReduction), fraction)
+}
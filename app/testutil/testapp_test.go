@@ -0,0 +1,106 @@
+package testutil_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/crescent-network/crescent/v4/app/testutil"
+	utils "github.com/crescent-network/crescent/v4/types"
+	liquiditytypes "github.com/crescent-network/crescent/v4/x/liquidity/types"
+	liquidstakingtypes "github.com/crescent-network/crescent/v4/x/liquidstaking/types"
+)
+
+func TestTestApp_AdvanceBlocks(t *testing.T) {
+	tApp := testutil.NewTestAppBuilder().
+		WithGenesisTime(utils.ParseTime("2023-01-01T00:00:00Z")).
+		WithBlockInterval(time.Minute).
+		Build()
+	require.Equal(t, int64(1), tApp.Ctx.BlockHeight())
+	require.Equal(t, utils.ParseTime("2023-01-01T00:00:00Z"), tApp.Ctx.BlockTime())
+
+	tApp.AdvanceBlocks(2)
+	require.Equal(t, int64(3), tApp.Ctx.BlockHeight())
+	require.Equal(t, utils.ParseTime("2023-01-01T00:02:00Z"), tApp.Ctx.BlockTime())
+
+	tApp.AdvanceBlocksWithTime(3, time.Hour)
+	require.Equal(t, int64(6), tApp.Ctx.BlockHeight())
+	require.Equal(t, utils.ParseTime("2023-01-01T03:02:00Z"), tApp.Ctx.BlockTime())
+}
+
+func TestTestApp_Genesis(t *testing.T) {
+	tApp := testutil.NewTestAppBuilder().
+		WithLiquidityGenesis(func(genState *liquiditytypes.GenesisState) {
+			genState.Params.PairCreationFee = utils.ParseCoins("1stake")
+		}).
+		Build()
+	require.Equal(t, utils.ParseCoins("1stake"), tApp.LiquidityKeeper.GetPairCreationFee(tApp.Ctx))
+}
+
+// TestSlashLiquidUnstakeSwap slashes a liquid validator, liquid unstakes and
+// then swaps the remaining bToken.
+func TestSlashLiquidUnstakeSwap(t *testing.T) {
+	tApp := testutil.NewTestAppBuilder().
+		WithLiquidStakingGenesis(func(genState *liquidstakingtypes.GenesisState) {
+			genState.Params.UnstakeFeeRate = sdk.ZeroDec()
+		}).
+		Build()
+
+	valAddrs := tApp.CreateValidators(100, 100)
+	tApp.NextBlock()
+
+	params := tApp.LiquidStakingKeeper.GetParams(tApp.Ctx)
+	for _, valAddr := range valAddrs {
+		params.WhitelistedValidators = append(params.WhitelistedValidators, liquidstakingtypes.WhitelistedValidator{
+			ValidatorAddress: valAddr.String(),
+			TargetWeight:     sdk.NewInt(1),
+		})
+	}
+	tApp.LiquidStakingKeeper.SetParams(tApp.Ctx, params)
+	tApp.NextBlock()
+
+	staker, lp := tApp.Addr(1), tApp.Addr(2)
+	tApp.FundAccount(staker, utils.ParseCoins("1000000000stake"))
+	tApp.FundAccount(lp, utils.ParseCoins("1000000000stake"))
+	for _, addr := range []sdk.AccAddress{staker, lp} {
+		_, err := tApp.DeliverMsg(liquidstakingtypes.NewMsgLiquidStake(addr, utils.ParseCoin("500000000stake")))
+		require.NoError(t, err)
+	}
+	tApp.NextBlock()
+
+	tApp.SlashValidator(valAddrs[0], utils.ParseDec("0.1"))
+	tApp.NextBlock()
+
+	// The unstaked amount reflects the slashing.
+	_, err := tApp.DeliverMsg(liquidstakingtypes.NewMsgLiquidUnstake(staker, utils.ParseCoin("250000000bstake")))
+	require.NoError(t, err)
+	unbondingAmt := sdk.ZeroInt()
+	for _, ubd := range tApp.StakingKeeper.GetAllUnbondingDelegations(tApp.Ctx, staker) {
+		for _, entry := range ubd.Entries {
+			unbondingAmt = unbondingAmt.Add(entry.Balance)
+		}
+	}
+	require.True(t, unbondingAmt.IsPositive())
+	require.True(t, unbondingAmt.LT(sdk.NewInt(250000000)))
+	tApp.NextBlock()
+
+	_, err = tApp.DeliverMsg(liquiditytypes.NewMsgCreatePair(lp, "bstake", "stake"))
+	require.NoError(t, err)
+	pair, _ := tApp.LiquidityKeeper.GetPair(tApp.Ctx, tApp.LiquidityKeeper.GetLastPairId(tApp.Ctx))
+	_, err = tApp.DeliverMsg(liquiditytypes.NewMsgCreatePool(lp, pair.Id, utils.ParseCoins("100000000bstake,100000000stake")))
+	require.NoError(t, err)
+	tApp.NextBlock()
+
+	_, err = tApp.DeliverMsg(liquiditytypes.NewMsgLimitOrder(
+		staker, pair.Id, liquiditytypes.OrderDirectionSell, utils.ParseCoin("10000000bstake"), "stake",
+		utils.ParseDec("0.9"), sdk.NewInt(10000000), time.Hour))
+	require.NoError(t, err)
+	stakeBalance := tApp.BankKeeper.GetBalance(tApp.Ctx, staker, "stake")
+	tApp.NextBlock()
+
+	require.True(t, tApp.BankKeeper.GetBalance(tApp.Ctx, staker, "stake").Amount.GT(stakeBalance.Amount))
+	require.True(t, tApp.BankKeeper.GetBalance(tApp.Ctx, staker, "bstake").Amount.Equal(sdk.NewInt(240000000)))
+}
@@ -0,0 +1,39 @@
+package v5
+
+import (
+	store "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	epochstypes "github.com/crescent-network/crescent/v4/x/epochs/types"
+	liquiditykeeper "github.com/crescent-network/crescent/v4/x/liquidity/keeper"
+	liquiditytypes "github.com/crescent-network/crescent/v4/x/liquidity/types"
+)
+
+const UpgradeName = "v5"
+
+func UpgradeHandler(
+	mm *module.Manager, configurator module.Configurator, liquidityKeeper liquiditykeeper.Keeper) upgradetypes.UpgradeHandler {
+	return func(ctx sdk.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		newVM, err := mm.RunMigrations(ctx, configurator, fromVM)
+		if err != nil {
+			return newVM, err
+		}
+
+		// Move pair escrows and pool reserves to the current address version.
+		// It is a no-op until a new address version is introduced.
+		if err := liquidityKeeper.MigrateAddresses(ctx, liquiditytypes.CurrentAddressVersion); err != nil {
+			return newVM, err
+		}
+
+		return newVM, nil
+	}
+}
+
+// Add store upgrades for new modules
+var StoreUpgrades = store.StoreUpgrades{
+	Added: []string{
+		epochstypes.StoreKey,
+	},
+}
@@ -0,0 +1,114 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+
+	liquiditytypes "github.com/crescent-network/crescent/v4/x/liquidity/types"
+)
+
+// ImportOrderBookCmd returns import-order-book cobra Command.
+func ImportOrderBookCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import-order-book [snapshot-file]",
+		Short: "Import an order book snapshot into genesis.json",
+		Long: `Import an order book snapshot exported by the export-order-book query command
+into genesis.json. The pair and its pools are assigned new ids, and every address
+in the snapshot is replaced with a deterministic address derived from it.
+The pair escrow, pool reserves and pool coin holders are funded in the bank genesis
+state so that the imported order book can be matched right after the chain starts.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			cdc := clientCtx.Codec
+
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			config.SetRoot(clientCtx.HomeDir)
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return fmt.Errorf("failed to read snapshot file: %w", err)
+			}
+
+			var snapshot liquiditytypes.OrderBookSnapshot
+			if err := json.Unmarshal(bz, &snapshot); err != nil {
+				return fmt.Errorf("failed to unmarshal snapshot: %w", err)
+			}
+
+			genFile := config.GenesisFile()
+			appState, genDoc, err := genutiltypes.GenesisStateFromGenFile(genFile)
+			if err != nil {
+				return fmt.Errorf("failed to unmarshal genesis state: %w", err)
+			}
+
+			var liquidityGenState liquiditytypes.GenesisState
+			if err := cdc.UnmarshalJSON(appState[liquiditytypes.ModuleName], &liquidityGenState); err != nil {
+				return fmt.Errorf("failed to unmarshal liquidity genesis state: %w", err)
+			}
+
+			balances, err := liquiditytypes.ImportOrderBookSnapshot(&liquidityGenState, snapshot, liquiditytypes.RemapAddress)
+			if err != nil {
+				return fmt.Errorf("failed to import snapshot: %w", err)
+			}
+
+			if err := liquidityGenState.Validate(); err != nil {
+				return fmt.Errorf("failed to validate liquidity genesis state: %w", err)
+			}
+
+			liquidityGenStateBz, err := cdc.MarshalJSON(&liquidityGenState)
+			if err != nil {
+				return fmt.Errorf("failed to marshal liquidity genesis state: %w", err)
+			}
+
+			appState[liquiditytypes.ModuleName] = liquidityGenStateBz
+
+			bankGenState := banktypes.GetGenesisStateFromAppState(cdc, appState)
+			balanceIndexes := map[string]int{}
+			for i, balance := range bankGenState.Balances {
+				balanceIndexes[balance.Address] = i
+			}
+			for _, balance := range balances {
+				if i, ok := balanceIndexes[balance.Address]; ok {
+					bankGenState.Balances[i].Coins = bankGenState.Balances[i].Coins.Add(balance.Coins...)
+				} else {
+					balanceIndexes[balance.Address] = len(bankGenState.Balances)
+					bankGenState.Balances = append(bankGenState.Balances, balance)
+				}
+				bankGenState.Supply = bankGenState.Supply.Add(balance.Coins...)
+			}
+			bankGenState.Balances = banktypes.SanitizeGenesisBalances(bankGenState.Balances)
+
+			bankGenStateBz, err := cdc.MarshalJSON(bankGenState)
+			if err != nil {
+				return fmt.Errorf("failed to marshal bank genesis state: %w", err)
+			}
+
+			appState[banktypes.ModuleName] = bankGenStateBz
+
+			appStateJSON, err := json.Marshal(appState)
+			if err != nil {
+				return fmt.Errorf("failed to marshal application genesis state: %w", err)
+			}
+
+			genDoc.AppState = appStateJSON
+			return genutil.ExportGenesisFile(genDoc, genFile)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+
+	return cmd
+}
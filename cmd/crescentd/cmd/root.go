@@ -122,10 +122,18 @@ func initAppConfig() (string, interface{}) {
 		LruSize uint64 `mapstructure:"lru_size"`
 	}
 
+	// LiquidityConfig defines configuration for the liquidity module.
+	type LiquidityConfig struct {
+		// LegacyOrderEvents defines whether the legacy order events are emitted
+		// along with the typed order events.
+		LegacyOrderEvents bool `mapstructure:"legacy-order-events"`
+	}
+
 	type CustomAppConfig struct {
 		serverconfig.Config
 
-		WASM WASMConfig `mapstructure:"wasm"`
+		WASM      WASMConfig      `mapstructure:"wasm"`
+		Liquidity LiquidityConfig `mapstructure:"liquidity"`
 	}
 
 	// Optionally allow the chain developer to overwrite the SDK's default
@@ -151,6 +159,9 @@ func initAppConfig() (string, interface{}) {
 			LruSize:       1,
 			QueryGasLimit: 300000,
 		},
+		Liquidity: LiquidityConfig{
+			LegacyOrderEvents: true,
+		},
 	}
 
 	customAppTemplate := serverconfig.DefaultConfigTemplate + `
@@ -159,7 +170,31 @@ func initAppConfig() (string, interface{}) {
 query_gas_limit = 300000
 # This is the number of wasm vm instances we keep cached in memory for speed-up
 # Warning: this is currently unstable and may lead to crashes, best to keep for 0 unless testing locally
-lru_size = 0`
+lru_size = 0
+
+[liquidity]
+# Whether to emit the legacy order events of the liquidity module, of which
+# attributes are plain strings, along with the typed order events.
+# The legacy order events are deprecated and will be removed in the next release.
+legacy-order-events = {{ .Liquidity.LegacyOrderEvents }}
+
+###############################################################################
+###                        State Streaming (ADR-038)                        ###
+###############################################################################
+
+[store]
+# The streaming services to stream the KV changes of the stores, such as
+# ["file"]. State streaming is disabled if empty.
+streamers = []
+
+[streamers.file]
+# The store keys to stream, such as ["liquidity", "liquidstaking"].
+# "*" streams all the stores.
+keys = []
+# The directory to write the streamed files to.
+write_dir = ""
+# The optional prefix of the streamed files.
+prefix = ""`
 
 	return customAppTemplate, customAppConfig
 }
@@ -172,6 +207,7 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig farmingparams.EncodingCo
 		genutilcli.GenTxCmd(chain.ModuleBasics, encodingConfig.TxConfig, banktypes.GenesisBalancesIterator{}, chain.DefaultNodeHome),
 		genutilcli.ValidateGenesisCmd(chain.ModuleBasics),
 		AddGenesisAccountCmd(chain.DefaultNodeHome),
+		ImportOrderBookCmd(chain.DefaultNodeHome),
 		tmcli.NewCompletionCmd(rootCmd, true),
 		testnetCmd(chain.ModuleBasics, banktypes.GenesisBalancesIterator{}),
 		debug.Cmd(),
@@ -28,3 +28,7 @@ done
 # move proto files to the right places
 cp -r github.com/crescent-network/crescent/v4/* ./
 rm -rf github.com
+
+# generate the descriptor set of the liquidity module including the comments,
+# which is embedded in the binary and served by Query/ProtoDescriptors
+buf build --path proto/crescent/liquidity/v1beta1 --exclude-imports --as-file-descriptor-set -o x/liquidity/types/descriptors.binpb
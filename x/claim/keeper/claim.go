@@ -26,15 +26,16 @@ func (k Keeper) Claim(ctx sdk.Context, msg *types.MsgClaim) (types.ClaimRecord,
 		return types.ClaimRecord{}, sdkerrors.Wrap(sdkerrors.ErrNotFound, "claim record not found")
 	}
 
-	for _, c := range record.ClaimedConditions {
-		if c == msg.ConditionType {
-			return types.ClaimRecord{}, types.ErrAlreadyClaimed
-		}
+	if record.HasClaimedCondition(msg.ConditionType) {
+		return types.ClaimRecord{}, types.ErrAlreadyClaimed
 	}
 
-	// Validate whether or not the recipient has executed the condition
-	if err := k.ValidateCondition(ctx, record.GetRecipient(), msg.ConditionType); err != nil {
-		return types.ClaimRecord{}, err
+	// Validate whether or not the recipient has executed the condition,
+	// unless the condition has been completed by the hooks already.
+	if !record.HasCompletedCondition(msg.ConditionType) {
+		if err := k.ValidateCondition(ctx, record.GetRecipient(), msg.ConditionType); err != nil {
+			return types.ClaimRecord{}, err
+		}
 	}
 
 	claimableCoins := record.GetClaimableCoinsForCondition(airdrop.Conditions)
@@ -121,6 +122,37 @@ func (k Keeper) ValidateCondition(ctx sdk.Context, recipient sdk.AccAddress, ct
 	return nil
 }
 
+// CompleteCondition records that the recipient has executed the condition
+// in the claim records of the airdrops in progress which have the condition,
+// so that the recipient can claim the condition any time before the
+// airdrop ends.
+// It is called by the hooks of the liquidity, liquidstaking and gov modules.
+func (k Keeper) CompleteCondition(ctx sdk.Context, recipient sdk.AccAddress, ct types.ConditionType) {
+	for _, airdrop := range k.GetAllAirdrops(ctx) {
+		if ctx.BlockTime().Before(airdrop.StartTime) || !airdrop.EndTime.After(ctx.BlockTime()) {
+			continue
+		}
+		if !airdrop.HasCondition(ct) {
+			continue
+		}
+		record, found := k.GetClaimRecordByRecipient(ctx, airdrop.Id, recipient)
+		if !found || record.HasClaimedCondition(ct) || record.HasCompletedCondition(ct) {
+			continue
+		}
+		record.CompletedConditions = append(record.CompletedConditions, ct)
+		k.SetClaimRecord(ctx, record)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCompleteCondition,
+				sdk.NewAttribute(types.AttributeKeyAirdropId, fmt.Sprint(record.AirdropId)),
+				sdk.NewAttribute(types.AttributeKeyRecipient, record.Recipient),
+				sdk.NewAttribute(types.AttributeKeyConditionType, ct.String()),
+			),
+		)
+	}
+}
+
 // TerminateAirdrop terminates the airdrop and transfer the remaining coins to the community pool.
 func (k Keeper) TerminateAirdrop(ctx sdk.Context, airdrop types.Airdrop) error {
 	amt := k.bankKeeper.SpendableCoins(ctx, airdrop.GetSourceAddress())
@@ -618,6 +618,12 @@ func (s *KeeperTestSuite) TestGasConsumption_Upgrade_v1_0_0() {
 
 		s.vote(recipient, 2, govtypes.OptionYes)
 
+		// Forget the condition recorded by the gov hook so that the claim
+		// validates the condition by iterating the votes.
+		record, _ := s.keeper.GetClaimRecordByRecipient(s.ctx, airdrop.Id, recipient)
+		record.CompletedConditions = nil
+		s.keeper.SetClaimRecord(s.ctx, record)
+
 		_, err := s.keeper.Claim(s.ctx, types.NewMsgClaim(airdrop.Id, recipient, types.ConditionTypeVote))
 		s.Require().NoError(err)
 
@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/crescent-network/crescent/v4/x/claim/types"
+	liquiditytypes "github.com/crescent-network/crescent/v4/x/liquidity/types"
+	liquidstakingtypes "github.com/crescent-network/crescent/v4/x/liquidstaking/types"
+)
+
+// Hooks wraps the claim keeper to record the conditions executed by the
+// airdrop recipients.
+type Hooks struct {
+	k Keeper
+}
+
+var (
+	_ liquiditytypes.LiquidityHooks         = Hooks{}
+	_ liquidstakingtypes.LiquidStakingHooks = Hooks{}
+	_ govtypes.GovHooks                     = Hooks{}
+)
+
+// Hooks returns the claim hooks.
+func (k Keeper) Hooks() Hooks { return Hooks{k} }
+
+func (h Hooks) AfterPoolCreated(_ sdk.Context, _ sdk.AccAddress, _ liquiditytypes.Pool) {}
+
+// AfterDeposit completes the deposit condition of the depositor.
+func (h Hooks) AfterDeposit(ctx sdk.Context, depositor sdk.AccAddress, _ uint64, _ sdk.Coins, _ sdk.Coin) {
+	h.k.CompleteCondition(ctx, depositor, types.ConditionTypeDeposit)
+}
+
+func (h Hooks) AfterWithdraw(_ sdk.Context, _ sdk.AccAddress, _ uint64, _ sdk.Coin, _ sdk.Coins) {}
+
+// AfterSwapMatched completes the swap condition of the orderer.
+func (h Hooks) AfterSwapMatched(ctx sdk.Context, orderer sdk.AccAddress, _, _ uint64, _, _ sdk.Coin) {
+	h.k.CompleteCondition(ctx, orderer, types.ConditionTypeSwap)
+}
+
+// AfterLiquidStake completes the liquid stake condition of the liquid staker.
+func (h Hooks) AfterLiquidStake(ctx sdk.Context, liquidStaker sdk.AccAddress, _, _ sdk.Coin) {
+	h.k.CompleteCondition(ctx, liquidStaker, types.ConditionTypeLiquidStake)
+}
+
+func (h Hooks) AfterLiquidUnstake(_ sdk.Context, _ sdk.AccAddress, _ sdk.Coin, _ sdk.Int) {}
+func (h Hooks) AfterRebalanced(_ sdk.Context, _ []liquidstakingtypes.Redelegation)        {}
+
+func (h Hooks) AfterProposalSubmission(_ sdk.Context, _ uint64)                {}
+func (h Hooks) AfterProposalDeposit(_ sdk.Context, _ uint64, _ sdk.AccAddress) {}
+
+// AfterProposalVote completes the vote condition of the voter.
+func (h Hooks) AfterProposalVote(ctx sdk.Context, _ uint64, voter sdk.AccAddress) {
+	h.k.CompleteCondition(ctx, voter, types.ConditionTypeVote)
+}
+
+func (h Hooks) AfterProposalFailedMinDeposit(_ sdk.Context, _ uint64)  {}
+func (h Hooks) AfterProposalVotingPeriodEnded(_ sdk.Context, _ uint64) {}
+
+func (h Hooks) SetAdditionalVotingPowers(_ sdk.Context, _ govtypes.Votes, _ *govtypes.AdditionalVotingPowers) {
+}
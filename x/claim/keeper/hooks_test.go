@@ -0,0 +1,135 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	utils "github.com/crescent-network/crescent/v4/types"
+	"github.com/crescent-network/crescent/v4/x/claim/types"
+	"github.com/crescent-network/crescent/v4/x/liquidity"
+)
+
+func (s *KeeperTestSuite) TestHooks_CompleteConditions() {
+	// Create an airdrop
+	sourceAddr := s.addr(0)
+	airdrop := s.createAirdrop(
+		1,
+		sourceAddr,
+		utils.ParseCoins("1000000000denom1"),
+		[]types.ConditionType{
+			types.ConditionTypeDeposit,
+			types.ConditionTypeSwap,
+			types.ConditionTypeLiquidStake,
+			types.ConditionTypeVote,
+		},
+		s.ctx.BlockTime(),
+		s.ctx.BlockTime().AddDate(0, 1, 0),
+		true,
+	)
+
+	// Create a claim record
+	recipient := s.addr(1)
+	s.createClaimRecord(
+		airdrop.Id,
+		recipient,
+		utils.ParseCoins("666666667denom1"),
+		utils.ParseCoins("666666667denom1"),
+		[]types.ConditionType{},
+	)
+
+	// Create a normal pair and pool
+	creator := s.addr(2)
+	s.createPair(creator, "denom3", "denom4", true)
+	s.createPool(creator, 1, utils.ParseCoins("1000000denom3,1000000denom4"), true)
+
+	// The recipient makes a deposit and an order matched with the pool
+	s.deposit(recipient, 1, utils.ParseCoins("500000denom3,500000denom4"), true)
+	s.sellLimitOrder(recipient, 1, utils.ParseDec("0.9"), sdk.NewInt(1000), 0, true)
+	liquidity.EndBlocker(s.ctx, s.app.LiquidityKeeper)
+
+	// The recipient liquid stakes and votes
+	s.createWhitelistedValidators([]int64{1000000, 1000000, 1000000})
+	s.liquidStaking(recipient, sdk.NewInt(100_000_000), true)
+	s.createTextProposal(sourceAddr, "Text", "Description")
+	s.vote(recipient, 1, govtypes.OptionYes)
+
+	r, found := s.keeper.GetClaimRecordByRecipient(s.ctx, airdrop.Id, recipient)
+	s.Require().True(found)
+	s.Require().ElementsMatch([]types.ConditionType{
+		types.ConditionTypeDeposit,
+		types.ConditionTypeSwap,
+		types.ConditionTypeLiquidStake,
+		types.ConditionTypeVote,
+	}, r.CompletedConditions)
+	s.Require().Empty(r.ClaimedConditions)
+
+	// The deposit condition can be claimed after the deposit request is
+	// deleted.
+	s.ctx = s.ctx.WithBlockHeight(s.ctx.BlockHeight() + 1)
+	liquidity.BeginBlocker(s.ctx, s.app.LiquidityKeeper)
+	s.Require().Empty(s.app.LiquidityKeeper.GetDepositRequestsByDepositor(s.ctx, recipient))
+	_, err := s.keeper.Claim(s.ctx, types.NewMsgClaim(airdrop.Id, recipient, types.ConditionTypeDeposit))
+	s.Require().NoError(err)
+
+	// Executing a claimed condition again doesn't record it.
+	s.deposit(recipient, 1, utils.ParseCoins("500000denom3,500000denom4"), true)
+	liquidity.EndBlocker(s.ctx, s.app.LiquidityKeeper)
+	r, _ = s.keeper.GetClaimRecordByRecipient(s.ctx, airdrop.Id, recipient)
+	s.Require().Equal([]types.ConditionType{types.ConditionTypeDeposit}, r.ClaimedConditions)
+	s.Require().Len(r.CompletedConditions, 4)
+}
+
+func (s *KeeperTestSuite) TestHooks_AirdropNotInProgress() {
+	s.ctx = s.ctx.WithBlockTime(utils.ParseTime("2022-06-01T00:00:00Z"))
+	sourceAddr := s.addr(0)
+	// An airdrop without the vote condition
+	s.createAirdrop(
+		1,
+		sourceAddr,
+		utils.ParseCoins("1000000000denom1"),
+		[]types.ConditionType{types.ConditionTypeDeposit},
+		s.ctx.BlockTime(),
+		s.ctx.BlockTime().AddDate(0, 1, 0),
+		true,
+	)
+	// An airdrop which hasn't started yet
+	s.createAirdrop(
+		2,
+		sourceAddr,
+		utils.ParseCoins("1000000000denom1"),
+		[]types.ConditionType{types.ConditionTypeVote},
+		s.ctx.BlockTime().AddDate(0, 1, 0),
+		s.ctx.BlockTime().AddDate(0, 2, 0),
+		false,
+	)
+	// An airdrop which has ended
+	s.createAirdrop(
+		3,
+		sourceAddr,
+		utils.ParseCoins("1000000000denom1"),
+		[]types.ConditionType{types.ConditionTypeVote},
+		s.ctx.BlockTime().AddDate(0, -2, 0),
+		s.ctx.BlockTime(),
+		false,
+	)
+
+	recipient := s.addr(1)
+	for _, airdropId := range []uint64{1, 2, 3} {
+		s.createClaimRecord(
+			airdropId,
+			recipient,
+			utils.ParseCoins("1000000denom1"),
+			utils.ParseCoins("1000000denom1"),
+			[]types.ConditionType{},
+		)
+	}
+
+	s.createTextProposal(sourceAddr, "Text", "Description")
+	s.vote(recipient, 1, govtypes.OptionYes)
+
+	for _, airdropId := range []uint64{1, 2, 3} {
+		r, found := s.keeper.GetClaimRecordByRecipient(s.ctx, airdropId, recipient)
+		s.Require().True(found)
+		s.Require().Empty(r.CompletedConditions)
+	}
+}
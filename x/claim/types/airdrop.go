@@ -12,6 +12,11 @@ func (a Airdrop) GetSourceAddress() sdk.AccAddress {
 	return addr
 }
 
+// HasCondition returns whether the airdrop has the condition.
+func (a Airdrop) HasCondition(ct ConditionType) bool {
+	return containsCondition(a.Conditions, ct)
+}
+
 func (r ClaimRecord) GetRecipient() sdk.AccAddress {
 	addr, err := sdk.AccAddressFromBech32(r.Recipient)
 	if err != nil {
@@ -20,6 +25,17 @@ func (r ClaimRecord) GetRecipient() sdk.AccAddress {
 	return addr
 }
 
+// HasClaimedCondition returns whether the recipient has claimed the condition.
+func (r ClaimRecord) HasClaimedCondition(ct ConditionType) bool {
+	return containsCondition(r.ClaimedConditions, ct)
+}
+
+// HasCompletedCondition returns whether the condition has been recorded as
+// executed by the recipient.
+func (r ClaimRecord) HasCompletedCondition(ct ConditionType) bool {
+	return containsCondition(r.CompletedConditions, ct)
+}
+
 // GetClaimableCoinsForCondition uses unclaimed # of conditions as divisor to
 // calculate a proportionate claimable amount of coins for the condition.
 func (r ClaimRecord) GetClaimableCoinsForCondition(airdropConditions []ConditionType) sdk.Coins {
@@ -39,3 +55,12 @@ func (r ClaimRecord) GetClaimableCoinsForCondition(airdropConditions []Condition
 	}
 	return claimableCoins
 }
+
+func containsCondition(conditions []ConditionType, ct ConditionType) bool {
+	for _, c := range conditions {
+		if c == ct {
+			return true
+		}
+	}
+	return false
+}
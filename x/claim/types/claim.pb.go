@@ -130,6 +130,10 @@ type ClaimRecord struct {
 	// claimed_conditions specifies a list of condition types
 	// initial values are empty and each condition type gets appended when claim is successfully executed
 	ClaimedConditions []ConditionType `protobuf:"varint,5,rep,packed,name=claimed_conditions,json=claimedConditions,proto3,enum=crescent.claim.v1beta1.ConditionType" json:"claimed_conditions,omitempty"`
+	// completed_conditions specifies a list of condition types the recipient has executed during the airdrop
+	// each condition type gets appended by the hooks of the liquidity, liquidstaking and gov modules, and then it can be
+	// claimed at any time before the airdrop ends
+	CompletedConditions []ConditionType `protobuf:"varint,6,rep,packed,name=completed_conditions,json=completedConditions,proto3,enum=crescent.claim.v1beta1.ConditionType" json:"completed_conditions,omitempty"`
 }
 
 func (m *ClaimRecord) Reset()         { *m = ClaimRecord{} }
@@ -176,48 +180,49 @@ func init() {
 }
 
 var fileDescriptor_2502de86f40cec83 = []byte{
-	// 642 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x41, 0x4f, 0xdb, 0x30,
-	0x18, 0x4d, 0xda, 0x32, 0xa8, 0x11, 0x5d, 0xf1, 0x80, 0x75, 0x11, 0x4b, 0x23, 0x24, 0xa4, 0x6a,
-	0x12, 0xc9, 0x60, 0xec, 0x36, 0x69, 0x2a, 0x6d, 0x26, 0x45, 0x43, 0xb4, 0x6b, 0x02, 0xd3, 0x76,
-	0x89, 0xd2, 0xd8, 0x74, 0x16, 0x6d, 0x9c, 0xc5, 0x2e, 0x8c, 0xf3, 0x2e, 0x13, 0xd2, 0x24, 0x7e,
-	0xc0, 0x38, 0xed, 0x30, 0x69, 0xbf, 0x84, 0x23, 0xc7, 0x9d, 0xc6, 0x06, 0x7f, 0x64, 0x4a, 0x9c,
-	0x42, 0x5b, 0xf5, 0x82, 0xb4, 0x53, 0xe2, 0xe7, 0xf7, 0xfc, 0xbe, 0xef, 0x7d, 0x4e, 0xc0, 0x8a,
-	0x1f, 0x61, 0xe6, 0xe3, 0x80, 0x1b, 0x7e, 0xd7, 0x23, 0x3d, 0xe3, 0x70, 0xbd, 0x8d, 0xb9, 0xb7,
-	0x2e, 0x56, 0x7a, 0x18, 0x51, 0x4e, 0xe1, 0xd2, 0x80, 0xa3, 0x0b, 0x34, 0xe5, 0x28, 0x0b, 0x1d,
-	0xda, 0xa1, 0x09, 0xc5, 0x88, 0xdf, 0x04, 0x5b, 0x29, 0x77, 0x28, 0xed, 0x74, 0xb1, 0x91, 0xac,
-	0xda, 0xfd, 0x7d, 0x83, 0x93, 0x1e, 0x66, 0xdc, 0xeb, 0x85, 0x29, 0x41, 0xf5, 0x29, 0xeb, 0x51,
-	0x66, 0xb4, 0x3d, 0x86, 0x6f, 0xfd, 0x28, 0x09, 0xc4, 0xfe, 0xca, 0xd7, 0x0c, 0x98, 0xae, 0x92,
-	0x08, 0x45, 0x34, 0x84, 0x05, 0x90, 0x21, 0xa8, 0x24, 0x6b, 0x72, 0x25, 0xd7, 0xca, 0x10, 0x04,
-	0x57, 0x41, 0x81, 0xd1, 0x7e, 0xe4, 0x63, 0xd7, 0x43, 0x28, 0xc2, 0x8c, 0x95, 0x32, 0x9a, 0x5c,
-	0xc9, 0xb7, 0xe6, 0x04, 0x5a, 0x15, 0x20, 0x34, 0x01, 0xf0, 0x69, 0x80, 0x08, 0x27, 0x34, 0x60,
-	0xa5, 0xac, 0x96, 0xad, 0x14, 0x36, 0x56, 0xf5, 0xc9, 0x6d, 0xe8, 0xb5, 0x01, 0xd3, 0x39, 0x0e,
-	0x71, 0x6b, 0x48, 0x08, 0x6b, 0x00, 0x30, 0xee, 0x45, 0xdc, 0x8d, 0x5b, 0x28, 0xe5, 0x34, 0xb9,
-	0x32, 0xbb, 0xa1, 0xe8, 0xa2, 0x3f, 0x7d, 0xd0, 0x9f, 0xee, 0x0c, 0xfa, 0xdb, 0x9a, 0x39, 0xff,
-	0x5d, 0x96, 0x4e, 0x2f, 0xcb, 0x72, 0x2b, 0x9f, 0xe8, 0xe2, 0x1d, 0xf8, 0x12, 0xcc, 0xe0, 0x00,
-	0x89, 0x23, 0xa6, 0xee, 0x70, 0xc4, 0x34, 0x0e, 0x50, 0x8c, 0xaf, 0x7c, 0xcb, 0x82, 0xd9, 0x5a,
-	0x5c, 0x71, 0x0b, 0xfb, 0x34, 0x42, 0xf0, 0x31, 0x00, 0x9e, 0x88, 0xc7, 0xbd, 0xc9, 0x26, 0x9f,
-	0x22, 0x16, 0x82, 0xcb, 0x20, 0x1f, 0x61, 0x9f, 0x84, 0x04, 0x07, 0x3c, 0x4d, 0xe7, 0x16, 0x80,
-	0x9f, 0x65, 0xf0, 0x90, 0x04, 0x84, 0x13, 0xaf, 0xeb, 0x26, 0x31, 0x78, 0xed, 0x2e, 0x76, 0xe3,
-	0xf4, 0x45, 0x4e, 0xb3, 0x1b, 0x8f, 0x74, 0x31, 0x1f, 0x3d, 0x9e, 0xcf, 0x50, 0x48, 0x24, 0xd8,
-	0x7a, 0x1a, 0x17, 0xf7, 0xf3, 0xb2, 0x5c, 0xe9, 0x10, 0xfe, 0xa1, 0xdf, 0xd6, 0x7d, 0xda, 0x33,
-	0xd2, 0x61, 0x8a, 0xc7, 0x1a, 0x43, 0x07, 0x06, 0x3f, 0x0e, 0x31, 0x4b, 0x04, 0xac, 0xb5, 0x98,
-	0x7a, 0xd5, 0x06, 0x56, 0x09, 0x0c, 0x39, 0xb8, 0x3f, 0x6e, 0x9e, 0xfb, 0xff, 0xe6, 0x05, 0x7f,
-	0xd4, 0xd5, 0x01, 0x30, 0x41, 0x30, 0x72, 0x87, 0x6e, 0xc7, 0xd4, 0x5d, 0x6e, 0xc7, 0x7c, 0x7a,
-	0xc0, 0x0d, 0xca, 0x9e, 0xfc, 0xc8, 0x80, 0xb9, 0x11, 0x12, 0x7c, 0x01, 0x94, 0x5a, 0x63, 0xa7,
-	0x6e, 0x39, 0x56, 0x63, 0xc7, 0x75, 0xde, 0x35, 0x4d, 0x77, 0x77, 0xc7, 0x6e, 0x9a, 0x35, 0xeb,
-	0x95, 0x65, 0xd6, 0x8b, 0x92, 0xb2, 0x7c, 0x72, 0xa6, 0x95, 0x46, 0x24, 0xbb, 0x01, 0x0b, 0xb1,
-	0x4f, 0xf6, 0x09, 0x46, 0x70, 0x13, 0x2c, 0x8d, 0xa9, 0xeb, 0x66, 0xb3, 0x61, 0x5b, 0x4e, 0x51,
-	0x56, 0x4a, 0x27, 0x67, 0xda, 0xc2, 0x88, 0xb2, 0x8e, 0x43, 0xca, 0x08, 0x87, 0x3a, 0x78, 0x30,
-	0xa6, 0xb2, 0xdf, 0x56, 0x9b, 0xc5, 0x8c, 0xb2, 0x78, 0x72, 0xa6, 0xcd, 0x8f, 0x48, 0xec, 0x23,
-	0x2f, 0x9c, 0x50, 0xe3, 0xb6, 0xf5, 0x66, 0xd7, 0xaa, 0xdb, 0x4e, 0xf5, 0xb5, 0x59, 0xcc, 0x4e,
-	0xa8, 0x71, 0x9b, 0x7c, 0xec, 0x13, 0x64, 0x73, 0xef, 0x00, 0x4f, 0x70, 0xdb, 0x6b, 0x38, 0x66,
-	0x31, 0x37, 0xc1, 0x6d, 0x8f, 0x72, 0xac, 0xe4, 0xbe, 0x7c, 0x57, 0xa5, 0x2d, 0xfb, 0xfc, 0xaf,
-	0x2a, 0x9d, 0x5f, 0xa9, 0xf2, 0xc5, 0x95, 0x2a, 0xff, 0xb9, 0x52, 0xe5, 0xd3, 0x6b, 0x55, 0xba,
-	0xb8, 0x56, 0xa5, 0x5f, 0xd7, 0xaa, 0xf4, 0xfe, 0xf9, 0xf0, 0x5c, 0xd3, 0x59, 0xac, 0x05, 0x98,
-	0x1f, 0xd1, 0xe8, 0xe0, 0x06, 0x30, 0x0e, 0x37, 0x8d, 0x4f, 0xe9, 0xaf, 0x2a, 0x19, 0x75, 0xfb,
-	0x5e, 0xf2, 0x11, 0x3d, 0xfb, 0x17, 0x00, 0x00, 0xff, 0xff, 0xb3, 0x66, 0xf7, 0xab, 0xc9, 0x04,
-	0x00, 0x00,
+	// 662 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x4b, 0x4f, 0xdb, 0x4c,
+	0x14, 0xb5, 0x93, 0xf0, 0xc8, 0x20, 0xf2, 0x85, 0xe1, 0xf1, 0xa5, 0x16, 0x75, 0x2c, 0x24, 0xa4,
+	0xa8, 0x12, 0x76, 0xa1, 0x74, 0x57, 0xa9, 0x0a, 0x8e, 0x2b, 0x59, 0x45, 0x24, 0x8d, 0x0d, 0x7d,
+	0x6c, 0x2c, 0xc7, 0x33, 0xa4, 0x23, 0x12, 0x8f, 0xeb, 0x99, 0x40, 0x59, 0x77, 0x53, 0x21, 0x55,
+	0xe2, 0x0f, 0xb0, 0xea, 0xa2, 0x52, 0x7f, 0x09, 0x4b, 0x96, 0x5d, 0x95, 0x16, 0xfe, 0x43, 0xd7,
+	0x95, 0x1f, 0x21, 0x09, 0xca, 0x26, 0x52, 0x57, 0xf6, 0x9c, 0xb9, 0xe7, 0x9e, 0x7b, 0xcf, 0xbd,
+	0x36, 0x58, 0xf3, 0x42, 0xcc, 0x3c, 0xec, 0x73, 0xcd, 0xeb, 0xb8, 0xa4, 0xab, 0x1d, 0x6f, 0xb6,
+	0x30, 0x77, 0x37, 0x93, 0x93, 0x1a, 0x84, 0x94, 0x53, 0xb8, 0xd2, 0x8f, 0x51, 0x13, 0x34, 0x8d,
+	0x91, 0x96, 0xda, 0xb4, 0x4d, 0xe3, 0x10, 0x2d, 0x7a, 0x4b, 0xa2, 0xa5, 0x72, 0x9b, 0xd2, 0x76,
+	0x07, 0x6b, 0xf1, 0xa9, 0xd5, 0x3b, 0xd4, 0x38, 0xe9, 0x62, 0xc6, 0xdd, 0x6e, 0x90, 0x06, 0xc8,
+	0x1e, 0x65, 0x5d, 0xca, 0xb4, 0x96, 0xcb, 0xf0, 0x40, 0x8f, 0x12, 0x3f, 0xb9, 0x5f, 0xfb, 0x92,
+	0x01, 0x33, 0x55, 0x12, 0xa2, 0x90, 0x06, 0xb0, 0x00, 0x32, 0x04, 0x95, 0x44, 0x45, 0xac, 0xe4,
+	0x9a, 0x19, 0x82, 0xe0, 0x3a, 0x28, 0x30, 0xda, 0x0b, 0x3d, 0xec, 0xb8, 0x08, 0x85, 0x98, 0xb1,
+	0x52, 0x46, 0x11, 0x2b, 0xf9, 0xe6, 0x7c, 0x82, 0x56, 0x13, 0x10, 0x1a, 0x00, 0x78, 0xd4, 0x47,
+	0x84, 0x13, 0xea, 0xb3, 0x52, 0x56, 0xc9, 0x56, 0x0a, 0x5b, 0xeb, 0xea, 0xf8, 0x36, 0x54, 0xbd,
+	0x1f, 0x69, 0x9f, 0x06, 0xb8, 0x39, 0x44, 0x84, 0x3a, 0x00, 0x8c, 0xbb, 0x21, 0x77, 0xa2, 0x16,
+	0x4a, 0x39, 0x45, 0xac, 0xcc, 0x6d, 0x49, 0x6a, 0xd2, 0x9f, 0xda, 0xef, 0x4f, 0xb5, 0xfb, 0xfd,
+	0xed, 0xcc, 0x5e, 0xfe, 0x2c, 0x0b, 0xe7, 0xd7, 0x65, 0xb1, 0x99, 0x8f, 0x79, 0xd1, 0x0d, 0x7c,
+	0x0e, 0x66, 0xb1, 0x8f, 0x92, 0x14, 0x53, 0x13, 0xa4, 0x98, 0xc1, 0x3e, 0x8a, 0xf0, 0xb5, 0x3f,
+	0x59, 0x30, 0xa7, 0x47, 0x15, 0x37, 0xb1, 0x47, 0x43, 0x04, 0x1f, 0x02, 0xe0, 0x26, 0xf6, 0x38,
+	0x77, 0xde, 0xe4, 0x53, 0xc4, 0x44, 0x70, 0x15, 0xe4, 0x43, 0xec, 0x91, 0x80, 0x60, 0x9f, 0xa7,
+	0xee, 0x0c, 0x00, 0xf8, 0x49, 0x04, 0xff, 0x13, 0x9f, 0x70, 0xe2, 0x76, 0x9c, 0xd8, 0x06, 0xb7,
+	0xd5, 0xc1, 0x4e, 0xe4, 0x7e, 0xe2, 0xd3, 0xdc, 0xd6, 0x03, 0x35, 0x99, 0x8f, 0x1a, 0xcd, 0x67,
+	0xc8, 0x24, 0xe2, 0xef, 0x3c, 0x8e, 0x8a, 0xfb, 0x7e, 0x5d, 0xae, 0xb4, 0x09, 0x7f, 0xdf, 0x6b,
+	0xa9, 0x1e, 0xed, 0x6a, 0xe9, 0x30, 0x93, 0xc7, 0x06, 0x43, 0x47, 0x1a, 0x3f, 0x0d, 0x30, 0x8b,
+	0x09, 0xac, 0xb9, 0x9c, 0x6a, 0xe9, 0x7d, 0xa9, 0x18, 0x86, 0x1c, 0xfc, 0x77, 0x5f, 0x3c, 0xf7,
+	0xef, 0xc5, 0x0b, 0xde, 0xa8, 0xaa, 0x0d, 0x60, 0x8c, 0x60, 0xe4, 0x0c, 0x6d, 0xc7, 0xd4, 0x24,
+	0xdb, 0xb1, 0x90, 0x26, 0xd0, 0x07, 0x4b, 0xf2, 0x06, 0x2c, 0x79, 0xb4, 0x1b, 0x74, 0x30, 0x1f,
+	0xcd, 0x3b, 0x3d, 0x49, 0xde, 0xc5, 0xbb, 0x14, 0x83, 0xcc, 0x8f, 0xbe, 0x65, 0xc0, 0xfc, 0x48,
+	0x18, 0x7c, 0x06, 0x24, 0xbd, 0xbe, 0x57, 0x33, 0x6d, 0xb3, 0xbe, 0xe7, 0xd8, 0x6f, 0x1b, 0x86,
+	0xb3, 0xbf, 0x67, 0x35, 0x0c, 0xdd, 0x7c, 0x61, 0x1a, 0xb5, 0xa2, 0x20, 0xad, 0x9e, 0x5d, 0x28,
+	0xa5, 0x11, 0xca, 0xbe, 0xcf, 0x02, 0xec, 0x91, 0x43, 0x82, 0x11, 0xdc, 0x06, 0x2b, 0xf7, 0xd8,
+	0x35, 0xa3, 0x51, 0xb7, 0x4c, 0xbb, 0x28, 0x4a, 0xa5, 0xb3, 0x0b, 0x65, 0x69, 0x84, 0x59, 0xc3,
+	0x01, 0x65, 0x84, 0x43, 0x15, 0x2c, 0xde, 0x63, 0x59, 0xaf, 0xab, 0x8d, 0x62, 0x46, 0x5a, 0x3e,
+	0xbb, 0x50, 0x16, 0x46, 0x28, 0xd6, 0x89, 0x1b, 0x8c, 0xa9, 0x71, 0xd7, 0x7c, 0xb5, 0x6f, 0xd6,
+	0x2c, 0xbb, 0xfa, 0xd2, 0x28, 0x66, 0xc7, 0xd4, 0xb8, 0x4b, 0x3e, 0xf4, 0x08, 0xb2, 0xb8, 0x7b,
+	0x84, 0xc7, 0xa8, 0x1d, 0xd4, 0x6d, 0xa3, 0x98, 0x1b, 0xa3, 0x76, 0x40, 0x39, 0x96, 0x72, 0x9f,
+	0xbf, 0xca, 0xc2, 0x8e, 0x75, 0xf9, 0x5b, 0x16, 0x2e, 0x6f, 0x64, 0xf1, 0xea, 0x46, 0x16, 0x7f,
+	0xdd, 0xc8, 0xe2, 0xf9, 0xad, 0x2c, 0x5c, 0xdd, 0xca, 0xc2, 0x8f, 0x5b, 0x59, 0x78, 0xf7, 0x74,
+	0x78, 0x63, 0xd2, 0x69, 0x6c, 0xf8, 0x98, 0x9f, 0xd0, 0xf0, 0xe8, 0x0e, 0xd0, 0x8e, 0xb7, 0xb5,
+	0x8f, 0xe9, 0x4f, 0x30, 0x5e, 0xa2, 0xd6, 0x74, 0xfc, 0x79, 0x3e, 0xf9, 0x3b, 0x00, 0x8e, 0x5a,
+	0xbe, 0xb1, 0x23, 0x05, 0x00, 0x00,
 }
 
 func (m *Airdrop) Marshal() (dAtA []byte, err error) {
@@ -309,10 +314,10 @@ func (m *ClaimRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if len(m.ClaimedConditions) > 0 {
-		dAtA6 := make([]byte, len(m.ClaimedConditions)*10)
+	if len(m.CompletedConditions) > 0 {
+		dAtA6 := make([]byte, len(m.CompletedConditions)*10)
 		var j5 int
-		for _, num := range m.ClaimedConditions {
+		for _, num := range m.CompletedConditions {
 			for num >= 1<<7 {
 				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
@@ -325,6 +330,24 @@ func (m *ClaimRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], dAtA6[:j5])
 		i = encodeVarintClaim(dAtA, i, uint64(j5))
 		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ClaimedConditions) > 0 {
+		dAtA8 := make([]byte, len(m.ClaimedConditions)*10)
+		var j7 int
+		for _, num := range m.ClaimedConditions {
+			for num >= 1<<7 {
+				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j7++
+			}
+			dAtA8[j7] = uint8(num)
+			j7++
+		}
+		i -= j7
+		copy(dAtA[i:], dAtA8[:j7])
+		i = encodeVarintClaim(dAtA, i, uint64(j7))
+		i--
 		dAtA[i] = 0x2a
 	}
 	if len(m.ClaimableCoins) > 0 {
@@ -440,6 +463,13 @@ func (m *ClaimRecord) Size() (n int) {
 		}
 		n += 1 + sovClaim(uint64(l)) + l
 	}
+	if len(m.CompletedConditions) > 0 {
+		l = 0
+		for _, e := range m.CompletedConditions {
+			l += sovClaim(uint64(e))
+		}
+		n += 1 + sovClaim(uint64(l)) + l
+	}
 	return n
 }
 
@@ -902,6 +932,75 @@ func (m *ClaimRecord) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field ClaimedConditions", wireType)
 			}
+		case 6:
+			if wireType == 0 {
+				var v ConditionType
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowClaim
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= ConditionType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.CompletedConditions = append(m.CompletedConditions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowClaim
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthClaim
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthClaim
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.CompletedConditions) == 0 {
+					m.CompletedConditions = make([]ConditionType, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v ConditionType
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowClaim
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= ConditionType(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.CompletedConditions = append(m.CompletedConditions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletedConditions", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipClaim(dAtA[iNdEx:])
@@ -2,7 +2,8 @@ package types
 
 // Event types for the claim module.
 const (
-	EventTypeClaim = "claim"
+	EventTypeClaim             = "claim"
+	EventTypeCompleteCondition = "complete_condition"
 
 	AttributeKeyAirdropId             = "airdrop_id"
 	AttributeKeyRecipient             = "recipient"
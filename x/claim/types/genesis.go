@@ -67,5 +67,14 @@ func (r ClaimRecord) Validate() error {
 	if err := r.ClaimableCoins.Validate(); err != nil {
 		return fmt.Errorf("invalid claimable coins: %w", err)
 	}
+
+	for _, c := range r.CompletedConditions {
+		switch c {
+		case ConditionTypeDeposit, ConditionTypeSwap,
+			ConditionTypeLiquidStake, ConditionTypeVote:
+		default:
+			return fmt.Errorf("unknown completed condition type %s", c)
+		}
+	}
 	return nil
 }
@@ -60,6 +60,33 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: true,
 		},
+		{
+			desc: "unknown completed condition type",
+			genState: &types.GenesisState{
+				Airdrops: []types.Airdrop{
+					{
+						Id:            1,
+						SourceAddress: sdk.AccAddress(crypto.AddressHash([]byte("sourceAddress"))).String(),
+						Conditions: []types.ConditionType{
+							types.ConditionTypeDeposit,
+						},
+						StartTime: time.Now(),
+						EndTime:   time.Now().AddDate(0, 1, 0),
+					},
+				},
+				ClaimRecords: []types.ClaimRecord{
+					{
+						AirdropId:             1,
+						Recipient:             sdk.AccAddress(crypto.AddressHash([]byte("recipient1"))).String(),
+						InitialClaimableCoins: sdk.NewCoins(sdk.NewCoin("denom1", sdk.NewInt(500_000_000_000))),
+						ClaimableCoins:        sdk.NewCoins(sdk.NewCoin("denom1", sdk.NewInt(500_000_000_000))),
+						ClaimedConditions:     []types.ConditionType{},
+						CompletedConditions:   []types.ConditionType{types.ConditionType(10)},
+					},
+				},
+			},
+			valid: false,
+		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
 			err := tc.genState.Validate()
@@ -0,0 +1,18 @@
+package epochs
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/crescent-network/crescent/v4/x/epochs/keeper"
+	"github.com/crescent-network/crescent/v4/x/epochs/types"
+)
+
+// BeginBlocker advances the epochs whose current epoch has ended and calls
+// the epoch hooks.
+func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
+	k.AdvanceEpochs(ctx)
+}
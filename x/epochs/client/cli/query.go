@@ -0,0 +1,111 @@
+package cli
+
+// DONTCOVER
+// client is excluded from test coverage in MVP version
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/crescent-network/crescent/v4/x/epochs/types"
+)
+
+// GetQueryCmd returns a root CLI command handler for all x/epochs query commands.
+func GetQueryCmd() *cobra.Command {
+	epochsQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the epochs module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	epochsQueryCmd.AddCommand(
+		NewQueryEpochsCmd(),
+		NewQueryEpochCmd(),
+	)
+
+	return epochsQueryCmd
+}
+
+// NewQueryEpochsCmd implements the epochs query command.
+func NewQueryEpochsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "epochs",
+		Args:  cobra.NoArgs,
+		Short: "Query all epochs",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all epochs with their current epoch numbers.
+
+Example:
+$ %s query %s epochs
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			resp, err := queryClient.Epochs(cmd.Context(), &types.QueryEpochsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewQueryEpochCmd implements the epoch query command.
+func NewQueryEpochCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "epoch [identifier]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query an epoch",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query an epoch with its identifier.
+
+Example:
+$ %s query %s epoch day
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			resp, err := queryClient.Epoch(cmd.Context(), &types.QueryEpochRequest{
+				Identifier: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&resp.Epoch)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/crescent-network/crescent/v4/x/epochs/types"
+)
+
+// AdvanceEpochs starts the first epochs of the epoch infos whose start time
+// has come, and moves the epoch infos whose current epoch has ended to the
+// next epoch, calling the epoch hooks.
+// An epoch info advances at most one epoch in a block, so the epochs missed
+// during a chain halt are caught up in the following blocks.
+func (k Keeper) AdvanceEpochs(ctx sdk.Context) {
+	k.IterateEpochInfos(ctx, func(info types.EpochInfo) (stop bool) {
+		initialEpochStart := !info.EpochCountingStarted && !info.StartTime.After(ctx.BlockTime())
+		epochEndTime := info.CurrentEpochEndTime()
+		epochStart := initialEpochStart || (info.EpochCountingStarted && !ctx.BlockTime().Before(epochEndTime))
+		if !epochStart {
+			return false
+		}
+
+		info.CurrentEpochStartHeight = ctx.BlockHeight()
+		if initialEpochStart {
+			info.EpochCountingStarted = true
+			info.CurrentEpoch = 1
+			info.CurrentEpochStartTime = info.StartTime
+		} else {
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeEpochEnd,
+					sdk.NewAttribute(types.AttributeKeyIdentifier, info.Identifier),
+					sdk.NewAttribute(types.AttributeKeyEpochNumber, strconv.FormatInt(info.CurrentEpoch, 10)),
+				),
+			)
+			k.afterEpochEnd(ctx, info.Identifier, info.CurrentEpoch)
+			info.CurrentEpoch++
+			info.CurrentEpochStartTime = epochEndTime
+		}
+		k.SetEpochInfo(ctx, info)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeEpochStart,
+				sdk.NewAttribute(types.AttributeKeyIdentifier, info.Identifier),
+				sdk.NewAttribute(types.AttributeKeyEpochNumber, strconv.FormatInt(info.CurrentEpoch, 10)),
+				sdk.NewAttribute(types.AttributeKeyStartTime, info.CurrentEpochStartTime.String()),
+			),
+		)
+		k.beforeEpochStart(ctx, info.Identifier, info.CurrentEpoch)
+		k.Logger(ctx).Info(
+			"epoch started",
+			"identifier", info.Identifier, "epoch_number", info.CurrentEpoch)
+		return false
+	})
+}
@@ -0,0 +1,91 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/crescent-network/crescent/v4/x/epochs/types"
+)
+
+func (s *KeeperTestSuite) TestAddEpochInfo() {
+	err := s.keeper.AddEpochInfo(s.ctx, types.NewGenesisEpochInfo("hour", time.Hour))
+	s.Require().NoError(err)
+
+	info, found := s.keeper.GetEpochInfo(s.ctx, "hour")
+	s.Require().True(found)
+	s.Require().Equal(s.ctx.BlockTime(), info.StartTime)
+	s.Require().Equal(s.ctx.BlockHeight(), info.CurrentEpochStartHeight)
+	s.Require().False(info.EpochCountingStarted)
+
+	err = s.keeper.AddEpochInfo(s.ctx, types.NewGenesisEpochInfo("hour", time.Minute))
+	s.Require().EqualError(err, "epoch with identifier hour already exists")
+
+	err = s.keeper.AddEpochInfo(s.ctx, types.NewGenesisEpochInfo("minute", 0))
+	s.Require().EqualError(err, "epoch duration must be positive: 0s")
+}
+
+func (s *KeeperTestSuite) TestAdvanceEpochs() {
+	s.Require().NoError(s.keeper.AddEpochInfo(s.ctx, types.NewGenesisEpochInfo("hour", time.Hour)))
+	startTime := s.ctx.BlockTime()
+
+	// The first epoch starts in the first block.
+	s.keeper.AdvanceEpochs(s.ctx)
+	info, _ := s.keeper.GetEpochInfo(s.ctx, "hour")
+	s.Require().True(info.EpochCountingStarted)
+	s.Require().EqualValues(1, info.CurrentEpoch)
+	s.Require().Equal(startTime, info.CurrentEpochStartTime)
+	s.Require().Equal([]hookCall{{"BeforeEpochStart", "hour", 1}}, s.hooks.calls)
+
+	// The epoch doesn't end before its duration passes.
+	s.hooks.calls = nil
+	s.nextBlock(59 * time.Minute)
+	info, _ = s.keeper.GetEpochInfo(s.ctx, "hour")
+	s.Require().EqualValues(1, info.CurrentEpoch)
+	s.Require().Empty(s.hooks.calls)
+
+	s.nextBlock(time.Minute)
+	info, _ = s.keeper.GetEpochInfo(s.ctx, "hour")
+	s.Require().EqualValues(2, info.CurrentEpoch)
+	s.Require().Equal(startTime.Add(time.Hour), info.CurrentEpochStartTime)
+	s.Require().Equal(s.ctx.BlockHeight(), info.CurrentEpochStartHeight)
+	s.Require().Equal([]hookCall{
+		{"AfterEpochEnd", "hour", 1},
+		{"BeforeEpochStart", "hour", 2},
+	}, s.hooks.calls)
+}
+
+func (s *KeeperTestSuite) TestAdvanceEpochs_CatchUp() {
+	s.Require().NoError(s.keeper.AddEpochInfo(s.ctx, types.NewGenesisEpochInfo("hour", time.Hour)))
+	startTime := s.ctx.BlockTime()
+	s.keeper.AdvanceEpochs(s.ctx)
+
+	// After a halt longer than several epochs, an epoch advances per block
+	// until it catches up with the block time.
+	s.nextBlock(150 * time.Minute)
+	info, _ := s.keeper.GetEpochInfo(s.ctx, "hour")
+	s.Require().EqualValues(2, info.CurrentEpoch)
+	s.nextBlock(time.Second)
+	info, _ = s.keeper.GetEpochInfo(s.ctx, "hour")
+	s.Require().EqualValues(3, info.CurrentEpoch)
+	s.Require().Equal(startTime.Add(2*time.Hour), info.CurrentEpochStartTime)
+	s.nextBlock(time.Second)
+	info, _ = s.keeper.GetEpochInfo(s.ctx, "hour")
+	s.Require().EqualValues(3, info.CurrentEpoch)
+}
+
+func (s *KeeperTestSuite) TestAdvanceEpochs_FutureStartTime() {
+	startTime := s.ctx.BlockTime().Add(time.Hour)
+	info := types.NewGenesisEpochInfo("hour", time.Hour)
+	info.StartTime = startTime
+	s.Require().NoError(s.keeper.AddEpochInfo(s.ctx, info))
+
+	s.keeper.AdvanceEpochs(s.ctx)
+	info, _ = s.keeper.GetEpochInfo(s.ctx, "hour")
+	s.Require().False(info.EpochCountingStarted)
+	s.Require().Empty(s.hooks.calls)
+
+	s.nextBlock(time.Hour)
+	info, _ = s.keeper.GetEpochInfo(s.ctx, "hour")
+	s.Require().True(info.EpochCountingStarted)
+	s.Require().EqualValues(1, info.CurrentEpoch)
+	s.Require().Equal(startTime, info.CurrentEpochStartTime)
+}
@@ -0,0 +1,24 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/crescent-network/crescent/v4/x/epochs/types"
+)
+
+// InitGenesis initializes the epochs module's state from a given genesis state.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
+	if err := genState.Validate(); err != nil {
+		panic(err)
+	}
+	for _, info := range genState.Epochs {
+		if err := k.AddEpochInfo(ctx, info); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// ExportGenesis returns the epochs module's genesis state.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return types.NewGenesisState(k.GetAllEpochInfos(ctx))
+}
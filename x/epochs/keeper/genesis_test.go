@@ -0,0 +1,22 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/crescent-network/crescent/v4/x/epochs/types"
+)
+
+func (s *KeeperTestSuite) TestInitExportGenesis() {
+	s.keeper.InitGenesis(s.ctx, *types.DefaultGenesisState())
+	s.nextBlock(25 * time.Hour)
+
+	genState := s.keeper.ExportGenesis(s.ctx)
+	s.Require().NoError(genState.Validate())
+	s.Require().Len(genState.Epochs, 2)
+
+	for _, info := range genState.Epochs {
+		s.keeper.DeleteEpochInfo(s.ctx, info.Identifier)
+	}
+	s.keeper.InitGenesis(s.ctx, *genState)
+	s.Require().Equal(genState, s.keeper.ExportGenesis(s.ctx))
+}
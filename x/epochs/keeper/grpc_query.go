@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/crescent-network/crescent/v4/x/epochs/types"
+)
+
+// Querier is used as Keeper will have duplicate methods if used directly, and gRPC names take precedence over keeper.
+type Querier struct {
+	Keeper
+}
+
+var _ types.QueryServer = Querier{}
+
+// Epochs queries all the epochs.
+func (k Querier) Epochs(c context.Context, req *types.QueryEpochsRequest) (*types.QueryEpochsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryEpochsResponse{Epochs: k.GetAllEpochInfos(ctx)}, nil
+}
+
+// Epoch queries the epoch with the identifier.
+func (k Querier) Epoch(c context.Context, req *types.QueryEpochRequest) (*types.QueryEpochResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Identifier == "" {
+		return nil, status.Error(codes.InvalidArgument, "identifier must not be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	info, found := k.GetEpochInfo(ctx, req.Identifier)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "epoch %s not found", req.Identifier)
+	}
+
+	return &types.QueryEpochResponse{Epoch: info}, nil
+}
@@ -0,0 +1,69 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/crescent-network/crescent/v4/x/epochs/types"
+)
+
+func (s *KeeperTestSuite) TestGRPCEpochs() {
+	s.keeper.InitGenesis(s.ctx, *types.DefaultGenesisState())
+
+	resp, err := s.querier.Epochs(sdk.WrapSDKContext(s.ctx), &types.QueryEpochsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(s.keeper.GetAllEpochInfos(s.ctx), resp.Epochs)
+
+	_, err = s.querier.Epochs(sdk.WrapSDKContext(s.ctx), nil)
+	s.Require().EqualError(err, "rpc error: code = InvalidArgument desc = empty request")
+}
+
+func (s *KeeperTestSuite) TestGRPCEpoch() {
+	s.Require().NoError(s.keeper.AddEpochInfo(s.ctx, types.NewGenesisEpochInfo("hour", time.Hour)))
+
+	for _, tc := range []struct {
+		name      string
+		req       *types.QueryEpochRequest
+		expectErr bool
+		postRun   func(*types.QueryEpochResponse)
+	}{
+		{
+			"nil request",
+			nil,
+			true,
+			nil,
+		},
+		{
+			"empty identifier",
+			&types.QueryEpochRequest{},
+			true,
+			nil,
+		},
+		{
+			"epoch not found",
+			&types.QueryEpochRequest{Identifier: "day"},
+			true,
+			nil,
+		},
+		{
+			"happy case",
+			&types.QueryEpochRequest{Identifier: "hour"},
+			false,
+			func(resp *types.QueryEpochResponse) {
+				s.Require().Equal("hour", resp.Epoch.Identifier)
+				s.Require().Equal(time.Hour, resp.Epoch.Duration)
+			},
+		},
+	} {
+		s.Run(tc.name, func() {
+			resp, err := s.querier.Epoch(sdk.WrapSDKContext(s.ctx), tc.req)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				tc.postRun(resp)
+			}
+		})
+	}
+}
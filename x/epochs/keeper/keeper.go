@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/crescent-network/crescent/v4/x/epochs/types"
+)
+
+// Keeper of the epochs store.
+type Keeper struct {
+	cdc      codec.BinaryCodec
+	storeKey sdk.StoreKey
+	hooks    types.EpochHooks
+}
+
+// NewKeeper returns an epochs keeper.
+func NewKeeper(cdc codec.BinaryCodec, storeKey sdk.StoreKey) Keeper {
+	return Keeper{
+		cdc:      cdc,
+		storeKey: storeKey,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// SetHooks sets the epoch hooks.
+func (k *Keeper) SetHooks(eh types.EpochHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set epochs hooks twice")
+	}
+	k.hooks = eh
+	return k
+}
+
+func (k Keeper) afterEpochEnd(ctx sdk.Context, identifier string, epochNumber int64) {
+	if k.hooks != nil {
+		k.hooks.AfterEpochEnd(ctx, identifier, epochNumber)
+	}
+}
+
+func (k Keeper) beforeEpochStart(ctx sdk.Context, identifier string, epochNumber int64) {
+	if k.hooks != nil {
+		k.hooks.BeforeEpochStart(ctx, identifier, epochNumber)
+	}
+}
@@ -0,0 +1,67 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	chain "github.com/crescent-network/crescent/v4/app"
+	"github.com/crescent-network/crescent/v4/x/epochs/keeper"
+	"github.com/crescent-network/crescent/v4/x/epochs/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app     *chain.App
+	ctx     sdk.Context
+	keeper  keeper.Keeper
+	querier keeper.Querier
+	hooks   *mockEpochHooks
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	s.app = chain.Setup(false)
+	s.ctx = s.app.BaseApp.NewContext(false, tmproto.Header{})
+	s.ctx = s.ctx.WithBlockHeight(1).WithBlockTime(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC))
+	// Use a separate keeper on the same store to subscribe to the hooks,
+	// since the hooks of the app's keeper are already set.
+	s.hooks = &mockEpochHooks{}
+	s.keeper = keeper.NewKeeper(s.app.AppCodec(), s.app.GetKey(types.StoreKey))
+	s.keeper.SetHooks(s.hooks)
+	s.querier = keeper.Querier{Keeper: s.keeper}
+	for _, info := range s.keeper.GetAllEpochInfos(s.ctx) {
+		s.keeper.DeleteEpochInfo(s.ctx, info.Identifier)
+	}
+}
+
+func (s *KeeperTestSuite) nextBlock(dur time.Duration) {
+	s.ctx = s.ctx.WithBlockHeight(s.ctx.BlockHeight() + 1).WithBlockTime(s.ctx.BlockTime().Add(dur))
+	s.keeper.AdvanceEpochs(s.ctx)
+}
+
+type hookCall struct {
+	hook        string
+	identifier  string
+	epochNumber int64
+}
+
+type mockEpochHooks struct {
+	calls []hookCall
+}
+
+func (h *mockEpochHooks) AfterEpochEnd(_ sdk.Context, identifier string, epochNumber int64) {
+	h.calls = append(h.calls, hookCall{"AfterEpochEnd", identifier, epochNumber})
+}
+
+func (h *mockEpochHooks) BeforeEpochStart(_ sdk.Context, identifier string, epochNumber int64) {
+	h.calls = append(h.calls, hookCall{"BeforeEpochStart", identifier, epochNumber})
+}
@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/crescent-network/crescent/v4/x/epochs/types"
+)
+
+// GetEpochInfo returns the epoch info by its identifier.
+func (k Keeper) GetEpochInfo(ctx sdk.Context, identifier string) (info types.EpochInfo, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetEpochInfoKey(identifier))
+	if bz == nil {
+		return
+	}
+	k.cdc.MustUnmarshal(bz, &info)
+	return info, true
+}
+
+// SetEpochInfo stores the epoch info.
+func (k Keeper) SetEpochInfo(ctx sdk.Context, info types.EpochInfo) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&info)
+	store.Set(types.GetEpochInfoKey(info.Identifier), bz)
+}
+
+// DeleteEpochInfo deletes the epoch info by its identifier.
+func (k Keeper) DeleteEpochInfo(ctx sdk.Context, identifier string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetEpochInfoKey(identifier))
+}
+
+// AddEpochInfo validates and stores a new epoch info.
+// The start time of the epoch is set to the block time if it is zero.
+func (k Keeper) AddEpochInfo(ctx sdk.Context, info types.EpochInfo) error {
+	if err := info.Validate(); err != nil {
+		return err
+	}
+	if _, found := k.GetEpochInfo(ctx, info.Identifier); found {
+		return fmt.Errorf("epoch with identifier %s already exists", info.Identifier)
+	}
+	if info.StartTime.IsZero() {
+		info.StartTime = ctx.BlockTime()
+	}
+	if info.CurrentEpochStartHeight == 0 {
+		info.CurrentEpochStartHeight = ctx.BlockHeight()
+	}
+	k.SetEpochInfo(ctx, info)
+	return nil
+}
+
+// IterateEpochInfos iterates through all the epoch infos in the order of
+// their identifiers.
+// Stops iteration when the callback returns true.
+func (k Keeper) IterateEpochInfos(ctx sdk.Context, cb func(info types.EpochInfo) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.EpochInfoKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var info types.EpochInfo
+		k.cdc.MustUnmarshal(iter.Value(), &info)
+		if cb(info) {
+			break
+		}
+	}
+}
+
+// GetAllEpochInfos returns all the epoch infos.
+func (k Keeper) GetAllEpochInfos(ctx sdk.Context) (infos []types.EpochInfo) {
+	infos = []types.EpochInfo{}
+	k.IterateEpochInfos(ctx, func(info types.EpochInfo) (stop bool) {
+		infos = append(infos, info)
+		return false
+	})
+	return
+}
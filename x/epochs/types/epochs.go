@@ -0,0 +1,47 @@
+package types
+
+import (
+	"fmt"
+	"time"
+)
+
+// Identifiers of the epochs defined in the default genesis.
+const (
+	DayEpochIdentifier  = "day"
+	WeekEpochIdentifier = "week"
+)
+
+// NewGenesisEpochInfo returns a new EpochInfo which hasn't started yet.
+// The start time is set to the block time when the epoch is added to the store
+// if it is zero.
+func NewGenesisEpochInfo(identifier string, duration time.Duration) EpochInfo {
+	return EpochInfo{
+		Identifier: identifier,
+		Duration:   duration,
+	}
+}
+
+// CurrentEpochEndTime returns the time the current epoch ends at.
+func (info EpochInfo) CurrentEpochEndTime() time.Time {
+	return info.CurrentEpochStartTime.Add(info.Duration)
+}
+
+// Validate validates EpochInfo.
+func (info EpochInfo) Validate() error {
+	if info.Identifier == "" {
+		return fmt.Errorf("epoch identifier must not be empty")
+	}
+	if info.Duration <= 0 {
+		return fmt.Errorf("epoch duration must be positive: %s", info.Duration)
+	}
+	if info.CurrentEpoch < 0 {
+		return fmt.Errorf("current epoch must not be negative: %d", info.CurrentEpoch)
+	}
+	if info.CurrentEpochStartHeight < 0 {
+		return fmt.Errorf("current epoch start height must not be negative: %d", info.CurrentEpochStartHeight)
+	}
+	if !info.EpochCountingStarted && info.CurrentEpoch != 0 {
+		return fmt.Errorf("current epoch must be 0 before epoch counting starts: %d", info.CurrentEpoch)
+	}
+	return nil
+}
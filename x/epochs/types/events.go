@@ -0,0 +1,11 @@
+package types
+
+// Event types for the epochs module.
+const (
+	EventTypeEpochStart = "epoch_start"
+	EventTypeEpochEnd   = "epoch_end"
+
+	AttributeKeyIdentifier  = "identifier"
+	AttributeKeyEpochNumber = "epoch_number"
+	AttributeKeyStartTime   = "start_time"
+)
@@ -0,0 +1,37 @@
+package types
+
+import (
+	"fmt"
+	"time"
+)
+
+// NewGenesisState returns new GenesisState.
+func NewGenesisState(epochs []EpochInfo) *GenesisState {
+	return &GenesisState{
+		Epochs: epochs,
+	}
+}
+
+// DefaultGenesisState returns the default genesis state, which defines the day
+// and week epochs.
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState([]EpochInfo{
+		NewGenesisEpochInfo(DayEpochIdentifier, 24*time.Hour),
+		NewGenesisEpochInfo(WeekEpochIdentifier, 7*24*time.Hour),
+	})
+}
+
+// Validate validates GenesisState.
+func (genState GenesisState) Validate() error {
+	identifiers := map[string]struct{}{}
+	for i, info := range genState.Epochs {
+		if err := info.Validate(); err != nil {
+			return fmt.Errorf("invalid epoch at index %d: %w", i, err)
+		}
+		if _, ok := identifiers[info.Identifier]; ok {
+			return fmt.Errorf("epoch at index %d has a duplicate identifier: %s", i, info.Identifier)
+		}
+		identifiers[info.Identifier] = struct{}{}
+	}
+	return nil
+}
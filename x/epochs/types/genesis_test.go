@@ -0,0 +1,63 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/crescent-network/crescent/v4/x/epochs/types"
+)
+
+func TestGenesisState_Validate(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		malleate    func(genState *types.GenesisState)
+		expectedErr string
+	}{
+		{
+			"default is valid",
+			func(genState *types.GenesisState) {},
+			"",
+		},
+		{
+			"empty identifier",
+			func(genState *types.GenesisState) {
+				genState.Epochs[0].Identifier = ""
+			},
+			"invalid epoch at index 0: epoch identifier must not be empty",
+		},
+		{
+			"zero duration",
+			func(genState *types.GenesisState) {
+				genState.Epochs[1].Duration = 0
+			},
+			"invalid epoch at index 1: epoch duration must be positive: 0s",
+		},
+		{
+			"current epoch before counting starts",
+			func(genState *types.GenesisState) {
+				genState.Epochs[0].CurrentEpoch = 1
+			},
+			"invalid epoch at index 0: current epoch must be 0 before epoch counting starts: 1",
+		},
+		{
+			"duplicate identifier",
+			func(genState *types.GenesisState) {
+				genState.Epochs = append(genState.Epochs, types.NewGenesisEpochInfo(types.DayEpochIdentifier, time.Hour))
+			},
+			"epoch at index 2 has a duplicate identifier: day",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			genState := types.DefaultGenesisState()
+			tc.malleate(genState)
+			err := genState.Validate()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
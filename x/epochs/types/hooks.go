@@ -0,0 +1,42 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ EpochHooks = MultiEpochHooks{}
+
+// EpochHooks defines the hooks called by the epochs module at the boundaries
+// of the epochs, which other modules key their periodic executions off.
+// The hooks are called in the begin block of the epochs module, which comes
+// before the begin blocks of the subscribing modules.
+type EpochHooks interface {
+	// AfterEpochEnd is called when the epoch with the identifier ends,
+	// right before the next epoch starts.
+	AfterEpochEnd(ctx sdk.Context, identifier string, epochNumber int64)
+	// BeforeEpochStart is called when a new epoch with the identifier
+	// starts, including the first epoch.
+	BeforeEpochStart(ctx sdk.Context, identifier string, epochNumber int64)
+}
+
+// MultiEpochHooks combines multiple epoch hooks, all hook functions are run
+// in array sequence.
+// An empty MultiEpochHooks is a no-op hooks.
+type MultiEpochHooks []EpochHooks
+
+// NewMultiEpochHooks returns a new MultiEpochHooks.
+func NewMultiEpochHooks(hooks ...EpochHooks) MultiEpochHooks {
+	return hooks
+}
+
+func (h MultiEpochHooks) AfterEpochEnd(ctx sdk.Context, identifier string, epochNumber int64) {
+	for i := range h {
+		h[i].AfterEpochEnd(ctx, identifier, epochNumber)
+	}
+}
+
+func (h MultiEpochHooks) BeforeEpochStart(ctx sdk.Context, identifier string, epochNumber int64) {
+	for i := range h {
+		h[i].BeforeEpochStart(ctx, identifier, epochNumber)
+	}
+}
@@ -0,0 +1,23 @@
+package types
+
+const (
+	// ModuleName is the name of the epochs module
+	ModuleName = "epochs"
+
+	// StoreKey is the default store key for the epochs module
+	StoreKey = ModuleName
+
+	// QuerierRoute is the querier route for the epochs module
+	QuerierRoute = ModuleName
+)
+
+// keys for epochs store prefixes
+var (
+	EpochInfoKeyPrefix = []byte{0xd0}
+)
+
+// GetEpochInfoKey returns the store key to retrieve the epoch info by its
+// identifier.
+func GetEpochInfoKey(identifier string) []byte {
+	return append(EpochInfoKeyPrefix, identifier...)
+}
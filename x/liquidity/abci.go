@@ -14,6 +14,8 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
 
 	k.DeleteOutdatedRequests(ctx)
+	k.PruneRequestResults(ctx)
+	k.CancelStaleMMOrders(ctx)
 }
 
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
@@ -22,5 +24,12 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 	params := k.GetParams(ctx)
 	if ctx.BlockHeight()%int64(params.BatchSize) == 0 {
 		k.ExecuteRequests(ctx)
+		k.ProcessSwapRoutes(ctx)
+	}
+	if params.PoolFeeSweepEpoch > 0 && ctx.BlockHeight()%int64(params.PoolFeeSweepEpoch) == 0 {
+		k.SweepPoolFees(ctx)
+	}
+	if params.DustSweepEpoch > 0 && ctx.BlockHeight()%int64(params.DustSweepEpoch) == 0 {
+		k.SweepDust(ctx)
 	}
 }
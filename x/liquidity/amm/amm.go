@@ -1,9 +1,14 @@
 package amm
 
 import (
+	"errors"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// ErrOverflow is returned when an arithmetic operation overflows.
+var ErrOverflow = errors.New("arithmetic overflow")
+
 // The minimum and maximum coin amount used in the amm package.
 var (
 	MinCoinAmount = sdk.NewInt(100)
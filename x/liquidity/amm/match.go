@@ -71,6 +71,11 @@ func FulfillOrders(orders []Order, price sdk.Dec) (quoteCoinDiff sdk.Int) {
 	return
 }
 
+// FindMatchPrice returns the price at which the orders in the order view
+// are matched at a single price(batch auction).
+// The match price is searched between the lowest sell price and the highest
+// buy price first, rather than the whole tick range, and tick indexes outside
+// the tick range are never converted to prices.
 func FindMatchPrice(ov OrderView, tickPrec int) (matchPrice sdk.Dec, found bool) {
 	highestBuyPrice, found := ov.HighestBuyPrice()
 	if !found {
@@ -87,17 +92,51 @@ func FindMatchPrice(ov OrderView, tickPrec int) (matchPrice sdk.Dec, found bool)
 	prec := TickPrecision(tickPrec)
 	lowestTickIdx := prec.TickToIndex(prec.LowestTick())
 	highestTickIdx := prec.TickToIndex(prec.HighestTick())
+	// tickIndex returns the index of the tick nearest to the price,
+	// clamped into the tick range.
+	tickIndex := func(price sdk.Dec, up bool) int {
+		switch {
+		case !price.GT(prec.LowestTick()):
+			return lowestTickIdx
+		case !price.LT(prec.HighestTick()):
+			return highestTickIdx
+		case up:
+			return prec.TickToIndex(prec.PriceToUpTick(price))
+		default:
+			return prec.TickToIndex(prec.PriceToDownTick(price))
+		}
+	}
+	// There are no orders at ticks outside the tick range.
+	sellAmountUnder := func(i int) sdk.Int {
+		if i < lowestTickIdx {
+			return zeroInt
+		}
+		return ov.SellAmountUnder(prec.TickFromIndex(i), true)
+	}
+	buyAmountOver := func(i int) sdk.Int {
+		if i > highestTickIdx {
+			return zeroInt
+		}
+		return ov.BuyAmountOver(prec.TickFromIndex(i), true)
+	}
+	// The prices of pools are not on ticks, so the lowest sell price can be
+	// higher than the highest buy price after rounding them to ticks.
+	lowIdx, highIdx := tickIndex(lowestSellPrice, true), tickIndex(highestBuyPrice, false)
+	if lowIdx > highIdx {
+		lowIdx, highIdx = highIdx, lowIdx
+	}
+
 	var i, j int
-	i, found = findFirstTrueCondition(lowestTickIdx, highestTickIdx, func(i int) bool {
-		sellAmt := ov.SellAmountUnder(prec.TickFromIndex(i), true)
-		return sellAmt.IsPositive() && ov.BuyAmountOver(prec.TickFromIndex(i+1), true).LTE(sellAmt)
+	i, found = findFirstTrueConditionWithHint(lowestTickIdx, highestTickIdx, lowIdx, highIdx, func(i int) bool {
+		sellAmt := sellAmountUnder(i)
+		return sellAmt.IsPositive() && buyAmountOver(i+1).LTE(sellAmt)
 	})
 	if !found {
 		return sdk.Dec{}, false
 	}
-	j, found = findFirstTrueCondition(highestTickIdx, lowestTickIdx, func(i int) bool {
-		buyAmt := ov.BuyAmountOver(prec.TickFromIndex(i), true)
-		return buyAmt.IsPositive() && buyAmt.GTE(ov.SellAmountUnder(prec.TickFromIndex(i-1), true))
+	j, found = findFirstTrueConditionWithHint(highestTickIdx, lowestTickIdx, highIdx, lowIdx, func(i int) bool {
+		buyAmt := buyAmountOver(i)
+		return buyAmt.IsPositive() && buyAmt.GTE(sellAmountUnder(i-1))
 	})
 	if !found {
 		return sdk.Dec{}, false
@@ -109,8 +148,18 @@ func FindMatchPrice(ov OrderView, tickPrec int) (matchPrice sdk.Dec, found bool)
 // FindMatchableAmountAtSinglePrice returns the largest matchable amount of orders
 // when matching orders at single price(batch auction).
 func (ob *OrderBook) FindMatchableAmountAtSinglePrice(matchPrice sdk.Dec) (matchableAmt sdk.Int, found bool) {
+	matchableAmt, _, _, found = ob.findMatchableAmountAtSinglePrice(matchPrice)
+	return
+}
+
+// findMatchableAmountAtSinglePrice is like FindMatchableAmountAtSinglePrice,
+// but it also returns the matchable amounts of the buy and sell ticks
+// with prices matchable at the price, so that the amounts don't have to be
+// computed again.
+func (ob *OrderBook) findMatchableAmountAtSinglePrice(matchPrice sdk.Dec) (matchableAmt sdk.Int, buyTickAmts, sellTickAmts []sdk.Int, found bool) {
 	type Side struct {
 		ticks             []*orderBookTick
+		tickAmts          []sdk.Int
 		totalMatchableAmt sdk.Int
 		i                 int
 		partialMatchAmt   sdk.Int
@@ -122,19 +171,21 @@ func (ob *OrderBook) FindMatchableAmountAtSinglePrice(matchPrice sdk.Dec) (match
 				(!priceIncreasing && tick.price.LT(matchPrice)) {
 				break
 			}
+			tickAmt := TotalMatchableAmount(tick.orders, matchPrice)
 			side.ticks = ticks[:i+1]
-			side.totalMatchableAmt = side.totalMatchableAmt.Add(TotalMatchableAmount(tick.orders, matchPrice))
+			side.tickAmts = append(side.tickAmts, tickAmt)
+			side.totalMatchableAmt = side.totalMatchableAmt.Add(tickAmt)
 		}
 		side.i = len(side.ticks) - 1
 		return
 	}
 	buySide := buildSide(ob.buys.ticks, ob.buys.priceIncreasing)
 	if len(buySide.ticks) == 0 {
-		return sdk.Int{}, false
+		return sdk.Int{}, nil, nil, false
 	}
 	sellSide := buildSide(ob.sells.ticks, ob.sells.priceIncreasing)
 	if len(sellSide.ticks) == 0 {
-		return sdk.Int{}, false
+		return sdk.Int{}, nil, nil, false
 	}
 	sides := map[OrderDirection]*Side{
 		Buy:  buySide,
@@ -147,8 +198,7 @@ func (ob *OrderBook) FindMatchableAmountAtSinglePrice(matchPrice sdk.Dec) (match
 		for _, dir := range []OrderDirection{Buy, Sell} {
 			side := sides[dir]
 			i := side.i
-			tick := side.ticks[i]
-			tickAmt := TotalMatchableAmount(tick.orders, matchPrice)
+			tickAmt := side.tickAmts[i]
 			// side.partialMatchAmt can be negative at this moment, but
 			// FindMatchableAmountAtSinglePrice won't return a negative amount because
 			// the if-block below would set ok = false if otherTicksAmt >= matchAmt
@@ -159,7 +209,7 @@ func (ob *OrderBook) FindMatchableAmountAtSinglePrice(matchPrice sdk.Dec) (match
 			if otherTicksAmt.GTE(matchableAmt) ||
 				(dir == Sell && matchPrice.MulInt(side.partialMatchAmt).TruncateInt().IsZero()) {
 				if i == 0 { // There's no orders left, which means orders are not matchable.
-					return sdk.Int{}, false
+					return sdk.Int{}, nil, nil, false
 				}
 				side.totalMatchableAmt = side.totalMatchableAmt.Sub(tickAmt)
 				side.i--
@@ -167,7 +217,7 @@ func (ob *OrderBook) FindMatchableAmountAtSinglePrice(matchPrice sdk.Dec) (match
 			}
 		}
 		if ok {
-			return matchableAmt, true
+			return matchableAmt, buySide.tickAmts, sellSide.tickAmts, true
 		}
 	}
 }
@@ -176,15 +226,17 @@ func (ob *OrderBook) FindMatchableAmountAtSinglePrice(matchPrice sdk.Dec) (match
 // than the price and sell orders with lower(or equal) price than the price)
 // at the price.
 func (ob *OrderBook) MatchAtSinglePrice(matchPrice sdk.Dec) (quoteCoinDiff sdk.Int, matched bool) {
-	matchableAmt, found := ob.FindMatchableAmountAtSinglePrice(matchPrice)
+	matchableAmt, buyTickAmts, sellTickAmts, found := ob.findMatchableAmountAtSinglePrice(matchPrice)
 	if !found {
 		return sdk.Int{}, false
 	}
 	quoteCoinDiff = sdk.ZeroInt()
-	distributeToTicks := func(ticks []*orderBookTick) {
+	// The matchable amounts of the ticks are not affected by the distribution
+	// to the ticks before them, so the cached amounts can be used.
+	distributeToTicks := func(ticks []*orderBookTick, tickAmts []sdk.Int) {
 		remainingAmt := matchableAmt
-		for _, tick := range ticks {
-			tickAmt := TotalMatchableAmount(tick.orders, matchPrice)
+		for i, tickAmt := range tickAmts {
+			tick := ticks[i]
 			if tickAmt.LTE(remainingAmt) {
 				quoteCoinDiff = quoteCoinDiff.Add(FulfillOrders(tick.orders, matchPrice))
 				remainingAmt = remainingAmt.Sub(tickAmt)
@@ -192,13 +244,13 @@ func (ob *OrderBook) MatchAtSinglePrice(matchPrice sdk.Dec) (quoteCoinDiff sdk.I
 					break
 				}
 			} else {
-				quoteCoinDiff = quoteCoinDiff.Add(DistributeOrderAmountToTick(tick, remainingAmt, matchPrice))
+				quoteCoinDiff = quoteCoinDiff.Add(DistributeOrderAmountToTick(tick, remainingAmt, matchPrice, ob.makerPriority))
 				break
 			}
 		}
 	}
-	distributeToTicks(ob.buys.ticks)
-	distributeToTicks(ob.sells.ticks)
+	distributeToTicks(ob.buys.ticks, buyTickAmts)
+	distributeToTicks(ob.sells.ticks, sellTickAmts)
 	matched = true
 	return
 }
@@ -281,16 +333,16 @@ func (ob *OrderBook) Match(lastPrice sdk.Dec) (matchPrice sdk.Dec, quoteCoinDiff
 			continue
 		}
 		if buyTickOpenAmt.LTE(sellTickOpenAmt) {
-			quoteCoinDiff = quoteCoinDiff.Add(DistributeOrderAmountToTick(buyTick, buyTickOpenAmt, p))
+			quoteCoinDiff = quoteCoinDiff.Add(DistributeOrderAmountToTick(buyTick, buyTickOpenAmt, p, ob.makerPriority))
 			bi++
 		} else {
-			quoteCoinDiff = quoteCoinDiff.Add(DistributeOrderAmountToTick(buyTick, sellTickOpenAmt, p))
+			quoteCoinDiff = quoteCoinDiff.Add(DistributeOrderAmountToTick(buyTick, sellTickOpenAmt, p, ob.makerPriority))
 		}
 		if sellTickOpenAmt.LTE(buyTickOpenAmt) {
-			quoteCoinDiff = quoteCoinDiff.Add(DistributeOrderAmountToTick(sellTick, sellTickOpenAmt, p))
+			quoteCoinDiff = quoteCoinDiff.Add(DistributeOrderAmountToTick(sellTick, sellTickOpenAmt, p, ob.makerPriority))
 			si++
 		} else {
-			quoteCoinDiff = quoteCoinDiff.Add(DistributeOrderAmountToTick(sellTick, buyTickOpenAmt, p))
+			quoteCoinDiff = quoteCoinDiff.Add(DistributeOrderAmountToTick(sellTick, buyTickOpenAmt, p, ob.makerPriority))
 		}
 		matchPrice = p
 		matched = true
@@ -298,14 +350,91 @@ func (ob *OrderBook) Match(lastPrice sdk.Dec) (matchPrice sdk.Dec, quoteCoinDiff
 	return
 }
 
+// PriceLimits returns the lowest and the highest price limits with given last price
+// and upward and downward price limit ratios.
+// The price limits are capped by the lowest and the highest price ticks.
+func PriceLimits(lastPrice, upwardRatio, downwardRatio sdk.Dec, tickPrec int) (lowestPrice, highestPrice sdk.Dec) {
+	lowestPrice = PriceToUpTick(sdk.MaxDec(lastPrice.Mul(sdk.OneDec().Sub(downwardRatio)), LowestTick(tickPrec)), tickPrec)
+	highestPrice = PriceToDownTick(MulDecCapped(lastPrice, sdk.OneDec().Add(upwardRatio), HighestTick(tickPrec)), tickPrec)
+	return
+}
+
+// MatchBatch matches the orders in the order book with the orders of the pools
+// and the order sources, as a batch of a pair is matched.
+// If lastPrice is nil, the orders are matched at a single price found from
+// all orders, otherwise the pools and order sources make orders within the
+// price limits around the last price and the orders are matched sequentially.
+func MatchBatch(
+	ob *OrderBook, pools []PoolOrderer, sources []OrderSource, lastPrice *sdk.Dec,
+	upwardRatio, downwardRatio sdk.Dec, tickPrec int) (matchPrice sdk.Dec, quoteCoinDiff sdk.Int, matched bool) {
+	if lastPrice == nil {
+		ov := MultipleOrderViews{ob.MakeView()}
+		for _, pool := range pools {
+			ov = append(ov, pool)
+		}
+		for _, source := range sources {
+			ov = append(ov, source)
+		}
+		var found bool
+		matchPrice, found = FindMatchPrice(ov, tickPrec)
+		if !found {
+			return sdk.Dec{}, sdk.Int{}, false
+		}
+		for _, pool := range pools {
+			buyAmt := pool.BuyAmountOver(matchPrice, true)
+			if buyAmt.IsPositive() {
+				ob.AddOrder(pool.Order(Buy, matchPrice, buyAmt))
+			}
+			sellAmt := pool.SellAmountUnder(matchPrice, true)
+			if sellAmt.IsPositive() {
+				ob.AddOrder(pool.Order(Sell, matchPrice, sellAmt))
+			}
+		}
+		for _, source := range sources {
+			ob.AddOrder(source.BuyOrdersOver(matchPrice)...)
+			ob.AddOrder(source.SellOrdersUnder(matchPrice)...)
+		}
+		quoteCoinDiff, matched = ob.MatchAtSinglePrice(matchPrice)
+	} else {
+		lowestPrice, highestPrice := PriceLimits(*lastPrice, upwardRatio, downwardRatio, tickPrec)
+		for _, pool := range pools {
+			ob.AddOrder(PoolOrders(pool, pool, lowestPrice, highestPrice, tickPrec)...)
+		}
+		for _, source := range sources {
+			// Source orders are bounded by the price limits as user orders are.
+			for _, order := range source.BuyOrdersOver(lowestPrice) {
+				if order.GetPrice().LTE(highestPrice) {
+					ob.AddOrder(order)
+				}
+			}
+			for _, order := range source.SellOrdersUnder(highestPrice) {
+				if order.GetPrice().GTE(lowestPrice) {
+					ob.AddOrder(order)
+				}
+			}
+		}
+		matchPrice, quoteCoinDiff, matched = ob.Match(*lastPrice)
+	}
+	return
+}
+
 // DistributeOrderAmountToTick distributes the given order amount to the orders
 // at the tick.
-// Orders with higher priority(have lower batch id) get matched first,
-// then the remaining amount is distributed to the remaining orders.
-func DistributeOrderAmountToTick(tick *orderBookTick, amt sdk.Int, price sdk.Dec) (quoteCoinDiff sdk.Int) {
+// With maker priority, orders with higher priority(have lower batch id) get
+// matched first, then the remaining amount is distributed to the remaining
+// orders.
+// Without maker priority, user orders are treated as a single group regardless
+// of their batch ids.
+// In both cases, orders without batch id, such as pool orders, get matched last.
+func DistributeOrderAmountToTick(tick *orderBookTick, amt sdk.Int, price sdk.Dec, makerPriority bool) (quoteCoinDiff sdk.Int) {
 	remainingAmt := amt
 	quoteCoinDiff = sdk.ZeroInt()
-	groups := GroupOrdersByBatchId(tick.orders)
+	var groups []*OrderGroup
+	if makerPriority {
+		groups = GroupOrdersByBatchId(tick.orders)
+	} else {
+		groups = GroupOrdersByBatchIdPresence(tick.orders)
+	}
 	for _, group := range groups {
 		openAmt := TotalMatchableAmount(group.Orders, price)
 		if openAmt.IsZero() {
@@ -334,12 +463,17 @@ func DistributeOrderAmountToTick(tick *orderBookTick, amt sdk.Int, price sdk.Dec
 // to the orders again, by priority.
 // This time, the proportion is not considered and each order takes up
 // the amount as much as possible.
+// Orders are filled in the given order, so that the matching result is
+// deterministic.
+// Fills below an order's min fill amount are skipped if possible, leaving the
+// order's amount open.
 func DistributeOrderAmountToOrders(orders []Order, amt sdk.Int, price sdk.Dec) (quoteCoinDiff sdk.Int) {
 	totalAmt := TotalAmount(orders)
 	totalMatchedAmt := sdk.ZeroInt()
-	matchedAmtByOrder := map[Order]sdk.Int{}
+	matchedAmts := make([]sdk.Int, len(orders)) // matchedAmts[i] is the matched amount of orders[i]
 
-	for _, order := range orders {
+	for i, order := range orders {
+		matchedAmts[i] = sdk.ZeroInt()
 		matchableAmt := MatchableAmount(order, price)
 		if matchableAmt.IsZero() {
 			continue
@@ -348,32 +482,38 @@ func DistributeOrderAmountToOrders(orders []Order, amt sdk.Int, price sdk.Dec) (
 		proportion := orderAmt.QuoTruncate(totalAmt.ToDec())
 		matchedAmt := sdk.MinInt(matchableAmt, proportion.MulInt(amt).TruncateInt())
 		if matchedAmt.IsPositive() {
-			matchedAmtByOrder[order] = matchedAmt
+			matchedAmts[i] = matchedAmt
 			totalMatchedAmt = totalMatchedAmt.Add(matchedAmt)
 		}
 	}
 
 	remainingAmt := amt.Sub(totalMatchedAmt)
-	for _, order := range orders {
+	for i, order := range orders {
 		if remainingAmt.IsZero() {
 			break
 		}
-		prevMatchedAmt, ok := matchedAmtByOrder[order]
-		if !ok { // TODO: is it possible?
-			prevMatchedAmt = sdk.ZeroInt()
-		}
 		matchableAmt := MatchableAmount(order, price)
-		matchedAmt := sdk.MinInt(remainingAmt, matchableAmt.Sub(prevMatchedAmt))
-		matchedAmtByOrder[order] = prevMatchedAmt.Add(matchedAmt)
+		matchedAmt := sdk.MinInt(remainingAmt, matchableAmt.Sub(matchedAmts[i]))
+		matchedAmts[i] = matchedAmts[i].Add(matchedAmt)
 		remainingAmt = remainingAmt.Sub(matchedAmt)
 	}
 
-	var matchedOrders, notMatchedOrders []Order
-	for _, order := range orders {
-		matchedAmt, ok := matchedAmtByOrder[order]
-		if !ok {
-			matchedAmt = sdk.ZeroInt()
+	// Orders which would get filled with an amount below their min fill
+	// amount are excluded, and the amount is distributed to the other orders
+	// again, as long as the other orders can take up the whole amount.
+	var fillableOrders []Order
+	for i, order := range orders {
+		if !IsBelowMinFillAmount(order, matchedAmts[i], price) {
+			fillableOrders = append(fillableOrders, order)
 		}
+	}
+	if len(fillableOrders) < len(orders) && TotalMatchableAmount(fillableOrders, price).GTE(amt) {
+		return DistributeOrderAmountToOrders(fillableOrders, amt, price)
+	}
+
+	var matchedOrders, notMatchedOrders []Order
+	for i, order := range orders {
+		matchedAmt := matchedAmts[i]
 		if !matchedAmt.IsZero() && (order.GetDirection() == Buy || price.MulInt(matchedAmt).TruncateInt().IsPositive()) {
 			matchedOrders = append(matchedOrders, order)
 		} else {
@@ -390,8 +530,8 @@ func DistributeOrderAmountToOrders(orders []Order, amt sdk.Int, price sdk.Dec) (
 	}
 
 	quoteCoinDiff = sdk.ZeroInt()
-	for order, matchedAmt := range matchedAmtByOrder {
-		quoteCoinDiff = quoteCoinDiff.Add(FillOrder(order, matchedAmt, price))
+	for i, order := range orders {
+		quoteCoinDiff = quoteCoinDiff.Add(FillOrder(order, matchedAmts[i], price))
 	}
 	return
 }
@@ -1,29 +1,109 @@
 package amm_test
 
-//func BenchmarkFindMatchPrice(b *testing.B) {
-//	minPrice, maxPrice := utils.ParseDec("0.0000001"), utils.ParseDec("10000000")
-//	minAmt, maxAmt := sdk.NewInt(100), sdk.NewInt(10000000)
-//	minReserveAmt, maxReserveAmt := sdk.NewInt(500), sdk.NewInt(1000000000)
-//
-//	for seed := int64(0); seed < 5; seed++ {
-//		b.Run(fmt.Sprintf("seed/%d", seed), func(b *testing.B) {
-//			r := rand.New(rand.NewSource(seed))
-//			ob := amm.NewOrderBook()
-//			for i := 0; i < 10000; i++ {
-//				ob.AddOrder(newOrder(amm.Buy, utils.RandomDec(r, minPrice, maxPrice), utils.RandomInt(r, minAmt, maxAmt)))
-//				ob.AddOrder(newOrder(amm.Sell, utils.RandomDec(r, minPrice, maxPrice), utils.RandomInt(r, minAmt, maxAmt)))
-//			}
-//			var poolOrderSources []amm.OrderSource
-//			for i := 0; i < 1000; i++ {
-//				rx, ry := utils.RandomInt(r, minReserveAmt, maxReserveAmt), utils.RandomInt(r, minReserveAmt, maxReserveAmt)
-//				pool := amm.NewBasicPool(rx, ry, sdk.Int{})
-//				poolOrderSources = append(poolOrderSources, amm.NewMockPoolOrderSource(pool, "denom1", "denom2"))
-//			}
-//			os := amm.MergeOrderSources(append(poolOrderSources, ob)...)
-//			b.ResetTimer()
-//			for i := 0; i < b.N; i++ {
-//				amm.FindMatchPrice(os, int(defTickPrec))
-//			}
-//		})
-//	}
-//}
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	utils "github.com/crescent-network/crescent/v4/types"
+	"github.com/crescent-network/crescent/v4/x/liquidity/amm"
+	"github.com/crescent-network/crescent/v4/x/liquidity/amm/testutil"
+)
+
+var benchNumOrders = []int{1000, 10000, 100000}
+
+// newBenchOrderBook returns an order book with orders crowded around
+// the price 1.0, so that most of them get matched.
+// The same order book is returned for the same number of orders.
+func newBenchOrderBook(numOrders int) *amm.OrderBook {
+	r := rand.New(rand.NewSource(0))
+	return testutil.RandomOrderBook(
+		r, numOrders, utils.ParseDec("1.0"), 100, sdk.NewInt(1_000000), sdk.NewInt(10_000000), int(defTickPrec))
+}
+
+func benchmarkFindMatchPrice(b *testing.B, numOrders int) {
+	ob := newBenchOrderBook(numOrders)
+	pool := amm.NewBasicPool(sdk.NewInt(1000_000000), sdk.NewInt(1000_000000), sdk.Int{})
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		amm.FindMatchPrice(amm.MultipleOrderViews{ob.MakeView(), pool}, int(defTickPrec))
+	}
+}
+
+func benchmarkMatchAtSinglePrice(b *testing.B, numOrders int) {
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		ob := newBenchOrderBook(numOrders)
+		b.StartTimer()
+		ob.MatchAtSinglePrice(utils.ParseDec("1.0"))
+	}
+}
+
+func benchmarkMatch(b *testing.B, numOrders int) {
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		ob := newBenchOrderBook(numOrders)
+		b.StartTimer()
+		ob.Match(utils.ParseDec("0.999"))
+	}
+}
+
+func benchmarkMatchBatch(b *testing.B, numOrders int) {
+	pools := []amm.PoolOrderer{
+		amm.NewSimPool(1, amm.NewBasicPool(sdk.NewInt(1000_000000), sdk.NewInt(1000_000000), sdk.Int{})),
+	}
+	lastPrice := utils.ParseDec("0.999")
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		ob := newBenchOrderBook(numOrders)
+		b.StartTimer()
+		amm.MatchBatch(ob, pools, nil, &lastPrice, utils.ParseDec("0.1"), utils.ParseDec("0.1"), int(defTickPrec))
+	}
+}
+
+func BenchmarkFindMatchPrice(b *testing.B) {
+	for _, numOrders := range benchNumOrders {
+		b.Run(fmt.Sprintf("%d orders", numOrders), func(b *testing.B) {
+			benchmarkFindMatchPrice(b, numOrders)
+		})
+	}
+}
+
+func BenchmarkOrderBook_MatchAtSinglePrice(b *testing.B) {
+	/*
+		Before caching the matchable amounts of ticks:
+		BenchmarkOrderBook_MatchAtSinglePrice/1000_orders         	       3	   2848879 ns/op	 1114096 B/op	   34490 allocs/op
+		BenchmarkOrderBook_MatchAtSinglePrice/10000_orders        	       3	  18306036 ns/op	10996432 B/op	  333507 allocs/op
+		BenchmarkOrderBook_MatchAtSinglePrice/100000_orders       	       3	 305413962 ns/op	108925840 B/op	 3296656 allocs/op
+
+		After:
+		BenchmarkOrderBook_MatchAtSinglePrice/1000_orders         	       3	   1051836 ns/op	  890544 B/op	   27737 allocs/op
+		BenchmarkOrderBook_MatchAtSinglePrice/10000_orders        	       3	  13772891 ns/op	 8666672 B/op	  266187 allocs/op
+		BenchmarkOrderBook_MatchAtSinglePrice/100000_orders       	       3	 271512996 ns/op	86025765 B/op	 2637700 allocs/op
+	*/
+	for _, numOrders := range benchNumOrders {
+		b.Run(fmt.Sprintf("%d orders", numOrders), func(b *testing.B) {
+			benchmarkMatchAtSinglePrice(b, numOrders)
+		})
+	}
+}
+
+// BenchmarkOrderBook_Match benchmarks the sequential matching of orders,
+// which happens when the pair has the last price.
+func BenchmarkOrderBook_Match(b *testing.B) {
+	for _, numOrders := range benchNumOrders {
+		b.Run(fmt.Sprintf("%d orders", numOrders), func(b *testing.B) {
+			benchmarkMatch(b, numOrders)
+		})
+	}
+}
+
+func BenchmarkMatchBatch(b *testing.B) {
+	for _, numOrders := range benchNumOrders {
+		b.Run(fmt.Sprintf("%d orders", numOrders), func(b *testing.B) {
+			benchmarkMatchBatch(b, numOrders)
+		})
+	}
+}
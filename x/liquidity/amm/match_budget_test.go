@@ -0,0 +1,41 @@
+//go:build norace
+// +build norace
+
+package amm_test
+
+import (
+	"testing"
+)
+
+// TestMatchingPerformanceBudget checks that the matching doesn't allocate
+// more than its budget.
+// The number of allocations is used instead of the elapsed time since it
+// is deterministic regardless of the machine running the test.
+// Update the budgets only when a change is known to need more allocations.
+func TestMatchingPerformanceBudget(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping performance budget test in short mode")
+	}
+	const numOrders = 1000
+	for _, tc := range []struct {
+		name        string
+		benchmark   func(b *testing.B, numOrders int)
+		allocBudget int64
+	}{
+		{"FindMatchPrice", benchmarkFindMatchPrice, 20000},
+		{"MatchAtSinglePrice", benchmarkMatchAtSinglePrice, 30000},
+		{"Match", benchmarkMatch, 62000},
+		{"MatchBatch", benchmarkMatchBatch, 80000},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			res := testing.Benchmark(func(b *testing.B) {
+				b.ReportAllocs()
+				tc.benchmark(b, numOrders)
+			})
+			if allocs := res.AllocsPerOp(); allocs > tc.allocBudget {
+				t.Errorf("%s allocated %d times per op with %d orders, which exceeds the budget %d",
+					tc.name, allocs, numOrders, tc.allocBudget)
+			}
+		})
+	}
+}
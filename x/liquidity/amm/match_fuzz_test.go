@@ -0,0 +1,71 @@
+package amm_test
+
+import (
+	"math/rand"
+	"testing"
+	"testing/quick"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	utils "github.com/crescent-network/crescent/v4/types"
+	"github.com/crescent-network/crescent/v4/x/liquidity/amm"
+	"github.com/crescent-network/crescent/v4/x/liquidity/amm/testutil"
+)
+
+func FuzzOrderBookMatch(f *testing.F) {
+	for seed := int64(0); seed < 20; seed++ {
+		f.Add(seed, uint8(seed))
+	}
+	f.Fuzz(func(t *testing.T, seed int64, numOrders uint8) {
+		r := rand.New(rand.NewSource(seed))
+		tickPrec := 4
+		ob := testutil.RandomExtremeOrderBook(r, int(numOrders%32)+2, tickPrec)
+		lastPrice := testutil.RandomExtremePrice(r, tickPrec)
+
+		_, err := testutil.MatchAndCheck(ob, lastPrice, tickPrec)
+		require.NoError(t, err)
+	})
+}
+
+func FuzzSafeQuoteAmount(f *testing.F) {
+	for seed := int64(0); seed < 20; seed++ {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		price := testutil.RandomExtremePrice(r, 4)
+		amt := testutil.RandomExtremeAmount(r).Mul(sdk.NewIntWithDecimal(1, r.Intn(37)))
+		require.NotPanics(t, func() {
+			quoteAmt, err := amm.SafeQuoteAmount(price, amt)
+			if err != nil {
+				require.ErrorIs(t, err, amm.ErrOverflow)
+				return
+			}
+			require.True(sdk.IntEq(t, price.MulInt(amt).TruncateInt(), quoteAmt))
+		})
+		require.NotPanics(t, func() {
+			_, _ = amm.SafeOfferCoinAmount(amm.Buy, price, amt)
+		})
+	})
+}
+
+func FuzzOrderBookMatchNearLastPrice(f *testing.F) {
+	for seed := int64(0); seed < 20; seed++ {
+		f.Add(seed, uint8(seed))
+	}
+	f.Fuzz(func(t *testing.T, seed int64, numOrders uint8) {
+		r := rand.New(rand.NewSource(seed))
+		tickPrec := 4
+		lastPrice := amm.PriceToDownTick(utils.RandomDec(r, utils.ParseDec("0.001"), utils.ParseDec("1000")), tickPrec)
+		ob := testutil.RandomOrderBook(
+			r, int(numOrders%64)+2, lastPrice, 50, sdk.NewInt(100), sdk.NewInt(1000_000000), tickPrec)
+		ob.SetMakerPriority(r.Intn(2) == 0)
+		_, err := testutil.MatchAndCheck(ob, lastPrice, tickPrec)
+		require.NoError(t, err)
+	})
+}
+
+func TestOrderBookMatchProperty(t *testing.T) {
+	require.NoError(t, quick.Check(testutil.MatchProperty, &quick.Config{MaxCount: 200}))
+}
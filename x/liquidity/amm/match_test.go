@@ -2,6 +2,8 @@ package amm_test
 
 import (
 	"fmt"
+	"math/rand"
+	"sort"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -9,6 +11,7 @@ import (
 
 	utils "github.com/crescent-network/crescent/v4/types"
 	"github.com/crescent-network/crescent/v4/x/liquidity/amm"
+	"github.com/crescent-network/crescent/v4/x/liquidity/amm/testutil"
 )
 
 func newOrder(dir amm.OrderDirection, price sdk.Dec, amt sdk.Int) amm.Order {
@@ -56,6 +59,33 @@ func TestFindMatchPrice(t *testing.T) {
 			false,
 			sdk.Dec{},
 		},
+		{
+			"orders at the lowest tick",
+			amm.NewOrderBook(
+				newOrder(amm.Buy, defTickPrec.LowestTick(), sdk.NewIntWithDecimal(1, 18)),
+				newOrder(amm.Sell, defTickPrec.LowestTick(), sdk.NewIntWithDecimal(1, 18)),
+			).MakeView(),
+			true,
+			defTickPrec.LowestTick(),
+		},
+		{
+			"orders at the highest tick",
+			amm.NewOrderBook(
+				newOrder(amm.Buy, defTickPrec.HighestTick(), sdk.NewInt(10000)),
+				newOrder(amm.Sell, defTickPrec.HighestTick(), sdk.NewInt(10000)),
+			).MakeView(),
+			true,
+			defTickPrec.HighestTick(),
+		},
+		{
+			"orders at both ends of the tick range",
+			amm.NewOrderBook(
+				newOrder(amm.Buy, defTickPrec.HighestTick(), sdk.NewInt(10000)),
+				newOrder(amm.Sell, defTickPrec.LowestTick(), sdk.NewIntWithDecimal(1, 18)),
+			).MakeView(),
+			true,
+			defTickPrec.LowestTick(),
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			matchPrice, found := amm.FindMatchPrice(tc.ov, int(defTickPrec))
@@ -67,6 +97,71 @@ func TestFindMatchPrice(t *testing.T) {
 	}
 }
 
+// findMatchPriceFullRange finds the match price by searching the whole
+// tick range, as FindMatchPrice did before narrowing the search range.
+func findMatchPriceFullRange(ov amm.OrderView, tickPrec int) (sdk.Dec, bool) {
+	highestBuyPrice, found := ov.HighestBuyPrice()
+	if !found {
+		return sdk.Dec{}, false
+	}
+	lowestSellPrice, found := ov.LowestSellPrice()
+	if !found || highestBuyPrice.LT(lowestSellPrice) {
+		return sdk.Dec{}, false
+	}
+	prec := amm.TickPrecision(tickPrec)
+	lowestTickIdx := prec.TickToIndex(prec.LowestTick())
+	highestTickIdx := prec.TickToIndex(prec.HighestTick())
+	n := highestTickIdx - lowestTickIdx + 1
+	i := lowestTickIdx + sort.Search(n, func(k int) bool {
+		i := lowestTickIdx + k
+		sellAmt := ov.SellAmountUnder(prec.TickFromIndex(i), true)
+		return sellAmt.IsPositive() && ov.BuyAmountOver(prec.TickFromIndex(i+1), true).LTE(sellAmt)
+	})
+	j := highestTickIdx - sort.Search(n, func(k int) bool {
+		j := highestTickIdx - k
+		buyAmt := ov.BuyAmountOver(prec.TickFromIndex(j), true)
+		return buyAmt.IsPositive() && buyAmt.GTE(ov.SellAmountUnder(prec.TickFromIndex(j-1), true))
+	})
+	if i > highestTickIdx || j < lowestTickIdx {
+		return sdk.Dec{}, false
+	}
+	return prec.RoundPrice(prec.TickFromIndex(i).Add(prec.TickFromIndex(j)).QuoInt64(2)), true
+}
+
+func TestFindMatchPrice_SameAsFullRange(t *testing.T) {
+	r := rand.New(rand.NewSource(0))
+	for i := 0; i < 200; i++ {
+		var ov amm.MultipleOrderViews
+		if i%2 == 0 {
+			centerPrice := utils.RandomDec(r, utils.ParseDec("0.0001"), utils.ParseDec("10000"))
+			ob := testutil.RandomOrderBook(
+				r, 20, centerPrice, 30, sdk.NewInt(100), sdk.NewInt(1000_000000), int(defTickPrec))
+			rx, ry := utils.RandomInt(r, sdk.NewInt(1_000000), sdk.NewInt(1000_000000)), utils.RandomInt(r, sdk.NewInt(1_000000), sdk.NewInt(1000_000000))
+			ov = amm.MultipleOrderViews{ob.MakeView(), amm.NewBasicPool(rx, ry, sdk.Int{})}
+		} else {
+			ov = amm.MultipleOrderViews{testutil.RandomExtremeOrderBook(r, 10, int(defTickPrec)).MakeView()}
+		}
+		var (
+			matchPrice, expectedMatchPrice sdk.Dec
+			found, expectedFound           bool
+		)
+		overflow := false
+		utils.SafeMath(func() {
+			expectedMatchPrice, expectedFound = findMatchPriceFullRange(ov, int(defTickPrec))
+			matchPrice, found = amm.FindMatchPrice(ov, int(defTickPrec))
+		}, func() {
+			overflow = true
+		})
+		if overflow {
+			continue
+		}
+		require.Equal(t, expectedFound, found)
+		if found {
+			require.True(sdk.DecEq(t, expectedMatchPrice, matchPrice))
+		}
+	}
+}
+
 func TestFindMatchPrice_Rounding(t *testing.T) {
 	basePrice := utils.ParseDec("0.9990")
 
@@ -217,3 +312,122 @@ func TestMatch_edgecase1(t *testing.T) {
 			order.GetPaidOfferCoinAmount(), order.GetReceivedDemandCoinAmount())
 	}
 }
+
+// fillRecordingOrder is an order which records the sequence of fills.
+type fillRecordingOrder struct {
+	*amm.BaseOrder
+	id    int
+	fills *[]int
+}
+
+func (order *fillRecordingOrder) SetOpenAmount(amt sdk.Int) {
+	*order.fills = append(*order.fills, order.id)
+	order.BaseOrder.SetOpenAmount(amt)
+}
+
+func TestDistributeOrderAmountToOrders_Deterministic(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		var fills []int
+		var orders []amm.Order
+		for j, amt := range []int64{1000, 1000, 1000} {
+			orders = append(orders, &fillRecordingOrder{
+				BaseOrder: amm.NewBaseOrder(amm.Buy, utils.ParseDec("1.0"), sdk.NewInt(amt), sdk.NewInt(amt)),
+				id:        j,
+				fills:     &fills,
+			})
+		}
+		quoteCoinDiff := amm.DistributeOrderAmountToOrders(orders, sdk.NewInt(1000), utils.ParseDec("1.0"))
+		require.True(sdk.IntEq(t, sdk.NewInt(1000), quoteCoinDiff))
+
+		// Orders are filled in the order of the slice, and the remaining
+		// amount from the truncation goes to the first order.
+		require.Equal(t, []int{0, 1, 2}, fills)
+		for j, expected := range []int64{334, 333, 333} {
+			require.True(sdk.IntEq(t, sdk.NewInt(expected), orders[j].GetAmount().Sub(orders[j].GetOpenAmount())))
+		}
+	}
+}
+
+// minFillOrder is an order with a min fill amount.
+type minFillOrder struct {
+	*amm.BaseOrder
+	minFillAmt sdk.Int
+}
+
+func (order *minFillOrder) GetMinFillAmount() sdk.Int {
+	return order.minFillAmt
+}
+
+func TestDistributeOrderAmountToOrders_MinFillAmount(t *testing.T) {
+	newOrder := func(amt, minFillAmt int64) amm.Order {
+		return &minFillOrder{
+			BaseOrder:  amm.NewBaseOrder(amm.Buy, utils.ParseDec("1.0"), sdk.NewInt(amt), sdk.NewInt(amt)),
+			minFillAmt: sdk.NewInt(minFillAmt),
+		}
+	}
+
+	for _, tc := range []struct {
+		name       string
+		orders     []amm.Order
+		amt        int64
+		matchedAmt []int64
+	}{
+		{
+			"dust fill skipped",
+			[]amm.Order{newOrder(1000, 500), newOrder(1000, 0), newOrder(1000, 0)},
+			1000,
+			[]int64{0, 500, 500},
+		},
+		{
+			"whole matchable amount is always allowed",
+			[]amm.Order{newOrder(1000, 0), newOrder(100, 500)},
+			1100,
+			[]int64{1000, 100},
+		},
+		{
+			"others cannot take up the amount",
+			[]amm.Order{newOrder(1000, 900), newOrder(100, 0)},
+			600,
+			[]int64{546, 54},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			quoteCoinDiff := amm.DistributeOrderAmountToOrders(tc.orders, sdk.NewInt(tc.amt), utils.ParseDec("1.0"))
+			require.True(sdk.IntEq(t, sdk.NewInt(tc.amt), quoteCoinDiff))
+			for i, expected := range tc.matchedAmt {
+				order := tc.orders[i]
+				require.True(sdk.IntEq(t, sdk.NewInt(expected), order.GetAmount().Sub(order.GetOpenAmount())))
+			}
+		})
+	}
+}
+
+func TestMatchAtSinglePrice_MakerPriority(t *testing.T) {
+	price := utils.ParseDec("1.0")
+	for _, tc := range []struct {
+		name          string
+		makerPriority bool
+		expectedMaker sdk.Int
+		expectedTaker sdk.Int
+	}{
+		{"maker priority", true, sdk.NewInt(1000), sdk.NewInt(500)},
+		{"pro-rata", false, sdk.NewInt(750), sdk.NewInt(750)},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			makerOrder := (&batchIdOrderer{1}).Order(amm.Buy, price, sdk.NewInt(1000))
+			takerOrder := (&batchIdOrderer{2}).Order(amm.Buy, price, sdk.NewInt(1000))
+			sourceOrder := newOrder(amm.Buy, price, sdk.NewInt(1000)) // has no batch id
+			ob := amm.NewOrderBook(
+				makerOrder, takerOrder, sourceOrder,
+				newOrder(amm.Sell, price, sdk.NewInt(1500)))
+			ob.SetMakerPriority(tc.makerPriority)
+
+			_, matched := ob.MatchAtSinglePrice(price)
+			require.True(t, matched)
+			require.True(sdk.IntEq(t, tc.expectedMaker, makerOrder.GetAmount().Sub(makerOrder.GetOpenAmount())))
+			require.True(sdk.IntEq(t, tc.expectedTaker, takerOrder.GetAmount().Sub(takerOrder.GetOpenAmount())))
+			// Orders without batch id get matched last in both modes.
+			require.True(sdk.IntEq(t, sourceOrder.GetAmount(), sourceOrder.GetOpenAmount()))
+		})
+	}
+}
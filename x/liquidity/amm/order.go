@@ -60,6 +60,16 @@ type Order interface {
 	SetReceivedDemandCoinAmount(amt sdk.Int)
 	GetOpenAmount() sdk.Int
 	SetOpenAmount(amt sdk.Int)
+	// GetMinFillAmount returns the minimum amount to be filled at once.
+	// Zero means that the order can be filled with any amount.
+	GetMinFillAmount() sdk.Int
+	// GetOrderer returns the address which pays the offer coin and receives
+	// the demand coin of the order.
+	// It is nil for hypothetical orders which are never settled.
+	GetOrderer() sdk.AccAddress
+	// GetDemandCoinDenom returns the denom of the coin received by the order.
+	// It is empty for hypothetical orders which are never settled.
+	GetDemandCoinDenom() string
 	IsMatched() bool
 	// HasPriority returns true if the order has higher priority
 	// than the other order.
@@ -73,6 +83,10 @@ type BaseOrder struct {
 	Price           sdk.Dec
 	Amount          sdk.Int
 	OfferCoinAmount sdk.Int
+	// Orderer and DemandCoinDenom are used to settle the matched order, and
+	// can be left empty for orders which are never settled.
+	Orderer         sdk.AccAddress
+	DemandCoinDenom string
 
 	// Match info
 	OpenAmount               sdk.Int
@@ -140,6 +154,20 @@ func (order *BaseOrder) SetOpenAmount(amt sdk.Int) {
 	order.OpenAmount = amt
 }
 
+func (order *BaseOrder) GetMinFillAmount() sdk.Int {
+	return sdk.ZeroInt()
+}
+
+// GetOrderer returns the orderer address.
+func (order *BaseOrder) GetOrderer() sdk.AccAddress {
+	return order.Orderer
+}
+
+// GetDemandCoinDenom returns the demand coin denom.
+func (order *BaseOrder) GetDemandCoinDenom() string {
+	return order.DemandCoinDenom
+}
+
 func (order *BaseOrder) IsMatched() bool {
 	return order.OpenAmount.LT(order.Amount)
 }
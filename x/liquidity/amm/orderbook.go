@@ -10,19 +10,31 @@ import (
 
 // OrderBook is an order book.
 type OrderBook struct {
-	buys, sells *orderBookTicks
+	buys, sells   *orderBookTicks
+	makerPriority bool
 }
 
 // NewOrderBook returns a new OrderBook.
+// Maker priority is enabled by default.
 func NewOrderBook(orders ...Order) *OrderBook {
 	ob := &OrderBook{
-		buys:  newOrderBookBuyTicks(),
-		sells: newOrderBookSellTicks(),
+		buys:          newOrderBookBuyTicks(),
+		sells:         newOrderBookSellTicks(),
+		makerPriority: true,
 	}
 	ob.AddOrder(orders...)
 	return ob
 }
 
+// SetMakerPriority sets whether orders from earlier batches get matched first
+// at the marginal tick.
+// If it is disabled, the amount at the marginal tick is distributed to all user
+// orders at the tick in proportion to their amounts, regardless of their batch
+// ids.
+func (ob *OrderBook) SetMakerPriority(enabled bool) {
+	ob.makerPriority = enabled
+}
+
 // AddOrder adds orders to the order book.
 func (ob *OrderBook) AddOrder(orders ...Order) {
 	for _, order := range orders {
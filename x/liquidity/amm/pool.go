@@ -32,6 +32,12 @@ type Pool interface {
 	Clone() Pool
 }
 
+// PoolOrderer is a pool which makes its own orders.
+type PoolOrderer interface {
+	Pool
+	Orderer
+}
+
 // BasicPool is the basic pool type.
 type BasicPool struct {
 	// rx and ry are the pool's reserve balance of each x/y coin.
@@ -508,6 +514,34 @@ func Deposit(rx, ry, ps, x, y sdk.Int) (ax, ay, pc sdk.Int) {
 	return
 }
 
+// DepositSingleAsset returns minted pool coin amount when someone deposits
+// amt of only one of the coins to a basic pool, where r is the pool's
+// reserve of the deposited coin.
+// It is equivalent to swapping a half of the deposit and then making
+// a proportional deposit, and the fee rate is taken from the half which is
+// virtually swapped.
+func DepositSingleAsset(r, ps, amt sdk.Int, feeRate sdk.Dec) (pc sdk.Int) {
+	if !r.IsPositive() || !ps.IsPositive() {
+		return zeroInt
+	}
+
+	utils.SafeMath(func() {
+		// amt' = floor(amt * (1 - feeRate / 2))
+		amt = amt.ToDec().MulTruncate(sdk.OneDec().Sub(feeRate.QuoInt64(2))).TruncateInt()
+		// pc = floor(sqrt(ps^2 * (r + amt') / r)) - ps
+		// Note that we take the integer square root of the floored value,
+		// so the minted amount is never overestimated.
+		x := new(big.Int).Mul(ps.BigInt(), ps.BigInt())
+		x.Mul(x, r.Add(amt).BigInt())
+		x.Quo(x, r.BigInt())
+		pc = sdk.NewIntFromBigInt(x.Sqrt(x)).Sub(ps)
+	}, func() {
+		pc = zeroInt
+	})
+
+	return
+}
+
 // Withdraw returns withdrawn x and y coin amount when someone withdraws
 // pc pool coin.
 // Withdraw also takes care of the fee rate.
@@ -608,14 +642,14 @@ func PoolBuyOrders(pool Pool, orderer Orderer, lowestPrice, highestPrice sdk.Dec
 		tmpPool.SetBalances(rx, ry, derive)
 	}
 	if poolPrice.GT(highestPrice) {
-		amt := tmpPool.BuyAmountTo(highestPrice)
+		amt := maxPoolOrderAmount(tmpPool, Buy, highestPrice, tmpPool.BuyAmountTo(highestPrice))
 		if amt.GTE(MinCoinAmount) {
 			placeOrder(highestPrice, amt, true)
 		}
 	}
 	tick := PriceToDownTick(sdk.MinDec(highestPrice, tmpPool.Price()), tickPrec)
 	for tick.GTE(lowestPrice) {
-		amt := tmpPool.BuyAmountOver(tick, true)
+		amt := maxPoolOrderAmount(tmpPool, Buy, tick, tmpPool.BuyAmountOver(tick, true))
 		if amt.LT(MinCoinAmount) {
 			tick = DownTick(tick, tickPrec) // TODO: check if the tick is the lowest possible tick
 			continue
@@ -649,14 +683,14 @@ func PoolSellOrders(pool Pool, orderer Orderer, lowestPrice, highestPrice sdk.De
 		tmpPool.SetBalances(rx, ry, derive)
 	}
 	if poolPrice.LT(lowestPrice) {
-		amt := tmpPool.SellAmountTo(lowestPrice)
+		amt := maxPoolOrderAmount(tmpPool, Sell, lowestPrice, tmpPool.SellAmountTo(lowestPrice))
 		if amt.GTE(MinCoinAmount) && lowestPrice.MulInt(amt).TruncateInt().IsPositive() {
 			placeOrder(lowestPrice, amt, true)
 		}
 	}
 	tick := PriceToUpTick(sdk.MaxDec(lowestPrice, tmpPool.Price()), tickPrec)
 	for tick.LTE(highestPrice) {
-		amt := tmpPool.SellAmountUnder(tick, true)
+		amt := maxPoolOrderAmount(tmpPool, Sell, tick, tmpPool.SellAmountUnder(tick, true))
 		if amt.LT(MinCoinAmount) || tick.MulInt(amt).TruncateInt().IsZero() {
 			tick = UpTick(tick, tickPrec)
 			continue
@@ -671,6 +705,120 @@ func PoolSellOrders(pool Pool, orderer Orderer, lowestPrice, highestPrice sdk.De
 	return orders
 }
 
+// poolProduct holds the reserves of a pool, translated for ranged pools, and
+// their product, all scaled by 10^18 which is the precision of translations
+// and prices, so that the products can be compared using exact integer math.
+type poolProduct struct {
+	x, y, k *big.Int
+}
+
+func newPoolProduct(pool Pool) poolProduct {
+	rx, ry := pool.Balances()
+	transX, transY := sdk.ZeroDec(), sdk.ZeroDec()
+	if pool, ok := pool.(*RangedPool); ok {
+		transX, transY = pool.Translation()
+	}
+	x := new(big.Int).Mul(rx.BigInt(), decPrecisionMultiplier)
+	x.Add(x, transX.BigInt())
+	y := new(big.Int).Mul(ry.BigInt(), decPrecisionMultiplier)
+	y.Add(y, transY.BigInt())
+	return poolProduct{x: x, y: y, k: new(big.Int).Mul(x, y)}
+}
+
+// preservedBy returns whether the product doesn't decrease after the pool's
+// order of amt at price is fully matched, where the quote coin amount is
+// rounded the same way as in PoolBuyOrders and PoolSellOrders.
+func (pp poolProduct) preservedBy(dir OrderDirection, price sdk.Dec, amt sdk.Int) bool {
+	s := decPrecisionMultiplier
+	a := amt.BigInt()
+	q := new(big.Int).Mul(price.BigInt(), a)
+	x := new(big.Int)
+	y := a.Mul(a, s)
+	switch dir {
+	case Buy:
+		q.Add(q, s).Sub(q, big.NewInt(1)).Quo(q, s).Mul(q, s) // quote coin ceiling
+		x.Sub(pp.x, q)
+		y.Add(pp.y, y)
+	case Sell:
+		q.Quo(q, s).Mul(q, s) // quote coin truncation
+		x.Add(pp.x, q)
+		y.Sub(pp.y, y)
+	}
+	if x.Sign() < 0 || y.Sign() < 0 {
+		return false
+	}
+	return x.Mul(x, y).Cmp(pp.k) >= 0
+}
+
+// lowerBound returns an amount with which the product is preserved for sure.
+//
+// With X and Y being the reserves and p the price, all scaled by S = 10^18,
+// the quote coin amount is rounded by less than one unit, so the product is
+// preserved for an amount a if
+//
+//	buy:  (X - p*a - S) * (Y + S*a) >= X*Y
+//	sell: (X + p*a - S) * (Y - S*a) >= X*Y
+//
+// Both reduce to A*a^2 - B*a + C <= 0, where A = p*S and C = S*Y,
+// and the larger root of the quadratic is returned.
+func (pp poolProduct) lowerBound(dir OrderDirection, price sdk.Dec) sdk.Int {
+	s := decPrecisionMultiplier
+	p := price.BigInt()
+	b := new(big.Int).Mul(p, pp.y)
+	xs := new(big.Int).Mul(pp.x, s)
+	ss := new(big.Int).Mul(s, s)
+	switch dir {
+	case Buy:
+		b.Sub(xs, b).Sub(b, ss) // B = X*S - p*Y - S^2
+	case Sell:
+		b.Sub(b, xs).Add(b, ss) // B = p*Y - X*S + S^2
+	}
+	if b.Sign() <= 0 {
+		return zeroInt
+	}
+	a2 := new(big.Int).Mul(p, s)
+	a2.Lsh(a2, 1) // 2A
+	c2 := new(big.Int).Mul(s, pp.y)
+	c2.Lsh(c2, 1) // 2C
+	disc := new(big.Int).Mul(b, b)
+	disc.Sub(disc, c2.Mul(a2, c2)) // B^2 - 4AC
+	if disc.Sign() < 0 {
+		return zeroInt
+	}
+	root := disc.Sqrt(disc)
+	root.Add(root, b).Quo(root, a2)
+	return sdk.NewIntFromBigInt(root)
+}
+
+// maxPoolOrderAmount returns the largest amount not greater than amt with
+// which the product of the pool's reserves, translated for ranged pools,
+// doesn't decrease after the pool's order at price is fully matched.
+// The amounts calculated by Dec math don't take the rounding of the quote
+// coin amount into account and may exceed it, in which case the amount is
+// searched for between the amount and the product's lower bound.
+func maxPoolOrderAmount(pool Pool, dir OrderDirection, price sdk.Dec, amt sdk.Int) sdk.Int {
+	if !amt.IsPositive() {
+		return amt
+	}
+	pp := newPoolProduct(pool)
+	if pp.preservedBy(dir, price, amt) {
+		return amt
+	}
+	lo, hi := pp.lowerBound(dir, price), amt
+	if lo.GTE(hi) { // never happens unless the lower bound is wrong
+		lo = zeroInt
+	}
+	for hi.Sub(lo).GT(sdk.OneInt()) {
+		mid := lo.Add(hi).QuoRaw(2)
+		if pp.preservedBy(dir, price, mid) {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return lo
+}
+
 // InitialPoolCoinSupply returns ideal initial pool coin minting amount.
 func InitialPoolCoinSupply(x, y sdk.Int) sdk.Int {
 	cx := len(x.BigInt().Text(10)) - 1 // characteristic of x
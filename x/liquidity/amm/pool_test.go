@@ -1,6 +1,7 @@
 package amm_test
 
 import (
+	"math/big"
 	"math/rand"
 	"testing"
 
@@ -286,6 +287,63 @@ func TestBasicPool_Deposit(t *testing.T) {
 	}
 }
 
+func TestDepositSingleAsset(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		r       int64
+		ps      int64
+		amt     int64
+		feeRate sdk.Dec
+		pc      int64
+	}{
+		{
+			name:    "no fee",
+			r:       10000,
+			ps:      10000,
+			amt:     10000,
+			feeRate: sdk.ZeroDec(),
+			pc:      4142,
+		},
+		{
+			name:    "with fee",
+			r:       10000,
+			ps:      10000,
+			amt:     10000,
+			feeRate: sdk.NewDecWithPrec(3, 3),
+			pc:      4136,
+		},
+		{
+			name:    "tiny minting amount",
+			r:       10000,
+			ps:      10000,
+			amt:     3,
+			feeRate: sdk.ZeroDec(),
+			pc:      1,
+		},
+		{
+			name:    "zero minting amount",
+			r:       10000,
+			ps:      10000,
+			amt:     1,
+			feeRate: sdk.ZeroDec(),
+			pc:      0,
+		},
+		{
+			name:    "zero reserve",
+			r:       0,
+			ps:      10000,
+			amt:     10000,
+			feeRate: sdk.ZeroDec(),
+			pc:      0,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			pc := amm.DepositSingleAsset(sdk.NewInt(tc.r), sdk.NewInt(tc.ps), sdk.NewInt(tc.amt), tc.feeRate)
+			require.True(sdk.IntEq(t, sdk.NewInt(tc.pc), pc))
+		})
+	}
+}
+
 func TestBasicPool_Withdraw(t *testing.T) {
 	for _, tc := range []struct {
 		name    string
@@ -1009,3 +1067,57 @@ func BenchmarkBasicPoolOrders(b *testing.B) {
 		amm.PoolOrders(pool, amm.DefaultOrderer, lowestPrice, highestPrice, 4)
 	}
 }
+
+// poolProduct returns the exact product of the pool's reserves with the
+// translation of the pool, scaled by 10^36.
+func poolProduct(pool amm.Pool, rx, ry sdk.Int) *big.Int {
+	transX, transY := sdk.ZeroDec(), sdk.ZeroDec()
+	if pool, ok := pool.(*amm.RangedPool); ok {
+		transX, transY = pool.Translation()
+	}
+	x := rx.ToDec().Add(transX).BigInt()
+	y := ry.ToDec().Add(transY).BigInt()
+	return new(big.Int).Mul(x, y)
+}
+
+func TestPoolOrdersPreserveProduct(t *testing.T) {
+	r := rand.New(rand.NewSource(0))
+	for i := 0; i < 500; i++ {
+		var pool amm.Pool
+		rx := utils.RandomInt(r, sdk.NewInt(1_000000), sdk.NewInt(1_000000_000000_000000))
+		ry := utils.RandomInt(r, sdk.NewInt(1_000000), sdk.NewInt(1_000000_000000_000000))
+		if r.Intn(2) == 0 {
+			pool = amm.NewBasicPool(rx, ry, sdk.Int{})
+		} else {
+			minPrice := utils.RandomDec(r, utils.ParseDec("0.001"), utils.ParseDec("1"))
+			maxPrice := utils.RandomDec(r, minPrice.Mul(utils.ParseDec("1.01")), utils.ParseDec("1000"))
+			pool = amm.NewRangedPool(rx, ry, sdk.Int{}, minPrice, maxPrice)
+		}
+		poolPrice := pool.Price()
+		lowestPrice := poolPrice.Mul(utils.ParseDec("0.9"))
+		highestPrice := poolPrice.Mul(utils.ParseDec("1.1"))
+
+		// Match the pool's orders one by one at their prices, from the
+		// orders closest to the pool price.
+		k := poolProduct(pool, rx, ry)
+		prx, pry := rx, ry
+		for _, order := range amm.PoolBuyOrders(pool, amm.DefaultOrderer, lowestPrice, highestPrice, int(defTickPrec)) {
+			prx = prx.Sub(order.GetPrice().MulInt(order.GetAmount()).Ceil().TruncateInt())
+			pry = pry.Add(order.GetAmount())
+			require.False(t, prx.IsNegative())
+			k2 := poolProduct(pool, prx, pry)
+			require.True(t, k2.Cmp(k) >= 0, "product decreased: %s -> %s", k, k2)
+			k = k2
+		}
+		k = poolProduct(pool, rx, ry)
+		prx, pry = rx, ry
+		for _, order := range amm.PoolSellOrders(pool, amm.DefaultOrderer, lowestPrice, highestPrice, int(defTickPrec)) {
+			prx = prx.Add(order.GetPrice().MulInt(order.GetAmount()).TruncateInt())
+			pry = pry.Sub(order.GetAmount())
+			require.False(t, pry.IsNegative())
+			k2 := poolProduct(pool, prx, pry)
+			require.True(t, k2.Cmp(k) >= 0, "product decreased: %s -> %s", k, k2)
+			k = k2
+		}
+	}
+}
@@ -0,0 +1,218 @@
+package amm
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ Order       = (*SimOrder)(nil)
+	_ Order       = (*SimPoolOrder)(nil)
+	_ PoolOrderer = (*SimPool)(nil)
+)
+
+// SimOrder is a user order built from plain data, which mirrors an order
+// stored on the chain.
+type SimOrder struct {
+	*BaseOrder
+	Id            uint64
+	BatchId       uint64
+	MinFillAmount sdk.Int
+}
+
+// NewSimOrder returns a new SimOrder.
+// The offer coin amount is derived from the direction, price and amount of
+// the order, and a minFillAmt of zero means that the order can be filled with
+// any amount.
+func NewSimOrder(id, batchId uint64, dir OrderDirection, price sdk.Dec, amt, minFillAmt sdk.Int) *SimOrder {
+	return &SimOrder{
+		BaseOrder:     NewBaseOrder(dir, price, amt, OfferCoinAmount(dir, price, amt)),
+		Id:            id,
+		BatchId:       batchId,
+		MinFillAmount: minFillAmt,
+	}
+}
+
+func (order *SimOrder) GetBatchId() uint64 {
+	return order.BatchId
+}
+
+func (order *SimOrder) GetMinFillAmount() sdk.Int {
+	return order.MinFillAmount
+}
+
+// HasPriority returns whether the order has higher priority than
+// the other order.
+// Like user orders on the chain, orders with the same amount are prioritized
+// by their ids, and they have priority over pool orders and other orders.
+func (order *SimOrder) HasPriority(other Order) bool {
+	if !order.Amount.Equal(other.GetAmount()) {
+		return order.BaseOrder.HasPriority(other)
+	}
+	if other, ok := other.(*SimOrder); ok {
+		return order.Id < other.Id
+	}
+	return true
+}
+
+func (order *SimOrder) String() string {
+	return fmt.Sprintf("SimOrder(%d,%d,%s,%s,%s)",
+		order.Id, order.BatchId, order.Direction, order.Price, order.Amount)
+}
+
+// SimPoolOrder is an order made by a SimPool.
+type SimPoolOrder struct {
+	*BaseOrder
+	PoolId uint64
+}
+
+// HasPriority returns whether the order has higher priority than
+// the other order.
+// Like pool orders on the chain, orders with the same amount are prioritized
+// by their pool ids, after user orders.
+func (order *SimPoolOrder) HasPriority(other Order) bool {
+	if !order.Amount.Equal(other.GetAmount()) {
+		return order.BaseOrder.HasPriority(other)
+	}
+	switch other := other.(type) {
+	case *SimOrder:
+		return false
+	case *SimPoolOrder:
+		return order.PoolId < other.PoolId
+	default:
+		return true
+	}
+}
+
+func (order *SimPoolOrder) String() string {
+	return fmt.Sprintf("SimPoolOrder(%d,%s,%s,%s)",
+		order.PoolId, order.Direction, order.Price, order.Amount)
+}
+
+// SimPool is a pool with its id, which makes SimPoolOrder.
+type SimPool struct {
+	Pool
+	Id uint64
+}
+
+// NewSimPool returns a new SimPool.
+func NewSimPool(id uint64, pool Pool) *SimPool {
+	return &SimPool{
+		Pool: pool,
+		Id:   id,
+	}
+}
+
+// Order implements Orderer.
+func (pool *SimPool) Order(dir OrderDirection, price sdk.Dec, amt sdk.Int) Order {
+	return &SimPoolOrder{
+		BaseOrder: NewBaseOrder(dir, price, amt, OfferCoinAmount(dir, price, amt)),
+		PoolId:    pool.Id,
+	}
+}
+
+// Simulator simulates the matching of a pair's batch with the same matching
+// logic the chain uses, without any chain state.
+// It can be embedded in off-chain programs, such as market making bots, to
+// predict the result of the next batch.
+type Simulator struct {
+	tickPrec      int
+	upwardRatio   sdk.Dec
+	downwardRatio sdk.Dec
+	makerPriority bool
+	lastPrice     *sdk.Dec
+	orders        []Order
+	pools         []PoolOrderer
+	sources       []OrderSource
+}
+
+// NewSimulator returns a new Simulator with the chain's params.
+// upwardRatio and downwardRatio are the price limit ratios of the pair.
+func NewSimulator(tickPrec int, upwardRatio, downwardRatio sdk.Dec, makerPriority bool) *Simulator {
+	return &Simulator{
+		tickPrec:      tickPrec,
+		upwardRatio:   upwardRatio,
+		downwardRatio: downwardRatio,
+		makerPriority: makerPriority,
+	}
+}
+
+// SetLastPrice sets the last price of the pair.
+// The orders are matched at a single price if the last price is not set.
+func (sim *Simulator) SetLastPrice(price sdk.Dec) {
+	sim.lastPrice = &price
+}
+
+// AddOrder adds user orders to the simulation.
+func (sim *Simulator) AddOrder(orders ...Order) {
+	sim.orders = append(sim.orders, orders...)
+}
+
+// AddPool adds pools to the simulation.
+// Use SimPool to make pools with ids, so that their orders are prioritized
+// as on the chain.
+func (sim *Simulator) AddPool(pools ...PoolOrderer) {
+	sim.pools = append(sim.pools, pools...)
+}
+
+// AddOrderSource adds order sources to the simulation.
+func (sim *Simulator) AddOrderSource(sources ...OrderSource) {
+	sim.sources = append(sim.sources, sources...)
+}
+
+// PriceLimits returns the price limits around the last price.
+// found is false if the last price is not set.
+func (sim *Simulator) PriceLimits() (lowestPrice, highestPrice sdk.Dec, found bool) {
+	if sim.lastPrice == nil {
+		return sdk.Dec{}, sdk.Dec{}, false
+	}
+	lowestPrice, highestPrice = PriceLimits(*sim.lastPrice, sim.upwardRatio, sim.downwardRatio, sim.tickPrec)
+	return lowestPrice, highestPrice, true
+}
+
+// PoolOrders returns the orders the pools place within the price limits
+// around the last price.
+// It returns nil if the last price is not set.
+func (sim *Simulator) PoolOrders() (orders []Order) {
+	lowestPrice, highestPrice, found := sim.PriceLimits()
+	if !found {
+		return nil
+	}
+	for _, pool := range sim.pools {
+		orders = append(orders, PoolOrders(pool, pool, lowestPrice, highestPrice, sim.tickPrec)...)
+	}
+	return orders
+}
+
+// SimulationResult is the result of a simulated batch.
+type SimulationResult struct {
+	Matched       bool
+	MatchPrice    sdk.Dec
+	QuoteCoinDiff sdk.Int
+	// Orders are all orders in the batch including the orders made by
+	// the pools and the order sources, with their match info updated.
+	Orders []Order
+}
+
+// Simulate simulates the batch and returns its result.
+// The match info of the user orders is reset before the simulation, so
+// Simulate can be called repeatedly with orders added between the calls.
+// Like OrderBook, Simulate panics on arithmetic overflows.
+func (sim *Simulator) Simulate() SimulationResult {
+	for _, order := range sim.orders {
+		order.SetOpenAmount(order.GetAmount())
+		order.SetPaidOfferCoinAmount(sdk.ZeroInt())
+		order.SetReceivedDemandCoinAmount(sdk.ZeroInt())
+	}
+	ob := NewOrderBook(sim.orders...)
+	ob.SetMakerPriority(sim.makerPriority)
+	matchPrice, quoteCoinDiff, matched := MatchBatch(
+		ob, sim.pools, sim.sources, sim.lastPrice, sim.upwardRatio, sim.downwardRatio, sim.tickPrec)
+	return SimulationResult{
+		Matched:       matched,
+		MatchPrice:    matchPrice,
+		QuoteCoinDiff: quoteCoinDiff,
+		Orders:        ob.Orders(),
+	}
+}
@@ -0,0 +1,75 @@
+package amm_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	utils "github.com/crescent-network/crescent/v4/types"
+	"github.com/crescent-network/crescent/v4/x/liquidity/amm"
+)
+
+func TestSimulator(t *testing.T) {
+	sim := amm.NewSimulator(4, utils.ParseDec("0.2"), utils.ParseDec("0.1"), true)
+	sim.SetLastPrice(utils.ParseDec("1.0"))
+	sim.AddPool(amm.NewSimPool(1, amm.NewBasicPool(sdk.NewInt(1000_000000), sdk.NewInt(1000_000000), sdk.NewInt(1000_000000))))
+
+	// Pools place orders within the price limits.
+	lowestPrice, highestPrice, found := sim.PriceLimits()
+	require.True(t, found)
+	require.True(t, utils.ParseDec("0.9").Equal(lowestPrice))
+	require.True(t, utils.ParseDec("1.2").Equal(highestPrice))
+	poolOrders := sim.PoolOrders()
+	require.NotEmpty(t, poolOrders)
+	for _, order := range poolOrders {
+		require.True(t, order.GetPrice().GTE(lowestPrice))
+		require.True(t, order.GetPrice().LTE(highestPrice))
+		require.Equal(t, uint64(1), order.(*amm.SimPoolOrder).PoolId)
+	}
+
+	order := amm.NewSimOrder(1, 1, amm.Buy, utils.ParseDec("1.05"), sdk.NewInt(10_000000), sdk.ZeroInt())
+	sim.AddOrder(order)
+	res := sim.Simulate()
+	require.True(t, res.Matched)
+	require.True(t, res.MatchPrice.GT(utils.ParseDec("1.0")))
+	require.True(t, res.MatchPrice.LTE(utils.ParseDec("1.05")))
+	require.True(t, order.IsMatched())
+	require.True(t, order.GetOpenAmount().IsZero())
+
+	// Simulating again gives the same result.
+	res2 := sim.Simulate()
+	require.True(t, res.MatchPrice.Equal(res2.MatchPrice))
+	require.True(t, order.GetOpenAmount().IsZero())
+	require.True(t, order.GetReceivedDemandCoinAmount().Equal(sdk.NewInt(10_000000)))
+}
+
+func TestSimulator_NoLastPrice(t *testing.T) {
+	sim := amm.NewSimulator(4, utils.ParseDec("0.1"), utils.ParseDec("0.1"), true)
+	_, _, found := sim.PriceLimits()
+	require.False(t, found)
+	require.Nil(t, sim.PoolOrders())
+
+	buyOrder := amm.NewSimOrder(1, 1, amm.Buy, utils.ParseDec("1.1"), sdk.NewInt(10000), sdk.ZeroInt())
+	sellOrder := amm.NewSimOrder(2, 1, amm.Sell, utils.ParseDec("0.9"), sdk.NewInt(10000), sdk.ZeroInt())
+	sim.AddOrder(buyOrder, sellOrder)
+	res := sim.Simulate()
+	require.True(t, res.Matched)
+	require.True(t, utils.ParseDec("1.0").Equal(res.MatchPrice))
+	require.Len(t, res.Orders, 2)
+	require.True(t, buyOrder.GetOpenAmount().IsZero())
+	require.True(t, sellOrder.GetOpenAmount().IsZero())
+}
+
+func TestSimOrder_HasPriority(t *testing.T) {
+	order1 := amm.NewSimOrder(1, 1, amm.Buy, utils.ParseDec("1.0"), sdk.NewInt(10000), sdk.ZeroInt())
+	order2 := amm.NewSimOrder(2, 1, amm.Buy, utils.ParseDec("1.0"), sdk.NewInt(10000), sdk.ZeroInt())
+	order3 := amm.NewSimOrder(3, 1, amm.Buy, utils.ParseDec("1.0"), sdk.NewInt(20000), sdk.ZeroInt())
+	poolOrder := amm.NewSimPool(1, nil).Order(amm.Buy, utils.ParseDec("1.0"), sdk.NewInt(10000))
+
+	require.True(t, order1.HasPriority(order2))
+	require.False(t, order2.HasPriority(order1))
+	require.True(t, order3.HasPriority(order1))
+	require.True(t, order1.HasPriority(poolOrder))
+	require.False(t, poolOrder.HasPriority(order1))
+}
@@ -0,0 +1,130 @@
+package amm
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// OrderSource is the interface of an external liquidity source which
+// contributes orders to a batch matching, just like pools do.
+type OrderSource interface {
+	OrderView
+	// BuyOrdersOver returns buy orders with price higher than or equal to
+	// the given price.
+	BuyOrdersOver(price sdk.Dec) []Order
+	// SellOrdersUnder returns sell orders with price lower than or equal to
+	// the given price.
+	SellOrdersUnder(price sdk.Dec) []Order
+}
+
+var (
+	_ Pool        = (*FeeAdjustedPool)(nil)
+	_ OrderSource = (*PoolOrderSource)(nil)
+)
+
+// FeeAdjustedPool wraps a Pool and applies the fee rate of an external AMM
+// to the pool's curve.
+// The pool buys at prices lower than the curve's price by the fee rate and
+// sells at prices higher than the curve's price by the fee rate, so that
+// the fee is accrued to the pool's reserves.
+type FeeAdjustedPool struct {
+	Pool
+	feeRate sdk.Dec
+}
+
+// NewFeeAdjustedPool returns a new FeeAdjustedPool.
+// feeRate must be in range [0, 1).
+func NewFeeAdjustedPool(pool Pool, feeRate sdk.Dec) *FeeAdjustedPool {
+	return &FeeAdjustedPool{
+		Pool:    pool,
+		feeRate: feeRate,
+	}
+}
+
+// FeeRate returns the fee rate of the pool.
+func (pool *FeeAdjustedPool) FeeRate() sdk.Dec {
+	return pool.feeRate
+}
+
+// buyPriceToCurvePrice converts a buy price of the pool into the curve's price.
+func (pool *FeeAdjustedPool) buyPriceToCurvePrice(price sdk.Dec) sdk.Dec {
+	return price.Quo(oneDec.Sub(pool.feeRate))
+}
+
+// sellPriceToCurvePrice converts a sell price of the pool into the curve's price.
+func (pool *FeeAdjustedPool) sellPriceToCurvePrice(price sdk.Dec) sdk.Dec {
+	return price.Mul(oneDec.Sub(pool.feeRate))
+}
+
+// HighestBuyPrice returns the highest buy price of the pool.
+func (pool *FeeAdjustedPool) HighestBuyPrice() (price sdk.Dec, found bool) {
+	price, found = pool.Pool.HighestBuyPrice()
+	if !found {
+		return sdk.Dec{}, false
+	}
+	return price.Mul(oneDec.Sub(pool.feeRate)), true
+}
+
+// LowestSellPrice returns the lowest sell price of the pool.
+func (pool *FeeAdjustedPool) LowestSellPrice() (price sdk.Dec, found bool) {
+	price, found = pool.Pool.LowestSellPrice()
+	if !found {
+		return sdk.Dec{}, false
+	}
+	return price.Quo(oneDec.Sub(pool.feeRate)), true
+}
+
+// BuyAmountOver returns the amount of buy orders for price greater than
+// or equal to given price.
+func (pool *FeeAdjustedPool) BuyAmountOver(price sdk.Dec, inclusive bool) sdk.Int {
+	return pool.Pool.BuyAmountOver(pool.buyPriceToCurvePrice(price), inclusive)
+}
+
+// SellAmountUnder returns the amount of sell orders for price less than
+// or equal to given price.
+func (pool *FeeAdjustedPool) SellAmountUnder(price sdk.Dec, inclusive bool) sdk.Int {
+	return pool.Pool.SellAmountUnder(pool.sellPriceToCurvePrice(price), inclusive)
+}
+
+// BuyAmountTo returns the amount of buy orders of the pool for price.
+func (pool *FeeAdjustedPool) BuyAmountTo(price sdk.Dec) sdk.Int {
+	return pool.Pool.BuyAmountTo(pool.buyPriceToCurvePrice(price))
+}
+
+// SellAmountTo returns the amount of sell orders of the pool for price.
+func (pool *FeeAdjustedPool) SellAmountTo(price sdk.Dec) sdk.Int {
+	return pool.Pool.SellAmountTo(pool.sellPriceToCurvePrice(price))
+}
+
+func (pool *FeeAdjustedPool) Clone() Pool {
+	return NewFeeAdjustedPool(pool.Pool.Clone(), pool.feeRate)
+}
+
+// PoolOrderSource is an OrderSource which provides orders of a pool.
+// It is used to let liquidity following a pool's curve, but not held by
+// a pool of the liquidity module, participate in batch matching.
+type PoolOrderSource struct {
+	Pool
+	orderer  Orderer
+	tickPrec int
+}
+
+// NewPoolOrderSource returns a new PoolOrderSource.
+func NewPoolOrderSource(pool Pool, orderer Orderer, tickPrec int) *PoolOrderSource {
+	return &PoolOrderSource{
+		Pool:     pool,
+		orderer:  orderer,
+		tickPrec: tickPrec,
+	}
+}
+
+// BuyOrdersOver returns buy orders of the pool with price higher than or
+// equal to the given price.
+func (source *PoolOrderSource) BuyOrdersOver(price sdk.Dec) []Order {
+	return PoolBuyOrders(source.Pool, source.orderer, price, HighestTick(source.tickPrec), source.tickPrec)
+}
+
+// SellOrdersUnder returns sell orders of the pool with price lower than or
+// equal to the given price.
+func (source *PoolOrderSource) SellOrdersUnder(price sdk.Dec) []Order {
+	return PoolSellOrders(source.Pool, source.orderer, LowestTick(source.tickPrec), price, source.tickPrec)
+}
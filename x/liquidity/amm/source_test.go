@@ -0,0 +1,63 @@
+package amm_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	utils "github.com/crescent-network/crescent/v4/types"
+	"github.com/crescent-network/crescent/v4/x/liquidity/amm"
+)
+
+func TestFeeAdjustedPool(t *testing.T) {
+	basicPool := amm.NewBasicPool(sdk.NewInt(1000000), sdk.NewInt(1000000), sdk.Int{})
+	pool := amm.NewFeeAdjustedPool(basicPool, utils.ParseDec("0.01"))
+
+	price, found := pool.HighestBuyPrice()
+	require.True(t, found)
+	require.True(sdk.DecEq(t, utils.ParseDec("0.99"), price))
+	price, found = pool.LowestSellPrice()
+	require.True(t, found)
+	require.True(sdk.DecEq(t, utils.ParseDec("1").Quo(utils.ParseDec("0.99")), price))
+
+	// The pool doesn't buy or sell within the fee range around the curve's price.
+	require.True(sdk.IntEq(t, sdk.ZeroInt(), pool.BuyAmountOver(utils.ParseDec("0.995"), true)))
+	require.True(sdk.IntEq(t, sdk.ZeroInt(), pool.SellAmountUnder(utils.ParseDec("1.005"), true)))
+
+	require.True(sdk.IntEq(t,
+		basicPool.BuyAmountOver(utils.ParseDec("0.98").Quo(utils.ParseDec("0.99")), true),
+		pool.BuyAmountOver(utils.ParseDec("0.98"), true)))
+	require.True(sdk.IntEq(t,
+		basicPool.SellAmountUnder(utils.ParseDec("1.02").Mul(utils.ParseDec("0.99")), true),
+		pool.SellAmountUnder(utils.ParseDec("1.02"), true)))
+
+	// Cloned pool keeps the fee rate.
+	require.True(sdk.DecEq(t, pool.FeeRate(), pool.Clone().(*amm.FeeAdjustedPool).FeeRate()))
+}
+
+func TestPoolOrderSource(t *testing.T) {
+	pool := amm.NewFeeAdjustedPool(
+		amm.NewBasicPool(sdk.NewInt(1000000), sdk.NewInt(1000000), sdk.Int{}), utils.ParseDec("0.01"))
+	source := amm.NewPoolOrderSource(pool, amm.DefaultOrderer, 4)
+
+	buyOrders := source.BuyOrdersOver(utils.ParseDec("0.95"))
+	require.NotEmpty(t, buyOrders)
+	for _, order := range buyOrders {
+		require.Equal(t, amm.Buy, order.GetDirection())
+		require.True(t, order.GetPrice().GTE(utils.ParseDec("0.95")))
+		require.True(t, order.GetPrice().LTE(utils.ParseDec("0.99")))
+	}
+
+	sellOrders := source.SellOrdersUnder(utils.ParseDec("1.05"))
+	require.NotEmpty(t, sellOrders)
+	for _, order := range sellOrders {
+		require.Equal(t, amm.Sell, order.GetDirection())
+		require.True(t, order.GetPrice().LTE(utils.ParseDec("1.05")))
+		require.True(t, order.GetPrice().GTE(utils.ParseDec("1").Quo(utils.ParseDec("0.99"))))
+	}
+
+	require.Empty(t, source.BuyOrdersOver(utils.ParseDec("0.995")))
+	require.Empty(t, source.SellOrdersUnder(utils.ParseDec("1.005")))
+}
@@ -0,0 +1,114 @@
+package testutil
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	utils "github.com/crescent-network/crescent/v4/types"
+	"github.com/crescent-network/crescent/v4/x/liquidity/amm"
+)
+
+// CheckOrderInvariants checks the invariants of each order after a matching:
+//   - the open amount is not negative and not greater than the order amount
+//   - the paid offer coin amount is not negative and not greater than the
+//     offer coin amount
+//   - the matched amount equals to the received base coin amount of a buy
+//     order, or the paid base coin amount of a sell order
+func CheckOrderInvariants(orders []amm.Order) error {
+	for _, order := range orders {
+		if order.GetOpenAmount().IsNegative() {
+			return fmt.Errorf("%s has negative open amount: %s", order, order.GetOpenAmount())
+		}
+		if order.GetOpenAmount().GT(order.GetAmount()) {
+			return fmt.Errorf("%s has open amount greater than its amount: %s", order, order.GetOpenAmount())
+		}
+		if order.GetPaidOfferCoinAmount().IsNegative() || order.GetPaidOfferCoinAmount().GT(order.GetOfferCoinAmount()) {
+			return fmt.Errorf("%s has invalid paid offer coin amount: %s", order, order.GetPaidOfferCoinAmount())
+		}
+		if order.GetReceivedDemandCoinAmount().IsNegative() {
+			return fmt.Errorf("%s has negative received demand coin amount: %s", order, order.GetReceivedDemandCoinAmount())
+		}
+		matchedAmt := order.GetAmount().Sub(order.GetOpenAmount())
+		baseCoinAmt := order.GetReceivedDemandCoinAmount()
+		if order.GetDirection() == amm.Sell {
+			baseCoinAmt = order.GetPaidOfferCoinAmount()
+		}
+		if !matchedAmt.Equal(baseCoinAmt) {
+			return fmt.Errorf("%s has matched amount %s, but base coin amount %s", order, matchedAmt, baseCoinAmt)
+		}
+	}
+	return nil
+}
+
+// CheckMatchInvariants checks the invariants of a matching result, in
+// addition to CheckOrderInvariants:
+//   - the base coin amounts matched by buy orders and sell orders are equal
+//   - quoteCoinDiff equals to the quote coin amount paid by buy orders minus
+//     the quote coin amount received by sell orders, and is not negative
+//   - the match price is on ticks and within the lowest and the highest ticks
+func CheckMatchInvariants(orders []amm.Order, matchPrice sdk.Dec, quoteCoinDiff sdk.Int, tickPrec int) error {
+	if err := CheckOrderInvariants(orders); err != nil {
+		return err
+	}
+	buyMatchedAmt, sellMatchedAmt := sdk.ZeroInt(), sdk.ZeroInt()
+	quotePaid, quoteReceived := sdk.ZeroInt(), sdk.ZeroInt()
+	for _, order := range orders {
+		matchedAmt := order.GetAmount().Sub(order.GetOpenAmount())
+		switch order.GetDirection() {
+		case amm.Buy:
+			buyMatchedAmt = buyMatchedAmt.Add(matchedAmt)
+			quotePaid = quotePaid.Add(order.GetPaidOfferCoinAmount())
+		case amm.Sell:
+			sellMatchedAmt = sellMatchedAmt.Add(matchedAmt)
+			quoteReceived = quoteReceived.Add(order.GetReceivedDemandCoinAmount())
+		}
+	}
+	if !buyMatchedAmt.Equal(sellMatchedAmt) {
+		return fmt.Errorf("matched buy amount %s != matched sell amount %s", buyMatchedAmt, sellMatchedAmt)
+	}
+	if diff := quotePaid.Sub(quoteReceived); !diff.Equal(quoteCoinDiff) {
+		return fmt.Errorf("quote coin diff %s != paid %s - received %s", quoteCoinDiff, quotePaid, quoteReceived)
+	}
+	if quoteCoinDiff.IsNegative() {
+		return fmt.Errorf("negative quote coin diff: %s", quoteCoinDiff)
+	}
+	if !amm.PriceToDownTick(matchPrice, tickPrec).Equal(matchPrice) {
+		return fmt.Errorf("match price %s is not on ticks", matchPrice)
+	}
+	if matchPrice.LT(amm.LowestTick(tickPrec)) || matchPrice.GT(amm.HighestTick(tickPrec)) {
+		return fmt.Errorf("match price %s is out of tick bounds", matchPrice)
+	}
+	return nil
+}
+
+// MatchAndCheck matches the order book with the last price and checks the
+// invariants of the result.
+// Arithmetic overflows, which are handled by the liquidity module by
+// discarding the matching result, are not reported as errors.
+func MatchAndCheck(ob *amm.OrderBook, lastPrice sdk.Dec, tickPrec int) (matched bool, err error) {
+	var (
+		matchPrice    sdk.Dec
+		quoteCoinDiff sdk.Int
+		overflow      bool
+	)
+	utils.SafeMath(func() {
+		matchPrice, quoteCoinDiff, matched = ob.Match(lastPrice)
+	}, func() {
+		overflow = true
+	})
+	if overflow {
+		return false, nil
+	}
+	if !matched {
+		return false, CheckOrderInvariants(ob.Orders())
+	}
+	return true, CheckMatchInvariants(ob.Orders(), matchPrice, quoteCoinDiff, tickPrec)
+}
+
+// MatchProperty is a property of the matching for testing/quick, which
+// returns whether the matching of the input satisfies the invariants.
+func MatchProperty(in OrderBookInput) bool {
+	_, err := MatchAndCheck(in.OrderBook, in.LastPrice, TickPrecision)
+	return err == nil
+}
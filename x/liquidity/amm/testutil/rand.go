@@ -0,0 +1,109 @@
+package testutil
+
+import (
+	"math/rand"
+	"reflect"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	utils "github.com/crescent-network/crescent/v4/types"
+	"github.com/crescent-network/crescent/v4/x/liquidity/amm"
+)
+
+// RandomExtremePrice returns a random price on ticks, which is likely to be
+// close to the lowest or the highest tick.
+func RandomExtremePrice(r *rand.Rand, tickPrec int) sdk.Dec {
+	m := int64(r.Intn(9999) + 1)
+	var price sdk.Dec
+	switch e := r.Intn(91) - 18; {
+	case e < 0:
+		price = sdk.NewDecWithPrec(m, int64(-e))
+	default:
+		price = sdk.NewIntWithDecimal(m, e).ToDec()
+	}
+	switch {
+	case price.LT(amm.LowestTick(tickPrec)):
+		return amm.LowestTick(tickPrec)
+	case price.GT(amm.HighestTick(tickPrec)):
+		return amm.HighestTick(tickPrec)
+	}
+	return amm.PriceToDownTick(price, tickPrec)
+}
+
+// RandomExtremeAmount returns a random amount in [amm.MinCoinAmount, amm.MaxCoinAmount],
+// which is likely to be close to amm.MaxCoinAmount.
+func RandomExtremeAmount(r *rand.Rand) sdk.Int {
+	if r.Intn(2) == 0 {
+		return amm.MaxCoinAmount.Sub(sdk.NewInt(r.Int63n(1000)))
+	}
+	return sdk.NewIntWithDecimal(int64(r.Intn(9)+1), r.Intn(39)+2)
+}
+
+// RandomExtremeOrderBook returns an order book with random orders which pass
+// the validation of the liquidity module, but have extreme prices and amounts.
+func RandomExtremeOrderBook(r *rand.Rand, numOrders, tickPrec int) *amm.OrderBook {
+	ob := amm.NewOrderBook()
+	for len(ob.Orders()) < numOrders {
+		price := RandomExtremePrice(r, tickPrec)
+		amt := RandomExtremeAmount(r)
+		if r.Intn(2) == 0 {
+			offerAmt, err := amm.SafeOfferCoinAmount(amm.Buy, price, amt)
+			if err != nil || offerAmt.GT(amm.MaxCoinAmount) {
+				continue
+			}
+			ob.AddOrder(amm.NewBaseOrder(amm.Buy, price, amt, offerAmt))
+		} else {
+			if _, err := amm.SafeQuoteAmount(price, amt); err != nil {
+				continue
+			}
+			ob.AddOrder(amm.NewBaseOrder(amm.Sell, price, amt, amt))
+		}
+	}
+	return ob
+}
+
+// RandomOrderBook returns an order book with random orders whose prices are
+// on ticks within maxTicks ticks around the center price, and whose amounts
+// are in [minAmt, maxAmt).
+// Orders have random batch ids in [1, 3] and random ids, so that the order
+// book has orders of the same price from different batches.
+func RandomOrderBook(
+	r *rand.Rand, numOrders int, centerPrice sdk.Dec, maxTicks int, minAmt, maxAmt sdk.Int, tickPrec int) *amm.OrderBook {
+	centerIdx := amm.TickToIndex(amm.PriceToDownTick(centerPrice, tickPrec), tickPrec)
+	maxIdx := amm.TickToIndex(amm.HighestTick(tickPrec), tickPrec)
+	ob := amm.NewOrderBook()
+	for i := 0; i < numOrders; i++ {
+		idx := centerIdx + r.Intn(2*maxTicks+1) - maxTicks
+		if idx < 0 {
+			idx = 0
+		} else if idx > maxIdx {
+			idx = maxIdx
+		}
+		price := amm.TickFromIndex(idx, tickPrec)
+		amt := utils.RandomInt(r, minAmt, maxAmt)
+		dir := amm.Buy
+		if r.Intn(2) == 0 {
+			dir = amm.Sell
+		}
+		ob.AddOrder(amm.NewSimOrder(uint64(i+1), uint64(r.Intn(3)+1), dir, price, amt, sdk.ZeroInt()))
+	}
+	return ob
+}
+
+// OrderBookInput is a random input of the matching, which can be generated
+// by testing/quick.
+type OrderBookInput struct {
+	OrderBook *amm.OrderBook
+	LastPrice sdk.Dec
+}
+
+// TickPrecision is the tick precision used by OrderBookInput.Generate.
+const TickPrecision = 4
+
+// Generate implements quick.Generator.
+// The size controls the number of orders in the order book.
+func (OrderBookInput) Generate(r *rand.Rand, size int) reflect.Value {
+	lastPrice := amm.PriceToDownTick(sdk.NewDecWithPrec(r.Int63n(100000)+1, 3), TickPrecision)
+	ob := RandomOrderBook(r, size+2, lastPrice, 20, sdk.NewInt(100), sdk.NewInt(100_000000), TickPrecision)
+	return reflect.ValueOf(OrderBookInput{OrderBook: ob, LastPrice: lastPrice})
+}
@@ -2,6 +2,7 @@ package amm
 
 import (
 	"fmt"
+	"math/big"
 	"sort"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -13,6 +14,8 @@ var (
 	zeroInt = sdk.ZeroInt()
 	oneDec  = sdk.OneDec()
 	fourDec = sdk.NewDec(4)
+
+	decPrecisionMultiplier = new(big.Int).Exp(big.NewInt(10), big.NewInt(sdk.Precision), nil)
 )
 
 // OfferCoinAmount returns the minimum offer coin amount for
@@ -28,6 +31,29 @@ func OfferCoinAmount(dir OrderDirection, price sdk.Dec, amt sdk.Int) sdk.Int {
 	}
 }
 
+// SafeOfferCoinAmount is like OfferCoinAmount, but returns ErrOverflow
+// instead of panicking when the amount overflows.
+func SafeOfferCoinAmount(dir OrderDirection, price sdk.Dec, amt sdk.Int) (offerAmt sdk.Int, err error) {
+	utils.SafeMath(func() {
+		offerAmt = OfferCoinAmount(dir, price, amt)
+	}, func() {
+		err = ErrOverflow
+	})
+	return
+}
+
+// SafeQuoteAmount returns the truncated quote coin amount for the base coin
+// amount at the price.
+// It returns ErrOverflow if the amount overflows.
+func SafeQuoteAmount(price sdk.Dec, amt sdk.Int) (quoteAmt sdk.Int, err error) {
+	utils.SafeMath(func() {
+		quoteAmt = price.MulInt(amt).TruncateInt()
+	}, func() {
+		err = ErrOverflow
+	})
+	return
+}
+
 // MatchableAmount returns matchable amount of an order considering
 // remaining offer coin and price.
 func MatchableAmount(order Order, price sdk.Dec) (matchableAmt sdk.Int) {
@@ -41,7 +67,8 @@ func MatchableAmount(order Order, price sdk.Dec) (matchableAmt sdk.Int) {
 	case Sell:
 		matchableAmt = order.GetOpenAmount()
 	}
-	if price.MulInt(matchableAmt).TruncateInt().IsZero() {
+	// An overflowed quote amount is never zero.
+	if quoteAmt, err := SafeQuoteAmount(price, matchableAmt); err == nil && quoteAmt.IsZero() {
 		matchableAmt = zeroInt
 	}
 	return
@@ -65,6 +92,20 @@ func TotalMatchableAmount(orders []Order, price sdk.Dec) (amt sdk.Int) {
 	return
 }
 
+// IsBelowMinFillAmount returns whether filling the order with the given
+// amount violates the order's min fill amount.
+// Filling the order with its whole matchable amount is always allowed.
+func IsBelowMinFillAmount(order Order, amt sdk.Int, price sdk.Dec) bool {
+	if !amt.IsPositive() {
+		return false
+	}
+	minFillAmt := order.GetMinFillAmount()
+	if !minFillAmt.IsPositive() {
+		return false
+	}
+	return amt.LT(sdk.MinInt(minFillAmt, MatchableAmount(order, price)))
+}
+
 // OrderGroup represents a group of orders with same batch id.
 type OrderGroup struct {
 	BatchId uint64
@@ -96,6 +137,37 @@ func GroupOrdersByBatchId(orders []Order) (groups []*OrderGroup) {
 	return
 }
 
+// GroupOrdersByBatchIdPresence groups orders into orders with batch id and
+// orders without batch id, in that order.
+// The group of orders with batch id has the lowest batch id among the orders
+// as its BatchId.
+func GroupOrdersByBatchIdPresence(orders []Order) (groups []*OrderGroup) {
+	var withBatchId, withoutBatchId *OrderGroup
+	for _, order := range orders {
+		batchId := order.GetBatchId()
+		if batchId == 0 {
+			if withoutBatchId == nil {
+				withoutBatchId = &OrderGroup{}
+			}
+			withoutBatchId.Orders = append(withoutBatchId.Orders, order)
+			continue
+		}
+		if withBatchId == nil {
+			withBatchId = &OrderGroup{BatchId: batchId}
+		} else if batchId < withBatchId.BatchId {
+			withBatchId.BatchId = batchId
+		}
+		withBatchId.Orders = append(withBatchId.Orders, order)
+	}
+	if withBatchId != nil {
+		groups = append(groups, withBatchId)
+	}
+	if withoutBatchId != nil {
+		groups = append(groups, withoutBatchId)
+	}
+	return
+}
+
 // SortOrders sorts orders using its HasPriority condition.
 func SortOrders(orders []Order) {
 	sort.SliceStable(orders, func(i, j int) bool {
@@ -126,6 +198,46 @@ func findFirstTrueCondition(start, end int, f func(i int) bool) (i int, found bo
 	return i, true
 }
 
+// findFirstTrueConditionWithHint is like findFirstTrueCondition, but it
+// searches in range [hintStart, hintEnd] first, which is expected to contain
+// the index.
+// The rest of range [start, end] is searched only if the index is not in
+// the hinted range, so the result is always the same as findFirstTrueCondition.
+// The hinted range must be in the same direction as [start, end].
+func findFirstTrueConditionWithHint(start, end, hintStart, hintEnd int, f func(i int) bool) (i int, found bool) {
+	step := 1
+	if start > end {
+		step = -1
+	}
+	within := func(i int) bool {
+		return (i-start)*step >= 0 && (end-i)*step >= 0
+	}
+	if !within(hintStart) || !within(hintEnd) || (hintEnd-hintStart)*step < 0 {
+		return findFirstTrueCondition(start, end, f)
+	}
+	if hintStart != start && f(hintStart-step) {
+		return findFirstTrueCondition(start, hintStart-step, f)
+	}
+	if i, found = findFirstTrueCondition(hintStart, hintEnd, f); found {
+		return i, true
+	}
+	if hintEnd == end {
+		return 0, false
+	}
+	return findFirstTrueCondition(hintEnd+step, end, f)
+}
+
+// MulDecCapped returns x * y, or max if the product is greater than max.
+// Unlike sdk.Dec.Mul, it doesn't panic when the product overflows.
+func MulDecCapped(x, y, max sdk.Dec) sdk.Dec {
+	i := new(big.Int).Mul(x.BigInt(), y.BigInt())
+	i.Quo(i, decPrecisionMultiplier)
+	if i.Cmp(max.BigInt()) > 0 {
+		return max
+	}
+	return x.Mul(y)
+}
+
 // inv returns the inverse of x.
 func inv(x sdk.Dec) (r sdk.Dec) {
 	r = oneDec.Quo(x)
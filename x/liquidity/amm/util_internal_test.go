@@ -31,6 +31,27 @@ func Test_findFirstTrueCondition(t *testing.T) {
 	require.False(t, found)
 }
 
+func Test_findFirstTrueConditionWithHint(t *testing.T) {
+	arr := []int{1, 5, 9, 10, 14, 20, 25}
+	for threshold := 0; threshold <= 26; threshold++ {
+		asc := func(i int) bool { return arr[i] >= threshold }
+		desc := func(i int) bool { return arr[i] < threshold }
+		for lo := -1; lo <= len(arr); lo++ {
+			for hi := -1; hi <= len(arr); hi++ {
+				expected, expectedFound := findFirstTrueCondition(0, len(arr)-1, asc)
+				i, found := findFirstTrueConditionWithHint(0, len(arr)-1, lo, hi, asc)
+				require.Equal(t, expectedFound, found)
+				require.Equal(t, expected, i)
+
+				expected, expectedFound = findFirstTrueCondition(len(arr)-1, 0, desc)
+				i, found = findFirstTrueConditionWithHint(len(arr)-1, 0, hi, lo, desc)
+				require.Equal(t, expectedFound, found)
+				require.Equal(t, expected, i)
+			}
+		}
+	}
+}
+
 func Test_poolOrderPriceGapRatio(t *testing.T) {
 	for _, tc := range []struct {
 		poolPrice    sdk.Dec
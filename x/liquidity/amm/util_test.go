@@ -30,6 +30,57 @@ func TestMatchableAmount(t *testing.T) {
 	}
 }
 
+func TestMatchableAmount_Overflow(t *testing.T) {
+	// The quote coin amount of the order overflows at the price, which
+	// means that the order is definitely not too small to be matched.
+	order := newOrder(amm.Sell, utils.ParseDec("1.0"), amm.MaxCoinAmount)
+	price := sdk.NewIntWithDecimal(1, 40).ToDec()
+	require.True(sdk.IntEq(t, amm.MaxCoinAmount, amm.MatchableAmount(order, price)))
+}
+
+func TestMulDecCapped(t *testing.T) {
+	max := amm.HighestTick(4)
+	for _, tc := range []struct {
+		x, y     sdk.Dec
+		expected sdk.Dec
+	}{
+		{utils.ParseDec("1.1"), utils.ParseDec("2"), utils.ParseDec("2.2")},
+		{max, utils.ParseDec("1"), max},
+		{max, utils.ParseDec("1.1"), max},
+		{max, max, max},
+	} {
+		t.Run("", func(t *testing.T) {
+			require.True(sdk.DecEq(t, tc.expected, amm.MulDecCapped(tc.x, tc.y, max)))
+		})
+	}
+}
+
+func TestSafeOfferCoinAmount(t *testing.T) {
+	for _, tc := range []struct {
+		price       sdk.Dec
+		amt         sdk.Int
+		expected    sdk.Int
+		expectedErr error
+	}{
+		{utils.ParseDec("1.5"), sdk.NewInt(1001), sdk.NewInt(1502), nil},
+		{sdk.NewIntWithDecimal(1, 37).ToDec(), amm.MaxCoinAmount, sdk.Int{}, amm.ErrOverflow},
+	} {
+		t.Run("", func(t *testing.T) {
+			offerAmt, err := amm.SafeOfferCoinAmount(amm.Buy, tc.price, tc.amt)
+			if tc.expectedErr == nil {
+				require.NoError(t, err)
+				require.True(sdk.IntEq(t, tc.expected, offerAmt))
+			} else {
+				require.ErrorIs(t, err, tc.expectedErr)
+			}
+			// The offer coin amount of sell orders never overflows.
+			offerAmt, err = amm.SafeOfferCoinAmount(amm.Sell, tc.price, tc.amt)
+			require.NoError(t, err)
+			require.True(sdk.IntEq(t, tc.amt, offerAmt))
+		})
+	}
+}
+
 type batchIdOrderer struct {
 	batchId uint64
 }
@@ -71,3 +122,26 @@ func TestGroupOrdersByBatchId(t *testing.T) {
 	require.EqualValues(t, 0, groups[3].BatchId)
 	require.True(sdk.IntEq(t, sdk.NewInt(96000), amm.TotalAmount(groups[3].Orders)))
 }
+
+func TestGroupOrdersByBatchIdPresence(t *testing.T) {
+	price := utils.ParseDec("1.0")
+	newOrder := func(amt sdk.Int, batchId uint64) amm.Order {
+		return (&batchIdOrderer{batchId}).Order(amm.Buy, price, amt)
+	}
+	orders := []amm.Order{
+		newOrder(sdk.NewInt(32000), 0),
+		newOrder(sdk.NewInt(8000), 4),
+		newOrder(sdk.NewInt(1000), 1),
+		newOrder(sdk.NewInt(16000), 4),
+		newOrder(sdk.NewInt(4000), 2),
+		newOrder(sdk.NewInt(64000), 0),
+	}
+	groups := amm.GroupOrdersByBatchIdPresence(orders)
+	require.Len(t, groups, 2)
+	require.EqualValues(t, 1, groups[0].BatchId)
+	require.True(sdk.IntEq(t, sdk.NewInt(29000), amm.TotalAmount(groups[0].Orders)))
+	require.EqualValues(t, 0, groups[1].BatchId)
+	require.True(sdk.IntEq(t, sdk.NewInt(96000), amm.TotalAmount(groups[1].Orders)))
+
+	require.Len(t, amm.GroupOrdersByBatchIdPresence(orders[1:5]), 1)
+}
@@ -7,13 +7,22 @@ import (
 )
 
 const (
-	FlagPairId         = "pair-id"
-	FlagDisabled       = "disabled"
-	FlagPoolCoinDenom  = "pool-coin-denom"
-	FlagReserveAddress = "reserve-address"
-	FlagDenoms         = "denoms"
-	FlagOrderLifespan  = "order-lifespan"
-	FlagNumTicks       = "num-ticks"
+	FlagPairId                = "pair-id"
+	FlagDisabled              = "disabled"
+	FlagPoolCoinDenom         = "pool-coin-denom"
+	FlagReserveAddress        = "reserve-address"
+	FlagDenoms                = "denoms"
+	FlagOrderLifespan         = "order-lifespan"
+	FlagNumTicks              = "num-ticks"
+	FlagInterval              = "interval"
+	FlagLogoURIHash           = "logo-uri-hash"
+	FlagAutoCancelAfterBlocks = "auto-cancel-after-blocks"
+	FlagFarm                  = "farm"
+	FlagPrice                 = "price"
+	FlagMinFillAmount         = "min-fill-amount"
+	FlagStatus                = "status"
+	FlagDepth                 = "depth"
+	FlagStream                = "stream"
 )
 
 func flagSetPools() *flag.FlagSet {
@@ -46,6 +55,7 @@ func flagSetOrders() *flag.FlagSet {
 	fs := flag.NewFlagSet("", flag.ContinueOnError)
 
 	fs.String(FlagPairId, "", "The pair id")
+	fs.String(FlagStatus, "", "The order status (not-executed|not-matched|partially-matched|completed|canceled|expired)")
 
 	return fs
 }
@@ -57,3 +67,11 @@ func flagSetOrder() *flag.FlagSet {
 
 	return fs
 }
+
+func flagSetVaults() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.String(FlagPairId, "", "The pair id")
+
+	return fs
+}
@@ -1,14 +1,20 @@
 package cli
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/rpc"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkquery "github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/version"
 
 	"github.com/crescent-network/crescent/v4/x/liquidity/types"
@@ -30,6 +36,9 @@ func GetQueryCmd() *cobra.Command {
 		NewQueryPoolCmd(),
 		NewQueryPairsCmd(),
 		NewQueryPairCmd(),
+		NewQueryPricesHistoryCmd(),
+		NewQueryTWAPCmd(),
+		NewQueryPairStatsCmd(),
 		NewQueryDepositRequestsCmd(),
 		NewQueryDepositRequestCmd(),
 		NewQueryWithdrawRequestsCmd(),
@@ -37,6 +46,20 @@ func GetQueryCmd() *cobra.Command {
 		NewQueryOrdersCmd(),
 		NewQueryOrderCmd(),
 		NewQueryOrderBooksCmd(),
+		NewQueryOrderBookCmd(),
+		NewExportOrderBookCmd(),
+		NewQueryEscrowBalanceDiffsCmd(),
+		NewQueryDustCmd(),
+		NewQueryPoolOrdersCmd(),
+		NewQuerySimulateBatchCmd(),
+		NewQueryVaultsCmd(),
+		NewQueryVaultCmd(),
+		NewQueryRequestResultCmd(),
+		NewQueryBatchResultCmd(),
+		NewQueryPoolCoinValueCmd(),
+		NewQueryTicksCmd(),
+		NewQueryModuleAccountsCmd(),
+		NewQueryNumActiveOrdersCmd(),
 	)
 
 	return cmd
@@ -175,6 +198,162 @@ $ %s query %s pair 1
 	return cmd
 }
 
+// NewQueryPricesHistoryCmd implements the prices-history query command.
+func NewQueryPricesHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prices-history [pair-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the price history of the pair as candles",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the price history of the pair as OHLC candles.
+Each candle covers the number of consecutive price history entries given by the interval flag.
+The candles are made from the price history entries in the page given by the pagination flags, which is 100 entries from the oldest one by default.
+
+Example:
+$ %s query %s prices-history 1
+$ %s query %s prices-history 1 --interval=10
+$ %s query %s prices-history 1 --interval=10 --reverse --limit=1000
+`,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pairId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			interval, _ := cmd.Flags().GetUint32(FlagInterval)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PricesHistory(cmd.Context(), &types.QueryPricesHistoryRequest{
+				PairId:     pairId,
+				Interval:   interval,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint32(FlagInterval, 1, "number of price history entries aggregated into each candle")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "prices-history")
+
+	return cmd
+}
+
+// NewQueryTWAPCmd implements the twap query command.
+func NewQueryTWAPCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "twap [pair-id] [duration]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the time-weighted average price of the pair",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the time-weighted average price of the pair over the duration ending at the latest block time.
+Valid time units of the duration are ns|us|ms|s|m|h.
+
+Example:
+$ %s query %s twap 1 1h
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pairId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			duration, err := time.ParseDuration(args[1])
+			if err != nil {
+				return fmt.Errorf("parse duration: %w", err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.TWAP(cmd.Context(), &types.QueryTWAPRequest{
+				PairId:   pairId,
+				Duration: duration,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewQueryPairStatsCmd implements the pair stats query command.
+func NewQueryPairStatsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pair-stats [pair-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the trading statistics of the pair over the last 24 hours",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the trading statistics of the pair over the last 24 hours.
+The statistics are aggregated from hourly buckets, so they cover the current hour and the 23 hours before it.
+
+Example:
+$ %s query %s pair-stats 1
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pairId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("parse pair id: %w", err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.PairStats(cmd.Context(), &types.QueryPairStatsRequest{
+				PairId: pairId,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // NewQueryPoolsCmd implements the pools query command.
 func NewQueryPoolsCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -540,10 +719,14 @@ Example:
 $ %s query %s orders cre1...
 $ %s query %s orders --pair-id=1 cre1...
 $ %s query %s orders --pair-id=1
+$ %s query %s orders --status=not-matched cre1...
+
+The --status flag can only be used along with the orderer.
 `,
 				version.AppName, types.ModuleName,
 				version.AppName, types.ModuleName,
 				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -574,6 +757,18 @@ $ %s query %s orders --pair-id=1
 				return fmt.Errorf("either orderer or pair-id must be specified")
 			}
 
+			var orderStatus types.OrderStatus
+			statusStr, _ := cmd.Flags().GetString(FlagStatus)
+			if statusStr != "" {
+				if orderer == nil {
+					return fmt.Errorf("status can only be specified with orderer")
+				}
+				orderStatus, err = parseOrderStatus(statusStr)
+				if err != nil {
+					return fmt.Errorf("parse order status: %w", err)
+				}
+			}
+
 			queryClient := types.NewQueryClient(clientCtx)
 
 			var res *types.QueryOrdersResponse
@@ -588,6 +783,7 @@ $ %s query %s orders --pair-id=1
 					&types.QueryOrdersByOrdererRequest{
 						Orderer:    *orderer,
 						PairId:     pairId,
+						Status:     orderStatus,
 						Pagination: pageReq,
 					})
 			}
@@ -609,16 +805,20 @@ $ %s query %s orders --pair-id=1
 // NewQueryOrderCmd implements the order query command.
 func NewQueryOrderCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "order [pair-id] [id]",
-		Args:  cobra.ExactArgs(2),
+		Use:   "order [pair-id] [id] | order [ref]",
+		Args:  cobra.RangeArgs(1, 2),
 		Short: "Query details of the specific order",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Query details of the specific order.
+The order can be specified either by its pair id and id or by its reference,
+which is formatted as "<pair-id>-<id>".
 
 Example:
 $ %s query %s order 1 1
+$ %s query %s order 1-1
 `,
 				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -627,24 +827,24 @@ $ %s query %s order 1 1
 				return err
 			}
 
-			pairId, err := strconv.ParseUint(args[0], 10, 64)
-			if err != nil {
-				return err
-			}
+			req := &types.QueryOrderRequest{}
+			if len(args) == 1 {
+				req.Ref = args[0]
+			} else {
+				req.PairId, err = strconv.ParseUint(args[0], 10, 64)
+				if err != nil {
+					return err
+				}
 
-			id, err := strconv.ParseUint(args[1], 10, 64)
-			if err != nil {
-				return err
+				req.Id, err = strconv.ParseUint(args[1], 10, 64)
+				if err != nil {
+					return err
+				}
 			}
 
 			queryClient := types.NewQueryClient(clientCtx)
 
-			res, err := queryClient.Order(
-				cmd.Context(),
-				&types.QueryOrderRequest{
-					PairId: pairId,
-					Id:     id,
-				})
+			res, err := queryClient.Order(cmd.Context(), req)
 			if err != nil {
 				return err
 			}
@@ -714,3 +914,813 @@ $ %s query %s order-books 2,3
 
 	return cmd
 }
+
+// NewQueryOrderBookCmd implements the order-book query command, which renders
+// the aggregated order book of the pair as a table.
+func NewQueryOrderBookCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "order-book [pair-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Display the order book of the pair",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Display the order book of the pair as a table of prices and the amounts of
+buy and sell orders, aggregating user orders and pool orders at each price.
+Sell ticks are displayed above the base price and buy ticks below it.
+The --depth flag limits the number of ticks displayed on each buy/sell side.
+With the --stream flag, the order book is displayed again whenever a new block is committed.
+
+Example:
+$ %s query %s order-book 1
+$ %s query %s order-book 1 --depth=10 --stream
+`,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pairId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("parse pair id: %w", err)
+			}
+
+			depth, _ := cmd.Flags().GetUint32(FlagDepth)
+			stream, _ := cmd.Flags().GetBool(FlagStream)
+
+			queryOrderBook := func(clientCtx client.Context) error {
+				queryClient := types.NewQueryClient(clientCtx)
+
+				res, err := queryClient.OrderBooks(
+					cmd.Context(),
+					&types.QueryOrderBooksRequest{
+						PairIds:         []uint64{pairId},
+						PriceUnitPowers: []uint32{0},
+						NumTicks:        depth,
+					})
+				if err != nil {
+					return err
+				}
+
+				if clientCtx.OutputFormat == "json" {
+					return clientCtx.PrintProto(&res.Pairs[0])
+				}
+				return printOrderBookTable(cmd.OutOrStdout(), res.Pairs[0], clientCtx.Height)
+			}
+
+			if !stream {
+				return queryOrderBook(clientCtx)
+			}
+
+			ctx := cmd.Context()
+			if ctx == nil {
+				ctx = context.Background()
+			}
+			var lastHeight int64
+			for {
+				height, err := rpc.GetChainHeight(clientCtx)
+				if err != nil {
+					return err
+				}
+				if height > lastHeight {
+					if err := queryOrderBook(clientCtx.WithHeight(height)); err != nil {
+						return err
+					}
+					lastHeight = height
+				}
+
+				select {
+				case <-ctx.Done():
+					return nil
+				case <-time.After(orderBookStreamInterval):
+				}
+			}
+		},
+	}
+
+	cmd.Flags().Uint32(FlagDepth, 20, "maximum number of ticks displayed on each buy/sell side")
+	cmd.Flags().Bool(FlagStream, false, "display the order book again on every new block until interrupted")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewExportOrderBookCmd implements the export-order-book query command.
+func NewExportOrderBookCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-order-book [pair-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Export the complete order book of the pair as a snapshot",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Export the complete order book of the pair, which consists of the pair,
+its pools with their reserves and its matchable orders, as a JSON snapshot.
+The snapshot can be imported into a local testnet genesis using the import-order-book command.
+
+Example:
+$ %s query %s export-order-book 1 > order-book.json
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pairId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pairRes, err := queryClient.Pair(cmd.Context(), &types.QueryPairRequest{
+				PairId: pairId,
+			})
+			if err != nil {
+				return err
+			}
+
+			snapshot := types.OrderBookSnapshot{
+				Pair:   pairRes.Pair,
+				Pools:  []types.PoolSnapshot{},
+				Orders: []types.Order{},
+			}
+
+			pageReq := &sdkquery.PageRequest{}
+			for {
+				res, err := queryClient.Pools(cmd.Context(), &types.QueryPoolsRequest{
+					PairId:     pairId,
+					Pagination: pageReq,
+				})
+				if err != nil {
+					return err
+				}
+				for _, pool := range res.Pools {
+					snapshot.Pools = append(snapshot.Pools, types.PoolSnapshot{
+						Pool: types.Pool{
+							Type:                  pool.Type,
+							Id:                    pool.Id,
+							PairId:                pool.PairId,
+							Creator:               pool.Creator,
+							ReserveAddress:        pool.ReserveAddress,
+							PoolCoinDenom:         pool.PoolCoinDenom,
+							MinPrice:              pool.MinPrice,
+							MaxPrice:              pool.MaxPrice,
+							LastDepositRequestId:  pool.LastDepositRequestId,
+							LastWithdrawRequestId: pool.LastWithdrawRequestId,
+							Disabled:              pool.Disabled,
+						},
+						Reserves:       sdk.NewCoins(pool.Balances.BaseCoin, pool.Balances.QuoteCoin),
+						PoolCoinSupply: pool.PoolCoinSupply,
+					})
+				}
+				if len(res.Pagination.GetNextKey()) == 0 {
+					break
+				}
+				pageReq = &sdkquery.PageRequest{Key: res.Pagination.NextKey}
+			}
+
+			pageReq = &sdkquery.PageRequest{}
+			for {
+				res, err := queryClient.Orders(cmd.Context(), &types.QueryOrdersRequest{
+					PairId:     pairId,
+					Pagination: pageReq,
+				})
+				if err != nil {
+					return err
+				}
+				for _, order := range res.Orders {
+					if order.Status.IsMatchable() {
+						snapshot.Orders = append(snapshot.Orders, order)
+					}
+				}
+				if len(res.Pagination.GetNextKey()) == 0 {
+					break
+				}
+				pageReq = &sdkquery.PageRequest{Key: res.Pagination.NextKey}
+			}
+
+			bz, err := json.MarshalIndent(snapshot, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintBytes(bz)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewQueryEscrowBalanceDiffsCmd implements the escrow balance diffs query command.
+func NewQueryEscrowBalanceDiffsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "escrow-balance-diffs",
+		Args:  cobra.NoArgs,
+		Short: "Reconcile escrow balances against state records",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Reconcile the balances of the global escrow and pair escrow accounts against
+the amounts expected from pending deposit/withdraw requests and open orders.
+Each denom whose balance diverges from the expected amount is printed.
+An empty result means that all escrow accounts are in balance.
+
+Example:
+$ %s query %s escrow-balance-diffs
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.EscrowBalanceDiffs(cmd.Context(), &types.QueryEscrowBalanceDiffsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewQueryDustCmd implements the dust query command.
+func NewQueryDustCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dust",
+		Args:  cobra.NoArgs,
+		Short: "Query the dust held in pair escrows and disabled pools",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the dust left by decimal truncation, which is held in pair escrows in excess
+of the remaining offer coins of open orders and in the reserves of disabled pools.
+The dust is swept to the dust collector at every DustSweepEpoch blocks.
+
+Example:
+$ %s query %s dust
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Dust(cmd.Context(), &types.QueryDustRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewQueryPoolOrdersCmd implements the pool orders query command.
+func NewQueryPoolOrdersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pool-orders [pair-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the orders the pools of the pair place in the next batch",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the buy and sell orders each pool of the pair places in the next batch,
+given the current pool reserves and the pair's last price.
+The orders are bounded by the price limits of the next batch.
+
+Example:
+$ %s query %s pool-orders 1
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pairId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PoolOrders(cmd.Context(), &types.QueryPoolOrdersRequest{
+				PairId: pairId,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewQuerySimulateBatchCmd implements the simulate batch query command.
+func NewQuerySimulateBatchCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-batch [pair-id] [direction] [amount]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Simulate the next batch of the pair with a hypothetical order",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Simulate the next batch of the pair with a hypothetical order added and
+query the estimated match price and the matching result of the order.
+The order is simulated as a market order unless the price is given with the --price flag.
+The direction is either buy or sell, and the amount is the base coin amount of the order.
+
+Example:
+$ %s query %s simulate-batch 1 buy 1000000
+$ %s query %s simulate-batch 1 sell 1000000 --price=1.05
+`,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pairId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			dir, err := parseOrderDirection(args[1])
+			if err != nil {
+				return err
+			}
+
+			amt, ok := sdk.NewIntFromString(args[2])
+			if !ok {
+				return fmt.Errorf("invalid amount: %s", args[2])
+			}
+
+			var price *sdk.Dec
+			priceStr, _ := cmd.Flags().GetString(FlagPrice)
+			if priceStr != "" {
+				p, err := sdk.NewDecFromStr(priceStr)
+				if err != nil {
+					return fmt.Errorf("invalid price: %w", err)
+				}
+				price = &p
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SimulateBatch(cmd.Context(), &types.QuerySimulateBatchRequest{
+				PairId:    pairId,
+				Direction: dir,
+				Price:     price,
+				Amount:    amt,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagPrice, "", "limit price of the order; the order is simulated as a market order if not set")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewQueryVaultsCmd implements the vaults query command.
+func NewQueryVaultsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vaults",
+		Args:  cobra.NoArgs,
+		Short: "Query for all vaults",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query for all existing vaults on a network.
+
+Example:
+$ %s query %s vaults
+$ %s query %s vaults --pair-id=1
+`,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			var pairId uint64
+
+			pairIdStr, _ := cmd.Flags().GetString(FlagPairId)
+			if pairIdStr != "" {
+				var err error
+				pairId, err = strconv.ParseUint(pairIdStr, 10, 64)
+				if err != nil {
+					return fmt.Errorf("parse pair id flag: %w", err)
+				}
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Vaults(cmd.Context(), &types.QueryVaultsRequest{
+				PairId:     pairId,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(flagSetVaults())
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "vaults")
+
+	return cmd
+}
+
+// NewQueryVaultCmd implements the vault query command.
+func NewQueryVaultCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vault [vault-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query details of the vault",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query details of the vault, including its balances, share supply and share value.
+
+Example:
+$ %s query %s vault 1
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			vaultId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("parse vault id: %w", err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Vault(cmd.Context(), &types.QueryVaultRequest{
+				VaultId: vaultId,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewQueryRequestResultCmd implements the request result query command.
+func NewQueryRequestResultCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "request-result [request-type] [target-id] [id]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Query the result of a finished request or order",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the result of a finished deposit request, withdraw request or order.
+The result is kept after the request or the order is deleted, until it is pruned.
+
+Example:
+$ %s query %s request-result deposit 1 1
+$ %s query %s request-result order 1 1
+
+[request-type]: request type (one of: deposit,withdraw,order)
+[target-id]: pool id for deposit and withdraw requests, or pair id for orders
+[id]: request id or order id
+`,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			typ, err := parseRequestType(args[0])
+			if err != nil {
+				return fmt.Errorf("parse request type: %w", err)
+			}
+
+			targetId, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("parse target id: %w", err)
+			}
+
+			id, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("parse id: %w", err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.RequestResult(cmd.Context(), &types.QueryRequestResultRequest{
+				Type:     typ,
+				TargetId: targetId,
+				Id:       id,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewQueryBatchResultCmd implements the batch result query command.
+func NewQueryBatchResultCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batch-result [pair-id] [batch-id]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the matching result of a batch of the pair",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the summary of the matching executed in a batch of the pair:
+the match price, the matched base and quote coin amounts and the number of
+user orders fully and partially matched.
+The result is kept until it is pruned after the request result retention.
+
+Example:
+$ %s query %s batch-result 1 100
+
+[pair-id]: pair id
+[batch-id]: batch id
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pairId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("parse pair id: %w", err)
+			}
+
+			batchId, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("parse batch id: %w", err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.BatchResult(cmd.Context(), &types.QueryBatchResultRequest{
+				PairId:  pairId,
+				BatchId: batchId,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewQueryPoolCoinValueCmd implements the pool coin value query command.
+func NewQueryPoolCoinValueCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pool-coin-value [pool-id] [pool-coin-amount]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the value of the pool coin amount",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the underlying coins redeemable for the pool coin amount at the current reserves,
+and the price of the pool coin in the quote coin of the pair.
+The price is derived from the pool's reserves valued at the last price of the pair.
+
+Example:
+$ %s query %s pool-coin-value 1 1000000000000
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			poolId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("parse pool id: %w", err)
+			}
+
+			poolCoinAmt, ok := sdk.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("invalid pool coin amount: %s", args[1])
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.PoolCoinValue(cmd.Context(), &types.QueryPoolCoinValueRequest{
+				PoolId:         poolId,
+				PoolCoinAmount: poolCoinAmt,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewQueryTicksCmd implements the ticks query command.
+func NewQueryTicksCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ticks [pair-id] [price]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the ticks around the price",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the ticks around the price under the current tick precision.
+The result includes the nearest ticks down and up from the price, which are the prices
+of buy and sell limit orders placed at the price, the tick gap at the price level,
+the price rounded to the nearest tick and the range of order prices accepted for the pair.
+
+Example:
+$ %s query %s ticks 1 1.23456
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pairId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("parse pair id: %w", err)
+			}
+
+			price, err := sdk.NewDecFromStr(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid price: %w", err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Ticks(cmd.Context(), &types.QueryTicksRequest{
+				PairId: pairId,
+				Price:  price,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewQueryModuleAccountsCmd implements the module accounts query command.
+func NewQueryModuleAccountsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "module-accounts",
+		Args:  cobra.NoArgs,
+		Short: "Query the accounts managed by the module",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the module account and the accounts derived by the module, such as
+the global escrow, pair escrows and pool reserves, with their purposes, balances
+and whether sending coins to them is blocked by the bank module.
+
+Example:
+$ %s query %s module-accounts
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ModuleAccounts(cmd.Context(), &types.QueryModuleAccountsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewQueryNumActiveOrdersCmd implements the num active orders query command.
+func NewQueryNumActiveOrdersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "num-active-orders [pair-id] [orderer]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the number of active orders of an orderer in a pair",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the number of active orders of an orderer in a pair.
+Orders are active until they are completed, canceled or expired.
+The result also includes the maximum number of active orders an orderer can have in a pair,
+where zero means there is no limit.
+
+Example:
+$ %s query %s num-active-orders 1 cre1...
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pairId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("parse pair id: %w", err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.NumActiveOrders(cmd.Context(), &types.QueryNumActiveOrdersRequest{
+				PairId:  pairId,
+				Orderer: args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
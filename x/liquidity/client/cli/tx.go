@@ -4,7 +4,10 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	gov "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -13,6 +16,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
 
+	"github.com/crescent-network/crescent/v4/x/liquidity/amm"
 	"github.com/crescent-network/crescent/v4/x/liquidity/types"
 )
 
@@ -31,13 +35,26 @@ func GetTxCmd() *cobra.Command {
 		NewCreatePoolCmd(),
 		NewCreateRangedPoolCmd(),
 		NewDepositCmd(),
+		NewDepositSingleAssetCmd(),
 		NewWithdrawCmd(),
 		NewLimitOrderCmd(),
+		NewPlaceOrdersCmd(),
 		NewMarketOrderCmd(),
+		NewSwapExactInCmd(),
 		NewMMOrderCmd(),
 		NewCancelOrderCmd(),
 		NewCancelAllOrdersCmd(),
 		NewCancelMMOrderCmd(),
+		NewPruneExpiredCmd(),
+		NewSetPairMetadataCmd(),
+		NewCreateVaultCmd(),
+		NewDepositVaultCmd(),
+		NewWithdrawVaultCmd(),
+		NewRebalanceVaultCmd(),
+		NewRenewOrderCmd(),
+		NewCommitOrderCmd(),
+		NewRevealOrderCmd(),
+		NewAmendOrderCmd(),
 	)
 
 	return cmd
@@ -190,8 +207,12 @@ func NewDepositCmd() *cobra.Command {
 
 Example:
 $ %s tx %s deposit 1 1000000000uatom,50000000000stake --from mykey
+$ %s tx %s deposit 1 1000000000uatom,50000000000stake --farm --from mykey
+
+[farm]: farm the minted pool coin in the lpfarm module after the deposit request is executed
 `,
 				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -211,6 +232,52 @@ $ %s tx %s deposit 1 1000000000uatom,50000000000stake --from mykey
 			}
 
 			msg := types.NewMsgDeposit(clientCtx.GetFromAddress(), poolId, depositCoins)
+			msg.Farm, _ = cmd.Flags().GetBool(FlagFarm)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagFarm, false, "Farm the minted pool coin in the lpfarm module")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewDepositSingleAssetCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deposit-single-asset [pool-id] [deposit-coin]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Deposit only one of the pair's coins to a basic liquidity pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Deposit only one of the pair's coins to a basic liquidity pool.
+Pool coins are minted as if a half of the deposit coin is swapped in the pool
+and then deposited with the other coin, and the swap fee rate is applied to the
+swapped half.
+
+Example:
+$ %s tx %s deposit-single-asset 1 1000000000uatom --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			poolId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid pool id: %w", err)
+			}
+
+			depositCoin, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid deposit coin: %w", err)
+			}
+
+			msg := types.NewMsgDepositSingleAsset(clientCtx.GetFromAddress(), poolId, depositCoin)
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
@@ -279,6 +346,7 @@ $ %s tx %s limit-order 1 buy 5000stake uatom 0.5 10000 --from mykey
 $ %s tx %s limit-order 1 b 5000stake uatom 0.5 10000 --from mykey
 $ %s tx %s limit-order 1 sell 10000uatom stake 2.0 10000 --order-lifespan=10m --from mykey
 $ %s tx %s limit-order 1 s 10000uatom stake 2.0 10000 --order-lifespan=10m --from mykey
+$ %s tx %s limit-order 1 b 5000stake uatom 0.5 10000 --min-fill-amount=1000 --from mykey
 
 [pair-id]: pair id to swap with
 [direction]: order direction (one of: buy,b,sell,s)
@@ -291,6 +359,7 @@ $ %s tx %s limit-order 1 s 10000uatom stake 2.0 10000 --order-lifespan=10m --fro
 				version.AppName, types.ModuleName,
 				version.AppName, types.ModuleName,
 				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -299,50 +368,111 @@ $ %s tx %s limit-order 1 s 10000uatom stake 2.0 10000 --order-lifespan=10m --fro
 				return err
 			}
 
-			pairId, err := strconv.ParseUint(args[0], 10, 64)
+			msg, err := parseMsgLimitOrder(cmd, clientCtx.GetFromAddress(), args)
 			if err != nil {
-				return fmt.Errorf("parse pair id: %w", err)
+				return err
 			}
 
-			dir, err := parseOrderDirection(args[1])
-			if err != nil {
-				return fmt.Errorf("parse order direction: %w", err)
-			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
 
-			offerCoin, err := sdk.ParseCoinNormalized(args[2])
-			if err != nil {
-				return fmt.Errorf("invalid offer coin: %w", err)
-			}
+	cmd.Flags().AddFlagSet(flagSetOrder())
+	cmd.Flags().String(FlagMinFillAmount, "", "Minimum amount of base coin to be filled at once; smaller fills are skipped unless the order is filled with all its remaining amount")
+	flags.AddTxFlagsToCmd(cmd)
 
-			demandCoinDenom := args[3]
-			if err := sdk.ValidateDenom(demandCoinDenom); err != nil {
-				return fmt.Errorf("invalid demand coin denom: %w", err)
-			}
+	return cmd
+}
+
+// NewPlaceOrdersCmd implements the place orders command handler.
+func NewPlaceOrdersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "place-orders [orders-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Make multiple limit orders in a single transaction",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Make multiple limit orders read from a JSON or CSV file in a single transaction.
+The orders are placed atomically; if any of them fails, none of them is placed.
+The file format is determined by its extension(.json or .csv).
+Prices must be on ticks of the tick precision, which is checked before building the transaction
+unless the --offline flag is set.
 
-			price, err := sdk.NewDecFromStr(args[4])
+Example:
+$ %s tx %s place-orders orders.json --from mykey
+$ %s tx %s place-orders orders.csv --order-lifespan=10m --from mykey
+
+Where orders.json contains:
+
+[
+  {
+    "pair_id": "1",
+    "direction": "buy",
+    "offer_coin": "5000stake",
+    "demand_coin_denom": "uatom",
+    "price": "0.5",
+    "amount": "10000"
+  },
+  {
+    "pair_id": "1",
+    "direction": "sell",
+    "offer_coin": "10000uatom",
+    "demand_coin_denom": "stake",
+    "price": "2.0",
+    "amount": "10000",
+    "order_lifespan": "1h",
+    "min_fill_amount": "1000"
+  }
+]
+
+And orders.csv contains:
+
+pair_id,direction,offer_coin,demand_coin_denom,price,amount,order_lifespan,min_fill_amount
+1,buy,5000stake,uatom,0.5,10000,,
+1,sell,10000uatom,stake,2.0,10000,1h,1000
+
+The order_lifespan and min_fill_amount fields are optional.
+The --order-lifespan flag is used for orders without order_lifespan.
+`,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
-				return fmt.Errorf("invalid price: %w", err)
+				return err
 			}
 
-			amt, ok := sdk.NewIntFromString(args[5])
-			if !ok {
-				return fmt.Errorf("invalid amount: %s", args[5])
+			entries, err := ParseOrdersFile(args[0])
+			if err != nil {
+				return err
 			}
 
 			orderLifespan, _ := cmd.Flags().GetDuration(FlagOrderLifespan)
 
-			msg := types.NewMsgLimitOrder(
-				clientCtx.GetFromAddress(),
-				pairId,
-				dir,
-				offerCoin,
-				demandCoinDenom,
-				price,
-				amt,
-				orderLifespan,
-			)
+			var tickPrec int
+			if !clientCtx.Offline {
+				queryClient := types.NewQueryClient(clientCtx)
+				res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+				if err != nil {
+					return err
+				}
+				tickPrec = int(res.Params.TickPrecision)
+			}
 
-			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+			var msgs []sdk.Msg
+			for i, entry := range entries {
+				msg, err := entry.MsgLimitOrder(clientCtx.GetFromAddress(), orderLifespan)
+				if err != nil {
+					return fmt.Errorf("order %d: %w", i+1, err)
+				}
+				if !clientCtx.Offline && !amm.PriceToDownTick(msg.Price, tickPrec).Equal(msg.Price) {
+					return fmt.Errorf("order %d: price %s is not on ticks", i+1, msg.Price)
+				}
+				msgs = append(msgs, msg)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msgs...)
 		},
 	}
 
@@ -431,6 +561,74 @@ $ %s tx %s market-order 1 s 10000uatom stake 10000 --order-lifespan=10m --from m
 	return cmd
 }
 
+// NewSwapExactInCmd implements the swap exact in command handler.
+func NewSwapExactInCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "swap-exact-in [pair-ids] [offer-coin] [min-out-coin]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Swap an exact amount of coin through a route of pairs",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Swap an exact amount of coin through a route of pairs.
+An order is placed in each pair of the route in turn, with the proceeds of the order in the previous pair.
+The order in the last pair is not matched at a price which yields less than the min out coin.
+
+Example:
+$ %s tx %s swap-exact-in 1,2 10000uatom 9000uusd --from mykey
+$ %s tx %s swap-exact-in 1,2,3 10000uatom 9000uusd --order-lifespan=10m --from mykey
+
+[pair-ids]: comma separated ids of the pairs to swap through, in order
+[offer-coin]: the amount of offer coin to swap
+[min-out-coin]: the minimum amount of coin to receive from the last pair
+`,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var pairIds []uint64
+			for _, pairIdStr := range strings.Split(args[0], ",") {
+				pairId, err := strconv.ParseUint(pairIdStr, 10, 64)
+				if err != nil {
+					return fmt.Errorf("parse pair id: %w", err)
+				}
+				pairIds = append(pairIds, pairId)
+			}
+
+			offerCoin, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid offer coin: %w", err)
+			}
+
+			minOutCoin, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return fmt.Errorf("invalid min out coin: %w", err)
+			}
+
+			orderLifespan, _ := cmd.Flags().GetDuration(FlagOrderLifespan)
+
+			msg := types.NewMsgSwapExactIn(
+				clientCtx.GetFromAddress(),
+				pairIds,
+				offerCoin,
+				minOutCoin,
+				orderLifespan,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(flagSetOrder())
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func NewMMOrderCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "mm-order [pair-id] [max-sell-price] [min-sell-price] [sell-amount] [max-buy-price] [min-buy-price] [buy-amount]",
@@ -453,10 +651,14 @@ $ %s tx %s mm-order 1 102 101 10000 0 0 0 --from mykey
 [max-buy-price]: maximum price of buy orders
 [min-buy-price]: minimum price of buy orders
 [buy-amount]: the total amount of buy orders
+
+The orders can be automatically canceled after a number of blocks with --%s,
+unless they are refreshed by another market making order in the meantime.
 `,
 				version.AppName, types.ModuleName,
 				version.AppName, types.ModuleName,
 				version.AppName, types.ModuleName,
+				FlagAutoCancelAfterBlocks,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -509,12 +711,14 @@ $ %s tx %s mm-order 1 102 101 10000 0 0 0 --from mykey
 				maxBuyPrice, minBuyPrice, buyAmt,
 				orderLifespan,
 			)
+			msg.AutoCancelAfterBlocks, _ = cmd.Flags().GetUint32(FlagAutoCancelAfterBlocks)
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
 	cmd.Flags().AddFlagSet(flagSetOrder())
+	cmd.Flags().Uint32(FlagAutoCancelAfterBlocks, 0, "Number of blocks after which the orders are canceled unless refreshed; 0 disables it")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -643,3 +847,939 @@ $ %s tx %s cancel-mm-order 1 --from mykey
 
 	return cmd
 }
+
+func NewPruneExpiredCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prune-expired",
+		Args:  cobra.NoArgs,
+		Short: "Prune expired or finished requests and orders",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Prune expired or finished requests and orders.
+The number of pruned entries is limited by the max_num_pruned_entries_per_msg param,
+and the pruner is rewarded from the prune reward pool for each pruned entry.
+
+Example:
+$ %s tx %s prune-expired --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgPruneExpired(clientCtx.GetFromAddress())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewSetPairMetadataCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-pair-metadata [pair-id] [display-name] [base-coin-decimals] [quote-coin-decimals]",
+		Args:  cobra.ExactArgs(4),
+		Short: "Attach the immutable metadata to a pair",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Attach the immutable metadata to a pair.
+Only the creator of the pair can attach the metadata, and it cannot be changed once attached.
+The hex-encoded SHA-256 hash of the pair's logo can be given by the --%s flag.
+
+Example:
+$ %s tx %s set-pair-metadata 1 ATOM/CRE 6 6 --%s=<sha256_hex> --from mykey
+`,
+				FlagLogoURIHash, version.AppName, types.ModuleName, FlagLogoURIHash,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pairId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("parse pair id: %w", err)
+			}
+
+			baseCoinDecimals, err := strconv.ParseUint(args[2], 10, 32)
+			if err != nil {
+				return fmt.Errorf("parse base coin decimals: %w", err)
+			}
+
+			quoteCoinDecimals, err := strconv.ParseUint(args[3], 10, 32)
+			if err != nil {
+				return fmt.Errorf("parse quote coin decimals: %w", err)
+			}
+
+			logoURIHash, err := cmd.Flags().GetString(FlagLogoURIHash)
+			if err != nil {
+				return err
+			}
+
+			metadata := types.NewPairMetadata(args[1], logoURIHash, uint32(baseCoinDecimals), uint32(quoteCoinDecimals))
+			msg := types.NewMsgSetPairMetadata(clientCtx.GetFromAddress(), pairId, metadata)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagLogoURIHash, "", "The hex-encoded SHA-256 hash of the pair's logo")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewCreateVaultCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-vault [pair-id] [max-price-deviation] [operator-fee-rate]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Create a vault operated by the sender",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Create a vault operated by the sender.
+Users deposit the pair's coins to the vault, and the operator sets the vault's price range
+within [last price * (1 - max-price-deviation), last price * (1 + max-price-deviation)].
+The operator receives the operator-fee-rate portion of the vault's profit as vault shares.
+
+Example:
+$ %s tx %s create-vault 1 0.02 0.1 --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pairId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("parse pair id: %w", err)
+			}
+
+			maxPriceDeviation, err := sdk.NewDecFromStr(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid max price deviation: %w", err)
+			}
+
+			operatorFeeRate, err := sdk.NewDecFromStr(args[2])
+			if err != nil {
+				return fmt.Errorf("invalid operator fee rate: %w", err)
+			}
+
+			msg := types.NewMsgCreateVault(clientCtx.GetFromAddress(), pairId, maxPriceDeviation, operatorFeeRate)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewDepositVaultCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deposit-vault [vault-id] [deposit-coins]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Deposit coins to a vault",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Deposit coins to a vault.
+Coins are accepted in proportion to the vault's balances and the rest is not taken.
+
+Example:
+$ %s tx %s deposit-vault 1 1000000000uatom,50000000000stake --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			vaultId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid vault id: %w", err)
+			}
+
+			depositCoins, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid deposit coins: %w", err)
+			}
+
+			msg := types.NewMsgDepositVault(clientCtx.GetFromAddress(), vaultId, depositCoins)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewWithdrawVaultCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-vault [vault-id] [share]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Withdraw coins from a vault by burning vault shares",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Withdraw coins from a vault by burning vault shares.
+
+Example:
+$ %s tx %s withdraw-vault 1 10000vault1 --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			vaultId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid vault id: %w", err)
+			}
+
+			share, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid share: %w", err)
+			}
+
+			msg := types.NewMsgWithdrawVault(clientCtx.GetFromAddress(), vaultId, share)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewRebalanceVaultCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rebalance-vault [vault-id] [min-price] [max-price]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Set the price range of a vault",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Set the price range of a vault.
+Only the operator of the vault can rebalance it, and the range must contain the pair's last price
+within the vault's max price deviation.
+
+Example:
+$ %s tx %s rebalance-vault 1 0.99 1.01 --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			vaultId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid vault id: %w", err)
+			}
+
+			minPrice, err := sdk.NewDecFromStr(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid min price: %w", err)
+			}
+
+			maxPrice, err := sdk.NewDecFromStr(args[2])
+			if err != nil {
+				return fmt.Errorf("invalid max price: %w", err)
+			}
+
+			msg := types.NewMsgRebalanceVault(clientCtx.GetFromAddress(), vaultId, minPrice, maxPrice)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewRenewOrderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "renew-order [pair-id] [order-id] [order-lifespan]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Extend the expiration of an open order",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Extend the expiration of an open order.
+The order expires after the new order lifespan from the current block time, which must be later
+than the order's current expiration and not longer than the max order lifespan.
+The order keeps its priority in matching.
+
+Example:
+$ %s tx %s renew-order 1 1 10m --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pairId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid pair id: %w", err)
+			}
+
+			orderId, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid order id: %w", err)
+			}
+
+			orderLifespan, err := time.ParseDuration(args[2])
+			if err != nil {
+				return fmt.Errorf("invalid order lifespan: %w", err)
+			}
+
+			msg := types.NewMsgRenewOrder(clientCtx.GetFromAddress(), pairId, orderId, orderLifespan)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewCommitOrderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "commit-order [pair-id] [direction] [offer-coin] [demand-coin-denom] [price] [amount] [salt]",
+		Args:  cobra.ExactArgs(7),
+		Short: "Commit to a limit order without revealing it",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Commit to a limit order without revealing it.
+Only the hash of the order and the salt is sent, and the order commit bond is escrowed.
+The order must be revealed by reveal-order with the same arguments and flags within
+the order reveal batches after the batch of the commit, otherwise the bond is forfeited.
+Keep the salt secret and hard to guess until the order is revealed.
+
+Example:
+$ %s tx %s commit-order 1 buy 5000stake uatom 0.5 10000 mysecretsalt --from mykey
+$ %s tx %s commit-order 1 s 10000uatom stake 2.0 10000 mysecretsalt --order-lifespan=10m --from mykey
+
+[pair-id]: pair id to swap with
+[direction]: order direction (one of: buy,b,sell,s)
+[offer-coin]: the amount of offer coin to swap
+[demand-coin-denom]: the denom to exchange with the offer coin
+[price]: the limit order price for the swap; the exchange ratio is X/Y where X is the amount of quote coin and Y is the amount of base coin
+[amount]: the amount of base coin to buy or sell
+[salt]: the salt hashed with the order
+`,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			order, err := parseMsgLimitOrder(cmd, clientCtx.GetFromAddress(), args[:6])
+			if err != nil {
+				return err
+			}
+			if err := order.ValidateBasic(); err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCommitOrder(
+				clientCtx.GetFromAddress(), order.PairId, types.OrderCommitHash(*order, args[6]))
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(flagSetOrder())
+	cmd.Flags().String(FlagMinFillAmount, "", "Minimum amount of base coin to be filled at once; smaller fills are skipped unless the order is filled with all its remaining amount")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewRevealOrderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reveal-order [pair-id] [direction] [offer-coin] [demand-coin-denom] [price] [amount] [salt]",
+		Args:  cobra.ExactArgs(7),
+		Short: "Reveal a committed limit order",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Reveal a limit order committed by commit-order and place it in the current batch.
+The arguments and flags must be the same as the ones the order was committed with.
+The order commit bond is refunded once the order is placed.
+
+Example:
+$ %s tx %s reveal-order 1 buy 5000stake uatom 0.5 10000 mysecretsalt --from mykey
+$ %s tx %s reveal-order 1 s 10000uatom stake 2.0 10000 mysecretsalt --order-lifespan=10m --from mykey
+
+[pair-id]: pair id to swap with
+[direction]: order direction (one of: buy,b,sell,s)
+[offer-coin]: the amount of offer coin to swap
+[demand-coin-denom]: the denom to exchange with the offer coin
+[price]: the limit order price for the swap; the exchange ratio is X/Y where X is the amount of quote coin and Y is the amount of base coin
+[amount]: the amount of base coin to buy or sell
+[salt]: the salt the order was committed with
+`,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			order, err := parseMsgLimitOrder(cmd, clientCtx.GetFromAddress(), args[:6])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevealOrder(*order, args[6])
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(flagSetOrder())
+	cmd.Flags().String(FlagMinFillAmount, "", "Minimum amount of base coin to be filled at once; smaller fills are skipped unless the order is filled with all its remaining amount")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdSubmitPoolMigrationProposal implements a command handler for submitting a pool migration proposal.
+func NewCmdSubmitPoolMigrationProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pool-migration [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a pool migration proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a pool migration proposal along with an initial deposit.
+The proposal migrates a basic pool to a new ranged pool of the same pair.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal pool-migration <path/to/proposal.json> --from=<key_or_address> --deposit=<deposit_amount>
+
+Where proposal.json contains:
+
+{
+  "title": "Pool Migration Proposal",
+  "description": "Let's migrate pool 1 to a ranged pool",
+  "pool_id": "1",
+  "min_price": "0.5",
+  "max_price": "2.0"
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(cli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			content, err := ParsePoolMigrationProposal(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			msg, err := gov.NewMsgSubmitProposal(&content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(cli.FlagDeposit, "", "deposit of proposal")
+
+	return cmd
+}
+
+// NewCmdSubmitPairMetadataProposal implements a command handler for submitting a pair metadata proposal.
+func NewCmdSubmitPairMetadataProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pair-metadata [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a pair metadata proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a pair metadata proposal along with an initial deposit.
+The proposal attaches the immutable metadata to a pair which has no metadata yet.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal pair-metadata <path/to/proposal.json> --from=<key_or_address> --deposit=<deposit_amount>
+
+Where proposal.json contains:
+
+{
+  "title": "Pair Metadata Proposal",
+  "description": "Let's attach the metadata to pair 1",
+  "pair_id": "1",
+  "metadata": {
+    "display_name": "ATOM/CRE",
+    "logo_uri_hash": "",
+    "base_coin_decimals": 6,
+    "quote_coin_decimals": 6
+  }
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(cli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			content, err := ParsePairMetadataProposal(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			msg, err := gov.NewMsgSubmitProposal(&content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(cli.FlagDeposit, "", "deposit of proposal")
+
+	return cmd
+}
+
+// NewCmdSubmitPairCircuitBreakerProposal implements a command handler for submitting a pair circuit breaker proposal.
+func NewCmdSubmitPairCircuitBreakerProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pair-circuit-breaker [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a pair circuit breaker proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a pair circuit breaker proposal along with an initial deposit.
+The proposal halts or resumes a pair. Orders can't be placed to a halted pair
+and its batches are not executed, while orders can still be canceled.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal pair-circuit-breaker <path/to/proposal.json> --from=<key_or_address> --deposit=<deposit_amount>
+
+Where proposal.json contains:
+
+{
+  "title": "Pair Circuit Breaker Proposal",
+  "description": "Let's halt pair 1 until the incident is resolved",
+  "pair_id": "1",
+  "halted": true
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(cli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			content, err := ParsePairCircuitBreakerProposal(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			msg, err := gov.NewMsgSubmitProposal(&content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(cli.FlagDeposit, "", "deposit of proposal")
+
+	return cmd
+}
+
+// NewCmdSubmitPairBatchWindowProposal implements a command handler for submitting a pair batch window proposal.
+func NewCmdSubmitPairBatchWindowProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pair-batch-window [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a pair batch window proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a pair batch window proposal along with an initial deposit.
+The proposal sets the number of batches in a pair's batch window.
+If it is greater than 1, the pair's orders are matched only once in each window,
+in a batch chosen by the block header hash, so that the batch boundaries can't
+be predicted. Setting it to 0 disables the batch window.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal pair-batch-window <path/to/proposal.json> --from=<key_or_address> --deposit=<deposit_amount>
+
+Where proposal.json contains:
+
+{
+  "title": "Pair Batch Window Proposal",
+  "description": "Let's match orders of pair 1 once in every 5 batches",
+  "pair_id": "1",
+  "batch_window": 5
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(cli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			content, err := ParsePairBatchWindowProposal(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			msg, err := gov.NewMsgSubmitProposal(&content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(cli.FlagDeposit, "", "deposit of proposal")
+
+	return cmd
+}
+
+// NewCmdSubmitPairOrderAmountLimitsProposal implements a command handler for submitting a pair order amount limits proposal.
+func NewCmdSubmitPairOrderAmountLimitsProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pair-order-amount-limits [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a pair order amount limits proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a pair order amount limits proposal along with an initial deposit.
+The proposal sets the minimum amounts of orders placed to a pair, in the base coin
+and in the quote coin, and the lot size which the amounts of orders must be
+multiples of. A zero value disables the limit.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal pair-order-amount-limits <path/to/proposal.json> --from=<key_or_address> --deposit=<deposit_amount>
+
+Where proposal.json contains:
+
+{
+  "title": "Pair Order Amount Limits Proposal",
+  "description": "Let's prevent dust orders in pair 1",
+  "pair_id": "1",
+  "limits": {
+    "min_base_order_amount": "1000000",
+    "min_quote_order_amount": "1000000",
+    "lot_size": "1000"
+  }
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(cli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			content, err := ParsePairOrderAmountLimitsProposal(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			msg, err := gov.NewMsgSubmitProposal(&content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(cli.FlagDeposit, "", "deposit of proposal")
+
+	return cmd
+}
+
+// NewCmdSubmitPairParamsProposal implements a command handler for submitting a pair params proposal.
+func NewCmdSubmitPairParamsProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pair-params [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a pair params proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a pair params proposal along with an initial deposit.
+The proposal overrides the upward and downward price limit ratios and the max
+order lifespan params for a pair. An omitted field removes the pair's override, so that the
+module's param is used for the pair.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal pair-params <path/to/proposal.json> --from=<key_or_address> --deposit=<deposit_amount>
+
+Where proposal.json contains:
+
+{
+  "title": "Pair Params Proposal",
+  "description": "Let's tighten the price limits of pair 1",
+  "pair_id": "1",
+  "upward_price_limit_ratio": "0.05",
+  "downward_price_limit_ratio": "0.03",
+  "max_order_lifespan": "86400s"
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(cli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			content, err := ParsePairParamsProposal(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			msg, err := gov.NewMsgSubmitProposal(&content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(cli.FlagDeposit, "", "deposit of proposal")
+
+	return cmd
+}
+
+// NewCmdSubmitPoolMaxReserveAmountProposal implements a command handler for submitting a pool max reserve amount proposal.
+func NewCmdSubmitPoolMaxReserveAmountProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pool-max-reserve-amount [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a pool max reserve amount proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a pool max reserve amount proposal along with an initial deposit.
+The proposal sets the caps of a pool's reserve for each denom, which limit the
+deposits to the pool. Deposits exceeding the caps are partially accepted, and
+refused if the reserve already reached the caps. An empty max reserve amount
+removes the pool's caps.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal pool-max-reserve-amount <path/to/proposal.json> --from=<key_or_address> --deposit=<deposit_amount>
+
+Where proposal.json contains:
+
+{
+  "title": "Pool Max Reserve Amount Proposal",
+  "description": "Let's cap the reserve of pool 1 during its launch",
+  "pool_id": "1",
+  "max_reserve_amount": [
+    {
+      "denom": "uatom",
+      "amount": "100000000000"
+    },
+    {
+      "denom": "ucre",
+      "amount": "1000000000000"
+    }
+  ]
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(cli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			content, err := ParsePoolMaxReserveAmountProposal(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			msg, err := gov.NewMsgSubmitProposal(&content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(cli.FlagDeposit, "", "deposit of proposal")
+
+	return cmd
+}
+
+func NewAmendOrderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "amend-order [pair-id] [order-id] [price] [amount]",
+		Args:  cobra.ExactArgs(4),
+		Short: "Amend the price and amount of an open limit order",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Amend the price and amount of an open limit order.
+The amount is the new amount of the order, which must not be smaller than the current amount.
+The difference of the offer coin needed for the amended order is escrowed from or refunded to the orderer.
+The order keeps its id and batch id.
+
+Example:
+$ %s tx %s amend-order 1 1 1.05 20000000 --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pairId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid pair id: %w", err)
+			}
+
+			orderId, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid order id: %w", err)
+			}
+
+			price, err := sdk.NewDecFromStr(args[2])
+			if err != nil {
+				return fmt.Errorf("invalid price: %w", err)
+			}
+
+			amt, ok := sdk.NewIntFromString(args[3])
+			if !ok {
+				return fmt.Errorf("invalid amount: %s", args[3])
+			}
+
+			msg := types.NewMsgAmendOrder(clientCtx.GetFromAddress(), pairId, orderId, price, amt)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -1,12 +1,137 @@
 package cli
 
 import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
 	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
 	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
 
 	"github.com/crescent-network/crescent/v4/x/liquidity/types"
 )
 
+// ParsePoolMigrationProposal reads and parses a PoolMigrationProposal from a file.
+func ParsePoolMigrationProposal(cdc codec.JSONCodec, proposalFile string) (types.PoolMigrationProposal, error) {
+	proposal := types.PoolMigrationProposal{}
+
+	contents, err := os.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err = cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+// ParsePairMetadataProposal reads and parses a PairMetadataProposal from a file.
+func ParsePairMetadataProposal(cdc codec.JSONCodec, proposalFile string) (types.PairMetadataProposal, error) {
+	proposal := types.PairMetadataProposal{}
+
+	contents, err := os.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err = cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+// ParsePairCircuitBreakerProposal reads and parses a PairCircuitBreakerProposal from a file.
+func ParsePairCircuitBreakerProposal(cdc codec.JSONCodec, proposalFile string) (types.PairCircuitBreakerProposal, error) {
+	proposal := types.PairCircuitBreakerProposal{}
+
+	contents, err := os.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err = cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+// ParsePairBatchWindowProposal reads and parses a PairBatchWindowProposal from a file.
+func ParsePairBatchWindowProposal(cdc codec.JSONCodec, proposalFile string) (types.PairBatchWindowProposal, error) {
+	proposal := types.PairBatchWindowProposal{}
+
+	contents, err := os.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err = cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+// ParsePairOrderAmountLimitsProposal reads and parses a PairOrderAmountLimitsProposal from a file.
+func ParsePairOrderAmountLimitsProposal(cdc codec.JSONCodec, proposalFile string) (types.PairOrderAmountLimitsProposal, error) {
+	proposal := types.PairOrderAmountLimitsProposal{}
+
+	contents, err := os.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err = cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+// ParsePairParamsProposal reads and parses a PairParamsProposal from a file.
+func ParsePairParamsProposal(cdc codec.JSONCodec, proposalFile string) (types.PairParamsProposal, error) {
+	proposal := types.PairParamsProposal{}
+
+	contents, err := os.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err = cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+// ParsePoolMaxReserveAmountProposal reads and parses a PoolMaxReserveAmountProposal from a file.
+func ParsePoolMaxReserveAmountProposal(cdc codec.JSONCodec, proposalFile string) (types.PoolMaxReserveAmountProposal, error) {
+	proposal := types.PoolMaxReserveAmountProposal{}
+
+	contents, err := os.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err = cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
 // excConditions returns true when exactly one condition is true.
 func excConditions(conditions ...bool) bool {
 	cnt := 0
@@ -29,3 +154,264 @@ func parseOrderDirection(s string) (types.OrderDirection, error) {
 	}
 	return 0, fmt.Errorf("invalid order direction: %s", s)
 }
+
+// parseMsgLimitOrder parses a MsgLimitOrder from the arguments
+// [pair-id] [direction] [offer-coin] [demand-coin-denom] [price] [amount]
+// and the order flags of the command.
+func parseMsgLimitOrder(cmd *cobra.Command, orderer sdk.AccAddress, args []string) (*types.MsgLimitOrder, error) {
+	pairId, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("parse pair id: %w", err)
+	}
+
+	dir, err := parseOrderDirection(args[1])
+	if err != nil {
+		return nil, fmt.Errorf("parse order direction: %w", err)
+	}
+
+	offerCoin, err := sdk.ParseCoinNormalized(args[2])
+	if err != nil {
+		return nil, fmt.Errorf("invalid offer coin: %w", err)
+	}
+
+	demandCoinDenom := args[3]
+	if err := sdk.ValidateDenom(demandCoinDenom); err != nil {
+		return nil, fmt.Errorf("invalid demand coin denom: %w", err)
+	}
+
+	price, err := sdk.NewDecFromStr(args[4])
+	if err != nil {
+		return nil, fmt.Errorf("invalid price: %w", err)
+	}
+
+	amt, ok := sdk.NewIntFromString(args[5])
+	if !ok {
+		return nil, fmt.Errorf("invalid amount: %s", args[5])
+	}
+
+	orderLifespan, _ := cmd.Flags().GetDuration(FlagOrderLifespan)
+
+	msg := types.NewMsgLimitOrder(
+		orderer,
+		pairId,
+		dir,
+		offerCoin,
+		demandCoinDenom,
+		price,
+		amt,
+		orderLifespan,
+	)
+
+	minFillAmtStr, _ := cmd.Flags().GetString(FlagMinFillAmount)
+	if minFillAmtStr != "" {
+		minFillAmt, ok := sdk.NewIntFromString(minFillAmtStr)
+		if !ok {
+			return nil, fmt.Errorf("invalid min fill amount: %s", minFillAmtStr)
+		}
+		msg.MinFillAmount = &minFillAmt
+	}
+
+	return msg, nil
+}
+
+func parseRequestType(s string) (types.RequestType, error) {
+	switch strings.ToLower(s) {
+	case "deposit":
+		return types.RequestTypeDeposit, nil
+	case "withdraw":
+		return types.RequestTypeWithdraw, nil
+	case "order":
+		return types.RequestTypeOrder, nil
+	}
+	return 0, fmt.Errorf("invalid request type: %s", s)
+}
+
+// parseOrderStatus parses order status string and returns types.OrderStatus.
+func parseOrderStatus(s string) (types.OrderStatus, error) {
+	switch strings.ToLower(s) {
+	case "not-executed":
+		return types.OrderStatusNotExecuted, nil
+	case "not-matched":
+		return types.OrderStatusNotMatched, nil
+	case "partially-matched":
+		return types.OrderStatusPartiallyMatched, nil
+	case "completed":
+		return types.OrderStatusCompleted, nil
+	case "canceled":
+		return types.OrderStatusCanceled, nil
+	case "expired":
+		return types.OrderStatusExpired, nil
+	}
+	return 0, fmt.Errorf("invalid order status: %s", s)
+}
+
+// orderBookStreamInterval is the interval at which the order-book command
+// polls the latest block height when streaming.
+const orderBookStreamInterval = 500 * time.Millisecond
+
+// printOrderBookTable writes the order book of the pair in a table of prices
+// and the aggregated amounts of buy and sell orders.
+// Zero height means the latest height.
+func printOrderBookTable(w io.Writer, pair types.OrderBookPairResponse, height int64) error {
+	heightStr := "latest"
+	if height > 0 {
+		heightStr = strconv.FormatInt(height, 10)
+	}
+	if _, err := fmt.Fprintf(w, "pair %d at height %s, base price %s\n", pair.PairId, heightStr, pair.BasePrice); err != nil {
+		return err
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(tw, "PRICE\tBUY AMOUNT\tSELL AMOUNT\t")
+	for _, ob := range pair.OrderBooks {
+		for _, tick := range ob.Sells {
+			fmt.Fprintf(tw, "%s\t\t%s\t\n", tick.Price, tick.UserOrderAmount.Add(tick.PoolOrderAmount))
+		}
+		for _, tick := range ob.Buys {
+			fmt.Fprintf(tw, "%s\t%s\t\t\n", tick.Price, tick.UserOrderAmount.Add(tick.PoolOrderAmount))
+		}
+	}
+	return tw.Flush()
+}
+
+// OrderFileEntry is a limit order read from the file of the place-orders
+// command.
+type OrderFileEntry struct {
+	PairId          string `json:"pair_id"`
+	Direction       string `json:"direction"`
+	OfferCoin       string `json:"offer_coin"`
+	DemandCoinDenom string `json:"demand_coin_denom"`
+	Price           string `json:"price"`
+	Amount          string `json:"amount"`
+	OrderLifespan   string `json:"order_lifespan,omitempty"`
+	MinFillAmount   string `json:"min_fill_amount,omitempty"`
+}
+
+// MsgLimitOrder parses the entry and returns types.MsgLimitOrder.
+// The default order lifespan is used when the entry has no order lifespan.
+func (entry OrderFileEntry) MsgLimitOrder(orderer sdk.AccAddress, defaultOrderLifespan time.Duration) (*types.MsgLimitOrder, error) {
+	pairId, err := strconv.ParseUint(entry.PairId, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("parse pair id: %w", err)
+	}
+
+	dir, err := parseOrderDirection(entry.Direction)
+	if err != nil {
+		return nil, fmt.Errorf("parse order direction: %w", err)
+	}
+
+	offerCoin, err := sdk.ParseCoinNormalized(entry.OfferCoin)
+	if err != nil {
+		return nil, fmt.Errorf("invalid offer coin: %w", err)
+	}
+
+	if err := sdk.ValidateDenom(entry.DemandCoinDenom); err != nil {
+		return nil, fmt.Errorf("invalid demand coin denom: %w", err)
+	}
+
+	price, err := sdk.NewDecFromStr(entry.Price)
+	if err != nil {
+		return nil, fmt.Errorf("invalid price: %w", err)
+	}
+
+	amt, ok := sdk.NewIntFromString(entry.Amount)
+	if !ok {
+		return nil, fmt.Errorf("invalid amount: %s", entry.Amount)
+	}
+
+	orderLifespan := defaultOrderLifespan
+	if entry.OrderLifespan != "" {
+		orderLifespan, err = time.ParseDuration(entry.OrderLifespan)
+		if err != nil {
+			return nil, fmt.Errorf("invalid order lifespan: %w", err)
+		}
+	}
+
+	msg := types.NewMsgLimitOrder(
+		orderer, pairId, dir, offerCoin, entry.DemandCoinDenom, price, amt, orderLifespan)
+
+	if entry.MinFillAmount != "" {
+		minFillAmt, ok := sdk.NewIntFromString(entry.MinFillAmount)
+		if !ok {
+			return nil, fmt.Errorf("invalid min fill amount: %s", entry.MinFillAmount)
+		}
+		msg.MinFillAmount = &minFillAmt
+	}
+
+	return msg, nil
+}
+
+// ParseOrdersFile reads and parses limit orders from a JSON or CSV file,
+// depending on the file extension.
+// A CSV file must have a header row of the JSON field names of OrderFileEntry.
+func ParseOrdersFile(ordersFile string) ([]OrderFileEntry, error) {
+	contents, err := os.ReadFile(ordersFile)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []OrderFileEntry
+	switch ext := strings.ToLower(filepath.Ext(ordersFile)); ext {
+	case ".json":
+		if err := json.Unmarshal(contents, &entries); err != nil {
+			return nil, err
+		}
+	case ".csv":
+		entries, err = parseOrdersCSV(contents)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unsupported orders file extension: %q", ext)
+	}
+
+	if len(entries) == 0 {
+		return nil, fmt.Errorf("no orders in %s", ordersFile)
+	}
+
+	return entries, nil
+}
+
+// parseOrdersCSV parses limit orders from CSV contents with a header row.
+func parseOrdersCSV(contents []byte) ([]OrderFileEntry, error) {
+	r := csv.NewReader(bytes.NewReader(contents))
+	r.TrimLeadingSpace = true
+	records, err := r.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, nil
+	}
+
+	var entries []OrderFileEntry
+	header := records[0]
+	for _, record := range records[1:] {
+		var entry OrderFileEntry
+		for i, field := range header {
+			value := strings.TrimSpace(record[i])
+			switch strings.TrimSpace(field) {
+			case "pair_id":
+				entry.PairId = value
+			case "direction":
+				entry.Direction = value
+			case "offer_coin":
+				entry.OfferCoin = value
+			case "demand_coin_denom":
+				entry.DemandCoinDenom = value
+			case "price":
+				entry.Price = value
+			case "amount":
+				entry.Amount = value
+			case "order_lifespan":
+				entry.OrderLifespan = value
+			case "min_fill_amount":
+				entry.MinFillAmount = value
+			default:
+				return nil, fmt.Errorf("unknown orders file column: %q", field)
+			}
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
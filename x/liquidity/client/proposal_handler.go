@@ -0,0 +1,26 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+
+	"github.com/crescent-network/crescent/v4/x/liquidity/client/cli"
+	"github.com/crescent-network/crescent/v4/x/liquidity/client/rest"
+)
+
+// ProposalHandler is the pool migration command handler,
+// PairMetadataProposalHandler is the pair metadata command handler,
+// PairCircuitBreakerProposalHandler is the pair circuit breaker command handler,
+// PairBatchWindowProposalHandler is the pair batch window command handler,
+// PairOrderAmountLimitsProposalHandler is the pair order amount limits command handler,
+// PairParamsProposalHandler is the pair params command handler and
+// PoolMaxReserveAmountProposalHandler is the pool max reserve amount command handler.
+// Note that the REST handlers will be deprecated in the future.
+var (
+	ProposalHandler                      = govclient.NewProposalHandler(cli.NewCmdSubmitPoolMigrationProposal, rest.ProposalRESTHandler)
+	PairMetadataProposalHandler          = govclient.NewProposalHandler(cli.NewCmdSubmitPairMetadataProposal, rest.PairMetadataProposalRESTHandler)
+	PairCircuitBreakerProposalHandler    = govclient.NewProposalHandler(cli.NewCmdSubmitPairCircuitBreakerProposal, rest.PairCircuitBreakerProposalRESTHandler)
+	PairBatchWindowProposalHandler       = govclient.NewProposalHandler(cli.NewCmdSubmitPairBatchWindowProposal, rest.PairBatchWindowProposalRESTHandler)
+	PairOrderAmountLimitsProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitPairOrderAmountLimitsProposal, rest.PairOrderAmountLimitsProposalRESTHandler)
+	PairParamsProposalHandler            = govclient.NewProposalHandler(cli.NewCmdSubmitPairParamsProposal, rest.PairParamsProposalRESTHandler)
+	PoolMaxReserveAmountProposalHandler  = govclient.NewProposalHandler(cli.NewCmdSubmitPoolMaxReserveAmountProposal, rest.PoolMaxReserveAmountProposalRESTHandler)
+)
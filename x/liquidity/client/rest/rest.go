@@ -0,0 +1,62 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+)
+
+func ProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "pool_migration",
+		Handler:  postProposalHandlerFn(clientCtx),
+	}
+}
+
+func PairMetadataProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "pair_metadata",
+		Handler:  postProposalHandlerFn(clientCtx),
+	}
+}
+
+func PairCircuitBreakerProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "pair_circuit_breaker",
+		Handler:  postProposalHandlerFn(clientCtx),
+	}
+}
+
+func PairBatchWindowProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "pair_batch_window",
+		Handler:  postProposalHandlerFn(clientCtx),
+	}
+}
+
+func PairOrderAmountLimitsProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "pair_order_amount_limits",
+		Handler:  postProposalHandlerFn(clientCtx),
+	}
+}
+
+func PairParamsProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "pair_params",
+		Handler:  postProposalHandlerFn(clientCtx),
+	}
+}
+
+func PoolMaxReserveAmountProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "pool_max_reserve_amount",
+		Handler:  postProposalHandlerFn(clientCtx),
+	}
+}
+
+func postProposalHandlerFn(_ client.Context) http.HandlerFunc {
+	return func(_ http.ResponseWriter, _ *http.Request) {
+	}
+}
@@ -2,6 +2,8 @@ package testutil
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -9,6 +11,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	store "github.com/cosmos/cosmos-sdk/store/types"
@@ -77,7 +80,7 @@ func (s *IntegrationTestSuite) SetupSuite() {
 	s.createPool(1, sdk.NewCoins(sdk.NewInt64Coin(s.denom1, 10000000), sdk.NewInt64Coin(s.denom2, 10000000)))
 	s.limitOrder(
 		1, types.OrderDirectionSell, utils.ParseCoin("1000000node0token"), s.cfg.BondDenom,
-		utils.ParseDec("1.0"), sdk.NewInt(1000000), time.Minute)
+		utils.ParseDec("0.99"), sdk.NewInt(1000000), time.Minute)
 }
 
 func (s *IntegrationTestSuite) TearDownSuite() {
@@ -239,3 +242,144 @@ func (s *IntegrationTestSuite) TestQueryOrdersCmd() {
 		})
 	}
 }
+
+func (s *IntegrationTestSuite) TestQueryOrderBookCmd() {
+	val := s.network.Validators[0]
+
+	for _, tc := range []struct {
+		name        string
+		args        []string
+		expectedErr string
+		postRun     func(resp types.OrderBookPairResponse)
+	}{
+		{
+			"happy case",
+			[]string{
+				"1",
+				fmt.Sprintf("--%s=%d", cli.FlagDepth, 5),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			"",
+			func(resp types.OrderBookPairResponse) {
+				s.Require().Equal(uint64(1), resp.PairId)
+				s.Require().Len(resp.OrderBooks, 1)
+				s.Require().LessOrEqual(len(resp.OrderBooks[0].Sells), 5)
+				s.Require().LessOrEqual(len(resp.OrderBooks[0].Buys), 5)
+				s.Require().NotEmpty(resp.OrderBooks[0].Buys)
+			},
+		},
+		{
+			"invalid pair id",
+			[]string{"a", fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+			"parse pair id: strconv.ParseUint: parsing \"a\": invalid syntax",
+			nil,
+		},
+	} {
+		s.Run(tc.name, func() {
+			cmd := cli.NewQueryOrderBookCmd()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, tc.args)
+			if tc.expectedErr == "" {
+				s.Require().NoError(err)
+				var resp types.OrderBookPairResponse
+				s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &resp), out.String())
+				tc.postRun(resp)
+			} else {
+				s.Require().EqualError(err, tc.expectedErr)
+			}
+		})
+	}
+
+	out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cli.NewQueryOrderBookCmd(), []string{"1"})
+	s.Require().NoError(err)
+	s.Require().Contains(out.String(), "pair 1 at height latest")
+	s.Require().Regexp(`PRICE\s+BUY AMOUNT\s+SELL AMOUNT`, out.String())
+}
+
+func (s *IntegrationTestSuite) TestPlaceOrdersCmd() {
+	val := s.network.Validators[0]
+	dir := s.T().TempDir()
+
+	// The generated tx is encoded with the app's encoding config, since
+	// the network's default config doesn't register the liquidity messages.
+	encCfg := chain.MakeTestEncodingConfig()
+	clientCtx := val.ClientCtx.
+		WithCodec(encCfg.Marshaler).
+		WithInterfaceRegistry(encCfg.InterfaceRegistry).
+		WithTxConfig(encCfg.TxConfig).
+		WithLegacyAmino(encCfg.Amino)
+
+	writeFile := func(name, contents string) string {
+		path := filepath.Join(dir, name)
+		s.Require().NoError(os.WriteFile(path, []byte(contents), 0644))
+		return path
+	}
+
+	for _, tc := range []struct {
+		name        string
+		path        string
+		expectedErr string
+		numMsgs     int
+	}{
+		{
+			"json file",
+			writeFile("orders.json", fmt.Sprintf(`[
+  {"pair_id": "1", "direction": "buy", "offer_coin": "1000000%[2]s", "demand_coin_denom": "%[1]s", "price": "0.98", "amount": "1000000"},
+  {"pair_id": "1", "direction": "sell", "offer_coin": "1000000%[1]s", "demand_coin_denom": "%[2]s", "price": "1.02", "amount": "1000000", "order_lifespan": "1h", "min_fill_amount": "1000"}
+]`, s.denom1, s.denom2)),
+			"",
+			2,
+		},
+		{
+			"csv file",
+			writeFile("orders.csv", fmt.Sprintf(`pair_id,direction,offer_coin,demand_coin_denom,price,amount,order_lifespan,min_fill_amount
+1,buy,1000000%[2]s,%[1]s,0.98,1000000,,
+1,buy,1000000%[2]s,%[1]s,0.97,1000000,,
+1,sell,1000000%[1]s,%[2]s,1.02,1000000,1h,1000
+`, s.denom1, s.denom2)),
+			"",
+			3,
+		},
+		{
+			"price not on ticks",
+			writeFile("off-tick.csv", fmt.Sprintf(`pair_id,direction,offer_coin,demand_coin_denom,price,amount
+1,buy,1000000%[2]s,%[1]s,0.98,1000000
+1,buy,1000000%[2]s,%[1]s,0.987654,1000000
+`, s.denom1, s.denom2)),
+			"order 2: price 0.987654000000000000 is not on ticks",
+			0,
+		},
+		{
+			"invalid order",
+			writeFile("invalid.json", `[{"pair_id": "1", "direction": "up"}]`),
+			"order 1: parse order direction: invalid order direction: up",
+			0,
+		},
+		{
+			"unsupported file extension",
+			writeFile("orders.txt", ""),
+			"unsupported orders file extension: \".txt\"",
+			0,
+		},
+	} {
+		s.Run(tc.name, func() {
+			cmd := cli.NewPlaceOrdersCmd()
+			args := []string{
+				tc.path,
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagGenerateOnly),
+			}
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, args)
+			if tc.expectedErr == "" {
+				s.Require().NoError(err)
+				tx, err := encCfg.TxConfig.TxJSONDecoder()(out.Bytes())
+				s.Require().NoError(err, out.String())
+				s.Require().Len(tx.GetMsgs(), tc.numMsgs)
+				for _, msg := range tx.GetMsgs() {
+					s.Require().IsType(&types.MsgLimitOrder{}, msg)
+				}
+			} else {
+				s.Require().EqualError(err, tc.expectedErr)
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package liquidity
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/crescent-network/crescent/v4/x/liquidity/keeper"
 	"github.com/crescent-network/crescent/v4/x/liquidity/types"
@@ -28,6 +29,9 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgDeposit:
 			res, err := msgServer.Deposit(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgDepositSingleAsset:
+			res, err := msgServer.DepositSingleAsset(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		case *types.MsgWithdraw:
 			res, err := msgServer.Withdraw(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
@@ -37,6 +41,15 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgMarketOrder:
 			res, err := msgServer.MarketOrder(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgSwapExactIn:
+			res, err := msgServer.SwapExactIn(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgCommitOrder:
+			res, err := msgServer.CommitOrder(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgRevealOrder:
+			res, err := msgServer.RevealOrder(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		case *types.MsgMMOrder:
 			res, err := msgServer.MMOrder(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
@@ -49,8 +62,56 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgCancelMMOrder:
 			res, err := msgServer.CancelMMOrder(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgPruneExpired:
+			res, err := msgServer.PruneExpired(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgSetPairMetadata:
+			res, err := msgServer.SetPairMetadata(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgCreateVault:
+			res, err := msgServer.CreateVault(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgDepositVault:
+			res, err := msgServer.DepositVault(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgWithdrawVault:
+			res, err := msgServer.WithdrawVault(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgRebalanceVault:
+			res, err := msgServer.RebalanceVault(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgRenewOrder:
+			res, err := msgServer.RenewOrder(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgAmendOrder:
+			res, err := msgServer.AmendOrder(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", types.ModuleName, msg)
 		}
 	}
 }
+
+// NewProposalHandler creates a governance handler to manage new proposal types.
+func NewProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.PoolMigrationProposal:
+			return keeper.HandlePoolMigrationProposal(ctx, k, c)
+		case *types.PairMetadataProposal:
+			return keeper.HandlePairMetadataProposal(ctx, k, c)
+		case *types.PairCircuitBreakerProposal:
+			return keeper.HandlePairCircuitBreakerProposal(ctx, k, c)
+		case *types.PairBatchWindowProposal:
+			return keeper.HandlePairBatchWindowProposal(ctx, k, c)
+		case *types.PairOrderAmountLimitsProposal:
+			return keeper.HandlePairOrderAmountLimitsProposal(ctx, k, c)
+		case *types.PairParamsProposal:
+			return keeper.HandlePairParamsProposal(ctx, k, c)
+		case *types.PoolMaxReserveAmountProposal:
+			return keeper.HandlePoolMaxReserveAmountProposal(ctx, k, c)
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized liquidity proposal content type: %T", c)
+		}
+	}
+}
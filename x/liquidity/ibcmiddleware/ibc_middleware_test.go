@@ -0,0 +1,157 @@
+package ibcmiddleware_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v3/modules/core/exported"
+
+	chain "github.com/crescent-network/crescent/v4/app"
+	utils "github.com/crescent-network/crescent/v4/types"
+	"github.com/crescent-network/crescent/v4/x/liquidity/ibcmiddleware"
+	"github.com/crescent-network/crescent/v4/x/liquidity/types"
+)
+
+// mockTransferApp mocks the transfer module by minting the transferred coin
+// to the receiver.
+type mockTransferApp struct {
+	porttypes.IBCModule
+	app      *chain.App
+	received bool
+}
+
+func (m *mockTransferApp) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, _ sdk.AccAddress) ibcexported.Acknowledgement {
+	var data transfertypes.FungibleTokenPacketData
+	transfertypes.ModuleCdc.MustUnmarshalJSON(packet.GetData(), &data)
+	amt, _ := sdk.NewIntFromString(data.Amount)
+	coins := sdk.NewCoins(sdk.NewCoin(ibcmiddleware.ReceivedDenom(packet, data.Denom), amt))
+	if err := m.app.BankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+		panic(err)
+	}
+	receiver, _ := sdk.AccAddressFromBech32(data.Receiver)
+	if err := m.app.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiver, coins); err != nil {
+		panic(err)
+	}
+	m.received = true
+	return channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+}
+
+type MiddlewareTestSuite struct {
+	suite.Suite
+
+	app         *chain.App
+	ctx         sdk.Context
+	transferApp *mockTransferApp
+	middleware  ibcmiddleware.IBCMiddleware
+	atomDenom   string
+	pair        types.Pair
+}
+
+func TestMiddlewareTestSuite(t *testing.T) {
+	suite.Run(t, new(MiddlewareTestSuite))
+}
+
+func (s *MiddlewareTestSuite) SetupTest() {
+	s.app = chain.Setup(false)
+	hdr := tmproto.Header{
+		Height: 1,
+		Time:   utils.ParseTime("2022-01-01T00:00:00Z"),
+	}
+	s.app.BeginBlock(abci.RequestBeginBlock{Header: hdr})
+	s.ctx = s.app.BaseApp.NewContext(false, hdr)
+	s.transferApp = &mockTransferApp{app: s.app}
+	s.middleware = ibcmiddleware.NewIBCMiddleware(s.transferApp, s.app.LiquidityKeeper)
+
+	s.atomDenom = ibcmiddleware.ReceivedDenom(s.packet("uatom", "", ""), "uatom")
+	creator := utils.TestAddress(0)
+	s.fundAddr(creator, s.app.LiquidityKeeper.GetPairCreationFee(s.ctx))
+	pair, err := s.app.LiquidityKeeper.CreatePair(s.ctx, types.NewMsgCreatePair(creator, s.atomDenom, "ucre"))
+	s.Require().NoError(err)
+	depositCoins := sdk.NewCoins(sdk.NewInt64Coin(s.atomDenom, 1000000000), sdk.NewInt64Coin("ucre", 1000000000))
+	s.fundAddr(creator, depositCoins.Add(s.app.LiquidityKeeper.GetPoolCreationFee(s.ctx)...))
+	_, err = s.app.LiquidityKeeper.CreatePool(s.ctx, types.NewMsgCreatePool(creator, pair.Id, depositCoins))
+	s.Require().NoError(err)
+	pair.LastPrice = utils.ParseDecP("1.0")
+	s.app.LiquidityKeeper.SetPair(s.ctx, pair)
+	s.pair = pair
+}
+
+func (s *MiddlewareTestSuite) fundAddr(addr sdk.AccAddress, amt sdk.Coins) {
+	s.T().Helper()
+	s.Require().NoError(s.app.BankKeeper.MintCoins(s.ctx, types.ModuleName, amt))
+	s.Require().NoError(s.app.BankKeeper.SendCoinsFromModuleToAccount(s.ctx, types.ModuleName, addr, amt))
+}
+
+// packet returns a transfer packet of 1000000 coins of the denom from the
+// counterparty chain.
+func (s *MiddlewareTestSuite) packet(denom, receiver, memo string) channeltypes.Packet {
+	data := transfertypes.FungibleTokenPacketData{
+		Denom:    denom,
+		Amount:   "1000000",
+		Sender:   "cosmos1sender",
+		Receiver: receiver,
+		Memo:     memo,
+	}
+	return channeltypes.Packet{
+		Sequence:           1,
+		SourcePort:         "transfer",
+		SourceChannel:      "channel-0",
+		DestinationPort:    "transfer",
+		DestinationChannel: "channel-1",
+		Data:               data.GetBytes(),
+	}
+}
+
+func (s *MiddlewareTestSuite) TestOnRecvPacket_NoSwapMemo() {
+	receiver := utils.TestAddress(1)
+	ack := s.middleware.OnRecvPacket(s.ctx, s.packet("uatom", receiver.String(), "hello"), nil)
+	s.Require().True(ack.Success())
+	s.Require().True(s.transferApp.received)
+	s.Require().Empty(s.app.LiquidityKeeper.GetAllSwapRoutes(s.ctx))
+	s.Require().Equal(sdk.NewInt(1000000), s.app.BankKeeper.GetBalance(s.ctx, receiver, s.atomDenom).Amount)
+}
+
+func (s *MiddlewareTestSuite) TestOnRecvPacket_Swap() {
+	orderer := utils.TestAddress(1)
+	receiver := utils.TestAddress(2)
+	memo := `{"swap": {"pair_ids": [1], "min_out_coin": "900000ucre", "receiver": "` + receiver.String() + `"}}`
+	ack := s.middleware.OnRecvPacket(s.ctx, s.packet("uatom", orderer.String(), memo), nil)
+	s.Require().True(ack.Success())
+
+	routes := s.app.LiquidityKeeper.GetAllSwapRoutes(s.ctx)
+	s.Require().Len(routes, 1)
+	s.Require().Equal(orderer.String(), routes[0].Orderer)
+	s.Require().Equal(receiver.String(), routes[0].Receiver)
+	s.Require().Equal(sdk.NewInt64Coin(s.atomDenom, 1000000), routes[0].OfferCoin)
+	s.Require().True(s.app.BankKeeper.GetAllBalances(s.ctx, orderer).IsZero())
+
+	// The coin from the swap is sent to the receiver after the batch.
+	s.app.EndBlocker(s.ctx, abci.RequestEndBlock{})
+	s.Require().Empty(s.app.LiquidityKeeper.GetAllSwapRoutes(s.ctx))
+	s.Require().True(s.app.BankKeeper.GetBalance(s.ctx, receiver, "ucre").Amount.GTE(sdk.NewInt(900000)))
+}
+
+func (s *MiddlewareTestSuite) TestOnRecvPacket_InvalidSwapMemo() {
+	orderer := utils.TestAddress(1)
+	for _, tc := range []struct {
+		name string
+		memo string
+	}{
+		{"malformed memo", `{"swap": {"pair_ids": "1"}}`},
+		{"invalid min out coin", `{"swap": {"pair_ids": [1], "min_out_coin": "ucre"}}`},
+		{"invalid receiver", `{"swap": {"pair_ids": [1], "min_out_coin": "1ucre", "receiver": "invalidaddr"}}`},
+		{"wrong route", `{"swap": {"pair_ids": [1], "min_out_coin": "1uusd"}}`},
+	} {
+		s.Run(tc.name, func() {
+			ack := s.middleware.OnRecvPacket(s.ctx, s.packet("uatom", orderer.String(), tc.memo), nil)
+			s.Require().False(ack.Success())
+		})
+	}
+}
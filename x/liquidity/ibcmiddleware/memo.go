@@ -0,0 +1,86 @@
+package ibcmiddleware
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/crescent-network/crescent/v4/x/liquidity/types"
+)
+
+// SwapMemoKey is the key of the swap instruction in the memo of an ICS-20
+// transfer packet.
+const SwapMemoKey = "swap"
+
+// SwapMemo is the swap instruction in the memo of an ICS-20 transfer packet.
+// The transferred coin is swapped through the pairs in PairIds, and the coins
+// from the swap are sent to Receiver, or to the receiver of the transfer if
+// Receiver is empty.
+//
+// An example of the memo:
+//
+//	{"swap": {"pair_ids": [1, 2], "min_out_coin": "1000000ubcre", "receiver": "cre1...", "order_lifespan": "1h"}}
+type SwapMemo struct {
+	PairIds       []uint64 `json:"pair_ids"`
+	MinOutCoin    string   `json:"min_out_coin"`
+	Receiver      string   `json:"receiver,omitempty"`
+	OrderLifespan string   `json:"order_lifespan,omitempty"`
+}
+
+// ParseSwapMemo parses the swap instruction in the memo.
+// found is false if the memo has no swap instruction, in which case the
+// transfer is handled as usual.
+func ParseSwapMemo(memo string) (swapMemo SwapMemo, found bool, err error) {
+	// Most transfers have no memo, or a memo which is not a JSON object.
+	if !strings.HasPrefix(strings.TrimSpace(memo), "{") {
+		return SwapMemo{}, false, nil
+	}
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(memo), &m); err != nil {
+		return SwapMemo{}, false, nil
+	}
+	bz, ok := m[SwapMemoKey]
+	if !ok {
+		return SwapMemo{}, false, nil
+	}
+	if err := json.Unmarshal(bz, &swapMemo); err != nil {
+		return SwapMemo{}, true, fmt.Errorf("invalid swap memo: %w", err)
+	}
+	return swapMemo, true, nil
+}
+
+// MsgSwapExactIn returns the types.MsgSwapExactIn for the swap instruction,
+// which swaps the offer coin received by the orderer.
+func (memo SwapMemo) MsgSwapExactIn(orderer sdk.AccAddress, offerCoin sdk.Coin) (*types.MsgSwapExactIn, error) {
+	minOutCoin, err := sdk.ParseCoinNormalized(memo.MinOutCoin)
+	if err != nil {
+		return nil, fmt.Errorf("invalid min out coin: %w", err)
+	}
+	var orderLifespan time.Duration
+	if memo.OrderLifespan != "" {
+		orderLifespan, err = time.ParseDuration(memo.OrderLifespan)
+		if err != nil {
+			return nil, fmt.Errorf("invalid order lifespan: %w", err)
+		}
+	}
+	msg := types.NewMsgSwapExactIn(orderer, memo.PairIds, offerCoin, minOutCoin, orderLifespan)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// GetReceiver returns the address which receives the coins from the swap.
+func (memo SwapMemo) GetReceiver(transferReceiver sdk.AccAddress) (sdk.AccAddress, error) {
+	if memo.Receiver == "" {
+		return transferReceiver, nil
+	}
+	addr, err := sdk.AccAddressFromBech32(memo.Receiver)
+	if err != nil {
+		return nil, fmt.Errorf("invalid receiver address: %w", err)
+	}
+	return addr, nil
+}
@@ -0,0 +1,76 @@
+package ibcmiddleware_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+
+	"github.com/crescent-network/crescent/v4/x/liquidity/ibcmiddleware"
+)
+
+func TestParseSwapMemo(t *testing.T) {
+	for _, tc := range []struct {
+		name          string
+		memo          string
+		expectedFound bool
+		expectedErr   string
+	}{
+		{
+			"empty memo",
+			"",
+			false,
+			"",
+		},
+		{
+			"plain text memo",
+			"hello",
+			false,
+			"",
+		},
+		{
+			"memo without swap",
+			`{"forward": {"receiver": "cosmos1..."}}`,
+			false,
+			"",
+		},
+		{
+			"swap memo",
+			`{"swap": {"pair_ids": [1, 2], "min_out_coin": "1000denom3"}}`,
+			true,
+			"",
+		},
+		{
+			"malformed swap memo",
+			`{"swap": {"pair_ids": "1"}}`,
+			true,
+			"invalid swap memo: json: cannot unmarshal string into Go struct field SwapMemo.pair_ids of type []uint64",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, found, err := ibcmiddleware.ParseSwapMemo(tc.memo)
+			require.Equal(t, tc.expectedFound, found)
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestReceivedDenom(t *testing.T) {
+	packet := channeltypes.Packet{
+		SourcePort:         "transfer",
+		SourceChannel:      "channel-0",
+		DestinationPort:    "transfer",
+		DestinationChannel: "channel-1",
+	}
+	// A coin native to the sender chain becomes an IBC voucher.
+	require.Equal(t,
+		"ibc/C4CFF46FD6DE35CA4CF4CE031E643C8FDC9BA4B99AE598E9B0ED98FE3A2319F9",
+		ibcmiddleware.ReceivedDenom(packet, "uatom"))
+	// A coin returning to this chain is unescrowed as the original denom.
+	require.Equal(t, "ucre", ibcmiddleware.ReceivedDenom(packet, "transfer/channel-0/ucre"))
+}
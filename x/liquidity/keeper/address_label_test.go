@@ -0,0 +1,103 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	utils "github.com/crescent-network/crescent/v4/types"
+	"github.com/crescent-network/crescent/v4/x/liquidity/types"
+)
+
+var _ types.AddressLabeler = (*testAddressLabeler)(nil)
+
+// testAddressLabeler is an address labeler with fixed labels.
+type testAddressLabeler struct {
+	name   string
+	labels map[string]string
+}
+
+func (labeler *testAddressLabeler) Name() string {
+	return labeler.name
+}
+
+func (labeler *testAddressLabeler) AddressLabel(_ sdk.Context, addr sdk.AccAddress) (string, bool) {
+	label, ok := labeler.labels[addr.String()]
+	return label, ok
+}
+
+func (s *KeeperTestSuite) TestRegisterAddressLabeler() {
+	labeler := &testAddressLabeler{name: "test"}
+	s.keeper.RegisterAddressLabeler(labeler)
+	s.Require().Panics(func() {
+		s.keeper.RegisterAddressLabeler(labeler)
+	})
+	s.Require().Panics(func() {
+		s.keeper.RegisterAddressLabeler(&testAddressLabeler{})
+	})
+}
+
+func (s *KeeperTestSuite) TestAddressLabel() {
+	s.keeper.RegisterAddressLabeler(&testAddressLabeler{
+		name: "b",
+		labels: map[string]string{
+			s.addr(1).String(): "Market Maker B",
+			s.addr(2).String(): "Market Maker B2",
+		},
+	})
+	s.keeper.RegisterAddressLabeler(&testAddressLabeler{
+		name: "a",
+		labels: map[string]string{
+			s.addr(2).String(): "Market Maker A",
+		},
+	})
+
+	label, found := s.keeper.AddressLabel(s.ctx, s.addr(1))
+	s.Require().True(found)
+	s.Require().Equal("Market Maker B", label)
+	// The labeler with the smallest name wins.
+	label, found = s.keeper.AddressLabel(s.ctx, s.addr(2))
+	s.Require().True(found)
+	s.Require().Equal("Market Maker A", label)
+	_, found = s.keeper.AddressLabel(s.ctx, s.addr(3))
+	s.Require().False(found)
+}
+
+func (s *KeeperTestSuite) TestGRPCOrdersAddressLabels() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	order := s.buyLimitOrder(s.addr(1), pair.Id, utils.ParseDec("1.0"), sdk.NewInt(1000000), time.Hour, true)
+	s.buyLimitOrder(s.addr(1), pair.Id, utils.ParseDec("0.9"), sdk.NewInt(1000000), time.Hour, true)
+	s.sellLimitOrder(s.addr(2), pair.Id, utils.ParseDec("1.1"), sdk.NewInt(1000000), time.Hour, true)
+
+	// No labels without address labelers.
+	resp, err := s.querier.Orders(sdk.WrapSDKContext(s.ctx), &types.QueryOrdersRequest{PairId: pair.Id})
+	s.Require().NoError(err)
+	s.Require().Len(resp.Orders, 3)
+	s.Require().Empty(resp.AddressLabels)
+
+	s.keeper.RegisterAddressLabeler(&testAddressLabeler{
+		name: "test",
+		labels: map[string]string{
+			s.addr(1).String(): "Market Maker",
+		},
+	})
+	expected := []types.AddressLabel{{Address: s.addr(1).String(), Label: "Market Maker"}}
+
+	resp, err = s.querier.Orders(sdk.WrapSDKContext(s.ctx), &types.QueryOrdersRequest{PairId: pair.Id})
+	s.Require().NoError(err)
+	s.Require().Equal(expected, resp.AddressLabels)
+
+	resp, err = s.querier.OrdersByOrderer(sdk.WrapSDKContext(s.ctx), &types.QueryOrdersByOrdererRequest{
+		Orderer: s.addr(1).String(),
+	})
+	s.Require().NoError(err)
+	s.Require().Len(resp.Orders, 2)
+	s.Require().Equal(expected, resp.AddressLabels)
+
+	orderResp, err := s.querier.Order(sdk.WrapSDKContext(s.ctx), &types.QueryOrderRequest{
+		PairId: pair.Id,
+		Id:     order.Id,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(expected, orderResp.AddressLabels)
+}
@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/crescent-network/crescent/v4/x/liquidity/types"
+)
+
+// MigrateAddresses moves the escrows of pairs and the reserves of pools whose
+// address version is lower than the given version to the addresses derived
+// with the given version, along with their balances.
+// The fee accounts of the pools are moved too, since they are always derived
+// with the current address version.
+// It is meant to be called in the upgrade handler which introduces a new
+// address version.
+func (k Keeper) MigrateAddresses(ctx sdk.Context, version uint32) error {
+	if err := types.ValidateAddressVersion(version); err != nil {
+		return err
+	}
+
+	bulkOp := types.NewBulkSendCoinsOperation()
+	numPairs, numPools := 0, 0
+	for _, pair := range k.GetAllPairs(ctx) {
+		if pair.AddressVersion >= version {
+			continue
+		}
+		escrowAddr, err := types.VersionedPairEscrowAddress(version, pair.Id)
+		if err != nil {
+			return err
+		}
+		oldEscrowAddr := pair.GetEscrowAddress()
+		bulkOp.QueueSendCoins(oldEscrowAddr, escrowAddr, k.bankKeeper.GetAllBalances(ctx, oldEscrowAddr))
+		pair.EscrowAddress = escrowAddr.String()
+		pair.AddressVersion = version
+		k.SetPair(ctx, pair)
+		numPairs++
+	}
+	for _, pool := range k.GetAllPools(ctx) {
+		if pool.AddressVersion >= version {
+			continue
+		}
+		reserveAddr, err := types.VersionedPoolReserveAddress(version, pool.Id)
+		if err != nil {
+			return err
+		}
+		oldFeeAddr, err := types.VersionedPoolFeeAddress(pool.AddressVersion, pool.Id)
+		if err != nil {
+			return err
+		}
+		feeAddr, err := types.VersionedPoolFeeAddress(version, pool.Id)
+		if err != nil {
+			return err
+		}
+		oldReserveAddr := pool.GetReserveAddress()
+		bulkOp.QueueSendCoins(oldReserveAddr, reserveAddr, k.bankKeeper.GetAllBalances(ctx, oldReserveAddr))
+		bulkOp.QueueSendCoins(oldFeeAddr, feeAddr, k.bankKeeper.GetAllBalances(ctx, oldFeeAddr))
+		k.DeletePoolByReserveIndex(ctx, pool)
+		pool.ReserveAddress = reserveAddr.String()
+		pool.AddressVersion = version
+		k.SetPool(ctx, pool)
+		k.SetPoolByReserveIndex(ctx, pool)
+		numPools++
+	}
+	if err := bulkOp.Run(ctx, k.bankKeeper); err != nil {
+		return err
+	}
+
+	k.Logger(ctx).Info(
+		"migrated addresses",
+		"version", version, "num_pairs", numPairs, "num_pools", numPools)
+	return nil
+}
@@ -0,0 +1,28 @@
+package keeper_test
+
+import (
+	utils "github.com/crescent-network/crescent/v4/types"
+	"github.com/crescent-network/crescent/v4/x/liquidity/types"
+)
+
+func (s *KeeperTestSuite) TestMigrateAddresses() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pool := s.createPool(s.addr(0), pair.Id, utils.ParseCoins("1000000denom1,1000000denom2"), true)
+	s.Require().Equal(types.CurrentAddressVersion, pair.AddressVersion)
+	s.Require().Equal(types.CurrentAddressVersion, pool.AddressVersion)
+
+	s.Require().EqualError(
+		s.keeper.MigrateAddresses(s.ctx, types.CurrentAddressVersion+1), "unknown address version: 1")
+
+	// Pairs and pools already derived with the version are left as they are.
+	reserveBalances := s.getBalances(pool.GetReserveAddress())
+	s.Require().NoError(s.keeper.MigrateAddresses(s.ctx, types.CurrentAddressVersion))
+	pair, _ = s.keeper.GetPair(s.ctx, pair.Id)
+	s.Require().Equal(types.PairEscrowAddress(pair.Id).String(), pair.EscrowAddress)
+	pool, _ = s.keeper.GetPool(s.ctx, pool.Id)
+	s.Require().Equal(types.PoolReserveAddress(pool.Id).String(), pool.ReserveAddress)
+	s.Require().True(coinsEq(reserveBalances, s.getBalances(pool.GetReserveAddress())))
+	pool, found := s.keeper.GetPoolByReserveAddress(s.ctx, pool.GetReserveAddress())
+	s.Require().True(found)
+	s.Require().Equal(uint64(1), pool.Id)
+}
@@ -1,37 +1,75 @@
 package keeper
 
 import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/crescent-network/crescent/v4/x/liquidity/types"
 )
 
 // ExecuteRequests executes all orders, deposit requests and withdraw requests.
-// ExecuteRequests also handles order expiration.
+// ExecuteRequests also handles order expiration and forfeits the order
+// commits which were not revealed in time.
 func (k Keeper) ExecuteRequests(ctx sdk.Context) {
+	// Orders of pairs whose batch is not executed in this batch by their batch
+	// window are kept until the pair's batch is executed.
+	waitingPairIdSet := map[uint64]struct{}{}
 	if err := k.IterateAllPairs(ctx, func(pair types.Pair) (stop bool, err error) {
-		if err := k.ExecuteMatching(ctx, pair); err != nil {
+		if !k.ShouldExecuteBatch(ctx, pair) {
+			waitingPairIdSet[pair.Id] = struct{}{}
+			return false, nil
+		}
+		if pair.BatchWindow > 1 {
+			pair.LastBatchHeight = ctx.BlockHeight()
+		}
+		if err := k.SafeExecuteMatching(ctx, pair); err != nil {
 			return false, err
 		}
 		return false, nil
 	}); err != nil {
 		panic(err)
 	}
+	// Refunds of expired orders are sent at once after the iteration.
+	// Expired orders, including the ones expired during the matching, are
+	// deleted along with their indexes after the refunds.
+	refundOp := types.NewBulkSendCoinsOperation()
+	pairCache := map[uint64]types.Pair{}
+	var expiredOrders []types.Order
 	if err := k.IterateAllOrders(ctx, func(order types.Order) (stop bool, err error) {
+		if _, ok := waitingPairIdSet[order.PairId]; ok && order.Status == types.OrderStatusNotExecuted {
+			return false, nil
+		}
 		if order.Status.CanBeExpired() && order.ExpiredAt(ctx.BlockTime()) {
-			if err := k.FinishOrder(ctx, order, types.OrderStatusExpired); err != nil {
-				return false, err
+			pair, ok := pairCache[order.PairId]
+			if !ok {
+				pair, _ = k.GetPair(ctx, order.PairId)
+				pairCache[order.PairId] = pair
 			}
+			k.queueFinishOrder(ctx, pair, order, types.OrderStatusExpired, refundOp)
+			expiredOrders = append(expiredOrders, order)
 		} else if types.IsTooSmallOrderAmount(order.OpenAmount, order.Price) {
 			// TODO: should we introduce new order status for this type of expiration?
-			if err := k.FinishOrder(ctx, order, types.OrderStatusExpired); err != nil {
+			if err := k.FailOrder(ctx, order, types.FailureReasonTooSmallOrder); err != nil {
 				return false, err
 			}
+		} else if order.Status == types.OrderStatusExpired {
+			expiredOrders = append(expiredOrders, order)
 		}
 		return false, nil
 	}); err != nil {
 		panic(err)
 	}
+	if err := refundOp.Run(ctx, k.bankKeeper); err != nil {
+		panic(err)
+	}
+	for _, order := range expiredOrders {
+		k.DeleteOrder(ctx, order)
+	}
+	if err := k.ForfeitUnrevealedOrderCommits(ctx); err != nil {
+		panic(err)
+	}
 	if err := k.IterateAllDepositRequests(ctx, func(req types.DepositRequest) (stop bool, err error) {
 		if req.Status == types.RequestStatusNotExecuted {
 			if err := k.ExecuteDepositRequest(ctx, req); err != nil {
@@ -57,22 +95,173 @@ func (k Keeper) ExecuteRequests(ctx sdk.Context) {
 // DeleteOutdatedRequests deletes outdated(should be deleted) requests.
 // Determining if a request should be deleted is based on its status.
 func (k Keeper) DeleteOutdatedRequests(ctx sdk.Context) {
+	var numDepositReqs, numWithdrawReqs, numOrders int
 	_ = k.IterateAllDepositRequests(ctx, func(req types.DepositRequest) (stop bool, err error) {
 		if req.Status.ShouldBeDeleted() {
 			k.DeleteDepositRequest(ctx, req)
+			numDepositReqs++
 		}
 		return false, nil
 	})
 	_ = k.IterateAllWithdrawRequests(ctx, func(req types.WithdrawRequest) (stop bool, err error) {
 		if req.Status.ShouldBeDeleted() {
 			k.DeleteWithdrawRequest(ctx, req)
+			numWithdrawReqs++
 		}
 		return false, nil
 	})
 	_ = k.IterateAllOrders(ctx, func(order types.Order) (stop bool, err error) {
 		if order.Status.ShouldBeDeleted() {
 			k.DeleteOrder(ctx, order)
+			numOrders++
+		}
+		return false, nil
+	})
+	measurePrunedEntries("auto", numDepositReqs, numWithdrawReqs, numOrders, 0)
+}
+
+// PruneRequestResults deletes the results of finished requests and orders
+// which have been kept for the RequestResultRetention param.
+// At most MaxNumAutoPrunedRequestResults results are deleted in a block and
+// the rest are left to the following blocks or MsgPruneExpired.
+func (k Keeper) PruneRequestResults(ctx sdk.Context) {
+	maxNumPruned := int(k.GetMaxNumAutoPrunedRequestResults(ctx))
+	if maxNumPruned == 0 {
+		return
+	}
+	retention := k.GetRequestResultRetention(ctx)
+	var numRequestResults int
+	_ = k.IterateRequestResultsFinishedUntil(ctx, ctx.BlockTime().Add(-retention), func(result types.RequestResult) (stop bool, err error) {
+		if numRequestResults >= maxNumPruned {
+			return true, nil
+		}
+		k.DeleteRequestResult(ctx, result)
+		numRequestResults++
+		return false, nil
+	})
+	measurePrunedEntries("auto", 0, 0, 0, numRequestResults)
+}
+
+// PruneExpired handles types.MsgPruneExpired and prunes finished requests and
+// orders, as well as expired orders which are not yet handled by the batch
+// execution.
+// Results of finished requests and orders are pruned after they have been
+// kept for the RequestResultRetention param.
+// The number of pruned entries is limited by the MaxNumPrunedEntriesPerMsg
+// param, and so is the number of visited requests and orders, which may not
+// be prunable. Finished requests and orders beyond them are left to the
+// BeginBlock and the batch execution. Only prunable request results are
+// visited, through their index by the finish time.
+//
+// The pruner gets PruneRewardPerEntry from the prune reward pool, as long as
+// the pool has enough funds, only for each pruned entry which the automatic
+// pruning has left behind.
+// Finished requests and orders are all deleted at the next BeginBlock and
+// expired orders are handled by the batch execution anyway, so they are
+// pruned without a reward. Otherwise anyone could drain the pool by canceling
+// an order and pruning it in the same block.
+// Only request results which have been prunable since a previous block, and
+// thus were skipped by the MaxNumAutoPrunedRequestResults param, are rewarded.
+func (k Keeper) PruneExpired(ctx sdk.Context, msg *types.MsgPruneExpired) (numPruned uint32, err error) {
+	maxNumPruned := k.GetMaxNumPrunedEntriesPerMsg(ctx)
+
+	var numDepositReqs, numWithdrawReqs, numOrders, numRequestResults int
+	var numRewarded int64
+	var numVisited uint32
+	_ = k.IterateAllDepositRequests(ctx, func(req types.DepositRequest) (stop bool, err error) {
+		if numVisited >= maxNumPruned {
+			return true, nil
+		}
+		numVisited++
+		if req.Status.ShouldBeDeleted() {
+			k.DeleteDepositRequest(ctx, req)
+			numDepositReqs++
+			numPruned++
+		}
+		return false, nil
+	})
+	_ = k.IterateAllWithdrawRequests(ctx, func(req types.WithdrawRequest) (stop bool, err error) {
+		if numVisited >= maxNumPruned {
+			return true, nil
+		}
+		numVisited++
+		if req.Status.ShouldBeDeleted() {
+			k.DeleteWithdrawRequest(ctx, req)
+			numWithdrawReqs++
+			numPruned++
+		}
+		return false, nil
+	})
+	if err := k.IterateAllOrders(ctx, func(order types.Order) (stop bool, err error) {
+		if numVisited >= maxNumPruned {
+			return true, nil
+		}
+		numVisited++
+		if order.Status.CanBeExpired() && order.ExpiredAt(ctx.BlockTime()) {
+			if err := k.FinishOrder(ctx, order, types.OrderStatusExpired); err != nil {
+				return false, err
+			}
+		} else if !order.Status.ShouldBeDeleted() {
+			return false, nil
+		}
+		k.DeleteOrder(ctx, order)
+		numOrders++
+		numPruned++
+		return false, nil
+	}); err != nil {
+		return 0, err
+	}
+	retention := k.GetRequestResultRetention(ctx)
+	_ = k.IterateRequestResultsFinishedUntil(ctx, ctx.BlockTime().Add(-retention), func(result types.RequestResult) (stop bool, err error) {
+		if numPruned >= maxNumPruned {
+			return true, nil
+		}
+		k.DeleteRequestResult(ctx, result)
+		numRequestResults++
+		numPruned++
+		if result.FinishedAt.Before(ctx.BlockTime()) {
+			numRewarded++
 		}
 		return false, nil
 	})
+	measurePrunedEntries("msg", numDepositReqs, numWithdrawReqs, numOrders, numRequestResults)
+
+	reward := sdk.Coins{}
+	for _, coin := range k.GetPruneRewardPerEntry(ctx) {
+		reward = reward.Add(sdk.NewCoin(coin.Denom, coin.Amount.MulRaw(numRewarded)))
+	}
+	reward = reward.Min(k.bankKeeper.SpendableCoins(ctx, types.PruneRewardPoolAddress))
+	if !reward.IsZero() {
+		if err := k.bankKeeper.SendCoins(ctx, types.PruneRewardPoolAddress, msg.GetPruner(), reward); err != nil {
+			return 0, err
+		}
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypePruneExpired,
+			sdk.NewAttribute(types.AttributeKeyPruner, msg.Pruner),
+			sdk.NewAttribute(types.AttributeKeyNumPrunedEntries, strconv.FormatUint(uint64(numPruned), 10)),
+			sdk.NewAttribute(types.AttributeKeyReward, reward.String()),
+		),
+	})
+
+	return numPruned, nil
+}
+
+// measurePrunedEntries records the number of pruned entries by their kinds.
+func measurePrunedEntries(source string, numDepositReqs, numWithdrawReqs, numOrders, numRequestResults int) {
+	for _, entry := range []struct {
+		kind string
+		num  int
+	}{
+		{"deposit_requests", numDepositReqs},
+		{"withdraw_requests", numWithdrawReqs},
+		{"orders", numOrders},
+		{"request_results", numRequestResults},
+	} {
+		if entry.num > 0 {
+			telemetry.IncrCounter(float32(entry.num), types.ModuleName, "pruned", source, entry.kind)
+		}
+	}
 }
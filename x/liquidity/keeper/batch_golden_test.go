@@ -0,0 +1,174 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	utils "github.com/crescent-network/crescent/v4/types"
+	"github.com/crescent-network/crescent/v4/x/liquidity"
+	"github.com/crescent-network/crescent/v4/x/liquidity/types"
+)
+
+// updateGolden makes TestBatchGoldenFiles overwrite the golden files with the
+// settlements of the current engine instead of verifying them.
+// Run it only after reviewing the changes in the settlements:
+//
+//	go test ./x/liquidity/keeper -run TestKeeperTestSuite/TestBatchGoldenFiles -update-golden
+var updateGolden = flag.Bool("update-golden", false, "update the golden files of the batch scenarios")
+
+// batchScenario is a batch scenario recorded in testdata/batches/{name}.json.
+// All orders in the scenario are placed in the same batch, after the pools
+// are created.
+type batchScenario struct {
+	Description    string               `json:"description"`
+	BaseCoinDenom  string               `json:"base_coin_denom"`
+	QuoteCoinDenom string               `json:"quote_coin_denom"`
+	LastPrice      *sdk.Dec             `json:"last_price,omitempty"`
+	Pools          []batchScenarioPool  `json:"pools"`
+	Orders         []batchScenarioOrder `json:"orders"`
+}
+
+// batchScenarioPool is a pool in a batch scenario.
+// The pool is a ranged pool if MinPrice, MaxPrice and InitialPrice are set.
+type batchScenarioPool struct {
+	DepositCoins string   `json:"deposit_coins"`
+	MinPrice     *sdk.Dec `json:"min_price,omitempty"`
+	MaxPrice     *sdk.Dec `json:"max_price,omitempty"`
+	InitialPrice *sdk.Dec `json:"initial_price,omitempty"`
+}
+
+// batchScenarioOrder is an order in a batch scenario.
+// Each order is made by a different orderer.
+type batchScenarioOrder struct {
+	Direction string   `json:"direction"` // buy or sell
+	Type      string   `json:"type"`      // limit or market
+	Price     *sdk.Dec `json:"price,omitempty"`
+	Amount    sdk.Int  `json:"amount"`
+}
+
+// batchSettlement is the settlement plan of a batch scenario, which is stored
+// in testdata/batches/{name}.golden.
+type batchSettlement struct {
+	LastPrice *sdk.Dec               `json:"last_price"`
+	Orders    []batchSettlementOrder `json:"orders"`
+	Pools     []batchSettlementPool  `json:"pools,omitempty"`
+}
+
+type batchSettlementOrder struct {
+	Id                 uint64  `json:"id"`
+	Status             string  `json:"status"`
+	OpenAmount         sdk.Int `json:"open_amount"`
+	RemainingOfferCoin string  `json:"remaining_offer_coin"`
+	ReceivedCoin       string  `json:"received_coin"`
+}
+
+type batchSettlementPool struct {
+	Id       uint64 `json:"id"`
+	Reserves string `json:"reserves"`
+}
+
+func (s *KeeperTestSuite) TestBatchGoldenFiles() {
+	paths, err := filepath.Glob(filepath.Join("testdata", "batches", "*.json"))
+	s.Require().NoError(err)
+	s.Require().NotEmpty(paths)
+
+	for _, path := range paths {
+		name := strings.TrimSuffix(filepath.Base(path), ".json")
+		s.Run(name, func() {
+			s.SetupTest()
+
+			bz, err := os.ReadFile(path)
+			s.Require().NoError(err)
+			var scenario batchScenario
+			s.Require().NoError(json.Unmarshal(bz, &scenario))
+
+			settlement := s.runBatchScenario(scenario)
+			actual, err := json.MarshalIndent(settlement, "", "  ")
+			s.Require().NoError(err)
+			actual = append(actual, '\n')
+
+			goldenPath := strings.TrimSuffix(path, ".json") + ".golden"
+			if *updateGolden {
+				s.Require().NoError(os.WriteFile(goldenPath, actual, 0644))
+				return
+			}
+			expected, err := os.ReadFile(goldenPath)
+			s.Require().NoError(err, "run with -update-golden to create the golden file")
+			s.Require().JSONEq(string(expected), string(actual), scenario.Description)
+		})
+	}
+}
+
+// runBatchScenario sets up the scenario, executes a batch and returns the
+// settlement of the batch.
+func (s *KeeperTestSuite) runBatchScenario(scenario batchScenario) batchSettlement {
+	s.T().Helper()
+
+	pair := s.createPair(s.addr(0), scenario.BaseCoinDenom, scenario.QuoteCoinDenom, true)
+	if scenario.LastPrice != nil {
+		pair.LastPrice = scenario.LastPrice
+		s.keeper.SetPair(s.ctx, pair)
+	}
+
+	var pools []types.Pool
+	for _, p := range scenario.Pools {
+		depositCoins := utils.ParseCoins(p.DepositCoins)
+		if p.MinPrice != nil {
+			pools = append(pools, s.createRangedPool(
+				s.addr(0), pair.Id, depositCoins, *p.MinPrice, *p.MaxPrice, *p.InitialPrice, true))
+		} else {
+			pools = append(pools, s.createPool(s.addr(0), pair.Id, depositCoins, true))
+		}
+	}
+
+	var orders []types.Order
+	for i, o := range scenario.Orders {
+		orderer := s.addr(i + 1)
+		var dir types.OrderDirection
+		switch o.Direction {
+		case "buy":
+			dir = types.OrderDirectionBuy
+		case "sell":
+			dir = types.OrderDirectionSell
+		default:
+			s.FailNowf("invalid order direction", "order %d: %s", i, o.Direction)
+		}
+		switch o.Type {
+		case "limit":
+			orders = append(orders, s.limitOrder(orderer, pair.Id, dir, *o.Price, o.Amount, time.Hour, true))
+		case "market":
+			orders = append(orders, s.marketOrder(orderer, pair.Id, dir, o.Amount, time.Hour, true))
+		default:
+			s.FailNowf("invalid order type", "order %d: %s", i, o.Type)
+		}
+	}
+
+	liquidity.EndBlocker(s.ctx, s.keeper)
+
+	pair, _ = s.keeper.GetPair(s.ctx, pair.Id)
+	settlement := batchSettlement{LastPrice: pair.LastPrice}
+	for _, order := range orders {
+		order, found := s.keeper.GetOrder(s.ctx, order.PairId, order.Id)
+		s.Require().True(found)
+		settlement.Orders = append(settlement.Orders, batchSettlementOrder{
+			Id:                 order.Id,
+			Status:             order.Status.String(),
+			OpenAmount:         order.OpenAmount,
+			RemainingOfferCoin: order.RemainingOfferCoin.String(),
+			ReceivedCoin:       order.ReceivedCoin.String(),
+		})
+	}
+	for _, pool := range pools {
+		settlement.Pools = append(settlement.Pools, batchSettlementPool{
+			Id:       pool.Id,
+			Reserves: s.getBalances(pool.GetReserveAddress()).String(),
+		})
+	}
+	return settlement
+}
@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/crescent-network/crescent/v4/x/liquidity/types"
+)
+
+// RecordBatchResult stores the result of the pair's batch and deletes the
+// pair's batch results which have been kept for the RequestResultRetention
+// param.
+func (k Keeper) RecordBatchResult(ctx sdk.Context, result types.BatchResult) {
+	k.SetBatchResult(ctx, result)
+
+	retention := k.GetRequestResultRetention(ctx)
+	var outdated []types.BatchResult
+	_ = k.IterateBatchResultsByPair(ctx, result.PairId, func(result types.BatchResult) (stop bool, err error) {
+		if !result.IsPrunable(ctx.BlockTime(), retention) {
+			return true, nil
+		}
+		outdated = append(outdated, result)
+		return false, nil
+	})
+	for _, result := range outdated {
+		k.DeleteBatchResult(ctx, result)
+	}
+}
@@ -0,0 +1,57 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	utils "github.com/crescent-network/crescent/v4/types"
+	"github.com/crescent-network/crescent/v4/x/liquidity"
+)
+
+func (s *KeeperTestSuite) TestRecordBatchResult() {
+	params := s.keeper.GetParams(s.ctx)
+	params.RequestResultRetention = time.Hour
+	s.keeper.SetParams(s.ctx, params)
+
+	s.ctx = s.ctx.WithBlockTime(utils.ParseTime("2022-01-01T00:00:00Z"))
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+
+	// A batch without any orders is recorded as unmatched.
+	liquidity.EndBlocker(s.ctx, s.keeper)
+	result, found := s.keeper.GetBatchResult(s.ctx, pair.Id, 1)
+	s.Require().True(found)
+	s.Require().False(result.Matched)
+	s.Require().Nil(result.MatchPrice)
+	s.Require().True(result.MatchedBaseAmount.IsZero())
+	s.Require().Equal(s.ctx.BlockHeight(), result.ExecutedHeight)
+
+	s.ctx = s.ctx.WithBlockTime(utils.ParseTime("2022-01-01T00:30:00Z"))
+	liquidity.BeginBlocker(s.ctx, s.keeper)
+	s.buyLimitOrder(s.addr(1), pair.Id, utils.ParseDec("1.0"), sdk.NewInt(1000000), time.Hour, true)
+	s.sellLimitOrder(s.addr(2), pair.Id, utils.ParseDec("1.0"), sdk.NewInt(600000), time.Hour, true)
+	s.sellLimitOrder(s.addr(3), pair.Id, utils.ParseDec("0.9"), sdk.NewInt(300000), time.Hour, true)
+	liquidity.EndBlocker(s.ctx, s.keeper)
+
+	result, found = s.keeper.GetBatchResult(s.ctx, pair.Id, 2)
+	s.Require().True(found)
+	s.Require().True(result.Matched)
+	s.Require().True(decEq(utils.ParseDec("1.0"), *result.MatchPrice))
+	s.Require().Equal(sdk.NewInt(900000), result.MatchedBaseAmount)
+	s.Require().Equal(sdk.NewInt(900000), result.MatchedQuoteAmount)
+	s.Require().EqualValues(2, result.NumFullyMatchedOrders)
+	s.Require().EqualValues(1, result.NumPartiallyMatchedOrders)
+	s.Require().Equal(utils.ParseTime("2022-01-01T00:30:00Z"), result.ExecutedAt)
+
+	// Results kept for RequestResultRetention are deleted when a new result
+	// of the pair is recorded.
+	s.ctx = s.ctx.WithBlockTime(utils.ParseTime("2022-01-01T01:10:00Z"))
+	liquidity.BeginBlocker(s.ctx, s.keeper)
+	liquidity.EndBlocker(s.ctx, s.keeper)
+	_, found = s.keeper.GetBatchResult(s.ctx, pair.Id, 1)
+	s.Require().False(found)
+	_, found = s.keeper.GetBatchResult(s.ctx, pair.Id, 2)
+	s.Require().True(found)
+	_, found = s.keeper.GetBatchResult(s.ctx, pair.Id, 3)
+	s.Require().True(found)
+}
@@ -1,9 +1,11 @@
 package keeper_test
 
 import (
+	"strconv"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
 
 	utils "github.com/crescent-network/crescent/v4/types"
 	"github.com/crescent-network/crescent/v4/x/liquidity"
@@ -35,12 +37,217 @@ func (s *KeeperTestSuite) TestOrderExpiration() {
 	// Another buy order comes in, but this time the first order has been expired,
 	// so there is no match.
 	s.limitOrder(s.addr(3), pair.Id, types.OrderDirectionBuy, utils.ParseDec("1.0"), sdk.NewInt(5000), 0, true)
+	s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
 	liquidity.EndBlocker(s.ctx, s.keeper)
-	order, _ = s.keeper.GetOrder(s.ctx, order.PairId, order.Id)
-	s.Require().Equal(types.OrderStatusExpired, order.Status)
-	s.Require().True(intEq(sdk.NewInt(5000), order.OpenAmount))
+	// The order is gone in the same EndBlocker, leaving its result.
+	_, found = s.keeper.GetOrder(s.ctx, order.PairId, order.Id)
+	s.Require().False(found)
+	s.Require().Empty(s.keeper.GetOrdersByOrderer(s.ctx, s.addr(1)))
+	result, found := s.keeper.GetRequestResult(s.ctx, types.RequestTypeOrder, order.PairId, order.Id)
+	s.Require().True(found)
+	s.Require().Equal(types.RequestResultCodeExpired, result.Code)
+	s.Require().True(coinsEq(utils.ParseCoins("5000denom1,5000denom2"), s.getBalances(s.addr(1))))
+
+	var expiredEvent *sdk.Event
+	for _, ev := range s.ctx.EventManager().Events() {
+		if ev.Type != types.EventTypeOrderExpired {
+			continue
+		}
+		for _, attr := range ev.Attributes {
+			if string(attr.Key) == types.AttributeKeyOrderId && string(attr.Value) == strconv.FormatUint(order.Id, 10) {
+				ev := ev
+				expiredEvent = &ev
+			}
+		}
+	}
+	s.Require().NotNil(expiredEvent)
+	s.Require().Contains(expiredEvent.Attributes, abci.EventAttribute{
+		Key: []byte(types.AttributeKeyRefundedCoins), Value: []byte("5000denom1")})
+}
+
+func (s *KeeperTestSuite) TestPruneExpired() {
+	params := s.keeper.GetParams(s.ctx)
+	params.MaxNumPrunedEntriesPerMsg = 2
+	params.PruneRewardPerEntry = utils.ParseCoins("100stake")
+	s.keeper.SetParams(s.ctx, params)
+	s.fundAddr(types.PruneRewardPoolAddress, utils.ParseCoins("150stake"))
+
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+
+	s.ctx = s.ctx.WithBlockTime(utils.ParseTime("2022-03-01T12:00:00Z"))
+	var orders []types.Order
+	for i := 1; i <= 3; i++ {
+		order := s.sellLimitOrder(s.addr(i), pair.Id, utils.ParseDec("1.0"), sdk.NewInt(10000), 10*time.Second, true)
+		orders = append(orders, order)
+	}
+	liquidity.EndBlocker(s.ctx, s.keeper)
+
+	// The orders have been expired, but the batch is not executed yet.
+	s.ctx = s.ctx.WithBlockTime(utils.ParseTime("2022-03-01T12:00:12Z"))
+	pruner := s.addr(4)
+	numPruned, err := s.keeper.PruneExpired(s.ctx, types.NewMsgPruneExpired(pruner))
+	s.Require().NoError(err)
+	s.Require().EqualValues(2, numPruned)
+
+	// Expired orders would be handled by the batch execution anyway, so the
+	// pruner isn't rewarded for them.
+	s.Require().True(s.getBalances(pruner).IsZero())
+	s.Require().True(coinsEq(utils.ParseCoins("150stake"), s.getBalances(types.PruneRewardPoolAddress)))
+
+	// Pruned orders are refunded and deleted.
+	for _, order := range orders[:2] {
+		_, found := s.keeper.GetOrder(s.ctx, order.PairId, order.Id)
+		s.Require().False(found)
+		s.Require().True(coinsEq(utils.ParseCoins("10000denom1"), s.getBalances(order.GetOrderer())))
+	}
+	_, found := s.keeper.GetOrder(s.ctx, orders[2].PairId, orders[2].Id)
+	s.Require().True(found)
+
+	numPruned, err = s.keeper.PruneExpired(s.ctx, types.NewMsgPruneExpired(pruner))
+	s.Require().NoError(err)
+	s.Require().EqualValues(1, numPruned)
+	_, found = s.keeper.GetOrder(s.ctx, orders[2].PairId, orders[2].Id)
+	s.Require().False(found)
+
+	// Nothing to prune.
+	numPruned, err = s.keeper.PruneExpired(s.ctx, types.NewMsgPruneExpired(pruner))
+	s.Require().NoError(err)
+	s.Require().Zero(numPruned)
+}
+
+func (s *KeeperTestSuite) TestPruneExpired_MaxNumVisitedEntries() {
+	params := s.keeper.GetParams(s.ctx)
+	params.MaxNumPrunedEntriesPerMsg = 2
+	s.keeper.SetParams(s.ctx, params)
+
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+
+	s.ctx = s.ctx.WithBlockTime(utils.ParseTime("2022-03-01T12:00:00Z"))
+	for i := 1; i <= 2; i++ {
+		s.sellLimitOrder(s.addr(i), pair.Id, utils.ParseDec("1.0"), sdk.NewInt(10000), time.Hour, true)
+	}
+	order := s.sellLimitOrder(s.addr(3), pair.Id, utils.ParseDec("1.0"), sdk.NewInt(10000), 10*time.Second, true)
+	liquidity.EndBlocker(s.ctx, s.keeper)
+
+	// The open orders visited first use up the number of visited entries,
+	// so the expired order after them is left to the batch execution.
+	s.ctx = s.ctx.WithBlockTime(utils.ParseTime("2022-03-01T12:00:12Z"))
+	numPruned, err := s.keeper.PruneExpired(s.ctx, types.NewMsgPruneExpired(s.addr(4)))
+	s.Require().NoError(err)
+	s.Require().Zero(numPruned)
+	_, found := s.keeper.GetOrder(s.ctx, order.PairId, order.Id)
+	s.Require().True(found)
+}
+
+func (s *KeeperTestSuite) TestPruneExpired_Reward() {
+	params := s.keeper.GetParams(s.ctx)
+	params.RequestResultRetention = 0
+	params.MaxNumAutoPrunedRequestResults = 1
+	params.PruneRewardPerEntry = utils.ParseCoins("100stake")
+	s.keeper.SetParams(s.ctx, params)
+	s.fundAddr(types.PruneRewardPoolAddress, utils.ParseCoins("1000stake"))
+
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+
+	// An order canceled in the current block is deleted at the next
+	// BeginBlock anyway, so pruning it along with its result earns no reward.
+	order := s.sellLimitOrder(s.addr(1), pair.Id, utils.ParseDec("1.0"), sdk.NewInt(10000), time.Hour, true)
+	s.nextBlock()
+	s.cancelOrder(order.GetOrderer(), order.PairId, order.Id)
+	pruner := s.addr(2)
+	numPruned, err := s.keeper.PruneExpired(s.ctx, types.NewMsgPruneExpired(pruner))
+	s.Require().NoError(err)
+	s.Require().EqualValues(2, numPruned)
+	_, found := s.keeper.GetOrder(s.ctx, order.PairId, order.Id)
+	s.Require().False(found)
+	s.Require().Empty(s.keeper.GetAllRequestResults(s.ctx))
+	s.Require().True(s.getBalances(pruner).IsZero())
+	s.Require().True(coinsEq(utils.ParseCoins("1000stake"), s.getBalances(types.PruneRewardPoolAddress)))
 
+	// Results left behind by the automatic pruning are rewarded.
+	for i := 3; i <= 5; i++ {
+		s.sellLimitOrder(s.addr(i), pair.Id, utils.ParseDec("1.0"), sdk.NewInt(10000), 0, true)
+	}
+	liquidity.EndBlocker(s.ctx, s.keeper)
+	s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(5 * time.Second))
 	liquidity.BeginBlocker(s.ctx, s.keeper)
-	_, found = s.keeper.GetOrder(s.ctx, order.PairId, order.Id)
-	s.Require().False(found) // The order is gone.
+	s.Require().Len(s.keeper.GetAllRequestResults(s.ctx), 2)
+	numPruned, err = s.keeper.PruneExpired(s.ctx, types.NewMsgPruneExpired(pruner))
+	s.Require().NoError(err)
+	s.Require().EqualValues(2, numPruned)
+	s.Require().True(coinsEq(utils.ParseCoins("200stake"), s.getBalances(pruner)))
+	s.Require().True(coinsEq(utils.ParseCoins("800stake"), s.getBalances(types.PruneRewardPoolAddress)))
+}
+
+func (s *KeeperTestSuite) TestPruneRequestResults() {
+	params := s.keeper.GetParams(s.ctx)
+	params.RequestResultRetention = time.Hour
+	s.keeper.SetParams(s.ctx, params)
+
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+
+	s.ctx = s.ctx.WithBlockTime(utils.ParseTime("2022-03-01T12:00:00Z"))
+	order := s.sellLimitOrder(s.addr(1), pair.Id, utils.ParseDec("1.0"), sdk.NewInt(10000), 0, true)
+	liquidity.EndBlocker(s.ctx, s.keeper)
+	liquidity.BeginBlocker(s.ctx, s.keeper)
+
+	// The order has been expired and deleted, but its result is kept.
+	_, found := s.keeper.GetOrder(s.ctx, order.PairId, order.Id)
+	s.Require().False(found)
+	result, found := s.keeper.GetRequestResult(s.ctx, types.RequestTypeOrder, order.PairId, order.Id)
+	s.Require().True(found)
+	s.Require().Equal(types.RequestResultCodeExpired, result.Code)
+
+	// The result cannot be pruned within the retention period.
+	pruner := s.addr(2)
+	s.ctx = s.ctx.WithBlockTime(utils.ParseTime("2022-03-01T12:59:59Z"))
+	numPruned, err := s.keeper.PruneExpired(s.ctx, types.NewMsgPruneExpired(pruner))
+	s.Require().NoError(err)
+	s.Require().Zero(numPruned)
+
+	s.ctx = s.ctx.WithBlockTime(utils.ParseTime("2022-03-01T13:00:00Z"))
+	numPruned, err = s.keeper.PruneExpired(s.ctx, types.NewMsgPruneExpired(pruner))
+	s.Require().NoError(err)
+	s.Require().EqualValues(1, numPruned)
+	_, found = s.keeper.GetRequestResult(s.ctx, types.RequestTypeOrder, order.PairId, order.Id)
+	s.Require().False(found)
+}
+
+func (s *KeeperTestSuite) TestAutoPruneRequestResults() {
+	params := s.keeper.GetParams(s.ctx)
+	params.RequestResultRetention = time.Hour
+	params.MaxNumAutoPrunedRequestResults = 2
+	s.keeper.SetParams(s.ctx, params)
+
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+
+	s.ctx = s.ctx.WithBlockTime(utils.ParseTime("2022-03-01T12:00:00Z"))
+	for i := 1; i <= 3; i++ {
+		s.sellLimitOrder(s.addr(i), pair.Id, utils.ParseDec("1.0"), sdk.NewInt(10000), 0, true)
+	}
+	liquidity.EndBlocker(s.ctx, s.keeper)
+	s.Require().Len(s.keeper.GetAllRequestResults(s.ctx), 3)
+
+	s.ctx = s.ctx.WithBlockTime(utils.ParseTime("2022-03-01T12:59:59Z"))
+	liquidity.BeginBlocker(s.ctx, s.keeper)
+	s.Require().Len(s.keeper.GetAllRequestResults(s.ctx), 3)
+
+	// At most MaxNumAutoPrunedRequestResults results are pruned in a block.
+	s.ctx = s.ctx.WithBlockTime(utils.ParseTime("2022-03-01T13:00:00Z"))
+	liquidity.BeginBlocker(s.ctx, s.keeper)
+	s.Require().Len(s.keeper.GetAllRequestResults(s.ctx), 1)
+	liquidity.BeginBlocker(s.ctx, s.keeper)
+	s.Require().Empty(s.keeper.GetAllRequestResults(s.ctx))
+}
+
+func (s *KeeperTestSuite) TestFailedOrderResult() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+
+	order := s.sellLimitOrder(s.addr(1), pair.Id, utils.ParseDec("1.0"), sdk.NewInt(10000), time.Hour, true)
+	s.Require().NoError(s.keeper.FailOrder(s.ctx, order, types.FailureReasonTooSmallOrder))
+
+	result, found := s.keeper.GetRequestResult(s.ctx, types.RequestTypeOrder, order.PairId, order.Id)
+	s.Require().True(found)
+	s.Require().Equal(types.RequestResultCodeFailed, result.Code)
+	s.Require().Equal(types.FailureReasonTooSmallOrder.String(), result.Reason)
 }
@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"fmt"
+	"math/rand"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -66,3 +68,71 @@ func BenchmarkMatching(b *testing.B) {
 		liquidity.EndBlocker(cacheCtx, keeper)
 	}
 }
+
+func BenchmarkMatching_LargeBook(b *testing.B) {
+	/*
+		Before optimization:
+		BenchmarkMatching_LargeBook/10000_orders         	       3	 634400050 ns/op	170944341 B/op	 4448365 allocs/op
+		BenchmarkMatching_LargeBook/50000_orders         	       3	2690224944 ns/op	828474272 B/op	21971440 allocs/op
+
+		After optimization:
+		BenchmarkMatching_LargeBook/10000_orders         	       3	 385290711 ns/op	128239018 B/op	 3364080 allocs/op
+		BenchmarkMatching_LargeBook/50000_orders         	       3	2325767395 ns/op	612834752 B/op	16476045 allocs/op
+	*/
+	for _, numOrders := range []int{1000, 10000, 100000} {
+		b.Run(fmt.Sprintf("%d orders", numOrders), func(b *testing.B) {
+			app := chain.Setup(false)
+			ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+			keeper := app.LiquidityKeeper
+
+			require.NoError(b, chain.FundAccount(
+				app.BankKeeper, ctx, utils.TestAddress(0),
+				utils.ParseCoins("9999999999999999denom1,9999999999999999denom2,9999999999999999stake")))
+
+			pair, err := keeper.CreatePair(ctx, types.NewMsgCreatePair(utils.TestAddress(0), "denom1", "denom2"))
+			require.NoError(b, err)
+			pair.LastPrice = utils.ParseDecP("1.0")
+			keeper.SetPair(ctx, pair)
+
+			_, err = keeper.CreatePool(ctx, types.NewMsgCreatePool(
+				utils.TestAddress(0), pair.Id, utils.ParseCoins("1000_000000denom1,1000_000000denom2")))
+			require.NoError(b, err)
+
+			// Place orders crowded around the last price, so that most of them
+			// get matched.
+			r := rand.New(rand.NewSource(0))
+			for i := 0; i < numOrders; i++ {
+				orderer := utils.TestAddress(1 + i%100)
+				if i < 100 {
+					require.NoError(b, chain.FundAccount(
+						app.BankKeeper, ctx, orderer, utils.ParseCoins("9999999999999denom1,9999999999999denom2")))
+				}
+				amt := utils.RandomInt(r, sdk.NewInt(1_000000), sdk.NewInt(10_000000))
+				var dir types.OrderDirection
+				var price sdk.Dec
+				var offerCoin sdk.Coin
+				var demandCoinDenom string
+				if i%2 == 0 {
+					dir = types.OrderDirectionBuy
+					price = amm.PriceToDownTick(utils.RandomDec(r, utils.ParseDec("0.99"), utils.ParseDec("1.01")), 4)
+					offerCoin = sdk.NewCoin("denom2", amm.OfferCoinAmount(amm.Buy, price, amt))
+					demandCoinDenom = "denom1"
+				} else {
+					dir = types.OrderDirectionSell
+					price = amm.PriceToDownTick(utils.RandomDec(r, utils.ParseDec("0.99"), utils.ParseDec("1.01")), 4)
+					offerCoin = sdk.NewCoin("denom1", amt)
+					demandCoinDenom = "denom2"
+				}
+				_, err = keeper.LimitOrder(ctx, types.NewMsgLimitOrder(
+					orderer, pair.Id, dir, offerCoin, demandCoinDenom, price, amt, 0))
+				require.NoError(b, err)
+			}
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				cacheCtx, _ := ctx.CacheContext()
+				liquidity.EndBlocker(cacheCtx, keeper)
+			}
+		})
+	}
+}
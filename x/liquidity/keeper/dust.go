@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/crescent-network/crescent/v4/x/liquidity/types"
+)
+
+// PairDust returns the coins held in the pair's escrow in excess of the
+// remaining offer coins of the pair's open orders.
+// Such coins are left by the decimal truncation in the matching and can't be
+// claimed by anyone.
+func (k Keeper) PairDust(ctx sdk.Context, pair types.Pair) sdk.Coins {
+	remainingOfferCoins := sdk.Coins{}
+	_ = k.IterateOrdersByPair(ctx, pair.Id, func(order types.Order) (stop bool, err error) {
+		if !order.Status.ShouldBeDeleted() {
+			remainingOfferCoins = remainingOfferCoins.Add(order.RemainingOfferCoin)
+		}
+		return false, nil
+	})
+	spendable := k.bankKeeper.SpendableCoins(ctx, pair.GetEscrowAddress())
+	dust := sdk.Coins{}
+	for _, denom := range []string{pair.BaseCoinDenom, pair.QuoteCoinDenom} {
+		if amt := spendable.AmountOf(denom).Sub(remainingOfferCoins.AmountOf(denom)); amt.IsPositive() {
+			dust = dust.Add(sdk.NewCoin(denom, amt))
+		}
+	}
+	return dust
+}
+
+// PoolDust returns the coins left in the reserve of the pool if the pool is
+// disabled, or empty coins otherwise.
+// Withdrawals from a pool truncate the withdrawn amounts, so the reserve of a
+// pool whose pool coins are all withdrawn may not be empty.
+func (k Keeper) PoolDust(ctx sdk.Context, pool types.Pool) sdk.Coins {
+	if !pool.Disabled {
+		return sdk.Coins{}
+	}
+	pair, _ := k.GetPair(ctx, pool.PairId)
+	rx, ry := k.getPoolBalances(ctx, pool, pair)
+	return sdk.NewCoins(rx, ry)
+}
+
+// SweepDust sends the dust held in pair escrows and disabled pools' reserves
+// to the dust collector.
+func (k Keeper) SweepDust(ctx sdk.Context) {
+	dustCollector := k.GetDustCollector(ctx)
+	_ = k.IterateAllPairs(ctx, func(pair types.Pair) (stop bool, err error) {
+		dust := k.PairDust(ctx, pair)
+		if dust.IsZero() {
+			return false, nil
+		}
+		if err := k.bankKeeper.SendCoins(ctx, pair.GetEscrowAddress(), dustCollector, dust); err != nil {
+			panic(err)
+		}
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeSweepDust,
+				sdk.NewAttribute(types.AttributeKeyPairId, strconv.FormatUint(pair.Id, 10)),
+				sdk.NewAttribute(types.AttributeKeySweptCoins, dust.String()),
+			),
+		})
+		return false, nil
+	})
+	_ = k.IterateAllPools(ctx, func(pool types.Pool) (stop bool, err error) {
+		dust := k.PoolDust(ctx, pool)
+		if dust.IsZero() {
+			return false, nil
+		}
+		if err := k.bankKeeper.SendCoins(ctx, pool.GetReserveAddress(), dustCollector, dust); err != nil {
+			panic(err)
+		}
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeSweepDust,
+				sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(pool.Id, 10)),
+				sdk.NewAttribute(types.AttributeKeySweptCoins, dust.String()),
+			),
+		})
+		return false, nil
+	})
+}
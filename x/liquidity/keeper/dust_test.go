@@ -0,0 +1,57 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	utils "github.com/crescent-network/crescent/v4/types"
+	"github.com/crescent-network/crescent/v4/x/liquidity"
+)
+
+func (s *KeeperTestSuite) TestSweepDust() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	s.buyLimitOrder(s.addr(1), pair.Id, utils.ParseDec("1.0"), sdk.NewInt(1000000), time.Hour, true)
+
+	pool := s.createPool(s.addr(2), pair.Id, utils.ParseCoins("1000000denom1,1000000denom2"), true)
+	s.withdraw(s.addr(2), pool.Id, s.getBalance(s.addr(2), pool.PoolCoinDenom))
+	s.nextBlock()
+	pool, _ = s.keeper.GetPool(s.ctx, pool.Id)
+	s.Require().True(pool.Disabled)
+
+	// No dust yet.
+	s.Require().True(s.keeper.PairDust(s.ctx, pair).IsZero())
+	s.Require().True(s.keeper.PoolDust(s.ctx, pool).IsZero())
+
+	s.fundAddr(pair.GetEscrowAddress(), utils.ParseCoins("100denom1,200denom2,300denom3"))
+	s.fundAddr(pool.GetReserveAddress(), utils.ParseCoins("10denom1,20denom2"))
+	// The remaining offer coin of the open order is not dust, and coins of
+	// denoms other than the pair's are not swept.
+	s.Require().True(coinsEq(utils.ParseCoins("100denom1,200denom2"), s.keeper.PairDust(s.ctx, pair)))
+	s.Require().True(coinsEq(utils.ParseCoins("10denom1,20denom2"), s.keeper.PoolDust(s.ctx, pool)))
+
+	s.keeper.SweepDust(s.ctx)
+	s.Require().True(s.keeper.PairDust(s.ctx, pair).IsZero())
+	s.Require().True(s.keeper.PoolDust(s.ctx, pool).IsZero())
+	s.Require().True(coinsEq(
+		utils.ParseCoins("110denom1,220denom2"), s.getBalances(s.keeper.GetDustCollector(s.ctx))))
+	s.Require().True(coinsEq(
+		utils.ParseCoins("1000000denom2,300denom3"), s.getBalances(pair.GetEscrowAddress())))
+}
+
+func (s *KeeperTestSuite) TestSweepDustEpoch() {
+	params := s.keeper.GetParams(s.ctx)
+	params.DustSweepEpoch = 5
+	s.keeper.SetParams(s.ctx, params)
+
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	s.fundAddr(pair.GetEscrowAddress(), utils.ParseCoins("100denom1"))
+
+	s.ctx = s.ctx.WithBlockHeight(4)
+	liquidity.EndBlocker(s.ctx, s.keeper)
+	s.Require().True(coinsEq(utils.ParseCoins("100denom1"), s.keeper.PairDust(s.ctx, pair)))
+
+	s.ctx = s.ctx.WithBlockHeight(5)
+	liquidity.EndBlocker(s.ctx, s.keeper)
+	s.Require().True(s.keeper.PairDust(s.ctx, pair).IsZero())
+}
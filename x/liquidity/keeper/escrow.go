@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/crescent-network/crescent/v4/x/liquidity/types"
+)
+
+// EscrowBalanceDiffs reconciles the escrow accounts of the module against
+// their bank balances and returns the per-denom differences found.
+// The global escrow must hold the deposit coins and pool coins of pending
+// deposit and withdraw requests and the bonds of order commits, and each
+// pair's escrow must hold the remaining offer coins of the pair's open orders.
+func (k Keeper) EscrowBalanceDiffs(ctx sdk.Context) []types.EscrowBalanceDiff {
+	globalEscrowCoins := sdk.Coins{}
+	_ = k.IterateAllDepositRequests(ctx, func(req types.DepositRequest) (stop bool, err error) {
+		if req.Status == types.RequestStatusNotExecuted {
+			globalEscrowCoins = globalEscrowCoins.Add(req.DepositCoins...)
+		}
+		return false, nil
+	})
+	_ = k.IterateAllWithdrawRequests(ctx, func(req types.WithdrawRequest) (stop bool, err error) {
+		if req.Status == types.RequestStatusNotExecuted {
+			globalEscrowCoins = globalEscrowCoins.Add(req.PoolCoin)
+		}
+		return false, nil
+	})
+	_ = k.IterateAllOrderCommits(ctx, func(commit types.OrderCommit) (stop bool, err error) {
+		globalEscrowCoins = globalEscrowCoins.Add(commit.Bond...)
+		return false, nil
+	})
+	diffs := types.NewEscrowBalanceDiffs(
+		types.GlobalEscrowAddress, "global escrow",
+		globalEscrowCoins, k.bankKeeper.SpendableCoins(ctx, types.GlobalEscrowAddress))
+
+	_ = k.IterateAllPairs(ctx, func(pair types.Pair) (stop bool, err error) {
+		remainingOfferCoins := sdk.Coins{}
+		_ = k.IterateOrdersByPair(ctx, pair.Id, func(order types.Order) (stop bool, err error) {
+			if !order.Status.ShouldBeDeleted() {
+				remainingOfferCoins = remainingOfferCoins.Add(order.RemainingOfferCoin)
+			}
+			return false, nil
+		})
+		escrowAddr := pair.GetEscrowAddress()
+		diffs = append(diffs, types.NewEscrowBalanceDiffs(
+			escrowAddr, fmt.Sprintf("pair %d escrow", pair.Id),
+			remainingOfferCoins, k.bankKeeper.SpendableCoins(ctx, escrowAddr))...)
+		return false, nil
+	})
+
+	return diffs
+}
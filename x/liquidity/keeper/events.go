@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+)
+
+// SetLegacyOrderEventsEnabled sets whether the legacy order events, of which
+// attributes are plain strings, are emitted along with the typed order events.
+// They are enabled by default for the compatibility of existing event
+// subscribers and will be removed in the next release.
+// Like SetHooks, it must be called before the keeper is passed to the
+// liquidity module.
+func (k *Keeper) SetLegacyOrderEventsEnabled(enabled bool) {
+	k.legacyOrderEvents = enabled
+}
+
+// emitOrderEvent emits the typed order event, as well as the legacy order
+// event if legacy order events are enabled.
+func (k Keeper) emitOrderEvent(ctx sdk.Context, typedEvent proto.Message, legacyEvent sdk.Event) {
+	if k.legacyOrderEvents {
+		ctx.EventManager().EmitEvent(legacyEvent)
+	}
+	if err := ctx.EventManager().EmitTypedEvent(typedEvent); err != nil {
+		// Typed events are always marshalable to JSON, so it never happens.
+		panic(fmt.Errorf("failed to emit %s: %w", proto.MessageName(typedEvent), err))
+	}
+}
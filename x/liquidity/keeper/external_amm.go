@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/crescent-network/crescent/v4/x/liquidity/amm"
+	"github.com/crescent-network/crescent/v4/x/liquidity/types"
+)
+
+var _ types.OrderSource = (*ExternalAMMOrderSource)(nil)
+
+// ExternalAMMOrderSource is an order source which provides orders from
+// liquidity held in external AMM vaults.
+// Each vault's balances are treated as the reserves of a constant-product
+// pool, and the vault's fee rate is applied to the pool's orders.
+// It is intended to let bridged or migrated liquidity participate in
+// batches during a transition period.
+type ExternalAMMOrderSource struct {
+	keeper Keeper
+	name   string
+	vaults map[uint64]types.ExternalAMMVault // pair id => vault
+}
+
+// NewExternalAMMOrderSource returns a new ExternalAMMOrderSource.
+// It panics if any of vaults is invalid or there are multiple vaults for
+// a pair.
+func NewExternalAMMOrderSource(k Keeper, name string, vaults ...types.ExternalAMMVault) *ExternalAMMOrderSource {
+	vaultByPairId := map[uint64]types.ExternalAMMVault{}
+	for _, vault := range vaults {
+		if err := vault.Validate(); err != nil {
+			panic(fmt.Errorf("invalid external amm vault: %w", err))
+		}
+		if _, ok := vaultByPairId[vault.PairId]; ok {
+			panic(fmt.Sprintf("multiple external amm vaults for pair %d", vault.PairId))
+		}
+		vaultByPairId[vault.PairId] = vault
+	}
+	return &ExternalAMMOrderSource{
+		keeper: k,
+		name:   name,
+		vaults: vaultByPairId,
+	}
+}
+
+// Name returns the name of the order source.
+func (source *ExternalAMMOrderSource) Name() string {
+	return source.name
+}
+
+// PairOrderSource returns an amm.OrderSource which provides orders from
+// the pair's vault.
+// found is false if there is no vault for the pair, or the vault doesn't
+// hold both coins of the pair.
+func (source *ExternalAMMOrderSource) PairOrderSource(ctx sdk.Context, pair types.Pair) (amm.OrderSource, bool) {
+	vault, ok := source.vaults[pair.Id]
+	if !ok {
+		return nil, false
+	}
+
+	spendable := source.keeper.bankKeeper.SpendableCoins(ctx, vault.ReserveAddress)
+	rx, ry := spendable.AmountOf(pair.QuoteCoinDenom), spendable.AmountOf(pair.BaseCoinDenom)
+	if !rx.IsPositive() || !ry.IsPositive() {
+		return nil, false
+	}
+
+	pool := amm.NewFeeAdjustedPool(amm.NewBasicPool(rx, ry, sdk.Int{}), vault.FeeRate)
+	orderer := types.NewSourceOrderer(source.name, vault.ReserveAddress, pair.BaseCoinDenom, pair.QuoteCoinDenom)
+	return amm.NewPoolOrderSource(pool, orderer, int(source.keeper.GetTickPrecision(ctx))), true
+}
@@ -0,0 +1,52 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	utils "github.com/crescent-network/crescent/v4/types"
+	"github.com/crescent-network/crescent/v4/x/liquidity/keeper"
+	"github.com/crescent-network/crescent/v4/x/liquidity/types"
+)
+
+func (s *KeeperTestSuite) TestNewExternalAMMOrderSource() {
+	s.Require().Panics(func() {
+		keeper.NewExternalAMMOrderSource(s.keeper, "external",
+			types.NewExternalAMMVault(1, s.addr(0), utils.ParseDec("1.0")))
+	})
+	s.Require().Panics(func() {
+		keeper.NewExternalAMMOrderSource(s.keeper, "external",
+			types.NewExternalAMMVault(1, s.addr(0), utils.ParseDec("0.003")),
+			types.NewExternalAMMVault(1, s.addr(1), utils.ParseDec("0.003")))
+	})
+}
+
+func (s *KeeperTestSuite) TestExternalAMMOrderSource() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+
+	vaultAddr := s.addr(1)
+	s.keeper.RegisterOrderSource(keeper.NewExternalAMMOrderSource(s.keeper, "external",
+		types.NewExternalAMMVault(pair.Id, vaultAddr, utils.ParseDec("0.01"))))
+
+	// A vault without reserves provides no orders.
+	s.buyLimitOrder(s.addr(2), pair.Id, utils.ParseDec("1.05"), sdk.NewInt(10000), 0, true)
+	s.nextBlock()
+	s.Require().True(s.getBalance(s.addr(2), "denom1").IsZero())
+	pair, _ = s.keeper.GetPair(s.ctx, pair.Id)
+	s.Require().Nil(pair.LastPrice)
+
+	s.fundAddr(vaultAddr, utils.ParseCoins("1000000denom1,1000000denom2"))
+	s.buyLimitOrder(s.addr(3), pair.Id, utils.ParseDec("1.05"), sdk.NewInt(10000), 0, true)
+	s.nextBlock()
+
+	pair, _ = s.keeper.GetPair(s.ctx, pair.Id)
+	s.Require().NotNil(pair.LastPrice)
+	// The vault sells at a price higher than its curve's price by the fee rate.
+	s.Require().True(pair.LastPrice.GTE(utils.ParseDec("1").Quo(utils.ParseDec("0.99"))))
+
+	s.Require().True(s.getBalance(s.addr(3), "denom1").Amount.IsPositive())
+	rx := s.getBalance(vaultAddr, "denom2").Amount
+	ry := s.getBalance(vaultAddr, "denom1").Amount
+	s.Require().True(ry.LT(sdk.NewInt(1000000)))
+	// The fee is accrued to the vault's reserves.
+	s.Require().True(rx.Mul(ry).GT(sdk.NewInt(1000000).Mul(sdk.NewInt(1000000))))
+}
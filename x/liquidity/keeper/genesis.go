@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/crescent-network/crescent/v4/x/liquidity/types"
@@ -41,6 +43,39 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 	for _, index := range genState.MarketMakingOrderIndexes {
 		k.SetMMOrderIndex(ctx, index)
 	}
+	for _, fees := range genState.AccruedSwapFees {
+		k.SetAccruedSwapFees(ctx, fees)
+	}
+	for _, entry := range genState.PriceHistoryEntries {
+		k.SetPriceHistoryEntry(ctx, entry)
+	}
+	k.SetLastVaultId(ctx, genState.LastVaultId)
+	for _, vault := range genState.Vaults {
+		k.SetVault(ctx, vault)
+		k.SetVaultsByPairIndex(ctx, vault)
+	}
+	for _, result := range genState.RequestResults {
+		k.SetRequestResult(ctx, result)
+	}
+	for _, bucket := range genState.PairStatsBuckets {
+		k.SetPairStatsBucket(ctx, bucket)
+	}
+	k.SetLastSwapRouteId(ctx, genState.LastSwapRouteId)
+	for _, route := range genState.SwapRoutes {
+		k.SetSwapRoute(ctx, route)
+	}
+	for _, result := range genState.BatchResults {
+		k.SetBatchResult(ctx, result)
+	}
+	for _, commit := range genState.OrderCommits {
+		k.SetOrderCommit(ctx, commit)
+	}
+
+	// The module account holds the minted pool coins to be sent to
+	// depositors, so sending coins to it must be rejected.
+	if moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName); !k.bankKeeper.BlockedAddr(moduleAddr) {
+		panic(fmt.Sprintf("%s module account %s is not a blocked address", types.ModuleName, moduleAddr))
+	}
 }
 
 // ExportGenesis returns the capability module's exported genesis.
@@ -55,5 +90,15 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		WithdrawRequests:         k.GetAllWithdrawRequests(ctx),
 		Orders:                   k.GetAllOrders(ctx),
 		MarketMakingOrderIndexes: k.GetAllMMOrderIndexes(ctx),
+		AccruedSwapFees:          k.GetAllAccruedSwapFees(ctx),
+		PriceHistoryEntries:      k.GetAllPriceHistoryEntries(ctx),
+		LastVaultId:              k.GetLastVaultId(ctx),
+		Vaults:                   k.GetAllVaults(ctx),
+		RequestResults:           k.GetAllRequestResults(ctx),
+		PairStatsBuckets:         k.GetAllPairStatsBuckets(ctx),
+		LastSwapRouteId:          k.GetLastSwapRouteId(ctx),
+		SwapRoutes:               k.GetAllSwapRoutes(ctx),
+		BatchResults:             k.GetAllBatchResults(ctx),
+		OrderCommits:             k.GetAllOrderCommits(ctx),
 	}
 }
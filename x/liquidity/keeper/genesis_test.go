@@ -64,6 +64,57 @@ func (s *KeeperTestSuite) TestImportExportGenesis() {
 	s.Require().Equal(order, order2)
 }
 
+func (s *KeeperTestSuite) TestImportExportGenesisMidBatch() {
+	s.ctx = s.ctx.WithBlockHeight(1).WithBlockTime(utils.ParseTime("2022-01-01T00:00:00Z"))
+
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pool := s.createPool(s.addr(0), pair.Id, utils.ParseCoins("1000000denom1,1000000denom2"), true)
+	s.buyLimitOrder(s.addr(1), pair.Id, utils.ParseDec("1.1"), newInt(10000), 0, true)
+	s.nextBlock()
+
+	// Requests of the current batch, which is not executed yet.
+	s.deposit(s.addr(2), pool.Id, utils.ParseCoins("1000000denom1,1000000denom2"), true)
+	s.withdraw(s.addr(0), pool.Id, utils.ParseCoin("100000pool1"))
+	buyOrder := s.buyLimitOrder(s.addr(3), pair.Id, utils.ParseDec("1.05"), newInt(10000), time.Hour, true)
+	sellOrder := s.sellLimitOrder(s.addr(4), pair.Id, utils.ParseDec("0.95"), newInt(20000), time.Hour, true)
+
+	genState := s.keeper.ExportGenesis(s.ctx)
+	var genState2 types.GenesisState
+	s.app.AppCodec().MustUnmarshalJSON(s.app.AppCodec().MustMarshalJSON(genState), &genState2)
+
+	// Execute the batch without exporting the genesis.
+	ctx1, _ := s.ctx.CacheContext()
+	liquidity.EndBlocker(ctx1, s.keeper)
+
+	// Execute the batch after re-importing the exported genesis into an
+	// emptied store.
+	ctx2, _ := s.ctx.CacheContext()
+	store := ctx2.KVStore(s.app.GetKey(types.StoreKey))
+	iter := store.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	s.keeper.InitGenesis(ctx2, genState2)
+	liquidity.EndBlocker(ctx2, s.keeper)
+
+	s.Require().Equal(s.keeper.ExportGenesis(ctx1), s.keeper.ExportGenesis(ctx2))
+	for _, order := range []types.Order{buyOrder, sellOrder} {
+		order2, found := s.keeper.GetOrder(ctx2, order.PairId, order.Id)
+		s.Require().True(found)
+		s.Require().NotEqual(types.OrderStatusNotExecuted, order2.Status)
+		s.Require().Equal(
+			s.app.BankKeeper.GetAllBalances(ctx1, order.GetOrderer()),
+			s.app.BankKeeper.GetAllBalances(ctx2, order.GetOrderer()))
+	}
+	pair2, _ := s.keeper.GetPair(ctx2, pair.Id)
+	s.Require().NotNil(pair2.LastPrice)
+}
+
 func (s *KeeperTestSuite) TestImportExportGenesisEmpty() {
 	genState := s.keeper.ExportGenesis(s.ctx)
 
@@ -25,9 +25,7 @@ var _ types.QueryServer = Querier{}
 // Params queries the parameters of the liquidity module.
 func (k Querier) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
-	var params types.Params
-	k.Keeper.paramSpace.GetParamSet(ctx, &params)
-	return &types.QueryParamsResponse{Params: params}, nil
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
 }
 
 // Pairs queries all pairs.
@@ -112,6 +110,261 @@ func (k Querier) Pair(c context.Context, req *types.QueryPairRequest) (*types.Qu
 	return &types.QueryPairResponse{Pair: pair}, nil
 }
 
+// PricesHistory queries the price history of the pair as candles.
+func (k Querier) PricesHistory(c context.Context, req *types.QueryPricesHistoryRequest) (*types.QueryPricesHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.PairId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "pair id cannot be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if _, found := k.GetPair(ctx, req.PairId); !found {
+		return nil, status.Errorf(codes.NotFound, "pair %d doesn't exist", req.PairId)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	entryStore := prefix.NewStore(store, types.GetPriceHistoryEntriesByPairKeyPrefix(req.PairId))
+
+	var entries []types.PriceHistoryEntry
+	pageRes, err := query.Paginate(entryStore, req.Pagination, func(key, value []byte) error {
+		var entry types.PriceHistoryEntry
+		if err := k.cdc.Unmarshal(value, &entry); err != nil {
+			return err
+		}
+		entries = append(entries, entry)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	// Candles are always made in chronological order.
+	if req.Pagination != nil && req.Pagination.Reverse {
+		for i, j := 0, len(entries)-1; i < j; i, j = i+1, j-1 {
+			entries[i], entries[j] = entries[j], entries[i]
+		}
+	}
+
+	return &types.QueryPricesHistoryResponse{Candles: types.MakeCandles(entries, req.Interval), Pagination: pageRes}, nil
+}
+
+// TWAP queries the time-weighted average price of the pair.
+func (k Querier) TWAP(c context.Context, req *types.QueryTWAPRequest) (*types.QueryTWAPResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.PairId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "pair id cannot be 0")
+	}
+
+	if req.Duration <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "duration must be positive")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if _, found := k.GetPair(ctx, req.PairId); !found {
+		return nil, status.Errorf(codes.NotFound, "pair %d doesn't exist", req.PairId)
+	}
+
+	twap, err := k.GetTWAP(ctx, req.PairId, req.Duration)
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	return &types.QueryTWAPResponse{Twap: twap}, nil
+}
+
+// EscrowBalanceDiffs queries the differences between the expected and actual
+// balances of escrow accounts.
+func (k Querier) EscrowBalanceDiffs(c context.Context, req *types.QueryEscrowBalanceDiffsRequest) (*types.QueryEscrowBalanceDiffsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	diffs := k.Keeper.EscrowBalanceDiffs(ctx)
+	if diffs == nil {
+		diffs = []types.EscrowBalanceDiff{}
+	}
+
+	return &types.QueryEscrowBalanceDiffsResponse{Diffs: diffs}, nil
+}
+
+// PoolOrders queries the orders each pool of the pair places in the next batch.
+func (k Querier) PoolOrders(c context.Context, req *types.QueryPoolOrdersRequest) (*types.QueryPoolOrdersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.PairId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "pair id cannot be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	pair, found := k.GetPair(ctx, req.PairId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "pair %d doesn't exist", req.PairId)
+	}
+
+	// Pools place their orders at a single price without a last price,
+	// so there's no order ladder to return.
+	if pair.LastPrice == nil {
+		return nil, status.Errorf(codes.Unavailable, "pair %d does not have last price", req.PairId)
+	}
+
+	tickPrec := int(k.GetTickPrecision(ctx))
+	lowestPrice, highestPrice := k.PriceLimits(ctx, pair)
+
+	pools := []types.PoolOrdersResponse{}
+	_ = k.IteratePoolsByPair(ctx, pair.Id, func(pool types.Pool) (stop bool, err error) {
+		if pool.Disabled {
+			return false, nil
+		}
+		rx, ry := k.getPoolBalances(ctx, pool, pair)
+		ps := k.GetPoolCoinSupply(ctx, pool)
+		ammPool := types.NewPoolOrderer(
+			pool.AMMPool(rx.Amount, ry.Amount, ps),
+			pool.Id, pool.GetReserveAddress(), pair.BaseCoinDenom, pair.QuoteCoinDenom)
+		if ammPool.IsDepleted() {
+			return false, nil
+		}
+		pools = append(pools, types.NewPoolOrdersResponse(
+			pool.Id,
+			amm.PoolBuyOrders(ammPool, ammPool, lowestPrice, highestPrice, tickPrec),
+			amm.PoolSellOrders(ammPool, ammPool, lowestPrice, highestPrice, tickPrec)))
+		return false, nil
+	})
+
+	return &types.QueryPoolOrdersResponse{
+		LowestPrice:  lowestPrice,
+		HighestPrice: highestPrice,
+		Pools:        pools,
+	}, nil
+}
+
+// SimulateBatch simulates the next batch of the pair with a hypothetical order.
+func (k Querier) SimulateBatch(c context.Context, req *types.QuerySimulateBatchRequest) (*types.QuerySimulateBatchResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.PairId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "pair id cannot be 0")
+	}
+
+	var dir amm.OrderDirection
+	switch req.Direction {
+	case types.OrderDirectionBuy:
+		dir = amm.Buy
+	case types.OrderDirectionSell:
+		dir = amm.Sell
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "invalid order direction: %s", req.Direction)
+	}
+
+	if req.Price != nil && !req.Price.IsPositive() {
+		return nil, status.Error(codes.InvalidArgument, "price must be positive")
+	}
+
+	if req.Amount.IsNil() || req.Amount.LT(amm.MinCoinAmount) || req.Amount.GT(amm.MaxCoinAmount) {
+		return nil, status.Errorf(codes.InvalidArgument, "order amount must be in range [%s, %s]", amm.MinCoinAmount, amm.MaxCoinAmount)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	pair, found := k.GetPair(ctx, req.PairId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "pair %d doesn't exist", req.PairId)
+	}
+
+	if pair.IsBootstrapping() {
+		return nil, status.Errorf(codes.FailedPrecondition, "pair %d is bootstrapping", req.PairId)
+	}
+
+	if k.IsPairHalted(ctx, pair) {
+		return nil, status.Errorf(codes.FailedPrecondition, "pair %d is halted", req.PairId)
+	}
+
+	tickPrec := int(k.GetTickPrecision(ctx))
+
+	var price sdk.Dec
+	if pair.LastPrice != nil {
+		lowestPrice, highestPrice := k.OrderPriceLimits(ctx, pair)
+		switch {
+		case req.Price == nil && dir == amm.Buy:
+			price = highestPrice
+		case req.Price == nil && dir == amm.Sell:
+			price = lowestPrice
+		case req.Price.GT(highestPrice), req.Price.LT(lowestPrice):
+			return nil, status.Errorf(codes.InvalidArgument, "price is out of range [%s, %s]", lowestPrice, highestPrice)
+		}
+	} else if req.Price == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "pair %d does not have last price", req.PairId)
+	}
+	if req.Price != nil {
+		switch dir {
+		case amm.Buy:
+			price = amm.PriceToDownTick(*req.Price, tickPrec)
+		case amm.Sell:
+			price = amm.PriceToUpTick(*req.Price, tickPrec)
+		}
+	}
+
+	offerCoinAmt, err := amm.SafeOfferCoinAmount(dir, price, req.Amount)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "offer coin amount overflows")
+	}
+
+	var offerCoinDenom, demandCoinDenom string
+	switch dir {
+	case amm.Buy:
+		offerCoinDenom, demandCoinDenom = pair.QuoteCoinDenom, pair.BaseCoinDenom
+	case amm.Sell:
+		offerCoinDenom, demandCoinDenom = pair.BaseCoinDenom, pair.QuoteCoinDenom
+	}
+	baseOrder := amm.NewBaseOrder(dir, price, req.Amount, offerCoinAmt)
+	baseOrder.DemandCoinDenom = demandCoinDenom
+	order := &types.UserOrder{
+		BaseOrder:      baseOrder,
+		OrderId:        pair.LastOrderId + 1,
+		BatchId:        pair.CurrentBatchId,
+		OfferCoinDenom: offerCoinDenom,
+		MinFillAmount:  sdk.ZeroInt(),
+	}
+
+	matchPrice, matched, err := k.SimulateMatching(ctx, pair, order)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &types.QuerySimulateBatchResponse{
+		OrderPrice:    price,
+		MatchedAmount: sdk.ZeroInt(),
+		PaidCoin:      sdk.NewInt64Coin(offerCoinDenom, 0),
+		ReceivedCoin:  sdk.NewInt64Coin(demandCoinDenom, 0),
+		SwapFee:       sdk.NewInt64Coin(demandCoinDenom, 0),
+	}
+	if matched {
+		resp.MatchPrice = &matchPrice
+		if order.IsMatched() {
+			swapFee := order.ReceivedDemandCoinAmount.ToDec().Mul(k.GetSwapFeeRate(ctx)).TruncateInt()
+			resp.MatchedAmount = order.Amount.Sub(order.OpenAmount)
+			resp.PaidCoin = sdk.NewCoin(offerCoinDenom, order.PaidOfferCoinAmount)
+			resp.ReceivedCoin = sdk.NewCoin(demandCoinDenom, order.ReceivedDemandCoinAmount.Sub(swapFee))
+			resp.SwapFee = sdk.NewCoin(demandCoinDenom, swapFee)
+		}
+	}
+
+	return resp, nil
+}
+
 // Pools queries all pools.
 func (k Querier) Pools(c context.Context, req *types.QueryPoolsRequest) (*types.QueryPoolsResponse, error) {
 	if req == nil {
@@ -428,7 +681,11 @@ func (k Querier) Orders(c context.Context, req *types.QueryOrdersRequest) (*type
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	return &types.QueryOrdersResponse{Orders: orders, Pagination: pageRes}, nil
+	return &types.QueryOrdersResponse{
+		Orders:        orders,
+		Pagination:    pageRes,
+		AddressLabels: k.ordererLabels(ctx, orders),
+	}, nil
 }
 
 // Order queries the specific order.
@@ -437,22 +694,38 @@ func (k Querier) Order(c context.Context, req *types.QueryOrderRequest) (*types.
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
 
-	if req.PairId == 0 {
+	pairId, id := req.PairId, req.Id
+	if req.Ref != "" {
+		if pairId != 0 || id != 0 {
+			return nil, status.Error(codes.InvalidArgument, "pair id and id must not be specified along with ref")
+		}
+		var err error
+		pairId, id, err = types.ParseOrderRef(req.Ref)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	if pairId == 0 {
 		return nil, status.Error(codes.InvalidArgument, "pair id cannot be 0")
 	}
 
-	if req.Id == 0 {
+	if id == 0 {
 		return nil, status.Error(codes.InvalidArgument, "id cannot be 0")
 	}
 
 	ctx := sdk.UnwrapSDKContext(c)
 
-	order, found := k.GetOrder(ctx, req.PairId, req.Id)
+	order, found := k.GetOrder(ctx, pairId, id)
 	if !found {
-		return nil, status.Errorf(codes.NotFound, "order %d in pair %d not found", req.PairId, req.Id)
+		return nil, status.Errorf(codes.NotFound, "order %d in pair %d not found", id, pairId)
 	}
 
-	return &types.QueryOrderResponse{Order: order}, nil
+	return &types.QueryOrderResponse{
+		Order:         order,
+		AddressLabels: k.ordererLabels(ctx, []types.Order{order}),
+		Ref:           order.Ref(),
+	}, nil
 }
 
 // OrdersByOrderer returns orders made by an orderer.
@@ -469,16 +742,26 @@ func (k Querier) OrdersByOrderer(c context.Context, req *types.QueryOrdersByOrde
 	ctx := sdk.UnwrapSDKContext(c)
 	store := ctx.KVStore(k.storeKey)
 
+	if _, ok := types.OrderStatus_name[int32(req.Status)]; !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid order status: %d", req.Status)
+	}
+
 	keyPrefix := types.GetOrderIndexKeyPrefix(orderer)
+	if req.PairId != 0 {
+		keyPrefix = types.GetOrderIndexKeyPrefixByPair(orderer, req.PairId)
+	}
 	orderStore := prefix.NewStore(store, keyPrefix)
 	var orders []types.Order
 	pageRes, err := query.FilteredPaginate(orderStore, req.Pagination, func(key, value []byte, accumulate bool) (bool, error) {
 		_, pairId, orderId := types.ParseOrderIndexKey(append(keyPrefix, key...))
-		if req.PairId != 0 && pairId != req.PairId {
+
+		order, found := k.GetOrder(ctx, pairId, orderId)
+		if !found {
+			return false, nil
+		}
+		if req.Status != types.OrderStatusUnspecified && order.Status != req.Status {
 			return false, nil
 		}
-
-		order, _ := k.GetOrder(ctx, pairId, orderId)
 
 		if accumulate {
 			orders = append(orders, order)
@@ -490,7 +773,31 @@ func (k Querier) OrdersByOrderer(c context.Context, req *types.QueryOrdersByOrde
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	return &types.QueryOrdersResponse{Orders: orders, Pagination: pageRes}, nil
+	return &types.QueryOrdersResponse{
+		Orders:        orders,
+		Pagination:    pageRes,
+		AddressLabels: k.ordererLabels(ctx, orders),
+	}, nil
+}
+
+// ordererLabels returns labels of the distinct orderers of the orders, in
+// the order of their first appearance.
+func (k Querier) ordererLabels(ctx sdk.Context, orders []types.Order) []types.AddressLabel {
+	if len(k.addressLabelers) == 0 {
+		return nil
+	}
+	var labels []types.AddressLabel
+	seen := map[string]struct{}{}
+	for _, order := range orders {
+		if _, ok := seen[order.Orderer]; ok {
+			continue
+		}
+		seen[order.Orderer] = struct{}{}
+		if label, found := k.AddressLabel(ctx, order.GetOrderer()); found {
+			labels = append(labels, types.AddressLabel{Address: order.Orderer, Label: label})
+		}
+	}
+	return labels
 }
 
 // OrderBooks queries virtual order books from user orders and pools.
@@ -553,7 +860,7 @@ func (k Querier) OrderBooks(c context.Context, req *types.QueryOrderBooksRequest
 			return false, nil
 		})
 
-		lowestPrice, highestPrice := k.PriceLimits(ctx, *pair.LastPrice)
+		lowestPrice, highestPrice := k.PriceLimits(ctx, pair)
 		_ = k.IteratePoolsByPair(ctx, pairId, func(pool types.Pool) (stop bool, err error) {
 			if pool.Disabled {
 				return false, nil
@@ -584,3 +891,322 @@ func (k Querier) OrderBooks(c context.Context, req *types.QueryOrderBooksRequest
 		Pairs: pairs,
 	}, nil
 }
+
+// Vaults queries all vaults.
+func (k Querier) Vaults(c context.Context, req *types.QueryVaultsRequest) (*types.QueryVaultsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(k.storeKey)
+
+	var keyPrefix []byte
+	var vaultGetter func(key, value []byte) types.Vault
+	switch {
+	case req.PairId == 0:
+		keyPrefix = types.VaultKeyPrefix
+		vaultGetter = func(_, value []byte) types.Vault {
+			var vault types.Vault
+			k.cdc.MustUnmarshal(value, &vault)
+			return vault
+		}
+	default:
+		keyPrefix = types.GetVaultsByPairIndexKeyPrefix(req.PairId)
+		vaultGetter = func(key, _ []byte) types.Vault {
+			vaultId := types.ParseVaultsByPairIndexKey(append(keyPrefix, key...))
+			vault, _ := k.GetVault(ctx, vaultId)
+			return vault
+		}
+	}
+
+	vaultStore := prefix.NewStore(store, keyPrefix)
+
+	pairMap := map[uint64]types.Pair{}
+	var vaultsRes []types.VaultResponse
+	pageRes, err := query.Paginate(vaultStore, req.Pagination, func(key, value []byte) error {
+		vault := vaultGetter(key, value)
+		pair, ok := pairMap[vault.PairId]
+		if !ok {
+			pair, _ = k.GetPair(ctx, vault.PairId)
+			pairMap[vault.PairId] = pair
+		}
+		rx, ry := k.GetVaultBalances(ctx, vault, pair)
+		ps := k.GetVaultShareSupply(ctx, vault)
+		vaultsRes = append(vaultsRes, types.NewVaultResponse(vault, rx, ry, ps, pair.LastPrice))
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryVaultsResponse{Vaults: vaultsRes, Pagination: pageRes}, nil
+}
+
+// Vault queries the specific vault.
+func (k Querier) Vault(c context.Context, req *types.QueryVaultRequest) (*types.QueryVaultResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.VaultId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "vault id cannot be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	vault, found := k.GetVault(ctx, req.VaultId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "vault %d doesn't exist", req.VaultId)
+	}
+
+	pair, _ := k.GetPair(ctx, vault.PairId)
+
+	rx, ry := k.GetVaultBalances(ctx, vault, pair)
+	ps := k.GetVaultShareSupply(ctx, vault)
+	return &types.QueryVaultResponse{Vault: types.NewVaultResponse(vault, rx, ry, ps, pair.LastPrice)}, nil
+}
+
+// RequestResult queries the result of a finished request or order.
+func (k Querier) RequestResult(c context.Context, req *types.QueryRequestResultRequest) (*types.QueryRequestResultResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if !req.Type.IsValid() {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request type: %s", req.Type)
+	}
+
+	if req.TargetId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "target id cannot be 0")
+	}
+
+	if req.Id == 0 {
+		return nil, status.Error(codes.InvalidArgument, "id cannot be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	result, found := k.GetRequestResult(ctx, req.Type, req.TargetId, req.Id)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "result of %s %d in %d doesn't exist", req.Type, req.Id, req.TargetId)
+	}
+
+	return &types.QueryRequestResultResponse{Result: result}, nil
+}
+
+// BatchResult queries the summary of the matching executed in a pair's batch.
+func (k Querier) BatchResult(c context.Context, req *types.QueryBatchResultRequest) (*types.QueryBatchResultResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.PairId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "pair id cannot be 0")
+	}
+
+	if req.BatchId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "batch id cannot be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	result, found := k.GetBatchResult(ctx, req.PairId, req.BatchId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "result of batch %d in pair %d doesn't exist", req.BatchId, req.PairId)
+	}
+
+	return &types.QueryBatchResultResponse{Result: result}, nil
+}
+
+// ProtoDescriptors queries the descriptors of the module's proto files with
+// their comments.
+func (k Querier) ProtoDescriptors(c context.Context, req *types.QueryProtoDescriptorsRequest) (*types.QueryProtoDescriptorsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	return &types.QueryProtoDescriptorsResponse{FileDescriptorSet: types.FileDescriptorSet()}, nil
+}
+
+// PoolCoinValue queries the underlying coins redeemable for the pool coin
+// amount and the price of the pool coin.
+func (k Querier) PoolCoinValue(c context.Context, req *types.QueryPoolCoinValueRequest) (*types.QueryPoolCoinValueResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.PoolId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "pool id cannot be 0")
+	}
+
+	if req.PoolCoinAmount.IsNil() || !req.PoolCoinAmount.IsPositive() {
+		return nil, status.Error(codes.InvalidArgument, "pool coin amount must be positive")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	pool, found := k.GetPool(ctx, req.PoolId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "pool %d doesn't exist", req.PoolId)
+	}
+	if pool.Disabled {
+		return nil, status.Errorf(codes.FailedPrecondition, "pool %d is disabled", req.PoolId)
+	}
+
+	pair, _ := k.GetPair(ctx, pool.PairId)
+	rx, ry := k.getPoolBalances(ctx, pool, pair)
+	ps := k.GetPoolCoinSupply(ctx, pool)
+	if req.PoolCoinAmount.GT(ps) {
+		return nil, status.Errorf(
+			codes.InvalidArgument, "pool coin amount %s is greater than the pool coin supply %s", req.PoolCoinAmount, ps)
+	}
+	ammPool := pool.AMMPool(rx.Amount, ry.Amount, ps)
+	if ammPool.IsDepleted() {
+		return nil, status.Errorf(codes.FailedPrecondition, "pool %d is depleted", req.PoolId)
+	}
+
+	x, y := amm.Withdraw(rx.Amount, ry.Amount, ps, req.PoolCoinAmount, k.GetWithdrawFeeRate(ctx))
+	redeemableCoins := sdk.NewCoins(sdk.NewCoin(pair.QuoteCoinDenom, x), sdk.NewCoin(pair.BaseCoinDenom, y))
+
+	price := ammPool.Price()
+	if pair.LastPrice != nil {
+		price = *pair.LastPrice
+	}
+	poolCoinPrice := rx.Amount.ToDec().Add(ry.Amount.ToDec().Mul(price)).QuoInt(ps)
+
+	return &types.QueryPoolCoinValueResponse{
+		RedeemableCoins: redeemableCoins,
+		PoolCoinPrice:   poolCoinPrice,
+		Value:           poolCoinPrice.MulInt(req.PoolCoinAmount),
+	}, nil
+}
+
+// PairStats queries the trading statistics of the pair over the last 24 hours.
+func (k Querier) PairStats(c context.Context, req *types.QueryPairStatsRequest) (*types.QueryPairStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.PairId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "pair id cannot be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if _, found := k.GetPair(ctx, req.PairId); !found {
+		return nil, status.Errorf(codes.NotFound, "pair %d doesn't exist", req.PairId)
+	}
+
+	return &types.QueryPairStatsResponse{Stats: k.GetPairStats(ctx, req.PairId)}, nil
+}
+
+// Dust queries the dust held in pair escrows and disabled pools' reserves.
+func (k Querier) Dust(c context.Context, req *types.QueryDustRequest) (*types.QueryDustResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	pairDusts := []types.PairDust{}
+	_ = k.IterateAllPairs(ctx, func(pair types.Pair) (stop bool, err error) {
+		if dust := k.PairDust(ctx, pair); !dust.IsZero() {
+			pairDusts = append(pairDusts, types.PairDust{PairId: pair.Id, Coins: dust})
+		}
+		return false, nil
+	})
+	poolDusts := []types.PoolDust{}
+	_ = k.IterateAllPools(ctx, func(pool types.Pool) (stop bool, err error) {
+		if dust := k.PoolDust(ctx, pool); !dust.IsZero() {
+			poolDusts = append(poolDusts, types.PoolDust{PoolId: pool.Id, Coins: dust})
+		}
+		return false, nil
+	})
+
+	return &types.QueryDustResponse{Pairs: pairDusts, Pools: poolDusts}, nil
+}
+
+// Ticks queries the ticks around the price.
+func (k Querier) Ticks(c context.Context, req *types.QueryTicksRequest) (*types.QueryTicksResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.PairId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "pair id cannot be 0")
+	}
+
+	if req.Price.IsNil() || !req.Price.IsPositive() {
+		return nil, status.Error(codes.InvalidArgument, "price must be positive")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	pair, found := k.GetPair(ctx, req.PairId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "pair %d doesn't exist", req.PairId)
+	}
+
+	tickPrec := k.GetTickPrecision(ctx)
+	lowestTick, highestTick := amm.LowestTick(int(tickPrec)), amm.HighestTick(int(tickPrec))
+	switch {
+	case req.Price.LT(lowestTick):
+		return nil, status.Errorf(codes.InvalidArgument, "price %s is lower than the lowest tick %s", req.Price, lowestTick)
+	case req.Price.GT(highestTick):
+		return nil, status.Errorf(codes.InvalidArgument, "price %s is higher than the highest tick %s", req.Price, highestTick)
+	}
+
+	lowestOrderPrice, highestOrderPrice := lowestTick, highestTick
+	if pair.LastPrice != nil {
+		lowestOrderPrice, highestOrderPrice = k.OrderPriceLimits(ctx, pair)
+	}
+
+	return &types.QueryTicksResponse{
+		TickPrecision:     tickPrec,
+		DownTick:          amm.PriceToDownTick(req.Price, int(tickPrec)),
+		UpTick:            amm.PriceToUpTick(req.Price, int(tickPrec)),
+		TickGap:           amm.TickGap(req.Price, int(tickPrec)),
+		RoundedPrice:      amm.RoundPrice(req.Price, int(tickPrec)),
+		LowestOrderPrice:  lowestOrderPrice,
+		HighestOrderPrice: highestOrderPrice,
+	}, nil
+}
+
+// ModuleAccounts queries the accounts managed by the module.
+func (k Querier) ModuleAccounts(c context.Context, req *types.QueryModuleAccountsRequest) (*types.QueryModuleAccountsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryModuleAccountsResponse{Accounts: k.Keeper.ModuleAccounts(ctx)}, nil
+}
+
+// NumActiveOrders queries the number of active orders of the orderer in the pair.
+func (k Querier) NumActiveOrders(c context.Context, req *types.QueryNumActiveOrdersRequest) (*types.QueryNumActiveOrdersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.PairId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "pair id cannot be 0")
+	}
+
+	orderer, err := sdk.AccAddressFromBech32(req.Orderer)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "orderer address %s is invalid", req.Orderer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if _, found := k.GetPair(ctx, req.PairId); !found {
+		return nil, status.Errorf(codes.NotFound, "pair %d doesn't exist", req.PairId)
+	}
+
+	return &types.QueryNumActiveOrdersResponse{
+		NumActiveOrders:    k.GetNumActiveOrders(ctx, orderer, req.PairId),
+		MaxNumActiveOrders: k.GetMaxNumActiveOrdersPerPair(ctx),
+	}, nil
+}
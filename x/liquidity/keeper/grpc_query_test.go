@@ -4,9 +4,11 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	utils "github.com/crescent-network/crescent/v4/types"
 	"github.com/crescent-network/crescent/v4/x/liquidity"
+	"github.com/crescent-network/crescent/v4/x/liquidity/amm"
 	"github.com/crescent-network/crescent/v4/x/liquidity/types"
 
 	_ "github.com/stretchr/testify/suite"
@@ -147,6 +149,263 @@ func (s *KeeperTestSuite) TestGRPCPair() {
 	}
 }
 
+func (s *KeeperTestSuite) TestGRPCPricesHistory() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	for i, price := range []string{"1.0", "1.2", "0.9", "1.1", "1.05"} {
+		s.keeper.SetPriceHistoryEntry(s.ctx, types.NewPriceHistoryEntry(
+			pair.Id, int64(i+1), utils.ParseTime("2022-01-01T00:00:00Z").Add(time.Duration(i)*time.Second), utils.ParseDec(price)))
+	}
+
+	for _, tc := range []struct {
+		name      string
+		req       *types.QueryPricesHistoryRequest
+		expectErr bool
+		postRun   func(*types.QueryPricesHistoryResponse)
+	}{
+		{
+			"nil request",
+			nil,
+			true,
+			nil,
+		},
+		{
+			"invalid request",
+			&types.QueryPricesHistoryRequest{},
+			true,
+			nil,
+		},
+		{
+			"pair not found",
+			&types.QueryPricesHistoryRequest{
+				PairId: 2,
+			},
+			true,
+			nil,
+		},
+		{
+			"query without interval",
+			&types.QueryPricesHistoryRequest{
+				PairId: pair.Id,
+			},
+			false,
+			func(resp *types.QueryPricesHistoryResponse) {
+				s.Require().Len(resp.Candles, 5)
+				s.Require().EqualValues(3, resp.Candles[2].StartHeight)
+				s.Require().EqualValues(3, resp.Candles[2].EndHeight)
+				s.Require().True(decEq(utils.ParseDec("0.9"), resp.Candles[2].Open))
+				s.Require().True(decEq(utils.ParseDec("0.9"), resp.Candles[2].Close))
+			},
+		},
+		{
+			"query with interval",
+			&types.QueryPricesHistoryRequest{
+				PairId:   pair.Id,
+				Interval: 3,
+			},
+			false,
+			func(resp *types.QueryPricesHistoryResponse) {
+				s.Require().Len(resp.Candles, 2)
+				candle := resp.Candles[0]
+				s.Require().EqualValues(1, candle.StartHeight)
+				s.Require().EqualValues(3, candle.EndHeight)
+				s.Require().Equal(utils.ParseTime("2022-01-01T00:00:00Z"), candle.StartTime)
+				s.Require().Equal(utils.ParseTime("2022-01-01T00:00:02Z"), candle.EndTime)
+				s.Require().True(decEq(utils.ParseDec("1.0"), candle.Open))
+				s.Require().True(decEq(utils.ParseDec("1.2"), candle.High))
+				s.Require().True(decEq(utils.ParseDec("0.9"), candle.Low))
+				s.Require().True(decEq(utils.ParseDec("0.9"), candle.Close))
+				candle = resp.Candles[1]
+				s.Require().EqualValues(4, candle.StartHeight)
+				s.Require().EqualValues(5, candle.EndHeight)
+				s.Require().True(decEq(utils.ParseDec("1.1"), candle.Open))
+				s.Require().True(decEq(utils.ParseDec("1.1"), candle.High))
+				s.Require().True(decEq(utils.ParseDec("1.05"), candle.Low))
+				s.Require().True(decEq(utils.ParseDec("1.05"), candle.Close))
+			},
+		},
+		{
+			"query with pagination",
+			&types.QueryPricesHistoryRequest{
+				PairId:     pair.Id,
+				Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+			},
+			false,
+			func(resp *types.QueryPricesHistoryResponse) {
+				s.Require().Len(resp.Candles, 2)
+				s.Require().EqualValues(1, resp.Candles[0].StartHeight)
+				s.Require().EqualValues(2, resp.Candles[1].StartHeight)
+				s.Require().EqualValues(5, resp.Pagination.Total)
+				s.Require().NotNil(resp.Pagination.NextKey)
+			},
+		},
+		{
+			"query the latest entries with interval",
+			&types.QueryPricesHistoryRequest{
+				PairId:     pair.Id,
+				Interval:   2,
+				Pagination: &query.PageRequest{Limit: 4, Reverse: true},
+			},
+			false,
+			func(resp *types.QueryPricesHistoryResponse) {
+				s.Require().Len(resp.Candles, 2)
+				candle := resp.Candles[0]
+				s.Require().EqualValues(2, candle.StartHeight)
+				s.Require().EqualValues(3, candle.EndHeight)
+				s.Require().True(decEq(utils.ParseDec("1.2"), candle.Open))
+				s.Require().True(decEq(utils.ParseDec("0.9"), candle.Close))
+				candle = resp.Candles[1]
+				s.Require().EqualValues(4, candle.StartHeight)
+				s.Require().EqualValues(5, candle.EndHeight)
+			},
+		},
+	} {
+		s.Run(tc.name, func() {
+			resp, err := s.querier.PricesHistory(sdk.WrapSDKContext(s.ctx), tc.req)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				tc.postRun(resp)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestGRPCTWAP() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	now := utils.ParseTime("2022-01-01T00:01:00Z")
+	s.ctx = s.ctx.WithBlockHeight(10).WithBlockTime(now)
+	s.keeper.SetPriceHistoryEntry(s.ctx, types.NewPriceHistoryEntry(pair.Id, 8, now.Add(-20*time.Second), utils.ParseDec("1.0")))
+	s.keeper.SetPriceHistoryEntry(s.ctx, types.NewPriceHistoryEntry(pair.Id, 9, now.Add(-10*time.Second), utils.ParseDec("2.0")))
+
+	for _, tc := range []struct {
+		name      string
+		req       *types.QueryTWAPRequest
+		expectErr bool
+		postRun   func(*types.QueryTWAPResponse)
+	}{
+		{
+			"nil request",
+			nil,
+			true,
+			nil,
+		},
+		{
+			"invalid request",
+			&types.QueryTWAPRequest{},
+			true,
+			nil,
+		},
+		{
+			"zero duration",
+			&types.QueryTWAPRequest{
+				PairId: pair.Id,
+			},
+			true,
+			nil,
+		},
+		{
+			"pair not found",
+			&types.QueryTWAPRequest{
+				PairId:   2,
+				Duration: 10 * time.Second,
+			},
+			true,
+			nil,
+		},
+		{
+			"insufficient price history",
+			&types.QueryTWAPRequest{
+				PairId:   pair.Id,
+				Duration: time.Minute,
+			},
+			true,
+			nil,
+		},
+		{
+			"happy case",
+			&types.QueryTWAPRequest{
+				PairId:   pair.Id,
+				Duration: 20 * time.Second,
+			},
+			false,
+			func(resp *types.QueryTWAPResponse) {
+				s.Require().True(decEq(utils.ParseDec("1.5"), resp.Twap))
+			},
+		},
+	} {
+		s.Run(tc.name, func() {
+			resp, err := s.querier.TWAP(sdk.WrapSDKContext(s.ctx), tc.req)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				tc.postRun(resp)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestGRPCPairStats() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	s.buyLimitOrder(s.addr(1), pair.Id, utils.ParseDec("1.0"), sdk.NewInt(1000000), time.Hour, true)
+	s.sellLimitOrder(s.addr(2), pair.Id, utils.ParseDec("1.0"), sdk.NewInt(1000000), time.Hour, true)
+	liquidity.EndBlocker(s.ctx, s.keeper)
+
+	for _, tc := range []struct {
+		name      string
+		req       *types.QueryPairStatsRequest
+		expectErr bool
+		postRun   func(*types.QueryPairStatsResponse)
+	}{
+		{
+			"nil request",
+			nil,
+			true,
+			nil,
+		},
+		{
+			"invalid request",
+			&types.QueryPairStatsRequest{},
+			true,
+			nil,
+		},
+		{
+			"pair not found",
+			&types.QueryPairStatsRequest{
+				PairId: 2,
+			},
+			true,
+			nil,
+		},
+		{
+			"happy case",
+			&types.QueryPairStatsRequest{
+				PairId: pair.Id,
+			},
+			false,
+			func(resp *types.QueryPairStatsResponse) {
+				s.Require().Equal(pair.Id, resp.Stats.PairId)
+				s.Require().Equal(sdk.NewInt(1000000), resp.Stats.BaseVolume)
+				s.Require().Equal(sdk.NewInt(1000000), resp.Stats.QuoteVolume)
+				s.Require().EqualValues(2, resp.Stats.NumMatchedOrders)
+				s.Require().True(decEq(utils.ParseDec("1.0"), *resp.Stats.HighPrice))
+				s.Require().True(decEq(utils.ParseDec("1.0"), *resp.Stats.LowPrice))
+			},
+		},
+	} {
+		s.Run(tc.name, func() {
+			resp, err := s.querier.PairStats(sdk.WrapSDKContext(s.ctx), tc.req)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				tc.postRun(resp)
+			}
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestGRPCPools() {
 	creator := s.addr(0)
 	s.createPair(creator, "denom1", "denom2", true)
@@ -815,7 +1074,46 @@ func (s *KeeperTestSuite) TestGRPCOrder() {
 				s.Require().Equal(order.BatchId, resp.Order.BatchId)
 				s.Require().Equal(order.ExpireAt, resp.Order.ExpireAt)
 				s.Require().NotEqual(order.Status, resp.Order.Status)
+				s.Require().Equal("1-1", resp.Ref)
+			},
+		},
+		{
+			"query the order by ref",
+			&types.QueryOrderRequest{
+				Ref: "1-1",
+			},
+			false,
+			func(resp *types.QueryOrderResponse) {
+				s.Require().Equal(order.Id, resp.Order.Id)
+				s.Require().Equal(order.PairId, resp.Order.PairId)
+				s.Require().Equal("1-1", resp.Ref)
+			},
+		},
+		{
+			"invalid ref",
+			&types.QueryOrderRequest{
+				Ref: "1",
+			},
+			true,
+			nil,
+		},
+		{
+			"ref along with pair id and id",
+			&types.QueryOrderRequest{
+				PairId: 1,
+				Id:     1,
+				Ref:    "1-1",
+			},
+			true,
+			nil,
+		},
+		{
+			"order not found",
+			&types.QueryOrderRequest{
+				Ref: "1-2",
 			},
+			true,
+			nil,
 		},
 	} {
 		s.Run(tc.name, func() {
@@ -893,6 +1191,54 @@ func (s *KeeperTestSuite) TestGRPCOrdersByOrderer() {
 				s.Require().Equal(order.PairId, resp.Orders[0].PairId)
 			},
 		},
+		{
+			"query by status",
+			&types.QueryOrdersByOrdererRequest{
+				Orderer: s.addr(1).String(),
+				Status:  types.OrderStatusNotMatched,
+			},
+			false,
+			func(resp *types.QueryOrdersResponse) {
+				s.Require().Len(resp.Orders, 1)
+				s.Require().Equal(order2.PairId, resp.Orders[0].PairId)
+				s.Require().Equal(order2.Id, resp.Orders[0].Id)
+			},
+		},
+		{
+			"query by pair id and status",
+			&types.QueryOrdersByOrdererRequest{
+				Orderer: s.addr(1).String(),
+				PairId:  pair.Id,
+				Status:  types.OrderStatusNotMatched,
+			},
+			false,
+			func(resp *types.QueryOrdersResponse) {
+				s.Require().Len(resp.Orders, 0)
+			},
+		},
+		{
+			"invalid status",
+			&types.QueryOrdersByOrdererRequest{
+				Orderer: s.addr(1).String(),
+				Status:  100,
+			},
+			true,
+			nil,
+		},
+		{
+			"paginate filtered orders",
+			&types.QueryOrdersByOrdererRequest{
+				Orderer:    s.addr(1).String(),
+				Status:     types.OrderStatusCompleted,
+				Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+			},
+			false,
+			func(resp *types.QueryOrdersResponse) {
+				s.Require().Len(resp.Orders, 1)
+				s.Require().Equal(order.Id, resp.Orders[0].Id)
+				s.Require().EqualValues(1, resp.Pagination.Total)
+			},
+		},
 	} {
 		s.Run(tc.name, func() {
 			resp, err := s.querier.OrdersByOrderer(sdk.WrapSDKContext(s.ctx), tc.req)
@@ -1088,3 +1434,745 @@ func (s *KeeperTestSuite) TestSellOrdersOnlyOrderBook() {
 		}
 	}
 }
+
+func (s *KeeperTestSuite) TestGRPCEscrowBalanceDiffs() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	s.buyLimitOrder(s.addr(1), pair.Id, utils.ParseDec("1.0"), newInt(1000000), 0, true)
+
+	_, err := s.querier.EscrowBalanceDiffs(sdk.WrapSDKContext(s.ctx), nil)
+	s.Require().Error(err)
+
+	resp, err := s.querier.EscrowBalanceDiffs(sdk.WrapSDKContext(s.ctx), &types.QueryEscrowBalanceDiffsRequest{})
+	s.Require().NoError(err)
+	s.Require().Empty(resp.Diffs)
+
+	s.fundAddr(pair.GetEscrowAddress(), utils.ParseCoins("1000denom1"))
+	resp, err = s.querier.EscrowBalanceDiffs(sdk.WrapSDKContext(s.ctx), &types.QueryEscrowBalanceDiffsRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(resp.Diffs, 1)
+	s.Require().Equal("pair 1 escrow", resp.Diffs[0].Description)
+	s.Require().Equal("denom1", resp.Diffs[0].Denom)
+	s.Require().Equal(newInt(0), resp.Diffs[0].Expected)
+	s.Require().Equal(newInt(1000), resp.Diffs[0].Actual)
+}
+
+func (s *KeeperTestSuite) TestGRPCDust() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pool := s.createPool(s.addr(1), pair.Id, utils.ParseCoins("1000000denom1,1000000denom2"), true)
+
+	_, err := s.querier.Dust(sdk.WrapSDKContext(s.ctx), nil)
+	s.Require().Error(err)
+
+	resp, err := s.querier.Dust(sdk.WrapSDKContext(s.ctx), &types.QueryDustRequest{})
+	s.Require().NoError(err)
+	s.Require().Empty(resp.Pairs)
+	s.Require().Empty(resp.Pools)
+
+	s.withdraw(s.addr(1), pool.Id, s.getBalance(s.addr(1), pool.PoolCoinDenom))
+	s.nextBlock()
+	s.fundAddr(pair.GetEscrowAddress(), utils.ParseCoins("1000denom1"))
+	s.fundAddr(pool.GetReserveAddress(), utils.ParseCoins("10denom2"))
+
+	resp, err = s.querier.Dust(sdk.WrapSDKContext(s.ctx), &types.QueryDustRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(resp.Pairs, 1)
+	s.Require().Equal(pair.Id, resp.Pairs[0].PairId)
+	s.Require().True(coinsEq(utils.ParseCoins("1000denom1"), resp.Pairs[0].Coins))
+	s.Require().Len(resp.Pools, 1)
+	s.Require().Equal(pool.Id, resp.Pools[0].PoolId)
+	s.Require().True(coinsEq(utils.ParseCoins("10denom2"), resp.Pools[0].Coins))
+}
+
+func (s *KeeperTestSuite) TestGRPCPoolOrders() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pair.LastPrice = utils.ParseDecP("1.0")
+	s.keeper.SetPair(s.ctx, pair)
+	pool := s.createPool(s.addr(0), pair.Id, utils.ParseCoins("1000000000denom1,1000000000denom2"), true)
+	pair2 := s.createPair(s.addr(0), "denom2", "denom3", true)
+
+	for _, tc := range []struct {
+		name      string
+		req       *types.QueryPoolOrdersRequest
+		expectErr bool
+		postRun   func(*types.QueryPoolOrdersResponse)
+	}{
+		{
+			"nil request",
+			nil,
+			true,
+			nil,
+		},
+		{
+			"query by zero pair id",
+			&types.QueryPoolOrdersRequest{PairId: 0},
+			true,
+			nil,
+		},
+		{
+			"query by invalid pair id",
+			&types.QueryPoolOrdersRequest{PairId: 10},
+			true,
+			nil,
+		},
+		{
+			"pair without last price",
+			&types.QueryPoolOrdersRequest{PairId: pair2.Id},
+			true,
+			nil,
+		},
+		{
+			"happy case",
+			&types.QueryPoolOrdersRequest{PairId: pair.Id},
+			false,
+			func(resp *types.QueryPoolOrdersResponse) {
+				s.Require().True(decEq(utils.ParseDec("0.9"), resp.LowestPrice))
+				s.Require().True(decEq(utils.ParseDec("1.1"), resp.HighestPrice))
+				s.Require().Len(resp.Pools, 1)
+				s.Require().Equal(pool.Id, resp.Pools[0].PoolId)
+
+				buyOrders := resp.Pools[0].BuyOrders
+				s.Require().NotEmpty(buyOrders)
+				offered := sdk.ZeroInt()
+				for i, order := range buyOrders {
+					s.Require().True(order.Price.LT(utils.ParseDec("1.0")))
+					s.Require().True(order.Price.GTE(resp.LowestPrice))
+					if i > 0 {
+						s.Require().True(order.Price.LT(buyOrders[i-1].Price))
+					}
+					s.Require().Equal("denom2", order.OfferCoin.Denom)
+					offered = offered.Add(order.OfferCoin.Amount)
+				}
+				s.Require().True(offered.LTE(sdk.NewInt(1000000000)))
+
+				sellOrders := resp.Pools[0].SellOrders
+				s.Require().NotEmpty(sellOrders)
+				for i, order := range sellOrders {
+					s.Require().True(order.Price.GT(utils.ParseDec("1.0")))
+					s.Require().True(order.Price.LTE(resp.HighestPrice))
+					if i > 0 {
+						s.Require().True(order.Price.GT(sellOrders[i-1].Price))
+					}
+					s.Require().Equal("denom1", order.OfferCoin.Denom)
+					s.Require().True(order.Amount.Equal(order.OfferCoin.Amount))
+				}
+			},
+		},
+	} {
+		s.Run(tc.name, func() {
+			resp, err := s.querier.PoolOrders(sdk.WrapSDKContext(s.ctx), tc.req)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				tc.postRun(resp)
+			}
+		})
+	}
+
+	// A sell order matching the pool's best buy order is filled by the pool
+	// at the price and amount of the order.
+	resp, err := s.querier.PoolOrders(sdk.WrapSDKContext(s.ctx), &types.QueryPoolOrdersRequest{PairId: pair.Id})
+	s.Require().NoError(err)
+	bestBuy := resp.Pools[0].BuyOrders[0]
+	s.sellLimitOrder(s.addr(1), pair.Id, bestBuy.Price, bestBuy.Amount, 0, true)
+	s.nextBlock()
+	s.Require().True(s.getBalance(s.addr(1), "denom1").IsZero())
+	pair, _ = s.keeper.GetPair(s.ctx, pair.Id)
+	s.Require().True(decEq(bestBuy.Price, *pair.LastPrice))
+}
+
+func (s *KeeperTestSuite) TestGRPCSimulateBatch() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pair.LastPrice = utils.ParseDecP("1.0")
+	s.keeper.SetPair(s.ctx, pair)
+	s.createPool(s.addr(0), pair.Id, utils.ParseCoins("1000000000denom1,1000000000denom2"), true)
+	s.sellLimitOrder(s.addr(1), pair.Id, utils.ParseDec("1.001"), newInt(1000000), time.Hour, true)
+	pair2 := s.createPair(s.addr(0), "denom2", "denom3", true)
+
+	for _, tc := range []struct {
+		name      string
+		req       *types.QuerySimulateBatchRequest
+		expectErr bool
+		postRun   func(*types.QuerySimulateBatchResponse)
+	}{
+		{
+			"nil request",
+			nil,
+			true,
+			nil,
+		},
+		{
+			"query by zero pair id",
+			&types.QuerySimulateBatchRequest{
+				PairId: 0, Direction: types.OrderDirectionBuy, Amount: newInt(1000000),
+			},
+			true,
+			nil,
+		},
+		{
+			"invalid direction",
+			&types.QuerySimulateBatchRequest{
+				PairId: pair.Id, Direction: types.OrderDirectionUnspecified, Amount: newInt(1000000),
+			},
+			true,
+			nil,
+		},
+		{
+			"too small amount",
+			&types.QuerySimulateBatchRequest{
+				PairId: pair.Id, Direction: types.OrderDirectionBuy, Amount: newInt(10),
+			},
+			true,
+			nil,
+		},
+		{
+			"pair not found",
+			&types.QuerySimulateBatchRequest{
+				PairId: 10, Direction: types.OrderDirectionBuy, Amount: newInt(1000000),
+			},
+			true,
+			nil,
+		},
+		{
+			"price out of range",
+			&types.QuerySimulateBatchRequest{
+				PairId: pair.Id, Direction: types.OrderDirectionBuy, Price: utils.ParseDecP("1.2"), Amount: newInt(1000000),
+			},
+			true,
+			nil,
+		},
+		{
+			"market order without last price",
+			&types.QuerySimulateBatchRequest{
+				PairId: pair2.Id, Direction: types.OrderDirectionBuy, Amount: newInt(1000000),
+			},
+			true,
+			nil,
+		},
+		{
+			"limit order not matched",
+			&types.QuerySimulateBatchRequest{
+				PairId: pair.Id, Direction: types.OrderDirectionBuy, Price: utils.ParseDecP("0.95"), Amount: newInt(1000000),
+			},
+			false,
+			func(resp *types.QuerySimulateBatchResponse) {
+				s.Require().True(decEq(utils.ParseDec("0.95"), resp.OrderPrice))
+				s.Require().True(resp.MatchedAmount.IsZero())
+				s.Require().True(resp.PaidCoin.IsZero())
+				s.Require().True(resp.ReceivedCoin.IsZero())
+			},
+		},
+		{
+			"limit order fit into ticks",
+			&types.QuerySimulateBatchRequest{
+				PairId: pair.Id, Direction: types.OrderDirectionSell, Price: utils.ParseDecP("1.00005"), Amount: newInt(1000000),
+			},
+			false,
+			func(resp *types.QuerySimulateBatchResponse) {
+				s.Require().True(decEq(utils.ParseDec("1.0001"), resp.OrderPrice))
+			},
+		},
+	} {
+		s.Run(tc.name, func() {
+			resp, err := s.querier.SimulateBatch(sdk.WrapSDKContext(s.ctx), tc.req)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				tc.postRun(resp)
+			}
+		})
+	}
+
+	// The simulated result of a market order is the same as the actual result.
+	resp, err := s.querier.SimulateBatch(sdk.WrapSDKContext(s.ctx), &types.QuerySimulateBatchRequest{
+		PairId: pair.Id, Direction: types.OrderDirectionBuy, Amount: newInt(3000000),
+	})
+	s.Require().NoError(err)
+	s.Require().NotNil(resp.MatchPrice)
+	s.Require().True(intEq(newInt(3000000), resp.MatchedAmount))
+
+	// The query doesn't change the state.
+	pair, _ = s.keeper.GetPair(s.ctx, pair.Id)
+	s.Require().EqualValues(1, pair.LastOrderId)
+	s.Require().True(decEq(utils.ParseDec("1.0"), *pair.LastPrice))
+
+	order := s.buyMarketOrder(s.addr(2), pair.Id, newInt(3000000), 0, true)
+	s.nextBlock()
+	pair, _ = s.keeper.GetPair(s.ctx, pair.Id)
+	s.Require().True(decEq(*resp.MatchPrice, *pair.LastPrice))
+	s.Require().True(coinEq(resp.ReceivedCoin, s.getBalance(s.addr(2), "denom1")))
+	s.Require().True(coinEq(order.OfferCoin.Sub(resp.PaidCoin), s.getBalance(s.addr(2), "denom2")))
+}
+
+func (s *KeeperTestSuite) TestGRPCRequestResult() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pool := s.createPool(s.addr(0), pair.Id, utils.ParseCoins("1000000denom1,1000000denom2"), true)
+
+	req := s.deposit(s.addr(1), pool.Id, utils.ParseCoins("1000000denom1,1000000denom2"), true)
+	order := s.buyLimitOrder(s.addr(2), pair.Id, utils.ParseDec("0.9"), sdk.NewInt(10000), time.Hour, true)
+	s.nextBlock()
+	s.cancelOrder(s.addr(2), pair.Id, order.Id)
+	s.nextBlock()
+
+	// The order is deleted, but its result is kept.
+	_, found := s.keeper.GetOrder(s.ctx, pair.Id, order.Id)
+	s.Require().False(found)
+
+	for _, tc := range []struct {
+		name      string
+		req       *types.QueryRequestResultRequest
+		expectErr bool
+		postRun   func(*types.QueryRequestResultResponse)
+	}{
+		{
+			"nil request",
+			nil,
+			true,
+			nil,
+		},
+		{
+			"invalid request type",
+			&types.QueryRequestResultRequest{
+				TargetId: pair.Id,
+				Id:       order.Id,
+			},
+			true,
+			nil,
+		},
+		{
+			"invalid id",
+			&types.QueryRequestResultRequest{
+				Type:     types.RequestTypeOrder,
+				TargetId: pair.Id,
+			},
+			true,
+			nil,
+		},
+		{
+			"result not found",
+			&types.QueryRequestResultRequest{
+				Type:     types.RequestTypeOrder,
+				TargetId: pair.Id,
+				Id:       10,
+			},
+			true,
+			nil,
+		},
+		{
+			"query the deposit request result",
+			&types.QueryRequestResultRequest{
+				Type:     types.RequestTypeDeposit,
+				TargetId: pool.Id,
+				Id:       req.Id,
+			},
+			false,
+			func(resp *types.QueryRequestResultResponse) {
+				s.Require().Equal(req.Depositor, resp.Result.Requester)
+				s.Require().Equal(types.RequestResultCodeSucceeded, resp.Result.Code)
+				s.Require().Empty(resp.Result.Reason)
+			},
+		},
+		{
+			"query the order result",
+			&types.QueryRequestResultRequest{
+				Type:     types.RequestTypeOrder,
+				TargetId: pair.Id,
+				Id:       order.Id,
+			},
+			false,
+			func(resp *types.QueryRequestResultResponse) {
+				s.Require().Equal(order.Orderer, resp.Result.Requester)
+				s.Require().Equal(types.RequestResultCodeCanceled, resp.Result.Code)
+				s.Require().Empty(resp.Result.Reason)
+			},
+		},
+	} {
+		s.Run(tc.name, func() {
+			resp, err := s.querier.RequestResult(sdk.WrapSDKContext(s.ctx), tc.req)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				tc.postRun(resp)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestGRPCProtoDescriptors() {
+	_, err := s.querier.ProtoDescriptors(sdk.WrapSDKContext(s.ctx), nil)
+	s.Require().Error(err)
+
+	resp, err := s.querier.ProtoDescriptors(sdk.WrapSDKContext(s.ctx), &types.QueryProtoDescriptorsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(types.FileDescriptorSet(), resp.FileDescriptorSet)
+}
+
+func (s *KeeperTestSuite) TestGRPCPoolCoinValue() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pool := s.createPool(s.addr(0), pair.Id, utils.ParseCoins("1000000denom1,2000000denom2"), true)
+	ps := s.keeper.GetPoolCoinSupply(s.ctx, pool)
+
+	for _, tc := range []struct {
+		name      string
+		malleate  func()
+		req       *types.QueryPoolCoinValueRequest
+		expectErr bool
+		postRun   func(*types.QueryPoolCoinValueResponse)
+	}{
+		{
+			"nil request",
+			nil,
+			nil,
+			true,
+			nil,
+		},
+		{
+			"query by zero pool id",
+			nil,
+			&types.QueryPoolCoinValueRequest{PoolId: 0, PoolCoinAmount: ps},
+			true,
+			nil,
+		},
+		{
+			"query by invalid pool id",
+			nil,
+			&types.QueryPoolCoinValueRequest{PoolId: 10, PoolCoinAmount: ps},
+			true,
+			nil,
+		},
+		{
+			"nil pool coin amount",
+			nil,
+			&types.QueryPoolCoinValueRequest{PoolId: pool.Id},
+			true,
+			nil,
+		},
+		{
+			"too large pool coin amount",
+			nil,
+			&types.QueryPoolCoinValueRequest{PoolId: pool.Id, PoolCoinAmount: ps.AddRaw(1)},
+			true,
+			nil,
+		},
+		{
+			"pool price without last price",
+			nil,
+			&types.QueryPoolCoinValueRequest{PoolId: pool.Id, PoolCoinAmount: ps.QuoRaw(10)},
+			false,
+			func(resp *types.QueryPoolCoinValueResponse) {
+				s.Require().True(coinsEq(utils.ParseCoins("100000denom1,200000denom2"), resp.RedeemableCoins))
+				// (2000000 + 1000000 * 2.0) / ps
+				s.Require().True(decEq(sdk.NewDec(4000000).QuoInt(ps), resp.PoolCoinPrice))
+				s.Require().True(decEq(resp.PoolCoinPrice.MulInt(ps.QuoRaw(10)), resp.Value))
+			},
+		},
+		{
+			"last price",
+			func() {
+				pair.LastPrice = utils.ParseDecP("1.5")
+				s.keeper.SetPair(s.ctx, pair)
+			},
+			&types.QueryPoolCoinValueRequest{PoolId: pool.Id, PoolCoinAmount: ps},
+			false,
+			func(resp *types.QueryPoolCoinValueResponse) {
+				s.Require().True(coinsEq(utils.ParseCoins("1000000denom1,2000000denom2"), resp.RedeemableCoins))
+				// (2000000 + 1000000 * 1.5) / ps
+				s.Require().True(decEq(sdk.NewDec(3500000).QuoInt(ps), resp.PoolCoinPrice))
+			},
+		},
+		{
+			"withdraw fee",
+			func() {
+				params := s.keeper.GetParams(s.ctx)
+				params.WithdrawFeeRate = utils.ParseDec("0.003")
+				s.keeper.SetParams(s.ctx, params)
+			},
+			&types.QueryPoolCoinValueRequest{PoolId: pool.Id, PoolCoinAmount: ps.QuoRaw(10)},
+			false,
+			func(resp *types.QueryPoolCoinValueResponse) {
+				s.Require().True(coinsEq(utils.ParseCoins("99700denom1,199400denom2"), resp.RedeemableCoins))
+			},
+		},
+	} {
+		s.Run(tc.name, func() {
+			if tc.malleate != nil {
+				tc.malleate()
+			}
+			resp, err := s.querier.PoolCoinValue(sdk.WrapSDKContext(s.ctx), tc.req)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				tc.postRun(resp)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestGRPCTicks() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+
+	for _, tc := range []struct {
+		name      string
+		malleate  func()
+		req       *types.QueryTicksRequest
+		expectErr bool
+		postRun   func(*types.QueryTicksResponse)
+	}{
+		{
+			"nil request",
+			nil,
+			nil,
+			true,
+			nil,
+		},
+		{
+			"query by zero pair id",
+			nil,
+			&types.QueryTicksRequest{PairId: 0, Price: utils.ParseDec("1.0")},
+			true,
+			nil,
+		},
+		{
+			"query by invalid pair id",
+			nil,
+			&types.QueryTicksRequest{PairId: 10, Price: utils.ParseDec("1.0")},
+			true,
+			nil,
+		},
+		{
+			"nil price",
+			nil,
+			&types.QueryTicksRequest{PairId: pair.Id},
+			true,
+			nil,
+		},
+		{
+			"price lower than the lowest tick",
+			nil,
+			&types.QueryTicksRequest{PairId: pair.Id, Price: utils.ParseDec("0.000000000000001")},
+			true,
+			nil,
+		},
+		{
+			"price not on ticks without last price",
+			nil,
+			&types.QueryTicksRequest{PairId: pair.Id, Price: utils.ParseDec("1.23456")},
+			false,
+			func(resp *types.QueryTicksResponse) {
+				s.Require().EqualValues(4, resp.TickPrecision)
+				s.Require().True(decEq(utils.ParseDec("1.2345"), resp.DownTick))
+				s.Require().True(decEq(utils.ParseDec("1.2346"), resp.UpTick))
+				s.Require().True(decEq(utils.ParseDec("0.0001"), resp.TickGap))
+				s.Require().True(decEq(utils.ParseDec("1.2346"), resp.RoundedPrice))
+				s.Require().True(decEq(amm.LowestTick(4), resp.LowestOrderPrice))
+				s.Require().True(decEq(amm.HighestTick(4), resp.HighestOrderPrice))
+			},
+		},
+		{
+			"price on ticks",
+			nil,
+			&types.QueryTicksRequest{PairId: pair.Id, Price: utils.ParseDec("123.4")},
+			false,
+			func(resp *types.QueryTicksResponse) {
+				s.Require().True(decEq(utils.ParseDec("123.4"), resp.DownTick))
+				s.Require().True(decEq(utils.ParseDec("123.4"), resp.UpTick))
+				s.Require().True(decEq(utils.ParseDec("0.01"), resp.TickGap))
+				s.Require().True(decEq(utils.ParseDec("123.4"), resp.RoundedPrice))
+			},
+		},
+		{
+			"last price",
+			func() {
+				pair.LastPrice = utils.ParseDecP("1.0")
+				s.keeper.SetPair(s.ctx, pair)
+			},
+			&types.QueryTicksRequest{PairId: pair.Id, Price: utils.ParseDec("0.987654")},
+			false,
+			func(resp *types.QueryTicksResponse) {
+				s.Require().True(decEq(utils.ParseDec("0.98765"), resp.DownTick))
+				s.Require().True(decEq(utils.ParseDec("0.98766"), resp.UpTick))
+				s.Require().True(decEq(utils.ParseDec("0.00001"), resp.TickGap))
+				s.Require().True(decEq(utils.ParseDec("0.9"), resp.LowestOrderPrice))
+				s.Require().True(decEq(utils.ParseDec("1.1"), resp.HighestOrderPrice))
+			},
+		},
+	} {
+		s.Run(tc.name, func() {
+			if tc.malleate != nil {
+				tc.malleate()
+			}
+			resp, err := s.querier.Ticks(sdk.WrapSDKContext(s.ctx), tc.req)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				tc.postRun(resp)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestGRPCModuleAccounts() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pool := s.createPool(s.addr(0), pair.Id, utils.ParseCoins("1000000denom1,1000000denom2"), true)
+
+	_, err := s.querier.ModuleAccounts(sdk.WrapSDKContext(s.ctx), nil)
+	s.Require().Error(err)
+
+	resp, err := s.querier.ModuleAccounts(sdk.WrapSDKContext(s.ctx), &types.QueryModuleAccountsRequest{})
+	s.Require().NoError(err)
+	accs := map[string]types.ModuleAccountResponse{}
+	for _, acc := range resp.Accounts {
+		accs[acc.Name] = acc
+	}
+	s.Require().Len(accs, 8)
+
+	// Only the module account is a blocked address.
+	s.Require().Equal(s.app.AccountKeeper.GetModuleAddress(types.ModuleName).String(), accs["module"].Address)
+	s.Require().True(accs["module"].Blocked)
+	s.Require().Equal(types.GlobalEscrowAddress.String(), accs["global_escrow"].Address)
+	s.Require().False(accs["global_escrow"].Blocked)
+	s.Require().Equal(s.keeper.GetFeeCollector(s.ctx).String(), accs["fee_collector"].Address)
+	s.Require().True(coinsEq(s.keeper.GetParams(s.ctx).PairCreationFee.Add(s.keeper.GetParams(s.ctx).PoolCreationFee...), accs["fee_collector"].Balances))
+	s.Require().Contains(accs, "dust_collector")
+	s.Require().Contains(accs, "prune_reward_pool")
+
+	s.Require().Equal(pair.GetEscrowAddress().String(), accs["pair_escrow/1"].Address)
+	s.Require().Equal(pool.GetReserveAddress().String(), accs["pool_reserve/1"].Address)
+	s.Require().True(coinsEq(utils.ParseCoins("1000000denom1,1000000denom2"), accs["pool_reserve/1"].Balances))
+	s.Require().Equal(types.PoolFeeAddress(pool.Id).String(), accs["pool_fee/1"].Address)
+	s.Require().NotEmpty(accs["pool_reserve/1"].Purpose)
+}
+
+func (s *KeeperTestSuite) TestGRPCBatchResult() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	s.buyLimitOrder(s.addr(1), pair.Id, utils.ParseDec("1.0"), sdk.NewInt(1000000), time.Hour, true)
+	s.sellLimitOrder(s.addr(2), pair.Id, utils.ParseDec("1.0"), sdk.NewInt(1000000), time.Hour, true)
+	liquidity.EndBlocker(s.ctx, s.keeper)
+
+	for _, tc := range []struct {
+		name      string
+		req       *types.QueryBatchResultRequest
+		expectErr bool
+		postRun   func(*types.QueryBatchResultResponse)
+	}{
+		{
+			"nil request",
+			nil,
+			true,
+			nil,
+		},
+		{
+			"invalid request",
+			&types.QueryBatchResultRequest{
+				PairId: pair.Id,
+			},
+			true,
+			nil,
+		},
+		{
+			"result not found",
+			&types.QueryBatchResultRequest{
+				PairId:  pair.Id,
+				BatchId: 2,
+			},
+			true,
+			nil,
+		},
+		{
+			"happy case",
+			&types.QueryBatchResultRequest{
+				PairId:  pair.Id,
+				BatchId: 1,
+			},
+			false,
+			func(resp *types.QueryBatchResultResponse) {
+				s.Require().Equal(pair.Id, resp.Result.PairId)
+				s.Require().EqualValues(1, resp.Result.BatchId)
+				s.Require().True(resp.Result.Matched)
+				s.Require().True(decEq(utils.ParseDec("1.0"), *resp.Result.MatchPrice))
+				s.Require().Equal(sdk.NewInt(1000000), resp.Result.MatchedBaseAmount)
+				s.Require().EqualValues(2, resp.Result.NumFullyMatchedOrders)
+				s.Require().Zero(resp.Result.NumPartiallyMatchedOrders)
+			},
+		},
+	} {
+		s.Run(tc.name, func() {
+			resp, err := s.querier.BatchResult(sdk.WrapSDKContext(s.ctx), tc.req)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				tc.postRun(resp)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestGRPCNumActiveOrders() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	s.buyLimitOrder(s.addr(1), pair.Id, utils.ParseDec("0.9"), sdk.NewInt(10000), time.Hour, true)
+	s.buyLimitOrder(s.addr(1), pair.Id, utils.ParseDec("0.9"), sdk.NewInt(10000), time.Hour, true)
+
+	for _, tc := range []struct {
+		name      string
+		req       *types.QueryNumActiveOrdersRequest
+		expectErr bool
+		postRun   func(*types.QueryNumActiveOrdersResponse)
+	}{
+		{
+			"nil request",
+			nil,
+			true,
+			nil,
+		},
+		{
+			"query by zero pair id",
+			&types.QueryNumActiveOrdersRequest{PairId: 0, Orderer: s.addr(1).String()},
+			true,
+			nil,
+		},
+		{
+			"query by invalid orderer",
+			&types.QueryNumActiveOrdersRequest{PairId: pair.Id, Orderer: "invalid"},
+			true,
+			nil,
+		},
+		{
+			"query by invalid pair id",
+			&types.QueryNumActiveOrdersRequest{PairId: 10, Orderer: s.addr(1).String()},
+			true,
+			nil,
+		},
+		{
+			"valid request",
+			&types.QueryNumActiveOrdersRequest{PairId: pair.Id, Orderer: s.addr(1).String()},
+			false,
+			func(resp *types.QueryNumActiveOrdersResponse) {
+				s.Require().EqualValues(2, resp.NumActiveOrders)
+				s.Require().EqualValues(types.DefaultMaxNumActiveOrdersPerPair, resp.MaxNumActiveOrders)
+			},
+		},
+		{
+			"orderer without orders",
+			&types.QueryNumActiveOrdersRequest{PairId: pair.Id, Orderer: s.addr(2).String()},
+			false,
+			func(resp *types.QueryNumActiveOrdersResponse) {
+				s.Require().EqualValues(0, resp.NumActiveOrders)
+			},
+		},
+	} {
+		s.Run(tc.name, func() {
+			resp, err := s.querier.NumActiveOrders(sdk.WrapSDKContext(s.ctx), tc.req)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				tc.postRun(resp)
+			}
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/crescent-network/crescent/v4/x/liquidity/types"
+)
+
+// SetHooks sets the liquidity hooks.
+// Like SetLPFarmKeeper, it must be called before the keeper is passed to the
+// liquidity module, and multiple hooks can be set at once using
+// types.NewMultiLiquidityHooks.
+func (k *Keeper) SetHooks(lh types.LiquidityHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set liquidity hooks twice")
+	}
+	k.hooks = lh
+	return k
+}
+
+func (k Keeper) afterPoolCreated(ctx sdk.Context, creator sdk.AccAddress, pool types.Pool) {
+	if k.hooks != nil {
+		k.hooks.AfterPoolCreated(ctx, creator, pool)
+	}
+}
+
+func (k Keeper) afterDeposit(ctx sdk.Context, req types.DepositRequest) {
+	if k.hooks != nil {
+		k.hooks.AfterDeposit(ctx, req.GetDepositor(), req.PoolId, req.AcceptedCoins, req.MintedPoolCoin)
+	}
+}
+
+func (k Keeper) afterWithdraw(ctx sdk.Context, req types.WithdrawRequest) {
+	if k.hooks != nil {
+		k.hooks.AfterWithdraw(ctx, req.GetWithdrawer(), req.PoolId, req.PoolCoin, req.WithdrawnCoins)
+	}
+}
+
+func (k Keeper) afterSwapMatched(
+	ctx sdk.Context, orderer sdk.AccAddress, pairId, orderId uint64, paidCoin, receivedCoin sdk.Coin) {
+	if k.hooks != nil {
+		k.hooks.AfterSwapMatched(ctx, orderer, pairId, orderId, paidCoin, receivedCoin)
+	}
+}
@@ -0,0 +1,85 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	utils "github.com/crescent-network/crescent/v4/types"
+	"github.com/crescent-network/crescent/v4/x/liquidity"
+	"github.com/crescent-network/crescent/v4/x/liquidity/keeper"
+	"github.com/crescent-network/crescent/v4/x/liquidity/types"
+)
+
+var _ types.LiquidityHooks = (*mockLiquidityHooks)(nil)
+
+// mockLiquidityHooks records the calls of the hooks.
+type mockLiquidityHooks struct {
+	poolsCreated []uint64
+	deposits     []sdk.Coin
+	withdrawals  []sdk.Coins
+	swapsMatched []uint64
+}
+
+func (h *mockLiquidityHooks) AfterPoolCreated(_ sdk.Context, _ sdk.AccAddress, pool types.Pool) {
+	h.poolsCreated = append(h.poolsCreated, pool.Id)
+}
+
+func (h *mockLiquidityHooks) AfterDeposit(_ sdk.Context, _ sdk.AccAddress, _ uint64, _ sdk.Coins, mintedPoolCoin sdk.Coin) {
+	h.deposits = append(h.deposits, mintedPoolCoin)
+}
+
+func (h *mockLiquidityHooks) AfterWithdraw(_ sdk.Context, _ sdk.AccAddress, _ uint64, _ sdk.Coin, withdrawnCoins sdk.Coins) {
+	h.withdrawals = append(h.withdrawals, withdrawnCoins)
+}
+
+func (h *mockLiquidityHooks) AfterSwapMatched(_ sdk.Context, _ sdk.AccAddress, _, orderId uint64, _, _ sdk.Coin) {
+	h.swapsMatched = append(h.swapsMatched, orderId)
+}
+
+// newKeeperWithHooks returns a new keeper sharing the store with the app's
+// keeper, with the hooks set.
+// The app's keeper already has its hooks set.
+func (s *KeeperTestSuite) newKeeperWithHooks(hooks types.LiquidityHooks) keeper.Keeper {
+	k := keeper.NewKeeper(
+		s.app.AppCodec(),
+		s.app.GetKey(types.StoreKey),
+		s.app.GetSubspace(types.ModuleName),
+		s.app.AccountKeeper,
+		s.app.BankKeeper,
+		s.app.DistrKeeper,
+	)
+	k.SetLPFarmKeeper(s.app.LPFarmKeeper)
+	k.SetHooks(hooks)
+	return k
+}
+
+func (s *KeeperTestSuite) TestLiquidityHooks() {
+	s.Require().Panics(func() {
+		s.keeper.SetHooks(types.NewMultiLiquidityHooks())
+	})
+
+	hooks1, hooks2 := &mockLiquidityHooks{}, &mockLiquidityHooks{}
+	s.keeper = s.newKeeperWithHooks(types.NewMultiLiquidityHooks(hooks1, hooks2))
+	s.msgServer = keeper.NewMsgServerImpl(s.keeper)
+
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pool := s.createPool(s.addr(0), pair.Id, utils.ParseCoins("1000000denom1,1000000denom2"), true)
+	rangedPool := s.createRangedPool(
+		s.addr(0), pair.Id, utils.ParseCoins("1000000denom1,1000000denom2"),
+		utils.ParseDec("0.5"), utils.ParseDec("2.0"), utils.ParseDec("1.0"), true)
+	s.Require().Equal([]uint64{pool.Id, rangedPool.Id}, hooks1.poolsCreated)
+
+	s.deposit(s.addr(1), pool.Id, utils.ParseCoins("1000000denom1,1000000denom2"), true)
+	s.withdraw(s.addr(0), pool.Id, utils.ParseCoin("1000000000000pool1"))
+	sellOrder := s.sellLimitOrder(s.addr(3), pair.Id, utils.ParseDec("1.0"), sdk.NewInt(10000), 0, true)
+	buyOrder := s.buyLimitOrder(s.addr(4), pair.Id, utils.ParseDec("1.0"), sdk.NewInt(10000), 0, true)
+	s.Require().Empty(hooks1.swapsMatched)
+	liquidity.EndBlocker(s.ctx, s.keeper)
+
+	s.Require().Len(hooks1.deposits, 1)
+	s.Require().True(coinEq(utils.ParseCoin("1000000000000pool1"), hooks1.deposits[0]))
+	s.Require().Len(hooks1.withdrawals, 1)
+	s.Require().ElementsMatch([]uint64{sellOrder.Id, buyOrder.Id}, hooks1.swapsMatched)
+
+	// All the hooks are called.
+	s.Require().Equal(hooks1, hooks2)
+}
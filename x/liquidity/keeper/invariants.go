@@ -14,6 +14,8 @@ func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 	ir.RegisterRoute(types.ModuleName, "pool-coin-escrow", PoolCoinEscrowInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "remaining-offer-coin-escrow", RemainingOfferCoinEscrowInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "pool-status", PoolStatusInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "escrow-balance", EscrowBalanceInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "pool-reserve", PoolReserveInvariant(k))
 }
 
 // AllInvariants returns a combined invariant of the liquidity module.
@@ -24,6 +26,8 @@ func AllInvariants(k Keeper) sdk.Invariant {
 			PoolCoinEscrowInvariant,
 			RemainingOfferCoinEscrowInvariant,
 			PoolStatusInvariant,
+			EscrowBalanceInvariant,
+			PoolReserveInvariant,
 		} {
 			res, stop := inv(k)(ctx)
 			if stop {
@@ -133,3 +137,86 @@ func PoolStatusInvariant(k Keeper) sdk.Invariant {
 		), broken
 	}
 }
+
+// EscrowBalanceInvariant checks that no escrow account of the module holds
+// less than the amount expected from the state records, for each denom.
+// Every diverging denom is reported, including the ones with surplus.
+func EscrowBalanceInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			count int
+			msg   string
+		)
+		for _, diff := range k.EscrowBalanceDiffs(ctx) {
+			if diff.IsDeficit() {
+				count++
+			}
+			msg += fmt.Sprintf(
+				"\t%s(%s) has %s%s, expected %s%s\n",
+				diff.Description, diff.Address, diff.Actual, diff.Denom, diff.Expected, diff.Denom)
+		}
+		broken := count != 0
+		return sdk.FormatInvariant(
+			types.ModuleName, "escrow-balance",
+			fmt.Sprintf("%d denom(s) with insufficient escrow balance found\n%s", count, msg),
+		), broken
+	}
+}
+
+// PoolReserveInvariant checks that each active pool with pool coins in
+// circulation holds reserves, which are both positive for basic pools, and
+// that the pool coins being withdrawn by pending withdraw requests don't
+// exceed the pool coin supply.
+func PoolReserveInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			count int
+			msg   string
+		)
+		withdrawingPoolCoins := map[uint64]sdk.Int{} // pool id => pool coin amount
+		_ = k.IterateAllWithdrawRequests(ctx, func(req types.WithdrawRequest) (stop bool, err error) {
+			if req.Status == types.RequestStatusNotExecuted {
+				amt, ok := withdrawingPoolCoins[req.PoolId]
+				if !ok {
+					amt = sdk.ZeroInt()
+				}
+				withdrawingPoolCoins[req.PoolId] = amt.Add(req.PoolCoin.Amount)
+			}
+			return false, nil
+		})
+		_ = k.IterateAllPools(ctx, func(pool types.Pool) (stop bool, err error) {
+			if pool.Disabled {
+				return false, nil
+			}
+			ps := k.GetPoolCoinSupply(ctx, pool)
+			if !ps.IsPositive() {
+				return false, nil
+			}
+			rx, ry := k.GetPoolBalances(ctx, pool)
+			switch pool.Type {
+			case types.PoolTypeBasic:
+				if rx.IsZero() || ry.IsZero() {
+					count++
+					msg += fmt.Sprintf("	basic pool %d has %s pool coins in circulation, but has reserve %s\n", pool.Id, ps, sdk.NewCoins(rx, ry))
+					return false, nil
+				}
+			default:
+				if rx.IsZero() && ry.IsZero() {
+					count++
+					msg += fmt.Sprintf("	pool %d has %s pool coins in circulation, but has no reserve\n", pool.Id, ps)
+					return false, nil
+				}
+			}
+			if pc, ok := withdrawingPoolCoins[pool.Id]; ok && pc.GT(ps) {
+				count++
+				msg += fmt.Sprintf("	pool %d has %s pool coins being withdrawn, which is greater than the supply %s\n", pool.Id, pc, ps)
+			}
+			return false, nil
+		})
+		broken := count != 0
+		return sdk.FormatInvariant(
+			types.ModuleName, "pool-reserve",
+			fmt.Sprintf("%d pool(s) with insufficient reserve found\n%s", count, msg),
+		), broken
+	}
+}
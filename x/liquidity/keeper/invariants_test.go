@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	utils "github.com/crescent-network/crescent/v4/types"
 	"github.com/crescent-network/crescent/v4/x/liquidity/keeper"
 )
@@ -89,3 +91,91 @@ func (s *KeeperTestSuite) TestPoolStatusInvariant() {
 	_, broken = keeper.PoolStatusInvariant(s.keeper)(s.ctx)
 	s.Require().True(broken)
 }
+
+func (s *KeeperTestSuite) TestPoolReserveInvariant() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pool := s.createPool(s.addr(0), pair.Id, utils.ParseCoins("1000000denom1,1000000denom2"), true)
+	s.nextBlock()
+
+	req := s.withdraw(s.addr(0), pool.Id, utils.ParseCoin("500000000000pool1"))
+	_, broken := keeper.PoolReserveInvariant(s.keeper)(s.ctx)
+	s.Require().False(broken)
+
+	oldReq := req
+	req.PoolCoin = sdk.NewCoin(pool.PoolCoinDenom, s.keeper.GetPoolCoinSupply(s.ctx, pool).AddRaw(1))
+	s.keeper.SetWithdrawRequest(s.ctx, req)
+	_, broken = keeper.PoolReserveInvariant(s.keeper)(s.ctx)
+	s.Require().True(broken)
+
+	req = oldReq
+	s.keeper.SetWithdrawRequest(s.ctx, req)
+	s.nextBlock()
+	_, broken = keeper.PoolReserveInvariant(s.keeper)(s.ctx)
+	s.Require().False(broken)
+
+	// Drain one side of the basic pool's reserve.
+	rx, _ := s.keeper.GetPoolBalances(s.ctx, pool)
+	s.sendCoins(pool.GetReserveAddress(), s.addr(1), sdk.NewCoins(rx))
+	_, broken = keeper.PoolReserveInvariant(s.keeper)(s.ctx)
+	s.Require().True(broken)
+}
+
+func (s *KeeperTestSuite) TestPoolReserveInvariant_RangedPool() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pool := s.createRangedPool(
+		s.addr(0), pair.Id, utils.ParseCoins("1000000denom1,1000000denom2"),
+		utils.ParseDec("0.5"), utils.ParseDec("2.0"), utils.ParseDec("1.0"), true)
+	s.nextBlock()
+
+	// Draining one side of a ranged pool's reserve is fine.
+	rx, ry := s.keeper.GetPoolBalances(s.ctx, pool)
+	s.sendCoins(pool.GetReserveAddress(), s.addr(1), sdk.NewCoins(rx))
+	_, broken := keeper.PoolReserveInvariant(s.keeper)(s.ctx)
+	s.Require().False(broken)
+
+	s.sendCoins(pool.GetReserveAddress(), s.addr(1), sdk.NewCoins(ry))
+	_, broken = keeper.PoolReserveInvariant(s.keeper)(s.ctx)
+	s.Require().True(broken)
+}
+
+func (s *KeeperTestSuite) TestEscrowBalanceInvariant() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pool := s.createPool(s.addr(0), pair.Id, utils.ParseCoins("1000000denom1,1000000denom2"), true)
+	s.nextBlock()
+
+	s.deposit(s.addr(1), pool.Id, utils.ParseCoins("1000000denom1,1000000denom2"), true)
+	s.withdraw(s.addr(0), pool.Id, utils.ParseCoin("100000pool1"))
+	order := s.buyLimitOrder(s.addr(2), pair.Id, utils.ParseDec("0.5"), newInt(1000000), 0, true)
+	s.Require().Empty(s.keeper.EscrowBalanceDiffs(s.ctx))
+	_, broken := keeper.EscrowBalanceInvariant(s.keeper)(s.ctx)
+	s.Require().False(broken)
+
+	// Surplus is reported, but doesn't break the invariant.
+	s.fundAddr(s.addr(3), utils.ParseCoins("1000denom3"))
+	s.sendCoins(s.addr(3), pair.GetEscrowAddress(), utils.ParseCoins("1000denom3"))
+	diffs := s.keeper.EscrowBalanceDiffs(s.ctx)
+	s.Require().Len(diffs, 1)
+	s.Require().Equal(pair.EscrowAddress, diffs[0].Address)
+	s.Require().Equal("denom3", diffs[0].Denom)
+	s.Require().True(diffs[0].Expected.IsZero())
+	s.Require().Equal(newInt(1000), diffs[0].Actual)
+	s.Require().False(diffs[0].IsDeficit())
+	_, broken = keeper.EscrowBalanceInvariant(s.keeper)(s.ctx)
+	s.Require().False(broken)
+
+	oldOrder := order
+	order.RemainingOfferCoin = order.RemainingOfferCoin.AddAmount(newInt(1))
+	s.keeper.SetOrder(s.ctx, order)
+	diffs = s.keeper.EscrowBalanceDiffs(s.ctx)
+	s.Require().Len(diffs, 2)
+	s.Require().Equal("denom2", diffs[0].Denom)
+	s.Require().True(diffs[0].IsDeficit())
+	msg, broken := keeper.EscrowBalanceInvariant(s.keeper)(s.ctx)
+	s.Require().True(broken)
+	s.Require().Contains(msg, "1 denom(s) with insufficient escrow balance found")
+
+	s.keeper.SetOrder(s.ctx, oldOrder)
+	s.nextBlock()
+	_, broken = keeper.EscrowBalanceInvariant(s.keeper)(s.ctx)
+	s.Require().False(broken)
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"sort"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -19,6 +20,14 @@ type Keeper struct {
 
 	accountKeeper types.AccountKeeper
 	bankKeeper    types.BankKeeper
+	distrKeeper   types.DistrKeeper
+	lpFarmKeeper  types.LPFarmKeeper
+	hooks         types.LiquidityHooks
+
+	orderSources    map[string]types.OrderSource
+	addressLabelers map[string]types.AddressLabeler
+
+	legacyOrderEvents bool
 }
 
 // NewKeeper creates a new liquidity Keeper instance.
@@ -28,17 +37,22 @@ func NewKeeper(
 	paramSpace paramstypes.Subspace,
 	accountKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
+	distrKeeper types.DistrKeeper,
 ) Keeper {
 	if !paramSpace.HasKeyTable() {
 		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
 	}
 
 	return Keeper{
-		cdc:           cdc,
-		storeKey:      storeKey,
-		paramSpace:    paramSpace,
-		accountKeeper: accountKeeper,
-		bankKeeper:    bankKeeper,
+		cdc:               cdc,
+		storeKey:          storeKey,
+		paramSpace:        paramSpace,
+		accountKeeper:     accountKeeper,
+		bankKeeper:        bankKeeper,
+		distrKeeper:       distrKeeper,
+		orderSources:      map[string]types.OrderSource{},
+		addressLabelers:   map[string]types.AddressLabeler{},
+		legacyOrderEvents: true,
 	}
 }
 
@@ -50,6 +64,10 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 // GetParams returns the parameters for the liquidity module.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	k.paramSpace.GetParamSet(ctx, &params)
+	// An empty list is stored as null, so keep it non-nil as in the genesis.
+	if params.PairCreatorAllowlist == nil {
+		params.PairCreatorAllowlist = []string{}
+	}
 	return
 }
 
@@ -57,3 +75,76 @@ func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.paramSpace.SetParamSet(ctx, &params)
 }
+
+// SetLPFarmKeeper sets the lpfarm keeper, which pool coins minted by deposit
+// requests with the farm option are farmed into.
+// It is set after the keeper's creation since the lpfarm keeper depends on
+// the liquidity keeper, and must be called before the keeper is passed to the
+// liquidity module.
+func (k *Keeper) SetLPFarmKeeper(lpFarmKeeper types.LPFarmKeeper) {
+	if k.lpFarmKeeper != nil {
+		panic("lpfarm keeper is already set")
+	}
+	k.lpFarmKeeper = lpFarmKeeper
+}
+
+// RegisterOrderSource registers an external order source which contributes
+// orders to pairs' batch matching.
+// It must be called during the app initialization, before any block is
+// processed.
+func (k Keeper) RegisterOrderSource(source types.OrderSource) {
+	name := source.Name()
+	if name == "" {
+		panic("order source name must not be empty")
+	}
+	if _, ok := k.orderSources[name]; ok {
+		panic(fmt.Sprintf("order source %s is already registered", name))
+	}
+	k.orderSources[name] = source
+}
+
+// OrderSources returns all registered order sources sorted by their names.
+func (k Keeper) OrderSources() []types.OrderSource {
+	names := make([]string, 0, len(k.orderSources))
+	for name := range k.orderSources {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	sources := make([]types.OrderSource, len(names))
+	for i, name := range names {
+		sources[i] = k.orderSources[name]
+	}
+	return sources
+}
+
+// RegisterAddressLabeler registers an address labeler which provides
+// human-readable labels of orderers in order queries.
+// It must be called during the app initialization.
+func (k Keeper) RegisterAddressLabeler(labeler types.AddressLabeler) {
+	name := labeler.Name()
+	if name == "" {
+		panic("address labeler name must not be empty")
+	}
+	if _, ok := k.addressLabelers[name]; ok {
+		panic(fmt.Sprintf("address labeler %s is already registered", name))
+	}
+	k.addressLabelers[name] = labeler
+}
+
+// AddressLabel returns the label of the address from the registered
+// address labelers.
+// When multiple labelers have a label for the address, the label from the
+// labeler with the smallest name wins.
+func (k Keeper) AddressLabel(ctx sdk.Context, addr sdk.AccAddress) (label string, found bool) {
+	names := make([]string, 0, len(k.addressLabelers))
+	for name := range k.addressLabelers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if label, found = k.addressLabelers[name].AddressLabel(ctx, addr); found {
+			return label, true
+		}
+	}
+	return "", false
+}
@@ -135,6 +135,16 @@ func (s *KeeperTestSuite) deposit(depositor sdk.AccAddress, poolId uint64, depos
 	return req
 }
 
+func (s *KeeperTestSuite) depositSingleAsset(depositor sdk.AccAddress, poolId uint64, depositCoin sdk.Coin, fund bool) types.DepositRequest {
+	s.T().Helper()
+	if fund {
+		s.fundAddr(depositor, sdk.NewCoins(depositCoin))
+	}
+	req, err := s.keeper.DepositSingleAsset(s.ctx, types.NewMsgDepositSingleAsset(depositor, poolId, depositCoin))
+	s.Require().NoError(err)
+	return req
+}
+
 func (s *KeeperTestSuite) withdraw(withdrawer sdk.AccAddress, poolId uint64, poolCoin sdk.Coin) types.WithdrawRequest {
 	s.T().Helper()
 	req, err := s.keeper.Withdraw(s.ctx, types.NewMsgWithdraw(withdrawer, poolId, poolCoin))
@@ -199,7 +209,8 @@ func (s *KeeperTestSuite) marketOrder(
 	var demandCoinDenom string
 	switch dir {
 	case types.OrderDirectionBuy:
-		maxPrice := lastPrice.Mul(sdk.OneDec().Add(s.keeper.GetMaxPriceLimitRatio(s.ctx)))
+		upward, _ := s.keeper.GetPairPriceLimitRatios(s.ctx, pair)
+		maxPrice := lastPrice.Mul(sdk.OneDec().Add(upward))
 		offerCoin = sdk.NewCoin(pair.QuoteCoinDenom, amm.OfferCoinAmount(amm.Buy, maxPrice, amt))
 		demandCoinDenom = pair.BaseCoinDenom
 	case types.OrderDirectionSell:
@@ -288,6 +299,25 @@ func (s *KeeperTestSuite) mmOrder(
 	return orders
 }
 
+func (s *KeeperTestSuite) createVault(operator sdk.AccAddress, pairId uint64, maxPriceDeviation, operatorFeeRate sdk.Dec) types.Vault {
+	s.T().Helper()
+	msg := types.NewMsgCreateVault(operator, pairId, maxPriceDeviation, operatorFeeRate)
+	s.Require().NoError(msg.ValidateBasic())
+	vault, err := s.keeper.CreateVault(s.ctx, msg)
+	s.Require().NoError(err)
+	return vault
+}
+
+func (s *KeeperTestSuite) depositVault(depositor sdk.AccAddress, vaultId uint64, depositCoins sdk.Coins, fund bool) sdk.Coin {
+	s.T().Helper()
+	if fund {
+		s.fundAddr(depositor, depositCoins)
+	}
+	mintedShare, err := s.keeper.DepositVault(s.ctx, types.NewMsgDepositVault(depositor, vaultId, depositCoins))
+	s.Require().NoError(err)
+	return mintedShare
+}
+
 // nolint
 func (s *KeeperTestSuite) cancelOrder(orderer sdk.AccAddress, pairId, orderId uint64) {
 	s.T().Helper()
@@ -301,6 +331,20 @@ func (s *KeeperTestSuite) cancelAllOrders(orderer sdk.AccAddress, pairIds []uint
 	s.Require().NoError(err)
 }
 
+// eventAttrs returns the attributes of the last event of the given type.
+func eventAttrs(events sdk.Events, typ string) (map[string]string, bool) {
+	for i := len(events) - 1; i >= 0; i-- {
+		if events[i].Type == typ {
+			attrs := map[string]string{}
+			for _, attr := range events[i].Attributes {
+				attrs[string(attr.Key)] = string(attr.Value)
+			}
+			return attrs, true
+		}
+	}
+	return nil, false
+}
+
 func coinEq(exp, got sdk.Coin) (bool, string, string, string) {
 	return exp.IsEqual(got), "expected:\t%v\ngot:\t\t%v", exp.String(), got.String()
 }
@@ -5,6 +5,7 @@ import (
 
 	v2 "github.com/crescent-network/crescent/v4/x/liquidity/legacy/v2"
 	v3 "github.com/crescent-network/crescent/v4/x/liquidity/legacy/v3"
+	v4 "github.com/crescent-network/crescent/v4/x/liquidity/legacy/v4"
 )
 
 type Migrator struct {
@@ -22,3 +23,7 @@ func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 func (m Migrator) Migrate2to3(ctx sdk.Context) error {
 	return v3.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
 }
+
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	return v4.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc, m.keeper.paramSpace)
+}
@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/crescent-network/crescent/v4/x/liquidity/types"
+)
+
+// ModuleAccounts returns the module account and the accounts derived by the
+// module, along with their balances and whether they are blocked addresses in
+// the bank module.
+// The derived accounts are created on demand, so they can't be registered as
+// blocked addresses, which are fixed when the app is constructed.
+func (k Keeper) ModuleAccounts(ctx sdk.Context) []types.ModuleAccountResponse {
+	params := k.GetParams(ctx)
+	accs := []types.ModuleAccountResponse{
+		k.moduleAccountResponse(ctx, "module", k.accountKeeper.GetModuleAddress(types.ModuleName),
+			"mints and burns pool coins"),
+		k.moduleAccountResponse(ctx, "global_escrow", types.GlobalEscrowAddress,
+			"escrows the coins of deposit and withdraw requests"),
+		k.moduleAccountResponse(ctx, "fee_collector", sdk.MustAccAddressFromBech32(params.FeeCollectorAddress),
+			"collects swap fees and pool creation fees"),
+		k.moduleAccountResponse(ctx, "dust_collector", sdk.MustAccAddressFromBech32(params.DustCollectorAddress),
+			"collects the truncation dust"),
+		k.moduleAccountResponse(ctx, "prune_reward_pool", types.PruneRewardPoolAddress,
+			"pays the rewards for pruning expired entries"),
+	}
+	_ = k.IterateAllPairs(ctx, func(pair types.Pair) (stop bool, err error) {
+		accs = append(accs, k.moduleAccountResponse(ctx, "pair_escrow/"+strconv.FormatUint(pair.Id, 10),
+			pair.GetEscrowAddress(), "escrows the offer coins of the pair's orders"))
+		return false, nil
+	})
+	_ = k.IterateAllPools(ctx, func(pool types.Pool) (stop bool, err error) {
+		poolId := strconv.FormatUint(pool.Id, 10)
+		accs = append(accs,
+			k.moduleAccountResponse(ctx, "pool_reserve/"+poolId, pool.GetReserveAddress(),
+				"holds the pool's reserve"),
+			k.moduleAccountResponse(ctx, "pool_fee/"+poolId, types.PoolFeeAddress(pool.Id),
+				"holds the swap fees earned by the pool until they are swept"))
+		return false, nil
+	})
+	_ = k.IterateAllVaults(ctx, func(vault types.Vault) (stop bool, err error) {
+		accs = append(accs, k.moduleAccountResponse(ctx, "vault_reserve/"+strconv.FormatUint(vault.Id, 10),
+			vault.GetReserveAddress(), "holds the vault's reserve"))
+		return false, nil
+	})
+	for _, route := range k.GetAllSwapRoutes(ctx) {
+		accs = append(accs, k.moduleAccountResponse(ctx, "swap_route_escrow/"+strconv.FormatUint(route.Id, 10),
+			route.GetEscrowAddress(), "escrows the intermediate proceeds of the swap route"))
+	}
+	return accs
+}
+
+func (k Keeper) moduleAccountResponse(ctx sdk.Context, name string, addr sdk.AccAddress, purpose string) types.ModuleAccountResponse {
+	return types.ModuleAccountResponse{
+		Name:     name,
+		Address:  addr.String(),
+		Purpose:  purpose,
+		Balances: k.bankKeeper.GetAllBalances(ctx, addr),
+		Blocked:  k.bankKeeper.BlockedAddr(addr),
+	}
+}
@@ -63,6 +63,18 @@ func (m msgServer) Deposit(goCtx context.Context, msg *types.MsgDeposit) (*types
 	return &types.MsgDepositResponse{}, nil
 }
 
+// DepositSingleAsset defines a method to deposit only one of the pair's
+// coins to the basic pool.
+func (m msgServer) DepositSingleAsset(goCtx context.Context, msg *types.MsgDepositSingleAsset) (*types.MsgDepositSingleAssetResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := m.Keeper.DepositSingleAsset(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDepositSingleAssetResponse{}, nil
+}
+
 // Withdraw defines a method to withdraw pool coin from the pool.
 func (m msgServer) Withdraw(goCtx context.Context, msg *types.MsgWithdraw) (*types.MsgWithdrawResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -96,6 +108,19 @@ func (m msgServer) MarketOrder(goCtx context.Context, msg *types.MsgMarketOrder)
 	return &types.MsgMarketOrderResponse{}, nil
 }
 
+// SwapExactIn defines a method to swap an exact amount of coin through a
+// route of pairs.
+func (m msgServer) SwapExactIn(goCtx context.Context, msg *types.MsgSwapExactIn) (*types.MsgSwapExactInResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	route, err := m.Keeper.SwapExactIn(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSwapExactInResponse{SwapRouteId: route.Id}, nil
+}
+
 // MMOrder defines a method to make a MM(market making) order.
 func (m msgServer) MMOrder(goCtx context.Context, msg *types.MsgMMOrder) (*types.MsgMMOrderResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -139,3 +164,114 @@ func (m msgServer) CancelMMOrder(goCtx context.Context, msg *types.MsgCancelMMOr
 
 	return &types.MsgCancelMMOrderResponse{}, nil
 }
+
+// PruneExpired defines a method to prune expired or finished requests and orders.
+func (m msgServer) PruneExpired(goCtx context.Context, msg *types.MsgPruneExpired) (*types.MsgPruneExpiredResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := m.Keeper.PruneExpired(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgPruneExpiredResponse{}, nil
+}
+
+// SetPairMetadata defines a method to attach the immutable metadata to a pair.
+func (m msgServer) SetPairMetadata(goCtx context.Context, msg *types.MsgSetPairMetadata) (*types.MsgSetPairMetadataResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := m.Keeper.SetPairMetadata(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetPairMetadataResponse{}, nil
+}
+
+// CreateVault defines a method to create a vault operated by the creator.
+func (m msgServer) CreateVault(goCtx context.Context, msg *types.MsgCreateVault) (*types.MsgCreateVaultResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := m.Keeper.CreateVault(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateVaultResponse{}, nil
+}
+
+// DepositVault defines a method to deposit coins to a vault.
+func (m msgServer) DepositVault(goCtx context.Context, msg *types.MsgDepositVault) (*types.MsgDepositVaultResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := m.Keeper.DepositVault(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDepositVaultResponse{}, nil
+}
+
+// WithdrawVault defines a method to withdraw coins from a vault.
+func (m msgServer) WithdrawVault(goCtx context.Context, msg *types.MsgWithdrawVault) (*types.MsgWithdrawVaultResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := m.Keeper.WithdrawVault(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWithdrawVaultResponse{}, nil
+}
+
+// RebalanceVault defines a method for the vault operator to set the vault's price range.
+func (m msgServer) RebalanceVault(goCtx context.Context, msg *types.MsgRebalanceVault) (*types.MsgRebalanceVaultResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.Keeper.RebalanceVault(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRebalanceVaultResponse{}, nil
+}
+
+// RenewOrder defines a method to extend the expiration of an open order.
+func (m msgServer) RenewOrder(goCtx context.Context, msg *types.MsgRenewOrder) (*types.MsgRenewOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := m.Keeper.RenewOrder(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRenewOrderResponse{}, nil
+}
+
+// CommitOrder defines a method to commit to a limit order without revealing
+// it.
+func (m msgServer) CommitOrder(goCtx context.Context, msg *types.MsgCommitOrder) (*types.MsgCommitOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := m.Keeper.CommitOrder(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCommitOrderResponse{}, nil
+}
+
+// RevealOrder defines a method to reveal a committed limit order.
+func (m msgServer) RevealOrder(goCtx context.Context, msg *types.MsgRevealOrder) (*types.MsgRevealOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := m.Keeper.RevealOrder(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRevealOrderResponse{}, nil
+}
+
+// AmendOrder defines a method to amend the price and amount of an open order.
+func (m msgServer) AmendOrder(goCtx context.Context, msg *types.MsgAmendOrder) (*types.MsgAmendOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := m.Keeper.AmendOrder(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAmendOrderResponse{}, nil
+}
@@ -0,0 +1,126 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/crescent-network/crescent/v4/x/liquidity/types"
+)
+
+// CommitOrder handles types.MsgCommitOrder and stores types.OrderCommit.
+// The OrderCommitBond param is escrowed in the global escrow until the order
+// is revealed or the order commit is forfeited.
+func (k Keeper) CommitOrder(ctx sdk.Context, msg *types.MsgCommitOrder) (types.OrderCommit, error) {
+	revealBatches := k.GetOrderRevealBatches(ctx)
+	if revealBatches == 0 {
+		return types.OrderCommit{}, types.ErrOrderCommitsDisabled
+	}
+
+	pair, found := k.GetPair(ctx, msg.PairId)
+	if !found {
+		return types.OrderCommit{}, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "pair %d not found", msg.PairId)
+	}
+	if _, found := k.GetOrderCommit(ctx, pair.Id, msg.GetOrderer(), msg.Hash); found {
+		return types.OrderCommit{}, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "order commit with the same hash already exists")
+	}
+
+	bond := k.GetOrderCommitBond(ctx)
+	if !bond.IsZero() {
+		if err := k.bankKeeper.SendCoins(ctx, msg.GetOrderer(), types.GlobalEscrowAddress, bond); err != nil {
+			return types.OrderCommit{}, err
+		}
+	}
+
+	commit := types.NewOrderCommit(msg, pair.CurrentBatchId, pair.CurrentBatchId+uint64(revealBatches), bond)
+	k.SetOrderCommit(ctx, commit)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventCommitOrder{
+		Orderer:           commit.Orderer,
+		PairId:            commit.PairId,
+		Hash:              commit.Hash,
+		BatchId:           commit.BatchId,
+		LastRevealBatchId: commit.LastRevealBatchId,
+		Bond:              commit.Bond,
+	}); err != nil {
+		return types.OrderCommit{}, err
+	}
+
+	return commit, nil
+}
+
+// RevealOrder handles types.MsgRevealOrder.
+// It places the committed limit order in the pair's current batch and refunds
+// the bond of the order commit.
+func (k Keeper) RevealOrder(ctx sdk.Context, msg *types.MsgRevealOrder) (types.Order, error) {
+	commit, found := k.GetOrderCommit(ctx, msg.Order.PairId, msg.GetOrderer(), msg.Hash())
+	if !found {
+		return types.Order{}, sdkerrors.Wrap(sdkerrors.ErrNotFound, "order commit not found")
+	}
+	pair, _ := k.GetPair(ctx, commit.PairId)
+	if !commit.CanBeRevealed(pair.CurrentBatchId) {
+		return types.Order{}, sdkerrors.Wrapf(
+			types.ErrOrderNotRevealable, "the order can be revealed from batch %d to %d, but the current batch is %d",
+			commit.BatchId+1, commit.LastRevealBatchId, pair.CurrentBatchId)
+	}
+
+	order, err := k.LimitOrder(ctx, &msg.Order)
+	if err != nil {
+		return types.Order{}, err
+	}
+
+	if !commit.Bond.IsZero() {
+		if err := k.bankKeeper.SendCoins(ctx, types.GlobalEscrowAddress, commit.GetOrderer(), commit.Bond); err != nil {
+			return types.Order{}, err
+		}
+	}
+	k.DeleteOrderCommit(ctx, commit)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventRevealOrder{
+		Orderer:      commit.Orderer,
+		PairId:       commit.PairId,
+		Hash:         commit.Hash,
+		OrderId:      order.Id,
+		RefundedBond: commit.Bond,
+	}); err != nil {
+		return types.Order{}, err
+	}
+
+	return order, nil
+}
+
+// ForfeitUnrevealedOrderCommits deletes the order commits whose last reveal
+// batch has passed without the order revealed, and sends their bonds to the
+// fee collector.
+func (k Keeper) ForfeitUnrevealedOrderCommits(ctx sdk.Context) error {
+	feeCollector := k.GetFeeCollector(ctx)
+	bulkOp := types.NewBulkSendCoinsOperation()
+	pairCache := map[uint64]types.Pair{}
+	var forfeitedCommits []types.OrderCommit
+	_ = k.IterateAllOrderCommits(ctx, func(commit types.OrderCommit) (stop bool, err error) {
+		pair, ok := pairCache[commit.PairId]
+		if !ok {
+			pair, _ = k.GetPair(ctx, commit.PairId)
+			pairCache[commit.PairId] = pair
+		}
+		if pair.CurrentBatchId > commit.LastRevealBatchId {
+			bulkOp.QueueSendCoins(types.GlobalEscrowAddress, feeCollector, commit.Bond)
+			forfeitedCommits = append(forfeitedCommits, commit)
+		}
+		return false, nil
+	})
+	if err := bulkOp.Run(ctx, k.bankKeeper); err != nil {
+		return err
+	}
+	for _, commit := range forfeitedCommits {
+		k.DeleteOrderCommit(ctx, commit)
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventOrderCommitForfeited{
+			Orderer:       commit.Orderer,
+			PairId:        commit.PairId,
+			Hash:          commit.Hash,
+			ForfeitedBond: commit.Bond,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	utils "github.com/crescent-network/crescent/v4/types"
+	"github.com/crescent-network/crescent/v4/x/liquidity/types"
+)
+
+func (s *KeeperTestSuite) commitOrder(order *types.MsgLimitOrder, salt string, fund bool) types.OrderCommit {
+	s.T().Helper()
+	if fund {
+		s.fundAddr(order.GetOrderer(), s.keeper.GetOrderCommitBond(s.ctx))
+	}
+	commit, err := s.keeper.CommitOrder(s.ctx, types.NewMsgCommitOrder(
+		order.GetOrderer(), order.PairId, types.OrderCommitHash(*order, salt)))
+	s.Require().NoError(err)
+	return commit
+}
+
+func (s *KeeperTestSuite) TestCommitRevealOrder() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+
+	orderer := s.addr(1)
+	order := types.NewMsgLimitOrder(
+		orderer, pair.Id, types.OrderDirectionBuy, utils.ParseCoin("1000000denom2"),
+		"denom1", utils.ParseDec("1.0"), sdk.NewInt(1000000), time.Hour)
+	bond := s.keeper.GetOrderCommitBond(s.ctx)
+	commit := s.commitOrder(order, "salt", true)
+	s.Require().Equal(pair.CurrentBatchId, commit.BatchId)
+	s.Require().Equal(pair.CurrentBatchId+uint64(types.DefaultOrderRevealBatches), commit.LastRevealBatchId)
+	s.Require().True(coinsEq(bond, commit.Bond))
+	s.Require().True(s.getBalances(orderer).IsZero())
+	s.Require().True(coinsEq(bond, s.getBalances(types.GlobalEscrowAddress)))
+
+	// The same order can't be committed twice.
+	s.fundAddr(orderer, bond)
+	_, err := s.keeper.CommitOrder(s.ctx, types.NewMsgCommitOrder(orderer, pair.Id, commit.Hash))
+	s.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	s.fundAddr(orderer, utils.ParseCoins("1000000denom2"))
+	// The order can't be revealed in the batch it was committed.
+	_, err = s.keeper.RevealOrder(s.ctx, types.NewMsgRevealOrder(*order, "salt"))
+	s.Require().ErrorIs(err, types.ErrOrderNotRevealable)
+
+	s.nextBlock()
+
+	_, err = s.keeper.RevealOrder(s.ctx, types.NewMsgRevealOrder(*order, "wrongsalt"))
+	s.Require().ErrorIs(err, sdkerrors.ErrNotFound)
+	order2 := *order
+	order2.Price = utils.ParseDec("0.9")
+	_, err = s.keeper.RevealOrder(s.ctx, types.NewMsgRevealOrder(order2, "salt"))
+	s.Require().ErrorIs(err, sdkerrors.ErrNotFound)
+
+	placedOrder, err := s.keeper.RevealOrder(s.ctx, types.NewMsgRevealOrder(*order, "salt"))
+	s.Require().NoError(err)
+	pair, _ = s.keeper.GetPair(s.ctx, pair.Id)
+	s.Require().Equal(pair.CurrentBatchId, placedOrder.BatchId)
+	s.Require().Equal(orderer.String(), placedOrder.Orderer)
+	s.Require().True(coinEq(utils.ParseCoin("1000000denom2"), placedOrder.OfferCoin))
+	_, found := s.keeper.GetOrderCommit(s.ctx, pair.Id, orderer, commit.Hash)
+	s.Require().False(found)
+	// The bond funded for the duplicate commit is left too.
+	s.Require().True(coinsEq(bond.Add(bond...), s.getBalances(orderer)))
+	s.Require().True(s.getBalances(types.GlobalEscrowAddress).IsZero())
+
+	// The order can't be revealed again.
+	_, err = s.keeper.RevealOrder(s.ctx, types.NewMsgRevealOrder(*order, "salt"))
+	s.Require().ErrorIs(err, sdkerrors.ErrNotFound)
+}
+
+func (s *KeeperTestSuite) TestOrderCommitForfeited() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+
+	orderer := s.addr(1)
+	order := types.NewMsgLimitOrder(
+		orderer, pair.Id, types.OrderDirectionSell, utils.ParseCoin("1000000denom1"),
+		"denom2", utils.ParseDec("1.0"), sdk.NewInt(1000000), time.Hour)
+	bond := s.keeper.GetOrderCommitBond(s.ctx)
+	commit := s.commitOrder(order, "salt", true)
+
+	feeCollector := s.keeper.GetFeeCollector(s.ctx)
+	feeCollectorBalances := s.getBalances(feeCollector)
+	for i := 0; i < types.DefaultOrderRevealBatches; i++ {
+		s.nextBlock()
+		_, found := s.keeper.GetOrderCommit(s.ctx, pair.Id, orderer, commit.Hash)
+		s.Require().True(found)
+	}
+	s.nextBlock()
+	_, found := s.keeper.GetOrderCommit(s.ctx, pair.Id, orderer, commit.Hash)
+	s.Require().False(found)
+	s.Require().True(coinsEq(feeCollectorBalances.Add(bond...), s.getBalances(feeCollector)))
+	s.Require().True(s.getBalances(types.GlobalEscrowAddress).IsZero())
+	s.Require().Empty(s.keeper.EscrowBalanceDiffs(s.ctx))
+
+	s.fundAddr(orderer, utils.ParseCoins("1000000denom1"))
+	_, err := s.keeper.RevealOrder(s.ctx, types.NewMsgRevealOrder(*order, "salt"))
+	s.Require().ErrorIs(err, sdkerrors.ErrNotFound)
+}
+
+func (s *KeeperTestSuite) TestCommitOrder_Disabled() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+
+	params := s.keeper.GetParams(s.ctx)
+	params.OrderRevealBatches = 0
+	s.keeper.SetParams(s.ctx, params)
+
+	order := types.NewMsgLimitOrder(
+		s.addr(1), pair.Id, types.OrderDirectionSell, utils.ParseCoin("1000000denom1"),
+		"denom2", utils.ParseDec("1.0"), sdk.NewInt(1000000), time.Hour)
+	_, err := s.keeper.CommitOrder(s.ctx, types.NewMsgCommitOrder(
+		order.GetOrderer(), pair.Id, types.OrderCommitHash(*order, "salt")))
+	s.Require().ErrorIs(err, types.ErrOrderCommitsDisabled)
+}
@@ -0,0 +1,98 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	utils "github.com/crescent-network/crescent/v4/types"
+	"github.com/crescent-network/crescent/v4/x/liquidity/amm"
+	"github.com/crescent-network/crescent/v4/x/liquidity/types"
+)
+
+var _ types.OrderSource = (*testOrderSource)(nil)
+
+// testOrderSource is an order source which places fixed orders to a pair.
+type testOrderSource struct {
+	name        string
+	reserveAddr sdk.AccAddress
+	pairId      uint64
+	orders      func(orderer amm.Orderer) []amm.Order
+}
+
+func (source *testOrderSource) Name() string {
+	return source.name
+}
+
+func (source *testOrderSource) PairOrderSource(_ sdk.Context, pair types.Pair) (amm.OrderSource, bool) {
+	if pair.Id != source.pairId {
+		return nil, false
+	}
+	orderer := types.NewSourceOrderer(source.name, source.reserveAddr, pair.BaseCoinDenom, pair.QuoteCoinDenom)
+	ob := amm.NewOrderBook(source.orders(orderer)...)
+	return &testPairOrderSource{ob.MakeView(), ob}, true
+}
+
+type testPairOrderSource struct {
+	*amm.OrderBookView
+	ob *amm.OrderBook
+}
+
+func (source *testPairOrderSource) BuyOrdersOver(price sdk.Dec) (orders []amm.Order) {
+	for _, order := range source.ob.Orders() {
+		if order.GetDirection() == amm.Buy && order.GetPrice().GTE(price) {
+			orders = append(orders, order)
+		}
+	}
+	return
+}
+
+func (source *testPairOrderSource) SellOrdersUnder(price sdk.Dec) (orders []amm.Order) {
+	for _, order := range source.ob.Orders() {
+		if order.GetDirection() == amm.Sell && order.GetPrice().LTE(price) {
+			orders = append(orders, order)
+		}
+	}
+	return
+}
+
+func (s *KeeperTestSuite) TestRegisterOrderSource() {
+	source := &testOrderSource{name: "test"}
+	s.keeper.RegisterOrderSource(source)
+	s.Require().Panics(func() {
+		s.keeper.RegisterOrderSource(source)
+	})
+	s.Require().Panics(func() {
+		s.keeper.RegisterOrderSource(&testOrderSource{})
+	})
+	s.Require().Equal([]types.OrderSource{source}, s.keeper.OrderSources())
+}
+
+func (s *KeeperTestSuite) TestOrderSourceMatching() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+
+	reserveAddr := s.addr(1)
+	s.fundAddr(reserveAddr, utils.ParseCoins("10000denom2"))
+	s.keeper.RegisterOrderSource(&testOrderSource{
+		name:        "test",
+		reserveAddr: reserveAddr,
+		pairId:      pair.Id,
+		orders: func(orderer amm.Orderer) []amm.Order {
+			return []amm.Order{orderer.Order(amm.Buy, utils.ParseDec("1.0"), sdk.NewInt(10000))}
+		},
+	})
+
+	order := s.sellLimitOrder(s.addr(2), pair.Id, utils.ParseDec("1.0"), sdk.NewInt(10000), 0, true)
+	s.nextBlock()
+
+	_, found := s.keeper.GetOrder(s.ctx, order.PairId, order.Id)
+	s.Require().False(found) // The order is completed and deleted.
+	s.Require().True(coinsEq(utils.ParseCoins("10000denom2"), s.getBalances(s.addr(2))))
+	s.Require().True(coinsEq(utils.ParseCoins("10000denom1"), s.getBalances(reserveAddr)))
+	s.Require().True(coinsEq(sdk.Coins{}, s.getBalances(pair.GetEscrowAddress())))
+
+	// Now the pair has the last price.
+	s.sellLimitOrder(s.addr(3), pair.Id, utils.ParseDec("1.0"), sdk.NewInt(10000), 0, true)
+	s.fundAddr(reserveAddr, utils.ParseCoins("10000denom2"))
+	s.nextBlock()
+	s.Require().True(coinsEq(utils.ParseCoins("10000denom2"), s.getBalances(s.addr(3))))
+	s.Require().True(coinsEq(utils.ParseCoins("20000denom1"), s.getBalances(reserveAddr)))
+}
@@ -1,7 +1,9 @@
 package keeper
 
 import (
+	"fmt"
 	"strconv"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -26,28 +28,54 @@ func (k Keeper) getNextOrderIdWithUpdate(ctx sdk.Context, pair types.Pair) uint6
 
 // ValidateMsgCreatePair validates types.MsgCreatePair.
 func (k Keeper) ValidateMsgCreatePair(ctx sdk.Context, msg *types.MsgCreatePair) error {
+	if !k.GetPermissionlessPairCreation(ctx) && !k.isAllowedPairCreator(ctx, msg.Creator) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to create pairs", msg.Creator)
+	}
 	if _, found := k.GetPairByDenoms(ctx, msg.BaseCoinDenom, msg.QuoteCoinDenom); found {
 		return types.ErrPairAlreadyExists
 	}
+	exp := types.PriceExponent(k.GetDenomExponent(ctx, msg.BaseCoinDenom), k.GetDenomExponent(ctx, msg.QuoteCoinDenom))
+	if err := types.ValidatePriceExponent(exp, int(k.GetTickPrecision(ctx))); err != nil {
+		return sdkerrors.Wrap(types.ErrUnsupportedPriceExponent, err.Error())
+	}
 	return nil
 }
 
+// GetDenomExponent returns the decimal exponent of the display unit of the
+// denom registered in the bank module's denom metadata.
+// It returns types.DefaultDenomExponent if there's no such metadata.
+func (k Keeper) GetDenomExponent(ctx sdk.Context, denom string) uint32 {
+	metadata, found := k.bankKeeper.GetDenomMetaData(ctx, denom)
+	if !found {
+		return types.DefaultDenomExponent
+	}
+	for _, unit := range metadata.DenomUnits {
+		if unit.Denom == metadata.Display {
+			return unit.Exponent
+		}
+	}
+	return types.DefaultDenomExponent
+}
+
 // CreatePair handles types.MsgCreatePair and creates a pair.
 func (k Keeper) CreatePair(ctx sdk.Context, msg *types.MsgCreatePair) (types.Pair, error) {
 	if err := k.ValidateMsgCreatePair(ctx, msg); err != nil {
 		return types.Pair{}, err
 	}
 
-	feeCollector := k.GetFeeCollector(ctx)
 	pairCreationFee := k.GetPairCreationFee(ctx)
-
-	// Send the pair creation fee to the fee collector.
-	if err := k.bankKeeper.SendCoins(ctx, msg.GetCreator(), feeCollector, pairCreationFee); err != nil {
+	feeDestination := k.GetPairCreationFeeDestination(ctx)
+	if err := k.payPairCreationFee(ctx, msg.GetCreator(), pairCreationFee, feeDestination); err != nil {
 		return types.Pair{}, sdkerrors.Wrap(err, "insufficient pair creation fee")
 	}
 
 	id := k.getNextPairIdWithUpdate(ctx)
 	pair := types.NewPair(id, msg.BaseCoinDenom, msg.QuoteCoinDenom)
+	pair.Creator = msg.Creator
+	pair.PriceExponent = types.PriceExponent(k.GetDenomExponent(ctx, msg.BaseCoinDenom), k.GetDenomExponent(ctx, msg.QuoteCoinDenom))
+	if numBootstrapBatches := k.GetNumBootstrapBatches(ctx); numBootstrapBatches > 0 {
+		pair.BootstrapEndBatchId = pair.CurrentBatchId + uint64(numBootstrapBatches) - 1
+	}
 	k.SetPair(ctx, pair)
 	k.SetPairIndex(ctx, pair.BaseCoinDenom, pair.QuoteCoinDenom, pair.Id)
 	k.SetPairLookupIndex(ctx, pair.BaseCoinDenom, pair.QuoteCoinDenom, pair.Id)
@@ -61,8 +89,250 @@ func (k Keeper) CreatePair(ctx sdk.Context, msg *types.MsgCreatePair) (types.Pai
 			sdk.NewAttribute(types.AttributeKeyQuoteCoinDenom, msg.QuoteCoinDenom),
 			sdk.NewAttribute(types.AttributeKeyPairId, strconv.FormatUint(pair.Id, 10)),
 			sdk.NewAttribute(types.AttributeKeyEscrowAddress, pair.EscrowAddress),
+			sdk.NewAttribute(types.AttributeKeyPairCreationFee, pairCreationFee.String()),
+			sdk.NewAttribute(types.AttributeKeyFeeDestination, feeDestination),
 		),
 	})
 
 	return pair, nil
 }
+
+// isAllowedPairCreator returns whether the address is in the pair creator
+// allowlist.
+func (k Keeper) isAllowedPairCreator(ctx sdk.Context, creator string) bool {
+	for _, addr := range k.GetPairCreatorAllowlist(ctx) {
+		if addr == creator {
+			return true
+		}
+	}
+	return false
+}
+
+// payPairCreationFee sends the pair creation fee from the creator to the fee
+// destination.
+func (k Keeper) payPairCreationFee(ctx sdk.Context, creator sdk.AccAddress, fee sdk.Coins, dest string) error {
+	switch dest {
+	case types.PairCreationFeeDestinationFeeCollector:
+		return k.bankKeeper.SendCoins(ctx, creator, k.GetFeeCollector(ctx), fee)
+	case types.PairCreationFeeDestinationCommunityPool:
+		return k.distrKeeper.FundCommunityPool(ctx, fee, creator)
+	case types.PairCreationFeeDestinationBurn:
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, creator, types.ModuleName, fee); err != nil {
+			return err
+		}
+		return k.bankKeeper.BurnCoins(ctx, types.ModuleName, fee)
+	default: // the param is validated
+		panic(fmt.Sprintf("invalid pair creation fee destination: %s", dest))
+	}
+}
+
+// SetPairMetadata handles types.MsgSetPairMetadata and attaches the metadata
+// to the pair. Only the creator of the pair can attach the metadata.
+func (k Keeper) SetPairMetadata(ctx sdk.Context, msg *types.MsgSetPairMetadata) (types.Pair, error) {
+	pair, found := k.GetPair(ctx, msg.PairId)
+	if !found {
+		return types.Pair{}, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "pair %d not found", msg.PairId)
+	}
+	if pair.Creator != msg.Creator {
+		return types.Pair{}, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "only the pair creator can set the pair metadata")
+	}
+	return k.AttachPairMetadata(ctx, msg.PairId, msg.Metadata)
+}
+
+// AttachPairMetadata attaches the metadata to the pair.
+// The metadata is immutable, so it fails if the pair already has metadata.
+func (k Keeper) AttachPairMetadata(ctx sdk.Context, pairId uint64, metadata types.PairMetadata) (types.Pair, error) {
+	pair, found := k.GetPair(ctx, pairId)
+	if !found {
+		return types.Pair{}, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "pair %d not found", pairId)
+	}
+	if pair.Metadata != nil {
+		return types.Pair{}, sdkerrors.Wrapf(types.ErrPairMetadataAlreadySet, "pair %d", pairId)
+	}
+	if err := metadata.Validate(); err != nil {
+		return types.Pair{}, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	pair.Metadata = &metadata
+	k.SetPair(ctx, pair)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetPairMetadata,
+			sdk.NewAttribute(types.AttributeKeyPairId, strconv.FormatUint(pairId, 10)),
+			sdk.NewAttribute(types.AttributeKeyDisplayName, metadata.DisplayName),
+			sdk.NewAttribute(types.AttributeKeyLogoURIHash, metadata.LogoUriHash),
+			sdk.NewAttribute(types.AttributeKeyBaseCoinDecimals, strconv.FormatUint(uint64(metadata.BaseCoinDecimals), 10)),
+			sdk.NewAttribute(types.AttributeKeyQuoteCoinDecimals, strconv.FormatUint(uint64(metadata.QuoteCoinDecimals), 10)),
+		),
+	})
+
+	return pair, nil
+}
+
+// SetPairHalted halts or resumes the pair through the circuit breaker.
+func (k Keeper) SetPairHalted(ctx sdk.Context, pairId uint64, halted bool) (types.Pair, error) {
+	pair, found := k.GetPair(ctx, pairId)
+	if !found {
+		return types.Pair{}, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "pair %d not found", pairId)
+	}
+
+	pair.Halted = halted
+	k.SetPair(ctx, pair)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetPairHalted,
+			sdk.NewAttribute(types.AttributeKeyPairId, strconv.FormatUint(pairId, 10)),
+			sdk.NewAttribute(types.AttributeKeyHalted, strconv.FormatBool(halted)),
+		),
+	})
+
+	return pair, nil
+}
+
+// SetPairBatchWindow sets the number of batches in the pair's batch window.
+func (k Keeper) SetPairBatchWindow(ctx sdk.Context, pairId uint64, batchWindow uint32) (types.Pair, error) {
+	pair, found := k.GetPair(ctx, pairId)
+	if !found {
+		return types.Pair{}, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "pair %d not found", pairId)
+	}
+
+	pair.BatchWindow = batchWindow
+	k.SetPair(ctx, pair)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetPairBatchWindow,
+			sdk.NewAttribute(types.AttributeKeyPairId, strconv.FormatUint(pairId, 10)),
+			sdk.NewAttribute(types.AttributeKeyBatchWindow, strconv.FormatUint(uint64(batchWindow), 10)),
+		),
+	})
+
+	return pair, nil
+}
+
+// SetPairOrderAmountLimits sets the limits of the amounts of orders placed
+// to the pair.
+// The pair's limits are removed if all the limits are zero.
+func (k Keeper) SetPairOrderAmountLimits(ctx sdk.Context, pairId uint64, limits types.PairOrderAmountLimits) (types.Pair, error) {
+	pair, found := k.GetPair(ctx, pairId)
+	if !found {
+		return types.Pair{}, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "pair %d not found", pairId)
+	}
+
+	if limits.IsZero() {
+		pair.OrderAmountLimits = nil
+	} else {
+		pair.OrderAmountLimits = &limits
+	}
+	k.SetPair(ctx, pair)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetPairOrderAmountLimits,
+			sdk.NewAttribute(types.AttributeKeyPairId, strconv.FormatUint(pairId, 10)),
+			sdk.NewAttribute(types.AttributeKeyMinBaseOrderAmount, limits.MinBaseOrderAmount.String()),
+			sdk.NewAttribute(types.AttributeKeyMinQuoteOrderAmount, limits.MinQuoteOrderAmount.String()),
+			sdk.NewAttribute(types.AttributeKeyLotSize, limits.LotSize.String()),
+		),
+	})
+
+	return pair, nil
+}
+
+// GetPairPriceLimitRatios returns the upward and downward price limit ratios
+// of the pair, which are the pair's overrides if set, or the module's params
+// otherwise.
+// The ratios are widened if the pair has had no trade for
+// PriceBandWideningBatches batches or more, so that the price of a thin
+// market can be re-discovered faster.
+func (k Keeper) GetPairPriceLimitRatios(ctx sdk.Context, pair types.Pair) (upward, downward sdk.Dec) {
+	if pair.UpwardPriceLimitRatio != nil {
+		upward = *pair.UpwardPriceLimitRatio
+	} else {
+		upward = k.GetUpwardPriceLimitRatio(ctx)
+	}
+	if pair.DownwardPriceLimitRatio != nil {
+		downward = *pair.DownwardPriceLimitRatio
+	} else {
+		downward = k.GetDownwardPriceLimitRatio(ctx)
+	}
+	steps := pair.PriceBandWideningSteps(k.GetPriceBandWideningBatches(ctx), k.GetMaxPriceBandWideningSteps(ctx))
+	return types.WidenPriceLimitRatios(upward, downward, steps)
+}
+
+// GetPairMaxOrderLifespan returns the max order lifespan of the pair,
+// which is the pair's override if set, or the module's param otherwise.
+func (k Keeper) GetPairMaxOrderLifespan(ctx sdk.Context, pair types.Pair) time.Duration {
+	if pair.MaxOrderLifespan != nil {
+		return *pair.MaxOrderLifespan
+	}
+	return k.GetMaxOrderLifespan(ctx)
+}
+
+// SetPairParams sets the params overridden for the pair.
+// A nil value removes the pair's override, so that the module's param is
+// used for the pair.
+func (k Keeper) SetPairParams(
+	ctx sdk.Context, pairId uint64, upwardPriceLimitRatio, downwardPriceLimitRatio *sdk.Dec,
+	maxOrderLifespan *time.Duration) (types.Pair, error) {
+	pair, found := k.GetPair(ctx, pairId)
+	if !found {
+		return types.Pair{}, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "pair %d not found", pairId)
+	}
+
+	pair.UpwardPriceLimitRatio = upwardPriceLimitRatio
+	pair.DownwardPriceLimitRatio = downwardPriceLimitRatio
+	pair.MaxOrderLifespan = maxOrderLifespan
+	k.SetPair(ctx, pair)
+
+	var upwardPriceLimitRatioStr, downwardPriceLimitRatioStr, maxOrderLifespanStr string
+	if upwardPriceLimitRatio != nil {
+		upwardPriceLimitRatioStr = upwardPriceLimitRatio.String()
+	}
+	if downwardPriceLimitRatio != nil {
+		downwardPriceLimitRatioStr = downwardPriceLimitRatio.String()
+	}
+	if maxOrderLifespan != nil {
+		maxOrderLifespanStr = maxOrderLifespan.String()
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetPairParams,
+			sdk.NewAttribute(types.AttributeKeyPairId, strconv.FormatUint(pairId, 10)),
+			sdk.NewAttribute(types.AttributeKeyUpwardPriceLimitRatio, upwardPriceLimitRatioStr),
+			sdk.NewAttribute(types.AttributeKeyDownwardPriceLimitRatio, downwardPriceLimitRatioStr),
+			sdk.NewAttribute(types.AttributeKeyMaxOrderLifespan, maxOrderLifespanStr),
+		),
+	})
+
+	return pair, nil
+}
+
+// ShouldExecuteBatch returns whether the pair's batch is executed in the
+// current batch.
+// If the pair's batch window is enabled, the pair's batch is executed only
+// once in each window, in a batch selected by types.IsBatchInWindowSelected.
+// The last batch of the window is always selected if no batch has been
+// executed in the window yet.
+func (k Keeper) ShouldExecuteBatch(ctx sdk.Context, pair types.Pair) bool {
+	if pair.BatchWindow <= 1 {
+		return true
+	}
+	batchSize := int64(k.GetBatchSize(ctx))
+	window := uint64(pair.BatchWindow)
+	batchIdx := uint64(ctx.BlockHeight() / batchSize)
+	if pair.LastBatchHeight > 0 && uint64(pair.LastBatchHeight/batchSize)/window == batchIdx/window {
+		return false // The batch has already been executed in this window.
+	}
+	return types.IsBatchInWindowSelected(ctx.HeaderHash(), pair.Id, window-batchIdx%window)
+}
+
+// IsPairHalted returns whether the pair is halted by the circuit breaker,
+// either by the pair's own switch or by the global one.
+// Orders can't be placed to a halted pair and its batches are not executed,
+// but orders can still be canceled.
+func (k Keeper) IsPairHalted(ctx sdk.Context, pair types.Pair) bool {
+	return pair.Halted || k.GetCircuitBreakerEnabled(ctx)
+}
@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/crescent-network/crescent/v4/x/liquidity/types"
+)
+
+// RecordPairStats adds the result of a batch matched at the price to the
+// pair's stats bucket of the current hour and deletes the pair's buckets
+// which are out of the stats window.
+func (k Keeper) RecordPairStats(
+	ctx sdk.Context, pairId uint64, price sdk.Dec, baseVolume, quoteVolume sdk.Int,
+	numMatchedOrders uint64, swapFees sdk.Coins) {
+	startTime := types.PairStatsBucketStartTime(ctx.BlockTime())
+	bucket, found := k.GetPairStatsBucket(ctx, pairId, startTime)
+	if !found {
+		bucket = types.NewPairStatsBucket(pairId, startTime)
+	}
+	bucket.AddBatch(price, baseVolume, quoteVolume, numMatchedOrders, swapFees)
+	k.SetPairStatsBucket(ctx, bucket)
+
+	windowStartTime := types.PairStatsWindowStartTime(ctx.BlockTime())
+	var outdated []types.PairStatsBucket
+	_ = k.IteratePairStatsBucketsByPair(ctx, pairId, func(bucket types.PairStatsBucket) (stop bool, err error) {
+		if !bucket.StartTime.Before(windowStartTime) {
+			return true, nil
+		}
+		outdated = append(outdated, bucket)
+		return false, nil
+	})
+	for _, bucket := range outdated {
+		k.DeletePairStatsBucket(ctx, bucket)
+	}
+}
+
+// GetPairStats returns the trading statistics of the pair over the stats
+// window ending at the current block time.
+// Buckets out of the window which haven't been deleted yet, because the pair
+// hasn't been matched since, are excluded.
+func (k Keeper) GetPairStats(ctx sdk.Context, pairId uint64) types.PairStatsResponse {
+	windowStartTime := types.PairStatsWindowStartTime(ctx.BlockTime())
+	var buckets []types.PairStatsBucket
+	_ = k.IteratePairStatsBucketsByPair(ctx, pairId, func(bucket types.PairStatsBucket) (stop bool, err error) {
+		if !bucket.StartTime.Before(windowStartTime) {
+			buckets = append(buckets, bucket)
+		}
+		return false, nil
+	})
+	return types.MakePairStats(pairId, windowStartTime, buckets)
+}
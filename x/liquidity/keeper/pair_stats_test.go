@@ -0,0 +1,75 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	utils "github.com/crescent-network/crescent/v4/types"
+	"github.com/crescent-network/crescent/v4/x/liquidity"
+)
+
+func (s *KeeperTestSuite) TestRecordPairStats() {
+	params := s.keeper.GetParams(s.ctx)
+	params.SwapFeeRate = utils.ParseDec("0.003")
+	s.keeper.SetParams(s.ctx, params)
+
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+
+	// No stats before any matching.
+	stats := s.keeper.GetPairStats(s.ctx, pair.Id)
+	s.Require().True(stats.BaseVolume.IsZero())
+	s.Require().Nil(stats.HighPrice)
+	s.Require().Nil(stats.LowPrice)
+
+	s.buyLimitOrder(s.addr(1), pair.Id, utils.ParseDec("1.0"), sdk.NewInt(1000000), time.Hour, true)
+	s.sellLimitOrder(s.addr(2), pair.Id, utils.ParseDec("1.0"), sdk.NewInt(1000000), time.Hour, true)
+	liquidity.EndBlocker(s.ctx, s.keeper)
+
+	s.ctx = s.ctx.WithBlockTime(utils.ParseTime("2022-01-01T00:30:00Z"))
+	liquidity.BeginBlocker(s.ctx, s.keeper)
+	s.buyLimitOrder(s.addr(1), pair.Id, utils.ParseDec("1.05"), sdk.NewInt(1000000), time.Hour, true)
+	s.sellLimitOrder(s.addr(2), pair.Id, utils.ParseDec("1.05"), sdk.NewInt(1000000), time.Hour, true)
+	// An order which is not matched isn't counted.
+	s.sellLimitOrder(s.addr(3), pair.Id, utils.ParseDec("1.1"), sdk.NewInt(1000000), time.Hour, true)
+	liquidity.EndBlocker(s.ctx, s.keeper)
+
+	// Both batches are recorded in the same bucket.
+	bucket, found := s.keeper.GetPairStatsBucket(s.ctx, pair.Id, utils.ParseTime("2022-01-01T00:00:00Z"))
+	s.Require().True(found)
+	s.Require().Equal(sdk.NewInt(2000000), bucket.BaseVolume)
+	s.Require().Equal(sdk.NewInt(2050000), bucket.QuoteVolume)
+	s.Require().EqualValues(4, bucket.NumMatchedOrders)
+	s.Require().True(decEq(utils.ParseDec("1.05"), bucket.HighPrice))
+	s.Require().True(decEq(utils.ParseDec("1.0"), bucket.LowPrice))
+	s.Require().True(coinsEq(utils.ParseCoins("6000denom1,6150denom2"), bucket.SwapFees))
+
+	s.ctx = s.ctx.WithBlockTime(utils.ParseTime("2022-01-01T23:59:59Z"))
+	stats = s.keeper.GetPairStats(s.ctx, pair.Id)
+	s.Require().Equal(utils.ParseTime("2022-01-01T00:00:00Z"), stats.StartTime)
+	s.Require().Equal(sdk.NewInt(2000000), stats.BaseVolume)
+	s.Require().Equal(sdk.NewInt(2050000), stats.QuoteVolume)
+	s.Require().EqualValues(4, stats.NumMatchedOrders)
+	s.Require().True(decEq(utils.ParseDec("1.05"), *stats.HighPrice))
+	s.Require().True(decEq(utils.ParseDec("1.0"), *stats.LowPrice))
+	s.Require().True(coinsEq(utils.ParseCoins("6000denom1,6150denom2"), stats.SwapFees))
+
+	// The bucket goes out of the stats window after 24 hours.
+	s.ctx = s.ctx.WithBlockTime(utils.ParseTime("2022-01-02T00:00:00Z"))
+	stats = s.keeper.GetPairStats(s.ctx, pair.Id)
+	s.Require().True(stats.BaseVolume.IsZero())
+	s.Require().Zero(stats.NumMatchedOrders)
+	s.Require().Nil(stats.HighPrice)
+
+	// The outdated bucket is deleted when the pair is matched again.
+	liquidity.BeginBlocker(s.ctx, s.keeper)
+	s.buyLimitOrder(s.addr(1), pair.Id, utils.ParseDec("1.1"), sdk.NewInt(1000000), time.Hour, true)
+	s.sellLimitOrder(s.addr(2), pair.Id, utils.ParseDec("1.1"), sdk.NewInt(1000000), time.Hour, true)
+	liquidity.EndBlocker(s.ctx, s.keeper)
+	_, found = s.keeper.GetPairStatsBucket(s.ctx, pair.Id, utils.ParseTime("2022-01-01T00:00:00Z"))
+	s.Require().False(found)
+	buckets := s.keeper.GetAllPairStatsBuckets(s.ctx)
+	s.Require().Len(buckets, 1)
+	s.Require().Equal(utils.ParseTime("2022-01-02T00:00:00Z"), buckets[0].StartTime)
+	s.Require().EqualValues(2, buckets[0].NumMatchedOrders)
+}
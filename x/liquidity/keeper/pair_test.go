@@ -1,8 +1,14 @@
 package keeper_test
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
+	utils "github.com/crescent-network/crescent/v4/types"
+	"github.com/crescent-network/crescent/v4/x/liquidity"
 	"github.com/crescent-network/crescent/v4/x/liquidity/types"
 )
 
@@ -20,3 +26,431 @@ func (s *KeeperTestSuite) TestPairIndexes() {
 	s.Require().Len(resp.Pairs, 1)
 	s.Require().Equal(pair.Id, resp.Pairs[0].Id)
 }
+
+func (s *KeeperTestSuite) TestPairBootstrapAuction() {
+	params := s.keeper.GetParams(s.ctx)
+	params.NumBootstrapBatches = 3
+	s.keeper.SetParams(s.ctx, params)
+
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	s.Require().EqualValues(3, pair.BootstrapEndBatchId)
+	s.Require().True(pair.IsBootstrapping())
+
+	s.buyLimitOrder(s.addr(1), pair.Id, utils.ParseDec("1.1"), sdk.NewInt(10000), time.Hour, true)
+	s.sellLimitOrder(s.addr(2), pair.Id, utils.ParseDec("0.9"), sdk.NewInt(10000), time.Hour, true)
+	s.nextBlock()
+
+	// Orders are not matched during the bootstrap phase.
+	pair, _ = s.keeper.GetPair(s.ctx, pair.Id)
+	s.Require().EqualValues(2, pair.CurrentBatchId)
+	s.Require().Nil(pair.LastPrice)
+	s.Require().True(s.getBalance(s.addr(1), "denom1").IsZero())
+
+	s.sellLimitOrder(s.addr(3), pair.Id, utils.ParseDec("1.0"), sdk.NewInt(10000), time.Hour, true)
+	s.nextBlock()
+	pair, _ = s.keeper.GetPair(s.ctx, pair.Id)
+	s.Require().EqualValues(3, pair.CurrentBatchId)
+	s.Require().False(pair.IsBootstrapping())
+	s.Require().Nil(pair.LastPrice)
+
+	// The bootstrap auction matches all accumulated orders at a single price.
+	s.nextBlock()
+	pair, _ = s.keeper.GetPair(s.ctx, pair.Id)
+	s.Require().EqualValues(4, pair.CurrentBatchId)
+	s.Require().NotNil(pair.LastPrice)
+	s.Require().True(s.getBalance(s.addr(1), "denom1").Amount.IsPositive())
+	s.Require().True(s.getBalance(s.addr(2), "denom2").Amount.IsPositive())
+}
+
+func (s *KeeperTestSuite) TestPairCircuitBreaker() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pool := s.createPool(s.addr(0), pair.Id, utils.ParseCoins("1000000denom1,1000000denom2"), true)
+	pair2 := s.createPair(s.addr(0), "denom2", "denom3", true)
+
+	order := s.buyLimitOrder(s.addr(1), pair.Id, utils.ParseDec("1.1"), sdk.NewInt(10000), time.Hour, true)
+
+	handler := liquidity.NewProposalHandler(s.keeper)
+	err := handler(s.ctx, types.NewPairCircuitBreakerProposal("title", "description", 10, true))
+	s.Require().ErrorIs(err, sdkerrors.ErrNotFound)
+	err = handler(s.ctx, types.NewPairCircuitBreakerProposal("title", "description", pair.Id, true))
+	s.Require().NoError(err)
+	pair, _ = s.keeper.GetPair(s.ctx, pair.Id)
+	s.Require().True(s.keeper.IsPairHalted(s.ctx, pair))
+	s.Require().False(s.keeper.IsPairHalted(s.ctx, pair2))
+
+	// The order is not matched, but the batch id advances.
+	s.nextBlock()
+	pair, _ = s.keeper.GetPair(s.ctx, pair.Id)
+	s.Require().EqualValues(2, pair.CurrentBatchId)
+	order, _ = s.keeper.GetOrder(s.ctx, pair.Id, order.Id)
+	s.Require().Equal(types.OrderStatusNotExecuted, order.Status)
+	s.Require().True(s.getBalance(s.addr(1), "denom1").IsZero())
+
+	// New orders and deposits are rejected.
+	offerCoin := utils.ParseCoin("11000denom2")
+	s.fundAddr(s.addr(2), sdk.NewCoins(offerCoin))
+	_, err = s.keeper.LimitOrder(s.ctx, types.NewMsgLimitOrder(
+		s.addr(2), pair.Id, types.OrderDirectionBuy, offerCoin, "denom1",
+		utils.ParseDec("1.1"), sdk.NewInt(10000), time.Hour))
+	s.Require().ErrorIs(err, types.ErrPairHalted)
+	_, err = s.keeper.MarketOrder(s.ctx, types.NewMsgMarketOrder(
+		s.addr(2), pair.Id, types.OrderDirectionBuy, offerCoin, "denom1", sdk.NewInt(10000), time.Hour))
+	s.Require().ErrorIs(err, types.ErrPairHalted)
+	_, err = s.keeper.MMOrder(s.ctx, types.NewMsgMMOrder(
+		s.addr(2), pair.Id, sdk.Dec{}, sdk.Dec{}, sdk.ZeroInt(),
+		utils.ParseDec("1.1"), utils.ParseDec("1.0"), sdk.NewInt(10000), time.Hour))
+	s.Require().ErrorIs(err, types.ErrPairHalted)
+	depositCoins := utils.ParseCoins("1000000denom1,1000000denom2")
+	s.fundAddr(s.addr(3), depositCoins)
+	_, err = s.keeper.Deposit(s.ctx, types.NewMsgDeposit(s.addr(3), pool.Id, depositCoins))
+	s.Require().ErrorIs(err, types.ErrPairHalted)
+
+	// Orders can still be canceled and pool coins can still be withdrawn.
+	s.cancelOrder(s.addr(1), pair.Id, order.Id)
+	s.withdraw(s.addr(0), pool.Id, utils.ParseCoin("1000000pool1"))
+	s.nextBlock()
+	s.Require().True(coinsEq(utils.ParseCoins("11000denom2"), s.getBalances(s.addr(1))))
+	s.Require().True(s.getBalance(s.addr(0), "denom1").IsPositive())
+
+	// The global circuit breaker halts all pairs.
+	params := s.keeper.GetParams(s.ctx)
+	params.CircuitBreakerEnabled = true
+	s.keeper.SetParams(s.ctx, params)
+	s.Require().True(s.keeper.IsPairHalted(s.ctx, pair2))
+	params.CircuitBreakerEnabled = false
+	s.keeper.SetParams(s.ctx, params)
+
+	// The pair resumes matching once the circuit breaker is released.
+	err = handler(s.ctx, types.NewPairCircuitBreakerProposal("title", "description", pair.Id, false))
+	s.Require().NoError(err)
+	pair, _ = s.keeper.GetPair(s.ctx, pair.Id)
+	s.Require().False(s.keeper.IsPairHalted(s.ctx, pair))
+	s.buyLimitOrder(s.addr(2), pair.Id, utils.ParseDec("1.1"), sdk.NewInt(10000), time.Hour, false)
+	s.nextBlock()
+	s.Require().True(s.getBalance(s.addr(2), "denom1").IsPositive())
+}
+
+func (s *KeeperTestSuite) TestPairBatchWindow() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	s.createPool(s.addr(0), pair.Id, utils.ParseCoins("1000000denom1,1000000denom2"), true)
+
+	handler := liquidity.NewProposalHandler(s.keeper)
+	err := handler(s.ctx, types.NewPairBatchWindowProposal("title", "description", 10, 3))
+	s.Require().ErrorIs(err, sdkerrors.ErrNotFound)
+	err = handler(s.ctx, types.NewPairBatchWindowProposal("title", "description", pair.Id, 3))
+	s.Require().NoError(err)
+	pair, _ = s.keeper.GetPair(s.ctx, pair.Id)
+	s.Require().EqualValues(3, pair.BatchWindow)
+
+	// Move to the start of a window.
+	for s.ctx.BlockHeight()%3 != 0 {
+		s.nextBlock()
+	}
+
+	// The pair's batch is executed exactly once in each window.
+	for i := 0; i < 3; i++ {
+		pair, _ = s.keeper.GetPair(s.ctx, pair.Id)
+		batchId := pair.CurrentBatchId
+		balanceBefore := s.getBalance(s.addr(1), "denom1")
+		s.buyLimitOrder(s.addr(1), pair.Id, utils.ParseDec("1.05"), sdk.NewInt(1000), 0, true)
+		for j := 0; j < 3; j++ {
+			s.nextBlock()
+		}
+		pair, _ = s.keeper.GetPair(s.ctx, pair.Id)
+		s.Require().Equal(batchId+1, pair.CurrentBatchId)
+
+		// The order with no lifespan is kept until the pair's batch is
+		// executed, so it gets matched.
+		s.Require().True(s.getBalance(s.addr(1), "denom1").Amount.GT(balanceBefore.Amount))
+	}
+
+	// The pair's batch is executed every batch once the batch window is disabled.
+	err = handler(s.ctx, types.NewPairBatchWindowProposal("title", "description", pair.Id, 0))
+	s.Require().NoError(err)
+	pair, _ = s.keeper.GetPair(s.ctx, pair.Id)
+	batchId := pair.CurrentBatchId
+	s.nextBlock()
+	s.nextBlock()
+	pair, _ = s.keeper.GetPair(s.ctx, pair.Id)
+	s.Require().Equal(batchId+2, pair.CurrentBatchId)
+}
+
+func (s *KeeperTestSuite) TestPairOrderAmountLimits() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	s.createPool(s.addr(0), pair.Id, utils.ParseCoins("1000000denom1,1000000denom2"), true)
+	pair.LastPrice = utils.ParseDecP("1.0")
+	s.keeper.SetPair(s.ctx, pair)
+
+	handler := liquidity.NewProposalHandler(s.keeper)
+	limits := types.NewPairOrderAmountLimits(sdk.NewInt(10000), sdk.NewInt(10500), sdk.NewInt(1000))
+	err := handler(s.ctx, types.NewPairOrderAmountLimitsProposal("title", "description", 10, limits))
+	s.Require().ErrorIs(err, sdkerrors.ErrNotFound)
+	err = handler(s.ctx, types.NewPairOrderAmountLimitsProposal("title", "description", pair.Id, limits))
+	s.Require().NoError(err)
+	pair, _ = s.keeper.GetPair(s.ctx, pair.Id)
+	s.Require().NotNil(pair.OrderAmountLimits)
+	s.Require().True(intEq(sdk.NewInt(1000), pair.OrderAmountLimits.LotSize))
+
+	orderer := s.addr(1)
+	s.fundAddr(orderer, utils.ParseCoins("1000000denom1,1000000denom2"))
+	limitOrder := func(price sdk.Dec, amt sdk.Int) error {
+		_, err := s.keeper.LimitOrder(s.ctx, types.NewMsgLimitOrder(
+			orderer, pair.Id, types.OrderDirectionSell, sdk.NewCoin("denom1", amt), "denom2", price, amt, 0))
+		return err
+	}
+	s.Require().ErrorIs(limitOrder(utils.ParseDec("1.0"), sdk.NewInt(9000)), types.ErrTooSmallOrder)
+	s.Require().ErrorIs(limitOrder(utils.ParseDec("0.95"), sdk.NewInt(11000)), types.ErrTooSmallOrder)
+	s.Require().ErrorIs(limitOrder(utils.ParseDec("1.0"), sdk.NewInt(10500)), types.ErrInvalidLotSize)
+	s.Require().NoError(limitOrder(utils.ParseDec("1.0"), sdk.NewInt(11000)))
+
+	_, err = s.keeper.MarketOrder(s.ctx, types.NewMsgMarketOrder(
+		orderer, pair.Id, types.OrderDirectionSell, utils.ParseCoin("12500denom1"), "denom2", sdk.NewInt(12500), 0))
+	s.Require().ErrorIs(err, types.ErrInvalidLotSize)
+
+	// Each tick of market making orders must satisfy the min order amounts.
+	_, err = s.keeper.MMOrder(s.ctx, types.NewMsgMMOrder(
+		orderer, pair.Id, utils.ParseDec("1.1"), utils.ParseDec("1.01"), sdk.NewInt(20000),
+		sdk.Dec{}, sdk.Dec{}, sdk.ZeroInt(), 0))
+	s.Require().ErrorIs(err, types.ErrTooSmallOrder)
+
+	// Zero limits remove the pair's limits.
+	err = handler(s.ctx, types.NewPairOrderAmountLimitsProposal(
+		"title", "description", pair.Id, types.NewPairOrderAmountLimits(sdk.ZeroInt(), sdk.ZeroInt(), sdk.ZeroInt())))
+	s.Require().NoError(err)
+	pair, _ = s.keeper.GetPair(s.ctx, pair.Id)
+	s.Require().Nil(pair.OrderAmountLimits)
+	s.Require().NoError(limitOrder(utils.ParseDec("1.0"), sdk.NewInt(9000)))
+}
+
+func (s *KeeperTestSuite) TestPairParams() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	s.createPool(s.addr(0), pair.Id, utils.ParseCoins("1000000denom1,1000000denom2"), true)
+	pair.LastPrice = utils.ParseDecP("1.0")
+	s.keeper.SetPair(s.ctx, pair)
+
+	// The module's params are used for a pair without overrides.
+	upward, downward := s.keeper.GetPairPriceLimitRatios(s.ctx, pair)
+	s.Require().True(decEq(s.keeper.GetUpwardPriceLimitRatio(s.ctx), upward))
+	s.Require().True(decEq(s.keeper.GetDownwardPriceLimitRatio(s.ctx), downward))
+	s.Require().Equal(s.keeper.GetMaxOrderLifespan(s.ctx), s.keeper.GetPairMaxOrderLifespan(s.ctx, pair))
+
+	handler := liquidity.NewProposalHandler(s.keeper)
+	upwardRatio, downwardRatio := utils.ParseDec("0.05"), utils.ParseDec("0.02")
+	lifespan := 48 * time.Hour
+	err := handler(s.ctx, types.NewPairParamsProposal(
+		"title", "description", 10, &upwardRatio, &downwardRatio, &lifespan))
+	s.Require().ErrorIs(err, sdkerrors.ErrNotFound)
+	err = handler(s.ctx, types.NewPairParamsProposal(
+		"title", "description", pair.Id, &upwardRatio, &downwardRatio, &lifespan))
+	s.Require().NoError(err)
+	pair, _ = s.keeper.GetPair(s.ctx, pair.Id)
+	upward, downward = s.keeper.GetPairPriceLimitRatios(s.ctx, pair)
+	s.Require().True(decEq(upwardRatio, upward))
+	s.Require().True(decEq(downwardRatio, downward))
+	s.Require().Equal(lifespan, s.keeper.GetPairMaxOrderLifespan(s.ctx, pair))
+
+	orderer := s.addr(1)
+	s.fundAddr(orderer, utils.ParseCoins("1000000denom1,1000000denom2"))
+	limitOrder := func(price sdk.Dec, lifespan time.Duration) error {
+		_, err := s.keeper.LimitOrder(s.ctx, types.NewMsgLimitOrder(
+			orderer, pair.Id, types.OrderDirectionSell, utils.ParseCoin("10000denom1"), "denom2", price,
+			sdk.NewInt(10000), lifespan))
+		return err
+	}
+	// The pair's downward ratio is tighter than the module's param.
+	s.Require().ErrorIs(limitOrder(utils.ParseDec("0.95"), 0), types.ErrPriceOutOfRange)
+	s.Require().NoError(limitOrder(utils.ParseDec("0.99"), 0))
+	// The band is not symmetric.
+	s.Require().NoError(limitOrder(utils.ParseDec("1.04"), 0))
+	s.Require().ErrorIs(limitOrder(utils.ParseDec("1.06"), 0), types.ErrPriceOutOfRange)
+	// The pair's lifespan is longer than the module's param.
+	s.Require().NoError(limitOrder(utils.ParseDec("0.99"), 36*time.Hour))
+	s.Require().ErrorIs(limitOrder(utils.ParseDec("0.99"), 72*time.Hour), types.ErrTooLongOrderLifespan)
+
+	// Omitted params remove the pair's overrides.
+	err = handler(s.ctx, types.NewPairParamsProposal("title", "description", pair.Id, nil, nil, nil))
+	s.Require().NoError(err)
+	pair, _ = s.keeper.GetPair(s.ctx, pair.Id)
+	s.Require().Nil(pair.UpwardPriceLimitRatio)
+	s.Require().Nil(pair.DownwardPriceLimitRatio)
+	s.Require().Nil(pair.MaxOrderLifespan)
+	s.Require().NoError(limitOrder(utils.ParseDec("0.95"), 0))
+	s.Require().ErrorIs(limitOrder(utils.ParseDec("0.99"), 36*time.Hour), types.ErrTooLongOrderLifespan)
+}
+
+func (s *KeeperTestSuite) TestPriceBandWidening() {
+	params := s.keeper.GetParams(s.ctx)
+	params.PriceBandWideningBatches = 2
+	params.MaxPriceBandWideningSteps = 2
+	s.keeper.SetParams(s.ctx, params)
+
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pair.LastPrice = utils.ParseDecP("1.0")
+	s.keeper.SetPair(s.ctx, pair)
+
+	// Batches without a trade widen the band.
+	for _, tc := range []struct {
+		highest, lowest sdk.Dec
+	}{
+		{utils.ParseDec("1.1"), utils.ParseDec("0.9")},
+		{utils.ParseDec("1.1"), utils.ParseDec("0.9")},
+		{utils.ParseDec("1.21"), utils.ParseDec("0.81")},
+		{utils.ParseDec("1.21"), utils.ParseDec("0.81")},
+		{utils.ParseDec("1.331"), utils.ParseDec("0.729")},
+		{utils.ParseDec("1.331"), utils.ParseDec("0.729")},
+		{utils.ParseDec("1.331"), utils.ParseDec("0.729")}, // Capped by MaxPriceBandWideningSteps.
+	} {
+		pair, _ = s.keeper.GetPair(s.ctx, pair.Id)
+		lowest, highest := s.keeper.PriceLimits(s.ctx, pair)
+		s.Require().True(decEq(tc.lowest, lowest))
+		s.Require().True(decEq(tc.highest, highest))
+		s.nextBlock()
+	}
+
+	// A trade resets the band.
+	s.createPool(s.addr(0), pair.Id, utils.ParseCoins("1000000denom1,1000000denom2"), true)
+	s.sellLimitOrder(s.addr(1), pair.Id, utils.ParseDec("0.99"), sdk.NewInt(10000), 0, true)
+	s.nextBlock()
+	pair, _ = s.keeper.GetPair(s.ctx, pair.Id)
+	s.Require().Zero(pair.NumBatchesWithoutTrade)
+	upward, downward := s.keeper.GetPairPriceLimitRatios(s.ctx, pair)
+	s.Require().True(decEq(params.UpwardPriceLimitRatio, upward))
+	s.Require().True(decEq(params.DownwardPriceLimitRatio, downward))
+}
+
+func (s *KeeperTestSuite) setDenomExponent(denom, display string, exp uint32) {
+	s.app.BankKeeper.SetDenomMetaData(s.ctx, banktypes.Metadata{
+		Base: denom,
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: denom, Exponent: 0},
+			{Denom: display, Exponent: exp},
+		},
+		Display: display,
+	})
+}
+
+func (s *KeeperTestSuite) TestPairPriceExponent() {
+	s.setDenomExponent("aweth", "weth", 18)
+	s.setDenomExponent("ucre", "cre", 6)
+	s.setDenomExponent("yoctodenom", "denom", 24)
+
+	pair := s.createPair(s.addr(0), "aweth", "ucre", true)
+	s.Require().EqualValues(-12, pair.PriceExponent)
+	s.Require().EqualValues(18, s.keeper.GetDenomExponent(s.ctx, "aweth"))
+	// Denoms without metadata have the default exponent.
+	s.Require().EqualValues(types.DefaultDenomExponent, s.keeper.GetDenomExponent(s.ctx, "denom1"))
+
+	pair2 := s.createPair(s.addr(0), "ucre", "aweth", true)
+	s.Require().EqualValues(12, pair2.PriceExponent)
+
+	// The price of 1 yoctodenom in ucre can't be represented on ticks.
+	s.fundAddr(s.addr(0), s.keeper.GetPairCreationFee(s.ctx))
+	_, err := s.keeper.CreatePair(s.ctx, types.NewMsgCreatePair(s.addr(0), "yoctodenom", "ucre"))
+	s.Require().ErrorIs(err, types.ErrUnsupportedPriceExponent)
+
+	// 1 weth = 1.23 cre.
+	price := pair.DenormalizePrice(utils.ParseDec("1.23"))
+	s.Require().True(decEq(utils.ParseDec("0.00000000000123"), price))
+	s.sellLimitOrder(s.addr(1), pair.Id, price, sdk.NewInt(1_000000000000000000), time.Hour, true)
+	s.buyLimitOrder(s.addr(2), pair.Id, price, sdk.NewInt(1_000000000000000000), time.Hour, true)
+	s.nextBlock()
+
+	pair, _ = s.keeper.GetPair(s.ctx, pair.Id)
+	s.Require().True(decEq(utils.ParseDec("1.23"), pair.NormalizePrice(*pair.LastPrice)))
+	s.Require().True(intEq(sdk.NewInt(1_000000000000000000), s.getBalance(s.addr(2), "aweth").Amount))
+	s.Require().True(intEq(sdk.NewInt(1_230000), s.getBalance(s.addr(1), "ucre").Amount))
+}
+
+func (s *KeeperTestSuite) TestPairCreationFeeDestination() {
+	fee := s.keeper.GetPairCreationFee(s.ctx)
+	communityPoolBefore := s.app.DistrKeeper.GetFeePoolCommunityCoins(s.ctx)
+	supplyBefore := s.app.BankKeeper.GetSupply(s.ctx, sdk.DefaultBondDenom)
+
+	// The fee goes to the fee collector by default.
+	s.createPair(s.addr(0), "denom1", "denom2", true)
+	s.Require().True(coinsEq(fee, s.getBalances(s.keeper.GetFeeCollector(s.ctx))))
+
+	params := s.keeper.GetParams(s.ctx)
+	params.PairCreationFeeDestination = types.PairCreationFeeDestinationCommunityPool
+	s.keeper.SetParams(s.ctx, params)
+	s.createPair(s.addr(0), "denom2", "denom3", true)
+	s.Require().Equal(
+		communityPoolBefore.Add(sdk.NewDecCoinsFromCoins(fee...)...),
+		s.app.DistrKeeper.GetFeePoolCommunityCoins(s.ctx))
+
+	params.PairCreationFeeDestination = types.PairCreationFeeDestinationBurn
+	s.keeper.SetParams(s.ctx, params)
+	s.createPair(s.addr(0), "denom3", "denom4", true)
+	// fundAddr minted the fee three times, and only the burned one is gone.
+	s.Require().True(intEq(
+		supplyBefore.Amount.Add(fee.AmountOf(sdk.DefaultBondDenom).MulRaw(2)),
+		s.app.BankKeeper.GetSupply(s.ctx, sdk.DefaultBondDenom).Amount))
+}
+
+func (s *KeeperTestSuite) TestPairCreatorAllowlist() {
+	params := s.keeper.GetParams(s.ctx)
+	params.PermissionlessPairCreation = false
+	params.PairCreatorAllowlist = []string{s.addr(0).String()}
+	s.keeper.SetParams(s.ctx, params)
+
+	s.createPair(s.addr(0), "denom1", "denom2", true)
+
+	s.fundAddr(s.addr(1), s.keeper.GetPairCreationFee(s.ctx))
+	_, err := s.keeper.CreatePair(s.ctx, types.NewMsgCreatePair(s.addr(1), "denom2", "denom3"))
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	params.PermissionlessPairCreation = true
+	s.keeper.SetParams(s.ctx, params)
+	s.createPair(s.addr(1), "denom2", "denom3", false)
+}
+
+func (s *KeeperTestSuite) TestSetPairMetadata() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	s.Require().Equal(s.addr(0).String(), pair.Creator)
+	s.Require().Nil(pair.Metadata)
+
+	metadata := types.NewPairMetadata("DENOM1/DENOM2", "", 6, 6)
+
+	// Only the pair creator can set the metadata.
+	_, err := s.keeper.SetPairMetadata(s.ctx, types.NewMsgSetPairMetadata(s.addr(1), pair.Id, metadata))
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	_, err = s.keeper.SetPairMetadata(s.ctx, types.NewMsgSetPairMetadata(s.addr(0), 10, metadata))
+	s.Require().ErrorIs(err, sdkerrors.ErrNotFound)
+
+	_, err = s.keeper.SetPairMetadata(s.ctx, types.NewMsgSetPairMetadata(s.addr(0), pair.Id, metadata))
+	s.Require().NoError(err)
+
+	resp, err := s.querier.Pair(sdk.WrapSDKContext(s.ctx), &types.QueryPairRequest{PairId: pair.Id})
+	s.Require().NoError(err)
+	s.Require().NotNil(resp.Pair.Metadata)
+	s.Require().Equal(metadata, *resp.Pair.Metadata)
+
+	// The metadata is immutable.
+	_, err = s.keeper.SetPairMetadata(s.ctx, types.NewMsgSetPairMetadata(
+		s.addr(0), pair.Id, types.NewPairMetadata("NEW", "", 6, 6)))
+	s.Require().ErrorIs(err, types.ErrPairMetadataAlreadySet)
+	handler := liquidity.NewProposalHandler(s.keeper)
+	err = handler(s.ctx, types.NewPairMetadataProposal("title", "description", pair.Id, types.NewPairMetadata("NEW", "", 6, 6)))
+	s.Require().ErrorIs(err, types.ErrPairMetadataAlreadySet)
+}
+
+func (s *KeeperTestSuite) TestPairMetadataProposal() {
+	// Pairs without the creator recorded can have metadata only through governance.
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pair.Creator = ""
+	s.keeper.SetPair(s.ctx, pair)
+
+	metadata := types.NewPairMetadata("DENOM1/DENOM2", "", 6, 6)
+	_, err := s.keeper.SetPairMetadata(s.ctx, types.NewMsgSetPairMetadata(s.addr(0), pair.Id, metadata))
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	handler := liquidity.NewProposalHandler(s.keeper)
+	err = handler(s.ctx, types.NewPairMetadataProposal("title", "description", 10, metadata))
+	s.Require().ErrorIs(err, sdkerrors.ErrNotFound)
+	err = handler(s.ctx, types.NewPairMetadataProposal("title", "description", pair.Id, metadata))
+	s.Require().NoError(err)
+
+	pair, _ = s.keeper.GetPair(s.ctx, pair.Id)
+	s.Require().Equal(metadata, *pair.Metadata)
+}
@@ -68,10 +68,17 @@ func (k Keeper) GetMinInitialDepositAmount(ctx sdk.Context) (amt sdk.Int) {
 	return
 }
 
-// GetMaxPriceLimitRatio returns the current maximum price limit ratio
+// GetUpwardPriceLimitRatio returns the current upward price limit ratio
 // parameter.
-func (k Keeper) GetMaxPriceLimitRatio(ctx sdk.Context) (ratio sdk.Dec) {
-	k.paramSpace.Get(ctx, types.KeyMaxPriceLimitRatio, &ratio)
+func (k Keeper) GetUpwardPriceLimitRatio(ctx sdk.Context) (ratio sdk.Dec) {
+	k.paramSpace.Get(ctx, types.KeyUpwardPriceLimitRatio, &ratio)
+	return
+}
+
+// GetDownwardPriceLimitRatio returns the current downward price limit ratio
+// parameter.
+func (k Keeper) GetDownwardPriceLimitRatio(ctx sdk.Context) (ratio sdk.Dec) {
+	k.paramSpace.Get(ctx, types.KeyDownwardPriceLimitRatio, &ratio)
 	return
 }
 
@@ -89,6 +96,18 @@ func (k Keeper) GetMaxOrderLifespan(ctx sdk.Context) (maxLifespan time.Duration)
 	return
 }
 
+// GetSwapFeeRate returns the current swap fee rate parameter.
+func (k Keeper) GetSwapFeeRate(ctx sdk.Context) (feeRate sdk.Dec) {
+	k.paramSpace.Get(ctx, types.KeySwapFeeRate, &feeRate)
+	return
+}
+
+// GetSwapFeeToPools returns the current swap fee to pools parameter.
+func (k Keeper) GetSwapFeeToPools(ctx sdk.Context) (toPools bool) {
+	k.paramSpace.Get(ctx, types.KeySwapFeeToPools, &toPools)
+	return
+}
+
 // GetWithdrawFeeRate returns the current withdraw fee rate parameter.
 func (k Keeper) GetWithdrawFeeRate(ctx sdk.Context) (feeRate sdk.Dec) {
 	k.paramSpace.Get(ctx, types.KeyWithdrawFeeRate, &feeRate)
@@ -129,3 +148,147 @@ func (k Keeper) GetMaxNumActivePoolsPerPair(ctx sdk.Context) (i uint32) {
 func (k Keeper) SetMaxNumActivePoolsPerPair(ctx sdk.Context, i uint32) {
 	k.paramSpace.Set(ctx, types.KeyMaxNumActivePoolsPerPair, i)
 }
+
+// GetMaxNumPrunedEntriesPerMsg returns the current maximum number of entries
+// pruned by a single MsgPruneExpired.
+func (k Keeper) GetMaxNumPrunedEntriesPerMsg(ctx sdk.Context) (i uint32) {
+	k.paramSpace.Get(ctx, types.KeyMaxNumPrunedEntriesPerMsg, &i)
+	return
+}
+
+// GetPruneRewardPerEntry returns the current prune reward per entry parameter.
+func (k Keeper) GetPruneRewardPerEntry(ctx sdk.Context) (reward sdk.Coins) {
+	k.paramSpace.Get(ctx, types.KeyPruneRewardPerEntry, &reward)
+	return
+}
+
+// GetPriceHistoryLength returns the current maximum number of price history
+// entries kept for each pair.
+func (k Keeper) GetPriceHistoryLength(ctx sdk.Context) (i uint32) {
+	k.paramSpace.Get(ctx, types.KeyPriceHistoryLength, &i)
+	return
+}
+
+// GetNumBootstrapBatches returns the current number of bootstrap batches
+// of newly created pairs.
+func (k Keeper) GetNumBootstrapBatches(ctx sdk.Context) (i uint32) {
+	k.paramSpace.Get(ctx, types.KeyNumBootstrapBatches, &i)
+	return
+}
+
+// GetPoolFeeSweepEpoch returns the current number of blocks between pool
+// fee sweeps.
+func (k Keeper) GetPoolFeeSweepEpoch(ctx sdk.Context) (i uint32) {
+	k.paramSpace.Get(ctx, types.KeyPoolFeeSweepEpoch, &i)
+	return
+}
+
+// GetCircuitBreakerEnabled returns whether the global circuit breaker is
+// enabled.
+func (k Keeper) GetCircuitBreakerEnabled(ctx sdk.Context) (enabled bool) {
+	k.paramSpace.Get(ctx, types.KeyCircuitBreakerEnabled, &enabled)
+	return
+}
+
+// GetMaxOrderPriceTicks returns the maximum number of ticks away from
+// the last price that a user order's price can be.
+func (k Keeper) GetMaxOrderPriceTicks(ctx sdk.Context) (ticks uint32) {
+	k.paramSpace.Get(ctx, types.KeyMaxOrderPriceTicks, &ticks)
+	return
+}
+
+// GetRequestResultRetention returns the minimum duration for which the results
+// of finished requests and orders are kept before they can be pruned.
+func (k Keeper) GetRequestResultRetention(ctx sdk.Context) (retention time.Duration) {
+	k.paramSpace.Get(ctx, types.KeyRequestResultRetention, &retention)
+	return
+}
+
+// GetMakerPriority returns whether orders from earlier batches get matched
+// first at the marginal tick.
+func (k Keeper) GetMakerPriority(ctx sdk.Context) (enabled bool) {
+	k.paramSpace.Get(ctx, types.KeyMakerPriority, &enabled)
+	return
+}
+
+// GetMaxNumAutoPrunedRequestResults returns the maximum number of request
+// results pruned at each begin block.
+func (k Keeper) GetMaxNumAutoPrunedRequestResults(ctx sdk.Context) (num uint32) {
+	k.paramSpace.Get(ctx, types.KeyMaxNumAutoPrunedRequestResults, &num)
+	return
+}
+
+// GetPairCreationFeeDestination returns the current pair creation fee
+// destination parameter.
+func (k Keeper) GetPairCreationFeeDestination(ctx sdk.Context) (dest string) {
+	k.paramSpace.Get(ctx, types.KeyPairCreationFeeDestination, &dest)
+	return
+}
+
+// GetPermissionlessPairCreation returns whether anyone can create pairs.
+func (k Keeper) GetPermissionlessPairCreation(ctx sdk.Context) (permissionless bool) {
+	k.paramSpace.Get(ctx, types.KeyPermissionlessPairCreation, &permissionless)
+	return
+}
+
+// GetPairCreatorAllowlist returns the current pair creator allowlist
+// parameter.
+func (k Keeper) GetPairCreatorAllowlist(ctx sdk.Context) (allowlist []string) {
+	k.paramSpace.Get(ctx, types.KeyPairCreatorAllowlist, &allowlist)
+	return
+}
+
+// GetBypassPoolPriceCheckForNewPairs returns whether pools created on pairs in
+// their bootstrap phase skip the initial pool price check.
+func (k Keeper) GetBypassPoolPriceCheckForNewPairs(ctx sdk.Context) (bypass bool) {
+	k.paramSpace.Get(ctx, types.KeyBypassPoolPriceCheckForNewPairs, &bypass)
+	return
+}
+
+// GetDustSweepEpoch returns the current number of blocks between dust sweeps.
+func (k Keeper) GetDustSweepEpoch(ctx sdk.Context) (i uint32) {
+	k.paramSpace.Get(ctx, types.KeyDustSweepEpoch, &i)
+	return
+}
+
+// GetInstantDepositWithdraw returns whether deposit and withdraw requests are
+// executed instantly in the msg handler.
+func (k Keeper) GetInstantDepositWithdraw(ctx sdk.Context) (instant bool) {
+	k.paramSpace.Get(ctx, types.KeyInstantDepositWithdraw, &instant)
+	return
+}
+
+// GetMaxNumActiveOrdersPerPair returns the maximum number of active orders
+// an orderer can have in a pair.
+func (k Keeper) GetMaxNumActiveOrdersPerPair(ctx sdk.Context) (num uint32) {
+	k.paramSpace.Get(ctx, types.KeyMaxNumActiveOrdersPerPair, &num)
+	return
+}
+
+// GetPriceBandWideningBatches returns the number of batches in a row without
+// a trade after which the price band of a pair widens by a step.
+func (k Keeper) GetPriceBandWideningBatches(ctx sdk.Context) (num uint32) {
+	k.paramSpace.Get(ctx, types.KeyPriceBandWideningBatches, &num)
+	return
+}
+
+// GetMaxPriceBandWideningSteps returns the maximum number of steps the price
+// band of a pair widens by.
+func (k Keeper) GetMaxPriceBandWideningSteps(ctx sdk.Context) (num uint32) {
+	k.paramSpace.Get(ctx, types.KeyMaxPriceBandWideningSteps, &num)
+	return
+}
+
+// GetOrderCommitBond returns the amount of coins escrowed for each order
+// commit.
+func (k Keeper) GetOrderCommitBond(ctx sdk.Context) (bond sdk.Coins) {
+	k.paramSpace.Get(ctx, types.KeyOrderCommitBond, &bond)
+	return
+}
+
+// GetOrderRevealBatches returns the number of batches of a pair after the
+// batch of an order commit, in which the order can be revealed.
+func (k Keeper) GetOrderRevealBatches(ctx sdk.Context) (num uint32) {
+	k.paramSpace.Get(ctx, types.KeyOrderRevealBatches, &num)
+	return
+}
@@ -36,8 +36,28 @@ func (s *KeeperTestSuite) TestGetMinInitialDepositAmount() {
 	s.Require().EqualValues(types.DefaultMinInitialDepositAmount, s.keeper.GetMinInitialDepositAmount(s.ctx))
 }
 
-func (s *KeeperTestSuite) TestGetMaxPriceLimitRatio() {
-	s.Require().EqualValues(types.DefaultMaxPriceLimitRatio, s.keeper.GetMaxPriceLimitRatio(s.ctx))
+func (s *KeeperTestSuite) TestGetUpwardPriceLimitRatio() {
+	s.Require().EqualValues(types.DefaultUpwardPriceLimitRatio, s.keeper.GetUpwardPriceLimitRatio(s.ctx))
+}
+
+func (s *KeeperTestSuite) TestGetDownwardPriceLimitRatio() {
+	s.Require().EqualValues(types.DefaultDownwardPriceLimitRatio, s.keeper.GetDownwardPriceLimitRatio(s.ctx))
+}
+
+func (s *KeeperTestSuite) TestGetPriceBandWideningBatches() {
+	s.Require().EqualValues(types.DefaultPriceBandWideningBatches, s.keeper.GetPriceBandWideningBatches(s.ctx))
+}
+
+func (s *KeeperTestSuite) TestGetMaxPriceBandWideningSteps() {
+	s.Require().EqualValues(types.DefaultMaxPriceBandWideningSteps, s.keeper.GetMaxPriceBandWideningSteps(s.ctx))
+}
+
+func (s *KeeperTestSuite) TestGetOrderCommitBond() {
+	s.Require().EqualValues(types.DefaultOrderCommitBond, s.keeper.GetOrderCommitBond(s.ctx))
+}
+
+func (s *KeeperTestSuite) TestGetOrderRevealBatches() {
+	s.Require().EqualValues(types.DefaultOrderRevealBatches, s.keeper.GetOrderRevealBatches(s.ctx))
 }
 
 func (s *KeeperTestSuite) TestGetMaxNumMarketMakingOrderTicks() {
@@ -48,6 +68,14 @@ func (s *KeeperTestSuite) TestGetMaxOrderLifespan() {
 	s.Require().EqualValues(types.DefaultMaxOrderLifespan, s.keeper.GetMaxOrderLifespan(s.ctx))
 }
 
+func (s *KeeperTestSuite) TestGetSwapFeeRate() {
+	s.Require().EqualValues(types.DefaultSwapFeeRate, s.keeper.GetSwapFeeRate(s.ctx))
+}
+
+func (s *KeeperTestSuite) TestGetSwapFeeToPools() {
+	s.Require().EqualValues(types.DefaultSwapFeeToPools, s.keeper.GetSwapFeeToPools(s.ctx))
+}
+
 func (s *KeeperTestSuite) TestGetWithdrawFeeRate() {
 	s.Require().EqualValues(types.DefaultWithdrawFeeRate, s.keeper.GetWithdrawFeeRate(s.ctx))
 }
@@ -67,3 +95,63 @@ func (s *KeeperTestSuite) TestGetOrderExtraGas() {
 func (s *KeeperTestSuite) TestGetMaxNumActivePoolsPerPair() {
 	s.Require().EqualValues(types.DefaultMaxNumActivePoolsPerPair, s.keeper.GetMaxNumActivePoolsPerPair(s.ctx))
 }
+
+func (s *KeeperTestSuite) TestGetMaxNumPrunedEntriesPerMsg() {
+	s.Require().EqualValues(types.DefaultMaxNumPrunedEntriesPerMsg, s.keeper.GetMaxNumPrunedEntriesPerMsg(s.ctx))
+}
+
+func (s *KeeperTestSuite) TestGetPruneRewardPerEntry() {
+	s.Require().EqualValues(types.DefaultPruneRewardPerEntry, s.keeper.GetPruneRewardPerEntry(s.ctx))
+}
+
+func (s *KeeperTestSuite) TestGetPriceHistoryLength() {
+	s.Require().EqualValues(types.DefaultPriceHistoryLength, s.keeper.GetPriceHistoryLength(s.ctx))
+}
+
+func (s *KeeperTestSuite) TestGetNumBootstrapBatches() {
+	s.Require().EqualValues(types.DefaultNumBootstrapBatches, s.keeper.GetNumBootstrapBatches(s.ctx))
+}
+
+func (s *KeeperTestSuite) TestGetPoolFeeSweepEpoch() {
+	s.Require().EqualValues(types.DefaultPoolFeeSweepEpoch, s.keeper.GetPoolFeeSweepEpoch(s.ctx))
+}
+
+func (s *KeeperTestSuite) TestGetCircuitBreakerEnabled() {
+	s.Require().EqualValues(types.DefaultCircuitBreakerEnabled, s.keeper.GetCircuitBreakerEnabled(s.ctx))
+}
+
+func (s *KeeperTestSuite) TestGetMaxOrderPriceTicks() {
+	s.Require().EqualValues(types.DefaultMaxOrderPriceTicks, s.keeper.GetMaxOrderPriceTicks(s.ctx))
+}
+
+func (s *KeeperTestSuite) TestGetRequestResultRetention() {
+	s.Require().EqualValues(types.DefaultRequestResultRetention, s.keeper.GetRequestResultRetention(s.ctx))
+}
+
+func (s *KeeperTestSuite) TestGetMakerPriority() {
+	s.Require().EqualValues(types.DefaultMakerPriority, s.keeper.GetMakerPriority(s.ctx))
+}
+
+func (s *KeeperTestSuite) TestGetPairCreationFeeDestination() {
+	s.Require().EqualValues(types.DefaultPairCreationFeeDestination, s.keeper.GetPairCreationFeeDestination(s.ctx))
+}
+
+func (s *KeeperTestSuite) TestGetPermissionlessPairCreation() {
+	s.Require().EqualValues(types.DefaultPermissionlessPairCreation, s.keeper.GetPermissionlessPairCreation(s.ctx))
+}
+
+func (s *KeeperTestSuite) TestGetBypassPoolPriceCheckForNewPairs() {
+	s.Require().EqualValues(types.DefaultBypassPoolPriceCheckForNewPairs, s.keeper.GetBypassPoolPriceCheckForNewPairs(s.ctx))
+}
+
+func (s *KeeperTestSuite) TestGetDustSweepEpoch() {
+	s.Require().EqualValues(types.DefaultDustSweepEpoch, s.keeper.GetDustSweepEpoch(s.ctx))
+}
+
+func (s *KeeperTestSuite) TestGetInstantDepositWithdraw() {
+	s.Require().EqualValues(types.DefaultInstantDepositWithdraw, s.keeper.GetInstantDepositWithdraw(s.ctx))
+}
+
+func (s *KeeperTestSuite) TestGetMaxNumActiveOrdersPerPair() {
+	s.Require().EqualValues(types.DefaultMaxNumActiveOrdersPerPair, s.keeper.GetMaxNumActiveOrdersPerPair(s.ctx))
+}
@@ -6,6 +6,8 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"github.com/crescent-network/crescent/v4/x/liquidity/amm"
 	"github.com/crescent-network/crescent/v4/x/liquidity/types"
@@ -67,6 +69,61 @@ func (k Keeper) MarkPoolAsDisabled(ctx sdk.Context, pool types.Pool) {
 	k.SetPool(ctx, pool)
 }
 
+// SweepPoolFees sends swap fees held in each pool's fee address to the pool's
+// reserve, so that the fees are compounded into the pool and the pool price
+// reflects them.
+func (k Keeper) SweepPoolFees(ctx sdk.Context) {
+	_ = k.IterateAllPools(ctx, func(pool types.Pool) (stop bool, err error) {
+		pair, _ := k.GetPair(ctx, pool.PairId)
+		spendable := k.bankKeeper.SpendableCoins(ctx, types.PoolFeeAddress(pool.Id))
+		fees := sdk.NewCoins(
+			sdk.NewCoin(pair.BaseCoinDenom, spendable.AmountOf(pair.BaseCoinDenom)),
+			sdk.NewCoin(pair.QuoteCoinDenom, spendable.AmountOf(pair.QuoteCoinDenom)))
+		if fees.IsZero() {
+			return false, nil
+		}
+		if err := k.bankKeeper.SendCoins(ctx, types.PoolFeeAddress(pool.Id), pool.GetReserveAddress(), fees); err != nil {
+			panic(err)
+		}
+
+		attrs := []sdk.Attribute{
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(pool.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeySweptCoins, fees.String()),
+		}
+		rx, ry := k.getPoolBalances(ctx, pool, pair)
+		ammPool := pool.AMMPool(rx.Amount, ry.Amount, k.GetPoolCoinSupply(ctx, pool))
+		if !ammPool.IsDepleted() {
+			attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyPoolPrice, ammPool.Price().String()))
+		}
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(types.EventTypeSweepPoolFees, attrs...),
+		})
+		return false, nil
+	})
+}
+
+// ValidateInitialPoolPrice validates that the initial price of a new pool in
+// the pair is within the pair's price limit ratios of its last price, preventing
+// mispriced pools from distorting the next batch.
+// Pairs with no last price, and pairs in their bootstrap phase if
+// BypassPoolPriceCheckForNewPairs is set, are not checked.
+func (k Keeper) ValidateInitialPoolPrice(ctx sdk.Context, pair types.Pair, price sdk.Dec) error {
+	if pair.LastPrice == nil {
+		return nil
+	}
+	if pair.IsBootstrapping() && k.GetBypassPoolPriceCheckForNewPairs(ctx) {
+		return nil
+	}
+	upward, downward := k.GetPairPriceLimitRatios(ctx, pair)
+	lowestPrice := pair.LastPrice.Mul(sdk.OneDec().Sub(downward))
+	highestPrice := pair.LastPrice.Mul(sdk.OneDec().Add(upward))
+	if price.LT(lowestPrice) || price.GT(highestPrice) {
+		return sdkerrors.Wrapf(
+			types.ErrPriceOutOfRange, "initial pool price %s is out of range [%s, %s]", price, lowestPrice, highestPrice)
+	}
+	return nil
+}
+
 // ValidateMsgCreatePool validates types.MsgCreatePool.
 func (k Keeper) ValidateMsgCreatePool(ctx sdk.Context, msg *types.MsgCreatePool) error {
 	pair, found := k.GetPair(ctx, msg.PairId)
@@ -123,6 +180,9 @@ func (k Keeper) CreatePool(ctx sdk.Context, msg *types.MsgCreatePool) (types.Poo
 	if err != nil {
 		return types.Pool{}, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
+	if err := k.ValidateInitialPoolPrice(ctx, pair, ammPool.Price()); err != nil {
+		return types.Pool{}, err
+	}
 
 	// Create and save the new pool object.
 	poolId := k.getNextPoolIdWithUpdate(ctx)
@@ -166,6 +226,8 @@ func (k Keeper) CreatePool(ctx sdk.Context, msg *types.MsgCreatePool) (types.Poo
 		),
 	})
 
+	k.afterPoolCreated(ctx, creator, pool)
+
 	return pool, nil
 }
 
@@ -225,6 +287,9 @@ func (k Keeper) CreateRangedPool(ctx sdk.Context, msg *types.MsgCreateRangedPool
 	if err != nil {
 		return types.Pool{}, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
+	if err := k.ValidateInitialPoolPrice(ctx, pair, ammPool.Price()); err != nil {
+		return types.Pool{}, err
+	}
 	ax, ay := ammPool.Balances()
 
 	minInitDepositAmt := k.GetMinInitialDepositAmount(ctx)
@@ -277,6 +342,8 @@ func (k Keeper) CreateRangedPool(ctx sdk.Context, msg *types.MsgCreateRangedPool
 		),
 	})
 
+	k.afterPoolCreated(ctx, creator, pool)
+
 	return pool, nil
 }
 
@@ -292,8 +359,14 @@ func (k Keeper) ValidateMsgDeposit(ctx sdk.Context, msg *types.MsgDeposit) error
 	if pool.Type == types.PoolTypeBasic && len(msg.DepositCoins) != 2 {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "wrong number of deposit coins: %d", len(msg.DepositCoins))
 	}
+	if msg.Farm && k.lpFarmKeeper == nil {
+		return types.ErrFarmingNotSupported
+	}
 
 	pair, _ := k.GetPair(ctx, pool.PairId)
+	if k.IsPairHalted(ctx, pair) {
+		return sdkerrors.Wrapf(types.ErrPairHalted, "pair %d", pair.Id)
+	}
 
 	for _, coin := range msg.DepositCoins {
 		if coin.Denom != pair.BaseCoinDenom && coin.Denom != pair.QuoteCoinDenom {
@@ -313,6 +386,7 @@ func (k Keeper) ValidateMsgDeposit(ctx sdk.Context, msg *types.MsgDeposit) error
 }
 
 // Deposit handles types.MsgDeposit and stores the request.
+// The request is executed right away if InstantDepositWithdraw is set.
 func (k Keeper) Deposit(ctx sdk.Context, msg *types.MsgDeposit) (types.DepositRequest, error) {
 	if err := k.ValidateMsgDeposit(ctx, msg); err != nil {
 		return types.DepositRequest{}, err
@@ -328,7 +402,10 @@ func (k Keeper) Deposit(ctx sdk.Context, msg *types.MsgDeposit) (types.DepositRe
 	k.SetDepositRequest(ctx, req)
 	k.SetDepositRequestIndex(ctx, req)
 
-	ctx.GasMeter().ConsumeGas(k.GetDepositExtraGas(ctx), "DepositExtraGas")
+	instant := k.GetInstantDepositWithdraw(ctx)
+	if !instant {
+		ctx.GasMeter().ConsumeGas(k.GetDepositExtraGas(ctx), "DepositExtraGas")
+	}
 
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
@@ -340,6 +417,81 @@ func (k Keeper) Deposit(ctx sdk.Context, msg *types.MsgDeposit) (types.DepositRe
 		),
 	})
 
+	if instant {
+		return k.executeDepositRequestInstantly(ctx, req)
+	}
+
+	return req, nil
+}
+
+// ValidateMsgDepositSingleAsset validates types.MsgDepositSingleAsset.
+func (k Keeper) ValidateMsgDepositSingleAsset(ctx sdk.Context, msg *types.MsgDepositSingleAsset) error {
+	pool, found := k.GetPool(ctx, msg.PoolId)
+	if !found {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "pool %d not found", msg.PoolId)
+	}
+	if pool.Disabled {
+		return types.ErrDisabledPool
+	}
+	if pool.Type != types.PoolTypeBasic {
+		return sdkerrors.Wrapf(types.ErrWrongPoolType, "pool %d is not a basic pool", pool.Id)
+	}
+
+	pair, _ := k.GetPair(ctx, pool.PairId)
+	if k.IsPairHalted(ctx, pair) {
+		return sdkerrors.Wrapf(types.ErrPairHalted, "pair %d", pair.Id)
+	}
+
+	if msg.DepositCoin.Denom != pair.BaseCoinDenom && msg.DepositCoin.Denom != pair.QuoteCoinDenom {
+		return sdkerrors.Wrapf(types.ErrInvalidCoinDenom, "coin denom %s is not in the pair", msg.DepositCoin.Denom)
+	}
+
+	rx, ry := k.getPoolBalances(ctx, pool, pair)
+	for _, r := range []sdk.Coin{rx, ry} {
+		if r.Denom == msg.DepositCoin.Denom && r.Amount.Add(msg.DepositCoin.Amount).GT(amm.MaxCoinAmount) {
+			return types.ErrTooLargePool
+		}
+	}
+
+	return nil
+}
+
+// DepositSingleAsset handles types.MsgDepositSingleAsset and stores the request.
+// The request is executed right away if InstantDepositWithdraw is set.
+func (k Keeper) DepositSingleAsset(ctx sdk.Context, msg *types.MsgDepositSingleAsset) (types.DepositRequest, error) {
+	if err := k.ValidateMsgDepositSingleAsset(ctx, msg); err != nil {
+		return types.DepositRequest{}, err
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, msg.GetDepositor(), types.GlobalEscrowAddress, sdk.NewCoins(msg.DepositCoin)); err != nil {
+		return types.DepositRequest{}, err
+	}
+
+	pool, _ := k.GetPool(ctx, msg.PoolId)
+	requestId := k.getNextDepositRequestIdWithUpdate(ctx, pool)
+	req := types.NewSingleAssetDepositRequest(msg, pool, requestId, ctx.BlockHeight())
+	k.SetDepositRequest(ctx, req)
+	k.SetDepositRequestIndex(ctx, req)
+
+	instant := k.GetInstantDepositWithdraw(ctx)
+	if !instant {
+		ctx.GasMeter().ConsumeGas(k.GetDepositExtraGas(ctx), "DepositExtraGas")
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeDepositSingleAsset,
+			sdk.NewAttribute(types.AttributeKeyDepositor, msg.Depositor),
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(pool.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyDepositCoins, msg.DepositCoin.String()),
+			sdk.NewAttribute(types.AttributeKeyRequestId, strconv.FormatUint(req.Id, 10)),
+		),
+	})
+
+	if instant {
+		return k.executeDepositRequestInstantly(ctx, req)
+	}
+
 	return req, nil
 }
 
@@ -361,6 +513,7 @@ func (k Keeper) ValidateMsgWithdraw(ctx sdk.Context, msg *types.MsgWithdraw) err
 }
 
 // Withdraw handles types.MsgWithdraw and stores the request.
+// The request is executed right away if InstantDepositWithdraw is set.
 func (k Keeper) Withdraw(ctx sdk.Context, msg *types.MsgWithdraw) (types.WithdrawRequest, error) {
 	if err := k.ValidateMsgWithdraw(ctx, msg); err != nil {
 		return types.WithdrawRequest{}, err
@@ -376,7 +529,10 @@ func (k Keeper) Withdraw(ctx sdk.Context, msg *types.MsgWithdraw) (types.Withdra
 	k.SetWithdrawRequest(ctx, req)
 	k.SetWithdrawRequestIndex(ctx, req)
 
-	ctx.GasMeter().ConsumeGas(k.GetWithdrawExtraGas(ctx), "WithdrawExtraGas")
+	instant := k.GetInstantDepositWithdraw(ctx)
+	if !instant {
+		ctx.GasMeter().ConsumeGas(k.GetWithdrawExtraGas(ctx), "WithdrawExtraGas")
+	}
 
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
@@ -388,6 +544,10 @@ func (k Keeper) Withdraw(ctx sdk.Context, msg *types.MsgWithdraw) (types.Withdra
 		),
 	})
 
+	if instant {
+		return k.executeWithdrawRequestInstantly(ctx, req)
+	}
+
 	return req, nil
 }
 
@@ -395,7 +555,7 @@ func (k Keeper) Withdraw(ctx sdk.Context, msg *types.MsgWithdraw) (types.Withdra
 func (k Keeper) ExecuteDepositRequest(ctx sdk.Context, req types.DepositRequest) error {
 	pool, _ := k.GetPool(ctx, req.PoolId)
 	if pool.Disabled {
-		if err := k.FinishDepositRequest(ctx, req, types.RequestStatusFailed); err != nil {
+		if err := k.FailDepositRequest(ctx, req, types.FailureReasonPoolDisabled); err != nil {
 			return fmt.Errorf("refund deposit request: %w", err)
 		}
 		return nil
@@ -407,16 +567,56 @@ func (k Keeper) ExecuteDepositRequest(ctx sdk.Context, req types.DepositRequest)
 	ammPool := pool.AMMPool(rx.Amount, ry.Amount, ps)
 	if ammPool.IsDepleted() {
 		k.MarkPoolAsDisabled(ctx, pool)
-		if err := k.FinishDepositRequest(ctx, req, types.RequestStatusFailed); err != nil {
+		if err := k.FailDepositRequest(ctx, req, types.FailureReasonPoolDepleted); err != nil {
 			return err
 		}
 		return nil
 	}
 
-	ax, ay, pc := amm.Deposit(rx.Amount, ry.Amount, ps, req.DepositCoins.AmountOf(pair.QuoteCoinDenom), req.DepositCoins.AmountOf(pair.BaseCoinDenom))
+	// The deposit coins are reduced so that the pool's reserve doesn't exceed
+	// the pool's max reserve amount, and the rest is refunded.
+	depositCoins, capped := pool.CapDepositCoins(sdk.NewCoins(rx, ry), req.DepositCoins)
+	if capped {
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeDepositCapped,
+				sdk.NewAttribute(types.AttributeKeyRequestId, strconv.FormatUint(req.Id, 10)),
+				sdk.NewAttribute(types.AttributeKeyDepositor, req.Depositor),
+				sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(req.PoolId, 10)),
+				sdk.NewAttribute(types.AttributeKeyDepositCoins, req.DepositCoins.String()),
+				sdk.NewAttribute(types.AttributeKeyMaxReserveAmount, pool.MaxReserveAmount.String()),
+			),
+		})
+	}
+
+	var (
+		acceptedCoins sdk.Coins
+		pc            sdk.Int
+	)
+	if req.SingleAsset {
+		// The whole deposit coin is accepted into the pool.
+		// The part of it which is taken as the fee is added to the
+		// reserve without minting pool coins, and thus goes to the
+		// existing pool coin holders.
+		depositCoin := sdk.NewCoin(req.DepositCoins[0].Denom, depositCoins.AmountOf(req.DepositCoins[0].Denom))
+		r := rx.Amount
+		if depositCoin.Denom == ry.Denom {
+			r = ry.Amount
+		}
+		pc = amm.DepositSingleAsset(r, ps, depositCoin.Amount, k.GetSwapFeeRate(ctx))
+		acceptedCoins = sdk.NewCoins(depositCoin)
+	} else {
+		var ax, ay sdk.Int
+		ax, ay, pc = amm.Deposit(rx.Amount, ry.Amount, ps, depositCoins.AmountOf(pair.QuoteCoinDenom), depositCoins.AmountOf(pair.BaseCoinDenom))
+		acceptedCoins = sdk.NewCoins(sdk.NewCoin(pair.QuoteCoinDenom, ax), sdk.NewCoin(pair.BaseCoinDenom, ay))
+	}
 
 	if pc.IsZero() {
-		if err := k.FinishDepositRequest(ctx, req, types.RequestStatusFailed); err != nil {
+		reason := types.FailureReasonTooSmallDeposit
+		if capped {
+			reason = types.FailureReasonMaxReserveAmountReached
+		}
+		if err := k.FailDepositRequest(ctx, req, reason); err != nil {
 			return err
 		}
 		return nil
@@ -429,7 +629,6 @@ func (k Keeper) ExecuteDepositRequest(ctx sdk.Context, req types.DepositRequest)
 		return err
 	}
 
-	acceptedCoins := sdk.NewCoins(sdk.NewCoin(pair.QuoteCoinDenom, ax), sdk.NewCoin(pair.BaseCoinDenom, ay))
 	bulkOp := types.NewBulkSendCoinsOperation()
 	bulkOp.QueueSendCoins(types.GlobalEscrowAddress, pool.GetReserveAddress(), acceptedCoins)
 	bulkOp.QueueSendCoins(k.accountKeeper.GetModuleAddress(types.ModuleName), req.GetDepositor(), mintingCoins)
@@ -442,11 +641,49 @@ func (k Keeper) ExecuteDepositRequest(ctx sdk.Context, req types.DepositRequest)
 	if err := k.FinishDepositRequest(ctx, req, types.RequestStatusSucceeded); err != nil {
 		return err
 	}
+	k.afterDeposit(ctx, req)
+	if req.Farm {
+		k.farmMintedPoolCoin(ctx, req)
+	}
 	return nil
 }
 
+// executeDepositRequestInstantly executes the deposit request in the msg
+// handler, instead of leaving it for the next batch, and returns the executed
+// request.
+func (k Keeper) executeDepositRequestInstantly(ctx sdk.Context, req types.DepositRequest) (types.DepositRequest, error) {
+	if err := k.ExecuteDepositRequest(ctx, req); err != nil {
+		return types.DepositRequest{}, err
+	}
+	req, _ = k.GetDepositRequest(ctx, req.PoolId, req.Id)
+	return req, nil
+}
+
+// farmMintedPoolCoin farms the pool coin minted by the deposit request in the
+// lpfarm module.
+// The deposit request is not affected by farming failures, and the pool coin
+// is left to the depositor in that case.
+func (k Keeper) farmMintedPoolCoin(ctx sdk.Context, req types.DepositRequest) {
+	if k.lpFarmKeeper == nil || !req.MintedPoolCoin.IsPositive() {
+		return
+	}
+	cacheCtx, writeCache := ctx.CacheContext()
+	if _, err := k.lpFarmKeeper.Farm(cacheCtx, req.GetDepositor(), req.MintedPoolCoin); err != nil {
+		k.Logger(ctx).Error("failed to farm minted pool coin", "request_id", req.Id, "error", err)
+		return
+	}
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+}
+
 // FinishDepositRequest refunds unhandled deposit coins and set request status.
 func (k Keeper) FinishDepositRequest(ctx sdk.Context, req types.DepositRequest, status types.RequestStatus) error {
+	return k.finishDepositRequest(ctx, req, status, "")
+}
+
+// finishDepositRequest is the same as FinishDepositRequest, except that
+// the failure reason is recorded in the request's result.
+func (k Keeper) finishDepositRequest(ctx sdk.Context, req types.DepositRequest, status types.RequestStatus, reason types.FailureReason) error {
 	if req.Status != types.RequestStatusNotExecuted { // sanity check
 		return nil
 	}
@@ -459,6 +696,7 @@ func (k Keeper) FinishDepositRequest(ctx sdk.Context, req types.DepositRequest,
 	}
 	req.SetStatus(status)
 	k.SetDepositRequest(ctx, req)
+	k.SetRequestResult(ctx, types.NewDepositRequestResult(req, reason, ctx.BlockHeight(), ctx.BlockTime()))
 
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
@@ -477,11 +715,46 @@ func (k Keeper) FinishDepositRequest(ctx sdk.Context, req types.DepositRequest,
 	return nil
 }
 
+// FailDepositRequest refunds the deposit coins of the deposit request and
+// marks it as failed, emitting a failure event with the reason.
+func (k Keeper) FailDepositRequest(ctx sdk.Context, req types.DepositRequest, reason types.FailureReason) error {
+	if req.Status != types.RequestStatusNotExecuted { // sanity check
+		return nil
+	}
+
+	refundedCoins := req.DepositCoins.Sub(req.AcceptedCoins)
+	if err := k.finishDepositRequest(ctx, req, types.RequestStatusFailed, reason); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeDepositFailed,
+			sdk.NewAttribute(types.AttributeKeyRequestId, strconv.FormatUint(req.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyDepositor, req.Depositor),
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(req.PoolId, 10)),
+			sdk.NewAttribute(types.AttributeKeyReason, reason.String()),
+			sdk.NewAttribute(types.AttributeKeyRefundedCoins, refundedCoins.String()),
+		),
+	})
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventDepositFailed{
+		RequestId:     req.Id,
+		Depositor:     req.Depositor,
+		PoolId:        req.PoolId,
+		Reason:        reason.String(),
+		RefundedCoins: refundedCoins,
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // ExecuteWithdrawRequest executes a withdraw request.
 func (k Keeper) ExecuteWithdrawRequest(ctx sdk.Context, req types.WithdrawRequest) error {
 	pool, _ := k.GetPool(ctx, req.PoolId)
 	if pool.Disabled {
-		if err := k.FinishWithdrawRequest(ctx, req, types.RequestStatusFailed); err != nil {
+		if err := k.FailWithdrawRequest(ctx, req, types.FailureReasonPoolDisabled); err != nil {
 			return err
 		}
 		return nil
@@ -493,7 +766,7 @@ func (k Keeper) ExecuteWithdrawRequest(ctx sdk.Context, req types.WithdrawReques
 	ammPool := pool.AMMPool(rx.Amount, ry.Amount, ps)
 	if ammPool.IsDepleted() {
 		k.MarkPoolAsDisabled(ctx, pool)
-		if err := k.FinishWithdrawRequest(ctx, req, types.RequestStatusFailed); err != nil {
+		if err := k.FailWithdrawRequest(ctx, req, types.FailureReasonPoolDepleted); err != nil {
 			return err
 		}
 		return nil
@@ -501,13 +774,20 @@ func (k Keeper) ExecuteWithdrawRequest(ctx sdk.Context, req types.WithdrawReques
 
 	x, y := amm.Withdraw(rx.Amount, ry.Amount, ps, req.PoolCoin.Amount, k.GetWithdrawFeeRate(ctx))
 	if x.IsZero() && y.IsZero() {
-		if err := k.FinishWithdrawRequest(ctx, req, types.RequestStatusFailed); err != nil {
+		if err := k.FailWithdrawRequest(ctx, req, types.FailureReasonTooSmallWithdrawal); err != nil {
 			return err
 		}
 		return nil
 	}
 
 	withdrawnCoins := sdk.NewCoins(sdk.NewCoin(pair.QuoteCoinDenom, x), sdk.NewCoin(pair.BaseCoinDenom, y))
+	// The withdraw fee is the part of the withdrawer's share of the reserve
+	// which is left in the pool, and thus goes to the remaining pool coin
+	// holders.
+	xWithoutFee, yWithoutFee := amm.Withdraw(rx.Amount, ry.Amount, ps, req.PoolCoin.Amount, sdk.ZeroDec())
+	withdrawFeeCoins := sdk.NewCoins(
+		sdk.NewCoin(pair.QuoteCoinDenom, xWithoutFee.Sub(x)),
+		sdk.NewCoin(pair.BaseCoinDenom, yWithoutFee.Sub(y)))
 	burningCoins := sdk.NewCoins(req.PoolCoin)
 
 	bulkOp := types.NewBulkSendCoinsOperation()
@@ -530,11 +810,40 @@ func (k Keeper) ExecuteWithdrawRequest(ctx sdk.Context, req types.WithdrawReques
 	if err := k.FinishWithdrawRequest(ctx, req, types.RequestStatusSucceeded); err != nil {
 		return err
 	}
+	if !withdrawFeeCoins.IsZero() {
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeWithdrawFee,
+				sdk.NewAttribute(types.AttributeKeyRequestId, strconv.FormatUint(req.Id, 10)),
+				sdk.NewAttribute(types.AttributeKeyWithdrawer, req.Withdrawer),
+				sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(req.PoolId, 10)),
+				sdk.NewAttribute(types.AttributeKeyWithdrawFeeCoins, withdrawFeeCoins.String()),
+			),
+		})
+	}
+	k.afterWithdraw(ctx, req)
 	return nil
 }
 
+// executeWithdrawRequestInstantly executes the withdraw request in the msg
+// handler, instead of leaving it for the next batch, and returns the executed
+// request.
+func (k Keeper) executeWithdrawRequestInstantly(ctx sdk.Context, req types.WithdrawRequest) (types.WithdrawRequest, error) {
+	if err := k.ExecuteWithdrawRequest(ctx, req); err != nil {
+		return types.WithdrawRequest{}, err
+	}
+	req, _ = k.GetWithdrawRequest(ctx, req.PoolId, req.Id)
+	return req, nil
+}
+
 // FinishWithdrawRequest refunds unhandled pool coin and set request status.
 func (k Keeper) FinishWithdrawRequest(ctx sdk.Context, req types.WithdrawRequest, status types.RequestStatus) error {
+	return k.finishWithdrawRequest(ctx, req, status, "")
+}
+
+// finishWithdrawRequest is the same as FinishWithdrawRequest, except that
+// the failure reason is recorded in the request's result.
+func (k Keeper) finishWithdrawRequest(ctx sdk.Context, req types.WithdrawRequest, status types.RequestStatus, reason types.FailureReason) error {
 	if req.Status != types.RequestStatusNotExecuted { // sanity check
 		return nil
 	}
@@ -548,6 +857,7 @@ func (k Keeper) FinishWithdrawRequest(ctx sdk.Context, req types.WithdrawRequest
 	}
 	req.SetStatus(status)
 	k.SetWithdrawRequest(ctx, req)
+	k.SetRequestResult(ctx, types.NewWithdrawRequestResult(req, reason, ctx.BlockHeight(), ctx.BlockTime()))
 
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
@@ -564,3 +874,216 @@ func (k Keeper) FinishWithdrawRequest(ctx sdk.Context, req types.WithdrawRequest
 
 	return nil
 }
+
+// FailWithdrawRequest refunds the pool coin of the withdraw request and
+// marks it as failed, emitting a failure event with the reason.
+func (k Keeper) FailWithdrawRequest(ctx sdk.Context, req types.WithdrawRequest, reason types.FailureReason) error {
+	if req.Status != types.RequestStatusNotExecuted { // sanity check
+		return nil
+	}
+
+	if err := k.finishWithdrawRequest(ctx, req, types.RequestStatusFailed, reason); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeWithdrawalFailed,
+			sdk.NewAttribute(types.AttributeKeyRequestId, strconv.FormatUint(req.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyWithdrawer, req.Withdrawer),
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(req.PoolId, 10)),
+			sdk.NewAttribute(types.AttributeKeyReason, reason.String()),
+			sdk.NewAttribute(types.AttributeKeyRefundedCoins, sdk.NewCoins(req.PoolCoin).String()),
+		),
+	})
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventWithdrawalFailed{
+		RequestId:     req.Id,
+		Withdrawer:    req.Withdrawer,
+		PoolId:        req.PoolId,
+		Reason:        reason.String(),
+		RefundedCoins: sdk.NewCoins(req.PoolCoin),
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MigratePool migrates a basic pool to a new ranged pool of the same pair
+// with the given price range.
+// The reserves of the basic pool are moved to the new pool at the basic
+// pool's price, and pool coin holders of the basic pool receive the same
+// amount of the new pool coins along with their share of the reserve which
+// couldn't be accepted by the new pool.
+// The basic pool is disabled after the migration.
+func (k Keeper) MigratePool(ctx sdk.Context, poolId uint64, minPrice, maxPrice sdk.Dec) (types.Pool, error) {
+	pool, found := k.GetPool(ctx, poolId)
+	if !found {
+		return types.Pool{}, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "pool %d not found", poolId)
+	}
+	if pool.Disabled {
+		return types.Pool{}, types.ErrDisabledPool
+	}
+	if pool.Type != types.PoolTypeBasic {
+		return types.Pool{}, sdkerrors.Wrapf(types.ErrWrongPoolType, "pool %d is not a basic pool", pool.Id)
+	}
+
+	tickPrec := int(k.GetTickPrecision(ctx))
+	if !amm.PriceToDownTick(minPrice, tickPrec).Equal(minPrice) {
+		return types.Pool{}, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "min price is not on ticks")
+	}
+	if !amm.PriceToDownTick(maxPrice, tickPrec).Equal(maxPrice) {
+		return types.Pool{}, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "max price is not on ticks")
+	}
+
+	var pendingReqExists bool
+	_ = k.IterateAllDepositRequests(ctx, func(req types.DepositRequest) (stop bool, err error) {
+		if req.PoolId == pool.Id && req.Status == types.RequestStatusNotExecuted {
+			pendingReqExists = true
+			return true, nil
+		}
+		return false, nil
+	})
+	_ = k.IterateAllWithdrawRequests(ctx, func(req types.WithdrawRequest) (stop bool, err error) {
+		if req.PoolId == pool.Id && req.Status == types.RequestStatusNotExecuted {
+			pendingReqExists = true
+			return true, nil
+		}
+		return false, nil
+	})
+	if pendingReqExists {
+		return types.Pool{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "pool %d has pending requests", pool.Id)
+	}
+
+	// Pool coins held by module accounts are tracked by the modules themselves,
+	// e.g. farming positions, and thus cannot be replaced with new pool coins.
+	var holders []banktypes.Balance
+	var holderErr error
+	k.bankKeeper.IterateAllBalances(ctx, func(addr sdk.AccAddress, coin sdk.Coin) (stop bool) {
+		if coin.Denom != pool.PoolCoinDenom || !coin.IsPositive() {
+			return false
+		}
+		if _, ok := k.accountKeeper.GetAccount(ctx, addr).(authtypes.ModuleAccountI); ok {
+			holderErr = sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "pool coin is held by module account %s", addr)
+			return true
+		}
+		holders = append(holders, banktypes.Balance{Address: addr.String(), Coins: sdk.NewCoins(coin)})
+		return false
+	})
+	if holderErr != nil {
+		return types.Pool{}, holderErr
+	}
+
+	pair, _ := k.GetPair(ctx, pool.PairId)
+
+	// Sweep unswept fees of the pool first so that they are migrated as well.
+	spendable := k.bankKeeper.SpendableCoins(ctx, types.PoolFeeAddress(pool.Id))
+	fees := sdk.NewCoins(
+		sdk.NewCoin(pair.BaseCoinDenom, spendable.AmountOf(pair.BaseCoinDenom)),
+		sdk.NewCoin(pair.QuoteCoinDenom, spendable.AmountOf(pair.QuoteCoinDenom)))
+	if !fees.IsZero() {
+		if err := k.bankKeeper.SendCoins(ctx, types.PoolFeeAddress(pool.Id), pool.GetReserveAddress(), fees); err != nil {
+			return types.Pool{}, err
+		}
+	}
+
+	rx, ry := k.getPoolBalances(ctx, pool, pair)
+	ps := k.GetPoolCoinSupply(ctx, pool)
+	basicPool := pool.AMMPool(rx.Amount, ry.Amount, ps)
+	if basicPool.IsDepleted() {
+		return types.Pool{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "pool %d is depleted", pool.Id)
+	}
+	ammPool, err := amm.CreateRangedPool(rx.Amount, ry.Amount, minPrice, maxPrice, basicPool.Price())
+	if err != nil {
+		return types.Pool{}, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	ax, ay := ammPool.Balances()
+
+	newPool := types.NewRangedPool(k.getNextPoolIdWithUpdate(ctx), pair.Id, pool.GetCreator(), minPrice, maxPrice)
+	newPool.MaxReserveAmount = pool.MaxReserveAmount
+	k.SetPool(ctx, newPool)
+	k.SetPoolByReserveIndex(ctx, newPool)
+	k.SetPoolsByPairIndex(ctx, newPool)
+
+	// The new pool coins are minted as much as the basic pool's pool coin
+	// supply, so that every holder keeps the same share of the pool.
+	newPoolCoins := sdk.NewCoins(sdk.NewCoin(newPool.PoolCoinDenom, ps))
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, newPoolCoins); err != nil {
+		return types.Pool{}, err
+	}
+
+	moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
+	lx, ly := rx.Amount.Sub(ax), ry.Amount.Sub(ay)
+	remaining := sdk.NewCoins(sdk.NewCoin(rx.Denom, lx), sdk.NewCoin(ry.Denom, ly))
+	bulkOp := types.NewBulkSendCoinsOperation()
+	bulkOp.QueueSendCoins(
+		pool.GetReserveAddress(), newPool.GetReserveAddress(),
+		sdk.NewCoins(sdk.NewCoin(rx.Denom, ax), sdk.NewCoin(ry.Denom, ay)))
+	for _, holder := range holders {
+		addr, _ := sdk.AccAddressFromBech32(holder.Address)
+		amt := holder.Coins.AmountOf(pool.PoolCoinDenom)
+		bulkOp.QueueSendCoins(addr, moduleAddr, holder.Coins)
+		bulkOp.QueueSendCoins(moduleAddr, addr, sdk.NewCoins(sdk.NewCoin(newPool.PoolCoinDenom, amt)))
+		share := sdk.NewCoins(
+			sdk.NewCoin(rx.Denom, lx.Mul(amt).Quo(ps)),
+			sdk.NewCoin(ry.Denom, ly.Mul(amt).Quo(ps)))
+		bulkOp.QueueSendCoins(pool.GetReserveAddress(), addr, share)
+		remaining = remaining.Sub(share)
+	}
+	// Truncated dust goes to the new pool.
+	bulkOp.QueueSendCoins(pool.GetReserveAddress(), newPool.GetReserveAddress(), remaining)
+	if err := bulkOp.Run(ctx, k.bankKeeper); err != nil {
+		return types.Pool{}, err
+	}
+
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin(pool.PoolCoinDenom, ps))); err != nil {
+		return types.Pool{}, err
+	}
+	k.MarkPoolAsDisabled(ctx, pool)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeMigratePool,
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(pool.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyNewPoolId, strconv.FormatUint(newPool.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyReserveAddress, newPool.ReserveAddress),
+			sdk.NewAttribute(types.AttributeKeyNumHolders, strconv.Itoa(len(holders))),
+		),
+	})
+
+	return newPool, nil
+}
+
+// SetPoolMaxReserveAmount sets the caps of the pool's reserve, which limit the
+// deposits to the pool.
+// The pool's caps are removed if maxReserveAmt is empty.
+// The reserve already exceeding the caps is not affected, but no more coins
+// of the denom can be deposited to the pool until the reserve goes below the
+// cap.
+func (k Keeper) SetPoolMaxReserveAmount(ctx sdk.Context, poolId uint64, maxReserveAmt sdk.Coins) (types.Pool, error) {
+	pool, found := k.GetPool(ctx, poolId)
+	if !found {
+		return types.Pool{}, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "pool %d not found", poolId)
+	}
+
+	pair, _ := k.GetPair(ctx, pool.PairId)
+	for _, coin := range maxReserveAmt {
+		if coin.Denom != pair.BaseCoinDenom && coin.Denom != pair.QuoteCoinDenom {
+			return types.Pool{}, sdkerrors.Wrapf(
+				sdkerrors.ErrInvalidRequest, "denom %s is not in pair %d", coin.Denom, pair.Id)
+		}
+	}
+
+	pool.MaxReserveAmount = maxReserveAmt
+	k.SetPool(ctx, pool)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetPoolMaxReserveAmount,
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+			sdk.NewAttribute(types.AttributeKeyMaxReserveAmount, maxReserveAmt.String()),
+		),
+	})
+
+	return pool, nil
+}
@@ -1,11 +1,17 @@
 package keeper_test
 
 import (
+	"strconv"
+
+	"github.com/gogo/protobuf/proto"
+	abci "github.com/tendermint/tendermint/abci/types"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	utils "github.com/crescent-network/crescent/v4/types"
 	"github.com/crescent-network/crescent/v4/x/liquidity"
+	"github.com/crescent-network/crescent/v4/x/liquidity/amm"
 	"github.com/crescent-network/crescent/v4/x/liquidity/types"
 
 	_ "github.com/stretchr/testify/suite"
@@ -255,6 +261,60 @@ func (s *KeeperTestSuite) TestCreatePoolInitialPoolCoinSupply() {
 	s.Require().True(intEq(sdk.NewInt(100000000000000), s.getBalance(poolCreator, pool.PoolCoinDenom).Amount))
 }
 
+func (s *KeeperTestSuite) TestCreatePoolInitialPriceCheck() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pair.LastPrice = utils.ParseDecP("1.0")
+	s.keeper.SetPair(s.ctx, pair)
+
+	poolCreator := s.addr(1)
+	s.fundAddr(poolCreator, utils.ParseCoins("10000000denom1,10000000denom2,10000000stake"))
+
+	// The basic pool's price is 2.0, which is out of the price limits
+	// [0.9, 1.1] around the last price.
+	_, err := s.keeper.CreatePool(s.ctx, types.NewMsgCreatePool(
+		poolCreator, pair.Id, utils.ParseCoins("1000000denom1,2000000denom2")))
+	s.Require().ErrorIs(err, types.ErrPriceOutOfRange)
+
+	_, err = s.keeper.CreateRangedPool(s.ctx, types.NewMsgCreateRangedPool(
+		poolCreator, pair.Id, utils.ParseCoins("1000000denom1,1000000denom2"),
+		utils.ParseDec("0.5"), utils.ParseDec("1.5"), utils.ParseDec("0.8")))
+	s.Require().ErrorIs(err, types.ErrPriceOutOfRange)
+
+	_, err = s.keeper.CreatePool(s.ctx, types.NewMsgCreatePool(
+		poolCreator, pair.Id, utils.ParseCoins("1000000denom1,1050000denom2")))
+	s.Require().NoError(err)
+	_, err = s.keeper.CreateRangedPool(s.ctx, types.NewMsgCreateRangedPool(
+		poolCreator, pair.Id, utils.ParseCoins("1000000denom1,1000000denom2"),
+		utils.ParseDec("0.5"), utils.ParseDec("1.5"), utils.ParseDec("0.95")))
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) TestCreatePoolInitialPriceCheckBypass() {
+	params := s.keeper.GetParams(s.ctx)
+	params.NumBootstrapBatches = 10
+	s.keeper.SetParams(s.ctx, params)
+
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pair.LastPrice = utils.ParseDecP("1.0")
+	s.keeper.SetPair(s.ctx, pair)
+	s.Require().True(pair.IsBootstrapping())
+
+	poolCreator := s.addr(1)
+	s.fundAddr(poolCreator, utils.ParseCoins("10000000denom1,10000000denom2,10000000stake"))
+	msg := types.NewMsgCreatePool(poolCreator, pair.Id, utils.ParseCoins("1000000denom1,2000000denom2"))
+
+	params.BypassPoolPriceCheckForNewPairs = false
+	s.keeper.SetParams(s.ctx, params)
+	_, err := s.keeper.CreatePool(s.ctx, msg)
+	s.Require().ErrorIs(err, types.ErrPriceOutOfRange)
+
+	// Pools on pairs in their bootstrap phase are not checked.
+	params.BypassPoolPriceCheckForNewPairs = true
+	s.keeper.SetParams(s.ctx, params)
+	_, err = s.keeper.CreatePool(s.ctx, msg)
+	s.Require().NoError(err)
+}
+
 func (s *KeeperTestSuite) TestPoolIndexes() {
 	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
 
@@ -288,6 +348,27 @@ func (s *KeeperTestSuite) TestDeposit() {
 	s.Require().True(coinsEq(sdk.NewCoins(expectedPoolCoin), s.getBalances(s.addr(2))))
 }
 
+func (s *KeeperTestSuite) TestDepositAndFarm() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pool := s.createPool(s.addr(0), pair.Id, utils.ParseCoins("1000000denom1,1000000denom2"), true)
+
+	depositCoins := utils.ParseCoins("1000000denom1,1000000denom2")
+	s.fundAddr(s.addr(1), depositCoins)
+	msg := types.NewMsgDeposit(s.addr(1), pool.Id, depositCoins)
+	msg.Farm = true
+	req, err := s.keeper.Deposit(s.ctx, msg)
+	s.Require().NoError(err)
+	s.Require().True(req.Farm)
+	s.nextBlock()
+
+	// The minted pool coin is farmed instead of being sent to the depositor.
+	expectedPoolCoin := s.getBalance(s.addr(0), pool.PoolCoinDenom)
+	s.Require().True(s.getBalances(s.addr(1)).IsZero())
+	position, found := s.app.LPFarmKeeper.GetPosition(s.ctx, s.addr(1), pool.PoolCoinDenom)
+	s.Require().True(found)
+	s.Require().True(intEq(expectedPoolCoin.Amount, position.FarmingAmount))
+}
+
 func (s *KeeperTestSuite) TestDepositRefund() {
 	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
 
@@ -346,10 +427,103 @@ func (s *KeeperTestSuite) TestDepositRefundTooSmallMintedPoolCoin() {
 	liquidity.EndBlocker(s.ctx, s.keeper)
 	req, _ = s.keeper.GetDepositRequest(s.ctx, req.PoolId, req.Id)
 	s.Require().Equal(types.RequestStatusFailed, req.Status)
+	attrs, found := eventAttrs(s.ctx.EventManager().Events(), types.EventTypeDepositFailed)
+	s.Require().True(found)
+	s.Require().Equal(types.FailureReasonTooSmallDeposit.String(), attrs[types.AttributeKeyReason])
+	s.Require().Equal(depositCoins.String(), attrs[types.AttributeKeyRefundedCoins])
 
 	s.Require().True(coinsEq(depositCoins, s.getBalances(depositor)))
 }
 
+func (s *KeeperTestSuite) TestDepositSingleAsset() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pool := s.createPool(s.addr(0), pair.Id, utils.ParseCoins("1000000denom1,1000000denom2"), true)
+
+	depositor := s.addr(1)
+	req := s.depositSingleAsset(depositor, pool.Id, utils.ParseCoin("1000000denom1"), true)
+	liquidity.EndBlocker(s.ctx, s.keeper)
+	req, _ = s.keeper.GetDepositRequest(s.ctx, req.PoolId, req.Id)
+	s.Require().Equal(types.RequestStatusSucceeded, req.Status)
+	s.Require().True(req.SingleAsset)
+
+	// The whole deposit coin is accepted and the minted pool coin is
+	// ps * (sqrt(2) - 1).
+	s.Require().True(coinsEq(utils.ParseCoins("1000000denom1"), req.AcceptedCoins))
+	s.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(pool.PoolCoinDenom, 414213562373)), s.getBalances(depositor)))
+	s.Require().True(coinsEq(utils.ParseCoins("2000000denom1,1000000denom2"), s.getBalances(pool.GetReserveAddress())))
+	liquidity.BeginBlocker(s.ctx, s.keeper)
+
+	// Depositing the other coin of the pair is also possible.
+	depositor = s.addr(2)
+	s.depositSingleAsset(depositor, pool.Id, utils.ParseCoin("1000000denom2"), true)
+	s.nextBlock()
+	s.Require().True(s.getBalance(depositor, pool.PoolCoinDenom).IsPositive())
+	s.Require().True(s.getBalance(depositor, "denom2").IsZero())
+}
+
+func (s *KeeperTestSuite) TestDepositSingleAssetFee() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pool := s.createPool(s.addr(0), pair.Id, utils.ParseCoins("1000000denom1,1000000denom2"), true)
+
+	s.depositSingleAsset(s.addr(1), pool.Id, utils.ParseCoin("1000000denom1"), true)
+	s.nextBlock()
+
+	params := s.keeper.GetParams(s.ctx)
+	params.SwapFeeRate = utils.ParseDec("0.003")
+	s.keeper.SetParams(s.ctx, params)
+
+	s.depositSingleAsset(s.addr(2), pool.Id, utils.ParseCoin("1000000denom1"), true)
+	s.nextBlock()
+
+	// The second depositor receives fewer pool coins than the first one
+	// not only because of the price impact but also because of the fee.
+	poolCoinSupply := s.keeper.GetPoolCoinSupply(s.ctx, pool)
+	expected := amm.DepositSingleAsset(
+		sdk.NewInt(2000000), poolCoinSupply.Sub(s.getBalance(s.addr(2), pool.PoolCoinDenom).Amount),
+		sdk.NewInt(1000000), sdk.ZeroDec())
+	s.Require().True(s.getBalance(s.addr(2), pool.PoolCoinDenom).Amount.LT(expected))
+}
+
+func (s *KeeperTestSuite) TestDepositSingleAssetValidation() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pool := s.createPool(s.addr(0), pair.Id, utils.ParseCoins("1000000denom1,1000000denom2"), true)
+	rangedPool := s.createRangedPool(
+		s.addr(0), pair.Id, utils.ParseCoins("1000000denom1,1000000denom2"),
+		utils.ParseDec("0.9"), utils.ParseDec("1.1"), utils.ParseDec("1.0"), true)
+
+	for _, tc := range []struct {
+		name        string
+		msg         *types.MsgDepositSingleAsset
+		expectedErr error
+	}{
+		{
+			"pool not found",
+			types.NewMsgDepositSingleAsset(s.addr(1), 10, utils.ParseCoin("1000000denom1")),
+			sdkerrors.ErrNotFound,
+		},
+		{
+			"ranged pool",
+			types.NewMsgDepositSingleAsset(s.addr(1), rangedPool.Id, utils.ParseCoin("1000000denom1")),
+			types.ErrWrongPoolType,
+		},
+		{
+			"wrong denom",
+			types.NewMsgDepositSingleAsset(s.addr(1), pool.Id, utils.ParseCoin("1000000denom3")),
+			types.ErrInvalidCoinDenom,
+		},
+		{
+			"too large pool",
+			types.NewMsgDepositSingleAsset(s.addr(1), pool.Id, utils.ParseCoin("10000000000000000000000000000000000000000denom1")),
+			types.ErrTooLargePool,
+		},
+	} {
+		s.Run(tc.name, func() {
+			_, err := s.keeper.DepositSingleAsset(s.ctx, tc.msg)
+			s.Require().ErrorIs(err, tc.expectedErr)
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestTooLargePool() {
 	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
 	pool := s.createPool(s.addr(0), pair.Id, utils.ParseCoins("1000000denom1,1000000denom2"), true)
@@ -408,6 +582,124 @@ func (s *KeeperTestSuite) TestWithdrawRefundTooSmallWithdrawCoins() {
 	s.Require().True(coinsEq(sdk.NewCoins(poolCoin), s.getBalances(depositor)))
 }
 
+func (s *KeeperTestSuite) TestTypedRequestFailedEvents() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pool := s.createPool(s.addr(0), pair.Id, utils.ParseCoins("1000000denom1,1000000denom2"), true)
+
+	depositor := s.addr(1)
+	s.deposit(depositor, pool.Id, utils.ParseCoins("1000000denom1,1000000denom2"), true)
+	s.nextBlock()
+	poolCoin := s.getBalance(depositor, pool.PoolCoinDenom)
+
+	// Make the pool depleted.
+	s.sendCoins(pool.GetReserveAddress(), s.addr(2), s.getBalances(pool.GetReserveAddress()))
+
+	depositCoins := utils.ParseCoins("1000000denom1,1000000denom2")
+	depositReq := s.deposit(s.addr(3), pool.Id, depositCoins, true)
+	withdrawReq := s.withdraw(depositor, pool.Id, poolCoin)
+	liquidity.EndBlocker(s.ctx, s.keeper)
+
+	var (
+		depositFailed    *types.EventDepositFailed
+		withdrawalFailed *types.EventWithdrawalFailed
+	)
+	for _, ev := range s.ctx.EventManager().Events() {
+		switch ev.Type {
+		case proto.MessageName(&types.EventDepositFailed{}), proto.MessageName(&types.EventWithdrawalFailed{}):
+			msg, err := sdk.ParseTypedEvent(abci.Event(ev))
+			s.Require().NoError(err)
+			switch msg := msg.(type) {
+			case *types.EventDepositFailed:
+				depositFailed = msg
+			case *types.EventWithdrawalFailed:
+				withdrawalFailed = msg
+			}
+		}
+	}
+	s.Require().NotNil(depositFailed)
+	s.Require().Equal(depositReq.Id, depositFailed.RequestId)
+	s.Require().Equal(types.FailureReasonPoolDisabled.String(), depositFailed.Reason)
+	s.Require().True(coinsEq(depositCoins, depositFailed.RefundedCoins))
+	s.Require().NotNil(withdrawalFailed)
+	s.Require().Equal(withdrawReq.Id, withdrawalFailed.RequestId)
+	s.Require().Equal(types.FailureReasonPoolDisabled.String(), withdrawalFailed.Reason)
+	s.Require().True(coinsEq(sdk.NewCoins(poolCoin), withdrawalFailed.RefundedCoins))
+
+	// The escrowed coins are refunded in the same block.
+	s.Require().True(coinsEq(depositCoins, s.getBalances(s.addr(3))))
+	s.Require().True(coinsEq(sdk.NewCoins(poolCoin), s.getBalances(depositor)))
+	s.Require().True(s.getBalances(types.GlobalEscrowAddress).IsZero())
+}
+
+func (s *KeeperTestSuite) TestWithdrawFee() {
+	params := s.keeper.GetParams(s.ctx)
+	params.WithdrawFeeRate = utils.ParseDec("0.01")
+	s.keeper.SetParams(s.ctx, params)
+
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pool := s.createPool(s.addr(0), pair.Id, utils.ParseCoins("1000000denom1,1000000denom2"), true)
+
+	depositor := s.addr(1)
+	s.deposit(depositor, pool.Id, utils.ParseCoins("1000000denom1,1000000denom2"), true)
+	s.nextBlock()
+
+	poolCoin := s.getBalance(depositor, pool.PoolCoinDenom)
+	req := s.withdraw(depositor, pool.Id, poolCoin)
+	liquidity.EndBlocker(s.ctx, s.keeper)
+
+	// 1% of the withdrawer's share is left in the pool.
+	s.Require().True(coinsEq(utils.ParseCoins("990000denom1,990000denom2"), s.getBalances(depositor)))
+	s.Require().True(coinsEq(utils.ParseCoins("1010000denom1,1010000denom2"), s.getBalances(pool.GetReserveAddress())))
+
+	attrs, found := eventAttrs(s.ctx.EventManager().Events(), types.EventTypeWithdrawFee)
+	s.Require().True(found)
+	s.Require().Equal(strconv.FormatUint(req.Id, 10), attrs[types.AttributeKeyRequestId])
+	s.Require().Equal(depositor.String(), attrs[types.AttributeKeyWithdrawer])
+	s.Require().Equal("10000denom1,10000denom2", attrs[types.AttributeKeyWithdrawFeeCoins])
+}
+
+func (s *KeeperTestSuite) TestInstantDepositWithdraw() {
+	params := s.keeper.GetParams(s.ctx)
+	params.InstantDepositWithdraw = true
+	s.keeper.SetParams(s.ctx, params)
+
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pool := s.createPool(s.addr(0), pair.Id, utils.ParseCoins("1000000denom1,1000000denom2"), true)
+
+	// The deposit request is executed without waiting for the batch.
+	depositor := s.addr(1)
+	depositCoins := utils.ParseCoins("1000000denom1,1000000denom2")
+	req := s.deposit(depositor, pool.Id, depositCoins, true)
+	s.Require().Equal(types.RequestStatusSucceeded, req.Status)
+	expectedPoolCoin := s.getBalance(s.addr(0), pool.PoolCoinDenom)
+	s.Require().True(coinsEq(sdk.NewCoins(expectedPoolCoin), s.getBalances(depositor)))
+	s.Require().True(s.getBalances(types.GlobalEscrowAddress).IsZero())
+
+	req = s.depositSingleAsset(s.addr(2), pool.Id, utils.ParseCoin("1000000denom1"), true)
+	s.Require().Equal(types.RequestStatusSucceeded, req.Status)
+	s.Require().True(req.MintedPoolCoin.IsPositive())
+
+	// So is the withdraw request.
+	withdrawReq := s.withdraw(depositor, pool.Id, expectedPoolCoin)
+	s.Require().Equal(types.RequestStatusSucceeded, withdrawReq.Status)
+	s.Require().False(s.getBalances(depositor).AmountOf(pool.PoolCoinDenom).IsPositive())
+	s.Require().True(coinsEq(withdrawReq.WithdrawnCoins, s.getBalances(depositor)))
+	s.Require().True(s.getBalances(types.GlobalEscrowAddress).IsZero())
+
+	// Failed requests are refunded right away.
+	s.sendCoins(pool.GetReserveAddress(), s.addr(3), s.getBalances(pool.GetReserveAddress()))
+	req = s.deposit(s.addr(4), pool.Id, depositCoins, true)
+	s.Require().Equal(types.RequestStatusFailed, req.Status)
+	s.Require().True(coinsEq(depositCoins, s.getBalances(s.addr(4))))
+
+	// The executed requests are deleted in the next block.
+	s.nextBlock()
+	_, found := s.keeper.GetDepositRequest(s.ctx, req.PoolId, req.Id)
+	s.Require().False(found)
+	_, found = s.keeper.GetWithdrawRequest(s.ctx, withdrawReq.PoolId, withdrawReq.Id)
+	s.Require().False(found)
+}
+
 func (s *KeeperTestSuite) TestDepositToDisabledPool() {
 	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
 
@@ -426,6 +718,12 @@ func (s *KeeperTestSuite) TestDepositToDisabledPool() {
 	req, _ = s.keeper.GetDepositRequest(s.ctx, pool.Id, req.Id)
 	s.Require().Equal(types.RequestStatusFailed, req.Status)
 
+	attrs, found := eventAttrs(s.ctx.EventManager().Events(), types.EventTypeDepositFailed)
+	s.Require().True(found)
+	s.Require().Equal(strconv.FormatUint(req.Id, 10), attrs[types.AttributeKeyRequestId])
+	s.Require().Equal(types.FailureReasonPoolDepleted.String(), attrs[types.AttributeKeyReason])
+	s.Require().Equal(depositCoins.String(), attrs[types.AttributeKeyRefundedCoins])
+
 	// Delete the previous request and refund coins to the depositor.
 	liquidity.BeginBlocker(s.ctx, s.keeper)
 
@@ -450,6 +748,12 @@ func (s *KeeperTestSuite) TestWithdrawFromDisabledPool() {
 	req, _ = s.keeper.GetWithdrawRequest(s.ctx, pool.Id, req.Id)
 	s.Require().Equal(types.RequestStatusFailed, req.Status)
 
+	attrs, found := eventAttrs(s.ctx.EventManager().Events(), types.EventTypeWithdrawalFailed)
+	s.Require().True(found)
+	s.Require().Equal(strconv.FormatUint(req.Id, 10), attrs[types.AttributeKeyRequestId])
+	s.Require().Equal(types.FailureReasonPoolDepleted.String(), attrs[types.AttributeKeyReason])
+	s.Require().Equal(req.PoolCoin.String(), attrs[types.AttributeKeyRefundedCoins])
+
 	// Delete the previous request and refund coins to the withdrawer.
 	liquidity.BeginBlocker(s.ctx, s.keeper)
 
@@ -645,3 +949,145 @@ func (s *KeeperTestSuite) TestMaxNumActivePoolsPerPair() {
 			utils.ParseDec("0.5"), utils.ParseDec("2.0"), utils.ParseDec("1.0"), true)
 	})
 }
+
+func (s *KeeperTestSuite) TestMigratePool() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pool := s.createPool(s.addr(1), pair.Id, utils.ParseCoins("1000000denom1,1000000denom2"), true)
+
+	ps := s.keeper.GetPoolCoinSupply(s.ctx, pool)
+	s.sendCoins(s.addr(1), s.addr(2), sdk.NewCoins(sdk.NewCoin(pool.PoolCoinDenom, ps.QuoRaw(4))))
+
+	newPool, err := s.keeper.MigratePool(s.ctx, pool.Id, utils.ParseDec("0.8"), utils.ParseDec("2.0"))
+	s.Require().NoError(err)
+	s.Require().Equal(types.PoolTypeRanged, newPool.Type)
+	s.Require().Equal(pair.Id, newPool.PairId)
+	s.Require().Equal(pool.Creator, newPool.Creator)
+
+	pool, _ = s.keeper.GetPool(s.ctx, pool.Id)
+	s.Require().True(pool.Disabled)
+	s.Require().True(s.keeper.GetPoolCoinSupply(s.ctx, pool).IsZero())
+	s.Require().True(s.getBalances(pool.GetReserveAddress()).IsZero())
+
+	// Pool coin holders keep the same share of the new pool.
+	s.Require().True(intEq(ps, s.keeper.GetPoolCoinSupply(s.ctx, newPool)))
+	s.Require().True(s.getBalance(s.addr(1), pool.PoolCoinDenom).IsZero())
+	s.Require().True(intEq(ps.Sub(ps.QuoRaw(4)), s.getBalance(s.addr(1), newPool.PoolCoinDenom).Amount))
+	s.Require().True(intEq(ps.QuoRaw(4), s.getBalance(s.addr(2), newPool.PoolCoinDenom).Amount))
+
+	// The new pool starts at the basic pool's price.
+	rx, ry := s.keeper.GetPoolBalances(s.ctx, newPool)
+	ammPool := newPool.AMMPool(rx.Amount, ry.Amount, ps)
+	s.Require().True(utils.DecApproxEqual(utils.ParseDec("1.0"), ammPool.Price()))
+
+	// The reserve which couldn't be accepted by the new pool is
+	// distributed to the holders.
+	total := s.getBalances(newPool.GetReserveAddress()).
+		Add(s.getBalances(s.addr(1)).Add(s.getBalances(s.addr(2))...)...)
+	s.Require().True(intEq(sdk.NewInt(1000000), total.AmountOf("denom1")))
+	s.Require().True(intEq(sdk.NewInt(1000000), total.AmountOf("denom2")))
+	s.Require().True(coinsEq(utils.ParseCoins("1000000denom1,360450denom2"), s.getBalances(newPool.GetReserveAddress())))
+	s.Require().True(intEq(sdk.NewInt(159887), s.getBalance(s.addr(2), "denom2").Amount))
+
+	// The new pool works as a normal ranged pool.
+	s.withdraw(s.addr(2), newPool.Id, s.getBalance(s.addr(2), newPool.PoolCoinDenom))
+	s.nextBlock()
+	s.Require().True(s.getBalance(s.addr(2), newPool.PoolCoinDenom).IsZero())
+	s.Require().True(s.getBalance(s.addr(2), "denom1").Amount.IsPositive())
+}
+
+func (s *KeeperTestSuite) TestMigratePoolValidation() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pool := s.createPool(s.addr(1), pair.Id, utils.ParseCoins("1000000denom1,1000000denom2"), true)
+	rangedPool := s.createRangedPool(
+		s.addr(1), pair.Id, utils.ParseCoins("1000000denom1,1000000denom2"),
+		utils.ParseDec("0.5"), utils.ParseDec("2.0"), utils.ParseDec("1.0"), true)
+
+	minPrice, maxPrice := utils.ParseDec("0.5"), utils.ParseDec("2.0")
+
+	_, err := s.keeper.MigratePool(s.ctx, 10, minPrice, maxPrice)
+	s.Require().ErrorIs(err, sdkerrors.ErrNotFound)
+
+	_, err = s.keeper.MigratePool(s.ctx, rangedPool.Id, minPrice, maxPrice)
+	s.Require().ErrorIs(err, types.ErrWrongPoolType)
+
+	_, err = s.keeper.MigratePool(s.ctx, pool.Id, utils.ParseDec("0.500001"), maxPrice)
+	s.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	// The basic pool's price is out of the range.
+	_, err = s.keeper.MigratePool(s.ctx, pool.Id, utils.ParseDec("1.5"), maxPrice)
+	s.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	// Pending withdraw requests.
+	s.withdraw(s.addr(1), pool.Id, sdk.NewCoin(pool.PoolCoinDenom, sdk.NewInt(1000)))
+	_, err = s.keeper.MigratePool(s.ctx, pool.Id, minPrice, maxPrice)
+	s.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+	s.nextBlock()
+
+	// Pool coins held by a module account.
+	poolCoin := sdk.NewCoin(pool.PoolCoinDenom, sdk.NewInt(1000))
+	s.Require().NoError(s.app.BankKeeper.SendCoinsFromAccountToModule(s.ctx, s.addr(1), types.ModuleName, sdk.NewCoins(poolCoin)))
+	_, err = s.keeper.MigratePool(s.ctx, pool.Id, minPrice, maxPrice)
+	s.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+	s.Require().NoError(s.app.BankKeeper.SendCoinsFromModuleToAccount(s.ctx, types.ModuleName, s.addr(1), sdk.NewCoins(poolCoin)))
+
+	_, err = s.keeper.MigratePool(s.ctx, pool.Id, minPrice, maxPrice)
+	s.Require().NoError(err)
+
+	_, err = s.keeper.MigratePool(s.ctx, pool.Id, minPrice, maxPrice)
+	s.Require().ErrorIs(err, types.ErrDisabledPool)
+}
+
+func (s *KeeperTestSuite) TestPoolMaxReserveAmount() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pool := s.createPool(s.addr(0), pair.Id, utils.ParseCoins("1000000denom1,1000000denom2"), true)
+
+	handler := liquidity.NewProposalHandler(s.keeper)
+	maxReserveAmt := utils.ParseCoins("1500000denom1,1500000denom2")
+	err := handler(s.ctx, types.NewPoolMaxReserveAmountProposal("title", "description", 10, maxReserveAmt))
+	s.Require().ErrorIs(err, sdkerrors.ErrNotFound)
+	err = handler(s.ctx, types.NewPoolMaxReserveAmountProposal(
+		"title", "description", pool.Id, utils.ParseCoins("1500000denom3")))
+	s.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+	err = handler(s.ctx, types.NewPoolMaxReserveAmountProposal("title", "description", pool.Id, maxReserveAmt))
+	s.Require().NoError(err)
+	pool, _ = s.keeper.GetPool(s.ctx, pool.Id)
+	s.Require().True(coinsEq(maxReserveAmt, pool.MaxReserveAmount))
+
+	// The deposit is partially accepted up to the caps.
+	depositor := s.addr(1)
+	depositCoins := utils.ParseCoins("1000000denom1,1000000denom2")
+	req := s.deposit(depositor, pool.Id, depositCoins, true)
+	liquidity.EndBlocker(s.ctx, s.keeper)
+	attrs, found := eventAttrs(s.ctx.EventManager().Events(), types.EventTypeDepositCapped)
+	s.Require().True(found)
+	s.Require().Equal(strconv.FormatUint(req.Id, 10), attrs[types.AttributeKeyRequestId])
+	s.Require().Equal(maxReserveAmt.String(), attrs[types.AttributeKeyMaxReserveAmount])
+	req, _ = s.keeper.GetDepositRequest(s.ctx, req.PoolId, req.Id)
+	s.Require().Equal(types.RequestStatusSucceeded, req.Status)
+	s.Require().True(coinsEq(utils.ParseCoins("500000denom1,500000denom2"), req.AcceptedCoins))
+	s.Require().True(coinsEq(maxReserveAmt, s.getBalances(pool.GetReserveAddress())))
+	s.Require().True(coinEq(utils.ParseCoin("500000denom1"), s.getBalance(depositor, "denom1")))
+	s.nextBlock()
+
+	// The deposit is refused once the reserve reached the caps.
+	req = s.deposit(depositor, pool.Id, utils.ParseCoins("100000denom1,100000denom2"), true)
+	liquidity.EndBlocker(s.ctx, s.keeper)
+	req, _ = s.keeper.GetDepositRequest(s.ctx, req.PoolId, req.Id)
+	s.Require().Equal(types.RequestStatusFailed, req.Status)
+	attrs, found = eventAttrs(s.ctx.EventManager().Events(), types.EventTypeDepositFailed)
+	s.Require().True(found)
+	s.Require().Equal(types.FailureReasonMaxReserveAmountReached.String(), attrs[types.AttributeKeyReason])
+	s.Require().True(coinsEq(maxReserveAmt, s.getBalances(pool.GetReserveAddress())))
+	s.nextBlock()
+
+	// An empty max reserve amount removes the caps.
+	err = handler(s.ctx, types.NewPoolMaxReserveAmountProposal("title", "description", pool.Id, nil))
+	s.Require().NoError(err)
+	pool, _ = s.keeper.GetPool(s.ctx, pool.Id)
+	s.Require().True(pool.MaxReserveAmount.Empty())
+	req = s.deposit(depositor, pool.Id, utils.ParseCoins("100000denom1,100000denom2"), true)
+	liquidity.EndBlocker(s.ctx, s.keeper)
+	req, _ = s.keeper.GetDepositRequest(s.ctx, req.PoolId, req.Id)
+	s.Require().Equal(types.RequestStatusSucceeded, req.Status)
+	s.Require().True(coinsEq(utils.ParseCoins("100000denom1,100000denom2"), req.AcceptedCoins))
+}
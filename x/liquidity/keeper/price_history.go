@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/crescent-network/crescent/v4/x/liquidity/types"
+)
+
+// RecordPriceHistory records the pair's last price at the current block and
+// prunes the oldest entries so that at most PriceHistoryLength entries are
+// kept for the pair.
+// Nothing is recorded if the pair has no last price yet.
+func (k Keeper) RecordPriceHistory(ctx sdk.Context, pair types.Pair) {
+	length := k.GetPriceHistoryLength(ctx)
+	if length == 0 || pair.LastPrice == nil {
+		return
+	}
+
+	k.SetPriceHistoryEntry(ctx, types.NewPriceHistoryEntry(pair.Id, ctx.BlockHeight(), ctx.BlockTime(), *pair.LastPrice))
+
+	// Only the entries to be deleted are read, which is usually the oldest
+	// entry alone.
+	if num := k.GetNumPriceHistoryEntries(ctx, pair.Id); num > uint64(length) {
+		k.DeleteOldestPriceHistoryEntries(ctx, pair.Id, num-uint64(length))
+	}
+}
+
+// GetTWAP returns the time-weighted average price of the pair over the
+// duration ending at the current block time.
+// Each price history entry's price is weighted by the time until the next
+// entry, or until the current block time for the latest entry.
+// It returns an error if the price history doesn't cover the whole duration
+// or there are missing blocks within the duration.
+func (k Keeper) GetTWAP(ctx sdk.Context, pairId uint64, duration time.Duration) (sdk.Dec, error) {
+	if duration <= 0 {
+		return sdk.Dec{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duration must be positive: %s", duration)
+	}
+
+	endTime := ctx.BlockTime()
+	startTime := endTime.Add(-duration)
+
+	// Collect entries within the duration, along with the latest entry
+	// before the duration which determines the price at the start time.
+	// Entries are read from the latest one, so that older entries are not
+	// read at all.
+	var entries []types.PriceHistoryEntry
+	_ = k.ReverseIteratePriceHistoryEntriesByPair(ctx, pairId, func(entry types.PriceHistoryEntry) (stop bool, err error) {
+		entries = append(entries, entry)
+		return !entry.Time.After(startTime), nil
+	})
+	for i, j := 0, len(entries)-1; i < j; i, j = i+1, j-1 {
+		entries[i], entries[j] = entries[j], entries[i]
+	}
+	if len(entries) == 0 || entries[0].Time.After(startTime) {
+		return sdk.Dec{}, sdkerrors.Wrapf(types.ErrInsufficientPriceHistory, "price history of pair %d doesn't cover %s", pairId, duration)
+	}
+
+	for i := 1; i < len(entries); i++ {
+		if entries[i].Height != entries[i-1].Height+1 {
+			return sdk.Dec{}, sdkerrors.Wrapf(
+				types.ErrMissingPriceHistory, "no price history of pair %d between heights %d and %d",
+				pairId, entries[i-1].Height, entries[i].Height)
+		}
+	}
+	if latest := entries[len(entries)-1]; latest.Height < ctx.BlockHeight()-1 {
+		return sdk.Dec{}, sdkerrors.Wrapf(
+			types.ErrMissingPriceHistory, "no price history of pair %d since height %d", pairId, latest.Height)
+	}
+
+	weightedSum := sdk.ZeroDec()
+	for i, entry := range entries {
+		from := entry.Time
+		if from.Before(startTime) {
+			from = startTime
+		}
+		to := endTime
+		if i < len(entries)-1 {
+			to = entries[i+1].Time
+		}
+		weightedSum = weightedSum.Add(entry.Price.MulInt64(to.Sub(from).Nanoseconds()))
+	}
+
+	return weightedSum.QuoInt64(duration.Nanoseconds()), nil
+}
@@ -0,0 +1,95 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	utils "github.com/crescent-network/crescent/v4/types"
+	"github.com/crescent-network/crescent/v4/x/liquidity/types"
+)
+
+func (s *KeeperTestSuite) TestRecordPriceHistory() {
+	params := s.keeper.GetParams(s.ctx)
+	params.PriceHistoryLength = 3
+	s.keeper.SetParams(s.ctx, params)
+
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+
+	// No price history is recorded for a pair without last price.
+	s.nextBlock()
+	s.Require().Empty(s.keeper.GetPriceHistoryEntriesByPair(s.ctx, pair.Id))
+
+	s.createPool(s.addr(0), pair.Id, utils.ParseCoins("1000000denom1,1000000denom2"), true)
+	s.buyLimitOrder(s.addr(1), pair.Id, utils.ParseDec("1.01"), sdk.NewInt(10000), time.Hour, true)
+	s.nextBlock()
+
+	entries := s.keeper.GetPriceHistoryEntriesByPair(s.ctx, pair.Id)
+	s.Require().Len(entries, 1)
+	pair, _ = s.keeper.GetPair(s.ctx, pair.Id)
+	s.Require().True(decEq(*pair.LastPrice, entries[0].Price))
+
+	for i := 0; i < 4; i++ {
+		s.nextBlock()
+	}
+
+	// Only the latest PriceHistoryLength entries are kept.
+	entries = s.keeper.GetPriceHistoryEntriesByPair(s.ctx, pair.Id)
+	s.Require().Len(entries, 3)
+	s.Require().Equal(entries[0].Height+1, entries[1].Height)
+	s.Require().Equal(entries[1].Height+1, entries[2].Height)
+	s.Require().Equal(s.ctx.BlockHeight()-1, entries[2].Height)
+	s.Require().EqualValues(3, s.keeper.GetNumPriceHistoryEntries(s.ctx, pair.Id))
+
+	// Lowering PriceHistoryLength deletes all the excess entries at once.
+	params.PriceHistoryLength = 1
+	s.keeper.SetParams(s.ctx, params)
+	s.nextBlock()
+	entries = s.keeper.GetPriceHistoryEntriesByPair(s.ctx, pair.Id)
+	s.Require().Len(entries, 1)
+	s.Require().Equal(s.ctx.BlockHeight()-1, entries[0].Height)
+	s.Require().EqualValues(1, s.keeper.GetNumPriceHistoryEntries(s.ctx, pair.Id))
+
+	// Disabling price history stops recording.
+	params.PriceHistoryLength = 0
+	s.keeper.SetParams(s.ctx, params)
+	s.nextBlock()
+	entries2 := s.keeper.GetPriceHistoryEntriesByPair(s.ctx, pair.Id)
+	s.Require().Equal(entries, entries2)
+}
+
+func (s *KeeperTestSuite) TestGetTWAP() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+
+	now := utils.ParseTime("2022-01-01T00:01:00Z")
+	s.ctx = s.ctx.WithBlockHeight(100).WithBlockTime(now)
+	for i, price := range []string{"1.0", "2.0", "4.0"} {
+		s.keeper.SetPriceHistoryEntry(s.ctx, types.NewPriceHistoryEntry(
+			pair.Id, int64(97+i), now.Add(-time.Duration(3-i)*10*time.Second), utils.ParseDec(price)))
+	}
+
+	twap, err := s.keeper.GetTWAP(s.ctx, pair.Id, 20*time.Second)
+	s.Require().NoError(err)
+	s.Require().True(decEq(utils.ParseDec("3.0"), twap))
+
+	twap, err = s.keeper.GetTWAP(s.ctx, pair.Id, 25*time.Second)
+	s.Require().NoError(err)
+	s.Require().True(decEq(utils.ParseDec("2.6"), twap))
+
+	_, err = s.keeper.GetTWAP(s.ctx, pair.Id, 0)
+	s.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	// The price history doesn't cover the duration.
+	_, err = s.keeper.GetTWAP(s.ctx, pair.Id, 40*time.Second)
+	s.Require().ErrorIs(err, types.ErrInsufficientPriceHistory)
+
+	// The latest entry is too old.
+	_, err = s.keeper.GetTWAP(s.ctx.WithBlockHeight(102), pair.Id, 20*time.Second)
+	s.Require().ErrorIs(err, types.ErrMissingPriceHistory)
+
+	// There is a missing block within the duration.
+	s.keeper.DeletePriceHistoryEntry(s.ctx, types.NewPriceHistoryEntry(pair.Id, 98, time.Time{}, sdk.Dec{}))
+	_, err = s.keeper.GetTWAP(s.ctx, pair.Id, 25*time.Second)
+	s.Require().ErrorIs(err, types.ErrMissingPriceHistory)
+}
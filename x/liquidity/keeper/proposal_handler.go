@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/crescent-network/crescent/v4/x/liquidity/types"
+)
+
+// HandlePoolMigrationProposal is a handler for executing a pool migration proposal.
+func HandlePoolMigrationProposal(ctx sdk.Context, k Keeper, p *types.PoolMigrationProposal) error {
+	_, err := k.MigratePool(ctx, p.PoolId, p.MinPrice, p.MaxPrice)
+	return err
+}
+
+// HandlePairMetadataProposal is a handler for executing a pair metadata proposal.
+func HandlePairMetadataProposal(ctx sdk.Context, k Keeper, p *types.PairMetadataProposal) error {
+	_, err := k.AttachPairMetadata(ctx, p.PairId, p.Metadata)
+	return err
+}
+
+// HandlePairCircuitBreakerProposal is a handler for executing a pair circuit
+// breaker proposal.
+func HandlePairCircuitBreakerProposal(ctx sdk.Context, k Keeper, p *types.PairCircuitBreakerProposal) error {
+	_, err := k.SetPairHalted(ctx, p.PairId, p.Halted)
+	return err
+}
+
+// HandlePairBatchWindowProposal is a handler for executing a pair batch
+// window proposal.
+func HandlePairBatchWindowProposal(ctx sdk.Context, k Keeper, p *types.PairBatchWindowProposal) error {
+	_, err := k.SetPairBatchWindow(ctx, p.PairId, p.BatchWindow)
+	return err
+}
+
+// HandlePairOrderAmountLimitsProposal is a handler for executing a pair order
+// amount limits proposal.
+func HandlePairOrderAmountLimitsProposal(ctx sdk.Context, k Keeper, p *types.PairOrderAmountLimitsProposal) error {
+	_, err := k.SetPairOrderAmountLimits(ctx, p.PairId, p.Limits)
+	return err
+}
+
+// HandlePairParamsProposal is a handler for executing a pair params proposal.
+func HandlePairParamsProposal(ctx sdk.Context, k Keeper, p *types.PairParamsProposal) error {
+	_, err := k.SetPairParams(ctx, p.PairId, p.UpwardPriceLimitRatio, p.DownwardPriceLimitRatio, p.MaxOrderLifespan)
+	return err
+}
+
+// HandlePoolMaxReserveAmountProposal is a handler for executing a pool max
+// reserve amount proposal.
+func HandlePoolMaxReserveAmountProposal(ctx sdk.Context, k Keeper, p *types.PoolMaxReserveAmountProposal) error {
+	_, err := k.SetPoolMaxReserveAmount(ctx, p.PoolId, p.MaxReserveAmount)
+	return err
+}
@@ -99,47 +99,62 @@ func (s *KeeperTestSuite) TestSimulation1() {
 		}
 	}
 
+	// Pools are created with price 1.0, so the last price moved by the previous
+	// fuzz is reset to pass the initial pool price check.
+	resetLastPrice := func() {
+		pair, _ = s.keeper.GetPair(s.ctx, pair.Id)
+		pair.LastPrice = utils.ParseDecP("1.0")
+		s.keeper.SetPair(s.ctx, pair)
+	}
+
 	// Add a basic pool with price 1.0.
 	s.createPool(s.addr(10000), pair.Id, utils.ParseCoins("1000000000denom1,1000000000denom2"), true)
 	fuzz()
 
 	// Add a ranged pool with price in range [0.9, 1.1].
+	resetLastPrice()
 	s.createRangedPool(
 		s.addr(10000), pair.Id, utils.ParseCoins("1000000000denom1,1000000000denom2"),
 		utils.ParseDec("0.9"), utils.ParseDec("1.1"), utils.ParseDec("1.0"), true)
 	fuzz()
 
 	// Add a ranged pool with price in range [0.8, 1.2].
+	resetLastPrice()
 	s.createRangedPool(
 		s.addr(10000), pair.Id, utils.ParseCoins("1000000000denom1,1000000000denom2"),
 		utils.ParseDec("0.8"), utils.ParseDec("1.2"), utils.ParseDec("1.0"), true)
 	fuzz()
 
 	// Add a ranged pool with price in range [0.95, 1.05].
+	resetLastPrice()
 	s.createRangedPool(
 		s.addr(10000), pair.Id, utils.ParseCoins("1000000000denom1,1000000000denom2"),
 		utils.ParseDec("0.95"), utils.ParseDec("1.05"), utils.ParseDec("1.0"), true)
 	fuzz()
 
 	// Add a ranged pool with price in range [0.99, 1.01].
+	resetLastPrice()
 	s.createRangedPool(
 		s.addr(10000), pair.Id, utils.ParseCoins("1000000000denom1,1000000000denom2"),
 		utils.ParseDec("0.99"), utils.ParseDec("1.01"), utils.ParseDec("1.0"), true)
 	fuzz()
 
 	// Add a ranged pool with price in range [0.999, 1.001].
+	resetLastPrice()
 	s.createRangedPool(
 		s.addr(10000), pair.Id, utils.ParseCoins("1000000000denom1,1000000000denom2"),
 		utils.ParseDec("0.999"), utils.ParseDec("1.001"), utils.ParseDec("1.0"), true)
 	fuzz()
 
 	// Add a ranged pool with price in range [10^-14, 2-(10^-4)].
+	resetLastPrice()
 	s.createRangedPool(
 		s.addr(10000), pair.Id, utils.ParseCoins("1000000000denom1,1000000000denom2"),
 		sdk.NewDecWithPrec(1, 14), sdk.NewDec(2).Sub(sdk.NewDecWithPrec(1, 4)), utils.ParseDec("1.0"), true)
 	fuzz()
 
 	// Add a ranged pool with price in range [10^-8, 10^20].
+	resetLastPrice()
 	s.createRangedPool(
 		s.addr(10000), pair.Id, utils.ParseCoins("1000000000denom1,1000000000denom2"),
 		sdk.NewDecWithPrec(1, 8), sdk.NewIntWithDecimal(1, 20).ToDec(), utils.ParseDec("1.0"), true)
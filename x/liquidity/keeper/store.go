@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"time"
+
 	gogotypes "github.com/gogo/protobuf/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -161,6 +163,12 @@ func (k Keeper) SetPoolByReserveIndex(ctx sdk.Context, pool types.Pool) {
 	store.Set(types.GetPoolByReserveAddressIndexKey(pool.GetReserveAddress()), bz)
 }
 
+// DeletePoolByReserveIndex deletes the reserve account index of the pool.
+func (k Keeper) DeletePoolByReserveIndex(ctx sdk.Context, pool types.Pool) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetPoolByReserveAddressIndexKey(pool.GetReserveAddress()))
+}
+
 // SetPoolsByPairIndex stores a pool by pair index key.
 func (k Keeper) SetPoolsByPairIndex(ctx sdk.Context, pool types.Pool) {
 	store := ctx.KVStore(k.storeKey)
@@ -585,3 +593,671 @@ func (k Keeper) DeleteMMOrderIndex(ctx sdk.Context, index types.MMOrderIndex) {
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.GetMMOrderIndexKey(index.GetOrderer(), index.PairId))
 }
+
+// GetAccruedSwapFees returns the swap fees accrued in the pair.
+func (k Keeper) GetAccruedSwapFees(ctx sdk.Context, pairId uint64) (fees types.AccruedSwapFees, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetAccruedSwapFeesKey(pairId))
+	if bz == nil {
+		return
+	}
+	k.cdc.MustUnmarshal(bz, &fees)
+	return fees, true
+}
+
+// SetAccruedSwapFees stores the swap fees accrued in a pair.
+func (k Keeper) SetAccruedSwapFees(ctx sdk.Context, fees types.AccruedSwapFees) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&fees)
+	store.Set(types.GetAccruedSwapFeesKey(fees.PairId), bz)
+}
+
+// IterateAllAccruedSwapFees iterates through all accrued swap fees in the
+// store and call cb for each pair.
+func (k Keeper) IterateAllAccruedSwapFees(ctx sdk.Context, cb func(fees types.AccruedSwapFees) (stop bool, err error)) error {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.AccruedSwapFeesKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var fees types.AccruedSwapFees
+		k.cdc.MustUnmarshal(iter.Value(), &fees)
+		stop, err := cb(fees)
+		if err != nil {
+			return err
+		}
+		if stop {
+			break
+		}
+	}
+	return nil
+}
+
+// GetAllAccruedSwapFees returns all accrued swap fees in the store.
+func (k Keeper) GetAllAccruedSwapFees(ctx sdk.Context) (feesList []types.AccruedSwapFees) {
+	feesList = []types.AccruedSwapFees{}
+	_ = k.IterateAllAccruedSwapFees(ctx, func(fees types.AccruedSwapFees) (stop bool, err error) {
+		feesList = append(feesList, fees)
+		return false, nil
+	})
+	return
+}
+
+// GetNumPriceHistoryEntries returns the number of price history entries of a
+// pair.
+func (k Keeper) GetNumPriceHistoryEntries(ctx sdk.Context, pairId uint64) (num uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetNumPriceHistoryEntriesKey(pairId))
+	if bz == nil {
+		return 0
+	}
+	var val gogotypes.UInt64Value
+	k.cdc.MustUnmarshal(bz, &val)
+	return val.GetValue()
+}
+
+// setNumPriceHistoryEntries stores the number of price history entries of a
+// pair.
+func (k Keeper) setNumPriceHistoryEntries(ctx sdk.Context, pairId uint64, num uint64) {
+	store := ctx.KVStore(k.storeKey)
+	if num == 0 {
+		store.Delete(types.GetNumPriceHistoryEntriesKey(pairId))
+		return
+	}
+	bz := k.cdc.MustMarshal(&gogotypes.UInt64Value{Value: num})
+	store.Set(types.GetNumPriceHistoryEntriesKey(pairId), bz)
+}
+
+// SetPriceHistoryEntry stores a price history entry and keeps track of the
+// number of the pair's entries.
+func (k Keeper) SetPriceHistoryEntry(ctx sdk.Context, entry types.PriceHistoryEntry) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetPriceHistoryEntryKey(entry.PairId, entry.Height)
+	if !store.Has(key) {
+		k.setNumPriceHistoryEntries(ctx, entry.PairId, k.GetNumPriceHistoryEntries(ctx, entry.PairId)+1)
+	}
+	bz := k.cdc.MustMarshal(&entry)
+	store.Set(key, bz)
+}
+
+// IteratePriceHistoryEntriesByPair iterates through all price history entries
+// of a pair from the oldest one and call cb for each entry.
+func (k Keeper) IteratePriceHistoryEntriesByPair(ctx sdk.Context, pairId uint64, cb func(entry types.PriceHistoryEntry) (stop bool, err error)) error {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetPriceHistoryEntriesByPairKeyPrefix(pairId))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var entry types.PriceHistoryEntry
+		k.cdc.MustUnmarshal(iter.Value(), &entry)
+		stop, err := cb(entry)
+		if err != nil {
+			return err
+		}
+		if stop {
+			break
+		}
+	}
+	return nil
+}
+
+// ReverseIteratePriceHistoryEntriesByPair iterates through all price history
+// entries of a pair from the latest one and call cb for each entry.
+func (k Keeper) ReverseIteratePriceHistoryEntriesByPair(ctx sdk.Context, pairId uint64, cb func(entry types.PriceHistoryEntry) (stop bool, err error)) error {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStoreReversePrefixIterator(store, types.GetPriceHistoryEntriesByPairKeyPrefix(pairId))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var entry types.PriceHistoryEntry
+		k.cdc.MustUnmarshal(iter.Value(), &entry)
+		stop, err := cb(entry)
+		if err != nil {
+			return err
+		}
+		if stop {
+			break
+		}
+	}
+	return nil
+}
+
+// GetPriceHistoryEntriesByPair returns all price history entries of a pair
+// ordered by their heights.
+func (k Keeper) GetPriceHistoryEntriesByPair(ctx sdk.Context, pairId uint64) (entries []types.PriceHistoryEntry) {
+	_ = k.IteratePriceHistoryEntriesByPair(ctx, pairId, func(entry types.PriceHistoryEntry) (stop bool, err error) {
+		entries = append(entries, entry)
+		return false, nil
+	})
+	return
+}
+
+// IterateAllPriceHistoryEntries iterates through all price history entries in
+// the store and call cb for each entry.
+func (k Keeper) IterateAllPriceHistoryEntries(ctx sdk.Context, cb func(entry types.PriceHistoryEntry) (stop bool, err error)) error {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.PriceHistoryEntryKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var entry types.PriceHistoryEntry
+		k.cdc.MustUnmarshal(iter.Value(), &entry)
+		stop, err := cb(entry)
+		if err != nil {
+			return err
+		}
+		if stop {
+			break
+		}
+	}
+	return nil
+}
+
+// GetAllPriceHistoryEntries returns all price history entries in the store.
+func (k Keeper) GetAllPriceHistoryEntries(ctx sdk.Context) (entries []types.PriceHistoryEntry) {
+	entries = []types.PriceHistoryEntry{}
+	_ = k.IterateAllPriceHistoryEntries(ctx, func(entry types.PriceHistoryEntry) (stop bool, err error) {
+		entries = append(entries, entry)
+		return false, nil
+	})
+	return
+}
+
+// DeletePriceHistoryEntry deletes a price history entry.
+func (k Keeper) DeletePriceHistoryEntry(ctx sdk.Context, entry types.PriceHistoryEntry) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetPriceHistoryEntryKey(entry.PairId, entry.Height)
+	if !store.Has(key) {
+		return
+	}
+	store.Delete(key)
+	k.setNumPriceHistoryEntries(ctx, entry.PairId, k.GetNumPriceHistoryEntries(ctx, entry.PairId)-1)
+}
+
+// DeleteOldestPriceHistoryEntries deletes the n oldest price history entries
+// of a pair.
+// Only the keys of the deleted entries are iterated, without unmarshaling
+// the entries.
+func (k Keeper) DeleteOldestPriceHistoryEntries(ctx sdk.Context, pairId uint64, n uint64) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetPriceHistoryEntriesByPairKeyPrefix(pairId))
+	var keys [][]byte
+	for ; iter.Valid() && uint64(len(keys)) < n; iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	k.setNumPriceHistoryEntries(ctx, pairId, k.GetNumPriceHistoryEntries(ctx, pairId)-uint64(len(keys)))
+}
+
+// GetPairStatsBucket returns the pair's stats bucket starting at startTime.
+func (k Keeper) GetPairStatsBucket(ctx sdk.Context, pairId uint64, startTime time.Time) (bucket types.PairStatsBucket, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetPairStatsBucketKey(pairId, startTime))
+	if bz == nil {
+		return
+	}
+	k.cdc.MustUnmarshal(bz, &bucket)
+	return bucket, true
+}
+
+// SetPairStatsBucket stores a pair stats bucket.
+func (k Keeper) SetPairStatsBucket(ctx sdk.Context, bucket types.PairStatsBucket) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&bucket)
+	store.Set(types.GetPairStatsBucketKey(bucket.PairId, bucket.StartTime), bz)
+}
+
+// IteratePairStatsBucketsByPair iterates through all stats buckets of a pair
+// from the oldest one and call cb for each bucket.
+func (k Keeper) IteratePairStatsBucketsByPair(ctx sdk.Context, pairId uint64, cb func(bucket types.PairStatsBucket) (stop bool, err error)) error {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetPairStatsBucketsByPairKeyPrefix(pairId))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var bucket types.PairStatsBucket
+		k.cdc.MustUnmarshal(iter.Value(), &bucket)
+		stop, err := cb(bucket)
+		if err != nil {
+			return err
+		}
+		if stop {
+			break
+		}
+	}
+	return nil
+}
+
+// IterateAllPairStatsBuckets iterates through all pair stats buckets in the
+// store and call cb for each bucket.
+func (k Keeper) IterateAllPairStatsBuckets(ctx sdk.Context, cb func(bucket types.PairStatsBucket) (stop bool, err error)) error {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.PairStatsBucketKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var bucket types.PairStatsBucket
+		k.cdc.MustUnmarshal(iter.Value(), &bucket)
+		stop, err := cb(bucket)
+		if err != nil {
+			return err
+		}
+		if stop {
+			break
+		}
+	}
+	return nil
+}
+
+// GetAllPairStatsBuckets returns all pair stats buckets in the store.
+func (k Keeper) GetAllPairStatsBuckets(ctx sdk.Context) (buckets []types.PairStatsBucket) {
+	buckets = []types.PairStatsBucket{}
+	_ = k.IterateAllPairStatsBuckets(ctx, func(bucket types.PairStatsBucket) (stop bool, err error) {
+		buckets = append(buckets, bucket)
+		return false, nil
+	})
+	return
+}
+
+// DeletePairStatsBucket deletes a pair stats bucket.
+func (k Keeper) DeletePairStatsBucket(ctx sdk.Context, bucket types.PairStatsBucket) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetPairStatsBucketKey(bucket.PairId, bucket.StartTime))
+}
+
+// GetBatchResult returns the result of the pair's batch.
+func (k Keeper) GetBatchResult(ctx sdk.Context, pairId, batchId uint64) (result types.BatchResult, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetBatchResultKey(pairId, batchId))
+	if bz == nil {
+		return
+	}
+	k.cdc.MustUnmarshal(bz, &result)
+	return result, true
+}
+
+// SetBatchResult stores a batch result.
+func (k Keeper) SetBatchResult(ctx sdk.Context, result types.BatchResult) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&result)
+	store.Set(types.GetBatchResultKey(result.PairId, result.BatchId), bz)
+}
+
+// IterateBatchResultsByPair iterates through all batch results of a pair
+// from the oldest one and call cb for each result.
+func (k Keeper) IterateBatchResultsByPair(ctx sdk.Context, pairId uint64, cb func(result types.BatchResult) (stop bool, err error)) error {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetBatchResultsByPairKeyPrefix(pairId))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var result types.BatchResult
+		k.cdc.MustUnmarshal(iter.Value(), &result)
+		stop, err := cb(result)
+		if err != nil {
+			return err
+		}
+		if stop {
+			break
+		}
+	}
+	return nil
+}
+
+// IterateAllBatchResults iterates through all batch results in the store and
+// call cb for each result.
+func (k Keeper) IterateAllBatchResults(ctx sdk.Context, cb func(result types.BatchResult) (stop bool, err error)) error {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.BatchResultKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var result types.BatchResult
+		k.cdc.MustUnmarshal(iter.Value(), &result)
+		stop, err := cb(result)
+		if err != nil {
+			return err
+		}
+		if stop {
+			break
+		}
+	}
+	return nil
+}
+
+// GetAllBatchResults returns all batch results in the store.
+func (k Keeper) GetAllBatchResults(ctx sdk.Context) (results []types.BatchResult) {
+	results = []types.BatchResult{}
+	_ = k.IterateAllBatchResults(ctx, func(result types.BatchResult) (stop bool, err error) {
+		results = append(results, result)
+		return false, nil
+	})
+	return
+}
+
+// DeleteBatchResult deletes a batch result.
+func (k Keeper) DeleteBatchResult(ctx sdk.Context, result types.BatchResult) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetBatchResultKey(result.PairId, result.BatchId))
+}
+
+// GetLastVaultId returns the last vault id.
+func (k Keeper) GetLastVaultId(ctx sdk.Context) (id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LastVaultIdKey)
+	if bz == nil {
+		id = 0 // initialize the vault id
+	} else {
+		var val gogotypes.UInt64Value
+		k.cdc.MustUnmarshal(bz, &val)
+		id = val.GetValue()
+	}
+	return
+}
+
+// SetLastVaultId stores the last vault id.
+func (k Keeper) SetLastVaultId(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&gogotypes.UInt64Value{Value: id})
+	store.Set(types.LastVaultIdKey, bz)
+}
+
+// GetVault returns vault object for the given vault id.
+func (k Keeper) GetVault(ctx sdk.Context, id uint64) (vault types.Vault, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetVaultKey(id))
+	if bz == nil {
+		return
+	}
+	k.cdc.MustUnmarshal(bz, &vault)
+	return vault, true
+}
+
+// SetVault stores the particular vault.
+func (k Keeper) SetVault(ctx sdk.Context, vault types.Vault) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&vault)
+	store.Set(types.GetVaultKey(vault.Id), bz)
+}
+
+// SetVaultsByPairIndex stores a vault by pair index key.
+func (k Keeper) SetVaultsByPairIndex(ctx sdk.Context, vault types.Vault) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetVaultsByPairIndexKey(vault.PairId, vault.Id), []byte{})
+}
+
+// IterateAllVaults iterates over all the stored vaults and performs a callback function.
+// Stops iteration when callback returns true.
+func (k Keeper) IterateAllVaults(ctx sdk.Context, cb func(vault types.Vault) (stop bool, err error)) error {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.VaultKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var vault types.Vault
+		k.cdc.MustUnmarshal(iter.Value(), &vault)
+		stop, err := cb(vault)
+		if err != nil {
+			return err
+		}
+		if stop {
+			break
+		}
+	}
+	return nil
+}
+
+// IterateVaultsByPair iterates over all the stored vaults by the pair and performs a callback function.
+// Stops iteration when callback returns true.
+func (k Keeper) IterateVaultsByPair(ctx sdk.Context, pairId uint64, cb func(vault types.Vault) (stop bool, err error)) error {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetVaultsByPairIndexKeyPrefix(pairId))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		vaultId := types.ParseVaultsByPairIndexKey(iter.Key())
+		vault, _ := k.GetVault(ctx, vaultId)
+		stop, err := cb(vault)
+		if err != nil {
+			return err
+		}
+		if stop {
+			break
+		}
+	}
+	return nil
+}
+
+// GetAllVaults returns all vaults in the store.
+func (k Keeper) GetAllVaults(ctx sdk.Context) (vaults []types.Vault) {
+	vaults = []types.Vault{}
+	_ = k.IterateAllVaults(ctx, func(vault types.Vault) (stop bool, err error) {
+		vaults = append(vaults, vault)
+		return false, nil
+	})
+	return
+}
+
+// GetLastSwapRouteId returns the last swap route id.
+func (k Keeper) GetLastSwapRouteId(ctx sdk.Context) (id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LastSwapRouteIdKey)
+	if bz == nil {
+		id = 0 // initialize the swap route id
+	} else {
+		var val gogotypes.UInt64Value
+		k.cdc.MustUnmarshal(bz, &val)
+		id = val.GetValue()
+	}
+	return
+}
+
+// SetLastSwapRouteId stores the last swap route id.
+func (k Keeper) SetLastSwapRouteId(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&gogotypes.UInt64Value{Value: id})
+	store.Set(types.LastSwapRouteIdKey, bz)
+}
+
+// GetSwapRoute returns swap route object for the given swap route id.
+func (k Keeper) GetSwapRoute(ctx sdk.Context, id uint64) (route types.SwapRoute, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetSwapRouteKey(id))
+	if bz == nil {
+		return
+	}
+	k.cdc.MustUnmarshal(bz, &route)
+	return route, true
+}
+
+// SetSwapRoute stores the particular swap route.
+func (k Keeper) SetSwapRoute(ctx sdk.Context, route types.SwapRoute) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&route)
+	store.Set(types.GetSwapRouteKey(route.Id), bz)
+}
+
+// IterateAllSwapRoutes iterates over all the stored swap routes and performs a callback function.
+// Stops iteration when callback returns true.
+func (k Keeper) IterateAllSwapRoutes(ctx sdk.Context, cb func(route types.SwapRoute) (stop bool, err error)) error {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.SwapRouteKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var route types.SwapRoute
+		k.cdc.MustUnmarshal(iter.Value(), &route)
+		stop, err := cb(route)
+		if err != nil {
+			return err
+		}
+		if stop {
+			break
+		}
+	}
+	return nil
+}
+
+// GetAllSwapRoutes returns all swap routes in the store.
+func (k Keeper) GetAllSwapRoutes(ctx sdk.Context) (routes []types.SwapRoute) {
+	routes = []types.SwapRoute{}
+	_ = k.IterateAllSwapRoutes(ctx, func(route types.SwapRoute) (stop bool, err error) {
+		routes = append(routes, route)
+		return false, nil
+	})
+	return
+}
+
+// DeleteSwapRoute deletes a swap route.
+func (k Keeper) DeleteSwapRoute(ctx sdk.Context, route types.SwapRoute) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetSwapRouteKey(route.Id))
+}
+
+// GetRequestResult returns the result of a request or an order.
+func (k Keeper) GetRequestResult(ctx sdk.Context, typ types.RequestType, targetId, id uint64) (result types.RequestResult, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetRequestResultKey(typ, targetId, id))
+	if bz == nil {
+		return
+	}
+	k.cdc.MustUnmarshal(bz, &result)
+	return result, true
+}
+
+// SetRequestResult stores the result of a request or an order, along with
+// its index by the finish time.
+// The result of a request or an order is set only once, when it finishes.
+func (k Keeper) SetRequestResult(ctx sdk.Context, result types.RequestResult) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&result)
+	store.Set(types.GetRequestResultKey(result.Type, result.TargetId, result.Id), bz)
+	store.Set(types.GetRequestResultIndexKey(result.FinishedAt, result.Type, result.TargetId, result.Id), []byte{})
+}
+
+// IterateAllRequestResults iterates through all request results in the store
+// and call cb for each result.
+func (k Keeper) IterateAllRequestResults(ctx sdk.Context, cb func(result types.RequestResult) (stop bool, err error)) error {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.RequestResultKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var result types.RequestResult
+		k.cdc.MustUnmarshal(iter.Value(), &result)
+		stop, err := cb(result)
+		if err != nil {
+			return err
+		}
+		if stop {
+			break
+		}
+	}
+	return nil
+}
+
+// IterateRequestResultsFinishedUntil iterates through the request results
+// finished at or before the time, from the earliest finished one, and call cb
+// for each result.
+// Only those request results are read from the store.
+func (k Keeper) IterateRequestResultsFinishedUntil(ctx sdk.Context, t time.Time, cb func(result types.RequestResult) (stop bool, err error)) error {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(types.RequestResultIndexKeyPrefix, types.GetRequestResultIndexEndKey(t))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		_, typ, targetId, id := types.ParseRequestResultIndexKey(iter.Key())
+		result, _ := k.GetRequestResult(ctx, typ, targetId, id)
+		stop, err := cb(result)
+		if err != nil {
+			return err
+		}
+		if stop {
+			break
+		}
+	}
+	return nil
+}
+
+// GetAllRequestResults returns all request results in the store.
+func (k Keeper) GetAllRequestResults(ctx sdk.Context) (results []types.RequestResult) {
+	results = []types.RequestResult{}
+	_ = k.IterateAllRequestResults(ctx, func(result types.RequestResult) (stop bool, err error) {
+		results = append(results, result)
+		return false, nil
+	})
+	return
+}
+
+// DeleteRequestResult deletes the result of a request or an order, along
+// with its index.
+func (k Keeper) DeleteRequestResult(ctx sdk.Context, result types.RequestResult) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetRequestResultKey(result.Type, result.TargetId, result.Id))
+	store.Delete(types.GetRequestResultIndexKey(result.FinishedAt, result.Type, result.TargetId, result.Id))
+}
+
+// GetOrderCommit returns the order commit of the orderer in the pair with
+// the given hash.
+func (k Keeper) GetOrderCommit(ctx sdk.Context, pairId uint64, orderer sdk.AccAddress, hash []byte) (commit types.OrderCommit, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetOrderCommitKey(pairId, orderer, hash))
+	if bz == nil {
+		return
+	}
+	k.cdc.MustUnmarshal(bz, &commit)
+	return commit, true
+}
+
+// SetOrderCommit stores an order commit.
+func (k Keeper) SetOrderCommit(ctx sdk.Context, commit types.OrderCommit) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&commit)
+	store.Set(types.GetOrderCommitKey(commit.PairId, commit.GetOrderer(), commit.Hash), bz)
+}
+
+// IterateOrderCommitsByPair iterates through all order commits of a pair and
+// call cb for each commit.
+func (k Keeper) IterateOrderCommitsByPair(ctx sdk.Context, pairId uint64, cb func(commit types.OrderCommit) (stop bool, err error)) error {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetOrderCommitsByPairKeyPrefix(pairId))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var commit types.OrderCommit
+		k.cdc.MustUnmarshal(iter.Value(), &commit)
+		stop, err := cb(commit)
+		if err != nil {
+			return err
+		}
+		if stop {
+			break
+		}
+	}
+	return nil
+}
+
+// IterateAllOrderCommits iterates through all order commits in the store and
+// call cb for each commit.
+func (k Keeper) IterateAllOrderCommits(ctx sdk.Context, cb func(commit types.OrderCommit) (stop bool, err error)) error {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.OrderCommitKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var commit types.OrderCommit
+		k.cdc.MustUnmarshal(iter.Value(), &commit)
+		stop, err := cb(commit)
+		if err != nil {
+			return err
+		}
+		if stop {
+			break
+		}
+	}
+	return nil
+}
+
+// GetAllOrderCommits returns all order commits in the store.
+func (k Keeper) GetAllOrderCommits(ctx sdk.Context) (commits []types.OrderCommit) {
+	commits = []types.OrderCommit{}
+	_ = k.IterateAllOrderCommits(ctx, func(commit types.OrderCommit) (stop bool, err error) {
+		commits = append(commits, commit)
+		return false, nil
+	})
+	return
+}
+
+// DeleteOrderCommit deletes an order commit.
+func (k Keeper) DeleteOrderCommit(ctx sdk.Context, commit types.OrderCommit) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetOrderCommitKey(commit.PairId, commit.GetOrderer(), commit.Hash))
+}
@@ -3,18 +3,67 @@ package keeper
 import (
 	"fmt"
 	"strconv"
-	"strings"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
+	utils "github.com/crescent-network/crescent/v4/types"
 	"github.com/crescent-network/crescent/v4/x/liquidity/amm"
 	"github.com/crescent-network/crescent/v4/x/liquidity/types"
 )
 
-func (k Keeper) PriceLimits(ctx sdk.Context, lastPrice sdk.Dec) (lowest, highest sdk.Dec) {
-	return types.PriceLimits(lastPrice, k.GetMaxPriceLimitRatio(ctx), int(k.GetTickPrecision(ctx)))
+// PriceLimits returns the price band of the pair around its last price, in
+// which the pools place orders and the batch is matched.
+func (k Keeper) PriceLimits(ctx sdk.Context, pair types.Pair) (lowest, highest sdk.Dec) {
+	upward, downward := k.GetPairPriceLimitRatios(ctx, pair)
+	return types.PriceLimits(*pair.LastPrice, upward, downward, int(k.GetTickPrecision(ctx)))
+}
+
+// OrderPriceLimits returns the price range in which user orders are accepted.
+// It is the range returned by PriceLimits, narrowed down to MaxOrderPriceTicks
+// ticks around the last price if the param is set.
+func (k Keeper) OrderPriceLimits(ctx sdk.Context, pair types.Pair) (lowest, highest sdk.Dec) {
+	lowest, highest = k.PriceLimits(ctx, pair)
+	if maxTicks := k.GetMaxOrderPriceTicks(ctx); maxTicks > 0 {
+		windowLowest, windowHighest := types.TickWindow(*pair.LastPrice, maxTicks, int(k.GetTickPrecision(ctx)))
+		lowest = sdk.MaxDec(lowest, windowLowest)
+		highest = sdk.MinDec(highest, windowHighest)
+	}
+	return
+}
+
+// GetNumActiveOrders returns the number of active orders, which are not
+// completed, canceled or expired yet, of the orderer in the pair.
+func (k Keeper) GetNumActiveOrders(ctx sdk.Context, orderer sdk.AccAddress, pairId uint64) (num uint32) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetOrderIndexKeyPrefixByPair(orderer, pairId))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		_, _, orderId := types.ParseOrderIndexKey(iter.Key())
+		order, found := k.GetOrder(ctx, pairId, orderId)
+		if found && order.Status.IsMatchable() {
+			num++
+		}
+	}
+	return
+}
+
+// validateNumActiveOrders returns an error if placing numNewOrders orders
+// makes the number of the orderer's active orders in the pair exceed
+// the MaxNumActiveOrdersPerPair param.
+func (k Keeper) validateNumActiveOrders(ctx sdk.Context, orderer sdk.AccAddress, pairId uint64, numNewOrders int) error {
+	maxNumOrders := k.GetMaxNumActiveOrdersPerPair(ctx)
+	if maxNumOrders == 0 {
+		return nil
+	}
+	numOrders := k.GetNumActiveOrders(ctx, orderer, pairId)
+	if uint64(numOrders)+uint64(numNewOrders) > uint64(maxNumOrders) {
+		return sdkerrors.Wrapf(
+			types.ErrTooManyOrders, "%d active orders + %d new orders exceed the limit %d",
+			numOrders, numNewOrders, maxNumOrders)
+	}
+	return nil
 }
 
 // ValidateMsgLimitOrder validates types.MsgLimitOrder with state and returns
@@ -28,21 +77,26 @@ func (k Keeper) ValidateMsgLimitOrder(ctx sdk.Context, msg *types.MsgLimitOrder)
 	}
 
 	tickPrec := k.GetTickPrecision(ctx)
-	maxOrderLifespan := k.GetMaxOrderLifespan(ctx)
-
-	if msg.OrderLifespan > maxOrderLifespan {
-		return sdk.Coin{}, sdk.Dec{},
-			sdkerrors.Wrapf(types.ErrTooLongOrderLifespan, "%s is longer than %s", msg.OrderLifespan, maxOrderLifespan)
-	}
 
 	pair, found := k.GetPair(ctx, msg.PairId)
 	if !found {
 		return sdk.Coin{}, sdk.Dec{}, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "pair %d not found", msg.PairId)
 	}
+	if k.IsPairHalted(ctx, pair) {
+		return sdk.Coin{}, sdk.Dec{}, sdkerrors.Wrapf(types.ErrPairHalted, "pair %d", pair.Id)
+	}
+	if err := k.validateNumActiveOrders(ctx, msg.GetOrderer(), pair.Id, 1); err != nil {
+		return sdk.Coin{}, sdk.Dec{}, err
+	}
+
+	if maxOrderLifespan := k.GetPairMaxOrderLifespan(ctx, pair); msg.OrderLifespan > maxOrderLifespan {
+		return sdk.Coin{}, sdk.Dec{},
+			sdkerrors.Wrapf(types.ErrTooLongOrderLifespan, "%s is longer than %s", msg.OrderLifespan, maxOrderLifespan)
+	}
 
 	var upperPriceLimit, lowerPriceLimit sdk.Dec
 	if pair.LastPrice != nil {
-		lowerPriceLimit, upperPriceLimit = k.PriceLimits(ctx, *pair.LastPrice)
+		lowerPriceLimit, upperPriceLimit = k.OrderPriceLimits(ctx, pair)
 	} else {
 		upperPriceLimit = amm.HighestTick(int(tickPrec))
 		lowerPriceLimit = amm.LowestTick(int(tickPrec))
@@ -62,7 +116,11 @@ func (k Keeper) ValidateMsgLimitOrder(ctx sdk.Context, msg *types.MsgLimitOrder)
 					msg.DemandCoinDenom, msg.OfferCoin.Denom, pair.BaseCoinDenom, pair.QuoteCoinDenom)
 		}
 		price = amm.PriceToDownTick(msg.Price, int(tickPrec))
-		offerCoin = sdk.NewCoin(msg.OfferCoin.Denom, amm.OfferCoinAmount(amm.Buy, price, msg.Amount))
+		offerCoinAmt, err := amm.SafeOfferCoinAmount(amm.Buy, price, msg.Amount)
+		if err != nil {
+			return sdk.Coin{}, sdk.Dec{}, sdkerrors.Wrap(types.ErrTooLargeOrder, "offer coin amount overflows")
+		}
+		offerCoin = sdk.NewCoin(msg.OfferCoin.Denom, offerCoinAmt)
 		if msg.OfferCoin.IsLT(offerCoin) {
 			return sdk.Coin{}, sdk.Dec{}, sdkerrors.Wrapf(
 				types.ErrInsufficientOfferCoin, "%s is smaller than %s", msg.OfferCoin, offerCoin)
@@ -79,10 +137,16 @@ func (k Keeper) ValidateMsgLimitOrder(ctx sdk.Context, msg *types.MsgLimitOrder)
 			return sdk.Coin{}, sdk.Dec{}, sdkerrors.Wrapf(
 				types.ErrInsufficientOfferCoin, "%s is smaller than %s", msg.OfferCoin, sdk.NewCoin(msg.OfferCoin.Denom, msg.Amount))
 		}
+		if _, err := amm.SafeQuoteAmount(price, msg.Amount); err != nil {
+			return sdk.Coin{}, sdk.Dec{}, sdkerrors.Wrap(types.ErrTooLargeOrder, "quote coin amount overflows")
+		}
 	}
 	if types.IsTooSmallOrderAmount(msg.Amount, price) {
 		return sdk.Coin{}, sdk.Dec{}, types.ErrTooSmallOrder
 	}
+	if err := pair.ValidateOrderAmount(msg.Amount, price); err != nil {
+		return sdk.Coin{}, sdk.Dec{}, err
+	}
 
 	return offerCoin, price, nil
 }
@@ -108,22 +172,33 @@ func (k Keeper) LimitOrder(ctx sdk.Context, msg *types.MsgLimitOrder) (types.Ord
 
 	ctx.GasMeter().ConsumeGas(k.GetOrderExtraGas(ctx), "OrderExtraGas")
 
-	ctx.EventManager().EmitEvents(sdk.Events{
-		sdk.NewEvent(
-			types.EventTypeLimitOrder,
-			sdk.NewAttribute(types.AttributeKeyOrderer, msg.Orderer),
-			sdk.NewAttribute(types.AttributeKeyPairId, strconv.FormatUint(msg.PairId, 10)),
-			sdk.NewAttribute(types.AttributeKeyOrderDirection, msg.Direction.String()),
-			sdk.NewAttribute(types.AttributeKeyOfferCoin, offerCoin.String()),
-			sdk.NewAttribute(types.AttributeKeyDemandCoinDenom, msg.DemandCoinDenom),
-			sdk.NewAttribute(types.AttributeKeyPrice, price.String()),
-			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
-			sdk.NewAttribute(types.AttributeKeyOrderId, strconv.FormatUint(order.Id, 10)),
-			sdk.NewAttribute(types.AttributeKeyBatchId, strconv.FormatUint(order.BatchId, 10)),
-			sdk.NewAttribute(types.AttributeKeyExpireAt, order.ExpireAt.Format(time.RFC3339)),
-			sdk.NewAttribute(types.AttributeKeyRefundedCoins, refundedCoin.String()),
-		),
-	})
+	k.emitOrderEvent(ctx, &types.EventLimitOrder{
+		Orderer:         msg.Orderer,
+		PairId:          msg.PairId,
+		OrderId:         order.Id,
+		BatchId:         order.BatchId,
+		Direction:       msg.Direction,
+		OfferCoin:       offerCoin,
+		DemandCoinDenom: msg.DemandCoinDenom,
+		Price:           price,
+		Amount:          msg.Amount,
+		ExpireAt:        order.ExpireAt,
+		RefundedCoin:    refundedCoin,
+		OrderRef:        order.Ref(),
+	}, sdk.NewEvent(
+		types.EventTypeLimitOrder,
+		sdk.NewAttribute(types.AttributeKeyOrderer, msg.Orderer),
+		sdk.NewAttribute(types.AttributeKeyPairId, strconv.FormatUint(msg.PairId, 10)),
+		sdk.NewAttribute(types.AttributeKeyOrderDirection, msg.Direction.String()),
+		sdk.NewAttribute(types.AttributeKeyOfferCoin, offerCoin.String()),
+		sdk.NewAttribute(types.AttributeKeyDemandCoinDenom, msg.DemandCoinDenom),
+		sdk.NewAttribute(types.AttributeKeyPrice, price.String()),
+		sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+		sdk.NewAttribute(types.AttributeKeyOrderId, strconv.FormatUint(order.Id, 10)),
+		sdk.NewAttribute(types.AttributeKeyBatchId, strconv.FormatUint(order.BatchId, 10)),
+		sdk.NewAttribute(types.AttributeKeyExpireAt, order.ExpireAt.Format(time.RFC3339)),
+		sdk.NewAttribute(types.AttributeKeyRefundedCoins, refundedCoin.String()),
+	))
 
 	return order, nil
 }
@@ -138,24 +213,26 @@ func (k Keeper) ValidateMsgMarketOrder(ctx sdk.Context, msg *types.MsgMarketOrde
 			sdk.NewCoin(msg.OfferCoin.Denom, spendableAmt), msg.OfferCoin)
 	}
 
-	maxOrderLifespan := k.GetMaxOrderLifespan(ctx)
-	maxPriceLimitRatio := k.GetMaxPriceLimitRatio(ctx)
-	tickPrec := k.GetTickPrecision(ctx)
-
-	if msg.OrderLifespan > maxOrderLifespan {
-		return sdk.Coin{}, sdk.Dec{},
-			sdkerrors.Wrapf(types.ErrTooLongOrderLifespan, "%s is longer than %s", msg.OrderLifespan, maxOrderLifespan)
-	}
-
 	pair, found := k.GetPair(ctx, msg.PairId)
 	if !found {
 		return sdk.Coin{}, sdk.Dec{}, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "pair %d not found", msg.PairId)
 	}
+	if k.IsPairHalted(ctx, pair) {
+		return sdk.Coin{}, sdk.Dec{}, sdkerrors.Wrapf(types.ErrPairHalted, "pair %d", pair.Id)
+	}
+	if err := k.validateNumActiveOrders(ctx, msg.GetOrderer(), pair.Id, 1); err != nil {
+		return sdk.Coin{}, sdk.Dec{}, err
+	}
+
+	if maxOrderLifespan := k.GetPairMaxOrderLifespan(ctx, pair); msg.OrderLifespan > maxOrderLifespan {
+		return sdk.Coin{}, sdk.Dec{},
+			sdkerrors.Wrapf(types.ErrTooLongOrderLifespan, "%s is longer than %s", msg.OrderLifespan, maxOrderLifespan)
+	}
 
 	if pair.LastPrice == nil {
 		return sdk.Coin{}, sdk.Dec{}, types.ErrNoLastPrice
 	}
-	lastPrice := *pair.LastPrice
+	lowestPrice, highestPrice := k.OrderPriceLimits(ctx, pair)
 
 	switch msg.Direction {
 	case types.OrderDirectionBuy:
@@ -164,8 +241,12 @@ func (k Keeper) ValidateMsgMarketOrder(ctx sdk.Context, msg *types.MsgMarketOrde
 				sdkerrors.Wrapf(types.ErrWrongPair, "denom pair (%s, %s) != (%s, %s)",
 					msg.DemandCoinDenom, msg.OfferCoin.Denom, pair.BaseCoinDenom, pair.QuoteCoinDenom)
 		}
-		price = amm.PriceToDownTick(lastPrice.Mul(sdk.OneDec().Add(maxPriceLimitRatio)), int(tickPrec))
-		offerCoin = sdk.NewCoin(msg.OfferCoin.Denom, amm.OfferCoinAmount(amm.Buy, price, msg.Amount))
+		price = highestPrice
+		offerCoinAmt, err := amm.SafeOfferCoinAmount(amm.Buy, price, msg.Amount)
+		if err != nil {
+			return sdk.Coin{}, sdk.Dec{}, sdkerrors.Wrap(types.ErrTooLargeOrder, "offer coin amount overflows")
+		}
+		offerCoin = sdk.NewCoin(msg.OfferCoin.Denom, offerCoinAmt)
 		if msg.OfferCoin.IsLT(offerCoin) {
 			return sdk.Coin{}, sdk.Dec{}, sdkerrors.Wrapf(
 				types.ErrInsufficientOfferCoin, "%s is smaller than %s", msg.OfferCoin, offerCoin)
@@ -176,7 +257,7 @@ func (k Keeper) ValidateMsgMarketOrder(ctx sdk.Context, msg *types.MsgMarketOrde
 				sdkerrors.Wrapf(types.ErrWrongPair, "denom pair (%s, %s) != (%s, %s)",
 					msg.OfferCoin.Denom, msg.DemandCoinDenom, pair.BaseCoinDenom, pair.QuoteCoinDenom)
 		}
-		price = amm.PriceToUpTick(lastPrice.Mul(sdk.OneDec().Sub(maxPriceLimitRatio)), int(tickPrec))
+		price = lowestPrice
 		offerCoin = sdk.NewCoin(msg.OfferCoin.Denom, msg.Amount)
 		if msg.OfferCoin.Amount.LT(msg.Amount) {
 			return sdk.Coin{}, sdk.Dec{}, sdkerrors.Wrapf(
@@ -186,6 +267,9 @@ func (k Keeper) ValidateMsgMarketOrder(ctx sdk.Context, msg *types.MsgMarketOrde
 	if types.IsTooSmallOrderAmount(msg.Amount, price) {
 		return sdk.Coin{}, sdk.Dec{}, types.ErrTooSmallOrder
 	}
+	if err := pair.ValidateOrderAmount(msg.Amount, price); err != nil {
+		return sdk.Coin{}, sdk.Dec{}, err
+	}
 
 	return offerCoin, price, nil
 }
@@ -211,22 +295,33 @@ func (k Keeper) MarketOrder(ctx sdk.Context, msg *types.MsgMarketOrder) (types.O
 
 	ctx.GasMeter().ConsumeGas(k.GetOrderExtraGas(ctx), "OrderExtraGas")
 
-	ctx.EventManager().EmitEvents(sdk.Events{
-		sdk.NewEvent(
-			types.EventTypeMarketOrder,
-			sdk.NewAttribute(types.AttributeKeyOrderer, msg.Orderer),
-			sdk.NewAttribute(types.AttributeKeyPairId, strconv.FormatUint(msg.PairId, 10)),
-			sdk.NewAttribute(types.AttributeKeyOrderDirection, msg.Direction.String()),
-			sdk.NewAttribute(types.AttributeKeyOfferCoin, offerCoin.String()),
-			sdk.NewAttribute(types.AttributeKeyDemandCoinDenom, msg.DemandCoinDenom),
-			sdk.NewAttribute(types.AttributeKeyPrice, price.String()),
-			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
-			sdk.NewAttribute(types.AttributeKeyOrderId, strconv.FormatUint(order.Id, 10)),
-			sdk.NewAttribute(types.AttributeKeyBatchId, strconv.FormatUint(order.BatchId, 10)),
-			sdk.NewAttribute(types.AttributeKeyExpireAt, order.ExpireAt.Format(time.RFC3339)),
-			sdk.NewAttribute(types.AttributeKeyRefundedCoins, refundedCoin.String()),
-		),
-	})
+	k.emitOrderEvent(ctx, &types.EventMarketOrder{
+		Orderer:         msg.Orderer,
+		PairId:          msg.PairId,
+		OrderId:         order.Id,
+		BatchId:         order.BatchId,
+		Direction:       msg.Direction,
+		OfferCoin:       offerCoin,
+		DemandCoinDenom: msg.DemandCoinDenom,
+		Price:           price,
+		Amount:          msg.Amount,
+		ExpireAt:        order.ExpireAt,
+		RefundedCoin:    refundedCoin,
+		OrderRef:        order.Ref(),
+	}, sdk.NewEvent(
+		types.EventTypeMarketOrder,
+		sdk.NewAttribute(types.AttributeKeyOrderer, msg.Orderer),
+		sdk.NewAttribute(types.AttributeKeyPairId, strconv.FormatUint(msg.PairId, 10)),
+		sdk.NewAttribute(types.AttributeKeyOrderDirection, msg.Direction.String()),
+		sdk.NewAttribute(types.AttributeKeyOfferCoin, offerCoin.String()),
+		sdk.NewAttribute(types.AttributeKeyDemandCoinDenom, msg.DemandCoinDenom),
+		sdk.NewAttribute(types.AttributeKeyPrice, price.String()),
+		sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+		sdk.NewAttribute(types.AttributeKeyOrderId, strconv.FormatUint(order.Id, 10)),
+		sdk.NewAttribute(types.AttributeKeyBatchId, strconv.FormatUint(order.BatchId, 10)),
+		sdk.NewAttribute(types.AttributeKeyExpireAt, order.ExpireAt.Format(time.RFC3339)),
+		sdk.NewAttribute(types.AttributeKeyRefundedCoins, refundedCoin.String()),
+	))
 
 	return order, nil
 }
@@ -255,10 +350,13 @@ func (k Keeper) MMOrder(ctx sdk.Context, msg *types.MsgMMOrder) (orders []types.
 	if !found {
 		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "pair %d not found", msg.PairId)
 	}
+	if k.IsPairHalted(ctx, pair) {
+		return nil, sdkerrors.Wrapf(types.ErrPairHalted, "pair %d", pair.Id)
+	}
 
 	var lowestPrice, highestPrice sdk.Dec
 	if pair.LastPrice != nil {
-		lowestPrice, highestPrice = types.PriceLimits(*pair.LastPrice, k.GetMaxPriceLimitRatio(ctx), tickPrec)
+		lowestPrice, highestPrice = k.OrderPriceLimits(ctx, pair)
 	} else {
 		lowestPrice = amm.LowestTick(tickPrec)
 		highestPrice = amm.HighestTick(tickPrec)
@@ -301,6 +399,16 @@ func (k Keeper) MMOrder(ctx sdk.Context, msg *types.MsgMMOrder) (orders []types.
 		}
 	}
 
+	// The amounts of market making orders are split by the chain, so they are
+	// not quantized by the pair's lot size.
+	for _, ticks := range [][]types.MMOrderTick{buyTicks, sellTicks} {
+		for _, tick := range ticks {
+			if err := pair.ValidateMinOrderAmount(tick.Amount, tick.Price); err != nil {
+				return nil, err
+			}
+		}
+	}
+
 	orderer := msg.GetOrderer()
 	spendable := k.bankKeeper.SpendableCoins(ctx, orderer)
 	if spendableAmt := spendable.AmountOf(pair.BaseCoinDenom); spendableAmt.LT(offerBaseCoin.Amount) {
@@ -314,8 +422,7 @@ func (k Keeper) MMOrder(ctx sdk.Context, msg *types.MsgMMOrder) (orders []types.
 			sdk.NewCoin(pair.QuoteCoinDenom, spendableAmt), offerQuoteCoin)
 	}
 
-	maxOrderLifespan := k.GetMaxOrderLifespan(ctx)
-	if msg.OrderLifespan > maxOrderLifespan {
+	if maxOrderLifespan := k.GetPairMaxOrderLifespan(ctx, pair); msg.OrderLifespan > maxOrderLifespan {
 		return nil, sdkerrors.Wrapf(
 			types.ErrTooLongOrderLifespan, "%s is longer than %s", msg.OrderLifespan, maxOrderLifespan)
 	}
@@ -326,6 +433,11 @@ func (k Keeper) MMOrder(ctx sdk.Context, msg *types.MsgMMOrder) (orders []types.
 		return nil, err
 	}
 
+	// The canceled orders are not active anymore, so they are not counted.
+	if err := k.validateNumActiveOrders(ctx, orderer, pair.Id, len(buyTicks)+len(sellTicks)); err != nil {
+		return nil, err
+	}
+
 	if err := k.bankKeeper.SendCoins(ctx, orderer, pair.GetEscrowAddress(), sdk.NewCoins(offerBaseCoin, offerQuoteCoin)); err != nil {
 		return nil, err
 	}
@@ -360,18 +472,28 @@ func (k Keeper) MMOrder(ctx sdk.Context, msg *types.MsgMMOrder) (orders []types.
 	pair.LastOrderId = lastOrderId
 	k.SetPair(ctx, pair)
 
-	k.SetMMOrderIndex(ctx, types.NewMMOrderIndex(orderer, pair.Id, orderIds))
-
-	ctx.EventManager().EmitEvents(sdk.Events{
-		sdk.NewEvent(
-			types.EventTypeMMOrder,
-			sdk.NewAttribute(types.AttributeKeyOrderer, msg.Orderer),
-			sdk.NewAttribute(types.AttributeKeyPairId, strconv.FormatUint(msg.PairId, 10)),
-			sdk.NewAttribute(types.AttributeKeyBatchId, strconv.FormatUint(pair.CurrentBatchId, 10)),
-			sdk.NewAttribute(types.AttributeKeyOrderIds, types.FormatUint64s(orderIds)),
-			sdk.NewAttribute(types.AttributeKeyCanceledOrderIds, types.FormatUint64s(canceledOrderIds)),
-		),
-	})
+	index := types.NewMMOrderIndex(orderer, pair.Id, orderIds)
+	if msg.AutoCancelAfterBlocks > 0 {
+		index.AutoCancelHeight = ctx.BlockHeight() + int64(msg.AutoCancelAfterBlocks)
+	}
+	k.SetMMOrderIndex(ctx, index)
+
+	k.emitOrderEvent(ctx, &types.EventMMOrder{
+		Orderer:          msg.Orderer,
+		PairId:           msg.PairId,
+		BatchId:          pair.CurrentBatchId,
+		OrderIds:         orderIds,
+		CanceledOrderIds: canceledOrderIds,
+		AutoCancelHeight: index.AutoCancelHeight,
+	}, sdk.NewEvent(
+		types.EventTypeMMOrder,
+		sdk.NewAttribute(types.AttributeKeyOrderer, msg.Orderer),
+		sdk.NewAttribute(types.AttributeKeyPairId, strconv.FormatUint(msg.PairId, 10)),
+		sdk.NewAttribute(types.AttributeKeyBatchId, strconv.FormatUint(pair.CurrentBatchId, 10)),
+		sdk.NewAttribute(types.AttributeKeyOrderIds, types.FormatUint64s(orderIds)),
+		sdk.NewAttribute(types.AttributeKeyCanceledOrderIds, types.FormatUint64s(canceledOrderIds)),
+		sdk.NewAttribute(types.AttributeKeyAutoCancelHeight, strconv.FormatInt(index.AutoCancelHeight, 10)),
+	))
 	return
 }
 
@@ -407,34 +529,229 @@ func (k Keeper) CancelOrder(ctx sdk.Context, msg *types.MsgCancelOrder) error {
 		return err
 	}
 
-	ctx.EventManager().EmitEvents(sdk.Events{
-		sdk.NewEvent(
-			types.EventTypeCancelOrder,
-			sdk.NewAttribute(types.AttributeKeyOrderer, msg.Orderer),
-			sdk.NewAttribute(types.AttributeKeyPairId, strconv.FormatUint(msg.PairId, 10)),
-			sdk.NewAttribute(types.AttributeKeyOrderId, strconv.FormatUint(msg.OrderId, 10)),
-		),
-	})
+	k.emitOrderEvent(ctx, &types.EventCancelOrder{
+		Orderer: msg.Orderer,
+		PairId:  msg.PairId,
+		OrderId: msg.OrderId,
+	}, sdk.NewEvent(
+		types.EventTypeCancelOrder,
+		sdk.NewAttribute(types.AttributeKeyOrderer, msg.Orderer),
+		sdk.NewAttribute(types.AttributeKeyPairId, strconv.FormatUint(msg.PairId, 10)),
+		sdk.NewAttribute(types.AttributeKeyOrderId, strconv.FormatUint(msg.OrderId, 10)),
+	))
 
 	return nil
 }
 
+// ValidateMsgRenewOrder validates types.MsgRenewOrder and returns the order
+// along with its new expiration time.
+func (k Keeper) ValidateMsgRenewOrder(ctx sdk.Context, msg *types.MsgRenewOrder) (order types.Order, expireAt time.Time, err error) {
+	var found bool
+	order, found = k.GetOrder(ctx, msg.PairId, msg.OrderId)
+	if !found {
+		return types.Order{}, time.Time{},
+			sdkerrors.Wrapf(sdkerrors.ErrNotFound, "order %d not found in pair %d", msg.OrderId, msg.PairId)
+	}
+	pair, _ := k.GetPair(ctx, msg.PairId)
+	if maxOrderLifespan := k.GetPairMaxOrderLifespan(ctx, pair); msg.OrderLifespan > maxOrderLifespan {
+		return types.Order{}, time.Time{},
+			sdkerrors.Wrapf(types.ErrTooLongOrderLifespan, "%s is longer than %s", msg.OrderLifespan, maxOrderLifespan)
+	}
+	if msg.Orderer != order.Orderer {
+		return types.Order{}, time.Time{}, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "mismatching orderer")
+	}
+	if order.Type == types.OrderTypeMM {
+		return types.Order{}, time.Time{}, sdkerrors.Wrap(types.ErrOrderNotRenewable, "mm orders are replaced by new mm orders")
+	}
+	if !order.Status.IsMatchable() {
+		return types.Order{}, time.Time{}, sdkerrors.Wrapf(types.ErrOrderNotRenewable, "order status is %s", order.Status)
+	}
+	if order.ExpiredAt(ctx.BlockTime()) {
+		return types.Order{}, time.Time{}, sdkerrors.Wrap(types.ErrOrderNotRenewable, "order has already expired")
+	}
+
+	expireAt = ctx.BlockTime().Add(msg.OrderLifespan)
+	if !expireAt.After(order.ExpireAt) {
+		return types.Order{}, time.Time{}, sdkerrors.Wrapf(
+			types.ErrOrderNotRenewable, "new expiration %s is not later than %s",
+			expireAt.Format(time.RFC3339), order.ExpireAt.Format(time.RFC3339))
+	}
+	return order, expireAt, nil
+}
+
+// RenewOrder handles types.MsgRenewOrder and extends the expiration of an
+// open order.
+// The order keeps its id and batch id, so it doesn't lose its time priority.
+func (k Keeper) RenewOrder(ctx sdk.Context, msg *types.MsgRenewOrder) (types.Order, error) {
+	order, expireAt, err := k.ValidateMsgRenewOrder(ctx, msg)
+	if err != nil {
+		return types.Order{}, err
+	}
+
+	order.ExpireAt = expireAt
+	k.SetOrder(ctx, order)
+	k.SetOrderIndex(ctx, order)
+
+	k.emitOrderEvent(ctx, &types.EventRenewOrder{
+		Orderer:  msg.Orderer,
+		PairId:   msg.PairId,
+		OrderId:  msg.OrderId,
+		ExpireAt: order.ExpireAt,
+	}, sdk.NewEvent(
+		types.EventTypeRenewOrder,
+		sdk.NewAttribute(types.AttributeKeyOrderer, msg.Orderer),
+		sdk.NewAttribute(types.AttributeKeyPairId, strconv.FormatUint(msg.PairId, 10)),
+		sdk.NewAttribute(types.AttributeKeyOrderId, strconv.FormatUint(msg.OrderId, 10)),
+		sdk.NewAttribute(types.AttributeKeyExpireAt, order.ExpireAt.Format(time.RFC3339)),
+	))
+
+	return order, nil
+}
+
+// ValidateMsgAmendOrder validates types.MsgAmendOrder and returns the order
+// along with the amended order.
+// The amended order's price is fit into ticks, and its offer coin is adjusted
+// to what the remaining open amount needs at the new price.
+func (k Keeper) ValidateMsgAmendOrder(ctx sdk.Context, msg *types.MsgAmendOrder) (order, amended types.Order, err error) {
+	var found bool
+	order, found = k.GetOrder(ctx, msg.PairId, msg.OrderId)
+	if !found {
+		return types.Order{}, types.Order{},
+			sdkerrors.Wrapf(sdkerrors.ErrNotFound, "order %d not found in pair %d", msg.OrderId, msg.PairId)
+	}
+	if msg.Orderer != order.Orderer {
+		return types.Order{}, types.Order{}, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "mismatching orderer")
+	}
+	if order.Type != types.OrderTypeLimit {
+		return types.Order{}, types.Order{}, sdkerrors.Wrapf(types.ErrOrderNotAmendable, "order type is %s", order.Type)
+	}
+	if !order.Status.IsMatchable() {
+		return types.Order{}, types.Order{}, sdkerrors.Wrapf(types.ErrOrderNotAmendable, "order status is %s", order.Status)
+	}
+	if order.ExpiredAt(ctx.BlockTime()) {
+		return types.Order{}, types.Order{}, sdkerrors.Wrap(types.ErrOrderNotAmendable, "order has already expired")
+	}
+	if msg.Amount.LT(order.Amount) {
+		return types.Order{}, types.Order{}, sdkerrors.Wrapf(
+			types.ErrOrderNotAmendable, "amount %s is smaller than the current amount %s", msg.Amount, order.Amount)
+	}
+
+	pair, _ := k.GetPair(ctx, msg.PairId)
+	if k.IsPairHalted(ctx, pair) {
+		return types.Order{}, types.Order{}, sdkerrors.Wrapf(types.ErrPairHalted, "pair %d", pair.Id)
+	}
+
+	tickPrec := int(k.GetTickPrecision(ctx))
+	var lowerPriceLimit, upperPriceLimit sdk.Dec
+	if pair.LastPrice != nil {
+		lowerPriceLimit, upperPriceLimit = k.OrderPriceLimits(ctx, pair)
+	} else {
+		lowerPriceLimit = amm.LowestTick(tickPrec)
+		upperPriceLimit = amm.HighestTick(tickPrec)
+	}
+	switch {
+	case msg.Price.GT(upperPriceLimit):
+		return types.Order{}, types.Order{}, sdkerrors.Wrapf(types.ErrPriceOutOfRange, "%s is higher than %s", msg.Price, upperPriceLimit)
+	case msg.Price.LT(lowerPriceLimit):
+		return types.Order{}, types.Order{}, sdkerrors.Wrapf(types.ErrPriceOutOfRange, "%s is lower than %s", msg.Price, lowerPriceLimit)
+	}
+
+	amended = order
+	amended.Amount = msg.Amount
+	amended.OpenAmount = order.OpenAmount.Add(msg.Amount.Sub(order.Amount))
+	var remainingOfferCoinAmt sdk.Int
+	switch order.Direction {
+	case types.OrderDirectionBuy:
+		amended.Price = amm.PriceToDownTick(msg.Price, tickPrec)
+		remainingOfferCoinAmt, err = amm.SafeOfferCoinAmount(amm.Buy, amended.Price, amended.OpenAmount)
+		if err != nil {
+			return types.Order{}, types.Order{}, sdkerrors.Wrap(types.ErrTooLargeOrder, "offer coin amount overflows")
+		}
+	case types.OrderDirectionSell:
+		amended.Price = amm.PriceToUpTick(msg.Price, tickPrec)
+		remainingOfferCoinAmt = amended.OpenAmount
+		if _, err := amm.SafeQuoteAmount(amended.Price, amended.Amount); err != nil {
+			return types.Order{}, types.Order{}, sdkerrors.Wrap(types.ErrTooLargeOrder, "quote coin amount overflows")
+		}
+	}
+	if amended.Price.Equal(order.Price) && amended.Amount.Equal(order.Amount) {
+		return types.Order{}, types.Order{}, sdkerrors.Wrap(types.ErrOrderNotAmendable, "neither price nor amount is changed")
+	}
+	if types.IsTooSmallOrderAmount(amended.Amount, amended.Price) {
+		return types.Order{}, types.Order{}, types.ErrTooSmallOrder
+	}
+	if err := pair.ValidateOrderAmount(amended.Amount, amended.Price); err != nil {
+		return types.Order{}, types.Order{}, err
+	}
+
+	amended.RemainingOfferCoin = sdk.NewCoin(order.RemainingOfferCoin.Denom, remainingOfferCoinAmt)
+	amended.OfferCoin = order.OfferCoin.AddAmount(remainingOfferCoinAmt.Sub(order.RemainingOfferCoin.Amount))
+	return order, amended, nil
+}
+
+// AmendOrder handles types.MsgAmendOrder and amends the price and amount of
+// an open limit order.
+// The order keeps its id and batch id, and since the order book is built from
+// the orders at every batch, it is matched at the new price from the next
+// batch.
+// The difference of the remaining offer coin is escrowed from the orderer if
+// the amended order needs more, or refunded to the orderer otherwise.
+func (k Keeper) AmendOrder(ctx sdk.Context, msg *types.MsgAmendOrder) (types.Order, error) {
+	order, amended, err := k.ValidateMsgAmendOrder(ctx, msg)
+	if err != nil {
+		return types.Order{}, err
+	}
+
+	pair, _ := k.GetPair(ctx, msg.PairId)
+	escrowedCoin := sdk.NewCoin(order.RemainingOfferCoin.Denom, sdk.ZeroInt())
+	refundedCoin := escrowedCoin
+	switch diff := amended.RemainingOfferCoin.Amount.Sub(order.RemainingOfferCoin.Amount); {
+	case diff.IsPositive():
+		escrowedCoin = escrowedCoin.AddAmount(diff)
+		if err := k.bankKeeper.SendCoins(ctx, msg.GetOrderer(), pair.GetEscrowAddress(), sdk.NewCoins(escrowedCoin)); err != nil {
+			return types.Order{}, err
+		}
+	case diff.IsNegative():
+		refundedCoin = refundedCoin.AddAmount(diff.Neg())
+		if err := k.bankKeeper.SendCoins(ctx, pair.GetEscrowAddress(), msg.GetOrderer(), sdk.NewCoins(refundedCoin)); err != nil {
+			return types.Order{}, err
+		}
+	}
+
+	k.SetOrder(ctx, amended)
+
+	// The legacy order events are deprecated, so only the typed event is
+	// emitted.
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventAmendOrder{
+		Orderer:      msg.Orderer,
+		PairId:       msg.PairId,
+		OrderId:      msg.OrderId,
+		Price:        amended.Price,
+		Amount:       amended.Amount,
+		OpenAmount:   amended.OpenAmount,
+		EscrowedCoin: escrowedCoin,
+		RefundedCoin: refundedCoin,
+	}); err != nil {
+		return types.Order{}, err
+	}
+
+	return amended, nil
+}
+
 // CancelAllOrders handles types.MsgCancelAllOrders and cancels all orders.
 func (k Keeper) CancelAllOrders(ctx sdk.Context, msg *types.MsgCancelAllOrders) error {
 	orderPairCache := map[uint64]types.Pair{} // maps order's pair id to pair, to cache the result
 	pairIdSet := map[uint64]struct{}{}        // set of pairs where to cancel orders
-	var pairIds []string                      // needed to emit an event
 	for _, pairId := range msg.PairIds {
 		pair, found := k.GetPair(ctx, pairId)
 		if !found { // check if the pair exists
 			return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "pair %d not found", pairId)
 		}
 		pairIdSet[pairId] = struct{}{} // add pair id to the set
-		pairIds = append(pairIds, strconv.FormatUint(pairId, 10))
-		orderPairCache[pairId] = pair // also cache the pair to use at below
+		orderPairCache[pairId] = pair  // also cache the pair to use at below
 	}
 
-	var canceledOrderIds []string
+	var canceledOrderIds []uint64
 	if err := k.IterateOrdersByOrderer(ctx, msg.GetOrderer(), func(order types.Order) (stop bool, err error) {
 		_, ok := pairIdSet[order.PairId] // is the pair included in the pair set?
 		if len(pairIdSet) == 0 || ok {   // pair ids not specified(cancel all), or the pair is in the set
@@ -447,7 +764,7 @@ func (k Keeper) CancelAllOrders(ctx sdk.Context, msg *types.MsgCancelAllOrders)
 				if err := k.FinishOrder(ctx, order, types.OrderStatusCanceled); err != nil {
 					return false, err
 				}
-				canceledOrderIds = append(canceledOrderIds, strconv.FormatUint(order.Id, 10))
+				canceledOrderIds = append(canceledOrderIds, order.Id)
 			}
 		}
 		return false, nil
@@ -455,14 +772,16 @@ func (k Keeper) CancelAllOrders(ctx sdk.Context, msg *types.MsgCancelAllOrders)
 		return err
 	}
 
-	ctx.EventManager().EmitEvents(sdk.Events{
-		sdk.NewEvent(
-			types.EventTypeCancelAllOrders,
-			sdk.NewAttribute(types.AttributeKeyOrderer, msg.Orderer),
-			sdk.NewAttribute(types.AttributeKeyPairIds, strings.Join(pairIds, ",")),
-			sdk.NewAttribute(types.AttributeKeyCanceledOrderIds, strings.Join(canceledOrderIds, ",")),
-		),
-	})
+	k.emitOrderEvent(ctx, &types.EventCancelAllOrders{
+		Orderer:          msg.Orderer,
+		PairIds:          msg.PairIds,
+		CanceledOrderIds: canceledOrderIds,
+	}, sdk.NewEvent(
+		types.EventTypeCancelAllOrders,
+		sdk.NewAttribute(types.AttributeKeyOrderer, msg.Orderer),
+		sdk.NewAttribute(types.AttributeKeyPairIds, types.FormatUint64s(msg.PairIds)),
+		sdk.NewAttribute(types.AttributeKeyCanceledOrderIds, types.FormatUint64s(canceledOrderIds)),
+	))
 
 	return nil
 }
@@ -506,20 +825,106 @@ func (k Keeper) CancelMMOrder(ctx sdk.Context, msg *types.MsgCancelMMOrder) (can
 		return
 	}
 
-	ctx.EventManager().EmitEvents(sdk.Events{
-		sdk.NewEvent(
-			types.EventTypeCancelMMOrder,
-			sdk.NewAttribute(types.AttributeKeyOrderer, msg.Orderer),
-			sdk.NewAttribute(types.AttributeKeyPairId, strconv.FormatUint(pair.Id, 10)),
-			sdk.NewAttribute(types.AttributeKeyCanceledOrderIds, types.FormatUint64s(canceledOrderIds)),
-		),
-	})
+	k.emitOrderEvent(ctx, &types.EventCancelMMOrder{
+		Orderer:          msg.Orderer,
+		PairId:           pair.Id,
+		CanceledOrderIds: canceledOrderIds,
+	}, sdk.NewEvent(
+		types.EventTypeCancelMMOrder,
+		sdk.NewAttribute(types.AttributeKeyOrderer, msg.Orderer),
+		sdk.NewAttribute(types.AttributeKeyPairId, strconv.FormatUint(pair.Id, 10)),
+		sdk.NewAttribute(types.AttributeKeyCanceledOrderIds, types.FormatUint64s(canceledOrderIds)),
+	))
 
 	return canceledOrderIds, nil
 }
 
+// CancelStaleMMOrders cancels market making orders which have not been
+// refreshed by another types.MsgMMOrder until their auto cancel height.
+func (k Keeper) CancelStaleMMOrders(ctx sdk.Context) {
+	var indexes []types.MMOrderIndex
+	_ = k.IterateAllMMOrderIndexes(ctx, func(index types.MMOrderIndex) (stop bool, err error) {
+		if index.AutoCancelHeight > 0 && ctx.BlockHeight() >= index.AutoCancelHeight {
+			indexes = append(indexes, index)
+		}
+		return false, nil
+	})
+
+	for _, index := range indexes {
+		pair, _ := k.GetPair(ctx, index.PairId)
+		cacheCtx, writeCache := ctx.CacheContext()
+		canceledOrderIds, err := k.cancelMMOrder(cacheCtx, index.GetOrderer(), pair, true)
+		if err != nil {
+			// The orders can't be canceled within the batch they were placed,
+			// so try again in the next block.
+			continue
+		}
+		writeCache()
+
+		k.emitOrderEvent(ctx, &types.EventAutoCancelMMOrder{
+			Orderer:          index.Orderer,
+			PairId:           index.PairId,
+			CanceledOrderIds: canceledOrderIds,
+		}, sdk.NewEvent(
+			types.EventTypeAutoCancelMMOrder,
+			sdk.NewAttribute(types.AttributeKeyOrderer, index.Orderer),
+			sdk.NewAttribute(types.AttributeKeyPairId, strconv.FormatUint(index.PairId, 10)),
+			sdk.NewAttribute(types.AttributeKeyCanceledOrderIds, types.FormatUint64s(canceledOrderIds)),
+		))
+	}
+}
+
 func (k Keeper) ExecuteMatching(ctx sdk.Context, pair types.Pair) error {
-	ob := amm.NewOrderBook()
+	// Orders are accumulated until the bootstrap auction, which is a single
+	// uniform-price matching executed at the end of the bootstrap phase.
+	if pair.IsBootstrapping() {
+		pair.CurrentBatchId++
+		k.SetPair(ctx, pair)
+		return nil
+	}
+	// Matching of a pair halted by the circuit breaker is skipped, but the
+	// batch id still advances so that orders can be canceled.
+	if k.IsPairHalted(ctx, pair) {
+		pair.CurrentBatchId++
+		k.SetPair(ctx, pair)
+		return nil
+	}
+
+	ob, pools, sources, err := k.prepareMatching(ctx, pair)
+	if err != nil {
+		return err
+	}
+
+	matchPrice, quoteCoinDiff, matched := k.Match(ctx, pair, ob, pools, sources)
+	if matched {
+		orders := ob.Orders()
+		if err := k.ApplyMatchResult(ctx, pair, matchPrice, orders, quoteCoinDiff); err != nil {
+			return err
+		}
+		pair.LastPrice = &matchPrice
+		pair.NumBatchesWithoutTrade = 0
+	} else {
+		k.RecordBatchResult(ctx, types.NewBatchResult(pair.Id, pair.CurrentBatchId, ctx.BlockHeight(), ctx.BlockTime()))
+		// The price band widens only around the last price.
+		if pair.LastPrice != nil {
+			pair.NumBatchesWithoutTrade++
+		}
+	}
+
+	pair.CurrentBatchId++
+	k.SetPair(ctx, pair)
+	k.RecordPriceHistory(ctx, pair)
+
+	return nil
+}
+
+// prepareMatching builds the order book of the pair's open user orders and
+// collects the pools and order sources, including the pair's vaults,
+// participating in the matching.
+// Expired orders are finished and depleted pools are disabled along the way.
+func (k Keeper) prepareMatching(ctx sdk.Context, pair types.Pair) (ob *amm.OrderBook, pools []*types.PoolOrderer, sources []amm.OrderSource, err error) {
+	ob = amm.NewOrderBook()
+	ob.SetMakerPriority(k.GetMakerPriority(ctx))
 
 	if err := k.IterateOrdersByPair(ctx, pair.Id, func(order types.Order) (stop bool, err error) {
 		switch order.Status {
@@ -532,22 +937,21 @@ func (k Keeper) ExecuteMatching(ctx sdk.Context, pair types.Pair) error {
 				}
 				return false, nil
 			}
-			// TODO: add orders only when price is in the range?
-			ob.AddOrder(types.NewUserOrder(order))
 			if order.Status == types.OrderStatusNotExecuted {
 				order.SetStatus(types.OrderStatusNotMatched)
 				k.SetOrder(ctx, order)
 			}
+			// TODO: add orders only when price is in the range?
+			ob.AddOrder(types.NewUserOrder(order))
 		case types.OrderStatusCanceled:
 		default:
 			return false, fmt.Errorf("invalid order status: %s", order.Status)
 		}
 		return false, nil
 	}); err != nil {
-		return err
+		return nil, nil, nil, err
 	}
 
-	var pools []*types.PoolOrderer
 	_ = k.IteratePoolsByPair(ctx, pair.Id, func(pool types.Pool) (stop bool, err error) {
 		if pool.Disabled {
 			return false, nil
@@ -565,72 +969,127 @@ func (k Keeper) ExecuteMatching(ctx sdk.Context, pair types.Pair) error {
 		return false, nil
 	})
 
-	matchPrice, quoteCoinDiff, matched := k.Match(ctx, ob, pools, pair.LastPrice)
-	if matched {
-		orders := ob.Orders()
-		if err := k.ApplyMatchResult(ctx, pair, orders, quoteCoinDiff); err != nil {
-			return err
+	for _, source := range k.OrderSources() {
+		pairSource, found := source.PairOrderSource(ctx, pair)
+		if found {
+			sources = append(sources, pairSource)
 		}
-		pair.LastPrice = &matchPrice
 	}
 
-	pair.CurrentBatchId++
-	k.SetPair(ctx, pair)
+	_ = k.IterateVaultsByPair(ctx, pair.Id, func(vault types.Vault) (stop bool, err error) {
+		if vaultSource, found := k.vaultOrderSource(ctx, pair, vault); found {
+			sources = append(sources, vaultSource)
+		}
+		return false, nil
+	})
 
-	return nil
+	return ob, pools, sources, nil
 }
 
-func (k Keeper) Match(ctx sdk.Context, ob *amm.OrderBook, pools []*types.PoolOrderer, lastPrice *sdk.Dec) (matchPrice sdk.Dec, quoteCoinDiff sdk.Int, matched bool) {
-	tickPrec := int(k.GetTickPrecision(ctx))
-	if lastPrice == nil {
-		ov := amm.MultipleOrderViews{ob.MakeView()}
-		for _, pool := range pools {
-			ov = append(ov, pool)
-		}
-		var found bool
-		matchPrice, found = amm.FindMatchPrice(ov, tickPrec)
-		if !found {
-			return sdk.Dec{}, sdk.Int{}, false
+// SimulateMatching simulates the matching of the pair's next batch with
+// the order added to the order book, without changing the state.
+// It returns the match price of the batch and the order after the matching.
+func (k Keeper) SimulateMatching(ctx sdk.Context, pair types.Pair, order *types.UserOrder) (matchPrice sdk.Dec, matched bool, err error) {
+	cacheCtx, _ := ctx.CacheContext()
+	var overflow bool
+	utils.SafeMath(func() {
+		var (
+			ob      *amm.OrderBook
+			pools   []*types.PoolOrderer
+			sources []amm.OrderSource
+		)
+		ob, pools, sources, err = k.prepareMatching(cacheCtx, pair)
+		if err != nil {
+			return
 		}
-		for _, pool := range pools {
-			buyAmt := pool.BuyAmountOver(matchPrice, true)
-			if buyAmt.IsPositive() {
-				ob.AddOrder(pool.Order(amm.Buy, matchPrice, buyAmt))
-			}
-			sellAmt := pool.SellAmountUnder(matchPrice, true)
-			if sellAmt.IsPositive() {
-				ob.AddOrder(pool.Order(amm.Sell, matchPrice, sellAmt))
-			}
+		ob.AddOrder(order)
+		matchPrice, _, matched = k.Match(cacheCtx, pair, ob, pools, sources)
+	}, func() {
+		overflow = true
+	})
+	if overflow {
+		return sdk.Dec{}, false, fmt.Errorf("overflow occurred during matching")
+	}
+	return matchPrice, matched, err
+}
+
+// SafeExecuteMatching runs ExecuteMatching in a cached context.
+// An arithmetic overflow during the matching, which can only be caused by
+// orders with extreme prices and amounts, discards the matching result of
+// the pair in this batch instead of halting the chain.
+// Orders of the pair are kept so that they are expired by their lifespan.
+func (k Keeper) SafeExecuteMatching(ctx sdk.Context, pair types.Pair) error {
+	cacheCtx, writeCache := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
+	var (
+		err      error
+		overflow bool
+	)
+	utils.SafeMath(func() {
+		err = k.ExecuteMatching(cacheCtx, pair)
+	}, func() {
+		overflow = true
+	})
+	if !overflow {
+		if err != nil {
+			return err
 		}
-		quoteCoinDiff, matched = ob.MatchAtSinglePrice(matchPrice)
-	} else {
-		lowestPrice, highestPrice := k.PriceLimits(ctx, *lastPrice)
-		for _, pool := range pools {
-			poolOrders := amm.PoolOrders(pool, pool, lowestPrice, highestPrice, tickPrec)
-			ob.AddOrder(poolOrders...)
+		writeCache()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		return nil
+	}
+
+	k.Logger(ctx).Error("overflow occurred during matching", "pair_id", pair.Id, "batch_id", pair.CurrentBatchId)
+	if err := k.IterateOrdersByPair(ctx, pair.Id, func(order types.Order) (stop bool, err error) {
+		if order.Status == types.OrderStatusNotExecuted {
+			order.SetStatus(types.OrderStatusNotMatched)
+			k.SetOrder(ctx, order)
 		}
-		matchPrice, quoteCoinDiff, matched = ob.Match(*lastPrice)
+		return false, nil
+	}); err != nil {
+		return err
 	}
-	return
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeMatchingOverflow,
+			sdk.NewAttribute(types.AttributeKeyPairId, strconv.FormatUint(pair.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyBatchId, strconv.FormatUint(pair.CurrentBatchId, 10)),
+		),
+	})
+	pair.CurrentBatchId++
+	k.SetPair(ctx, pair)
+	k.RecordPriceHistory(ctx, pair)
+	return nil
 }
 
-func (k Keeper) ApplyMatchResult(ctx sdk.Context, pair types.Pair, orders []amm.Order, quoteCoinDiff sdk.Int) error {
-	bulkOp := types.NewBulkSendCoinsOperation()
+// Match matches the orders of the pair's batch using amm.MatchBatch with the
+// module's params and the pair's overrides.
+func (k Keeper) Match(ctx sdk.Context, pair types.Pair, ob *amm.OrderBook, pools []*types.PoolOrderer, sources []amm.OrderSource) (matchPrice sdk.Dec, quoteCoinDiff sdk.Int, matched bool) {
+	ammPools := make([]amm.PoolOrderer, len(pools))
+	for i, pool := range pools {
+		ammPools[i] = pool
+	}
+	var upward, downward sdk.Dec
+	if pair.LastPrice != nil {
+		upward, downward = k.GetPairPriceLimitRatios(ctx, pair)
+	}
+	return amm.MatchBatch(ob, ammPools, sources, pair.LastPrice, upward, downward, int(k.GetTickPrecision(ctx)))
+}
+
+func (k Keeper) ApplyMatchResult(ctx sdk.Context, pair types.Pair, matchPrice sdk.Dec, orders []amm.Order, quoteCoinDiff sdk.Int) error {
+	// All transfers of the batch are netted and settled at once, so the coins
+	// paid by pools and order sources to the escrow are delivered directly
+	// to the counterparties.
+	bulkOp := types.NewNettingSendCoinsOperation()
+	// The offer coins of user orders are already in the escrow, and the other
+	// orders, such as pool orders and source orders, pay from their orderers.
 	for _, order := range orders { // TODO: need optimization to filter matched orders only
-		order, ok := order.(*types.PoolOrder)
-		if !ok {
-			continue
-		}
-		if !order.IsMatched() {
+		if _, ok := order.(*types.UserOrder); ok || !order.IsMatched() {
 			continue
 		}
-		paidCoin := sdk.NewCoin(order.OfferCoinDenom, order.PaidOfferCoinAmount)
-		bulkOp.QueueSendCoins(order.ReserveAddress, pair.GetEscrowAddress(), sdk.NewCoins(paidCoin))
+		paidCoin := sdk.NewCoin(pair.OfferCoinDenom(order.GetDirection()), order.GetPaidOfferCoinAmount())
+		bulkOp.QueueSendCoins(order.GetOrderer(), pair.GetEscrowAddress(), sdk.NewCoins(paidCoin))
 	}
-	if err := bulkOp.Run(ctx, k.bankKeeper); err != nil {
-		return err
-	}
-	bulkOp = types.NewBulkSendCoinsOperation()
 	type PoolMatchResult struct {
 		PoolId         uint64
 		OrderDirection types.OrderDirection
@@ -640,51 +1099,96 @@ func (k Keeper) ApplyMatchResult(ctx sdk.Context, pair types.Pair, orders []amm.
 	}
 	poolMatchResultById := map[uint64]*PoolMatchResult{}
 	var poolMatchResults []*PoolMatchResult
+	type UserMatchResult struct {
+		Orderer      sdk.AccAddress
+		OrderId      uint64
+		PaidCoin     sdk.Coin
+		ReceivedCoin sdk.Coin
+	}
+	var userMatchResults []UserMatchResult
+	swapFeeRate := k.GetSwapFeeRate(ctx)
+	swapFees := sdk.Coins{}
+	baseVolume, quoteVolume := sdk.ZeroInt(), sdk.ZeroInt()
+	var numMatchedOrders, numFullyMatchedOrders uint64
 	for _, order := range orders {
 		if !order.IsMatched() {
 			continue
 		}
 
 		matchedAmt := order.GetAmount().Sub(order.GetOpenAmount())
+		// Every trade has a buy side, so the volumes are summed up from the
+		// buy orders only.
+		if order.GetDirection() == amm.Buy {
+			baseVolume = baseVolume.Add(matchedAmt)
+			quoteVolume = quoteVolume.Add(order.GetPaidOfferCoinAmount())
+		}
 
-		switch order := order.(type) {
-		case *types.UserOrder:
+		if order, ok := order.(*types.UserOrder); ok {
 			paidCoin := sdk.NewCoin(order.OfferCoinDenom, order.PaidOfferCoinAmount)
-			receivedCoin := sdk.NewCoin(order.DemandCoinDenom, order.ReceivedDemandCoinAmount)
+			swapFee := sdk.NewCoin(order.DemandCoinDenom, order.ReceivedDemandCoinAmount.ToDec().Mul(swapFeeRate).TruncateInt())
+			receivedCoin := sdk.NewCoin(order.DemandCoinDenom, order.ReceivedDemandCoinAmount.Sub(swapFee.Amount))
+			swapFees = swapFees.Add(swapFee)
 
-			o, _ := k.GetOrder(ctx, pair.Id, order.OrderId)
+			var o types.Order
+			if order.Record != nil {
+				o = *order.Record
+			} else {
+				o, _ = k.GetOrder(ctx, pair.Id, order.OrderId)
+			}
+			numMatchedOrders++
 			o.OpenAmount = o.OpenAmount.Sub(matchedAmt)
 			o.RemainingOfferCoin = o.RemainingOfferCoin.Sub(paidCoin)
 			o.ReceivedCoin = o.ReceivedCoin.Add(receivedCoin)
 
 			if o.OpenAmount.IsZero() {
-				if err := k.FinishOrder(ctx, o, types.OrderStatusCompleted); err != nil {
-					return err
+				// The remaining offer coin is refunded along with the other
+				// transfers of the batch.
+				if o.RemainingOfferCoin.IsPositive() {
+					bulkOp.QueueSendCoins(pair.GetEscrowAddress(), order.Orderer, sdk.NewCoins(o.RemainingOfferCoin))
 				}
+				k.markOrderFinished(ctx, o, types.OrderStatusCompleted, "")
+				numFullyMatchedOrders++
 			} else {
 				o.SetStatus(types.OrderStatusPartiallyMatched)
 				k.SetOrder(ctx, o)
 			}
 			bulkOp.QueueSendCoins(pair.GetEscrowAddress(), order.Orderer, sdk.NewCoins(receivedCoin))
-
-			ctx.EventManager().EmitEvents(sdk.Events{
-				sdk.NewEvent(
-					types.EventTypeUserOrderMatched,
-					sdk.NewAttribute(types.AttributeKeyOrderDirection, types.OrderDirectionFromAMM(order.Direction).String()),
-					sdk.NewAttribute(types.AttributeKeyOrderer, order.Orderer.String()),
-					sdk.NewAttribute(types.AttributeKeyPairId, strconv.FormatUint(pair.Id, 10)),
-					sdk.NewAttribute(types.AttributeKeyOrderId, strconv.FormatUint(order.OrderId, 10)),
-					sdk.NewAttribute(types.AttributeKeyMatchedAmount, matchedAmt.String()),
-					sdk.NewAttribute(types.AttributeKeyPaidCoin, paidCoin.String()),
-					sdk.NewAttribute(types.AttributeKeyReceivedCoin, receivedCoin.String()),
-				),
+			userMatchResults = append(userMatchResults, UserMatchResult{
+				Orderer:      order.Orderer,
+				OrderId:      order.OrderId,
+				PaidCoin:     paidCoin,
+				ReceivedCoin: receivedCoin,
 			})
-		case *types.PoolOrder:
-			paidCoin := sdk.NewCoin(order.OfferCoinDenom, order.PaidOfferCoinAmount)
-			receivedCoin := sdk.NewCoin(order.DemandCoinDenom, order.ReceivedDemandCoinAmount)
 
-			bulkOp.QueueSendCoins(pair.GetEscrowAddress(), order.ReserveAddress, sdk.NewCoins(receivedCoin))
+			k.emitOrderEvent(ctx, &types.EventUserOrderMatched{
+				Orderer:       order.Orderer.String(),
+				PairId:        pair.Id,
+				OrderId:       order.OrderId,
+				Direction:     types.OrderDirectionFromAMM(order.Direction),
+				MatchedAmount: matchedAmt,
+				PaidCoin:      paidCoin,
+				ReceivedCoin:  receivedCoin,
+				SwapFee:       swapFee,
+			}, sdk.NewEvent(
+				types.EventTypeUserOrderMatched,
+				sdk.NewAttribute(types.AttributeKeyOrderDirection, types.OrderDirectionFromAMM(order.Direction).String()),
+				sdk.NewAttribute(types.AttributeKeyOrderer, order.Orderer.String()),
+				sdk.NewAttribute(types.AttributeKeyPairId, strconv.FormatUint(pair.Id, 10)),
+				sdk.NewAttribute(types.AttributeKeyOrderId, strconv.FormatUint(order.OrderId, 10)),
+				sdk.NewAttribute(types.AttributeKeyMatchedAmount, matchedAmt.String()),
+				sdk.NewAttribute(types.AttributeKeyPaidCoin, paidCoin.String()),
+				sdk.NewAttribute(types.AttributeKeyReceivedCoin, receivedCoin.String()),
+				sdk.NewAttribute(types.AttributeKeySwapFee, swapFee.String()),
+			))
+			continue
+		}
+
+		paidCoin := sdk.NewCoin(pair.OfferCoinDenom(order.GetDirection()), order.GetPaidOfferCoinAmount())
+		receivedCoin := sdk.NewCoin(order.GetDemandCoinDenom(), order.GetReceivedDemandCoinAmount())
+		bulkOp.QueueSendCoins(pair.GetEscrowAddress(), order.GetOrderer(), sdk.NewCoins(receivedCoin))
 
+		switch order := order.(type) {
+		case *types.PoolOrder:
 			r, ok := poolMatchResultById[order.PoolId]
 			if !ok {
 				r = &PoolMatchResult{
@@ -704,14 +1208,48 @@ func (k Keeper) ApplyMatchResult(ctx sdk.Context, pair types.Pair, orders []amm.
 			r.PaidCoin = r.PaidCoin.Add(paidCoin)
 			r.ReceivedCoin = r.ReceivedCoin.Add(receivedCoin)
 			r.MatchedAmount = r.MatchedAmount.Add(matchedAmt)
-		default:
-			panic(fmt.Errorf("invalid order type: %T", order))
+		case *types.SourceOrder:
+			ctx.EventManager().EmitEvents(sdk.Events{
+				sdk.NewEvent(
+					types.EventTypeSourceOrderMatched,
+					sdk.NewAttribute(types.AttributeKeyOrderDirection, types.OrderDirectionFromAMM(order.Direction).String()),
+					sdk.NewAttribute(types.AttributeKeyPairId, strconv.FormatUint(pair.Id, 10)),
+					sdk.NewAttribute(types.AttributeKeySourceName, order.SourceName),
+					sdk.NewAttribute(types.AttributeKeyMatchedAmount, matchedAmt.String()),
+					sdk.NewAttribute(types.AttributeKeyPaidCoin, paidCoin.String()),
+					sdk.NewAttribute(types.AttributeKeyReceivedCoin, receivedCoin.String()),
+				),
+			})
 		}
 	}
 	bulkOp.QueueSendCoins(pair.GetEscrowAddress(), k.GetDustCollector(ctx), sdk.NewCoins(sdk.NewCoin(pair.QuoteCoinDenom, quoteCoinDiff)))
+	if !swapFees.IsZero() {
+		k.queueSwapFees(ctx, bulkOp, pair, swapFees)
+	}
 	if err := bulkOp.Run(ctx, k.bankKeeper); err != nil {
 		return err
 	}
+	if !swapFees.IsZero() {
+		accruedFees, found := k.GetAccruedSwapFees(ctx, pair.Id)
+		if !found {
+			accruedFees = types.NewAccruedSwapFees(pair.Id)
+		}
+		accruedFees.Fees = accruedFees.Fees.Add(swapFees...)
+		k.SetAccruedSwapFees(ctx, accruedFees)
+	}
+	k.RecordPairStats(ctx, pair.Id, matchPrice, baseVolume, quoteVolume, numMatchedOrders, swapFees)
+	batchResult := types.NewBatchResult(pair.Id, pair.CurrentBatchId, ctx.BlockHeight(), ctx.BlockTime())
+	batchResult.Matched = true
+	batchResult.MatchPrice = &matchPrice
+	batchResult.MatchedBaseAmount = baseVolume
+	batchResult.MatchedQuoteAmount = quoteVolume
+	batchResult.NumFullyMatchedOrders = numFullyMatchedOrders
+	batchResult.NumPartiallyMatchedOrders = numMatchedOrders - numFullyMatchedOrders
+	k.RecordBatchResult(ctx, batchResult)
+	// The hooks are called after the coins are transferred.
+	for _, r := range userMatchResults {
+		k.afterSwapMatched(ctx, r.Orderer, pair.Id, r.OrderId, r.PaidCoin, r.ReceivedCoin)
+	}
 	for _, r := range poolMatchResults {
 		ctx.EventManager().EmitEvents(sdk.Events{
 			sdk.NewEvent(
@@ -728,7 +1266,63 @@ func (k Keeper) ApplyMatchResult(ctx sdk.Context, pair types.Pair, orders []amm.
 	return nil
 }
 
+// queueSwapFees queues send operations for the swap fees collected in the
+// pair's escrow.
+// If SwapFeeToPools param is set, the fees are distributed to the pair's active
+// pools in proportion to each pool's reserve of the fee denom.
+// Otherwise, or if there's a remainder after the distribution, the fees are
+// sent to the fee collector.
+func (k Keeper) queueSwapFees(ctx sdk.Context, bulkOp *types.NettingSendCoinsOperation, pair types.Pair, fees sdk.Coins) {
+	feeCollector := k.GetFeeCollector(ctx)
+	if !k.GetSwapFeeToPools(ctx) {
+		bulkOp.QueueSendCoins(pair.GetEscrowAddress(), feeCollector, fees)
+		return
+	}
+
+	var pools []types.Pool
+	var reserves []sdk.Coins
+	_ = k.IteratePoolsByPair(ctx, pair.Id, func(pool types.Pool) (stop bool, err error) {
+		if pool.Disabled {
+			return false, nil
+		}
+		rx, ry := k.getPoolBalances(ctx, pool, pair)
+		pools = append(pools, pool)
+		reserves = append(reserves, sdk.NewCoins(rx, ry))
+		return false, nil
+	})
+
+	// If pool fee sweeping is enabled, fees are held in each pool's fee
+	// address until they are swept into the pool's reserve.
+	sweepEnabled := k.GetPoolFeeSweepEpoch(ctx) > 0
+
+	for _, fee := range fees {
+		totalReserve := sdk.ZeroInt()
+		for _, reserve := range reserves {
+			totalReserve = totalReserve.Add(reserve.AmountOf(fee.Denom))
+		}
+		remaining := fee.Amount
+		if totalReserve.IsPositive() {
+			for i, pool := range pools {
+				amt := fee.Amount.Mul(reserves[i].AmountOf(fee.Denom)).Quo(totalReserve)
+				feeAddr := pool.GetReserveAddress()
+				if sweepEnabled {
+					feeAddr = types.PoolFeeAddress(pool.Id)
+				}
+				bulkOp.QueueSendCoins(pair.GetEscrowAddress(), feeAddr, sdk.NewCoins(sdk.NewCoin(fee.Denom, amt)))
+				remaining = remaining.Sub(amt)
+			}
+		}
+		bulkOp.QueueSendCoins(pair.GetEscrowAddress(), feeCollector, sdk.NewCoins(sdk.NewCoin(fee.Denom, remaining)))
+	}
+}
+
 func (k Keeper) FinishOrder(ctx sdk.Context, order types.Order, status types.OrderStatus) error {
+	return k.finishOrder(ctx, order, status, "")
+}
+
+// finishOrder is the same as FinishOrder, except that the failure reason is
+// recorded in the order's result.
+func (k Keeper) finishOrder(ctx sdk.Context, order types.Order, status types.OrderStatus, reason types.FailureReason) error {
 	if order.Status == types.OrderStatusCompleted || order.Status.IsCanceledOrExpired() { // sanity check
 		return nil
 	}
@@ -740,24 +1334,101 @@ func (k Keeper) FinishOrder(ctx sdk.Context, order types.Order, status types.Ord
 		}
 	}
 
+	k.markOrderFinished(ctx, order, status, reason)
+
+	return nil
+}
+
+// queueFinishOrder is the same as FinishOrder, except that the refund of the
+// remaining offer coin is queued to bulkOp instead of being sent immediately.
+func (k Keeper) queueFinishOrder(
+	ctx sdk.Context, pair types.Pair, order types.Order, status types.OrderStatus, bulkOp *types.BulkSendCoinsOperation) {
+	if order.Status == types.OrderStatusCompleted || order.Status.IsCanceledOrExpired() { // sanity check
+		return
+	}
+
+	if order.RemainingOfferCoin.IsPositive() {
+		bulkOp.QueueSendCoins(pair.GetEscrowAddress(), order.GetOrderer(), sdk.NewCoins(order.RemainingOfferCoin))
+	}
+
+	k.markOrderFinished(ctx, order, status, "")
+}
+
+// markOrderFinished sets the order's status to the finished status, records
+// the order's result and emits an order result event, as well as an order
+// expired event if the order has expired at the end of its lifespan.
+// The caller is responsible for refunding the remaining offer coin.
+func (k Keeper) markOrderFinished(ctx sdk.Context, order types.Order, status types.OrderStatus, reason types.FailureReason) {
 	order.SetStatus(status)
 	k.SetOrder(ctx, order)
-
-	ctx.EventManager().EmitEvents(sdk.Events{
-		sdk.NewEvent(
-			types.EventTypeOrderResult,
-			sdk.NewAttribute(types.AttributeKeyOrderDirection, order.Direction.String()),
+	k.SetRequestResult(ctx, types.NewOrderResult(order, reason, ctx.BlockHeight(), ctx.BlockTime()))
+
+	k.emitOrderEvent(ctx, &types.EventOrderResult{
+		Orderer:            order.Orderer,
+		PairId:             order.PairId,
+		OrderId:            order.Id,
+		Direction:          order.Direction,
+		Amount:             order.Amount,
+		OpenAmount:         order.OpenAmount,
+		OfferCoin:          order.OfferCoin,
+		RemainingOfferCoin: order.RemainingOfferCoin,
+		ReceivedCoin:       order.ReceivedCoin,
+		Status:             order.Status,
+	}, sdk.NewEvent(
+		types.EventTypeOrderResult,
+		sdk.NewAttribute(types.AttributeKeyOrderDirection, order.Direction.String()),
+		sdk.NewAttribute(types.AttributeKeyOrderer, order.Orderer),
+		sdk.NewAttribute(types.AttributeKeyPairId, strconv.FormatUint(order.PairId, 10)),
+		sdk.NewAttribute(types.AttributeKeyOrderId, strconv.FormatUint(order.Id, 10)),
+		sdk.NewAttribute(types.AttributeKeyAmount, order.Amount.String()),
+		sdk.NewAttribute(types.AttributeKeyOpenAmount, order.OpenAmount.String()),
+		sdk.NewAttribute(types.AttributeKeyOfferCoin, order.OfferCoin.String()),
+		sdk.NewAttribute(types.AttributeKeyRemainingOfferCoin, order.RemainingOfferCoin.String()),
+		sdk.NewAttribute(types.AttributeKeyReceivedCoin, order.ReceivedCoin.String()),
+		sdk.NewAttribute(types.AttributeKeyStatus, order.Status.String()),
+	))
+	if status == types.OrderStatusExpired && reason == "" {
+		k.emitOrderEvent(ctx, &types.EventOrderExpired{
+			Orderer:      order.Orderer,
+			PairId:       order.PairId,
+			OrderId:      order.Id,
+			RefundedCoin: order.RemainingOfferCoin,
+		}, sdk.NewEvent(
+			types.EventTypeOrderExpired,
 			sdk.NewAttribute(types.AttributeKeyOrderer, order.Orderer),
 			sdk.NewAttribute(types.AttributeKeyPairId, strconv.FormatUint(order.PairId, 10)),
 			sdk.NewAttribute(types.AttributeKeyOrderId, strconv.FormatUint(order.Id, 10)),
-			sdk.NewAttribute(types.AttributeKeyAmount, order.Amount.String()),
-			sdk.NewAttribute(types.AttributeKeyOpenAmount, order.OpenAmount.String()),
-			sdk.NewAttribute(types.AttributeKeyOfferCoin, order.OfferCoin.String()),
-			sdk.NewAttribute(types.AttributeKeyRemainingOfferCoin, order.RemainingOfferCoin.String()),
-			sdk.NewAttribute(types.AttributeKeyReceivedCoin, order.ReceivedCoin.String()),
-			sdk.NewAttribute(types.AttributeKeyStatus, order.Status.String()),
-		),
-	})
+			sdk.NewAttribute(types.AttributeKeyRefundedCoins, order.RemainingOfferCoin.String()),
+		))
+	}
+}
+
+// FailOrder refunds the remaining offer coin of the order and marks it as
+// expired, emitting a failure event with the reason.
+func (k Keeper) FailOrder(ctx sdk.Context, order types.Order, reason types.FailureReason) error {
+	if order.Status == types.OrderStatusCompleted || order.Status.IsCanceledOrExpired() { // sanity check
+		return nil
+	}
+
+	refundedCoins := sdk.NewCoins(order.RemainingOfferCoin)
+	if err := k.finishOrder(ctx, order, types.OrderStatusExpired, reason); err != nil {
+		return err
+	}
+
+	k.emitOrderEvent(ctx, &types.EventOrderFailed{
+		Orderer:       order.Orderer,
+		PairId:        order.PairId,
+		OrderId:       order.Id,
+		Reason:        reason.String(),
+		RefundedCoins: refundedCoins,
+	}, sdk.NewEvent(
+		types.EventTypeOrderFailed,
+		sdk.NewAttribute(types.AttributeKeyOrderer, order.Orderer),
+		sdk.NewAttribute(types.AttributeKeyPairId, strconv.FormatUint(order.PairId, 10)),
+		sdk.NewAttribute(types.AttributeKeyOrderId, strconv.FormatUint(order.Id, 10)),
+		sdk.NewAttribute(types.AttributeKeyReason, reason.String()),
+		sdk.NewAttribute(types.AttributeKeyRefundedCoins, refundedCoins.String()),
+	))
 
 	return nil
 }
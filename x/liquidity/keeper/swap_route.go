@@ -0,0 +1,275 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/crescent-network/crescent/v4/x/liquidity/amm"
+	"github.com/crescent-network/crescent/v4/x/liquidity/types"
+)
+
+// getNextSwapRouteIdWithUpdate increments swap route id by one and set it as
+// the last swap route id.
+func (k Keeper) getNextSwapRouteIdWithUpdate(ctx sdk.Context) uint64 {
+	id := k.GetLastSwapRouteId(ctx) + 1
+	k.SetLastSwapRouteId(ctx, id)
+	return id
+}
+
+// SwapExactIn handles types.MsgSwapExactIn and stores types.SwapRoute.
+// The offer coin is escrowed in the swap route's escrow account and an order
+// is placed in the first pair of the route.
+// The following orders are placed by ProcessSwapRoutes.
+func (k Keeper) SwapExactIn(ctx sdk.Context, msg *types.MsgSwapExactIn) (types.SwapRoute, error) {
+	return k.SwapExactInWithReceiver(ctx, msg, msg.GetOrderer())
+}
+
+// SwapExactInWithReceiver is same as SwapExactIn, except that the coins from
+// the swap route are sent to the receiver instead of the orderer.
+func (k Keeper) SwapExactInWithReceiver(
+	ctx sdk.Context, msg *types.MsgSwapExactIn, receiver sdk.AccAddress) (types.SwapRoute, error) {
+	denom := msg.OfferCoin.Denom
+	for _, pairId := range msg.PairIds {
+		pair, found := k.GetPair(ctx, pairId)
+		if !found {
+			return types.SwapRoute{}, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "pair %d not found", pairId)
+		}
+		if maxOrderLifespan := k.GetPairMaxOrderLifespan(ctx, pair); msg.OrderLifespan > maxOrderLifespan {
+			return types.SwapRoute{},
+				sdkerrors.Wrapf(types.ErrTooLongOrderLifespan, "%s is longer than %s", msg.OrderLifespan, maxOrderLifespan)
+		}
+		switch denom {
+		case pair.BaseCoinDenom:
+			denom = pair.QuoteCoinDenom
+		case pair.QuoteCoinDenom:
+			denom = pair.BaseCoinDenom
+		default:
+			return types.SwapRoute{}, sdkerrors.Wrapf(types.ErrWrongPair, "pair %d has no %s", pairId, denom)
+		}
+	}
+	if denom != msg.MinOutCoin.Denom {
+		return types.SwapRoute{}, sdkerrors.Wrapf(
+			types.ErrWrongPair, "route ends with %s, not %s", denom, msg.MinOutCoin.Denom)
+	}
+
+	route := types.NewSwapRoute(k.getNextSwapRouteIdWithUpdate(ctx), msg, receiver)
+	if err := k.bankKeeper.SendCoins(ctx, msg.GetOrderer(), route.GetEscrowAddress(), sdk.NewCoins(msg.OfferCoin)); err != nil {
+		return types.SwapRoute{}, err
+	}
+
+	order, err := k.placeSwapRouteOrder(ctx, route)
+	if err != nil {
+		return types.SwapRoute{}, err
+	}
+	route.OrderId = order.Id
+	k.SetSwapRoute(ctx, route)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSwapExactIn,
+			sdk.NewAttribute(types.AttributeKeyOrderer, msg.Orderer),
+			sdk.NewAttribute(types.AttributeKeyReceiver, route.Receiver),
+			sdk.NewAttribute(types.AttributeKeySwapRouteId, strconv.FormatUint(route.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyPairId, strconv.FormatUint(route.CurrentPairId(), 10)),
+			sdk.NewAttribute(types.AttributeKeyOfferCoin, msg.OfferCoin.String()),
+			sdk.NewAttribute(types.AttributeKeyMinOutCoin, msg.MinOutCoin.String()),
+			sdk.NewAttribute(types.AttributeKeyOrderId, strconv.FormatUint(order.Id, 10)),
+		),
+	})
+
+	return route, nil
+}
+
+// swapRouteOfferDenom returns the denom of the coin offered to the pair at
+// the swap route's current hop.
+func (k Keeper) swapRouteOfferDenom(ctx sdk.Context, route types.SwapRoute) string {
+	denom := route.OfferCoin.Denom
+	for _, pairId := range route.PairIds[:route.Hop] {
+		pair, _ := k.GetPair(ctx, pairId)
+		if denom == pair.BaseCoinDenom {
+			denom = pair.QuoteCoinDenom
+		} else {
+			denom = pair.BaseCoinDenom
+		}
+	}
+	return denom
+}
+
+// placeSwapRouteOrder places an order of the swap route's escrow account in
+// the pair at the route's current hop, offering the whole escrowed balance of
+// the coin the pair takes.
+// Orders in the intermediate pairs are market orders, and the order in the
+// last pair is a limit order priced so that it can't be matched at a price
+// worse than the one which yields the route's min out coin.
+func (k Keeper) placeSwapRouteOrder(ctx sdk.Context, route types.SwapRoute) (types.Order, error) {
+	pair, found := k.GetPair(ctx, route.CurrentPairId())
+	if !found {
+		return types.Order{}, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "pair %d not found", route.CurrentPairId())
+	}
+	escrowAddr := route.GetEscrowAddress()
+	offerCoin := k.bankKeeper.GetBalance(ctx, escrowAddr, k.swapRouteOfferDenom(ctx, route))
+
+	dir, demandCoinDenom := types.OrderDirectionSell, pair.QuoteCoinDenom
+	if offerCoin.Denom == pair.QuoteCoinDenom {
+		dir, demandCoinDenom = types.OrderDirectionBuy, pair.BaseCoinDenom
+	}
+
+	if !route.IsLastHop() {
+		amt := offerCoin.Amount
+		if dir == types.OrderDirectionBuy {
+			if pair.LastPrice == nil {
+				return types.Order{}, types.ErrNoLastPrice
+			}
+			_, highestPrice := k.OrderPriceLimits(ctx, pair)
+			amt = offerCoin.Amount.ToDec().QuoTruncate(highestPrice).TruncateInt()
+		}
+		msg := types.NewMsgMarketOrder(
+			escrowAddr, pair.Id, dir, offerCoin, demandCoinDenom, amt, route.OrderLifespan)
+		if err := msg.ValidateBasic(); err != nil {
+			return types.Order{}, err
+		}
+		return k.MarketOrder(ctx, msg)
+	}
+
+	tickPrec := int(k.GetTickPrecision(ctx))
+	// The received amount is reduced by the swap fee, so the order must
+	// receive minOutAmt / (1 - swapFeeRate) before paying the fee.
+	minOutAmt := route.MinOutCoin.Amount.ToDec().QuoRoundUp(sdk.OneDec().Sub(k.GetSwapFeeRate(ctx)))
+	var price sdk.Dec
+	var amt sdk.Int
+	switch dir {
+	case types.OrderDirectionSell:
+		if !offerCoin.Amount.IsPositive() {
+			return types.Order{}, types.ErrTooSmallOrder
+		}
+		price = amm.PriceToUpTick(minOutAmt.QuoRoundUp(offerCoin.Amount.ToDec()), tickPrec)
+		if pair.LastPrice != nil {
+			lowestPrice, _ := k.OrderPriceLimits(ctx, pair)
+			price = sdk.MaxDec(price, lowestPrice)
+		}
+		amt = offerCoin.Amount
+	case types.OrderDirectionBuy:
+		price = amm.PriceToDownTick(offerCoin.Amount.ToDec().QuoTruncate(minOutAmt.Ceil()), tickPrec)
+		if pair.LastPrice != nil {
+			_, highestPrice := k.OrderPriceLimits(ctx, pair)
+			price = sdk.MinDec(price, highestPrice)
+		}
+		if !price.IsPositive() {
+			return types.Order{}, sdkerrors.Wrapf(types.ErrPriceOutOfRange, "%s is too small to buy %s", offerCoin, route.MinOutCoin)
+		}
+		amt = offerCoin.Amount.ToDec().QuoTruncate(price).TruncateInt()
+	}
+	msg := types.NewMsgLimitOrder(
+		escrowAddr, pair.Id, dir, offerCoin, demandCoinDenom, price, amt, route.OrderLifespan)
+	if err := msg.ValidateBasic(); err != nil {
+		return types.Order{}, err
+	}
+	return k.LimitOrder(ctx, msg)
+}
+
+// ProcessSwapRoutes moves forward the swap routes whose current orders are
+// no longer matchable.
+// A swap route proceeds to the next pair with the proceeds of the finished
+// order, or is finished if the order was placed in the last pair of the route
+// or the route can't proceed.
+func (k Keeper) ProcessSwapRoutes(ctx sdk.Context) {
+	var routes []types.SwapRoute
+	_ = k.IterateAllSwapRoutes(ctx, func(route types.SwapRoute) (stop bool, err error) {
+		order, found := k.GetOrder(ctx, route.CurrentPairId(), route.OrderId)
+		if !found || !order.Status.IsMatchable() {
+			routes = append(routes, route)
+		}
+		return false, nil
+	})
+	for _, route := range routes {
+		k.advanceSwapRoute(ctx, route)
+	}
+}
+
+// advanceSwapRoute places the next order of the swap route whose current
+// order has been finished, or finishes the route.
+func (k Keeper) advanceSwapRoute(ctx sdk.Context, route types.SwapRoute) {
+	escrowAddr := route.GetEscrowAddress()
+
+	if route.IsLastHop() {
+		outCoin := k.bankKeeper.GetBalance(ctx, escrowAddr, route.MinOutCoin.Denom)
+		if outCoin.IsLT(route.MinOutCoin) {
+			k.finishSwapRoute(ctx, route, types.FailureReasonMinOutNotMet)
+		} else {
+			k.finishSwapRoute(ctx, route, "")
+		}
+		return
+	}
+
+	pair, _ := k.GetPair(ctx, route.CurrentPairId())
+	proceedsDenom := pair.BaseCoinDenom
+	if k.swapRouteOfferDenom(ctx, route) == pair.BaseCoinDenom {
+		proceedsDenom = pair.QuoteCoinDenom
+	}
+	balances := k.bankKeeper.GetAllBalances(ctx, escrowAddr)
+	proceeds := sdk.NewCoin(proceedsDenom, balances.AmountOf(proceedsDenom))
+	if !proceeds.IsPositive() {
+		k.finishSwapRoute(ctx, route, types.FailureReasonNoSwapOutput)
+		return
+	}
+	// Return the unused offer coin of the finished order to the receiver, so
+	// that only the proceeds are carried to the next pair.
+	if refundedCoins := balances.Sub(sdk.NewCoins(proceeds)); !refundedCoins.IsZero() {
+		if err := k.bankKeeper.SendCoins(ctx, escrowAddr, route.GetReceiver(), refundedCoins); err != nil {
+			panic(err)
+		}
+	}
+
+	route.Hop++
+	cacheCtx, writeCache := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
+	order, err := k.placeSwapRouteOrder(cacheCtx, route)
+	if err != nil {
+		k.finishSwapRoute(ctx, route, types.FailureReasonSwapOrderRejected)
+		return
+	}
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	route.OrderId = order.Id
+	k.SetSwapRoute(ctx, route)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSwapRouteHop,
+			sdk.NewAttribute(types.AttributeKeySwapRouteId, strconv.FormatUint(route.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyPairId, strconv.FormatUint(route.CurrentPairId(), 10)),
+			sdk.NewAttribute(types.AttributeKeyOfferCoin, proceeds.String()),
+			sdk.NewAttribute(types.AttributeKeyOrderId, strconv.FormatUint(order.Id, 10)),
+		),
+	})
+}
+
+// finishSwapRoute sends all the coins in the swap route's escrow account to
+// the receiver and deletes the route.
+// An empty reason means that the route has been finished successfully.
+func (k Keeper) finishSwapRoute(ctx sdk.Context, route types.SwapRoute, reason types.FailureReason) {
+	escrowAddr := route.GetEscrowAddress()
+	balances := k.bankKeeper.GetAllBalances(ctx, escrowAddr)
+	if !balances.IsZero() {
+		if err := k.bankKeeper.SendCoins(ctx, escrowAddr, route.GetReceiver(), balances); err != nil {
+			panic(err)
+		}
+	}
+	k.DeleteSwapRoute(ctx, route)
+
+	outCoin := sdk.NewCoin(route.MinOutCoin.Denom, balances.AmountOf(route.MinOutCoin.Denom))
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSwapRouteFinished,
+			sdk.NewAttribute(types.AttributeKeySwapRouteId, strconv.FormatUint(route.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyOrderer, route.Orderer),
+			sdk.NewAttribute(types.AttributeKeyReceiver, route.Receiver),
+			sdk.NewAttribute(types.AttributeKeyOutCoin, outCoin.String()),
+			sdk.NewAttribute(types.AttributeKeyRefundedCoins, balances.Sub(sdk.NewCoins(outCoin)).String()),
+			sdk.NewAttribute(types.AttributeKeyReason, string(reason)),
+		),
+	})
+}
@@ -0,0 +1,126 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	utils "github.com/crescent-network/crescent/v4/types"
+	"github.com/crescent-network/crescent/v4/x/liquidity/types"
+)
+
+// createSwapRoutePairs creates two pairs, denom1/denom2 and denom3/denom2,
+// each with a basic pool and a last price of 1.
+func (s *KeeperTestSuite) createSwapRoutePairs() (pair1, pair2 types.Pair) {
+	s.T().Helper()
+	pair1 = s.createPair(s.addr(0), "denom1", "denom2", true)
+	s.createPool(s.addr(0), pair1.Id, utils.ParseCoins("1000000000denom1,1000000000denom2"), true)
+	pair2 = s.createPair(s.addr(0), "denom3", "denom2", true)
+	s.createPool(s.addr(0), pair2.Id, utils.ParseCoins("1000000000denom3,1000000000denom2"), true)
+	for _, pair := range []types.Pair{pair1, pair2} {
+		pair.LastPrice = utils.ParseDecP("1.0")
+		s.keeper.SetPair(s.ctx, pair)
+	}
+	pair1, _ = s.keeper.GetPair(s.ctx, pair1.Id)
+	pair2, _ = s.keeper.GetPair(s.ctx, pair2.Id)
+	return
+}
+
+func (s *KeeperTestSuite) TestSwapExactIn() {
+	pair1, pair2 := s.createSwapRoutePairs()
+
+	orderer := s.addr(1)
+	s.fundAddr(orderer, utils.ParseCoins("1000000denom1"))
+	route, err := s.keeper.SwapExactIn(s.ctx, types.NewMsgSwapExactIn(
+		orderer, []uint64{pair1.Id, pair2.Id}, utils.ParseCoin("1000000denom1"),
+		utils.ParseCoin("990000denom3"), time.Hour))
+	s.Require().NoError(err)
+	s.Require().True(s.getBalances(orderer).IsZero())
+
+	// The first order is a sell order in the first pair.
+	order, found := s.keeper.GetOrder(s.ctx, pair1.Id, route.OrderId)
+	s.Require().True(found)
+	s.Require().Equal(types.OrderDirectionSell, order.Direction)
+	s.Require().Equal(route.EscrowAddress, order.Orderer)
+
+	// The proceeds of the first order are offered to the second pair.
+	s.nextBlock()
+	route, found = s.keeper.GetSwapRoute(s.ctx, route.Id)
+	s.Require().True(found)
+	s.Require().EqualValues(1, route.Hop)
+	order, found = s.keeper.GetOrder(s.ctx, pair2.Id, route.OrderId)
+	s.Require().True(found)
+	s.Require().Equal(types.OrderDirectionBuy, order.Direction)
+	s.Require().Equal("denom2", order.OfferCoin.Denom)
+
+	s.nextBlock()
+	_, found = s.keeper.GetSwapRoute(s.ctx, route.Id)
+	s.Require().False(found)
+	s.Require().True(s.getBalances(route.GetEscrowAddress()).IsZero())
+	s.Require().True(s.getBalance(orderer, "denom3").Amount.GTE(sdk.NewInt(990000)))
+	s.Require().True(s.getBalance(orderer, "denom1").IsZero())
+}
+
+func (s *KeeperTestSuite) TestSwapExactIn_MinOutNotMet() {
+	pair1, pair2 := s.createSwapRoutePairs()
+
+	orderer := s.addr(1)
+	s.fundAddr(orderer, utils.ParseCoins("1000000denom1"))
+	route, err := s.keeper.SwapExactIn(s.ctx, types.NewMsgSwapExactIn(
+		orderer, []uint64{pair1.Id, pair2.Id}, utils.ParseCoin("1000000denom1"),
+		utils.ParseCoin("1100000denom3"), 0))
+	s.Require().NoError(err)
+
+	s.nextBlock()
+	route, found := s.keeper.GetSwapRoute(s.ctx, route.Id)
+	s.Require().True(found)
+	s.Require().EqualValues(1, route.Hop)
+
+	// The last order is priced too low to be matched, so it expires and the
+	// intermediate proceeds are returned to the orderer.
+	s.nextBlock()
+	_, found = s.keeper.GetSwapRoute(s.ctx, route.Id)
+	s.Require().False(found)
+	s.Require().True(s.getBalances(route.GetEscrowAddress()).IsZero())
+	s.Require().True(s.getBalance(orderer, "denom3").IsZero())
+	s.Require().True(s.getBalance(orderer, "denom2").IsPositive())
+}
+
+func (s *KeeperTestSuite) TestSwapExactIn_WrongRoute() {
+	pair1, pair2 := s.createSwapRoutePairs()
+	pair3 := s.createPair(s.addr(0), "denom4", "denom5", true)
+
+	orderer := s.addr(1)
+	s.fundAddr(orderer, utils.ParseCoins("1000000denom1"))
+	for _, tc := range []struct {
+		name        string
+		pairIds     []uint64
+		minOutCoin  sdk.Coin
+		expectedErr string
+	}{
+		{
+			"unknown pair",
+			[]uint64{pair1.Id, 10},
+			utils.ParseCoin("1denom3"),
+			"pair 10 not found: not found",
+		},
+		{
+			"disconnected pairs",
+			[]uint64{pair1.Id, pair3.Id},
+			utils.ParseCoin("1denom4"),
+			"pair 3 has no denom2: wrong denom pair",
+		},
+		{
+			"wrong min out coin denom",
+			[]uint64{pair1.Id, pair2.Id},
+			utils.ParseCoin("1denom1"),
+			"route ends with denom3, not denom1: wrong denom pair",
+		},
+	} {
+		s.Run(tc.name, func() {
+			_, err := s.keeper.SwapExactIn(s.ctx, types.NewMsgSwapExactIn(
+				orderer, tc.pairIds, utils.ParseCoin("1000000denom1"), tc.minOutCoin, time.Hour))
+			s.Require().EqualError(err, tc.expectedErr)
+		})
+	}
+}
@@ -3,10 +3,13 @@ package keeper_test
 import (
 	"fmt"
 	"math/rand"
+	"strconv"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gogo/protobuf/proto"
+	abci "github.com/tendermint/tendermint/abci/types"
 
 	utils "github.com/crescent-network/crescent/v4/types"
 	"github.com/crescent-network/crescent/v4/x/liquidity"
@@ -117,6 +120,134 @@ func (s *KeeperTestSuite) TestLimitOrder() {
 	}
 }
 
+func (s *KeeperTestSuite) TestMaxNumActiveOrdersPerPair() {
+	params := s.keeper.GetParams(s.ctx)
+	params.MaxNumActiveOrdersPerPair = 3
+	s.keeper.SetParams(s.ctx, params)
+
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pair.LastPrice = utils.ParseDecP("1.0")
+	s.keeper.SetPair(s.ctx, pair)
+	pair2 := s.createPair(s.addr(0), "denom2", "denom3", true)
+
+	orderer := s.addr(1)
+	s.fundAddr(orderer, utils.ParseCoins("1000000000denom1,1000000000denom2"))
+
+	var orders []types.Order
+	for i := 0; i < 3; i++ {
+		orders = append(orders, s.buyLimitOrder(orderer, pair.Id, utils.ParseDec("0.95"), newInt(1000000), time.Hour, false))
+	}
+	s.Require().EqualValues(3, s.keeper.GetNumActiveOrders(s.ctx, orderer, pair.Id))
+
+	_, err := s.keeper.LimitOrder(s.ctx, types.NewMsgLimitOrder(
+		orderer, pair.Id, types.OrderDirectionBuy, utils.ParseCoin("950000denom2"), "denom1",
+		utils.ParseDec("0.95"), newInt(1000000), time.Hour))
+	s.Require().ErrorIs(err, types.ErrTooManyOrders)
+	_, err = s.keeper.MarketOrder(s.ctx, types.NewMsgMarketOrder(
+		orderer, pair.Id, types.OrderDirectionSell, utils.ParseCoin("1000000denom1"), "denom2", newInt(1000000), time.Hour))
+	s.Require().ErrorIs(err, types.ErrTooManyOrders)
+	_, err = s.keeper.MMOrder(s.ctx, types.NewMsgMMOrder(
+		orderer, pair.Id, utils.ParseDec("1.05"), utils.ParseDec("1.01"), newInt(1000000),
+		sdk.Dec{}, sdk.Dec{}, sdk.ZeroInt(), time.Hour))
+	s.Require().ErrorIs(err, types.ErrTooManyOrders)
+
+	// The limit is per orderer and per pair.
+	s.buyLimitOrder(s.addr(2), pair.Id, utils.ParseDec("0.95"), newInt(1000000), time.Hour, true)
+	s.sellLimitOrder(orderer, pair2.Id, utils.ParseDec("1.0"), newInt(1000000), time.Hour, false)
+
+	// Canceled orders are not active anymore.
+	s.nextBlock()
+	s.cancelOrder(orderer, pair.Id, orders[0].Id)
+	s.Require().EqualValues(2, s.keeper.GetNumActiveOrders(s.ctx, orderer, pair.Id))
+	s.buyLimitOrder(orderer, pair.Id, utils.ParseDec("0.95"), newInt(1000000), time.Hour, false)
+
+	// Zero means there is no limit.
+	params.MaxNumActiveOrdersPerPair = 0
+	s.keeper.SetParams(s.ctx, params)
+	s.buyLimitOrder(orderer, pair.Id, utils.ParseDec("0.95"), newInt(1000000), time.Hour, false)
+	s.Require().EqualValues(4, s.keeper.GetNumActiveOrders(s.ctx, orderer, pair.Id))
+}
+
+func (s *KeeperTestSuite) TestMaxNumActiveOrdersPerPair_MMOrder() {
+	params := s.keeper.GetParams(s.ctx)
+	params.MaxNumActiveOrdersPerPair = 10
+	s.keeper.SetParams(s.ctx, params)
+
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	orderer := s.addr(1)
+	s.fundAddr(orderer, utils.ParseCoins("1000000000denom1,1000000000denom2"))
+
+	// MaxNumMarketMakingOrderTicks(10) sell orders between 1.01 and 1.05.
+	orders := s.mmOrder(
+		orderer, pair.Id, utils.ParseDec("1.05"), utils.ParseDec("1.01"), newInt(5000000),
+		sdk.Dec{}, sdk.Dec{}, sdk.ZeroInt(), time.Hour, false)
+	s.Require().Len(orders, 10)
+
+	// Replacing the previous MM orders is allowed, since they are canceled.
+	s.nextBlock()
+	orders = s.mmOrder(
+		orderer, pair.Id, utils.ParseDec("1.06"), utils.ParseDec("1.02"), newInt(5000000),
+		sdk.Dec{}, sdk.Dec{}, sdk.ZeroInt(), time.Hour, false)
+	s.Require().Len(orders, 10)
+
+	// But MM orders and other orders together can't exceed the limit.
+	s.nextBlock()
+	_, err := s.keeper.LimitOrder(s.ctx, types.NewMsgLimitOrder(
+		orderer, pair.Id, types.OrderDirectionBuy, utils.ParseCoin("950000denom2"), "denom1",
+		utils.ParseDec("0.95"), newInt(1000000), time.Hour))
+	s.Require().ErrorIs(err, types.ErrTooManyOrders)
+	_, err = s.keeper.CancelMMOrder(s.ctx, types.NewMsgCancelMMOrder(orderer, pair.Id))
+	s.Require().NoError(err)
+	s.buyLimitOrder(orderer, pair.Id, utils.ParseDec("0.95"), newInt(1000000), time.Hour, false)
+	_, err = s.keeper.MMOrder(s.ctx, types.NewMsgMMOrder(
+		orderer, pair.Id, utils.ParseDec("1.06"), utils.ParseDec("1.02"), newInt(5000000),
+		sdk.Dec{}, sdk.Dec{}, sdk.ZeroInt(), time.Hour))
+	s.Require().ErrorIs(err, types.ErrTooManyOrders)
+}
+
+func (s *KeeperTestSuite) TestOrderPriceTickWindow() {
+	params := s.keeper.GetParams(s.ctx)
+	params.MaxOrderPriceTicks = 100
+	s.keeper.SetParams(s.ctx, params)
+
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pair.LastPrice = utils.ParseDecP("1.0")
+	s.keeper.SetPair(s.ctx, pair)
+
+	// The tick window is narrower than the price limit ratio band [0.9, 1.1].
+	lowest, highest := s.keeper.OrderPriceLimits(s.ctx, pair)
+	s.Require().True(decEq(utils.ParseDec("0.999"), lowest))
+	s.Require().True(decEq(utils.ParseDec("1.01"), highest))
+
+	orderer := s.addr(1)
+	s.fundAddr(orderer, utils.ParseCoins("1000000000denom1,1000000000denom2"))
+
+	_, err := s.keeper.LimitOrder(s.ctx, types.NewMsgLimitOrder(
+		orderer, pair.Id, types.OrderDirectionBuy, utils.ParseCoin("1010000denom2"), "denom1",
+		utils.ParseDec("1.01"), newInt(1000000), 0))
+	s.Require().NoError(err)
+	_, err = s.keeper.LimitOrder(s.ctx, types.NewMsgLimitOrder(
+		orderer, pair.Id, types.OrderDirectionBuy, utils.ParseCoin("1020000denom2"), "denom1",
+		utils.ParseDec("1.02"), newInt(1000000), 0))
+	s.Require().EqualError(err, "1.020000000000000000 is higher than 1.010000000000000000: price out of range limit")
+	_, err = s.keeper.LimitOrder(s.ctx, types.NewMsgLimitOrder(
+		orderer, pair.Id, types.OrderDirectionSell, utils.ParseCoin("1000000denom1"), "denom2",
+		utils.ParseDec("0.998"), newInt(1000000), 0))
+	s.Require().EqualError(err, "0.998000000000000000 is lower than 0.999000000000000000: price out of range limit")
+
+	// Market orders are placed at the edge of the tick window.
+	order, err := s.keeper.MarketOrder(s.ctx, types.NewMsgMarketOrder(
+		orderer, pair.Id, types.OrderDirectionSell, utils.ParseCoin("1000000denom1"), "denom2", newInt(1000000), 0))
+	s.Require().NoError(err)
+	s.Require().True(decEq(utils.ParseDec("0.999"), order.Price))
+
+	// MM orders are bounded by the tick window as well.
+	_, err = s.keeper.MMOrder(s.ctx, types.NewMsgMMOrder(
+		orderer, pair.Id, utils.ParseDec("1.0"), utils.ParseDec("1.02"), newInt(1000000),
+		sdk.Dec{}, sdk.Dec{}, sdk.ZeroInt(), 0))
+	s.Require().ErrorIs(err, types.ErrPriceOutOfRange)
+}
+
 func (s *KeeperTestSuite) TestLimitOrderInsufficientOfferCoin() {
 	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
 
@@ -308,8 +439,8 @@ func (s *KeeperTestSuite) TestSingleOrderNoMatch() {
 	// the request's changed status
 	liquidity.EndBlocker(s.ctx, s.keeper)
 
-	order, _ = s.keeper.GetOrder(s.ctx, order.PairId, order.Id)
-	s.Require().Equal(types.OrderStatusExpired, order.Status)
+	_, found = s.keeper.GetOrder(s.ctx, order.PairId, order.Id)
+	s.Require().False(found) // The order has been deleted after its expiration.
 
 	s.Require().True(coinsEq(utils.ParseCoins("1000000denom2"), s.getBalances(s.addr(1))))
 }
@@ -356,6 +487,40 @@ func (s *KeeperTestSuite) TestPartialMatch() {
 	s.Require().False(found)
 }
 
+func (s *KeeperTestSuite) TestPartialMatchMinFillAmount() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+
+	offerCoin := utils.ParseCoin("10000denom2")
+	s.fundAddr(s.addr(1), sdk.NewCoins(offerCoin))
+	msg := types.NewMsgLimitOrder(
+		s.addr(1), pair.Id, types.OrderDirectionBuy, offerCoin, "denom1",
+		utils.ParseDec("1.0"), sdk.NewInt(10000), time.Hour)
+	minFillAmt := sdk.NewInt(5000)
+	msg.MinFillAmount = &minFillAmt
+	order1, err := s.keeper.LimitOrder(s.ctx, msg)
+	s.Require().NoError(err)
+	order2 := s.buyLimitOrder(s.addr(2), pair.Id, utils.ParseDec("1.0"), sdk.NewInt(10000), time.Hour, true)
+	s.sellLimitOrder(s.addr(3), pair.Id, utils.ParseDec("1.0"), sdk.NewInt(4000), 0, true)
+	s.nextBlock()
+
+	// The fill for order1 is skipped since it is below the min fill amount.
+	order1, found := s.keeper.GetOrder(s.ctx, order1.PairId, order1.Id)
+	s.Require().True(found)
+	s.Require().Equal(types.OrderStatusNotMatched, order1.Status)
+	s.Require().True(intEq(sdk.NewInt(10000), order1.OpenAmount))
+	order2, found = s.keeper.GetOrder(s.ctx, order2.PairId, order2.Id)
+	s.Require().True(found)
+	s.Require().Equal(types.OrderStatusPartiallyMatched, order2.Status)
+	s.Require().True(coinEq(utils.ParseCoin("4000denom1"), order2.ReceivedCoin))
+
+	s.sellLimitOrder(s.addr(3), pair.Id, utils.ParseDec("1.0"), sdk.NewInt(12000), 0, true)
+	s.nextBlock()
+
+	order1, found = s.keeper.GetOrder(s.ctx, order1.PairId, order1.Id)
+	s.Require().True(found)
+	s.Require().True(coinEq(utils.ParseCoin("7500denom1"), order1.ReceivedCoin))
+}
+
 func (s *KeeperTestSuite) TestMatchWithLowPricePool() {
 	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
 	// Create a pool with very low price.
@@ -367,6 +532,50 @@ func (s *KeeperTestSuite) TestMatchWithLowPricePool() {
 	s.Require().Equal(types.OrderStatusNotMatched, order.Status)
 }
 
+func (s *KeeperTestSuite) TestOrderIdSequence() {
+	pair1 := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pair2 := s.createPair(s.addr(0), "denom2", "denom3", true)
+	for _, pair := range []types.Pair{pair1, pair2} {
+		pair.LastPrice = utils.ParseDecP("1.0")
+		s.keeper.SetPair(s.ctx, pair)
+	}
+
+	// Order ids are assigned from a sequence of each pair, starting from 1
+	// and increasing monotonically regardless of the order type and the
+	// orders placed to other pairs.
+	lastOrderIds := map[uint64]uint64{}
+	refs := map[string]struct{}{}
+	checkOrders := func(orders ...types.Order) {
+		for _, order := range orders {
+			s.Require().Equal(lastOrderIds[order.PairId]+1, order.Id)
+			lastOrderIds[order.PairId] = order.Id
+			_, ok := refs[order.Ref()]
+			s.Require().False(ok)
+			refs[order.Ref()] = struct{}{}
+			pairId, orderId, err := types.ParseOrderRef(order.Ref())
+			s.Require().NoError(err)
+			s.Require().Equal(order.PairId, pairId)
+			s.Require().Equal(order.Id, orderId)
+		}
+	}
+	for i := 0; i < 2; i++ {
+		checkOrders(s.buyLimitOrder(s.addr(1), pair1.Id, utils.ParseDec("0.99"), sdk.NewInt(10000), time.Hour, true))
+		checkOrders(s.sellLimitOrder(s.addr(1), pair2.Id, utils.ParseDec("1.01"), sdk.NewInt(10000), time.Hour, true))
+		checkOrders(s.sellMarketOrder(s.addr(2), pair2.Id, sdk.NewInt(10000), time.Hour, true))
+		checkOrders(s.mmOrder(
+			s.addr(3), pair1.Id,
+			utils.ParseDec("1.1"), utils.ParseDec("1.03"), sdk.NewInt(1000_000000),
+			utils.ParseDec("0.97"), utils.ParseDec("0.9"), sdk.NewInt(1000_000000),
+			time.Hour, true)...)
+		// Ids are not reused after orders are executed or deleted.
+		s.nextBlock()
+	}
+	for _, pair := range []types.Pair{pair1, pair2} {
+		pair, _ = s.keeper.GetPair(s.ctx, pair.Id)
+		s.Require().Equal(lastOrderIds[pair.Id], pair.LastOrderId)
+	}
+}
+
 func (s *KeeperTestSuite) TestMMOrder() {
 	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
 	pair.LastPrice = utils.ParseDecP("1.0")
@@ -447,6 +656,118 @@ func (s *KeeperTestSuite) TestMMOrderCancelPreviousOrders() {
 	}
 }
 
+func (s *KeeperTestSuite) TestMMOrderAutoCancel() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pair.LastPrice = utils.ParseDecP("1.0")
+	s.keeper.SetPair(s.ctx, pair)
+
+	newMsg := func() *types.MsgMMOrder {
+		msg := types.NewMsgMMOrder(
+			s.addr(1), pair.Id,
+			utils.ParseDec("1.1"), utils.ParseDec("1.03"), sdk.NewInt(1000_000000),
+			utils.ParseDec("0.97"), utils.ParseDec("0.9"), sdk.NewInt(1000_000000),
+			time.Hour)
+		msg.AutoCancelAfterBlocks = 3
+		return msg
+	}
+
+	balancesBefore := utils.ParseCoins("10000000000denom1,10000000000denom2")
+	s.fundAddr(s.addr(1), balancesBefore)
+	orders, err := s.keeper.MMOrder(s.ctx, newMsg())
+	s.Require().NoError(err)
+	index, found := s.keeper.GetMMOrderIndex(s.ctx, s.addr(1), pair.Id)
+	s.Require().True(found)
+	s.Require().Equal(s.ctx.BlockHeight()+3, index.AutoCancelHeight)
+
+	// Refreshing the orders postpones the auto cancel height.
+	s.nextBlock()
+	s.nextBlock()
+	orders, err = s.keeper.MMOrder(s.ctx, newMsg())
+	s.Require().NoError(err)
+	index, _ = s.keeper.GetMMOrderIndex(s.ctx, s.addr(1), pair.Id)
+	s.Require().Equal(s.ctx.BlockHeight()+3, index.AutoCancelHeight)
+
+	s.nextBlock()
+	s.nextBlock()
+	for _, order := range orders {
+		order, found := s.keeper.GetOrder(s.ctx, pair.Id, order.Id)
+		s.Require().True(found)
+		s.Require().Equal(types.OrderStatusNotMatched, order.Status)
+	}
+
+	// The orders are canceled since they are not refreshed.
+	s.nextBlock()
+	s.Require().Equal(index.AutoCancelHeight, s.ctx.BlockHeight())
+	_, found = s.keeper.GetMMOrderIndex(s.ctx, s.addr(1), pair.Id)
+	s.Require().False(found)
+	// The canceled orders may have already been deleted by the BeginBlocker.
+	for _, order := range orders {
+		if order, found := s.keeper.GetOrder(s.ctx, pair.Id, order.Id); found {
+			s.Require().Equal(types.OrderStatusCanceled, order.Status)
+		}
+	}
+	s.Require().True(coinsEq(balancesBefore, s.getBalances(s.addr(1))))
+}
+
+func (s *KeeperTestSuite) TestMMOrderAutoCancelWithinBatch() {
+	params := s.keeper.GetParams(s.ctx)
+	params.BatchSize = 3
+	s.keeper.SetParams(s.ctx, params)
+
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pair.LastPrice = utils.ParseDecP("1.0")
+	s.keeper.SetPair(s.ctx, pair)
+
+	msg := types.NewMsgMMOrder(
+		s.addr(1), pair.Id,
+		utils.ParseDec("1.1"), utils.ParseDec("1.03"), sdk.NewInt(1000_000000),
+		utils.ParseDec("0.97"), utils.ParseDec("0.9"), sdk.NewInt(1000_000000),
+		time.Hour)
+	msg.AutoCancelAfterBlocks = 1
+	s.fundAddr(s.addr(1), utils.ParseCoins("10000000000denom1,10000000000denom2"))
+	_, err := s.keeper.MMOrder(s.ctx, msg)
+	s.Require().NoError(err)
+
+	// The orders can't be canceled until the batch they were placed is executed.
+	for {
+		pair, _ = s.keeper.GetPair(s.ctx, pair.Id)
+		if pair.CurrentBatchId > 1 {
+			break
+		}
+		_, found := s.keeper.GetMMOrderIndex(s.ctx, s.addr(1), pair.Id)
+		s.Require().True(found)
+		s.nextBlock()
+	}
+	_, found := s.keeper.GetMMOrderIndex(s.ctx, s.addr(1), pair.Id)
+	s.Require().False(found)
+}
+
+func (s *KeeperTestSuite) TestMMOrderWithoutAutoCancel() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pair.LastPrice = utils.ParseDecP("1.0")
+	s.keeper.SetPair(s.ctx, pair)
+
+	orders := s.mmOrder(
+		s.addr(1), pair.Id,
+		utils.ParseDec("1.1"), utils.ParseDec("1.03"), sdk.NewInt(1000_000000),
+		utils.ParseDec("0.97"), utils.ParseDec("0.9"), sdk.NewInt(1000_000000),
+		time.Hour, true)
+	index, found := s.keeper.GetMMOrderIndex(s.ctx, s.addr(1), pair.Id)
+	s.Require().True(found)
+	s.Require().Zero(index.AutoCancelHeight)
+
+	for i := 0; i < 5; i++ {
+		s.nextBlock()
+	}
+	_, found = s.keeper.GetMMOrderIndex(s.ctx, s.addr(1), pair.Id)
+	s.Require().True(found)
+	for _, order := range orders {
+		order, found := s.keeper.GetOrder(s.ctx, pair.Id, order.Id)
+		s.Require().True(found)
+		s.Require().Equal(types.OrderStatusNotMatched, order.Status)
+	}
+}
+
 func (s *KeeperTestSuite) TestCancelOrder() {
 	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
 
@@ -476,6 +797,170 @@ func (s *KeeperTestSuite) TestCancelOrder() {
 	s.Require().False(found)
 }
 
+func (s *KeeperTestSuite) TestRenewOrder() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+
+	order := s.buyLimitOrder(s.addr(1), pair.Id, utils.ParseDec("1.0"), newInt(10000), 10*time.Second, true)
+
+	// Only the orderer can renew the order.
+	_, err := s.keeper.RenewOrder(s.ctx, types.NewMsgRenewOrder(s.addr(2), pair.Id, order.Id, time.Minute))
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// The lifespan can't exceed the max order lifespan.
+	_, err = s.keeper.RenewOrder(s.ctx, types.NewMsgRenewOrder(
+		s.addr(1), pair.Id, order.Id, types.DefaultMaxOrderLifespan+time.Second))
+	s.Require().ErrorIs(err, types.ErrTooLongOrderLifespan)
+
+	// The new expiration must be later than the current one.
+	_, err = s.keeper.RenewOrder(s.ctx, types.NewMsgRenewOrder(s.addr(1), pair.Id, order.Id, 5*time.Second))
+	s.Require().ErrorIs(err, types.ErrOrderNotRenewable)
+
+	s.nextBlock()
+
+	renewed, err := s.keeper.RenewOrder(s.ctx, types.NewMsgRenewOrder(s.addr(1), pair.Id, order.Id, time.Minute))
+	s.Require().NoError(err)
+	s.Require().Equal(s.ctx.BlockTime().Add(time.Minute), renewed.ExpireAt)
+	s.Require().Equal(order.BatchId, renewed.BatchId)
+
+	// The order would have been expired without the renewal.
+	s.nextBlock()
+	s.nextBlock()
+	order, found := s.keeper.GetOrder(s.ctx, pair.Id, order.Id)
+	s.Require().True(found)
+	s.Require().Equal(types.OrderStatusNotMatched, order.Status)
+	s.Require().Equal(renewed.ExpireAt, order.ExpireAt)
+
+	// A finished order can't be renewed.
+	s.cancelOrder(s.addr(1), pair.Id, order.Id)
+	_, err = s.keeper.RenewOrder(s.ctx, types.NewMsgRenewOrder(s.addr(1), pair.Id, order.Id, 2*time.Minute))
+	s.Require().ErrorIs(err, types.ErrOrderNotRenewable)
+}
+
+func (s *KeeperTestSuite) TestRenewMMOrder() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pair.LastPrice = utils.ParseDecP("1.0")
+	s.keeper.SetPair(s.ctx, pair)
+
+	orders := s.mmOrder(
+		s.addr(1), pair.Id, utils.ParseDec("1.1"), utils.ParseDec("1.05"), newInt(10000),
+		utils.ParseDec("0.95"), utils.ParseDec("0.9"), newInt(10000), time.Minute, true)
+	s.nextBlock()
+
+	_, err := s.keeper.RenewOrder(s.ctx, types.NewMsgRenewOrder(s.addr(1), pair.Id, orders[0].Id, 2*time.Minute))
+	s.Require().ErrorIs(err, types.ErrOrderNotRenewable)
+}
+
+func (s *KeeperTestSuite) TestAmendOrder() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+
+	orderer := s.addr(1)
+	order := s.buyLimitOrder(orderer, pair.Id, utils.ParseDec("1.0"), newInt(10000), time.Hour, true)
+
+	// Only the orderer can amend the order.
+	_, err := s.keeper.AmendOrder(s.ctx, types.NewMsgAmendOrder(
+		s.addr(2), pair.Id, order.Id, utils.ParseDec("1.1"), newInt(10000)))
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// The amount can't be decreased.
+	_, err = s.keeper.AmendOrder(s.ctx, types.NewMsgAmendOrder(
+		orderer, pair.Id, order.Id, utils.ParseDec("1.0"), newInt(9999)))
+	s.Require().ErrorIs(err, types.ErrOrderNotAmendable)
+
+	// Nothing is changed.
+	_, err = s.keeper.AmendOrder(s.ctx, types.NewMsgAmendOrder(
+		orderer, pair.Id, order.Id, utils.ParseDec("1.0"), newInt(10000)))
+	s.Require().ErrorIs(err, types.ErrOrderNotAmendable)
+
+	// The orderer doesn't have enough coins to escrow.
+	_, err = s.keeper.AmendOrder(s.ctx, types.NewMsgAmendOrder(
+		orderer, pair.Id, order.Id, utils.ParseDec("1.1"), newInt(10000)))
+	s.Require().ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+
+	// Raising the price escrows the difference.
+	s.fundAddr(orderer, utils.ParseCoins("1000denom2"))
+	amended, err := s.keeper.AmendOrder(s.ctx, types.NewMsgAmendOrder(
+		orderer, pair.Id, order.Id, utils.ParseDec("1.1"), newInt(10000)))
+	s.Require().NoError(err)
+	s.Require().Equal(order.Id, amended.Id)
+	s.Require().Equal(order.BatchId, amended.BatchId)
+	s.Require().True(coinEq(utils.ParseCoin("11000denom2"), amended.OfferCoin))
+	s.Require().True(coinEq(utils.ParseCoin("11000denom2"), amended.RemainingOfferCoin))
+	s.Require().True(s.getBalances(orderer).IsZero())
+
+	// Lowering the price refunds the difference, even if the amount is
+	// increased.
+	amended, err = s.keeper.AmendOrder(s.ctx, types.NewMsgAmendOrder(
+		orderer, pair.Id, order.Id, utils.ParseDec("0.5"), newInt(20000)))
+	s.Require().NoError(err)
+	s.Require().True(intEq(newInt(20000), amended.Amount))
+	s.Require().True(intEq(newInt(20000), amended.OpenAmount))
+	s.Require().True(coinEq(utils.ParseCoin("10000denom2"), amended.RemainingOfferCoin))
+	s.Require().True(coinsEq(utils.ParseCoins("1000denom2"), s.getBalances(orderer)))
+	s.Require().Empty(s.keeper.EscrowBalanceDiffs(s.ctx))
+
+	s.sellLimitOrder(s.addr(2), pair.Id, utils.ParseDec("0.5"), newInt(20000), time.Hour, true)
+	liquidity.EndBlocker(s.ctx, s.keeper)
+
+	order, found := s.keeper.GetOrder(s.ctx, pair.Id, order.Id)
+	s.Require().True(found)
+	s.Require().Equal(types.OrderStatusCompleted, order.Status)
+	s.Require().True(coinsEq(utils.ParseCoins("1000denom2,20000denom1"), s.getBalances(orderer)))
+
+	// A finished order can't be amended.
+	_, err = s.keeper.AmendOrder(s.ctx, types.NewMsgAmendOrder(
+		orderer, pair.Id, order.Id, utils.ParseDec("0.5"), newInt(30000)))
+	s.Require().ErrorIs(err, types.ErrOrderNotAmendable)
+}
+
+func (s *KeeperTestSuite) TestAmendOrder_PriceBand() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pair.LastPrice = utils.ParseDecP("1.0")
+	s.keeper.SetPair(s.ctx, pair)
+
+	order := s.sellLimitOrder(s.addr(1), pair.Id, utils.ParseDec("1.05"), newInt(10000), time.Hour, true)
+	_, err := s.keeper.AmendOrder(s.ctx, types.NewMsgAmendOrder(
+		s.addr(1), pair.Id, order.Id, utils.ParseDec("1.2"), newInt(10000)))
+	s.Require().ErrorIs(err, types.ErrPriceOutOfRange)
+
+	// The price is fit into ticks.
+	amended, err := s.keeper.AmendOrder(s.ctx, types.NewMsgAmendOrder(
+		s.addr(1), pair.Id, order.Id, utils.ParseDec("1.08015"), newInt(10000)))
+	s.Require().NoError(err)
+	s.Require().True(decEq(utils.ParseDec("1.0802"), amended.Price))
+}
+
+func (s *KeeperTestSuite) TestMakerPriority() {
+	for _, tc := range []struct {
+		name          string
+		makerPriority bool
+		makerReceived string
+		takerReceived string
+	}{
+		{"maker priority", true, "10000denom1", "5000denom1"},
+		{"pro-rata", false, "7500denom1", "7500denom1"},
+	} {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			params := s.keeper.GetParams(s.ctx)
+			params.MakerPriority = tc.makerPriority
+			s.keeper.SetParams(s.ctx, params)
+
+			pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+
+			// The maker order stays in the order book for a batch.
+			s.buyLimitOrder(s.addr(1), pair.Id, utils.ParseDec("1.0"), newInt(10000), time.Hour, true)
+			s.nextBlock()
+
+			s.buyLimitOrder(s.addr(2), pair.Id, utils.ParseDec("1.0"), newInt(10000), time.Hour, true)
+			s.sellLimitOrder(s.addr(3), pair.Id, utils.ParseDec("1.0"), newInt(15000), time.Hour, true)
+			s.nextBlock()
+
+			s.Require().True(coinsEq(utils.ParseCoins(tc.makerReceived), s.getBalances(s.addr(1))))
+			s.Require().True(coinsEq(utils.ParseCoins(tc.takerReceived), s.getBalances(s.addr(2))))
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestCancelAllOrders() {
 	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
 
@@ -550,6 +1035,111 @@ func (s *KeeperTestSuite) TestDustCollector() {
 	s.Require().True(coinsEq(utils.ParseCoins("1denom2"), s.getBalances(s.keeper.GetDustCollector(s.ctx))))
 }
 
+func (s *KeeperTestSuite) TestSwapFee() {
+	params := s.keeper.GetParams(s.ctx)
+	params.SwapFeeRate = utils.ParseDec("0.003")
+	s.keeper.SetParams(s.ctx, params)
+
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+
+	feeCollector := s.keeper.GetFeeCollector(s.ctx)
+	s.buyLimitOrder(s.addr(1), pair.Id, utils.ParseDec("1.0"), newInt(10000), 0, true)
+	s.sellLimitOrder(s.addr(2), pair.Id, utils.ParseDec("1.0"), newInt(10000), 0, true)
+	s.nextBlock()
+
+	s.Require().True(coinsEq(utils.ParseCoins("9970denom1"), s.getBalances(s.addr(1))))
+	s.Require().True(coinsEq(utils.ParseCoins("9970denom2"), s.getBalances(s.addr(2))))
+	s.Require().True(coinsEq(sdk.Coins{}, s.getBalances(pair.GetEscrowAddress())))
+	s.Require().True(coinEq(utils.ParseCoin("30denom1"), s.getBalance(feeCollector, "denom1")))
+	s.Require().True(coinEq(utils.ParseCoin("30denom2"), s.getBalance(feeCollector, "denom2")))
+
+	accruedFees, found := s.keeper.GetAccruedSwapFees(s.ctx, pair.Id)
+	s.Require().True(found)
+	s.Require().True(coinsEq(utils.ParseCoins("30denom1,30denom2"), accruedFees.Fees))
+
+	s.buyLimitOrder(s.addr(3), pair.Id, utils.ParseDec("1.0"), newInt(1000), 0, true)
+	s.sellLimitOrder(s.addr(4), pair.Id, utils.ParseDec("1.0"), newInt(1000), 0, true)
+	s.nextBlock()
+
+	accruedFees, _ = s.keeper.GetAccruedSwapFees(s.ctx, pair.Id)
+	s.Require().True(coinsEq(utils.ParseCoins("33denom1,33denom2"), accruedFees.Fees))
+}
+
+func (s *KeeperTestSuite) TestSwapFeeToPools() {
+	params := s.keeper.GetParams(s.ctx)
+	params.SwapFeeRate = utils.ParseDec("0.003")
+	params.SwapFeeToPools = true
+	s.keeper.SetParams(s.ctx, params)
+
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pool := s.createPool(s.addr(0), pair.Id, utils.ParseCoins("1000000denom1,1000000denom2"), true)
+
+	feeCollector := s.keeper.GetFeeCollector(s.ctx)
+	s.sellLimitOrder(s.addr(1), pair.Id, utils.ParseDec("0.99"), newInt(10000), 0, true)
+	s.nextBlock()
+
+	received := s.getBalance(s.addr(1), "denom2")
+	s.Require().True(received.IsPositive())
+
+	accruedFees, found := s.keeper.GetAccruedSwapFees(s.ctx, pair.Id)
+	s.Require().True(found)
+	s.Require().True(accruedFees.Fees.AmountOf("denom2").IsPositive())
+
+	// All swap fees went back to the pool.
+	s.Require().True(coinsEq(sdk.Coins{}, s.getBalances(pair.GetEscrowAddress())))
+	s.Require().True(s.getBalance(feeCollector, "denom2").IsZero())
+	dust := s.getBalance(s.keeper.GetDustCollector(s.ctx), "denom2")
+	s.Require().True(intEq(
+		sdk.NewInt(1000000).Sub(received.Amount).Sub(dust.Amount),
+		s.getBalance(pool.GetReserveAddress(), "denom2").Amount))
+}
+
+func (s *KeeperTestSuite) TestSweepPoolFees() {
+	params := s.keeper.GetParams(s.ctx)
+	params.SwapFeeRate = utils.ParseDec("0.003")
+	params.SwapFeeToPools = true
+	params.PoolFeeSweepEpoch = 1000000
+	s.keeper.SetParams(s.ctx, params)
+
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pool := s.createPool(s.addr(0), pair.Id, utils.ParseCoins("1000000denom1,1000000denom2"), true)
+	poolFeeAddr := types.PoolFeeAddress(pool.Id)
+
+	s.sellLimitOrder(s.addr(1), pair.Id, utils.ParseDec("0.99"), newInt(10000), 0, true)
+	s.nextBlock()
+
+	received := s.getBalance(s.addr(1), "denom2")
+	dust := s.getBalance(s.keeper.GetDustCollector(s.ctx), "denom2")
+	fees := s.getBalance(poolFeeAddr, "denom2")
+	s.Require().True(fees.IsPositive())
+	// Fees are held in the pool's fee address until swept.
+	s.Require().True(intEq(
+		sdk.NewInt(1000000).Sub(received.Amount).Sub(dust.Amount).Sub(fees.Amount),
+		s.getBalance(pool.GetReserveAddress(), "denom2").Amount))
+
+	s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+	s.keeper.SweepPoolFees(s.ctx)
+	s.Require().True(coinsEq(sdk.Coins{}, s.getBalances(poolFeeAddr)))
+	s.Require().True(intEq(
+		sdk.NewInt(1000000).Sub(received.Amount).Sub(dust.Amount),
+		s.getBalance(pool.GetReserveAddress(), "denom2").Amount))
+	var found bool
+	for _, ev := range s.ctx.EventManager().ABCIEvents() {
+		if ev.Type == types.EventTypeSweepPoolFees {
+			found = true
+		}
+	}
+	s.Require().True(found)
+
+	// Fees are swept at the end of each epoch.
+	params.PoolFeeSweepEpoch = 1
+	s.keeper.SetParams(s.ctx, params)
+	s.sellLimitOrder(s.addr(2), pair.Id, utils.ParseDec("0.98"), newInt(10000), 0, true)
+	s.nextBlock()
+	s.Require().True(s.getBalance(s.addr(2), "denom2").IsPositive())
+	s.Require().True(coinsEq(sdk.Coins{}, s.getBalances(poolFeeAddr)))
+}
+
 func (s *KeeperTestSuite) TestFitPrice() {
 	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
 	lastPrice := utils.ParseDec("1")
@@ -780,10 +1370,21 @@ func (s *KeeperTestSuite) TestPoolOrderOverflow() {
 	i, _ := sdk.NewIntFromString("10000000000000000000000000")
 	s.createPool(s.addr(0), pair.Id, sdk.NewCoins(sdk.NewInt64Coin("denom1", 1e6), sdk.NewCoin("denom2", i)), true)
 
-	s.sellLimitOrder(s.addr(1), pair.Id, utils.ParseDec("0.000000000000010000"), sdk.NewInt(1e17), 0, true)
+	order := s.sellLimitOrder(s.addr(1), pair.Id, utils.ParseDec("0.000000000000010000"), sdk.NewInt(1e17), 0, true)
 	s.Require().NotPanics(func() {
 		liquidity.EndBlocker(s.ctx, s.keeper)
 	})
+
+	// The amm arithmetic doesn't overflow with the near-limit pool reserves,
+	// so the order is fully matched with the pool instead of the batch being
+	// skipped.
+	for _, ev := range s.ctx.EventManager().ABCIEvents() {
+		s.Require().NotEqual(types.EventTypeMatchingOverflow, ev.Type)
+	}
+	order, _ = s.keeper.GetOrder(s.ctx, order.PairId, order.Id)
+	s.Require().Equal(types.OrderStatusCompleted, order.Status)
+	s.Require().True(order.OpenAmount.IsZero())
+	s.Require().True(coinsEq(utils.ParseCoins("9999900000000000000000000denom2"), s.getBalances(s.addr(1))))
 }
 
 func (s *KeeperTestSuite) TestRangedLiquidity() {
@@ -798,9 +1399,10 @@ func (s *KeeperTestSuite) TestRangedLiquidity() {
 
 	order := s.buyLimitOrder(s.addr(2), pair.Id, orderPrice, orderAmt, 0, true)
 	liquidity.EndBlocker(s.ctx, s.keeper)
-	order, _ = s.keeper.GetOrder(s.ctx, order.PairId, order.Id)
-	paid := order.OfferCoin.Sub(order.RemainingOfferCoin).Amount
-	received := order.ReceivedCoin.Amount
+	// The order has been partially matched and deleted after its expiration,
+	// with the remaining offer coin refunded.
+	paid := order.OfferCoin.Amount.Sub(s.getBalance(s.addr(2), "denom2").Amount)
+	received := s.getBalance(s.addr(2), "denom1").Amount
 	s.Require().True(received.LT(orderAmt))
 	s.Require().True(paid.ToDec().QuoInt(received).LTE(orderPrice))
 	liquidity.BeginBlocker(s.ctx, s.keeper)
@@ -933,9 +1535,6 @@ func (s *KeeperTestSuite) TestSwap_edgecase1() {
 
 func (s *KeeperTestSuite) TestSwap_edgecase2() {
 	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
-	pair.LastPrice = utils.ParseDecP("1.6724")
-	s.keeper.SetPair(s.ctx, pair)
-
 	s.createPool(s.addr(0), pair.Id, utils.ParseCoins("1005184935980denom2,601040339855denom1"), true)
 	s.createRangedPool(
 		s.addr(0), pair.Id, utils.ParseCoins("17335058855denom2"),
@@ -943,16 +1542,20 @@ func (s *KeeperTestSuite) TestSwap_edgecase2() {
 	s.createRangedPool(
 		s.addr(0), pair.Id, utils.ParseCoins("217771046279denom2"),
 		utils.ParseDec("1.25"), utils.ParseDec("1.45"), utils.ParseDec("1.45"), true)
+	// The last price is set after creating pools to bypass the initial pool
+	// price check.
+	pair.LastPrice = utils.ParseDecP("1.6724")
+	s.keeper.SetPair(s.ctx, pair)
 
 	s.sellMarketOrder(s.addr(1), pair.Id, sdk.NewInt(4336_000000), 0, true)
 	s.nextBlock()
 
 	pair, _ = s.keeper.GetPair(s.ctx, pair.Id)
-	s.Require().True(decEq(utils.ParseDec("1.6484"), *pair.LastPrice))
+	s.Require().True(decEq(utils.ParseDec("1.6483"), *pair.LastPrice))
 
 	s.nextBlock()
 	pair, _ = s.keeper.GetPair(s.ctx, pair.Id)
-	s.Require().True(decEq(utils.ParseDec("1.6484"), *pair.LastPrice))
+	s.Require().True(decEq(utils.ParseDec("1.6483"), *pair.LastPrice))
 
 	s.sellMarketOrder(s.addr(1), pair.Id, sdk.NewInt(4450_000000), 0, true)
 	s.nextBlock()
@@ -980,15 +1583,16 @@ func (s *KeeperTestSuite) TestSwap_edgecase3() {
 
 func (s *KeeperTestSuite) TestSwap_edgecase4() {
 	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
-	pair.LastPrice = utils.ParseDecP("0.99999")
-	s.keeper.SetPair(s.ctx, pair)
-
 	s.createPool(s.addr(0), pair.Id, utils.ParseCoins("1000_000000denom1,100_000000denom2"), true)
 
 	s.createRangedPool(s.addr(0), pair.Id, utils.ParseCoins("1000_000000denom1,1000_000000denom2"),
 		utils.ParseDec("0.95"), utils.ParseDec("1.05"), utils.ParseDec("1.02"), true)
 	s.createRangedPool(s.addr(0), pair.Id, utils.ParseCoins("1000_000000denom1,1000_000000denom2"),
 		utils.ParseDec("0.9"), utils.ParseDec("1.2"), utils.ParseDec("0.98"), true)
+	// The last price is set after creating pools to bypass the initial pool
+	// price check.
+	pair.LastPrice = utils.ParseDecP("0.99999")
+	s.keeper.SetPair(s.ctx, pair)
 
 	s.sellLimitOrder(s.addr(1), pair.Id, utils.ParseDec("1.05"), sdk.NewInt(50_000000), 0, true)
 	s.buyLimitOrder(s.addr(1), pair.Id, utils.ParseDec("0.97"), sdk.NewInt(100_000000), 0, true)
@@ -998,9 +1602,6 @@ func (s *KeeperTestSuite) TestSwap_edgecase4() {
 
 func (s *KeeperTestSuite) TestOrderBooks_edgecase1() {
 	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
-	pair.LastPrice = utils.ParseDecP("0.57472")
-	s.keeper.SetPair(s.ctx, pair)
-
 	s.createPool(s.addr(0), pair.Id, utils.ParseCoins("991883358661denom2,620800303846denom1"), true)
 	s.createRangedPool(
 		s.addr(0), pair.Id, utils.ParseCoins("155025981873denom2,4703143223denom1"),
@@ -1008,6 +1609,10 @@ func (s *KeeperTestSuite) TestOrderBooks_edgecase1() {
 	s.createRangedPool(
 		s.addr(0), pair.Id, utils.ParseCoins("223122824634denom2,26528571912denom1"),
 		utils.ParseDec("1.25"), utils.ParseDec("1.45"), utils.ParseDec("1.4199"), true)
+	// The last price is set after creating pools to bypass the initial pool
+	// price check.
+	pair.LastPrice = utils.ParseDecP("0.57472")
+	s.keeper.SetPair(s.ctx, pair)
 
 	resp, err := s.querier.OrderBooks(sdk.WrapSDKContext(s.ctx), &types.QueryOrderBooksRequest{
 		PairIds:  []uint64{pair.Id},
@@ -1021,7 +1626,7 @@ func (s *KeeperTestSuite) TestOrderBooks_edgecase1() {
 	s.Require().True(decEq(utils.ParseDec("0.63219"), resp.Pairs[0].OrderBooks[0].Buys[0].Price))
 	s.Require().True(intEq(sdk.NewInt(1178846737645), resp.Pairs[0].OrderBooks[0].Buys[0].UserOrderAmount))
 	s.Require().True(decEq(utils.ParseDec("0.5187"), resp.Pairs[0].OrderBooks[0].Buys[1].Price))
-	s.Require().True(intEq(sdk.NewInt(13340086), resp.Pairs[0].OrderBooks[0].Buys[1].UserOrderAmount))
+	s.Require().True(intEq(sdk.NewInt(13274908), resp.Pairs[0].OrderBooks[0].Buys[1].UserOrderAmount))
 	s.Require().Len(resp.Pairs[0].OrderBooks[0].Sells, 0)
 }
 
@@ -1082,3 +1687,191 @@ func (s *KeeperTestSuite) TestPoolPreserveK() {
 		}
 	}
 }
+
+func (s *KeeperTestSuite) TestLimitOrderTooLarge() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+
+	orderer := s.addr(1)
+	price := utils.ParseDec("100000000000000000000000000000000000000") // 10^38
+	s.fundAddr(orderer, sdk.NewCoins(sdk.NewCoin("denom1", amm.MaxCoinAmount), sdk.NewCoin("denom2", amm.MaxCoinAmount)))
+
+	_, err := s.keeper.LimitOrder(s.ctx, types.NewMsgLimitOrder(
+		orderer, pair.Id, types.OrderDirectionSell, sdk.NewCoin("denom1", amm.MaxCoinAmount), "denom2",
+		price, amm.MaxCoinAmount, 0))
+	s.Require().ErrorIs(err, types.ErrTooLargeOrder)
+
+	_, err = s.keeper.LimitOrder(s.ctx, types.NewMsgLimitOrder(
+		orderer, pair.Id, types.OrderDirectionBuy, sdk.NewCoin("denom2", amm.MaxCoinAmount), "denom1",
+		price, amm.MaxCoinAmount, 0))
+	s.Require().ErrorIs(err, types.ErrTooLargeOrder)
+}
+
+func (s *KeeperTestSuite) TestMatchingOverflow() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	lastPrice := utils.ParseDec("1.0")
+	pair.LastPrice = &lastPrice
+	s.keeper.SetPair(s.ctx, pair)
+
+	// Orders from an order source are not validated as user orders are,
+	// and the sum of their amounts overflows.
+	s.keeper.RegisterOrderSource(&testOrderSource{
+		name:        "test",
+		reserveAddr: s.addr(1),
+		pairId:      pair.Id,
+		orders: func(orderer amm.Orderer) []amm.Order {
+			return []amm.Order{
+				orderer.Order(amm.Sell, lastPrice, sdk.NewIntWithDecimal(6, 76)),
+				orderer.Order(amm.Sell, lastPrice, sdk.NewIntWithDecimal(6, 76)),
+			}
+		},
+	})
+	order := s.buyLimitOrder(s.addr(2), pair.Id, lastPrice, sdk.NewInt(10000), time.Hour, true)
+
+	s.Require().NotPanics(func() {
+		liquidity.EndBlocker(s.ctx, s.keeper)
+	})
+
+	pair, _ = s.keeper.GetPair(s.ctx, pair.Id)
+	s.Require().EqualValues(2, pair.CurrentBatchId)
+
+	// The order is kept as not matched, so that it can be expired later.
+	order, found := s.keeper.GetOrder(s.ctx, pair.Id, order.Id)
+	s.Require().True(found)
+	s.Require().Equal(types.OrderStatusNotMatched, order.Status)
+	s.Require().True(intEq(order.Amount, order.OpenAmount))
+
+	found = false
+	for _, ev := range s.ctx.EventManager().Events() {
+		if ev.Type == types.EventTypeMatchingOverflow {
+			found = true
+		}
+	}
+	s.Require().True(found)
+}
+
+func (s *KeeperTestSuite) TestTooSmallOrderFailed() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+
+	// The sell order's open amount becomes too small after matching.
+	order := s.sellLimitOrder(s.addr(1), pair.Id, utils.ParseDec("1.0"), sdk.NewInt(1000050), time.Hour, true)
+	s.buyLimitOrder(s.addr(2), pair.Id, utils.ParseDec("1.0"), sdk.NewInt(1000000), time.Hour, true)
+	liquidity.EndBlocker(s.ctx, s.keeper)
+
+	order, _ = s.keeper.GetOrder(s.ctx, pair.Id, order.Id)
+	s.Require().Equal(types.OrderStatusExpired, order.Status)
+	attrs, found := eventAttrs(s.ctx.EventManager().Events(), types.EventTypeOrderFailed)
+	s.Require().True(found)
+	s.Require().Equal(strconv.FormatUint(order.Id, 10), attrs[types.AttributeKeyOrderId])
+	s.Require().Equal(types.FailureReasonTooSmallOrder.String(), attrs[types.AttributeKeyReason])
+	s.Require().Equal("50denom1", attrs[types.AttributeKeyRefundedCoins])
+	s.Require().True(coinsEq(utils.ParseCoins("50denom1,1000000denom2"), s.getBalances(s.addr(1))))
+}
+
+func (s *KeeperTestSuite) TestSimulatorMatchesChain() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pair.LastPrice = utils.ParseDecP("1.0")
+	s.keeper.SetPair(s.ctx, pair)
+	pool := s.createPool(s.addr(0), pair.Id, utils.ParseCoins("1000_000000denom1,1000_000000denom2"), true)
+	rangedPool := s.createRangedPool(
+		s.addr(0), pair.Id, utils.ParseCoins("1000_000000denom1,1000_000000denom2"),
+		utils.ParseDec("0.9"), utils.ParseDec("1.1"), utils.ParseDec("1.0"), true)
+
+	// Build the simulator from plain data only.
+	upward, downward := s.keeper.GetPairPriceLimitRatios(s.ctx, pair)
+	sim := amm.NewSimulator(
+		int(s.keeper.GetTickPrecision(s.ctx)), upward, downward, s.keeper.GetMakerPriority(s.ctx))
+	sim.SetLastPrice(*pair.LastPrice)
+	for _, p := range []types.Pool{pool, rangedPool} {
+		rx, ry := s.keeper.GetPoolBalances(s.ctx, p)
+		ammPool := p.AMMPool(rx.Amount, ry.Amount, s.keeper.GetPoolCoinSupply(s.ctx, p))
+		sim.AddPool(amm.NewSimPool(p.Id, ammPool))
+	}
+	var simOrders []*amm.SimOrder
+	for i, o := range []struct {
+		dir   types.OrderDirection
+		price string
+		amt   int64
+	}{
+		{types.OrderDirectionBuy, "1.05", 30_000000},
+		{types.OrderDirectionBuy, "1.02", 10_000000},
+		{types.OrderDirectionSell, "1.01", 5_000000},
+	} {
+		order := s.limitOrder(s.addr(i+1), pair.Id, o.dir, utils.ParseDec(o.price), sdk.NewInt(o.amt), time.Hour, true)
+		userOrder := types.NewUserOrder(order)
+		simOrder := amm.NewSimOrder(
+			order.Id, order.BatchId, userOrder.Direction, userOrder.Price, userOrder.Amount, userOrder.MinFillAmount)
+		sim.AddOrder(simOrder)
+		simOrders = append(simOrders, simOrder)
+	}
+	res := sim.Simulate()
+	s.Require().True(res.Matched)
+
+	s.nextBlock()
+	pair, _ = s.keeper.GetPair(s.ctx, pair.Id)
+	s.Require().True(decEq(res.MatchPrice, *pair.LastPrice))
+	for i, simOrder := range simOrders {
+		demandCoinDenom := "denom1"
+		if simOrder.Direction == amm.Sell {
+			demandCoinDenom = "denom2"
+		}
+		s.Require().True(intEq(simOrder.GetReceivedDemandCoinAmount(), s.getBalance(s.addr(i+1), demandCoinDenom).Amount))
+	}
+}
+
+func (s *KeeperTestSuite) TestTypedOrderEvents() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+
+	typedEvent := func(events sdk.Events, typ proto.Message) (abci.Event, proto.Message, bool) {
+		for i := len(events) - 1; i >= 0; i-- {
+			if events[i].Type == proto.MessageName(typ) {
+				ev := abci.Event(events[i])
+				msg, err := sdk.ParseTypedEvent(ev)
+				s.Require().NoError(err)
+				return ev, msg, true
+			}
+		}
+		return abci.Event{}, nil, false
+	}
+
+	s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+	sellOrder := s.sellLimitOrder(s.addr(1), pair.Id, utils.ParseDec("1.0"), sdk.NewInt(1000000), time.Hour, true)
+	_, msg, found := typedEvent(s.ctx.EventManager().Events(), &types.EventLimitOrder{})
+	s.Require().True(found)
+	limitOrderEvent := msg.(*types.EventLimitOrder)
+	s.Require().Equal(pair.Id, limitOrderEvent.PairId)
+	s.Require().Equal(sellOrder.Id, limitOrderEvent.OrderId)
+	s.Require().Equal(sellOrder.Ref(), limitOrderEvent.OrderRef)
+	s.Require().Equal(types.OrderDirectionSell, limitOrderEvent.Direction)
+	s.Require().True(decEq(utils.ParseDec("1.0"), limitOrderEvent.Price))
+	// The legacy event is emitted by default.
+	_, found = eventAttrs(s.ctx.EventManager().Events(), types.EventTypeLimitOrder)
+	s.Require().True(found)
+
+	s.keeper.SetLegacyOrderEventsEnabled(false)
+	s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+	buyOrder := s.buyLimitOrder(s.addr(2), pair.Id, utils.ParseDec("1.0"), sdk.NewInt(1000000), time.Hour, true)
+	liquidity.EndBlocker(s.ctx, s.keeper)
+
+	_, found = eventAttrs(s.ctx.EventManager().Events(), types.EventTypeLimitOrder)
+	s.Require().False(found)
+	_, found = eventAttrs(s.ctx.EventManager().Events(), types.EventTypeUserOrderMatched)
+	s.Require().False(found)
+
+	ev, msg, found := typedEvent(s.ctx.EventManager().Events(), &types.EventUserOrderMatched{})
+	s.Require().True(found)
+	matchedEvent := msg.(*types.EventUserOrderMatched)
+	s.Require().Equal(pair.Id, matchedEvent.PairId)
+	s.Require().Contains([]uint64{sellOrder.Id, buyOrder.Id}, matchedEvent.OrderId)
+	s.Require().True(intEq(sdk.NewInt(1000000), matchedEvent.MatchedAmount))
+	// The pair id is an attribute of the event, so that event subscriptions
+	// can filter the events of the pair.
+	attrs := map[string]string{}
+	for _, attr := range ev.Attributes {
+		attrs[string(attr.Key)] = string(attr.Value)
+	}
+	s.Require().Equal(`"1"`, attrs["pair_id"])
+
+	_, msg, found = typedEvent(s.ctx.EventManager().Events(), &types.EventOrderResult{})
+	s.Require().True(found)
+	s.Require().Equal(types.OrderStatusCompleted, msg.(*types.EventOrderResult).Status)
+}
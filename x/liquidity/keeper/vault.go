@@ -0,0 +1,303 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/crescent-network/crescent/v4/x/liquidity/amm"
+	"github.com/crescent-network/crescent/v4/x/liquidity/types"
+)
+
+// getNextVaultIdWithUpdate increments vault id by one and set it.
+func (k Keeper) getNextVaultIdWithUpdate(ctx sdk.Context) uint64 {
+	id := k.GetLastVaultId(ctx) + 1
+	k.SetLastVaultId(ctx, id)
+	return id
+}
+
+// GetVaultBalances returns the balances of the vault.
+func (k Keeper) GetVaultBalances(ctx sdk.Context, vault types.Vault, pair types.Pair) (rx sdk.Coin, ry sdk.Coin) {
+	spendable := k.bankKeeper.SpendableCoins(ctx, vault.GetReserveAddress())
+	rx = sdk.NewCoin(pair.QuoteCoinDenom, spendable.AmountOf(pair.QuoteCoinDenom))
+	ry = sdk.NewCoin(pair.BaseCoinDenom, spendable.AmountOf(pair.BaseCoinDenom))
+	return
+}
+
+// GetVaultShareSupply returns total share supply of the vault.
+func (k Keeper) GetVaultShareSupply(ctx sdk.Context, vault types.Vault) sdk.Int {
+	return k.bankKeeper.GetSupply(ctx, vault.ShareDenom).Amount
+}
+
+// CreateVault handles types.MsgCreateVault and creates a vault.
+func (k Keeper) CreateVault(ctx sdk.Context, msg *types.MsgCreateVault) (types.Vault, error) {
+	pair, found := k.GetPair(ctx, msg.PairId)
+	if !found {
+		return types.Vault{}, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "pair %d not found", msg.PairId)
+	}
+
+	vaultId := k.getNextVaultIdWithUpdate(ctx)
+	vault := types.NewVault(vaultId, pair.Id, msg.GetOperator(), msg.MaxPriceDeviation, msg.OperatorFeeRate)
+	k.SetVault(ctx, vault)
+	k.SetVaultsByPairIndex(ctx, vault)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCreateVault,
+			sdk.NewAttribute(types.AttributeKeyOperator, msg.Operator),
+			sdk.NewAttribute(types.AttributeKeyPairId, strconv.FormatUint(msg.PairId, 10)),
+			sdk.NewAttribute(types.AttributeKeyVaultId, strconv.FormatUint(vault.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyReserveAddress, vault.ReserveAddress),
+		),
+	})
+
+	return vault, nil
+}
+
+// DepositVault handles types.MsgDepositVault and mints vault shares to the
+// depositor.
+// The first deposit to a vault mints shares as much as the value of the
+// deposit in the quote coin, and later deposits are accepted in proportion
+// to the vault's balances.
+func (k Keeper) DepositVault(ctx sdk.Context, msg *types.MsgDepositVault) (mintedShare sdk.Coin, err error) {
+	vault, found := k.GetVault(ctx, msg.VaultId)
+	if !found {
+		return sdk.Coin{}, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "vault %d not found", msg.VaultId)
+	}
+	pair, _ := k.GetPair(ctx, vault.PairId)
+	if k.IsPairHalted(ctx, pair) {
+		return sdk.Coin{}, sdkerrors.Wrapf(types.ErrPairHalted, "pair %d", pair.Id)
+	}
+	if pair.LastPrice == nil {
+		return sdk.Coin{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "pair %d has no last price", pair.Id)
+	}
+	for _, coin := range msg.DepositCoins {
+		if coin.Denom != pair.BaseCoinDenom && coin.Denom != pair.QuoteCoinDenom {
+			return sdk.Coin{}, sdkerrors.Wrapf(types.ErrInvalidCoinDenom, "coin denom %s is not in the pair", coin.Denom)
+		}
+	}
+
+	vault, err = k.accrueOperatorFee(ctx, vault, pair)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	rx, ry := k.GetVaultBalances(ctx, vault, pair)
+	ps := k.GetVaultShareSupply(ctx, vault)
+	x, y := msg.DepositCoins.AmountOf(pair.QuoteCoinDenom), msg.DepositCoins.AmountOf(pair.BaseCoinDenom)
+	var ax, ay, pc sdk.Int
+	if ps.IsZero() {
+		ax, ay = x, y
+		pc = types.VaultValue(x, y, *pair.LastPrice).TruncateInt()
+	} else {
+		ax, ay, pc = amm.Deposit(rx.Amount, ry.Amount, ps, x, y)
+	}
+	if !pc.IsPositive() {
+		return sdk.Coin{}, types.ErrInsufficientDepositAmount
+	}
+	if rx.Amount.Add(ax).GT(amm.MaxCoinAmount) || ry.Amount.Add(ay).GT(amm.MaxCoinAmount) {
+		return sdk.Coin{}, types.ErrTooLargePool
+	}
+
+	depositor := msg.GetDepositor()
+	acceptedCoins := sdk.NewCoins(sdk.NewCoin(pair.QuoteCoinDenom, ax), sdk.NewCoin(pair.BaseCoinDenom, ay))
+	if err := k.bankKeeper.SendCoins(ctx, depositor, vault.GetReserveAddress(), acceptedCoins); err != nil {
+		return sdk.Coin{}, err
+	}
+	mintedShare = sdk.NewCoin(vault.ShareDenom, pc)
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(mintedShare)); err != nil {
+		return sdk.Coin{}, err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, depositor, sdk.NewCoins(mintedShare)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if ps.IsZero() {
+		// The operator fee is charged for the profit made after the first
+		// deposit.
+		value := types.VaultValue(rx.Amount.Add(ax), ry.Amount.Add(ay), *pair.LastPrice)
+		vault.HighWaterMark = value.QuoInt(pc)
+		k.SetVault(ctx, vault)
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeDepositVault,
+			sdk.NewAttribute(types.AttributeKeyDepositor, msg.Depositor),
+			sdk.NewAttribute(types.AttributeKeyVaultId, strconv.FormatUint(vault.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyDepositCoins, msg.DepositCoins.String()),
+			sdk.NewAttribute(types.AttributeKeyAcceptedCoins, acceptedCoins.String()),
+			sdk.NewAttribute(types.AttributeKeyMintedShare, mintedShare.String()),
+		),
+	})
+
+	return mintedShare, nil
+}
+
+// WithdrawVault handles types.MsgWithdrawVault and withdraws coins from the
+// vault in proportion to the burned shares.
+func (k Keeper) WithdrawVault(ctx sdk.Context, msg *types.MsgWithdrawVault) (withdrawnCoins sdk.Coins, err error) {
+	vault, found := k.GetVault(ctx, msg.VaultId)
+	if !found {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "vault %d not found", msg.VaultId)
+	}
+	if msg.Share.Denom != vault.ShareDenom {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidCoinDenom, "share denom %s != %s", msg.Share.Denom, vault.ShareDenom)
+	}
+	pair, _ := k.GetPair(ctx, vault.PairId)
+
+	vault, err = k.accrueOperatorFee(ctx, vault, pair)
+	if err != nil {
+		return nil, err
+	}
+
+	rx, ry := k.GetVaultBalances(ctx, vault, pair)
+	ps := k.GetVaultShareSupply(ctx, vault)
+	if msg.Share.Amount.GT(ps) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "%s is larger than the share supply %s", msg.Share, ps)
+	}
+	x, y := amm.Withdraw(rx.Amount, ry.Amount, ps, msg.Share.Amount, sdk.ZeroDec())
+	if x.IsZero() && y.IsZero() {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "too small share to withdraw")
+	}
+
+	withdrawnCoins = sdk.NewCoins(sdk.NewCoin(pair.QuoteCoinDenom, x), sdk.NewCoin(pair.BaseCoinDenom, y))
+	burningCoins := sdk.NewCoins(msg.Share)
+
+	bulkOp := types.NewBulkSendCoinsOperation()
+	bulkOp.QueueSendCoins(msg.GetWithdrawer(), k.accountKeeper.GetModuleAddress(types.ModuleName), burningCoins)
+	bulkOp.QueueSendCoins(vault.GetReserveAddress(), msg.GetWithdrawer(), withdrawnCoins)
+	if err := bulkOp.Run(ctx, k.bankKeeper); err != nil {
+		return nil, err
+	}
+
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, burningCoins); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeWithdrawVault,
+			sdk.NewAttribute(types.AttributeKeyWithdrawer, msg.Withdrawer),
+			sdk.NewAttribute(types.AttributeKeyVaultId, strconv.FormatUint(vault.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyShare, msg.Share.String()),
+			sdk.NewAttribute(types.AttributeKeyWithdrawnCoins, withdrawnCoins.String()),
+		),
+	})
+
+	return withdrawnCoins, nil
+}
+
+// RebalanceVault handles types.MsgRebalanceVault and sets the vault's price
+// range, which must be within the vault's strategy.
+func (k Keeper) RebalanceVault(ctx sdk.Context, msg *types.MsgRebalanceVault) error {
+	vault, found := k.GetVault(ctx, msg.VaultId)
+	if !found {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "vault %d not found", msg.VaultId)
+	}
+	if msg.Operator != vault.Operator {
+		return sdkerrors.Wrapf(types.ErrNotVaultOperator, "%s is not the operator of vault %d", msg.Operator, vault.Id)
+	}
+	pair, _ := k.GetPair(ctx, vault.PairId)
+	if k.IsPairHalted(ctx, pair) {
+		return sdkerrors.Wrapf(types.ErrPairHalted, "pair %d", pair.Id)
+	}
+	if pair.LastPrice == nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "pair %d has no last price", pair.Id)
+	}
+	if err := types.ValidateVaultRange(vault, msg.MinPrice, msg.MaxPrice, *pair.LastPrice); err != nil {
+		return sdkerrors.Wrap(types.ErrVaultRangeOutOfStrategy, err.Error())
+	}
+
+	vault, err := k.accrueOperatorFee(ctx, vault, pair)
+	if err != nil {
+		return err
+	}
+	vault.MinPrice = &msg.MinPrice
+	vault.MaxPrice = &msg.MaxPrice
+	k.SetVault(ctx, vault)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRebalanceVault,
+			sdk.NewAttribute(types.AttributeKeyOperator, msg.Operator),
+			sdk.NewAttribute(types.AttributeKeyVaultId, strconv.FormatUint(vault.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyMinPrice, msg.MinPrice.String()),
+			sdk.NewAttribute(types.AttributeKeyMaxPrice, msg.MaxPrice.String()),
+		),
+	})
+
+	return nil
+}
+
+// accrueOperatorFee mints vault shares to the vault's operator for the
+// profit made since the last accrual, valued at the pair's last price,
+// and returns the vault with its high water mark updated.
+// It must be called before the vault's balances or share supply change,
+// so that depositors and withdrawers bear the operator fee only for the
+// profit made while they hold shares.
+func (k Keeper) accrueOperatorFee(ctx sdk.Context, vault types.Vault, pair types.Pair) (types.Vault, error) {
+	if pair.LastPrice == nil {
+		return vault, nil
+	}
+	ps := k.GetVaultShareSupply(ctx, vault)
+	if ps.IsZero() {
+		return vault, nil
+	}
+	rx, ry := k.GetVaultBalances(ctx, vault, pair)
+	value := types.VaultValue(rx.Amount, ry.Amount, *pair.LastPrice)
+	feeShare, highWaterMark := types.OperatorFeeShare(value, ps, vault.HighWaterMark, vault.OperatorFeeRate)
+	if highWaterMark.Equal(vault.HighWaterMark) {
+		return vault, nil
+	}
+	vault.HighWaterMark = highWaterMark
+	k.SetVault(ctx, vault)
+
+	if feeShare.IsPositive() {
+		feeShareCoins := sdk.NewCoins(sdk.NewCoin(vault.ShareDenom, feeShare))
+		if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, feeShareCoins); err != nil {
+			return types.Vault{}, err
+		}
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, vault.GetOperator(), feeShareCoins); err != nil {
+			return types.Vault{}, err
+		}
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeAccrueOperatorFee,
+			sdk.NewAttribute(types.AttributeKeyOperator, vault.Operator),
+			sdk.NewAttribute(types.AttributeKeyVaultId, strconv.FormatUint(vault.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyMintedShare, sdk.NewCoin(vault.ShareDenom, feeShare).String()),
+			sdk.NewAttribute(types.AttributeKeyShareValue, highWaterMark.String()),
+		),
+	})
+
+	return vault, nil
+}
+
+// vaultOrderSource returns an amm.OrderSource which provides orders from
+// the vault's balances as a ranged pool with the vault's price range.
+// found is false if the vault's range is not set or the vault has no
+// liquidity.
+func (k Keeper) vaultOrderSource(ctx sdk.Context, pair types.Pair, vault types.Vault) (amm.OrderSource, bool) {
+	if !vault.HasRange() {
+		return nil, false
+	}
+	ps := k.GetVaultShareSupply(ctx, vault)
+	if ps.IsZero() {
+		return nil, false
+	}
+	rx, ry := k.GetVaultBalances(ctx, vault, pair)
+	if rx.IsZero() && ry.IsZero() {
+		return nil, false
+	}
+	pool := vault.AMMPool(rx.Amount, ry.Amount, ps)
+	if pool.IsDepleted() {
+		return nil, false
+	}
+	orderer := types.NewSourceOrderer(
+		types.VaultSourceName(vault.Id), vault.GetReserveAddress(), pair.BaseCoinDenom, pair.QuoteCoinDenom)
+	return amm.NewPoolOrderSource(pool, orderer, int(k.GetTickPrecision(ctx))), true
+}
@@ -0,0 +1,185 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	utils "github.com/crescent-network/crescent/v4/types"
+	"github.com/crescent-network/crescent/v4/x/liquidity/types"
+)
+
+func (s *KeeperTestSuite) TestCreateVault() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+
+	_, err := s.keeper.CreateVault(s.ctx, types.NewMsgCreateVault(
+		s.addr(1), 2, utils.ParseDec("0.02"), utils.ParseDec("0.1")))
+	s.Require().EqualError(err, "pair 2 not found: not found")
+
+	vault := s.createVault(s.addr(1), pair.Id, utils.ParseDec("0.02"), utils.ParseDec("0.1"))
+	s.Require().EqualValues(1, vault.Id)
+	s.Require().Equal(pair.Id, vault.PairId)
+	s.Require().Equal(s.addr(1).String(), vault.Operator)
+	s.Require().Equal(types.VaultReserveAddress(1).String(), vault.ReserveAddress)
+	s.Require().Equal("vault1", vault.ShareDenom)
+	s.Require().False(vault.HasRange())
+
+	vault2 := s.createVault(s.addr(2), pair.Id, utils.ParseDec("0.05"), sdk.ZeroDec())
+	s.Require().EqualValues(2, vault2.Id)
+	s.Require().Len(s.keeper.GetAllVaults(s.ctx), 2)
+}
+
+func (s *KeeperTestSuite) TestDepositWithdrawVault() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	vault := s.createVault(s.addr(1), pair.Id, utils.ParseDec("0.02"), utils.ParseDec("0.1"))
+
+	// Deposits are not allowed until the pair has the last price.
+	s.fundAddr(s.addr(2), utils.ParseCoins("1000000denom1,2000000denom2"))
+	_, err := s.keeper.DepositVault(s.ctx, types.NewMsgDepositVault(
+		s.addr(2), vault.Id, utils.ParseCoins("1000000denom1,2000000denom2")))
+	s.Require().EqualError(err, "pair 1 has no last price: invalid request")
+
+	pair.LastPrice = utils.ParseDecP("2.0")
+	s.keeper.SetPair(s.ctx, pair)
+
+	_, err = s.keeper.DepositVault(s.ctx, types.NewMsgDepositVault(
+		s.addr(2), vault.Id, utils.ParseCoins("1000000denom1,1000000denom3")))
+	s.Require().ErrorIs(err, types.ErrInvalidCoinDenom)
+
+	// The first deposit mints shares as much as the value of the deposit.
+	share := s.depositVault(s.addr(2), vault.Id, utils.ParseCoins("1000000denom1,2000000denom2"), false)
+	s.Require().True(coinEq(utils.ParseCoin("4000000vault1"), share))
+	vault, _ = s.keeper.GetVault(s.ctx, vault.Id)
+	s.Require().True(decEq(sdk.OneDec(), vault.HighWaterMark))
+
+	// Later deposits are accepted in proportion to the vault's balances.
+	s.fundAddr(s.addr(3), utils.ParseCoins("500000denom1,2000000denom2"))
+	share = s.depositVault(s.addr(3), vault.Id, utils.ParseCoins("500000denom1,2000000denom2"), false)
+	s.Require().True(coinEq(utils.ParseCoin("2000000vault1"), share))
+	s.Require().True(coinsEq(utils.ParseCoins("1000000denom2,2000000vault1"), s.getBalances(s.addr(3))))
+
+	_, err = s.keeper.WithdrawVault(s.ctx, types.NewMsgWithdrawVault(s.addr(3), vault.Id, utils.ParseCoin("1000pool1")))
+	s.Require().ErrorIs(err, types.ErrInvalidCoinDenom)
+
+	withdrawn, err := s.keeper.WithdrawVault(s.ctx, types.NewMsgWithdrawVault(s.addr(3), vault.Id, utils.ParseCoin("2000000vault1")))
+	s.Require().NoError(err)
+	s.Require().True(coinsEq(utils.ParseCoins("499999denom1,999999denom2"), withdrawn))
+	s.Require().True(coinsEq(utils.ParseCoins("499999denom1,1999999denom2"), s.getBalances(s.addr(3))))
+	s.Require().True(intEq(sdk.NewInt(4000000), s.keeper.GetVaultShareSupply(s.ctx, vault)))
+}
+
+func (s *KeeperTestSuite) TestRebalanceVault() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pair.LastPrice = utils.ParseDecP("1.0")
+	s.keeper.SetPair(s.ctx, pair)
+	vault := s.createVault(s.addr(1), pair.Id, utils.ParseDec("0.02"), utils.ParseDec("0.1"))
+
+	for _, tc := range []struct {
+		name               string
+		operator           sdk.AccAddress
+		minPrice, maxPrice sdk.Dec
+		expectedErr        string
+	}{
+		{
+			"happy case",
+			s.addr(1),
+			utils.ParseDec("0.98"),
+			utils.ParseDec("1.02"),
+			"",
+		},
+		{
+			"not the operator",
+			s.addr(2),
+			utils.ParseDec("0.98"),
+			utils.ParseDec("1.02"),
+			s.addr(2).String() + " is not the operator of vault 1: not the vault operator",
+		},
+		{
+			"min price too low",
+			s.addr(1),
+			utils.ParseDec("0.97"),
+			utils.ParseDec("1.02"),
+			"min price must not be lower than 0.980000000000000000: vault price range is out of the vault's strategy",
+		},
+		{
+			"max price too high",
+			s.addr(1),
+			utils.ParseDec("0.98"),
+			utils.ParseDec("1.03"),
+			"max price must not be higher than 1.020000000000000000: vault price range is out of the vault's strategy",
+		},
+		{
+			"range not containing the last price",
+			s.addr(1),
+			utils.ParseDec("1.005"),
+			utils.ParseDec("1.02"),
+			"initial price must not be lower than min price: vault price range is out of the vault's strategy",
+		},
+	} {
+		s.Run(tc.name, func() {
+			err := s.keeper.RebalanceVault(s.ctx, types.NewMsgRebalanceVault(tc.operator, vault.Id, tc.minPrice, tc.maxPrice))
+			if tc.expectedErr == "" {
+				s.Require().NoError(err)
+				vault, _ := s.keeper.GetVault(s.ctx, vault.Id)
+				s.Require().True(decEq(tc.minPrice, *vault.MinPrice))
+				s.Require().True(decEq(tc.maxPrice, *vault.MaxPrice))
+			} else {
+				s.Require().EqualError(err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestVaultOrders() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pair.LastPrice = utils.ParseDecP("1.0")
+	s.keeper.SetPair(s.ctx, pair)
+	vault := s.createVault(s.addr(1), pair.Id, utils.ParseDec("0.1"), sdk.ZeroDec())
+	s.depositVault(s.addr(2), vault.Id, utils.ParseCoins("1000000000denom1,1000000000denom2"), true)
+
+	// The vault provides no orders until its range is set.
+	s.buyLimitOrder(s.addr(3), pair.Id, utils.ParseDec("1.01"), sdk.NewInt(1000000), 0, true)
+	s.nextBlock()
+	s.Require().True(s.getBalance(s.addr(3), "denom1").IsZero())
+
+	s.Require().NoError(s.keeper.RebalanceVault(s.ctx, types.NewMsgRebalanceVault(
+		s.addr(1), vault.Id, utils.ParseDec("0.95"), utils.ParseDec("1.05"))))
+
+	s.buyLimitOrder(s.addr(4), pair.Id, utils.ParseDec("1.01"), sdk.NewInt(1000000), 0, true)
+	s.nextBlock()
+	s.Require().True(intEq(sdk.NewInt(1000000), s.getBalance(s.addr(4), "denom1").Amount))
+
+	reserveAddr := vault.GetReserveAddress()
+	s.Require().True(intEq(sdk.NewInt(999000000), s.getBalance(reserveAddr, "denom1").Amount))
+	s.Require().True(intEq(sdk.NewInt(1001000000), s.getBalance(reserveAddr, "denom2").Amount))
+
+	resp, err := s.querier.Vault(sdk.WrapSDKContext(s.ctx), &types.QueryVaultRequest{VaultId: vault.Id})
+	s.Require().NoError(err)
+	s.Require().True(coinEq(s.getBalance(reserveAddr, "denom1"), resp.Vault.Balances.BaseCoin))
+	s.Require().True(intEq(sdk.NewInt(2000000000), resp.Vault.ShareSupply))
+	s.Require().NotNil(resp.Vault.ShareValue)
+}
+
+func (s *KeeperTestSuite) TestVaultOperatorFee() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pair.LastPrice = utils.ParseDecP("1.0")
+	s.keeper.SetPair(s.ctx, pair)
+	operator := s.addr(1)
+	vault := s.createVault(operator, pair.Id, utils.ParseDec("0.02"), utils.ParseDec("0.2"))
+	s.depositVault(s.addr(2), vault.Id, utils.ParseCoins("1000000denom1,1000000denom2"), true)
+
+	// The vault makes a profit of 200000denom2.
+	s.fundAddr(vault.GetReserveAddress(), utils.ParseCoins("200000denom2"))
+
+	s.Require().NoError(s.keeper.RebalanceVault(s.ctx, types.NewMsgRebalanceVault(
+		operator, vault.Id, utils.ParseDec("0.99"), utils.ParseDec("1.01"))))
+
+	// The operator fee is 20% of the profit, 40000denom2, paid in shares:
+	// 40000 * 2000000 / (2200000 - 40000) = 37037.03...
+	s.Require().True(coinEq(utils.ParseCoin("37037vault1"), s.getBalance(operator, "vault1")))
+	vault, _ = s.keeper.GetVault(s.ctx, vault.Id)
+	s.Require().True(decEq(utils.ParseDec("2200000").QuoInt64(2037037), vault.HighWaterMark))
+
+	// No more fee is charged without a new profit.
+	s.Require().NoError(s.keeper.RebalanceVault(s.ctx, types.NewMsgRebalanceVault(
+		operator, vault.Id, utils.ParseDec("0.98"), utils.ParseDec("1.02"))))
+	s.Require().True(coinEq(utils.ParseCoin("37037vault1"), s.getBalance(operator, "vault1")))
+}
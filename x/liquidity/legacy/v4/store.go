@@ -0,0 +1,83 @@
+package v4
+
+import (
+	"encoding/json"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/crescent-network/crescent/v4/x/liquidity/types"
+)
+
+// KeyMaxPriceLimitRatio is the key of the max price limit ratio param, which
+// is replaced by the upward and downward price limit ratio params.
+var KeyMaxPriceLimitRatio = []byte("MaxPriceLimitRatio")
+
+// MigrateParams sets both the upward and downward price limit ratio params to
+// the max price limit ratio param, and the price band widening params to
+// their defaults, which disable the widening.
+// All the other params added since v3 are set to their defaults as well, since
+// getting the params panics if any of them is missing.
+func MigrateParams(ctx sdk.Context, paramSpace paramstypes.Subspace) error {
+	upward, downward := types.DefaultUpwardPriceLimitRatio, types.DefaultDownwardPriceLimitRatio
+	if bz := paramSpace.GetRaw(ctx, KeyMaxPriceLimitRatio); bz != nil {
+		var ratio sdk.Dec
+		if err := json.Unmarshal(bz, &ratio); err != nil {
+			return err
+		}
+		upward, downward = ratio, ratio
+	}
+	paramSpace.Set(ctx, types.KeyUpwardPriceLimitRatio, upward)
+	paramSpace.Set(ctx, types.KeyDownwardPriceLimitRatio, downward)
+	paramSpace.Set(ctx, types.KeyPriceBandWideningBatches, uint32(types.DefaultPriceBandWideningBatches))
+	paramSpace.Set(ctx, types.KeyMaxPriceBandWideningSteps, uint32(types.DefaultMaxPriceBandWideningSteps))
+	defaultParams := types.DefaultParams()
+	for _, pair := range defaultParams.ParamSetPairs() {
+		if !paramSpace.Has(ctx, pair.Key) {
+			paramSpace.Set(ctx, pair.Key, pair.Value)
+		}
+	}
+	return nil
+}
+
+// MigratePairs moves the max price limit ratio overridden for pairs to both
+// the upward and downward price limit ratios of the pairs.
+func MigratePairs(store sdk.KVStore, cdc codec.BinaryCodec) error {
+	iter := sdk.KVStorePrefixIterator(store, types.PairKeyPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var pair types.Pair
+		if err := cdc.Unmarshal(iter.Value(), &pair); err != nil {
+			return err
+		}
+		if pair.MaxPriceLimitRatio == nil {
+			continue
+		}
+
+		upward, downward := *pair.MaxPriceLimitRatio, *pair.MaxPriceLimitRatio
+		pair.UpwardPriceLimitRatio = &upward
+		pair.DownwardPriceLimitRatio = &downward
+		pair.MaxPriceLimitRatio = nil
+
+		bz, err := cdc.Marshal(&pair)
+		if err != nil {
+			return err
+		}
+		store.Set(iter.Key(), bz)
+	}
+
+	return nil
+}
+
+func MigrateStore(ctx sdk.Context, storeKey sdk.StoreKey, cdc codec.BinaryCodec, paramSpace paramstypes.Subspace) error {
+	if err := MigrateParams(ctx, paramSpace); err != nil {
+		return err
+	}
+	store := ctx.KVStore(storeKey)
+	if err := MigratePairs(store, cdc); err != nil {
+		return err
+	}
+	return nil
+}
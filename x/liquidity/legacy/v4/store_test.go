@@ -0,0 +1,90 @@
+package v4_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	chain "github.com/crescent-network/crescent/v4/app"
+	utils "github.com/crescent-network/crescent/v4/types"
+	v4liquidity "github.com/crescent-network/crescent/v4/x/liquidity/legacy/v4"
+	"github.com/crescent-network/crescent/v4/x/liquidity/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	app := chain.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	// Set the max price limit ratio param as it was stored before.
+	paramsStore := ctx.KVStore(app.GetKey(paramstypes.StoreKey))
+	paramsStore.Set(append([]byte(types.ModuleName+"/"), v4liquidity.KeyMaxPriceLimitRatio...), []byte(`"0.200000000000000000"`))
+
+	ratio := utils.ParseDec("0.05")
+	pair1 := types.NewPair(1, "denom1", "denom2")
+	pair1.MaxPriceLimitRatio = &ratio
+	pair2 := types.NewPair(2, "denom2", "denom3")
+	app.LiquidityKeeper.SetPair(ctx, pair1)
+	app.LiquidityKeeper.SetPair(ctx, pair2)
+
+	require.NoError(t, v4liquidity.MigrateStore(
+		ctx, app.GetKey(types.StoreKey), app.AppCodec(), app.GetSubspace(types.ModuleName)))
+
+	params := app.LiquidityKeeper.GetParams(ctx)
+	require.Equal(t, utils.ParseDec("0.2"), params.UpwardPriceLimitRatio)
+	require.Equal(t, utils.ParseDec("0.2"), params.DownwardPriceLimitRatio)
+	require.Zero(t, params.PriceBandWideningBatches)
+	require.Zero(t, params.MaxPriceBandWideningSteps)
+
+	pair1, _ = app.LiquidityKeeper.GetPair(ctx, pair1.Id)
+	require.Nil(t, pair1.MaxPriceLimitRatio)
+	require.Equal(t, &ratio, pair1.UpwardPriceLimitRatio)
+	require.Equal(t, &ratio, pair1.DownwardPriceLimitRatio)
+	pair2, _ = app.LiquidityKeeper.GetPair(ctx, pair2.Id)
+	require.Nil(t, pair2.UpwardPriceLimitRatio)
+	require.Nil(t, pair2.DownwardPriceLimitRatio)
+}
+
+// v3ParamKeys are the keys of the params stored by v3 of the module.
+var v3ParamKeys = []string{
+	"BatchSize", "TickPrecision", "FeeCollectorAddress", "DustCollectorAddress", "MinInitialPoolCoinSupply",
+	"PairCreationFee", "PoolCreationFee", "MinInitialDepositAmount", "MaxPriceLimitRatio",
+	"MaxNumMarketMakingOrderTicks", "MaxOrderLifespan", "SwapFeeRate", "WithdrawFeeRate", "DepositExtraGas",
+	"WithdrawExtraGas", "OrderExtraGas", "MaxNumActivePoolsPerPair",
+}
+
+func TestMigrateParams_V3ParamStore(t *testing.T) {
+	app := chain.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	// Leave only the params stored by v3.
+	paramsStore := prefix.NewStore(ctx.KVStore(app.GetKey(paramstypes.StoreKey)), []byte(types.ModuleName+"/"))
+	paramsStore.Set(v4liquidity.KeyMaxPriceLimitRatio, []byte(`"0.100000000000000000"`))
+	v3Keys := map[string]struct{}{}
+	for _, key := range v3ParamKeys {
+		v3Keys[key] = struct{}{}
+	}
+	var keysToDelete [][]byte
+	iter := paramsStore.Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		if _, ok := v3Keys[string(iter.Key())]; !ok {
+			keysToDelete = append(keysToDelete, iter.Key())
+		}
+	}
+	iter.Close()
+	require.NotEmpty(t, keysToDelete)
+	for _, key := range keysToDelete {
+		paramsStore.Delete(key)
+	}
+	require.Panics(t, func() { app.LiquidityKeeper.GetParams(ctx) })
+
+	require.NoError(t, v4liquidity.MigrateStore(
+		ctx, app.GetKey(types.StoreKey), app.AppCodec(), app.GetSubspace(types.ModuleName)))
+
+	expected := types.DefaultParams()
+	expected.UpwardPriceLimitRatio = utils.ParseDec("0.1")
+	expected.DownwardPriceLimitRatio = utils.ParseDec("0.1")
+	require.Equal(t, expected, app.LiquidityKeeper.GetParams(ctx))
+}
@@ -18,11 +18,13 @@ import (
 
 // Simulation parameter constants.
 const (
-	batchSize          = "batch_size"
-	tickPrecision      = "tick_precision"
-	maxPriceLimitRatio = "max_price_limit_ratio"
-	withdrawFeeRate    = "withdraw_fee_rate"
-	maxOrderLifespan   = "max_order_lifespan"
+	batchSize               = "batch_size"
+	tickPrecision           = "tick_precision"
+	upwardPriceLimitRatio   = "upward_price_limit_ratio"
+	downwardPriceLimitRatio = "downward_price_limit_ratio"
+	withdrawFeeRate         = "withdraw_fee_rate"
+	maxOrderLifespan        = "max_order_lifespan"
+	swapFeeRate             = "swap_fee_rate"
 )
 
 func GenBatchSize(r *rand.Rand) uint32 {
@@ -33,7 +35,7 @@ func GenTickPrecision(r *rand.Rand) uint32 {
 	return uint32(2 + r.Int31n(4))
 }
 
-func GenMaxPriceRatio(r *rand.Rand) sdk.Dec {
+func GenPriceLimitRatio(r *rand.Rand) sdk.Dec {
 	return utils.RandomDec(r, utils.ParseDec("0.1"), utils.ParseDec("0.2"))
 }
 
@@ -45,6 +47,10 @@ func GenMaxOrderLifespan(r *rand.Rand) time.Duration {
 	return time.Duration(r.Int63n(int64(72 * time.Hour)))
 }
 
+func GenSwapFeeRate(r *rand.Rand) sdk.Dec {
+	return simtypes.RandomDecAmount(r, sdk.NewDecWithPrec(3, 3))
+}
+
 // RandomizedGenState generates a random GenesisState for liquidity.
 func RandomizedGenState(simState *module.SimulationState) {
 	genesis := types.DefaultGenesis()
@@ -60,8 +66,13 @@ func RandomizedGenState(simState *module.SimulationState) {
 	)
 
 	simState.AppParams.GetOrGenerate(
-		simState.Cdc, maxPriceLimitRatio, &genesis.Params.MaxPriceLimitRatio, simState.Rand,
-		func(r *rand.Rand) { genesis.Params.MaxPriceLimitRatio = GenMaxPriceRatio(r) },
+		simState.Cdc, upwardPriceLimitRatio, &genesis.Params.UpwardPriceLimitRatio, simState.Rand,
+		func(r *rand.Rand) { genesis.Params.UpwardPriceLimitRatio = GenPriceLimitRatio(r) },
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, downwardPriceLimitRatio, &genesis.Params.DownwardPriceLimitRatio, simState.Rand,
+		func(r *rand.Rand) { genesis.Params.DownwardPriceLimitRatio = GenPriceLimitRatio(r) },
 	)
 
 	simState.AppParams.GetOrGenerate(
@@ -74,6 +85,11 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { genesis.Params.MaxOrderLifespan = GenMaxOrderLifespan(r) },
 	)
 
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, swapFeeRate, &genesis.Params.SwapFeeRate, simState.Rand,
+		func(r *rand.Rand) { genesis.Params.SwapFeeRate = GenSwapFeeRate(r) },
+	)
+
 	bz, _ := json.MarshalIndent(genesis, "", " ")
 	fmt.Printf("Selected randomly generated liquidity parameters:\n%s\n", bz)
 	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(genesis)
@@ -0,0 +1,52 @@
+package simulation_test
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"github.com/crescent-network/crescent/v4/x/liquidity/simulation"
+	"github.com/crescent-network/crescent/v4/x/liquidity/types"
+)
+
+// TestRandomizedGenState tests the normal scenario of applying RandomizedGenState.
+func TestRandomizedGenState(t *testing.T) {
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+	r := rand.New(rand.NewSource(1))
+
+	simState := module.SimulationState{
+		AppParams:    make(simtypes.AppParams),
+		Cdc:          cdc,
+		Rand:         r,
+		NumBonded:    3,
+		Accounts:     simtypes.RandomAccounts(r, 3),
+		InitialStake: 1000,
+		GenState:     make(map[string]json.RawMessage),
+	}
+
+	simulation.RandomizedGenState(&simState)
+
+	var genState types.GenesisState
+	simState.Cdc.MustUnmarshalJSON(simState.GenState[types.ModuleName], &genState)
+	require.NoError(t, genState.Validate())
+
+	require.Equal(t, uint32(1), genState.Params.BatchSize)
+	require.Equal(t, uint32(2), genState.Params.TickPrecision)
+	require.Equal(t, sdk.MustNewDecFromStr("0.161360745258595679"), genState.Params.UpwardPriceLimitRatio)
+	require.Equal(t, sdk.MustNewDecFromStr("0.132911778057366069"), genState.Params.DownwardPriceLimitRatio)
+	require.Equal(t, sdk.MustNewDecFromStr("0.009589619167596062"), genState.Params.WithdrawFeeRate)
+	require.Equal(t, time.Duration(216736693774911), genState.Params.MaxOrderLifespan)
+	require.Equal(t, sdk.MustNewDecFromStr("0.000732209256898655"), genState.Params.SwapFeeRate)
+	require.Empty(t, genState.Pairs)
+	require.Empty(t, genState.Pools)
+}
@@ -236,15 +236,24 @@ func SimulateMsgCreatePool(ak types.AccountKeeper, bk types.BankKeeper, k keeper
 			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreatePool, "no account to create a pool"), nil, nil
 		}
 
+		depositableCoins := spendable.Sub(params.PoolCreationFee)
+		baseAmt := utils.RandomInt(r, minDepositAmt, depositableCoins.AmountOf(pair.BaseCoinDenom))
+		quoteAmt := utils.RandomInt(r, minDepositAmt, depositableCoins.AmountOf(pair.QuoteCoinDenom))
+		if lowest, highest, limited := initialPoolPriceLimits(k, ctx, pair); limited {
+			// deposit coins at a price within the limits
+			price := utils.RandomDec(r, lowest, highest)
+			quoteAmt = price.MulInt(baseAmt).TruncateInt()
+			if maxQuoteAmt := depositableCoins.AmountOf(pair.QuoteCoinDenom); quoteAmt.GT(maxQuoteAmt) {
+				quoteAmt = maxQuoteAmt
+				baseAmt = quoteAmt.ToDec().Quo(price).TruncateInt()
+			}
+			if baseAmt.LT(minDepositAmt) || quoteAmt.LT(minDepositAmt) {
+				return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreatePool, "insufficient deposit coins"), nil, nil
+			}
+		}
 		depositCoins := sdk.NewCoins(
-			sdk.NewCoin(
-				pair.BaseCoinDenom,
-				utils.RandomInt(r, minDepositAmt, spendable.Sub(params.PoolCreationFee).AmountOf(pair.BaseCoinDenom)),
-			),
-			sdk.NewCoin(
-				pair.QuoteCoinDenom,
-				utils.RandomInt(r, minDepositAmt, spendable.Sub(params.PoolCreationFee).AmountOf(pair.QuoteCoinDenom)),
-			),
+			sdk.NewCoin(pair.BaseCoinDenom, baseAmt),
+			sdk.NewCoin(pair.QuoteCoinDenom, quoteAmt),
 		)
 
 		msg := types.NewMsgCreatePool(simAccount.Address, pair.Id, depositCoins)
@@ -313,9 +322,16 @@ func SimulateMsgCreateRangedPool(ak types.AccountKeeper, bk types.BankKeeper, k
 			} else {
 				y = utils.RandomInt(r, sdk.ZeroInt(), depositableCoins.AmountOf(pair.BaseCoinDenom))
 			}
-			minPrice = amm.RandomTick(r, utils.ParseDec("0.00001"), utils.ParseDec("1"), int(tickPrec))
-			maxPrice = amm.RandomTick(r, minPrice.Mul(utils.ParseDec("1.01")), utils.ParseDec("10000"), int(tickPrec))
-			initialPrice = amm.RandomTick(r, minPrice, maxPrice, int(tickPrec))
+			if lowest, highest, limited := initialPoolPriceLimits(k, ctx, pair); limited {
+				// make the price range include a price within the limits
+				initialPrice = amm.RandomTick(r, lowest, highest, int(tickPrec))
+				minPrice = amm.RandomTick(r, initialPrice.QuoInt64(100), initialPrice, int(tickPrec))
+				maxPrice = amm.RandomTick(r, initialPrice.Mul(utils.ParseDec("1.01")), initialPrice.MulInt64(100), int(tickPrec))
+			} else {
+				minPrice = amm.RandomTick(r, utils.ParseDec("0.00001"), utils.ParseDec("1"), int(tickPrec))
+				maxPrice = amm.RandomTick(r, minPrice.Mul(utils.ParseDec("1.01")), utils.ParseDec("10000"), int(tickPrec))
+				initialPrice = amm.RandomTick(r, minPrice, maxPrice, int(tickPrec))
+			}
 			pool, err := amm.CreateRangedPool(x, y, minPrice, maxPrice, initialPrice)
 			ax, ay := pool.Balances()
 			if err == nil && (ax.GTE(minDepositAmt) || ay.GTE(minDepositAmt)) {
@@ -503,7 +519,7 @@ func SimulateMsgLimitOrder(ak types.AccountKeeper, bk types.BankKeeper, k keeper
 		if pair.LastPrice != nil {
 			minPrice, maxPrice = minMaxPrice(k, ctx, *pair.LastPrice)
 		} else {
-			if pool != (types.Pool{}) {
+			if pool.Id != 0 {
 				rx, ry := k.GetPoolBalances(ctx, pool)
 				ammPool := pool.AMMPool(rx.Amount, ry.Amount, sdk.Int{})
 				minPrice, maxPrice = minMaxPrice(k, ctx, ammPool.Price())
@@ -536,7 +552,7 @@ func SimulateMsgLimitOrder(ak types.AccountKeeper, bk types.BankKeeper, k keeper
 			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgLimitOrder, "insufficient funds"), nil, nil
 		}
 
-		lifespan := time.Duration(r.Int63n(int64(params.MaxOrderLifespan)))
+		lifespan := time.Duration(r.Int63n(int64(k.GetPairMaxOrderLifespan(ctx, pair))))
 
 		msg := types.NewMsgLimitOrder(
 			simAccount.Address, pair.Id, dir, offerCoin, demandCoinDenom, price, amt, lifespan)
@@ -566,8 +582,6 @@ func SimulateMsgMarketOrder(ak types.AccountKeeper, bk types.BankKeeper, k keepe
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
 		fundAccountsOnce(r, ctx, bk, accs)
 
-		params := k.GetParams(ctx)
-
 		accs = utils.ShuffleSimAccounts(r, accs)
 
 		var simAccount simtypes.Account
@@ -589,7 +603,7 @@ func SimulateMsgMarketOrder(ak types.AccountKeeper, bk types.BankKeeper, k keepe
 			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgMarketOrder, "no account to make a market order"), nil, nil
 		}
 
-		minPrice, maxPrice := types.PriceLimits(*pair.LastPrice, k.GetMaxPriceLimitRatio(ctx), int(k.GetTickPrecision(ctx)))
+		minPrice, maxPrice := k.PriceLimits(ctx, pair)
 
 		minAmt := sdk.MaxInt(
 			amm.MinCoinAmount,
@@ -614,7 +628,7 @@ func SimulateMsgMarketOrder(ak types.AccountKeeper, bk types.BankKeeper, k keepe
 			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgMarketOrder, "insufficient funds"), nil, nil
 		}
 
-		lifespan := time.Duration(r.Int63n(int64(params.MaxOrderLifespan)))
+		lifespan := time.Duration(r.Int63n(int64(k.GetPairMaxOrderLifespan(ctx, pair))))
 
 		msg := types.NewMsgMarketOrder(
 			simAccount.Address, pair.Id, dir, offerCoin, demandCoinDenom, amt, lifespan)
@@ -673,7 +687,7 @@ func SimulateMsgMMOrder(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Ke
 			minPrice = utils.ParseDec("0.5")
 			maxPrice = utils.ParseDec("5.0")
 		} else {
-			minPrice, maxPrice = types.PriceLimits(*pair.LastPrice, k.GetMaxPriceLimitRatio(ctx), tickPrec)
+			minPrice, maxPrice = k.PriceLimits(ctx, pair)
 		}
 		midPrice := minPrice.Add(maxPrice).QuoInt64(2)
 
@@ -720,7 +734,7 @@ func SimulateMsgMMOrder(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Ke
 			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgMMOrder, "insufficient funds"), nil, nil
 		}
 
-		lifespan := time.Duration(r.Int63n(int64(k.GetMaxOrderLifespan(ctx))))
+		lifespan := time.Duration(r.Int63n(int64(k.GetPairMaxOrderLifespan(ctx, pair))))
 
 		msg := types.NewMsgMMOrder(
 			simAccount.Address, pair.Id,
@@ -994,6 +1008,17 @@ func findPairToCreatePool(r *rand.Rand, k keeper.Keeper, ctx sdk.Context, spenda
 	return types.Pair{}, false
 }
 
+// initialPoolPriceLimits returns the range of the initial price of a new pool
+// in the pair, narrowed not to be affected by rounding, if the initial price
+// is checked by keeper.Keeper.ValidateInitialPoolPrice.
+func initialPoolPriceLimits(k keeper.Keeper, ctx sdk.Context, pair types.Pair) (lowest, highest sdk.Dec, limited bool) {
+	if pair.LastPrice == nil || (pair.IsBootstrapping() && k.GetBypassPoolPriceCheckForNewPairs(ctx)) {
+		return sdk.Dec{}, sdk.Dec{}, false
+	}
+	upward, downward := k.GetPairPriceLimitRatios(ctx, pair)
+	return pair.LastPrice.Mul(sdk.OneDec().Sub(downward.QuoInt64(2))), pair.LastPrice.Mul(sdk.OneDec().Add(upward.QuoInt64(2))), true
+}
+
 func findPairToCreateRangedPool(r *rand.Rand, k keeper.Keeper, ctx sdk.Context, spendable sdk.Coins) (types.Pair, bool) {
 	var hasNeg bool
 	spendable, hasNeg = spendable.SafeSub(k.GetPoolCreationFee(ctx))
@@ -1171,7 +1196,7 @@ loop:
 func minMaxPrice(k keeper.Keeper, ctx sdk.Context, lastPrice sdk.Dec) (sdk.Dec, sdk.Dec) {
 	params := k.GetParams(ctx)
 	tickPrec := int(params.TickPrecision)
-	maxPrice := amm.PriceToDownTick(lastPrice.Mul(sdk.OneDec().Add(params.MaxPriceLimitRatio)), tickPrec)
-	minPrice := amm.PriceToUpTick(lastPrice.Mul(sdk.OneDec().Sub(params.MaxPriceLimitRatio)), tickPrec)
+	maxPrice := amm.PriceToDownTick(lastPrice.Mul(sdk.OneDec().Add(params.UpwardPriceLimitRatio)), tickPrec)
+	minPrice := amm.PriceToUpTick(lastPrice.Mul(sdk.OneDec().Sub(params.DownwardPriceLimitRatio)), tickPrec)
 	return minPrice, maxPrice
 }
@@ -25,9 +25,14 @@ func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
 				return fmt.Sprintf("%d", GenTickPrecision(r))
 			},
 		),
-		simulation.NewSimParamChange(types.ModuleName, string(types.KeyMaxPriceLimitRatio),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyUpwardPriceLimitRatio),
 			func(r *rand.Rand) string {
-				return fmt.Sprintf("\"%s\"", GenMaxPriceRatio(r))
+				return fmt.Sprintf("\"%s\"", GenPriceLimitRatio(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyDownwardPriceLimitRatio),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenPriceLimitRatio(r))
 			},
 		),
 		simulation.NewSimParamChange(types.ModuleName, string(types.KeyWithdrawFeeRate),
@@ -41,5 +46,10 @@ func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
 				return fmt.Sprintf("\"%s\"", bz)
 			},
 		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeySwapFeeRate),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenSwapFeeRate(r))
+			},
+		),
 	}
 }
@@ -13,7 +13,7 @@ func TestParamChanges(t *testing.T) {
 	r := rand.New(rand.NewSource(0))
 
 	paramChanges := simulation.ParamChanges(r)
-	require.Len(t, paramChanges, 5)
+	require.Len(t, paramChanges, 7)
 
 	expected := []struct {
 		composedKey string
@@ -23,9 +23,11 @@ func TestParamChanges(t *testing.T) {
 	}{
 		{"liquidity/BatchSize", "BatchSize", "5", "liquidity"},
 		{"liquidity/TickPrecision", "TickPrecision", "4", "liquidity"},
-		{"liquidity/MaxPriceLimitRatio", "MaxPriceLimitRatio", "\"0.107709506529800694\"", "liquidity"},
-		{"liquidity/WithdrawFeeRate", "WithdrawFeeRate", "\"0.009218100047625633\"", "liquidity"},
-		{"liquidity/MaxOrderLifespan", "MaxOrderLifespan", "\"74699420976708\"", "liquidity"},
+		{"liquidity/UpwardPriceLimitRatio", "UpwardPriceLimitRatio", "\"0.107709506529800694\"", "liquidity"},
+		{"liquidity/DownwardPriceLimitRatio", "DownwardPriceLimitRatio", "\"0.160725623033489936\"", "liquidity"},
+		{"liquidity/WithdrawFeeRate", "WithdrawFeeRate", "\"0.003152673080679543\"", "liquidity"},
+		{"liquidity/MaxOrderLifespan", "MaxOrderLifespan", "\"25063346885298\"", "liquidity"},
+		{"liquidity/SwapFeeRate", "SwapFeeRate", "\"0.000782580377782740\"", "liquidity"},
 	}
 
 	for i, p := range paramChanges {
@@ -0,0 +1,17 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AddressLabeler provides human-readable labels of addresses, usually
+// backed by an on-chain name registry of another module.
+// Labels are only used to make query responses more interpretable and
+// never affect the state.
+type AddressLabeler interface {
+	// Name returns the unique name of the address labeler.
+	Name() string
+	// AddressLabel returns the label of the address.
+	// found is false if the labeler has no label for the address.
+	AddressLabel(ctx sdk.Context, addr sdk.AccAddress) (label string, found bool)
+}
@@ -0,0 +1,76 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	farmingtypes "github.com/crescent-network/crescent/v4/x/farming/types"
+)
+
+// Address versions of the schemes pair escrow and pool reserve addresses are
+// derived with.
+// Each pair and pool records the version its address is derived with, so a
+// new scheme can be introduced without changing the addresses of existing
+// pairs and pools, which are moved to the new scheme by
+// Keeper.MigrateAddresses in an upgrade.
+const (
+	// AddressVersion0 derives 32 bytes addresses from the module name and
+	// the prefixed id.
+	AddressVersion0 uint32 = 0
+
+	// CurrentAddressVersion is the address version of new pairs and pools.
+	CurrentAddressVersion = AddressVersion0
+)
+
+// ValidateAddressVersion returns an error if the address version is unknown.
+func ValidateAddressVersion(version uint32) error {
+	if version > CurrentAddressVersion {
+		return fmt.Errorf("unknown address version: %d", version)
+	}
+	return nil
+}
+
+// DeriveVersionedAddress derives an address of the module from the name
+// with the scheme of the address version.
+func DeriveVersionedAddress(version uint32, name string) (sdk.AccAddress, error) {
+	switch version {
+	case AddressVersion0:
+		return farmingtypes.DeriveAddress(AddressType, ModuleName, name), nil
+	default:
+		return nil, fmt.Errorf("unknown address version: %d", version)
+	}
+}
+
+// VersionedPairEscrowAddress returns the address of the pair's escrow
+// derived with the scheme of the address version.
+func VersionedPairEscrowAddress(version uint32, pairId uint64) (sdk.AccAddress, error) {
+	return DeriveVersionedAddress(
+		version,
+		strings.Join([]string{PairEscrowAddressPrefix, strconv.FormatUint(pairId, 10)}, ModuleAddressNameSplitter))
+}
+
+// VersionedPoolReserveAddress returns the address of the pool's reserve
+// derived with the scheme of the address version.
+func VersionedPoolReserveAddress(version uint32, poolId uint64) (sdk.AccAddress, error) {
+	return DeriveVersionedAddress(
+		version,
+		strings.Join([]string{PoolReserveAddressPrefix, strconv.FormatUint(poolId, 10)}, ModuleAddressNameSplitter))
+}
+
+// VersionedPoolFeeAddress returns the address of the pool's fee account
+// derived with the scheme of the address version.
+func VersionedPoolFeeAddress(version uint32, poolId uint64) (sdk.AccAddress, error) {
+	return DeriveVersionedAddress(
+		version,
+		strings.Join([]string{PoolFeeAddressPrefix, strconv.FormatUint(poolId, 10)}, ModuleAddressNameSplitter))
+}
+
+func mustAddress(addr sdk.AccAddress, err error) sdk.AccAddress {
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
@@ -0,0 +1,28 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/crescent-network/crescent/v4/x/liquidity/types"
+)
+
+func TestVersionedAddresses(t *testing.T) {
+	addr, err := types.VersionedPairEscrowAddress(types.AddressVersion0, 1)
+	require.NoError(t, err)
+	require.Equal(t, types.PairEscrowAddress(1), addr)
+
+	addr, err = types.VersionedPoolReserveAddress(types.AddressVersion0, 1)
+	require.NoError(t, err)
+	require.Equal(t, types.PoolReserveAddress(1), addr)
+
+	addr, err = types.VersionedPoolFeeAddress(types.AddressVersion0, 1)
+	require.NoError(t, err)
+	require.Equal(t, types.PoolFeeAddress(1), addr)
+
+	_, err = types.VersionedPairEscrowAddress(types.CurrentAddressVersion+1, 1)
+	require.EqualError(t, err, "unknown address version: 1")
+	require.EqualError(t, types.ValidateAddressVersion(types.CurrentAddressVersion+1), "unknown address version: 1")
+	require.NoError(t, types.ValidateAddressVersion(types.CurrentAddressVersion))
+}
@@ -0,0 +1,56 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewBatchResult returns a new BatchResult of the pair's batch in which no
+// orders were matched.
+func NewBatchResult(pairId, batchId uint64, height int64, t time.Time) BatchResult {
+	return BatchResult{
+		PairId:             pairId,
+		BatchId:            batchId,
+		Matched:            false,
+		MatchPrice:         nil,
+		MatchedBaseAmount:  sdk.ZeroInt(),
+		MatchedQuoteAmount: sdk.ZeroInt(),
+		ExecutedHeight:     height,
+		ExecutedAt:         t,
+	}
+}
+
+// Validate validates BatchResult for genesis.
+func (result BatchResult) Validate() error {
+	if result.PairId == 0 {
+		return fmt.Errorf("pair id must not be 0")
+	}
+	if result.BatchId == 0 {
+		return fmt.Errorf("batch id must not be 0")
+	}
+	if result.Matched {
+		if result.MatchPrice == nil || !result.MatchPrice.IsPositive() {
+			return fmt.Errorf("match price must be positive: %v", result.MatchPrice)
+		}
+	} else if result.MatchPrice != nil {
+		return fmt.Errorf("match price must be nil if not matched")
+	}
+	if result.MatchedBaseAmount.IsNil() || result.MatchedBaseAmount.IsNegative() {
+		return fmt.Errorf("matched base amount must not be negative: %s", result.MatchedBaseAmount)
+	}
+	if result.MatchedQuoteAmount.IsNil() || result.MatchedQuoteAmount.IsNegative() {
+		return fmt.Errorf("matched quote amount must not be negative: %s", result.MatchedQuoteAmount)
+	}
+	if result.ExecutedHeight <= 0 {
+		return fmt.Errorf("executed height must be positive: %d", result.ExecutedHeight)
+	}
+	return nil
+}
+
+// IsPrunable returns whether the result has been kept for the retention
+// period and can be pruned at the given time.
+func (result BatchResult) IsPrunable(t time.Time, retention time.Duration) bool {
+	return !t.Before(result.ExecutedAt.Add(retention))
+}
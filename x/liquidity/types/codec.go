@@ -6,6 +6,7 @@ import (
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 // RegisterLegacyAminoCodec registers the necessary x/liquidity interfaces and concrete types
@@ -15,6 +16,7 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgCreatePool{}, "liquidity/MsgCreatePool", nil)
 	cdc.RegisterConcrete(&MsgCreateRangedPool{}, "liquidity/MsgCreateRangedPool", nil)
 	cdc.RegisterConcrete(&MsgDeposit{}, "liquidity/MsgDeposit", nil)
+	cdc.RegisterConcrete(&MsgDepositSingleAsset{}, "liquidity/MsgDepositSingleAsset", nil)
 	cdc.RegisterConcrete(&MsgWithdraw{}, "liquidity/MsgWithdraw", nil)
 	cdc.RegisterConcrete(&MsgLimitOrder{}, "liquidity/MsgLimitOrder", nil)
 	cdc.RegisterConcrete(&MsgMarketOrder{}, "liquidity/MsgMarketOrder", nil)
@@ -22,6 +24,24 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgCancelOrder{}, "liquidity/MsgCancelOrder", nil)
 	cdc.RegisterConcrete(&MsgCancelAllOrders{}, "liquidity/MsgCancelAllOrders", nil)
 	cdc.RegisterConcrete(&MsgCancelMMOrder{}, "liquidity/MsgCancelMMOrder", nil)
+	cdc.RegisterConcrete(&MsgPruneExpired{}, "liquidity/MsgPruneExpired", nil)
+	cdc.RegisterConcrete(&MsgSetPairMetadata{}, "liquidity/MsgSetPairMetadata", nil)
+	cdc.RegisterConcrete(&MsgCreateVault{}, "liquidity/MsgCreateVault", nil)
+	cdc.RegisterConcrete(&MsgDepositVault{}, "liquidity/MsgDepositVault", nil)
+	cdc.RegisterConcrete(&MsgWithdrawVault{}, "liquidity/MsgWithdrawVault", nil)
+	cdc.RegisterConcrete(&MsgRebalanceVault{}, "liquidity/MsgRebalanceVault", nil)
+	cdc.RegisterConcrete(&MsgRenewOrder{}, "liquidity/MsgRenewOrder", nil)
+	cdc.RegisterConcrete(&MsgSwapExactIn{}, "liquidity/MsgSwapExactIn", nil)
+	cdc.RegisterConcrete(&MsgCommitOrder{}, "liquidity/MsgCommitOrder", nil)
+	cdc.RegisterConcrete(&MsgRevealOrder{}, "liquidity/MsgRevealOrder", nil)
+	cdc.RegisterConcrete(&MsgAmendOrder{}, "liquidity/MsgAmendOrder", nil)
+	cdc.RegisterConcrete(&PoolMigrationProposal{}, "liquidity/PoolMigrationProposal", nil)
+	cdc.RegisterConcrete(&PairMetadataProposal{}, "liquidity/PairMetadataProposal", nil)
+	cdc.RegisterConcrete(&PairCircuitBreakerProposal{}, "liquidity/PairCircuitBreakerProposal", nil)
+	cdc.RegisterConcrete(&PairBatchWindowProposal{}, "liquidity/PairBatchWindowProposal", nil)
+	cdc.RegisterConcrete(&PairOrderAmountLimitsProposal{}, "liquidity/PairOrderAmountLimitsProposal", nil)
+	cdc.RegisterConcrete(&PairParamsProposal{}, "liquidity/PairParamsProposal", nil)
+	cdc.RegisterConcrete(&PoolMaxReserveAmountProposal{}, "liquidity/PoolMaxReserveAmountProposal", nil)
 }
 
 // RegisterInterfaces registers the x/liquidity interfaces types with the
@@ -33,6 +53,7 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgCreatePool{},
 		&MsgCreateRangedPool{},
 		&MsgDeposit{},
+		&MsgDepositSingleAsset{},
 		&MsgWithdraw{},
 		&MsgLimitOrder{},
 		&MsgMarketOrder{},
@@ -40,6 +61,27 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgCancelOrder{},
 		&MsgCancelAllOrders{},
 		&MsgCancelMMOrder{},
+		&MsgPruneExpired{},
+		&MsgSetPairMetadata{},
+		&MsgCreateVault{},
+		&MsgDepositVault{},
+		&MsgWithdrawVault{},
+		&MsgRebalanceVault{},
+		&MsgRenewOrder{},
+		&MsgSwapExactIn{},
+		&MsgCommitOrder{},
+		&MsgRevealOrder{},
+		&MsgAmendOrder{},
+	)
+	registry.RegisterImplementations(
+		(*govtypes.Content)(nil),
+		&PoolMigrationProposal{},
+		&PairMetadataProposal{},
+		&PairCircuitBreakerProposal{},
+		&PairBatchWindowProposal{},
+		&PairOrderAmountLimitsProposal{},
+		&PairParamsProposal{},
+		&PoolMaxReserveAmountProposal{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -0,0 +1,22 @@
+package types
+
+import (
+	_ "embed"
+)
+
+// fileDescriptorSet is the serialized google.protobuf.FileDescriptorSet of
+// the module's proto files including the comments, generated by
+// scripts/protocgen.sh.
+// The descriptors registered by the generated code don't have comments, since
+// the source code info is stripped out of them.
+//
+//go:embed descriptors.binpb
+var fileDescriptorSet []byte
+
+// FileDescriptorSet returns the serialized google.protobuf.FileDescriptorSet
+// of the module's proto files, including the comments as source code info.
+func FileDescriptorSet() []byte {
+	bz := make([]byte, len(fileDescriptorSet))
+	copy(bz, fileDescriptorSet)
+	return bz
+}
@@ -0,0 +1,67 @@
+package types_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"testing"
+
+	gogoproto "github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	"github.com/crescent-network/crescent/v4/x/liquidity/types"
+)
+
+func fileDescriptorSet(t *testing.T) *descriptorpb.FileDescriptorSet {
+	t.Helper()
+	var fds descriptorpb.FileDescriptorSet
+	require.NoError(t, proto.Unmarshal(types.FileDescriptorSet(), &fds))
+	return &fds
+}
+
+func TestFileDescriptorSet(t *testing.T) {
+	fds := fileDescriptorSet(t)
+
+	var txFile *descriptorpb.FileDescriptorProto
+	for _, file := range fds.File {
+		if file.GetName() == "crescent/liquidity/v1beta1/tx.proto" {
+			txFile = file
+		}
+	}
+	require.NotNil(t, txFile)
+
+	// The comments of the fields are kept.
+	found := false
+	for _, loc := range txFile.GetSourceCodeInfo().GetLocation() {
+		if loc.GetLeadingComments() == " price specifies the order price\n" {
+			found = true
+		}
+	}
+	require.True(t, found)
+}
+
+// TestProtoDescriptorsInSync makes sure that the embedded descriptors are
+// regenerated along with the generated code.
+func TestProtoDescriptorsInSync(t *testing.T) {
+	fds := fileDescriptorSet(t)
+	require.NotEmpty(t, fds.File)
+
+	for _, file := range fds.File {
+		gz := gogoproto.FileDescriptor(file.GetName())
+		require.NotNil(t, gz, file.GetName())
+		r, err := gzip.NewReader(bytes.NewReader(gz))
+		require.NoError(t, err)
+		bz, err := io.ReadAll(r)
+		require.NoError(t, err)
+		var registered descriptorpb.FileDescriptorProto
+		require.NoError(t, proto.Unmarshal(bz, &registered))
+
+		embedded := proto.Clone(file).(*descriptorpb.FileDescriptorProto)
+		embedded.SourceCodeInfo = nil
+		// The code generator adds its own file options.
+		registered.Options, embedded.Options = nil, nil
+		require.True(t, proto.Equal(&registered, embedded), "descriptors of %s are out of sync", file.GetName())
+	}
+}
@@ -26,4 +26,20 @@ var (
 	ErrTooLargePool              = sdkerrors.Register(ModuleName, 18, "too large pool")
 	ErrTooManyPools              = sdkerrors.Register(ModuleName, 19, "too many pools in the pair")
 	ErrPriceNotOnTicks           = sdkerrors.Register(ModuleName, 20, "price is not on ticks")
+	ErrInsufficientPriceHistory  = sdkerrors.Register(ModuleName, 21, "insufficient price history")
+	ErrMissingPriceHistory       = sdkerrors.Register(ModuleName, 22, "price history has missing blocks")
+	ErrWrongPoolType             = sdkerrors.Register(ModuleName, 23, "wrong pool type")
+	ErrUnsupportedPriceExponent  = sdkerrors.Register(ModuleName, 24, "unsupported price exponent")
+	ErrTooLargeOrder             = sdkerrors.Register(ModuleName, 25, "too large order")
+	ErrPairMetadataAlreadySet    = sdkerrors.Register(ModuleName, 26, "pair metadata is already set")
+	ErrFarmingNotSupported       = sdkerrors.Register(ModuleName, 27, "farming is not supported")
+	ErrPairHalted                = sdkerrors.Register(ModuleName, 28, "pair is halted by the circuit breaker")
+	ErrNotVaultOperator          = sdkerrors.Register(ModuleName, 29, "not the vault operator")
+	ErrVaultRangeOutOfStrategy   = sdkerrors.Register(ModuleName, 30, "vault price range is out of the vault's strategy")
+	ErrOrderNotRenewable         = sdkerrors.Register(ModuleName, 31, "the order cannot be renewed")
+	ErrInvalidLotSize            = sdkerrors.Register(ModuleName, 32, "order amount is not a multiple of the lot size")
+	ErrTooManyOrders             = sdkerrors.Register(ModuleName, 33, "too many active orders in the pair")
+	ErrOrderCommitsDisabled      = sdkerrors.Register(ModuleName, 34, "order commits are disabled")
+	ErrOrderNotRevealable        = sdkerrors.Register(ModuleName, 35, "the committed order cannot be revealed in the current batch")
+	ErrOrderNotAmendable         = sdkerrors.Register(ModuleName, 36, "the order cannot be amended")
 )
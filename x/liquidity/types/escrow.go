@@ -0,0 +1,46 @@
+package types
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewEscrowBalanceDiffs returns the differences between the expected and
+// actual balances of an escrow account, one per diverging denom and sorted
+// by denom.
+// It returns nil if the balances are identical.
+func NewEscrowBalanceDiffs(addr sdk.AccAddress, desc string, expected, actual sdk.Coins) []EscrowBalanceDiff {
+	denomSet := map[string]struct{}{}
+	for _, coin := range expected {
+		denomSet[coin.Denom] = struct{}{}
+	}
+	for _, coin := range actual {
+		denomSet[coin.Denom] = struct{}{}
+	}
+	denoms := make([]string, 0, len(denomSet))
+	for denom := range denomSet {
+		denoms = append(denoms, denom)
+	}
+	sort.Strings(denoms)
+
+	var diffs []EscrowBalanceDiff
+	for _, denom := range denoms {
+		expectedAmt, actualAmt := expected.AmountOf(denom), actual.AmountOf(denom)
+		if !expectedAmt.Equal(actualAmt) {
+			diffs = append(diffs, EscrowBalanceDiff{
+				Address:     addr.String(),
+				Description: desc,
+				Denom:       denom,
+				Expected:    expectedAmt,
+				Actual:      actualAmt,
+			})
+		}
+	}
+	return diffs
+}
+
+// IsDeficit returns whether the escrow account holds less than expected.
+func (diff EscrowBalanceDiff) IsDeficit() bool {
+	return diff.Actual.LT(diff.Expected)
+}
@@ -2,55 +2,125 @@ package types
 
 // Event types for the liquidity module.
 const (
-	EventTypeCreatePair       = "create_pair"
-	EventTypeCreatePool       = "create_pool"
-	EventTypeCreateRangedPool = "create_ranged_pool"
-	EventTypeDeposit          = "deposit"
-	EventTypeWithdraw         = "withdraw"
-	EventTypeLimitOrder       = "limit_order"
-	EventTypeMarketOrder      = "market_order"
-	EventTypeMMOrder          = "mm_order"
-	EventTypeCancelOrder      = "cancel_order"
-	EventTypeCancelAllOrders  = "cancel_all_orders"
-	EventTypeCancelMMOrder    = "cancel_mm_order"
-	EventTypeDepositResult    = "deposit_result"
-	EventTypeWithdrawalResult = "withdrawal_result"
-	EventTypeOrderResult      = "order_result"
-	EventTypeUserOrderMatched = "user_order_matched"
-	EventTypePoolOrderMatched = "pool_order_matched"
+	EventTypeCreatePair               = "create_pair"
+	EventTypeCreatePool               = "create_pool"
+	EventTypeCreateRangedPool         = "create_ranged_pool"
+	EventTypeDeposit                  = "deposit"
+	EventTypeDepositSingleAsset       = "deposit_single_asset"
+	EventTypeWithdraw                 = "withdraw"
+	EventTypeLimitOrder               = "limit_order"
+	EventTypeMarketOrder              = "market_order"
+	EventTypeMMOrder                  = "mm_order"
+	EventTypeCancelOrder              = "cancel_order"
+	EventTypeCancelAllOrders          = "cancel_all_orders"
+	EventTypeCancelMMOrder            = "cancel_mm_order"
+	EventTypeDepositResult            = "deposit_result"
+	EventTypeWithdrawalResult         = "withdrawal_result"
+	EventTypeOrderResult              = "order_result"
+	EventTypeUserOrderMatched         = "user_order_matched"
+	EventTypePoolOrderMatched         = "pool_order_matched"
+	EventTypeSourceOrderMatched       = "source_order_matched"
+	EventTypePruneExpired             = "prune_expired"
+	EventTypeSweepPoolFees            = "sweep_pool_fees"
+	EventTypeSweepDust                = "sweep_dust"
+	EventTypeMigratePool              = "migrate_pool"
+	EventTypeMatchingOverflow         = "matching_overflow"
+	EventTypeDepositFailed            = "deposit_failed"
+	EventTypeWithdrawalFailed         = "withdrawal_failed"
+	EventTypeOrderFailed              = "order_failed"
+	EventTypeSetPairMetadata          = "set_pair_metadata"
+	EventTypeAutoCancelMMOrder        = "auto_cancel_mm_order"
+	EventTypeSetPairHalted            = "set_pair_halted"
+	EventTypeCreateVault              = "create_vault"
+	EventTypeDepositVault             = "deposit_vault"
+	EventTypeWithdrawVault            = "withdraw_vault"
+	EventTypeRebalanceVault           = "rebalance_vault"
+	EventTypeAccrueOperatorFee        = "accrue_operator_fee"
+	EventTypeSetPairBatchWindow       = "set_pair_batch_window"
+	EventTypeSetPairOrderAmountLimits = "set_pair_order_amount_limits"
+	EventTypeSetPairParams            = "set_pair_params"
+	EventTypeSetPoolMaxReserveAmount  = "set_pool_max_reserve_amount"
+	EventTypeDepositCapped            = "deposit_capped"
+	EventTypeRenewOrder               = "renew_order"
+	EventTypeOrderExpired             = "order_expired"
+	EventTypeSwapExactIn              = "swap_exact_in"
+	EventTypeSwapRouteHop             = "swap_route_hop"
+	EventTypeSwapRouteFinished        = "swap_route_finished"
+	EventTypeIBCSwap                  = "ibc_swap"
+	EventTypeWithdrawFee              = "withdraw_fee"
 
-	AttributeKeyCreator            = "creator"
-	AttributeKeyDepositor          = "depositor"
-	AttributeKeyWithdrawer         = "withdrawer"
-	AttributeKeyOrderer            = "orderer"
-	AttributeKeyBaseCoinDenom      = "base_coin_denom"
-	AttributeKeyQuoteCoinDenom     = "quote_coin_denom"
-	AttributeKeyDepositCoins       = "deposit_coins"
-	AttributeKeyAcceptedCoins      = "accepted_coins"
-	AttributeKeyMintedPoolCoin     = "minted_pool_coin"
-	AttributeKeyPoolCoin           = "pool_coin"
-	AttributeKeyWithdrawnCoins     = "withdrawn_coins"
-	AttributeKeyRefundedCoins      = "refunded_coins"
-	AttributeKeyReserveAddress     = "reserve_address"
-	AttributeKeyEscrowAddress      = "escrow_address"
-	AttributeKeyRequestId          = "request_id"
-	AttributeKeyPoolId             = "pool_id"
-	AttributeKeyPairId             = "pair_id"
-	AttributeKeyBatchId            = "batch_id"
-	AttributeKeyOrderId            = "order_id"
-	AttributeKeyOrderIds           = "order_ids"
-	AttributeKeyOrderDirection     = "order_direction"
-	AttributeKeyOfferCoin          = "offer_coin"
-	AttributeKeyDemandCoinDenom    = "demand_coin_denom"
-	AttributeKeyPrice              = "price"
-	AttributeKeyAmount             = "amount"
-	AttributeKeyOpenAmount         = "open_amount"
-	AttributeKeyExpireAt           = "expire_at"
-	AttributeKeyRemainingOfferCoin = "remaining_offer_coin"
-	AttributeKeyReceivedCoin       = "received_coin"
-	AttributeKeyPairIds            = "pair_ids"
-	AttributeKeyCanceledOrderIds   = "canceled_order_ids"
-	AttributeKeyStatus             = "status"
-	AttributeKeyMatchedAmount      = "matched_amount"
-	AttributeKeyPaidCoin           = "paid_coin"
+	AttributeKeyCreator                 = "creator"
+	AttributeKeyDepositor               = "depositor"
+	AttributeKeyWithdrawer              = "withdrawer"
+	AttributeKeyOrderer                 = "orderer"
+	AttributeKeyReceiver                = "receiver"
+	AttributeKeyBaseCoinDenom           = "base_coin_denom"
+	AttributeKeyQuoteCoinDenom          = "quote_coin_denom"
+	AttributeKeyDepositCoins            = "deposit_coins"
+	AttributeKeyAcceptedCoins           = "accepted_coins"
+	AttributeKeyMintedPoolCoin          = "minted_pool_coin"
+	AttributeKeyPoolCoin                = "pool_coin"
+	AttributeKeyWithdrawnCoins          = "withdrawn_coins"
+	AttributeKeyRefundedCoins           = "refunded_coins"
+	AttributeKeyReserveAddress          = "reserve_address"
+	AttributeKeyEscrowAddress           = "escrow_address"
+	AttributeKeyRequestId               = "request_id"
+	AttributeKeyPoolId                  = "pool_id"
+	AttributeKeyPairId                  = "pair_id"
+	AttributeKeyBatchId                 = "batch_id"
+	AttributeKeyOrderId                 = "order_id"
+	AttributeKeyOrderIds                = "order_ids"
+	AttributeKeyOrderDirection          = "order_direction"
+	AttributeKeyOfferCoin               = "offer_coin"
+	AttributeKeyDemandCoinDenom         = "demand_coin_denom"
+	AttributeKeyPrice                   = "price"
+	AttributeKeyAmount                  = "amount"
+	AttributeKeyOpenAmount              = "open_amount"
+	AttributeKeyExpireAt                = "expire_at"
+	AttributeKeyRemainingOfferCoin      = "remaining_offer_coin"
+	AttributeKeyReceivedCoin            = "received_coin"
+	AttributeKeyPairIds                 = "pair_ids"
+	AttributeKeyCanceledOrderIds        = "canceled_order_ids"
+	AttributeKeyStatus                  = "status"
+	AttributeKeyMatchedAmount           = "matched_amount"
+	AttributeKeyPaidCoin                = "paid_coin"
+	AttributeKeySwapFee                 = "swap_fee"
+	AttributeKeyPruner                  = "pruner"
+	AttributeKeyNumPrunedEntries        = "num_pruned_entries"
+	AttributeKeyReward                  = "reward"
+	AttributeKeySourceName              = "source_name"
+	AttributeKeySweptCoins              = "swept_coins"
+	AttributeKeyPoolPrice               = "pool_price"
+	AttributeKeyNewPoolId               = "new_pool_id"
+	AttributeKeyNumHolders              = "num_holders"
+	AttributeKeyReason                  = "reason"
+	AttributeKeyDisplayName             = "display_name"
+	AttributeKeyLogoURIHash             = "logo_uri_hash"
+	AttributeKeyBaseCoinDecimals        = "base_coin_decimals"
+	AttributeKeyQuoteCoinDecimals       = "quote_coin_decimals"
+	AttributeKeyAutoCancelHeight        = "auto_cancel_height"
+	AttributeKeyHalted                  = "halted"
+	AttributeKeyVaultId                 = "vault_id"
+	AttributeKeyOperator                = "operator"
+	AttributeKeyMintedShare             = "minted_share"
+	AttributeKeyShare                   = "share"
+	AttributeKeyPairCreationFee         = "pair_creation_fee"
+	AttributeKeyFeeDestination          = "fee_destination"
+	AttributeKeyMinPrice                = "min_price"
+	AttributeKeyMaxPrice                = "max_price"
+	AttributeKeyShareValue              = "share_value"
+	AttributeKeyBatchWindow             = "batch_window"
+	AttributeKeyMinBaseOrderAmount      = "min_base_order_amount"
+	AttributeKeyMinQuoteOrderAmount     = "min_quote_order_amount"
+	AttributeKeyLotSize                 = "lot_size"
+	AttributeKeyUpwardPriceLimitRatio   = "upward_price_limit_ratio"
+	AttributeKeyDownwardPriceLimitRatio = "downward_price_limit_ratio"
+	AttributeKeyMaxOrderLifespan        = "max_order_lifespan"
+	AttributeKeySwapRouteId             = "swap_route_id"
+	AttributeKeyMinOutCoin              = "min_out_coin"
+	AttributeKeyOutCoin                 = "out_coin"
+	AttributeKeyPacketSequence          = "packet_sequence"
+	AttributeKeyDestChannel             = "dest_channel"
+	AttributeKeyWithdrawFeeCoins        = "withdraw_fee_coins"
+	AttributeKeyMaxReserveAmount        = "max_reserve_amount"
 )
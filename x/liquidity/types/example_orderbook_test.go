@@ -73,29 +73,29 @@ func ExampleMakeOrderBookPairResponse_pool() {
 
 	// Output:
 	// +------------------------------------------------------------------------+
-	// |            2299835 |         1.011000000000000000 |                    |
-	// |            2342262 |         1.008000000000000000 |                    |
-	// |            2170367 |         1.005000000000000000 |                    |
-	// |            2059733 |         1.003000000000000000 |                    |
-	// |            1914496 |         1.000000000000000000 |                    |
-	// |            1846587 |         0.998000000000000000 |                    |
-	// |            1729430 |         0.995000000000000000 |                    |
-	// |            1674921 |         0.993000000000000000 |                    |
-	// |            1571324 |         0.991000000000000000 |                    |
-	// |            1307011 |         0.989000000000000000 |                    |
+	// |            2753064 |         1.012000000000000000 |                    |
+	// |            2031564 |         1.009000000000000000 |                    |
+	// |            2475276 |         1.006000000000000000 |                    |
+	// |            1748870 |         1.003000000000000000 |                    |
+	// |            2341745 |         1.001000000000000000 |                    |
+	// |            1582017 |         0.998000000000000000 |                    |
+	// |            2051502 |         0.996000000000000000 |                    |
+	// |            1410549 |         0.994000000000000000 |                    |
+	// |            1879359 |         0.991000000000000000 |                    |
+	// |            1250586 |         0.989000000000000000 |                    |
 	// |------------------------------------------------------------------------|
 	// |                              0.988400000000000000                      |
 	// |------------------------------------------------------------------------|
-	// |                    |         0.988000000000000000 | 1521174            |
-	// |                    |         0.985000000000000000 | 1520420            |
-	// |                    |         0.982000000000000000 | 1671594            |
-	// |                    |         0.979000000000000000 | 1672618            |
-	// |                    |         0.975000000000000000 | 1836801            |
-	// |                    |         0.972000000000000000 | 1839965            |
-	// |                    |         0.968000000000000000 | 2017741            |
-	// |                    |         0.965000000000000000 | 2023466            |
-	// |                    |         0.961000000000000000 | 2215515            |
-	// |                    |         0.957000000000000000 | 2224296            |
+	// |                    |         0.987000000000000000 | 1373678            |
+	// |                    |         0.984000000000000000 | 1632257            |
+	// |                    |         0.981000000000000000 | 1583182            |
+	// |                    |         0.978000000000000000 | 1785090            |
+	// |                    |         0.975000000000000000 | 1748195            |
+	// |                    |         0.971000000000000000 | 1953645            |
+	// |                    |         0.968000000000000000 | 1928968            |
+	// |                    |         0.964000000000000000 | 2148889            |
+	// |                    |         0.960000000000000000 | 2115795            |
+	// |                    |         0.956000000000000000 | 2361974            |
 	// +------------------------------------------------------------------------+
 }
 
@@ -131,29 +131,29 @@ func ExampleMakeOrderBookPairResponse_userOrder() {
 
 	// Output:
 	// +------------------------------------------------------------------------+
-	// |          283061445 |         1.335000000000000000 |                    |
-	// |          252607703 |         1.334000000000000000 |                    |
-	// |          252895894 |         1.333000000000000000 |                    |
-	// |          253180839 |         1.332000000000000000 |                    |
-	// |          253466318 |         1.331000000000000000 |                    |
-	// |          253752331 |         1.330000000000000000 |                    |
-	// |          254038885 |         1.329000000000000000 |                    |
-	// |          254325976 |         1.328000000000000000 |                    |
-	// |          254613613 |         1.327000000000000000 |                    |
-	// |          254901787 |         1.326000000000000000 |                    |
+	// |          283517770 |         1.335000000000000000 |                    |
+	// |          252571272 |         1.334000000000000000 |                    |
+	// |          252870128 |         1.333000000000000000 |                    |
+	// |          253153901 |         1.332000000000000000 |                    |
+	// |          253431524 |         1.331000000000000000 |                    |
+	// |          253742190 |         1.330000000000000000 |                    |
+	// |          254007469 |         1.329000000000000000 |                    |
+	// |          254310235 |         1.328000000000000000 |                    |
+	// |          254603536 |         1.327000000000000000 |                    |
+	// |          254873661 |         1.326000000000000000 |                    |
 	// |------------------------------------------------------------------------|
 	// |                              1.326000000000000000                      |
 	// |------------------------------------------------------------------------|
-	// |                    |         1.325000000000000000 | 272987175          |
-	// |                    |         1.324000000000000000 | 255217324          |
-	// |                    |         1.323000000000000000 | 255506641          |
-	// |                    |         1.322000000000000000 | 255796508          |
-	// |                    |         1.321000000000000000 | 256086923          |
-	// |                    |         1.320000000000000000 | 367377883          |
-	// |                    |         1.319000000000000000 | 256669399          |
-	// |                    |         1.318000000000000000 | 256961468          |
-	// |                    |         1.317000000000000000 | 257254090          |
-	// |                    |         1.316000000000000000 | 368543354          |
+	// |                    |         1.325000000000000000 | 272972947          |
+	// |                    |         1.324000000000000000 | 255198159          |
+	// |                    |         1.323000000000000000 | 255470423          |
+	// |                    |         1.322000000000000000 | 255771366          |
+	// |                    |         1.321000000000000000 | 256076516          |
+	// |                    |         1.320000000000000000 | 367366828          |
+	// |                    |         1.319000000000000000 | 256632365          |
+	// |                    |         1.318000000000000000 | 256943727          |
+	// |                    |         1.317000000000000000 | 257208641          |
+	// |                    |         1.316000000000000000 | 368543944          |
 	// +------------------------------------------------------------------------+
 }
 
@@ -240,29 +240,29 @@ func ExampleMakeOrderBookPairResponse_edgecase1() {
 
 	// Output:
 	// +------------------------------------------------------------------------+
-	// |             424830 |         0.953900000000000000 |                    |
-	// |             502738 |         0.953800000000000000 |                    |
-	// |             436285 |         0.953700000000000000 |                    |
-	// |             502809 |         0.953600000000000000 |                    |
-	// |             425098 |         0.953500000000000000 |                    |
-	// |             503055 |         0.953400000000000000 |                    |
-	// |             436559 |         0.953300000000000000 |                    |
-	// |             497509 |         0.953200000000000000 |                    |
-	// |             495757 |         0.953100000000000000 |                    |
-	// |              59171 |         0.953000000000000000 |                    |
+	// |             353030 |         0.953900000000000000 |                    |
+	// |             619285 |         0.953800000000000000 |                    |
+	// |             525811 |         0.953700000000000000 |                    |
+	// |             505073 |         0.953600000000000000 |                    |
+	// |             401415 |         0.953500000000000000 |                    |
+	// |             582671 |         0.953400000000000000 |                    |
+	// |             252060 |         0.953300000000000000 |                    |
+	// |             402384 |         0.953200000000000000 |                    |
+	// |             651662 |         0.953100000000000000 |                    |
+	// |              16209 |         0.953000000000000000 |                    |
 	// |------------------------------------------------------------------------|
 	// |                              0.952980000000000000                      |
 	// |------------------------------------------------------------------------|
-	// |                    |         0.952900000000000000 | 425397             |
-	// |                    |         0.952800000000000000 | 415669             |
-	// |                    |         0.952700000000000000 | 518993             |
-	// |                    |         0.952600000000000000 | 503626             |
-	// |                    |         0.952500000000000000 | 408371             |
-	// |                    |         0.952400000000000000 | 503685             |
-	// |                    |         0.952300000000000000 | 454527             |
-	// |                    |         0.952200000000000000 | 503942             |
-	// |                    |         0.952100000000000000 | 408638             |
-	// |                    |         0.952000000000000000 | 504004             |
+	// |                    |         0.952900000000000000 | 420008             |
+	// |                    |         0.952800000000000000 | 449049             |
+	// |                    |         0.952700000000000000 | 441068             |
+	// |                    |         0.952600000000000000 | 554565             |
+	// |                    |         0.952500000000000000 | 402725             |
+	// |                    |         0.952400000000000000 | 465842             |
+	// |                    |         0.952300000000000000 | 526116             |
+	// |                    |         0.952200000000000000 | 387981             |
+	// |                    |         0.952100000000000000 | 523862             |
+	// |                    |         0.952000000000000000 | 544484             |
 	// +------------------------------------------------------------------------+
 }
 
@@ -324,7 +324,7 @@ func ExampleMakeOrderBookPairResponse_priceUnits1() {
 	ob := amm.NewOrderBook()
 
 	lastPrice := utils.ParseDec("0.9995")
-	lowestPrice, highestPrice := types.PriceLimits(lastPrice, utils.ParseDec("0.1"), 4)
+	lowestPrice, highestPrice := types.PriceLimits(lastPrice, utils.ParseDec("0.1"), utils.ParseDec("0.1"), 4)
 	pool := amm.NewBasicPool(sdk.NewInt(9995_000000), sdk.NewInt(10000_000000), sdk.Int{})
 	ob.AddOrder(amm.PoolOrders(pool, amm.DefaultOrderer, lowestPrice, highestPrice, 4)...)
 
@@ -351,79 +351,79 @@ func ExampleMakeOrderBookPairResponse_priceUnits1() {
 
 	// Output:
 	// +------------------------------------------------------------------------+
-	// |             300018 |         0.999870000000000000 |                    |
-	// |              99970 |         0.999830000000000000 |                    |
-	// |             300047 |         0.999790000000000000 |                    |
-	// |              99989 |         0.999750000000000000 |                    |
-	// |             300076 |         0.999710000000000000 |                    |
-	// |             100009 |         0.999670000000000000 |                    |
-	// |             300104 |         0.999630000000000000 |                    |
-	// |             100029 |         0.999590000000000000 |                    |
-	// |             300132 |         0.999550000000000000 |                    |
-	// |             100049 |         0.999510000000000000 |                    |
+	// |             200000 |         0.999900000000000000 |                    |
+	// |             185714 |         0.999860000000000000 |                    |
+	// |             300000 |         0.999810000000000000 |                    |
+	// |             121739 |         0.999770000000000000 |                    |
+	// |             255555 |         0.999730000000000000 |                    |
+	// |             154838 |         0.999690000000000000 |                    |
+	// |             333333 |         0.999640000000000000 |                    |
+	// |             112500 |         0.999600000000000000 |                    |
+	// |             247727 |         0.999560000000000000 |                    |
+	// |             168750 |         0.999520000000000000 |                    |
 	// |------------------------------------------------------------------------|
 	// |                              0.999500000000000000                      |
 	// |------------------------------------------------------------------------|
-	// |                    |         0.999490000000000000 | 100051             |
-	// |                    |         0.999450000000000000 | 300169             |
-	// |                    |         0.999410000000000000 | 100071             |
-	// |                    |         0.999370000000000000 | 300197             |
-	// |                    |         0.999330000000000000 | 100091             |
-	// |                    |         0.999290000000000000 | 300225             |
-	// |                    |         0.999250000000000000 | 100111             |
-	// |                    |         0.999210000000000000 | 300253             |
-	// |                    |         0.999170000000000000 | 100132             |
-	// |                    |         0.999130000000000000 | 300280             |
+	// |                    |         0.999480000000000000 | 177688             |
+	// |                    |         0.999440000000000000 | 213692             |
+	// |                    |         0.999400000000000000 | 174590             |
+	// |                    |         0.999360000000000000 | 244675             |
+	// |                    |         0.999320000000000000 | 125997             |
+	// |                    |         0.999280000000000000 | 319815             |
+	// |                    |         0.999230000000000000 | 165440             |
+	// |                    |         0.999190000000000000 | 236445             |
+	// |                    |         0.999150000000000000 | 168580             |
+	// |                    |         0.999110000000000000 | 230814             |
 	// +------------------------------------------------------------------------+
 	// +------------------------------------------------------------------------+
-	// |             998495 |         1.000900000000000000 |                    |
-	// |             998608 |         1.000700000000000000 |                    |
-	// |             999095 |         1.000500000000000000 |                    |
-	// |             999206 |         1.000300000000000000 |                    |
-	// |             999695 |         1.000100000000000000 |                    |
-	// |             499871 |         1.000000000000000000 |                    |
-	// |             399988 |         0.999900000000000000 |                    |
-	// |             700112 |         0.999800000000000000 |                    |
-	// |             400113 |         0.999700000000000000 |                    |
-	// |             500210 |         0.999600000000000000 |                    |
+	// |             999426 |         1.000900000000000000 |                    |
+	// |             996190 |         1.000700000000000000 |                    |
+	// |            1002962 |         1.000500000000000000 |                    |
+	// |             994717 |         1.000300000000000000 |                    |
+	// |            1003319 |         1.000100000000000000 |                    |
+	// |             416666 |         1.000000000000000000 |                    |
+	// |             685714 |         0.999900000000000000 |                    |
+	// |             377294 |         0.999800000000000000 |                    |
+	// |             488171 |         0.999700000000000000 |                    |
+	// |             528977 |         0.999600000000000000 |                    |
 	// |------------------------------------------------------------------------|
 	// |                              0.999500000000000000                      |
 	// |------------------------------------------------------------------------|
-	// |                    |         0.999400000000000000 | 500291             |
-	// |                    |         0.999300000000000000 | 400288             |
-	// |                    |         0.999200000000000000 | 700589             |
-	// |                    |         0.999100000000000000 | 400412             |
-	// |                    |         0.999000000000000000 | 500633             |
-	// |                    |         0.998900000000000000 | 400528             |
-	// |                    |         0.998800000000000000 | 700969             |
-	// |                    |         0.998700000000000000 | 400653             |
-	// |                    |         0.998600000000000000 | 500972             |
-	// |                    |         0.998500000000000000 | 400768             |
+	// |                    |         0.999400000000000000 | 565970             |
+	// |                    |         0.999300000000000000 | 370672             |
+	// |                    |         0.999200000000000000 | 485255             |
+	// |                    |         0.999100000000000000 | 635839             |
+	// |                    |         0.999000000000000000 | 399528             |
+	// |                    |         0.998900000000000000 | 488546             |
+	// |                    |         0.998800000000000000 | 604042             |
+	// |                    |         0.998700000000000000 | 404133             |
+	// |                    |         0.998600000000000000 | 523042             |
+	// |                    |         0.998500000000000000 | 490536             |
 	// +------------------------------------------------------------------------+
 	// +------------------------------------------------------------------------+
-	// |            4935785 |         1.009000000000000000 |                    |
-	// |            4942943 |         1.008000000000000000 |                    |
-	// |            4950502 |         1.007000000000000000 |                    |
-	// |            4957699 |         1.006000000000000000 |                    |
-	// |            4965293 |         1.005000000000000000 |                    |
-	// |            4972529 |         1.004000000000000000 |                    |
-	// |            4980159 |         1.003000000000000000 |                    |
-	// |            4987432 |         1.002000000000000000 |                    |
-	// |            4995099 |         1.001000000000000000 |                    |
-	// |            2500294 |         1.000000000000000000 |                    |
+	// |            4963106 |         1.009000000000000000 |                    |
+	// |            4914508 |         1.008000000000000000 |                    |
+	// |            4976894 |         1.007000000000000000 |                    |
+	// |            4934028 |         1.006000000000000000 |                    |
+	// |            4980174 |         1.005000000000000000 |                    |
+	// |            4960987 |         1.004000000000000000 |                    |
+	// |            4990806 |         1.003000000000000000 |                    |
+	// |            4983628 |         1.002000000000000000 |                    |
+	// |            4996614 |         1.001000000000000000 |                    |
+	// |            2496822 |         1.000000000000000000 |                    |
 	// |------------------------------------------------------------------------|
 	// |                              0.999500000000000000                      |
 	// |------------------------------------------------------------------------|
-	// |                    |         0.999000000000000000 | 2502213            |
-	// |                    |         0.998000000000000000 | 5210466            |
-	// |                    |         0.997000000000000000 | 5017777            |
-	// |                    |         0.996000000000000000 | 5025346            |
-	// |                    |         0.995000000000000000 | 4932203            |
-	// |                    |         0.994000000000000000 | 4939515            |
-	// |                    |         0.993000000000000000 | 4947464            |
-	// |                    |         0.992000000000000000 | 4954234            |
-	// |                    |         0.991000000000000000 | 5163351            |
-	// |                    |         0.990000000000000000 | 5274693            |
+	// |                    |         0.999000000000000000 | 2457264            |
+	// |                    |         0.998000000000000000 | 5154750            |
+	// |                    |         0.997000000000000000 | 4972669            |
+	// |                    |         0.996000000000000000 | 5093193            |
+	// |                    |         0.995000000000000000 | 5120157            |
+	// |                    |         0.994000000000000000 | 4560703            |
+	// |                    |         0.993000000000000000 | 5473020            |
+	// |                    |         0.992000000000000000 | 5100308            |
+	// |                    |         0.991000000000000000 | 4849449            |
+	// |                    |         0.990000000000000000 | 5151718            |
 	// +------------------------------------------------------------------------+
 }
 
@@ -431,7 +431,7 @@ func ExampleMakeOrderBookPairResponse_priceUnits2() {
 	ob := amm.NewOrderBook()
 
 	lastPrice := utils.ParseDec("0.9999")
-	lowestPrice, highestPrice := types.PriceLimits(lastPrice, utils.ParseDec("0.1"), 4)
+	lowestPrice, highestPrice := types.PriceLimits(lastPrice, utils.ParseDec("0.1"), utils.ParseDec("0.1"), 4)
 	pool := amm.NewBasicPool(sdk.NewInt(9999_000000), sdk.NewInt(10000_000000), sdk.Int{})
 	ob.AddOrder(amm.PoolOrders(pool, amm.DefaultOrderer, lowestPrice, highestPrice, 4)...)
 
@@ -458,78 +458,78 @@ func ExampleMakeOrderBookPairResponse_priceUnits2() {
 
 	// Output:
 	// +------------------------------------------------------------------------+
-	// |             997461 |         1.001700000000000000 |                    |
-	// |             997650 |         1.001500000000000000 |                    |
-	// |             998058 |         1.001300000000000000 |                    |
-	// |             998247 |         1.001100000000000000 |                    |
-	// |             998658 |         1.000900000000000000 |                    |
-	// |             998845 |         1.000700000000000000 |                    |
-	// |             999257 |         1.000500000000000000 |                    |
-	// |             999444 |         1.000300000000000000 |                    |
-	// |             999857 |         1.000100000000000000 |                    |
-	// |             500010 |         1.000000000000000000 |                    |
+	// |             991254 |         1.001500000000000000 |                    |
+	// |            1002036 |         1.001300000000000000 |                    |
+	// |             990216 |         1.001100000000000000 |                    |
+	// |            1010722 |         1.000900000000000000 |                    |
+	// |             984931 |         1.000700000000000000 |                    |
+	// |            1012866 |         1.000500000000000000 |                    |
+	// |             989180 |         1.000300000000000000 |                    |
+	// |              56520 |         1.000200000000000000 |                    |
+	// |             894643 |         1.000100000000000000 |                    |
+	// |             550023 |         1.000000000000000000 |                    |
 	// |------------------------------------------------------------------------|
 	// |                              0.999900000000000000                      |
 	// |------------------------------------------------------------------------|
-	// |                    |         0.999800000000000000 | 500091             |
-	// |                    |         0.999700000000000000 | 400128             |
-	// |                    |         0.999600000000000000 | 700309             |
-	// |                    |         0.999500000000000000 | 400252             |
-	// |                    |         0.999400000000000000 | 500431             |
-	// |                    |         0.999300000000000000 | 400369             |
-	// |                    |         0.999200000000000000 | 700688             |
-	// |                    |         0.999100000000000000 | 400492             |
-	// |                    |         0.999000000000000000 | 500773             |
-	// |                    |         0.998900000000000000 | 400608             |
+	// |                    |         0.999800000000000000 | 586302             |
+	// |                    |         0.999700000000000000 | 366341             |
+	// |                    |         0.999600000000000000 | 752074             |
+	// |                    |         0.999500000000000000 | 391693             |
+	// |                    |         0.999400000000000000 | 427201             |
+	// |                    |         0.999300000000000000 | 520923             |
+	// |                    |         0.999200000000000000 | 331669             |
+	// |                    |         0.999100000000000000 | 578873             |
+	// |                    |         0.999000000000000000 | 759580             |
+	// |                    |         0.998900000000000000 | 436560             |
 	// +------------------------------------------------------------------------+
 	// +------------------------------------------------------------------------+
-	// |            4936732 |         1.009000000000000000 |                    |
-	// |            4943972 |         1.008000000000000000 |                    |
-	// |            4951453 |         1.007000000000000000 |                    |
-	// |            4958730 |         1.006000000000000000 |                    |
-	// |            4966248 |         1.005000000000000000 |                    |
-	// |            4973563 |         1.004000000000000000 |                    |
-	// |            4981117 |         1.003000000000000000 |                    |
-	// |            4988468 |         1.002000000000000000 |                    |
-	// |            4996061 |         1.001000000000000000 |                    |
-	// |             500010 |         1.000000000000000000 |                    |
+	// |            4939956 |         1.009000000000000000 |                    |
+	// |            4944037 |         1.008000000000000000 |                    |
+	// |            4965270 |         1.007000000000000000 |                    |
+	// |            4927351 |         1.006000000000000000 |                    |
+	// |            4999416 |         1.005000000000000000 |                    |
+	// |            4941710 |         1.004000000000000000 |                    |
+	// |            5004266 |         1.003000000000000000 |                    |
+	// |            4973999 |         1.002000000000000000 |                    |
+	// |            4948862 |         1.001000000000000000 |                    |
+	// |             550023 |         1.000000000000000000 |                    |
 	// |------------------------------------------------------------------------|
 	// |                              0.999900000000000000                      |
 	// |------------------------------------------------------------------------|
-	// |                    |         0.999000000000000000 | 4503533            |
-	// |                    |         0.998000000000000000 | 5211459            |
-	// |                    |         0.997000000000000000 | 5018778            |
-	// |                    |         0.996000000000000000 | 4825162            |
-	// |                    |         0.995000000000000000 | 5235152            |
-	// |                    |         0.994000000000000000 | 5041557            |
-	// |                    |         0.993000000000000000 | 4847036            |
-	// |                    |         0.992000000000000000 | 5258875            |
-	// |                    |         0.991000000000000000 | 4964041            |
-	// |                    |         0.990000000000000000 | 5072107            |
+	// |                    |         0.999000000000000000 | 4714656            |
+	// |                    |         0.998000000000000000 | 4872314            |
+	// |                    |         0.997000000000000000 | 5088557            |
+	// |                    |         0.996000000000000000 | 4969808            |
+	// |                    |         0.995000000000000000 | 4929148            |
+	// |                    |         0.994000000000000000 | 4930290            |
+	// |                    |         0.993000000000000000 | 5184974            |
+	// |                    |         0.992000000000000000 | 5019352            |
+	// |                    |         0.991000000000000000 | 4914421            |
+	// |                    |         0.990000000000000000 | 5137941            |
 	// +------------------------------------------------------------------------+
 	// +------------------------------------------------------------------------+
-	// |           39884892 |         1.090000000000000000 |                    |
-	// |           35872783 |         1.080000000000000000 |                    |
-	// |           49088294 |         1.070000000000000000 |                    |
-	// |           52603184 |         1.060000000000000000 |                    |
-	// |           43083979 |         1.050000000000000000 |                    |
-	// |           43657842 |         1.040000000000000000 |                    |
-	// |           50064733 |         1.030000000000000000 |                    |
-	// |           50835962 |         1.020000000000000000 |                    |
-	// |           49625631 |         1.010000000000000000 |                    |
-	// |             500010 |         1.000000000000000000 |                    |
+	// |           39885137 |         1.090000000000000000 |                    |
+	// |           35873141 |         1.080000000000000000 |                    |
+	// |           49028719 |         1.070000000000000000 |                    |
+	// |           52602844 |         1.060000000000000000 |                    |
+	// |           43084302 |         1.050000000000000000 |                    |
+	// |           43717432 |         1.040000000000000000 |                    |
+	// |           50064698 |         1.030000000000000000 |                    |
+	// |           50819107 |         1.020000000000000000 |                    |
+	// |           49562630 |         1.010000000000000000 |                    |
+	// |             550023 |         1.000000000000000000 |                    |
 	// |------------------------------------------------------------------------|
 	// |                              0.999900000000000000                      |
 	// |------------------------------------------------------------------------|
-	// |                    |         0.990000000000000000 | 49977700           |
-	// |                    |         0.980000000000000000 | 50524375           |
-	// |                    |         0.970000000000000000 | 54863157           |
-	// |                    |         0.960000000000000000 | 52675477           |
-	// |                    |         0.950000000000000000 | 57485995           |
-	// |                    |         0.940000000000000000 | 40941734           |
-	// |                    |         0.930000000000000000 | 65324860           |
-	// |                    |         0.920000000000000000 | 58141770           |
-	// |                    |         0.910000000000000000 | 43270158           |
-	// |                    |         0.900000000000000000 | 72707068           |
+	// |                    |         0.990000000000000000 | 49761461           |
+	// |                    |         0.980000000000000000 | 50828367           |
+	// |                    |         0.970000000000000000 | 54685193           |
+	// |                    |         0.960000000000000000 | 52675821           |
+	// |                    |         0.950000000000000000 | 57665825           |
+	// |                    |         0.940000000000000000 | 40761083           |
+	// |                    |         0.930000000000000000 | 65324120           |
+	// |                    |         0.920000000000000000 | 58324985           |
+	// |                    |         0.910000000000000000 | 43270802           |
+	// |                    |         0.900000000000000000 | 72521434           |
 	// +------------------------------------------------------------------------+
 }
@@ -0,0 +1,40 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ExternalAMMVault defines a vault which holds liquidity of a pair following
+// an external AMM's constant-product curve with its own fee rate.
+// Such liquidity, e.g. bridged or migrated from another chain, participates
+// in the pair's batch matching through an ExternalAMMOrderSource.
+type ExternalAMMVault struct {
+	PairId         uint64
+	ReserveAddress sdk.AccAddress
+	FeeRate        sdk.Dec
+}
+
+// NewExternalAMMVault returns a new ExternalAMMVault.
+func NewExternalAMMVault(pairId uint64, reserveAddr sdk.AccAddress, feeRate sdk.Dec) ExternalAMMVault {
+	return ExternalAMMVault{
+		PairId:         pairId,
+		ReserveAddress: reserveAddr,
+		FeeRate:        feeRate,
+	}
+}
+
+// Validate validates ExternalAMMVault.
+func (vault ExternalAMMVault) Validate() error {
+	if vault.PairId == 0 {
+		return fmt.Errorf("pair id must not be 0")
+	}
+	if err := sdk.VerifyAddressFormat(vault.ReserveAddress); err != nil {
+		return fmt.Errorf("invalid reserve address: %w", err)
+	}
+	if vault.FeeRate.IsNil() || vault.FeeRate.IsNegative() || vault.FeeRate.GTE(sdk.OneDec()) {
+		return fmt.Errorf("fee rate must be in range [0, 1): %s", vault.FeeRate)
+	}
+	return nil
+}
@@ -16,6 +16,16 @@ func DefaultGenesis() *GenesisState {
 		WithdrawRequests:         []WithdrawRequest{},
 		Orders:                   []Order{},
 		MarketMakingOrderIndexes: []MMOrderIndex{},
+		AccruedSwapFees:          []AccruedSwapFees{},
+		PriceHistoryEntries:      []PriceHistoryEntry{},
+		LastVaultId:              0,
+		Vaults:                   []Vault{},
+		RequestResults:           []RequestResult{},
+		PairStatsBuckets:         []PairStatsBucket{},
+		LastSwapRouteId:          0,
+		SwapRoutes:               []SwapRoute{},
+		BatchResults:             []BatchResult{},
+		OrderCommits:             []OrderCommit{},
 	}
 }
 
@@ -66,6 +76,9 @@ func (genState GenesisState) Validate() error {
 		if req.MintedPoolCoin.Denom != pool.PoolCoinDenom {
 			return fmt.Errorf("deposit request at index %d has wrong minted pool coin: %s", i, req.MintedPoolCoin)
 		}
+		if req.Id > pool.LastDepositRequestId {
+			return fmt.Errorf("deposit request at index %d has an id greater than its pool's last deposit request id: %d", i, req.Id)
+		}
 		pair := pairMap[pool.PairId]
 		if req.DepositCoins.AmountOf(pair.BaseCoinDenom).IsZero() ||
 			req.DepositCoins.AmountOf(pair.QuoteCoinDenom).IsZero() {
@@ -92,6 +105,9 @@ func (genState GenesisState) Validate() error {
 		if req.PoolCoin.Denom != pool.PoolCoinDenom {
 			return fmt.Errorf("withdraw request at index %d has wrong pool coin: %s", i, req.PoolCoin)
 		}
+		if req.Id > pool.LastWithdrawRequestId {
+			return fmt.Errorf("withdraw request at index %d has an id greater than its pool's last withdraw request id: %d", i, req.Id)
+		}
 		if set, ok := withdrawReqSet[req.PoolId]; ok {
 			if _, ok := set[req.Id]; ok {
 				return fmt.Errorf("withdraw request at index %d has a duplicate id: %d", i, req.Id)
@@ -110,6 +126,9 @@ func (genState GenesisState) Validate() error {
 		if !ok {
 			return fmt.Errorf("order at index %d has unknown pair id: %d", i, order.PairId)
 		}
+		if order.Id > pair.LastOrderId {
+			return fmt.Errorf("order at index %d has an id greater than its pair's last order id: %d", i, order.Id)
+		}
 		if order.BatchId > pair.CurrentBatchId {
 			return fmt.Errorf("order at index %d has a batch id greater than its pair's current batch id: %d", i, order.BatchId)
 		}
@@ -135,5 +154,127 @@ func (genState GenesisState) Validate() error {
 		}
 		orderSet[order.PairId][order.Id] = struct{}{}
 	}
+	accruedSwapFeesSet := map[uint64]struct{}{}
+	for i, fees := range genState.AccruedSwapFees {
+		if err := fees.Validate(); err != nil {
+			return fmt.Errorf("invalid accrued swap fees at index %d: %w", i, err)
+		}
+		pair, ok := pairMap[fees.PairId]
+		if !ok {
+			return fmt.Errorf("accrued swap fees at index %d has unknown pair id: %d", i, fees.PairId)
+		}
+		for _, fee := range fees.Fees {
+			if fee.Denom != pair.BaseCoinDenom && fee.Denom != pair.QuoteCoinDenom {
+				return fmt.Errorf("accrued swap fees at index %d has wrong fee denom: %s", i, fee.Denom)
+			}
+		}
+		if _, ok := accruedSwapFeesSet[fees.PairId]; ok {
+			return fmt.Errorf("accrued swap fees at index %d has a duplicate pair id: %d", i, fees.PairId)
+		}
+		accruedSwapFeesSet[fees.PairId] = struct{}{}
+	}
+	priceHistoryEntrySet := map[uint64]map[int64]struct{}{}
+	for i, entry := range genState.PriceHistoryEntries {
+		if err := entry.Validate(); err != nil {
+			return fmt.Errorf("invalid price history entry at index %d: %w", i, err)
+		}
+		if _, ok := pairMap[entry.PairId]; !ok {
+			return fmt.Errorf("price history entry at index %d has unknown pair id: %d", i, entry.PairId)
+		}
+		if priceHistoryEntrySet[entry.PairId] == nil {
+			priceHistoryEntrySet[entry.PairId] = map[int64]struct{}{}
+		}
+		if _, ok := priceHistoryEntrySet[entry.PairId][entry.Height]; ok {
+			return fmt.Errorf("price history entry at index %d has a duplicate height: %d", i, entry.Height)
+		}
+		priceHistoryEntrySet[entry.PairId][entry.Height] = struct{}{}
+	}
+	vaultSet := map[uint64]struct{}{}
+	for i, vault := range genState.Vaults {
+		if err := vault.Validate(); err != nil {
+			return fmt.Errorf("invalid vault at index %d: %w", i, err)
+		}
+		if vault.Id > genState.LastVaultId {
+			return fmt.Errorf("vault at index %d has an id greater than last vault id: %d", i, vault.Id)
+		}
+		if _, ok := pairMap[vault.PairId]; !ok {
+			return fmt.Errorf("vault at index %d has unknown pair id: %d", i, vault.PairId)
+		}
+		if _, ok := vaultSet[vault.Id]; ok {
+			return fmt.Errorf("vault at index %d has a duplicate vault id: %d", i, vault.Id)
+		}
+		vaultSet[vault.Id] = struct{}{}
+	}
+	requestResultSet := map[string]struct{}{}
+	for i, result := range genState.RequestResults {
+		if err := result.Validate(); err != nil {
+			return fmt.Errorf("invalid request result at index %d: %w", i, err)
+		}
+		key := string(GetRequestResultKey(result.Type, result.TargetId, result.Id))
+		if _, ok := requestResultSet[key]; ok {
+			return fmt.Errorf("request result at index %d is duplicate", i)
+		}
+		requestResultSet[key] = struct{}{}
+	}
+	pairStatsBucketSet := map[string]struct{}{}
+	for i, bucket := range genState.PairStatsBuckets {
+		if err := bucket.Validate(); err != nil {
+			return fmt.Errorf("invalid pair stats bucket at index %d: %w", i, err)
+		}
+		if _, ok := pairMap[bucket.PairId]; !ok {
+			return fmt.Errorf("pair stats bucket at index %d has unknown pair id: %d", i, bucket.PairId)
+		}
+		key := string(GetPairStatsBucketKey(bucket.PairId, bucket.StartTime))
+		if _, ok := pairStatsBucketSet[key]; ok {
+			return fmt.Errorf("pair stats bucket at index %d is duplicate", i)
+		}
+		pairStatsBucketSet[key] = struct{}{}
+	}
+	swapRouteSet := map[uint64]struct{}{}
+	for i, route := range genState.SwapRoutes {
+		if err := route.Validate(); err != nil {
+			return fmt.Errorf("invalid swap route at index %d: %w", i, err)
+		}
+		if route.Id > genState.LastSwapRouteId {
+			return fmt.Errorf("swap route at index %d has an id greater than last swap route id: %d", i, route.Id)
+		}
+		for _, pairId := range route.PairIds {
+			if _, ok := pairMap[pairId]; !ok {
+				return fmt.Errorf("swap route at index %d has unknown pair id: %d", i, pairId)
+			}
+		}
+		if _, ok := swapRouteSet[route.Id]; ok {
+			return fmt.Errorf("swap route at index %d has a duplicate swap route id: %d", i, route.Id)
+		}
+		swapRouteSet[route.Id] = struct{}{}
+	}
+	batchResultSet := map[string]struct{}{}
+	for i, result := range genState.BatchResults {
+		if err := result.Validate(); err != nil {
+			return fmt.Errorf("invalid batch result at index %d: %w", i, err)
+		}
+		if _, ok := pairMap[result.PairId]; !ok {
+			return fmt.Errorf("batch result at index %d has unknown pair id: %d", i, result.PairId)
+		}
+		key := string(GetBatchResultKey(result.PairId, result.BatchId))
+		if _, ok := batchResultSet[key]; ok {
+			return fmt.Errorf("batch result at index %d is duplicate", i)
+		}
+		batchResultSet[key] = struct{}{}
+	}
+	orderCommitSet := map[string]struct{}{}
+	for i, commit := range genState.OrderCommits {
+		if err := commit.Validate(); err != nil {
+			return fmt.Errorf("invalid order commit at index %d: %w", i, err)
+		}
+		if _, ok := pairMap[commit.PairId]; !ok {
+			return fmt.Errorf("order commit at index %d has unknown pair id: %d", i, commit.PairId)
+		}
+		key := string(GetOrderCommitKey(commit.PairId, commit.GetOrderer(), commit.Hash))
+		if _, ok := orderCommitSet[key]; ok {
+			return fmt.Errorf("order commit at index %d is duplicate", i)
+		}
+		orderCommitSet[key] = struct{}{}
+	}
 	return nil
 }
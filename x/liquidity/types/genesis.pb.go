@@ -25,15 +25,25 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the liquidity module's genesis state.
 type GenesisState struct {
-	Params                   Params            `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	LastPairId               uint64            `protobuf:"varint,2,opt,name=last_pair_id,json=lastPairId,proto3" json:"last_pair_id,omitempty"`
-	LastPoolId               uint64            `protobuf:"varint,3,opt,name=last_pool_id,json=lastPoolId,proto3" json:"last_pool_id,omitempty"`
-	Pairs                    []Pair            `protobuf:"bytes,4,rep,name=pairs,proto3" json:"pairs"`
-	Pools                    []Pool            `protobuf:"bytes,5,rep,name=pools,proto3" json:"pools"`
-	DepositRequests          []DepositRequest  `protobuf:"bytes,6,rep,name=deposit_requests,json=depositRequests,proto3" json:"deposit_requests"`
-	WithdrawRequests         []WithdrawRequest `protobuf:"bytes,7,rep,name=withdraw_requests,json=withdrawRequests,proto3" json:"withdraw_requests"`
-	Orders                   []Order           `protobuf:"bytes,8,rep,name=orders,proto3" json:"orders"`
-	MarketMakingOrderIndexes []MMOrderIndex    `protobuf:"bytes,9,rep,name=market_making_order_indexes,json=marketMakingOrderIndexes,proto3" json:"market_making_order_indexes"`
+	Params                   Params              `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	LastPairId               uint64              `protobuf:"varint,2,opt,name=last_pair_id,json=lastPairId,proto3" json:"last_pair_id,omitempty"`
+	LastPoolId               uint64              `protobuf:"varint,3,opt,name=last_pool_id,json=lastPoolId,proto3" json:"last_pool_id,omitempty"`
+	Pairs                    []Pair              `protobuf:"bytes,4,rep,name=pairs,proto3" json:"pairs"`
+	Pools                    []Pool              `protobuf:"bytes,5,rep,name=pools,proto3" json:"pools"`
+	DepositRequests          []DepositRequest    `protobuf:"bytes,6,rep,name=deposit_requests,json=depositRequests,proto3" json:"deposit_requests"`
+	WithdrawRequests         []WithdrawRequest   `protobuf:"bytes,7,rep,name=withdraw_requests,json=withdrawRequests,proto3" json:"withdraw_requests"`
+	Orders                   []Order             `protobuf:"bytes,8,rep,name=orders,proto3" json:"orders"`
+	MarketMakingOrderIndexes []MMOrderIndex      `protobuf:"bytes,9,rep,name=market_making_order_indexes,json=marketMakingOrderIndexes,proto3" json:"market_making_order_indexes"`
+	AccruedSwapFees          []AccruedSwapFees   `protobuf:"bytes,10,rep,name=accrued_swap_fees,json=accruedSwapFees,proto3" json:"accrued_swap_fees"`
+	PriceHistoryEntries      []PriceHistoryEntry `protobuf:"bytes,11,rep,name=price_history_entries,json=priceHistoryEntries,proto3" json:"price_history_entries"`
+	LastVaultId              uint64              `protobuf:"varint,12,opt,name=last_vault_id,json=lastVaultId,proto3" json:"last_vault_id,omitempty"`
+	Vaults                   []Vault             `protobuf:"bytes,13,rep,name=vaults,proto3" json:"vaults"`
+	RequestResults           []RequestResult     `protobuf:"bytes,14,rep,name=request_results,json=requestResults,proto3" json:"request_results"`
+	PairStatsBuckets         []PairStatsBucket   `protobuf:"bytes,15,rep,name=pair_stats_buckets,json=pairStatsBuckets,proto3" json:"pair_stats_buckets"`
+	LastSwapRouteId          uint64              `protobuf:"varint,16,opt,name=last_swap_route_id,json=lastSwapRouteId,proto3" json:"last_swap_route_id,omitempty"`
+	SwapRoutes               []SwapRoute         `protobuf:"bytes,17,rep,name=swap_routes,json=swapRoutes,proto3" json:"swap_routes"`
+	BatchResults             []BatchResult       `protobuf:"bytes,18,rep,name=batch_results,json=batchResults,proto3" json:"batch_results"`
+	OrderCommits             []OrderCommit       `protobuf:"bytes,19,rep,name=order_commits,json=orderCommits,proto3" json:"order_commits"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -78,35 +88,51 @@ func init() {
 }
 
 var fileDescriptor_6a6239844d27c73b = []byte{
-	// 435 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x41, 0x6b, 0xd4, 0x40,
-	0x14, 0x80, 0x13, 0xbb, 0x5d, 0x75, 0x5a, 0xb0, 0x06, 0x0f, 0xc3, 0x0a, 0x31, 0xf6, 0x14, 0x2a,
-	0x26, 0xb4, 0x7a, 0x11, 0x04, 0xa5, 0x08, 0xb2, 0x87, 0xc5, 0xb2, 0x1e, 0x0a, 0x0a, 0x86, 0xd9,
-	0x9d, 0x47, 0x3a, 0x6c, 0x92, 0x97, 0xce, 0x9b, 0xed, 0xb6, 0xff, 0xc2, 0x9f, 0xb5, 0xc7, 0x1e,
-	0x3d, 0x89, 0xee, 0xfe, 0x0e, 0x41, 0x32, 0xc9, 0xee, 0x76, 0x0f, 0x06, 0x6f, 0xe1, 0xe5, 0xfb,
-	0xbe, 0x37, 0x30, 0xc3, 0xc2, 0xb1, 0x06, 0x1a, 0x43, 0x61, 0xe2, 0x4c, 0x5d, 0x4e, 0x95, 0x54,
-	0xe6, 0x26, 0xbe, 0x3a, 0x1e, 0x81, 0x11, 0xc7, 0x71, 0x0a, 0x05, 0x90, 0xa2, 0xa8, 0xd4, 0x68,
-	0xd0, 0xeb, 0xad, 0xc8, 0x68, 0x4d, 0x46, 0x0d, 0xd9, 0x7b, 0x92, 0x62, 0x8a, 0x16, 0x8b, 0xab,
-	0xaf, 0xda, 0xe8, 0x1d, 0xb5, 0xb4, 0x37, 0x0d, 0xcb, 0x1e, 0xfe, 0xe9, 0xb0, 0xfd, 0x8f, 0xf5,
-	0xbe, 0xcf, 0x46, 0x18, 0xf0, 0xde, 0xb3, 0x6e, 0x29, 0xb4, 0xc8, 0x89, 0xbb, 0x81, 0x1b, 0xee,
-	0x9d, 0x1c, 0x46, 0xff, 0xde, 0x1f, 0x9d, 0x59, 0xf2, 0xb4, 0x33, 0xff, 0xf9, 0xcc, 0x19, 0x36,
-	0x9e, 0x17, 0xb0, 0xfd, 0x4c, 0x90, 0x49, 0x4a, 0xa1, 0x74, 0xa2, 0x24, 0xbf, 0x17, 0xb8, 0x61,
-	0x67, 0xc8, 0xaa, 0xd9, 0x99, 0x50, 0xba, 0x2f, 0x37, 0x04, 0x62, 0x56, 0x11, 0x3b, 0x77, 0x08,
-	0xc4, 0xac, 0x2f, 0xbd, 0xb7, 0x6c, 0xb7, 0xd2, 0x89, 0x77, 0x82, 0x9d, 0x70, 0xef, 0x24, 0x68,
-	0x3f, 0x84, 0xd2, 0xcd, 0x11, 0x6a, 0xc9, 0xda, 0x88, 0x19, 0xf1, 0xdd, 0xff, 0xb0, 0x11, 0xb3,
-	0xb5, 0x5d, 0x49, 0xde, 0x57, 0x76, 0x20, 0xa1, 0x44, 0x52, 0x26, 0xd1, 0x70, 0x39, 0x05, 0x32,
-	0xc4, 0xbb, 0x36, 0x74, 0xd4, 0x16, 0xfa, 0x50, 0x3b, 0xc3, 0x5a, 0x69, 0x92, 0x8f, 0xe4, 0xd6,
-	0x94, 0xbc, 0x6f, 0xec, 0xf1, 0x4c, 0x99, 0x0b, 0xa9, 0xc5, 0x6c, 0x53, 0xbf, 0x6f, 0xeb, 0x2f,
-	0xda, 0xea, 0xe7, 0x8d, 0xb4, 0x9d, 0x3f, 0x98, 0x6d, 0x8f, 0xc9, 0x7b, 0xc7, 0xba, 0xa8, 0x25,
-	0x68, 0xe2, 0x0f, 0x6c, 0xf4, 0x79, 0x5b, 0xf4, 0x53, 0x45, 0xae, 0x6e, 0xaf, 0xd6, 0xbc, 0x9c,
-	0x3d, 0xcd, 0x85, 0x9e, 0x80, 0x49, 0x72, 0x31, 0x51, 0x45, 0x9a, 0xd8, 0x79, 0xa2, 0x0a, 0x09,
-	0xd7, 0x40, 0xfc, 0xa1, 0xad, 0x86, 0x6d, 0xd5, 0xc1, 0xc0, 0x76, 0xfb, 0x95, 0xd1, 0xc4, 0x79,
-	0x9d, 0x1c, 0xd8, 0xe2, 0xe6, 0x2f, 0xd0, 0xe9, 0xf9, 0xfc, 0xb7, 0xef, 0xcc, 0x17, 0xbe, 0x7b,
-	0xbb, 0xf0, 0xdd, 0x5f, 0x0b, 0xdf, 0xfd, 0xbe, 0xf4, 0x9d, 0xdb, 0xa5, 0xef, 0xfc, 0x58, 0xfa,
-	0xce, 0x97, 0x37, 0xa9, 0x32, 0x17, 0xd3, 0x51, 0x34, 0xc6, 0x3c, 0x5e, 0x6d, 0x7c, 0x59, 0x80,
-	0x99, 0xa1, 0x9e, 0xac, 0x07, 0xf1, 0xd5, 0xeb, 0xf8, 0xfa, 0xce, 0x53, 0x37, 0x37, 0x25, 0xd0,
-	0xa8, 0x6b, 0xdf, 0xf7, 0xab, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x1c, 0xd1, 0x6a, 0x18, 0x69,
-	0x03, 0x00, 0x00,
+	// 693 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x5f, 0x6b, 0x13, 0x4d,
+	0x14, 0xc6, 0x93, 0xb7, 0x6d, 0xde, 0xf7, 0x9d, 0xa4, 0x4d, 0x3b, 0x55, 0x58, 0x2a, 0xc4, 0x58,
+	0x10, 0x63, 0x4b, 0x13, 0x5a, 0xbd, 0x11, 0x04, 0x35, 0xfe, 0x0d, 0x58, 0x2c, 0x29, 0x58, 0x51,
+	0x74, 0x99, 0xec, 0x1e, 0x93, 0x21, 0x7f, 0x66, 0x3b, 0x67, 0xb6, 0x69, 0xbe, 0x81, 0x97, 0x7e,
+	0xac, 0x5e, 0xf6, 0xd2, 0x2b, 0xd1, 0xf6, 0x8b, 0xc8, 0x9c, 0xdd, 0xcd, 0x36, 0x82, 0x1b, 0xef,
+	0x96, 0x67, 0x9e, 0xe7, 0x37, 0x87, 0x73, 0xce, 0x0e, 0xab, 0x79, 0x1a, 0xd0, 0x83, 0x91, 0x69,
+	0x0c, 0xe4, 0x71, 0x28, 0x7d, 0x69, 0x26, 0x8d, 0x93, 0xdd, 0x0e, 0x18, 0xb1, 0xdb, 0xe8, 0xc2,
+	0x08, 0x50, 0x62, 0x3d, 0xd0, 0xca, 0x28, 0xbe, 0x91, 0x38, 0xeb, 0x53, 0x67, 0x3d, 0x76, 0x6e,
+	0x5c, 0xeb, 0xaa, 0xae, 0x22, 0x5b, 0xc3, 0x7e, 0x45, 0x89, 0x8d, 0xad, 0x0c, 0x76, 0xca, 0x20,
+	0xef, 0xe6, 0x97, 0x22, 0x2b, 0xbd, 0x8c, 0xee, 0x3b, 0x34, 0xc2, 0x00, 0x7f, 0xcc, 0x0a, 0x81,
+	0xd0, 0x62, 0x88, 0x4e, 0xbe, 0x9a, 0xaf, 0x15, 0xf7, 0x36, 0xeb, 0x7f, 0xbe, 0xbf, 0x7e, 0x40,
+	0xce, 0xe6, 0xe2, 0xd9, 0xf7, 0x9b, 0xb9, 0x76, 0x9c, 0xe3, 0x55, 0x56, 0x1a, 0x08, 0x34, 0x6e,
+	0x20, 0xa4, 0x76, 0xa5, 0xef, 0xfc, 0x53, 0xcd, 0xd7, 0x16, 0xdb, 0xcc, 0x6a, 0x07, 0x42, 0xea,
+	0x96, 0x9f, 0x3a, 0x94, 0x1a, 0x58, 0xc7, 0xc2, 0x15, 0x87, 0x52, 0x83, 0x96, 0xcf, 0x1f, 0xb2,
+	0x25, 0x1b, 0x47, 0x67, 0xb1, 0xba, 0x50, 0x2b, 0xee, 0x55, 0xb3, 0x8b, 0x90, 0x3a, 0x2e, 0x21,
+	0x0a, 0x51, 0x5a, 0xa9, 0x01, 0x3a, 0x4b, 0x7f, 0x91, 0x56, 0x6a, 0x30, 0x4d, 0xdb, 0x10, 0xff,
+	0xc0, 0x56, 0x7d, 0x08, 0x14, 0x4a, 0xe3, 0x6a, 0x38, 0x0e, 0x01, 0x0d, 0x3a, 0x05, 0x02, 0x6d,
+	0x65, 0x81, 0x9e, 0x45, 0x99, 0x76, 0x14, 0x89, 0x91, 0x65, 0x7f, 0x46, 0x45, 0xfe, 0x89, 0xad,
+	0x8d, 0xa5, 0xe9, 0xf9, 0x5a, 0x8c, 0x53, 0xfa, 0xbf, 0x44, 0xdf, 0xce, 0xa2, 0x1f, 0xc5, 0xa1,
+	0x59, 0xfc, 0xea, 0x78, 0x56, 0x46, 0xfe, 0x88, 0x15, 0x94, 0xf6, 0x41, 0xa3, 0xf3, 0x1f, 0x41,
+	0x6f, 0x65, 0x41, 0xdf, 0x58, 0x67, 0x32, 0xbd, 0x28, 0xc6, 0x87, 0xec, 0xc6, 0x50, 0xe8, 0x3e,
+	0x18, 0x77, 0x28, 0xfa, 0x72, 0xd4, 0x75, 0x49, 0x77, 0xe5, 0xc8, 0x87, 0x53, 0x40, 0xe7, 0x7f,
+	0xa2, 0xd6, 0xb2, 0xa8, 0xfb, 0xfb, 0xc4, 0x6d, 0xd9, 0x44, 0x0c, 0x77, 0x22, 0xe4, 0x3e, 0x11,
+	0xd3, 0x53, 0x40, 0xfe, 0x91, 0xad, 0x09, 0xcf, 0xd3, 0x21, 0xf8, 0x2e, 0x8e, 0x45, 0xe0, 0x7e,
+	0x06, 0x40, 0x87, 0xcd, 0xef, 0xc7, 0x93, 0x28, 0x74, 0x38, 0x16, 0xc1, 0x0b, 0x80, 0x64, 0x05,
+	0xcb, 0x62, 0x56, 0xe6, 0x5d, 0x76, 0x3d, 0xd0, 0xd2, 0x03, 0xb7, 0x27, 0xd1, 0x28, 0x3d, 0x71,
+	0x61, 0x64, 0xb4, 0x04, 0x74, 0x8a, 0x74, 0xc5, 0x4e, 0xe6, 0x66, 0xd8, 0xe0, 0xab, 0x28, 0xf7,
+	0x7c, 0x64, 0xf4, 0x24, 0xbe, 0x64, 0x3d, 0xf8, 0xed, 0x40, 0x02, 0xf2, 0x4d, 0xb6, 0x4c, 0x2b,
+	0x7d, 0x22, 0xc2, 0x81, 0xb1, 0x3b, 0x5d, 0xa2, 0x9d, 0x2e, 0x5a, 0xf1, 0xad, 0xd5, 0x5a, 0xbe,
+	0x9d, 0x0d, 0x1d, 0xa3, 0xb3, 0x3c, 0x7f, 0x36, 0x14, 0x4a, 0x66, 0x13, 0xc5, 0xf8, 0x3b, 0x56,
+	0x8e, 0x77, 0xc6, 0xd5, 0x80, 0x44, 0x5a, 0x21, 0xd2, 0xdd, 0x2c, 0x52, 0xbc, 0x1b, 0x6d, 0xc0,
+	0x94, 0xb8, 0xa2, 0xaf, 0x8a, 0xc8, 0x5d, 0xc6, 0xe9, 0x77, 0x45, 0x23, 0x0c, 0xba, 0x9d, 0xd0,
+	0xeb, 0x83, 0x41, 0xa7, 0x3c, 0x7f, 0x0e, 0xf6, 0xe7, 0xb3, 0x0f, 0x07, 0x36, 0x29, 0x93, 0xec,
+	0x65, 0x30, 0x2b, 0x23, 0xdf, 0x66, 0x9c, 0xfa, 0x43, 0x43, 0xd6, 0x2a, 0x34, 0x60, 0x9b, 0xb4,
+	0x4a, 0x4d, 0x2a, 0xdb, 0x13, 0x3b, 0xb2, 0xb6, 0xd5, 0x5b, 0x3e, 0x7f, 0xcd, 0x8a, 0xa9, 0x0f,
+	0x9d, 0x35, 0x2a, 0xe3, 0x76, 0x56, 0x19, 0xd3, 0x74, 0x5c, 0x00, 0xc3, 0x44, 0x40, 0xde, 0x66,
+	0xcb, 0x1d, 0x61, 0xbc, 0xde, 0xb4, 0x67, 0x9c, 0x78, 0x77, 0xb2, 0x78, 0x4d, 0x1b, 0x98, 0xe9,
+	0x58, 0xa9, 0x93, 0x4a, 0xc4, 0x8c, 0xfe, 0x0b, 0x4f, 0x0d, 0x87, 0xd2, 0xa0, 0xb3, 0x3e, 0x9f,
+	0x49, 0x7b, 0xff, 0x94, 0xfc, 0x09, 0x53, 0xa5, 0x12, 0x36, 0x8f, 0xce, 0x7e, 0x56, 0x72, 0x67,
+	0x17, 0x95, 0xfc, 0xf9, 0x45, 0x25, 0xff, 0xe3, 0xa2, 0x92, 0xff, 0x7a, 0x59, 0xc9, 0x9d, 0x5f,
+	0x56, 0x72, 0xdf, 0x2e, 0x2b, 0xb9, 0xf7, 0x0f, 0xba, 0xd2, 0xf4, 0xc2, 0x4e, 0xdd, 0x53, 0xc3,
+	0x46, 0x72, 0xc9, 0xce, 0x08, 0xcc, 0x58, 0xe9, 0xfe, 0x54, 0x68, 0x9c, 0xdc, 0x6f, 0x9c, 0x5e,
+	0x79, 0xf5, 0xcd, 0x24, 0x00, 0xec, 0x14, 0xe8, 0xa9, 0xbf, 0xf7, 0x6b, 0x00, 0x3f, 0x27, 0x8e,
+	0x8e, 0x74, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -129,6 +155,136 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.OrderCommits) > 0 {
+		for iNdEx := len(m.OrderCommits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OrderCommits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.BatchResults) > 0 {
+		for iNdEx := len(m.BatchResults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BatchResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.SwapRoutes) > 0 {
+		for iNdEx := len(m.SwapRoutes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SwapRoutes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if m.LastSwapRouteId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastSwapRouteId))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.PairStatsBuckets) > 0 {
+		for iNdEx := len(m.PairStatsBuckets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PairStatsBuckets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.RequestResults) > 0 {
+		for iNdEx := len(m.RequestResults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RequestResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.Vaults) > 0 {
+		for iNdEx := len(m.Vaults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Vaults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if m.LastVaultId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastVaultId))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.PriceHistoryEntries) > 0 {
+		for iNdEx := len(m.PriceHistoryEntries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceHistoryEntries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.AccruedSwapFees) > 0 {
+		for iNdEx := len(m.AccruedSwapFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccruedSwapFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	if len(m.MarketMakingOrderIndexes) > 0 {
 		for iNdEx := len(m.MarketMakingOrderIndexes) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -297,6 +453,60 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.AccruedSwapFees) > 0 {
+		for _, e := range m.AccruedSwapFees {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PriceHistoryEntries) > 0 {
+		for _, e := range m.PriceHistoryEntries {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastVaultId != 0 {
+		n += 1 + sovGenesis(uint64(m.LastVaultId))
+	}
+	if len(m.Vaults) > 0 {
+		for _, e := range m.Vaults {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RequestResults) > 0 {
+		for _, e := range m.RequestResults {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PairStatsBuckets) > 0 {
+		for _, e := range m.PairStatsBuckets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastSwapRouteId != 0 {
+		n += 2 + sovGenesis(uint64(m.LastSwapRouteId))
+	}
+	if len(m.SwapRoutes) > 0 {
+		for _, e := range m.SwapRoutes {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BatchResults) > 0 {
+		for _, e := range m.BatchResults {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.OrderCommits) > 0 {
+		for _, e := range m.OrderCommits {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -610,6 +820,316 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccruedSwapFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccruedSwapFees = append(m.AccruedSwapFees, AccruedSwapFees{})
+			if err := m.AccruedSwapFees[len(m.AccruedSwapFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceHistoryEntries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceHistoryEntries = append(m.PriceHistoryEntries, PriceHistoryEntry{})
+			if err := m.PriceHistoryEntries[len(m.PriceHistoryEntries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastVaultId", wireType)
+			}
+			m.LastVaultId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastVaultId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vaults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vaults = append(m.Vaults, Vault{})
+			if err := m.Vaults[len(m.Vaults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestResults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestResults = append(m.RequestResults, RequestResult{})
+			if err := m.RequestResults[len(m.RequestResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairStatsBuckets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PairStatsBuckets = append(m.PairStatsBuckets, PairStatsBucket{})
+			if err := m.PairStatsBuckets[len(m.PairStatsBuckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSwapRouteId", wireType)
+			}
+			m.LastSwapRouteId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastSwapRouteId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapRoutes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SwapRoutes = append(m.SwapRoutes, SwapRoute{})
+			if err := m.SwapRoutes[len(m.SwapRoutes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchResults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BatchResults = append(m.BatchResults, BatchResult{})
+			if err := m.BatchResults[len(m.BatchResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderCommits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderCommits = append(m.OrderCommits, OrderCommit{})
+			if err := m.OrderCommits[len(m.OrderCommits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
@@ -14,7 +15,10 @@ import (
 func TestGenesisState_Validate(t *testing.T) {
 	// Valid structs.
 	pair := types.NewPair(1, "denom1", "denom2")
+	pair.LastOrderId = 1
 	pool := types.NewBasicPool(1, 1, testAddr)
+	pool.LastDepositRequestId = 1
+	pool.LastWithdrawRequestId = 1
 	depositReq := types.DepositRequest{
 		Id:             1,
 		PoolId:         1,
@@ -48,6 +52,29 @@ func TestGenesisState_Validate(t *testing.T) {
 		ExpireAt:           utils.ParseTime("2022-02-01T00:00:00Z"),
 		Status:             types.OrderStatusPartiallyMatched,
 	}
+	accruedSwapFees := types.AccruedSwapFees{
+		PairId: 1,
+		Fees:   utils.ParseCoins("1000denom1,1000denom2"),
+	}
+	priceHistoryEntry := types.PriceHistoryEntry{
+		PairId: 1,
+		Height: 1,
+		Time:   utils.ParseTime("2022-01-01T00:00:00Z"),
+		Price:  utils.ParseDec("1.0"),
+	}
+	requestResult := types.NewRequestResult(
+		types.RequestTypeOrder, 1, 1, utils.TestAddress(2).String(), types.RequestResultCodeFailed,
+		types.FailureReasonTooSmallOrder, 1, utils.ParseTime("2022-01-01T00:00:00Z"))
+	pairStatsBucket := types.NewPairStatsBucket(1, utils.ParseTime("2022-01-01T00:00:00Z"))
+	pairStatsBucket.AddBatch(
+		utils.ParseDec("1.0"), sdk.NewInt(1000000), sdk.NewInt(1000000), 2, utils.ParseCoins("3000denom1,3000denom2"))
+	swapRoute := types.NewSwapRoute(1, types.NewMsgSwapExactIn(
+		utils.TestAddress(3), []uint64{1}, utils.ParseCoin("1000000denom1"), utils.ParseCoin("900000denom2"), time.Hour), utils.TestAddress(3))
+	swapRoute.OrderId = 1
+	batchResult := types.NewBatchResult(1, 1, 1, utils.ParseTime("2022-01-01T00:00:00Z"))
+	orderCommit := types.NewOrderCommit(
+		types.NewMsgCommitOrder(utils.TestAddress(4), 1, make([]byte, types.OrderCommitHashLength)),
+		1, 4, utils.ParseCoins("100000stake"))
 
 	for _, tc := range []struct {
 		name        string
@@ -143,6 +170,13 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			"deposit request at index 0 has wrong deposit coins: 1000000denom1,1000000denom3",
 		},
+		{
+			"wrong deposit request id",
+			func(genState *types.GenesisState) {
+				genState.DepositRequests[0].Id = 2
+			},
+			"deposit request at index 0 has an id greater than its pool's last deposit request id: 2",
+		},
 		{
 			"duplicate deposit request",
 			func(genState *types.GenesisState) {
@@ -171,6 +205,13 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			"withdraw request at index 0 has wrong pool coin: 1000000pool2",
 		},
+		{
+			"wrong withdraw request id",
+			func(genState *types.GenesisState) {
+				genState.WithdrawRequests[0].Id = 2
+			},
+			"withdraw request at index 0 has an id greater than its pool's last withdraw request id: 2",
+		},
 		{
 			"duplicate withdraw request",
 			func(genState *types.GenesisState) {
@@ -192,6 +233,13 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			"order at index 0 has unknown pair id: 2",
 		},
+		{
+			"wrong order id",
+			func(genState *types.GenesisState) {
+				genState.Orders[0].Id = 2
+			},
+			"order at index 0 has an id greater than its pair's last order id: 2",
+		},
 		{
 			"wrong batch id",
 			func(genState *types.GenesisState) {
@@ -221,6 +269,181 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			"order at index 1 has a duplicate id: 1",
 		},
+		{
+			"invalid accrued swap fees",
+			func(genState *types.GenesisState) {
+				genState.AccruedSwapFees[0].PairId = 0
+			},
+			"invalid accrued swap fees at index 0: pair id must not be 0",
+		},
+		{
+			"accrued swap fees with unknown pair",
+			func(genState *types.GenesisState) {
+				genState.AccruedSwapFees[0].PairId = 2
+			},
+			"accrued swap fees at index 0 has unknown pair id: 2",
+		},
+		{
+			"wrong accrued swap fee denom",
+			func(genState *types.GenesisState) {
+				genState.AccruedSwapFees[0].Fees = utils.ParseCoins("1000denom3")
+			},
+			"accrued swap fees at index 0 has wrong fee denom: denom3",
+		},
+		{
+			"duplicate accrued swap fees",
+			func(genState *types.GenesisState) {
+				genState.AccruedSwapFees = []types.AccruedSwapFees{accruedSwapFees, accruedSwapFees}
+			},
+			"accrued swap fees at index 1 has a duplicate pair id: 1",
+		},
+		{
+			"invalid price history entry",
+			func(genState *types.GenesisState) {
+				genState.PriceHistoryEntries[0].Price = sdk.ZeroDec()
+			},
+			"invalid price history entry at index 0: price must be positive: 0.000000000000000000",
+		},
+		{
+			"price history entry with unknown pair",
+			func(genState *types.GenesisState) {
+				genState.PriceHistoryEntries[0].PairId = 2
+			},
+			"price history entry at index 0 has unknown pair id: 2",
+		},
+		{
+			"duplicate price history entries",
+			func(genState *types.GenesisState) {
+				genState.PriceHistoryEntries = []types.PriceHistoryEntry{priceHistoryEntry, priceHistoryEntry}
+			},
+			"price history entry at index 1 has a duplicate height: 1",
+		},
+		{
+			"invalid request result type",
+			func(genState *types.GenesisState) {
+				genState.RequestResults[0].Type = types.RequestTypeUnspecified
+			},
+			"invalid request result at index 0: invalid request type: REQUEST_TYPE_UNSPECIFIED",
+		},
+		{
+			"invalid request result reason",
+			func(genState *types.GenesisState) {
+				genState.RequestResults[0].Code = types.RequestResultCodeExpired
+			},
+			"invalid request result at index 0: reason must be empty for result code REQUEST_RESULT_CODE_EXPIRED",
+		},
+		{
+			"duplicate request results",
+			func(genState *types.GenesisState) {
+				genState.RequestResults = []types.RequestResult{requestResult, requestResult}
+			},
+			"request result at index 1 is duplicate",
+		},
+		{
+			"invalid pair stats bucket start time",
+			func(genState *types.GenesisState) {
+				genState.PairStatsBuckets[0].StartTime = utils.ParseTime("2022-01-01T00:30:00Z")
+			},
+			"invalid pair stats bucket at index 0: start time must be on the hour: 2022-01-01 00:30:00 +0000 UTC",
+		},
+		{
+			"invalid pair stats bucket prices",
+			func(genState *types.GenesisState) {
+				genState.PairStatsBuckets[0].LowPrice = utils.ParseDec("1.1")
+			},
+			"invalid pair stats bucket at index 0: low price must not be greater than high price: 1.100000000000000000 > 1.000000000000000000",
+		},
+		{
+			"pair stats bucket with unknown pair",
+			func(genState *types.GenesisState) {
+				genState.PairStatsBuckets[0].PairId = 2
+			},
+			"pair stats bucket at index 0 has unknown pair id: 2",
+		},
+		{
+			"duplicate pair stats buckets",
+			func(genState *types.GenesisState) {
+				genState.PairStatsBuckets = []types.PairStatsBucket{pairStatsBucket, pairStatsBucket}
+			},
+			"pair stats bucket at index 1 is duplicate",
+		},
+		{
+			"invalid swap route hop",
+			func(genState *types.GenesisState) {
+				genState.SwapRoutes[0].Hop = 1
+			},
+			"invalid swap route at index 0: hop must be less than the number of pairs: 1",
+		},
+		{
+			"wrong swap route id",
+			func(genState *types.GenesisState) {
+				genState.SwapRoutes[0].Id = 2
+			},
+			"swap route at index 0 has an id greater than last swap route id: 2",
+		},
+		{
+			"swap route with unknown pair",
+			func(genState *types.GenesisState) {
+				genState.SwapRoutes[0].PairIds = []uint64{2}
+			},
+			"swap route at index 0 has unknown pair id: 2",
+		},
+		{
+			"duplicate swap routes",
+			func(genState *types.GenesisState) {
+				genState.SwapRoutes = []types.SwapRoute{swapRoute, swapRoute}
+			},
+			"swap route at index 1 has a duplicate swap route id: 1",
+		},
+		{
+			"invalid batch result match price",
+			func(genState *types.GenesisState) {
+				genState.BatchResults[0].Matched = true
+			},
+			"invalid batch result at index 0: match price must be positive: <nil>",
+		},
+		{
+			"batch result with unknown pair",
+			func(genState *types.GenesisState) {
+				genState.BatchResults[0].PairId = 2
+			},
+			"batch result at index 0 has unknown pair id: 2",
+		},
+		{
+			"duplicate batch results",
+			func(genState *types.GenesisState) {
+				genState.BatchResults = []types.BatchResult{batchResult, batchResult}
+			},
+			"batch result at index 1 is duplicate",
+		},
+		{
+			"invalid order commit hash",
+			func(genState *types.GenesisState) {
+				genState.OrderCommits[0].Hash = []byte{1}
+			},
+			"invalid order commit at index 0: hash length must be 32: 1",
+		},
+		{
+			"invalid order commit last reveal batch id",
+			func(genState *types.GenesisState) {
+				genState.OrderCommits[0].LastRevealBatchId = 1
+			},
+			"invalid order commit at index 0: last reveal batch id must be greater than batch id: 1 <= 1",
+		},
+		{
+			"order commit with unknown pair",
+			func(genState *types.GenesisState) {
+				genState.OrderCommits[0].PairId = 2
+			},
+			"order commit at index 0 has unknown pair id: 2",
+		},
+		{
+			"duplicate order commits",
+			func(genState *types.GenesisState) {
+				genState.OrderCommits = []types.OrderCommit{orderCommit, orderCommit}
+			},
+			"order commit at index 1 is duplicate",
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			genState := types.DefaultGenesis()
@@ -231,6 +454,14 @@ func TestGenesisState_Validate(t *testing.T) {
 			genState.DepositRequests = []types.DepositRequest{depositReq}
 			genState.WithdrawRequests = []types.WithdrawRequest{withdrawReq}
 			genState.Orders = []types.Order{order}
+			genState.AccruedSwapFees = []types.AccruedSwapFees{accruedSwapFees}
+			genState.PriceHistoryEntries = []types.PriceHistoryEntry{priceHistoryEntry}
+			genState.RequestResults = []types.RequestResult{requestResult}
+			genState.PairStatsBuckets = []types.PairStatsBucket{pairStatsBucket}
+			genState.SwapRoutes = []types.SwapRoute{swapRoute}
+			genState.LastSwapRouteId = 1
+			genState.BatchResults = []types.BatchResult{batchResult}
+			genState.OrderCommits = []types.OrderCommit{orderCommit}
 			tc.malleate(genState)
 			err := genState.Validate()
 			if tc.expectedErr == "" {
@@ -0,0 +1,61 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ LiquidityHooks = MultiLiquidityHooks{}
+
+// LiquidityHooks defines the hooks called by the liquidity module, which
+// incentive modules can subscribe to without the liquidity module depending
+// on them.
+// The hooks are called after the state changes, including the transfers of
+// coins, are made.
+type LiquidityHooks interface {
+	// AfterPoolCreated is called after a basic or ranged pool is created.
+	AfterPoolCreated(ctx sdk.Context, creator sdk.AccAddress, pool Pool)
+	// AfterDeposit is called after a deposit request is executed successfully.
+	AfterDeposit(ctx sdk.Context, depositor sdk.AccAddress, poolId uint64, acceptedCoins sdk.Coins, mintedPoolCoin sdk.Coin)
+	// AfterWithdraw is called after a withdraw request is executed successfully.
+	AfterWithdraw(ctx sdk.Context, withdrawer sdk.AccAddress, poolId uint64, burnedPoolCoin sdk.Coin, withdrawnCoins sdk.Coins)
+	// AfterSwapMatched is called for each user order matched in a batch.
+	// paidCoin and receivedCoin are the amounts matched in the batch, and
+	// receivedCoin excludes the swap fee.
+	AfterSwapMatched(ctx sdk.Context, orderer sdk.AccAddress, pairId, orderId uint64, paidCoin, receivedCoin sdk.Coin)
+}
+
+// MultiLiquidityHooks combines multiple liquidity hooks, all hook functions
+// are run in array sequence.
+type MultiLiquidityHooks []LiquidityHooks
+
+// NewMultiLiquidityHooks returns a new MultiLiquidityHooks.
+func NewMultiLiquidityHooks(hooks ...LiquidityHooks) MultiLiquidityHooks {
+	return hooks
+}
+
+func (h MultiLiquidityHooks) AfterPoolCreated(ctx sdk.Context, creator sdk.AccAddress, pool Pool) {
+	for i := range h {
+		h[i].AfterPoolCreated(ctx, creator, pool)
+	}
+}
+
+func (h MultiLiquidityHooks) AfterDeposit(
+	ctx sdk.Context, depositor sdk.AccAddress, poolId uint64, acceptedCoins sdk.Coins, mintedPoolCoin sdk.Coin) {
+	for i := range h {
+		h[i].AfterDeposit(ctx, depositor, poolId, acceptedCoins, mintedPoolCoin)
+	}
+}
+
+func (h MultiLiquidityHooks) AfterWithdraw(
+	ctx sdk.Context, withdrawer sdk.AccAddress, poolId uint64, burnedPoolCoin sdk.Coin, withdrawnCoins sdk.Coins) {
+	for i := range h {
+		h[i].AfterWithdraw(ctx, withdrawer, poolId, burnedPoolCoin, withdrawnCoins)
+	}
+}
+
+func (h MultiLiquidityHooks) AfterSwapMatched(
+	ctx sdk.Context, orderer sdk.AccAddress, pairId, orderId uint64, paidCoin, receivedCoin sdk.Coin) {
+	for i := range h {
+		h[i].AfterSwapMatched(ctx, orderer, pairId, orderId, paidCoin, receivedCoin)
+	}
+}
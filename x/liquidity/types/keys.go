@@ -2,6 +2,8 @@ package types
 
 import (
 	"bytes"
+	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
@@ -22,8 +24,10 @@ const (
 )
 
 var (
-	LastPairIdKey = []byte{0xa0} // key for the latest pair id
-	LastPoolIdKey = []byte{0xa1} // key for the latest pool id
+	LastPairIdKey      = []byte{0xa0} // key for the latest pair id
+	LastPoolIdKey      = []byte{0xa1} // key for the latest pool id
+	LastVaultIdKey     = []byte{0xa2} // key for the latest vault id
+	LastSwapRouteIdKey = []byte{0xa3} // key for the latest swap route id
 
 	PairKeyPrefix               = []byte{0xa5}
 	PairIndexKeyPrefix          = []byte{0xa6}
@@ -40,6 +44,25 @@ var (
 	OrderKeyPrefix                = []byte{0xb2}
 	OrderIndexKeyPrefix           = []byte{0xb3}
 	MMOrderIndexKeyPrefix         = []byte{0xb6}
+	OrderCommitKeyPrefix          = []byte{0xb7}
+
+	AccruedSwapFeesKeyPrefix   = []byte{0xc0}
+	PriceHistoryEntryKeyPrefix = []byte{0xc1}
+
+	VaultKeyPrefix             = []byte{0xc2}
+	VaultsByPairIndexKeyPrefix = []byte{0xc3}
+
+	RequestResultKeyPrefix = []byte{0xc4}
+
+	PairStatsBucketKeyPrefix = []byte{0xc5}
+
+	SwapRouteKeyPrefix = []byte{0xc6}
+
+	BatchResultKeyPrefix = []byte{0xc7}
+
+	NumPriceHistoryEntriesKeyPrefix = []byte{0xc8}
+
+	RequestResultIndexKeyPrefix = []byte{0xc9}
 )
 
 // GetPairKey returns the store key to retrieve pair object from the pair id.
@@ -87,6 +110,40 @@ func GetPoolsByPairIndexKeyPrefix(pairId uint64) []byte {
 	return append(PoolsByPairIndexKeyPrefix, sdk.Uint64ToBigEndian(pairId)...)
 }
 
+// GetVaultKey returns the store key to retrieve vault object from the vault id.
+func GetVaultKey(vaultId uint64) []byte {
+	return append(VaultKeyPrefix, sdk.Uint64ToBigEndian(vaultId)...)
+}
+
+// GetVaultsByPairIndexKey returns the index key to retrieve vault id that is used to iterate vaults.
+func GetVaultsByPairIndexKey(pairId, vaultId uint64) []byte {
+	return append(append(VaultsByPairIndexKeyPrefix, sdk.Uint64ToBigEndian(pairId)...), sdk.Uint64ToBigEndian(vaultId)...)
+}
+
+// GetVaultsByPairIndexKeyPrefix returns the store key to retrieve vault id to iterate vaults.
+func GetVaultsByPairIndexKeyPrefix(pairId uint64) []byte {
+	return append(VaultsByPairIndexKeyPrefix, sdk.Uint64ToBigEndian(pairId)...)
+}
+
+// GetRequestResultKey returns the store key to retrieve the result of
+// a request or an order.
+func GetRequestResultKey(typ RequestType, targetId, id uint64) []byte {
+	return append(append(append(RequestResultKeyPrefix, byte(typ)), sdk.Uint64ToBigEndian(targetId)...), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetRequestResultIndexKey returns the index key to iterate request results
+// in the order of their finish time.
+func GetRequestResultIndexKey(finishedAt time.Time, typ RequestType, targetId, id uint64) []byte {
+	return append(append(append(append(RequestResultIndexKeyPrefix,
+		sdk.FormatTimeBytes(finishedAt)...), byte(typ)), sdk.Uint64ToBigEndian(targetId)...), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetRequestResultIndexEndKey returns the end key to iterate request results
+// finished at or before the time.
+func GetRequestResultIndexEndKey(t time.Time) []byte {
+	return append(RequestResultIndexKeyPrefix, sdk.FormatTimeBytes(t.Add(1))...)
+}
+
 // GetDepositRequestKey returns the store key to retrieve deposit request object from the pool id and request id.
 func GetDepositRequestKey(poolId, id uint64) []byte {
 	return append(append(DepositRequestKeyPrefix, sdk.Uint64ToBigEndian(poolId)...), sdk.Uint64ToBigEndian(id)...)
@@ -145,12 +202,84 @@ func GetOrderIndexKeyPrefix(orderer sdk.AccAddress) []byte {
 	return append(OrderIndexKeyPrefix, address.MustLengthPrefix(orderer)...)
 }
 
+// GetOrderIndexKeyPrefixByPair returns the index key prefix to iterate
+// orders by an orderer in a pair.
+func GetOrderIndexKeyPrefixByPair(orderer sdk.AccAddress, pairId uint64) []byte {
+	return append(GetOrderIndexKeyPrefix(orderer), sdk.Uint64ToBigEndian(pairId)...)
+}
+
 // GetMMOrderIndexKey returns the store key to retrieve MMOrderIndex object by
 // orderer and pair id.
 func GetMMOrderIndexKey(orderer sdk.AccAddress, pairId uint64) []byte {
 	return append(append(MMOrderIndexKeyPrefix, address.MustLengthPrefix(orderer)...), sdk.Uint64ToBigEndian(pairId)...)
 }
 
+// GetOrderCommitKey returns the store key to retrieve order commit object by
+// pair id, orderer and hash.
+func GetOrderCommitKey(pairId uint64, orderer sdk.AccAddress, hash []byte) []byte {
+	return append(append(GetOrderCommitsByPairKeyPrefix(pairId), address.MustLengthPrefix(orderer)...), hash...)
+}
+
+// GetOrderCommitsByPairKeyPrefix returns the store key prefix to iterate
+// order commits of a pair.
+func GetOrderCommitsByPairKeyPrefix(pairId uint64) []byte {
+	return append(OrderCommitKeyPrefix, sdk.Uint64ToBigEndian(pairId)...)
+}
+
+// GetAccruedSwapFeesKey returns the store key to retrieve AccruedSwapFees
+// object by pair id.
+func GetAccruedSwapFeesKey(pairId uint64) []byte {
+	return append(AccruedSwapFeesKeyPrefix, sdk.Uint64ToBigEndian(pairId)...)
+}
+
+// GetPriceHistoryEntryKey returns the store key to retrieve PriceHistoryEntry
+// object by pair id and height.
+func GetPriceHistoryEntryKey(pairId uint64, height int64) []byte {
+	return append(GetPriceHistoryEntriesByPairKeyPrefix(pairId), sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// GetPriceHistoryEntriesByPairKeyPrefix returns the store key prefix to
+// iterate price history entries of a pair.
+func GetPriceHistoryEntriesByPairKeyPrefix(pairId uint64) []byte {
+	return append(PriceHistoryEntryKeyPrefix, sdk.Uint64ToBigEndian(pairId)...)
+}
+
+// GetNumPriceHistoryEntriesKey returns the store key to retrieve the number
+// of price history entries of a pair.
+func GetNumPriceHistoryEntriesKey(pairId uint64) []byte {
+	return append(NumPriceHistoryEntriesKeyPrefix, sdk.Uint64ToBigEndian(pairId)...)
+}
+
+// GetPairStatsBucketKey returns the store key to retrieve PairStatsBucket
+// object by pair id and start time.
+func GetPairStatsBucketKey(pairId uint64, startTime time.Time) []byte {
+	return append(GetPairStatsBucketsByPairKeyPrefix(pairId), sdk.Uint64ToBigEndian(uint64(startTime.Unix()))...)
+}
+
+// GetPairStatsBucketsByPairKeyPrefix returns the store key prefix to iterate
+// stats buckets of a pair.
+func GetPairStatsBucketsByPairKeyPrefix(pairId uint64) []byte {
+	return append(PairStatsBucketKeyPrefix, sdk.Uint64ToBigEndian(pairId)...)
+}
+
+// GetSwapRouteKey returns the store key to retrieve swap route object from
+// the swap route id.
+func GetSwapRouteKey(swapRouteId uint64) []byte {
+	return append(SwapRouteKeyPrefix, sdk.Uint64ToBigEndian(swapRouteId)...)
+}
+
+// GetBatchResultKey returns the store key to retrieve BatchResult object by
+// pair id and batch id.
+func GetBatchResultKey(pairId, batchId uint64) []byte {
+	return append(GetBatchResultsByPairKeyPrefix(pairId), sdk.Uint64ToBigEndian(batchId)...)
+}
+
+// GetBatchResultsByPairKeyPrefix returns the store key prefix to iterate
+// batch results of a pair.
+func GetBatchResultsByPairKeyPrefix(pairId uint64) []byte {
+	return append(BatchResultKeyPrefix, sdk.Uint64ToBigEndian(pairId)...)
+}
+
 // ParsePairsByDenomsIndexKey parses a pair by denom index key.
 func ParsePairsByDenomsIndexKey(key []byte) (denomA, denomB string, pairId uint64) {
 	if !bytes.HasPrefix(key, PairsByDenomsIndexKeyPrefix) {
@@ -177,6 +306,17 @@ func ParsePoolsByPairIndexKey(key []byte) (poolId uint64) {
 	return
 }
 
+// ParseVaultsByPairIndexKey parses a vault id from the index key.
+func ParseVaultsByPairIndexKey(key []byte) (vaultId uint64) {
+	if !bytes.HasPrefix(key, VaultsByPairIndexKeyPrefix) {
+		panic("key does not have proper prefix")
+	}
+
+	bytesLen := 8
+	vaultId = sdk.BigEndianToUint64(key[1+bytesLen:])
+	return
+}
+
 // ParseDepositRequestIndexKey parses a deposit request index key.
 func ParseDepositRequestIndexKey(key []byte) (depositor sdk.AccAddress, poolId, reqId uint64) {
 	if !bytes.HasPrefix(key, DepositRequestIndexKeyPrefix) {
@@ -216,6 +356,24 @@ func ParseOrderIndexKey(key []byte) (orderer sdk.AccAddress, pairId, orderId uin
 	return
 }
 
+// ParseRequestResultIndexKey parses a request result index key.
+func ParseRequestResultIndexKey(key []byte) (finishedAt time.Time, typ RequestType, targetId, id uint64) {
+	if !bytes.HasPrefix(key, RequestResultIndexKeyPrefix) {
+		panic("key does not have proper prefix")
+	}
+
+	timeLen := len(key) - 1 - 1 - 8 - 8
+	var err error
+	finishedAt, err = sdk.ParseTimeBytes(key[1 : 1+timeLen])
+	if err != nil {
+		panic(fmt.Errorf("parse finished at: %w", err))
+	}
+	typ = RequestType(key[1+timeLen])
+	targetId = sdk.BigEndianToUint64(key[2+timeLen : 2+timeLen+8])
+	id = sdk.BigEndianToUint64(key[2+timeLen+8:])
+	return
+}
+
 // LengthPrefixString returns length-prefixed bytes representation
 // of a string.
 func LengthPrefixString(s string) []byte {
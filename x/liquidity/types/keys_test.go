@@ -3,12 +3,14 @@ package types_test
 import (
 	"bytes"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/tendermint/tendermint/crypto"
 
+	utils "github.com/crescent-network/crescent/v4/types"
 	"github.com/crescent-network/crescent/v4/x/liquidity/types"
 )
 
@@ -188,12 +190,28 @@ func (s *keysTestSuite) TestOrderIndexKey() {
 		0x5c, 0xbc, 0x50, 0xf2, 0x85, 0xf7, 0x7d, 0xff, 0x52, 0x9f, 0x25, 0, 0, 0, 0,
 		0, 0, 0, 0x1, 0, 0, 0, 0, 0, 0, 0, 0x1}, key)
 	s.Require().True(bytes.HasPrefix(key, types.GetOrderIndexKeyPrefix(orderer)))
+	s.Require().True(bytes.HasPrefix(key, types.GetOrderIndexKeyPrefixByPair(orderer, 1)))
+	s.Require().False(bytes.HasPrefix(key, types.GetOrderIndexKeyPrefixByPair(orderer, 2)))
 	orderer2, pairId, orderId := types.ParseOrderIndexKey(key)
 	s.Require().Equal(orderer, orderer2)
 	s.Require().Equal(uint64(1), pairId)
 	s.Require().Equal(uint64(1), orderId)
 }
 
+func (s *keysTestSuite) TestRequestResultIndexKey() {
+	finishedAt := utils.ParseTime("2022-03-01T12:00:00Z")
+	key := types.GetRequestResultIndexKey(finishedAt, types.RequestTypeOrder, 1, 2)
+	finishedAt2, typ, pairId, orderId := types.ParseRequestResultIndexKey(key)
+	s.Require().Equal(finishedAt, finishedAt2)
+	s.Require().Equal(types.RequestTypeOrder, typ)
+	s.Require().Equal(uint64(1), pairId)
+	s.Require().Equal(uint64(2), orderId)
+
+	// The end key includes the results finished at the time only.
+	s.Require().Negative(bytes.Compare(key, types.GetRequestResultIndexEndKey(finishedAt)))
+	s.Require().Positive(bytes.Compare(key, types.GetRequestResultIndexEndKey(finishedAt.Add(-time.Nanosecond))))
+}
+
 func (s *keysTestSuite) TestMMOrderIndexKey() {
 	orderer := sdk.AccAddress(crypto.AddressHash([]byte("orderer")))
 	key := types.GetMMOrderIndexKey(orderer, 1)
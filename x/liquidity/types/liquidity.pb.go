@@ -214,25 +214,157 @@ func (OrderStatus) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_c9be4f53a63dce2f, []int{4}
 }
 
+// RequestType enumerates types of requests which have results.
+type RequestType int32
+
+const (
+	// REQUEST_TYPE_UNSPECIFIED specifies unknown request type
+	RequestTypeUnspecified RequestType = 0
+	// REQUEST_TYPE_DEPOSIT specifies deposit requests
+	RequestTypeDeposit RequestType = 1
+	// REQUEST_TYPE_WITHDRAW specifies withdraw requests
+	RequestTypeWithdraw RequestType = 2
+	// REQUEST_TYPE_ORDER specifies orders
+	RequestTypeOrder RequestType = 3
+)
+
+var RequestType_name = map[int32]string{
+	0: "REQUEST_TYPE_UNSPECIFIED",
+	1: "REQUEST_TYPE_DEPOSIT",
+	2: "REQUEST_TYPE_WITHDRAW",
+	3: "REQUEST_TYPE_ORDER",
+}
+
+var RequestType_value = map[string]int32{
+	"REQUEST_TYPE_UNSPECIFIED": 0,
+	"REQUEST_TYPE_DEPOSIT":     1,
+	"REQUEST_TYPE_WITHDRAW":    2,
+	"REQUEST_TYPE_ORDER":       3,
+}
+
+func (x RequestType) String() string {
+	return proto.EnumName(RequestType_name, int32(x))
+}
+
+func (RequestType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_c9be4f53a63dce2f, []int{5}
+}
+
+// RequestResultCode enumerates standardized terminal results of requests and
+// orders.
+type RequestResultCode int32
+
+const (
+	// REQUEST_RESULT_CODE_UNSPECIFIED specifies unknown result
+	RequestResultCodeUnspecified RequestResultCode = 0
+	// REQUEST_RESULT_CODE_SUCCEEDED indicates the request has been succeeded or
+	// the order has been completely matched
+	RequestResultCodeSucceeded RequestResultCode = 1
+	// REQUEST_RESULT_CODE_FAILED indicates the request or the order has failed
+	// for the reason specified
+	RequestResultCodeFailed RequestResultCode = 2
+	// REQUEST_RESULT_CODE_CANCELED indicates the order has been canceled
+	RequestResultCodeCanceled RequestResultCode = 3
+	// REQUEST_RESULT_CODE_EXPIRED indicates the order has been expired
+	RequestResultCodeExpired RequestResultCode = 4
+)
+
+var RequestResultCode_name = map[int32]string{
+	0: "REQUEST_RESULT_CODE_UNSPECIFIED",
+	1: "REQUEST_RESULT_CODE_SUCCEEDED",
+	2: "REQUEST_RESULT_CODE_FAILED",
+	3: "REQUEST_RESULT_CODE_CANCELED",
+	4: "REQUEST_RESULT_CODE_EXPIRED",
+}
+
+var RequestResultCode_value = map[string]int32{
+	"REQUEST_RESULT_CODE_UNSPECIFIED": 0,
+	"REQUEST_RESULT_CODE_SUCCEEDED":   1,
+	"REQUEST_RESULT_CODE_FAILED":      2,
+	"REQUEST_RESULT_CODE_CANCELED":    3,
+	"REQUEST_RESULT_CODE_EXPIRED":     4,
+}
+
+func (x RequestResultCode) String() string {
+	return proto.EnumName(RequestResultCode_name, int32(x))
+}
+
+func (RequestResultCode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_c9be4f53a63dce2f, []int{6}
+}
+
 // Params defines the parameters for the liquidity module.
 type Params struct {
-	BatchSize                    uint32                                   `protobuf:"varint,1,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
-	TickPrecision                uint32                                   `protobuf:"varint,2,opt,name=tick_precision,json=tickPrecision,proto3" json:"tick_precision,omitempty"`
-	FeeCollectorAddress          string                                   `protobuf:"bytes,3,opt,name=fee_collector_address,json=feeCollectorAddress,proto3" json:"fee_collector_address,omitempty"`
-	DustCollectorAddress         string                                   `protobuf:"bytes,4,opt,name=dust_collector_address,json=dustCollectorAddress,proto3" json:"dust_collector_address,omitempty"`
-	MinInitialPoolCoinSupply     github_com_cosmos_cosmos_sdk_types.Int   `protobuf:"bytes,5,opt,name=min_initial_pool_coin_supply,json=minInitialPoolCoinSupply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_initial_pool_coin_supply"`
-	PairCreationFee              github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=pair_creation_fee,json=pairCreationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"pair_creation_fee"`
-	PoolCreationFee              github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=pool_creation_fee,json=poolCreationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"pool_creation_fee"`
-	MinInitialDepositAmount      github_com_cosmos_cosmos_sdk_types.Int   `protobuf:"bytes,8,opt,name=min_initial_deposit_amount,json=minInitialDepositAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_initial_deposit_amount"`
-	MaxPriceLimitRatio           github_com_cosmos_cosmos_sdk_types.Dec   `protobuf:"bytes,9,opt,name=max_price_limit_ratio,json=maxPriceLimitRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_price_limit_ratio"`
-	MaxNumMarketMakingOrderTicks uint32                                   `protobuf:"varint,10,opt,name=max_num_market_making_order_ticks,json=maxNumMarketMakingOrderTicks,proto3" json:"max_num_market_making_order_ticks,omitempty"`
-	MaxOrderLifespan             time.Duration                            `protobuf:"bytes,11,opt,name=max_order_lifespan,json=maxOrderLifespan,proto3,stdduration" json:"max_order_lifespan"`
-	SwapFeeRate                  github_com_cosmos_cosmos_sdk_types.Dec   `protobuf:"bytes,12,opt,name=swap_fee_rate,json=swapFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"swap_fee_rate"`
-	WithdrawFeeRate              github_com_cosmos_cosmos_sdk_types.Dec   `protobuf:"bytes,13,opt,name=withdraw_fee_rate,json=withdrawFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"withdraw_fee_rate"`
-	DepositExtraGas              github_com_cosmos_cosmos_sdk_types.Gas   `protobuf:"varint,14,opt,name=deposit_extra_gas,json=depositExtraGas,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Gas" json:"deposit_extra_gas"`
-	WithdrawExtraGas             github_com_cosmos_cosmos_sdk_types.Gas   `protobuf:"varint,15,opt,name=withdraw_extra_gas,json=withdrawExtraGas,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Gas" json:"withdraw_extra_gas"`
-	OrderExtraGas                github_com_cosmos_cosmos_sdk_types.Gas   `protobuf:"varint,16,opt,name=order_extra_gas,json=orderExtraGas,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Gas" json:"order_extra_gas"`
-	MaxNumActivePoolsPerPair     uint32                                   `protobuf:"varint,17,opt,name=max_num_active_pools_per_pair,json=maxNumActivePoolsPerPair,proto3" json:"max_num_active_pools_per_pair,omitempty"`
+	BatchSize                      uint32                                   `protobuf:"varint,1,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	TickPrecision                  uint32                                   `protobuf:"varint,2,opt,name=tick_precision,json=tickPrecision,proto3" json:"tick_precision,omitempty"`
+	FeeCollectorAddress            string                                   `protobuf:"bytes,3,opt,name=fee_collector_address,json=feeCollectorAddress,proto3" json:"fee_collector_address,omitempty"`
+	DustCollectorAddress           string                                   `protobuf:"bytes,4,opt,name=dust_collector_address,json=dustCollectorAddress,proto3" json:"dust_collector_address,omitempty"`
+	MinInitialPoolCoinSupply       github_com_cosmos_cosmos_sdk_types.Int   `protobuf:"bytes,5,opt,name=min_initial_pool_coin_supply,json=minInitialPoolCoinSupply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_initial_pool_coin_supply"`
+	PairCreationFee                github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=pair_creation_fee,json=pairCreationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"pair_creation_fee"`
+	PoolCreationFee                github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=pool_creation_fee,json=poolCreationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"pool_creation_fee"`
+	MinInitialDepositAmount        github_com_cosmos_cosmos_sdk_types.Int   `protobuf:"bytes,8,opt,name=min_initial_deposit_amount,json=minInitialDepositAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_initial_deposit_amount"`
+	MaxNumMarketMakingOrderTicks   uint32                                   `protobuf:"varint,10,opt,name=max_num_market_making_order_ticks,json=maxNumMarketMakingOrderTicks,proto3" json:"max_num_market_making_order_ticks,omitempty"`
+	MaxOrderLifespan               time.Duration                            `protobuf:"bytes,11,opt,name=max_order_lifespan,json=maxOrderLifespan,proto3,stdduration" json:"max_order_lifespan"`
+	SwapFeeRate                    github_com_cosmos_cosmos_sdk_types.Dec   `protobuf:"bytes,12,opt,name=swap_fee_rate,json=swapFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"swap_fee_rate"`
+	WithdrawFeeRate                github_com_cosmos_cosmos_sdk_types.Dec   `protobuf:"bytes,13,opt,name=withdraw_fee_rate,json=withdrawFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"withdraw_fee_rate"`
+	DepositExtraGas                github_com_cosmos_cosmos_sdk_types.Gas   `protobuf:"varint,14,opt,name=deposit_extra_gas,json=depositExtraGas,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Gas" json:"deposit_extra_gas"`
+	WithdrawExtraGas               github_com_cosmos_cosmos_sdk_types.Gas   `protobuf:"varint,15,opt,name=withdraw_extra_gas,json=withdrawExtraGas,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Gas" json:"withdraw_extra_gas"`
+	OrderExtraGas                  github_com_cosmos_cosmos_sdk_types.Gas   `protobuf:"varint,16,opt,name=order_extra_gas,json=orderExtraGas,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Gas" json:"order_extra_gas"`
+	MaxNumActivePoolsPerPair       uint32                                   `protobuf:"varint,17,opt,name=max_num_active_pools_per_pair,json=maxNumActivePoolsPerPair,proto3" json:"max_num_active_pools_per_pair,omitempty"`
+	SwapFeeToPools                 bool                                     `protobuf:"varint,18,opt,name=swap_fee_to_pools,json=swapFeeToPools,proto3" json:"swap_fee_to_pools,omitempty"`
+	MaxNumPrunedEntriesPerMsg      uint32                                   `protobuf:"varint,19,opt,name=max_num_pruned_entries_per_msg,json=maxNumPrunedEntriesPerMsg,proto3" json:"max_num_pruned_entries_per_msg,omitempty"`
+	PruneRewardPerEntry            github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,20,rep,name=prune_reward_per_entry,json=pruneRewardPerEntry,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"prune_reward_per_entry"`
+	PriceHistoryLength             uint32                                   `protobuf:"varint,21,opt,name=price_history_length,json=priceHistoryLength,proto3" json:"price_history_length,omitempty"`
+	NumBootstrapBatches            uint32                                   `protobuf:"varint,22,opt,name=num_bootstrap_batches,json=numBootstrapBatches,proto3" json:"num_bootstrap_batches,omitempty"`
+	PoolFeeSweepEpoch              uint32                                   `protobuf:"varint,23,opt,name=pool_fee_sweep_epoch,json=poolFeeSweepEpoch,proto3" json:"pool_fee_sweep_epoch,omitempty"`
+	CircuitBreakerEnabled          bool                                     `protobuf:"varint,24,opt,name=circuit_breaker_enabled,json=circuitBreakerEnabled,proto3" json:"circuit_breaker_enabled,omitempty"`
+	MaxOrderPriceTicks             uint32                                   `protobuf:"varint,25,opt,name=max_order_price_ticks,json=maxOrderPriceTicks,proto3" json:"max_order_price_ticks,omitempty"`
+	RequestResultRetention         time.Duration                            `protobuf:"bytes,26,opt,name=request_result_retention,json=requestResultRetention,proto3,stdduration" json:"request_result_retention"`
+	MakerPriority                  bool                                     `protobuf:"varint,27,opt,name=maker_priority,json=makerPriority,proto3" json:"maker_priority,omitempty"`
+	MaxNumAutoPrunedRequestResults uint32                                   `protobuf:"varint,28,opt,name=max_num_auto_pruned_request_results,json=maxNumAutoPrunedRequestResults,proto3" json:"max_num_auto_pruned_request_results,omitempty"`
+	// pair_creation_fee_destination is where the pair creation fee goes to,
+	// one of "fee_collector", "community_pool" and "burn".
+	PairCreationFeeDestination string `protobuf:"bytes,29,opt,name=pair_creation_fee_destination,json=pairCreationFeeDestination,proto3" json:"pair_creation_fee_destination,omitempty"`
+	// permissionless_pair_creation is false if only the addresses in
+	// pair_creator_allowlist can create pairs.
+	PermissionlessPairCreation bool     `protobuf:"varint,30,opt,name=permissionless_pair_creation,json=permissionlessPairCreation,proto3" json:"permissionless_pair_creation,omitempty"`
+	PairCreatorAllowlist       []string `protobuf:"bytes,31,rep,name=pair_creator_allowlist,json=pairCreatorAllowlist,proto3" json:"pair_creator_allowlist,omitempty"`
+	// bypass_pool_price_check_for_new_pairs is true if pools created on pairs in
+	// their bootstrap phase skip the initial pool price check against the pair's
+	// last price.
+	BypassPoolPriceCheckForNewPairs bool `protobuf:"varint,32,opt,name=bypass_pool_price_check_for_new_pairs,json=bypassPoolPriceCheckForNewPairs,proto3" json:"bypass_pool_price_check_for_new_pairs,omitempty"`
+	// dust_sweep_epoch is the interval in blocks at which the truncation dust
+	// left in pair escrows and disabled pools' reserves is swept to the dust
+	// collector. Zero disables the sweep.
+	DustSweepEpoch uint32 `protobuf:"varint,33,opt,name=dust_sweep_epoch,json=dustSweepEpoch,proto3" json:"dust_sweep_epoch,omitempty"`
+	// instant_deposit_withdraw is true if deposit and withdraw requests are
+	// executed right away in the msg handler instead of in the next batch.
+	InstantDepositWithdraw bool `protobuf:"varint,34,opt,name=instant_deposit_withdraw,json=instantDepositWithdraw,proto3" json:"instant_deposit_withdraw,omitempty"`
+	// max_num_active_orders_per_pair is the maximum number of active orders
+	// an orderer can have in a pair. Orders are active until they are
+	// completed, canceled or expired. Zero means there is no limit.
+	MaxNumActiveOrdersPerPair uint32 `protobuf:"varint,35,opt,name=max_num_active_orders_per_pair,json=maxNumActiveOrdersPerPair,proto3" json:"max_num_active_orders_per_pair,omitempty"`
+	// upward_price_limit_ratio is the ratio of the highest price of orders to
+	// the last price of a pair, above the last price.
+	UpwardPriceLimitRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,36,opt,name=upward_price_limit_ratio,json=upwardPriceLimitRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"upward_price_limit_ratio"`
+	// downward_price_limit_ratio is the ratio of the lowest price of orders to
+	// the last price of a pair, below the last price.
+	DownwardPriceLimitRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,37,opt,name=downward_price_limit_ratio,json=downwardPriceLimitRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"downward_price_limit_ratio"`
+	// price_band_widening_batches is the number of batches in a row without a
+	// trade after which the price band of a pair widens by a step.
+	// Zero disables the widening.
+	PriceBandWideningBatches uint32 `protobuf:"varint,38,opt,name=price_band_widening_batches,json=priceBandWideningBatches,proto3" json:"price_band_widening_batches,omitempty"`
+	// max_price_band_widening_steps is the maximum number of steps the price
+	// band of a pair widens by.
+	MaxPriceBandWideningSteps uint32 `protobuf:"varint,39,opt,name=max_price_band_widening_steps,json=maxPriceBandWideningSteps,proto3" json:"max_price_band_widening_steps,omitempty"`
+	// order_commit_bond is the amount of coins escrowed for each order commit
+	// made by MsgCommitOrder, which is forfeited to the fee collector if the
+	// order is not revealed in time.
+	OrderCommitBond github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,40,rep,name=order_commit_bond,json=orderCommitBond,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"order_commit_bond"`
+	// order_reveal_batches is the number of batches of a pair after the batch
+	// of an order commit, in which the order can be revealed.
+	// Zero disables order commits.
+	OrderRevealBatches uint32 `protobuf:"varint,41,opt,name=order_reveal_batches,json=orderRevealBatches,proto3" json:"order_reveal_batches,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -277,6 +409,55 @@ type Pair struct {
 	LastOrderId    uint64                                  `protobuf:"varint,5,opt,name=last_order_id,json=lastOrderId,proto3" json:"last_order_id,omitempty"`
 	LastPrice      *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=last_price,json=lastPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"last_price,omitempty"`
 	CurrentBatchId uint64                                  `protobuf:"varint,7,opt,name=current_batch_id,json=currentBatchId,proto3" json:"current_batch_id,omitempty"`
+	// bootstrap_end_batch_id is the id of the batch in which the pair's
+	// bootstrap auction is executed. Orders are only accumulated, without
+	// matching, in batches before it.
+	BootstrapEndBatchId uint64 `protobuf:"varint,8,opt,name=bootstrap_end_batch_id,json=bootstrapEndBatchId,proto3" json:"bootstrap_end_batch_id,omitempty"`
+	// price_exponent is the difference between the decimal exponents of the
+	// quote coin and the base coin. A price in the smallest units of the coins
+	// equals to the display price multiplied by 10^price_exponent.
+	PriceExponent int32 `protobuf:"varint,9,opt,name=price_exponent,json=priceExponent,proto3" json:"price_exponent,omitempty"`
+	// creator is the bech32-encoded address of the pair creator.
+	// It is empty for pairs created before it was recorded.
+	Creator string `protobuf:"bytes,10,opt,name=creator,proto3" json:"creator,omitempty"`
+	// metadata is the immutable metadata of the pair attached by the pair
+	// creator or governance. It is nil until the metadata is attached.
+	Metadata *PairMetadata `protobuf:"bytes,11,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// halted is true if the pair is halted by the circuit breaker through
+	// governance. Orders can't be placed to a halted pair and its batches are
+	// not executed, while orders can still be canceled.
+	Halted bool `protobuf:"varint,12,opt,name=halted,proto3" json:"halted,omitempty"`
+	// batch_window is the number of batches in a batch window of the pair.
+	// If it is greater than 1, the pair's orders are matched only once in each
+	// window, in a batch chosen by the block header hash, so that the batch
+	// boundaries can't be predicted.
+	BatchWindow uint32 `protobuf:"varint,13,opt,name=batch_window,json=batchWindow,proto3" json:"batch_window,omitempty"`
+	// last_batch_height is the height at which the pair's batch was executed
+	// last time while the batch window is enabled.
+	LastBatchHeight int64 `protobuf:"varint,14,opt,name=last_batch_height,json=lastBatchHeight,proto3" json:"last_batch_height,omitempty"`
+	// order_amount_limits is the limits of the amounts of orders placed to the
+	// pair set through governance. It is nil if the pair has no limits.
+	OrderAmountLimits *PairOrderAmountLimits `protobuf:"bytes,15,opt,name=order_amount_limits,json=orderAmountLimits,proto3" json:"order_amount_limits,omitempty"`
+	// max_price_limit_ratio is the symmetric price limit ratio of the pair
+	// set through governance before the price band became directional.
+	// Deprecated: it is moved to upward_price_limit_ratio and
+	// downward_price_limit_ratio by the v4 store migration.
+	MaxPriceLimitRatio *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,16,opt,name=max_price_limit_ratio,json=maxPriceLimitRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_price_limit_ratio,omitempty"` // Deprecated: Do not use.
+	// max_order_lifespan overrides the max_order_lifespan param for the pair if
+	// it is set through governance.
+	MaxOrderLifespan *time.Duration `protobuf:"bytes,17,opt,name=max_order_lifespan,json=maxOrderLifespan,proto3,stdduration" json:"max_order_lifespan,omitempty"`
+	// address_version is the version of the scheme the escrow address is
+	// derived with.
+	AddressVersion uint32 `protobuf:"varint,18,opt,name=address_version,json=addressVersion,proto3" json:"address_version,omitempty"`
+	// upward_price_limit_ratio overrides the upward_price_limit_ratio param for
+	// the pair if it is set through governance.
+	UpwardPriceLimitRatio *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,19,opt,name=upward_price_limit_ratio,json=upwardPriceLimitRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"upward_price_limit_ratio,omitempty"`
+	// downward_price_limit_ratio overrides the downward_price_limit_ratio param
+	// for the pair if it is set through governance.
+	DownwardPriceLimitRatio *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,20,opt,name=downward_price_limit_ratio,json=downwardPriceLimitRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"downward_price_limit_ratio,omitempty"`
+	// num_batches_without_trade is the number of the pair's batches executed in
+	// a row without a trade since the last trade.
+	NumBatchesWithoutTrade uint32 `protobuf:"varint,21,opt,name=num_batches_without_trade,json=numBatchesWithoutTrade,proto3" json:"num_batches_without_trade,omitempty"`
 }
 
 func (m *Pair) Reset()         { *m = Pair{} }
@@ -312,6 +493,98 @@ func (m *Pair) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Pair proto.InternalMessageInfo
 
+// PairOrderAmountLimits defines the limits of the amounts of orders placed to
+// a pair. A zero value disables the limit.
+type PairOrderAmountLimits struct {
+	// min_base_order_amount is the minimum amount of an order in the base coin.
+	MinBaseOrderAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=min_base_order_amount,json=minBaseOrderAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_base_order_amount"`
+	// min_quote_order_amount is the minimum amount of an order in the quote coin,
+	// which is the order's amount multiplied by its price.
+	MinQuoteOrderAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=min_quote_order_amount,json=minQuoteOrderAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_quote_order_amount"`
+	// lot_size is the unit of the amount of an order in the base coin.
+	// The amount of an order must be a multiple of the lot size.
+	LotSize github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=lot_size,json=lotSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"lot_size"`
+}
+
+func (m *PairOrderAmountLimits) Reset()         { *m = PairOrderAmountLimits{} }
+func (m *PairOrderAmountLimits) String() string { return proto.CompactTextString(m) }
+func (*PairOrderAmountLimits) ProtoMessage()    {}
+func (*PairOrderAmountLimits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9be4f53a63dce2f, []int{2}
+}
+func (m *PairOrderAmountLimits) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PairOrderAmountLimits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PairOrderAmountLimits.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PairOrderAmountLimits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PairOrderAmountLimits.Merge(m, src)
+}
+func (m *PairOrderAmountLimits) XXX_Size() int {
+	return m.Size()
+}
+func (m *PairOrderAmountLimits) XXX_DiscardUnknown() {
+	xxx_messageInfo_PairOrderAmountLimits.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PairOrderAmountLimits proto.InternalMessageInfo
+
+// PairMetadata defines the display information of a pair for front-ends.
+type PairMetadata struct {
+	// display_name is the human readable name of the pair, e.g. "ATOM/CRE".
+	DisplayName string `protobuf:"bytes,1,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	// logo_uri_hash is the hex-encoded SHA-256 hash of the pair's logo, which
+	// can be used to verify a logo fetched from off-chain sources.
+	LogoUriHash string `protobuf:"bytes,2,opt,name=logo_uri_hash,json=logoUriHash,proto3" json:"logo_uri_hash,omitempty"`
+	// base_coin_decimals is the number of decimals of the base coin's display unit.
+	BaseCoinDecimals uint32 `protobuf:"varint,3,opt,name=base_coin_decimals,json=baseCoinDecimals,proto3" json:"base_coin_decimals,omitempty"`
+	// quote_coin_decimals is the number of decimals of the quote coin's display unit.
+	QuoteCoinDecimals uint32 `protobuf:"varint,4,opt,name=quote_coin_decimals,json=quoteCoinDecimals,proto3" json:"quote_coin_decimals,omitempty"`
+}
+
+func (m *PairMetadata) Reset()         { *m = PairMetadata{} }
+func (m *PairMetadata) String() string { return proto.CompactTextString(m) }
+func (*PairMetadata) ProtoMessage()    {}
+func (*PairMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9be4f53a63dce2f, []int{3}
+}
+func (m *PairMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PairMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PairMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PairMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PairMetadata.Merge(m, src)
+}
+func (m *PairMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *PairMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_PairMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PairMetadata proto.InternalMessageInfo
+
 // Pool defines generic liquidity pool object which can be either a basic pool or a
 // ranged pool.
 type Pool struct {
@@ -326,13 +599,20 @@ type Pool struct {
 	LastDepositRequestId  uint64                                  `protobuf:"varint,9,opt,name=last_deposit_request_id,json=lastDepositRequestId,proto3" json:"last_deposit_request_id,omitempty"`
 	LastWithdrawRequestId uint64                                  `protobuf:"varint,10,opt,name=last_withdraw_request_id,json=lastWithdrawRequestId,proto3" json:"last_withdraw_request_id,omitempty"`
 	Disabled              bool                                    `protobuf:"varint,11,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	// address_version is the version of the scheme the reserve address is
+	// derived with.
+	AddressVersion uint32 `protobuf:"varint,12,opt,name=address_version,json=addressVersion,proto3" json:"address_version,omitempty"`
+	// max_reserve_amount specifies the caps of the pool's reserve for each
+	// denom, which limit the deposits to the pool.
+	// The reserve of a denom not in max_reserve_amount is not capped.
+	MaxReserveAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,13,rep,name=max_reserve_amount,json=maxReserveAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"max_reserve_amount"`
 }
 
 func (m *Pool) Reset()         { *m = Pool{} }
 func (m *Pool) String() string { return proto.CompactTextString(m) }
 func (*Pool) ProtoMessage()    {}
 func (*Pool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9be4f53a63dce2f, []int{2}
+	return fileDescriptor_c9be4f53a63dce2f, []int{4}
 }
 func (m *Pool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -377,13 +657,17 @@ type DepositRequest struct {
 	AcceptedCoins  github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=accepted_coins,json=acceptedCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"accepted_coins"`
 	MintedPoolCoin types.Coin                               `protobuf:"bytes,7,opt,name=minted_pool_coin,json=mintedPoolCoin,proto3" json:"minted_pool_coin"`
 	Status         RequestStatus                            `protobuf:"varint,8,opt,name=status,proto3,enum=crescent.liquidity.v1beta1.RequestStatus" json:"status,omitempty"`
+	// single_asset specifies whether the request is made by MsgDepositSingleAsset
+	SingleAsset bool `protobuf:"varint,9,opt,name=single_asset,json=singleAsset,proto3" json:"single_asset,omitempty"`
+	// farm specifies whether to farm the minted pool coin in the lpfarm module
+	Farm bool `protobuf:"varint,10,opt,name=farm,proto3" json:"farm,omitempty"`
 }
 
 func (m *DepositRequest) Reset()         { *m = DepositRequest{} }
 func (m *DepositRequest) String() string { return proto.CompactTextString(m) }
 func (*DepositRequest) ProtoMessage()    {}
 func (*DepositRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9be4f53a63dce2f, []int{3}
+	return fileDescriptor_c9be4f53a63dce2f, []int{5}
 }
 func (m *DepositRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -433,7 +717,7 @@ func (m *WithdrawRequest) Reset()         { *m = WithdrawRequest{} }
 func (m *WithdrawRequest) String() string { return proto.CompactTextString(m) }
 func (*WithdrawRequest) ProtoMessage()    {}
 func (*WithdrawRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9be4f53a63dce2f, []int{4}
+	return fileDescriptor_c9be4f53a63dce2f, []int{6}
 }
 func (m *WithdrawRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -489,13 +773,16 @@ type Order struct {
 	BatchId  uint64      `protobuf:"varint,13,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
 	ExpireAt time.Time   `protobuf:"bytes,14,opt,name=expire_at,json=expireAt,proto3,stdtime" json:"expire_at"`
 	Status   OrderStatus `protobuf:"varint,15,opt,name=status,proto3,enum=crescent.liquidity.v1beta1.OrderStatus" json:"status,omitempty"`
+	// min_fill_amount specifies the minimum amount of base coin to be filled
+	// at once, except when the order is filled with all its remaining amount
+	MinFillAmount *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,16,opt,name=min_fill_amount,json=minFillAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_fill_amount,omitempty"`
 }
 
 func (m *Order) Reset()         { *m = Order{} }
 func (m *Order) String() string { return proto.CompactTextString(m) }
 func (*Order) ProtoMessage()    {}
 func (*Order) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9be4f53a63dce2f, []int{5}
+	return fileDescriptor_c9be4f53a63dce2f, []int{7}
 }
 func (m *Order) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -530,13 +817,17 @@ type MMOrderIndex struct {
 	Orderer  string   `protobuf:"bytes,1,opt,name=orderer,proto3" json:"orderer,omitempty"`
 	PairId   uint64   `protobuf:"varint,2,opt,name=pair_id,json=pairId,proto3" json:"pair_id,omitempty"`
 	OrderIds []uint64 `protobuf:"varint,3,rep,packed,name=order_ids,json=orderIds,proto3" json:"order_ids,omitempty"`
+	// auto_cancel_height is the block height at which the orders are
+	// automatically canceled. Zero means the orders are never automatically
+	// canceled.
+	AutoCancelHeight int64 `protobuf:"varint,4,opt,name=auto_cancel_height,json=autoCancelHeight,proto3" json:"auto_cancel_height,omitempty"`
 }
 
 func (m *MMOrderIndex) Reset()         { *m = MMOrderIndex{} }
 func (m *MMOrderIndex) String() string { return proto.CompactTextString(m) }
 func (*MMOrderIndex) ProtoMessage()    {}
 func (*MMOrderIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9be4f53a63dce2f, []int{6}
+	return fileDescriptor_c9be4f53a63dce2f, []int{8}
 }
 func (m *MMOrderIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -565,19 +856,445 @@ func (m *MMOrderIndex) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MMOrderIndex proto.InternalMessageInfo
 
+// AccruedSwapFees defines the total swap fees collected from matched orders
+// in a pair.
+type AccruedSwapFees struct {
+	PairId uint64                                   `protobuf:"varint,1,opt,name=pair_id,json=pairId,proto3" json:"pair_id,omitempty"`
+	Fees   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=fees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fees"`
+}
+
+func (m *AccruedSwapFees) Reset()         { *m = AccruedSwapFees{} }
+func (m *AccruedSwapFees) String() string { return proto.CompactTextString(m) }
+func (*AccruedSwapFees) ProtoMessage()    {}
+func (*AccruedSwapFees) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9be4f53a63dce2f, []int{9}
+}
+func (m *AccruedSwapFees) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccruedSwapFees) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccruedSwapFees.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccruedSwapFees) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccruedSwapFees.Merge(m, src)
+}
+func (m *AccruedSwapFees) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccruedSwapFees) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccruedSwapFees.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccruedSwapFees proto.InternalMessageInfo
+
+// PriceHistoryEntry defines a record of a pair's last price after a batch
+// execution.
+type PriceHistoryEntry struct {
+	PairId uint64                                 `protobuf:"varint,1,opt,name=pair_id,json=pairId,proto3" json:"pair_id,omitempty"`
+	Height int64                                  `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Time   time.Time                              `protobuf:"bytes,3,opt,name=time,proto3,stdtime" json:"time"`
+	Price  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+}
+
+func (m *PriceHistoryEntry) Reset()         { *m = PriceHistoryEntry{} }
+func (m *PriceHistoryEntry) String() string { return proto.CompactTextString(m) }
+func (*PriceHistoryEntry) ProtoMessage()    {}
+func (*PriceHistoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9be4f53a63dce2f, []int{10}
+}
+func (m *PriceHistoryEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceHistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceHistoryEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceHistoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceHistoryEntry.Merge(m, src)
+}
+func (m *PriceHistoryEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceHistoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceHistoryEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceHistoryEntry proto.InternalMessageInfo
+
+// Vault defines a vault where users deposit the pair's coins and the vault's
+// operator manages the liquidity within the bounds of the vault's strategy.
+// The vault's balances provide orders to the pair's batches as a ranged pool
+// whose price range is set by the operator.
+type Vault struct {
+	Id     uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PairId uint64 `protobuf:"varint,2,opt,name=pair_id,json=pairId,proto3" json:"pair_id,omitempty"`
+	// operator is the only address which can rebalance the vault
+	Operator       string `protobuf:"bytes,3,opt,name=operator,proto3" json:"operator,omitempty"`
+	ReserveAddress string `protobuf:"bytes,4,opt,name=reserve_address,json=reserveAddress,proto3" json:"reserve_address,omitempty"`
+	ShareDenom     string `protobuf:"bytes,5,opt,name=share_denom,json=shareDenom,proto3" json:"share_denom,omitempty"`
+	// max_price_deviation is the strategy parameter which bounds the vault's
+	// price range around the pair's last price at the time of rebalancing
+	MaxPriceDeviation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=max_price_deviation,json=maxPriceDeviation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_price_deviation"`
+	// operator_fee_rate is the portion of the vault's profit paid to the
+	// operator as vault shares
+	OperatorFeeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=operator_fee_rate,json=operatorFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"operator_fee_rate"`
+	// min_price and max_price are the vault's current price range.
+	// The vault provides no orders until its range is set by the operator.
+	MinPrice *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=min_price,json=minPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_price,omitempty"`
+	MaxPrice *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=max_price,json=maxPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_price,omitempty"`
+	// high_water_mark is the highest value of a share, in the quote coin,
+	// for which the operator fee has been charged
+	HighWaterMark github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=high_water_mark,json=highWaterMark,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"high_water_mark"`
+}
+
+func (m *Vault) Reset()         { *m = Vault{} }
+func (m *Vault) String() string { return proto.CompactTextString(m) }
+func (*Vault) ProtoMessage()    {}
+func (*Vault) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9be4f53a63dce2f, []int{11}
+}
+func (m *Vault) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Vault) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Vault.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Vault) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Vault.Merge(m, src)
+}
+func (m *Vault) XXX_Size() int {
+	return m.Size()
+}
+func (m *Vault) XXX_DiscardUnknown() {
+	xxx_messageInfo_Vault.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Vault proto.InternalMessageInfo
+
+// RequestResult defines the terminal result of a deposit request, a withdraw
+// request or an order.
+// It is kept after the request or the order is deleted, until it is pruned.
+type RequestResult struct {
+	Type RequestType `protobuf:"varint,1,opt,name=type,proto3,enum=crescent.liquidity.v1beta1.RequestType" json:"type,omitempty"`
+	// target_id is the pool id for deposit and withdraw requests, or the pair id
+	// for orders
+	TargetId uint64 `protobuf:"varint,2,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	// id is the request id or the order id
+	Id uint64 `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
+	// requester is the bech32-encoded address of the depositor, the withdrawer
+	// or the orderer
+	Requester string            `protobuf:"bytes,4,opt,name=requester,proto3" json:"requester,omitempty"`
+	Code      RequestResultCode `protobuf:"varint,5,opt,name=code,proto3,enum=crescent.liquidity.v1beta1.RequestResultCode" json:"code,omitempty"`
+	// reason is the machine-readable failure reason, which is set only when
+	// the code is REQUEST_RESULT_CODE_FAILED
+	Reason         string    `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	FinishedHeight int64     `protobuf:"varint,7,opt,name=finished_height,json=finishedHeight,proto3" json:"finished_height,omitempty"`
+	FinishedAt     time.Time `protobuf:"bytes,8,opt,name=finished_at,json=finishedAt,proto3,stdtime" json:"finished_at"`
+}
+
+func (m *RequestResult) Reset()         { *m = RequestResult{} }
+func (m *RequestResult) String() string { return proto.CompactTextString(m) }
+func (*RequestResult) ProtoMessage()    {}
+func (*RequestResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9be4f53a63dce2f, []int{12}
+}
+func (m *RequestResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RequestResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RequestResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RequestResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestResult.Merge(m, src)
+}
+func (m *RequestResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *RequestResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestResult proto.InternalMessageInfo
+
+// BatchResult defines the summary of the matching executed in a pair's batch.
+// It is kept for the request_result_retention param.
+type BatchResult struct {
+	PairId  uint64 `protobuf:"varint,1,opt,name=pair_id,json=pairId,proto3" json:"pair_id,omitempty"`
+	BatchId uint64 `protobuf:"varint,2,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+	// matched is false if no orders were matched in the batch.
+	Matched bool `protobuf:"varint,3,opt,name=matched,proto3" json:"matched,omitempty"`
+	// match_price is the price at which the orders were matched, which is nil
+	// if not matched.
+	MatchPrice *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=match_price,json=matchPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"match_price,omitempty"`
+	// matched_base_amount is the amount of the base coin traded in the batch.
+	MatchedBaseAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=matched_base_amount,json=matchedBaseAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"matched_base_amount"`
+	// matched_quote_amount is the amount of the quote coin traded in the batch.
+	MatchedQuoteAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=matched_quote_amount,json=matchedQuoteAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"matched_quote_amount"`
+	// num_fully_matched_orders is the number of user orders whose open amount
+	// was fully matched in the batch.
+	NumFullyMatchedOrders uint64 `protobuf:"varint,7,opt,name=num_fully_matched_orders,json=numFullyMatchedOrders,proto3" json:"num_fully_matched_orders,omitempty"`
+	// num_partially_matched_orders is the number of user orders which were
+	// matched in the batch with their open amount left.
+	NumPartiallyMatchedOrders uint64    `protobuf:"varint,8,opt,name=num_partially_matched_orders,json=numPartiallyMatchedOrders,proto3" json:"num_partially_matched_orders,omitempty"`
+	ExecutedHeight            int64     `protobuf:"varint,9,opt,name=executed_height,json=executedHeight,proto3" json:"executed_height,omitempty"`
+	ExecutedAt                time.Time `protobuf:"bytes,10,opt,name=executed_at,json=executedAt,proto3,stdtime" json:"executed_at"`
+}
+
+func (m *BatchResult) Reset()         { *m = BatchResult{} }
+func (m *BatchResult) String() string { return proto.CompactTextString(m) }
+func (*BatchResult) ProtoMessage()    {}
+func (*BatchResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9be4f53a63dce2f, []int{13}
+}
+func (m *BatchResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BatchResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchResult.Merge(m, src)
+}
+func (m *BatchResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchResult proto.InternalMessageInfo
+
+// SwapRoute defines the state of a swap through a route of pairs made by
+// MsgSwapExactIn.
+// It is deleted when the order in the last pair of the route finishes or the
+// swap fails.
+type SwapRoute struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// orderer is the bech32-encoded address that made the swap
+	Orderer    string     `protobuf:"bytes,2,opt,name=orderer,proto3" json:"orderer,omitempty"`
+	PairIds    []uint64   `protobuf:"varint,3,rep,packed,name=pair_ids,json=pairIds,proto3" json:"pair_ids,omitempty"`
+	OfferCoin  types.Coin `protobuf:"bytes,4,opt,name=offer_coin,json=offerCoin,proto3" json:"offer_coin"`
+	MinOutCoin types.Coin `protobuf:"bytes,5,opt,name=min_out_coin,json=minOutCoin,proto3" json:"min_out_coin"`
+	// order_lifespan is the lifespan of the order placed in each pair
+	OrderLifespan time.Duration `protobuf:"bytes,6,opt,name=order_lifespan,json=orderLifespan,proto3,stdduration" json:"order_lifespan"`
+	// escrow_address holds the coins of the swap between the orders and places
+	// the orders
+	EscrowAddress string `protobuf:"bytes,7,opt,name=escrow_address,json=escrowAddress,proto3" json:"escrow_address,omitempty"`
+	// hop is the index of the pair in pair_ids where the current order is placed
+	Hop uint32 `protobuf:"varint,8,opt,name=hop,proto3" json:"hop,omitempty"`
+	// order_id is the id of the current order in the pair
+	OrderId uint64 `protobuf:"varint,9,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// receiver is the bech32-encoded address which receives the coins from the
+	// swap when it is finished
+	Receiver string `protobuf:"bytes,10,opt,name=receiver,proto3" json:"receiver,omitempty"`
+}
+
+func (m *SwapRoute) Reset()         { *m = SwapRoute{} }
+func (m *SwapRoute) String() string { return proto.CompactTextString(m) }
+func (*SwapRoute) ProtoMessage()    {}
+func (*SwapRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9be4f53a63dce2f, []int{14}
+}
+func (m *SwapRoute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SwapRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SwapRoute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SwapRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwapRoute.Merge(m, src)
+}
+func (m *SwapRoute) XXX_Size() int {
+	return m.Size()
+}
+func (m *SwapRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwapRoute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SwapRoute proto.InternalMessageInfo
+
+// OrderCommit defines the state of a commitment to a limit order made by
+// MsgCommitOrder.
+// It is deleted when the order is revealed by MsgRevealOrder, or when the
+// order is not revealed until the last reveal batch and the bond is
+// forfeited.
+type OrderCommit struct {
+	PairId uint64 `protobuf:"varint,1,opt,name=pair_id,json=pairId,proto3" json:"pair_id,omitempty"`
+	// orderer is the bech32-encoded address that committed to the order
+	Orderer string `protobuf:"bytes,2,opt,name=orderer,proto3" json:"orderer,omitempty"`
+	// hash is the hash of the committed order, see MsgCommitOrder
+	Hash []byte `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
+	// batch_id is the pair's batch id when the commit was made
+	BatchId uint64 `protobuf:"varint,4,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+	// last_reveal_batch_id is the pair's last batch id in which the order can
+	// be revealed
+	LastRevealBatchId uint64 `protobuf:"varint,5,opt,name=last_reveal_batch_id,json=lastRevealBatchId,proto3" json:"last_reveal_batch_id,omitempty"`
+	// bond is the coins escrowed for the commit
+	Bond github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=bond,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"bond"`
+}
+
+func (m *OrderCommit) Reset()         { *m = OrderCommit{} }
+func (m *OrderCommit) String() string { return proto.CompactTextString(m) }
+func (*OrderCommit) ProtoMessage()    {}
+func (*OrderCommit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9be4f53a63dce2f, []int{15}
+}
+func (m *OrderCommit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrderCommit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrderCommit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OrderCommit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderCommit.Merge(m, src)
+}
+func (m *OrderCommit) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrderCommit) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderCommit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderCommit proto.InternalMessageInfo
+
+// PairStatsBucket defines the trading statistics of a pair accumulated from
+// the batches matched within an hour.
+type PairStatsBucket struct {
+	PairId uint64 `protobuf:"varint,1,opt,name=pair_id,json=pairId,proto3" json:"pair_id,omitempty"`
+	// start_time is the start of the hour the bucket covers
+	StartTime time.Time `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// base_volume is the amount of the base coin traded
+	BaseVolume github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=base_volume,json=baseVolume,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"base_volume"`
+	// quote_volume is the amount of the quote coin traded
+	QuoteVolume github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=quote_volume,json=quoteVolume,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"quote_volume"`
+	// num_matched_orders is the number of user orders matched, counted once per
+	// batch in which an order is matched
+	NumMatchedOrders uint64                                   `protobuf:"varint,5,opt,name=num_matched_orders,json=numMatchedOrders,proto3" json:"num_matched_orders,omitempty"`
+	HighPrice        github_com_cosmos_cosmos_sdk_types.Dec   `protobuf:"bytes,6,opt,name=high_price,json=highPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"high_price"`
+	LowPrice         github_com_cosmos_cosmos_sdk_types.Dec   `protobuf:"bytes,7,opt,name=low_price,json=lowPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"low_price"`
+	SwapFees         github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=swap_fees,json=swapFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"swap_fees"`
+}
+
+func (m *PairStatsBucket) Reset()         { *m = PairStatsBucket{} }
+func (m *PairStatsBucket) String() string { return proto.CompactTextString(m) }
+func (*PairStatsBucket) ProtoMessage()    {}
+func (*PairStatsBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9be4f53a63dce2f, []int{16}
+}
+func (m *PairStatsBucket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PairStatsBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PairStatsBucket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PairStatsBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PairStatsBucket.Merge(m, src)
+}
+func (m *PairStatsBucket) XXX_Size() int {
+	return m.Size()
+}
+func (m *PairStatsBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_PairStatsBucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PairStatsBucket proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("crescent.liquidity.v1beta1.PoolType", PoolType_name, PoolType_value)
 	proto.RegisterEnum("crescent.liquidity.v1beta1.OrderType", OrderType_name, OrderType_value)
 	proto.RegisterEnum("crescent.liquidity.v1beta1.OrderDirection", OrderDirection_name, OrderDirection_value)
 	proto.RegisterEnum("crescent.liquidity.v1beta1.RequestStatus", RequestStatus_name, RequestStatus_value)
 	proto.RegisterEnum("crescent.liquidity.v1beta1.OrderStatus", OrderStatus_name, OrderStatus_value)
+	proto.RegisterEnum("crescent.liquidity.v1beta1.RequestType", RequestType_name, RequestType_value)
+	proto.RegisterEnum("crescent.liquidity.v1beta1.RequestResultCode", RequestResultCode_name, RequestResultCode_value)
 	proto.RegisterType((*Params)(nil), "crescent.liquidity.v1beta1.Params")
 	proto.RegisterType((*Pair)(nil), "crescent.liquidity.v1beta1.Pair")
+	proto.RegisterType((*PairOrderAmountLimits)(nil), "crescent.liquidity.v1beta1.PairOrderAmountLimits")
+	proto.RegisterType((*PairMetadata)(nil), "crescent.liquidity.v1beta1.PairMetadata")
 	proto.RegisterType((*Pool)(nil), "crescent.liquidity.v1beta1.Pool")
 	proto.RegisterType((*DepositRequest)(nil), "crescent.liquidity.v1beta1.DepositRequest")
 	proto.RegisterType((*WithdrawRequest)(nil), "crescent.liquidity.v1beta1.WithdrawRequest")
 	proto.RegisterType((*Order)(nil), "crescent.liquidity.v1beta1.Order")
 	proto.RegisterType((*MMOrderIndex)(nil), "crescent.liquidity.v1beta1.MMOrderIndex")
+	proto.RegisterType((*AccruedSwapFees)(nil), "crescent.liquidity.v1beta1.AccruedSwapFees")
+	proto.RegisterType((*PriceHistoryEntry)(nil), "crescent.liquidity.v1beta1.PriceHistoryEntry")
+	proto.RegisterType((*Vault)(nil), "crescent.liquidity.v1beta1.Vault")
+	proto.RegisterType((*RequestResult)(nil), "crescent.liquidity.v1beta1.RequestResult")
+	proto.RegisterType((*BatchResult)(nil), "crescent.liquidity.v1beta1.BatchResult")
+	proto.RegisterType((*SwapRoute)(nil), "crescent.liquidity.v1beta1.SwapRoute")
+	proto.RegisterType((*OrderCommit)(nil), "crescent.liquidity.v1beta1.OrderCommit")
+	proto.RegisterType((*PairStatsBucket)(nil), "crescent.liquidity.v1beta1.PairStatsBucket")
 }
 
 func init() {
@@ -585,135 +1302,262 @@ func init() {
 }
 
 var fileDescriptor_c9be4f53a63dce2f = []byte{
-	// 2036 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcd, 0x6f, 0x1b, 0xc7,
-	0x15, 0x17, 0x3f, 0x44, 0x91, 0x8f, 0xe6, 0x87, 0xc6, 0xb2, 0xbd, 0xa6, 0x6d, 0x8a, 0x15, 0x6a,
-	0x47, 0x15, 0x10, 0x32, 0x51, 0x53, 0x24, 0x06, 0xd2, 0x04, 0x14, 0xb9, 0x92, 0x89, 0x92, 0x12,
-	0xbd, 0xa4, 0x9a, 0x38, 0x28, 0xba, 0x18, 0xed, 0x8e, 0xa8, 0x81, 0xb8, 0x1f, 0xde, 0x5d, 0xea,
-	0x23, 0xa7, 0x1e, 0x5b, 0x9e, 0x72, 0x2a, 0x7a, 0xe1, 0xa5, 0xbd, 0x14, 0xfd, 0x0b, 0x7a, 0xed,
-	0xa1, 0x80, 0x8f, 0x39, 0x16, 0x3d, 0x24, 0xad, 0x7d, 0xeb, 0xa9, 0xe8, 0x5f, 0x50, 0xcc, 0xcc,
-	0xee, 0x72, 0x49, 0x3b, 0x8e, 0x45, 0xc4, 0x27, 0x71, 0xdf, 0xbc, 0xdf, 0xef, 0xcd, 0xbc, 0xaf,
-	0x79, 0x23, 0xd8, 0xd2, 0x1c, 0xe2, 0x6a, 0xc4, 0xf4, 0x6a, 0x43, 0xfa, 0x74, 0x44, 0x75, 0xea,
-	0x5d, 0xd6, 0xce, 0xde, 0x3f, 0x22, 0x1e, 0x7e, 0x7f, 0x2a, 0xa9, 0xda, 0x8e, 0xe5, 0x59, 0xa8,
-	0x14, 0xe8, 0x56, 0xa7, 0x2b, 0xbe, 0x6e, 0x69, 0x6d, 0x60, 0x0d, 0x2c, 0xae, 0x56, 0x63, 0xbf,
-	0x04, 0xa2, 0x54, 0xd6, 0x2c, 0xd7, 0xb0, 0xdc, 0xda, 0x11, 0x76, 0x49, 0x48, 0xab, 0x59, 0xd4,
-	0xf4, 0xd7, 0xd7, 0x07, 0x96, 0x35, 0x18, 0x92, 0x1a, 0xff, 0x3a, 0x1a, 0x1d, 0xd7, 0x3c, 0x6a,
-	0x10, 0xd7, 0xc3, 0x86, 0x1d, 0x10, 0xcc, 0x2b, 0xe8, 0x23, 0x07, 0x7b, 0xd4, 0xf2, 0x09, 0x36,
-	0x7e, 0x97, 0x85, 0x54, 0x17, 0x3b, 0xd8, 0x70, 0xd1, 0x3d, 0x80, 0x23, 0xec, 0x69, 0x27, 0xaa,
-	0x4b, 0xbf, 0x24, 0x52, 0xac, 0x12, 0xdb, 0xcc, 0x29, 0x19, 0x2e, 0xe9, 0xd1, 0x2f, 0x09, 0xba,
-	0x0f, 0x79, 0x8f, 0x6a, 0xa7, 0xaa, 0xed, 0x10, 0x8d, 0xba, 0xd4, 0x32, 0xa5, 0x38, 0x57, 0xc9,
-	0x31, 0x69, 0x37, 0x10, 0xa2, 0x6d, 0xb8, 0x71, 0x4c, 0x88, 0xaa, 0x59, 0xc3, 0x21, 0xd1, 0x3c,
-	0xcb, 0x51, 0xb1, 0xae, 0x3b, 0xc4, 0x75, 0xa5, 0x44, 0x25, 0xb6, 0x99, 0x51, 0xae, 0x1f, 0x13,
-	0xd2, 0x08, 0xd6, 0xea, 0x62, 0x09, 0x7d, 0x00, 0x37, 0xf5, 0x91, 0xeb, 0xbd, 0x02, 0x94, 0xe4,
-	0xa0, 0x35, 0xb6, 0xfa, 0x12, 0xca, 0x84, 0xbb, 0x06, 0x35, 0x55, 0x6a, 0x52, 0x8f, 0xe2, 0xa1,
-	0x6a, 0x5b, 0xd6, 0x50, 0x65, 0xae, 0x51, 0xdd, 0x91, 0x6d, 0x0f, 0x2f, 0xa5, 0x65, 0x86, 0xdd,
-	0xa9, 0x3e, 0xfb, 0x66, 0x7d, 0xe9, 0x9f, 0xdf, 0xac, 0x3f, 0x18, 0x50, 0xef, 0x64, 0x74, 0x54,
-	0xd5, 0x2c, 0xa3, 0xe6, 0x3b, 0x55, 0xfc, 0x79, 0xd7, 0xd5, 0x4f, 0x6b, 0xde, 0xa5, 0x4d, 0xdc,
-	0x6a, 0xcb, 0xf4, 0x14, 0xc9, 0xa0, 0x66, 0x4b, 0x50, 0x76, 0x2d, 0x6b, 0xd8, 0xb0, 0xa8, 0xd9,
-	0xe3, 0x7c, 0xe8, 0x1c, 0x56, 0x6d, 0x4c, 0x1d, 0x55, 0x73, 0x08, 0xf7, 0xa0, 0x7a, 0x4c, 0x88,
-	0x94, 0xaa, 0x24, 0x36, 0xb3, 0xdb, 0xb7, 0xab, 0x82, 0xab, 0xca, 0xe2, 0x14, 0x84, 0xb4, 0xca,
-	0xb0, 0x3b, 0xef, 0x31, 0xfb, 0x7f, 0xf9, 0x76, 0x7d, 0xf3, 0x0d, 0xec, 0x33, 0x80, 0xab, 0x14,
-	0x98, 0x95, 0x86, 0x6f, 0x64, 0x97, 0x10, 0x6e, 0x98, 0x1f, 0x2e, 0x6a, 0x78, 0xe5, 0x6d, 0x18,
-	0x66, 0x07, 0x8e, 0x18, 0x3e, 0x85, 0x52, 0xd4, 0xc3, 0x3a, 0xb1, 0x2d, 0x97, 0x7a, 0x2a, 0x36,
-	0xac, 0x91, 0xe9, 0x49, 0xe9, 0x85, 0xfc, 0x7b, 0x6b, 0xea, 0xdf, 0xa6, 0xe0, 0xab, 0x73, 0x3a,
-	0x84, 0xe1, 0x86, 0x81, 0x2f, 0x54, 0xdb, 0xa1, 0x1a, 0x51, 0x87, 0xd4, 0xa0, 0x9e, 0xca, 0x33,
-	0x55, 0xca, 0x5c, 0xd9, 0x4e, 0x93, 0x68, 0x0a, 0x32, 0xf0, 0x45, 0x97, 0x71, 0xb5, 0x19, 0x95,
-	0xc2, 0x98, 0xd0, 0x1e, 0xfc, 0x88, 0x99, 0x30, 0x47, 0x86, 0x6a, 0x60, 0xe7, 0x94, 0x78, 0xaa,
-	0x81, 0x4f, 0xa9, 0x39, 0x50, 0x2d, 0x47, 0x27, 0x8e, 0xca, 0x12, 0xd9, 0x95, 0x80, 0x67, 0xf5,
-	0x5d, 0x03, 0x5f, 0xec, 0x8f, 0x8c, 0x0e, 0x57, 0xeb, 0x70, 0xad, 0x03, 0xa6, 0xd4, 0x67, 0x3a,
-	0xe8, 0x31, 0x30, 0x7a, 0x1f, 0x36, 0xa4, 0xc7, 0xc4, 0xb5, 0xb1, 0x29, 0x65, 0x2b, 0x31, 0x1e,
-	0x12, 0x51, 0x72, 0xd5, 0xa0, 0xe4, 0xaa, 0x4d, 0xbf, 0xe4, 0x76, 0xd2, 0xec, 0x0c, 0x7f, 0xf8,
-	0x76, 0x3d, 0xa6, 0x14, 0x0d, 0x7c, 0xc1, 0xf9, 0xda, 0x3e, 0x18, 0x29, 0x90, 0x73, 0xcf, 0xb1,
-	0xcd, 0x62, 0xcb, 0xce, 0x4d, 0xa4, 0x6b, 0x0b, 0x1d, 0x3b, 0xcb, 0x48, 0x76, 0x09, 0x51, 0xb0,
-	0x47, 0xd0, 0x17, 0xb0, 0x7a, 0x4e, 0xbd, 0x13, 0xdd, 0xc1, 0xe7, 0x53, 0xde, 0xdc, 0x42, 0xbc,
-	0x85, 0x80, 0x28, 0xc2, 0x1d, 0xe4, 0x03, 0xb9, 0xf0, 0x1c, 0xac, 0x0e, 0xb0, 0x2b, 0xe5, 0x2b,
-	0xb1, 0xcd, 0xe4, 0x95, 0xb8, 0xf7, 0xb0, 0xab, 0x14, 0x7c, 0x22, 0x99, 0xf1, 0xec, 0x61, 0x17,
-	0xfd, 0x0a, 0x50, 0xb8, 0xef, 0x29, 0x79, 0x61, 0x21, 0xf2, 0x62, 0xc0, 0x14, 0xb2, 0xff, 0x12,
-	0x0a, 0x22, 0x70, 0x53, 0xea, 0xe2, 0x42, 0xd4, 0x39, 0x4e, 0x13, 0xf2, 0x7e, 0x0a, 0xf7, 0x82,
-	0xec, 0xc2, 0x9a, 0x47, 0xcf, 0x08, 0x6f, 0x49, 0xae, 0x6a, 0x13, 0x47, 0x65, 0x25, 0x2d, 0xad,
-	0xf2, 0xcc, 0x92, 0x44, 0x66, 0xd5, 0xb9, 0x0a, 0x6b, 0x31, 0x6e, 0x97, 0x38, 0x5d, 0x4c, 0x9d,
-	0x8d, 0x3f, 0xc7, 0x21, 0xc9, 0x7e, 0xa0, 0x3c, 0xc4, 0xa9, 0xce, 0x3b, 0x70, 0x52, 0x89, 0x53,
-	0x1d, 0x3d, 0x80, 0x02, 0xab, 0x6f, 0xd1, 0xdd, 0x74, 0x62, 0x5a, 0x06, 0xef, 0xbd, 0x19, 0x25,
-	0xc7, 0xc4, 0xac, 0x78, 0x9b, 0x4c, 0x88, 0x36, 0xa1, 0xf8, 0x74, 0x64, 0x79, 0x33, 0x8a, 0xa2,
-	0xed, 0xe6, 0xb9, 0x7c, 0xaa, 0x79, 0x1f, 0xf2, 0xc4, 0xd5, 0x1c, 0xeb, 0x7c, 0xae, 0xd3, 0xe6,
-	0x84, 0x34, 0x68, 0xb1, 0x1b, 0x90, 0x1b, 0x62, 0xd7, 0xf3, 0x13, 0x9d, 0xea, 0xbc, 0xa7, 0x26,
-	0x95, 0x2c, 0x13, 0xf2, 0xf4, 0x6d, 0xe9, 0xa8, 0x05, 0xc0, 0x75, 0x78, 0xe1, 0x4a, 0x29, 0x9e,
-	0x5d, 0x5b, 0x57, 0xc8, 0xac, 0x0c, 0x43, 0xf3, 0x4a, 0x65, 0xfb, 0xd7, 0x46, 0x8e, 0x43, 0x4c,
-	0x4f, 0x15, 0x37, 0x11, 0xd5, 0xa5, 0x15, 0x6e, 0x31, 0xef, 0xcb, 0x77, 0x98, 0xb8, 0xa5, 0x6f,
-	0xfc, 0x2f, 0x01, 0x49, 0xe6, 0x3b, 0xf4, 0x11, 0x24, 0x19, 0x15, 0x77, 0x56, 0x7e, 0xfb, 0xc7,
-	0xd5, 0xef, 0xbe, 0x61, 0xab, 0x4c, 0xbf, 0x7f, 0x69, 0x13, 0x85, 0x23, 0x7c, 0x27, 0xc7, 0x43,
-	0x27, 0xdf, 0x82, 0x15, 0xde, 0xde, 0xa9, 0xce, 0x7d, 0x96, 0x54, 0x52, 0xec, 0xb3, 0xa5, 0x23,
-	0x09, 0x56, 0x78, 0xe7, 0xb5, 0x1c, 0xdf, 0x49, 0xc1, 0x27, 0x7a, 0x07, 0x0a, 0x0e, 0x71, 0x89,
-	0x73, 0x46, 0x42, 0x37, 0x2e, 0x0b, 0x77, 0xfb, 0xe2, 0xc0, 0x8f, 0x0f, 0xa0, 0x30, 0xbd, 0x9e,
-	0x44, 0x5c, 0x52, 0xc2, 0xdf, 0xb6, 0x7f, 0xc7, 0x88, 0xb0, 0xec, 0x41, 0x86, 0x35, 0x5c, 0xe1,
-	0xca, 0x95, 0x2b, 0xbb, 0x32, 0x6d, 0x50, 0x53, 0x78, 0x92, 0x11, 0x05, 0xcd, 0xd4, 0x6f, 0xd4,
-	0x57, 0x23, 0xf2, 0x9b, 0x27, 0xfa, 0x19, 0xdc, 0xe2, 0xd1, 0x0d, 0x6a, 0xdd, 0x21, 0x4f, 0x47,
-	0xc4, 0xf5, 0x98, 0x97, 0x32, 0xdc, 0x4b, 0x6b, 0x6c, 0xd9, 0xef, 0xe4, 0x8a, 0x58, 0x6c, 0xe9,
-	0xe8, 0x43, 0x90, 0x38, 0x2c, 0x2c, 0xe3, 0x08, 0x0e, 0x38, 0xee, 0x06, 0x5b, 0xff, 0xcc, 0x5f,
-	0x9e, 0x02, 0x4b, 0x90, 0xd6, 0xa9, 0x8b, 0x8f, 0x86, 0x44, 0xe7, 0xfd, 0x34, 0xad, 0x84, 0xdf,
-	0x1b, 0xff, 0x49, 0x40, 0x7e, 0xd6, 0xd2, 0x4b, 0x95, 0xc2, 0x82, 0xc8, 0x1c, 0x1d, 0x46, 0x36,
-	0xc5, 0x3e, 0x5b, 0x3a, 0x1b, 0x6e, 0x0c, 0x77, 0xa0, 0x9e, 0x10, 0x3a, 0x38, 0xf1, 0x78, 0x80,
-	0x13, 0x4a, 0xc6, 0x70, 0x07, 0x8f, 0xb8, 0x00, 0xdd, 0x85, 0x8c, 0x7f, 0xc2, 0x30, 0xca, 0x53,
-	0x01, 0xb2, 0x21, 0x17, 0x9c, 0x9f, 0x45, 0x90, 0x45, 0xf9, 0x07, 0xbf, 0x7c, 0xaf, 0xf9, 0x16,
-	0xf8, 0x17, 0x72, 0x20, 0x8f, 0x35, 0x8d, 0xd8, 0x1e, 0xd1, 0x7d, 0x93, 0x6f, 0x61, 0xd0, 0xc8,
-	0x05, 0x26, 0x84, 0xcd, 0x16, 0x14, 0x0d, 0x6a, 0x32, 0x8b, 0x61, 0xae, 0xf2, 0x1c, 0x7c, 0xad,
-	0xd5, 0x24, 0xb3, 0xaa, 0xe4, 0x05, 0x30, 0x18, 0x98, 0x50, 0x1d, 0x52, 0xae, 0x87, 0xbd, 0x91,
-	0xcb, 0x73, 0x2f, 0xbf, 0xfd, 0x93, 0xd7, 0xd5, 0xa5, 0x1f, 0xcb, 0x1e, 0x07, 0x28, 0x3e, 0x70,
-	0xe3, 0xbf, 0x71, 0x28, 0xcc, 0xa5, 0xc7, 0x0f, 0x16, 0xed, 0x32, 0x40, 0x90, 0x98, 0x24, 0x08,
-	0x77, 0x44, 0x82, 0x3e, 0x86, 0xcc, 0xd4, 0x05, 0xcb, 0x6f, 0xe6, 0x82, 0x74, 0x50, 0xc9, 0xc8,
-	0x83, 0xf0, 0xb2, 0x34, 0xdf, 0x5e, 0xf0, 0xf2, 0xa1, 0x0d, 0x11, 0xbd, 0xa9, 0xcb, 0x57, 0x16,
-	0x75, 0xf9, 0xdf, 0x53, 0xb0, 0xcc, 0xbb, 0x3a, 0x7a, 0x38, 0xd3, 0x55, 0xef, 0xbf, 0x8e, 0x4a,
-	0x4c, 0x45, 0x0b, 0xb4, 0xd5, 0xd9, 0x18, 0x25, 0xe7, 0x63, 0x24, 0xc1, 0x0a, 0xbf, 0x75, 0x88,
-	0xe3, 0xf7, 0xd4, 0xe0, 0x13, 0x3d, 0x82, 0x8c, 0x4e, 0x1d, 0xa2, 0xb1, 0x91, 0x8a, 0xb7, 0xd1,
-	0xfc, 0xf6, 0xd6, 0xf7, 0xee, 0xb0, 0x19, 0x20, 0x94, 0x29, 0x18, 0x7d, 0x02, 0x60, 0x1d, 0x1f,
-	0x13, 0xe7, 0x4a, 0xb9, 0x9e, 0xe1, 0x10, 0x1e, 0xe9, 0xc7, 0xb0, 0xe6, 0x10, 0x03, 0x53, 0x93,
-	0xcf, 0x90, 0x53, 0xa6, 0xf4, 0x9b, 0x31, 0xa1, 0x10, 0x7c, 0x10, 0x52, 0x36, 0x21, 0xe7, 0x10,
-	0x8d, 0xd0, 0x33, 0xbf, 0xf0, 0x79, 0x97, 0x7d, 0x03, 0xae, 0x6b, 0x01, 0xca, 0x67, 0x59, 0x16,
-	0xad, 0x1f, 0x16, 0x1a, 0xf6, 0x04, 0x18, 0xed, 0x42, 0xca, 0x1f, 0xf5, 0xb3, 0x0b, 0x8d, 0xfa,
-	0x3e, 0x1a, 0x1d, 0x40, 0xd6, 0xb2, 0x89, 0x19, 0xbc, 0x1b, 0xae, 0x2d, 0x44, 0x06, 0x8c, 0xc2,
-	0x7f, 0x2a, 0xdc, 0x86, 0x74, 0x38, 0x1f, 0xe4, 0x78, 0x52, 0xad, 0x1c, 0x89, 0xc1, 0x00, 0xd5,
-	0x21, 0x43, 0x2e, 0x6c, 0xea, 0x10, 0x15, 0x7b, 0x7c, 0x1c, 0xcd, 0x6e, 0x97, 0x5e, 0x1a, 0xc8,
-	0xfb, 0xc1, 0x23, 0x59, 0x4c, 0xe4, 0x5f, 0xb1, 0x89, 0x3c, 0x2d, 0x60, 0x75, 0x0f, 0x7d, 0x1a,
-	0x56, 0x52, 0x81, 0x27, 0xd7, 0x3b, 0xdf, 0x9b, 0x5c, 0x73, 0x75, 0xf4, 0x6b, 0xb8, 0xd6, 0xe9,
-	0x88, 0xf1, 0xc8, 0xd4, 0xc9, 0x45, 0x34, 0x95, 0x63, 0xb3, 0xa9, 0x1c, 0x29, 0x8e, 0xf8, 0x4c,
-	0x71, 0xdc, 0x81, 0x4c, 0x30, 0x73, 0xb1, 0x97, 0x73, 0x62, 0x33, 0xa9, 0xa4, 0x2d, 0x31, 0x70,
-	0xb9, 0x5b, 0xbf, 0x8f, 0x41, 0x3a, 0x18, 0x66, 0xd8, 0x7b, 0xbb, 0x7b, 0x70, 0xd0, 0x56, 0xfb,
-	0x4f, 0xba, 0xb2, 0x7a, 0xb8, 0xdf, 0xeb, 0xca, 0x8d, 0xd6, 0x6e, 0x4b, 0x6e, 0x16, 0x97, 0x4a,
-	0xb7, 0xc6, 0x93, 0xca, 0xf5, 0x40, 0xf1, 0xd0, 0x74, 0x6d, 0xa2, 0xd1, 0x63, 0x4a, 0xf8, 0x3c,
-	0x39, 0xc5, 0xec, 0xd4, 0x7b, 0xad, 0x46, 0x31, 0x56, 0x5a, 0x1d, 0x4f, 0x2a, 0xb9, 0x40, 0x7b,
-	0x07, 0xbb, 0x54, 0x63, 0xf3, 0xd8, 0x54, 0x4f, 0xa9, 0xef, 0xef, 0xc9, 0xcd, 0x62, 0xbc, 0x84,
-	0xc6, 0x93, 0x4a, 0x3e, 0x1c, 0xa6, 0xb0, 0x39, 0x20, 0x7a, 0x29, 0xf9, 0xdb, 0x3f, 0x95, 0x97,
-	0xb6, 0xfe, 0x16, 0x83, 0x4c, 0xd8, 0x0f, 0xd8, 0xab, 0xfe, 0x40, 0x69, 0xca, 0xca, 0xab, 0xb6,
-	0x26, 0x8d, 0x27, 0x95, 0xb5, 0x50, 0x35, 0xba, 0xb7, 0x4d, 0x28, 0x46, 0x50, 0xed, 0x56, 0xa7,
-	0xd5, 0x2f, 0xc6, 0x84, 0xcd, 0x50, 0x9f, 0x3f, 0xe9, 0xd0, 0x16, 0xac, 0x46, 0x34, 0x3b, 0x75,
-	0xe5, 0x17, 0x72, 0xbf, 0x18, 0x2f, 0x5d, 0x1f, 0x4f, 0x2a, 0x85, 0x50, 0x55, 0x3c, 0xe0, 0xd8,
-	0x20, 0x1b, 0xd5, 0xed, 0x14, 0x13, 0xa5, 0xc2, 0x78, 0x52, 0xc9, 0x4e, 0xf5, 0x3a, 0xfe, 0x19,
-	0xfe, 0x1a, 0x83, 0xfc, 0x6c, 0xc7, 0x40, 0x9f, 0xc0, 0x1d, 0x01, 0x6e, 0xb6, 0x14, 0xb9, 0xd1,
-	0x6f, 0x1d, 0xec, 0xcf, 0x9d, 0xe6, 0xde, 0x78, 0x52, 0xb9, 0x3d, 0x0b, 0x8a, 0x1e, 0xa9, 0x0a,
-	0xd7, 0xe7, 0xf1, 0x3b, 0x87, 0x4f, 0x8a, 0xb1, 0xd2, 0x8d, 0xf1, 0xa4, 0xb2, 0x3a, 0x8b, 0xdb,
-	0x19, 0x5d, 0xa2, 0xf7, 0x60, 0x6d, 0x5e, 0xbf, 0x27, 0xb7, 0xdb, 0xc5, 0x78, 0xe9, 0xe6, 0x78,
-	0x52, 0x41, 0xb3, 0x80, 0x1e, 0x19, 0x0e, 0xfd, 0xad, 0xff, 0x26, 0x0e, 0xb9, 0x99, 0xce, 0x8e,
-	0x3e, 0x86, 0x92, 0x22, 0x3f, 0x3e, 0x94, 0x7b, 0x7d, 0xb5, 0xd7, 0xaf, 0xf7, 0x0f, 0x7b, 0x73,
-	0x1b, 0xbf, 0x3b, 0x9e, 0x54, 0xa4, 0x19, 0x48, 0x74, 0xdf, 0x3f, 0x87, 0x3b, 0x73, 0xe8, 0xfd,
-	0x83, 0xbe, 0x2a, 0x7f, 0x2e, 0x37, 0x0e, 0xfb, 0x72, 0xb3, 0x18, 0x7b, 0x05, 0x7c, 0xdf, 0xf2,
-	0xe4, 0x0b, 0xa2, 0x8d, 0x3c, 0xa2, 0xa3, 0x8f, 0x40, 0x9a, 0x83, 0xf7, 0x0e, 0x1b, 0x0d, 0x59,
-	0x6e, 0xf2, 0x2c, 0x2a, 0x8d, 0x27, 0x95, 0x9b, 0x33, 0xd8, 0xde, 0x48, 0xd3, 0x08, 0xd1, 0x89,
-	0xce, 0x72, 0x7a, 0x0e, 0xb9, 0x5b, 0x6f, 0xb5, 0xe5, 0x66, 0x31, 0x21, 0x72, 0x7a, 0x06, 0xb6,
-	0x8b, 0xe9, 0x30, 0xcc, 0xc0, 0x3f, 0x26, 0x20, 0x1b, 0x29, 0x49, 0xb6, 0x07, 0xe1, 0xca, 0x57,
-	0x1e, 0x9f, 0xef, 0x21, 0xa2, 0x1e, 0x3d, 0xfc, 0x43, 0xb8, 0x3d, 0x83, 0x9c, 0x3b, 0xfa, 0x3c,
-	0x34, 0x7a, 0xf0, 0x0f, 0xe7, 0x8c, 0x32, 0x68, 0xa7, 0xde, 0x6f, 0x3c, 0xe2, 0x07, 0xbf, 0x3d,
-	0x9e, 0x54, 0x6e, 0xcc, 0x22, 0x3b, 0xac, 0x79, 0x11, 0x1d, 0x35, 0xa0, 0x3c, 0x03, 0xec, 0xd6,
-	0x95, 0x7e, 0xab, 0xde, 0x6e, 0x3f, 0x09, 0xe1, 0x89, 0xd2, 0xfa, 0x78, 0x52, 0xb9, 0x13, 0x81,
-	0x77, 0xb1, 0xe3, 0x51, 0x3c, 0x1c, 0x5e, 0x06, 0x24, 0x61, 0xd9, 0xf9, 0x24, 0x8d, 0x83, 0x4e,
-	0xb7, 0x2d, 0xb3, 0x5d, 0x27, 0x23, 0x65, 0x27, 0xc0, 0x0d, 0xcb, 0xb0, 0x87, 0xc4, 0x13, 0x2e,
-	0x9f, 0x45, 0xd5, 0xf7, 0x1b, 0x32, 0x73, 0xf9, 0xb2, 0x70, 0x79, 0x14, 0x84, 0x4d, 0x8d, 0x0c,
-	0x89, 0x3e, 0xcd, 0x53, 0x1f, 0x23, 0x7f, 0xde, 0x6d, 0x29, 0x72, 0xb3, 0x98, 0x8a, 0xe4, 0xa9,
-	0x80, 0xc8, 0xbc, 0xb7, 0xfa, 0x41, 0xda, 0xf9, 0xec, 0xd9, 0xbf, 0xcb, 0x4b, 0xcf, 0x9e, 0x97,
-	0x63, 0x5f, 0x3f, 0x2f, 0xc7, 0xfe, 0xf5, 0xbc, 0x1c, 0xfb, 0xea, 0x45, 0x79, 0xe9, 0xeb, 0x17,
-	0xe5, 0xa5, 0x7f, 0xbc, 0x28, 0x2f, 0x7d, 0xf1, 0x30, 0x7a, 0x21, 0xf8, 0x8d, 0xf7, 0x5d, 0x93,
-	0x78, 0xe7, 0x96, 0x73, 0x1a, 0x0a, 0x6a, 0x67, 0x1f, 0xd4, 0x2e, 0x22, 0xff, 0x71, 0xe5, 0xf7,
-	0xc4, 0x51, 0x8a, 0x77, 0xf8, 0x9f, 0xfe, 0x3f, 0x00, 0x00, 0xff, 0xff, 0x21, 0x50, 0xe1, 0x95,
-	0x94, 0x15, 0x00, 0x00,
+	// 4067 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5b, 0xcb, 0x73, 0x1b, 0x47,
+	0x7a, 0x17, 0x40, 0x88, 0x04, 0x3e, 0x10, 0x0f, 0x36, 0x1f, 0x1a, 0x41, 0x12, 0x09, 0x73, 0x57,
+	0x16, 0xad, 0xb2, 0x49, 0x5b, 0xbb, 0x89, 0xed, 0xda, 0x87, 0x17, 0x04, 0x40, 0x0b, 0x6b, 0x3e,
+	0xa0, 0x21, 0x25, 0xad, 0xb7, 0x92, 0x4c, 0x86, 0x33, 0x4d, 0xa0, 0x8b, 0xf3, 0x80, 0x67, 0x06,
+	0xa2, 0xb8, 0xa7, 0xcd, 0x2d, 0xc5, 0xa4, 0x2a, 0x3e, 0x6d, 0x25, 0x07, 0x1e, 0x92, 0xdc, 0xf6,
+	0x9a, 0x4b, 0x0e, 0xc9, 0x21, 0x55, 0xa9, 0x8a, 0x8f, 0x7b, 0xc8, 0x21, 0x95, 0xc3, 0x3e, 0xec,
+	0x7f, 0x20, 0x7f, 0x42, 0xaa, 0xbf, 0xee, 0x79, 0x81, 0xa0, 0x4c, 0x62, 0xa5, 0x93, 0x38, 0xdd,
+	0xdf, 0xef, 0xfb, 0xfa, 0xeb, 0xfe, 0x5e, 0xfd, 0x35, 0x04, 0x0f, 0x0d, 0x8f, 0xfa, 0x06, 0x75,
+	0x82, 0x0d, 0x8b, 0x7d, 0x31, 0x64, 0x26, 0x0b, 0x4e, 0x37, 0x5e, 0x7c, 0x70, 0x48, 0x03, 0xfd,
+	0x83, 0x78, 0x64, 0x7d, 0xe0, 0xb9, 0x81, 0x4b, 0x6a, 0x21, 0xed, 0x7a, 0x3c, 0x23, 0x69, 0x6b,
+	0x0b, 0x3d, 0xb7, 0xe7, 0x22, 0xd9, 0x06, 0xff, 0x4b, 0x20, 0x6a, 0xcb, 0x86, 0xeb, 0xdb, 0xae,
+	0xbf, 0x71, 0xa8, 0xfb, 0x34, 0x62, 0x6b, 0xb8, 0xcc, 0x91, 0xf3, 0x2b, 0x3d, 0xd7, 0xed, 0x59,
+	0x74, 0x03, 0xbf, 0x0e, 0x87, 0x47, 0x1b, 0x01, 0xb3, 0xa9, 0x1f, 0xe8, 0xf6, 0x20, 0x64, 0x30,
+	0x4a, 0x60, 0x0e, 0x3d, 0x3d, 0x60, 0xae, 0x64, 0xb0, 0xfa, 0xef, 0xb7, 0x60, 0xba, 0xab, 0x7b,
+	0xba, 0xed, 0x93, 0x7b, 0x00, 0x87, 0x7a, 0x60, 0xf4, 0x35, 0x9f, 0xfd, 0x82, 0x2a, 0x99, 0x7a,
+	0x66, 0xad, 0xa4, 0x16, 0x70, 0x64, 0x9f, 0xfd, 0x82, 0x92, 0xfb, 0x50, 0x0e, 0x98, 0x71, 0xac,
+	0x0d, 0x3c, 0x6a, 0x30, 0x9f, 0xb9, 0x8e, 0x92, 0x45, 0x92, 0x12, 0x1f, 0xed, 0x86, 0x83, 0xe4,
+	0x11, 0x2c, 0x1e, 0x51, 0xaa, 0x19, 0xae, 0x65, 0x51, 0x23, 0x70, 0x3d, 0x4d, 0x37, 0x4d, 0x8f,
+	0xfa, 0xbe, 0x32, 0x55, 0xcf, 0xac, 0x15, 0xd4, 0xf9, 0x23, 0x4a, 0x9b, 0xe1, 0x5c, 0x43, 0x4c,
+	0x91, 0xef, 0xc3, 0x92, 0x39, 0xf4, 0x83, 0x31, 0xa0, 0x1c, 0x82, 0x16, 0xf8, 0xec, 0x05, 0x94,
+	0x03, 0x77, 0x6d, 0xe6, 0x68, 0xcc, 0x61, 0x01, 0xd3, 0x2d, 0x6d, 0xe0, 0xba, 0x96, 0xc6, 0xb7,
+	0x46, 0xf3, 0x87, 0x83, 0x81, 0x75, 0xaa, 0xdc, 0xe4, 0xd8, 0xcd, 0xf5, 0xaf, 0x7e, 0xbb, 0x72,
+	0xe3, 0x7f, 0x7f, 0xbb, 0xf2, 0x76, 0x8f, 0x05, 0xfd, 0xe1, 0xe1, 0xba, 0xe1, 0xda, 0x1b, 0x72,
+	0x53, 0xc5, 0x3f, 0xef, 0xf9, 0xe6, 0xf1, 0x46, 0x70, 0x3a, 0xa0, 0xfe, 0x7a, 0xc7, 0x09, 0x54,
+	0xc5, 0x66, 0x4e, 0x47, 0xb0, 0xec, 0xba, 0xae, 0xd5, 0x74, 0x99, 0xb3, 0x8f, 0xfc, 0xc8, 0x09,
+	0xcc, 0x0d, 0x74, 0xe6, 0x69, 0x86, 0x47, 0x71, 0x07, 0xb5, 0x23, 0x4a, 0x95, 0xe9, 0xfa, 0xd4,
+	0x5a, 0xf1, 0xd1, 0xed, 0x75, 0xc1, 0x6b, 0x9d, 0x9f, 0x53, 0x78, 0xa4, 0xeb, 0x1c, 0xbb, 0xf9,
+	0x3e, 0x97, 0xff, 0xeb, 0xdf, 0xad, 0xac, 0x5d, 0x41, 0x3e, 0x07, 0xf8, 0x6a, 0x85, 0x4b, 0x69,
+	0x4a, 0x21, 0x5b, 0x94, 0xa2, 0x60, 0x54, 0x2e, 0x29, 0x78, 0xe6, 0x4d, 0x08, 0xe6, 0x0a, 0x27,
+	0x04, 0x1f, 0x43, 0x2d, 0xb9, 0xc3, 0x26, 0x1d, 0xb8, 0x3e, 0x0b, 0x34, 0xdd, 0x76, 0x87, 0x4e,
+	0xa0, 0xe4, 0x27, 0xda, 0xdf, 0x5b, 0xf1, 0xfe, 0xb6, 0x04, 0xbf, 0x06, 0xb2, 0x23, 0x9f, 0xc2,
+	0x5b, 0xb6, 0xfe, 0x52, 0x73, 0x86, 0xb6, 0x66, 0xeb, 0xde, 0x31, 0x0d, 0x34, 0x5b, 0x3f, 0x66,
+	0x4e, 0x4f, 0x73, 0x3d, 0x93, 0x7a, 0x1a, 0xb7, 0x32, 0x5f, 0x01, 0x34, 0xb9, 0xbb, 0xb6, 0xfe,
+	0x72, 0x77, 0x68, 0xef, 0x20, 0xd9, 0x0e, 0x52, 0xed, 0x71, 0xa2, 0x03, 0x4e, 0x43, 0x9e, 0x00,
+	0xe1, 0x8c, 0x04, 0xcc, 0x62, 0x47, 0xd4, 0x1f, 0xe8, 0x8e, 0x52, 0xac, 0x67, 0x70, 0xbf, 0x84,
+	0x3f, 0xac, 0x87, 0xfe, 0xb0, 0xde, 0x92, 0xfe, 0xb0, 0x99, 0xe7, 0x8a, 0xfc, 0xfd, 0xef, 0x56,
+	0x32, 0x6a, 0xd5, 0xd6, 0x5f, 0x22, 0xbf, 0x6d, 0x09, 0x26, 0x2a, 0x94, 0xfc, 0x13, 0x7d, 0xc0,
+	0x37, 0x5e, 0xf3, 0xf4, 0x80, 0x2a, 0xb3, 0xd7, 0xd6, 0xbd, 0x45, 0x0d, 0xb5, 0xc8, 0x99, 0x6c,
+	0x51, 0xaa, 0xea, 0x01, 0x25, 0x3f, 0x87, 0xb9, 0x13, 0x16, 0xf4, 0x4d, 0x4f, 0x3f, 0x89, 0xf9,
+	0x96, 0x26, 0xe2, 0x5b, 0x09, 0x19, 0x25, 0x78, 0x87, 0x87, 0x45, 0x5f, 0x06, 0x9e, 0xae, 0xf5,
+	0x74, 0x5f, 0x29, 0xd7, 0x33, 0x6b, 0xb9, 0x6b, 0xf1, 0xfe, 0x54, 0xf7, 0xd5, 0x8a, 0x64, 0xd4,
+	0xe6, 0x7c, 0x3e, 0xd5, 0x7d, 0xf2, 0x67, 0x40, 0xa2, 0x75, 0xc7, 0xcc, 0x2b, 0x13, 0x31, 0xaf,
+	0x86, 0x9c, 0x22, 0xee, 0xcf, 0xa0, 0x22, 0x0e, 0x2e, 0x66, 0x5d, 0x9d, 0x88, 0x75, 0x09, 0xd9,
+	0x44, 0x7c, 0x3f, 0x81, 0x7b, 0xa1, 0x75, 0xe9, 0x46, 0xc0, 0x5e, 0x50, 0x8c, 0x17, 0xbe, 0x36,
+	0xa0, 0x9e, 0xc6, 0xfd, 0x4d, 0x99, 0x43, 0xcb, 0x52, 0x84, 0x65, 0x35, 0x90, 0x84, 0xfb, 0xbf,
+	0xdf, 0xa5, 0x5e, 0x57, 0x67, 0x1e, 0x79, 0x07, 0xe6, 0x22, 0x13, 0x08, 0x5c, 0x81, 0x56, 0x48,
+	0x3d, 0xb3, 0x96, 0x57, 0xcb, 0xf2, 0x58, 0x0f, 0x5c, 0x44, 0x90, 0x06, 0x2c, 0x87, 0xb2, 0x06,
+	0xde, 0xd0, 0xa1, 0xa6, 0x46, 0x9d, 0xc0, 0x63, 0x54, 0x48, 0xb3, 0xfd, 0x9e, 0x32, 0x8f, 0xc2,
+	0x6e, 0x0b, 0x61, 0x5d, 0xa4, 0x69, 0x0b, 0x92, 0x2e, 0xf5, 0x76, 0xfc, 0x1e, 0xf9, 0x65, 0x06,
+	0x96, 0x10, 0xab, 0x79, 0xf4, 0x44, 0xf7, 0x4c, 0x44, 0x72, 0x2e, 0xa7, 0xca, 0xc2, 0xeb, 0x77,
+	0xfc, 0x79, 0x14, 0xa5, 0xa2, 0xa4, 0x2e, 0xf5, 0xf8, 0x52, 0x4e, 0xc9, 0xfb, 0xb0, 0x30, 0xf0,
+	0x98, 0x41, 0xb5, 0x3e, 0xf3, 0x03, 0xd7, 0x3b, 0xd5, 0x2c, 0xea, 0xf4, 0x82, 0xbe, 0xb2, 0x88,
+	0x6b, 0x27, 0x38, 0xf7, 0x58, 0x4c, 0x6d, 0xe3, 0x0c, 0x0f, 0xfd, 0x5c, 0xe7, 0x43, 0xd7, 0x0d,
+	0xfc, 0xc0, 0xd3, 0x07, 0x1a, 0x26, 0x0f, 0xea, 0x2b, 0x4b, 0x08, 0x99, 0x77, 0x86, 0xf6, 0x66,
+	0x38, 0xb7, 0x29, 0xa6, 0xc8, 0x06, 0x2c, 0x60, 0x6c, 0xe3, 0xdb, 0xea, 0x9f, 0x50, 0x3a, 0xd0,
+	0xe8, 0xc0, 0x35, 0xfa, 0xca, 0x2d, 0x84, 0x60, 0xdc, 0xdb, 0xa2, 0x74, 0x9f, 0xcf, 0xb4, 0xf9,
+	0x04, 0xf9, 0x53, 0xb8, 0x65, 0x30, 0xcf, 0x18, 0xb2, 0x40, 0x3b, 0xf4, 0xa8, 0x7e, 0x8c, 0xfb,
+	0xa2, 0x1f, 0x5a, 0xd4, 0x54, 0x14, 0x3c, 0x8d, 0x45, 0x39, 0xbd, 0x29, 0x66, 0xdb, 0x62, 0x92,
+	0x7c, 0x00, 0x8b, 0x71, 0x54, 0x10, 0x8a, 0x89, 0x90, 0x72, 0x5b, 0xe8, 0x13, 0xfa, 0x7c, 0x97,
+	0x4f, 0x89, 0x40, 0xf2, 0xe7, 0xa0, 0x78, 0xf4, 0x8b, 0x21, 0xf5, 0x03, 0xcd, 0xa3, 0xfe, 0xd0,
+	0xe2, 0xff, 0x04, 0xd4, 0xe1, 0xd1, 0x42, 0xa9, 0x5d, 0x3d, 0x9c, 0x2c, 0x49, 0x26, 0x2a, 0xf2,
+	0x50, 0x43, 0x16, 0x3c, 0xa1, 0xda, 0xb8, 0xfe, 0x81, 0xc7, 0x5c, 0x8f, 0x05, 0xa7, 0xca, 0x1d,
+	0x54, 0xa0, 0x84, 0xa3, 0x5d, 0x39, 0x48, 0x3e, 0x83, 0xef, 0x44, 0x96, 0x3b, 0xe4, 0x96, 0x27,
+	0x4c, 0x2a, 0xbd, 0x32, 0x5f, 0xb9, 0x8b, 0x6a, 0x2c, 0x4b, 0xfb, 0x1d, 0x06, 0xae, 0x30, 0x2b,
+	0x35, 0x29, 0x9b, 0x9b, 0xe6, 0xbd, 0x0b, 0x39, 0x4c, 0x33, 0xa9, 0x1f, 0x30, 0x07, 0xbf, 0x95,
+	0x7b, 0x98, 0x70, 0x6b, 0x23, 0x29, 0xa8, 0x15, 0x53, 0x90, 0x9f, 0xc0, 0xdd, 0x01, 0xf5, 0x6c,
+	0xe6, 0xf3, 0x74, 0x6f, 0x51, 0xdf, 0xd7, 0x52, 0x1c, 0x95, 0x65, 0x54, 0xa2, 0x96, 0xa6, 0xe9,
+	0x26, 0xf8, 0xf1, 0x74, 0x1f, 0x43, 0x78, 0xb2, 0xb7, 0x2c, 0xf7, 0xc4, 0x62, 0x7e, 0xa0, 0xac,
+	0xd4, 0xa7, 0x78, 0xba, 0x8f, 0xa4, 0xbb, 0x5e, 0x23, 0x9c, 0x23, 0xbb, 0x70, 0xff, 0xf0, 0x74,
+	0xa0, 0x73, 0x79, 0xdc, 0x60, 0xc4, 0x11, 0x1a, 0x7d, 0x6a, 0x1c, 0x6b, 0x47, 0xae, 0xa7, 0x39,
+	0xf4, 0x04, 0x17, 0xe2, 0x2b, 0x75, 0x5c, 0xc0, 0x8a, 0x20, 0xe6, 0x1e, 0x89, 0x47, 0xda, 0xe4,
+	0x94, 0x5b, 0xae, 0xb7, 0x4b, 0x4f, 0xf8, 0x62, 0x7c, 0xb2, 0x06, 0x55, 0x2c, 0x3a, 0x92, 0x56,
+	0xf7, 0x16, 0x6e, 0x62, 0x99, 0x8f, 0x27, 0x4c, 0xee, 0x23, 0x50, 0x98, 0xe3, 0x07, 0xba, 0x13,
+	0x44, 0x29, 0x30, 0x8c, 0x5b, 0xca, 0x2a, 0x0a, 0x5b, 0x92, 0xf3, 0x32, 0xa3, 0x3d, 0x97, 0xb3,
+	0xc9, 0x48, 0x20, 0xa3, 0x0e, 0xda, 0x5f, 0x22, 0xec, 0x7c, 0x27, 0x19, 0x09, 0x44, 0xd8, 0x41,
+	0x33, 0x8c, 0xe2, 0x4e, 0x0f, 0x94, 0xe1, 0x40, 0x84, 0x00, 0xd4, 0xd8, 0x62, 0x36, 0x0b, 0x34,
+	0x34, 0x32, 0xe5, 0xbb, 0x13, 0x65, 0x8b, 0x45, 0xc1, 0x0f, 0x77, 0x65, 0x9b, 0x73, 0x53, 0x39,
+	0x33, 0x9e, 0xec, 0x4d, 0xf7, 0xc4, 0xb9, 0x44, 0xd4, 0xfd, 0x89, 0x44, 0xdd, 0x0a, 0x39, 0x8e,
+	0x0a, 0xfb, 0x11, 0xdc, 0x11, 0x32, 0x0e, 0x75, 0xc7, 0xd4, 0x4e, 0x98, 0x49, 0x1d, 0x9e, 0xea,
+	0xc3, 0x80, 0xf1, 0xb6, 0x08, 0xc6, 0x48, 0xb2, 0xa9, 0x3b, 0xe6, 0x73, 0x49, 0x10, 0x46, 0x8d,
+	0x9f, 0x88, 0x68, 0x3e, 0x8e, 0x85, 0x1f, 0xd0, 0x81, 0xaf, 0x3c, 0x88, 0xb6, 0xb5, 0x3b, 0xca,
+	0x63, 0x9f, 0x13, 0xf0, 0x9a, 0x4a, 0x84, 0x02, 0xc3, 0xb5, 0xb9, 0x96, 0x87, 0xae, 0x63, 0x2a,
+	0x6b, 0x6f, 0xa0, 0xa6, 0x42, 0x29, 0x4d, 0x14, 0xb2, 0xe9, 0x3a, 0x26, 0x0f, 0xab, 0x42, 0xb0,
+	0x47, 0x5f, 0x50, 0xdd, 0x8a, 0x54, 0x7e, 0x47, 0x84, 0x21, 0x9c, 0x53, 0x71, 0x4a, 0x2a, 0xfb,
+	0xd3, 0x5c, 0xbe, 0x50, 0x05, 0x75, 0x31, 0x56, 0x38, 0x71, 0x2e, 0xab, 0x5f, 0x16, 0x20, 0x87,
+	0x76, 0x52, 0x86, 0x2c, 0x33, 0xb1, 0x6a, 0xcf, 0xa9, 0x59, 0x66, 0x92, 0xb7, 0xa1, 0xc2, 0xd7,
+	0x2f, 0x2a, 0x62, 0x93, 0x3a, 0xae, 0x8d, 0xf5, 0x7a, 0x41, 0x2d, 0xf1, 0x61, 0xbe, 0xb8, 0x16,
+	0x1f, 0xe4, 0x6e, 0xf0, 0xc5, 0xd0, 0x0d, 0x52, 0x84, 0xa2, 0x54, 0x2f, 0xe3, 0x78, 0x4c, 0x79,
+	0x1f, 0xca, 0xd4, 0x37, 0x3c, 0xf7, 0x64, 0xa4, 0x3a, 0x2f, 0x89, 0xd1, 0xb0, 0x2c, 0x5f, 0x85,
+	0x92, 0xa5, 0xfb, 0x81, 0x8c, 0xb4, 0xcc, 0xc4, 0x3a, 0x3c, 0xa7, 0x16, 0xf9, 0x20, 0x9a, 0x76,
+	0xc7, 0x24, 0x1d, 0x00, 0xa4, 0x41, 0x7d, 0x94, 0x69, 0xb4, 0xad, 0x87, 0xd7, 0xb0, 0xab, 0x02,
+	0x47, 0xe3, 0xc9, 0xf2, 0xf5, 0x1b, 0x43, 0xcf, 0xa3, 0x4e, 0x20, 0xb6, 0x92, 0x4b, 0x9c, 0x41,
+	0x89, 0x65, 0x39, 0x8e, 0xfb, 0xd8, 0x31, 0xc9, 0xf7, 0x60, 0x29, 0x4e, 0x4d, 0xd4, 0x31, 0x63,
+	0xfa, 0x3c, 0xd2, 0xcf, 0x47, 0xb3, 0x6d, 0xc7, 0x0c, 0x41, 0xf7, 0xa1, 0x2c, 0x36, 0x9d, 0xbe,
+	0x1c, 0xb8, 0x0e, 0x75, 0x02, 0xa5, 0x50, 0xcf, 0xac, 0xdd, 0x54, 0x4b, 0x38, 0xda, 0x96, 0x83,
+	0x44, 0x81, 0x19, 0x19, 0xcd, 0xb0, 0x44, 0x2d, 0xa8, 0xe1, 0x27, 0x69, 0x41, 0xde, 0xa6, 0x81,
+	0x6e, 0xea, 0x81, 0x2e, 0x6b, 0xd0, 0xb5, 0xf5, 0xcb, 0xaf, 0x81, 0xeb, 0xfc, 0x2c, 0x77, 0x24,
+	0xbd, 0x1a, 0x21, 0xc9, 0x12, 0x4c, 0xf7, 0x75, 0x2b, 0xa0, 0x26, 0x56, 0x9e, 0x79, 0x55, 0x7e,
+	0x91, 0xb7, 0x60, 0x56, 0x68, 0x71, 0xc2, 0x1c, 0xd3, 0x3d, 0xc1, 0xfa, 0xb1, 0xa4, 0x16, 0x71,
+	0xec, 0x39, 0x0e, 0x91, 0x87, 0x30, 0x87, 0x7b, 0x2d, 0xe8, 0xfa, 0x94, 0xf5, 0xfa, 0x01, 0xd6,
+	0x82, 0x53, 0x6a, 0x85, 0x4f, 0xa0, 0xa6, 0x8f, 0x71, 0x98, 0xe8, 0x30, 0x2f, 0x8e, 0x4d, 0x94,
+	0xf8, 0xc2, 0xd2, 0x44, 0x71, 0x57, 0x7c, 0xf4, 0xc1, 0xb7, 0xad, 0x1b, 0x4f, 0x57, 0x54, 0xf3,
+	0xe8, 0xea, 0xbe, 0x3a, 0xe7, 0x8e, 0x0e, 0x11, 0x0d, 0xc6, 0x5b, 0x32, 0x96, 0x79, 0x85, 0xcd,
+	0x77, 0xaf, 0x6e, 0x05, 0x4a, 0x06, 0xb3, 0xf6, 0x68, 0x68, 0xd9, 0x19, 0x5b, 0xfe, 0xcf, 0x7d,
+	0x5b, 0xbe, 0xce, 0x5d, 0x52, 0xfa, 0x3f, 0x80, 0x8a, 0x34, 0x77, 0xed, 0x05, 0xf5, 0xf0, 0xde,
+	0x4b, 0x44, 0x96, 0x90, 0xc3, 0xcf, 0xc4, 0x28, 0x31, 0x5e, 0x11, 0xa8, 0xe7, 0xaf, 0x6d, 0xe1,
+	0x97, 0x04, 0xe9, 0xde, 0x2b, 0x83, 0xf4, 0xc2, 0xb5, 0xc5, 0x5c, 0x1a, 0xa0, 0x3f, 0x86, 0xdb,
+	0x58, 0xcb, 0x89, 0x18, 0x84, 0xf9, 0xce, 0x1d, 0x06, 0x5a, 0xe0, 0xe9, 0x26, 0x95, 0x25, 0xe0,
+	0x12, 0xaf, 0xe7, 0xc4, 0xfc, 0x73, 0x31, 0x7d, 0xc0, 0x67, 0x57, 0x7f, 0x9d, 0x85, 0xc5, 0xb1,
+	0xe6, 0x40, 0x74, 0x58, 0xe4, 0xf7, 0x49, 0x8c, 0x4b, 0x49, 0x3b, 0x53, 0x32, 0xd7, 0xce, 0x2e,
+	0xfc, 0x2a, 0x49, 0x6c, 0xe6, 0x6c, 0xea, 0x3e, 0x4d, 0x08, 0x22, 0x06, 0x2c, 0x71, 0x11, 0x22,
+	0xa4, 0xa5, 0x64, 0x64, 0x27, 0x92, 0x31, 0x6f, 0x33, 0xe7, 0x09, 0x67, 0x96, 0x14, 0xd2, 0x81,
+	0xbc, 0xe5, 0x06, 0xa2, 0x4f, 0x32, 0x35, 0x11, 0xdb, 0x19, 0xcb, 0x0d, 0x78, 0x57, 0x65, 0xf5,
+	0x5f, 0x32, 0x30, 0x9b, 0xf4, 0x79, 0xee, 0xd1, 0x26, 0xf3, 0x07, 0x96, 0x7e, 0xaa, 0x39, 0xba,
+	0x2d, 0xfa, 0x30, 0x05, 0xb5, 0x28, 0xc7, 0x76, 0x75, 0x9b, 0x62, 0x84, 0x75, 0x7b, 0xae, 0x36,
+	0xf4, 0x98, 0xd6, 0xd7, 0xfd, 0xbe, 0x0c, 0xec, 0x45, 0x3e, 0xf8, 0xd4, 0x63, 0x8f, 0x75, 0xbf,
+	0x4f, 0xde, 0x05, 0x92, 0x0c, 0xff, 0x06, 0xb3, 0x75, 0x4b, 0xf4, 0x60, 0x4a, 0x6a, 0x35, 0xce,
+	0x00, 0x62, 0x9c, 0xac, 0xc3, 0x7c, 0x2a, 0x09, 0x48, 0xf2, 0x9c, 0x28, 0xc2, 0x13, 0x79, 0x40,
+	0x4c, 0xac, 0xfe, 0xea, 0x26, 0xe4, 0x78, 0x65, 0x45, 0x3e, 0x82, 0x1c, 0x57, 0x0a, 0x57, 0x59,
+	0x7e, 0xf4, 0xdd, 0x57, 0x46, 0x08, 0xd7, 0xb5, 0x0e, 0x4e, 0x07, 0x54, 0x45, 0x84, 0xcc, 0x57,
+	0xd9, 0x28, 0x5f, 0xdd, 0x82, 0x19, 0x2c, 0x0a, 0x99, 0x89, 0xab, 0xcc, 0xa9, 0xd3, 0xfc, 0xb3,
+	0x63, 0x26, 0x43, 0x6b, 0x2e, 0x1d, 0x5a, 0x1f, 0x40, 0xc5, 0xa3, 0x3e, 0xf5, 0x5e, 0xd0, 0x28,
+	0x23, 0xdd, 0x14, 0x99, 0x4b, 0x0e, 0x87, 0x29, 0xe9, 0x6d, 0xa8, 0xc4, 0xdd, 0x21, 0x91, 0xe2,
+	0xa6, 0x45, 0xea, 0x1a, 0xc8, 0x16, 0x8f, 0xc8, 0x70, 0x9f, 0x42, 0x81, 0x1b, 0x8f, 0xc8, 0x4a,
+	0x33, 0xd7, 0x76, 0xa6, 0xbc, 0xcd, 0x1c, 0x91, 0x94, 0x38, 0xa3, 0x30, 0xc8, 0x29, 0xf9, 0x09,
+	0x18, 0xc9, 0xb0, 0x46, 0xfe, 0x04, 0x6e, 0x61, 0xf0, 0x0e, 0xeb, 0xce, 0xb0, 0xea, 0x67, 0x26,
+	0xe6, 0xa1, 0x9c, 0xba, 0xc0, 0xa7, 0x65, 0xd9, 0x29, 0x6b, 0xfd, 0x8e, 0x49, 0x3e, 0x04, 0x05,
+	0x61, 0xd1, 0x45, 0x3d, 0x81, 0x03, 0xc4, 0x2d, 0xf2, 0xf9, 0xb0, 0x4e, 0x8d, 0x81, 0x35, 0xc8,
+	0x9b, 0xcc, 0x17, 0xd7, 0xa9, 0x22, 0x66, 0x9a, 0xe8, 0x7b, 0x5c, 0x24, 0x9c, 0x1d, 0x1b, 0x09,
+	0x4f, 0x45, 0x04, 0x8e, 0xce, 0x46, 0xf8, 0x5f, 0xe9, 0xf5, 0x17, 0x57, 0x3c, 0x5a, 0xab, 0xf2,
+	0xa8, 0x51, 0xc8, 0xea, 0x3f, 0xe6, 0xa0, 0x9c, 0xde, 0x8d, 0x0b, 0x85, 0x11, 0x37, 0x34, 0x6e,
+	0x0c, 0x91, 0xf5, 0x4d, 0xf3, 0xcf, 0x8e, 0xc9, 0xfb, 0x9f, 0xb6, 0xdf, 0x0b, 0x33, 0xe4, 0x14,
+	0x66, 0xc8, 0x82, 0xed, 0xf7, 0x64, 0x6e, 0xbc, 0x0b, 0x05, 0x79, 0x0a, 0x91, 0x25, 0xc6, 0x03,
+	0x64, 0x00, 0x25, 0xf9, 0x81, 0x56, 0xc6, 0x2d, 0xf1, 0xb5, 0xab, 0x3b, 0x2b, 0x25, 0xe0, 0x17,
+	0xf1, 0xa0, 0xac, 0x1b, 0x06, 0x1d, 0x04, 0xd4, 0x94, 0x22, 0xdf, 0x40, 0x2f, 0xb2, 0x14, 0x8a,
+	0x10, 0x32, 0x3b, 0x50, 0xb5, 0x99, 0xc3, 0x25, 0x46, 0xfe, 0x84, 0x7e, 0xf2, 0x4a, 0xa9, 0x39,
+	0x2e, 0x55, 0x2d, 0x0b, 0x60, 0xd8, 0x53, 0x25, 0x0d, 0x98, 0xf6, 0x03, 0x3d, 0x18, 0xfa, 0xe8,
+	0x1f, 0xe5, 0x47, 0xef, 0xbc, 0x2a, 0x76, 0xc8, 0xb3, 0xdc, 0x47, 0x80, 0x2a, 0x81, 0x3c, 0x54,
+	0xfa, 0xcc, 0xe9, 0x59, 0x54, 0xd3, 0x7d, 0x9f, 0x8a, 0xca, 0x2c, 0xaf, 0x16, 0xc5, 0x58, 0x83,
+	0x0f, 0x11, 0x02, 0xb9, 0x23, 0xdd, 0xb3, 0xd1, 0xe8, 0xf3, 0x2a, 0xfe, 0xbd, 0xfa, 0x7f, 0x59,
+	0xa8, 0x8c, 0x58, 0xfe, 0x6b, 0x33, 0x92, 0x65, 0x80, 0xd0, 0xe7, 0x68, 0x68, 0x25, 0x89, 0x11,
+	0xf2, 0x43, 0x28, 0xc4, 0x3b, 0x77, 0xf3, 0x6a, 0x3b, 0x97, 0x0f, 0x83, 0x14, 0x09, 0x20, 0xea,
+	0xf4, 0x39, 0x6f, 0xee, 0xcc, 0xcb, 0x91, 0x0c, 0x71, 0xe8, 0xf1, 0x49, 0xcd, 0x4c, 0x78, 0x52,
+	0xab, 0xff, 0x30, 0x03, 0x37, 0x31, 0x81, 0x92, 0x8f, 0x53, 0x09, 0xe3, 0xfe, 0xab, 0x58, 0x21,
+	0x60, 0x92, 0x8c, 0x91, 0x3e, 0xa3, 0xdc, 0xe8, 0x19, 0x29, 0x30, 0x83, 0x85, 0x01, 0xf5, 0x64,
+	0xba, 0x08, 0x3f, 0xc9, 0x63, 0x28, 0x98, 0xcc, 0xa3, 0x06, 0xf6, 0x31, 0xa6, 0x71, 0x85, 0x0f,
+	0xbf, 0x75, 0x85, 0xad, 0x10, 0xa1, 0xc6, 0x60, 0xf2, 0x63, 0x00, 0xf7, 0xe8, 0x88, 0x7a, 0xd7,
+	0x72, 0x91, 0x02, 0x42, 0xf0, 0xa4, 0x9f, 0xc0, 0x82, 0x47, 0x6d, 0x9d, 0xe1, 0x95, 0x36, 0xc1,
+	0x29, 0x7f, 0x35, 0x4e, 0x24, 0x02, 0xef, 0x45, 0x2c, 0x5b, 0x50, 0xf2, 0xa8, 0x41, 0xd9, 0x0b,
+	0x19, 0x2f, 0x94, 0xc2, 0xd5, 0x78, 0xcd, 0x86, 0x28, 0xc9, 0xe5, 0xa6, 0xc8, 0x6a, 0x30, 0x51,
+	0x43, 0x40, 0x80, 0xc9, 0x16, 0x4c, 0xcb, 0xac, 0x50, 0x9c, 0xa8, 0x7c, 0x92, 0x68, 0xb2, 0x07,
+	0x45, 0x77, 0x40, 0x9d, 0x30, 0xc5, 0xcc, 0x4e, 0xc4, 0x0c, 0x38, 0x0b, 0x59, 0xd9, 0xdd, 0x86,
+	0x7c, 0x74, 0x2b, 0x2c, 0xa1, 0x51, 0xcd, 0x1c, 0xca, 0x9b, 0x60, 0x03, 0x0a, 0xf4, 0xe5, 0x80,
+	0x79, 0x54, 0xd3, 0xc5, 0xfd, 0xa9, 0xf8, 0xa8, 0x76, 0xe1, 0x3a, 0x71, 0x10, 0x3e, 0xbf, 0x89,
+	0xfe, 0xdf, 0x97, 0xfc, 0x4e, 0x91, 0x17, 0xb0, 0x46, 0x40, 0x3e, 0x89, 0x3c, 0xa9, 0x82, 0xc6,
+	0xf5, 0xe0, 0x5b, 0x8d, 0x6b, 0x24, 0xe2, 0xa9, 0x50, 0xe1, 0x05, 0xca, 0x11, 0xb3, 0xac, 0x50,
+	0xe7, 0xea, 0xb5, 0xaa, 0x0b, 0xae, 0x6f, 0xc9, 0x66, 0xce, 0x16, 0xb3, 0x2c, 0x99, 0x32, 0xff,
+	0x36, 0x03, 0xb3, 0x3b, 0x3b, 0xe2, 0x66, 0xee, 0x98, 0xf4, 0x65, 0xd2, 0x3f, 0x32, 0x69, 0xff,
+	0x48, 0x78, 0x5c, 0x36, 0xe5, 0x71, 0x77, 0xa0, 0x10, 0x5e, 0xf7, 0x79, 0x91, 0x39, 0xb5, 0x96,
+	0x53, 0xf3, 0x38, 0xd0, 0x31, 0x7d, 0x5e, 0x8a, 0x62, 0xe3, 0xd2, 0xd0, 0x1d, 0x83, 0x5a, 0x69,
+	0xb7, 0xac, 0xf2, 0x99, 0x26, 0x4e, 0x08, 0xef, 0x5c, 0xfd, 0x9b, 0x0c, 0x54, 0x1a, 0x86, 0xe1,
+	0x0d, 0xa9, 0xb9, 0x2f, 0xda, 0xea, 0x7e, 0x52, 0x6e, 0x26, 0x25, 0x57, 0x83, 0xdc, 0x11, 0xa5,
+	0xbe, 0x92, 0x7d, 0xfd, 0x51, 0x10, 0x19, 0xaf, 0xfe, 0x67, 0x06, 0xe6, 0xba, 0x89, 0x4e, 0xb7,
+	0x68, 0x8d, 0x5f, 0xba, 0x1e, 0x7e, 0x4d, 0x17, 0xea, 0x65, 0x51, 0x3d, 0xf9, 0x85, 0x65, 0x32,
+	0xb3, 0xc5, 0x65, 0xe1, 0xaa, 0x66, 0x83, 0x88, 0xd8, 0xdf, 0x72, 0x7f, 0x84, 0xbf, 0xad, 0xfe,
+	0x5b, 0x0e, 0x6e, 0x3e, 0xd3, 0x87, 0xd6, 0xf8, 0x44, 0x37, 0xf6, 0x48, 0x6b, 0x90, 0x77, 0x07,
+	0xd4, 0xc3, 0xba, 0x5b, 0xf4, 0x83, 0xa2, 0xef, 0x71, 0x85, 0x77, 0x6e, 0x6c, 0xe1, 0xbd, 0x02,
+	0x45, 0xbf, 0xaf, 0x7b, 0x54, 0x16, 0xdd, 0x22, 0xdc, 0x02, 0x0e, 0x89, 0x8a, 0xfb, 0x2f, 0x60,
+	0x3e, 0xee, 0x06, 0x98, 0xf4, 0x05, 0xd3, 0xa3, 0xd8, 0x7b, 0x7d, 0x65, 0xe7, 0xc2, 0xb2, 0xb9,
+	0x15, 0x32, 0xe2, 0x0f, 0x61, 0xe1, 0xaa, 0xe3, 0x47, 0xb6, 0x99, 0xc9, 0x1e, 0xd9, 0x42, 0x46,
+	0xe1, 0x23, 0x5b, 0xea, 0xb6, 0x90, 0x7f, 0x5d, 0xb7, 0x85, 0xc2, 0x1f, 0x71, 0x5b, 0x78, 0x06,
+	0x95, 0x3e, 0xeb, 0xf5, 0xb5, 0x13, 0x3d, 0xe0, 0x0f, 0x4d, 0xba, 0x77, 0x3c, 0x61, 0x98, 0x2e,
+	0x71, 0x36, 0xcf, 0x39, 0x17, 0xfe, 0xc6, 0xba, 0xfa, 0x75, 0x16, 0x4a, 0xa9, 0x87, 0x04, 0xf2,
+	0x83, 0x54, 0x1a, 0x7f, 0x70, 0x85, 0x8a, 0x20, 0x91, 0xc8, 0xef, 0x40, 0x21, 0xd0, 0xbd, 0x1e,
+	0x0d, 0x62, 0xab, 0xcb, 0x8b, 0x81, 0x8e, 0x29, 0x0d, 0x74, 0x2a, 0x32, 0xd0, 0xbb, 0x50, 0x90,
+	0x97, 0x97, 0xa8, 0xa0, 0x8a, 0x07, 0x48, 0x03, 0x72, 0x86, 0x6b, 0x52, 0xb4, 0xac, 0xf2, 0xa3,
+	0xf7, 0xae, 0xb0, 0x0e, 0xa1, 0x40, 0xd3, 0x35, 0xa9, 0x8a, 0x50, 0xee, 0xb3, 0x1e, 0xd5, 0xfd,
+	0xd0, 0xea, 0x54, 0xf9, 0xc5, 0x8d, 0xfc, 0x88, 0x39, 0xcc, 0xef, 0x53, 0x33, 0x8c, 0x59, 0x33,
+	0xe8, 0xd4, 0xe5, 0x70, 0x58, 0xd6, 0x13, 0x6d, 0x28, 0x46, 0x84, 0x7a, 0xa0, 0xe4, 0xaf, 0xe1,
+	0xe3, 0x10, 0x02, 0x1b, 0xc1, 0xea, 0x7f, 0xe7, 0xa0, 0x88, 0xed, 0x14, 0xb9, 0xc5, 0x97, 0x06,
+	0x99, 0x64, 0x8e, 0xca, 0xa6, 0x73, 0x94, 0x02, 0x33, 0x36, 0xff, 0x93, 0x8a, 0x1d, 0xcc, 0xab,
+	0xe1, 0x27, 0xf9, 0x0c, 0x8a, 0xf8, 0xa7, 0x96, 0x8c, 0x26, 0xd7, 0xb1, 0x32, 0x40, 0xb8, 0xb0,
+	0x33, 0xf4, 0x5a, 0xe4, 0x2b, 0x7a, 0x39, 0x32, 0x15, 0x4d, 0xf6, 0x83, 0x8b, 0x39, 0xc9, 0x8a,
+	0x77, 0x72, 0x64, 0x16, 0xfe, 0x4b, 0x58, 0x08, 0xf9, 0x8b, 0xb6, 0x84, 0x14, 0x30, 0x3d, 0x61,
+	0x9b, 0x48, 0xf0, 0xc2, 0x36, 0x8e, 0x94, 0xf0, 0x21, 0x28, 0xbc, 0xbd, 0x75, 0x34, 0xb4, 0xac,
+	0x53, 0x2d, 0x94, 0x25, 0xde, 0x66, 0x64, 0xf7, 0x98, 0x3f, 0x65, 0x6e, 0xf1, 0xe9, 0x1d, 0x31,
+	0x2b, 0x5e, 0x65, 0xc8, 0x27, 0x70, 0x97, 0x03, 0x07, 0xba, 0xc7, 0x7f, 0xc1, 0x70, 0x11, 0x2c,
+	0x5a, 0xc9, 0xbc, 0x77, 0xd6, 0x0d, 0x49, 0xd2, 0x0c, 0x1e, 0x40, 0x85, 0xbe, 0xa4, 0xc6, 0x30,
+	0x88, 0xcd, 0xaa, 0x20, 0xcc, 0x2a, 0x1c, 0x8e, 0xcd, 0x2a, 0x22, 0xd4, 0x03, 0x05, 0xae, 0x63,
+	0x56, 0x21, 0xb0, 0x11, 0xac, 0xfe, 0xdd, 0x14, 0x14, 0x78, 0x22, 0x55, 0xdd, 0x61, 0x40, 0x2f,
+	0x84, 0xff, 0x44, 0xae, 0xcf, 0xa6, 0x73, 0xfd, 0x6d, 0xc8, 0x4b, 0xf3, 0x0b, 0x33, 0xfa, 0x8c,
+	0xb0, 0x3f, 0x7f, 0xa4, 0xb8, 0xcd, 0x5d, 0xbb, 0xb8, 0x6d, 0xc0, 0x2c, 0x0f, 0x9c, 0xbc, 0x9f,
+	0x78, 0x9d, 0x7b, 0x10, 0xd8, 0xcc, 0xd9, 0x1b, 0xe2, 0xed, 0x97, 0xfc, 0x14, 0xca, 0x23, 0x0d,
+	0xde, 0xe9, 0xab, 0x3f, 0xc8, 0x96, 0xdc, 0x54, 0x87, 0xf7, 0xe2, 0xbb, 0xc6, 0xcc, 0xb8, 0x77,
+	0x8d, 0x2a, 0x4c, 0xf5, 0xdd, 0x01, 0x1e, 0x70, 0x49, 0xe5, 0x7f, 0xf2, 0x2d, 0x8a, 0x1e, 0x39,
+	0x44, 0x37, 0x66, 0x46, 0x16, 0x3d, 0x3c, 0x7b, 0xca, 0xb2, 0x39, 0x7c, 0x10, 0x88, 0xbe, 0x57,
+	0xff, 0x2a, 0x0b, 0xc5, 0xbd, 0xf8, 0x55, 0xe8, 0x72, 0x47, 0xbf, 0xfc, 0x70, 0x08, 0xe4, 0xb0,
+	0xf1, 0xc7, 0x9d, 0x7c, 0x56, 0xc5, 0xbf, 0x53, 0x61, 0x21, 0x97, 0x0e, 0x0b, 0x1b, 0x80, 0x6d,
+	0xa2, 0xd4, 0x93, 0x53, 0xfc, 0x32, 0x83, 0xcf, 0x03, 0x89, 0x27, 0x27, 0x51, 0x57, 0xe1, 0x83,
+	0xd8, 0x1b, 0xb8, 0x5d, 0x22, 0xe3, 0xd5, 0xff, 0xca, 0x41, 0x85, 0xb7, 0x3d, 0x79, 0x7d, 0xeb,
+	0x6f, 0x0e, 0x8d, 0x63, 0xfa, 0x8a, 0x7d, 0x68, 0x02, 0xf8, 0x81, 0xee, 0x05, 0x1a, 0xd6, 0x50,
+	0xd9, 0x6b, 0x38, 0x42, 0x01, 0x71, 0x7c, 0x86, 0x5f, 0x15, 0x30, 0x56, 0xbd, 0x70, 0xad, 0xa1,
+	0x3d, 0x69, 0xdb, 0x16, 0x38, 0x8b, 0x67, 0xc8, 0x81, 0x3c, 0x81, 0x59, 0x11, 0x9c, 0x24, 0xc7,
+	0xdc, 0x44, 0x1c, 0x8b, 0xc8, 0x43, 0xb2, 0x7c, 0x17, 0x88, 0xf8, 0xf9, 0x53, 0x2a, 0xa4, 0x88,
+	0x53, 0xaa, 0x3a, 0xfc, 0x07, 0x4f, 0xc9, 0x48, 0xb2, 0x03, 0x80, 0xd9, 0x3e, 0xf9, 0x88, 0x76,
+	0xdd, 0x44, 0x5f, 0xe0, 0x1c, 0x44, 0x50, 0xff, 0x0c, 0x0a, 0x96, 0x7b, 0x92, 0x6a, 0x7e, 0x5e,
+	0x97, 0x5b, 0xde, 0x72, 0x4f, 0x04, 0xb3, 0x3e, 0x14, 0xc2, 0x5f, 0xcb, 0xf0, 0x98, 0xf8, 0xda,
+	0xad, 0x28, 0x2f, 0x7f, 0x72, 0xe3, 0x3f, 0xfc, 0x55, 0x06, 0xf2, 0x61, 0x6b, 0x99, 0xff, 0x02,
+	0xa5, 0xbb, 0xb7, 0xb7, 0xad, 0x1d, 0x7c, 0xde, 0x6d, 0x6b, 0x4f, 0x77, 0xf7, 0xbb, 0xed, 0x66,
+	0x67, 0xab, 0xd3, 0x6e, 0x55, 0x6f, 0xd4, 0x6e, 0x9d, 0x9d, 0xd7, 0xe7, 0x43, 0xc2, 0xa7, 0x8e,
+	0x3f, 0xa0, 0x06, 0x3b, 0x62, 0x14, 0x1f, 0x4a, 0x63, 0xcc, 0x66, 0x63, 0xbf, 0xd3, 0xac, 0x66,
+	0x6a, 0x73, 0x67, 0xe7, 0xf5, 0x52, 0x48, 0xbd, 0xa9, 0xfb, 0xcc, 0xe0, 0x0f, 0x8d, 0x31, 0x9d,
+	0xda, 0xd8, 0xfd, 0xb4, 0xdd, 0xaa, 0x66, 0x6b, 0xe4, 0xec, 0xbc, 0x5e, 0x0e, 0x09, 0x55, 0xdd,
+	0xe9, 0x51, 0xb3, 0x96, 0xfb, 0xeb, 0x7f, 0x5e, 0xbe, 0xf1, 0xf0, 0x3f, 0x32, 0x50, 0x88, 0x5a,
+	0x18, 0xfc, 0x37, 0x0f, 0x7b, 0x6a, 0xab, 0xad, 0x8e, 0x5b, 0x9a, 0x72, 0x76, 0x5e, 0x5f, 0x88,
+	0x48, 0x93, 0x6b, 0x5b, 0x83, 0x6a, 0x02, 0xb5, 0xdd, 0xd9, 0xe9, 0x1c, 0x54, 0x33, 0x42, 0x66,
+	0x44, 0x8f, 0x6f, 0x2b, 0xfc, 0x95, 0x2f, 0x41, 0xb9, 0xd3, 0x50, 0x3f, 0x6b, 0x1f, 0x54, 0xb3,
+	0xb5, 0xf9, 0xb3, 0xf3, 0x7a, 0x25, 0x22, 0x15, 0x3f, 0x98, 0xe3, 0xef, 0x07, 0x49, 0xda, 0x9d,
+	0xea, 0x54, 0xad, 0x72, 0x76, 0x5e, 0x2f, 0xc6, 0x74, 0x3b, 0x52, 0x87, 0x7f, 0xcd, 0x40, 0x39,
+	0xdd, 0xe4, 0x20, 0x3f, 0x86, 0x3b, 0x02, 0xdc, 0xea, 0xa8, 0xed, 0xe6, 0x41, 0x67, 0x6f, 0x77,
+	0x44, 0x9b, 0x7b, 0x67, 0xe7, 0xf5, 0xdb, 0x69, 0x50, 0x52, 0xa5, 0x75, 0x98, 0x1f, 0xc5, 0x6f,
+	0x3e, 0xfd, 0xbc, 0x9a, 0xa9, 0x2d, 0x9e, 0x9d, 0xd7, 0xe7, 0xd2, 0xb8, 0xcd, 0x21, 0xfe, 0x0c,
+	0x69, 0x94, 0x7e, 0xbf, 0xbd, 0xbd, 0x5d, 0xcd, 0xd6, 0x96, 0xce, 0xce, 0xeb, 0x24, 0x0d, 0xd8,
+	0xa7, 0x96, 0x25, 0x97, 0xfe, 0xcb, 0xb8, 0x66, 0x15, 0x97, 0x68, 0xf2, 0x43, 0xa8, 0xa9, 0xed,
+	0x27, 0x4f, 0xdb, 0xfb, 0x07, 0xda, 0xfe, 0x41, 0xe3, 0xe0, 0xe9, 0xfe, 0xc8, 0xc2, 0xef, 0x9e,
+	0x9d, 0xd7, 0x95, 0x14, 0x24, 0xb9, 0xee, 0x1f, 0xc1, 0x9d, 0x11, 0xf4, 0xee, 0xde, 0x81, 0xd6,
+	0xfe, 0x59, 0xbb, 0xf9, 0xf4, 0xa0, 0xdd, 0xaa, 0x66, 0xc6, 0xc0, 0x77, 0xdd, 0xa0, 0x2d, 0x13,
+	0x31, 0xff, 0x0d, 0xc9, 0x08, 0x7c, 0xff, 0x69, 0xb3, 0xd9, 0x6e, 0xb7, 0xd0, 0x8a, 0x6a, 0x67,
+	0xe7, 0xf5, 0xa5, 0x14, 0x76, 0x7f, 0x68, 0x18, 0x94, 0x9a, 0xd4, 0xe4, 0x36, 0x3d, 0x82, 0xdc,
+	0x6a, 0x74, 0xb6, 0xdb, 0xad, 0xea, 0x94, 0xb0, 0xe9, 0x14, 0x6c, 0x4b, 0x67, 0x56, 0x64, 0x81,
+	0xff, 0x34, 0x25, 0x13, 0x8d, 0xdc, 0x80, 0x8f, 0x40, 0x11, 0x5b, 0x39, 0x56, 0x7d, 0x5c, 0x43,
+	0x82, 0x3c, 0xa9, 0xfc, 0xc7, 0x70, 0x3b, 0x85, 0x1c, 0x51, 0x7d, 0x14, 0x9a, 0x54, 0xfc, 0x43,
+	0x50, 0x2e, 0x40, 0x77, 0x1a, 0x07, 0xcd, 0xc7, 0xa8, 0xf8, 0xed, 0xb3, 0xf3, 0xfa, 0x62, 0x1a,
+	0x29, 0x83, 0x1c, 0x69, 0xc2, 0x72, 0x0a, 0xd8, 0x6d, 0xa8, 0x07, 0x9d, 0xc6, 0xf6, 0xf6, 0xe7,
+	0x11, 0x7c, 0xaa, 0xb6, 0x72, 0x76, 0x5e, 0xbf, 0x93, 0x80, 0x8f, 0xd6, 0x5c, 0xb1, 0xdb, 0x49,
+	0x26, 0xcd, 0xbd, 0x9d, 0xee, 0x76, 0x9b, 0xaf, 0x3a, 0x97, 0x70, 0x3b, 0x01, 0x6e, 0xba, 0xf6,
+	0xc0, 0xa2, 0x81, 0xd8, 0xf2, 0x34, 0xaa, 0xb1, 0xdb, 0x6c, 0xf3, 0x2d, 0xbf, 0x29, 0xb6, 0x3c,
+	0x09, 0xc2, 0xde, 0x05, 0x35, 0x63, 0x3b, 0x95, 0x98, 0xf6, 0xcf, 0xba, 0x1d, 0xb5, 0xdd, 0xaa,
+	0x4e, 0x27, 0xec, 0x54, 0x40, 0xda, 0xd8, 0x0e, 0x0a, 0x0f, 0xe9, 0x0f, 0x19, 0x28, 0x26, 0xae,
+	0x48, 0x49, 0x43, 0x19, 0x13, 0x2a, 0x92, 0x86, 0x32, 0x1a, 0x2c, 0xde, 0x87, 0x85, 0x14, 0xb2,
+	0xd5, 0xee, 0xee, 0xed, 0x63, 0xc0, 0xc0, 0x15, 0x24, 0x50, 0xf2, 0x85, 0x24, 0x69, 0x5a, 0x88,
+	0x78, 0xde, 0x39, 0x78, 0xdc, 0x52, 0x1b, 0xcf, 0xab, 0xd9, 0x94, 0x69, 0x71, 0x48, 0xf4, 0x93,
+	0xa6, 0x77, 0x81, 0xa4, 0x30, 0xa8, 0x74, 0x75, 0xaa, 0xb6, 0x70, 0x76, 0x5e, 0xaf, 0x26, 0x00,
+	0xa8, 0xb0, 0xd4, 0xf1, 0xf7, 0x59, 0x98, 0xbb, 0x70, 0xfd, 0x22, 0x6d, 0x58, 0x09, 0x39, 0xa9,
+	0xed, 0xfd, 0xa7, 0xdb, 0x07, 0x5a, 0x73, 0xaf, 0x35, 0xaa, 0x70, 0xfd, 0xec, 0xbc, 0x7e, 0xf7,
+	0x02, 0x36, 0xa9, 0x76, 0x03, 0xee, 0x8d, 0x63, 0x13, 0xbb, 0x57, 0xa6, 0xb6, 0x7c, 0x76, 0x5e,
+	0xaf, 0x5d, 0x60, 0x12, 0xbb, 0xd8, 0x0f, 0xa0, 0x36, 0x8e, 0x85, 0xf4, 0xb3, 0x6c, 0xed, 0xce,
+	0xd9, 0x79, 0xfd, 0xd6, 0x05, 0xbc, 0xf0, 0x35, 0x7e, 0x23, 0x18, 0x07, 0x8e, 0x6c, 0x66, 0x4a,
+	0x44, 0xc4, 0x0b, 0xf0, 0xc8, 0x72, 0x12, 0x91, 0x25, 0xc9, 0x20, 0x34, 0xa0, 0x5c, 0x2a, 0xb2,
+	0xc4, 0xf8, 0x94, 0x19, 0x6d, 0x3e, 0xff, 0xea, 0x0f, 0xcb, 0x37, 0xbe, 0xfa, 0x7a, 0x39, 0xf3,
+	0x9b, 0xaf, 0x97, 0x33, 0xbf, 0xff, 0x7a, 0x39, 0xf3, 0xe5, 0x37, 0xcb, 0x37, 0x7e, 0xf3, 0xcd,
+	0xf2, 0x8d, 0xff, 0xf9, 0x66, 0xf9, 0xc6, 0xcf, 0x3f, 0x4e, 0x26, 0x56, 0x79, 0x45, 0x7e, 0xcf,
+	0xa1, 0xc1, 0x89, 0xeb, 0x1d, 0x47, 0x03, 0x1b, 0x2f, 0xbe, 0xbf, 0xf1, 0x32, 0xf1, 0xbf, 0x18,
+	0x30, 0xdf, 0x1e, 0x4e, 0x63, 0x81, 0xf5, 0xbd, 0xff, 0x1f, 0x00, 0xab, 0x85, 0x66, 0x4f, 0xe8,
+	0x30, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -736,18 +1580,253 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.MaxNumActivePoolsPerPair != 0 {
-		i = encodeVarintLiquidity(dAtA, i, uint64(m.MaxNumActivePoolsPerPair))
+	if m.OrderRevealBatches != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.OrderRevealBatches))
 		i--
-		dAtA[i] = 0x1
+		dAtA[i] = 0x2
 		i--
-		dAtA[i] = 0x88
+		dAtA[i] = 0xc8
 	}
-	if m.OrderExtraGas != 0 {
-		i = encodeVarintLiquidity(dAtA, i, uint64(m.OrderExtraGas))
-		i--
-		dAtA[i] = 0x1
-		i--
+	if len(m.OrderCommitBond) > 0 {
+		for iNdEx := len(m.OrderCommitBond) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OrderCommitBond[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLiquidity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if m.MaxPriceBandWideningSteps != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.MaxPriceBandWideningSteps))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xb8
+	}
+	if m.PriceBandWideningBatches != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.PriceBandWideningBatches))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xb0
+	}
+	{
+		size := m.DownwardPriceLimitRatio.Size()
+		i -= size
+		if _, err := m.DownwardPriceLimitRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0xaa
+	{
+		size := m.UpwardPriceLimitRatio.Size()
+		i -= size
+		if _, err := m.UpwardPriceLimitRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0xa2
+	if m.MaxNumActiveOrdersPerPair != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.MaxNumActiveOrdersPerPair))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.InstantDepositWithdraw {
+		i--
+		if m.InstantDepositWithdraw {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.DustSweepEpoch != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.DustSweepEpoch))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.BypassPoolPriceCheckForNewPairs {
+		i--
+		if m.BypassPoolPriceCheckForNewPairs {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.PairCreatorAllowlist) > 0 {
+		for iNdEx := len(m.PairCreatorAllowlist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PairCreatorAllowlist[iNdEx])
+			copy(dAtA[i:], m.PairCreatorAllowlist[iNdEx])
+			i = encodeVarintLiquidity(dAtA, i, uint64(len(m.PairCreatorAllowlist[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xfa
+		}
+	}
+	if m.PermissionlessPairCreation {
+		i--
+		if m.PermissionlessPairCreation {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf0
+	}
+	if len(m.PairCreationFeeDestination) > 0 {
+		i -= len(m.PairCreationFeeDestination)
+		copy(dAtA[i:], m.PairCreationFeeDestination)
+		i = encodeVarintLiquidity(dAtA, i, uint64(len(m.PairCreationFeeDestination)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xea
+	}
+	if m.MaxNumAutoPrunedRequestResults != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.MaxNumAutoPrunedRequestResults))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe0
+	}
+	if m.MakerPriority {
+		i--
+		if m.MakerPriority {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd8
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.RequestResultRetention, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.RequestResultRetention):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintLiquidity(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xd2
+	if m.MaxOrderPriceTicks != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.MaxOrderPriceTicks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc8
+	}
+	if m.CircuitBreakerEnabled {
+		i--
+		if m.CircuitBreakerEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	if m.PoolFeeSweepEpoch != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.PoolFeeSweepEpoch))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if m.NumBootstrapBatches != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.NumBootstrapBatches))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.PriceHistoryLength != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.PriceHistoryLength))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if len(m.PruneRewardPerEntry) > 0 {
+		for iNdEx := len(m.PruneRewardPerEntry) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PruneRewardPerEntry[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLiquidity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if m.MaxNumPrunedEntriesPerMsg != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.MaxNumPrunedEntriesPerMsg))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.SwapFeeToPools {
+		i--
+		if m.SwapFeeToPools {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.MaxNumActivePoolsPerPair != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.MaxNumActivePoolsPerPair))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.OrderExtraGas != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.OrderExtraGas))
+		i--
+		dAtA[i] = 0x1
+		i--
 		dAtA[i] = 0x80
 	}
 	if m.WithdrawExtraGas != 0 {
@@ -780,12 +1859,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x62
-	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxOrderLifespan, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxOrderLifespan):])
-	if err1 != nil {
-		return 0, err1
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxOrderLifespan, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxOrderLifespan):])
+	if err2 != nil {
+		return 0, err2
 	}
-	i -= n1
-	i = encodeVarintLiquidity(dAtA, i, uint64(n1))
+	i -= n2
+	i = encodeVarintLiquidity(dAtA, i, uint64(n2))
 	i--
 	dAtA[i] = 0x5a
 	if m.MaxNumMarketMakingOrderTicks != 0 {
@@ -793,16 +1872,6 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x50
 	}
-	{
-		size := m.MaxPriceLimitRatio.Size()
-		i -= size
-		if _, err := m.MaxPriceLimitRatio.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintLiquidity(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x4a
 	{
 		size := m.MinInitialDepositAmount.Size()
 		i -= size
@@ -898,6 +1967,135 @@ func (m *Pair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.NumBatchesWithoutTrade != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.NumBatchesWithoutTrade))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.DownwardPriceLimitRatio != nil {
+		{
+			size := m.DownwardPriceLimitRatio.Size()
+			i -= size
+			if _, err := m.DownwardPriceLimitRatio.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintLiquidity(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if m.UpwardPriceLimitRatio != nil {
+		{
+			size := m.UpwardPriceLimitRatio.Size()
+			i -= size
+			if _, err := m.UpwardPriceLimitRatio.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintLiquidity(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.AddressVersion != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.AddressVersion))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.MaxOrderLifespan != nil {
+		n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.MaxOrderLifespan, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.MaxOrderLifespan):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintLiquidity(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.MaxPriceLimitRatio != nil {
+		{
+			size := m.MaxPriceLimitRatio.Size()
+			i -= size
+			if _, err := m.MaxPriceLimitRatio.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintLiquidity(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.OrderAmountLimits != nil {
+		{
+			size, err := m.OrderAmountLimits.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLiquidity(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.LastBatchHeight != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.LastBatchHeight))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.BatchWindow != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.BatchWindow))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.Halted {
+		i--
+		if m.Halted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.Metadata != nil {
+		{
+			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLiquidity(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintLiquidity(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.PriceExponent != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.PriceExponent))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.BootstrapEndBatchId != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.BootstrapEndBatchId))
+		i--
+		dAtA[i] = 0x40
+	}
 	if m.CurrentBatchId != 0 {
 		i = encodeVarintLiquidity(dAtA, i, uint64(m.CurrentBatchId))
 		i--
@@ -949,7 +2147,7 @@ func (m *Pair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Pool) Marshal() (dAtA []byte, err error) {
+func (m *PairOrderAmountLimits) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -959,16 +2157,135 @@ func (m *Pool) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Pool) MarshalTo(dAtA []byte) (int, error) {
+func (m *PairOrderAmountLimits) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PairOrderAmountLimits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.LotSize.Size()
+		i -= size
+		if _, err := m.LotSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MinQuoteOrderAmount.Size()
+		i -= size
+		if _, err := m.MinQuoteOrderAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.MinBaseOrderAmount.Size()
+		i -= size
+		if _, err := m.MinBaseOrderAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PairMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PairMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PairMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.QuoteCoinDecimals != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.QuoteCoinDecimals))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BaseCoinDecimals != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.BaseCoinDecimals))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.LogoUriHash) > 0 {
+		i -= len(m.LogoUriHash)
+		copy(dAtA[i:], m.LogoUriHash)
+		i = encodeVarintLiquidity(dAtA, i, uint64(len(m.LogoUriHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DisplayName) > 0 {
+		i -= len(m.DisplayName)
+		copy(dAtA[i:], m.DisplayName)
+		i = encodeVarintLiquidity(dAtA, i, uint64(len(m.DisplayName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Pool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Pool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MaxReserveAmount) > 0 {
+		for iNdEx := len(m.MaxReserveAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxReserveAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLiquidity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if m.AddressVersion != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.AddressVersion))
+		i--
+		dAtA[i] = 0x60
+	}
 	if m.Disabled {
 		i--
 		if m.Disabled {
@@ -1072,6 +2389,26 @@ func (m *DepositRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Farm {
+		i--
+		if m.Farm {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.SingleAsset {
+		i--
+		if m.SingleAsset {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
 	if m.Status != 0 {
 		i = encodeVarintLiquidity(dAtA, i, uint64(m.Status))
 		i--
@@ -1234,17 +2571,31 @@ func (m *Order) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MinFillAmount != nil {
+		{
+			size := m.MinFillAmount.Size()
+			i -= size
+			if _, err := m.MinFillAmount.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintLiquidity(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
 	if m.Status != 0 {
 		i = encodeVarintLiquidity(dAtA, i, uint64(m.Status))
 		i--
 		dAtA[i] = 0x78
 	}
-	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ExpireAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ExpireAt):])
-	if err4 != nil {
-		return 0, err4
+	n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ExpireAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ExpireAt):])
+	if err8 != nil {
+		return 0, err8
 	}
-	i -= n4
-	i = encodeVarintLiquidity(dAtA, i, uint64(n4))
+	i -= n8
+	i = encodeVarintLiquidity(dAtA, i, uint64(n8))
 	i--
 	dAtA[i] = 0x72
 	if m.BatchId != 0 {
@@ -1367,21 +2718,26 @@ func (m *MMOrderIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AutoCancelHeight != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.AutoCancelHeight))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.OrderIds) > 0 {
-		dAtA9 := make([]byte, len(m.OrderIds)*10)
-		var j8 int
+		dAtA13 := make([]byte, len(m.OrderIds)*10)
+		var j12 int
 		for _, num := range m.OrderIds {
 			for num >= 1<<7 {
-				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA13[j12] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j8++
+				j12++
 			}
-			dAtA9[j8] = uint8(num)
-			j8++
+			dAtA13[j12] = uint8(num)
+			j12++
 		}
-		i -= j8
-		copy(dAtA[i:], dAtA9[:j8])
-		i = encodeVarintLiquidity(dAtA, i, uint64(j8))
+		i -= j12
+		copy(dAtA[i:], dAtA13[:j12])
+		i = encodeVarintLiquidity(dAtA, i, uint64(j12))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -1400,343 +2756,4628 @@ func (m *MMOrderIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintLiquidity(dAtA []byte, offset int, v uint64) int {
-	offset -= sovLiquidity(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *AccruedSwapFees) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *AccruedSwapFees) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccruedSwapFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.BatchSize != 0 {
-		n += 1 + sovLiquidity(uint64(m.BatchSize))
-	}
-	if m.TickPrecision != 0 {
-		n += 1 + sovLiquidity(uint64(m.TickPrecision))
-	}
-	l = len(m.FeeCollectorAddress)
-	if l > 0 {
-		n += 1 + l + sovLiquidity(uint64(l))
-	}
-	l = len(m.DustCollectorAddress)
-	if l > 0 {
-		n += 1 + l + sovLiquidity(uint64(l))
-	}
-	l = m.MinInitialPoolCoinSupply.Size()
-	n += 1 + l + sovLiquidity(uint64(l))
-	if len(m.PairCreationFee) > 0 {
-		for _, e := range m.PairCreationFee {
-			l = e.Size()
-			n += 1 + l + sovLiquidity(uint64(l))
+	if len(m.Fees) > 0 {
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLiquidity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if len(m.PoolCreationFee) > 0 {
-		for _, e := range m.PoolCreationFee {
-			l = e.Size()
-			n += 1 + l + sovLiquidity(uint64(l))
-		}
+	if m.PairId != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.PairId))
+		i--
+		dAtA[i] = 0x8
 	}
-	l = m.MinInitialDepositAmount.Size()
-	n += 1 + l + sovLiquidity(uint64(l))
-	l = m.MaxPriceLimitRatio.Size()
-	n += 1 + l + sovLiquidity(uint64(l))
-	if m.MaxNumMarketMakingOrderTicks != 0 {
-		n += 1 + sovLiquidity(uint64(m.MaxNumMarketMakingOrderTicks))
+	return len(dAtA) - i, nil
+}
+
+func (m *PriceHistoryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxOrderLifespan)
-	n += 1 + l + sovLiquidity(uint64(l))
-	l = m.SwapFeeRate.Size()
-	n += 1 + l + sovLiquidity(uint64(l))
-	l = m.WithdrawFeeRate.Size()
-	n += 1 + l + sovLiquidity(uint64(l))
-	if m.DepositExtraGas != 0 {
-		n += 1 + sovLiquidity(uint64(m.DepositExtraGas))
+	return dAtA[:n], nil
+}
+
+func (m *PriceHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
 	}
-	if m.WithdrawExtraGas != 0 {
-		n += 1 + sovLiquidity(uint64(m.WithdrawExtraGas))
+	i--
+	dAtA[i] = 0x22
+	n14, err14 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err14 != nil {
+		return 0, err14
 	}
-	if m.OrderExtraGas != 0 {
-		n += 2 + sovLiquidity(uint64(m.OrderExtraGas))
+	i -= n14
+	i = encodeVarintLiquidity(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.MaxNumActivePoolsPerPair != 0 {
-		n += 2 + sovLiquidity(uint64(m.MaxNumActivePoolsPerPair))
+	if m.PairId != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.PairId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Pair) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Vault) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Vault) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Vault) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovLiquidity(uint64(m.Id))
-	}
-	l = len(m.BaseCoinDenom)
-	if l > 0 {
-		n += 1 + l + sovLiquidity(uint64(l))
+	{
+		size := m.HighWaterMark.Size()
+		i -= size
+		if _, err := m.HighWaterMark.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
 	}
-	l = len(m.QuoteCoinDenom)
-	if l > 0 {
-		n += 1 + l + sovLiquidity(uint64(l))
+	i--
+	dAtA[i] = 0x52
+	if m.MaxPrice != nil {
+		{
+			size := m.MaxPrice.Size()
+			i -= size
+			if _, err := m.MaxPrice.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintLiquidity(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
 	}
-	l = len(m.EscrowAddress)
-	if l > 0 {
-		n += 1 + l + sovLiquidity(uint64(l))
+	if m.MinPrice != nil {
+		{
+			size := m.MinPrice.Size()
+			i -= size
+			if _, err := m.MinPrice.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintLiquidity(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
 	}
-	if m.LastOrderId != 0 {
-		n += 1 + sovLiquidity(uint64(m.LastOrderId))
+	{
+		size := m.OperatorFeeRate.Size()
+		i -= size
+		if _, err := m.OperatorFeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
 	}
-	if m.LastPrice != nil {
-		l = m.LastPrice.Size()
-		n += 1 + l + sovLiquidity(uint64(l))
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.MaxPriceDeviation.Size()
+		i -= size
+		if _, err := m.MaxPriceDeviation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
 	}
-	if m.CurrentBatchId != 0 {
-		n += 1 + sovLiquidity(uint64(m.CurrentBatchId))
+	i--
+	dAtA[i] = 0x32
+	if len(m.ShareDenom) > 0 {
+		i -= len(m.ShareDenom)
+		copy(dAtA[i:], m.ShareDenom)
+		i = encodeVarintLiquidity(dAtA, i, uint64(len(m.ShareDenom)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	return n
-}
-
-func (m *Pool) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.ReserveAddress) > 0 {
+		i -= len(m.ReserveAddress)
+		copy(dAtA[i:], m.ReserveAddress)
+		i = encodeVarintLiquidity(dAtA, i, uint64(len(m.ReserveAddress)))
+		i--
+		dAtA[i] = 0x22
 	}
-	var l int
-	_ = l
-	if m.Type != 0 {
-		n += 1 + sovLiquidity(uint64(m.Type))
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintLiquidity(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PairId != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.PairId))
+		i--
+		dAtA[i] = 0x10
 	}
 	if m.Id != 0 {
-		n += 1 + sovLiquidity(uint64(m.Id))
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
 	}
-	if m.PairId != 0 {
-		n += 1 + sovLiquidity(uint64(m.PairId))
+	return len(dAtA) - i, nil
+}
+
+func (m *RequestResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovLiquidity(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *RequestResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RequestResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n15, err15 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.FinishedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.FinishedAt):])
+	if err15 != nil {
+		return 0, err15
 	}
-	l = len(m.ReserveAddress)
-	if l > 0 {
-		n += 1 + l + sovLiquidity(uint64(l))
+	i -= n15
+	i = encodeVarintLiquidity(dAtA, i, uint64(n15))
+	i--
+	dAtA[i] = 0x42
+	if m.FinishedHeight != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.FinishedHeight))
+		i--
+		dAtA[i] = 0x38
 	}
-	l = len(m.PoolCoinDenom)
-	if l > 0 {
-		n += 1 + l + sovLiquidity(uint64(l))
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintLiquidity(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x32
 	}
-	if m.MinPrice != nil {
-		l = m.MinPrice.Size()
-		n += 1 + l + sovLiquidity(uint64(l))
+	if m.Code != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.MaxPrice != nil {
-		l = m.MaxPrice.Size()
-		n += 1 + l + sovLiquidity(uint64(l))
+	if len(m.Requester) > 0 {
+		i -= len(m.Requester)
+		copy(dAtA[i:], m.Requester)
+		i = encodeVarintLiquidity(dAtA, i, uint64(len(m.Requester)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.LastDepositRequestId != 0 {
-		n += 1 + sovLiquidity(uint64(m.LastDepositRequestId))
+	if m.Id != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.LastWithdrawRequestId != 0 {
-		n += 1 + sovLiquidity(uint64(m.LastWithdrawRequestId))
+	if m.TargetId != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.TargetId))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Disabled {
-		n += 2
+	if m.Type != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *DepositRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *BatchResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovLiquidity(uint64(m.Id))
+	n16, err16 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ExecutedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ExecutedAt):])
+	if err16 != nil {
+		return 0, err16
 	}
-	if m.PoolId != 0 {
-		n += 1 + sovLiquidity(uint64(m.PoolId))
+	i -= n16
+	i = encodeVarintLiquidity(dAtA, i, uint64(n16))
+	i--
+	dAtA[i] = 0x52
+	if m.ExecutedHeight != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.ExecutedHeight))
+		i--
+		dAtA[i] = 0x48
 	}
-	if m.MsgHeight != 0 {
-		n += 1 + sovLiquidity(uint64(m.MsgHeight))
+	if m.NumPartiallyMatchedOrders != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.NumPartiallyMatchedOrders))
+		i--
+		dAtA[i] = 0x40
 	}
-	l = len(m.Depositor)
-	if l > 0 {
-		n += 1 + l + sovLiquidity(uint64(l))
+	if m.NumFullyMatchedOrders != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.NumFullyMatchedOrders))
+		i--
+		dAtA[i] = 0x38
 	}
-	if len(m.DepositCoins) > 0 {
-		for _, e := range m.DepositCoins {
-			l = e.Size()
-			n += 1 + l + sovLiquidity(uint64(l))
+	{
+		size := m.MatchedQuoteAmount.Size()
+		i -= size
+		if _, err := m.MatchedQuoteAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
 	}
-	if len(m.AcceptedCoins) > 0 {
-		for _, e := range m.AcceptedCoins {
-			l = e.Size()
-			n += 1 + l + sovLiquidity(uint64(l))
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.MatchedBaseAmount.Size()
+		i -= size
+		if _, err := m.MatchedBaseAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
 	}
-	l = m.MintedPoolCoin.Size()
-	n += 1 + l + sovLiquidity(uint64(l))
-	if m.Status != 0 {
-		n += 1 + sovLiquidity(uint64(m.Status))
+	i--
+	dAtA[i] = 0x2a
+	if m.MatchPrice != nil {
+		{
+			size := m.MatchPrice.Size()
+			i -= size
+			if _, err := m.MatchPrice.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintLiquidity(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	return n
+	if m.Matched {
+		i--
+		if m.Matched {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BatchId != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.BatchId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PairId != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.PairId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *WithdrawRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SwapRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SwapRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwapRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovLiquidity(uint64(m.Id))
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintLiquidity(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x52
 	}
-	if m.PoolId != 0 {
-		n += 1 + sovLiquidity(uint64(m.PoolId))
+	if m.OrderId != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x48
 	}
-	if m.MsgHeight != 0 {
-		n += 1 + sovLiquidity(uint64(m.MsgHeight))
+	if m.Hop != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.Hop))
+		i--
+		dAtA[i] = 0x40
 	}
-	l = len(m.Withdrawer)
-	if l > 0 {
-		n += 1 + l + sovLiquidity(uint64(l))
+	if len(m.EscrowAddress) > 0 {
+		i -= len(m.EscrowAddress)
+		copy(dAtA[i:], m.EscrowAddress)
+		i = encodeVarintLiquidity(dAtA, i, uint64(len(m.EscrowAddress)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	l = m.PoolCoin.Size()
-	n += 1 + l + sovLiquidity(uint64(l))
-	if len(m.WithdrawnCoins) > 0 {
-		for _, e := range m.WithdrawnCoins {
-			l = e.Size()
-			n += 1 + l + sovLiquidity(uint64(l))
+	n17, err17 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.OrderLifespan, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.OrderLifespan):])
+	if err17 != nil {
+		return 0, err17
+	}
+	i -= n17
+	i = encodeVarintLiquidity(dAtA, i, uint64(n17))
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.MinOutCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
 	}
-	if m.Status != 0 {
-		n += 1 + sovLiquidity(uint64(m.Status))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.OfferCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x22
+	if len(m.PairIds) > 0 {
+		dAtA21 := make([]byte, len(m.PairIds)*10)
+		var j20 int
+		for _, num := range m.PairIds {
+			for num >= 1<<7 {
+				dAtA21[j20] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j20++
+			}
+			dAtA21[j20] = uint8(num)
+			j20++
+		}
+		i -= j20
+		copy(dAtA[i:], dAtA21[:j20])
+		i = encodeVarintLiquidity(dAtA, i, uint64(j20))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Orderer) > 0 {
+		i -= len(m.Orderer)
+		copy(dAtA[i:], m.Orderer)
+		i = encodeVarintLiquidity(dAtA, i, uint64(len(m.Orderer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Order) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *OrderCommit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *OrderCommit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrderCommit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Type != 0 {
-		n += 1 + sovLiquidity(uint64(m.Type))
+	if len(m.Bond) > 0 {
+		for iNdEx := len(m.Bond) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bond[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLiquidity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
 	}
-	if m.Id != 0 {
-		n += 1 + sovLiquidity(uint64(m.Id))
+	if m.LastRevealBatchId != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.LastRevealBatchId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.BatchId != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.BatchId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintLiquidity(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Orderer) > 0 {
+		i -= len(m.Orderer)
+		copy(dAtA[i:], m.Orderer)
+		i = encodeVarintLiquidity(dAtA, i, uint64(len(m.Orderer)))
+		i--
+		dAtA[i] = 0x12
 	}
 	if m.PairId != 0 {
-		n += 1 + sovLiquidity(uint64(m.PairId))
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.PairId))
+		i--
+		dAtA[i] = 0x8
 	}
-	if m.MsgHeight != 0 {
-		n += 1 + sovLiquidity(uint64(m.MsgHeight))
+	return len(dAtA) - i, nil
+}
+
+func (m *PairStatsBucket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Orderer)
-	if l > 0 {
-		n += 1 + l + sovLiquidity(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *PairStatsBucket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PairStatsBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SwapFees) > 0 {
+		for iNdEx := len(m.SwapFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SwapFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLiquidity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
 	}
-	if m.Direction != 0 {
-		n += 1 + sovLiquidity(uint64(m.Direction))
+	{
+		size := m.LowPrice.Size()
+		i -= size
+		if _, err := m.LowPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
 	}
-	l = m.OfferCoin.Size()
-	n += 1 + l + sovLiquidity(uint64(l))
-	l = m.RemainingOfferCoin.Size()
-	n += 1 + l + sovLiquidity(uint64(l))
-	l = m.ReceivedCoin.Size()
-	n += 1 + l + sovLiquidity(uint64(l))
-	l = m.Price.Size()
-	n += 1 + l + sovLiquidity(uint64(l))
-	l = m.Amount.Size()
-	n += 1 + l + sovLiquidity(uint64(l))
-	l = m.OpenAmount.Size()
-	n += 1 + l + sovLiquidity(uint64(l))
-	if m.BatchId != 0 {
-		n += 1 + sovLiquidity(uint64(m.BatchId))
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.HighPrice.Size()
+		i -= size
+		if _, err := m.HighPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.ExpireAt)
-	n += 1 + l + sovLiquidity(uint64(l))
-	if m.Status != 0 {
-		n += 1 + sovLiquidity(uint64(m.Status))
+	i--
+	dAtA[i] = 0x32
+	if m.NumMatchedOrders != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.NumMatchedOrders))
+		i--
+		dAtA[i] = 0x28
 	}
-	return n
+	{
+		size := m.QuoteVolume.Size()
+		i -= size
+		if _, err := m.QuoteVolume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.BaseVolume.Size()
+		i -= size
+		if _, err := m.BaseVolume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	n22, err22 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err22 != nil {
+		return 0, err22
+	}
+	i -= n22
+	i = encodeVarintLiquidity(dAtA, i, uint64(n22))
+	i--
+	dAtA[i] = 0x12
+	if m.PairId != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.PairId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MMOrderIndex) Size() (n int) {
+func encodeVarintLiquidity(dAtA []byte, offset int, v uint64) int {
+	offset -= sovLiquidity(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Orderer)
+	if m.BatchSize != 0 {
+		n += 1 + sovLiquidity(uint64(m.BatchSize))
+	}
+	if m.TickPrecision != 0 {
+		n += 1 + sovLiquidity(uint64(m.TickPrecision))
+	}
+	l = len(m.FeeCollectorAddress)
 	if l > 0 {
 		n += 1 + l + sovLiquidity(uint64(l))
 	}
-	if m.PairId != 0 {
-		n += 1 + sovLiquidity(uint64(m.PairId))
+	l = len(m.DustCollectorAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
 	}
-	if len(m.OrderIds) > 0 {
-		l = 0
-		for _, e := range m.OrderIds {
-			l += sovLiquidity(uint64(e))
+	l = m.MinInitialPoolCoinSupply.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	if len(m.PairCreationFee) > 0 {
+		for _, e := range m.PairCreationFee {
+			l = e.Size()
+			n += 1 + l + sovLiquidity(uint64(l))
 		}
-		n += 1 + sovLiquidity(uint64(l)) + l
 	}
-	return n
-}
-
-func sovLiquidity(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozLiquidity(x uint64) (n int) {
-	return sovLiquidity(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *Params) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
+	if len(m.PoolCreationFee) > 0 {
+		for _, e := range m.PoolCreationFee {
+			l = e.Size()
+			n += 1 + l + sovLiquidity(uint64(l))
+		}
+	}
+	l = m.MinInitialDepositAmount.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	if m.MaxNumMarketMakingOrderTicks != 0 {
+		n += 1 + sovLiquidity(uint64(m.MaxNumMarketMakingOrderTicks))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxOrderLifespan)
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = m.SwapFeeRate.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = m.WithdrawFeeRate.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	if m.DepositExtraGas != 0 {
+		n += 1 + sovLiquidity(uint64(m.DepositExtraGas))
+	}
+	if m.WithdrawExtraGas != 0 {
+		n += 1 + sovLiquidity(uint64(m.WithdrawExtraGas))
+	}
+	if m.OrderExtraGas != 0 {
+		n += 2 + sovLiquidity(uint64(m.OrderExtraGas))
+	}
+	if m.MaxNumActivePoolsPerPair != 0 {
+		n += 2 + sovLiquidity(uint64(m.MaxNumActivePoolsPerPair))
+	}
+	if m.SwapFeeToPools {
+		n += 3
+	}
+	if m.MaxNumPrunedEntriesPerMsg != 0 {
+		n += 2 + sovLiquidity(uint64(m.MaxNumPrunedEntriesPerMsg))
+	}
+	if len(m.PruneRewardPerEntry) > 0 {
+		for _, e := range m.PruneRewardPerEntry {
+			l = e.Size()
+			n += 2 + l + sovLiquidity(uint64(l))
+		}
+	}
+	if m.PriceHistoryLength != 0 {
+		n += 2 + sovLiquidity(uint64(m.PriceHistoryLength))
+	}
+	if m.NumBootstrapBatches != 0 {
+		n += 2 + sovLiquidity(uint64(m.NumBootstrapBatches))
+	}
+	if m.PoolFeeSweepEpoch != 0 {
+		n += 2 + sovLiquidity(uint64(m.PoolFeeSweepEpoch))
+	}
+	if m.CircuitBreakerEnabled {
+		n += 3
+	}
+	if m.MaxOrderPriceTicks != 0 {
+		n += 2 + sovLiquidity(uint64(m.MaxOrderPriceTicks))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.RequestResultRetention)
+	n += 2 + l + sovLiquidity(uint64(l))
+	if m.MakerPriority {
+		n += 3
+	}
+	if m.MaxNumAutoPrunedRequestResults != 0 {
+		n += 2 + sovLiquidity(uint64(m.MaxNumAutoPrunedRequestResults))
+	}
+	l = len(m.PairCreationFeeDestination)
+	if l > 0 {
+		n += 2 + l + sovLiquidity(uint64(l))
+	}
+	if m.PermissionlessPairCreation {
+		n += 3
+	}
+	if len(m.PairCreatorAllowlist) > 0 {
+		for _, s := range m.PairCreatorAllowlist {
+			l = len(s)
+			n += 2 + l + sovLiquidity(uint64(l))
+		}
+	}
+	if m.BypassPoolPriceCheckForNewPairs {
+		n += 3
+	}
+	if m.DustSweepEpoch != 0 {
+		n += 2 + sovLiquidity(uint64(m.DustSweepEpoch))
+	}
+	if m.InstantDepositWithdraw {
+		n += 3
+	}
+	if m.MaxNumActiveOrdersPerPair != 0 {
+		n += 2 + sovLiquidity(uint64(m.MaxNumActiveOrdersPerPair))
+	}
+	l = m.UpwardPriceLimitRatio.Size()
+	n += 2 + l + sovLiquidity(uint64(l))
+	l = m.DownwardPriceLimitRatio.Size()
+	n += 2 + l + sovLiquidity(uint64(l))
+	if m.PriceBandWideningBatches != 0 {
+		n += 2 + sovLiquidity(uint64(m.PriceBandWideningBatches))
+	}
+	if m.MaxPriceBandWideningSteps != 0 {
+		n += 2 + sovLiquidity(uint64(m.MaxPriceBandWideningSteps))
+	}
+	if len(m.OrderCommitBond) > 0 {
+		for _, e := range m.OrderCommitBond {
+			l = e.Size()
+			n += 2 + l + sovLiquidity(uint64(l))
+		}
+	}
+	if m.OrderRevealBatches != 0 {
+		n += 2 + sovLiquidity(uint64(m.OrderRevealBatches))
+	}
+	return n
+}
+
+func (m *Pair) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovLiquidity(uint64(m.Id))
+	}
+	l = len(m.BaseCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	l = len(m.QuoteCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	l = len(m.EscrowAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	if m.LastOrderId != 0 {
+		n += 1 + sovLiquidity(uint64(m.LastOrderId))
+	}
+	if m.LastPrice != nil {
+		l = m.LastPrice.Size()
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	if m.CurrentBatchId != 0 {
+		n += 1 + sovLiquidity(uint64(m.CurrentBatchId))
+	}
+	if m.BootstrapEndBatchId != 0 {
+		n += 1 + sovLiquidity(uint64(m.BootstrapEndBatchId))
+	}
+	if m.PriceExponent != 0 {
+		n += 1 + sovLiquidity(uint64(m.PriceExponent))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	if m.Halted {
+		n += 2
+	}
+	if m.BatchWindow != 0 {
+		n += 1 + sovLiquidity(uint64(m.BatchWindow))
+	}
+	if m.LastBatchHeight != 0 {
+		n += 1 + sovLiquidity(uint64(m.LastBatchHeight))
+	}
+	if m.OrderAmountLimits != nil {
+		l = m.OrderAmountLimits.Size()
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	if m.MaxPriceLimitRatio != nil {
+		l = m.MaxPriceLimitRatio.Size()
+		n += 2 + l + sovLiquidity(uint64(l))
+	}
+	if m.MaxOrderLifespan != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.MaxOrderLifespan)
+		n += 2 + l + sovLiquidity(uint64(l))
+	}
+	if m.AddressVersion != 0 {
+		n += 2 + sovLiquidity(uint64(m.AddressVersion))
+	}
+	if m.UpwardPriceLimitRatio != nil {
+		l = m.UpwardPriceLimitRatio.Size()
+		n += 2 + l + sovLiquidity(uint64(l))
+	}
+	if m.DownwardPriceLimitRatio != nil {
+		l = m.DownwardPriceLimitRatio.Size()
+		n += 2 + l + sovLiquidity(uint64(l))
+	}
+	if m.NumBatchesWithoutTrade != 0 {
+		n += 2 + sovLiquidity(uint64(m.NumBatchesWithoutTrade))
+	}
+	return n
+}
+
+func (m *PairOrderAmountLimits) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MinBaseOrderAmount.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = m.MinQuoteOrderAmount.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = m.LotSize.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	return n
+}
+
+func (m *PairMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DisplayName)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	l = len(m.LogoUriHash)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	if m.BaseCoinDecimals != 0 {
+		n += 1 + sovLiquidity(uint64(m.BaseCoinDecimals))
+	}
+	if m.QuoteCoinDecimals != 0 {
+		n += 1 + sovLiquidity(uint64(m.QuoteCoinDecimals))
+	}
+	return n
+}
+
+func (m *Pool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovLiquidity(uint64(m.Type))
+	}
+	if m.Id != 0 {
+		n += 1 + sovLiquidity(uint64(m.Id))
+	}
+	if m.PairId != 0 {
+		n += 1 + sovLiquidity(uint64(m.PairId))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	l = len(m.ReserveAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	l = len(m.PoolCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	if m.MinPrice != nil {
+		l = m.MinPrice.Size()
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	if m.MaxPrice != nil {
+		l = m.MaxPrice.Size()
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	if m.LastDepositRequestId != 0 {
+		n += 1 + sovLiquidity(uint64(m.LastDepositRequestId))
+	}
+	if m.LastWithdrawRequestId != 0 {
+		n += 1 + sovLiquidity(uint64(m.LastWithdrawRequestId))
+	}
+	if m.Disabled {
+		n += 2
+	}
+	if m.AddressVersion != 0 {
+		n += 1 + sovLiquidity(uint64(m.AddressVersion))
+	}
+	if len(m.MaxReserveAmount) > 0 {
+		for _, e := range m.MaxReserveAmount {
+			l = e.Size()
+			n += 1 + l + sovLiquidity(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DepositRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovLiquidity(uint64(m.Id))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovLiquidity(uint64(m.PoolId))
+	}
+	if m.MsgHeight != 0 {
+		n += 1 + sovLiquidity(uint64(m.MsgHeight))
+	}
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	if len(m.DepositCoins) > 0 {
+		for _, e := range m.DepositCoins {
+			l = e.Size()
+			n += 1 + l + sovLiquidity(uint64(l))
+		}
+	}
+	if len(m.AcceptedCoins) > 0 {
+		for _, e := range m.AcceptedCoins {
+			l = e.Size()
+			n += 1 + l + sovLiquidity(uint64(l))
+		}
+	}
+	l = m.MintedPoolCoin.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovLiquidity(uint64(m.Status))
+	}
+	if m.SingleAsset {
+		n += 2
+	}
+	if m.Farm {
+		n += 2
+	}
+	return n
+}
+
+func (m *WithdrawRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovLiquidity(uint64(m.Id))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovLiquidity(uint64(m.PoolId))
+	}
+	if m.MsgHeight != 0 {
+		n += 1 + sovLiquidity(uint64(m.MsgHeight))
+	}
+	l = len(m.Withdrawer)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	l = m.PoolCoin.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	if len(m.WithdrawnCoins) > 0 {
+		for _, e := range m.WithdrawnCoins {
+			l = e.Size()
+			n += 1 + l + sovLiquidity(uint64(l))
+		}
+	}
+	if m.Status != 0 {
+		n += 1 + sovLiquidity(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *Order) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovLiquidity(uint64(m.Type))
+	}
+	if m.Id != 0 {
+		n += 1 + sovLiquidity(uint64(m.Id))
+	}
+	if m.PairId != 0 {
+		n += 1 + sovLiquidity(uint64(m.PairId))
+	}
+	if m.MsgHeight != 0 {
+		n += 1 + sovLiquidity(uint64(m.MsgHeight))
+	}
+	l = len(m.Orderer)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	if m.Direction != 0 {
+		n += 1 + sovLiquidity(uint64(m.Direction))
+	}
+	l = m.OfferCoin.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = m.RemainingOfferCoin.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = m.ReceivedCoin.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = m.Price.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = m.Amount.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = m.OpenAmount.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	if m.BatchId != 0 {
+		n += 1 + sovLiquidity(uint64(m.BatchId))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.ExpireAt)
+	n += 1 + l + sovLiquidity(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovLiquidity(uint64(m.Status))
+	}
+	if m.MinFillAmount != nil {
+		l = m.MinFillAmount.Size()
+		n += 2 + l + sovLiquidity(uint64(l))
+	}
+	return n
+}
+
+func (m *MMOrderIndex) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Orderer)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	if m.PairId != 0 {
+		n += 1 + sovLiquidity(uint64(m.PairId))
+	}
+	if len(m.OrderIds) > 0 {
+		l = 0
+		for _, e := range m.OrderIds {
+			l += sovLiquidity(uint64(e))
+		}
+		n += 1 + sovLiquidity(uint64(l)) + l
+	}
+	if m.AutoCancelHeight != 0 {
+		n += 1 + sovLiquidity(uint64(m.AutoCancelHeight))
+	}
+	return n
+}
+
+func (m *AccruedSwapFees) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PairId != 0 {
+		n += 1 + sovLiquidity(uint64(m.PairId))
+	}
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovLiquidity(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PriceHistoryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PairId != 0 {
+		n += 1 + sovLiquidity(uint64(m.PairId))
+	}
+	if m.Height != 0 {
+		n += 1 + sovLiquidity(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = m.Price.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	return n
+}
+
+func (m *Vault) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovLiquidity(uint64(m.Id))
+	}
+	if m.PairId != 0 {
+		n += 1 + sovLiquidity(uint64(m.PairId))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	l = len(m.ReserveAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	l = len(m.ShareDenom)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	l = m.MaxPriceDeviation.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = m.OperatorFeeRate.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	if m.MinPrice != nil {
+		l = m.MinPrice.Size()
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	if m.MaxPrice != nil {
+		l = m.MaxPrice.Size()
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	l = m.HighWaterMark.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	return n
+}
+
+func (m *RequestResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovLiquidity(uint64(m.Type))
+	}
+	if m.TargetId != 0 {
+		n += 1 + sovLiquidity(uint64(m.TargetId))
+	}
+	if m.Id != 0 {
+		n += 1 + sovLiquidity(uint64(m.Id))
+	}
+	l = len(m.Requester)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	if m.Code != 0 {
+		n += 1 + sovLiquidity(uint64(m.Code))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	if m.FinishedHeight != 0 {
+		n += 1 + sovLiquidity(uint64(m.FinishedHeight))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.FinishedAt)
+	n += 1 + l + sovLiquidity(uint64(l))
+	return n
+}
+
+func (m *BatchResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PairId != 0 {
+		n += 1 + sovLiquidity(uint64(m.PairId))
+	}
+	if m.BatchId != 0 {
+		n += 1 + sovLiquidity(uint64(m.BatchId))
+	}
+	if m.Matched {
+		n += 2
+	}
+	if m.MatchPrice != nil {
+		l = m.MatchPrice.Size()
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	l = m.MatchedBaseAmount.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = m.MatchedQuoteAmount.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	if m.NumFullyMatchedOrders != 0 {
+		n += 1 + sovLiquidity(uint64(m.NumFullyMatchedOrders))
+	}
+	if m.NumPartiallyMatchedOrders != 0 {
+		n += 1 + sovLiquidity(uint64(m.NumPartiallyMatchedOrders))
+	}
+	if m.ExecutedHeight != 0 {
+		n += 1 + sovLiquidity(uint64(m.ExecutedHeight))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.ExecutedAt)
+	n += 1 + l + sovLiquidity(uint64(l))
+	return n
+}
+
+func (m *SwapRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovLiquidity(uint64(m.Id))
+	}
+	l = len(m.Orderer)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	if len(m.PairIds) > 0 {
+		l = 0
+		for _, e := range m.PairIds {
+			l += sovLiquidity(uint64(e))
+		}
+		n += 1 + sovLiquidity(uint64(l)) + l
+	}
+	l = m.OfferCoin.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = m.MinOutCoin.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.OrderLifespan)
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = len(m.EscrowAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	if m.Hop != 0 {
+		n += 1 + sovLiquidity(uint64(m.Hop))
+	}
+	if m.OrderId != 0 {
+		n += 1 + sovLiquidity(uint64(m.OrderId))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	return n
+}
+
+func (m *OrderCommit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PairId != 0 {
+		n += 1 + sovLiquidity(uint64(m.PairId))
+	}
+	l = len(m.Orderer)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	if m.BatchId != 0 {
+		n += 1 + sovLiquidity(uint64(m.BatchId))
+	}
+	if m.LastRevealBatchId != 0 {
+		n += 1 + sovLiquidity(uint64(m.LastRevealBatchId))
+	}
+	if len(m.Bond) > 0 {
+		for _, e := range m.Bond {
+			l = e.Size()
+			n += 1 + l + sovLiquidity(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PairStatsBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PairId != 0 {
+		n += 1 + sovLiquidity(uint64(m.PairId))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = m.BaseVolume.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = m.QuoteVolume.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	if m.NumMatchedOrders != 0 {
+		n += 1 + sovLiquidity(uint64(m.NumMatchedOrders))
+	}
+	l = m.HighPrice.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = m.LowPrice.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	if len(m.SwapFees) > 0 {
+		for _, e := range m.SwapFees {
+			l = e.Size()
+			n += 1 + l + sovLiquidity(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovLiquidity(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozLiquidity(x uint64) (n int) {
+	return sovLiquidity(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchSize", wireType)
+			}
+			m.BatchSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickPrecision", wireType)
+			}
+			m.TickPrecision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TickPrecision |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeCollectorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeCollectorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DustCollectorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DustCollectorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinInitialPoolCoinSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinInitialPoolCoinSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairCreationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PairCreationFee = append(m.PairCreationFee, types.Coin{})
+			if err := m.PairCreationFee[len(m.PairCreationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolCreationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolCreationFee = append(m.PoolCreationFee, types.Coin{})
+			if err := m.PoolCreationFee[len(m.PoolCreationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinInitialDepositAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinInitialDepositAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxNumMarketMakingOrderTicks", wireType)
+			}
+			m.MaxNumMarketMakingOrderTicks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxNumMarketMakingOrderTicks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOrderLifespan", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MaxOrderLifespan, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwapFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WithdrawFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositExtraGas", wireType)
+			}
+			m.DepositExtraGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DepositExtraGas |= github_com_cosmos_cosmos_sdk_types.Gas(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawExtraGas", wireType)
+			}
+			m.WithdrawExtraGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WithdrawExtraGas |= github_com_cosmos_cosmos_sdk_types.Gas(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderExtraGas", wireType)
+			}
+			m.OrderExtraGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderExtraGas |= github_com_cosmos_cosmos_sdk_types.Gas(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxNumActivePoolsPerPair", wireType)
+			}
+			m.MaxNumActivePoolsPerPair = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxNumActivePoolsPerPair |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFeeToPools", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SwapFeeToPools = bool(v != 0)
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxNumPrunedEntriesPerMsg", wireType)
+			}
+			m.MaxNumPrunedEntriesPerMsg = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxNumPrunedEntriesPerMsg |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PruneRewardPerEntry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PruneRewardPerEntry = append(m.PruneRewardPerEntry, types.Coin{})
+			if err := m.PruneRewardPerEntry[len(m.PruneRewardPerEntry)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceHistoryLength", wireType)
+			}
+			m.PriceHistoryLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PriceHistoryLength |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumBootstrapBatches", wireType)
+			}
+			m.NumBootstrapBatches = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumBootstrapBatches |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolFeeSweepEpoch", wireType)
+			}
+			m.PoolFeeSweepEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolFeeSweepEpoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakerEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CircuitBreakerEnabled = bool(v != 0)
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOrderPriceTicks", wireType)
+			}
+			m.MaxOrderPriceTicks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxOrderPriceTicks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestResultRetention", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.RequestResultRetention, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 27:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerPriority", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MakerPriority = bool(v != 0)
+		case 28:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxNumAutoPrunedRequestResults", wireType)
+			}
+			m.MaxNumAutoPrunedRequestResults = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxNumAutoPrunedRequestResults |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairCreationFeeDestination", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PairCreationFeeDestination = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 30:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PermissionlessPairCreation", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PermissionlessPairCreation = bool(v != 0)
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairCreatorAllowlist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PairCreatorAllowlist = append(m.PairCreatorAllowlist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 32:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BypassPoolPriceCheckForNewPairs", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BypassPoolPriceCheckForNewPairs = bool(v != 0)
+		case 33:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DustSweepEpoch", wireType)
+			}
+			m.DustSweepEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DustSweepEpoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 34:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstantDepositWithdraw", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InstantDepositWithdraw = bool(v != 0)
+		case 35:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxNumActiveOrdersPerPair", wireType)
+			}
+			m.MaxNumActiveOrdersPerPair = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxNumActiveOrdersPerPair |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 36:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpwardPriceLimitRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UpwardPriceLimitRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 37:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownwardPriceLimitRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DownwardPriceLimitRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 38:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceBandWideningBatches", wireType)
+			}
+			m.PriceBandWideningBatches = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PriceBandWideningBatches |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 39:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceBandWideningSteps", wireType)
+			}
+			m.MaxPriceBandWideningSteps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPriceBandWideningSteps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 40:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderCommitBond", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderCommitBond = append(m.OrderCommitBond, types.Coin{})
+			if err := m.OrderCommitBond[len(m.OrderCommitBond)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 41:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderRevealBatches", wireType)
+			}
+			m.OrderRevealBatches = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderRevealBatches |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Pair) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Pair: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Pair: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseCoinDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseCoinDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteCoinDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteCoinDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EscrowAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastOrderId", wireType)
+			}
+			m.LastOrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastOrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.LastPrice = &v
+			if err := m.LastPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentBatchId", wireType)
+			}
+			m.CurrentBatchId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentBatchId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BootstrapEndBatchId", wireType)
+			}
+			m.BootstrapEndBatchId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BootstrapEndBatchId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceExponent", wireType)
+			}
+			m.PriceExponent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PriceExponent |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &PairMetadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Halted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Halted = bool(v != 0)
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchWindow", wireType)
+			}
+			m.BatchWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchWindow |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastBatchHeight", wireType)
+			}
+			m.LastBatchHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastBatchHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderAmountLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OrderAmountLimits == nil {
+				m.OrderAmountLimits = &PairOrderAmountLimits{}
+			}
+			if err := m.OrderAmountLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceLimitRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.MaxPriceLimitRatio = &v
+			if err := m.MaxPriceLimitRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOrderLifespan", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaxOrderLifespan == nil {
+				m.MaxOrderLifespan = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.MaxOrderLifespan, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressVersion", wireType)
+			}
+			m.AddressVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AddressVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpwardPriceLimitRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.UpwardPriceLimitRatio = &v
+			if err := m.UpwardPriceLimitRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownwardPriceLimitRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.DownwardPriceLimitRatio = &v
+			if err := m.DownwardPriceLimitRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumBatchesWithoutTrade", wireType)
+			}
+			m.NumBatchesWithoutTrade = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumBatchesWithoutTrade |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PairOrderAmountLimits) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PairOrderAmountLimits: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PairOrderAmountLimits: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBaseOrderAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinBaseOrderAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinQuoteOrderAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinQuoteOrderAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LotSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LotSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PairMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PairMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PairMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisplayName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisplayName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogoUriHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LogoUriHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseCoinDecimals", wireType)
+			}
+			m.BaseCoinDecimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseCoinDecimals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteCoinDecimals", wireType)
+			}
+			m.QuoteCoinDecimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QuoteCoinDecimals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Pool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Pool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= PoolType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
+			}
+			m.PairId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PairId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReserveAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolCoinDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolCoinDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.MinPrice = &v
+			if err := m.MinPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.MaxPrice = &v
+			if err := m.MaxPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastDepositRequestId", wireType)
+			}
+			m.LastDepositRequestId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastDepositRequestId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastWithdrawRequestId", wireType)
+			}
+			m.LastWithdrawRequestId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastWithdrawRequestId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Disabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Disabled = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressVersion", wireType)
+			}
+			m.AddressVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AddressVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxReserveAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxReserveAmount = append(m.MaxReserveAmount, types.Coin{})
+			if err := m.MaxReserveAmount[len(m.MaxReserveAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DepositRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DepositRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DepositRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgHeight", wireType)
+			}
+			m.MsgHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MsgHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DepositCoins = append(m.DepositCoins, types.Coin{})
+			if err := m.DepositCoins[len(m.DepositCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptedCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AcceptedCoins = append(m.AcceptedCoins, types.Coin{})
+			if err := m.AcceptedCoins[len(m.AcceptedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintedPoolCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MintedPoolCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= RequestStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SingleAsset", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SingleAsset = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Farm", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Farm = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WithdrawRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WithdrawRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WithdrawRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgHeight", wireType)
+			}
+			m.MsgHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MsgHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Withdrawer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PoolCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawnCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawnCoins = append(m.WithdrawnCoins, types.Coin{})
+			if err := m.WithdrawnCoins[len(m.WithdrawnCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= RequestStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Order) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
 				return ErrIntOverflowLiquidity
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Order: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Order: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= OrderType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
+			}
+			m.PairId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PairId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgHeight", wireType)
+			}
+			m.MsgHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MsgHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orderer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Orderer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Direction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Direction |= OrderDirection(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OfferCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OfferCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingOfferCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemainingOfferCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceivedCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReceivedCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OpenAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchId", wireType)
 			}
-			m.BatchSize = 0
+			m.BatchId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -1746,16 +7387,49 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BatchSize |= uint32(b&0x7F) << shift
+				m.BatchId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpireAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ExpireAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TickPrecision", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			m.TickPrecision = 0
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -1765,14 +7439,14 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TickPrecision |= uint32(b&0x7F) << shift
+				m.Status |= OrderStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeCollectorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFillAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1800,11 +7474,65 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeeCollectorAddress = string(dAtA[iNdEx:postIndex])
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.MinFillAmount = &v
+			if err := m.MinFillAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MMOrderIndex) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MMOrderIndex: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MMOrderIndex: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DustCollectorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Orderer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1832,13 +7560,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DustCollectorAddress = string(dAtA[iNdEx:postIndex])
+			m.Orderer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinInitialPoolCoinSupply", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
 			}
-			var stringLen uint64
+			m.PairId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -1848,31 +7576,161 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PairId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLiquidity
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowLiquidity
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.OrderIds = append(m.OrderIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowLiquidity
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthLiquidity
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthLiquidity
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.OrderIds) == 0 {
+					m.OrderIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowLiquidity
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.OrderIds = append(m.OrderIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderIds", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCancelHeight", wireType)
+			}
+			m.AutoCancelHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoCancelHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthLiquidity
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinInitialPoolCoinSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccruedSwapFees) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PairCreationFee", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccruedSwapFees: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccruedSwapFees: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
 			}
-			var msglen int
+			m.PairId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -1882,29 +7740,14 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PairId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthLiquidity
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthLiquidity
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PairCreationFee = append(m.PairCreationFee, types.Coin{})
-			if err := m.PairCreationFee[len(m.PairCreationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolCreationFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1931,50 +7774,66 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PoolCreationFee = append(m.PoolCreationFee, types.Coin{})
-			if err := m.PoolCreationFee[len(m.PoolCreationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Fees = append(m.Fees, types.Coin{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinInitialDepositAmount", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLiquidity
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidity(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthLiquidity
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLiquidity
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceHistoryEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidity
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinInitialDepositAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceLimitRatio", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceHistoryEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
 			}
-			var stringLen uint64
+			m.PairId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -1984,31 +7843,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PairId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLiquidity
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLiquidity
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.MaxPriceLimitRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 10:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxNumMarketMakingOrderTicks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			m.MaxNumMarketMakingOrderTicks = 0
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -2018,14 +7862,14 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxNumMarketMakingOrderTicks |= uint32(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxOrderLifespan", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2052,13 +7896,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MaxOrderLifespan, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SwapFeeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2086,68 +7930,65 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SwapFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawFeeRate", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLiquidity
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLiquidity
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidity(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthLiquidity
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.WithdrawFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Vault) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidity
 			}
-			iNdEx = postIndex
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DepositExtraGas", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.DepositExtraGas = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLiquidity
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DepositExtraGas |= github_com_cosmos_cosmos_sdk_types.Gas(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 15:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Vault: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Vault: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawExtraGas", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.WithdrawExtraGas = 0
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -2157,16 +7998,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.WithdrawExtraGas |= github_com_cosmos_cosmos_sdk_types.Gas(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 16:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderExtraGas", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
 			}
-			m.OrderExtraGas = 0
+			m.PairId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -2176,16 +8017,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OrderExtraGas |= github_com_cosmos_cosmos_sdk_types.Gas(b&0x7F) << shift
+				m.PairId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 17:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxNumActivePoolsPerPair", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
-			m.MaxNumActivePoolsPerPair = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -2195,66 +8036,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxNumActivePoolsPerPair |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipLiquidity(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthLiquidity
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Pair) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowLiquidity
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Pair: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Pair: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveAddress", wireType)
 			}
-			m.Id = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -2264,14 +8068,27 @@ func (m *Pair) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReserveAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseCoinDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2299,11 +8116,11 @@ func (m *Pair) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BaseCoinDenom = string(dAtA[iNdEx:postIndex])
+			m.ShareDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QuoteCoinDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceDeviation", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2331,11 +8148,13 @@ func (m *Pair) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.QuoteCoinDenom = string(dAtA[iNdEx:postIndex])
+			if err := m.MaxPriceDeviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EscrowAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorFeeRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2363,13 +8182,15 @@ func (m *Pair) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EscrowAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.OperatorFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastOrderId", wireType)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPrice", wireType)
 			}
-			m.LastOrderId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -2379,14 +8200,31 @@ func (m *Pair) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastOrderId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.MinPrice = &v
+			if err := m.MinPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2415,16 +8253,16 @@ func (m *Pair) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			var v github_com_cosmos_cosmos_sdk_types.Dec
-			m.LastPrice = &v
-			if err := m.LastPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.MaxPrice = &v
+			if err := m.MaxPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentBatchId", wireType)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HighWaterMark", wireType)
 			}
-			m.CurrentBatchId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -2434,11 +8272,26 @@ func (m *Pair) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CurrentBatchId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.HighWaterMark.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLiquidity(dAtA[iNdEx:])
@@ -2460,7 +8313,7 @@ func (m *Pair) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Pool) Unmarshal(dAtA []byte) error {
+func (m *RequestResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2483,10 +8336,10 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Pool: wiretype end group for non-group")
+			return fmt.Errorf("proto: RequestResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RequestResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2503,16 +8356,16 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= PoolType(b&0x7F) << shift
+				m.Type |= RequestType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetId", wireType)
 			}
-			m.Id = 0
+			m.TargetId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -2522,16 +8375,16 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.TargetId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.PairId = 0
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -2541,14 +8394,14 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PairId |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Requester", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2576,77 +8429,13 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Requester = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReserveAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLiquidity
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLiquidity
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLiquidity
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ReserveAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolCoinDenom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLiquidity
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLiquidity
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLiquidity
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PoolCoinDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinPrice", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
 			}
-			var stringLen uint64
+			m.Code = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -2654,33 +8443,16 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLiquidity
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLiquidity
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			var v github_com_cosmos_cosmos_sdk_types.Dec
-			m.MinPrice = &v
-			if err := m.MinPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= RequestResultCode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 8:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2708,17 +8480,13 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_cosmos_cosmos_sdk_types.Dec
-			m.MaxPrice = &v
-			if err := m.MaxPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastDepositRequestId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishedHeight", wireType)
 			}
-			m.LastDepositRequestId = 0
+			m.FinishedHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -2728,16 +8496,16 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastDepositRequestId |= uint64(b&0x7F) << shift
+				m.FinishedHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastWithdrawRequestId", wireType)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
 			}
-			m.LastWithdrawRequestId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -2747,31 +8515,25 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastWithdrawRequestId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Disabled", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLiquidity
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
 			}
-			m.Disabled = bool(v != 0)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.FinishedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLiquidity(dAtA[iNdEx:])
@@ -2793,7 +8555,7 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DepositRequest) Unmarshal(dAtA []byte) error {
+func (m *BatchResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2816,17 +8578,17 @@ func (m *DepositRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DepositRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: BatchResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DepositRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BatchResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
 			}
-			m.Id = 0
+			m.PairId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -2836,16 +8598,16 @@ func (m *DepositRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.PairId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchId", wireType)
 			}
-			m.PoolId = 0
+			m.BatchId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -2855,16 +8617,16 @@ func (m *DepositRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				m.BatchId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Matched", wireType)
 			}
-			m.MsgHeight = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -2874,14 +8636,15 @@ func (m *DepositRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MsgHeight |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Matched = bool(v != 0)
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2909,13 +8672,17 @@ func (m *DepositRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Depositor = string(dAtA[iNdEx:postIndex])
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.MatchPrice = &v
+			if err := m.MatchPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DepositCoins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchedBaseAmount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -2925,31 +8692,31 @@ func (m *DepositRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthLiquidity
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthLiquidity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DepositCoins = append(m.DepositCoins, types.Coin{})
-			if err := m.DepositCoins[len(m.DepositCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MatchedBaseAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AcceptedCoins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchedQuoteAmount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -2959,31 +8726,31 @@ func (m *DepositRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthLiquidity
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthLiquidity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AcceptedCoins = append(m.AcceptedCoins, types.Coin{})
-			if err := m.AcceptedCoins[len(m.AcceptedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MatchedQuoteAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintedPoolCoin", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumFullyMatchedOrders", wireType)
 			}
-			var msglen int
+			m.NumFullyMatchedOrders = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -2993,30 +8760,54 @@ func (m *DepositRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.NumFullyMatchedOrders |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthLiquidity
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumPartiallyMatchedOrders", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthLiquidity
+			m.NumPartiallyMatchedOrders = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumPartiallyMatchedOrders |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedHeight", wireType)
 			}
-			if err := m.MintedPoolCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ExecutedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedAt", wireType)
 			}
-			m.Status = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -3026,11 +8817,25 @@ func (m *DepositRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= RequestStatus(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ExecutedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLiquidity(dAtA[iNdEx:])
@@ -3052,7 +8857,7 @@ func (m *DepositRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WithdrawRequest) Unmarshal(dAtA []byte) error {
+func (m *SwapRoute) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3075,10 +8880,10 @@ func (m *WithdrawRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WithdrawRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SwapRoute: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WithdrawRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SwapRoute: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3101,10 +8906,10 @@ func (m *WithdrawRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orderer", wireType)
 			}
-			m.PoolId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -3114,16 +8919,105 @@ func (m *WithdrawRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgHeight", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Orderer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowLiquidity
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PairIds = append(m.PairIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowLiquidity
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthLiquidity
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthLiquidity
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PairIds) == 0 {
+					m.PairIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowLiquidity
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PairIds = append(m.PairIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairIds", wireType)
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OfferCoin", wireType)
 			}
-			m.MsgHeight = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -3133,16 +9027,30 @@ func (m *WithdrawRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MsgHeight |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OfferCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinOutCoin", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -3152,27 +9060,28 @@ func (m *WithdrawRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLiquidity
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLiquidity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Withdrawer = string(dAtA[iNdEx:postIndex])
+			if err := m.MinOutCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolCoin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderLifespan", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3199,15 +9108,15 @@ func (m *WithdrawRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PoolCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.OrderLifespan, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawnCoins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -3217,31 +9126,29 @@ func (m *WithdrawRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthLiquidity
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthLiquidity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WithdrawnCoins = append(m.WithdrawnCoins, types.Coin{})
-			if err := m.WithdrawnCoins[len(m.WithdrawnCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.EscrowAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hop", wireType)
 			}
-			m.Status = 0
+			m.Hop = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -3251,11 +9158,62 @@ func (m *WithdrawRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= RequestStatus(b&0x7F) << shift
+				m.Hop |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLiquidity(dAtA[iNdEx:])
@@ -3277,7 +9235,7 @@ func (m *WithdrawRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Order) Unmarshal(dAtA []byte) error {
+func (m *OrderCommit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3300,17 +9258,17 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Order: wiretype end group for non-group")
+			return fmt.Errorf("proto: OrderCommit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Order: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OrderCommit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
 			}
-			m.Type = 0
+			m.PairId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -3320,16 +9278,16 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= OrderType(b&0x7F) << shift
+				m.PairId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orderer", wireType)
 			}
-			m.Id = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -3339,16 +9297,29 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Orderer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
-			m.PairId = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -3358,16 +9329,31 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PairId |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchId", wireType)
 			}
-			m.MsgHeight = 0
+			m.BatchId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -3377,48 +9363,16 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MsgHeight |= int64(b&0x7F) << shift
+				m.BatchId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Orderer", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLiquidity
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLiquidity
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLiquidity
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Orderer = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRevealBatchId", wireType)
 			}
-			m.Direction = 0
+			m.LastRevealBatchId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -3428,14 +9382,14 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Direction |= OrderDirection(b&0x7F) << shift
+				m.LastRevealBatchId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OfferCoin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bond", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3462,48 +9416,66 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OfferCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Bond = append(m.Bond, types.Coin{})
+			if err := m.Bond[len(m.Bond)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemainingOfferCoin", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLiquidity
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidity(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthLiquidity
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthLiquidity
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PairStatsBucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidity
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RemainingOfferCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReceivedCoin", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PairStatsBucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PairStatsBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
 			}
-			var msglen int
+			m.PairId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -3513,30 +9485,16 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PairId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthLiquidity
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthLiquidity
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ReceivedCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 10:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -3546,29 +9504,28 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLiquidity
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLiquidity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseVolume", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3596,13 +9553,13 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BaseVolume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OpenAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteVolume", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3630,15 +9587,15 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OpenAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.QuoteVolume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NumMatchedOrders", wireType)
 			}
-			m.BatchId = 0
+			m.NumMatchedOrders = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -3648,16 +9605,16 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BatchId |= uint64(b&0x7F) << shift
+				m.NumMatchedOrders |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 14:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpireAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HighPrice", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -3667,97 +9624,29 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthLiquidity
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthLiquidity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ExpireAt, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.HighPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 15:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLiquidity
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= OrderStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipLiquidity(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthLiquidity
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MMOrderIndex) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowLiquidity
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MMOrderIndex: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MMOrderIndex: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Orderer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LowPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3785,13 +9674,15 @@ func (m *MMOrderIndex) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Orderer = string(dAtA[iNdEx:postIndex])
+			if err := m.LowPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFees", wireType)
 			}
-			m.PairId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -3801,87 +9692,26 @@ func (m *MMOrderIndex) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PairId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowLiquidity
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.OrderIds = append(m.OrderIds, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowLiquidity
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthLiquidity
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthLiquidity
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.OrderIds) == 0 {
-					m.OrderIds = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowLiquidity
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.OrderIds = append(m.OrderIds, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderIds", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SwapFees = append(m.SwapFees, types.Coin{})
+			if err := m.SwapFees[len(m.SwapFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLiquidity(dAtA[iNdEx:])
@@ -14,6 +14,7 @@ var (
 	_ sdk.Msg = (*MsgCreatePool)(nil)
 	_ sdk.Msg = (*MsgCreateRangedPool)(nil)
 	_ sdk.Msg = (*MsgDeposit)(nil)
+	_ sdk.Msg = (*MsgDepositSingleAsset)(nil)
 	_ sdk.Msg = (*MsgWithdraw)(nil)
 	_ sdk.Msg = (*MsgLimitOrder)(nil)
 	_ sdk.Msg = (*MsgMarketOrder)(nil)
@@ -21,21 +22,44 @@ var (
 	_ sdk.Msg = (*MsgCancelOrder)(nil)
 	_ sdk.Msg = (*MsgCancelAllOrders)(nil)
 	_ sdk.Msg = (*MsgCancelMMOrder)(nil)
+	_ sdk.Msg = (*MsgPruneExpired)(nil)
+	_ sdk.Msg = (*MsgSetPairMetadata)(nil)
+	_ sdk.Msg = (*MsgCreateVault)(nil)
+	_ sdk.Msg = (*MsgDepositVault)(nil)
+	_ sdk.Msg = (*MsgWithdrawVault)(nil)
+	_ sdk.Msg = (*MsgRebalanceVault)(nil)
+	_ sdk.Msg = (*MsgRenewOrder)(nil)
+	_ sdk.Msg = (*MsgSwapExactIn)(nil)
+	_ sdk.Msg = (*MsgCommitOrder)(nil)
+	_ sdk.Msg = (*MsgRevealOrder)(nil)
+	_ sdk.Msg = (*MsgAmendOrder)(nil)
 )
 
 // Message types for the liquidity module
 const (
-	TypeMsgCreatePair       = "create_pair"
-	TypeMsgCreatePool       = "create_pool"
-	TypeMsgCreateRangedPool = "create_ranged_pool"
-	TypeMsgDeposit          = "deposit"
-	TypeMsgWithdraw         = "withdraw"
-	TypeMsgLimitOrder       = "limit_order"
-	TypeMsgMarketOrder      = "market_order"
-	TypeMsgMMOrder          = "mm_order"
-	TypeMsgCancelOrder      = "cancel_order"
-	TypeMsgCancelAllOrders  = "cancel_all_orders"
-	TypeMsgCancelMMOrder    = "cancel_mm_order"
+	TypeMsgCreatePair         = "create_pair"
+	TypeMsgCreatePool         = "create_pool"
+	TypeMsgCreateRangedPool   = "create_ranged_pool"
+	TypeMsgDeposit            = "deposit"
+	TypeMsgDepositSingleAsset = "deposit_single_asset"
+	TypeMsgWithdraw           = "withdraw"
+	TypeMsgLimitOrder         = "limit_order"
+	TypeMsgMarketOrder        = "market_order"
+	TypeMsgMMOrder            = "mm_order"
+	TypeMsgCancelOrder        = "cancel_order"
+	TypeMsgCancelAllOrders    = "cancel_all_orders"
+	TypeMsgCancelMMOrder      = "cancel_mm_order"
+	TypeMsgPruneExpired       = "prune_expired"
+	TypeMsgSetPairMetadata    = "set_pair_metadata"
+	TypeMsgCreateVault        = "create_vault"
+	TypeMsgDepositVault       = "deposit_vault"
+	TypeMsgWithdrawVault      = "withdraw_vault"
+	TypeMsgRebalanceVault     = "rebalance_vault"
+	TypeMsgRenewOrder         = "renew_order"
+	TypeMsgSwapExactIn        = "swap_exact_in"
+	TypeMsgCommitOrder        = "commit_order"
+	TypeMsgRevealOrder        = "reveal_order"
+	TypeMsgAmendOrder         = "amend_order"
 )
 
 // NewMsgCreatePair returns a new MsgCreatePair.
@@ -265,6 +289,59 @@ func (msg MsgDeposit) GetDepositor() sdk.AccAddress {
 	return addr
 }
 
+// NewMsgDepositSingleAsset creates a new MsgDepositSingleAsset.
+func NewMsgDepositSingleAsset(
+	depositor sdk.AccAddress,
+	poolId uint64,
+	depositCoin sdk.Coin,
+) *MsgDepositSingleAsset {
+	return &MsgDepositSingleAsset{
+		Depositor:   depositor.String(),
+		PoolId:      poolId,
+		DepositCoin: depositCoin,
+	}
+}
+
+func (msg MsgDepositSingleAsset) Route() string { return RouterKey }
+
+func (msg MsgDepositSingleAsset) Type() string { return TypeMsgDepositSingleAsset }
+
+func (msg MsgDepositSingleAsset) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Depositor); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid depositor address: %v", err)
+	}
+	if msg.PoolId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "pool id must not be 0")
+	}
+	if err := msg.DepositCoin.Validate(); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid deposit coin: %v", err)
+	}
+	if !msg.DepositCoin.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "deposit coin must be positive: %s", msg.DepositCoin)
+	}
+	return nil
+}
+
+func (msg MsgDepositSingleAsset) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgDepositSingleAsset) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Depositor)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgDepositSingleAsset) GetDepositor() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Depositor)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
 // NewMsgWithdraw creates a new MsgWithdraw.
 func NewMsgWithdraw(
 	withdrawer sdk.AccAddress,
@@ -379,7 +456,11 @@ func (msg MsgLimitOrder) ValidateBasic() error {
 	var minOfferCoin sdk.Coin
 	switch msg.Direction {
 	case OrderDirectionBuy:
-		minOfferCoin = sdk.NewCoin(msg.OfferCoin.Denom, amm.OfferCoinAmount(amm.Buy, msg.Price, msg.Amount))
+		offerCoinAmt, err := amm.SafeOfferCoinAmount(amm.Buy, msg.Price, msg.Amount)
+		if err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "offer coin amount overflows")
+		}
+		minOfferCoin = sdk.NewCoin(msg.OfferCoin.Denom, offerCoinAmt)
 	case OrderDirectionSell:
 		minOfferCoin = sdk.NewCoin(msg.OfferCoin.Denom, msg.Amount)
 	}
@@ -392,6 +473,14 @@ func (msg MsgLimitOrder) ValidateBasic() error {
 	if msg.OrderLifespan < 0 {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "order lifespan must not be negative: %s", msg.OrderLifespan)
 	}
+	if msg.MinFillAmount != nil {
+		if !msg.MinFillAmount.IsPositive() {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "min fill amount must be positive: %s", msg.MinFillAmount)
+		}
+		if msg.MinFillAmount.GT(msg.Amount) {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "min fill amount %s must not be greater than the order amount %s", msg.MinFillAmount, msg.Amount)
+		}
+	}
 	return nil
 }
 
@@ -732,3 +821,583 @@ func (msg MsgCancelMMOrder) GetOrderer() sdk.AccAddress {
 	}
 	return addr
 }
+
+// NewMsgPruneExpired creates a new MsgPruneExpired.
+func NewMsgPruneExpired(pruner sdk.AccAddress) *MsgPruneExpired {
+	return &MsgPruneExpired{
+		Pruner: pruner.String(),
+	}
+}
+
+func (msg MsgPruneExpired) Route() string { return RouterKey }
+
+func (msg MsgPruneExpired) Type() string { return TypeMsgPruneExpired }
+
+func (msg MsgPruneExpired) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Pruner); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid pruner address: %v", err)
+	}
+	return nil
+}
+
+func (msg MsgPruneExpired) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgPruneExpired) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Pruner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgPruneExpired) GetPruner() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Pruner)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// NewMsgSetPairMetadata creates a new MsgSetPairMetadata.
+func NewMsgSetPairMetadata(
+	creator sdk.AccAddress,
+	pairId uint64,
+	metadata PairMetadata,
+) *MsgSetPairMetadata {
+	return &MsgSetPairMetadata{
+		Creator:  creator.String(),
+		PairId:   pairId,
+		Metadata: metadata,
+	}
+}
+
+func (msg MsgSetPairMetadata) Route() string { return RouterKey }
+
+func (msg MsgSetPairMetadata) Type() string { return TypeMsgSetPairMetadata }
+
+func (msg MsgSetPairMetadata) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address: %v", err)
+	}
+	if msg.PairId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "pair id must not be 0")
+	}
+	if err := msg.Metadata.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return nil
+}
+
+func (msg MsgSetPairMetadata) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgSetPairMetadata) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgSetPairMetadata) GetCreator() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// NewMsgCreateVault returns a new MsgCreateVault.
+func NewMsgCreateVault(
+	operator sdk.AccAddress,
+	pairId uint64,
+	maxPriceDeviation sdk.Dec,
+	operatorFeeRate sdk.Dec,
+) *MsgCreateVault {
+	return &MsgCreateVault{
+		Operator:          operator.String(),
+		PairId:            pairId,
+		MaxPriceDeviation: maxPriceDeviation,
+		OperatorFeeRate:   operatorFeeRate,
+	}
+}
+
+func (msg MsgCreateVault) Route() string { return RouterKey }
+
+func (msg MsgCreateVault) Type() string { return TypeMsgCreateVault }
+
+func (msg MsgCreateVault) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Operator); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid operator address: %v", err)
+	}
+	if msg.PairId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "pair id must not be 0")
+	}
+	if err := ValidateVaultStrategy(msg.MaxPriceDeviation, msg.OperatorFeeRate); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return nil
+}
+
+func (msg MsgCreateVault) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCreateVault) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgCreateVault) GetOperator() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// NewMsgDepositVault returns a new MsgDepositVault.
+func NewMsgDepositVault(
+	depositor sdk.AccAddress,
+	vaultId uint64,
+	depositCoins sdk.Coins,
+) *MsgDepositVault {
+	return &MsgDepositVault{
+		Depositor:    depositor.String(),
+		VaultId:      vaultId,
+		DepositCoins: depositCoins,
+	}
+}
+
+func (msg MsgDepositVault) Route() string { return RouterKey }
+
+func (msg MsgDepositVault) Type() string { return TypeMsgDepositVault }
+
+func (msg MsgDepositVault) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Depositor); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid depositor address: %v", err)
+	}
+	if msg.VaultId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "vault id must not be 0")
+	}
+	if err := msg.DepositCoins.Validate(); err != nil {
+		return err
+	}
+	if len(msg.DepositCoins) == 0 || len(msg.DepositCoins) > 2 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "wrong number of deposit coins: %d", len(msg.DepositCoins))
+	}
+	return nil
+}
+
+func (msg MsgDepositVault) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgDepositVault) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Depositor)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgDepositVault) GetDepositor() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Depositor)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// NewMsgWithdrawVault returns a new MsgWithdrawVault.
+func NewMsgWithdrawVault(
+	withdrawer sdk.AccAddress,
+	vaultId uint64,
+	share sdk.Coin,
+) *MsgWithdrawVault {
+	return &MsgWithdrawVault{
+		Withdrawer: withdrawer.String(),
+		VaultId:    vaultId,
+		Share:      share,
+	}
+}
+
+func (msg MsgWithdrawVault) Route() string { return RouterKey }
+
+func (msg MsgWithdrawVault) Type() string { return TypeMsgWithdrawVault }
+
+func (msg MsgWithdrawVault) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Withdrawer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid withdrawer address: %v", err)
+	}
+	if msg.VaultId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "vault id must not be 0")
+	}
+	if err := msg.Share.Validate(); err != nil {
+		return err
+	}
+	if !msg.Share.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "share must be positive")
+	}
+	return nil
+}
+
+func (msg MsgWithdrawVault) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgWithdrawVault) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Withdrawer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgWithdrawVault) GetWithdrawer() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Withdrawer)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// NewMsgRebalanceVault returns a new MsgRebalanceVault.
+func NewMsgRebalanceVault(
+	operator sdk.AccAddress,
+	vaultId uint64,
+	minPrice, maxPrice sdk.Dec,
+) *MsgRebalanceVault {
+	return &MsgRebalanceVault{
+		Operator: operator.String(),
+		VaultId:  vaultId,
+		MinPrice: minPrice,
+		MaxPrice: maxPrice,
+	}
+}
+
+func (msg MsgRebalanceVault) Route() string { return RouterKey }
+
+func (msg MsgRebalanceVault) Type() string { return TypeMsgRebalanceVault }
+
+func (msg MsgRebalanceVault) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Operator); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid operator address: %v", err)
+	}
+	if msg.VaultId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "vault id must not be 0")
+	}
+	if !msg.MinPrice.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "min price must be positive: %s", msg.MinPrice)
+	}
+	if !msg.MaxPrice.GT(msg.MinPrice) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "max price must be higher than min price")
+	}
+	return nil
+}
+
+func (msg MsgRebalanceVault) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgRebalanceVault) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgRebalanceVault) GetOperator() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// NewMsgRenewOrder creates a new MsgRenewOrder.
+func NewMsgRenewOrder(
+	orderer sdk.AccAddress,
+	pairId uint64,
+	orderId uint64,
+	orderLifespan time.Duration,
+) *MsgRenewOrder {
+	return &MsgRenewOrder{
+		Orderer:       orderer.String(),
+		PairId:        pairId,
+		OrderId:       orderId,
+		OrderLifespan: orderLifespan,
+	}
+}
+
+func (msg MsgRenewOrder) Route() string { return RouterKey }
+
+func (msg MsgRenewOrder) Type() string { return TypeMsgRenewOrder }
+
+func (msg MsgRenewOrder) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Orderer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid orderer address: %v", err)
+	}
+	if msg.PairId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "pair id must not be 0")
+	}
+	if msg.OrderId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "order id must not be 0")
+	}
+	if msg.OrderLifespan <= 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "order lifespan must be positive: %s", msg.OrderLifespan)
+	}
+	return nil
+}
+
+func (msg MsgRenewOrder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgRenewOrder) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Orderer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgRenewOrder) GetOrderer() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Orderer)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// NewMsgSwapExactIn creates a new MsgSwapExactIn.
+func NewMsgSwapExactIn(
+	orderer sdk.AccAddress,
+	pairIds []uint64,
+	offerCoin sdk.Coin,
+	minOutCoin sdk.Coin,
+	orderLifespan time.Duration,
+) *MsgSwapExactIn {
+	return &MsgSwapExactIn{
+		Orderer:       orderer.String(),
+		PairIds:       pairIds,
+		OfferCoin:     offerCoin,
+		MinOutCoin:    minOutCoin,
+		OrderLifespan: orderLifespan,
+	}
+}
+
+func (msg MsgSwapExactIn) Route() string { return RouterKey }
+
+func (msg MsgSwapExactIn) Type() string { return TypeMsgSwapExactIn }
+
+func (msg MsgSwapExactIn) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Orderer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid orderer address: %v", err)
+	}
+	if len(msg.PairIds) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "pair ids must not be empty")
+	}
+	if len(msg.PairIds) > MaxSwapRouteLength {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "number of pairs must not exceed %d", MaxSwapRouteLength)
+	}
+	for _, pairId := range msg.PairIds {
+		if pairId == 0 {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "pair id must not be 0")
+		}
+	}
+	if err := msg.OfferCoin.Validate(); err != nil {
+		return sdkerrors.Wrap(err, "invalid offer coin")
+	}
+	if msg.OfferCoin.Amount.LT(amm.MinCoinAmount) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "offer coin %s is smaller than the min amount %s", msg.OfferCoin, amm.MinCoinAmount)
+	}
+	if msg.OfferCoin.Amount.GT(amm.MaxCoinAmount) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "offer coin %s is bigger than the max amount %s", msg.OfferCoin, amm.MaxCoinAmount)
+	}
+	if err := msg.MinOutCoin.Validate(); err != nil {
+		return sdkerrors.Wrap(err, "invalid min out coin")
+	}
+	if !msg.MinOutCoin.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "min out coin must be positive: %s", msg.MinOutCoin)
+	}
+	if msg.OrderLifespan < 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "order lifespan must not be negative: %s", msg.OrderLifespan)
+	}
+	return nil
+}
+
+func (msg MsgSwapExactIn) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgSwapExactIn) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Orderer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgSwapExactIn) GetOrderer() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Orderer)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// NewMsgCommitOrder creates a new MsgCommitOrder.
+func NewMsgCommitOrder(orderer sdk.AccAddress, pairId uint64, hash []byte) *MsgCommitOrder {
+	return &MsgCommitOrder{
+		Orderer: orderer.String(),
+		PairId:  pairId,
+		Hash:    hash,
+	}
+}
+
+func (msg MsgCommitOrder) Route() string { return RouterKey }
+
+func (msg MsgCommitOrder) Type() string { return TypeMsgCommitOrder }
+
+func (msg MsgCommitOrder) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Orderer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid orderer address: %v", err)
+	}
+	if msg.PairId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "pair id must not be 0")
+	}
+	if len(msg.Hash) != OrderCommitHashLength {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "hash length must be %d: %d", OrderCommitHashLength, len(msg.Hash))
+	}
+	return nil
+}
+
+func (msg MsgCommitOrder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCommitOrder) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Orderer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgCommitOrder) GetOrderer() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Orderer)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// NewMsgRevealOrder creates a new MsgRevealOrder.
+func NewMsgRevealOrder(order MsgLimitOrder, salt string) *MsgRevealOrder {
+	return &MsgRevealOrder{
+		Order: order,
+		Salt:  salt,
+	}
+}
+
+func (msg MsgRevealOrder) Route() string { return RouterKey }
+
+func (msg MsgRevealOrder) Type() string { return TypeMsgRevealOrder }
+
+func (msg MsgRevealOrder) ValidateBasic() error {
+	if err := msg.Order.ValidateBasic(); err != nil {
+		return err
+	}
+	if msg.Salt == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "salt must not be empty")
+	}
+	return nil
+}
+
+func (msg MsgRevealOrder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgRevealOrder) GetSigners() []sdk.AccAddress {
+	return msg.Order.GetSigners()
+}
+
+func (msg MsgRevealOrder) GetOrderer() sdk.AccAddress {
+	return msg.Order.GetOrderer()
+}
+
+// Hash returns the hash the revealed order was committed with.
+func (msg MsgRevealOrder) Hash() []byte {
+	return OrderCommitHash(msg.Order, msg.Salt)
+}
+
+// NewMsgAmendOrder creates a new MsgAmendOrder.
+func NewMsgAmendOrder(
+	orderer sdk.AccAddress,
+	pairId uint64,
+	orderId uint64,
+	price sdk.Dec,
+	amt sdk.Int,
+) *MsgAmendOrder {
+	return &MsgAmendOrder{
+		Orderer: orderer.String(),
+		PairId:  pairId,
+		OrderId: orderId,
+		Price:   price,
+		Amount:  amt,
+	}
+}
+
+func (msg MsgAmendOrder) Route() string { return RouterKey }
+
+func (msg MsgAmendOrder) Type() string { return TypeMsgAmendOrder }
+
+func (msg MsgAmendOrder) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Orderer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid orderer address: %v", err)
+	}
+	if msg.PairId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "pair id must not be 0")
+	}
+	if msg.OrderId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "order id must not be 0")
+	}
+	if msg.Price.IsNil() || !msg.Price.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "price must be positive")
+	}
+	if msg.Amount.IsNil() || msg.Amount.LT(amm.MinCoinAmount) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "order amount %s is smaller than the min amount %s", msg.Amount, amm.MinCoinAmount)
+	}
+	if msg.Amount.GT(amm.MaxCoinAmount) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "order amount %s is bigger than the max amount %s", msg.Amount, amm.MaxCoinAmount)
+	}
+	return nil
+}
+
+func (msg MsgAmendOrder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgAmendOrder) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Orderer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgAmendOrder) GetOrderer() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Orderer)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
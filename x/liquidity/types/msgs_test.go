@@ -10,6 +10,7 @@ import (
 	"github.com/tendermint/tendermint/crypto"
 
 	utils "github.com/crescent-network/crescent/v4/types"
+	"github.com/crescent-network/crescent/v4/x/liquidity/amm"
 	"github.com/crescent-network/crescent/v4/x/liquidity/types"
 )
 
@@ -319,6 +320,66 @@ func TestMsgDeposit(t *testing.T) {
 	}
 }
 
+func TestMsgDepositSingleAsset(t *testing.T) {
+	testCases := []struct {
+		name        string
+		malleate    func(msg *types.MsgDepositSingleAsset)
+		expectedErr string
+	}{
+		{
+			"happy case",
+			func(msg *types.MsgDepositSingleAsset) {},
+			"", // empty means no error expected
+		},
+		{
+			"invalid depositor",
+			func(msg *types.MsgDepositSingleAsset) {
+				msg.Depositor = "invalidaddr"
+			},
+			"invalid depositor address: decoding bech32 failed: invalid separator index -1: invalid address",
+		},
+		{
+			"invalid pool id",
+			func(msg *types.MsgDepositSingleAsset) {
+				msg.PoolId = 0
+			},
+			"pool id must not be 0: invalid request",
+		},
+		{
+			"invalid deposit coin",
+			func(msg *types.MsgDepositSingleAsset) {
+				msg.DepositCoin = sdk.Coin{Denom: "1", Amount: sdk.NewInt(1000000)}
+			},
+			"invalid deposit coin: invalid denom: 1: invalid request",
+		},
+		{
+			"zero deposit coin",
+			func(msg *types.MsgDepositSingleAsset) {
+				msg.DepositCoin = utils.ParseCoin("0denom1")
+			},
+			"deposit coin must be positive: 0denom1: invalid request",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := types.NewMsgDepositSingleAsset(testAddr, 1, utils.ParseCoin("1000000denom1"))
+			tc.malleate(msg)
+			require.Equal(t, types.TypeMsgDepositSingleAsset, msg.Type())
+			require.Equal(t, types.RouterKey, msg.Route())
+			err := msg.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+				signers := msg.GetSigners()
+				require.Len(t, signers, 1)
+				require.Equal(t, msg.GetDepositor(), signers[0])
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
 func TestMsgWithdraw(t *testing.T) {
 	for _, tc := range []struct {
 		name        string
@@ -426,6 +487,14 @@ func TestMsgLimitOrder(t *testing.T) {
 			},
 			"1000000denom2 is less than 10000000denom2: insufficient offer coin",
 		},
+		{
+			"overflowing offer coin amount",
+			func(msg *types.MsgLimitOrder) {
+				msg.Price = sdk.NewIntWithDecimal(1, 60).ToDec()
+				msg.Amount = amm.MaxCoinAmount
+			},
+			"offer coin amount overflows: invalid request",
+		},
 		{
 			"invalid demand coin denom",
 			func(msg *types.MsgLimitOrder) {
@@ -469,6 +538,30 @@ func TestMsgLimitOrder(t *testing.T) {
 			},
 			"order lifespan must not be negative: -1ns: invalid request",
 		},
+		{
+			"valid min fill amount",
+			func(msg *types.MsgLimitOrder) {
+				minFillAmt := newInt(1000)
+				msg.MinFillAmount = &minFillAmt
+			},
+			"",
+		},
+		{
+			"zero min fill amount",
+			func(msg *types.MsgLimitOrder) {
+				minFillAmt := sdk.ZeroInt()
+				msg.MinFillAmount = &minFillAmt
+			},
+			"min fill amount must be positive: 0: invalid request",
+		},
+		{
+			"too big min fill amount",
+			func(msg *types.MsgLimitOrder) {
+				minFillAmt := newInt(1000001)
+				msg.MinFillAmount = &minFillAmt
+			},
+			"min fill amount 1000001 must not be greater than the order amount 1000000: invalid request",
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			msg := types.NewMsgLimitOrder(
@@ -594,6 +687,88 @@ func TestMsgMarketOrder(t *testing.T) {
 	}
 }
 
+func TestMsgSwapExactIn(t *testing.T) {
+	orderLifespan := 20 * time.Second
+	for _, tc := range []struct {
+		name        string
+		malleate    func(msg *types.MsgSwapExactIn)
+		expectedErr string
+	}{
+		{
+			"happy case",
+			func(msg *types.MsgSwapExactIn) {},
+			"", // empty means no error expected
+		},
+		{
+			"invalid orderer",
+			func(msg *types.MsgSwapExactIn) {
+				msg.Orderer = "invalidaddr"
+			},
+			"invalid orderer address: decoding bech32 failed: invalid separator index -1: invalid address",
+		},
+		{
+			"empty pair ids",
+			func(msg *types.MsgSwapExactIn) {
+				msg.PairIds = nil
+			},
+			"pair ids must not be empty: invalid request",
+		},
+		{
+			"too many pair ids",
+			func(msg *types.MsgSwapExactIn) {
+				msg.PairIds = []uint64{1, 2, 3, 4, 5, 6}
+			},
+			"number of pairs must not exceed 5: invalid request",
+		},
+		{
+			"invalid pair id",
+			func(msg *types.MsgSwapExactIn) {
+				msg.PairIds = []uint64{1, 0}
+			},
+			"pair id must not be 0: invalid request",
+		},
+		{
+			"small offer coin amount",
+			func(msg *types.MsgSwapExactIn) {
+				msg.OfferCoin = utils.ParseCoin("10denom1")
+			},
+			"offer coin 10denom1 is smaller than the min amount 100: invalid request",
+		},
+		{
+			"zero min out coin",
+			func(msg *types.MsgSwapExactIn) {
+				msg.MinOutCoin = utils.ParseCoin("0denom3")
+			},
+			"min out coin must be positive: 0denom3: invalid request",
+		},
+		{
+			"invalid order lifespan",
+			func(msg *types.MsgSwapExactIn) {
+				msg.OrderLifespan = -1
+			},
+			"order lifespan must not be negative: -1ns: invalid request",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := types.NewMsgSwapExactIn(
+				testAddr, []uint64{1, 2}, utils.ParseCoin("1000000denom1"),
+				utils.ParseCoin("900000denom3"), orderLifespan)
+			tc.malleate(msg)
+			require.Equal(t, types.TypeMsgSwapExactIn, msg.Type())
+			require.Equal(t, types.RouterKey, msg.Route())
+			err := msg.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+				signers := msg.GetSigners()
+				require.Len(t, signers, 1)
+				require.Equal(t, msg.GetOrderer(), signers[0])
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
 func TestMsgMMOrder(t *testing.T) {
 	orderLifespan := 20 * time.Second
 	for _, tc := range []struct {
@@ -882,3 +1057,323 @@ func TestMsgCancelMMOrder(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgPruneExpired(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		malleate    func(msg *types.MsgPruneExpired)
+		expectedErr string
+	}{
+		{
+			"happy case",
+			func(msg *types.MsgPruneExpired) {},
+			"", // empty means no error expected
+		},
+		{
+			"invalid pruner",
+			func(msg *types.MsgPruneExpired) {
+				msg.Pruner = "invalidaddr"
+			},
+			"invalid pruner address: decoding bech32 failed: invalid separator index -1: invalid address",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := types.NewMsgPruneExpired(testAddr)
+			tc.malleate(msg)
+			require.Equal(t, types.TypeMsgPruneExpired, msg.Type())
+			require.Equal(t, types.RouterKey, msg.Route())
+			err := msg.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+				signers := msg.GetSigners()
+				require.Len(t, signers, 1)
+				require.Equal(t, msg.GetPruner(), signers[0])
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestMsgSetPairMetadata(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		malleate    func(msg *types.MsgSetPairMetadata)
+		expectedErr string
+	}{
+		{
+			"happy case",
+			func(msg *types.MsgSetPairMetadata) {},
+			"", // empty means no error expected
+		},
+		{
+			"invalid creator",
+			func(msg *types.MsgSetPairMetadata) {
+				msg.Creator = "invalidaddr"
+			},
+			"invalid creator address: decoding bech32 failed: invalid separator index -1: invalid address",
+		},
+		{
+			"invalid pair id",
+			func(msg *types.MsgSetPairMetadata) {
+				msg.PairId = 0
+			},
+			"pair id must not be 0: invalid request",
+		},
+		{
+			"invalid metadata",
+			func(msg *types.MsgSetPairMetadata) {
+				msg.Metadata.DisplayName = ""
+			},
+			"display name must not be empty: invalid request",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := types.NewMsgSetPairMetadata(testAddr, 1, types.NewPairMetadata("DENOM1/DENOM2", "", 6, 6))
+			tc.malleate(msg)
+			require.Equal(t, types.TypeMsgSetPairMetadata, msg.Type())
+			require.Equal(t, types.RouterKey, msg.Route())
+			err := msg.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+				signers := msg.GetSigners()
+				require.Len(t, signers, 1)
+				require.Equal(t, msg.GetCreator(), signers[0])
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestMsgRenewOrder(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		malleate    func(msg *types.MsgRenewOrder)
+		expectedErr string
+	}{
+		{
+			"happy case",
+			func(msg *types.MsgRenewOrder) {},
+			"", // empty means no error expected
+		},
+		{
+			"invalid orderer",
+			func(msg *types.MsgRenewOrder) {
+				msg.Orderer = "invalidaddr"
+			},
+			"invalid orderer address: decoding bech32 failed: invalid separator index -1: invalid address",
+		},
+		{
+			"invalid pair id",
+			func(msg *types.MsgRenewOrder) {
+				msg.PairId = 0
+			},
+			"pair id must not be 0: invalid request",
+		},
+		{
+			"invalid order id",
+			func(msg *types.MsgRenewOrder) {
+				msg.OrderId = 0
+			},
+			"order id must not be 0: invalid request",
+		},
+		{
+			"zero order lifespan",
+			func(msg *types.MsgRenewOrder) {
+				msg.OrderLifespan = 0
+			},
+			"order lifespan must be positive: 0s: invalid request",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := types.NewMsgRenewOrder(testAddr, 1, 1, time.Hour)
+			tc.malleate(msg)
+			require.Equal(t, types.TypeMsgRenewOrder, msg.Type())
+			require.Equal(t, types.RouterKey, msg.Route())
+			err := msg.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+				signers := msg.GetSigners()
+				require.Len(t, signers, 1)
+				require.Equal(t, msg.GetOrderer(), signers[0])
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestMsgCommitOrder(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		malleate    func(msg *types.MsgCommitOrder)
+		expectedErr string
+	}{
+		{
+			"happy case",
+			func(msg *types.MsgCommitOrder) {},
+			"", // empty means no error expected
+		},
+		{
+			"invalid orderer",
+			func(msg *types.MsgCommitOrder) {
+				msg.Orderer = "invalidaddr"
+			},
+			"invalid orderer address: decoding bech32 failed: invalid separator index -1: invalid address",
+		},
+		{
+			"invalid pair id",
+			func(msg *types.MsgCommitOrder) {
+				msg.PairId = 0
+			},
+			"pair id must not be 0: invalid request",
+		},
+		{
+			"invalid hash",
+			func(msg *types.MsgCommitOrder) {
+				msg.Hash = []byte("hash")
+			},
+			"hash length must be 32: 4: invalid request",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			order := types.NewMsgLimitOrder(
+				testAddr, 1, types.OrderDirectionBuy, utils.ParseCoin("1000000denom2"),
+				"denom1", utils.ParseDec("1.0"), sdk.NewInt(1000000), time.Hour)
+			msg := types.NewMsgCommitOrder(testAddr, 1, types.OrderCommitHash(*order, "salt"))
+			tc.malleate(msg)
+			require.Equal(t, types.TypeMsgCommitOrder, msg.Type())
+			require.Equal(t, types.RouterKey, msg.Route())
+			err := msg.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+				signers := msg.GetSigners()
+				require.Len(t, signers, 1)
+				require.Equal(t, msg.GetOrderer(), signers[0])
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestMsgRevealOrder(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		malleate    func(msg *types.MsgRevealOrder)
+		expectedErr string
+	}{
+		{
+			"happy case",
+			func(msg *types.MsgRevealOrder) {},
+			"", // empty means no error expected
+		},
+		{
+			"invalid order",
+			func(msg *types.MsgRevealOrder) {
+				msg.Order.PairId = 0
+			},
+			"pair id must not be 0: invalid request",
+		},
+		{
+			"empty salt",
+			func(msg *types.MsgRevealOrder) {
+				msg.Salt = ""
+			},
+			"salt must not be empty: invalid request",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			order := types.NewMsgLimitOrder(
+				testAddr, 1, types.OrderDirectionBuy, utils.ParseCoin("1000000denom2"),
+				"denom1", utils.ParseDec("1.0"), sdk.NewInt(1000000), time.Hour)
+			msg := types.NewMsgRevealOrder(*order, "salt")
+			tc.malleate(msg)
+			require.Equal(t, types.TypeMsgRevealOrder, msg.Type())
+			require.Equal(t, types.RouterKey, msg.Route())
+			err := msg.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+				require.Equal(t, types.OrderCommitHash(*order, "salt"), msg.Hash())
+				signers := msg.GetSigners()
+				require.Len(t, signers, 1)
+				require.Equal(t, msg.GetOrderer(), signers[0])
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestMsgAmendOrder(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		malleate    func(msg *types.MsgAmendOrder)
+		expectedErr string
+	}{
+		{
+			"happy case",
+			func(msg *types.MsgAmendOrder) {},
+			"", // empty means no error expected
+		},
+		{
+			"invalid orderer",
+			func(msg *types.MsgAmendOrder) {
+				msg.Orderer = "invalidaddr"
+			},
+			"invalid orderer address: decoding bech32 failed: invalid separator index -1: invalid address",
+		},
+		{
+			"invalid pair id",
+			func(msg *types.MsgAmendOrder) {
+				msg.PairId = 0
+			},
+			"pair id must not be 0: invalid request",
+		},
+		{
+			"invalid order id",
+			func(msg *types.MsgAmendOrder) {
+				msg.OrderId = 0
+			},
+			"order id must not be 0: invalid request",
+		},
+		{
+			"zero price",
+			func(msg *types.MsgAmendOrder) {
+				msg.Price = sdk.ZeroDec()
+			},
+			"price must be positive: invalid request",
+		},
+		{
+			"too small amount",
+			func(msg *types.MsgAmendOrder) {
+				msg.Amount = sdk.NewInt(99)
+			},
+			"order amount 99 is smaller than the min amount 100: invalid request",
+		},
+		{
+			"too large amount",
+			func(msg *types.MsgAmendOrder) {
+				msg.Amount = amm.MaxCoinAmount.AddRaw(1)
+			},
+			"order amount 10000000000000000000000000000000000000001 is bigger than the max amount 10000000000000000000000000000000000000000: invalid request",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := types.NewMsgAmendOrder(testAddr, 1, 1, utils.ParseDec("1.0"), sdk.NewInt(1000000))
+			tc.malleate(msg)
+			require.Equal(t, types.TypeMsgAmendOrder, msg.Type())
+			require.Equal(t, types.RouterKey, msg.Route())
+			err := msg.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+				signers := msg.GetSigners()
+				require.Len(t, signers, 1)
+				require.Equal(t, msg.GetOrderer(), signers[0])
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package types
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// NettingSendCoinsOperation holds a list of SendCoins operations and settles
+// them at once by their net balance changes.
+// Unlike BulkSendCoinsOperation, coins sent back and forth between addresses,
+// e.g. from pools to the pair escrow and then from the escrow to orderers,
+// are netted out, so each address takes part in the settlement at most once
+// as an input and once as an output.
+type NettingSendCoinsOperation struct {
+	addrs   []sdk.AccAddress
+	changes map[string]map[string]sdk.Int // addr -> denom -> net change
+}
+
+// NewNettingSendCoinsOperation returns an empty NettingSendCoinsOperation.
+func NewNettingSendCoinsOperation() *NettingSendCoinsOperation {
+	return &NettingSendCoinsOperation{
+		changes: map[string]map[string]sdk.Int{},
+	}
+}
+
+// QueueSendCoins queues a BankKeeper.SendCoins operation for later settlement.
+func (op *NettingSendCoinsOperation) QueueSendCoins(fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) {
+	if amt.IsValid() && !amt.IsZero() {
+		for _, coin := range amt {
+			op.addChange(fromAddr, coin.Denom, coin.Amount.Neg())
+			op.addChange(toAddr, coin.Denom, coin.Amount)
+		}
+	}
+}
+
+func (op *NettingSendCoinsOperation) addChange(addr sdk.AccAddress, denom string, amt sdk.Int) {
+	changes, ok := op.changes[addr.String()]
+	if !ok {
+		changes = map[string]sdk.Int{}
+		op.changes[addr.String()] = changes
+		op.addrs = append(op.addrs, addr)
+	}
+	if change, ok := changes[denom]; ok {
+		changes[denom] = change.Add(amt)
+	} else {
+		changes[denom] = amt
+	}
+}
+
+// NetTransfers returns the inputs and outputs that settle the net balance
+// changes of the queued operations.
+// Addresses are ordered by their first appearance in the queued operations.
+func (op *NettingSendCoinsOperation) NetTransfers() (inputs []banktypes.Input, outputs []banktypes.Output) {
+	for _, addr := range op.addrs {
+		changes := op.changes[addr.String()]
+		denoms := make([]string, 0, len(changes))
+		for denom := range changes {
+			denoms = append(denoms, denom)
+		}
+		sort.Strings(denoms)
+
+		sent, received := sdk.Coins{}, sdk.Coins{}
+		for _, denom := range denoms {
+			change := changes[denom]
+			switch {
+			case change.IsNegative():
+				sent = append(sent, sdk.NewCoin(denom, change.Neg()))
+			case change.IsPositive():
+				received = append(received, sdk.NewCoin(denom, change))
+			}
+		}
+		if !sent.IsZero() {
+			inputs = append(inputs, banktypes.NewInput(addr, sent))
+		}
+		if !received.IsZero() {
+			outputs = append(outputs, banktypes.NewOutput(addr, received))
+		}
+	}
+	return
+}
+
+// Run runs BankKeeper.InputOutputCoins once for the net transfers of queued
+// operations.
+func (op *NettingSendCoinsOperation) Run(ctx sdk.Context, bankKeeper BankKeeper) error {
+	inputs, outputs := op.NetTransfers()
+	if len(inputs) > 0 {
+		return bankKeeper.InputOutputCoins(ctx, inputs, outputs)
+	}
+	return nil
+}
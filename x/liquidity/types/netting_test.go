@@ -0,0 +1,48 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	utils "github.com/crescent-network/crescent/v4/types"
+	"github.com/crescent-network/crescent/v4/x/liquidity/types"
+)
+
+func TestNettingSendCoinsOperation(t *testing.T) {
+	escrowAddr := types.PairEscrowAddress(1)
+	poolAddr := types.PoolReserveAddress(1)
+	ordererAddr1 := utils.TestAddress(1)
+	ordererAddr2 := utils.TestAddress(2)
+	feeCollectorAddr := utils.TestAddress(3)
+
+	op := types.NewNettingSendCoinsOperation()
+	inputs, outputs := op.NetTransfers()
+	require.Empty(t, inputs)
+	require.Empty(t, outputs)
+
+	// The pool pays to the escrow, and the escrow pays to the orderers and
+	// the pool.
+	op.QueueSendCoins(poolAddr, escrowAddr, utils.ParseCoins("1000denom1"))
+	op.QueueSendCoins(escrowAddr, ordererAddr1, utils.ParseCoins("600denom1"))
+	op.QueueSendCoins(escrowAddr, ordererAddr2, utils.ParseCoins("400denom1,100denom2"))
+	op.QueueSendCoins(escrowAddr, poolAddr, utils.ParseCoins("900denom2"))
+	op.QueueSendCoins(escrowAddr, feeCollectorAddr, utils.ParseCoins("3denom2"))
+	op.QueueSendCoins(ordererAddr1, escrowAddr, sdk.Coins{}) // ignored
+
+	inputs, outputs = op.NetTransfers()
+	require.Equal(t, []banktypes.Input{
+		banktypes.NewInput(poolAddr, utils.ParseCoins("1000denom1")),
+		banktypes.NewInput(escrowAddr, utils.ParseCoins("1003denom2")),
+	}, inputs)
+	require.Equal(t, []banktypes.Output{
+		banktypes.NewOutput(poolAddr, utils.ParseCoins("900denom2")),
+		banktypes.NewOutput(ordererAddr1, utils.ParseCoins("600denom1")),
+		banktypes.NewOutput(ordererAddr2, utils.ParseCoins("400denom1,100denom2")),
+		banktypes.NewOutput(feeCollectorAddr, utils.ParseCoins("3denom2")),
+	}, outputs)
+	require.NoError(t, banktypes.ValidateInputsOutputs(inputs, outputs))
+}
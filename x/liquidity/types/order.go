@@ -24,10 +24,14 @@ func OrderDirectionFromAMM(dir amm.OrderDirection) OrderDirection {
 
 type UserOrder struct {
 	*amm.BaseOrder
-	Orderer                         sdk.AccAddress
-	OrderId                         uint64
-	BatchId                         uint64
-	OfferCoinDenom, DemandCoinDenom string
+	OrderId        uint64
+	BatchId        uint64
+	OfferCoinDenom string
+	MinFillAmount  sdk.Int
+	// Record is the order's state loaded when building the order book,
+	// which is updated with the matching result without reloading it.
+	// It is nil for hypothetical orders not stored in the state.
+	Record *Order
 }
 
 // NewUserOrder returns a new user order.
@@ -49,13 +53,20 @@ func NewUserOrder(order Order) *UserOrder {
 		dir = amm.Sell
 		amt = order.OpenAmount
 	}
+	minFillAmt := sdk.ZeroInt()
+	if order.MinFillAmount != nil {
+		minFillAmt = *order.MinFillAmount
+	}
+	baseOrder := amm.NewBaseOrder(dir, order.Price, amt, order.RemainingOfferCoin.Amount)
+	baseOrder.Orderer = order.GetOrderer()
+	baseOrder.DemandCoinDenom = order.ReceivedCoin.Denom
 	return &UserOrder{
-		BaseOrder:       amm.NewBaseOrder(dir, order.Price, amt, order.RemainingOfferCoin.Amount),
-		Orderer:         order.GetOrderer(),
-		OrderId:         order.Id,
-		BatchId:         order.BatchId,
-		OfferCoinDenom:  order.OfferCoin.Denom,
-		DemandCoinDenom: order.ReceivedCoin.Denom,
+		BaseOrder:      baseOrder,
+		OrderId:        order.Id,
+		BatchId:        order.BatchId,
+		OfferCoinDenom: order.OfferCoin.Denom,
+		MinFillAmount:  minFillAmt,
+		Record:         &order,
 	}
 }
 
@@ -63,6 +74,10 @@ func (order *UserOrder) GetBatchId() uint64 {
 	return order.BatchId
 }
 
+func (order *UserOrder) GetMinFillAmount() sdk.Int {
+	return order.MinFillAmount
+}
+
 func (order *UserOrder) HasPriority(other amm.Order) bool {
 	if !order.Amount.Equal(other.GetAmount()) {
 		return order.BaseOrder.HasPriority(other)
@@ -70,7 +85,7 @@ func (order *UserOrder) HasPriority(other amm.Order) bool {
 	switch other := other.(type) {
 	case *UserOrder:
 		return order.OrderId < other.OrderId
-	case *PoolOrder:
+	case *PoolOrder, *SourceOrder:
 		return true
 	default:
 		panic(fmt.Errorf("invalid order type: %T", other))
@@ -82,22 +97,24 @@ func (order *UserOrder) String() string {
 		order.OrderId, order.BatchId, order.Direction, order.Price, order.Amount)
 }
 
+// PoolOrder is an order made by a pool.
+// Its orderer is the pool's reserve address.
 type PoolOrder struct {
 	*amm.BaseOrder
-	PoolId                          uint64
-	ReserveAddress                  sdk.AccAddress
-	OfferCoinDenom, DemandCoinDenom string
+	PoolId         uint64
+	OfferCoinDenom string
 }
 
 func NewPoolOrder(
 	poolId uint64, reserveAddr sdk.AccAddress, dir amm.OrderDirection, price sdk.Dec, amt sdk.Int,
 	offerCoinDenom, demandCoinDenom string) *PoolOrder {
+	baseOrder := amm.NewBaseOrder(dir, price, amt, amm.OfferCoinAmount(dir, price, amt))
+	baseOrder.Orderer = reserveAddr
+	baseOrder.DemandCoinDenom = demandCoinDenom
 	return &PoolOrder{
-		BaseOrder:       amm.NewBaseOrder(dir, price, amt, amm.OfferCoinAmount(dir, price, amt)),
-		PoolId:          poolId,
-		ReserveAddress:  reserveAddr,
-		OfferCoinDenom:  offerCoinDenom,
-		DemandCoinDenom: demandCoinDenom,
+		BaseOrder:      baseOrder,
+		PoolId:         poolId,
+		OfferCoinDenom: offerCoinDenom,
 	}
 }
 
@@ -110,6 +127,8 @@ func (order *PoolOrder) HasPriority(other amm.Order) bool {
 		return false
 	case *PoolOrder:
 		return order.PoolId < other.PoolId
+	case *SourceOrder:
+		return true
 	default:
 		panic(fmt.Errorf("invalid order type: %T", other))
 	}
@@ -119,3 +138,44 @@ func (order *PoolOrder) String() string {
 	return fmt.Sprintf("PoolOrder(%d,%s,%s,%s)",
 		order.PoolId, order.Direction, order.Price, order.Amount)
 }
+
+// SourceOrder is an order made by an external order source.
+// Its orderer is the order source's reserve address.
+type SourceOrder struct {
+	*amm.BaseOrder
+	SourceName     string
+	OfferCoinDenom string
+}
+
+// NewSourceOrder returns a new source order.
+func NewSourceOrder(
+	sourceName string, reserveAddr sdk.AccAddress, dir amm.OrderDirection, price sdk.Dec, amt sdk.Int,
+	offerCoinDenom, demandCoinDenom string) *SourceOrder {
+	baseOrder := amm.NewBaseOrder(dir, price, amt, amm.OfferCoinAmount(dir, price, amt))
+	baseOrder.Orderer = reserveAddr
+	baseOrder.DemandCoinDenom = demandCoinDenom
+	return &SourceOrder{
+		BaseOrder:      baseOrder,
+		SourceName:     sourceName,
+		OfferCoinDenom: offerCoinDenom,
+	}
+}
+
+func (order *SourceOrder) HasPriority(other amm.Order) bool {
+	if !order.Amount.Equal(other.GetAmount()) {
+		return order.BaseOrder.HasPriority(other)
+	}
+	switch other := other.(type) {
+	case *UserOrder, *PoolOrder:
+		return false
+	case *SourceOrder:
+		return order.SourceName < other.SourceName
+	default:
+		panic(fmt.Errorf("invalid order type: %T", other))
+	}
+}
+
+func (order *SourceOrder) String() string {
+	return fmt.Sprintf("SourceOrder(%s,%s,%s,%s)",
+		order.SourceName, order.Direction, order.Price, order.Amount)
+}
@@ -0,0 +1,101 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/tendermint/tendermint/crypto"
+)
+
+// OrderBookSnapshot is a dump of the complete order book of a pair, which
+// consists of the pair itself, its pools with their reserves and its
+// matchable orders.
+// It is used to move a real-world order book from one environment to
+// another, e.g. from mainnet state into a local testnet genesis.
+type OrderBookSnapshot struct {
+	Pair   Pair           `json:"pair"`
+	Pools  []PoolSnapshot `json:"pools"`
+	Orders []Order        `json:"orders"`
+}
+
+// PoolSnapshot is a dump of a pool within an OrderBookSnapshot.
+type PoolSnapshot struct {
+	Pool           Pool      `json:"pool"`
+	Reserves       sdk.Coins `json:"reserves"`
+	PoolCoinSupply sdk.Int   `json:"pool_coin_supply"`
+}
+
+// RemapAddress returns a deterministic address derived from addr.
+// It is used to replace addresses of an OrderBookSnapshot so that
+// imported objects do not belong to the original owners.
+func RemapAddress(addr string) sdk.AccAddress {
+	return sdk.AccAddress(crypto.AddressHash([]byte("order-book-snapshot/" + addr)))
+}
+
+// ImportOrderBookSnapshot imports the snapshot into genState with newly
+// assigned pair id, pool ids and remapped addresses.
+// It returns the balances that must be added to the bank genesis state
+// along with the imported objects: the pair escrow holding the remaining
+// offer coins of orders, pool reserves and pool coins held by the remapped
+// pool creators.
+func ImportOrderBookSnapshot(genState *GenesisState, snapshot OrderBookSnapshot, remap func(addr string) sdk.AccAddress) ([]banktypes.Balance, error) {
+	for _, pair := range genState.Pairs {
+		if pair.BaseCoinDenom == snapshot.Pair.BaseCoinDenom && pair.QuoteCoinDenom == snapshot.Pair.QuoteCoinDenom {
+			return nil, fmt.Errorf("pair with denoms %s/%s already exists: %d", pair.BaseCoinDenom, pair.QuoteCoinDenom, pair.Id)
+		}
+	}
+
+	pair := snapshot.Pair
+	pair.Id = genState.LastPairId + 1
+	pair.EscrowAddress = PairEscrowAddress(pair.Id).String()
+	if pair.Creator != "" {
+		pair.Creator = remap(pair.Creator).String()
+	}
+	genState.Pairs = append(genState.Pairs, pair)
+	genState.LastPairId = pair.Id
+
+	var balances []banktypes.Balance
+	for _, poolSnapshot := range snapshot.Pools {
+		if poolSnapshot.Pool.PairId != snapshot.Pair.Id {
+			return nil, fmt.Errorf("pool %d has wrong pair id: %d", poolSnapshot.Pool.Id, poolSnapshot.Pool.PairId)
+		}
+		pool := poolSnapshot.Pool
+		pool.Id = genState.LastPoolId + 1
+		pool.PairId = pair.Id
+		pool.Creator = remap(pool.Creator).String()
+		pool.ReserveAddress = PoolReserveAddress(pool.Id).String()
+		pool.PoolCoinDenom = PoolCoinDenom(pool.Id)
+		genState.Pools = append(genState.Pools, pool)
+		genState.LastPoolId = pool.Id
+
+		if !poolSnapshot.Reserves.IsZero() {
+			balances = append(balances, banktypes.Balance{Address: pool.ReserveAddress, Coins: poolSnapshot.Reserves})
+		}
+		if poolSnapshot.PoolCoinSupply.IsPositive() {
+			balances = append(balances, banktypes.Balance{
+				Address: pool.Creator,
+				Coins:   sdk.NewCoins(sdk.NewCoin(pool.PoolCoinDenom, poolSnapshot.PoolCoinSupply)),
+			})
+		}
+	}
+
+	escrowCoins := sdk.Coins{}
+	for _, order := range snapshot.Orders {
+		if order.PairId != snapshot.Pair.Id {
+			return nil, fmt.Errorf("order %d has wrong pair id: %d", order.Id, order.PairId)
+		}
+		if !order.Status.IsMatchable() {
+			continue
+		}
+		order.PairId = pair.Id
+		order.Orderer = remap(order.Orderer).String()
+		genState.Orders = append(genState.Orders, order)
+		escrowCoins = escrowCoins.Add(order.RemainingOfferCoin)
+	}
+	if !escrowCoins.IsZero() {
+		balances = append(balances, banktypes.Balance{Address: pair.EscrowAddress, Coins: escrowCoins})
+	}
+
+	return balances, nil
+}
@@ -0,0 +1,101 @@
+package types_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+
+	utils "github.com/crescent-network/crescent/v4/types"
+	"github.com/crescent-network/crescent/v4/x/liquidity/types"
+)
+
+func TestImportOrderBookSnapshot(t *testing.T) {
+	orderer := sdk.AccAddress(crypto.AddressHash([]byte("orderer")))
+
+	pair := types.NewPair(3, "denom1", "denom2")
+	pair.LastOrderId = 2
+	lastPrice := utils.ParseDec("1.0")
+	pair.LastPrice = &lastPrice
+	pool := types.NewBasicPool(5, 3, testAddr)
+	pool.MaxReserveAmount = utils.ParseCoins("10000000denom1,10000000denom2")
+	newOrder := func(id uint64, status types.OrderStatus) types.Order {
+		return types.Order{
+			Id:                 id,
+			PairId:             3,
+			MsgHeight:          1,
+			Orderer:            orderer.String(),
+			Direction:          types.OrderDirectionBuy,
+			OfferCoin:          sdk.NewInt64Coin("denom2", 1000000),
+			RemainingOfferCoin: sdk.NewInt64Coin("denom2", 1000000),
+			ReceivedCoin:       sdk.NewInt64Coin("denom1", 0),
+			Price:              utils.ParseDec("0.99"),
+			Amount:             sdk.NewInt(1000000),
+			OpenAmount:         sdk.NewInt(1000000),
+			BatchId:            1,
+			ExpireAt:           utils.ParseTime("2022-02-01T00:00:00Z"),
+			Status:             status,
+		}
+	}
+	snapshot := types.OrderBookSnapshot{
+		Pair: pair,
+		Pools: []types.PoolSnapshot{
+			{
+				Pool:           pool,
+				Reserves:       utils.ParseCoins("1000000denom1,1000000denom2"),
+				PoolCoinSupply: sdk.NewInt(1000000000000),
+			},
+		},
+		Orders: []types.Order{
+			newOrder(1, types.OrderStatusNotExecuted),
+			newOrder(2, types.OrderStatusCompleted),
+		},
+	}
+
+	// The snapshot survives a JSON round trip.
+	bz, err := json.Marshal(snapshot)
+	require.NoError(t, err)
+	var snapshot2 types.OrderBookSnapshot
+	require.NoError(t, json.Unmarshal(bz, &snapshot2))
+	require.Equal(t, snapshot, snapshot2)
+
+	genState := types.DefaultGenesis()
+	genState.Pairs = []types.Pair{types.NewPair(1, "denom1", "denom3")}
+	genState.LastPairId = 1
+
+	balances, err := types.ImportOrderBookSnapshot(genState, snapshot2, types.RemapAddress)
+	require.NoError(t, err)
+	require.NoError(t, genState.Validate())
+
+	require.EqualValues(t, 2, genState.LastPairId)
+	importedPair := genState.Pairs[1]
+	require.EqualValues(t, 2, importedPair.Id)
+	require.Equal(t, types.PairEscrowAddress(2).String(), importedPair.EscrowAddress)
+	require.Equal(t, pair.LastPrice, importedPair.LastPrice)
+
+	require.EqualValues(t, 1, genState.LastPoolId)
+	importedPool := genState.Pools[0]
+	require.EqualValues(t, 2, importedPool.PairId)
+	require.Equal(t, types.PoolReserveAddress(1).String(), importedPool.ReserveAddress)
+	require.Equal(t, "pool1", importedPool.PoolCoinDenom)
+	require.Equal(t, types.RemapAddress(testAddr.String()).String(), importedPool.Creator)
+
+	// Only matchable orders are imported.
+	require.Len(t, genState.Orders, 1)
+	require.EqualValues(t, 2, genState.Orders[0].PairId)
+	require.Equal(t, types.RemapAddress(orderer.String()).String(), genState.Orders[0].Orderer)
+
+	require.Len(t, balances, 3)
+	require.Equal(t, importedPool.ReserveAddress, balances[0].Address)
+	require.Equal(t, utils.ParseCoins("1000000denom1,1000000denom2"), balances[0].Coins)
+	require.Equal(t, importedPool.Creator, balances[1].Address)
+	require.Equal(t, utils.ParseCoins("1000000000000pool1"), balances[1].Coins)
+	require.Equal(t, importedPair.EscrowAddress, balances[2].Address)
+	require.Equal(t, utils.ParseCoins("1000000denom2"), balances[2].Coins)
+
+	// The same pair cannot be imported twice.
+	_, err = types.ImportOrderBookSnapshot(genState, snapshot2, types.RemapAddress)
+	require.EqualError(t, err, "pair with denoms denom1/denom2 already exists: 2")
+}
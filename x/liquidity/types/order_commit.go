@@ -0,0 +1,66 @@
+package types
+
+import (
+	"crypto/sha256"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// OrderCommitHashLength is the length of the hash of an order commit.
+const OrderCommitHashLength = sha256.Size
+
+// OrderCommitHash returns the hash of the limit order committed with the
+// salt, which is the SHA-256 hash of the amino JSON sign bytes of the
+// MsgLimitOrder followed by the salt.
+func OrderCommitHash(order MsgLimitOrder, salt string) []byte {
+	hash := sha256.Sum256(append(order.GetSignBytes(), salt...))
+	return hash[:]
+}
+
+// NewOrderCommit returns a new order commit for the MsgCommitOrder.
+func NewOrderCommit(msg *MsgCommitOrder, batchId, lastRevealBatchId uint64, bond sdk.Coins) OrderCommit {
+	return OrderCommit{
+		PairId:            msg.PairId,
+		Orderer:           msg.Orderer,
+		Hash:              msg.Hash,
+		BatchId:           batchId,
+		LastRevealBatchId: lastRevealBatchId,
+		Bond:              bond,
+	}
+}
+
+func (commit OrderCommit) GetOrderer() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(commit.Orderer)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// CanBeRevealed returns whether the committed order can be revealed in the
+// batch.
+// An order can't be revealed in the batch it was committed.
+func (commit OrderCommit) CanBeRevealed(batchId uint64) bool {
+	return commit.BatchId < batchId && batchId <= commit.LastRevealBatchId
+}
+
+// Validate validates OrderCommit for genesis.
+func (commit OrderCommit) Validate() error {
+	if commit.PairId == 0 {
+		return fmt.Errorf("pair id must not be 0")
+	}
+	if _, err := sdk.AccAddressFromBech32(commit.Orderer); err != nil {
+		return fmt.Errorf("invalid orderer address %s: %w", commit.Orderer, err)
+	}
+	if len(commit.Hash) != OrderCommitHashLength {
+		return fmt.Errorf("hash length must be %d: %d", OrderCommitHashLength, len(commit.Hash))
+	}
+	if commit.LastRevealBatchId <= commit.BatchId {
+		return fmt.Errorf("last reveal batch id must be greater than batch id: %d <= %d", commit.LastRevealBatchId, commit.BatchId)
+	}
+	if err := commit.Bond.Validate(); err != nil {
+		return fmt.Errorf("invalid bond %s: %w", commit.Bond, err)
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/crescent-network/crescent/v4/x/liquidity/amm"
+)
+
+var _ amm.Orderer = (*SourceOrderer)(nil)
+
+// OrderSource defines an external liquidity source, usually another module,
+// which contributes orders to pairs' batch matching.
+type OrderSource interface {
+	// Name returns the unique name of the order source.
+	Name() string
+	// PairOrderSource returns an amm.OrderSource which provides orders for
+	// the pair.
+	// All orders provided by the amm.OrderSource must be created by
+	// a SourceOrderer.
+	// found is false if the source has no liquidity for the pair.
+	PairOrderSource(ctx sdk.Context, pair Pair) (source amm.OrderSource, found bool)
+}
+
+// SourceOrderer creates orders of an order source.
+// Offer coins of matched orders are taken from the reserve address, and
+// demand coins are sent to the reserve address.
+type SourceOrderer struct {
+	SourceName                    string
+	ReserveAddress                sdk.AccAddress
+	BaseCoinDenom, QuoteCoinDenom string
+}
+
+// NewSourceOrderer returns a new SourceOrderer.
+func NewSourceOrderer(sourceName string, reserveAddr sdk.AccAddress, baseCoinDenom, quoteCoinDenom string) *SourceOrderer {
+	return &SourceOrderer{
+		SourceName:     sourceName,
+		ReserveAddress: reserveAddr,
+		BaseCoinDenom:  baseCoinDenom,
+		QuoteCoinDenom: quoteCoinDenom,
+	}
+}
+
+func (orderer *SourceOrderer) Order(dir amm.OrderDirection, price sdk.Dec, amt sdk.Int) amm.Order {
+	var offerCoinDenom, demandCoinDenom string
+	switch dir {
+	case amm.Buy:
+		offerCoinDenom, demandCoinDenom = orderer.QuoteCoinDenom, orderer.BaseCoinDenom
+	case amm.Sell:
+		offerCoinDenom, demandCoinDenom = orderer.BaseCoinDenom, orderer.QuoteCoinDenom
+	}
+	return NewSourceOrder(orderer.SourceName, orderer.ReserveAddress, dir, price, amt, offerCoinDenom, demandCoinDenom)
+}
@@ -1,16 +1,31 @@
 package types
 
 import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
 	"fmt"
-	"strconv"
-	"strings"
+	"math/big"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
-	farmingtypes "github.com/crescent-network/crescent/v4/x/farming/types"
+	"github.com/crescent-network/crescent/v4/x/liquidity/amm"
 )
 
+// DefaultDenomExponent is the decimal exponent used for a denom which has no
+// display unit registered in the bank module's denom metadata.
+const DefaultDenomExponent = 6
+
+// MaxPairDisplayNameLength is the maximum length of the display name in a
+// pair's metadata.
+const MaxPairDisplayNameLength = 64
+
+// MaxPairBatchWindow is the maximum number of batches in a pair's batch window.
+const MaxPairBatchWindow = 100
+
 func (pair Pair) GetEscrowAddress() sdk.AccAddress {
 	addr, err := sdk.AccAddressFromBech32(pair.EscrowAddress)
 	if err != nil {
@@ -29,9 +44,71 @@ func NewPair(id uint64, baseCoinDenom, quoteCoinDenom string) Pair {
 		LastOrderId:    0,
 		LastPrice:      nil,
 		CurrentBatchId: 1,
+		AddressVersion: CurrentAddressVersion,
 	}
 }
 
+// OfferCoinDenom returns the denom of the coin offered by the pair's orders
+// in the direction.
+func (pair Pair) OfferCoinDenom(dir amm.OrderDirection) string {
+	if dir == amm.Buy {
+		return pair.QuoteCoinDenom
+	}
+	return pair.BaseCoinDenom
+}
+
+// IsBootstrapping returns whether the pair is in its bootstrap phase, where
+// orders are only accumulated without matching until the bootstrap auction.
+func (pair Pair) IsBootstrapping() bool {
+	return pair.CurrentBatchId < pair.BootstrapEndBatchId
+}
+
+// NormalizePrice converts a price in the smallest units of the coins into
+// the display price of the pair.
+func (pair Pair) NormalizePrice(price sdk.Dec) sdk.Dec {
+	return ScalePrice(price, -int(pair.PriceExponent))
+}
+
+// DenormalizePrice converts a display price of the pair into the price in
+// the smallest units of the coins.
+func (pair Pair) DenormalizePrice(price sdk.Dec) sdk.Dec {
+	return ScalePrice(price, int(pair.PriceExponent))
+}
+
+// PriceExponent returns the price exponent of a pair whose base and quote
+// coins have the given decimal exponents.
+func PriceExponent(baseCoinExponent, quoteCoinExponent uint32) int32 {
+	return int32(quoteCoinExponent) - int32(baseCoinExponent)
+}
+
+// ValidatePriceExponent validates that the display price 1 of a pair with
+// the price exponent is representable on the price ticks with the tick
+// precision. Prices of pairs with more extreme exponents are either
+// truncated by the decimal precision or overflow the highest tick.
+func ValidatePriceExponent(exp int32, tickPrec int) error {
+	minExp := tickPrec - sdk.Precision
+	maxExp := len(amm.HighestTick(tickPrec).BigInt().Text(10)) - 1 - sdk.Precision
+	if int(exp) < minExp || int(exp) > maxExp {
+		return fmt.Errorf("price exponent %d is out of range [%d, %d]", exp, minExp, maxExp)
+	}
+	return nil
+}
+
+// ScalePrice returns price * 10^exp.
+// The result is truncated when exp is negative.
+func ScalePrice(price sdk.Dec, exp int) sdk.Dec {
+	if exp == 0 {
+		return price
+	}
+	b := price.BigInt()
+	if exp > 0 {
+		b.Mul(b, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(exp)), nil))
+	} else {
+		b.Quo(b, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(-exp)), nil))
+	}
+	return sdk.NewDecFromBigIntWithPrec(b, sdk.Precision)
+}
+
 // Validate validates Pair for genesis.
 func (pair Pair) Validate() error {
 	if pair.Id == 0 {
@@ -46,6 +123,9 @@ func (pair Pair) Validate() error {
 	if _, err := sdk.AccAddressFromBech32(pair.EscrowAddress); err != nil {
 		return fmt.Errorf("invalid escrow address %s: %w", pair.EscrowAddress, err)
 	}
+	if err := ValidateAddressVersion(pair.AddressVersion); err != nil {
+		return err
+	}
 	if pair.LastPrice != nil {
 		if !pair.LastPrice.IsPositive() {
 			return fmt.Errorf("last price must be positive: %s", pair.LastPrice)
@@ -54,15 +134,237 @@ func (pair Pair) Validate() error {
 	if pair.CurrentBatchId == 0 {
 		return fmt.Errorf("current batch id must not be 0")
 	}
+	if pair.PriceExponent < -sdk.Precision {
+		return fmt.Errorf("price exponent must not be lower than %d: %d", -sdk.Precision, pair.PriceExponent)
+	}
+	if pair.Creator != "" {
+		if _, err := sdk.AccAddressFromBech32(pair.Creator); err != nil {
+			return fmt.Errorf("invalid creator address %s: %w", pair.Creator, err)
+		}
+	}
+	if pair.Metadata != nil {
+		if err := pair.Metadata.Validate(); err != nil {
+			return fmt.Errorf("invalid metadata: %w", err)
+		}
+	}
+	if pair.BatchWindow > MaxPairBatchWindow {
+		return fmt.Errorf("batch window must not be greater than %d: %d", MaxPairBatchWindow, pair.BatchWindow)
+	}
+	if pair.OrderAmountLimits != nil {
+		if err := pair.OrderAmountLimits.Validate(); err != nil {
+			return fmt.Errorf("invalid order amount limits: %w", err)
+		}
+	}
+	if err := ValidatePairParams(pair.UpwardPriceLimitRatio, pair.DownwardPriceLimitRatio, pair.MaxOrderLifespan); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ValidatePairParams validates the params overridden for a pair.
+// A nil value means that the param is not overridden.
+func ValidatePairParams(upwardPriceLimitRatio, downwardPriceLimitRatio *sdk.Dec, maxOrderLifespan *time.Duration) error {
+	if upwardPriceLimitRatio != nil {
+		if err := ValidateUpwardPriceLimitRatio(*upwardPriceLimitRatio); err != nil {
+			return err
+		}
+	}
+	if downwardPriceLimitRatio != nil {
+		if err := ValidateDownwardPriceLimitRatio(*downwardPriceLimitRatio); err != nil {
+			return err
+		}
+	}
+	if maxOrderLifespan != nil {
+		if *maxOrderLifespan < 0 {
+			return fmt.Errorf("max order lifespan must not be negative: %s", maxOrderLifespan)
+		}
+	}
+	return nil
+}
+
+// ValidateUpwardPriceLimitRatio validates the upward price limit ratio.
+func ValidateUpwardPriceLimitRatio(ratio sdk.Dec) error {
+	if ratio.IsNil() || ratio.IsNegative() {
+		return fmt.Errorf("upward price limit ratio must not be negative: %s", ratio)
+	}
+	return nil
+}
+
+// ValidateDownwardPriceLimitRatio validates the downward price limit ratio,
+// which must be less than 1 so that the lowest price stays positive.
+func ValidateDownwardPriceLimitRatio(ratio sdk.Dec) error {
+	if ratio.IsNil() || ratio.IsNegative() || ratio.GTE(sdk.OneDec()) {
+		return fmt.Errorf("downward price limit ratio must be in range [0, 1): %s", ratio)
+	}
+	return nil
+}
+
+// PriceBandWideningSteps returns the number of steps the pair's price band
+// widens by, which is the number of times the pair has had
+// wideningBatches batches in a row without a trade, capped by maxSteps.
+// Zero wideningBatches disables the widening.
+func (pair Pair) PriceBandWideningSteps(wideningBatches, maxSteps uint32) uint32 {
+	if wideningBatches == 0 {
+		return 0
+	}
+	steps := pair.NumBatchesWithoutTrade / wideningBatches
+	if steps > maxSteps {
+		steps = maxSteps
+	}
+	return steps
+}
+
+// WidenPriceLimitRatios returns the price limit ratios widened by the steps,
+// as if the price had moved to the price limit in each step.
+// The widened upward ratio is capped so that 1 plus the ratio doesn't exceed
+// the highest price tick, which keeps it from overflowing.
+func WidenPriceLimitRatios(upwardRatio, downwardRatio sdk.Dec, steps uint32) (sdk.Dec, sdk.Dec) {
+	if steps == 0 {
+		return upwardRatio, downwardRatio
+	}
+	maxUpwardFactor := amm.HighestTick(0)
+	upwardFactor := sdk.OneDec().Add(upwardRatio)
+	widenedUpwardFactor := upwardFactor
+	for i := uint32(0); i < steps; i++ {
+		widenedUpwardFactor = amm.MulDecCapped(widenedUpwardFactor, upwardFactor, maxUpwardFactor)
+	}
+	upwardRatio = widenedUpwardFactor.Sub(sdk.OneDec())
+	downwardRatio = sdk.OneDec().Sub(sdk.OneDec().Sub(downwardRatio).Power(uint64(steps) + 1))
+	return upwardRatio, downwardRatio
+}
+
+// ValidateOrderAmount validates the amount of an order placed to the pair
+// at the price against the pair's order amount limits.
+func (pair Pair) ValidateOrderAmount(amt sdk.Int, price sdk.Dec) error {
+	if pair.OrderAmountLimits == nil {
+		return nil
+	}
+	return pair.OrderAmountLimits.ValidateOrderAmount(amt, price)
+}
+
+// ValidateMinOrderAmount is like ValidateOrderAmount, but it doesn't check
+// the lot size.
+func (pair Pair) ValidateMinOrderAmount(amt sdk.Int, price sdk.Dec) error {
+	if pair.OrderAmountLimits == nil {
+		return nil
+	}
+	return pair.OrderAmountLimits.ValidateMinOrderAmount(amt, price)
+}
+
+// NewPairOrderAmountLimits returns a new PairOrderAmountLimits.
+func NewPairOrderAmountLimits(minBaseOrderAmt, minQuoteOrderAmt, lotSize sdk.Int) PairOrderAmountLimits {
+	return PairOrderAmountLimits{
+		MinBaseOrderAmount:  minBaseOrderAmt,
+		MinQuoteOrderAmount: minQuoteOrderAmt,
+		LotSize:             lotSize,
+	}
+}
+
+// IsZero returns whether all the limits are disabled.
+func (limits PairOrderAmountLimits) IsZero() bool {
+	return limits.MinBaseOrderAmount.IsZero() && limits.MinQuoteOrderAmount.IsZero() && limits.LotSize.IsZero()
+}
+
+// Validate validates PairOrderAmountLimits.
+func (limits PairOrderAmountLimits) Validate() error {
+	if limits.MinBaseOrderAmount.IsNil() || limits.MinBaseOrderAmount.IsNegative() {
+		return fmt.Errorf("min base order amount must not be negative: %s", limits.MinBaseOrderAmount)
+	}
+	if limits.MinQuoteOrderAmount.IsNil() || limits.MinQuoteOrderAmount.IsNegative() {
+		return fmt.Errorf("min quote order amount must not be negative: %s", limits.MinQuoteOrderAmount)
+	}
+	if limits.LotSize.IsNil() || limits.LotSize.IsNegative() {
+		return fmt.Errorf("lot size must not be negative: %s", limits.LotSize)
+	}
+	return nil
+}
+
+// ValidateOrderAmount validates the amount of an order at the price
+// against the limits.
+func (limits PairOrderAmountLimits) ValidateOrderAmount(amt sdk.Int, price sdk.Dec) error {
+	if err := limits.ValidateMinOrderAmount(amt, price); err != nil {
+		return err
+	}
+	if limits.LotSize.IsPositive() && !amt.Mod(limits.LotSize).IsZero() {
+		return sdkerrors.Wrapf(ErrInvalidLotSize, "amount %s is not a multiple of the lot size %s", amt, limits.LotSize)
+	}
 	return nil
 }
 
-// PairEscrowAddress returns a unique address of the pair's escrow.
+// ValidateMinOrderAmount validates the amount of an order at the price
+// against the min order amounts.
+func (limits PairOrderAmountLimits) ValidateMinOrderAmount(amt sdk.Int, price sdk.Dec) error {
+	if amt.LT(limits.MinBaseOrderAmount) {
+		return sdkerrors.Wrapf(
+			ErrTooSmallOrder, "amount %s is smaller than the min base order amount %s", amt, limits.MinBaseOrderAmount)
+	}
+	if limits.MinQuoteOrderAmount.IsPositive() {
+		// An overflowed quote amount is large enough.
+		if quoteAmt, err := amm.SafeQuoteAmount(price, amt); err == nil && quoteAmt.LT(limits.MinQuoteOrderAmount) {
+			return sdkerrors.Wrapf(
+				ErrTooSmallOrder, "quote amount %s is smaller than the min quote order amount %s", quoteAmt, limits.MinQuoteOrderAmount)
+		}
+	}
+	return nil
+}
+
+// NewPairMetadata returns a new PairMetadata.
+func NewPairMetadata(displayName, logoURIHash string, baseCoinDecimals, quoteCoinDecimals uint32) PairMetadata {
+	return PairMetadata{
+		DisplayName:       displayName,
+		LogoUriHash:       logoURIHash,
+		BaseCoinDecimals:  baseCoinDecimals,
+		QuoteCoinDecimals: quoteCoinDecimals,
+	}
+}
+
+// Validate validates PairMetadata.
+// The logo URI hash is optional, but it must be a hex-encoded SHA-256 hash
+// when it is given.
+func (metadata PairMetadata) Validate() error {
+	if metadata.DisplayName == "" {
+		return fmt.Errorf("display name must not be empty")
+	}
+	if len(metadata.DisplayName) > MaxPairDisplayNameLength {
+		return fmt.Errorf("display name is too long: %d > %d", len(metadata.DisplayName), MaxPairDisplayNameLength)
+	}
+	if metadata.LogoUriHash != "" {
+		bz, err := hex.DecodeString(metadata.LogoUriHash)
+		if err != nil || len(bz) != sha256.Size {
+			return fmt.Errorf("logo uri hash must be a hex-encoded SHA-256 hash: %s", metadata.LogoUriHash)
+		}
+	}
+	if metadata.BaseCoinDecimals > sdk.Precision {
+		return fmt.Errorf("base coin decimals must not be greater than %d: %d", sdk.Precision, metadata.BaseCoinDecimals)
+	}
+	if metadata.QuoteCoinDecimals > sdk.Precision {
+		return fmt.Errorf("quote coin decimals must not be greater than %d: %d", sdk.Precision, metadata.QuoteCoinDecimals)
+	}
+	return nil
+}
+
+// IsBatchInWindowSelected returns whether the current batch is selected as
+// the batch in which a pair's orders are matched within the pair's batch window.
+// remainingBatches is the number of batches left in the window including the
+// current batch, so that each batch in the window is selected with the same
+// probability.
+// The selection is derived from the block header hash and the pair id, so it
+// can't be predicted before the block is proposed, while it can be verified by
+// anyone afterwards.
+func IsBatchInWindowSelected(headerHash []byte, pairId uint64, remainingBatches uint64) bool {
+	if remainingBatches <= 1 {
+		return true
+	}
+	h := sha256.New()
+	h.Write(headerHash)
+	h.Write(sdk.Uint64ToBigEndian(pairId))
+	return binary.BigEndian.Uint64(h.Sum(nil))%remainingBatches == 0
+}
+
+// PairEscrowAddress returns a unique address of the pair's escrow derived
+// with the current address version.
 func PairEscrowAddress(pairId uint64) sdk.AccAddress {
-	return farmingtypes.DeriveAddress(
-		AddressType,
-		ModuleName,
-		strings.Join([]string{PairEscrowAddressPrefix, strconv.FormatUint(pairId, 10)}, ModuleAddressNameSplitter))
+	return mustAddress(VersionedPairEscrowAddress(CurrentAddressVersion, pairId))
 }
 
 // MustMarshalPair returns the pair bytes.
@@ -0,0 +1,112 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// PairStatsBucketDuration is the duration each pair stats bucket covers.
+	PairStatsBucketDuration = time.Hour
+	// PairStatsWindow is the duration over which pair stats are aggregated.
+	PairStatsWindow = 24 * time.Hour
+)
+
+// PairStatsBucketStartTime returns the start time of the stats bucket which
+// covers t.
+func PairStatsBucketStartTime(t time.Time) time.Time {
+	return t.UTC().Truncate(PairStatsBucketDuration)
+}
+
+// PairStatsWindowStartTime returns the start time of the oldest stats bucket
+// within the stats window ending at t.
+// The window consists of the bucket covering t and the buckets of the
+// preceding hours, so it may start up to an hour earlier than t - 24h.
+func PairStatsWindowStartTime(t time.Time) time.Time {
+	return PairStatsBucketStartTime(t).Add(-PairStatsWindow + PairStatsBucketDuration)
+}
+
+// NewPairStatsBucket returns a new PairStatsBucket with no trades.
+func NewPairStatsBucket(pairId uint64, startTime time.Time) PairStatsBucket {
+	return PairStatsBucket{
+		PairId:      pairId,
+		StartTime:   startTime,
+		BaseVolume:  sdk.ZeroInt(),
+		QuoteVolume: sdk.ZeroInt(),
+		SwapFees:    sdk.Coins{},
+	}
+}
+
+// AddBatch adds the result of a batch matched at the price to the bucket.
+func (bucket *PairStatsBucket) AddBatch(
+	price sdk.Dec, baseVolume, quoteVolume sdk.Int, numMatchedOrders uint64, swapFees sdk.Coins) {
+	bucket.BaseVolume = bucket.BaseVolume.Add(baseVolume)
+	bucket.QuoteVolume = bucket.QuoteVolume.Add(quoteVolume)
+	bucket.NumMatchedOrders += numMatchedOrders
+	if bucket.HighPrice.IsNil() {
+		bucket.HighPrice = price
+		bucket.LowPrice = price
+	} else {
+		bucket.HighPrice = sdk.MaxDec(bucket.HighPrice, price)
+		bucket.LowPrice = sdk.MinDec(bucket.LowPrice, price)
+	}
+	bucket.SwapFees = bucket.SwapFees.Add(swapFees...)
+}
+
+// Validate validates PairStatsBucket for genesis.
+func (bucket PairStatsBucket) Validate() error {
+	if bucket.PairId == 0 {
+		return fmt.Errorf("pair id must not be 0")
+	}
+	if !bucket.StartTime.Equal(PairStatsBucketStartTime(bucket.StartTime)) {
+		return fmt.Errorf("start time must be on the hour: %s", bucket.StartTime)
+	}
+	if bucket.BaseVolume.IsNil() || bucket.BaseVolume.IsNegative() {
+		return fmt.Errorf("base volume must not be negative: %s", bucket.BaseVolume)
+	}
+	if bucket.QuoteVolume.IsNil() || bucket.QuoteVolume.IsNegative() {
+		return fmt.Errorf("quote volume must not be negative: %s", bucket.QuoteVolume)
+	}
+	if bucket.HighPrice.IsNil() || !bucket.HighPrice.IsPositive() {
+		return fmt.Errorf("high price must be positive: %s", bucket.HighPrice)
+	}
+	if bucket.LowPrice.IsNil() || !bucket.LowPrice.IsPositive() {
+		return fmt.Errorf("low price must be positive: %s", bucket.LowPrice)
+	}
+	if bucket.LowPrice.GT(bucket.HighPrice) {
+		return fmt.Errorf("low price must not be greater than high price: %s > %s", bucket.LowPrice, bucket.HighPrice)
+	}
+	if err := bucket.SwapFees.Validate(); err != nil {
+		return fmt.Errorf("invalid swap fees: %w", err)
+	}
+	return nil
+}
+
+// MakePairStats aggregates the stats buckets of a pair into the pair's stats
+// over the window starting at startTime.
+func MakePairStats(pairId uint64, startTime time.Time, buckets []PairStatsBucket) PairStatsResponse {
+	stats := PairStatsResponse{
+		PairId:      pairId,
+		StartTime:   startTime,
+		BaseVolume:  sdk.ZeroInt(),
+		QuoteVolume: sdk.ZeroInt(),
+		SwapFees:    sdk.Coins{},
+	}
+	for _, bucket := range buckets {
+		stats.BaseVolume = stats.BaseVolume.Add(bucket.BaseVolume)
+		stats.QuoteVolume = stats.QuoteVolume.Add(bucket.QuoteVolume)
+		stats.NumMatchedOrders += bucket.NumMatchedOrders
+		if stats.HighPrice == nil {
+			highPrice, lowPrice := bucket.HighPrice, bucket.LowPrice
+			stats.HighPrice, stats.LowPrice = &highPrice, &lowPrice
+		} else {
+			highPrice := sdk.MaxDec(*stats.HighPrice, bucket.HighPrice)
+			lowPrice := sdk.MinDec(*stats.LowPrice, bucket.LowPrice)
+			stats.HighPrice, stats.LowPrice = &highPrice, &lowPrice
+		}
+		stats.SwapFees = stats.SwapFees.Add(bucket.SwapFees...)
+	}
+	return stats
+}
@@ -1,11 +1,16 @@
 package types_test
 
 import (
+	"math/rand"
+	"strings"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
+	utils "github.com/crescent-network/crescent/v4/types"
+	"github.com/crescent-network/crescent/v4/x/liquidity/amm"
 	"github.com/crescent-network/crescent/v4/x/liquidity/types"
 )
 
@@ -63,6 +68,87 @@ func TestPair_Validate(t *testing.T) {
 			},
 			"current batch id must not be 0",
 		},
+		{
+			"",
+			func(pair *types.Pair) {
+				pair.PriceExponent = -19
+			},
+			"price exponent must not be lower than -18: -19",
+		},
+		{
+			"invalid creator",
+			func(pair *types.Pair) {
+				pair.Creator = "invalidaddr"
+			},
+			"invalid creator address invalidaddr: decoding bech32 failed: invalid separator index -1",
+		},
+		{
+			"valid metadata",
+			func(pair *types.Pair) {
+				metadata := types.NewPairMetadata("DENOM1/DENOM2", "", 6, 6)
+				pair.Metadata = &metadata
+			},
+			"",
+		},
+		{
+			"invalid metadata",
+			func(pair *types.Pair) {
+				metadata := types.NewPairMetadata("", "", 6, 6)
+				pair.Metadata = &metadata
+			},
+			"invalid metadata: display name must not be empty",
+		},
+		{
+			"valid order amount limits",
+			func(pair *types.Pair) {
+				limits := types.NewPairOrderAmountLimits(sdk.NewInt(1000), sdk.NewInt(1000), sdk.NewInt(100))
+				pair.OrderAmountLimits = &limits
+			},
+			"",
+		},
+		{
+			"invalid order amount limits",
+			func(pair *types.Pair) {
+				limits := types.NewPairOrderAmountLimits(sdk.NewInt(1000), sdk.NewInt(1000), sdk.NewInt(-1))
+				pair.OrderAmountLimits = &limits
+			},
+			"invalid order amount limits: lot size must not be negative: -1",
+		},
+		{
+			"valid pair params",
+			func(pair *types.Pair) {
+				upward, downward := utils.ParseDec("0.1"), utils.ParseDec("0.05")
+				lifespan := 48 * time.Hour
+				pair.UpwardPriceLimitRatio = &upward
+				pair.DownwardPriceLimitRatio = &downward
+				pair.MaxOrderLifespan = &lifespan
+			},
+			"",
+		},
+		{
+			"negative upward price limit ratio",
+			func(pair *types.Pair) {
+				ratio := utils.ParseDec("-0.05")
+				pair.UpwardPriceLimitRatio = &ratio
+			},
+			"upward price limit ratio must not be negative: -0.050000000000000000",
+		},
+		{
+			"negative downward price limit ratio",
+			func(pair *types.Pair) {
+				ratio := utils.ParseDec("-0.05")
+				pair.DownwardPriceLimitRatio = &ratio
+			},
+			"downward price limit ratio must be in range [0, 1): -0.050000000000000000",
+		},
+		{
+			"negative max order lifespan",
+			func(pair *types.Pair) {
+				lifespan := -time.Hour
+				pair.MaxOrderLifespan = &lifespan
+			},
+			"max order lifespan must not be negative: -1h0m0s",
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			pair := types.NewPair(1, "denom1", "denom2")
@@ -77,6 +163,112 @@ func TestPair_Validate(t *testing.T) {
 	}
 }
 
+func TestPair_ValidateOrderAmount(t *testing.T) {
+	pair := types.NewPair(1, "denom1", "denom2")
+	// A pair without limits accepts any amount.
+	require.NoError(t, pair.ValidateOrderAmount(sdk.NewInt(1), utils.ParseDec("0.001")))
+
+	limits := types.NewPairOrderAmountLimits(sdk.NewInt(1000), sdk.NewInt(500), sdk.NewInt(100))
+	pair.OrderAmountLimits = &limits
+	for _, tc := range []struct {
+		name        string
+		amt         sdk.Int
+		price       sdk.Dec
+		expectedErr error
+	}{
+		{"happy case", sdk.NewInt(1000), utils.ParseDec("1.0"), nil},
+		{"too small base amount", sdk.NewInt(900), utils.ParseDec("1.0"), types.ErrTooSmallOrder},
+		{"too small quote amount", sdk.NewInt(1000), utils.ParseDec("0.4"), types.ErrTooSmallOrder},
+		{"not a multiple of the lot size", sdk.NewInt(1050), utils.ParseDec("1.0"), types.ErrInvalidLotSize},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := pair.ValidateOrderAmount(tc.amt, tc.price)
+			if tc.expectedErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expectedErr)
+			}
+		})
+	}
+	// The lot size is not checked for the min order amounts.
+	require.NoError(t, pair.ValidateMinOrderAmount(sdk.NewInt(1050), utils.ParseDec("1.0")))
+}
+
+func TestPairMetadata_Validate(t *testing.T) {
+	logoURIHash := "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+	for _, tc := range []struct {
+		name        string
+		malleate    func(metadata *types.PairMetadata)
+		expectedErr string
+	}{
+		{
+			"happy case",
+			func(metadata *types.PairMetadata) {},
+			"",
+		},
+		{
+			"empty logo uri hash",
+			func(metadata *types.PairMetadata) {
+				metadata.LogoUriHash = ""
+			},
+			"",
+		},
+		{
+			"empty display name",
+			func(metadata *types.PairMetadata) {
+				metadata.DisplayName = ""
+			},
+			"display name must not be empty",
+		},
+		{
+			"too long display name",
+			func(metadata *types.PairMetadata) {
+				metadata.DisplayName = strings.Repeat("A", 65)
+			},
+			"display name is too long: 65 > 64",
+		},
+		{
+			"invalid logo uri hash",
+			func(metadata *types.PairMetadata) {
+				metadata.LogoUriHash = "invalidhash"
+			},
+			"logo uri hash must be a hex-encoded SHA-256 hash: invalidhash",
+		},
+		{
+			"wrong length logo uri hash",
+			func(metadata *types.PairMetadata) {
+				metadata.LogoUriHash = logoURIHash[:62]
+			},
+			"logo uri hash must be a hex-encoded SHA-256 hash: " + logoURIHash[:62],
+		},
+		{
+			"too large base coin decimals",
+			func(metadata *types.PairMetadata) {
+				metadata.BaseCoinDecimals = 19
+			},
+			"base coin decimals must not be greater than 18: 19",
+		},
+		{
+			"too large quote coin decimals",
+			func(metadata *types.PairMetadata) {
+				metadata.QuoteCoinDecimals = 19
+			},
+			"quote coin decimals must not be greater than 18: 19",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			metadata := types.NewPairMetadata("DENOM1/DENOM2", logoURIHash, 6, 18)
+			tc.malleate(&metadata)
+			err := metadata.Validate()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
 func TestPairEscrowAddress(t *testing.T) {
 	for _, tc := range []struct {
 		pairId   uint64
@@ -90,3 +282,148 @@ func TestPairEscrowAddress(t *testing.T) {
 		})
 	}
 }
+
+func TestPair_IsBootstrapping(t *testing.T) {
+	pair := types.NewPair(1, "denom1", "denom2")
+	require.False(t, pair.IsBootstrapping())
+	pair.BootstrapEndBatchId = 2
+	require.True(t, pair.IsBootstrapping())
+	pair.CurrentBatchId = 2
+	require.False(t, pair.IsBootstrapping())
+}
+
+func TestPair_OfferCoinDenom(t *testing.T) {
+	pair := types.NewPair(1, "denom1", "denom2")
+	require.Equal(t, "denom2", pair.OfferCoinDenom(amm.Buy))
+	require.Equal(t, "denom1", pair.OfferCoinDenom(amm.Sell))
+}
+
+func TestIsBatchInWindowSelected(t *testing.T) {
+	require.True(t, types.IsBatchInWindowSelected(nil, 1, 1))
+	require.True(t, types.IsBatchInWindowSelected(nil, 1, 0))
+
+	// Each batch in a window is selected with the same probability.
+	r := rand.New(rand.NewSource(0))
+	const window, numWindows = 5, 10000
+	counts := make([]int, window)
+	for i := 0; i < numWindows; i++ {
+		for pos := 0; pos < window; pos++ {
+			headerHash := make([]byte, 32)
+			r.Read(headerHash)
+			if types.IsBatchInWindowSelected(headerHash, 1, window-uint64(pos)) {
+				counts[pos]++
+				break
+			}
+		}
+	}
+	for _, cnt := range counts {
+		require.InDelta(t, numWindows/window, cnt, numWindows/window*0.1)
+	}
+}
+
+func TestScalePrice(t *testing.T) {
+	for _, tc := range []struct {
+		price    sdk.Dec
+		exp      int
+		expected sdk.Dec
+	}{
+		{utils.ParseDec("1.23"), 0, utils.ParseDec("1.23")},
+		{utils.ParseDec("1.23"), 12, utils.ParseDec("1230000000000")},
+		{utils.ParseDec("1.23"), -12, utils.ParseDec("0.00000000000123")},
+		{utils.ParseDec("1.23"), -18, utils.ParseDec("0.000000000000000001")},
+		{utils.ParseDec("1"), 18, utils.ParseDec("1000000000000000000")},
+	} {
+		t.Run("", func(t *testing.T) {
+			require.True(sdk.DecEq(t, tc.expected, types.ScalePrice(tc.price, tc.exp)))
+		})
+	}
+}
+
+func TestPair_NormalizePrice(t *testing.T) {
+	for _, exp := range []int32{0, 12, -12} {
+		pair := types.NewPair(1, "denom1", "denom2")
+		pair.PriceExponent = exp
+		displayPrice := utils.ParseDec("1.23")
+		rawPrice := pair.DenormalizePrice(displayPrice)
+		require.True(sdk.DecEq(t, types.ScalePrice(displayPrice, int(exp)), rawPrice))
+		require.True(sdk.DecEq(t, displayPrice, pair.NormalizePrice(rawPrice)))
+	}
+}
+
+func TestValidatePriceExponent(t *testing.T) {
+	for _, tc := range []struct {
+		exp         int32
+		tickPrec    int
+		expectedErr string
+	}{
+		{0, 3, ""},
+		{-12, 3, ""},
+		{-15, 3, ""},
+		{-16, 3, "price exponent -16 is out of range [-15, 72]"},
+		{72, 3, ""},
+		{73, 3, "price exponent 73 is out of range [-15, 72]"},
+		{-14, 4, ""},
+		{-15, 4, "price exponent -15 is out of range [-14, 72]"},
+	} {
+		t.Run("", func(t *testing.T) {
+			err := types.ValidatePriceExponent(tc.exp, tc.tickPrec)
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestPair_PriceBandWideningSteps(t *testing.T) {
+	for _, tc := range []struct {
+		numBatches, wideningBatches, maxSteps uint32
+		expected                              uint32
+	}{
+		{0, 0, 0, 0},
+		{10, 0, 3, 0},
+		{2, 3, 3, 0},
+		{3, 3, 3, 1},
+		{8, 3, 3, 2},
+		{100, 3, 3, 3},
+	} {
+		t.Run("", func(t *testing.T) {
+			pair := types.NewPair(1, "denom1", "denom2")
+			pair.NumBatchesWithoutTrade = tc.numBatches
+			require.Equal(t, tc.expected, pair.PriceBandWideningSteps(tc.wideningBatches, tc.maxSteps))
+		})
+	}
+}
+
+func TestWidenPriceLimitRatios(t *testing.T) {
+	for _, tc := range []struct {
+		upward, downward                 sdk.Dec
+		steps                            uint32
+		expectedUpward, expectedDownward sdk.Dec
+	}{
+		{utils.ParseDec("0.1"), utils.ParseDec("0.1"), 0, utils.ParseDec("0.1"), utils.ParseDec("0.1")},
+		{utils.ParseDec("0.1"), utils.ParseDec("0.1"), 1, utils.ParseDec("0.21"), utils.ParseDec("0.19")},
+		{utils.ParseDec("0.2"), utils.ParseDec("0.1"), 2, utils.ParseDec("0.728"), utils.ParseDec("0.271")},
+		{utils.ParseDec("0"), utils.ParseDec("0"), 5, utils.ParseDec("0"), utils.ParseDec("0")},
+	} {
+		t.Run("", func(t *testing.T) {
+			upward, downward := types.WidenPriceLimitRatios(tc.upward, tc.downward, tc.steps)
+			require.True(sdk.DecEq(t, tc.expectedUpward, upward))
+			require.True(sdk.DecEq(t, tc.expectedDownward, downward))
+		})
+	}
+}
+
+func TestWidenPriceLimitRatios_Overflow(t *testing.T) {
+	// (1 + 1000000)^21 overflows sdk.Dec, but the widened upward ratio is
+	// capped instead. The price limits are capped by the lowest and the
+	// highest price ticks.
+	upward, downward := types.WidenPriceLimitRatios(utils.ParseDec("1000000"), utils.ParseDec("0.9"), types.MaxPriceBandWideningStepsLimit)
+	require.True(sdk.DecEq(t, amm.HighestTick(0).Sub(sdk.OneDec()), upward))
+	require.True(sdk.DecEq(t, sdk.OneDec(), downward))
+
+	lowest, highest := types.PriceLimits(utils.ParseDec("1000000"), upward, downward, 4)
+	require.True(sdk.DecEq(t, amm.LowestTick(4), lowest))
+	require.True(sdk.DecEq(t, amm.HighestTick(4), highest))
+}
@@ -12,60 +12,128 @@ import (
 
 // Liquidity params default values
 const (
-	DefaultBatchSize                    uint32 = 1
-	DefaultTickPrecision                uint32 = 4
-	DefaultMaxNumMarketMakingOrderTicks        = 10
-	DefaultMaxOrderLifespan                    = 24 * time.Hour
-	DefaultMaxNumActivePoolsPerPair            = 20
+	DefaultBatchSize                      uint32 = 1
+	DefaultTickPrecision                  uint32 = 4
+	DefaultMaxNumMarketMakingOrderTicks          = 10
+	DefaultMaxOrderLifespan                      = 24 * time.Hour
+	DefaultMaxNumActivePoolsPerPair              = 20
+	DefaultMaxNumPrunedEntriesPerMsg             = 100
+	DefaultPriceHistoryLength                    = 100
+	DefaultNumBootstrapBatches                   = 0
+	DefaultPoolFeeSweepEpoch                     = 0
+	DefaultMaxOrderPriceTicks                    = 0
+	DefaultRequestResultRetention                = 24 * time.Hour
+	DefaultMaxNumAutoPrunedRequestResults        = 100
+	DefaultMaxNumActiveOrdersPerPair             = 100
+	DefaultPriceBandWideningBatches              = 0
+	DefaultMaxPriceBandWideningSteps             = 0
+	DefaultOrderRevealBatches                    = 3
 )
 
 // Liquidity params default values
 var (
-	DefaultFeeCollectorAddress      = farmingtypes.DeriveAddress(AddressType, ModuleName, "FeeCollector")
-	DefaultDustCollectorAddress     = farmingtypes.DeriveAddress(AddressType, ModuleName, "DustCollector")
-	DefaultMinInitialPoolCoinSupply = sdk.NewInt(1_000_000_000_000)
-	DefaultPairCreationFee          = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000000))
-	DefaultPoolCreationFee          = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000000))
-	DefaultMinInitialDepositAmount  = sdk.NewInt(1000000)
-	DefaultMaxPriceLimitRatio       = sdk.NewDecWithPrec(1, 1) // 10%
-	DefaultSwapFeeRate              = sdk.ZeroDec()
-	DefaultWithdrawFeeRate          = sdk.ZeroDec()
-	DefaultDepositExtraGas          = sdk.Gas(60000)
-	DefaultWithdrawExtraGas         = sdk.Gas(64000)
-	DefaultOrderExtraGas            = sdk.Gas(37000)
+	DefaultFeeCollectorAddress             = farmingtypes.DeriveAddress(AddressType, ModuleName, "FeeCollector")
+	DefaultDustCollectorAddress            = farmingtypes.DeriveAddress(AddressType, ModuleName, "DustCollector")
+	DefaultMinInitialPoolCoinSupply        = sdk.NewInt(1_000_000_000_000)
+	DefaultPairCreationFee                 = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000000))
+	DefaultPoolCreationFee                 = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000000))
+	DefaultMinInitialDepositAmount         = sdk.NewInt(1000000)
+	DefaultUpwardPriceLimitRatio           = sdk.NewDecWithPrec(1, 1) // 10%
+	DefaultDownwardPriceLimitRatio         = sdk.NewDecWithPrec(1, 1) // 10%
+	DefaultSwapFeeRate                     = sdk.ZeroDec()
+	DefaultWithdrawFeeRate                 = sdk.ZeroDec()
+	DefaultDepositExtraGas                 = sdk.Gas(60000)
+	DefaultWithdrawExtraGas                = sdk.Gas(64000)
+	DefaultOrderExtraGas                   = sdk.Gas(37000)
+	DefaultSwapFeeToPools                  = false
+	DefaultPruneRewardPerEntry             = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	DefaultCircuitBreakerEnabled           = false
+	DefaultMakerPriority                   = true
+	DefaultPairCreationFeeDestination      = PairCreationFeeDestinationFeeCollector
+	DefaultPermissionlessPairCreation      = true
+	DefaultPairCreatorAllowlist            = []string{}
+	DefaultBypassPoolPriceCheckForNewPairs = true
+	DefaultDustSweepEpoch                  = uint32(0)
+	DefaultInstantDepositWithdraw          = false
+	DefaultOrderCommitBond                 = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100000))
+)
+
+// MaxPriceHistoryLength is the upper bound of the PriceHistoryLength param.
+const MaxPriceHistoryLength = 10000
+
+// MaxPriceBandWideningStepsLimit is the upper bound of the
+// MaxPriceBandWideningSteps param.
+const MaxPriceBandWideningStepsLimit = 20
+
+// Pair creation fee destinations
+const (
+	PairCreationFeeDestinationFeeCollector  = "fee_collector"
+	PairCreationFeeDestinationCommunityPool = "community_pool"
+	PairCreationFeeDestinationBurn          = "burn"
 )
 
 // General constants
 const (
-	PoolReserveAddressPrefix  = "PoolReserveAddress"
-	PairEscrowAddressPrefix   = "PairEscrowAddress"
-	ModuleAddressNameSplitter = "|"
-	AddressType               = farmingtypes.AddressType32Bytes
+	PoolReserveAddressPrefix     = "PoolReserveAddress"
+	PoolFeeAddressPrefix         = "PoolFeeAddress"
+	PairEscrowAddressPrefix      = "PairEscrowAddress"
+	VaultReserveAddressPrefix    = "VaultReserveAddress"
+	SwapRouteEscrowAddressPrefix = "SwapRouteEscrowAddress"
+	ModuleAddressNameSplitter    = "|"
+	OrderRefSeparator            = "-"
+	AddressType                  = farmingtypes.AddressType32Bytes
 )
 
 var (
 	// GlobalEscrowAddress is an escrow for deposit/withdraw requests.
 	GlobalEscrowAddress = farmingtypes.DeriveAddress(AddressType, ModuleName, "GlobalEscrow")
+
+	// PruneRewardPoolAddress holds the funds which are paid to users who prune
+	// the state through MsgPruneExpired.
+	PruneRewardPoolAddress = farmingtypes.DeriveAddress(AddressType, ModuleName, "PruneRewardPool")
 )
 
 var (
-	KeyBatchSize                    = []byte("BatchSize")
-	KeyTickPrecision                = []byte("TickPrecision")
-	KeyFeeCollectorAddress          = []byte("FeeCollectorAddress")
-	KeyDustCollectorAddress         = []byte("DustCollectorAddress")
-	KeyMinInitialPoolCoinSupply     = []byte("MinInitialPoolCoinSupply")
-	KeyPairCreationFee              = []byte("PairCreationFee")
-	KeyPoolCreationFee              = []byte("PoolCreationFee")
-	KeyMinInitialDepositAmount      = []byte("MinInitialDepositAmount")
-	KeyMaxPriceLimitRatio           = []byte("MaxPriceLimitRatio")
-	KeyMaxNumMarketMakingOrderTicks = []byte("MaxNumMarketMakingOrderTicks")
-	KeyMaxOrderLifespan             = []byte("MaxOrderLifespan")
-	KeySwapFeeRate                  = []byte("SwapFeeRate")
-	KeyWithdrawFeeRate              = []byte("WithdrawFeeRate")
-	KeyDepositExtraGas              = []byte("DepositExtraGas")
-	KeyWithdrawExtraGas             = []byte("WithdrawExtraGas")
-	KeyOrderExtraGas                = []byte("OrderExtraGas")
-	KeyMaxNumActivePoolsPerPair     = []byte("MaxNumActivePoolsPerPair")
+	KeyBatchSize                       = []byte("BatchSize")
+	KeyTickPrecision                   = []byte("TickPrecision")
+	KeyFeeCollectorAddress             = []byte("FeeCollectorAddress")
+	KeyDustCollectorAddress            = []byte("DustCollectorAddress")
+	KeyMinInitialPoolCoinSupply        = []byte("MinInitialPoolCoinSupply")
+	KeyPairCreationFee                 = []byte("PairCreationFee")
+	KeyPoolCreationFee                 = []byte("PoolCreationFee")
+	KeyMinInitialDepositAmount         = []byte("MinInitialDepositAmount")
+	KeyMaxNumMarketMakingOrderTicks    = []byte("MaxNumMarketMakingOrderTicks")
+	KeyMaxOrderLifespan                = []byte("MaxOrderLifespan")
+	KeySwapFeeRate                     = []byte("SwapFeeRate")
+	KeyWithdrawFeeRate                 = []byte("WithdrawFeeRate")
+	KeyDepositExtraGas                 = []byte("DepositExtraGas")
+	KeyWithdrawExtraGas                = []byte("WithdrawExtraGas")
+	KeyOrderExtraGas                   = []byte("OrderExtraGas")
+	KeyMaxNumActivePoolsPerPair        = []byte("MaxNumActivePoolsPerPair")
+	KeySwapFeeToPools                  = []byte("SwapFeeToPools")
+	KeyMaxNumPrunedEntriesPerMsg       = []byte("MaxNumPrunedEntriesPerMsg")
+	KeyPruneRewardPerEntry             = []byte("PruneRewardPerEntry")
+	KeyPriceHistoryLength              = []byte("PriceHistoryLength")
+	KeyNumBootstrapBatches             = []byte("NumBootstrapBatches")
+	KeyPoolFeeSweepEpoch               = []byte("PoolFeeSweepEpoch")
+	KeyCircuitBreakerEnabled           = []byte("CircuitBreakerEnabled")
+	KeyMaxOrderPriceTicks              = []byte("MaxOrderPriceTicks")
+	KeyRequestResultRetention          = []byte("RequestResultRetention")
+	KeyMakerPriority                   = []byte("MakerPriority")
+	KeyMaxNumAutoPrunedRequestResults  = []byte("MaxNumAutoPrunedRequestResults")
+	KeyPairCreationFeeDestination      = []byte("PairCreationFeeDestination")
+	KeyPermissionlessPairCreation      = []byte("PermissionlessPairCreation")
+	KeyPairCreatorAllowlist            = []byte("PairCreatorAllowlist")
+	KeyBypassPoolPriceCheckForNewPairs = []byte("BypassPoolPriceCheckForNewPairs")
+	KeyDustSweepEpoch                  = []byte("DustSweepEpoch")
+	KeyInstantDepositWithdraw          = []byte("InstantDepositWithdraw")
+	KeyMaxNumActiveOrdersPerPair       = []byte("MaxNumActiveOrdersPerPair")
+	KeyUpwardPriceLimitRatio           = []byte("UpwardPriceLimitRatio")
+	KeyDownwardPriceLimitRatio         = []byte("DownwardPriceLimitRatio")
+	KeyPriceBandWideningBatches        = []byte("PriceBandWideningBatches")
+	KeyMaxPriceBandWideningSteps       = []byte("MaxPriceBandWideningSteps")
+	KeyOrderCommitBond                 = []byte("OrderCommitBond")
+	KeyOrderRevealBatches              = []byte("OrderRevealBatches")
 )
 
 var _ paramstypes.ParamSet = (*Params)(nil)
@@ -77,23 +145,46 @@ func ParamKeyTable() paramstypes.KeyTable {
 // DefaultParams returns a default params for the liquidity module.
 func DefaultParams() Params {
 	return Params{
-		BatchSize:                    DefaultBatchSize,
-		TickPrecision:                DefaultTickPrecision,
-		FeeCollectorAddress:          DefaultFeeCollectorAddress.String(),
-		DustCollectorAddress:         DefaultDustCollectorAddress.String(),
-		MinInitialPoolCoinSupply:     DefaultMinInitialPoolCoinSupply,
-		PairCreationFee:              DefaultPairCreationFee,
-		PoolCreationFee:              DefaultPoolCreationFee,
-		MinInitialDepositAmount:      DefaultMinInitialDepositAmount,
-		MaxPriceLimitRatio:           DefaultMaxPriceLimitRatio,
-		MaxNumMarketMakingOrderTicks: DefaultMaxNumMarketMakingOrderTicks,
-		MaxOrderLifespan:             DefaultMaxOrderLifespan,
-		SwapFeeRate:                  DefaultSwapFeeRate,
-		WithdrawFeeRate:              DefaultWithdrawFeeRate,
-		DepositExtraGas:              DefaultDepositExtraGas,
-		WithdrawExtraGas:             DefaultWithdrawExtraGas,
-		OrderExtraGas:                DefaultOrderExtraGas,
-		MaxNumActivePoolsPerPair:     DefaultMaxNumActivePoolsPerPair,
+		BatchSize:                       DefaultBatchSize,
+		TickPrecision:                   DefaultTickPrecision,
+		FeeCollectorAddress:             DefaultFeeCollectorAddress.String(),
+		DustCollectorAddress:            DefaultDustCollectorAddress.String(),
+		MinInitialPoolCoinSupply:        DefaultMinInitialPoolCoinSupply,
+		PairCreationFee:                 DefaultPairCreationFee,
+		PoolCreationFee:                 DefaultPoolCreationFee,
+		MinInitialDepositAmount:         DefaultMinInitialDepositAmount,
+		MaxNumMarketMakingOrderTicks:    DefaultMaxNumMarketMakingOrderTicks,
+		MaxOrderLifespan:                DefaultMaxOrderLifespan,
+		SwapFeeRate:                     DefaultSwapFeeRate,
+		WithdrawFeeRate:                 DefaultWithdrawFeeRate,
+		DepositExtraGas:                 DefaultDepositExtraGas,
+		WithdrawExtraGas:                DefaultWithdrawExtraGas,
+		OrderExtraGas:                   DefaultOrderExtraGas,
+		MaxNumActivePoolsPerPair:        DefaultMaxNumActivePoolsPerPair,
+		SwapFeeToPools:                  DefaultSwapFeeToPools,
+		MaxNumPrunedEntriesPerMsg:       DefaultMaxNumPrunedEntriesPerMsg,
+		PruneRewardPerEntry:             DefaultPruneRewardPerEntry,
+		PriceHistoryLength:              DefaultPriceHistoryLength,
+		NumBootstrapBatches:             DefaultNumBootstrapBatches,
+		PoolFeeSweepEpoch:               DefaultPoolFeeSweepEpoch,
+		CircuitBreakerEnabled:           DefaultCircuitBreakerEnabled,
+		MaxOrderPriceTicks:              DefaultMaxOrderPriceTicks,
+		RequestResultRetention:          DefaultRequestResultRetention,
+		MakerPriority:                   DefaultMakerPriority,
+		MaxNumAutoPrunedRequestResults:  DefaultMaxNumAutoPrunedRequestResults,
+		PairCreationFeeDestination:      DefaultPairCreationFeeDestination,
+		PermissionlessPairCreation:      DefaultPermissionlessPairCreation,
+		PairCreatorAllowlist:            DefaultPairCreatorAllowlist,
+		BypassPoolPriceCheckForNewPairs: DefaultBypassPoolPriceCheckForNewPairs,
+		DustSweepEpoch:                  DefaultDustSweepEpoch,
+		InstantDepositWithdraw:          DefaultInstantDepositWithdraw,
+		MaxNumActiveOrdersPerPair:       DefaultMaxNumActiveOrdersPerPair,
+		UpwardPriceLimitRatio:           DefaultUpwardPriceLimitRatio,
+		DownwardPriceLimitRatio:         DefaultDownwardPriceLimitRatio,
+		PriceBandWideningBatches:        DefaultPriceBandWideningBatches,
+		MaxPriceBandWideningSteps:       DefaultMaxPriceBandWideningSteps,
+		OrderCommitBond:                 DefaultOrderCommitBond,
+		OrderRevealBatches:              DefaultOrderRevealBatches,
 	}
 }
 
@@ -108,7 +199,6 @@ func (params *Params) ParamSetPairs() paramstypes.ParamSetPairs {
 		paramstypes.NewParamSetPair(KeyPairCreationFee, &params.PairCreationFee, validatePairCreationFee),
 		paramstypes.NewParamSetPair(KeyPoolCreationFee, &params.PoolCreationFee, validatePoolCreationFee),
 		paramstypes.NewParamSetPair(KeyMinInitialDepositAmount, &params.MinInitialDepositAmount, validateMinInitialDepositAmount),
-		paramstypes.NewParamSetPair(KeyMaxPriceLimitRatio, &params.MaxPriceLimitRatio, validateMaxPriceLimitRatio),
 		paramstypes.NewParamSetPair(KeyMaxNumMarketMakingOrderTicks, &params.MaxNumMarketMakingOrderTicks, validateMaxNumMarketMakingOrderTicks),
 		paramstypes.NewParamSetPair(KeyMaxOrderLifespan, &params.MaxOrderLifespan, validateMaxOrderLifespan),
 		paramstypes.NewParamSetPair(KeySwapFeeRate, &params.SwapFeeRate, validateSwapFeeRate),
@@ -117,6 +207,30 @@ func (params *Params) ParamSetPairs() paramstypes.ParamSetPairs {
 		paramstypes.NewParamSetPair(KeyWithdrawExtraGas, &params.WithdrawExtraGas, validateExtraGas),
 		paramstypes.NewParamSetPair(KeyOrderExtraGas, &params.OrderExtraGas, validateExtraGas),
 		paramstypes.NewParamSetPair(KeyMaxNumActivePoolsPerPair, &params.MaxNumActivePoolsPerPair, validateMaxNumActivePoolsPerPair),
+		paramstypes.NewParamSetPair(KeySwapFeeToPools, &params.SwapFeeToPools, validateSwapFeeToPools),
+		paramstypes.NewParamSetPair(KeyMaxNumPrunedEntriesPerMsg, &params.MaxNumPrunedEntriesPerMsg, validateMaxNumPrunedEntriesPerMsg),
+		paramstypes.NewParamSetPair(KeyPruneRewardPerEntry, &params.PruneRewardPerEntry, validatePruneRewardPerEntry),
+		paramstypes.NewParamSetPair(KeyPriceHistoryLength, &params.PriceHistoryLength, validatePriceHistoryLength),
+		paramstypes.NewParamSetPair(KeyNumBootstrapBatches, &params.NumBootstrapBatches, validateNumBootstrapBatches),
+		paramstypes.NewParamSetPair(KeyPoolFeeSweepEpoch, &params.PoolFeeSweepEpoch, validatePoolFeeSweepEpoch),
+		paramstypes.NewParamSetPair(KeyCircuitBreakerEnabled, &params.CircuitBreakerEnabled, validateCircuitBreakerEnabled),
+		paramstypes.NewParamSetPair(KeyMaxOrderPriceTicks, &params.MaxOrderPriceTicks, validateMaxOrderPriceTicks),
+		paramstypes.NewParamSetPair(KeyRequestResultRetention, &params.RequestResultRetention, validateRequestResultRetention),
+		paramstypes.NewParamSetPair(KeyMakerPriority, &params.MakerPriority, validateMakerPriority),
+		paramstypes.NewParamSetPair(KeyMaxNumAutoPrunedRequestResults, &params.MaxNumAutoPrunedRequestResults, validateMaxNumAutoPrunedRequestResults),
+		paramstypes.NewParamSetPair(KeyPairCreationFeeDestination, &params.PairCreationFeeDestination, validatePairCreationFeeDestination),
+		paramstypes.NewParamSetPair(KeyPermissionlessPairCreation, &params.PermissionlessPairCreation, validatePermissionlessPairCreation),
+		paramstypes.NewParamSetPair(KeyPairCreatorAllowlist, &params.PairCreatorAllowlist, validatePairCreatorAllowlist),
+		paramstypes.NewParamSetPair(KeyBypassPoolPriceCheckForNewPairs, &params.BypassPoolPriceCheckForNewPairs, validateBypassPoolPriceCheckForNewPairs),
+		paramstypes.NewParamSetPair(KeyDustSweepEpoch, &params.DustSweepEpoch, validateDustSweepEpoch),
+		paramstypes.NewParamSetPair(KeyInstantDepositWithdraw, &params.InstantDepositWithdraw, validateInstantDepositWithdraw),
+		paramstypes.NewParamSetPair(KeyMaxNumActiveOrdersPerPair, &params.MaxNumActiveOrdersPerPair, validateMaxNumActiveOrdersPerPair),
+		paramstypes.NewParamSetPair(KeyUpwardPriceLimitRatio, &params.UpwardPriceLimitRatio, validateUpwardPriceLimitRatio),
+		paramstypes.NewParamSetPair(KeyDownwardPriceLimitRatio, &params.DownwardPriceLimitRatio, validateDownwardPriceLimitRatio),
+		paramstypes.NewParamSetPair(KeyPriceBandWideningBatches, &params.PriceBandWideningBatches, validatePriceBandWideningBatches),
+		paramstypes.NewParamSetPair(KeyMaxPriceBandWideningSteps, &params.MaxPriceBandWideningSteps, validateMaxPriceBandWideningSteps),
+		paramstypes.NewParamSetPair(KeyOrderCommitBond, &params.OrderCommitBond, validateOrderCommitBond),
+		paramstypes.NewParamSetPair(KeyOrderRevealBatches, &params.OrderRevealBatches, validateOrderRevealBatches),
 	}
 }
 
@@ -134,7 +248,6 @@ func (params Params) Validate() error {
 		{params.PairCreationFee, validatePairCreationFee},
 		{params.PoolCreationFee, validatePoolCreationFee},
 		{params.MinInitialDepositAmount, validateMinInitialDepositAmount},
-		{params.MaxPriceLimitRatio, validateMaxPriceLimitRatio},
 		{params.MaxNumMarketMakingOrderTicks, validateMaxNumMarketMakingOrderTicks},
 		{params.MaxOrderLifespan, validateMaxOrderLifespan},
 		{params.SwapFeeRate, validateSwapFeeRate},
@@ -143,6 +256,30 @@ func (params Params) Validate() error {
 		{params.WithdrawExtraGas, validateExtraGas},
 		{params.OrderExtraGas, validateExtraGas},
 		{params.MaxNumActivePoolsPerPair, validateMaxNumActivePoolsPerPair},
+		{params.SwapFeeToPools, validateSwapFeeToPools},
+		{params.MaxNumPrunedEntriesPerMsg, validateMaxNumPrunedEntriesPerMsg},
+		{params.PruneRewardPerEntry, validatePruneRewardPerEntry},
+		{params.PriceHistoryLength, validatePriceHistoryLength},
+		{params.NumBootstrapBatches, validateNumBootstrapBatches},
+		{params.PoolFeeSweepEpoch, validatePoolFeeSweepEpoch},
+		{params.CircuitBreakerEnabled, validateCircuitBreakerEnabled},
+		{params.MaxOrderPriceTicks, validateMaxOrderPriceTicks},
+		{params.RequestResultRetention, validateRequestResultRetention},
+		{params.MakerPriority, validateMakerPriority},
+		{params.MaxNumAutoPrunedRequestResults, validateMaxNumAutoPrunedRequestResults},
+		{params.PairCreationFeeDestination, validatePairCreationFeeDestination},
+		{params.PermissionlessPairCreation, validatePermissionlessPairCreation},
+		{params.PairCreatorAllowlist, validatePairCreatorAllowlist},
+		{params.BypassPoolPriceCheckForNewPairs, validateBypassPoolPriceCheckForNewPairs},
+		{params.DustSweepEpoch, validateDustSweepEpoch},
+		{params.InstantDepositWithdraw, validateInstantDepositWithdraw},
+		{params.MaxNumActiveOrdersPerPair, validateMaxNumActiveOrdersPerPair},
+		{params.UpwardPriceLimitRatio, validateUpwardPriceLimitRatio},
+		{params.DownwardPriceLimitRatio, validateDownwardPriceLimitRatio},
+		{params.PriceBandWideningBatches, validatePriceBandWideningBatches},
+		{params.MaxPriceBandWideningSteps, validateMaxPriceBandWideningSteps},
+		{params.OrderCommitBond, validateOrderCommitBond},
+		{params.OrderRevealBatches, validateOrderRevealBatches},
 	} {
 		if err := field.validateFunc(field.val); err != nil {
 			return err
@@ -255,19 +392,6 @@ func validateMinInitialDepositAmount(i interface{}) error {
 	return nil
 }
 
-func validateMaxPriceLimitRatio(i interface{}) error {
-	v, ok := i.(sdk.Dec)
-	if !ok {
-		return fmt.Errorf("invalid parameter type: %T", i)
-	}
-
-	if v.IsNegative() {
-		return fmt.Errorf("max price limit ratio must not be negative: %s", v)
-	}
-
-	return nil
-}
-
 func validateMaxNumMarketMakingOrderTicks(i interface{}) error {
 	v, ok := i.(uint32)
 	if !ok {
@@ -304,6 +428,10 @@ func validateSwapFeeRate(i interface{}) error {
 		return fmt.Errorf("swap fee rate must not be negative: %s", v)
 	}
 
+	if v.GTE(sdk.OneDec()) {
+		return fmt.Errorf("swap fee rate must be less than 1: %s", v)
+	}
+
 	return nil
 }
 
@@ -317,6 +445,10 @@ func validateWithdrawFeeRate(i interface{}) error {
 		return fmt.Errorf("withdraw fee rate must not be negative: %s", v)
 	}
 
+	if v.GTE(sdk.OneDec()) {
+		return fmt.Errorf("withdraw fee rate must be less than 1: %s", v)
+	}
+
 	return nil
 }
 
@@ -336,3 +468,242 @@ func validateMaxNumActivePoolsPerPair(i interface{}) error {
 	}
 	return nil
 }
+
+func validateSwapFeeToPools(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateMaxNumPrunedEntriesPerMsg(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("max number of pruned entries per msg must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validatePruneRewardPerEntry(i interface{}) error {
+	v, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if err := v.Validate(); err != nil {
+		return fmt.Errorf("invalid prune reward per entry: %w", err)
+	}
+
+	return nil
+}
+
+func validatePriceHistoryLength(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v > MaxPriceHistoryLength {
+		return fmt.Errorf("price history length must not be greater than %d: %d", MaxPriceHistoryLength, v)
+	}
+	return nil
+}
+
+func validateNumBootstrapBatches(i interface{}) error {
+	_, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validatePoolFeeSweepEpoch(i interface{}) error {
+	_, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateCircuitBreakerEnabled(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateMaxOrderPriceTicks(i interface{}) error {
+	_, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateRequestResultRetention(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("request result retention must not be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateMakerPriority(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateMaxNumAutoPrunedRequestResults(i interface{}) error {
+	_, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validatePairCreationFeeDestination(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	switch v {
+	case PairCreationFeeDestinationFeeCollector, PairCreationFeeDestinationCommunityPool, PairCreationFeeDestinationBurn:
+	default:
+		return fmt.Errorf("invalid pair creation fee destination: %q", v)
+	}
+
+	return nil
+}
+
+func validatePermissionlessPairCreation(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validatePairCreatorAllowlist(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	addrs := map[string]struct{}{}
+	for _, addr := range v {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return fmt.Errorf("invalid pair creator address %s: %w", addr, err)
+		}
+		if _, ok := addrs[addr]; ok {
+			return fmt.Errorf("duplicate pair creator address %s", addr)
+		}
+		addrs[addr] = struct{}{}
+	}
+
+	return nil
+}
+
+func validateBypassPoolPriceCheckForNewPairs(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateDustSweepEpoch(i interface{}) error {
+	_, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateInstantDepositWithdraw(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateMaxNumActiveOrdersPerPair(i interface{}) error {
+	_, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateUpwardPriceLimitRatio(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return ValidateUpwardPriceLimitRatio(v)
+}
+
+func validateDownwardPriceLimitRatio(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return ValidateDownwardPriceLimitRatio(v)
+}
+
+func validatePriceBandWideningBatches(i interface{}) error {
+	_, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateMaxPriceBandWideningSteps(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v > MaxPriceBandWideningStepsLimit {
+		return fmt.Errorf("max price band widening steps must not be greater than %d: %d", MaxPriceBandWideningStepsLimit, v)
+	}
+	return nil
+}
+
+func validateOrderCommitBond(i interface{}) error {
+	v, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if err := v.Validate(); err != nil {
+		return fmt.Errorf("invalid order commit bond: %w", err)
+	}
+
+	return nil
+}
+
+func validateOrderRevealBatches(i interface{}) error {
+	_, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -78,11 +79,39 @@ func TestParams_Validate(t *testing.T) {
 			"minimum initial deposit amount must not be negative: -1",
 		},
 		{
-			"negative MaxPriceLimitRatio",
+			"negative UpwardPriceLimitRatio",
 			func(params *types.Params) {
-				params.MaxPriceLimitRatio = sdk.NewDec(-1)
+				params.UpwardPriceLimitRatio = sdk.NewDec(-1)
 			},
-			"max price limit ratio must not be negative: -1.000000000000000000",
+			"upward price limit ratio must not be negative: -1.000000000000000000",
+		},
+		{
+			"negative DownwardPriceLimitRatio",
+			func(params *types.Params) {
+				params.DownwardPriceLimitRatio = sdk.NewDec(-1)
+			},
+			"downward price limit ratio must be in range [0, 1): -1.000000000000000000",
+		},
+		{
+			"too large DownwardPriceLimitRatio",
+			func(params *types.Params) {
+				params.DownwardPriceLimitRatio = sdk.OneDec()
+			},
+			"downward price limit ratio must be in range [0, 1): 1.000000000000000000",
+		},
+		{
+			"too large PriceHistoryLength",
+			func(params *types.Params) {
+				params.PriceHistoryLength = types.MaxPriceHistoryLength + 1
+			},
+			"price history length must not be greater than 10000: 10001",
+		},
+		{
+			"too large MaxPriceBandWideningSteps",
+			func(params *types.Params) {
+				params.MaxPriceBandWideningSteps = types.MaxPriceBandWideningStepsLimit + 1
+			},
+			"max price band widening steps must not be greater than 20: 21",
 		},
 		{
 			"zero MaxNumMarketMakingOrderTicks",
@@ -105,6 +134,13 @@ func TestParams_Validate(t *testing.T) {
 			},
 			"swap fee rate must not be negative: -1.000000000000000000",
 		},
+		{
+			"too large SwapFeeRate",
+			func(params *types.Params) {
+				params.SwapFeeRate = sdk.OneDec()
+			},
+			"swap fee rate must be less than 1: 1.000000000000000000",
+		},
 		{
 			"negative WithdrawFeeRate",
 			func(params *types.Params) {
@@ -112,6 +148,56 @@ func TestParams_Validate(t *testing.T) {
 			},
 			"withdraw fee rate must not be negative: -1.000000000000000000",
 		},
+		{
+			"too large WithdrawFeeRate",
+			func(params *types.Params) {
+				params.WithdrawFeeRate = sdk.OneDec()
+			},
+			"withdraw fee rate must be less than 1: 1.000000000000000000",
+		},
+		{
+			"zero MaxNumPrunedEntriesPerMsg",
+			func(params *types.Params) {
+				params.MaxNumPrunedEntriesPerMsg = 0
+			},
+			"max number of pruned entries per msg must be positive: 0",
+		},
+		{
+			"invalid PruneRewardPerEntry",
+			func(params *types.Params) {
+				params.PruneRewardPerEntry = sdk.Coins{sdk.NewInt64Coin("stake", 0)}
+			},
+			"invalid prune reward per entry: coin 0stake amount is not positive",
+		},
+		{
+			"invalid PairCreationFeeDestination",
+			func(params *types.Params) {
+				params.PairCreationFeeDestination = "treasury"
+			},
+			"invalid pair creation fee destination: \"treasury\"",
+		},
+		{
+			"invalid PairCreatorAllowlist",
+			func(params *types.Params) {
+				params.PairCreatorAllowlist = []string{"invalidaddr"}
+			},
+			"invalid pair creator address invalidaddr: decoding bech32 failed: invalid separator index -1",
+		},
+		{
+			"duplicate PairCreatorAllowlist",
+			func(params *types.Params) {
+				addr := sdk.AccAddress(crypto.AddressHash([]byte("creator"))).String()
+				params.PairCreatorAllowlist = []string{addr, addr}
+			},
+			"duplicate pair creator address " + sdk.AccAddress(crypto.AddressHash([]byte("creator"))).String(),
+		},
+		{
+			"invalid OrderCommitBond",
+			func(params *types.Params) {
+				params.OrderCommitBond = sdk.Coins{sdk.NewInt64Coin("stake", 0)}
+			},
+			"invalid order commit bond: coin 0stake amount is not positive",
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			params := types.DefaultParams()
@@ -4,12 +4,10 @@ import (
 	"fmt"
 	"regexp"
 	"strconv"
-	"strings"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
-	farmingtypes "github.com/crescent-network/crescent/v4/x/farming/types"
 	"github.com/crescent-network/crescent/v4/x/liquidity/amm"
 )
 
@@ -21,11 +19,13 @@ var (
 
 // PoolReserveAddress returns a unique pool reserve account address for each pool.
 func PoolReserveAddress(poolId uint64) sdk.AccAddress {
-	return farmingtypes.DeriveAddress(
-		AddressType,
-		ModuleName,
-		strings.Join([]string{PoolReserveAddressPrefix, strconv.FormatUint(poolId, 10)}, ModuleAddressNameSplitter),
-	)
+	return mustAddress(VersionedPoolReserveAddress(CurrentAddressVersion, poolId))
+}
+
+// PoolFeeAddress returns a unique address for each pool which holds swap fees
+// earned by the pool until they are swept into the pool's reserve.
+func PoolFeeAddress(poolId uint64) sdk.AccAddress {
+	return mustAddress(VersionedPoolFeeAddress(CurrentAddressVersion, poolId))
 }
 
 // PoolCoinDenom returns a unique pool coin denom for a pool.
@@ -58,6 +58,7 @@ func NewBasicPool(id, pairId uint64, creator sdk.AccAddress) Pool {
 		LastDepositRequestId:  0,
 		LastWithdrawRequestId: 0,
 		Disabled:              false,
+		AddressVersion:        CurrentAddressVersion,
 	}
 }
 
@@ -75,6 +76,7 @@ func NewRangedPool(id, pairId uint64, creator sdk.AccAddress, minPrice, maxPrice
 		LastDepositRequestId:  0,
 		LastWithdrawRequestId: 0,
 		Disabled:              false,
+		AddressVersion:        CurrentAddressVersion,
 	}
 }
 
@@ -108,9 +110,41 @@ func (pool Pool) Validate() error {
 	if _, err := sdk.AccAddressFromBech32(pool.ReserveAddress); err != nil {
 		return fmt.Errorf("invalid reserve address %s: %w", pool.ReserveAddress, err)
 	}
+	if err := ValidateAddressVersion(pool.AddressVersion); err != nil {
+		return err
+	}
 	if err := sdk.ValidateDenom(pool.PoolCoinDenom); err != nil {
 		return fmt.Errorf("invalid pool coin denom: %w", err)
 	}
+	if err := ValidateMaxReserveAmount(pool.MaxReserveAmount); err != nil {
+		return err
+	}
+	return nil
+}
+
+// CapDepositCoins returns the deposit coins reduced so that the pool's
+// reserve doesn't exceed the pool's MaxReserveAmount after the deposit,
+// along with whether any of the coins is reduced.
+// Coins whose denom has no room left in the reserve are removed.
+func (pool Pool) CapDepositCoins(reserveCoins, depositCoins sdk.Coins) (capped sdk.Coins, reduced bool) {
+	for _, coin := range depositCoins {
+		if maxAmt := pool.MaxReserveAmount.AmountOf(coin.Denom); maxAmt.IsPositive() {
+			room := maxAmt.Sub(reserveCoins.AmountOf(coin.Denom))
+			if coin.Amount.GT(room) {
+				coin.Amount = sdk.MaxInt(room, sdk.ZeroInt())
+				reduced = true
+			}
+		}
+		capped = capped.Add(coin)
+	}
+	return capped, reduced
+}
+
+// ValidateMaxReserveAmount validates the caps of a pool's reserve.
+func ValidateMaxReserveAmount(maxReserveAmt sdk.Coins) error {
+	if err := maxReserveAmt.Validate(); err != nil {
+		return fmt.Errorf("invalid max reserve amount %s: %w", maxReserveAmt, err)
+	}
 	return nil
 }
 
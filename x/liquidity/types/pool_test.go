@@ -5,6 +5,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	utils "github.com/crescent-network/crescent/v4/types"
 	"github.com/crescent-network/crescent/v4/x/liquidity/types"
 )
 
@@ -22,6 +23,20 @@ func TestPoolReserveAddress(t *testing.T) {
 	}
 }
 
+func TestPoolFeeAddress(t *testing.T) {
+	for _, tc := range []struct {
+		poolId   uint64
+		expected string
+	}{
+		{1, "cosmos1aumrc6fd6e6ghs9swkzv79sghmapw46chmv2r2lz8zuskf6885msn84anf"},
+		{2, "cosmos105qlltph0zn4ejck7ynhu0f4rfxvvy6rjl0gvugvepn4zja9zmvq3wdhsu"},
+	} {
+		t.Run("", func(t *testing.T) {
+			require.Equal(t, tc.expected, types.PoolFeeAddress(tc.poolId).String())
+		})
+	}
+}
+
 func TestPoolCoinDenom(t *testing.T) {
 	for _, tc := range []struct {
 		poolId   uint64
@@ -64,3 +79,28 @@ func TestParsePoolCoinDenomFailure(t *testing.T) {
 		})
 	}
 }
+
+func TestPool_CapDepositCoins(t *testing.T) {
+	for _, tc := range []struct {
+		maxReserveAmt   string
+		reserveCoins    string
+		depositCoins    string
+		expectedCapped  string
+		expectedReduced bool
+	}{
+		{"", "1000000denom1,1000000denom2", "100000denom1,100000denom2", "100000denom1,100000denom2", false},
+		{"2000000denom1", "1000000denom1,1000000denom2", "100000denom1,100000denom2", "100000denom1,100000denom2", false},
+		{"1050000denom1", "1000000denom1,1000000denom2", "100000denom1,100000denom2", "50000denom1,100000denom2", true},
+		{"1050000denom1,1020000denom2", "1000000denom1,1000000denom2", "100000denom1,100000denom2", "50000denom1,20000denom2", true},
+		{"1000000denom1", "1000000denom1,1000000denom2", "100000denom1,100000denom2", "100000denom2", true},
+		{"900000denom1", "1000000denom1,1000000denom2", "100000denom1", "", true},
+	} {
+		t.Run("", func(t *testing.T) {
+			pool := types.NewBasicPool(1, 1, testAddr)
+			pool.MaxReserveAmount = utils.ParseCoins(tc.maxReserveAmt)
+			capped, reduced := pool.CapDepositCoins(utils.ParseCoins(tc.reserveCoins), utils.ParseCoins(tc.depositCoins))
+			require.Equal(t, tc.expectedCapped, capped.String())
+			require.Equal(t, tc.expectedReduced, reduced)
+		})
+	}
+}
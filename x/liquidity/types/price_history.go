@@ -0,0 +1,66 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewPriceHistoryEntry returns a new PriceHistoryEntry.
+func NewPriceHistoryEntry(pairId uint64, height int64, t time.Time, price sdk.Dec) PriceHistoryEntry {
+	return PriceHistoryEntry{
+		PairId: pairId,
+		Height: height,
+		Time:   t,
+		Price:  price,
+	}
+}
+
+// Validate validates PriceHistoryEntry for genesis.
+func (entry PriceHistoryEntry) Validate() error {
+	if entry.PairId == 0 {
+		return fmt.Errorf("pair id must not be 0")
+	}
+	if entry.Height <= 0 {
+		return fmt.Errorf("height must be positive: %d", entry.Height)
+	}
+	if entry.Price.IsNil() || !entry.Price.IsPositive() {
+		return fmt.Errorf("price must be positive: %s", entry.Price)
+	}
+	return nil
+}
+
+// MakeCandles groups consecutive price history entries into candles, each
+// of which covers at most interval entries.
+// entries must be sorted by their heights.
+// An interval of 0 is treated as 1.
+func MakeCandles(entries []PriceHistoryEntry, interval uint32) []CandleResponse {
+	if interval == 0 {
+		interval = 1
+	}
+	candles := []CandleResponse{}
+	for start := 0; start < len(entries); start += int(interval) {
+		end := start + int(interval)
+		if end > len(entries) {
+			end = len(entries)
+		}
+		first, last := entries[start], entries[end-1]
+		candle := CandleResponse{
+			StartHeight: first.Height,
+			EndHeight:   last.Height,
+			StartTime:   first.Time,
+			EndTime:     last.Time,
+			Open:        first.Price,
+			High:        first.Price,
+			Low:         first.Price,
+			Close:       last.Price,
+		}
+		for _, entry := range entries[start+1 : end] {
+			candle.High = sdk.MaxDec(candle.High, entry.Price)
+			candle.Low = sdk.MinDec(candle.Low, entry.Price)
+		}
+		candles = append(candles, candle)
+	}
+	return candles
+}
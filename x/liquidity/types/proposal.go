@@ -0,0 +1,314 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	gov "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/crescent-network/crescent/v4/x/liquidity/amm"
+)
+
+const (
+	ProposalTypePoolMigration         string = "PoolMigration"
+	ProposalTypePairMetadata          string = "PairMetadata"
+	ProposalTypePairCircuitBreaker    string = "PairCircuitBreaker"
+	ProposalTypePairBatchWindow       string = "PairBatchWindow"
+	ProposalTypePairOrderAmountLimits string = "PairOrderAmountLimits"
+	ProposalTypePairParams            string = "PairParams"
+	ProposalTypePoolMaxReserveAmount  string = "PoolMaxReserveAmount"
+)
+
+var (
+	_ gov.Content = &PoolMigrationProposal{}
+	_ gov.Content = &PairMetadataProposal{}
+	_ gov.Content = &PairCircuitBreakerProposal{}
+	_ gov.Content = &PairBatchWindowProposal{}
+	_ gov.Content = &PairOrderAmountLimitsProposal{}
+	_ gov.Content = &PairParamsProposal{}
+	_ gov.Content = &PoolMaxReserveAmountProposal{}
+)
+
+func init() {
+	gov.RegisterProposalType(ProposalTypePoolMigration)
+	gov.RegisterProposalTypeCodec(&PoolMigrationProposal{}, "crescent/PoolMigrationProposal")
+	gov.RegisterProposalType(ProposalTypePairMetadata)
+	gov.RegisterProposalTypeCodec(&PairMetadataProposal{}, "crescent/PairMetadataProposal")
+	gov.RegisterProposalType(ProposalTypePairCircuitBreaker)
+	gov.RegisterProposalTypeCodec(&PairCircuitBreakerProposal{}, "crescent/PairCircuitBreakerProposal")
+	gov.RegisterProposalType(ProposalTypePairBatchWindow)
+	gov.RegisterProposalTypeCodec(&PairBatchWindowProposal{}, "crescent/PairBatchWindowProposal")
+	gov.RegisterProposalType(ProposalTypePairOrderAmountLimits)
+	gov.RegisterProposalTypeCodec(&PairOrderAmountLimitsProposal{}, "crescent/PairOrderAmountLimitsProposal")
+	gov.RegisterProposalType(ProposalTypePairParams)
+	gov.RegisterProposalTypeCodec(&PairParamsProposal{}, "crescent/PairParamsProposal")
+	gov.RegisterProposalType(ProposalTypePoolMaxReserveAmount)
+	gov.RegisterProposalTypeCodec(&PoolMaxReserveAmountProposal{}, "crescent/PoolMaxReserveAmountProposal")
+}
+
+// NewPoolMigrationProposal returns a new PoolMigrationProposal.
+func NewPoolMigrationProposal(title, description string, poolId uint64, minPrice, maxPrice sdk.Dec) *PoolMigrationProposal {
+	return &PoolMigrationProposal{
+		Title:       title,
+		Description: description,
+		PoolId:      poolId,
+		MinPrice:    minPrice,
+		MaxPrice:    maxPrice,
+	}
+}
+
+func (p *PoolMigrationProposal) GetTitle() string       { return p.Title }
+func (p *PoolMigrationProposal) GetDescription() string { return p.Description }
+func (p *PoolMigrationProposal) ProposalRoute() string  { return RouterKey }
+func (p *PoolMigrationProposal) ProposalType() string   { return ProposalTypePoolMigration }
+
+func (p *PoolMigrationProposal) ValidateBasic() error {
+	if p.PoolId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "pool id must not be 0")
+	}
+	// The initial price of the new pool is determined by the basic pool's
+	// price at the time of execution, so only the price range is validated here.
+	if err := amm.ValidateRangedPoolParams(p.MinPrice, p.MaxPrice, p.MinPrice); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return gov.ValidateAbstract(p)
+}
+
+func (p PoolMigrationProposal) String() string {
+	return fmt.Sprintf(`Pool Migration Proposal:
+  Title:       %s
+  Description: %s
+  PoolId:      %d
+  MinPrice:    %s
+  MaxPrice:    %s
+`, p.Title, p.Description, p.PoolId, p.MinPrice, p.MaxPrice)
+}
+
+// NewPairMetadataProposal returns a new PairMetadataProposal.
+func NewPairMetadataProposal(title, description string, pairId uint64, metadata PairMetadata) *PairMetadataProposal {
+	return &PairMetadataProposal{
+		Title:       title,
+		Description: description,
+		PairId:      pairId,
+		Metadata:    metadata,
+	}
+}
+
+func (p *PairMetadataProposal) GetTitle() string       { return p.Title }
+func (p *PairMetadataProposal) GetDescription() string { return p.Description }
+func (p *PairMetadataProposal) ProposalRoute() string  { return RouterKey }
+func (p *PairMetadataProposal) ProposalType() string   { return ProposalTypePairMetadata }
+
+func (p *PairMetadataProposal) ValidateBasic() error {
+	if p.PairId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "pair id must not be 0")
+	}
+	if err := p.Metadata.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return gov.ValidateAbstract(p)
+}
+
+func (p PairMetadataProposal) String() string {
+	return fmt.Sprintf(`Pair Metadata Proposal:
+  Title:             %s
+  Description:       %s
+  PairId:            %d
+  DisplayName:       %s
+  LogoURIHash:       %s
+  BaseCoinDecimals:  %d
+  QuoteCoinDecimals: %d
+`, p.Title, p.Description, p.PairId, p.Metadata.DisplayName, p.Metadata.LogoUriHash,
+		p.Metadata.BaseCoinDecimals, p.Metadata.QuoteCoinDecimals)
+}
+
+// NewPairCircuitBreakerProposal returns a new PairCircuitBreakerProposal.
+func NewPairCircuitBreakerProposal(title, description string, pairId uint64, halted bool) *PairCircuitBreakerProposal {
+	return &PairCircuitBreakerProposal{
+		Title:       title,
+		Description: description,
+		PairId:      pairId,
+		Halted:      halted,
+	}
+}
+
+func (p *PairCircuitBreakerProposal) GetTitle() string       { return p.Title }
+func (p *PairCircuitBreakerProposal) GetDescription() string { return p.Description }
+func (p *PairCircuitBreakerProposal) ProposalRoute() string  { return RouterKey }
+func (p *PairCircuitBreakerProposal) ProposalType() string   { return ProposalTypePairCircuitBreaker }
+
+func (p *PairCircuitBreakerProposal) ValidateBasic() error {
+	if p.PairId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "pair id must not be 0")
+	}
+	return gov.ValidateAbstract(p)
+}
+
+func (p PairCircuitBreakerProposal) String() string {
+	return fmt.Sprintf(`Pair Circuit Breaker Proposal:
+  Title:       %s
+  Description: %s
+  PairId:      %d
+  Halted:      %t
+`, p.Title, p.Description, p.PairId, p.Halted)
+}
+
+// NewPairBatchWindowProposal returns a new PairBatchWindowProposal.
+func NewPairBatchWindowProposal(title, description string, pairId uint64, batchWindow uint32) *PairBatchWindowProposal {
+	return &PairBatchWindowProposal{
+		Title:       title,
+		Description: description,
+		PairId:      pairId,
+		BatchWindow: batchWindow,
+	}
+}
+
+func (p *PairBatchWindowProposal) GetTitle() string       { return p.Title }
+func (p *PairBatchWindowProposal) GetDescription() string { return p.Description }
+func (p *PairBatchWindowProposal) ProposalRoute() string  { return RouterKey }
+func (p *PairBatchWindowProposal) ProposalType() string   { return ProposalTypePairBatchWindow }
+
+func (p *PairBatchWindowProposal) ValidateBasic() error {
+	if p.PairId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "pair id must not be 0")
+	}
+	if p.BatchWindow > MaxPairBatchWindow {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "batch window must not be greater than %d: %d", MaxPairBatchWindow, p.BatchWindow)
+	}
+	return gov.ValidateAbstract(p)
+}
+
+func (p PairBatchWindowProposal) String() string {
+	return fmt.Sprintf(`Pair Batch Window Proposal:
+  Title:       %s
+  Description: %s
+  PairId:      %d
+  BatchWindow: %d
+`, p.Title, p.Description, p.PairId, p.BatchWindow)
+}
+
+// NewPairOrderAmountLimitsProposal returns a new PairOrderAmountLimitsProposal.
+func NewPairOrderAmountLimitsProposal(title, description string, pairId uint64, limits PairOrderAmountLimits) *PairOrderAmountLimitsProposal {
+	return &PairOrderAmountLimitsProposal{
+		Title:       title,
+		Description: description,
+		PairId:      pairId,
+		Limits:      limits,
+	}
+}
+
+func (p *PairOrderAmountLimitsProposal) GetTitle() string       { return p.Title }
+func (p *PairOrderAmountLimitsProposal) GetDescription() string { return p.Description }
+func (p *PairOrderAmountLimitsProposal) ProposalRoute() string  { return RouterKey }
+func (p *PairOrderAmountLimitsProposal) ProposalType() string {
+	return ProposalTypePairOrderAmountLimits
+}
+
+func (p *PairOrderAmountLimitsProposal) ValidateBasic() error {
+	if p.PairId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "pair id must not be 0")
+	}
+	if err := p.Limits.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return gov.ValidateAbstract(p)
+}
+
+func (p PairOrderAmountLimitsProposal) String() string {
+	return fmt.Sprintf(`Pair Order Amount Limits Proposal:
+  Title:               %s
+  Description:         %s
+  PairId:              %d
+  MinBaseOrderAmount:  %s
+  MinQuoteOrderAmount: %s
+  LotSize:             %s
+`, p.Title, p.Description, p.PairId, p.Limits.MinBaseOrderAmount, p.Limits.MinQuoteOrderAmount, p.Limits.LotSize)
+}
+
+// NewPairParamsProposal returns a new PairParamsProposal.
+func NewPairParamsProposal(
+	title, description string, pairId uint64, upwardPriceLimitRatio, downwardPriceLimitRatio *sdk.Dec,
+	maxOrderLifespan *time.Duration) *PairParamsProposal {
+	return &PairParamsProposal{
+		Title:                   title,
+		Description:             description,
+		PairId:                  pairId,
+		UpwardPriceLimitRatio:   upwardPriceLimitRatio,
+		DownwardPriceLimitRatio: downwardPriceLimitRatio,
+		MaxOrderLifespan:        maxOrderLifespan,
+	}
+}
+
+func (p *PairParamsProposal) GetTitle() string       { return p.Title }
+func (p *PairParamsProposal) GetDescription() string { return p.Description }
+func (p *PairParamsProposal) ProposalRoute() string  { return RouterKey }
+func (p *PairParamsProposal) ProposalType() string   { return ProposalTypePairParams }
+
+func (p *PairParamsProposal) ValidateBasic() error {
+	if p.PairId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "pair id must not be 0")
+	}
+	if err := ValidatePairParams(p.UpwardPriceLimitRatio, p.DownwardPriceLimitRatio, p.MaxOrderLifespan); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return gov.ValidateAbstract(p)
+}
+
+func (p PairParamsProposal) String() string {
+	upwardPriceLimitRatio, downwardPriceLimitRatio, maxOrderLifespan := "<nil>", "<nil>", "<nil>"
+	if p.UpwardPriceLimitRatio != nil {
+		upwardPriceLimitRatio = p.UpwardPriceLimitRatio.String()
+	}
+	if p.DownwardPriceLimitRatio != nil {
+		downwardPriceLimitRatio = p.DownwardPriceLimitRatio.String()
+	}
+	if p.MaxOrderLifespan != nil {
+		maxOrderLifespan = p.MaxOrderLifespan.String()
+	}
+	return fmt.Sprintf(`Pair Params Proposal:
+  Title:                   %s
+  Description:             %s
+  PairId:                  %d
+  UpwardPriceLimitRatio:   %s
+  DownwardPriceLimitRatio: %s
+  MaxOrderLifespan:        %s
+`, p.Title, p.Description, p.PairId, upwardPriceLimitRatio, downwardPriceLimitRatio, maxOrderLifespan)
+}
+
+// NewPoolMaxReserveAmountProposal returns a new PoolMaxReserveAmountProposal.
+func NewPoolMaxReserveAmountProposal(title, description string, poolId uint64, maxReserveAmt sdk.Coins) *PoolMaxReserveAmountProposal {
+	return &PoolMaxReserveAmountProposal{
+		Title:            title,
+		Description:      description,
+		PoolId:           poolId,
+		MaxReserveAmount: maxReserveAmt,
+	}
+}
+
+func (p *PoolMaxReserveAmountProposal) GetTitle() string       { return p.Title }
+func (p *PoolMaxReserveAmountProposal) GetDescription() string { return p.Description }
+func (p *PoolMaxReserveAmountProposal) ProposalRoute() string  { return RouterKey }
+func (p *PoolMaxReserveAmountProposal) ProposalType() string {
+	return ProposalTypePoolMaxReserveAmount
+}
+
+func (p *PoolMaxReserveAmountProposal) ValidateBasic() error {
+	if p.PoolId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "pool id must not be 0")
+	}
+	if err := ValidateMaxReserveAmount(p.MaxReserveAmount); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return gov.ValidateAbstract(p)
+}
+
+func (p PoolMaxReserveAmountProposal) String() string {
+	return fmt.Sprintf(`Pool Max Reserve Amount Proposal:
+  Title:            %s
+  Description:      %s
+  PoolId:           %d
+  MaxReserveAmount: %s
+`, p.Title, p.Description, p.PoolId, p.MaxReserveAmount)
+}
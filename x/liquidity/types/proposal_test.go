@@ -0,0 +1,393 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	utils "github.com/crescent-network/crescent/v4/types"
+	"github.com/crescent-network/crescent/v4/x/liquidity/types"
+)
+
+func TestPoolMigrationProposal_ValidateBasic(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		malleate    func(p *types.PoolMigrationProposal)
+		expectedErr string
+	}{
+		{
+			"happy case",
+			func(p *types.PoolMigrationProposal) {},
+			"",
+		},
+		{
+			"zero pool id",
+			func(p *types.PoolMigrationProposal) {
+				p.PoolId = 0
+			},
+			"pool id must not be 0: invalid request",
+		},
+		{
+			"wrong price range",
+			func(p *types.PoolMigrationProposal) {
+				p.MinPrice = utils.ParseDec("3.0")
+			},
+			"max price must be higher than min price: invalid request",
+		},
+		{
+			"empty title",
+			func(p *types.PoolMigrationProposal) {
+				p.Title = ""
+			},
+			"proposal title cannot be blank: invalid proposal content",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			p := types.NewPoolMigrationProposal("title", "description", 1, utils.ParseDec("0.5"), utils.ParseDec("2.0"))
+			tc.malleate(p)
+			err := p.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestPairMetadataProposal_ValidateBasic(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		malleate    func(p *types.PairMetadataProposal)
+		expectedErr string
+	}{
+		{
+			"happy case",
+			func(p *types.PairMetadataProposal) {},
+			"",
+		},
+		{
+			"zero pair id",
+			func(p *types.PairMetadataProposal) {
+				p.PairId = 0
+			},
+			"pair id must not be 0: invalid request",
+		},
+		{
+			"invalid metadata",
+			func(p *types.PairMetadataProposal) {
+				p.Metadata.BaseCoinDecimals = 19
+			},
+			"base coin decimals must not be greater than 18: 19: invalid request",
+		},
+		{
+			"empty title",
+			func(p *types.PairMetadataProposal) {
+				p.Title = ""
+			},
+			"proposal title cannot be blank: invalid proposal content",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			p := types.NewPairMetadataProposal("title", "description", 1, types.NewPairMetadata("DENOM1/DENOM2", "", 6, 6))
+			tc.malleate(p)
+			err := p.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestPairCircuitBreakerProposal_ValidateBasic(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		malleate    func(p *types.PairCircuitBreakerProposal)
+		expectedErr string
+	}{
+		{
+			"happy case",
+			func(p *types.PairCircuitBreakerProposal) {},
+			"",
+		},
+		{
+			"zero pair id",
+			func(p *types.PairCircuitBreakerProposal) {
+				p.PairId = 0
+			},
+			"pair id must not be 0: invalid request",
+		},
+		{
+			"empty title",
+			func(p *types.PairCircuitBreakerProposal) {
+				p.Title = ""
+			},
+			"proposal title cannot be blank: invalid proposal content",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			p := types.NewPairCircuitBreakerProposal("title", "description", 1, true)
+			tc.malleate(p)
+			err := p.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestPairBatchWindowProposal_ValidateBasic(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		malleate    func(p *types.PairBatchWindowProposal)
+		expectedErr string
+	}{
+		{
+			"happy case",
+			func(p *types.PairBatchWindowProposal) {},
+			"",
+		},
+		{
+			"disabling batch window",
+			func(p *types.PairBatchWindowProposal) {
+				p.BatchWindow = 0
+			},
+			"",
+		},
+		{
+			"zero pair id",
+			func(p *types.PairBatchWindowProposal) {
+				p.PairId = 0
+			},
+			"pair id must not be 0: invalid request",
+		},
+		{
+			"too large batch window",
+			func(p *types.PairBatchWindowProposal) {
+				p.BatchWindow = 101
+			},
+			"batch window must not be greater than 100: 101: invalid request",
+		},
+		{
+			"empty title",
+			func(p *types.PairBatchWindowProposal) {
+				p.Title = ""
+			},
+			"proposal title cannot be blank: invalid proposal content",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			p := types.NewPairBatchWindowProposal("title", "description", 1, 5)
+			tc.malleate(p)
+			err := p.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestPairOrderAmountLimitsProposal_ValidateBasic(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		malleate    func(p *types.PairOrderAmountLimitsProposal)
+		expectedErr string
+	}{
+		{
+			"happy case",
+			func(p *types.PairOrderAmountLimitsProposal) {},
+			"",
+		},
+		{
+			"removing limits",
+			func(p *types.PairOrderAmountLimitsProposal) {
+				p.Limits = types.NewPairOrderAmountLimits(sdk.ZeroInt(), sdk.ZeroInt(), sdk.ZeroInt())
+			},
+			"",
+		},
+		{
+			"zero pair id",
+			func(p *types.PairOrderAmountLimitsProposal) {
+				p.PairId = 0
+			},
+			"pair id must not be 0: invalid request",
+		},
+		{
+			"negative min base order amount",
+			func(p *types.PairOrderAmountLimitsProposal) {
+				p.Limits.MinBaseOrderAmount = sdk.NewInt(-1)
+			},
+			"min base order amount must not be negative: -1: invalid request",
+		},
+		{
+			"nil lot size",
+			func(p *types.PairOrderAmountLimitsProposal) {
+				p.Limits.LotSize = sdk.Int{}
+			},
+			"lot size must not be negative: <nil>: invalid request",
+		},
+		{
+			"empty title",
+			func(p *types.PairOrderAmountLimitsProposal) {
+				p.Title = ""
+			},
+			"proposal title cannot be blank: invalid proposal content",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			p := types.NewPairOrderAmountLimitsProposal(
+				"title", "description", 1,
+				types.NewPairOrderAmountLimits(sdk.NewInt(1000), sdk.NewInt(1000), sdk.NewInt(100)))
+			tc.malleate(p)
+			err := p.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestPairParamsProposal_ValidateBasic(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		malleate    func(p *types.PairParamsProposal)
+		expectedErr string
+	}{
+		{
+			"happy case",
+			func(p *types.PairParamsProposal) {},
+			"",
+		},
+		{
+			"no overrides",
+			func(p *types.PairParamsProposal) {
+				p.UpwardPriceLimitRatio = nil
+				p.DownwardPriceLimitRatio = nil
+				p.MaxOrderLifespan = nil
+			},
+			"",
+		},
+		{
+			"zero pair id",
+			func(p *types.PairParamsProposal) {
+				p.PairId = 0
+			},
+			"pair id must not be 0: invalid request",
+		},
+		{
+			"negative upward price limit ratio",
+			func(p *types.PairParamsProposal) {
+				ratio := utils.ParseDec("-0.1")
+				p.UpwardPriceLimitRatio = &ratio
+			},
+			"upward price limit ratio must not be negative: -0.100000000000000000: invalid request",
+		},
+		{
+			"nil upward price limit ratio",
+			func(p *types.PairParamsProposal) {
+				p.UpwardPriceLimitRatio = &sdk.Dec{}
+			},
+			"upward price limit ratio must not be negative: <nil>: invalid request",
+		},
+		{
+			"too large downward price limit ratio",
+			func(p *types.PairParamsProposal) {
+				ratio := utils.ParseDec("1")
+				p.DownwardPriceLimitRatio = &ratio
+			},
+			"downward price limit ratio must be in range [0, 1): 1.000000000000000000: invalid request",
+		},
+		{
+			"negative max order lifespan",
+			func(p *types.PairParamsProposal) {
+				lifespan := -time.Second
+				p.MaxOrderLifespan = &lifespan
+			},
+			"max order lifespan must not be negative: -1s: invalid request",
+		},
+		{
+			"empty title",
+			func(p *types.PairParamsProposal) {
+				p.Title = ""
+			},
+			"proposal title cannot be blank: invalid proposal content",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			upward, downward := utils.ParseDec("0.1"), utils.ParseDec("0.05")
+			lifespan := 48 * time.Hour
+			p := types.NewPairParamsProposal("title", "description", 1, &upward, &downward, &lifespan)
+			tc.malleate(p)
+			err := p.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestPoolMaxReserveAmountProposal_ValidateBasic(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		malleate    func(p *types.PoolMaxReserveAmountProposal)
+		expectedErr string
+	}{
+		{
+			"happy case",
+			func(p *types.PoolMaxReserveAmountProposal) {},
+			"",
+		},
+		{
+			"removing caps",
+			func(p *types.PoolMaxReserveAmountProposal) {
+				p.MaxReserveAmount = nil
+			},
+			"",
+		},
+		{
+			"zero pool id",
+			func(p *types.PoolMaxReserveAmountProposal) {
+				p.PoolId = 0
+			},
+			"pool id must not be 0: invalid request",
+		},
+		{
+			"zero max reserve amount",
+			func(p *types.PoolMaxReserveAmountProposal) {
+				p.MaxReserveAmount = sdk.Coins{sdk.NewInt64Coin("denom1", 0)}
+			},
+			"invalid max reserve amount 0denom1: coin 0denom1 amount is not positive: invalid request",
+		},
+		{
+			"empty title",
+			func(p *types.PoolMaxReserveAmountProposal) {
+				p.Title = ""
+			},
+			"proposal title cannot be blank: invalid proposal content",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			p := types.NewPoolMaxReserveAmountProposal(
+				"title", "description", 1, utils.ParseCoins("1000000denom1,1000000denom2"))
+			tc.malleate(p)
+			err := p.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
@@ -2,6 +2,8 @@ package types
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -19,6 +21,23 @@ func NewDepositRequest(msg *MsgDeposit, pool Pool, id uint64, msgHeight int64) D
 		AcceptedCoins:  nil,
 		MintedPoolCoin: sdk.NewCoin(pool.PoolCoinDenom, sdk.ZeroInt()),
 		Status:         RequestStatusNotExecuted,
+		Farm:           msg.Farm,
+	}
+}
+
+// NewSingleAssetDepositRequest returns a new DepositRequest made by
+// MsgDepositSingleAsset.
+func NewSingleAssetDepositRequest(msg *MsgDepositSingleAsset, pool Pool, id uint64, msgHeight int64) DepositRequest {
+	return DepositRequest{
+		Id:             id,
+		PoolId:         msg.PoolId,
+		MsgHeight:      msgHeight,
+		Depositor:      msg.Depositor,
+		DepositCoins:   sdk.NewCoins(msg.DepositCoin),
+		AcceptedCoins:  nil,
+		MintedPoolCoin: sdk.NewCoin(pool.PoolCoinDenom, sdk.ZeroInt()),
+		Status:         RequestStatusNotExecuted,
+		SingleAsset:    true,
 	}
 }
 
@@ -50,6 +69,9 @@ func (req DepositRequest) Validate() error {
 	if len(req.DepositCoins) == 0 || len(req.DepositCoins) > 2 {
 		return fmt.Errorf("wrong number of deposit coins: %d", len(req.DepositCoins))
 	}
+	if req.SingleAsset && len(req.DepositCoins) != 1 {
+		return fmt.Errorf("single asset deposit must have only one deposit coin: %d", len(req.DepositCoins))
+	}
 	if err := req.AcceptedCoins.Validate(); err != nil {
 		return fmt.Errorf("invalid accepted coins: %w", err)
 	}
@@ -153,6 +175,7 @@ func NewOrderForLimitOrder(msg *MsgLimitOrder, id uint64, pair Pair, offerCoin s
 		BatchId:            pair.CurrentBatchId,
 		ExpireAt:           expireAt,
 		Status:             OrderStatusNotExecuted,
+		MinFillAmount:      msg.MinFillAmount,
 	}
 }
 
@@ -268,6 +291,9 @@ func (order Order) Validate() error {
 	if !order.Status.IsValid() {
 		return fmt.Errorf("invalid status: %s", order.Status)
 	}
+	if order.MinFillAmount != nil && !order.MinFillAmount.IsPositive() {
+		return fmt.Errorf("min fill amount must be positive: %s", order.MinFillAmount)
+	}
 	return nil
 }
 
@@ -276,6 +302,35 @@ func (order Order) ExpiredAt(t time.Time) bool {
 	return !order.ExpireAt.After(t)
 }
 
+// Ref returns the reference of the order, see OrderRef.
+func (order Order) Ref() string {
+	return OrderRef(order.PairId, order.Id)
+}
+
+// OrderRef returns the reference of an order, which identifies the order
+// across all pairs since order ids are unique only within a pair.
+// The reference is formatted as "<pair id>-<order id>".
+func OrderRef(pairId, orderId uint64) string {
+	return strconv.FormatUint(pairId, 10) + OrderRefSeparator + strconv.FormatUint(orderId, 10)
+}
+
+// ParseOrderRef parses an order reference formatted by OrderRef.
+func ParseOrderRef(ref string) (pairId, orderId uint64, err error) {
+	chunks := strings.Split(ref, OrderRefSeparator)
+	if len(chunks) != 2 {
+		return 0, 0, fmt.Errorf("invalid order reference: %s", ref)
+	}
+	pairId, err = strconv.ParseUint(chunks[0], 10, 64)
+	if err != nil || pairId == 0 {
+		return 0, 0, fmt.Errorf("invalid pair id in order reference: %s", ref)
+	}
+	orderId, err = strconv.ParseUint(chunks[1], 10, 64)
+	if err != nil || orderId == 0 {
+		return 0, 0, fmt.Errorf("invalid order id in order reference: %s", ref)
+	}
+	return pairId, orderId, nil
+}
+
 // SetStatus sets the order's status.
 // SetStatus is to easily find locations where the status is changed.
 func (order *Order) SetStatus(status OrderStatus) {
@@ -361,6 +416,46 @@ func (status OrderStatus) ShouldBeDeleted() bool {
 	return status == OrderStatusCompleted || status.IsCanceledOrExpired()
 }
 
+// FailureReason is a machine-readable reason why a request or an order
+// failed at batch execution.
+// It is emitted along with the failure events so that clients can tell
+// failed requests apart from pending ones.
+type FailureReason string
+
+// Failure reasons of requests and orders.
+const (
+	// FailureReasonPoolDisabled is used when the pool was disabled.
+	FailureReasonPoolDisabled FailureReason = "pool_disabled"
+	// FailureReasonPoolDepleted is used when the pool's reserve was depleted.
+	FailureReasonPoolDepleted FailureReason = "pool_depleted"
+	// FailureReasonTooSmallDeposit is used when no pool coin could be minted
+	// for the deposit.
+	FailureReasonTooSmallDeposit FailureReason = "too_small_deposit"
+	// FailureReasonMaxReserveAmountReached is used when the pool's reserve
+	// reached its MaxReserveAmount and nothing could be deposited.
+	FailureReasonMaxReserveAmountReached FailureReason = "max_reserve_amount_reached"
+	// FailureReasonTooSmallWithdrawal is used when no reserve coin could be
+	// withdrawn for the pool coin.
+	FailureReasonTooSmallWithdrawal FailureReason = "too_small_withdrawal"
+	// FailureReasonTooSmallOrder is used when the order's open amount became
+	// too small to be matched.
+	FailureReasonTooSmallOrder FailureReason = "too_small_order"
+	// FailureReasonSwapOrderRejected is used when the order of a swap route
+	// couldn't be placed in the next pair of the route.
+	FailureReasonSwapOrderRejected FailureReason = "swap_order_rejected"
+	// FailureReasonNoSwapOutput is used when the order of a swap route
+	// received nothing from the pair.
+	FailureReasonNoSwapOutput FailureReason = "no_swap_output"
+	// FailureReasonMinOutNotMet is used when the coin received from the last
+	// pair of a swap route is less than the min out coin.
+	FailureReasonMinOutNotMet FailureReason = "min_out_not_met"
+)
+
+// String implements fmt.Stringer.
+func (reason FailureReason) String() string {
+	return string(reason)
+}
+
 // MustMarshalDepositRequest returns the DepositRequest bytes. Panics if fails.
 func MustMarshalDepositRequest(cdc codec.BinaryCodec, msg DepositRequest) []byte {
 	return cdc.MustMarshal(&msg)
@@ -0,0 +1,136 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewRequestResult returns a new RequestResult.
+func NewRequestResult(
+	typ RequestType, targetId, id uint64, requester string, code RequestResultCode,
+	reason FailureReason, height int64, t time.Time) RequestResult {
+	return RequestResult{
+		Type:           typ,
+		TargetId:       targetId,
+		Id:             id,
+		Requester:      requester,
+		Code:           code,
+		Reason:         reason.String(),
+		FinishedHeight: height,
+		FinishedAt:     t,
+	}
+}
+
+// NewDepositRequestResult returns a new RequestResult for the finished
+// deposit request.
+func NewDepositRequestResult(req DepositRequest, reason FailureReason, height int64, t time.Time) RequestResult {
+	return NewRequestResult(
+		RequestTypeDeposit, req.PoolId, req.Id, req.Depositor,
+		RequestResultCodeFromRequestStatus(req.Status), reason, height, t)
+}
+
+// NewWithdrawRequestResult returns a new RequestResult for the finished
+// withdraw request.
+func NewWithdrawRequestResult(req WithdrawRequest, reason FailureReason, height int64, t time.Time) RequestResult {
+	return NewRequestResult(
+		RequestTypeWithdraw, req.PoolId, req.Id, req.Withdrawer,
+		RequestResultCodeFromRequestStatus(req.Status), reason, height, t)
+}
+
+// NewOrderResult returns a new RequestResult for the finished order.
+// An order with a failure reason results in RequestResultCodeFailed,
+// regardless of its status.
+func NewOrderResult(order Order, reason FailureReason, height int64, t time.Time) RequestResult {
+	code := RequestResultCodeFromOrderStatus(order.Status)
+	if reason != "" {
+		code = RequestResultCodeFailed
+	}
+	return NewRequestResult(
+		RequestTypeOrder, order.PairId, order.Id, order.Orderer, code, reason, height, t)
+}
+
+// RequestResultCodeFromRequestStatus returns the result code corresponding to
+// the terminal request status.
+func RequestResultCodeFromRequestStatus(status RequestStatus) RequestResultCode {
+	switch status {
+	case RequestStatusSucceeded:
+		return RequestResultCodeSucceeded
+	case RequestStatusFailed:
+		return RequestResultCodeFailed
+	default:
+		return RequestResultCodeUnspecified
+	}
+}
+
+// RequestResultCodeFromOrderStatus returns the result code corresponding to
+// the terminal order status.
+func RequestResultCodeFromOrderStatus(status OrderStatus) RequestResultCode {
+	switch status {
+	case OrderStatusCompleted:
+		return RequestResultCodeSucceeded
+	case OrderStatusCanceled:
+		return RequestResultCodeCanceled
+	case OrderStatusExpired:
+		return RequestResultCodeExpired
+	default:
+		return RequestResultCodeUnspecified
+	}
+}
+
+// Validate validates RequestResult for genesis.
+func (result RequestResult) Validate() error {
+	if !result.Type.IsValid() {
+		return fmt.Errorf("invalid request type: %s", result.Type)
+	}
+	if result.TargetId == 0 {
+		return fmt.Errorf("target id must not be 0")
+	}
+	if result.Id == 0 {
+		return fmt.Errorf("id must not be 0")
+	}
+	if _, err := sdk.AccAddressFromBech32(result.Requester); err != nil {
+		return fmt.Errorf("invalid requester address %s: %w", result.Requester, err)
+	}
+	if !result.Code.IsValid() {
+		return fmt.Errorf("invalid result code: %s", result.Code)
+	}
+	if result.Code != RequestResultCodeFailed && result.Reason != "" {
+		return fmt.Errorf("reason must be empty for result code %s", result.Code)
+	}
+	if result.FinishedHeight <= 0 {
+		return fmt.Errorf("finished height must be positive: %d", result.FinishedHeight)
+	}
+	return nil
+}
+
+// IsPrunable returns whether the result has been kept for the retention
+// period and can be pruned at the given time.
+func (result RequestResult) IsPrunable(t time.Time, retention time.Duration) bool {
+	return !t.Before(result.FinishedAt.Add(retention))
+}
+
+// IsValid returns true if the RequestType is one of:
+// RequestTypeDeposit, RequestTypeWithdraw, RequestTypeOrder.
+func (typ RequestType) IsValid() bool {
+	switch typ {
+	case RequestTypeDeposit, RequestTypeWithdraw, RequestTypeOrder:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsValid returns true if the RequestResultCode is one of:
+// RequestResultCodeSucceeded, RequestResultCodeFailed,
+// RequestResultCodeCanceled, RequestResultCodeExpired.
+func (code RequestResultCode) IsValid() bool {
+	switch code {
+	case RequestResultCodeSucceeded, RequestResultCodeFailed,
+		RequestResultCodeCanceled, RequestResultCodeExpired:
+		return true
+	default:
+		return false
+	}
+}
@@ -379,3 +379,32 @@ func TestOrder_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestParseOrderRef(t *testing.T) {
+	for _, tc := range []struct {
+		ref             string
+		pairId, orderId uint64
+		expectedErr     string
+	}{
+		{"1-1", 1, 1, ""},
+		{"12-345", 12, 345, ""},
+		{"1", 0, 0, "invalid order reference: 1"},
+		{"1-2-3", 0, 0, "invalid order reference: 1-2-3"},
+		{"0-1", 0, 0, "invalid pair id in order reference: 0-1"},
+		{"a-1", 0, 0, "invalid pair id in order reference: a-1"},
+		{"1-0", 0, 0, "invalid order id in order reference: 1-0"},
+		{"1-", 0, 0, "invalid order id in order reference: 1-"},
+	} {
+		t.Run(tc.ref, func(t *testing.T) {
+			pairId, orderId, err := types.ParseOrderRef(tc.ref)
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+				require.Equal(t, tc.pairId, pairId)
+				require.Equal(t, tc.orderId, orderId)
+				require.Equal(t, tc.ref, types.OrderRef(pairId, orderId))
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
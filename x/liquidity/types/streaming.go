@@ -0,0 +1,66 @@
+package types
+
+import (
+	"bytes"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+)
+
+// DecodeStateChange decodes a KV pair of the liquidity store streamed by
+// ADR-038 streaming services into one of PairStateChange, PoolStateChange
+// and OrderStateChange.
+// It returns nil if the KV pair is of another store or of other states,
+// such as indexes and requests.
+func DecodeStateChange(cdc codec.BinaryCodec, kvPair storetypes.StoreKVPair) (proto.Message, error) {
+	if kvPair.StoreKey != StoreKey || len(kvPair.Key) == 0 {
+		return nil, nil
+	}
+	key := kvPair.Key
+	switch {
+	case bytes.HasPrefix(key, PairKeyPrefix) && len(key) == 1+8:
+		change := &PairStateChange{
+			PairId:  sdk.BigEndianToUint64(key[1:]),
+			Deleted: kvPair.Delete,
+		}
+		if !kvPair.Delete {
+			pair, err := UnmarshalPair(cdc, kvPair.Value)
+			if err != nil {
+				return nil, err
+			}
+			change.Pair = &pair
+		}
+		return change, nil
+	case bytes.HasPrefix(key, PoolKeyPrefix) && len(key) == 1+8:
+		change := &PoolStateChange{
+			PoolId:  sdk.BigEndianToUint64(key[1:]),
+			Deleted: kvPair.Delete,
+		}
+		if !kvPair.Delete {
+			pool, err := UnmarshalPool(cdc, kvPair.Value)
+			if err != nil {
+				return nil, err
+			}
+			change.Pool = &pool
+		}
+		return change, nil
+	case bytes.HasPrefix(key, OrderKeyPrefix) && len(key) == 1+8+8:
+		change := &OrderStateChange{
+			PairId:  sdk.BigEndianToUint64(key[1 : 1+8]),
+			OrderId: sdk.BigEndianToUint64(key[1+8:]),
+			Deleted: kvPair.Delete,
+		}
+		if !kvPair.Delete {
+			order, err := UnmarshalOrder(cdc, kvPair.Value)
+			if err != nil {
+				return nil, err
+			}
+			change.Order = &order
+		}
+		return change, nil
+	default:
+		return nil, nil
+	}
+}
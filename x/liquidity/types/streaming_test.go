@@ -0,0 +1,81 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	utils "github.com/crescent-network/crescent/v4/types"
+	"github.com/crescent-network/crescent/v4/x/liquidity/types"
+)
+
+func TestDecodeStateChange(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	pair := types.NewPair(1, "denom1", "denom2")
+	pool := types.NewBasicPool(2, pair.Id, utils.TestAddress(0))
+	order := types.NewOrder(
+		types.OrderTypeLimit, 3, pair, utils.TestAddress(1), utils.ParseCoin("1000000denom2"),
+		utils.ParseDec("1.0"), sdk.NewInt(1000000), utils.ParseTime("2022-01-01T00:00:00Z").Add(time.Hour), 1)
+
+	for _, tc := range []struct {
+		name     string
+		kvPair   storetypes.StoreKVPair
+		expected interface{}
+	}{
+		{
+			"pair",
+			storetypes.StoreKVPair{StoreKey: types.StoreKey, Key: types.GetPairKey(pair.Id), Value: types.MustMarshalPair(cdc, pair)},
+			&types.PairStateChange{PairId: pair.Id, Pair: &pair},
+		},
+		{
+			"deleted pool",
+			storetypes.StoreKVPair{StoreKey: types.StoreKey, Key: types.GetPoolKey(pool.Id), Delete: true},
+			&types.PoolStateChange{PoolId: pool.Id, Deleted: true},
+		},
+		{
+			"pool",
+			storetypes.StoreKVPair{StoreKey: types.StoreKey, Key: types.GetPoolKey(pool.Id), Value: types.MustMarshalPool(cdc, pool)},
+			&types.PoolStateChange{PoolId: pool.Id, Pool: &pool},
+		},
+		{
+			"order",
+			storetypes.StoreKVPair{StoreKey: types.StoreKey, Key: types.GetOrderKey(pair.Id, order.Id), Value: types.MustMarshaOrder(cdc, order)},
+			&types.OrderStateChange{PairId: pair.Id, OrderId: order.Id, Order: &order},
+		},
+		{
+			"deleted order",
+			storetypes.StoreKVPair{StoreKey: types.StoreKey, Key: types.GetOrderKey(pair.Id, order.Id), Delete: true},
+			&types.OrderStateChange{PairId: pair.Id, OrderId: order.Id, Deleted: true},
+		},
+		{
+			"order index",
+			storetypes.StoreKVPair{StoreKey: types.StoreKey, Key: types.GetOrderIndexKey(order.GetOrderer(), pair.Id, order.Id), Value: []byte{}},
+			nil,
+		},
+		{
+			"other store",
+			storetypes.StoreKVPair{StoreKey: "bank", Key: types.GetPairKey(pair.Id), Value: types.MustMarshalPair(cdc, pair)},
+			nil,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			change, err := types.DecodeStateChange(cdc, tc.kvPair)
+			require.NoError(t, err)
+			if tc.expected == nil {
+				require.Nil(t, change)
+			} else {
+				require.Equal(t, tc.expected, change)
+			}
+		})
+	}
+
+	_, err := types.DecodeStateChange(cdc, storetypes.StoreKVPair{
+		StoreKey: types.StoreKey, Key: types.GetOrderKey(pair.Id, order.Id), Value: []byte{0xff}})
+	require.Error(t, err)
+}
@@ -0,0 +1,26 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewAccruedSwapFees returns a new AccruedSwapFees object with no fees.
+func NewAccruedSwapFees(pairId uint64) AccruedSwapFees {
+	return AccruedSwapFees{
+		PairId: pairId,
+		Fees:   sdk.Coins{},
+	}
+}
+
+// Validate validates AccruedSwapFees for genesis.
+func (fees AccruedSwapFees) Validate() error {
+	if fees.PairId == 0 {
+		return fmt.Errorf("pair id must not be 0")
+	}
+	if err := fees.Fees.Validate(); err != nil {
+		return fmt.Errorf("invalid fees: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	farmingtypes "github.com/crescent-network/crescent/v4/x/farming/types"
+)
+
+// MaxSwapRouteLength is the maximum number of pairs in a swap route.
+const MaxSwapRouteLength = 5
+
+// SwapRouteEscrowAddress returns a unique escrow account address for each
+// swap route.
+func SwapRouteEscrowAddress(swapRouteId uint64) sdk.AccAddress {
+	return farmingtypes.DeriveAddress(
+		AddressType,
+		ModuleName,
+		strings.Join([]string{SwapRouteEscrowAddressPrefix, strconv.FormatUint(swapRouteId, 10)}, ModuleAddressNameSplitter),
+	)
+}
+
+// NewSwapRoute returns a new swap route for the MsgSwapExactIn.
+// The coins from the swap route are sent to the receiver.
+func NewSwapRoute(id uint64, msg *MsgSwapExactIn, receiver sdk.AccAddress) SwapRoute {
+	return SwapRoute{
+		Id:            id,
+		Orderer:       msg.Orderer,
+		PairIds:       msg.PairIds,
+		OfferCoin:     msg.OfferCoin,
+		MinOutCoin:    msg.MinOutCoin,
+		OrderLifespan: msg.OrderLifespan,
+		EscrowAddress: SwapRouteEscrowAddress(id).String(),
+		Receiver:      receiver.String(),
+	}
+}
+
+func (route SwapRoute) GetOrderer() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(route.Orderer)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+func (route SwapRoute) GetEscrowAddress() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(route.EscrowAddress)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+func (route SwapRoute) GetReceiver() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(route.Receiver)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// CurrentPairId returns the id of the pair where the current order of the
+// swap route is placed.
+func (route SwapRoute) CurrentPairId() uint64 {
+	return route.PairIds[route.Hop]
+}
+
+// IsLastHop returns whether the current order of the swap route is placed in
+// the last pair of the route.
+func (route SwapRoute) IsLastHop() bool {
+	return int(route.Hop) == len(route.PairIds)-1
+}
+
+// Validate validates SwapRoute for genesis.
+func (route SwapRoute) Validate() error {
+	if route.Id == 0 {
+		return fmt.Errorf("swap route id must not be 0")
+	}
+	if _, err := sdk.AccAddressFromBech32(route.Orderer); err != nil {
+		return fmt.Errorf("invalid orderer address %s: %w", route.Orderer, err)
+	}
+	if len(route.PairIds) == 0 || len(route.PairIds) > MaxSwapRouteLength {
+		return fmt.Errorf("number of pairs must be in range [1, %d]: %d", MaxSwapRouteLength, len(route.PairIds))
+	}
+	for _, pairId := range route.PairIds {
+		if pairId == 0 {
+			return fmt.Errorf("pair id must not be 0")
+		}
+	}
+	if err := route.OfferCoin.Validate(); err != nil {
+		return fmt.Errorf("invalid offer coin: %w", err)
+	}
+	if err := route.MinOutCoin.Validate(); err != nil {
+		return fmt.Errorf("invalid min out coin: %w", err)
+	}
+	if route.OrderLifespan < 0 {
+		return fmt.Errorf("order lifespan must not be negative: %s", route.OrderLifespan)
+	}
+	if _, err := sdk.AccAddressFromBech32(route.EscrowAddress); err != nil {
+		return fmt.Errorf("invalid escrow address %s: %w", route.EscrowAddress, err)
+	}
+	if int(route.Hop) >= len(route.PairIds) {
+		return fmt.Errorf("hop must be less than the number of pairs: %d", route.Hop)
+	}
+	if route.OrderId == 0 {
+		return fmt.Errorf("order id must not be 0")
+	}
+	if _, err := sdk.AccAddressFromBech32(route.Receiver); err != nil {
+		return fmt.Errorf("invalid receiver address %s: %w", route.Receiver, err)
+	}
+	return nil
+}
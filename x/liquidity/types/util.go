@@ -90,17 +90,75 @@ func NewPoolResponse(pool Pool, rx, ry sdk.Coin, poolCoinSupply sdk.Int) PoolRes
 	}
 }
 
+// NewVaultResponse returns a new VaultResponse from given information.
+func NewVaultResponse(vault Vault, rx, ry sdk.Coin, shareSupply sdk.Int, lastPrice *sdk.Dec) VaultResponse {
+	var shareValue *sdk.Dec
+	if lastPrice != nil && shareSupply.IsPositive() {
+		v := VaultValue(rx.Amount, ry.Amount, *lastPrice).QuoInt(shareSupply)
+		shareValue = &v
+	}
+	return VaultResponse{
+		Vault: vault,
+		Balances: PoolBalances{
+			BaseCoin:  ry,
+			QuoteCoin: rx,
+		},
+		ShareSupply: shareSupply,
+		ShareValue:  shareValue,
+	}
+}
+
+// NewPoolOrdersResponse returns a new PoolOrdersResponse from the orders
+// made by PoolOrderer.
+func NewPoolOrdersResponse(poolId uint64, buyOrders, sellOrders []amm.Order) PoolOrdersResponse {
+	toResponses := func(orders []amm.Order) []PoolOrderResponse {
+		resps := []PoolOrderResponse{}
+		for _, order := range orders {
+			order := order.(*PoolOrder)
+			resps = append(resps, PoolOrderResponse{
+				Price:     order.Price,
+				Amount:    order.Amount,
+				OfferCoin: sdk.NewCoin(order.OfferCoinDenom, order.OfferCoinAmount),
+			})
+		}
+		return resps
+	}
+	return PoolOrdersResponse{
+		PoolId:     poolId,
+		BuyOrders:  toResponses(buyOrders),
+		SellOrders: toResponses(sellOrders),
+	}
+}
+
 // IsTooSmallOrderAmount returns whether the order amount is too small for
 // matching, based on the order price.
 func IsTooSmallOrderAmount(amt sdk.Int, price sdk.Dec) bool {
-	return amt.LT(amm.MinCoinAmount) || price.MulInt(amt).LT(amm.MinCoinAmount.ToDec())
+	if amt.LT(amm.MinCoinAmount) {
+		return true
+	}
+	quoteAmt, err := amm.SafeQuoteAmount(price, amt)
+	return err == nil && quoteAmt.LT(amm.MinCoinAmount)
 }
 
 // PriceLimits returns the lowest and the highest price limits with given last price
-// and price limit ratio.
-func PriceLimits(lastPrice, priceLimitRatio sdk.Dec, tickPrec int) (lowestPrice, highestPrice sdk.Dec) {
-	lowestPrice = amm.PriceToUpTick(lastPrice.Mul(sdk.OneDec().Sub(priceLimitRatio)), tickPrec)
-	highestPrice = amm.PriceToDownTick(lastPrice.Mul(sdk.OneDec().Add(priceLimitRatio)), tickPrec)
+// and upward and downward price limit ratios.
+func PriceLimits(lastPrice, upwardRatio, downwardRatio sdk.Dec, tickPrec int) (lowestPrice, highestPrice sdk.Dec) {
+	return amm.PriceLimits(lastPrice, upwardRatio, downwardRatio, tickPrec)
+}
+
+// TickWindow returns the lowest and highest price ticks which are at most
+// numTicks ticks away from the price.
+func TickWindow(price sdk.Dec, numTicks uint32, tickPrec int) (lowestPrice, highestPrice sdk.Dec) {
+	lowestIdx := amm.TickToIndex(amm.PriceToUpTick(price, tickPrec), tickPrec) - int(numTicks)
+	if lowestIdx < 0 {
+		lowestIdx = 0
+	}
+	highestIdx := amm.TickToIndex(amm.PriceToDownTick(price, tickPrec), tickPrec) + int(numTicks)
+	if maxIdx := amm.TickToIndex(amm.HighestTick(tickPrec), tickPrec); highestIdx > maxIdx {
+		highestIdx = maxIdx
+	}
+	lowestPrice = amm.TickFromIndex(lowestIdx, tickPrec)
+	highestPrice = amm.TickFromIndex(highestIdx, tickPrec)
 	return
 }
 
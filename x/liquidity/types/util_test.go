@@ -47,3 +47,23 @@ func TestMMOrderTicks(t *testing.T) {
 			sdk.NewInt(109), types.DefaultMaxNumMarketMakingOrderTicks, 4),
 	)
 }
+
+func TestTickWindow(t *testing.T) {
+	for _, tc := range []struct {
+		price           sdk.Dec
+		numTicks        uint32
+		expectedLowest  sdk.Dec
+		expectedHighest sdk.Dec
+	}{
+		{utils.ParseDec("1.0"), 100, utils.ParseDec("0.999"), utils.ParseDec("1.01")},
+		{utils.ParseDec("1.00005"), 1, utils.ParseDec("1.0"), utils.ParseDec("1.0001")},
+		{utils.ParseDec("12345"), 10, utils.ParseDec("12335"), utils.ParseDec("12355")},
+		{utils.ParseDec("0.00000000000001"), 10, utils.ParseDec("0.00000000000001"), utils.ParseDec("0.00000000000001001")},
+	} {
+		t.Run("", func(t *testing.T) {
+			lowest, highest := types.TickWindow(tc.price, tc.numTicks, 4)
+			require.True(sdk.DecEq(t, tc.expectedLowest, lowest))
+			require.True(sdk.DecEq(t, tc.expectedHighest, highest))
+		})
+	}
+}
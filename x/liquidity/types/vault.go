@@ -0,0 +1,176 @@
+package types
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	farmingtypes "github.com/crescent-network/crescent/v4/x/farming/types"
+	"github.com/crescent-network/crescent/v4/x/liquidity/amm"
+)
+
+var vaultShareDenomRegexp = regexp.MustCompile(`^vault([1-9]\d*)$`)
+
+// VaultReserveAddress returns a unique vault reserve account address for each vault.
+func VaultReserveAddress(vaultId uint64) sdk.AccAddress {
+	return farmingtypes.DeriveAddress(
+		AddressType,
+		ModuleName,
+		strings.Join([]string{VaultReserveAddressPrefix, strconv.FormatUint(vaultId, 10)}, ModuleAddressNameSplitter),
+	)
+}
+
+// VaultShareDenom returns a unique share denom for a vault.
+func VaultShareDenom(vaultId uint64) string {
+	return fmt.Sprintf("vault%d", vaultId)
+}
+
+// ParseVaultShareDenom parses a vault share denom and returns the vault id.
+func ParseVaultShareDenom(denom string) (vaultId uint64, err error) {
+	chunks := vaultShareDenomRegexp.FindStringSubmatch(denom)
+	if len(chunks) == 0 {
+		return 0, fmt.Errorf("%s is not a vault share denom", denom)
+	}
+	vaultId, err = strconv.ParseUint(chunks[1], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("parse vault id: %w", err)
+	}
+	return vaultId, nil
+}
+
+// VaultSourceName returns the order source name of a vault, which is used
+// to identify the vault's orders in batch matching.
+func VaultSourceName(vaultId uint64) string {
+	return fmt.Sprintf("vault/%d", vaultId)
+}
+
+// NewVault returns a new vault object.
+func NewVault(id, pairId uint64, operator sdk.AccAddress, maxPriceDeviation, operatorFeeRate sdk.Dec) Vault {
+	return Vault{
+		Id:                id,
+		PairId:            pairId,
+		Operator:          operator.String(),
+		ReserveAddress:    VaultReserveAddress(id).String(),
+		ShareDenom:        VaultShareDenom(id),
+		MaxPriceDeviation: maxPriceDeviation,
+		OperatorFeeRate:   operatorFeeRate,
+		HighWaterMark:     sdk.ZeroDec(),
+	}
+}
+
+func (vault Vault) GetOperator() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(vault.Operator)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+func (vault Vault) GetReserveAddress() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(vault.ReserveAddress)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// HasRange returns whether the vault's price range is set by the operator.
+func (vault Vault) HasRange() bool {
+	return vault.MinPrice != nil && vault.MaxPrice != nil
+}
+
+// AMMPool returns a ranged amm pool of the vault's balances and price range.
+// It must be called only when the vault has its range set.
+func (vault Vault) AMMPool(rx, ry, ps sdk.Int) *amm.RangedPool {
+	return amm.NewRangedPool(rx, ry, ps, *vault.MinPrice, *vault.MaxPrice)
+}
+
+// Validate validates Vault for genesis.
+func (vault Vault) Validate() error {
+	if vault.Id == 0 {
+		return fmt.Errorf("vault id must not be 0")
+	}
+	if vault.PairId == 0 {
+		return fmt.Errorf("pair id must not be 0")
+	}
+	if _, err := sdk.AccAddressFromBech32(vault.Operator); err != nil {
+		return fmt.Errorf("invalid operator address %s: %w", vault.Operator, err)
+	}
+	if _, err := sdk.AccAddressFromBech32(vault.ReserveAddress); err != nil {
+		return fmt.Errorf("invalid reserve address %s: %w", vault.ReserveAddress, err)
+	}
+	if err := sdk.ValidateDenom(vault.ShareDenom); err != nil {
+		return fmt.Errorf("invalid share denom: %w", err)
+	}
+	if err := ValidateVaultStrategy(vault.MaxPriceDeviation, vault.OperatorFeeRate); err != nil {
+		return err
+	}
+	if (vault.MinPrice == nil) != (vault.MaxPrice == nil) {
+		return fmt.Errorf("min price and max price must be set together")
+	}
+	if vault.HasRange() && !vault.MaxPrice.GT(*vault.MinPrice) {
+		return fmt.Errorf("max price must be higher than min price")
+	}
+	if vault.HighWaterMark.IsNil() || vault.HighWaterMark.IsNegative() {
+		return fmt.Errorf("high water mark must not be negative: %s", vault.HighWaterMark)
+	}
+	return nil
+}
+
+// ValidateVaultStrategy validates the strategy parameters of a vault.
+func ValidateVaultStrategy(maxPriceDeviation, operatorFeeRate sdk.Dec) error {
+	if maxPriceDeviation.IsNil() || !maxPriceDeviation.IsPositive() || maxPriceDeviation.GTE(sdk.OneDec()) {
+		return fmt.Errorf("max price deviation must be in range (0, 1): %s", maxPriceDeviation)
+	}
+	if operatorFeeRate.IsNil() || operatorFeeRate.IsNegative() || operatorFeeRate.GTE(sdk.OneDec()) {
+		return fmt.Errorf("operator fee rate must be in range [0, 1): %s", operatorFeeRate)
+	}
+	return nil
+}
+
+// ValidateVaultRange validates the price range of a vault against the
+// vault's strategy.
+// The range must contain the last price and must not deviate from the last
+// price more than the vault's max price deviation.
+func ValidateVaultRange(vault Vault, minPrice, maxPrice, lastPrice sdk.Dec) error {
+	if err := amm.ValidateRangedPoolParams(minPrice, maxPrice, lastPrice); err != nil {
+		return err
+	}
+	if minPrice.LT(lastPrice.Mul(sdk.OneDec().Sub(vault.MaxPriceDeviation))) {
+		return fmt.Errorf("min price must not be lower than %s", lastPrice.Mul(sdk.OneDec().Sub(vault.MaxPriceDeviation)))
+	}
+	if maxPrice.GT(lastPrice.Mul(sdk.OneDec().Add(vault.MaxPriceDeviation))) {
+		return fmt.Errorf("max price must not be higher than %s", lastPrice.Mul(sdk.OneDec().Add(vault.MaxPriceDeviation)))
+	}
+	return nil
+}
+
+// VaultValue returns the value of a vault's balances in the quote coin at
+// the price.
+func VaultValue(rx, ry sdk.Int, price sdk.Dec) sdk.Dec {
+	return rx.ToDec().Add(price.MulInt(ry))
+}
+
+// OperatorFeeShare returns the amount of shares to mint to the operator as
+// the operator fee, and the new high water mark after the minting.
+// The operator fee is charged only for the increase of the share value
+// above the high water mark.
+func OperatorFeeShare(value sdk.Dec, supply sdk.Int, highWaterMark, operatorFeeRate sdk.Dec) (feeShare sdk.Int, newHighWaterMark sdk.Dec) {
+	if !supply.IsPositive() {
+		return sdk.ZeroInt(), highWaterMark
+	}
+	shareValue := value.QuoInt(supply)
+	if !shareValue.GT(highWaterMark) {
+		return sdk.ZeroInt(), highWaterMark
+	}
+	// fee = (shareValue - highWaterMark) * supply * operatorFeeRate
+	fee := shareValue.Sub(highWaterMark).MulInt(supply).Mul(operatorFeeRate)
+	// Mint shares so that the operator's portion of the vault values the fee:
+	// feeShare / (supply + feeShare) * value = fee
+	feeShare = fee.MulInt(supply).Quo(value.Sub(fee)).TruncateInt()
+	newHighWaterMark = value.QuoInt(supply.Add(feeShare))
+	return feeShare, newHighWaterMark
+}
@@ -0,0 +1,150 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+
+	utils "github.com/crescent-network/crescent/v4/types"
+	"github.com/crescent-network/crescent/v4/x/liquidity/types"
+)
+
+func TestParseVaultShareDenom(t *testing.T) {
+	for _, tc := range []struct {
+		denom       string
+		expectedId  uint64
+		expectedErr string
+	}{
+		{"vault1", 1, ""},
+		{"vault10", 10, ""},
+		{"vault0", 0, "vault0 is not a vault share denom"},
+		{"pool1", 0, "pool1 is not a vault share denom"},
+		{"vault18446744073709551616", 0, "parse vault id: strconv.ParseUint: parsing \"18446744073709551616\": value out of range"},
+	} {
+		t.Run(tc.denom, func(t *testing.T) {
+			vaultId, err := types.ParseVaultShareDenom(tc.denom)
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedId, vaultId)
+				require.Equal(t, tc.denom, types.VaultShareDenom(vaultId))
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestVault_Validate(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		malleate    func(vault *types.Vault)
+		expectedErr string
+	}{
+		{
+			"happy case",
+			func(vault *types.Vault) {},
+			"",
+		},
+		{
+			"with range",
+			func(vault *types.Vault) {
+				vault.MinPrice = utils.ParseDecP("0.99")
+				vault.MaxPrice = utils.ParseDecP("1.01")
+			},
+			"",
+		},
+		{
+			"zero id",
+			func(vault *types.Vault) {
+				vault.Id = 0
+			},
+			"vault id must not be 0",
+		},
+		{
+			"invalid max price deviation",
+			func(vault *types.Vault) {
+				vault.MaxPriceDeviation = sdk.OneDec()
+			},
+			"max price deviation must be in range (0, 1): 1.000000000000000000",
+		},
+		{
+			"invalid operator fee rate",
+			func(vault *types.Vault) {
+				vault.OperatorFeeRate = utils.ParseDec("-0.1")
+			},
+			"operator fee rate must be in range [0, 1): -0.100000000000000000",
+		},
+		{
+			"only min price",
+			func(vault *types.Vault) {
+				vault.MinPrice = utils.ParseDecP("0.99")
+			},
+			"min price and max price must be set together",
+		},
+		{
+			"wrong range",
+			func(vault *types.Vault) {
+				vault.MinPrice = utils.ParseDecP("1.01")
+				vault.MaxPrice = utils.ParseDecP("0.99")
+			},
+			"max price must be higher than min price",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			vault := types.NewVault(1, 1, sdk.AccAddress(crypto.AddressHash([]byte("operator"))), utils.ParseDec("0.02"), utils.ParseDec("0.1"))
+			tc.malleate(&vault)
+			err := vault.Validate()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestOperatorFeeShare(t *testing.T) {
+	for _, tc := range []struct {
+		name                   string
+		value                  sdk.Dec
+		supply                 sdk.Int
+		highWaterMark, feeRate sdk.Dec
+		expectedFeeShare       sdk.Int
+		expectedHighWaterMark  sdk.Dec
+	}{
+		{
+			"no profit",
+			utils.ParseDec("1000000"), sdk.NewInt(1000000), sdk.OneDec(), utils.ParseDec("0.1"),
+			sdk.ZeroInt(), sdk.OneDec(),
+		},
+		{
+			"loss",
+			utils.ParseDec("900000"), sdk.NewInt(1000000), sdk.OneDec(), utils.ParseDec("0.1"),
+			sdk.ZeroInt(), sdk.OneDec(),
+		},
+		{
+			"profit",
+			utils.ParseDec("1100000"), sdk.NewInt(1000000), sdk.OneDec(), utils.ParseDec("0.1"),
+			// 10000 * 1000000 / (1100000 - 10000) = 9174.31...
+			sdk.NewInt(9174), utils.ParseDec("1100000").QuoInt64(1009174),
+		},
+		{
+			"zero fee rate",
+			utils.ParseDec("1100000"), sdk.NewInt(1000000), sdk.OneDec(), sdk.ZeroDec(),
+			sdk.ZeroInt(), utils.ParseDec("1.1"),
+		},
+		{
+			"no supply",
+			utils.ParseDec("1100000"), sdk.ZeroInt(), sdk.OneDec(), utils.ParseDec("0.1"),
+			sdk.ZeroInt(), sdk.OneDec(),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			feeShare, highWaterMark := types.OperatorFeeShare(tc.value, tc.supply, tc.highWaterMark, tc.feeRate)
+			require.True(t, tc.expectedFeeShare.Equal(feeShare), feeShare.String())
+			require.True(t, tc.expectedHighWaterMark.Equal(highWaterMark), highWaterMark.String())
+		})
+	}
+}
@@ -14,4 +14,20 @@ import (
 func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
 	k.UpdateLiquidValidatorSet(ctx)
+	// Rewards are compounded by the epoch hooks instead when RewardCompoundingEpochIdentifier is set to an existing
+	// epoch.
+	if params := k.GetParams(ctx); params.RewardCompoundingEpoch > 0 &&
+		ctx.BlockHeight()%int64(params.RewardCompoundingEpoch) == 0 && !k.CompoundsRewardsByEpoch(ctx) {
+		k.CompoundRewards(ctx)
+	}
+	k.DeleteCompletedUnstakingRecords(ctx, ctx.BlockTime())
+	k.RecordNetAmountSnapshot(ctx)
+	k.UpdateMintRateBound(ctx)
+}
+
+// EndBlocker transfers the deposits of the host zones and delegates them on
+// the host chains.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+	k.ProcessHostZones(ctx)
 }
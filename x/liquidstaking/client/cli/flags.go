@@ -0,0 +1,8 @@
+package cli
+
+// DONTCOVER
+
+const (
+	FlagSnapshotHeight = "snapshot-height"
+	FlagFarm           = "farm"
+)
@@ -29,6 +29,13 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryLiquidValidators(),
 		GetCmdQueryStates(),
 		GetCmdQueryVotingPower(),
+		GetCmdQueryUnstakingRecords(),
+		GetCmdQueryNetAmount(),
+		GetCmdQueryNetAmountSnapshots(),
+		GetCmdQueryHostZones(),
+		GetCmdQueryHostZone(),
+		GetCmdQueryModuleAccounts(),
+		GetCmdQueryRewardsEstimate(),
 	)
 
 	return liquidValidatorQueryCmd
@@ -200,3 +207,304 @@ $ %s query %s voting-power %s1zaavvzxez0elundtn32qnk9lkm8kmcszzsv80v
 
 	return cmd
 }
+
+// GetCmdQueryUnstakingRecords implements the query unstaking records command.
+func GetCmdQueryUnstakingRecords() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unstaking-records [liquid-staker]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the liquid staker's in-progress unbondings initiated through liquid unstaking",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the liquid staker's in-progress unbondings initiated through liquid unstaking.
+
+Example:
+$ %s query %s unstaking-records %s1zaavvzxez0elundtn32qnk9lkm8kmcszzsv80v
+`,
+				version.AppName, types.ModuleName, sdk.Bech32MainPrefix,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			liquidStaker, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.UnstakingRecords(
+				cmd.Context(),
+				&types.QueryUnstakingRecordsRequest{LiquidStaker: liquidStaker.String()},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryNetAmount implements the query net amount command.
+func GetCmdQueryNetAmount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "net-amount",
+		Args:  cobra.NoArgs,
+		Short: "Query the net amount, bToken total supply and mint rate",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the net amount, bToken total supply and mint rate of the current block.
+If --snapshot-height is given, query them from the stored net amount snapshot at the height.
+
+Example:
+$ %s query %s net-amount
+$ %s query %s net-amount --snapshot-height 1000
+`,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			height, err := cmd.Flags().GetInt64(FlagSnapshotHeight)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.NetAmount(
+				cmd.Context(),
+				&types.QueryNetAmountRequest{Height: height},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Int64(FlagSnapshotHeight, 0, "Height of the net amount snapshot to query")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryNetAmountSnapshots implements the query net amount snapshots command.
+func GetCmdQueryNetAmountSnapshots() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "net-amount-snapshots",
+		Args:  cobra.NoArgs,
+		Short: "Query all stored net amount snapshots",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all stored net amount snapshots ordered by their heights.
+
+Example:
+$ %s query %s net-amount-snapshots
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.NetAmountSnapshots(
+				cmd.Context(),
+				&types.QueryNetAmountSnapshotsRequest{Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "net-amount-snapshots")
+
+	return cmd
+}
+
+// GetCmdQueryHostZones implements the query host zones command.
+func GetCmdQueryHostZones() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "host-zones",
+		Args:  cobra.NoArgs,
+		Short: "Query all host zones of the interchain liquid staking",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all host zones of the interchain liquid staking.
+
+Example:
+$ %s query %s host-zones
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.HostZones(
+				cmd.Context(),
+				&types.QueryHostZonesRequest{},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryHostZone implements the query host zone command.
+func GetCmdQueryHostZone() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "host-zone [chain-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query a host zone with its bToken total supply and net amount",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query a host zone of the interchain liquid staking with its bToken total supply and net amount.
+
+Example:
+$ %s query %s host-zone cosmoshub-4
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.HostZone(
+				cmd.Context(),
+				&types.QueryHostZoneRequest{ChainId: args[0]},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryModuleAccounts implements the query module accounts command.
+func GetCmdQueryModuleAccounts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "module-accounts",
+		Args:  cobra.NoArgs,
+		Short: "Query the accounts managed by the liquidstaking module",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the module account and the accounts derived by the liquidstaking module, such as the proxy account and the deposit accounts of host zones, with their balances.
+
+Example:
+$ %s query %s module-accounts
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ModuleAccounts(
+				cmd.Context(),
+				&types.QueryModuleAccountsRequest{},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryRewardsEstimate implements the query rewards estimate command.
+func GetCmdQueryRewardsEstimate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rewards-estimate",
+		Args:  cobra.NoArgs,
+		Short: "Query the estimated APR of liquid staking",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the estimated APR of liquid staking from the inflation of the mint module, the commission rates of the active liquid validators and the unstake fee rate.
+The gross APR is net of the validator and protocol commissions, and the net APR is net of the unstake fee too.
+
+Example:
+$ %s query %s rewards-estimate
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.RewardsEstimate(
+				cmd.Context(),
+				&types.QueryRewardsEstimateRequest{},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
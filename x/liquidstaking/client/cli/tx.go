@@ -5,6 +5,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -14,6 +15,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	gov "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/crescent-network/crescent/v4/x/liquidstaking/types"
 )
@@ -31,6 +34,9 @@ func GetTxCmd() *cobra.Command {
 	liquidstakingTxCmd.AddCommand(
 		NewLiquidStakeCmd(),
 		NewLiquidUnstakeCmd(),
+		NewArbLiquidStakeCmd(),
+		NewLiquidUnstakeInstantCmd(),
+		NewInterchainLiquidStakeCmd(),
 	)
 
 	return liquidstakingTxCmd
@@ -47,8 +53,12 @@ func NewLiquidStakeCmd() *cobra.Command {
 			
 Example:
 $ %s tx %s liquid-stake 1000stake --from mykey
+$ %s tx %s liquid-stake 1000stake --farm --from mykey
+
+[farm]: farm the minted bToken in the lpfarm module right away
 `,
 				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -65,11 +75,13 @@ $ %s tx %s liquid-stake 1000stake --from mykey
 			}
 
 			msg := types.NewMsgLiquidStake(liquidStaker, stakingCoin)
+			msg.Farm, _ = cmd.Flags().GetBool(FlagFarm)
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().Bool(FlagFarm, false, "Farm the minted bToken in the lpfarm module")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -113,3 +125,282 @@ $ %s tx %s liquid-unstake 500stake --from mykey
 
 	return cmd
 }
+
+// NewArbLiquidStakeCmd implements the liquid staking arbitrage command handler.
+func NewArbLiquidStakeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "arb-liquid-stake [pair-id] [max-spend]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Arbitrage between the bToken price on the DEX and the liquid staking rates",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Arbitrage between the bToken price on the DEX and the liquid staking rates.
+If bToken trades below the redemption rate, a buy order of bToken is made with max-spend.
+If bToken trades above the mint rate, bToken is minted by liquid staking max-spend
+and a sell order of the minted bToken is made.
+The orders are matched only in the current batch.
+
+Example:
+$ %s tx %s arb-liquid-stake 1 1000stake --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			arbitrager := clientCtx.GetFromAddress()
+
+			pairId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid pair id: %w", err)
+			}
+
+			maxSpend, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgArbLiquidStake(arbitrager, pairId, maxSpend)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewLiquidUnstakeInstantCmd implements the instant liquid unstake command handler.
+func NewLiquidUnstakeInstantCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "liquid-unstake-instant [pair-id] [amount] [max-slippage]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Liquid-unstake coin instantly by selling it on the DEX",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Liquid-unstake coin instantly by selling it on the DEX.
+A sell order of bToken is made on the pair at the last price of the pair lowered by max-slippage,
+instead of waiting for the unbonding period.
+The order is matched only in the current batch and the unmatched bToken is refunded.
+
+Example:
+$ %s tx %s liquid-unstake-instant 1 500bstake 0.01 --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			liquidStaker := clientCtx.GetFromAddress()
+
+			pairId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid pair id: %w", err)
+			}
+
+			unstakingCoin, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			maxSlippage, err := sdk.NewDecFromStr(args[2])
+			if err != nil {
+				return fmt.Errorf("invalid max slippage: %w", err)
+			}
+
+			msg := types.NewMsgLiquidUnstakeInstant(liquidStaker, pairId, unstakingCoin, maxSlippage)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewInterchainLiquidStakeCmd implements the interchain liquid stake command handler.
+func NewInterchainLiquidStakeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "interchain-liquid-stake [chain-id] [amount]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Liquid-stake coin on a host chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Liquid-stake the IBC coin of a host zone's staking denom on the host chain.
+The bToken of the host zone is minted right away, and the coin is transferred
+to the interchain account of the host zone and delegated on the host chain
+at the end of the block.
+
+Example:
+$ %s tx %s interchain-liquid-stake cosmoshub-4 1000ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2 --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			liquidStaker := clientCtx.GetFromAddress()
+
+			stakingCoin, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgInterchainLiquidStake(liquidStaker, args[0], stakingCoin)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdSubmitLiquidStakingWhitelistProposal implements a command handler for submitting a liquid staking whitelist proposal.
+func NewCmdSubmitLiquidStakingWhitelistProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "liquid-staking-whitelist [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a liquid staking whitelist proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a liquid staking whitelist proposal along with an initial deposit.
+The proposal adds validators to the whitelist or adjusts their target weights,
+and removes delisted validators from the whitelist.
+The liquid validator set is rebalanced right after the proposal passes.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal liquid-staking-whitelist <path/to/proposal.json> --from=<key_or_address> --deposit=<deposit_amount>
+
+Where proposal.json contains:
+
+{
+  "title": "Liquid Staking Whitelist Proposal",
+  "description": "Let's whitelist a new validator and delist an old one",
+  "whitelisted_validators": [
+    {
+      "validator_address": "crevaloper1zaavvzxez0elundtn32qnk9lkm8kmcszuwx9jz",
+      "target_weight": "10"
+    }
+  ],
+  "delisted_validators": [
+    "crevaloper1mzgucqnfr2l8cj5apvdpllhzt4zeuh2c5l33n3"
+  ]
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			content, err := ParseLiquidStakingWhitelistProposal(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			msg, err := gov.NewMsgSubmitProposal(&content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+
+	return cmd
+}
+
+// NewCmdSubmitRegisterHostZoneProposal implements a command handler for submitting a host zone registration proposal.
+func NewCmdSubmitRegisterHostZoneProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-host-zone [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a host zone registration proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a host zone registration proposal along with an initial deposit.
+The proposal registers a host chain for the interchain liquid staking and
+registers the interchain account which delegates to the validator on the host chain.
+The connection and the transfer channel to the host chain must already exist.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal register-host-zone <path/to/proposal.json> --from=<key_or_address> --deposit=<deposit_amount>
+
+Where proposal.json contains:
+
+{
+  "title": "Register Cosmos Hub",
+  "description": "Let's liquid stake ATOM",
+  "chain_id": "cosmoshub-4",
+  "connection_id": "connection-0",
+  "transfer_channel_id": "channel-0",
+  "host_denom": "uatom",
+  "btoken_denom": "batom",
+  "validator_address": "cosmosvaloper1clpqr4nrk4khgkxj78fcwwh6dl3uw4epsluffn"
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			content, err := ParseRegisterHostZoneProposal(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			msg, err := gov.NewMsgSubmitProposal(&content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+
+	return cmd
+}
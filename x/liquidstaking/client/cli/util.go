@@ -0,0 +1,41 @@
+package cli
+
+import (
+	"os"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	"github.com/crescent-network/crescent/v4/x/liquidstaking/types"
+)
+
+// ParseLiquidStakingWhitelistProposal reads and parses a LiquidStakingWhitelistProposal from a file.
+func ParseLiquidStakingWhitelistProposal(cdc codec.JSONCodec, proposalFile string) (types.LiquidStakingWhitelistProposal, error) {
+	proposal := types.LiquidStakingWhitelistProposal{}
+
+	contents, err := os.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err = cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+// ParseRegisterHostZoneProposal reads and parses a RegisterHostZoneProposal from a file.
+func ParseRegisterHostZoneProposal(cdc codec.JSONCodec, proposalFile string) (types.RegisterHostZoneProposal, error) {
+	proposal := types.RegisterHostZoneProposal{}
+
+	contents, err := os.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err = cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
@@ -0,0 +1,16 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+
+	"github.com/crescent-network/crescent/v4/x/liquidstaking/client/cli"
+	"github.com/crescent-network/crescent/v4/x/liquidstaking/client/rest"
+)
+
+// ProposalHandler is the liquid staking whitelist command handler and
+// RegisterHostZoneProposalHandler is the host zone registration command handler.
+// Note that the REST handlers will be deprecated in the future.
+var (
+	ProposalHandler                 = govclient.NewProposalHandler(cli.NewCmdSubmitLiquidStakingWhitelistProposal, rest.ProposalRESTHandler)
+	RegisterHostZoneProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitRegisterHostZoneProposal, rest.RegisterHostZoneProposalRESTHandler)
+)
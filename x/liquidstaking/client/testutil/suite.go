@@ -99,13 +99,17 @@ func (s *IntegrationTestSuite) TestCmdParams() {
 		{
 			"json output",
 			[]string{fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
-			`{"liquid_bond_denom":"bstake","whitelisted_validators":[],"unstake_fee_rate":"0.000000000000000000","min_liquid_staking_amount":"1000000"}`,
+			`{"liquid_bond_denom":"bstake","whitelisted_validators":[],"unstake_fee_rate":"0.000000000000000000","min_liquid_staking_amount":"1000000","rebalancing_trigger":"0.001000000000000000","max_redelegations_per_rebalancing":20,"reward_compounding_epoch":0,"net_amount_snapshot_retention":0}`,
 		},
 		{
 			"text output",
 			[]string{},
 			`liquid_bond_denom: bstake
+max_redelegations_per_rebalancing: 20
 min_liquid_staking_amount: "1000000"
+net_amount_snapshot_retention: 0
+rebalancing_trigger: "0.001000000000000000"
+reward_compounding_epoch: 0
 unstake_fee_rate: "0.000000000000000000"
 whitelisted_validators: []
 `,
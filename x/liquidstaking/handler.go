@@ -3,6 +3,7 @@ package liquidstaking
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/crescent-network/crescent/v4/x/liquidstaking/keeper"
 	"github.com/crescent-network/crescent/v4/x/liquidstaking/types"
@@ -22,9 +23,38 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgLiquidUnstake:
 			res, err := msgServer.LiquidUnstake(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgArbLiquidStake:
+			res, err := msgServer.ArbLiquidStake(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgLiquidUnstakeInstant:
+			res, err := msgServer.LiquidUnstakeInstant(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgInterchainLiquidStake:
+			res, err := msgServer.InterchainLiquidStake(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgUpdateHostZoneState:
+			res, err := msgServer.UpdateHostZoneState(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgUpdateWhitelistedValidatorWeight:
+			res, err := msgServer.UpdateWhitelistedValidatorWeight(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", types.ModuleName, msg)
 		}
 	}
 }
+
+// NewProposalHandler creates a governance handler to manage new proposal types.
+func NewProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.LiquidStakingWhitelistProposal:
+			return keeper.HandleLiquidStakingWhitelistProposal(ctx, k, c)
+		case *types.RegisterHostZoneProposal:
+			return keeper.HandleRegisterHostZoneProposal(ctx, k, c)
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized liquidstaking proposal content type: %T", c)
+		}
+	}
+}
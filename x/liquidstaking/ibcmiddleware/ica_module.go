@@ -0,0 +1,122 @@
+package ibcmiddleware
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	ibcexported "github.com/cosmos/ibc-go/v3/modules/core/exported"
+
+	"github.com/crescent-network/crescent/v4/x/liquidstaking/keeper"
+	"github.com/crescent-network/crescent/v4/x/liquidstaking/types"
+)
+
+var _ porttypes.IBCModule = ICAModule{}
+
+// ICAModule is the authentication module of the interchain accounts
+// controller for the interchain accounts of the host zones.
+// It owns the channel capabilities of the interchain accounts, and tracks the
+// delegations made by the interchain accounts on the host chains.
+type ICAModule struct {
+	keeper keeper.Keeper
+}
+
+// NewICAModule returns a new ICAModule.
+func NewICAModule(k keeper.Keeper) ICAModule {
+	return ICAModule{
+		keeper: k,
+	}
+}
+
+// OnChanOpenInit implements the IBCModule interface.
+func (im ICAModule) OnChanOpenInit(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID string,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	version string,
+) error {
+	if _, ok := types.ParseHostZoneICAPortID(portID); !ok {
+		return sdkerrors.Wrapf(icatypes.ErrInvalidControllerPort, "port %s is not of a host zone", portID)
+	}
+	return im.keeper.ClaimCapability(ctx, chanCap, host.ChannelCapabilityPath(portID, channelID))
+}
+
+// OnChanOpenTry implements the IBCModule interface.
+func (im ICAModule) OnChanOpenTry(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	counterpartyVersion string,
+) (string, error) {
+	return "", sdkerrors.Wrap(icatypes.ErrInvalidChannelFlow, "channel handshake must be initiated by controller chain")
+}
+
+// OnChanOpenAck implements the IBCModule interface.
+func (im ICAModule) OnChanOpenAck(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	counterpartyChannelID string,
+	counterpartyVersion string,
+) error {
+	return im.keeper.OnHostZoneICAOpened(ctx, portID)
+}
+
+// OnChanOpenConfirm implements the IBCModule interface.
+func (im ICAModule) OnChanOpenConfirm(ctx sdk.Context, portID, channelID string) error {
+	return sdkerrors.Wrap(icatypes.ErrInvalidChannelFlow, "channel handshake must be initiated by controller chain")
+}
+
+// OnChanCloseInit implements the IBCModule interface.
+func (im ICAModule) OnChanCloseInit(ctx sdk.Context, portID, channelID string) error {
+	return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "user cannot close channel")
+}
+
+// OnChanCloseConfirm implements the IBCModule interface.
+func (im ICAModule) OnChanCloseConfirm(ctx sdk.Context, portID, channelID string) error {
+	return nil
+}
+
+// OnRecvPacket implements the IBCModule interface.
+func (im ICAModule) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	return channeltypes.NewErrorAcknowledgement("cannot receive packet via interchain accounts authentication module")
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface.
+func (im ICAModule) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	var ack channeltypes.Acknowledgement
+	if err := channeltypes.SubModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal interchain accounts packet acknowledgement: %v", err)
+	}
+	im.keeper.OnHostZoneDelegationAcknowledged(ctx, packet, ack.Success())
+	return nil
+}
+
+// OnTimeoutPacket implements the IBCModule interface.
+// The channel of the interchain account is closed by the timeout since it is
+// ordered, and a new channel must be opened for the interchain account by
+// MsgChannelOpenInit to resume the delegations.
+func (im ICAModule) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) error {
+	im.keeper.OnHostZoneDelegationAcknowledged(ctx, packet, false)
+	return nil
+}
@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	liquiditytypes "github.com/crescent-network/crescent/v4/x/liquidity/types"
+	"github.com/crescent-network/crescent/v4/x/liquidstaking/types"
+)
+
+// ArbLiquidStake performs an arbitrage between the bToken price on the DEX and the liquid staking rates.
+// If the last price of the pair is lower than the redemption rate, it makes a buy order of bToken
+// with maxSpend at the redemption rate. If the last price is higher than the mint rate, it mints bToken
+// by liquid staking maxSpend and makes a sell order of the minted bToken at the mint rate.
+// Orders are made with zero lifespan so that they are matched only in the current batch and the unmatched
+// remaining coins are refunded to the arbitrager after the batch.
+func (k Keeper) ArbLiquidStake(
+	ctx sdk.Context, proxyAcc, arbitrager sdk.AccAddress, pairId uint64, maxSpend sdk.Coin,
+) (order liquiditytypes.Order, bTokenMintAmount sdk.Int, err error) {
+	bTokenMintAmount = sdk.ZeroInt()
+
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+	if maxSpend.Denom != bondDenom {
+		return liquiditytypes.Order{}, bTokenMintAmount, sdkerrors.Wrapf(
+			types.ErrInvalidBondDenom, "invalid coin denomination: got %s, expected %s", maxSpend.Denom, bondDenom,
+		)
+	}
+
+	pair, found := k.liquidityKeeper.GetPair(ctx, pairId)
+	if !found {
+		return liquiditytypes.Order{}, bTokenMintAmount, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "pair %d not found", pairId)
+	}
+	liquidBondDenom := k.LiquidBondDenom(ctx)
+	if pair.BaseCoinDenom != liquidBondDenom || pair.QuoteCoinDenom != bondDenom {
+		return liquiditytypes.Order{}, bTokenMintAmount, sdkerrors.Wrapf(
+			types.ErrInvalidArbPair, "(%s, %s) != (%s, %s)", pair.BaseCoinDenom, pair.QuoteCoinDenom, liquidBondDenom, bondDenom,
+		)
+	}
+	if pair.LastPrice == nil {
+		return liquiditytypes.Order{}, bTokenMintAmount, sdkerrors.Wrapf(types.ErrNoArbOpportunity, "pair %d has no last price", pairId)
+	}
+	lastPrice := *pair.LastPrice
+
+	nas := k.GetNetAmountState(ctx)
+	redeemPrice, mintPrice := nas.BTokenPegPrices(k.GetParams(ctx).UnstakeFeeRate)
+	// The order price is bounded by the price limits of the pair, which never crosses the peg
+	// since the last price is on the other side of it.
+	lowestPrice, highestPrice := k.liquidityKeeper.PriceLimits(ctx, pair)
+
+	var msg *liquiditytypes.MsgLimitOrder
+	switch {
+	case lastPrice.LT(redeemPrice):
+		price := sdk.MinDec(redeemPrice, highestPrice)
+		amt := maxSpend.Amount.ToDec().QuoTruncate(price).TruncateInt()
+		msg = liquiditytypes.NewMsgLimitOrder(
+			arbitrager, pairId, liquiditytypes.OrderDirectionBuy, maxSpend, liquidBondDenom, price, amt, 0)
+	case lastPrice.GT(mintPrice):
+		_, bTokenMintAmount, err = k.LiquidStake(ctx, proxyAcc, arbitrager, maxSpend)
+		if err != nil {
+			return liquiditytypes.Order{}, bTokenMintAmount, err
+		}
+		price := sdk.MaxDec(mintPrice, lowestPrice)
+		msg = liquiditytypes.NewMsgLimitOrder(
+			arbitrager, pairId, liquiditytypes.OrderDirectionSell, sdk.NewCoin(liquidBondDenom, bTokenMintAmount),
+			bondDenom, price, bTokenMintAmount, 0)
+	default:
+		return liquiditytypes.Order{}, bTokenMintAmount, sdkerrors.Wrapf(
+			types.ErrNoArbOpportunity, "last price %s is within [%s, %s]", lastPrice, redeemPrice, mintPrice)
+	}
+
+	order, err = k.liquidityKeeper.LimitOrder(ctx, msg)
+	if err != nil {
+		return liquiditytypes.Order{}, bTokenMintAmount, err
+	}
+	return order, bTokenMintAmount, nil
+}
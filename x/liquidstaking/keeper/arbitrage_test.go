@@ -0,0 +1,86 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	utils "github.com/crescent-network/crescent/v4/types"
+	liquiditytypes "github.com/crescent-network/crescent/v4/x/liquidity/types"
+	"github.com/crescent-network/crescent/v4/x/liquidstaking/types"
+)
+
+func (s *KeeperTestSuite) setPairLastPrice(pairId uint64, lastPrice sdk.Dec) {
+	pair, found := s.app.LiquidityKeeper.GetPair(s.ctx, pairId)
+	s.Require().True(found)
+	pair.LastPrice = &lastPrice
+	s.app.LiquidityKeeper.SetPair(s.ctx, pair)
+}
+
+func (s *KeeperTestSuite) TestArbLiquidStake() {
+	_, valOpers, _ := s.CreateValidators([]int64{1000000, 2000000, 3000000})
+	params := s.keeper.GetParams(s.ctx)
+	params.WhitelistedValidators = []types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[0].String(), TargetWeight: sdk.NewInt(1)},
+		{ValidatorAddress: valOpers[1].String(), TargetWeight: sdk.NewInt(1)},
+		{ValidatorAddress: valOpers[2].String(), TargetWeight: sdk.NewInt(1)},
+	}
+	s.keeper.SetParams(s.ctx, params)
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+	s.Require().NoError(s.liquidStaking(s.delAddrs[0], sdk.NewInt(10_000_000)))
+
+	pair := s.createPair(s.delAddrs[0], params.LiquidBondDenom, sdk.DefaultBondDenom, true)
+	arbitrager := s.delAddrs[1]
+	maxSpend := sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000_000)
+
+	// No arbitrage opportunity when the bToken price is at the peg.
+	s.setPairLastPrice(pair.Id, utils.ParseDec("1.0"))
+	_, _, err := s.keeper.ArbLiquidStake(s.ctx, types.LiquidStakingProxyAcc, arbitrager, pair.Id, maxSpend)
+	s.Require().ErrorIs(err, types.ErrNoArbOpportunity)
+
+	// bToken trades below the redemption rate, so buy bToken.
+	// The order price is bounded by the price limit of the pair.
+	s.setPairLastPrice(pair.Id, utils.ParseDec("0.9"))
+	order, bTokenMintAmt, err := s.keeper.ArbLiquidStake(s.ctx, types.LiquidStakingProxyAcc, arbitrager, pair.Id, maxSpend)
+	s.Require().NoError(err)
+	s.Require().True(bTokenMintAmt.IsZero())
+	s.Require().Equal(liquiditytypes.OrderDirectionBuy, order.Direction)
+	s.Require().True(order.Price.Equal(utils.ParseDec("0.99")))
+	s.Require().True(order.Amount.Equal(sdk.NewInt(1010101)))
+	s.Require().False(maxSpend.IsLT(order.OfferCoin))
+
+	// bToken trades above the mint rate, so mint bToken and sell it.
+	s.setPairLastPrice(pair.Id, utils.ParseDec("1.2"))
+	bTokenSupplyBefore := s.app.BankKeeper.GetSupply(s.ctx, params.LiquidBondDenom).Amount
+	order, bTokenMintAmt, err = s.keeper.ArbLiquidStake(s.ctx, types.LiquidStakingProxyAcc, arbitrager, pair.Id, maxSpend)
+	s.Require().NoError(err)
+	s.Require().True(bTokenMintAmt.Equal(sdk.NewInt(1_000_000)))
+	s.Require().True(s.app.BankKeeper.GetSupply(s.ctx, params.LiquidBondDenom).Amount.Equal(bTokenSupplyBefore.Add(bTokenMintAmt)))
+	s.Require().Equal(liquiditytypes.OrderDirectionSell, order.Direction)
+	s.Require().True(order.Price.Equal(utils.ParseDec("1.08")))
+	s.Require().True(order.Amount.Equal(bTokenMintAmt))
+	s.Require().True(s.app.BankKeeper.GetBalance(s.ctx, arbitrager, params.LiquidBondDenom).IsZero())
+}
+
+func (s *KeeperTestSuite) TestArbLiquidStakeEdgeCases() {
+	params := s.keeper.GetParams(s.ctx)
+	pair := s.createPair(s.delAddrs[0], params.LiquidBondDenom, sdk.DefaultBondDenom, true)
+	reversedPair := s.createPair(s.delAddrs[0], sdk.DefaultBondDenom, params.LiquidBondDenom, true)
+	arbitrager := s.delAddrs[1]
+	maxSpend := sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000_000)
+
+	// fail, invalid bond denom
+	_, _, err := s.keeper.ArbLiquidStake(s.ctx, types.LiquidStakingProxyAcc, arbitrager, pair.Id, sdk.NewInt64Coin("bad", 1_000_000))
+	s.Require().ErrorIs(err, types.ErrInvalidBondDenom)
+
+	// fail, pair not found
+	_, _, err = s.keeper.ArbLiquidStake(s.ctx, types.LiquidStakingProxyAcc, arbitrager, 10, maxSpend)
+	s.Require().ErrorIs(err, sdkerrors.ErrNotFound)
+
+	// fail, bToken is not the base coin of the pair
+	_, _, err = s.keeper.ArbLiquidStake(s.ctx, types.LiquidStakingProxyAcc, arbitrager, reversedPair.Id, maxSpend)
+	s.Require().ErrorIs(err, types.ErrInvalidArbPair)
+
+	// fail, no last price
+	_, _, err = s.keeper.ArbLiquidStake(s.ctx, types.LiquidStakingProxyAcc, arbitrager, pair.Id, maxSpend)
+	s.Require().ErrorIs(err, types.ErrNoArbOpportunity)
+}
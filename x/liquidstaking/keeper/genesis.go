@@ -23,10 +23,24 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 		k.SetLiquidValidator(ctx, lv)
 	}
 
+	k.SetLastUnstakingRecordId(ctx, genState.LastUnstakingRecordId)
+	for _, record := range genState.UnstakingRecords {
+		k.SetUnstakingRecord(ctx, record)
+	}
+	for _, snapshot := range genState.NetAmountSnapshots {
+		k.SetNetAmountSnapshot(ctx, snapshot)
+	}
+	for _, zone := range genState.HostZones {
+		k.SetHostZone(ctx, zone)
+	}
+
 	moduleAcc := k.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
 	if moduleAcc == nil {
 		panic(fmt.Sprintf("%s module account has not been set", types.ModuleName))
 	}
+	if !k.bankKeeper.BlockedAddr(moduleAcc.GetAddress()) {
+		panic(fmt.Sprintf("%s module account %s is not a blocked address", types.ModuleName, moduleAcc.GetAddress()))
+	}
 }
 
 // ExportGenesis returns the liquidstaking module's genesis state.
@@ -38,5 +52,10 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	}
 
 	liquidValidators := k.GetAllLiquidValidators(ctx)
-	return types.NewGenesisState(params, liquidValidators)
+	genState := types.NewGenesisState(params, liquidValidators)
+	genState.LastUnstakingRecordId = k.GetLastUnstakingRecordId(ctx)
+	genState.UnstakingRecords = k.GetAllUnstakingRecords(ctx)
+	genState.NetAmountSnapshots = k.GetAllNetAmountSnapshots(ctx)
+	genState.HostZones = k.GetAllHostZones(ctx)
+	return genState
 }
@@ -31,6 +31,9 @@ func (s *KeeperTestSuite) TestImportExportGenesis() {
 	s.Require().NoError(s.liquidStaking(s.delAddrs[0], stakingAmt))
 	lvs := k.GetAllLiquidValidators(ctx)
 	s.Require().Len(lvs, 2)
+	s.Require().NoError(s.liquidUnstaking(s.delAddrs[0], sdk.NewInt(10000000), false))
+	s.Require().Len(k.GetAllUnstakingRecords(ctx), 2)
+	s.registerHostZone(valOpers[2].String())
 
 	lvStates := k.GetAllLiquidValidatorStates(ctx)
 	genState := k.ExportGenesis(ctx)
@@ -50,6 +53,9 @@ func (s *KeeperTestSuite) TestImportExportGenesis() {
 
 	lvStates3 := k.GetAllLiquidValidatorStates(ctx)
 	s.Require().EqualValues(lvStates, lvStates3)
+	s.Require().Len(k.GetAllUnstakingRecords(ctx), 2)
+	s.Require().Equal(uint64(2), k.GetLastUnstakingRecordId(ctx))
+	s.Require().Len(k.GetAllHostZones(ctx), 1)
 }
 
 func (s *KeeperTestSuite) TestImportExportGenesisEmpty() {
@@ -6,7 +6,9 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/crescent-network/crescent/v4/x/liquidstaking/types"
 )
@@ -58,3 +60,111 @@ func (k Querier) VotingPower(c context.Context, req *types.QueryVotingPowerReque
 	}
 	return &types.QueryVotingPowerResponse{VotingPower: k.GetVotingPower(ctx, addr)}, nil
 }
+
+// UnstakingRecords queries in-progress unbondings of the liquid staker initiated through liquid unstaking.
+func (k Querier) UnstakingRecords(c context.Context, req *types.QueryUnstakingRecordsRequest) (*types.QueryUnstakingRecordsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	liquidStaker, err := sdk.AccAddressFromBech32(req.LiquidStaker)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid liquid staker address: %v", err)
+	}
+	return &types.QueryUnstakingRecordsResponse{UnstakingRecords: k.GetUnstakingRecordsByLiquidStaker(ctx, liquidStaker)}, nil
+}
+
+// NetAmount queries the net amount, bToken total supply and mint rate of the current block or a past block.
+func (k Querier) NetAmount(c context.Context, req *types.QueryNetAmountRequest) (*types.QueryNetAmountResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.Height < 0 {
+		return nil, status.Error(codes.InvalidArgument, "height must not be negative")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	if req.Height == 0 || req.Height == ctx.BlockHeight() {
+		snapshot := types.NewNetAmountSnapshot(ctx.BlockHeight(), ctx.BlockTime(), k.GetNetAmountState(ctx))
+		return &types.QueryNetAmountResponse{NetAmountSnapshot: snapshot}, nil
+	}
+	snapshot, found := k.GetNetAmountSnapshot(ctx, req.Height)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "net amount snapshot at height %d not found", req.Height)
+	}
+	return &types.QueryNetAmountResponse{NetAmountSnapshot: snapshot}, nil
+}
+
+// NetAmountSnapshots queries all stored net amount snapshots.
+func (k Querier) NetAmountSnapshots(c context.Context, req *types.QueryNetAmountSnapshotsRequest) (*types.QueryNetAmountSnapshotsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(k.storeKey)
+	snapshotStore := prefix.NewStore(store, types.NetAmountSnapshotKeyPrefix)
+	var snapshots []types.NetAmountSnapshot
+	pageRes, err := query.Paginate(snapshotStore, req.Pagination, func(key, value []byte) error {
+		var snapshot types.NetAmountSnapshot
+		if err := k.cdc.Unmarshal(value, &snapshot); err != nil {
+			return err
+		}
+		snapshots = append(snapshots, snapshot)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryNetAmountSnapshotsResponse{NetAmountSnapshots: snapshots, Pagination: pageRes}, nil
+}
+
+// HostZones queries all host zones.
+func (k Querier) HostZones(c context.Context, req *types.QueryHostZonesRequest) (*types.QueryHostZonesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryHostZonesResponse{HostZones: k.GetAllHostZones(ctx)}, nil
+}
+
+// HostZone queries the host zone of the chain id.
+func (k Querier) HostZone(c context.Context, req *types.QueryHostZoneRequest) (*types.QueryHostZoneResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.ChainId == "" {
+		return nil, status.Error(codes.InvalidArgument, "chain id must not be empty")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	zone, found := k.GetHostZone(ctx, req.ChainId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "host zone %s not found", req.ChainId)
+	}
+	return &types.QueryHostZoneResponse{
+		HostZone:          zone,
+		BtokenTotalSupply: k.bankKeeper.GetSupply(ctx, zone.BtokenDenom).Amount,
+		NetAmount:         k.GetHostZoneNetAmount(ctx, zone),
+	}, nil
+}
+
+// ModuleAccounts queries the accounts managed by the module.
+func (k Querier) ModuleAccounts(c context.Context, req *types.QueryModuleAccountsRequest) (*types.QueryModuleAccountsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryModuleAccountsResponse{Accounts: k.Keeper.ModuleAccounts(ctx)}, nil
+}
+
+// RewardsEstimate queries the estimated APR of liquid staking.
+func (k Querier) RewardsEstimate(c context.Context, req *types.QueryRewardsEstimateRequest) (*types.QueryRewardsEstimateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	resp := k.EstimateRewards(ctx)
+	return &resp, nil
+}
@@ -6,7 +6,9 @@ import (
 	"google.golang.org/grpc/status"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
+	"github.com/crescent-network/crescent/v4/x/liquidstaking"
 	"github.com/crescent-network/crescent/v4/x/liquidstaking/types"
 )
 
@@ -66,3 +68,148 @@ func (s *KeeperTestSuite) TestGRPCQueries() {
 	s.Require().Nil(respVotingPower)
 	s.Require().EqualError(err, "decoding bech32 failed: invalid separator index -1")
 }
+
+func (s *KeeperTestSuite) TestGRPCUnstakingRecords() {
+	_, valOpers, _ := s.CreateValidators([]int64{1000000, 1000000, 1000000})
+	params := s.keeper.GetParams(s.ctx)
+	params.MinLiquidStakingAmount = sdk.NewInt(50000)
+	params.WhitelistedValidators = []types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[0].String(), TargetWeight: sdk.NewInt(1)},
+		{ValidatorAddress: valOpers[1].String(), TargetWeight: sdk.NewInt(1)},
+	}
+	s.keeper.SetParams(s.ctx, params)
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+
+	liquidStaker := s.delAddrs[0]
+	s.Require().NoError(s.liquidStaking(liquidStaker, sdk.NewInt(1000000)))
+
+	// The liquid staker's own native unbonding is not included in the records.
+	_, err := s.app.StakingKeeper.Delegate(s.ctx, liquidStaker, sdk.NewInt(100000), stakingtypes.Unbonded, s.app.StakingKeeper.Validator(s.ctx, valOpers[2]).(stakingtypes.Validator), true)
+	s.Require().NoError(err)
+	_, err = s.app.StakingKeeper.Undelegate(s.ctx, liquidStaker, valOpers[2], sdk.NewDec(100000))
+	s.Require().NoError(err)
+
+	ubdTime, _, ubds, _, err := s.liquidUnstakingWithResult(liquidStaker, sdk.NewCoin(params.LiquidBondDenom, sdk.NewInt(100000)))
+	s.Require().NoError(err)
+	s.Require().Len(ubds, 2)
+
+	resp, err := s.querier.UnstakingRecords(sdk.WrapSDKContext(s.ctx), &types.QueryUnstakingRecordsRequest{LiquidStaker: liquidStaker.String()})
+	s.Require().NoError(err)
+	s.Require().Len(resp.UnstakingRecords, 2)
+	for i, record := range resp.UnstakingRecords {
+		s.Require().Equal(uint64(i+1), record.Id)
+		s.Require().Equal(liquidStaker.String(), record.LiquidStaker)
+		s.Require().Equal(ubds[i].ValidatorAddress, record.ValidatorAddress)
+		s.Require().Equal(s.ctx.BlockHeight(), record.CreationHeight)
+		s.Require().True(ubdTime.Equal(record.CompletionTime))
+		s.Require().True(record.Amount.Equal(ubds[i].Entries[0].InitialBalance))
+	}
+
+	resp, err = s.querier.UnstakingRecords(sdk.WrapSDKContext(s.ctx), &types.QueryUnstakingRecordsRequest{LiquidStaker: s.delAddrs[1].String()})
+	s.Require().NoError(err)
+	s.Require().Len(resp.UnstakingRecords, 0)
+
+	resp, err = s.querier.UnstakingRecords(sdk.WrapSDKContext(s.ctx), nil)
+	s.Require().Nil(resp)
+	s.Require().ErrorIs(err, status.Error(codes.InvalidArgument, "invalid request"))
+
+	resp, err = s.querier.UnstakingRecords(sdk.WrapSDKContext(s.ctx), &types.QueryUnstakingRecordsRequest{LiquidStaker: "invalidaddr"})
+	s.Require().Nil(resp)
+	s.Require().EqualError(err, "rpc error: code = InvalidArgument desc = invalid liquid staker address: decoding bech32 failed: invalid separator index -1")
+
+	// Completed records are not returned and deleted on the next begin block.
+	s.ctx = s.ctx.WithBlockHeight(s.ctx.BlockHeight() + 1).WithBlockTime(ubdTime)
+	resp, err = s.querier.UnstakingRecords(sdk.WrapSDKContext(s.ctx), &types.QueryUnstakingRecordsRequest{LiquidStaker: liquidStaker.String()})
+	s.Require().NoError(err)
+	s.Require().Len(resp.UnstakingRecords, 0)
+	s.Require().Len(s.keeper.GetAllUnstakingRecords(s.ctx), 2)
+	liquidstaking.BeginBlocker(s.ctx, s.keeper)
+	s.Require().Len(s.keeper.GetAllUnstakingRecords(s.ctx), 0)
+	s.Require().Equal(uint64(2), s.keeper.GetLastUnstakingRecordId(s.ctx))
+}
+
+func (s *KeeperTestSuite) TestGRPCModuleAccounts() {
+	_, valOpers, _ := s.CreateValidators([]int64{1000000})
+	zone := s.registerHostZone(valOpers[0].String())
+	s.fundAddr(zone.GetDepositAddress(), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)))
+
+	resp, err := s.querier.ModuleAccounts(sdk.WrapSDKContext(s.ctx), nil)
+	s.Require().Nil(resp)
+	s.Require().ErrorIs(err, status.Error(codes.InvalidArgument, "invalid request"))
+
+	resp, err = s.querier.ModuleAccounts(sdk.WrapSDKContext(s.ctx), &types.QueryModuleAccountsRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(resp.Accounts, 3)
+
+	s.Require().Equal("module", resp.Accounts[0].Name)
+	s.Require().Equal(s.app.AccountKeeper.GetModuleAddress(types.ModuleName).String(), resp.Accounts[0].Address)
+	s.Require().True(resp.Accounts[0].Blocked)
+
+	s.Require().Equal("liquid_staking_proxy", resp.Accounts[1].Name)
+	s.Require().Equal(types.LiquidStakingProxyAcc.String(), resp.Accounts[1].Address)
+	s.Require().False(resp.Accounts[1].Blocked)
+
+	s.Require().Equal("host_zone_deposit/"+zone.ChainId, resp.Accounts[2].Name)
+	s.Require().Equal(zone.DepositAddress, resp.Accounts[2].Address)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)), resp.Accounts[2].Balances)
+}
+
+func (s *KeeperTestSuite) TestGRPCRewardsEstimate() {
+	_, valOpers, _ := s.CreateValidators([]int64{1000000, 1000000})
+	for i, rate := range []sdk.Dec{sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(3, 1)} {
+		val, _ := s.app.StakingKeeper.GetValidator(s.ctx, valOpers[i])
+		val.Commission.Rate = rate
+		s.app.StakingKeeper.SetValidator(s.ctx, val)
+	}
+	params := s.keeper.GetParams(s.ctx)
+	params.WhitelistedValidators = []types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[0].String(), TargetWeight: sdk.NewInt(1)},
+		{ValidatorAddress: valOpers[1].String(), TargetWeight: sdk.NewInt(3)},
+	}
+	params.ProtocolCommissionRate = sdk.NewDecWithPrec(1, 1)
+	params.UnstakeFeeRate = sdk.NewDecWithPrec(1, 2)
+	s.keeper.SetParams(s.ctx, params)
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+
+	resp, err := s.querier.RewardsEstimate(sdk.WrapSDKContext(s.ctx), nil)
+	s.Require().Nil(resp)
+	s.Require().ErrorIs(err, status.Error(codes.InvalidArgument, "invalid request"))
+
+	stakingAPR := s.app.MintKeeper.GetAnnualProvisions(s.ctx).
+		Mul(sdk.OneDec().Sub(s.app.DistrKeeper.GetCommunityTax(s.ctx))).
+		QuoInt(s.app.StakingKeeper.TotalBondedTokens(s.ctx))
+	s.Require().True(stakingAPR.IsPositive())
+
+	// Nothing is liquid staked yet, so the commission rates are weighted by
+	// the target weights.
+	resp, err = s.querier.RewardsEstimate(sdk.WrapSDKContext(s.ctx), &types.QueryRewardsEstimateRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(stakingAPR, resp.StakingApr)
+	s.Require().Equal(sdk.MustNewDecFromStr("0.25"), resp.CommissionRate)
+	s.Require().Equal(params.ProtocolCommissionRate, resp.ProtocolCommissionRate)
+	s.Require().Equal(params.UnstakeFeeRate, resp.UnstakeFeeRate)
+	grossAPR := stakingAPR.Mul(sdk.MustNewDecFromStr("0.75")).Mul(sdk.MustNewDecFromStr("0.9"))
+	s.Require().Equal(grossAPR, resp.GrossApr)
+	s.Require().Equal(sdk.OneDec().Add(grossAPR).Mul(sdk.MustNewDecFromStr("0.99")).Sub(sdk.OneDec()), resp.NetApr)
+
+	// The liquid tokens are delegated by the target weights, and the
+	// commission rates are weighted by the liquid tokens even after the
+	// weights change.
+	s.Require().NoError(s.liquidStaking(s.delAddrs[0], sdk.NewInt(4000000)))
+	params.WhitelistedValidators[0].TargetWeight = sdk.NewInt(3)
+	params.WhitelistedValidators[1].TargetWeight = sdk.NewInt(1)
+	s.keeper.SetParams(s.ctx, params)
+	resp, err = s.querier.RewardsEstimate(sdk.WrapSDKContext(s.ctx), &types.QueryRewardsEstimateRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.MustNewDecFromStr("0.25"), resp.CommissionRate)
+
+	// The inflation is not paid in the bond denom.
+	mintParams := s.app.MintKeeper.GetParams(s.ctx)
+	mintParams.MintDenom = "denom1"
+	s.app.MintKeeper.SetParams(s.ctx, mintParams)
+	resp, err = s.querier.RewardsEstimate(sdk.WrapSDKContext(s.ctx), &types.QueryRewardsEstimateRequest{})
+	s.Require().NoError(err)
+	s.Require().True(resp.StakingApr.IsZero())
+	s.Require().True(resp.GrossApr.IsZero())
+	s.Require().Equal(sdk.NewDecWithPrec(-1, 2), resp.NetApr)
+}
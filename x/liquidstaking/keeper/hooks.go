@@ -3,6 +3,10 @@ package keeper
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	epochstypes "github.com/crescent-network/crescent/v4/x/epochs/types"
+	"github.com/crescent-network/crescent/v4/x/liquidstaking/types"
 )
 
 // Wrapper struct
@@ -10,7 +14,11 @@ type Hooks struct {
 	k Keeper
 }
 
-var _ govtypes.GovHooks = Hooks{}
+var (
+	_ govtypes.GovHooks         = Hooks{}
+	_ stakingtypes.StakingHooks = Hooks{}
+	_ epochstypes.EpochHooks    = Hooks{}
+)
 
 // Create new distribution hooks
 func (k Keeper) Hooks() Hooks { return Hooks{k} }
@@ -25,3 +33,83 @@ func (h Hooks) AfterProposalVotingPeriodEnded(_ sdk.Context, _ uint64)         {
 func (h Hooks) SetAdditionalVotingPowers(ctx sdk.Context, votes govtypes.Votes, votingPowers *govtypes.AdditionalVotingPowers) {
 	h.k.SetLiquidStakingVotingPowers(ctx, votes, votingPowers)
 }
+
+func (h Hooks) AfterValidatorCreated(_ sdk.Context, _ sdk.ValAddress)                            {}
+func (h Hooks) AfterValidatorRemoved(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress)         {}
+func (h Hooks) AfterValidatorBonded(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress)          {}
+func (h Hooks) AfterValidatorBeginUnbonding(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress)  {}
+func (h Hooks) BeforeDelegationCreated(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress)        {}
+func (h Hooks) BeforeDelegationSharesModified(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) {}
+func (h Hooks) BeforeDelegationRemoved(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress)        {}
+func (h Hooks) AfterDelegationModified(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress)        {}
+func (h Hooks) BeforeValidatorSlashed(_ sdk.Context, _ sdk.ValAddress, _ sdk.Dec)                {}
+
+// BeforeValidatorModified resets the mint rate bound, since the validator
+// may be about to be slashed.
+// Slashing calls this hook before slashing the unbonding delegations and
+// redelegations of the validator, which can lower the net amount too.
+func (h Hooks) BeforeValidatorModified(ctx sdk.Context, _ sdk.ValAddress) {
+	h.k.DeleteMintRateBound(ctx)
+}
+
+// AfterEpochEnd rebalances the liquid validators when the epoch of RebalancingEpochIdentifier ends, and compounds
+// rewards when the epoch of RewardCompoundingEpochIdentifier ends.
+func (h Hooks) AfterEpochEnd(ctx sdk.Context, identifier string, _ int64) {
+	params := h.k.GetParams(ctx)
+	if params.RebalancingEpochIdentifier != "" && identifier == params.RebalancingEpochIdentifier {
+		h.k.updateLiquidValidatorSet(ctx, true)
+	}
+	if params.RewardCompoundingEpochIdentifier != "" && identifier == params.RewardCompoundingEpochIdentifier {
+		h.k.CompoundRewards(ctx)
+	}
+}
+
+func (h Hooks) BeforeEpochStart(_ sdk.Context, _ string, _ int64) {}
+
+// epochExists returns whether the epoch of the identifier exists in the epochs
+// module, so that the epoch hooks are called for it.
+// Rebalancing and reward compounding fall back to their per-block schedules
+// when their epoch identifiers are empty or unknown.
+func (k Keeper) epochExists(ctx sdk.Context, identifier string) bool {
+	if identifier == "" {
+		return false
+	}
+	_, found := k.epochsKeeper.GetEpochInfo(ctx, identifier)
+	return found
+}
+
+// CompoundsRewardsByEpoch returns whether rewards are compounded at the end of
+// the epoch of RewardCompoundingEpochIdentifier rather than every
+// RewardCompoundingEpoch blocks.
+func (k Keeper) CompoundsRewardsByEpoch(ctx sdk.Context) bool {
+	return k.epochExists(ctx, k.GetParams(ctx).RewardCompoundingEpochIdentifier)
+}
+
+// SetHooks sets the liquidstaking hooks.
+// It must be called before the keeper is passed to other modules, and
+// multiple hooks can be set at once using types.NewMultiLiquidStakingHooks.
+func (k *Keeper) SetHooks(lh types.LiquidStakingHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set liquidstaking hooks twice")
+	}
+	k.hooks = lh
+	return k
+}
+
+func (k Keeper) afterLiquidStake(ctx sdk.Context, liquidStaker sdk.AccAddress, stakingCoin, mintedBToken sdk.Coin) {
+	if k.hooks != nil {
+		k.hooks.AfterLiquidStake(ctx, liquidStaker, stakingCoin, mintedBToken)
+	}
+}
+
+func (k Keeper) afterLiquidUnstake(ctx sdk.Context, liquidStaker sdk.AccAddress, burnedBToken sdk.Coin, unstakedAmount sdk.Int) {
+	if k.hooks != nil {
+		k.hooks.AfterLiquidUnstake(ctx, liquidStaker, burnedBToken, unstakedAmount)
+	}
+}
+
+func (k Keeper) afterRebalanced(ctx sdk.Context, redelegations []types.Redelegation) {
+	if k.hooks != nil {
+		k.hooks.AfterRebalanced(ctx, redelegations)
+	}
+}
@@ -0,0 +1,107 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	utils "github.com/crescent-network/crescent/v4/types"
+	"github.com/crescent-network/crescent/v4/x/liquidstaking/keeper"
+	"github.com/crescent-network/crescent/v4/x/liquidstaking/types"
+)
+
+var _ types.LiquidStakingHooks = (*mockLiquidStakingHooks)(nil)
+
+// mockLiquidStakingHooks records the calls of the hooks.
+type mockLiquidStakingHooks struct {
+	mintedBTokens []sdk.Coin
+	burnedBTokens []sdk.Coin
+	unstakedAmts  []sdk.Int
+	redelegations [][]types.Redelegation
+}
+
+func (h *mockLiquidStakingHooks) AfterLiquidStake(_ sdk.Context, _ sdk.AccAddress, _, mintedBToken sdk.Coin) {
+	h.mintedBTokens = append(h.mintedBTokens, mintedBToken)
+}
+
+func (h *mockLiquidStakingHooks) AfterLiquidUnstake(_ sdk.Context, _ sdk.AccAddress, burnedBToken sdk.Coin, unstakedAmount sdk.Int) {
+	h.burnedBTokens = append(h.burnedBTokens, burnedBToken)
+	h.unstakedAmts = append(h.unstakedAmts, unstakedAmount)
+}
+
+func (h *mockLiquidStakingHooks) AfterRebalanced(_ sdk.Context, redelegations []types.Redelegation) {
+	h.redelegations = append(h.redelegations, redelegations)
+}
+
+// newKeeperWithHooks returns a new keeper sharing the store with the app's
+// keeper, with the hooks set.
+// The app's keeper already has its hooks set.
+func (s *KeeperTestSuite) newKeeperWithHooks(hooks types.LiquidStakingHooks) keeper.Keeper {
+	k := keeper.NewKeeper(
+		s.app.AppCodec(),
+		s.app.GetKey(types.StoreKey),
+		s.app.GetSubspace(types.ModuleName),
+		s.app.AccountKeeper,
+		s.app.BankKeeper,
+		s.app.StakingKeeper,
+		s.app.DistrKeeper,
+		s.app.LiquidityKeeper,
+		s.app.LPFarmKeeper,
+		s.app.SlashingKeeper,
+		s.app.MintKeeper,
+		s.app.EpochsKeeper,
+		s.app.IBCKeeper.ClientKeeper,
+		s.app.IBCKeeper.ConnectionKeeper,
+		s.app.IBCKeeper.ChannelKeeper,
+		s.app.TransferKeeper,
+		s.app.ICAControllerKeeper,
+		s.app.ScopedLiquidStakingKeeper,
+		s.app.LiquidStakingKeeper.GetAuthority(),
+	)
+	k.SetHooks(hooks)
+	return k
+}
+
+func (s *KeeperTestSuite) TestLiquidStakingHooks() {
+	s.Require().Panics(func() {
+		s.keeper.SetHooks(types.NewMultiLiquidStakingHooks())
+	})
+
+	hooks1, hooks2 := &mockLiquidStakingHooks{}, &mockLiquidStakingHooks{}
+	k := s.newKeeperWithHooks(types.NewMultiLiquidStakingHooks(hooks1, hooks2))
+
+	_, valOpers, _ := s.CreateValidators([]int64{1000000, 1000000, 1000000})
+	params := k.GetParams(s.ctx)
+	params.MinLiquidStakingAmount = sdk.NewInt(10000)
+	params.WhitelistedValidators = []types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[0].String(), TargetWeight: sdk.NewInt(10)},
+		{ValidatorAddress: valOpers[1].String(), TargetWeight: sdk.NewInt(10)},
+	}
+	k.SetParams(s.ctx, params)
+	s.Require().Empty(k.UpdateLiquidValidatorSet(s.ctx))
+
+	_, bTokenMintAmt, err := k.LiquidStake(
+		s.ctx, types.LiquidStakingProxyAcc, s.delAddrs[0], sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(50000)))
+	s.Require().NoError(err)
+	s.Require().Equal([]sdk.Coin{sdk.NewCoin(params.LiquidBondDenom, bTokenMintAmt)}, hooks1.mintedBTokens)
+	// A failed liquid staking doesn't call the hook.
+	_, _, err = k.LiquidStake(
+		s.ctx, types.LiquidStakingProxyAcc, s.delAddrs[0], sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
+	s.Require().ErrorIs(err, types.ErrLessThanMinLiquidStakingAmount)
+	s.Require().Len(hooks1.mintedBTokens, 1)
+
+	// A new validator makes the liquid tokens rebalanced.
+	params.WhitelistedValidators = append(params.WhitelistedValidators,
+		types.WhitelistedValidator{ValidatorAddress: valOpers[2].String(), TargetWeight: sdk.NewInt(10)})
+	k.SetParams(s.ctx, params)
+	reds := k.UpdateLiquidValidatorSet(s.ctx)
+	s.Require().NotEmpty(reds)
+	s.Require().Equal([][]types.Redelegation{reds}, hooks1.redelegations)
+
+	burnedBToken := utils.ParseCoin("30000bstake")
+	_, unbondingAmt, _, _, err := k.LiquidUnstake(s.ctx, types.LiquidStakingProxyAcc, s.delAddrs[0], burnedBToken)
+	s.Require().NoError(err)
+	s.Require().Equal([]sdk.Coin{burnedBToken}, hooks1.burnedBTokens)
+	s.Require().Equal([]sdk.Int{unbondingAmt}, hooks1.unstakedAmts)
+
+	// All the hooks are called.
+	s.Require().Equal(hooks1, hooks2)
+}
@@ -0,0 +1,161 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+
+	"github.com/crescent-network/crescent/v4/x/liquidstaking/types"
+)
+
+// GetHostZone returns the host zone of the chain id.
+func (k Keeper) GetHostZone(ctx sdk.Context, chainID string) (zone types.HostZone, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetHostZoneKey(chainID))
+	if bz == nil {
+		return
+	}
+	zone = types.MustUnmarshalHostZone(k.cdc, bz)
+	return zone, true
+}
+
+// SetHostZone stores the host zone.
+func (k Keeper) SetHostZone(ctx sdk.Context, zone types.HostZone) {
+	store := ctx.KVStore(k.storeKey)
+	bz := types.MustMarshalHostZone(k.cdc, &zone)
+	store.Set(types.GetHostZoneKey(zone.ChainId), bz)
+}
+
+// IterateAllHostZones iterates through all host zones and calls cb for each
+// host zone.
+func (k Keeper) IterateAllHostZones(ctx sdk.Context, cb func(zone types.HostZone) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.HostZoneKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		zone := types.MustUnmarshalHostZone(k.cdc, iterator.Value())
+		if cb(zone) {
+			break
+		}
+	}
+}
+
+// GetAllHostZones returns all host zones.
+func (k Keeper) GetAllHostZones(ctx sdk.Context) (zones []types.HostZone) {
+	zones = []types.HostZone{}
+	k.IterateAllHostZones(ctx, func(zone types.HostZone) (stop bool) {
+		zones = append(zones, zone)
+		return false
+	})
+	return zones
+}
+
+// GetHostZoneNetAmount returns the amount of the deposits of the host zone
+// and the tokens staked on the host chain.
+func (k Keeper) GetHostZoneNetAmount(ctx sdk.Context, zone types.HostZone) sdk.Int {
+	deposits := k.bankKeeper.GetBalance(ctx, zone.GetDepositAddress(), zone.IbcDenom).Amount
+	return deposits.Add(zone.StakedAmount())
+}
+
+// RegisterHostZone registers a host zone and its interchain account.
+// The interchain account is usable once its channel handshake is completed
+// by relayers, until then the deposits of the host zone are kept on this
+// chain.
+func (k Keeper) RegisterHostZone(ctx sdk.Context, p *types.RegisterHostZoneProposal) (types.HostZone, error) {
+	if _, found := k.GetHostZone(ctx, p.ChainId); found {
+		return types.HostZone{}, sdkerrors.Wrapf(types.ErrHostZoneAlreadyExists, "chain id %s", p.ChainId)
+	}
+	if p.BtokenDenom == k.LiquidBondDenom(ctx) {
+		return types.HostZone{}, sdkerrors.Wrapf(types.ErrInvalidDenom, "btoken denom must not be the liquid bond denom")
+	}
+	var bTokenDenomUsed bool
+	k.IterateAllHostZones(ctx, func(zone types.HostZone) (stop bool) {
+		bTokenDenomUsed = zone.BtokenDenom == p.BtokenDenom
+		return bTokenDenomUsed
+	})
+	if bTokenDenomUsed {
+		return types.HostZone{}, sdkerrors.Wrapf(types.ErrInvalidDenom, "btoken denom %s is already used", p.BtokenDenom)
+	}
+	if k.bankKeeper.GetSupply(ctx, p.BtokenDenom).IsPositive() {
+		return types.HostZone{}, sdkerrors.Wrapf(types.ErrInvalidDenom, "btoken denom %s already has supply", p.BtokenDenom)
+	}
+
+	if _, found := k.connectionKeeper.GetConnection(ctx, p.ConnectionId); !found {
+		return types.HostZone{}, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "connection %s not found", p.ConnectionId)
+	}
+	channel, found := k.channelKeeper.GetChannel(ctx, transfertypes.PortID, p.TransferChannelId)
+	if !found {
+		return types.HostZone{}, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "transfer channel %s not found", p.TransferChannelId)
+	}
+	if len(channel.ConnectionHops) != 1 || channel.ConnectionHops[0] != p.ConnectionId {
+		return types.HostZone{}, sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest, "transfer channel %s is not on connection %s", p.TransferChannelId, p.ConnectionId)
+	}
+
+	zone := types.NewHostZone(p)
+	if err := k.icaControllerKeeper.RegisterInterchainAccount(ctx, zone.ConnectionId, types.HostZoneICAOwner(zone.ChainId)); err != nil {
+		return types.HostZone{}, err
+	}
+	k.SetHostZone(ctx, zone)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRegisterHostZone,
+			sdk.NewAttribute(types.AttributeKeyChainId, zone.ChainId),
+			sdk.NewAttribute(types.AttributeKeyBTokenDenom, zone.BtokenDenom),
+		),
+	})
+
+	return zone, nil
+}
+
+// InterchainLiquidStake deposits the IBC denom coin of the host zone's
+// staking denom to be staked on the host chain, and mints the bToken of the
+// host zone for it.
+// The deposits are transferred to the interchain account and delegated on the
+// host chain at the end of the block.
+// The bTokens of host zones cannot be unstaked yet, so it fails unless the
+// InterchainLiquidStakingEnabled param is set.
+func (k Keeper) InterchainLiquidStake(
+	ctx sdk.Context, liquidStaker sdk.AccAddress, chainID string, stakingCoin sdk.Coin) (bTokenMinted sdk.Coin, err error) {
+	if !k.GetParams(ctx).InterchainLiquidStakingEnabled {
+		return sdk.Coin{}, types.ErrInterchainLiquidStakingDisabled
+	}
+	zone, found := k.GetHostZone(ctx, chainID)
+	if !found {
+		return sdk.Coin{}, sdkerrors.Wrapf(types.ErrHostZoneNotFound, "chain id %s", chainID)
+	}
+	if stakingCoin.Denom != zone.IbcDenom {
+		return sdk.Coin{}, sdkerrors.Wrapf(
+			types.ErrInvalidDenom, "invalid coin denomination: got %s, expected %s", stakingCoin.Denom, zone.IbcDenom)
+	}
+
+	// net amount must be calculated before send
+	netAmount := k.GetHostZoneNetAmount(ctx, zone)
+	bTokenTotalSupply := k.bankKeeper.GetSupply(ctx, zone.BtokenDenom).Amount
+
+	bTokenMintAmount := stakingCoin.Amount
+	if bTokenTotalSupply.IsPositive() {
+		if !netAmount.IsPositive() {
+			return sdk.Coin{}, types.ErrInvalidBTokenSupply
+		}
+		bTokenMintAmount = types.NativeTokenToBToken(stakingCoin.Amount, bTokenTotalSupply, netAmount.ToDec())
+	}
+	if !bTokenMintAmount.IsPositive() {
+		return sdk.Coin{}, types.ErrTooSmallLiquidStakingAmount
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, liquidStaker, zone.GetDepositAddress(), sdk.NewCoins(stakingCoin)); err != nil {
+		return sdk.Coin{}, err
+	}
+	bTokenMinted = sdk.NewCoin(zone.BtokenDenom, bTokenMintAmount)
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(bTokenMinted)); err != nil {
+		return sdk.Coin{}, err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, liquidStaker, sdk.NewCoins(bTokenMinted)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return bTokenMinted, nil
+}
@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	liquiditytypes "github.com/crescent-network/crescent/v4/x/liquidity/types"
+	"github.com/crescent-network/crescent/v4/x/liquidstaking/types"
+)
+
+// LiquidUnstakeInstant sells the bToken of the liquid staker through the pair of bToken and bond denom
+// on the DEX, instead of unbonding it, so that the liquid staker gets the bond denom coin without waiting
+// for the unbonding period.
+// The sell order is made at the last price of the pair lowered by maxSlippage, which is bounded by the
+// price limits of the pair. The order is made with zero lifespan so that it is matched only in the current
+// batch and the unmatched remaining bToken is refunded to the liquid staker after the batch.
+func (k Keeper) LiquidUnstakeInstant(
+	ctx sdk.Context, liquidStaker sdk.AccAddress, pairId uint64, amount sdk.Coin, maxSlippage sdk.Dec,
+) (liquiditytypes.Order, error) {
+	liquidBondDenom := k.LiquidBondDenom(ctx)
+	if amount.Denom != liquidBondDenom {
+		return liquiditytypes.Order{}, sdkerrors.Wrapf(
+			types.ErrInvalidLiquidBondDenom, "invalid coin denomination: got %s, expected %s", amount.Denom, liquidBondDenom,
+		)
+	}
+
+	pair, found := k.liquidityKeeper.GetPair(ctx, pairId)
+	if !found {
+		return liquiditytypes.Order{}, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "pair %d not found", pairId)
+	}
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+	if pair.BaseCoinDenom != liquidBondDenom || pair.QuoteCoinDenom != bondDenom {
+		return liquiditytypes.Order{}, sdkerrors.Wrapf(
+			types.ErrInvalidArbPair, "(%s, %s) != (%s, %s)", pair.BaseCoinDenom, pair.QuoteCoinDenom, liquidBondDenom, bondDenom,
+		)
+	}
+	if pair.LastPrice == nil {
+		return liquiditytypes.Order{}, sdkerrors.Wrapf(types.ErrNoPairLastPrice, "pair %d", pairId)
+	}
+	lastPrice := *pair.LastPrice
+
+	lowestPrice, _ := k.liquidityKeeper.PriceLimits(ctx, pair)
+	price := sdk.MaxDec(lastPrice.Mul(sdk.OneDec().Sub(maxSlippage)), lowestPrice)
+
+	msg := liquiditytypes.NewMsgLimitOrder(
+		liquidStaker, pairId, liquiditytypes.OrderDirectionSell, amount, bondDenom, price, amount.Amount, 0)
+	return k.liquidityKeeper.LimitOrder(ctx, msg)
+}
@@ -0,0 +1,89 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	utils "github.com/crescent-network/crescent/v4/types"
+	"github.com/crescent-network/crescent/v4/x/liquidity"
+	liquiditytypes "github.com/crescent-network/crescent/v4/x/liquidity/types"
+	"github.com/crescent-network/crescent/v4/x/liquidstaking/types"
+)
+
+// executeBatch executes the batch of the liquidity module and deletes the finished orders.
+func (s *KeeperTestSuite) executeBatch() {
+	liquidity.EndBlocker(s.ctx, s.app.LiquidityKeeper)
+	liquidity.BeginBlocker(s.ctx, s.app.LiquidityKeeper)
+}
+
+func (s *KeeperTestSuite) TestLiquidUnstakeInstant() {
+	params := s.keeper.GetParams(s.ctx)
+	pair := s.createPair(s.delAddrs[0], params.LiquidBondDenom, sdk.DefaultBondDenom, true)
+	s.createPool(s.delAddrs[0], pair.Id, sdk.NewCoins(
+		sdk.NewInt64Coin(params.LiquidBondDenom, 1_000_000_000), sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000_000_000)), true)
+	s.setPairLastPrice(pair.Id, utils.ParseDec("1.0"))
+
+	liquidStaker := s.delAddrs[1]
+	amount := sdk.NewInt64Coin(params.LiquidBondDenom, 1_000_000)
+	s.fundAddr(liquidStaker, sdk.NewCoins(amount))
+	bondDenomBalanceBefore := s.app.BankKeeper.GetBalance(s.ctx, liquidStaker, sdk.DefaultBondDenom)
+
+	// No slippage is allowed, so the order is not matched with the pool
+	// and the bToken is refunded after the batch.
+	order, err := s.keeper.LiquidUnstakeInstant(s.ctx, liquidStaker, pair.Id, amount, sdk.ZeroDec())
+	s.Require().NoError(err)
+	s.Require().Equal(liquiditytypes.OrderDirectionSell, order.Direction)
+	s.Require().True(order.Price.Equal(utils.ParseDec("1.0")))
+	s.Require().True(order.Amount.Equal(amount.Amount))
+	s.Require().True(s.app.BankKeeper.GetBalance(s.ctx, liquidStaker, params.LiquidBondDenom).IsZero())
+	s.executeBatch()
+	s.Require().True(s.app.BankKeeper.GetBalance(s.ctx, liquidStaker, params.LiquidBondDenom).IsEqual(amount))
+	s.Require().True(s.app.BankKeeper.GetBalance(s.ctx, liquidStaker, sdk.DefaultBondDenom).IsEqual(bondDenomBalanceBefore))
+
+	// The order price is lowered by the max slippage.
+	order, err = s.keeper.LiquidUnstakeInstant(s.ctx, liquidStaker, pair.Id, amount, utils.ParseDec("0.05"))
+	s.Require().NoError(err)
+	s.Require().True(order.Price.Equal(utils.ParseDec("0.95")))
+	s.executeBatch()
+	s.Require().True(s.app.BankKeeper.GetBalance(s.ctx, liquidStaker, params.LiquidBondDenom).IsZero())
+	received := s.app.BankKeeper.GetBalance(s.ctx, liquidStaker, sdk.DefaultBondDenom).Sub(bondDenomBalanceBefore)
+	s.Require().True(received.Amount.ToDec().GTE(amount.Amount.ToDec().Mul(utils.ParseDec("0.95"))))
+	s.Require().True(received.Amount.LT(amount.Amount))
+
+	// The order price is bounded by the price limits of the pair.
+	s.setPairLastPrice(pair.Id, utils.ParseDec("1.0"))
+	s.fundAddr(liquidStaker, sdk.NewCoins(amount))
+	order, err = s.keeper.LiquidUnstakeInstant(s.ctx, liquidStaker, pair.Id, amount, utils.ParseDec("0.5"))
+	s.Require().NoError(err)
+	s.Require().True(order.Price.Equal(utils.ParseDec("0.9")))
+}
+
+func (s *KeeperTestSuite) TestLiquidUnstakeInstantEdgeCases() {
+	params := s.keeper.GetParams(s.ctx)
+	pair := s.createPair(s.delAddrs[0], params.LiquidBondDenom, sdk.DefaultBondDenom, true)
+	reversedPair := s.createPair(s.delAddrs[0], sdk.DefaultBondDenom, params.LiquidBondDenom, true)
+	liquidStaker := s.delAddrs[1]
+	amount := sdk.NewInt64Coin(params.LiquidBondDenom, 1_000_000)
+	maxSlippage := utils.ParseDec("0.01")
+
+	// fail, invalid liquid bond denom
+	_, err := s.keeper.LiquidUnstakeInstant(s.ctx, liquidStaker, pair.Id, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000_000), maxSlippage)
+	s.Require().ErrorIs(err, types.ErrInvalidLiquidBondDenom)
+
+	// fail, pair not found
+	_, err = s.keeper.LiquidUnstakeInstant(s.ctx, liquidStaker, 10, amount, maxSlippage)
+	s.Require().ErrorIs(err, sdkerrors.ErrNotFound)
+
+	// fail, bToken is not the base coin of the pair
+	_, err = s.keeper.LiquidUnstakeInstant(s.ctx, liquidStaker, reversedPair.Id, amount, maxSlippage)
+	s.Require().ErrorIs(err, types.ErrInvalidArbPair)
+
+	// fail, no last price
+	_, err = s.keeper.LiquidUnstakeInstant(s.ctx, liquidStaker, pair.Id, amount, maxSlippage)
+	s.Require().ErrorIs(err, types.ErrNoPairLastPrice)
+
+	// fail, insufficient bToken balance
+	s.setPairLastPrice(pair.Id, utils.ParseDec("1.0"))
+	_, err = s.keeper.LiquidUnstakeInstant(s.ctx, liquidStaker, pair.Id, amount, maxSlippage)
+	s.Require().ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+}
@@ -0,0 +1,355 @@
+package keeper
+
+import (
+	"net/url"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v3/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	ibctmtypes "github.com/cosmos/ibc-go/v3/modules/light-clients/07-tendermint/types"
+
+	"github.com/crescent-network/crescent/v4/x/liquidstaking/types"
+)
+
+// ProcessHostZones transfers the deposits of the host zones to their
+// interchain accounts, and delegates the balances of the interchain accounts
+// on the host chains.
+// A host zone has at most one transfer and one delegation in flight at a time.
+func (k Keeper) ProcessHostZones(ctx sdk.Context) {
+	logger := k.Logger(ctx)
+	for _, zone := range k.GetAllHostZones(ctx) {
+		if zone.IcaAddress == "" {
+			continue
+		}
+		if !zone.IsTransferInFlight() {
+			deposits := k.bankKeeper.GetBalance(ctx, zone.GetDepositAddress(), zone.IbcDenom)
+			if deposits.IsPositive() {
+				cachedCtx, writeCache := ctx.CacheContext()
+				if err := k.transferToHostZone(cachedCtx, &zone, deposits); err != nil {
+					logger.Error("transfer to host zone failed", "chain_id", zone.ChainId, "error", err)
+				} else {
+					writeCache()
+				}
+			}
+		}
+		if !zone.IsDelegationInFlight() && zone.IdleAmount.IsPositive() {
+			cachedCtx, writeCache := ctx.CacheContext()
+			if err := k.delegateOnHostZone(cachedCtx, &zone); err != nil {
+				logger.Error("delegation on host zone failed", "chain_id", zone.ChainId, "error", err)
+			} else {
+				writeCache()
+			}
+		}
+	}
+}
+
+// transferToHostZone transfers the deposits of the host zone to its
+// interchain account.
+func (k Keeper) transferToHostZone(ctx sdk.Context, zone *types.HostZone, deposits sdk.Coin) error {
+	sequence, found := k.channelKeeper.GetNextSequenceSend(ctx, transfertypes.PortID, zone.TransferChannelId)
+	if !found {
+		return sdkerrors.Wrapf(channeltypes.ErrSequenceSendNotFound, "port %s, channel %s", transfertypes.PortID, zone.TransferChannelId)
+	}
+	timeoutTimestamp := uint64(ctx.BlockTime().Add(types.InterchainPacketTimeout).UnixNano())
+	if err := k.transferKeeper.SendTransfer(
+		ctx, transfertypes.PortID, zone.TransferChannelId, deposits, zone.GetDepositAddress(), zone.IcaAddress,
+		clienttypes.ZeroHeight(), timeoutTimestamp); err != nil {
+		return err
+	}
+
+	zone.TransferringAmount = deposits.Amount
+	zone.TransferSequence = sequence
+	k.SetHostZone(ctx, *zone)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeTransferToHostZone,
+			sdk.NewAttribute(types.AttributeKeyChainId, zone.ChainId),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, deposits.String()),
+			sdk.NewAttribute(types.AttributeKeyPacketSequence, strconv.FormatUint(sequence, 10)),
+		),
+	})
+	return nil
+}
+
+// delegateOnHostZone sends a transaction to the interchain account of the
+// host zone, which delegates the idle balance of the interchain account to
+// the validator of the host zone.
+func (k Keeper) delegateOnHostZone(ctx sdk.Context, zone *types.HostZone) error {
+	portID := zone.ICAPortID()
+	channelID, found := k.icaControllerKeeper.GetActiveChannelID(ctx, zone.ConnectionId, portID)
+	if !found {
+		return sdkerrors.Wrapf(icatypes.ErrActiveChannelNotFound, "port %s, connection %s", portID, zone.ConnectionId)
+	}
+	chanCap, found := k.scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(portID, channelID))
+	if !found {
+		return sdkerrors.Wrapf(channeltypes.ErrChannelCapabilityNotFound, "port %s, channel %s", portID, channelID)
+	}
+
+	amount := sdk.NewCoin(zone.HostDenom, zone.IdleAmount)
+	data, err := icatypes.SerializeCosmosTx(k.cdc, []sdk.Msg{
+		&stakingtypes.MsgDelegate{
+			DelegatorAddress: zone.IcaAddress,
+			ValidatorAddress: zone.ValidatorAddress,
+			Amount:           amount,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	packetData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+	}
+	timeoutTimestamp := uint64(ctx.BlockTime().Add(types.InterchainPacketTimeout).UnixNano())
+	sequence, err := k.icaControllerKeeper.SendTx(ctx, chanCap, zone.ConnectionId, portID, packetData, timeoutTimestamp)
+	if err != nil {
+		return err
+	}
+
+	zone.DelegatingAmount = zone.IdleAmount
+	zone.IdleAmount = sdk.ZeroInt()
+	zone.DelegateSequence = sequence
+	k.SetHostZone(ctx, *zone)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeDelegateOnHostZone,
+			sdk.NewAttribute(types.AttributeKeyChainId, zone.ChainId),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyPacketSequence, strconv.FormatUint(sequence, 10)),
+		),
+	})
+	return nil
+}
+
+// OnHostZoneICAOpened sets the address of the interchain account of the host
+// zone once the channel of the interchain account is opened.
+func (k Keeper) OnHostZoneICAOpened(ctx sdk.Context, portID string) error {
+	chainID, ok := types.ParseHostZoneICAPortID(portID)
+	if !ok {
+		return sdkerrors.Wrapf(icatypes.ErrInvalidControllerPort, "port %s is not of a host zone", portID)
+	}
+	zone, found := k.GetHostZone(ctx, chainID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrHostZoneNotFound, "chain id %s", chainID)
+	}
+	icaAddr, found := k.icaControllerKeeper.GetInterchainAccountAddress(ctx, zone.ConnectionId, portID)
+	if !found {
+		return sdkerrors.Wrapf(icatypes.ErrInterchainAccountNotFound, "port %s, connection %s", portID, zone.ConnectionId)
+	}
+	zone.IcaAddress = icaAddr
+	k.SetHostZone(ctx, zone)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeOpenHostZoneICA,
+			sdk.NewAttribute(types.AttributeKeyChainId, zone.ChainId),
+			sdk.NewAttribute(types.AttributeKeyICAAddress, zone.IcaAddress),
+		),
+	})
+	return nil
+}
+
+// OnHostZoneTransferAcknowledged updates the host zone whose transfer to the
+// interchain account is acknowledged or timed out.
+// A failed transfer is refunded to the deposit address by the transfer
+// module, and is transferred again later.
+func (k Keeper) OnHostZoneTransferAcknowledged(ctx sdk.Context, packet channeltypes.Packet, success bool) {
+	if packet.GetSourcePort() != transfertypes.PortID {
+		return
+	}
+	for _, zone := range k.GetAllHostZones(ctx) {
+		if zone.TransferChannelId != packet.GetSourceChannel() ||
+			!zone.IsTransferInFlight() || zone.TransferSequence != packet.GetSequence() {
+			continue
+		}
+		if success {
+			zone.IdleAmount = zone.IdleAmount.Add(zone.TransferringAmount)
+		}
+		zone.TransferringAmount = sdk.ZeroInt()
+		zone.TransferSequence = 0
+		k.bumpHostZoneStateHeight(ctx, &zone)
+		k.SetHostZone(ctx, zone)
+		k.emitHostZonePacketAcknowledged(ctx, zone, packet, success)
+		return
+	}
+}
+
+// OnHostZoneDelegationAcknowledged updates the host zone whose delegation by
+// the interchain account is acknowledged or timed out.
+// The amount of a failed delegation is delegated again later.
+func (k Keeper) OnHostZoneDelegationAcknowledged(ctx sdk.Context, packet channeltypes.Packet, success bool) {
+	chainID, ok := types.ParseHostZoneICAPortID(packet.GetSourcePort())
+	if !ok {
+		return
+	}
+	zone, found := k.GetHostZone(ctx, chainID)
+	if !found || !zone.IsDelegationInFlight() || zone.DelegateSequence != packet.GetSequence() {
+		return
+	}
+	if success {
+		zone.DelegatedAmount = zone.DelegatedAmount.Add(zone.DelegatingAmount)
+	} else {
+		zone.IdleAmount = zone.IdleAmount.Add(zone.DelegatingAmount)
+	}
+	zone.DelegatingAmount = sdk.ZeroInt()
+	zone.DelegateSequence = 0
+	k.bumpHostZoneStateHeight(ctx, &zone)
+	k.SetHostZone(ctx, zone)
+	k.emitHostZonePacketAcknowledged(ctx, zone, packet, success)
+}
+
+func (k Keeper) emitHostZonePacketAcknowledged(ctx sdk.Context, zone types.HostZone, packet channeltypes.Packet, success bool) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeHostZonePacketAcknowledged,
+			sdk.NewAttribute(types.AttributeKeyChainId, zone.ChainId),
+			sdk.NewAttribute(types.AttributeKeyPacketSequence, strconv.FormatUint(packet.GetSequence(), 10)),
+			sdk.NewAttribute(types.AttributeKeySuccess, strconv.FormatBool(success)),
+		),
+	})
+}
+
+// bumpHostZoneStateHeight raises the last state height of the host zone to
+// the latest height of the host chain's light client.
+// An acknowledgement or a timeout is proven at a height not above the
+// latest height of the light client, so the host chain state must be proven
+// at a greater height to reflect the result of the packet.
+func (k Keeper) bumpHostZoneStateHeight(ctx sdk.Context, zone *types.HostZone) {
+	clientState, err := k.getHostZoneClientState(ctx, *zone)
+	if err != nil {
+		return
+	}
+	if height := clientState.GetLatestHeight().GetRevisionHeight(); height > zone.LastStateHeight {
+		zone.LastStateHeight = height
+	}
+}
+
+func (k Keeper) getHostZoneClientState(ctx sdk.Context, zone types.HostZone) (*ibctmtypes.ClientState, error) {
+	connection, found := k.connectionKeeper.GetConnection(ctx, zone.ConnectionId)
+	if !found {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "connection %s not found", zone.ConnectionId)
+	}
+	clientState, found := k.clientKeeper.GetClientState(ctx, connection.ClientId)
+	if !found {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "client %s not found", connection.ClientId)
+	}
+	tmClientState, ok := clientState.(*ibctmtypes.ClientState)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "client %s is not a tendermint client", connection.ClientId)
+	}
+	return tmClientState, nil
+}
+
+// UpdateHostZoneState updates the idle amount and the delegated amount of the
+// host zone with the balance and the delegation of the interchain account on
+// the host chain, which are proven against the consensus state of the host
+// chain's light client.
+// This reflects the rewards and the slashes on the host chain in the bToken
+// exchange rate of the host zone.
+func (k Keeper) UpdateHostZoneState(ctx sdk.Context, msg *types.MsgUpdateHostZoneState) (types.HostZone, error) {
+	zone, found := k.GetHostZone(ctx, msg.ChainId)
+	if !found {
+		return types.HostZone{}, sdkerrors.Wrapf(types.ErrHostZoneNotFound, "chain id %s", msg.ChainId)
+	}
+	if zone.IcaAddress == "" {
+		return types.HostZone{}, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "interchain account is not opened yet")
+	}
+	// The state proven while a packet is in flight may or may not reflect
+	// the packet.
+	if zone.IsTransferInFlight() || zone.IsDelegationInFlight() {
+		return types.HostZone{}, types.ErrPacketInFlight
+	}
+	if msg.ProofHeight <= zone.LastStateHeight {
+		return types.HostZone{}, sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest, "proof height must be greater than %d", zone.LastStateHeight)
+	}
+
+	clientState, err := k.getHostZoneClientState(ctx, zone)
+	if err != nil {
+		return types.HostZone{}, err
+	}
+	connection, _ := k.connectionKeeper.GetConnection(ctx, zone.ConnectionId)
+	height := clienttypes.NewHeight(clientState.GetLatestHeight().GetRevisionNumber(), msg.ProofHeight)
+	consState, found := k.clientKeeper.GetClientConsensusState(ctx, connection.ClientId, height)
+	if !found {
+		return types.HostZone{}, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "consensus state at height %s not found", height)
+	}
+	verify := func(storeKey string, key, value, proofBz []byte) error {
+		var proof commitmenttypes.MerkleProof
+		if err := k.cdc.Unmarshal(proofBz, &proof); err != nil {
+			return sdkerrors.Wrapf(types.ErrInvalidHostZoneProof, "invalid proof: %v", err)
+		}
+		path := commitmenttypes.NewMerklePath(storeKey, url.PathEscape(string(key)))
+		if len(value) == 0 {
+			err = proof.VerifyNonMembership(clientState.ProofSpecs, consState.GetRoot(), path)
+		} else {
+			err = proof.VerifyMembership(clientState.ProofSpecs, consState.GetRoot(), path, value)
+		}
+		if err != nil {
+			return sdkerrors.Wrap(types.ErrInvalidHostZoneProof, err.Error())
+		}
+		return nil
+	}
+
+	_, icaAddr, err := bech32.DecodeAndConvert(zone.IcaAddress)
+	if err != nil {
+		return types.HostZone{}, err
+	}
+	_, valAddr, err := bech32.DecodeAndConvert(zone.ValidatorAddress)
+	if err != nil {
+		return types.HostZone{}, err
+	}
+
+	balanceKey := banktypes.CreatePrefixedAccountStoreKey(icaAddr, []byte(zone.HostDenom))
+	if err := verify(banktypes.StoreKey, balanceKey, msg.Balance, msg.BalanceProof); err != nil {
+		return types.HostZone{}, err
+	}
+	balance := sdk.ZeroInt()
+	if len(msg.Balance) > 0 {
+		var coin sdk.Coin
+		if err := k.cdc.Unmarshal(msg.Balance, &coin); err != nil {
+			return types.HostZone{}, sdkerrors.Wrapf(types.ErrInvalidHostZoneProof, "invalid balance: %v", err)
+		}
+		balance = coin.Amount
+	}
+
+	delegationKey := stakingtypes.GetDelegationKey(icaAddr, valAddr)
+	if err := verify(stakingtypes.StoreKey, delegationKey, msg.Delegation, msg.DelegationProof); err != nil {
+		return types.HostZone{}, err
+	}
+	delegatedAmount := sdk.ZeroInt()
+	if len(msg.Delegation) > 0 {
+		if err := verify(stakingtypes.StoreKey, stakingtypes.GetValidatorKey(valAddr), msg.Validator, msg.ValidatorProof); err != nil {
+			return types.HostZone{}, err
+		}
+		var delegation stakingtypes.Delegation
+		if err := k.cdc.Unmarshal(msg.Delegation, &delegation); err != nil {
+			return types.HostZone{}, sdkerrors.Wrapf(types.ErrInvalidHostZoneProof, "invalid delegation: %v", err)
+		}
+		var validator stakingtypes.Validator
+		if err := k.cdc.Unmarshal(msg.Validator, &validator); err != nil {
+			return types.HostZone{}, sdkerrors.Wrapf(types.ErrInvalidHostZoneProof, "invalid validator: %v", err)
+		}
+		if validator.DelegatorShares.IsPositive() {
+			delegatedAmount = validator.TokensFromShares(delegation.Shares).TruncateInt()
+		}
+	}
+
+	zone.IdleAmount = balance
+	zone.DelegatedAmount = delegatedAmount
+	zone.LastStateHeight = msg.ProofHeight
+	k.SetHostZone(ctx, zone)
+
+	return zone, nil
+}
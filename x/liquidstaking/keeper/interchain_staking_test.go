@@ -0,0 +1,189 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v3/modules/core/23-commitment/types"
+	ibctmtypes "github.com/cosmos/ibc-go/v3/modules/light-clients/07-tendermint/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/crescent-network/crescent/v4/x/liquidstaking/types"
+)
+
+const (
+	hostChainID    = "cosmoshub-4"
+	hostClientID   = "07-tendermint-0"
+	hostConnection = "connection-0"
+)
+
+// registerHostZone stores a host zone delegating to the validator, without
+// registering its interchain account.
+func (s *KeeperTestSuite) registerHostZone(valAddr string) types.HostZone {
+	zone := types.NewHostZone(types.NewRegisterHostZoneProposal(
+		"title", "description", hostChainID, hostConnection, "channel-0", sdk.DefaultBondDenom, "batom", valAddr))
+	s.keeper.SetHostZone(s.ctx, zone)
+	return zone
+}
+
+// commit commits the state and begins the next block.
+func (s *KeeperTestSuite) commit() {
+	s.app.Commit()
+	hdr := tmproto.Header{Height: s.app.LastBlockHeight() + 1, Time: s.ctx.BlockTime().Add(BlockTime)}
+	s.app.BeginBlock(abci.RequestBeginBlock{Header: hdr})
+	s.ctx = s.app.BaseApp.NewContext(false, hdr)
+}
+
+func (s *KeeperTestSuite) TestInterchainLiquidStake() {
+	_, valOpers, _ := s.CreateValidators([]int64{1000000})
+	zone := s.registerHostZone(valOpers[0].String())
+	staker := s.addrs[0]
+	s.fundAddr(staker, sdk.NewCoins(sdk.NewInt64Coin(zone.IbcDenom, 10000)))
+
+	// Interchain liquid staking is disabled by default.
+	_, err := s.keeper.InterchainLiquidStake(s.ctx, staker, hostChainID, sdk.NewInt64Coin(zone.IbcDenom, 1000))
+	s.Require().ErrorIs(err, types.ErrInterchainLiquidStakingDisabled)
+	params := s.keeper.GetParams(s.ctx)
+	params.InterchainLiquidStakingEnabled = true
+	s.keeper.SetParams(s.ctx, params)
+
+	_, err = s.keeper.InterchainLiquidStake(s.ctx, staker, "unknown-1", sdk.NewInt64Coin(zone.IbcDenom, 1000))
+	s.Require().ErrorIs(err, types.ErrHostZoneNotFound)
+	_, err = s.keeper.InterchainLiquidStake(s.ctx, staker, hostChainID, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	s.Require().ErrorIs(err, types.ErrInvalidDenom)
+
+	// The first bToken is minted 1:1.
+	minted, err := s.keeper.InterchainLiquidStake(s.ctx, staker, hostChainID, sdk.NewInt64Coin(zone.IbcDenom, 1000))
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt64Coin("batom", 1000), minted)
+	s.Require().Equal(sdk.NewInt(1000), s.app.BankKeeper.GetBalance(s.ctx, zone.GetDepositAddress(), zone.IbcDenom).Amount)
+
+	// The rewards on the host chain raise the net amount.
+	zone, _ = s.keeper.GetHostZone(s.ctx, hostChainID)
+	zone.DelegatedAmount = sdk.NewInt(1000)
+	s.keeper.SetHostZone(s.ctx, zone)
+	s.Require().Equal(sdk.NewInt(2000), s.keeper.GetHostZoneNetAmount(s.ctx, zone))
+
+	minted, err = s.keeper.InterchainLiquidStake(s.ctx, staker, hostChainID, sdk.NewInt64Coin(zone.IbcDenom, 1000))
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt64Coin("batom", 500), minted)
+	s.Require().Equal(sdk.NewInt(1500), s.app.BankKeeper.GetBalance(s.ctx, staker, "batom").Amount)
+}
+
+func (s *KeeperTestSuite) TestOnHostZonePacketAcknowledged() {
+	_, valOpers, _ := s.CreateValidators([]int64{1000000})
+	zone := s.registerHostZone(valOpers[0].String())
+	zone.TransferringAmount = sdk.NewInt(1000)
+	zone.TransferSequence = 3
+	zone.DelegatingAmount = sdk.NewInt(500)
+	zone.DelegateSequence = 5
+	s.keeper.SetHostZone(s.ctx, zone)
+
+	transferPacket := func(seq uint64) channeltypes.Packet {
+		return channeltypes.NewPacket(nil, seq, transfertypes.PortID, zone.TransferChannelId, "", "", clienttypes.ZeroHeight(), 0)
+	}
+	icaPacket := func(seq uint64) channeltypes.Packet {
+		return channeltypes.NewPacket(nil, seq, zone.ICAPortID(), "channel-1", "", "", clienttypes.ZeroHeight(), 0)
+	}
+
+	// Packets of other sequences are ignored.
+	s.keeper.OnHostZoneTransferAcknowledged(s.ctx, transferPacket(2), true)
+	s.keeper.OnHostZoneDelegationAcknowledged(s.ctx, icaPacket(4), true)
+	zone, _ = s.keeper.GetHostZone(s.ctx, hostChainID)
+	s.Require().True(zone.IsTransferInFlight())
+	s.Require().True(zone.IsDelegationInFlight())
+
+	s.keeper.OnHostZoneTransferAcknowledged(s.ctx, transferPacket(3), true)
+	zone, _ = s.keeper.GetHostZone(s.ctx, hostChainID)
+	s.Require().False(zone.IsTransferInFlight())
+	s.Require().Equal(sdk.NewInt(1000), zone.IdleAmount)
+
+	// A failed delegation is moved back to the idle amount.
+	s.keeper.OnHostZoneDelegationAcknowledged(s.ctx, icaPacket(5), false)
+	zone, _ = s.keeper.GetHostZone(s.ctx, hostChainID)
+	s.Require().False(zone.IsDelegationInFlight())
+	s.Require().Equal(sdk.NewInt(1500), zone.IdleAmount)
+	s.Require().True(zone.DelegatedAmount.IsZero())
+	s.Require().Equal(sdk.NewInt(1500), zone.StakedAmount())
+}
+
+func (s *KeeperTestSuite) TestUpdateHostZoneState() {
+	// This chain plays the host chain as well, and the interchain account is
+	// mocked by an account delegating to the validator.
+	_, valOpers, _ := s.CreateValidators([]int64{1000000})
+	// Proofs are not available at the first height.
+	s.commit()
+	icaAddr := s.addrs[0]
+	val, _ := s.app.StakingKeeper.GetValidator(s.ctx, valOpers[0])
+	_, err := s.app.StakingKeeper.Delegate(s.ctx, icaAddr, sdk.NewInt(3000), stakingtypes.Unbonded, val, true)
+	s.Require().NoError(err)
+	s.commit()
+	proofHeight := s.app.LastBlockHeight()
+	appHash := s.app.LastCommitID().Hash
+
+	query := func(storeKey string, key []byte) (value, proof []byte) {
+		res := s.app.Query(abci.RequestQuery{
+			Path:   "/store/" + storeKey + "/key",
+			Data:   key,
+			Height: proofHeight,
+			Prove:  true,
+		})
+		s.Require().True(res.IsOK(), res.Log)
+		merkleProof, err := commitmenttypes.ConvertProofs(res.ProofOps)
+		s.Require().NoError(err)
+		proof, err = s.app.AppCodec().Marshal(&merkleProof)
+		s.Require().NoError(err)
+		return res.Value, proof
+	}
+	balance, balanceProof := query(banktypes.StoreKey, banktypes.CreatePrefixedAccountStoreKey(icaAddr, []byte(sdk.DefaultBondDenom)))
+	delegation, delegationProof := query(stakingtypes.StoreKey, stakingtypes.GetDelegationKey(icaAddr, valOpers[0]))
+	validator, validatorProof := query(stakingtypes.StoreKey, stakingtypes.GetValidatorKey(valOpers[0]))
+	s.Require().NotEmpty(delegation)
+
+	// Set up the light client of the host chain with the consensus state at
+	// the proof height.
+	height := clienttypes.NewHeight(0, uint64(proofHeight))
+	clientState := ibctmtypes.NewClientState(
+		hostChainID, ibctmtypes.DefaultTrustLevel, time.Hour, 2*time.Hour, time.Minute, height,
+		commitmenttypes.GetSDKSpecs(), []string{"upgrade", "upgradedIBCState"}, false, false)
+	s.app.IBCKeeper.ClientKeeper.SetClientState(s.ctx, hostClientID, clientState)
+	s.app.IBCKeeper.ClientKeeper.SetClientConsensusState(s.ctx, hostClientID, height,
+		ibctmtypes.NewConsensusState(s.ctx.BlockTime(), commitmenttypes.NewMerkleRoot(appHash), nil))
+	s.app.IBCKeeper.ConnectionKeeper.SetConnection(s.ctx, hostConnection, connectiontypes.NewConnectionEnd(
+		connectiontypes.OPEN, hostClientID,
+		connectiontypes.NewCounterparty("07-tendermint-0", "connection-0", commitmenttypes.NewMerklePrefix([]byte("ibc"))),
+		connectiontypes.ExportedVersionsToProto(connectiontypes.GetCompatibleVersions()), 0))
+
+	zone := s.registerHostZone(valOpers[0].String())
+	msg := types.NewMsgUpdateHostZoneState(
+		s.addrs[1], hostChainID, uint64(proofHeight), delegation, delegationProof, validator, validatorProof, balance, balanceProof)
+
+	_, err = s.keeper.UpdateHostZoneState(s.ctx, msg)
+	s.Require().ErrorContains(err, "interchain account is not opened yet")
+
+	zone.IcaAddress = icaAddr.String()
+	s.keeper.SetHostZone(s.ctx, zone)
+
+	// A tampered balance is rejected.
+	tampered := types.NewMsgUpdateHostZoneState(
+		s.addrs[1], hostChainID, uint64(proofHeight), delegation, delegationProof, validator, validatorProof, delegation, balanceProof)
+	_, err = s.keeper.UpdateHostZoneState(s.ctx, tampered)
+	s.Require().ErrorIs(err, types.ErrInvalidHostZoneProof)
+
+	zone, err = s.keeper.UpdateHostZoneState(s.ctx, msg)
+	s.Require().NoError(err)
+	s.Require().Equal(s.app.BankKeeper.GetBalance(s.ctx, icaAddr, sdk.DefaultBondDenom).Amount, zone.IdleAmount)
+	s.Require().Equal(sdk.NewInt(3000), zone.DelegatedAmount)
+	s.Require().Equal(uint64(proofHeight), zone.LastStateHeight)
+
+	// The state cannot be updated by a proof at the same height again.
+	_, err = s.keeper.UpdateHostZoneState(s.ctx, msg)
+	s.Require().ErrorContains(err, "proof height must be greater than")
+}
@@ -17,6 +17,8 @@ func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 		TotalLiquidTokensInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "liquid-delegation",
 		LiquidDelegationInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "btoken-backing",
+		BTokenBackingInvariant(k))
 }
 
 // AllInvariants runs all invariants of the liquidstaking module.
@@ -26,6 +28,7 @@ func AllInvariants(k Keeper) sdk.Invariant {
 			NetAmountInvariant,
 			TotalLiquidTokensInvariant,
 			LiquidDelegationInvariant,
+			BTokenBackingInvariant,
 		} {
 			res, stop := inv(k)(ctx)
 			if stop {
@@ -113,3 +116,26 @@ func LiquidDelegationInvariant(k Keeper) sdk.Invariant {
 		), broken
 	}
 }
+
+// BTokenBackingInvariant checks that the btoken supply is not greater than
+// the NetAmount times the mint rate bound, which is raised only by slashing.
+// The number of liquid validators is added to the NetAmount to tolerate the
+// truncation of the liquid tokens of each liquid validator.
+func BTokenBackingInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		bound, found := k.GetMintRateBound(ctx)
+		if !found {
+			return "", false
+		}
+		nas := k.GetNetAmountState(ctx)
+		tolerance := sdk.NewDec(int64(k.GetAllLiquidValidators(ctx).Len()))
+		backing := nas.NetAmount.Add(tolerance).Mul(bound)
+
+		broken := nas.BtokenTotalSupply.ToDec().GT(backing)
+		return sdk.FormatInvariant(
+			types.ModuleName, "btoken backing invariant broken",
+			fmt.Sprintf("found btoken supply %s greater than net amount %s with mint rate bound %s\n",
+				nas.BtokenTotalSupply, nas.NetAmount, bound),
+		), broken
+	}
+}
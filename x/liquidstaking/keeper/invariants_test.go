@@ -1 +1,55 @@
 package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/crescent-network/crescent/v4/x/liquidstaking/keeper"
+	"github.com/crescent-network/crescent/v4/x/liquidstaking/types"
+)
+
+func (s *KeeperTestSuite) TestBTokenBackingInvariant() {
+	_, valOpers, pks := s.CreateValidators([]int64{1000000, 2000000, 3000000})
+	params := s.keeper.GetParams(s.ctx)
+	params.WhitelistedValidators = []types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[0].String(), TargetWeight: sdk.NewInt(1)},
+		{ValidatorAddress: valOpers[1].String(), TargetWeight: sdk.NewInt(1)},
+		{ValidatorAddress: valOpers[2].String(), TargetWeight: sdk.NewInt(1)},
+	}
+	s.keeper.SetParams(s.ctx, params)
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+
+	s.Require().NoError(s.liquidStaking(s.delAddrs[0], params.MinLiquidStakingAmount))
+	s.keeper.UpdateMintRateBound(s.ctx)
+	bound, found := s.keeper.GetMintRateBound(s.ctx)
+	s.Require().True(found)
+	s.Require().Equal(sdk.OneDec(), bound)
+
+	_, broken := keeper.BTokenBackingInvariant(s.keeper)(s.ctx)
+	s.Require().False(broken)
+
+	// btokens minted without backing break the invariant
+	cacheCtx, _ := s.ctx.CacheContext()
+	s.Require().NoError(s.app.BankKeeper.MintCoins(
+		cacheCtx, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(params.LiquidBondDenom, 1000))))
+	_, broken = keeper.BTokenBackingInvariant(s.keeper)(cacheCtx)
+	s.Require().True(broken)
+
+	// slashing resets the bound
+	s.doubleSign(valOpers[1], sdk.ConsAddress(pks[1].Address()))
+	_, found = s.keeper.GetMintRateBound(s.ctx)
+	s.Require().False(found)
+	_, broken = keeper.BTokenBackingInvariant(s.keeper)(s.ctx)
+	s.Require().False(broken)
+
+	// the bound is raised to the mint rate after the slashing
+	s.keeper.UpdateMintRateBound(s.ctx)
+	bound, found = s.keeper.GetMintRateBound(s.ctx)
+	s.Require().True(found)
+	s.Require().True(bound.GT(sdk.OneDec()))
+	_, broken = keeper.BTokenBackingInvariant(s.keeper)(s.ctx)
+	s.Require().False(broken)
+
+	s.Require().NoError(s.liquidStaking(s.delAddrs[1], params.MinLiquidStakingAmount))
+	_, broken = keeper.BTokenBackingInvariant(s.keeper)(s.ctx)
+	s.Require().False(broken)
+}
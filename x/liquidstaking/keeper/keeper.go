@@ -5,6 +5,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/tendermint/tendermint/libs/log"
 
@@ -24,6 +25,21 @@ type Keeper struct {
 	liquidityKeeper types.LiquidityKeeper
 	lpfarmKeeper    types.LPFarmKeeper
 	slashingKeeper  types.SlashingKeeper
+	mintKeeper      types.MintKeeper
+	epochsKeeper    types.EpochsKeeper
+
+	clientKeeper        types.ClientKeeper
+	connectionKeeper    types.ConnectionKeeper
+	channelKeeper       types.ChannelKeeper
+	transferKeeper      types.TransferKeeper
+	icaControllerKeeper types.ICAControllerKeeper
+	scopedKeeper        types.ScopedKeeper
+
+	// authority is the address allowed to execute the authority-gated
+	// messages, which is the gov module account by default.
+	authority string
+
+	hooks types.LiquidStakingHooks
 }
 
 // NewKeeper returns a liquidstaking keeper. It handles:
@@ -33,7 +49,11 @@ type Keeper struct {
 func NewKeeper(cdc codec.BinaryCodec, key sdk.StoreKey, paramSpace paramtypes.Subspace,
 	accountKeeper types.AccountKeeper, bankKeeper types.BankKeeper, stakingKeeper types.StakingKeeper,
 	distrKeeper types.DistrKeeper, liquidityKeeper types.LiquidityKeeper,
-	lpfarmKeeper types.LPFarmKeeper, slashingKeeper types.SlashingKeeper,
+	lpfarmKeeper types.LPFarmKeeper, slashingKeeper types.SlashingKeeper, mintKeeper types.MintKeeper,
+	epochsKeeper types.EpochsKeeper,
+	clientKeeper types.ClientKeeper, connectionKeeper types.ConnectionKeeper, channelKeeper types.ChannelKeeper,
+	transferKeeper types.TransferKeeper, icaControllerKeeper types.ICAControllerKeeper, scopedKeeper types.ScopedKeeper,
+	authority string,
 ) Keeper {
 	// ensure liquidstaking module account is set
 	if addr := accountKeeper.GetModuleAddress(types.ModuleName); addr == nil {
@@ -56,9 +76,25 @@ func NewKeeper(cdc codec.BinaryCodec, key sdk.StoreKey, paramSpace paramtypes.Su
 		liquidityKeeper: liquidityKeeper,
 		lpfarmKeeper:    lpfarmKeeper,
 		slashingKeeper:  slashingKeeper,
+		mintKeeper:      mintKeeper,
+		epochsKeeper:    epochsKeeper,
+
+		clientKeeper:        clientKeeper,
+		connectionKeeper:    connectionKeeper,
+		channelKeeper:       channelKeeper,
+		transferKeeper:      transferKeeper,
+		icaControllerKeeper: icaControllerKeeper,
+		scopedKeeper:        scopedKeeper,
+		authority:           authority,
 	}
 }
 
+// GetAuthority returns the address allowed to execute the authority-gated
+// messages of the module.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+types.ModuleName)
@@ -77,3 +113,9 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 
 // GetCodec return codec.Codec object used by the keeper
 func (k Keeper) GetCodec() codec.BinaryCodec { return k.cdc }
+
+// ClaimCapability claims the channel capability of the interchain account of
+// a host zone, which is required to send packets through the channel.
+func (k Keeper) ClaimCapability(ctx sdk.Context, cap *capabilitytypes.Capability, name string) error {
+	return k.scopedKeeper.ClaimCapability(ctx, cap, name)
+}
@@ -30,13 +30,18 @@ func (k Keeper) GetNetAmountState(ctx sdk.Context) (nas types.NetAmountState) {
 		}
 	}
 
+	// the protocol commission on the rewards, including the withdrawn ones in the proxy account, is excluded in advance
+	proxyAccBalance := k.GetProxyAccBalance(ctx, types.LiquidStakingProxyAcc).Amount
+	pendingProtocolCommission := k.GetParams(ctx).ProtocolCommissionRate.Mul(proxyAccBalance.ToDec().Add(totalRemainingRewards))
+
 	nas = types.NetAmountState{
-		BtokenTotalSupply:     k.bankKeeper.GetSupply(ctx, k.LiquidBondDenom(ctx)).Amount,
-		TotalDelShares:        totalDelShares,
-		TotalLiquidTokens:     totalLiquidTokens,
-		TotalRemainingRewards: totalRemainingRewards,
-		TotalUnbondingBalance: totalUnbondingBalance,
-		ProxyAccBalance:       k.GetProxyAccBalance(ctx, types.LiquidStakingProxyAcc).Amount,
+		BtokenTotalSupply:         k.bankKeeper.GetSupply(ctx, k.LiquidBondDenom(ctx)).Amount,
+		TotalDelShares:            totalDelShares,
+		TotalLiquidTokens:         totalLiquidTokens,
+		TotalRemainingRewards:     totalRemainingRewards,
+		TotalUnbondingBalance:     totalUnbondingBalance,
+		ProxyAccBalance:           proxyAccBalance,
+		PendingProtocolCommission: pendingProtocolCommission,
 	}
 
 	nas.NetAmount = nas.CalcNetAmount()
@@ -71,16 +76,14 @@ func (k Keeper) LiquidStake(
 	// NetAmount must be calculated before send
 	nas := k.GetNetAmountState(ctx)
 
-	// send staking coin to liquid staking proxy account to proxy delegation, need sufficient spendable balances
-	err = k.bankKeeper.SendCoins(ctx, liquidStaker, proxyAcc, sdk.NewCoins(stakingCoin))
-	if err != nil {
-		return sdk.ZeroDec(), sdk.ZeroInt(), err
-	}
-
-	// mint btoken, MintAmount = TotalSupply * StakeAmount/NetAmount
+	// MintAmount = TotalSupply * StakeAmount/NetAmount, which is checked before any transfer so that
+	// a staking amount truncated to zero bToken is rejected instead of being donated to the bToken holders
 	liquidBondDenom := k.LiquidBondDenom(ctx)
 	bTokenMintAmount = stakingCoin.Amount
 	if nas.BtokenTotalSupply.IsPositive() {
+		if !nas.NetAmount.TruncateDec().IsPositive() {
+			return sdk.ZeroDec(), sdk.ZeroInt(), types.ErrInvalidBTokenSupply
+		}
 		bTokenMintAmount = types.NativeTokenToBToken(stakingCoin.Amount, nas.BtokenTotalSupply, nas.NetAmount)
 	}
 
@@ -88,7 +91,13 @@ func (k Keeper) LiquidStake(
 		return sdk.ZeroDec(), sdk.ZeroInt(), types.ErrTooSmallLiquidStakingAmount
 	}
 
-	// mint on module acc and send
+	// send staking coin to liquid staking proxy account to proxy delegation, need sufficient spendable balances
+	err = k.bankKeeper.SendCoins(ctx, liquidStaker, proxyAcc, sdk.NewCoins(stakingCoin))
+	if err != nil {
+		return sdk.ZeroDec(), sdk.ZeroInt(), err
+	}
+
+	// mint btoken on module acc and send
 	mintCoin := sdk.NewCoins(sdk.NewCoin(liquidBondDenom, bTokenMintAmount))
 	err = k.bankKeeper.MintCoins(ctx, types.ModuleName, mintCoin)
 	if err != nil {
@@ -100,7 +109,11 @@ func (k Keeper) LiquidStake(
 	}
 
 	newShares, err = k.LiquidDelegate(ctx, proxyAcc, activeVals, stakingCoin.Amount, whitelistedValsMap)
-	return newShares, bTokenMintAmount, err
+	if err != nil {
+		return newShares, bTokenMintAmount, err
+	}
+	k.afterLiquidStake(ctx, liquidStaker, stakingCoin, sdk.NewCoin(liquidBondDenom, bTokenMintAmount))
+	return newShares, bTokenMintAmount, nil
 }
 
 // LiquidDelegate delegates staking amount to active validators by proxy account.
@@ -177,6 +190,7 @@ func (k Keeper) LiquidUnstake(
 			if err != nil {
 				return time.Time{}, sdk.ZeroInt(), []stakingtypes.UnbondingDelegation{}, sdk.ZeroInt(), err
 			} else {
+				k.afterLiquidUnstake(ctx, liquidStaker, unstakingBtoken, unbondingAmountInt)
 				return time.Time{}, sdk.ZeroInt(), []stakingtypes.UnbondingDelegation{}, unbondingAmountInt, nil
 			}
 		} else {
@@ -218,9 +232,13 @@ func (k Keeper) LiquidUnstake(
 		if err != nil {
 			return time.Time{}, sdk.ZeroInt(), []stakingtypes.UnbondingDelegation{}, sdk.ZeroInt(), err
 		}
+		// track the unbonding entry queued to the liquid staker
+		k.SetUnstakingRecord(ctx, types.NewUnstakingRecord(
+			k.GetNextUnstakingRecordIdWithUpdate(ctx), liquidStaker, val.GetOperator(), ctx.BlockHeight(), ubdTime, returnAmount))
 		ubds = append(ubds, ubd)
 		totalReturnAmount = totalReturnAmount.Add(returnAmount)
 	}
+	k.afterLiquidUnstake(ctx, liquidStaker, unstakingBtoken, totalReturnAmount)
 	return ubdTime, totalReturnAmount, ubds, sdk.ZeroInt(), nil
 }
 
@@ -372,7 +390,7 @@ func (k Keeper) GetAllLiquidValidatorStates(ctx sdk.Context) (liquidValidatorSta
 		lvState := types.LiquidValidatorState{
 			OperatorAddress: lv.OperatorAddress,
 			Weight:          lv.GetWeight(whitelistedValsMap, active),
-			Status:          lv.GetStatus(active),
+			Status:          k.GetLiquidValidatorStatus(ctx, lv, whitelistedValsMap),
 			DelShares:       lv.GetDelShares(ctx, k.stakingKeeper),
 			LiquidTokens:    lv.GetLiquidTokens(ctx, k.stakingKeeper, false),
 		}
@@ -397,7 +415,7 @@ func (k Keeper) GetLiquidValidatorState(ctx sdk.Context, addr sdk.ValAddress) (l
 	return types.LiquidValidatorState{
 		OperatorAddress: lv.OperatorAddress,
 		Weight:          lv.GetWeight(whitelistedValsMap, active),
-		Status:          lv.GetStatus(active),
+		Status:          k.GetLiquidValidatorStatus(ctx, lv, whitelistedValsMap),
 		DelShares:       lv.GetDelShares(ctx, k.stakingKeeper),
 		LiquidTokens:    lv.GetLiquidTokens(ctx, k.stakingKeeper, false),
 	}, true
@@ -411,6 +429,25 @@ func (k Keeper) IsActiveLiquidValidator(ctx sdk.Context, lv types.LiquidValidato
 	return types.ActiveCondition(val, whitelistedValsMap.IsListed(lv.OperatorAddress), k.IsTombstoned(ctx, val))
 }
 
+// GetLiquidValidatorStatus returns the status of the liquid validator.
+// A whitelisted liquid validator which is jailed or tombstoned is distinguished as ValidatorStatusJailed from other
+// inactive liquid validators, though both have zero weight.
+func (k Keeper) GetLiquidValidatorStatus(ctx sdk.Context, lv types.LiquidValidator, whitelistedValsMap types.WhitelistedValsMap) types.ValidatorStatus {
+	val, found := k.stakingKeeper.GetValidator(ctx, lv.GetOperator())
+	if !found {
+		return types.ValidatorStatusInactive
+	}
+	whitelisted := whitelistedValsMap.IsListed(lv.OperatorAddress)
+	tombstoned := k.IsTombstoned(ctx, val)
+	if types.ActiveCondition(val, whitelisted, tombstoned) {
+		return types.ValidatorStatusActive
+	}
+	if whitelisted && (val.IsJailed() || tombstoned) {
+		return types.ValidatorStatusJailed
+	}
+	return types.ValidatorStatusInactive
+}
+
 func (k Keeper) IsTombstoned(ctx sdk.Context, val stakingtypes.Validator) bool {
 	consPk, err := val.ConsPubKey()
 	if err != nil {
@@ -8,6 +8,7 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	utils "github.com/crescent-network/crescent/v4/types"
+	"github.com/crescent-network/crescent/v4/x/liquidstaking/keeper"
 	"github.com/crescent-network/crescent/v4/x/liquidstaking/types"
 	minttypes "github.com/crescent-network/crescent/v4/x/mint/types"
 )
@@ -265,6 +266,40 @@ func (s *KeeperTestSuite) TestLiquidStakeEdgeCases() {
 	states.TotalLiquidTokens.Equal(hugeAmt)
 }
 
+func (s *KeeperTestSuite) TestLiquidStakeTooSmallAmount() {
+	_, valOpers, _ := s.CreateValidators([]int64{1000000, 2000000, 3000000})
+	params := s.keeper.GetParams(s.ctx)
+	params.MinLiquidStakingAmount = sdk.OneInt()
+	params.WhitelistedValidators = []types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[0].String(), TargetWeight: sdk.NewInt(10)},
+		{ValidatorAddress: valOpers[1].String(), TargetWeight: sdk.NewInt(10)},
+		{ValidatorAddress: valOpers[2].String(), TargetWeight: sdk.NewInt(10)},
+	}
+	s.keeper.SetParams(s.ctx, params)
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+
+	// fail when liquid staking less than MinLiquidStakingAmount
+	_, _, err := s.keeper.LiquidStake(s.ctx, types.LiquidStakingProxyAcc, s.delAddrs[0], sdk.NewCoin(sdk.DefaultBondDenom, sdk.ZeroInt()))
+	s.Require().ErrorIs(err, types.ErrLessThanMinLiquidStakingAmount)
+
+	s.Require().NoError(s.liquidStaking(s.delAddrs[0], sdk.NewInt(1000000)))
+
+	// the mint rate becomes 0.5 as the proxy account balance is added to the net amount
+	s.fundAddr(types.LiquidStakingProxyAcc, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000000)))
+	s.Require().True(s.keeper.GetNetAmountState(s.ctx).MintRate.Equal(sdk.MustNewDecFromStr("0.5")))
+
+	// fail without transferring the staking coin when the bToken mint amount is truncated to zero
+	balanceBefore := s.app.BankKeeper.GetBalance(s.ctx, s.delAddrs[1], sdk.DefaultBondDenom)
+	_, _, err = s.keeper.LiquidStake(s.ctx, types.LiquidStakingProxyAcc, s.delAddrs[1], sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt()))
+	s.Require().ErrorIs(err, types.ErrTooSmallLiquidStakingAmount)
+	s.Require().Equal(balanceBefore, s.app.BankKeeper.GetBalance(s.ctx, s.delAddrs[1], sdk.DefaultBondDenom))
+
+	// success when the bToken mint amount is positive
+	_, bTokenMintAmt, err := s.keeper.LiquidStake(s.ctx, types.LiquidStakingProxyAcc, s.delAddrs[1], sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(2)))
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.OneInt(), bTokenMintAmt)
+}
+
 func (s *KeeperTestSuite) TestLiquidUnstakeEdgeCases() {
 	mintParams := s.app.MintKeeper.GetParams(s.ctx)
 	mintParams.InflationSchedules = []minttypes.InflationSchedule{}
@@ -329,3 +364,36 @@ func (s *KeeperTestSuite) TestLiquidUnstakeEdgeCases() {
 	s.Require().EqualValues(ubdTime, time.Time{})
 	s.Require().Len(ubds, 0)
 }
+
+func (s *KeeperTestSuite) TestLiquidStakeAndFarm() {
+	_, valOpers, _ := s.CreateValidators([]int64{1000000, 2000000, 3000000})
+	params := s.keeper.GetParams(s.ctx)
+	params.WhitelistedValidators = []types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[0].String(), TargetWeight: sdk.NewInt(1)},
+		{ValidatorAddress: valOpers[1].String(), TargetWeight: sdk.NewInt(1)},
+	}
+	s.keeper.SetParams(s.ctx, params)
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+
+	msgServer := keeper.NewMsgServerImpl(s.keeper)
+	liquidBondDenom := s.keeper.LiquidBondDenom(s.ctx)
+
+	msg := types.NewMsgLiquidStake(s.delAddrs[0], sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000000))
+	msg.Farm = true
+	_, err := msgServer.LiquidStake(sdk.WrapSDKContext(s.ctx), msg)
+	s.Require().NoError(err)
+
+	// The minted bToken is farmed right away.
+	s.Require().True(s.app.BankKeeper.GetBalance(s.ctx, s.delAddrs[0], liquidBondDenom).IsZero())
+	position, found := s.app.LPFarmKeeper.GetPosition(s.ctx, s.delAddrs[0], liquidBondDenom)
+	s.Require().True(found)
+	s.Require().Equal(sdk.NewInt(1000000), position.FarmingAmount)
+
+	// Without the farm option, the bToken is sent to the liquid staker.
+	msg = types.NewMsgLiquidStake(s.delAddrs[1], sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000000))
+	_, err = msgServer.LiquidStake(sdk.WrapSDKContext(s.ctx), msg)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt(1000000), s.app.BankKeeper.GetBalance(s.ctx, s.delAddrs[1], liquidBondDenom).Amount)
+	_, found = s.app.LPFarmKeeper.GetPosition(s.ctx, s.delAddrs[1], liquidBondDenom)
+	s.Require().False(found)
+}
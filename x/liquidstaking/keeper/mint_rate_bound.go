@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/crescent-network/crescent/v4/x/liquidstaking/types"
+)
+
+// GetMintRateBound returns the upper bound of the mint rate.
+func (k Keeper) GetMintRateBound(ctx sdk.Context) (bound sdk.Dec, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.MintRateBoundKey)
+	if bz == nil {
+		return
+	}
+	var val sdk.DecProto
+	k.cdc.MustUnmarshal(bz, &val)
+	return val.Dec, true
+}
+
+// SetMintRateBound stores the upper bound of the mint rate.
+func (k Keeper) SetMintRateBound(ctx sdk.Context, bound sdk.Dec) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&sdk.DecProto{Dec: bound})
+	store.Set(types.MintRateBoundKey, bz)
+}
+
+// DeleteMintRateBound deletes the upper bound of the mint rate.
+func (k Keeper) DeleteMintRateBound(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.MintRateBoundKey)
+}
+
+// UpdateMintRateBound sets the upper bound of the mint rate to the current
+// mint rate, but not lower than 1, if the bound has been reset.
+// Liquid staking, liquid unstaking and compounded rewards never raise the
+// mint rate above 1 or above its current value, only slashing does.
+// So the bound is reset whenever a validator is slashed and set again here
+// with the mint rate after the slashing.
+func (k Keeper) UpdateMintRateBound(ctx sdk.Context) {
+	if _, found := k.GetMintRateBound(ctx); found {
+		return
+	}
+	nas := k.GetNetAmountState(ctx)
+	bound := sdk.OneDec()
+	if nas.BtokenTotalSupply.IsPositive() && nas.NetAmount.IsPositive() {
+		bound = sdk.MaxDec(bound, nas.BtokenTotalSupply.ToDec().QuoRoundUp(nas.NetAmount))
+	}
+	k.SetMintRateBound(ctx, bound)
+}
@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/crescent-network/crescent/v4/x/liquidstaking/types"
+)
+
+// ModuleAccounts returns the module account and the accounts derived by the module, along with their balances and
+// whether they are blocked addresses in the bank module.
+func (k Keeper) ModuleAccounts(ctx sdk.Context) []types.ModuleAccountResponse {
+	accs := []types.ModuleAccountResponse{
+		k.moduleAccountResponse(ctx, "module", k.accountKeeper.GetModuleAddress(types.ModuleName),
+			"mints and burns bToken"),
+		k.moduleAccountResponse(ctx, "liquid_staking_proxy", types.LiquidStakingProxyAcc,
+			"delegates the liquid staked tokens to the liquid validators"),
+	}
+	for _, zone := range k.GetAllHostZones(ctx) {
+		accs = append(accs, k.moduleAccountResponse(ctx, "host_zone_deposit/"+zone.ChainId,
+			zone.GetDepositAddress(), "collects the deposits of the host zone"))
+	}
+	return accs
+}
+
+func (k Keeper) moduleAccountResponse(ctx sdk.Context, name string, addr sdk.AccAddress, purpose string) types.ModuleAccountResponse {
+	return types.ModuleAccountResponse{
+		Name:     name,
+		Address:  addr.String(),
+		Purpose:  purpose,
+		Balances: k.bankKeeper.GetAllBalances(ctx, addr),
+		Blocked:  k.bankKeeper.BlockedAddr(addr),
+	}
+}
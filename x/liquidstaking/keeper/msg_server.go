@@ -7,6 +7,7 @@ package keeper
 
 import (
 	"context"
+	"strconv"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -35,6 +36,13 @@ func (k msgServer) LiquidStake(goCtx context.Context, msg *types.MsgLiquidStake)
 	}
 
 	liquidBondDenom := k.LiquidBondDenom(ctx)
+	if msg.Farm {
+		// farm the minted bToken right away
+		if _, err := k.lpfarmKeeper.Farm(ctx, msg.GetDelegator(), sdk.NewCoin(liquidBondDenom, bTokenMintAmount)); err != nil {
+			return nil, err
+		}
+	}
+
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
@@ -78,3 +86,131 @@ func (k msgServer) LiquidUnstake(goCtx context.Context, msg *types.MsgLiquidUnst
 		CompletionTime: completionTime,
 	}, nil
 }
+
+func (k msgServer) ArbLiquidStake(goCtx context.Context, msg *types.MsgArbLiquidStake) (*types.MsgArbLiquidStakeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	order, bTokenMintAmount, err := k.Keeper.ArbLiquidStake(ctx, types.LiquidStakingProxyAcc, msg.GetArbitrager(), msg.PairId, msg.MaxSpend)
+	if err != nil {
+		return nil, err
+	}
+
+	liquidBondDenom := k.LiquidBondDenom(ctx)
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+		sdk.NewEvent(
+			types.EventTypeMsgArbLiquidStake,
+			sdk.NewAttribute(types.AttributeKeyArbitrager, msg.ArbitragerAddress),
+			sdk.NewAttribute(types.AttributeKeyPairId, strconv.FormatUint(msg.PairId, 10)),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.MaxSpend.String()),
+			sdk.NewAttribute(types.AttributeKeyOrderId, strconv.FormatUint(order.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyOrderDirection, order.Direction.String()),
+			sdk.NewAttribute(types.AttributeKeyBTokenMintedAmount, sdk.Coin{Denom: liquidBondDenom, Amount: bTokenMintAmount}.String()),
+		),
+	})
+	return &types.MsgArbLiquidStakeResponse{
+		OrderId: order.Id,
+	}, nil
+}
+
+func (k msgServer) LiquidUnstakeInstant(goCtx context.Context, msg *types.MsgLiquidUnstakeInstant) (*types.MsgLiquidUnstakeInstantResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	order, err := k.Keeper.LiquidUnstakeInstant(ctx, msg.GetLiquidStaker(), msg.PairId, msg.Amount, msg.MaxSlippage)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+		sdk.NewEvent(
+			types.EventTypeMsgLiquidUnstakeInstant,
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.LiquidStaker),
+			sdk.NewAttribute(types.AttributeKeyPairId, strconv.FormatUint(msg.PairId, 10)),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyOrderId, strconv.FormatUint(order.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyOrderPrice, order.Price.String()),
+		),
+	})
+	return &types.MsgLiquidUnstakeInstantResponse{
+		OrderId: order.Id,
+	}, nil
+}
+
+func (k msgServer) InterchainLiquidStake(goCtx context.Context, msg *types.MsgInterchainLiquidStake) (*types.MsgInterchainLiquidStakeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	bTokenMinted, err := k.Keeper.InterchainLiquidStake(ctx, msg.GetDelegator(), msg.ChainId, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+		sdk.NewEvent(
+			types.EventTypeMsgInterchainLiquidStake,
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+			sdk.NewAttribute(types.AttributeKeyChainId, msg.ChainId),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyBTokenMintedAmount, bTokenMinted.String()),
+		),
+	})
+	return &types.MsgInterchainLiquidStakeResponse{
+		MintedAmount: bTokenMinted,
+	}, nil
+}
+
+func (k msgServer) UpdateHostZoneState(goCtx context.Context, msg *types.MsgUpdateHostZoneState) (*types.MsgUpdateHostZoneStateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	zone, err := k.Keeper.UpdateHostZoneState(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+		sdk.NewEvent(
+			types.EventTypeMsgUpdateHostZoneState,
+			sdk.NewAttribute(types.AttributeKeyChainId, msg.ChainId),
+			sdk.NewAttribute(types.AttributeKeyProofHeight, strconv.FormatUint(msg.ProofHeight, 10)),
+			sdk.NewAttribute(types.AttributeKeyIdleAmount, zone.IdleAmount.String()),
+			sdk.NewAttribute(types.AttributeKeyDelegatedAmount, zone.DelegatedAmount.String()),
+		),
+	})
+	return &types.MsgUpdateHostZoneStateResponse{}, nil
+}
+
+// UpdateWhitelistedValidatorWeight defines a method for adjusting the target weight of a whitelisted validator.
+func (k msgServer) UpdateWhitelistedValidatorWeight(goCtx context.Context, msg *types.MsgUpdateWhitelistedValidatorWeight) (*types.MsgUpdateWhitelistedValidatorWeightResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.Keeper.UpdateWhitelistedValidatorWeight(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+		sdk.NewEvent(
+			types.EventTypeMsgUpdateWhitelistedValidatorWeight,
+			sdk.NewAttribute(types.AttributeKeyLiquidValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyTargetWeight, msg.TargetWeight.String()),
+		),
+	})
+	return &types.MsgUpdateWhitelistedValidatorWeightResponse{}, nil
+}
@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/crescent-network/crescent/v4/x/liquidstaking/types"
+)
+
+// GetNetAmountSnapshot returns the net amount snapshot at the height.
+func (k Keeper) GetNetAmountSnapshot(ctx sdk.Context, height int64) (snapshot types.NetAmountSnapshot, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetNetAmountSnapshotKey(height))
+	if bz == nil {
+		return
+	}
+	k.cdc.MustUnmarshal(bz, &snapshot)
+	return snapshot, true
+}
+
+// SetNetAmountSnapshot stores the net amount snapshot.
+func (k Keeper) SetNetAmountSnapshot(ctx sdk.Context, snapshot types.NetAmountSnapshot) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&snapshot)
+	store.Set(types.GetNetAmountSnapshotKey(snapshot.Height), bz)
+}
+
+// DeleteNetAmountSnapshot deletes the net amount snapshot.
+func (k Keeper) DeleteNetAmountSnapshot(ctx sdk.Context, snapshot types.NetAmountSnapshot) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetNetAmountSnapshotKey(snapshot.Height))
+}
+
+// IterateAllNetAmountSnapshots iterates through all net amount snapshots from
+// the oldest one and calls cb for each snapshot.
+func (k Keeper) IterateAllNetAmountSnapshots(ctx sdk.Context, cb func(snapshot types.NetAmountSnapshot) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.NetAmountSnapshotKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var snapshot types.NetAmountSnapshot
+		k.cdc.MustUnmarshal(iterator.Value(), &snapshot)
+		if cb(snapshot) {
+			break
+		}
+	}
+}
+
+// GetAllNetAmountSnapshots returns all net amount snapshots ordered by their
+// heights, used during genesis dump.
+func (k Keeper) GetAllNetAmountSnapshots(ctx sdk.Context) (snapshots []types.NetAmountSnapshot) {
+	snapshots = []types.NetAmountSnapshot{}
+	k.IterateAllNetAmountSnapshots(ctx, func(snapshot types.NetAmountSnapshot) (stop bool) {
+		snapshots = append(snapshots, snapshot)
+		return false
+	})
+	return snapshots
+}
+
+// RecordNetAmountSnapshot stores the net amount snapshot of the current block
+// and prunes the snapshots older than NetAmountSnapshotRetention blocks.
+// All snapshots are pruned if NetAmountSnapshotRetention is zero.
+func (k Keeper) RecordNetAmountSnapshot(ctx sdk.Context) {
+	retention := k.GetParams(ctx).NetAmountSnapshotRetention
+	if retention > 0 {
+		nas := k.GetNetAmountState(ctx)
+		k.SetNetAmountSnapshot(ctx, types.NewNetAmountSnapshot(ctx.BlockHeight(), ctx.BlockTime(), nas))
+	}
+
+	var pruned []types.NetAmountSnapshot
+	k.IterateAllNetAmountSnapshots(ctx, func(snapshot types.NetAmountSnapshot) (stop bool) {
+		if snapshot.Height > ctx.BlockHeight()-int64(retention) {
+			return true
+		}
+		pruned = append(pruned, snapshot)
+		return false
+	})
+	for _, snapshot := range pruned {
+		k.DeleteNetAmountSnapshot(ctx, snapshot)
+	}
+}
@@ -0,0 +1,125 @@
+package keeper_test
+
+import (
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/crescent-network/crescent/v4/x/liquidstaking"
+	"github.com/crescent-network/crescent/v4/x/liquidstaking/types"
+)
+
+func (s *KeeperTestSuite) snapshotHeights() (heights []int64) {
+	for _, snapshot := range s.keeper.GetAllNetAmountSnapshots(s.ctx) {
+		heights = append(heights, snapshot.Height)
+	}
+	return
+}
+
+func (s *KeeperTestSuite) TestRecordNetAmountSnapshot() {
+	_, valOpers, _ := s.CreateValidators([]int64{1000000, 1000000})
+	params := s.keeper.GetParams(s.ctx)
+	params.WhitelistedValidators = []types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[0].String(), TargetWeight: sdk.NewInt(10)},
+		{ValidatorAddress: valOpers[1].String(), TargetWeight: sdk.NewInt(10)},
+	}
+	s.keeper.SetParams(s.ctx, params)
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+	s.Require().NoError(s.liquidStaking(s.delAddrs[0], sdk.NewInt(100000000)))
+
+	// disabled by default
+	s.ctx = s.ctx.WithBlockHeight(101)
+	liquidstaking.BeginBlocker(s.ctx, s.keeper)
+	s.Require().Empty(s.keeper.GetAllNetAmountSnapshots(s.ctx))
+
+	params = s.keeper.GetParams(s.ctx)
+	params.NetAmountSnapshotRetention = 3
+	s.keeper.SetParams(s.ctx, params)
+
+	startTime := s.ctx.BlockTime()
+	for height := int64(102); height <= 106; height++ {
+		s.ctx = s.ctx.WithBlockHeight(height).WithBlockTime(startTime.Add(time.Duration(height-101) * time.Minute))
+		liquidstaking.BeginBlocker(s.ctx, s.keeper)
+	}
+	s.Require().Equal([]int64{104, 105, 106}, s.snapshotHeights())
+
+	nas := s.keeper.GetNetAmountState(s.ctx)
+	snapshot, found := s.keeper.GetNetAmountSnapshot(s.ctx, 106)
+	s.Require().True(found)
+	s.Require().Equal(types.NewNetAmountSnapshot(106, startTime.Add(5*time.Minute), nas), snapshot)
+	s.Require().NoError(snapshot.Validate())
+
+	// shrinking the retention window prunes old snapshots at once
+	params.NetAmountSnapshotRetention = 1
+	s.keeper.SetParams(s.ctx, params)
+	s.ctx = s.ctx.WithBlockHeight(107)
+	liquidstaking.BeginBlocker(s.ctx, s.keeper)
+	s.Require().Equal([]int64{107}, s.snapshotHeights())
+
+	// disabling snapshots prunes all snapshots
+	params.NetAmountSnapshotRetention = 0
+	s.keeper.SetParams(s.ctx, params)
+	s.ctx = s.ctx.WithBlockHeight(108)
+	liquidstaking.BeginBlocker(s.ctx, s.keeper)
+	s.Require().Empty(s.keeper.GetAllNetAmountSnapshots(s.ctx))
+}
+
+func (s *KeeperTestSuite) TestGRPCNetAmount() {
+	_, valOpers, _ := s.CreateValidators([]int64{1000000, 1000000})
+	params := s.keeper.GetParams(s.ctx)
+	params.WhitelistedValidators = []types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[0].String(), TargetWeight: sdk.NewInt(10)},
+		{ValidatorAddress: valOpers[1].String(), TargetWeight: sdk.NewInt(10)},
+	}
+	params.NetAmountSnapshotRetention = 10
+	s.keeper.SetParams(s.ctx, params)
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+	s.Require().NoError(s.liquidStaking(s.delAddrs[0], sdk.NewInt(100000000)))
+
+	s.ctx = s.ctx.WithBlockHeight(101)
+	liquidstaking.BeginBlocker(s.ctx, s.keeper)
+	snapshot101, _ := s.keeper.GetNetAmountSnapshot(s.ctx, 101)
+
+	s.Require().NoError(s.liquidStaking(s.delAddrs[1], sdk.NewInt(50000000)))
+	s.ctx = s.ctx.WithBlockHeight(102)
+	liquidstaking.BeginBlocker(s.ctx, s.keeper)
+
+	_, err := s.querier.NetAmount(sdk.WrapSDKContext(s.ctx), nil)
+	s.Require().Error(err)
+	_, err = s.querier.NetAmount(sdk.WrapSDKContext(s.ctx), &types.QueryNetAmountRequest{Height: -1})
+	s.Require().Equal(codes.InvalidArgument, status.Code(err))
+
+	// current block
+	nas := s.keeper.GetNetAmountState(s.ctx)
+	resp, err := s.querier.NetAmount(sdk.WrapSDKContext(s.ctx), &types.QueryNetAmountRequest{})
+	s.Require().NoError(err)
+	s.Require().EqualValues(102, resp.NetAmountSnapshot.Height)
+	s.Require().Equal(nas.NetAmount, resp.NetAmountSnapshot.NetAmount)
+	s.Require().Equal(nas.BtokenTotalSupply, resp.NetAmountSnapshot.BtokenTotalSupply)
+	s.Require().Equal(nas.MintRate, resp.NetAmountSnapshot.MintRate)
+
+	// past block
+	resp, err = s.querier.NetAmount(sdk.WrapSDKContext(s.ctx), &types.QueryNetAmountRequest{Height: 101})
+	s.Require().NoError(err)
+	s.Require().Equal(snapshot101, resp.NetAmountSnapshot)
+	s.Require().True(resp.NetAmountSnapshot.NetAmount.LT(nas.NetAmount))
+
+	_, err = s.querier.NetAmount(sdk.WrapSDKContext(s.ctx), &types.QueryNetAmountRequest{Height: 100})
+	s.Require().Equal(codes.NotFound, status.Code(err))
+
+	snapshotsResp, err := s.querier.NetAmountSnapshots(sdk.WrapSDKContext(s.ctx), &types.QueryNetAmountSnapshotsRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(snapshotsResp.NetAmountSnapshots, 2)
+	s.Require().Equal(snapshot101, snapshotsResp.NetAmountSnapshots[0])
+
+	snapshotsResp, err = s.querier.NetAmountSnapshots(sdk.WrapSDKContext(s.ctx), &types.QueryNetAmountSnapshotsRequest{
+		Pagination: &query.PageRequest{Limit: 1, Reverse: true},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(snapshotsResp.NetAmountSnapshots, 1)
+	s.Require().EqualValues(102, snapshotsResp.NetAmountSnapshots[0].Height)
+}
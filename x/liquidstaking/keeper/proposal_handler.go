@@ -0,0 +1,103 @@
+package keeper
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/crescent-network/crescent/v4/x/liquidstaking/types"
+)
+
+// HandleLiquidStakingWhitelistProposal is a handler for executing a liquid staking whitelist proposal.
+func HandleLiquidStakingWhitelistProposal(ctx sdk.Context, k Keeper, p *types.LiquidStakingWhitelistProposal) error {
+	return k.UpdateWhitelistedValidators(ctx, p.WhitelistedValidators, p.DelistedValidators)
+}
+
+// HandleRegisterHostZoneProposal is a handler for executing a register host zone proposal.
+func HandleRegisterHostZoneProposal(ctx sdk.Context, k Keeper, p *types.RegisterHostZoneProposal) error {
+	_, err := k.RegisterHostZone(ctx, p)
+	return err
+}
+
+// UpdateWhitelistedValidatorWeight adjusts the target weight of an already
+// whitelisted validator, leaving the other whitelisted validators untouched.
+// Only the authority of the module can adjust the weight.
+func (k Keeper) UpdateWhitelistedValidatorWeight(ctx sdk.Context, msg *types.MsgUpdateWhitelistedValidatorWeight) error {
+	if msg.Authority != k.authority {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+	params := k.GetParams(ctx)
+	if _, ok := types.GetWhitelistedValsMap(params.WhitelistedValidators)[msg.ValidatorAddress]; !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "validator %s is not whitelisted", msg.ValidatorAddress)
+	}
+	return k.UpdateWhitelistedValidators(ctx, []types.WhitelistedValidator{
+		{ValidatorAddress: msg.ValidatorAddress, TargetWeight: msg.TargetWeight},
+	}, nil)
+}
+
+// UpdateWhitelistedValidators adds the whitelisted validators to the whitelist
+// or adjusts their target weights if they are already whitelisted, and removes
+// the delisted validators from the whitelist.
+// The liquid validator set is rebalanced right after the params are updated.
+func (k Keeper) UpdateWhitelistedValidators(ctx sdk.Context, whitelistedVals []types.WhitelistedValidator, delistedVals []string) error {
+	params := k.GetParams(ctx)
+	valsMap := types.GetWhitelistedValsMap(params.WhitelistedValidators)
+
+	for _, wv := range whitelistedVals {
+		if _, ok := valsMap[wv.ValidatorAddress]; !ok {
+			valAddr, err := sdk.ValAddressFromBech32(wv.ValidatorAddress)
+			if err != nil {
+				return err
+			}
+			if _, found := k.stakingKeeper.GetValidator(ctx, valAddr); !found {
+				return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "validator %s not found", wv.ValidatorAddress)
+			}
+		}
+	}
+	delistedValsMap := map[string]struct{}{}
+	for _, valAddr := range delistedVals {
+		if _, ok := valsMap[valAddr]; !ok {
+			return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "validator %s is not whitelisted", valAddr)
+		}
+		delistedValsMap[valAddr] = struct{}{}
+	}
+
+	updatedValsMap := types.GetWhitelistedValsMap(whitelistedVals)
+	var newWhitelistedVals []types.WhitelistedValidator
+	for _, wv := range params.WhitelistedValidators {
+		if _, ok := delistedValsMap[wv.ValidatorAddress]; ok {
+			continue
+		}
+		if updated, ok := updatedValsMap[wv.ValidatorAddress]; ok {
+			wv.TargetWeight = updated.TargetWeight
+		}
+		newWhitelistedVals = append(newWhitelistedVals, wv)
+	}
+	for _, wv := range whitelistedVals {
+		if _, ok := valsMap[wv.ValidatorAddress]; !ok {
+			newWhitelistedVals = append(newWhitelistedVals, wv)
+		}
+	}
+
+	params.WhitelistedValidators = newWhitelistedVals
+	if err := params.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	k.SetParams(ctx, params)
+
+	var whitelistedValAddrs []string
+	for _, wv := range whitelistedVals {
+		whitelistedValAddrs = append(whitelistedValAddrs, wv.ValidatorAddress)
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUpdateWhitelistedValidators,
+			sdk.NewAttribute(types.AttributeKeyWhitelistedValidators, strings.Join(whitelistedValAddrs, ",")),
+			sdk.NewAttribute(types.AttributeKeyDelistedValidators, strings.Join(delistedVals, ",")),
+		),
+	})
+
+	k.UpdateLiquidValidatorSet(ctx)
+	return nil
+}
@@ -0,0 +1,116 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/crescent-network/crescent/v4/x/liquidstaking"
+	"github.com/crescent-network/crescent/v4/x/liquidstaking/types"
+)
+
+func (s *KeeperTestSuite) TestLiquidStakingWhitelistProposal() {
+	_, valOpers, _ := s.CreateValidators([]int64{1000000, 1000000, 1000000})
+	params := s.keeper.GetParams(s.ctx)
+	params.MinLiquidStakingAmount = sdk.NewInt(10000)
+	params.WhitelistedValidators = []types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[0].String(), TargetWeight: sdk.NewInt(1)},
+		{ValidatorAddress: valOpers[1].String(), TargetWeight: sdk.NewInt(1)},
+	}
+	s.keeper.SetParams(s.ctx, params)
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+	s.Require().NoError(s.liquidStaking(s.delAddrs[0], sdk.NewInt(100000)))
+
+	handler := liquidstaking.NewProposalHandler(s.keeper)
+	p := types.NewLiquidStakingWhitelistProposal("title", "description",
+		[]types.WhitelistedValidator{
+			{ValidatorAddress: valOpers[2].String(), TargetWeight: sdk.NewInt(2)},
+			{ValidatorAddress: valOpers[0].String(), TargetWeight: sdk.NewInt(3)},
+		},
+		[]string{valOpers[1].String()})
+	s.Require().NoError(p.ValidateBasic())
+	s.Require().NoError(handler(s.ctx, p))
+
+	params = s.keeper.GetParams(s.ctx)
+	s.Require().Equal([]types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[0].String(), TargetWeight: sdk.NewInt(3)},
+		{ValidatorAddress: valOpers[2].String(), TargetWeight: sdk.NewInt(2)},
+	}, params.WhitelistedValidators)
+
+	// The new whitelisted validator becomes a liquid validator and receives
+	// liquid tokens by rebalancing.
+	lv, found := s.keeper.GetLiquidValidator(s.ctx, valOpers[2])
+	s.Require().True(found)
+	s.Require().True(s.keeper.IsActiveLiquidValidator(s.ctx, lv, params.WhitelistedValsMap()))
+	s.Require().True(lv.GetLiquidTokens(s.ctx, s.app.StakingKeeper, false).IsPositive())
+
+	// The delisted validator is no longer active.
+	lv, found = s.keeper.GetLiquidValidator(s.ctx, valOpers[1])
+	if found {
+		s.Require().False(s.keeper.IsActiveLiquidValidator(s.ctx, lv, params.WhitelistedValsMap()))
+	}
+}
+
+func (s *KeeperTestSuite) TestUpdateWhitelistedValidatorWeight() {
+	_, valOpers, _ := s.CreateValidators([]int64{1000000, 1000000, 1000000})
+	params := s.keeper.GetParams(s.ctx)
+	params.MinLiquidStakingAmount = sdk.NewInt(10000)
+	params.WhitelistedValidators = []types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[0].String(), TargetWeight: sdk.NewInt(1)},
+		{ValidatorAddress: valOpers[1].String(), TargetWeight: sdk.NewInt(1)},
+	}
+	s.keeper.SetParams(s.ctx, params)
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+	s.Require().NoError(s.liquidStaking(s.delAddrs[0], sdk.NewInt(100000)))
+
+	authority := sdk.MustAccAddressFromBech32(s.keeper.GetAuthority())
+	handler := liquidstaking.NewHandler(s.keeper)
+
+	// Only the authority can adjust the weight.
+	_, err := handler(s.ctx, types.NewMsgUpdateWhitelistedValidatorWeight(s.delAddrs[0], valOpers[0], sdk.NewInt(3)))
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// The weight of a validator which is not whitelisted cannot be adjusted.
+	_, err = handler(s.ctx, types.NewMsgUpdateWhitelistedValidatorWeight(authority, valOpers[2], sdk.NewInt(3)))
+	s.Require().ErrorIs(err, sdkerrors.ErrNotFound)
+
+	_, err = handler(s.ctx, types.NewMsgUpdateWhitelistedValidatorWeight(authority, valOpers[0], sdk.NewInt(3)))
+	s.Require().NoError(err)
+
+	params = s.keeper.GetParams(s.ctx)
+	s.Require().Equal([]types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[0].String(), TargetWeight: sdk.NewInt(3)},
+		{ValidatorAddress: valOpers[1].String(), TargetWeight: sdk.NewInt(1)},
+	}, params.WhitelistedValidators)
+
+	// The liquid tokens are rebalanced according to the new weight.
+	lv, found := s.keeper.GetLiquidValidator(s.ctx, valOpers[0])
+	s.Require().True(found)
+	s.Require().Equal(sdk.NewInt(75000), lv.GetLiquidTokens(s.ctx, s.app.StakingKeeper, false))
+	lv, found = s.keeper.GetLiquidValidator(s.ctx, valOpers[1])
+	s.Require().True(found)
+	s.Require().Equal(sdk.NewInt(25000), lv.GetLiquidTokens(s.ctx, s.app.StakingKeeper, false))
+}
+
+func (s *KeeperTestSuite) TestLiquidStakingWhitelistProposalEdgeCases() {
+	_, valOpers, _ := s.CreateValidators([]int64{1000000, 1000000})
+	params := s.keeper.GetParams(s.ctx)
+	params.WhitelistedValidators = []types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[0].String(), TargetWeight: sdk.NewInt(1)},
+	}
+	s.keeper.SetParams(s.ctx, params)
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+
+	// Validator not found
+	err := s.keeper.UpdateWhitelistedValidators(s.ctx, []types.WhitelistedValidator{
+		{ValidatorAddress: sdk.ValAddress(s.addrs[0]).String(), TargetWeight: sdk.NewInt(1)},
+	}, nil)
+	s.Require().ErrorIs(err, sdkerrors.ErrNotFound)
+
+	// Delisting a validator which is not whitelisted
+	err = s.keeper.UpdateWhitelistedValidators(s.ctx, nil, []string{valOpers[1].String()})
+	s.Require().ErrorIs(err, sdkerrors.ErrNotFound)
+
+	// Delisting all validators is allowed.
+	s.Require().NoError(s.keeper.UpdateWhitelistedValidators(s.ctx, nil, []string{valOpers[0].String()}))
+	s.Require().Empty(s.keeper.GetParams(s.ctx).WhitelistedValidators)
+}
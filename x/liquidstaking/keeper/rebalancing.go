@@ -1,10 +1,12 @@
 package keeper
 
 import (
+	"fmt"
 	"strconv"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/crescent-network/crescent/v4/x/liquidstaking/types"
@@ -48,7 +50,8 @@ func (k Keeper) TryRedelegation(ctx sdk.Context, re types.Redelegation) (complet
 }
 
 // Rebalance argument liquidVals containing ValidatorStatusActive which is containing just added on whitelist(liquidToken 0) and ValidatorStatusInactive to delist
-func (k Keeper) Rebalance(ctx sdk.Context, proxyAcc sdk.AccAddress, liquidVals types.LiquidValidators, whitelistedValsMap types.WhitelistedValsMap, rebalancingTrigger sdk.Dec) (redelegations []types.Redelegation) {
+// At most maxRedelegations redelegations are tried, and the remaining gaps are rebalanced in the following blocks.
+func (k Keeper) Rebalance(ctx sdk.Context, proxyAcc sdk.AccAddress, liquidVals types.LiquidValidators, whitelistedValsMap types.WhitelistedValsMap, rebalancingTrigger sdk.Dec, maxRedelegations uint32) (redelegations []types.Redelegation) {
 	logger := k.Logger(ctx)
 	totalLiquidTokens, liquidTokenMap := liquidVals.TotalLiquidTokens(ctx, k.stakingKeeper, false)
 	if !totalLiquidTokens.IsPositive() {
@@ -84,7 +87,7 @@ func (k Keeper) Rebalance(ctx sdk.Context, proxyAcc sdk.AccAddress, liquidVals t
 	failCount := 0
 	rebalancingThresholdAmt := rebalancingTrigger.Mul(totalLiquidTokens.ToDec()).TruncateInt()
 
-	for i := 0; i < liquidVals.Len(); i++ {
+	for i := 0; i < liquidVals.Len() && i < int(maxRedelegations); i++ {
 		// get min, max of liquid token gap
 		minVal, maxVal, amountNeeded, last := liquidVals.MinMaxGap(targetMap, liquidTokenMap)
 		if amountNeeded.IsZero() || (i == 0 && !amountNeeded.GT(rebalancingThresholdAmt)) {
@@ -126,6 +129,7 @@ func (k Keeper) Rebalance(ctx sdk.Context, proxyAcc sdk.AccAddress, liquidVals t
 			types.AttributeKeyDelegator, types.LiquidStakingProxyAcc.String(),
 			types.AttributeKeyRedelegationCount, strconv.Itoa(len(redelegations)),
 			types.AttributeKeyRedelegationFailCount, strconv.Itoa(failCount))
+		k.afterRebalanced(ctx, redelegations)
 	}
 	return redelegations
 }
@@ -146,17 +150,21 @@ func (k Keeper) WithdrawRewardsAndReStake(ctx sdk.Context, whitelistedValsMap ty
 	// Withdraw rewards of LiquidStakingProxyAcc and re-staking
 	k.WithdrawLiquidRewards(ctx, types.LiquidStakingProxyAcc)
 
-	// re-staking with proxyAccBalance, due to auto-withdraw on add staking by f1
-	proxyAccBalance = k.GetProxyAccBalance(ctx, types.LiquidStakingProxyAcc)
-
 	// skip when no active liquid validator
 	activeVals := k.GetActiveLiquidValidators(ctx, whitelistedValsMap)
 	if len(activeVals) == 0 {
 		return
 	}
 
-	// re-staking
+	// re-staking with proxyAccBalance after the protocol commission is taken, due to auto-withdraw on add staking by f1
 	cachedCtx, writeCache := ctx.CacheContext()
+	cachedCtx = cachedCtx.WithEventManager(sdk.NewEventManager())
+	if err := k.TakeProtocolCommission(cachedCtx, types.LiquidStakingProxyAcc); err != nil {
+		logger := k.Logger(ctx)
+		logger.Error("re-staking failed", "error", err)
+		return
+	}
+	proxyAccBalance = k.GetProxyAccBalance(cachedCtx, types.LiquidStakingProxyAcc)
 	_, err := k.LiquidDelegate(cachedCtx, types.LiquidStakingProxyAcc, activeVals, proxyAccBalance.Amount, whitelistedValsMap)
 	if err != nil {
 		logger := k.Logger(ctx)
@@ -164,6 +172,7 @@ func (k Keeper) WithdrawRewardsAndReStake(ctx sdk.Context, whitelistedValsMap ty
 		return
 	}
 	writeCache()
+	ctx.EventManager().EmitEvents(cachedCtx.EventManager().Events())
 	logger := k.Logger(ctx)
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
@@ -177,7 +186,179 @@ func (k Keeper) WithdrawRewardsAndReStake(ctx sdk.Context, whitelistedValsMap ty
 		sdk.AttributeKeyAmount, proxyAccBalance.String())
 }
 
+// CompoundRewards withdraws the accumulated delegation rewards from all liquid validators regardless of
+// types.RewardTrigger and re-stakes them to the active liquid validators according to their target weights.
+func (k Keeper) CompoundRewards(ctx sdk.Context) {
+	logger := k.Logger(ctx)
+	whitelistedValsMap := k.GetParams(ctx).WhitelistedValsMap()
+
+	// skip when no active liquid validator
+	activeVals := k.GetActiveLiquidValidators(ctx, whitelistedValsMap)
+	if len(activeVals) == 0 {
+		return
+	}
+
+	k.WithdrawLiquidRewards(ctx, types.LiquidStakingProxyAcc)
+
+	// re-staking with proxyAccBalance after the protocol commission is taken, due to auto-withdraw on add staking by f1
+	cachedCtx, writeCache := ctx.CacheContext()
+	cachedCtx = cachedCtx.WithEventManager(sdk.NewEventManager())
+	if err := k.TakeProtocolCommission(cachedCtx, types.LiquidStakingProxyAcc); err != nil {
+		logger.Error("reward compounding failed", "error", err)
+		return
+	}
+	proxyAccBalance := k.GetProxyAccBalance(cachedCtx, types.LiquidStakingProxyAcc)
+	if !proxyAccBalance.IsPositive() {
+		return
+	}
+
+	weightedAmt, crumb := types.DivideByWeight(activeVals, proxyAccBalance.Amount, whitelistedValsMap)
+	if len(weightedAmt) == 0 {
+		return
+	}
+	weightedAmt[0] = weightedAmt[0].Add(crumb)
+
+	if _, err := k.LiquidDelegate(cachedCtx, types.LiquidStakingProxyAcc, activeVals, proxyAccBalance.Amount, whitelistedValsMap); err != nil {
+		logger.Error("reward compounding failed", "error", err)
+		return
+	}
+	writeCache()
+	ctx.EventManager().EmitEvents(cachedCtx.EventManager().Events())
+
+	for i, val := range activeVals {
+		if !weightedAmt[i].IsPositive() {
+			continue
+		}
+		amount := sdk.NewCoin(proxyAccBalance.Denom, weightedAmt[i]).String()
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeCompoundRewards,
+				sdk.NewAttribute(types.AttributeKeyDelegator, types.LiquidStakingProxyAcc.String()),
+				sdk.NewAttribute(types.AttributeKeyLiquidValidator, val.OperatorAddress),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, amount),
+			),
+		})
+		logger.Info(types.EventTypeCompoundRewards,
+			types.AttributeKeyDelegator, types.LiquidStakingProxyAcc.String(),
+			types.AttributeKeyLiquidValidator, val.OperatorAddress,
+			sdk.AttributeKeyAmount, amount)
+	}
+}
+
+// TakeProtocolCommission sends params.ProtocolCommissionRate of the native token balance of the proxy account, which
+// consists of the withdrawn delegation rewards, to params.ProtocolCommissionDestination before it is re-staked.
+func (k Keeper) TakeProtocolCommission(ctx sdk.Context, proxyAcc sdk.AccAddress) error {
+	params := k.GetParams(ctx)
+	proxyAccBalance := k.GetProxyAccBalance(ctx, proxyAcc)
+	commission := sdk.NewCoin(proxyAccBalance.Denom, params.ProtocolCommissionRate.MulInt(proxyAccBalance.Amount).TruncateInt())
+	if !commission.IsPositive() {
+		return nil
+	}
+
+	var err error
+	switch params.ProtocolCommissionDestination {
+	case types.ProtocolCommissionDestinationFeeCollector:
+		err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, proxyAcc, authtypes.FeeCollectorName, sdk.NewCoins(commission))
+	case types.ProtocolCommissionDestinationCommunityPool:
+		err = k.distrKeeper.FundCommunityPool(ctx, sdk.NewCoins(commission), proxyAcc)
+	default: // the param is validated
+		panic(fmt.Sprintf("invalid protocol commission destination: %s", params.ProtocolCommissionDestination))
+	}
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeTakeProtocolCommission,
+			sdk.NewAttribute(types.AttributeKeyDelegator, proxyAcc.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, commission.String()),
+			sdk.NewAttribute(types.AttributeKeyDestination, params.ProtocolCommissionDestination),
+		),
+	})
+	k.Logger(ctx).Info(types.EventTypeTakeProtocolCommission,
+		types.AttributeKeyDelegator, proxyAcc.String(),
+		sdk.AttributeKeyAmount, commission.String(),
+		types.AttributeKeyDestination, params.ProtocolCommissionDestination)
+	return nil
+}
+
+// ValidatorRequirementViolation returns the reason why the validator doesn't meet the requirements of
+// whitelisted validators, params.MinSelfDelegation and params.MaxCommissionRate, or an empty string if it meets them.
+func (k Keeper) ValidatorRequirementViolation(ctx sdk.Context, val stakingtypes.Validator, params types.Params) string {
+	if val.Commission.Rate.GT(params.MaxCommissionRate) {
+		return fmt.Sprintf("commission rate %s exceeds max commission rate %s", val.Commission.Rate, params.MaxCommissionRate)
+	}
+	if params.MinSelfDelegation.IsPositive() {
+		selfDelegation := sdk.ZeroInt()
+		valAddr := val.GetOperator()
+		if del, found := k.stakingKeeper.GetDelegation(ctx, sdk.AccAddress(valAddr), valAddr); found {
+			selfDelegation = val.TokensFromShares(del.Shares).TruncateInt()
+		}
+		if selfDelegation.LT(params.MinSelfDelegation) {
+			return fmt.Sprintf("self delegation %s is less than min self delegation %s", selfDelegation, params.MinSelfDelegation)
+		}
+	}
+	return ""
+}
+
+// DelistViolatingValidators removes the whitelisted validators which don't meet the requirements of whitelisted
+// validators from the whitelist. The liquid tokens of the removed validators are moved to the other active liquid
+// validators by the following update of the liquid validator set.
+func (k Keeper) DelistViolatingValidators(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	if !params.MinSelfDelegation.IsPositive() && params.MaxCommissionRate.GTE(sdk.OneDec()) {
+		return
+	}
+
+	var whitelistedVals []types.WhitelistedValidator
+	delisted := false
+	for _, wv := range params.WhitelistedValidators {
+		valAddr, err := sdk.ValAddressFromBech32(wv.ValidatorAddress)
+		if err != nil {
+			whitelistedVals = append(whitelistedVals, wv)
+			continue
+		}
+		val, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+		if !found {
+			whitelistedVals = append(whitelistedVals, wv)
+			continue
+		}
+		reason := k.ValidatorRequirementViolation(ctx, val, params)
+		if reason == "" {
+			whitelistedVals = append(whitelistedVals, wv)
+			continue
+		}
+		delisted = true
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeDelistValidator,
+				sdk.NewAttribute(types.AttributeKeyLiquidValidator, wv.ValidatorAddress),
+				sdk.NewAttribute(types.AttributeKeyReason, reason),
+			),
+		})
+		k.Logger(ctx).Info(types.EventTypeDelistValidator,
+			types.AttributeKeyLiquidValidator, wv.ValidatorAddress,
+			types.AttributeKeyReason, reason)
+	}
+	if delisted {
+		params.WhitelistedValidators = whitelistedVals
+		k.SetParams(ctx, params)
+	}
+}
+
+// UpdateLiquidValidatorSet updates the liquid validator set and rebalances
+// the liquid validators.
+// When RebalancingEpochIdentifier is set to an existing epoch, the liquid
+// validators are rebalanced only if the status of a liquid validator changes,
+// and otherwise are left to the end of the epoch.
 func (k Keeper) UpdateLiquidValidatorSet(ctx sdk.Context) []types.Redelegation {
+	return k.updateLiquidValidatorSet(ctx, !k.epochExists(ctx, k.GetParams(ctx).RebalancingEpochIdentifier))
+}
+
+func (k Keeper) updateLiquidValidatorSet(ctx sdk.Context, forceRebalancing bool) (reds []types.Redelegation) {
+	k.DelistViolatingValidators(ctx)
+
 	logger := k.Logger(ctx)
 	params := k.GetParams(ctx)
 	liquidValidators := k.GetAllLiquidValidators(ctx)
@@ -189,6 +370,7 @@ func (k Keeper) UpdateLiquidValidatorSet(ctx sdk.Context) []types.Redelegation {
 		if _, ok := liquidValsMap[wv.ValidatorAddress]; !ok {
 			lv := types.LiquidValidator{
 				OperatorAddress: wv.ValidatorAddress,
+				Status:          types.ValidatorStatusActive,
 			}
 			if k.IsActiveLiquidValidator(ctx, lv, whitelistedValsMap) {
 				k.SetLiquidValidator(ctx, lv)
@@ -204,9 +386,40 @@ func (k Keeper) UpdateLiquidValidatorSet(ctx sdk.Context) []types.Redelegation {
 		}
 	}
 
+	// update the status of liquid validators, e.g. a jailed or tombstoned validator gets zero weight and its liquid
+	// tokens are redelegated to the other active liquid validators by the rebalancing below
+	statusChanged := false
+	for i, lv := range liquidValidators {
+		status := k.GetLiquidValidatorStatus(ctx, lv, whitelistedValsMap)
+		if status == lv.Status {
+			continue
+		}
+		statusChanged = true
+		prevStatus := lv.Status
+		lv.Status = status
+		k.SetLiquidValidator(ctx, lv)
+		liquidValidators[i] = lv
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeUpdateLiquidValidatorStatus,
+				sdk.NewAttribute(types.AttributeKeyLiquidValidator, lv.OperatorAddress),
+				sdk.NewAttribute(types.AttributeKeyPreviousStatus, prevStatus.String()),
+				sdk.NewAttribute(types.AttributeKeyStatus, status.String()),
+			),
+		})
+		logger.Info(types.EventTypeUpdateLiquidValidatorStatus,
+			types.AttributeKeyLiquidValidator, lv.OperatorAddress,
+			types.AttributeKeyPreviousStatus, prevStatus.String(),
+			types.AttributeKeyStatus, status.String())
+	}
+
 	// rebalancing based updated liquid validators status with threshold, try by cachedCtx
 	// tombstone status also handled on Rebalance
-	reds := k.Rebalance(ctx, types.LiquidStakingProxyAcc, liquidValidators, whitelistedValsMap, types.RebalancingTrigger)
+	// the liquid tokens of validators whose status changed are redelegated right away rather than unbonded below,
+	// even when the rebalancing waits for the epoch
+	if forceRebalancing || statusChanged {
+		reds = k.Rebalance(ctx, types.LiquidStakingProxyAcc, liquidValidators, whitelistedValsMap, params.RebalancingTrigger, params.MaxRedelegationsPerRebalancing)
+	}
 
 	// unbond all delShares to proxyAcc if delShares exist on inactive liquid validators
 	for _, lv := range liquidValidators {
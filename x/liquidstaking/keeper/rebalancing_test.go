@@ -3,11 +3,17 @@ package keeper_test
 import (
 	"time"
 
+	abci "github.com/tendermint/tendermint/abci/types"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	utils "github.com/crescent-network/crescent/v4/types"
+	epochstypes "github.com/crescent-network/crescent/v4/x/epochs/types"
+	liquiditytypes "github.com/crescent-network/crescent/v4/x/liquidity/types"
+	"github.com/crescent-network/crescent/v4/x/liquidstaking"
 	"github.com/crescent-network/crescent/v4/x/liquidstaking/types"
 )
 
@@ -198,10 +204,10 @@ func (s *KeeperTestSuite) TestRebalancingCase1() {
 	// double sign, tombstone, slash, jail
 	s.doubleSign(valOpers[1], sdk.ConsAddress(pks[1].Address()))
 
-	// check inactive with zero weight after tombstoned
+	// check jailed with zero weight after tombstoned
 	lvState, found := s.keeper.GetLiquidValidatorState(s.ctx, proxyAccDel2.GetValidatorAddr())
 	s.Require().True(found)
-	s.Require().Equal(lvState.Status, types.ValidatorStatusInactive)
+	s.Require().Equal(lvState.Status, types.ValidatorStatusJailed)
 	s.Require().Equal(lvState.Weight, sdk.ZeroInt())
 	s.Require().NotEqualValues(lvState.DelShares, sdk.ZeroDec())
 	s.Require().NotEqualValues(lvState.LiquidTokens, sdk.ZeroInt())
@@ -467,6 +473,56 @@ func (s *KeeperTestSuite) TestRebalancingConsecutiveCase() {
 	s.printRedelegationsLiquidTokens()
 }
 
+func (s *KeeperTestSuite) TestRebalancingParams() {
+	_, valOpers, _ := s.CreateValidators([]int64{
+		1000000, 1000000, 1000000, 1000000, 1000000,
+		1000000, 1000000, 1000000, 1000000, 1000000})
+	s.ctx = s.ctx.WithBlockHeight(100).WithBlockTime(utils.ParseTime("2022-03-01T00:00:00Z"))
+	params := s.keeper.GetParams(s.ctx)
+	params.UnstakeFeeRate = sdk.ZeroDec()
+	params.MinLiquidStakingAmount = sdk.NewInt(10000)
+	params.RebalancingTrigger = sdk.NewDecWithPrec(2, 1) // 20%
+	params.MaxRedelegationsPerRebalancing = 3
+	for _, valOper := range valOpers[:8] {
+		params.WhitelistedValidators = append(params.WhitelistedValidators,
+			types.WhitelistedValidator{ValidatorAddress: valOper.String(), TargetWeight: sdk.NewInt(10)})
+	}
+	s.keeper.SetParams(s.ctx, params)
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+
+	stakingAmt := sdk.NewInt(10000000000000)
+	s.fundAddr(s.delAddrs[0], sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, stakingAmt)))
+	_, _, err := s.keeper.LiquidStake(s.ctx, types.LiquidStakingProxyAcc, s.delAddrs[0], sdk.NewCoin(sdk.DefaultBondDenom, stakingAmt))
+	s.Require().NoError(err)
+
+	// each redelegation needed is about 1.4% of total liquid tokens, which doesn't exceed the rebalancing trigger
+	params.WhitelistedValidators = append(params.WhitelistedValidators,
+		types.WhitelistedValidator{ValidatorAddress: valOpers[8].String(), TargetWeight: sdk.NewInt(10)})
+	s.keeper.SetParams(s.ctx, params)
+	reds := s.keeper.UpdateLiquidValidatorSet(s.ctx)
+	s.Require().Len(reds, 0)
+
+	// the rebalancing is spread across multiple blocks by max redelegations per rebalancing
+	params.RebalancingTrigger = sdk.NewDecWithPrec(1, 2) // 1%
+	s.keeper.SetParams(s.ctx, params)
+	for _, expected := range []int{3, 3, 2, 0} {
+		reds = s.keeper.UpdateLiquidValidatorSet(s.ctx)
+		s.Require().Len(reds, expected)
+		s.Require().Equal(0, s.redelegationsErrorCount(reds))
+		for _, red := range reds {
+			s.Require().Equal(valOpers[8].String(), red.DstValidator.OperatorAddress)
+		}
+		s.ctx = s.ctx.WithBlockHeight(s.ctx.BlockHeight() + 1).WithBlockTime(s.ctx.BlockTime().Add(5 * time.Second))
+	}
+
+	// assert rebalanced
+	_, liquidTokenMap := s.keeper.GetAllLiquidValidators(s.ctx).TotalLiquidTokens(s.ctx, s.app.StakingKeeper, false)
+	target := stakingAmt.QuoRaw(9)
+	for _, valOper := range valOpers[:9] {
+		s.Require().True(liquidTokenMap[valOper.String()].Sub(target).Abs().LTE(sdk.NewInt(10)))
+	}
+}
+
 func (s *KeeperTestSuite) TestWithdrawRewardsAndReStaking() {
 	_, valOpers, _ := s.CreateValidators([]int64{1000000, 1000000, 1000000})
 	params := s.keeper.GetParams(s.ctx)
@@ -544,3 +600,439 @@ func (s *KeeperTestSuite) TestRemoveAllLiquidValidator() {
 	s.Require().EqualValues(nasAfter2.ProxyAccBalance, nasAfter.ProxyAccBalance.Add(nasBefore.TotalLiquidTokens))
 	s.Require().EqualValues(nasAfter2.NetAmount.TruncateInt(), nasBefore.NetAmount.TruncateInt())
 }
+
+func (s *KeeperTestSuite) allocateRewards(valAddr sdk.ValAddress, amt sdk.Coins) {
+	err := s.app.BankKeeper.MintCoins(s.ctx, liquiditytypes.ModuleName, amt)
+	s.Require().NoError(err)
+	err = s.app.BankKeeper.SendCoinsFromModuleToModule(s.ctx, liquiditytypes.ModuleName, distrtypes.ModuleName, amt)
+	s.Require().NoError(err)
+	s.app.DistrKeeper.AllocateTokensToValidator(s.ctx, s.app.StakingKeeper.Validator(s.ctx, valAddr), sdk.NewDecCoinsFromCoins(amt...))
+}
+
+func (s *KeeperTestSuite) compoundedAmounts() map[string]sdk.Int {
+	amounts := map[string]sdk.Int{}
+	for _, ev := range s.ctx.EventManager().ABCIEvents() {
+		if ev.Type != types.EventTypeCompoundRewards {
+			continue
+		}
+		var valAddr string
+		var amount sdk.Coin
+		for _, attr := range ev.Attributes {
+			switch string(attr.Key) {
+			case types.AttributeKeyLiquidValidator:
+				valAddr = string(attr.Value)
+			case sdk.AttributeKeyAmount:
+				var err error
+				amount, err = sdk.ParseCoinNormalized(string(attr.Value))
+				s.Require().NoError(err)
+			}
+		}
+		amounts[valAddr] = amount.Amount
+	}
+	return amounts
+}
+
+func (s *KeeperTestSuite) TestCompoundRewards() {
+	_, valOpers, _ := s.CreateValidators([]int64{1000000, 1000000, 1000000})
+	params := s.keeper.GetParams(s.ctx)
+
+	params.WhitelistedValidators = []types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[0].String(), TargetWeight: sdk.NewInt(10)},
+		{ValidatorAddress: valOpers[1].String(), TargetWeight: sdk.NewInt(30)},
+	}
+	s.keeper.SetParams(s.ctx, params)
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+
+	stakingAmt := sdk.NewInt(100000000)
+	s.Require().NoError(s.liquidStaking(s.delAddrs[0], stakingAmt))
+
+	// allocate rewards which don't exceed the reward trigger
+	s.ctx = s.ctx.WithBlockHeight(s.ctx.BlockHeight() + 1)
+	s.allocateRewards(valOpers[0], sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 30000)))
+	s.allocateRewards(valOpers[1], sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 30000)))
+	totalRewards, totalDelShares, _ := s.keeper.CheckDelegationStates(s.ctx, types.LiquidStakingProxyAcc)
+	s.Require().True(totalRewards.IsPositive())
+	s.Require().True(totalRewards.LT(types.RewardTrigger.MulInt(stakingAmt)))
+
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+	totalRewardsAfter, _, _ := s.keeper.CheckDelegationStates(s.ctx, types.LiquidStakingProxyAcc)
+	s.Require().True(totalRewardsAfter.Equal(totalRewards))
+
+	// rewards are compounded regardless of the reward trigger
+	s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+	s.keeper.CompoundRewards(s.ctx)
+	totalRewardsAfter, totalDelSharesAfter, totalLiquidTokensAfter := s.keeper.CheckDelegationStates(s.ctx, types.LiquidStakingProxyAcc)
+	s.Require().True(totalRewardsAfter.IsZero())
+	s.Require().True(totalDelSharesAfter.Equal(totalLiquidTokensAfter.ToDec()))
+	s.Require().True(s.keeper.GetProxyAccBalance(s.ctx, types.LiquidStakingProxyAcc).IsZero())
+
+	// the withdrawn rewards are divided by the target weights
+	// decimal loss may occur while withdrawing rewards of each validator
+	amounts := s.compoundedAmounts()
+	s.Require().Len(amounts, 2)
+	compounded := amounts[valOpers[0].String()].Add(amounts[valOpers[1].String()])
+	s.Require().True(totalDelSharesAfter.Sub(totalDelShares).Equal(compounded.ToDec()))
+	s.Require().True(totalRewards.TruncateInt().Sub(compounded).LTE(sdk.NewInt(2)))
+	s.Require().True(amounts[valOpers[0].String()].MulRaw(3).Sub(amounts[valOpers[1].String()]).Abs().LTE(sdk.NewInt(3)))
+
+	// nothing to compound
+	s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+	s.keeper.CompoundRewards(s.ctx)
+	s.Require().Empty(s.compoundedAmounts())
+}
+
+func (s *KeeperTestSuite) TestRewardCompoundingEpoch() {
+	_, valOpers, _ := s.CreateValidators([]int64{1000000, 1000000})
+	params := s.keeper.GetParams(s.ctx)
+	params.WhitelistedValidators = []types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[0].String(), TargetWeight: sdk.NewInt(10)},
+		{ValidatorAddress: valOpers[1].String(), TargetWeight: sdk.NewInt(10)},
+	}
+	params.RewardCompoundingEpoch = 5
+	s.keeper.SetParams(s.ctx, params)
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+	s.Require().NoError(s.liquidStaking(s.delAddrs[0], sdk.NewInt(100000000)))
+	s.ctx = s.ctx.WithBlockHeight(s.ctx.BlockHeight() + 1)
+	s.allocateRewards(valOpers[0], sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10000)))
+
+	s.ctx = s.ctx.WithBlockHeight(104).WithEventManager(sdk.NewEventManager())
+	liquidstaking.BeginBlocker(s.ctx, s.keeper)
+	s.Require().Empty(s.compoundedAmounts())
+	totalRewards, _, _ := s.keeper.CheckDelegationStates(s.ctx, types.LiquidStakingProxyAcc)
+	s.Require().True(totalRewards.IsPositive())
+
+	s.ctx = s.ctx.WithBlockHeight(105).WithEventManager(sdk.NewEventManager())
+	liquidstaking.BeginBlocker(s.ctx, s.keeper)
+	s.Require().Len(s.compoundedAmounts(), 2)
+	totalRewards, _, _ = s.keeper.CheckDelegationStates(s.ctx, types.LiquidStakingProxyAcc)
+	s.Require().True(totalRewards.IsZero())
+
+	// disabled
+	params = s.keeper.GetParams(s.ctx)
+	params.RewardCompoundingEpoch = 0
+	s.keeper.SetParams(s.ctx, params)
+	s.allocateRewards(valOpers[0], sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10000)))
+	s.ctx = s.ctx.WithBlockHeight(110).WithEventManager(sdk.NewEventManager())
+	liquidstaking.BeginBlocker(s.ctx, s.keeper)
+	s.Require().Empty(s.compoundedAmounts())
+}
+
+func (s *KeeperTestSuite) TestRewardCompoundingEpochIdentifier() {
+	_, valOpers, _ := s.CreateValidators([]int64{1000000, 1000000})
+	params := s.keeper.GetParams(s.ctx)
+	params.WhitelistedValidators = []types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[0].String(), TargetWeight: sdk.NewInt(10)},
+		{ValidatorAddress: valOpers[1].String(), TargetWeight: sdk.NewInt(10)},
+	}
+	params.RewardCompoundingEpoch = 5
+	params.RewardCompoundingEpochIdentifier = epochstypes.DayEpochIdentifier
+	s.keeper.SetParams(s.ctx, params)
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+	s.Require().NoError(s.liquidStaking(s.delAddrs[0], sdk.NewInt(100000000)))
+	s.ctx = s.ctx.WithBlockHeight(s.ctx.BlockHeight() + 1)
+	s.allocateRewards(valOpers[0], sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10000)))
+
+	// The block-based epoch is ignored when the epoch identifier is set.
+	s.ctx = s.ctx.WithBlockHeight(105).WithEventManager(sdk.NewEventManager())
+	liquidstaking.BeginBlocker(s.ctx, s.keeper)
+	s.Require().Empty(s.compoundedAmounts())
+
+	hooks := s.keeper.Hooks()
+	hooks.AfterEpochEnd(s.ctx, epochstypes.WeekEpochIdentifier, 1)
+	s.Require().Empty(s.compoundedAmounts())
+	totalRewards, _, _ := s.keeper.CheckDelegationStates(s.ctx, types.LiquidStakingProxyAcc)
+	s.Require().True(totalRewards.IsPositive())
+
+	hooks.AfterEpochEnd(s.ctx, epochstypes.DayEpochIdentifier, 1)
+	s.Require().Len(s.compoundedAmounts(), 2)
+	totalRewards, _, _ = s.keeper.CheckDelegationStates(s.ctx, types.LiquidStakingProxyAcc)
+	s.Require().True(totalRewards.IsZero())
+}
+
+func (s *KeeperTestSuite) TestRewardCompoundingEpochIdentifier_UnknownEpoch() {
+	_, valOpers, _ := s.CreateValidators([]int64{1000000, 1000000})
+	params := s.keeper.GetParams(s.ctx)
+	params.WhitelistedValidators = []types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[0].String(), TargetWeight: sdk.NewInt(10)},
+		{ValidatorAddress: valOpers[1].String(), TargetWeight: sdk.NewInt(10)},
+	}
+	params.RewardCompoundingEpoch = 5
+	params.RewardCompoundingEpochIdentifier = "dya"
+	s.keeper.SetParams(s.ctx, params)
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+	s.Require().NoError(s.liquidStaking(s.delAddrs[0], sdk.NewInt(100000000)))
+	s.ctx = s.ctx.WithBlockHeight(s.ctx.BlockHeight() + 1)
+	s.allocateRewards(valOpers[0], sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10000)))
+
+	// The block-based epoch is used since there's no such epoch.
+	s.Require().False(s.keeper.CompoundsRewardsByEpoch(s.ctx))
+	s.ctx = s.ctx.WithBlockHeight(105).WithEventManager(sdk.NewEventManager())
+	liquidstaking.BeginBlocker(s.ctx, s.keeper)
+	s.Require().Len(s.compoundedAmounts(), 2)
+}
+
+func (s *KeeperTestSuite) TestRebalancingEpochIdentifier() {
+	_, valOpers, pks := s.CreateValidators([]int64{1000000, 1000000, 1000000})
+	s.ctx = s.ctx.WithBlockHeight(100).WithBlockTime(utils.ParseTime("2022-03-01T00:00:00Z"))
+	params := s.keeper.GetParams(s.ctx)
+	params.UnstakeFeeRate = sdk.ZeroDec()
+	params.MinLiquidStakingAmount = sdk.NewInt(10000)
+	params.WhitelistedValidators = []types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[0].String(), TargetWeight: sdk.NewInt(10)},
+		{ValidatorAddress: valOpers[1].String(), TargetWeight: sdk.NewInt(10)},
+	}
+	params.RebalancingEpochIdentifier = epochstypes.WeekEpochIdentifier
+	s.keeper.SetParams(s.ctx, params)
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+	s.Require().NoError(s.liquidStaking(s.delAddrs[0], sdk.NewInt(30000)))
+
+	// The added liquid validator isn't rebalanced until the end of the epoch.
+	params.WhitelistedValidators = append(params.WhitelistedValidators,
+		types.WhitelistedValidator{ValidatorAddress: valOpers[2].String(), TargetWeight: sdk.NewInt(10)})
+	s.keeper.SetParams(s.ctx, params)
+	s.Require().Empty(s.keeper.UpdateLiquidValidatorSet(s.ctx))
+	_, found := s.keeper.GetLiquidValidator(s.ctx, valOpers[2])
+	s.Require().True(found)
+	_, found = s.app.StakingKeeper.GetDelegation(s.ctx, types.LiquidStakingProxyAcc, valOpers[2])
+	s.Require().False(found)
+
+	hooks := s.keeper.Hooks()
+	hooks.AfterEpochEnd(s.ctx, epochstypes.DayEpochIdentifier, 1)
+	_, found = s.app.StakingKeeper.GetDelegation(s.ctx, types.LiquidStakingProxyAcc, valOpers[2])
+	s.Require().False(found)
+
+	hooks.AfterEpochEnd(s.ctx, epochstypes.WeekEpochIdentifier, 1)
+	lvState, found := s.keeper.GetLiquidValidatorState(s.ctx, valOpers[2])
+	s.Require().True(found)
+	s.Require().EqualValues(sdk.NewInt(10000), lvState.LiquidTokens)
+	s.completeRedelegationUnbonding()
+
+	// The liquid tokens of a jailed liquid validator are redelegated right away.
+	s.app.StakingKeeper.Jail(s.ctx, sdk.ConsAddress(pks[0].Address()))
+	reds := s.keeper.UpdateLiquidValidatorSet(s.ctx)
+	s.Require().Len(reds, 2)
+	s.Require().Zero(s.redelegationsErrorCount(reds))
+	_, found = s.app.StakingKeeper.GetDelegation(s.ctx, types.LiquidStakingProxyAcc, valOpers[0])
+	s.Require().False(found)
+}
+
+func (s *KeeperTestSuite) TestRebalancingEpochIdentifier_UnknownEpoch() {
+	_, valOpers, _ := s.CreateValidators([]int64{1000000, 1000000, 1000000})
+	s.ctx = s.ctx.WithBlockHeight(100).WithBlockTime(utils.ParseTime("2022-03-01T00:00:00Z"))
+	params := s.keeper.GetParams(s.ctx)
+	params.UnstakeFeeRate = sdk.ZeroDec()
+	params.MinLiquidStakingAmount = sdk.NewInt(10000)
+	params.WhitelistedValidators = []types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[0].String(), TargetWeight: sdk.NewInt(10)},
+		{ValidatorAddress: valOpers[1].String(), TargetWeight: sdk.NewInt(10)},
+	}
+	params.RebalancingEpochIdentifier = "weak"
+	s.keeper.SetParams(s.ctx, params)
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+	s.Require().NoError(s.liquidStaking(s.delAddrs[0], sdk.NewInt(30000)))
+
+	// The added liquid validator is rebalanced right away since there's no
+	// such epoch.
+	params.WhitelistedValidators = append(params.WhitelistedValidators,
+		types.WhitelistedValidator{ValidatorAddress: valOpers[2].String(), TargetWeight: sdk.NewInt(10)})
+	s.keeper.SetParams(s.ctx, params)
+	s.Require().NotEmpty(s.keeper.UpdateLiquidValidatorSet(s.ctx))
+	lvState, found := s.keeper.GetLiquidValidatorState(s.ctx, valOpers[2])
+	s.Require().True(found)
+	s.Require().EqualValues(sdk.NewInt(10000), lvState.LiquidTokens)
+}
+
+func (s *KeeperTestSuite) updateLiquidValidatorStatusEvents() (events []sdk.Event) {
+	for _, ev := range s.ctx.EventManager().Events() {
+		if ev.Type == types.EventTypeUpdateLiquidValidatorStatus {
+			events = append(events, ev)
+		}
+	}
+	return
+}
+
+func (s *KeeperTestSuite) TestRebalancingJailedValidator() {
+	_, valOpers, pks := s.CreateValidators([]int64{1000000, 1000000, 1000000})
+	s.ctx = s.ctx.WithBlockHeight(100).WithBlockTime(utils.ParseTime("2022-03-01T00:00:00Z"))
+	params := s.keeper.GetParams(s.ctx)
+	params.UnstakeFeeRate = sdk.ZeroDec()
+	params.MinLiquidStakingAmount = sdk.NewInt(10000)
+	params.WhitelistedValidators = []types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[0].String(), TargetWeight: sdk.NewInt(10)},
+		{ValidatorAddress: valOpers[1].String(), TargetWeight: sdk.NewInt(10)},
+		{ValidatorAddress: valOpers[2].String(), TargetWeight: sdk.NewInt(10)},
+	}
+	s.keeper.SetParams(s.ctx, params)
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+
+	stakingAmt := sdk.NewInt(30000)
+	s.Require().NoError(s.liquidStaking(s.delAddrs[0], stakingAmt))
+	s.completeRedelegationUnbonding()
+
+	// jail a liquid validator
+	consAddr := sdk.ConsAddress(pks[2].Address())
+	s.app.StakingKeeper.Jail(s.ctx, consAddr)
+	lvState, found := s.keeper.GetLiquidValidatorState(s.ctx, valOpers[2])
+	s.Require().True(found)
+	s.Require().Equal(types.ValidatorStatusJailed, lvState.Status)
+	s.Require().True(lvState.Weight.IsZero())
+
+	// the status transition is emitted and its liquid tokens are redelegated to the other liquid validators
+	s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+	reds := s.keeper.UpdateLiquidValidatorSet(s.ctx)
+	s.Require().Len(reds, 2)
+	s.Require().Zero(s.redelegationsErrorCount(reds))
+	events := s.updateLiquidValidatorStatusEvents()
+	s.Require().Len(events, 1)
+	s.Require().Equal([]abci.EventAttribute{
+		{Key: []byte(types.AttributeKeyLiquidValidator), Value: []byte(valOpers[2].String())},
+		{Key: []byte(types.AttributeKeyPreviousStatus), Value: []byte(types.ValidatorStatusActive.String())},
+		{Key: []byte(types.AttributeKeyStatus), Value: []byte(types.ValidatorStatusJailed.String())},
+	}, events[0].Attributes)
+
+	_, found = s.app.StakingKeeper.GetDelegation(s.ctx, types.LiquidStakingProxyAcc, valOpers[2])
+	s.Require().False(found)
+	totalLiquidTokens, _ := s.keeper.GetAllLiquidValidators(s.ctx).TotalLiquidTokens(s.ctx, s.app.StakingKeeper, false)
+	s.Require().EqualValues(stakingAmt, totalLiquidTokens)
+
+	// the liquid validator is removed since it has no delegation
+	s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+	s.Require().Empty(s.keeper.UpdateLiquidValidatorSet(s.ctx))
+	s.Require().Empty(s.updateLiquidValidatorStatusEvents())
+	_, found = s.keeper.GetLiquidValidator(s.ctx, valOpers[2])
+	s.Require().False(found)
+	s.completeRedelegationUnbonding()
+
+	// the unjailed validator is added again and rebalanced
+	s.app.StakingKeeper.Unjail(s.ctx, consAddr)
+	reds = s.keeper.UpdateLiquidValidatorSet(s.ctx)
+	s.Require().Len(reds, 2)
+	s.Require().Zero(s.redelegationsErrorCount(reds))
+	lvState, found = s.keeper.GetLiquidValidatorState(s.ctx, valOpers[2])
+	s.Require().True(found)
+	s.Require().Equal(types.ValidatorStatusActive, lvState.Status)
+	s.Require().EqualValues(sdk.NewInt(10), lvState.Weight)
+	s.Require().EqualValues(sdk.NewInt(10000), lvState.LiquidTokens)
+}
+
+func (s *KeeperTestSuite) TestDelistViolatingValidators() {
+	_, valOpers, _ := s.CreateValidators([]int64{1000000, 2000000, 3000000})
+	params := s.keeper.GetParams(s.ctx)
+	params.MinLiquidStakingAmount = sdk.NewInt(10000)
+	params.WhitelistedValidators = []types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[0].String(), TargetWeight: sdk.NewInt(10)},
+		{ValidatorAddress: valOpers[1].String(), TargetWeight: sdk.NewInt(10)},
+		{ValidatorAddress: valOpers[2].String(), TargetWeight: sdk.NewInt(10)},
+	}
+	s.keeper.SetParams(s.ctx, params)
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+
+	stakingAmt := sdk.NewInt(30000)
+	s.Require().NoError(s.liquidStaking(s.delAddrs[0], stakingAmt))
+	s.completeRedelegationUnbonding()
+
+	delistEvents := func() (events []sdk.Event) {
+		for _, ev := range s.ctx.EventManager().Events() {
+			if ev.Type == types.EventTypeDelistValidator {
+				events = append(events, ev)
+			}
+		}
+		return
+	}
+
+	// the validator with less self-delegation than MinSelfDelegation is delisted and its liquid tokens are
+	// redelegated to the other liquid validators
+	params = s.keeper.GetParams(s.ctx)
+	params.MinSelfDelegation = sdk.NewInt(1500000)
+	s.keeper.SetParams(s.ctx, params)
+	s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+	reds := s.keeper.UpdateLiquidValidatorSet(s.ctx)
+	s.Require().Len(reds, 2)
+	s.Require().Zero(s.redelegationsErrorCount(reds))
+	events := delistEvents()
+	s.Require().Len(events, 1)
+	s.Require().Equal([]abci.EventAttribute{
+		{Key: []byte(types.AttributeKeyLiquidValidator), Value: []byte(valOpers[0].String())},
+		{Key: []byte(types.AttributeKeyReason), Value: []byte("self delegation 1000000 is less than min self delegation 1500000")},
+	}, events[0].Attributes)
+	s.Require().Equal([]types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[1].String(), TargetWeight: sdk.NewInt(10)},
+		{ValidatorAddress: valOpers[2].String(), TargetWeight: sdk.NewInt(10)},
+	}, s.keeper.GetParams(s.ctx).WhitelistedValidators)
+	_, found := s.app.StakingKeeper.GetDelegation(s.ctx, types.LiquidStakingProxyAcc, valOpers[0])
+	s.Require().False(found)
+	s.completeRedelegationUnbonding()
+
+	// the validator with a higher commission rate than MaxCommissionRate is delisted
+	val, _ := s.app.StakingKeeper.GetValidator(s.ctx, valOpers[2])
+	val.Commission.Rate = sdk.NewDecWithPrec(5, 1)
+	s.app.StakingKeeper.SetValidator(s.ctx, val)
+	params = s.keeper.GetParams(s.ctx)
+	params.MaxCommissionRate = sdk.NewDecWithPrec(2, 1)
+	s.keeper.SetParams(s.ctx, params)
+	s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+	events = delistEvents()
+	s.Require().Len(events, 1)
+	s.Require().Equal([]abci.EventAttribute{
+		{Key: []byte(types.AttributeKeyLiquidValidator), Value: []byte(valOpers[2].String())},
+		{Key: []byte(types.AttributeKeyReason), Value: []byte("commission rate 0.500000000000000000 exceeds max commission rate 0.200000000000000000")},
+	}, events[0].Attributes)
+	s.Require().Equal([]types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[1].String(), TargetWeight: sdk.NewInt(10)},
+	}, s.keeper.GetParams(s.ctx).WhitelistedValidators)
+
+	lvState, found := s.keeper.GetLiquidValidatorState(s.ctx, valOpers[1])
+	s.Require().True(found)
+	s.Require().EqualValues(stakingAmt, lvState.LiquidTokens)
+}
+
+func (s *KeeperTestSuite) TestProtocolCommission() {
+	_, valOpers, _ := s.CreateValidators([]int64{1000000, 1000000})
+	params := s.keeper.GetParams(s.ctx)
+	params.WhitelistedValidators = []types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[0].String(), TargetWeight: sdk.NewInt(10)},
+		{ValidatorAddress: valOpers[1].String(), TargetWeight: sdk.NewInt(10)},
+	}
+	params.RewardCompoundingEpoch = 5
+	params.ProtocolCommissionRate = sdk.NewDecWithPrec(1, 1) // 10%
+	params.ProtocolCommissionDestination = types.ProtocolCommissionDestinationCommunityPool
+	s.keeper.SetParams(s.ctx, params)
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+	s.Require().NoError(s.liquidStaking(s.delAddrs[0], sdk.NewInt(100000000)))
+	s.ctx = s.ctx.WithBlockHeight(s.ctx.BlockHeight() + 1)
+	s.allocateRewards(valOpers[0], sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10000)))
+
+	// the commission is excluded from the net amount before it is taken
+	nas := s.keeper.GetNetAmountState(s.ctx)
+	s.Require().True(nas.TotalRemainingRewards.IsPositive())
+	s.Require().Equal(params.ProtocolCommissionRate.Mul(nas.TotalRemainingRewards), nas.PendingProtocolCommission)
+	s.Require().Equal(nas.TotalLiquidTokens.ToDec().Add(nas.TotalRemainingRewards).Sub(nas.PendingProtocolCommission), nas.NetAmount)
+
+	communityPool := s.app.DistrKeeper.GetFeePoolCommunityCoins(s.ctx)
+	s.ctx = s.ctx.WithBlockHeight(105).WithEventManager(sdk.NewEventManager())
+	liquidstaking.BeginBlocker(s.ctx, s.keeper)
+	s.Require().Len(s.compoundedAmounts(), 2)
+
+	var commission sdk.Coin
+	for _, ev := range s.ctx.EventManager().Events() {
+		if ev.Type == types.EventTypeTakeProtocolCommission {
+			for _, attr := range ev.Attributes {
+				if string(attr.Key) == sdk.AttributeKeyAmount {
+					commission = utils.ParseCoin(string(attr.Value))
+				}
+			}
+		}
+	}
+	s.Require().True(commission.IsPositive())
+	s.Require().True(commission.Amount.ToDec().Sub(nas.PendingProtocolCommission).Abs().LTE(sdk.OneDec()))
+	// the community pool also receives the truncated decimal rewards of the withdrawal
+	s.Require().Equal(
+		commission.Amount,
+		s.app.DistrKeeper.GetFeePoolCommunityCoins(s.ctx).Sub(communityPool).AmountOf(sdk.DefaultBondDenom).TruncateInt())
+
+	// the net amount is not decreased by taking the commission, except for the truncated decimal rewards
+	nas2 := s.keeper.GetNetAmountState(s.ctx)
+	s.Require().True(nas2.PendingProtocolCommission.IsZero())
+	s.Require().True(nas.NetAmount.Sub(nas2.NetAmount).LT(sdk.NewDec(int64(len(valOpers)))))
+}
@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/crescent-network/crescent/v4/x/liquidstaking/types"
+)
+
+// EstimateRewards estimates the current APR of liquid staking.
+// The staking APR assumes that all coins minted by the mint module are
+// distributed to the delegators, and is zero if the mint denom is not the
+// bond denom or there are no bonded tokens.
+// The commission rate is the average of the commission rates of the active
+// liquid validators weighted by their liquid tokens, or by their target
+// weights if nothing is liquid staked yet.
+func (k Keeper) EstimateRewards(ctx sdk.Context) types.QueryRewardsEstimateResponse {
+	params := k.GetParams(ctx)
+
+	stakingAPR := sdk.ZeroDec()
+	bondedTokens := k.stakingKeeper.TotalBondedTokens(ctx)
+	if k.mintKeeper.GetParams(ctx).MintDenom == k.stakingKeeper.BondDenom(ctx) && bondedTokens.IsPositive() {
+		stakingAPR = k.mintKeeper.GetAnnualProvisions(ctx).
+			Mul(sdk.OneDec().Sub(k.distrKeeper.GetCommunityTax(ctx))).
+			QuoInt(bondedTokens)
+	}
+
+	whitelistedValsMap := params.WhitelistedValsMap()
+	activeVals := k.GetActiveLiquidValidators(ctx, whitelistedValsMap)
+	totalLiquidTokens, liquidTokenMap := activeVals.TotalActiveLiquidTokens(ctx, k.stakingKeeper, false)
+	totalWeight := sdk.ZeroInt()
+	weightMap := map[string]sdk.Int{}
+	if totalLiquidTokens.IsPositive() {
+		totalWeight, weightMap = totalLiquidTokens, liquidTokenMap
+	} else {
+		for _, val := range activeVals {
+			weightMap[val.OperatorAddress] = val.GetWeight(whitelistedValsMap, true)
+		}
+		totalWeight = activeVals.TotalWeight(whitelistedValsMap)
+	}
+	commissionRate := sdk.ZeroDec()
+	if totalWeight.IsPositive() {
+		for _, val := range activeVals {
+			validator, _ := k.stakingKeeper.GetValidator(ctx, val.GetOperator())
+			commissionRate = commissionRate.Add(validator.Commission.Rate.MulInt(weightMap[val.OperatorAddress]))
+		}
+		commissionRate = commissionRate.QuoInt(totalWeight)
+	}
+
+	grossAPR := stakingAPR.
+		Mul(sdk.OneDec().Sub(commissionRate)).
+		Mul(sdk.OneDec().Sub(params.ProtocolCommissionRate))
+	// The unstake fee is paid once when unstaking, so the net APR of
+	// a year is the return of unstaking after a year of liquid staking.
+	netAPR := sdk.OneDec().Add(grossAPR).Mul(sdk.OneDec().Sub(params.UnstakeFeeRate)).Sub(sdk.OneDec())
+
+	return types.QueryRewardsEstimateResponse{
+		StakingApr:             stakingAPR,
+		CommissionRate:         commissionRate,
+		ProtocolCommissionRate: params.ProtocolCommissionRate,
+		UnstakeFeeRate:         params.UnstakeFeeRate,
+		GrossApr:               grossAPR,
+		NetApr:                 netAPR,
+	}
+}
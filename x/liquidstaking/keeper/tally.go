@@ -64,6 +64,21 @@ func (k Keeper) TokenAmountFromFarmingPositions(ctx sdk.Context, addr sdk.AccAdd
 	return tokenAmount
 }
 
+// TokenAmountFromOpenOrders returns the amount of the target denom escrowed
+// as the remaining offer coins of the open orders of the addr in the
+// liquidity module, so that the coins don't lose their voting power while
+// the orders are open.
+func (k Keeper) TokenAmountFromOpenOrders(ctx sdk.Context, addr sdk.AccAddress, targetDenom string) sdk.Int {
+	tokenAmount := sdk.ZeroInt()
+	_ = k.liquidityKeeper.IterateOrdersByOrderer(ctx, addr, func(order liquiditytypes.Order) (stop bool, err error) {
+		if order.Status.IsMatchable() && order.RemainingOfferCoin.Denom == targetDenom {
+			tokenAmount = tokenAmount.Add(order.RemainingOfferCoin.Amount)
+		}
+		return false, nil
+	})
+	return tokenAmount
+}
+
 // TokenSharePerPoolCoin returns token share of the target denom of a pool coin
 func (k Keeper) TokenSharePerPoolCoin(ctx sdk.Context, targetDenom, poolCoinDenom string) sdk.Dec {
 	poolId, err := liquiditytypes.ParsePoolCoinDenom(poolCoinDenom)
@@ -177,6 +192,11 @@ func (k Keeper) CalcLiquidStakingVotingPower(ctx sdk.Context, addr sdk.AccAddres
 		bTokenAmount = bTokenAmount.Add(tokenAmount)
 	}
 
+	tokenAmount = k.TokenAmountFromOpenOrders(ctx, addr, liquidBondDenom)
+	if tokenAmount.IsPositive() {
+		bTokenAmount = bTokenAmount.Add(tokenAmount)
+	}
+
 	if bTokenAmount.IsPositive() {
 		return types.BTokenToNativeToken(bTokenAmount, bTokenTotalSupply, totalBondedLiquidTokens.ToDec()).TruncateInt()
 	} else {
@@ -235,7 +255,7 @@ func (k Keeper) SetLiquidStakingVotingPowers(ctx sdk.Context, votes govtypes.Vot
 		}
 	}
 
-	// add owned btoken amount of farming positions on bTokenOwnMap
+	// add owned btoken amount of farming positions and open orders on bTokenOwnMap
 	for _, vote := range votes {
 		voter, err := sdk.AccAddressFromBech32(vote.Voter)
 		if err != nil {
@@ -245,6 +265,10 @@ func (k Keeper) SetLiquidStakingVotingPowers(ctx sdk.Context, votes govtypes.Vot
 		if tokenAmount.IsPositive() {
 			bTokenOwnMap.AddOrSet(vote.Voter, tokenAmount)
 		}
+		tokenAmount = k.TokenAmountFromOpenOrders(ctx, voter, liquidBondDenom)
+		if tokenAmount.IsPositive() {
+			bTokenOwnMap.AddOrSet(vote.Voter, tokenAmount)
+		}
 	}
 
 	for voter, bTokenAmount := range bTokenOwnMap {
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
@@ -9,6 +10,7 @@ import (
 
 	chain "github.com/crescent-network/crescent/v4/app"
 	utils "github.com/crescent-network/crescent/v4/types"
+	liquiditytypes "github.com/crescent-network/crescent/v4/x/liquidity/types"
 	"github.com/crescent-network/crescent/v4/x/liquidstaking/types"
 	lpfarmtypes "github.com/crescent-network/crescent/v4/x/lpfarm/types"
 )
@@ -332,6 +334,15 @@ func (s *KeeperTestSuite) TestGetVotingPower() {
 	s.Require().NoError(err)
 	s.assertVotingPower(delE, sdk.NewInt(60000000), sdk.ZeroInt(), sdk.ZeroInt())
 	s.assertVotingPower(vals[3], sdk.ZeroInt(), delEbToken, sdk.NewInt(130000000)) // self bonding 10000000 + normal staking 60000000 + liquid staking 240000000/4
+
+	// Test remaining offer coin of open orders offering bToken
+	_, err = s.app.LiquidityKeeper.LimitOrder(s.ctx, liquiditytypes.NewMsgLimitOrder(
+		vals[3], pair1.Id, liquiditytypes.OrderDirectionSell, sdk.NewCoin(liquidBondDenom, delEbToken), sdk.DefaultBondDenom,
+		sdk.MustNewDecFromStr("1.2"), delEbToken, time.Hour))
+	s.Require().NoError(err)
+	s.Require().True(s.app.BankKeeper.GetBalance(s.ctx, vals[3], liquidBondDenom).IsZero())
+	s.Require().Equal(delEbToken, s.keeper.TokenAmountFromOpenOrders(s.ctx, vals[3], liquidBondDenom))
+	s.assertVotingPower(vals[3], sdk.ZeroInt(), delEbToken, sdk.NewInt(130000000))
 }
 
 // test Liquid Staking gov power
@@ -0,0 +1,127 @@
+package keeper
+
+import (
+	"time"
+
+	gogotypes "github.com/gogo/protobuf/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/crescent-network/crescent/v4/x/liquidstaking/types"
+)
+
+// GetLastUnstakingRecordId returns the last unstaking record id.
+func (k Keeper) GetLastUnstakingRecordId(ctx sdk.Context) (id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LastUnstakingRecordIdKey)
+	if bz == nil {
+		id = 0 // initialize the unstaking record id
+	} else {
+		var val gogotypes.UInt64Value
+		k.cdc.MustUnmarshal(bz, &val)
+		id = val.GetValue()
+	}
+	return
+}
+
+// SetLastUnstakingRecordId stores the last unstaking record id.
+func (k Keeper) SetLastUnstakingRecordId(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&gogotypes.UInt64Value{Value: id})
+	store.Set(types.LastUnstakingRecordIdKey, bz)
+}
+
+// GetNextUnstakingRecordIdWithUpdate increments the last unstaking record id
+// and returns it.
+func (k Keeper) GetNextUnstakingRecordIdWithUpdate(ctx sdk.Context) uint64 {
+	id := k.GetLastUnstakingRecordId(ctx) + 1
+	k.SetLastUnstakingRecordId(ctx, id)
+	return id
+}
+
+// GetUnstakingRecord returns the unstaking record of the liquid staker.
+func (k Keeper) GetUnstakingRecord(ctx sdk.Context, liquidStaker sdk.AccAddress, id uint64) (record types.UnstakingRecord, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetUnstakingRecordKey(liquidStaker, id))
+	if bz == nil {
+		return
+	}
+	record = types.MustUnmarshalUnstakingRecord(k.cdc, bz)
+	return record, true
+}
+
+// SetUnstakingRecord stores the unstaking record.
+func (k Keeper) SetUnstakingRecord(ctx sdk.Context, record types.UnstakingRecord) {
+	store := ctx.KVStore(k.storeKey)
+	bz := types.MustMarshalUnstakingRecord(k.cdc, &record)
+	store.Set(types.GetUnstakingRecordKey(record.GetLiquidStaker(), record.Id), bz)
+}
+
+// DeleteUnstakingRecord deletes the unstaking record.
+func (k Keeper) DeleteUnstakingRecord(ctx sdk.Context, record types.UnstakingRecord) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetUnstakingRecordKey(record.GetLiquidStaker(), record.Id))
+}
+
+// IterateAllUnstakingRecords iterates through all unstaking records and
+// calls cb for each record.
+func (k Keeper) IterateAllUnstakingRecords(ctx sdk.Context, cb func(record types.UnstakingRecord) (stop bool)) {
+	k.iterateUnstakingRecords(ctx, types.UnstakingRecordKeyPrefix, cb)
+}
+
+// IterateUnstakingRecordsByLiquidStaker iterates through all unstaking
+// records of the liquid staker and calls cb for each record.
+func (k Keeper) IterateUnstakingRecordsByLiquidStaker(ctx sdk.Context, liquidStaker sdk.AccAddress, cb func(record types.UnstakingRecord) (stop bool)) {
+	k.iterateUnstakingRecords(ctx, types.GetUnstakingRecordsByLiquidStakerPrefix(liquidStaker), cb)
+}
+
+func (k Keeper) iterateUnstakingRecords(ctx sdk.Context, prefix []byte, cb func(record types.UnstakingRecord) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		record := types.MustUnmarshalUnstakingRecord(k.cdc, iterator.Value())
+		if cb(record) {
+			break
+		}
+	}
+}
+
+// GetAllUnstakingRecords returns all unstaking records, used during genesis dump.
+func (k Keeper) GetAllUnstakingRecords(ctx sdk.Context) (records []types.UnstakingRecord) {
+	records = []types.UnstakingRecord{}
+	k.IterateAllUnstakingRecords(ctx, func(record types.UnstakingRecord) (stop bool) {
+		records = append(records, record)
+		return false
+	})
+	return records
+}
+
+// GetUnstakingRecordsByLiquidStaker returns the unstaking records of the liquid
+// staker which are not completed yet.
+func (k Keeper) GetUnstakingRecordsByLiquidStaker(ctx sdk.Context, liquidStaker sdk.AccAddress) (records []types.UnstakingRecord) {
+	records = []types.UnstakingRecord{}
+	k.IterateUnstakingRecordsByLiquidStaker(ctx, liquidStaker, func(record types.UnstakingRecord) (stop bool) {
+		if !record.IsCompleted(ctx.BlockTime()) {
+			records = append(records, record)
+		}
+		return false
+	})
+	return records
+}
+
+// DeleteCompletedUnstakingRecords deletes unstaking records whose unbondings
+// are completed by the time t.
+func (k Keeper) DeleteCompletedUnstakingRecords(ctx sdk.Context, t time.Time) {
+	var completed []types.UnstakingRecord
+	k.IterateAllUnstakingRecords(ctx, func(record types.UnstakingRecord) (stop bool) {
+		if record.IsCompleted(t) {
+			completed = append(completed, record)
+		}
+		return false
+	})
+	for _, record := range completed {
+		k.DeleteUnstakingRecord(ctx, record)
+	}
+}
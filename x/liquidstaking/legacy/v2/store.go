@@ -0,0 +1,24 @@
+package v2
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/crescent-network/crescent/v4/x/liquidstaking/types"
+)
+
+func MigrateStore(ctx sdk.Context, paramSpace paramtypes.Subspace) error {
+	migrateParamsStore(ctx, paramSpace)
+	return nil
+}
+
+// migrateParamsStore sets the params added since v1 to their defaults, since
+// getting the params panics if any of them is missing.
+func migrateParamsStore(ctx sdk.Context, paramSpace paramtypes.Subspace) {
+	defaultParams := types.DefaultParams()
+	for _, pair := range defaultParams.ParamSetPairs() {
+		if !paramSpace.Has(ctx, pair.Key) {
+			paramSpace.Set(ctx, pair.Key, pair.Value)
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package v2_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/crescent-network/crescent/v4/app"
+	v2 "github.com/crescent-network/crescent/v4/x/liquidstaking/legacy/v2"
+	"github.com/crescent-network/crescent/v4/x/liquidstaking/types"
+)
+
+func TestStoreMigration(t *testing.T) {
+	encCfg := app.MakeTestEncodingConfig()
+	key := sdk.NewKVStoreKey(types.ModuleName)
+	tKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(key, tKey)
+	paramSpace := paramtypes.NewSubspace(encCfg.Marshaler, encCfg.Amino, key, tKey, types.ModuleName)
+	paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+
+	// Set the params stored by v1 only.
+	whitelistedValidators := []types.WhitelistedValidator{
+		{ValidatorAddress: "crevaloper1zaavvzxez0elundtn32qnk9lkm8kmcszuwx9jz", TargetWeight: sdk.NewInt(10)},
+	}
+	paramSpace.Set(ctx, types.KeyLiquidBondDenom, "bstake")
+	paramSpace.Set(ctx, types.KeyWhitelistedValidators, whitelistedValidators)
+	paramSpace.Set(ctx, types.KeyUnstakeFeeRate, sdk.MustNewDecFromStr("0.002"))
+	paramSpace.Set(ctx, types.KeyMinLiquidStakingAmount, sdk.NewInt(2000000))
+	require.False(t, paramSpace.Has(ctx, types.KeyRebalancingTrigger))
+	require.Panics(t, func() {
+		var params types.Params
+		paramSpace.GetParamSet(ctx, &params)
+	})
+
+	// Run migrations.
+	err := v2.MigrateStore(ctx, paramSpace)
+	require.NoError(t, err)
+
+	// Make sure the new params are set to their defaults, leaving the old ones as they were.
+	var params types.Params
+	paramSpace.GetParamSet(ctx, &params)
+	expected := types.DefaultParams()
+	expected.LiquidBondDenom = "bstake"
+	expected.WhitelistedValidators = whitelistedValidators
+	expected.UnstakeFeeRate = sdk.MustNewDecFromStr("0.002")
+	expected.MinLiquidStakingAmount = sdk.NewInt(2000000)
+	require.Equal(t, expected, params)
+}
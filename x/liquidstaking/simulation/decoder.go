@@ -21,6 +21,12 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvA.Value, &cB)
 			return fmt.Sprintf("%v\n%v", cA, cB)
 
+		case bytes.Equal(kvA.Key[:1], types.NetAmountSnapshotKeyPrefix):
+			var sA, sB types.NetAmountSnapshot
+			cdc.MustUnmarshal(kvA.Value, &sA)
+			cdc.MustUnmarshal(kvB.Value, &sB)
+			return fmt.Sprintf("%v\n%v", sA, sB)
+
 		default:
 			panic(fmt.Sprintf("invalid liquidstaking key prefix %X", kvA.Key[:1]))
 		}
@@ -22,6 +22,7 @@ const (
 	OpWeightSimulateDeleteWhitelistValidatorsProposal = "op_weight_delete_whitelist_validators_proposal"
 	OpWeightCompleteRedelegationUnbonding             = "op_weight_complete_redelegation_unbonding"
 	OpWeightTallyWithLiquidStaking                    = "op_weight_tally_with_liquid_staking"
+	OpWeightSlashLiquidValidator                      = "op_weight_slash_liquid_validator"
 	MaxWhitelistValidators                            = 10
 )
 
@@ -53,6 +54,11 @@ func ProposalContents(ak types.AccountKeeper, bk types.BankKeeper, sk types.Stak
 			params.DefaultWeightTallyWithLiquidStaking,
 			SimulateTallyWithLiquidStaking(ak, bk, gk),
 		),
+		simulation.NewWeightedProposalContent(
+			OpWeightSlashLiquidValidator,
+			params.DefaultWeightSlashLiquidValidator,
+			SimulateSlashLiquidValidator(sk, k),
+		),
 	}
 }
 
@@ -179,3 +185,21 @@ func SimulateTallyWithLiquidStaking(ak types.AccountKeeper, bk types.BankKeeper,
 		return nil
 	}
 }
+
+// SimulateSlashLiquidValidator mocking slashing of a random active liquid validator by a random fraction, which resets
+// the mint rate bound and makes the liquid tokens of the liquid validators deviate from their target weights.
+func SimulateSlashLiquidValidator(sk types.StakingKeeper, k keeper.Keeper) simtypes.ContentSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) simtypes.Content {
+		val, found := keeper.RandomActiveLiquidValidator(r, ctx, k, sk)
+		if !found {
+			return nil
+		}
+		consAddr, err := val.GetConsAddr()
+		if err != nil {
+			panic(err)
+		}
+		slashFactor := simtypes.RandomDecAmount(r, sdk.NewDecWithPrec(5, 2))
+		sk.Slash(ctx, consAddr, ctx.BlockHeight(), val.ConsensusPower(sdk.DefaultPowerReduction), slashFactor)
+		return nil
+	}
+}
@@ -6,6 +6,8 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
@@ -47,13 +49,14 @@ func TestProposalContents(t *testing.T) {
 
 	// execute ProposalContents function
 	weightedProposalContent := simulation.ProposalContents(app.AccountKeeper, app.BankKeeper, app.StakingKeeper, app.GovKeeper, app.LiquidStakingKeeper)
-	require.Len(t, weightedProposalContent, 5)
+	require.Len(t, weightedProposalContent, 6)
 
 	w0 := weightedProposalContent[0]
 	w1 := weightedProposalContent[1]
 	w2 := weightedProposalContent[2]
 	w3 := weightedProposalContent[3]
 	w4 := weightedProposalContent[4]
+	w5 := weightedProposalContent[5]
 
 	// tests w0 interface:
 	require.Equal(t, simulation.OpWeightSimulateAddWhitelistValidatorsProposal, w0.AppParamsKey())
@@ -75,6 +78,10 @@ func TestProposalContents(t *testing.T) {
 	require.Equal(t, simulation.OpWeightTallyWithLiquidStaking, w4.AppParamsKey())
 	require.Equal(t, params.DefaultWeightTallyWithLiquidStaking, w4.DefaultWeight())
 
+	// tests w5 interface:
+	require.Equal(t, simulation.OpWeightSlashLiquidValidator, w5.AppParamsKey())
+	require.Equal(t, params.DefaultWeightSlashLiquidValidator, w5.DefaultWeight())
+
 	content0 := w0.ContentSimulatorFn()(r, ctx, accounts)
 	require.Nil(t, content0)
 
@@ -89,4 +96,43 @@ func TestProposalContents(t *testing.T) {
 
 	content4 := w4.ContentSimulatorFn()(r, ctx, accounts)
 	require.Nil(t, content4)
+
+	content5 := w5.ContentSimulatorFn()(r, ctx, accounts)
+	require.Nil(t, content5)
+}
+
+func TestSimulateSlashLiquidValidator(t *testing.T) {
+	app, ctx := createTestApp(false)
+	r := rand.New(rand.NewSource(1))
+	accounts := getTestingAccounts(t, r, app, ctx, 3)
+
+	// create a bonded validator and whitelist it
+	valAddr := sdk.ValAddress(accounts[0].Address)
+	val, err := stakingtypes.NewValidator(valAddr, accounts[0].ConsKey.PubKey(), stakingtypes.Description{})
+	require.NoError(t, err)
+	app.StakingKeeper.SetValidator(ctx, val)
+	require.NoError(t, app.StakingKeeper.SetValidatorByConsAddr(ctx, val))
+	app.StakingKeeper.SetNewValidatorByPowerIndex(ctx, val)
+	app.StakingKeeper.AfterValidatorCreated(ctx, valAddr)
+	_, err = app.StakingKeeper.Delegate(ctx, accounts[0].Address, sdk.NewInt(100000000), stakingtypes.Unbonded, val, true)
+	require.NoError(t, err)
+	staking.EndBlocker(ctx, *app.StakingKeeper)
+	vals := app.StakingKeeper.GetBondedValidatorsByPower(ctx)
+	require.Len(t, vals, 1)
+	param := app.LiquidStakingKeeper.GetParams(ctx)
+	param.WhitelistedValidators = []types.WhitelistedValidator{
+		{ValidatorAddress: vals[0].OperatorAddress, TargetWeight: sdk.OneInt()},
+	}
+	app.LiquidStakingKeeper.SetParams(ctx, param)
+	app.LiquidStakingKeeper.UpdateLiquidValidatorSet(ctx)
+	app.LiquidStakingKeeper.UpdateMintRateBound(ctx)
+
+	content := simulation.SimulateSlashLiquidValidator(app.StakingKeeper, app.LiquidStakingKeeper)(r, ctx, accounts)
+	require.Nil(t, content)
+
+	val, found := app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	require.True(t, val.Tokens.LT(vals[0].Tokens))
+	_, found = app.LiquidStakingKeeper.GetMintRateBound(ctx)
+	require.False(t, found)
 }
@@ -5,6 +5,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 // RegisterLegacyAminoCodec registers the necessary x/liquidstaking interfaces and concrete types
@@ -12,6 +13,13 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgLiquidStake{}, "liquidstaking/MsgLiquidStake", nil)
 	cdc.RegisterConcrete(&MsgLiquidUnstake{}, "liquidstaking/MsgLiquidUnstake", nil)
+	cdc.RegisterConcrete(&MsgArbLiquidStake{}, "liquidstaking/MsgArbLiquidStake", nil)
+	cdc.RegisterConcrete(&MsgLiquidUnstakeInstant{}, "liquidstaking/MsgLiquidUnstakeInstant", nil)
+	cdc.RegisterConcrete(&MsgInterchainLiquidStake{}, "liquidstaking/MsgInterchainLiquidStake", nil)
+	cdc.RegisterConcrete(&MsgUpdateHostZoneState{}, "liquidstaking/MsgUpdateHostZoneState", nil)
+	cdc.RegisterConcrete(&MsgUpdateWhitelistedValidatorWeight{}, "liquidstaking/MsgUpdateWhitelistedValidatorWeight", nil)
+	cdc.RegisterConcrete(&LiquidStakingWhitelistProposal{}, "liquidstaking/LiquidStakingWhitelistProposal", nil)
+	cdc.RegisterConcrete(&RegisterHostZoneProposal{}, "liquidstaking/RegisterHostZoneProposal", nil)
 }
 
 // RegisterInterfaces registers the x/liquidstaking interfaces types with the interface registry.
@@ -20,6 +28,17 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgLiquidStake{},
 		&MsgLiquidUnstake{},
+		&MsgArbLiquidStake{},
+		&MsgLiquidUnstakeInstant{},
+		&MsgInterchainLiquidStake{},
+		&MsgUpdateHostZoneState{},
+		&MsgUpdateWhitelistedValidatorWeight{},
+	)
+
+	registry.RegisterImplementations(
+		(*govtypes.Content)(nil),
+		&LiquidStakingWhitelistProposal{},
+		&RegisterHostZoneProposal{},
 	)
 }
 
@@ -16,4 +16,12 @@ var (
 	ErrInsufficientProxyAccBalance     = sdkerrors.Register(ModuleName, 11, "insufficient liquid tokens or balance of proxy account, need to wait for new liquid validator to be added or unbonding of proxy account to be completed")
 	ErrTooSmallLiquidStakingAmount     = sdkerrors.Register(ModuleName, 12, "liquid staking amount is too small, the result becomes zero")
 	ErrTooSmallLiquidUnstakingAmount   = sdkerrors.Register(ModuleName, 13, "liquid unstaking amount is too small, the result becomes zero")
+	ErrInvalidArbPair                  = sdkerrors.Register(ModuleName, 14, "pair must consist of liquid bond denom as base and bond denom as quote")
+	ErrNoArbOpportunity                = sdkerrors.Register(ModuleName, 15, "no arbitrage opportunity")
+	ErrNoPairLastPrice                 = sdkerrors.Register(ModuleName, 16, "pair has no last price")
+	ErrHostZoneNotFound                = sdkerrors.Register(ModuleName, 17, "host zone not found")
+	ErrHostZoneAlreadyExists           = sdkerrors.Register(ModuleName, 18, "host zone already exists")
+	ErrPacketInFlight                  = sdkerrors.Register(ModuleName, 19, "packet to the host chain is in flight")
+	ErrInvalidHostZoneProof            = sdkerrors.Register(ModuleName, 20, "invalid host chain state proof")
+	ErrInterchainLiquidStakingDisabled = sdkerrors.Register(ModuleName, 21, "interchain liquid staking is disabled")
 )
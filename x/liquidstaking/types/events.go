@@ -2,13 +2,28 @@ package types
 
 // Event types for the liquidstaking module.
 const (
-	EventTypeMsgLiquidStake             = TypeMsgLiquidStake
-	EventTypeMsgLiquidUnstake           = TypeMsgLiquidUnstake
-	EventTypeAddLiquidValidator         = "add_liquid_validator"
-	EventTypeRemoveLiquidValidator      = "remove_liquid_validator"
-	EventTypeBeginRebalancing           = "begin_rebalancing"
-	EventTypeReStake                    = "re_stake"
-	EventTypeUnbondInactiveLiquidTokens = "unbond_inactive_liquid_tokens"
+	EventTypeMsgLiquidStake                      = TypeMsgLiquidStake
+	EventTypeMsgLiquidUnstake                    = TypeMsgLiquidUnstake
+	EventTypeMsgArbLiquidStake                   = TypeMsgArbLiquidStake
+	EventTypeMsgLiquidUnstakeInstant             = TypeMsgLiquidUnstakeInstant
+	EventTypeMsgInterchainLiquidStake            = TypeMsgInterchainLiquidStake
+	EventTypeMsgUpdateHostZoneState              = TypeMsgUpdateHostZoneState
+	EventTypeMsgUpdateWhitelistedValidatorWeight = TypeMsgUpdateWhitelistedValidatorWeight
+	EventTypeAddLiquidValidator                  = "add_liquid_validator"
+	EventTypeRemoveLiquidValidator               = "remove_liquid_validator"
+	EventTypeBeginRebalancing                    = "begin_rebalancing"
+	EventTypeReStake                             = "re_stake"
+	EventTypeUnbondInactiveLiquidTokens          = "unbond_inactive_liquid_tokens"
+	EventTypeUpdateWhitelistedValidators         = "update_whitelisted_validators"
+	EventTypeCompoundRewards                     = "compound_rewards"
+	EventTypeUpdateLiquidValidatorStatus         = "update_liquid_validator_status"
+	EventTypeRegisterHostZone                    = "register_host_zone"
+	EventTypeOpenHostZoneICA                     = "open_host_zone_ica"
+	EventTypeTransferToHostZone                  = "transfer_to_host_zone"
+	EventTypeDelegateOnHostZone                  = "delegate_on_host_zone"
+	EventTypeHostZonePacketAcknowledged          = "host_zone_packet_acknowledged"
+	EventTypeTakeProtocolCommission              = "take_protocol_commission"
+	EventTypeDelistValidator                     = "delist_validator"
 
 	AttributeKeyDelegator             = "delegator"
 	AttributeKeyNewShares             = "new_shares"
@@ -19,6 +34,26 @@ const (
 	AttributeKeyLiquidValidator       = "liquid_validator"
 	AttributeKeyRedelegationCount     = "redelegation_count"
 	AttributeKeyRedelegationFailCount = "redelegation_fail_count"
+	AttributeKeyArbitrager            = "arbitrager"
+	AttributeKeyPairId                = "pair_id"
+	AttributeKeyOrderId               = "order_id"
+	AttributeKeyOrderDirection        = "order_direction"
+	AttributeKeyOrderPrice            = "order_price"
+	AttributeKeyWhitelistedValidators = "whitelisted_validators"
+	AttributeKeyDelistedValidators    = "delisted_validators"
+	AttributeKeyPreviousStatus        = "previous_status"
+	AttributeKeyStatus                = "status"
+	AttributeKeyChainId               = "chain_id"
+	AttributeKeyBTokenDenom           = "btoken_denom"
+	AttributeKeyICAAddress            = "ica_address"
+	AttributeKeyPacketSequence        = "packet_sequence"
+	AttributeKeySuccess               = "success"
+	AttributeKeyProofHeight           = "proof_height"
+	AttributeKeyIdleAmount            = "idle_amount"
+	AttributeKeyDelegatedAmount       = "delegated_amount"
+	AttributeKeyDestination           = "destination"
+	AttributeKeyTargetWeight          = "target_weight"
+	AttributeKeyReason                = "reason"
 
 	AttributeValueCategory = ModuleName
 )
@@ -5,12 +5,20 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v3/modules/core/exported"
 	abci "github.com/tendermint/tendermint/abci/types"
 
+	epochstypes "github.com/crescent-network/crescent/v4/x/epochs/types"
 	liquiditytypes "github.com/crescent-network/crescent/v4/x/liquidity/types"
 	lpfarmtypes "github.com/crescent-network/crescent/v4/x/lpfarm/types"
+	minttypes "github.com/crescent-network/crescent/v4/x/mint/types"
 )
 
 // BankKeeper defines the expected bank send keeper
@@ -24,6 +32,9 @@ type BankKeeper interface {
 	BurnCoins(ctx sdk.Context, name string, amt sdk.Coins) error
 	MintCoins(ctx sdk.Context, name string, amt sdk.Coins) error
 	SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	BlockedAddr(addr sdk.AccAddress) bool
 }
 
 // AccountKeeper defines the expected account keeper
@@ -43,6 +54,7 @@ type StakingKeeper interface {
 	GetBondedValidatorsByPower(ctx sdk.Context) []stakingtypes.Validator
 
 	GetLastTotalPower(ctx sdk.Context) sdk.Int
+	TotalBondedTokens(ctx sdk.Context) sdk.Int
 	GetLastValidatorPower(ctx sdk.Context, valAddr sdk.ValAddress) int64
 
 	Delegation(sdk.Context, sdk.AccAddress, sdk.ValAddress) stakingtypes.DelegationI
@@ -78,6 +90,7 @@ type StakingKeeper interface {
 	BlockValidatorUpdates(ctx sdk.Context) []abci.ValidatorUpdate
 	HasMaxUnbondingDelegationEntries(ctx sdk.Context,
 		delegatorAddr sdk.AccAddress, validatorAddr sdk.ValAddress) bool
+	Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeight int64, power int64, slashFactor sdk.Dec)
 }
 
 // GovKeeper expected gov keeper (noalias)
@@ -96,6 +109,19 @@ type DistrKeeper interface {
 	IncrementValidatorPeriod(ctx sdk.Context, val stakingtypes.ValidatorI) uint64
 	CalculateDelegationRewards(ctx sdk.Context, val stakingtypes.ValidatorI, del stakingtypes.DelegationI, endingPeriod uint64) (rewards sdk.DecCoins)
 	WithdrawDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (sdk.Coins, error)
+	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
+	GetCommunityTax(ctx sdk.Context) (percent sdk.Dec)
+}
+
+// MintKeeper expected mint keeper (noalias)
+type MintKeeper interface {
+	GetParams(ctx sdk.Context) (params minttypes.Params)
+	GetAnnualProvisions(ctx sdk.Context) sdk.Dec
+}
+
+// EpochsKeeper expected epochs keeper (noalias)
+type EpochsKeeper interface {
+	GetEpochInfo(ctx sdk.Context, identifier string) (info epochstypes.EpochInfo, found bool)
 }
 
 // Liquidity expected liquidity keeper (noalias)
@@ -105,12 +131,16 @@ type LiquidityKeeper interface {
 	GetPoolBalances(ctx sdk.Context, pool liquiditytypes.Pool) (rx sdk.Coin, ry sdk.Coin)
 	GetPoolCoinSupply(ctx sdk.Context, pool liquiditytypes.Pool) sdk.Int
 	IterateAllPools(ctx sdk.Context, cb func(pool liquiditytypes.Pool) (stop bool, err error)) error
+	IterateOrdersByOrderer(ctx sdk.Context, orderer sdk.AccAddress, cb func(order liquiditytypes.Order) (stop bool, err error)) error
+	PriceLimits(ctx sdk.Context, pair liquiditytypes.Pair) (lowest, highest sdk.Dec)
+	LimitOrder(ctx sdk.Context, msg *liquiditytypes.MsgLimitOrder) (liquiditytypes.Order, error)
 }
 
 // LPFarmKeeper defines expected lpfarm keeper
 type LPFarmKeeper interface {
 	GetPosition(ctx sdk.Context, farmerAddr sdk.AccAddress, denom string) (lpfarmtypes.Position, bool)
 	IteratePositionsByFarmer(ctx sdk.Context, farmerAddr sdk.AccAddress, cb func(position lpfarmtypes.Position) bool)
+	Farm(ctx sdk.Context, farmerAddr sdk.AccAddress, coin sdk.Coin) (withdrawnRewards sdk.Coins, err error)
 }
 
 // SlashingKeeper expected slashing keeper (noalias)
@@ -128,3 +158,46 @@ type StakingHooks interface {
 	AfterDelegationModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress)
 	BeforeValidatorSlashed(ctx sdk.Context, valAddr sdk.ValAddress, fraction sdk.Dec)
 }
+
+// ClientKeeper defines the expected IBC client keeper
+type ClientKeeper interface {
+	GetClientState(ctx sdk.Context, clientID string) (ibcexported.ClientState, bool)
+	GetClientConsensusState(ctx sdk.Context, clientID string, height ibcexported.Height) (ibcexported.ConsensusState, bool)
+}
+
+// ConnectionKeeper defines the expected IBC connection keeper
+type ConnectionKeeper interface {
+	GetConnection(ctx sdk.Context, connectionID string) (connectiontypes.ConnectionEnd, bool)
+}
+
+// ChannelKeeper defines the expected IBC channel keeper
+type ChannelKeeper interface {
+	GetChannel(ctx sdk.Context, portID, channelID string) (channeltypes.Channel, bool)
+	GetNextSequenceSend(ctx sdk.Context, portID, channelID string) (uint64, bool)
+}
+
+// TransferKeeper defines the expected IBC transfer keeper
+type TransferKeeper interface {
+	SendTransfer(
+		ctx sdk.Context, sourcePort, sourceChannel string, token sdk.Coin, sender sdk.AccAddress, receiver string,
+		timeoutHeight clienttypes.Height, timeoutTimestamp uint64,
+	) error
+}
+
+// ICAControllerKeeper defines the expected interchain accounts controller keeper
+type ICAControllerKeeper interface {
+	RegisterInterchainAccount(ctx sdk.Context, connectionID, owner string) error
+	GetActiveChannelID(ctx sdk.Context, connectionID, portID string) (string, bool)
+	GetInterchainAccountAddress(ctx sdk.Context, connectionID, portID string) (string, bool)
+	SendTx(
+		ctx sdk.Context, chanCap *capabilitytypes.Capability, connectionID, portID string,
+		icaPacketData icatypes.InterchainAccountPacketData, timeoutTimestamp uint64,
+	) (uint64, error)
+}
+
+// ScopedKeeper defines the expected scoped capability keeper
+type ScopedKeeper interface {
+	GetCapability(ctx sdk.Context, name string) (*capabilitytypes.Capability, bool)
+	AuthenticateCapability(ctx sdk.Context, cap *capabilitytypes.Capability, name string) bool
+	ClaimCapability(ctx sdk.Context, cap *capabilitytypes.Capability, name string) error
+}
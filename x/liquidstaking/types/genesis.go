@@ -1,12 +1,19 @@
 package types
 
-import sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+import (
+	"fmt"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
 
 // NewGenesisState returns new GenesisState instance.
 func NewGenesisState(params Params, liquidValidators []LiquidValidator) *GenesisState {
 	return &GenesisState{
-		Params:           params,
-		LiquidValidators: liquidValidators,
+		Params:             params,
+		LiquidValidators:   liquidValidators,
+		UnstakingRecords:   []UnstakingRecord{},
+		NetAmountSnapshots: []NetAmountSnapshot{},
+		HostZones:          []HostZone{},
 	}
 }
 
@@ -30,5 +37,43 @@ func ValidateGenesis(data GenesisState) error {
 				"invalid liquid validator %s: %v", lv, err)
 		}
 	}
+	recordIds := map[uint64]struct{}{}
+	for _, record := range data.UnstakingRecords {
+		if err := record.Validate(); err != nil {
+			return fmt.Errorf("invalid unstaking record %d: %w", record.Id, err)
+		}
+		if record.Id > data.LastUnstakingRecordId {
+			return fmt.Errorf("unstaking record %d has id greater than the last unstaking record id %d", record.Id, data.LastUnstakingRecordId)
+		}
+		if _, ok := recordIds[record.Id]; ok {
+			return fmt.Errorf("duplicate unstaking record id %d", record.Id)
+		}
+		recordIds[record.Id] = struct{}{}
+	}
+	snapshotHeights := map[int64]struct{}{}
+	for _, snapshot := range data.NetAmountSnapshots {
+		if err := snapshot.Validate(); err != nil {
+			return fmt.Errorf("invalid net amount snapshot at height %d: %w", snapshot.Height, err)
+		}
+		if _, ok := snapshotHeights[snapshot.Height]; ok {
+			return fmt.Errorf("duplicate net amount snapshot height %d", snapshot.Height)
+		}
+		snapshotHeights[snapshot.Height] = struct{}{}
+	}
+	chainIDs := map[string]struct{}{}
+	bTokenDenoms := map[string]struct{}{data.Params.LiquidBondDenom: {}}
+	for _, zone := range data.HostZones {
+		if err := zone.Validate(); err != nil {
+			return fmt.Errorf("invalid host zone %s: %w", zone.ChainId, err)
+		}
+		if _, ok := chainIDs[zone.ChainId]; ok {
+			return fmt.Errorf("duplicate host zone chain id %s", zone.ChainId)
+		}
+		chainIDs[zone.ChainId] = struct{}{}
+		if _, ok := bTokenDenoms[zone.BtokenDenom]; ok {
+			return fmt.Errorf("duplicate btoken denom %s of host zone %s", zone.BtokenDenom, zone.ChainId)
+		}
+		bTokenDenoms[zone.BtokenDenom] = struct{}{}
+	}
 	return nil
 }
@@ -26,8 +26,12 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState defines the liquidstaking module's genesis state.
 type GenesisState struct {
 	// params defines all the parameters for the liquidstaking module
-	Params           Params            `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	LiquidValidators []LiquidValidator `protobuf:"bytes,2,rep,name=liquid_validators,json=liquidValidators,proto3" json:"liquid_validators" yaml:"liquid_validators"`
+	Params                Params              `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	LiquidValidators      []LiquidValidator   `protobuf:"bytes,2,rep,name=liquid_validators,json=liquidValidators,proto3" json:"liquid_validators" yaml:"liquid_validators"`
+	LastUnstakingRecordId uint64              `protobuf:"varint,3,opt,name=last_unstaking_record_id,json=lastUnstakingRecordId,proto3" json:"last_unstaking_record_id,omitempty" yaml:"last_unstaking_record_id"`
+	UnstakingRecords      []UnstakingRecord   `protobuf:"bytes,4,rep,name=unstaking_records,json=unstakingRecords,proto3" json:"unstaking_records" yaml:"unstaking_records"`
+	NetAmountSnapshots    []NetAmountSnapshot `protobuf:"bytes,5,rep,name=net_amount_snapshots,json=netAmountSnapshots,proto3" json:"net_amount_snapshots" yaml:"net_amount_snapshots"`
+	HostZones             []HostZone          `protobuf:"bytes,6,rep,name=host_zones,json=hostZones,proto3" json:"host_zones" yaml:"host_zones"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -72,26 +76,36 @@ func init() {
 }
 
 var fileDescriptor_41fc9b45d9317560 = []byte{
-	// 292 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x49, 0x2e, 0x4a, 0x2d,
-	0x4e, 0x4e, 0xcd, 0x2b, 0xd1, 0xcf, 0xc9, 0x2c, 0x2c, 0xcd, 0x4c, 0x29, 0x2e, 0x49, 0xcc, 0xce,
-	0xcc, 0x4b, 0xd7, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d,
-	0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x83, 0xa9, 0xd6, 0x43, 0x51, 0xad,
-	0x07, 0x55, 0x2d, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x56, 0xaa, 0x0f, 0x62, 0x41, 0x74, 0x49,
-	0x19, 0x11, 0xb0, 0x03, 0xd5, 0x2c, 0xb0, 0x1e, 0xa5, 0x7b, 0x8c, 0x5c, 0x3c, 0xee, 0x10, 0xbb,
-	0x83, 0x4b, 0x12, 0x4b, 0x52, 0x85, 0x5c, 0xb8, 0xd8, 0x0a, 0x12, 0x8b, 0x12, 0x73, 0x8b, 0x25,
-	0x18, 0x15, 0x18, 0x35, 0xb8, 0x8d, 0xd4, 0xf4, 0xf0, 0xbb, 0x45, 0x2f, 0x00, 0xac, 0xda, 0x89,
-	0xe5, 0xc4, 0x3d, 0x79, 0x86, 0x20, 0xa8, 0x5e, 0xa1, 0x3a, 0x2e, 0x41, 0x88, 0xea, 0xf8, 0xb2,
-	0xc4, 0x9c, 0xcc, 0x94, 0xc4, 0x92, 0xfc, 0xa2, 0x62, 0x09, 0x26, 0x05, 0x66, 0x0d, 0x6e, 0x23,
-	0x7d, 0x42, 0x06, 0xfa, 0x80, 0x45, 0xc3, 0x60, 0xfa, 0x9c, 0x14, 0x40, 0x26, 0x7f, 0xba, 0x27,
-	0x2f, 0x51, 0x99, 0x98, 0x9b, 0x63, 0xa5, 0x84, 0x61, 0xae, 0x52, 0x90, 0x40, 0x0e, 0xaa, 0x96,
-	0x62, 0x2b, 0x8e, 0x8e, 0x05, 0xf2, 0x0c, 0x2f, 0x16, 0xc8, 0x33, 0x38, 0x45, 0xaf, 0x78, 0x24,
-	0xc7, 0x78, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78,
-	0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0xb6, 0xe9, 0x99, 0x25,
-	0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x30, 0x67, 0xe9, 0xe6, 0xa5, 0x96, 0x94, 0xe7,
-	0x17, 0x65, 0xc3, 0x05, 0xf4, 0xcb, 0x4c, 0xf4, 0x2b, 0xd0, 0xc2, 0xb4, 0xa4, 0xb2, 0x20, 0xb5,
-	0x38, 0x89, 0x0d, 0x1c, 0x88, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x4b, 0x19, 0xa5, 0x99,
-	0xde, 0x01, 0x00, 0x00,
+	// 460 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xc1, 0x6b, 0x13, 0x41,
+	0x14, 0xc6, 0x77, 0x6d, 0x1a, 0x74, 0xeb, 0xa1, 0x5d, 0x2a, 0x8c, 0x15, 0x66, 0xc3, 0x16, 0x24,
+	0x07, 0xdd, 0x21, 0xd1, 0x53, 0xc1, 0x83, 0x8b, 0xa0, 0x82, 0x88, 0x6c, 0xd1, 0x43, 0x15, 0x96,
+	0x49, 0x76, 0xd8, 0x2c, 0xdd, 0xcc, 0xc4, 0x7d, 0x6f, 0xa3, 0xf5, 0xe0, 0xb9, 0x47, 0xff, 0x84,
+	0x1e, 0x05, 0xff, 0x91, 0x1e, 0x7b, 0xf4, 0x14, 0x24, 0xb9, 0x78, 0xee, 0x5f, 0x20, 0x99, 0x9d,
+	0x28, 0xd9, 0xa8, 0xe9, 0x6d, 0x98, 0xf7, 0x7d, 0xbf, 0xef, 0x7b, 0x87, 0xe7, 0xdc, 0xeb, 0x17,
+	0x02, 0xfa, 0x42, 0x22, 0xcb, 0xb3, 0xf7, 0x65, 0x96, 0x00, 0xf2, 0xe3, 0x4c, 0xa6, 0x6c, 0xdc,
+	0xe9, 0x09, 0xe4, 0x1d, 0x96, 0x0a, 0x29, 0x20, 0x83, 0x60, 0x54, 0x28, 0x54, 0x2e, 0x5d, 0xa8,
+	0x83, 0x25, 0x75, 0x60, 0xd4, 0x7b, 0xbb, 0xa9, 0x4a, 0x95, 0x96, 0xb2, 0xf9, 0xab, 0x72, 0xed,
+	0x75, 0xd7, 0x64, 0x2c, 0xb3, 0xb4, 0xc7, 0xff, 0xb6, 0xe9, 0xdc, 0x7c, 0x5a, 0x65, 0x1f, 0x22,
+	0x47, 0xe1, 0x3e, 0x71, 0x9a, 0x23, 0x5e, 0xf0, 0x21, 0x10, 0xbb, 0x65, 0xb7, 0xb7, 0xba, 0x77,
+	0x83, 0xff, 0x77, 0x09, 0x5e, 0x69, 0x75, 0xd8, 0x38, 0x9f, 0x78, 0x56, 0x64, 0xbc, 0xee, 0x67,
+	0x67, 0xa7, 0x52, 0xc7, 0x63, 0x9e, 0x67, 0x09, 0x47, 0x55, 0x00, 0xb9, 0xd6, 0xda, 0x68, 0x6f,
+	0x75, 0xd9, 0x3a, 0xe0, 0x0b, 0xfd, 0xfb, 0x66, 0xe1, 0x0b, 0x5b, 0x73, 0xf2, 0xe5, 0xc4, 0x23,
+	0x27, 0x7c, 0x98, 0x1f, 0xf8, 0x2b, 0x5c, 0x3f, 0xda, 0xce, 0x97, 0x2d, 0xe0, 0xbe, 0x73, 0x48,
+	0xce, 0x01, 0xe3, 0x52, 0x1a, 0x7a, 0x5c, 0x88, 0xbe, 0x2a, 0x92, 0x38, 0x4b, 0xc8, 0x46, 0xcb,
+	0x6e, 0x37, 0xc2, 0xfd, 0xcb, 0x89, 0xe7, 0x19, 0xe2, 0x3f, 0x94, 0x7e, 0x74, 0x6b, 0x3e, 0x7a,
+	0xbd, 0x98, 0x44, 0x7a, 0xf0, 0x3c, 0x99, 0x6f, 0x57, 0x97, 0x03, 0x69, 0x5c, 0x6d, 0xbb, 0x1a,
+	0xad, 0xbe, 0xdd, 0x0a, 0xd7, 0x8f, 0xb6, 0xcb, 0x65, 0x0b, 0xb8, 0xa7, 0xb6, 0xb3, 0x2b, 0x05,
+	0xc6, 0x7c, 0xa8, 0x4a, 0x89, 0x31, 0x48, 0x3e, 0x82, 0x81, 0x42, 0x20, 0x9b, 0xba, 0x43, 0x67,
+	0x5d, 0x87, 0x97, 0x02, 0x1f, 0x6b, 0xeb, 0xa1, 0x71, 0x86, 0xfb, 0xa6, 0xc5, 0x9d, 0xaa, 0xc5,
+	0xdf, 0xe0, 0x7e, 0xe4, 0xca, 0xba, 0x0f, 0xdc, 0x9e, 0xe3, 0x0c, 0x14, 0x60, 0xfc, 0x49, 0x49,
+	0x01, 0xa4, 0xa9, 0xf3, 0xdb, 0xeb, 0xf2, 0x9f, 0x29, 0xc0, 0x23, 0x25, 0x45, 0x78, 0xdb, 0xc4,
+	0xee, 0x54, 0xb1, 0x7f, 0x48, 0x7e, 0x74, 0x63, 0x60, 0x44, 0x70, 0x70, 0xfd, 0xf4, 0xcc, 0xb3,
+	0x7e, 0x9e, 0x79, 0x56, 0xf8, 0xf6, 0xeb, 0x94, 0xda, 0xe7, 0x53, 0x6a, 0x5f, 0x4c, 0xa9, 0xfd,
+	0x63, 0x4a, 0xed, 0x2f, 0x33, 0x6a, 0x5d, 0xcc, 0xa8, 0xf5, 0x7d, 0x46, 0xad, 0xa3, 0x47, 0x69,
+	0x86, 0x83, 0xb2, 0x17, 0xf4, 0xd5, 0x90, 0x2d, 0x1a, 0xdc, 0x97, 0x02, 0x3f, 0xa8, 0xe2, 0xf8,
+	0xf7, 0x07, 0x1b, 0x3f, 0x64, 0x1f, 0x6b, 0x07, 0x82, 0x27, 0x23, 0x01, 0xbd, 0xa6, 0xbe, 0x88,
+	0x07, 0xbf, 0x06, 0x00, 0x0b, 0x4e, 0x38, 0xbb, 0xab, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -114,6 +128,53 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.HostZones) > 0 {
+		for iNdEx := len(m.HostZones) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HostZones[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.NetAmountSnapshots) > 0 {
+		for iNdEx := len(m.NetAmountSnapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NetAmountSnapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.UnstakingRecords) > 0 {
+		for iNdEx := len(m.UnstakingRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnstakingRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.LastUnstakingRecordId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastUnstakingRecordId))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.LiquidValidators) > 0 {
 		for iNdEx := len(m.LiquidValidators) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -166,6 +227,27 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if m.LastUnstakingRecordId != 0 {
+		n += 1 + sovGenesis(uint64(m.LastUnstakingRecordId))
+	}
+	if len(m.UnstakingRecords) > 0 {
+		for _, e := range m.UnstakingRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.NetAmountSnapshots) > 0 {
+		for _, e := range m.NetAmountSnapshots {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.HostZones) > 0 {
+		for _, e := range m.HostZones {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -271,6 +353,127 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUnstakingRecordId", wireType)
+			}
+			m.LastUnstakingRecordId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastUnstakingRecordId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnstakingRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnstakingRecords = append(m.UnstakingRecords, UnstakingRecord{})
+			if err := m.UnstakingRecords[len(m.UnstakingRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetAmountSnapshots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NetAmountSnapshots = append(m.NetAmountSnapshots, NetAmountSnapshot{})
+			if err := m.NetAmountSnapshots[len(m.NetAmountSnapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostZones", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostZones = append(m.HostZones, HostZone{})
+			if err := m.HostZones[len(m.HostZones)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
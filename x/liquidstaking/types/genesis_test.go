@@ -5,8 +5,12 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/tendermint/tendermint/crypto"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
 
+	utils "github.com/crescent-network/crescent/v4/types"
 	"github.com/crescent-network/crescent/v4/x/liquidstaking/types"
 )
 
@@ -30,7 +34,7 @@ func TestGenesisState_Validate(t *testing.T) {
 					},
 				}
 			},
-			"invalid liquid validator {invalidAddr}: decoding bech32 failed: string not all lowercase or all uppercase: invalid address",
+			"invalid liquid validator {invalidAddr VALIDATOR_STATUS_UNSPECIFIED}: decoding bech32 failed: string not all lowercase or all uppercase: invalid address",
 		},
 		{
 			"empty liquid validator address",
@@ -41,7 +45,7 @@ func TestGenesisState_Validate(t *testing.T) {
 					},
 				}
 			},
-			"invalid liquid validator {}: empty address string is not allowed: invalid address",
+			"invalid liquid validator { VALIDATOR_STATUS_UNSPECIFIED}: empty address string is not allowed: invalid address",
 		},
 		{
 			"invalid params(UnstakeFeeRate)",
@@ -50,6 +54,87 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			"unstake fee rate must not be nil",
 		},
+		{
+			"valid unstaking record",
+			func(genState *types.GenesisState) {
+				genState.LastUnstakingRecordId = 1
+				genState.UnstakingRecords = []types.UnstakingRecord{unstakingRecord(1)}
+			},
+			"",
+		},
+		{
+			"unstaking record id greater than the last id",
+			func(genState *types.GenesisState) {
+				genState.LastUnstakingRecordId = 1
+				genState.UnstakingRecords = []types.UnstakingRecord{unstakingRecord(2)}
+			},
+			"unstaking record 2 has id greater than the last unstaking record id 1",
+		},
+		{
+			"duplicate unstaking record id",
+			func(genState *types.GenesisState) {
+				genState.LastUnstakingRecordId = 1
+				genState.UnstakingRecords = []types.UnstakingRecord{unstakingRecord(1), unstakingRecord(1)}
+			},
+			"duplicate unstaking record id 1",
+		},
+		{
+			"invalid unstaking record amount",
+			func(genState *types.GenesisState) {
+				record := unstakingRecord(1)
+				record.Amount = sdk.ZeroInt()
+				genState.LastUnstakingRecordId = 1
+				genState.UnstakingRecords = []types.UnstakingRecord{record}
+			},
+			"invalid unstaking record 1: amount must be positive: 0",
+		},
+		{
+			"invalid unstaking record validator address",
+			func(genState *types.GenesisState) {
+				record := unstakingRecord(1)
+				record.ValidatorAddress = "invalidaddr"
+				genState.LastUnstakingRecordId = 1
+				genState.UnstakingRecords = []types.UnstakingRecord{record}
+			},
+			"invalid unstaking record 1: invalid validator address invalidaddr: decoding bech32 failed: invalid separator index -1",
+		},
+		{
+			"valid host zone",
+			func(genState *types.GenesisState) {
+				genState.HostZones = []types.HostZone{hostZone("cosmoshub-4", "batom")}
+			},
+			"",
+		},
+		{
+			"duplicate host zone chain id",
+			func(genState *types.GenesisState) {
+				genState.HostZones = []types.HostZone{hostZone("cosmoshub-4", "batom"), hostZone("cosmoshub-4", "batom2")}
+			},
+			"duplicate host zone chain id cosmoshub-4",
+		},
+		{
+			"duplicate btoken denom",
+			func(genState *types.GenesisState) {
+				genState.HostZones = []types.HostZone{hostZone("cosmoshub-4", "batom"), hostZone("theta-testnet-001", "batom")}
+			},
+			"duplicate btoken denom batom of host zone theta-testnet-001",
+		},
+		{
+			"btoken denom same as liquid bond denom",
+			func(genState *types.GenesisState) {
+				genState.HostZones = []types.HostZone{hostZone("cosmoshub-4", genState.Params.LiquidBondDenom)}
+			},
+			"duplicate btoken denom bstake of host zone cosmoshub-4",
+		},
+		{
+			"invalid host zone",
+			func(genState *types.GenesisState) {
+				zone := hostZone("cosmoshub-4", "batom")
+				zone.DelegatedAmount = sdk.NewInt(-1)
+				genState.HostZones = []types.HostZone{zone}
+			},
+			"invalid host zone cosmoshub-4: delegated amount must not be negative: -1",
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			genState := types.DefaultGenesisState()
@@ -63,3 +148,18 @@ func TestGenesisState_Validate(t *testing.T) {
 		})
 	}
 }
+
+func unstakingRecord(id uint64) types.UnstakingRecord {
+	return types.NewUnstakingRecord(
+		id, sdk.AccAddress(crypto.AddressHash([]byte("liquidStaker"))), sdk.ValAddress(crypto.AddressHash([]byte("validator"))),
+		1, utils.ParseTime("2022-03-01T00:00:00Z"), sdk.NewInt(1000000))
+}
+
+func hostZone(chainID, bTokenDenom string) types.HostZone {
+	valAddr, err := bech32.ConvertAndEncode("cosmosvaloper", crypto.AddressHash([]byte("hostValidator")))
+	if err != nil {
+		panic(err)
+	}
+	return types.NewHostZone(types.NewRegisterHostZoneProposal(
+		"title", "description", chainID, "connection-0", "channel-0", "uatom", bTokenDenom, valAddr))
+}
@@ -0,0 +1,56 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ LiquidStakingHooks = MultiLiquidStakingHooks{}
+
+// LiquidStakingHooks defines the hooks called by the liquidstaking module,
+// which other modules such as airdrops, incentives and analytics can
+// subscribe to.
+// The hooks are called after the state changes are made.
+type LiquidStakingHooks interface {
+	// AfterLiquidStake is called after bToken is minted to the liquid staker
+	// and the staking coin is delegated.
+	AfterLiquidStake(ctx sdk.Context, liquidStaker sdk.AccAddress, stakingCoin, mintedBToken sdk.Coin)
+	// AfterLiquidUnstake is called after bToken of the liquid staker is burned.
+	// unstakedAmount is the amount of the staking coin being unbonded to the
+	// liquid staker, or withdrawn to the liquid staker immediately if there
+	// were no liquid tokens to unbond.
+	AfterLiquidUnstake(ctx sdk.Context, liquidStaker sdk.AccAddress, burnedBToken sdk.Coin, unstakedAmount sdk.Int)
+	// AfterRebalanced is called after the liquid tokens are redelegated among
+	// the liquid validators.
+	// The redelegations which failed have their Error set.
+	AfterRebalanced(ctx sdk.Context, redelegations []Redelegation)
+}
+
+// MultiLiquidStakingHooks combines multiple liquidstaking hooks, all hook
+// functions are run in array sequence.
+// An empty MultiLiquidStakingHooks is a no-op hooks.
+type MultiLiquidStakingHooks []LiquidStakingHooks
+
+// NewMultiLiquidStakingHooks returns a new MultiLiquidStakingHooks.
+func NewMultiLiquidStakingHooks(hooks ...LiquidStakingHooks) MultiLiquidStakingHooks {
+	return hooks
+}
+
+func (h MultiLiquidStakingHooks) AfterLiquidStake(
+	ctx sdk.Context, liquidStaker sdk.AccAddress, stakingCoin, mintedBToken sdk.Coin) {
+	for i := range h {
+		h[i].AfterLiquidStake(ctx, liquidStaker, stakingCoin, mintedBToken)
+	}
+}
+
+func (h MultiLiquidStakingHooks) AfterLiquidUnstake(
+	ctx sdk.Context, liquidStaker sdk.AccAddress, burnedBToken sdk.Coin, unstakedAmount sdk.Int) {
+	for i := range h {
+		h[i].AfterLiquidUnstake(ctx, liquidStaker, burnedBToken, unstakedAmount)
+	}
+}
+
+func (h MultiLiquidStakingHooks) AfterRebalanced(ctx sdk.Context, redelegations []Redelegation) {
+	for i := range h {
+		h[i].AfterRebalanced(ctx, redelegations)
+	}
+}
@@ -0,0 +1,175 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+
+	farmingtypes "github.com/crescent-network/crescent/v4/x/farming/types"
+)
+
+const (
+	// HostZoneICAOwnerPrefix is the prefix of the owner of the interchain
+	// account of a host zone, which is followed by the chain id of the host zone.
+	HostZoneICAOwnerPrefix = ModuleName + "."
+
+	// InterchainPacketTimeout is the timeout of the packets sent to host chains.
+	InterchainPacketTimeout = time.Hour
+)
+
+// HostZoneICAOwner returns the owner of the interchain account of the host zone.
+func HostZoneICAOwner(chainID string) string {
+	return HostZoneICAOwnerPrefix + chainID
+}
+
+// HostZoneICAPortID returns the controller port id of the interchain account
+// of the host zone.
+func HostZoneICAPortID(chainID string) string {
+	return icatypes.PortPrefix + HostZoneICAOwner(chainID)
+}
+
+// ParseHostZoneICAPortID returns the chain id of the host zone from the
+// controller port id of its interchain account.
+func ParseHostZoneICAPortID(portID string) (chainID string, ok bool) {
+	prefix := icatypes.PortPrefix + HostZoneICAOwnerPrefix
+	if !strings.HasPrefix(portID, prefix) {
+		return "", false
+	}
+	return strings.TrimPrefix(portID, prefix), true
+}
+
+// DeriveHostZoneDepositAddress returns the address of the account which
+// collects the deposits of the host zone.
+func DeriveHostZoneDepositAddress(chainID string) sdk.AccAddress {
+	return farmingtypes.DeriveAddress(farmingtypes.AddressType32Bytes, ModuleName, "HostZoneDepositAcc/"+chainID)
+}
+
+// NewHostZone returns a new HostZone registered by the proposal.
+func NewHostZone(p *RegisterHostZoneProposal) HostZone {
+	prefixedDenom := transfertypes.GetPrefixedDenom(transfertypes.PortID, p.TransferChannelId, p.HostDenom)
+	return HostZone{
+		ChainId:            p.ChainId,
+		ConnectionId:       p.ConnectionId,
+		TransferChannelId:  p.TransferChannelId,
+		HostDenom:          p.HostDenom,
+		IbcDenom:           transfertypes.ParseDenomTrace(prefixedDenom).IBCDenom(),
+		BtokenDenom:        p.BtokenDenom,
+		ValidatorAddress:   p.ValidatorAddress,
+		DepositAddress:     DeriveHostZoneDepositAddress(p.ChainId).String(),
+		TransferringAmount: sdk.ZeroInt(),
+		IdleAmount:         sdk.ZeroInt(),
+		DelegatingAmount:   sdk.ZeroInt(),
+		DelegatedAmount:    sdk.ZeroInt(),
+	}
+}
+
+// GetDepositAddress returns the deposit address of the host zone.
+func (z HostZone) GetDepositAddress() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(z.DepositAddress)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// ICAPortID returns the controller port id of the interchain account of the
+// host zone.
+func (z HostZone) ICAPortID() string {
+	return HostZoneICAPortID(z.ChainId)
+}
+
+// IsTransferInFlight returns true if a transfer to the interchain account is
+// waiting for its acknowledgement.
+func (z HostZone) IsTransferInFlight() bool {
+	return z.TransferringAmount.IsPositive()
+}
+
+// IsDelegationInFlight returns true if a delegation by the interchain account
+// is waiting for its acknowledgement.
+func (z HostZone) IsDelegationInFlight() bool {
+	return z.DelegatingAmount.IsPositive()
+}
+
+// StakedAmount returns the amount of the tokens transferred to the host chain
+// or on the way to it, excluding the deposits not transferred yet.
+func (z HostZone) StakedAmount() sdk.Int {
+	return z.TransferringAmount.Add(z.IdleAmount).Add(z.DelegatingAmount).Add(z.DelegatedAmount)
+}
+
+// Validate validates HostZone.
+func (z HostZone) Validate() error {
+	if z.ChainId == "" {
+		return fmt.Errorf("chain id must not be empty")
+	}
+	if err := host.PortIdentifierValidator(z.ICAPortID()); err != nil {
+		return fmt.Errorf("invalid chain id %s: %w", z.ChainId, err)
+	}
+	if err := host.ConnectionIdentifierValidator(z.ConnectionId); err != nil {
+		return fmt.Errorf("invalid connection id %s: %w", z.ConnectionId, err)
+	}
+	if err := host.ChannelIdentifierValidator(z.TransferChannelId); err != nil {
+		return fmt.Errorf("invalid transfer channel id %s: %w", z.TransferChannelId, err)
+	}
+	if err := sdk.ValidateDenom(z.HostDenom); err != nil {
+		return fmt.Errorf("invalid host denom: %w", err)
+	}
+	if err := sdk.ValidateDenom(z.IbcDenom); err != nil {
+		return fmt.Errorf("invalid ibc denom: %w", err)
+	}
+	if err := sdk.ValidateDenom(z.BtokenDenom); err != nil {
+		return fmt.Errorf("invalid btoken denom: %w", err)
+	}
+	if err := validateHostAddress(z.ValidatorAddress); err != nil {
+		return fmt.Errorf("invalid validator address %s: %w", z.ValidatorAddress, err)
+	}
+	if _, err := sdk.AccAddressFromBech32(z.DepositAddress); err != nil {
+		return fmt.Errorf("invalid deposit address %s: %w", z.DepositAddress, err)
+	}
+	if z.IcaAddress != "" {
+		if err := validateHostAddress(z.IcaAddress); err != nil {
+			return fmt.Errorf("invalid ica address %s: %w", z.IcaAddress, err)
+		}
+	}
+	for _, amt := range []struct {
+		name string
+		amt  sdk.Int
+	}{
+		{"transferring amount", z.TransferringAmount},
+		{"idle amount", z.IdleAmount},
+		{"delegating amount", z.DelegatingAmount},
+		{"delegated amount", z.DelegatedAmount},
+	} {
+		if amt.amt.IsNil() || amt.amt.IsNegative() {
+			return fmt.Errorf("%s must not be negative: %s", amt.name, amt.amt)
+		}
+	}
+	return nil
+}
+
+// validateHostAddress validates a bech32-encoded address on a host chain,
+// whose bech32 prefix may differ from this chain's.
+func validateHostAddress(addr string) error {
+	_, bz, err := bech32.DecodeAndConvert(addr)
+	if err != nil {
+		return err
+	}
+	return sdk.VerifyAddressFormat(bz)
+}
+
+// MustMarshalHostZone returns the HostZone bytes. Panics if fails.
+func MustMarshalHostZone(cdc codec.BinaryCodec, zone *HostZone) []byte {
+	return cdc.MustMarshal(zone)
+}
+
+// MustUnmarshalHostZone returns the HostZone from bytes. Panics if fails.
+func MustUnmarshalHostZone(cdc codec.BinaryCodec, value []byte) (zone HostZone) {
+	cdc.MustUnmarshal(value, &zone)
+	return zone
+}
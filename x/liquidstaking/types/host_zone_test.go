@@ -0,0 +1,32 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/crescent-network/crescent/v4/x/liquidstaking/types"
+)
+
+func TestHostZoneICAPortID(t *testing.T) {
+	portID := types.HostZoneICAPortID("cosmoshub-4")
+	require.Equal(t, "icacontroller-liquidstaking.cosmoshub-4", portID)
+
+	chainID, ok := types.ParseHostZoneICAPortID(portID)
+	require.True(t, ok)
+	require.Equal(t, "cosmoshub-4", chainID)
+
+	_, ok = types.ParseHostZoneICAPortID("icacontroller-cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpjnp7du")
+	require.False(t, ok)
+}
+
+func TestNewHostZone(t *testing.T) {
+	zone := hostZone("cosmoshub-4", "batom")
+	require.NoError(t, zone.Validate())
+	// The IBC denom of uatom received through transfer/channel-0.
+	require.Equal(t, "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", zone.IbcDenom)
+	require.Equal(t, types.DeriveHostZoneDepositAddress("cosmoshub-4"), zone.GetDepositAddress())
+	require.True(t, zone.StakedAmount().IsZero())
+	require.False(t, zone.IsTransferInFlight())
+	require.False(t, zone.IsDelegationInFlight())
+}
@@ -22,6 +22,15 @@ const (
 var (
 	// Keys for store prefixes
 	LiquidValidatorsKey = []byte{0xc0} // prefix for each key to a liquid validator
+
+	LastUnstakingRecordIdKey = []byte{0xc1} // key for the last unstaking record id
+	UnstakingRecordKeyPrefix = []byte{0xc2} // prefix for each key to an unstaking record
+
+	NetAmountSnapshotKeyPrefix = []byte{0xc3} // prefix for each key to a net amount snapshot
+
+	MintRateBoundKey = []byte{0xc4} // key for the mint rate bound
+
+	HostZoneKeyPrefix = []byte{0xc5} // prefix for each key to a host zone
 )
 
 // GetLiquidValidatorKey creates the key for the liquid validator with address
@@ -29,3 +38,26 @@ var (
 func GetLiquidValidatorKey(operatorAddr sdk.ValAddress) []byte {
 	return append(LiquidValidatorsKey, address.MustLengthPrefix(operatorAddr)...)
 }
+
+// GetUnstakingRecordKey creates the key for the unstaking record
+// VALUE: liquidstaking/UnstakingRecord
+func GetUnstakingRecordKey(liquidStaker sdk.AccAddress, id uint64) []byte {
+	return append(GetUnstakingRecordsByLiquidStakerPrefix(liquidStaker), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetUnstakingRecordsByLiquidStakerPrefix creates the prefix of unstaking records of the liquid staker
+func GetUnstakingRecordsByLiquidStakerPrefix(liquidStaker sdk.AccAddress) []byte {
+	return append(UnstakingRecordKeyPrefix, address.MustLengthPrefix(liquidStaker)...)
+}
+
+// GetNetAmountSnapshotKey creates the key for the net amount snapshot at the height
+// VALUE: liquidstaking/NetAmountSnapshot
+func GetNetAmountSnapshotKey(height int64) []byte {
+	return append(NetAmountSnapshotKeyPrefix, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// GetHostZoneKey creates the key for the host zone with the chain id
+// VALUE: liquidstaking/HostZone
+func GetHostZoneKey(chainID string) []byte {
+	return append(HostZoneKeyPrefix, []byte(chainID)...)
+}
@@ -1,6 +1,9 @@
 package types
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -84,9 +87,11 @@ func (v LiquidValidator) GetStatus(activeCondition bool) ValidatorStatus {
 //- included on whitelist
 //- existed valid validator on staking module ( existed, not nil del shares and tokens, valid exchange rate)
 //- not tombstoned
+//- not jailed
 func ActiveCondition(validator stakingtypes.Validator, whitelisted bool, tombstoned bool) bool {
 	return whitelisted &&
 		!tombstoned &&
+		!validator.IsJailed() &&
 		// !Unspecified ==> Bonded, Unbonding, Unbonded
 		validator.GetStatus() != stakingtypes.Unspecified &&
 		!validator.GetTokens().IsNil() &&
@@ -179,7 +184,7 @@ func DeductFeeRate(input sdk.Dec, feeRate sdk.Dec) (feeDeductedOutput sdk.Dec) {
 }
 
 func (nas NetAmountState) CalcNetAmount() sdk.Dec {
-	return nas.ProxyAccBalance.Add(nas.TotalLiquidTokens).Add(nas.TotalUnbondingBalance).ToDec().Add(nas.TotalRemainingRewards)
+	return nas.ProxyAccBalance.Add(nas.TotalLiquidTokens).Add(nas.TotalUnbondingBalance).ToDec().Add(nas.TotalRemainingRewards).Sub(nas.PendingProtocolCommission)
 }
 
 func (nas NetAmountState) CalcMintRate() sdk.Dec {
@@ -189,6 +194,19 @@ func (nas NetAmountState) CalcMintRate() sdk.Dec {
 	return nas.BtokenTotalSupply.ToDec().QuoTruncate(nas.NetAmount)
 }
 
+// BTokenPegPrices returns the native token value of 1 bToken when liquid
+// unstaking it(redeemPrice) and when minting it by liquid staking(mintPrice).
+// Buying bToken below redeemPrice or selling bToken above mintPrice on the DEX
+// is an arbitrage opportunity which also pushes the bToken price back to the peg.
+func (nas NetAmountState) BTokenPegPrices(unstakeFeeRate sdk.Dec) (redeemPrice, mintPrice sdk.Dec) {
+	mintPrice = sdk.OneDec()
+	if nas.BtokenTotalSupply.IsPositive() && nas.NetAmount.IsPositive() {
+		mintPrice = nas.NetAmount.QuoTruncate(nas.BtokenTotalSupply.ToDec())
+	}
+	redeemPrice = mintPrice.MulTruncate(sdk.OneDec().Sub(unstakeFeeRate))
+	return
+}
+
 type LiquidValidatorStates []LiquidValidatorState
 
 func MustMarshalLiquidValidator(cdc codec.BinaryCodec, val *LiquidValidator) []byte {
@@ -210,3 +228,85 @@ func UnmarshalLiquidValidator(cdc codec.BinaryCodec, value []byte) (val LiquidVa
 	err = cdc.Unmarshal(value, &val)
 	return val, err
 }
+
+// NewUnstakingRecord returns a new UnstakingRecord.
+func NewUnstakingRecord(id uint64, liquidStaker sdk.AccAddress, valAddr sdk.ValAddress, creationHeight int64, completionTime time.Time, amount sdk.Int) UnstakingRecord {
+	return UnstakingRecord{
+		Id:               id,
+		LiquidStaker:     liquidStaker.String(),
+		ValidatorAddress: valAddr.String(),
+		CreationHeight:   creationHeight,
+		CompletionTime:   completionTime,
+		Amount:           amount,
+	}
+}
+
+// GetLiquidStaker returns the liquid staker address of the record.
+func (r UnstakingRecord) GetLiquidStaker() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(r.LiquidStaker)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// IsCompleted returns true if the unbonding of the record is completed at t.
+func (r UnstakingRecord) IsCompleted(t time.Time) bool {
+	return !r.CompletionTime.After(t)
+}
+
+// Validate validates UnstakingRecord.
+func (r UnstakingRecord) Validate() error {
+	if r.Id == 0 {
+		return fmt.Errorf("id must not be 0")
+	}
+	if _, err := sdk.AccAddressFromBech32(r.LiquidStaker); err != nil {
+		return fmt.Errorf("invalid liquid staker address %s: %w", r.LiquidStaker, err)
+	}
+	if _, err := sdk.ValAddressFromBech32(r.ValidatorAddress); err != nil {
+		return fmt.Errorf("invalid validator address %s: %w", r.ValidatorAddress, err)
+	}
+	if r.Amount.IsNil() || !r.Amount.IsPositive() {
+		return fmt.Errorf("amount must be positive: %s", r.Amount)
+	}
+	return nil
+}
+
+// MustMarshalUnstakingRecord returns the UnstakingRecord bytes. Panics if fails.
+func MustMarshalUnstakingRecord(cdc codec.BinaryCodec, record *UnstakingRecord) []byte {
+	return cdc.MustMarshal(record)
+}
+
+// MustUnmarshalUnstakingRecord returns the UnstakingRecord from bytes. Panics if fails.
+func MustUnmarshalUnstakingRecord(cdc codec.BinaryCodec, value []byte) (record UnstakingRecord) {
+	cdc.MustUnmarshal(value, &record)
+	return record
+}
+
+// NewNetAmountSnapshot returns a new NetAmountSnapshot of the net amount state.
+func NewNetAmountSnapshot(height int64, t time.Time, nas NetAmountState) NetAmountSnapshot {
+	return NetAmountSnapshot{
+		Height:            height,
+		Time:              t,
+		NetAmount:         nas.NetAmount,
+		BtokenTotalSupply: nas.BtokenTotalSupply,
+		MintRate:          nas.MintRate,
+	}
+}
+
+// Validate validates NetAmountSnapshot.
+func (s NetAmountSnapshot) Validate() error {
+	if s.Height <= 0 {
+		return fmt.Errorf("height must be positive: %d", s.Height)
+	}
+	if s.NetAmount.IsNil() || s.NetAmount.IsNegative() {
+		return fmt.Errorf("net amount must not be negative: %s", s.NetAmount)
+	}
+	if s.BtokenTotalSupply.IsNil() || s.BtokenTotalSupply.IsNegative() {
+		return fmt.Errorf("btoken total supply must not be negative: %s", s.BtokenTotalSupply)
+	}
+	if s.MintRate.IsNil() || s.MintRate.IsNegative() {
+		return fmt.Errorf("mint rate must not be negative: %s", s.MintRate)
+	}
+	return nil
+}
@@ -9,18 +9,21 @@ import (
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
 	_ "github.com/regen-network/cosmos-proto"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -38,18 +41,22 @@ const (
 	ValidatorStatusActive ValidatorStatus = 1
 	// VALIDATOR_STATUS_INACTIVE defines the inactive, invalid status
 	ValidatorStatusInactive ValidatorStatus = 2
+	// VALIDATOR_STATUS_JAILED defines the inactive status of a whitelisted validator which is jailed or tombstoned
+	ValidatorStatusJailed ValidatorStatus = 3
 )
 
 var ValidatorStatus_name = map[int32]string{
 	0: "VALIDATOR_STATUS_UNSPECIFIED",
 	1: "VALIDATOR_STATUS_ACTIVE",
 	2: "VALIDATOR_STATUS_INACTIVE",
+	3: "VALIDATOR_STATUS_JAILED",
 }
 
 var ValidatorStatus_value = map[string]int32{
 	"VALIDATOR_STATUS_UNSPECIFIED": 0,
 	"VALIDATOR_STATUS_ACTIVE":      1,
 	"VALIDATOR_STATUS_INACTIVE":    2,
+	"VALIDATOR_STATUS_JAILED":      3,
 }
 
 func (x ValidatorStatus) String() string {
@@ -73,6 +80,42 @@ type Params struct {
 	// MinLiquidStakingAmount specifies the minimum number of coins to be staked to the active liquid validators on liquid
 	// staking to minimize decimal loss and consider gas efficiency.
 	MinLiquidStakingAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=min_liquid_staking_amount,json=minLiquidStakingAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_liquid_staking_amount" yaml:"min_liquid_staking_amount"`
+	// RebalancingTrigger specifies the minimum ratio of the liquid token gap of a liquid validator from its target to
+	// total liquid tokens, which triggers asset rebalancing (redelegation).
+	RebalancingTrigger github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=rebalancing_trigger,json=rebalancingTrigger,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"rebalancing_trigger" yaml:"rebalancing_trigger"`
+	// MaxRedelegationsPerRebalancing specifies the maximum number of redelegations in a single rebalancing. The rest of
+	// the rebalancing is continued in the following blocks.
+	MaxRedelegationsPerRebalancing uint32 `protobuf:"varint,7,opt,name=max_redelegations_per_rebalancing,json=maxRedelegationsPerRebalancing,proto3" json:"max_redelegations_per_rebalancing,omitempty" yaml:"max_redelegations_per_rebalancing"`
+	// RewardCompoundingEpoch specifies the number of blocks between reward compoundings, which withdraw the accumulated
+	// delegation rewards from all liquid validators and re-stake them according to the target weights. Zero disables it.
+	RewardCompoundingEpoch uint32 `protobuf:"varint,8,opt,name=reward_compounding_epoch,json=rewardCompoundingEpoch,proto3" json:"reward_compounding_epoch,omitempty" yaml:"reward_compounding_epoch"`
+	// NetAmountSnapshotRetention specifies the number of recent blocks whose net amount snapshots are kept. Older
+	// snapshots are pruned every block. Zero disables net amount snapshots.
+	NetAmountSnapshotRetention uint32 `protobuf:"varint,9,opt,name=net_amount_snapshot_retention,json=netAmountSnapshotRetention,proto3" json:"net_amount_snapshot_retention,omitempty" yaml:"net_amount_snapshot_retention"`
+	// ProtocolCommissionRate specifies the rate of the delegation rewards of the proxy account taken as the protocol
+	// commission when the rewards are re-staked. The commission is excluded from NetAmount before it is taken.
+	ProtocolCommissionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=protocol_commission_rate,json=protocolCommissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"protocol_commission_rate" yaml:"protocol_commission_rate"`
+	// ProtocolCommissionDestination specifies where the protocol commission is sent to, either "fee_collector" or
+	// "community_pool".
+	ProtocolCommissionDestination string `protobuf:"bytes,11,opt,name=protocol_commission_destination,json=protocolCommissionDestination,proto3" json:"protocol_commission_destination,omitempty" yaml:"protocol_commission_destination"`
+	// MinSelfDelegation specifies the minimum amount of tokens self-delegated by the operator that a whitelisted
+	// validator must maintain. A whitelisted validator with less self-delegation is removed from the whitelist. Zero
+	// disables the requirement.
+	MinSelfDelegation github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,12,opt,name=min_self_delegation,json=minSelfDelegation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_self_delegation" yaml:"min_self_delegation"`
+	// MaxCommissionRate specifies the maximum commission rate of a whitelisted validator. A whitelisted validator with a
+	// higher commission rate is removed from the whitelist. One disables the requirement.
+	MaxCommissionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=max_commission_rate,json=maxCommissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_commission_rate" yaml:"max_commission_rate"`
+	// RewardCompoundingEpochIdentifier specifies the identifier of the epoch of the epochs module at the end of which
+	// rewards are compounded. When it is set, RewardCompoundingEpoch is ignored. Empty keeps the block-based
+	// RewardCompoundingEpoch.
+	RewardCompoundingEpochIdentifier string `protobuf:"bytes,14,opt,name=reward_compounding_epoch_identifier,json=rewardCompoundingEpochIdentifier,proto3" json:"reward_compounding_epoch_identifier,omitempty" yaml:"reward_compounding_epoch_identifier"`
+	// RebalancingEpochIdentifier specifies the identifier of the epoch of the epochs module at the end of which the
+	// liquid validators are rebalanced. When it is set, the rebalancing of the begin block is skipped unless the status
+	// of a liquid validator changes. Empty keeps the rebalancing of every begin block.
+	RebalancingEpochIdentifier string `protobuf:"bytes,15,opt,name=rebalancing_epoch_identifier,json=rebalancingEpochIdentifier,proto3" json:"rebalancing_epoch_identifier,omitempty" yaml:"rebalancing_epoch_identifier"`
+	// InterchainLiquidStakingEnabled specifies whether MsgInterchainLiquidStake is accepted. The bTokens of host zones
+	// cannot be unstaked yet, so it is disabled by default.
+	InterchainLiquidStakingEnabled bool `protobuf:"varint,16,opt,name=interchain_liquid_staking_enabled,json=interchainLiquidStakingEnabled,proto3" json:"interchain_liquid_staking_enabled,omitempty" yaml:"interchain_liquid_staking_enabled"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -156,6 +199,9 @@ var xxx_messageInfo_WhitelistedValidator proto.InternalMessageInfo
 type LiquidValidator struct {
 	// operator_address defines the address of the validator's operator; bech encoded in JSON.
 	OperatorAddress string `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty" yaml:"operator_address"`
+	// status is the liquid validator status seen at the last liquid validator set update, which is used only to
+	// detect status transitions
+	Status ValidatorStatus `protobuf:"varint,2,opt,name=status,proto3,enum=crescent.liquidstaking.v1beta1.ValidatorStatus" json:"status,omitempty" yaml:"status"`
 }
 
 func (m *LiquidValidator) Reset()         { *m = LiquidValidator{} }
@@ -238,6 +284,56 @@ func (m *LiquidValidatorState) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_LiquidValidatorState proto.InternalMessageInfo
 
+// UnstakingRecord tracks an unbonding entry of a liquid staker initiated through liquid unstaking, which is queued to the
+// liquid staker's unbonding delegation of the staking module along with its own native unbondings.
+type UnstakingRecord struct {
+	// id specifies the id of the record
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// liquid_staker defines the bech32-encoded address of the liquid staker
+	LiquidStaker string `protobuf:"bytes,2,opt,name=liquid_staker,json=liquidStaker,proto3" json:"liquid_staker,omitempty" yaml:"liquid_staker"`
+	// validator_address defines the bech32-encoded address of the originating validator
+	ValidatorAddress string `protobuf:"bytes,3,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty" yaml:"validator_address"`
+	// creation_height is the height which the unbonding took place
+	CreationHeight int64 `protobuf:"varint,4,opt,name=creation_height,json=creationHeight,proto3" json:"creation_height,omitempty" yaml:"creation_height"`
+	// completion_time is the unix time for unbonding completion
+	CompletionTime time.Time `protobuf:"bytes,5,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time" yaml:"completion_time"`
+	// amount defines the native token amount of the unbonding entry at its creation
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+}
+
+func (m *UnstakingRecord) Reset()         { *m = UnstakingRecord{} }
+func (m *UnstakingRecord) String() string { return proto.CompactTextString(m) }
+func (*UnstakingRecord) ProtoMessage()    {}
+func (*UnstakingRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f11ef7f6d0889fb0, []int{4}
+}
+func (m *UnstakingRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnstakingRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnstakingRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnstakingRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnstakingRecord.Merge(m, src)
+}
+func (m *UnstakingRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnstakingRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnstakingRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnstakingRecord proto.InternalMessageInfo
+
 // NetAmountState is type for net amount raw data and mint rate, This is a value that depends on the several module
 // state every time, so it is used only for calculation and query and is not stored in kv.
 type NetAmountState struct {
@@ -246,7 +342,7 @@ type NetAmountState struct {
 	// btoken_total_supply returns the total supply of btoken(liquid_bond_denom)
 	BtokenTotalSupply github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=btoken_total_supply,json=btokenTotalSupply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"btoken_total_supply"`
 	// net_amount is proxy account's native token balance + total liquid tokens + total remaining rewards + total
-	// unbonding balance
+	// unbonding balance - pending protocol commission
 	NetAmount github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=net_amount,json=netAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"net_amount"`
 	// total_del_shares define the delegation shares of all liquid validators
 	TotalDelShares github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=total_del_shares,json=totalDelShares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"total_del_shares"`
@@ -260,13 +356,16 @@ type NetAmountState struct {
 	TotalUnbondingBalance github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=total_unbonding_balance,json=totalUnbondingBalance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_unbonding_balance"`
 	// proxy_acc_balance define the balance of proxy account for the native token
 	ProxyAccBalance github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,8,opt,name=proxy_acc_balance,json=proxyAccBalance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"proxy_acc_balance"`
+	// pending_protocol_commission define the protocol commission on proxy account's native token balance and total
+	// remaining rewards, which is not taken yet and excluded from the net amount
+	PendingProtocolCommission github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=pending_protocol_commission,json=pendingProtocolCommission,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"pending_protocol_commission"`
 }
 
 func (m *NetAmountState) Reset()         { *m = NetAmountState{} }
 func (m *NetAmountState) String() string { return proto.CompactTextString(m) }
 func (*NetAmountState) ProtoMessage()    {}
 func (*NetAmountState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f11ef7f6d0889fb0, []int{4}
+	return fileDescriptor_f11ef7f6d0889fb0, []int{5}
 }
 func (m *NetAmountState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -295,6 +394,54 @@ func (m *NetAmountState) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_NetAmountState proto.InternalMessageInfo
 
+// NetAmountSnapshot is a record of the net amount, bToken total supply and mint rate taken at the beginning of a
+// block, which is used to track the exchange rate between bToken and the native token over time.
+type NetAmountSnapshot struct {
+	// height is the height of the block where the snapshot was taken
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the time of the block where the snapshot was taken
+	Time time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time"`
+	// net_amount is the net amount at the time of the snapshot
+	NetAmount github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=net_amount,json=netAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"net_amount"`
+	// btoken_total_supply is the total supply of btoken(liquid_bond_denom) at the time of the snapshot
+	BtokenTotalSupply github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=btoken_total_supply,json=btokenTotalSupply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"btoken_total_supply" yaml:"btoken_total_supply"`
+	// mint_rate is bTokenTotalSupply / NetAmount at the time of the snapshot
+	MintRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=mint_rate,json=mintRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"mint_rate" yaml:"mint_rate"`
+}
+
+func (m *NetAmountSnapshot) Reset()         { *m = NetAmountSnapshot{} }
+func (m *NetAmountSnapshot) String() string { return proto.CompactTextString(m) }
+func (*NetAmountSnapshot) ProtoMessage()    {}
+func (*NetAmountSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f11ef7f6d0889fb0, []int{6}
+}
+func (m *NetAmountSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NetAmountSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NetAmountSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NetAmountSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NetAmountSnapshot.Merge(m, src)
+}
+func (m *NetAmountSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *NetAmountSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_NetAmountSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NetAmountSnapshot proto.InternalMessageInfo
+
 // VotingPower is type for current voting power of the voter including staking module's voting power and liquid staking
 // module's voting power, It depends on the amount of delegation of staking module, the bonded state of the delegated
 // validator, the value of btoken(liquid_bond_denom), and the pool coin and farming position containing btoken..
@@ -314,7 +461,7 @@ func (m *VotingPower) Reset()         { *m = VotingPower{} }
 func (m *VotingPower) String() string { return proto.CompactTextString(m) }
 func (*VotingPower) ProtoMessage()    {}
 func (*VotingPower) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f11ef7f6d0889fb0, []int{5}
+	return fileDescriptor_f11ef7f6d0889fb0, []int{7}
 }
 func (m *VotingPower) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -343,14 +490,90 @@ func (m *VotingPower) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_VotingPower proto.InternalMessageInfo
 
+// HostZone is a host chain where the liquid staking module stakes tokens through an interchain account, and mints its
+// own bToken for the tokens staked on the host chain.
+type HostZone struct {
+	// chain_id specifies the chain id of the host chain
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty" yaml:"chain_id"`
+	// connection_id specifies the IBC connection to the host chain, on which the interchain account is registered
+	ConnectionId string `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty" yaml:"connection_id"`
+	// transfer_channel_id specifies the ICS-20 channel to the host chain, through which the deposits are transferred
+	TransferChannelId string `protobuf:"bytes,3,opt,name=transfer_channel_id,json=transferChannelId,proto3" json:"transfer_channel_id,omitempty" yaml:"transfer_channel_id"`
+	// host_denom specifies the staking denom on the host chain
+	HostDenom string `protobuf:"bytes,4,opt,name=host_denom,json=hostDenom,proto3" json:"host_denom,omitempty" yaml:"host_denom"`
+	// ibc_denom specifies the IBC denom of the host denom transferred through the transfer channel
+	IbcDenom string `protobuf:"bytes,5,opt,name=ibc_denom,json=ibcDenom,proto3" json:"ibc_denom,omitempty" yaml:"ibc_denom"`
+	// btoken_denom specifies the denom of the bToken minted for the tokens staked on the host chain
+	BtokenDenom string `protobuf:"bytes,6,opt,name=btoken_denom,json=btokenDenom,proto3" json:"btoken_denom,omitempty" yaml:"btoken_denom"`
+	// validator_address specifies the bech32-encoded address of the validator on the host chain to delegate to
+	ValidatorAddress string `protobuf:"bytes,7,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty" yaml:"validator_address"`
+	// deposit_address specifies the bech32-encoded address of the account which collects the deposits before they are
+	// transferred to the host chain
+	DepositAddress string `protobuf:"bytes,8,opt,name=deposit_address,json=depositAddress,proto3" json:"deposit_address,omitempty" yaml:"deposit_address"`
+	// ica_address specifies the bech32-encoded address of the interchain account on the host chain, which is set once
+	// the channel of the interchain account is opened
+	IcaAddress string `protobuf:"bytes,9,opt,name=ica_address,json=icaAddress,proto3" json:"ica_address,omitempty" yaml:"ica_address"`
+	// transferring_amount specifies the amount of the deposits being transferred to the interchain account
+	TransferringAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,10,opt,name=transferring_amount,json=transferringAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"transferring_amount" yaml:"transferring_amount"`
+	// transfer_sequence specifies the packet sequence of the transfer in flight
+	TransferSequence uint64 `protobuf:"varint,11,opt,name=transfer_sequence,json=transferSequence,proto3" json:"transfer_sequence,omitempty" yaml:"transfer_sequence"`
+	// idle_amount specifies the balance of the interchain account which is not delegated yet
+	IdleAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,12,opt,name=idle_amount,json=idleAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"idle_amount" yaml:"idle_amount"`
+	// delegating_amount specifies the amount being delegated by the interchain account
+	DelegatingAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,13,opt,name=delegating_amount,json=delegatingAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"delegating_amount" yaml:"delegating_amount"`
+	// delegate_sequence specifies the packet sequence of the delegation in flight
+	DelegateSequence uint64 `protobuf:"varint,14,opt,name=delegate_sequence,json=delegateSequence,proto3" json:"delegate_sequence,omitempty" yaml:"delegate_sequence"`
+	// delegated_amount specifies the token amount worth of the delegation of the interchain account
+	DelegatedAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,15,opt,name=delegated_amount,json=delegatedAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"delegated_amount" yaml:"delegated_amount"`
+	// last_state_height specifies the host chain height from which the host chain state must be proven to update
+	// idle_amount and delegated_amount
+	LastStateHeight uint64 `protobuf:"varint,16,opt,name=last_state_height,json=lastStateHeight,proto3" json:"last_state_height,omitempty" yaml:"last_state_height"`
+}
+
+func (m *HostZone) Reset()         { *m = HostZone{} }
+func (m *HostZone) String() string { return proto.CompactTextString(m) }
+func (*HostZone) ProtoMessage()    {}
+func (*HostZone) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f11ef7f6d0889fb0, []int{8}
+}
+func (m *HostZone) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HostZone) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HostZone.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HostZone) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HostZone.Merge(m, src)
+}
+func (m *HostZone) XXX_Size() int {
+	return m.Size()
+}
+func (m *HostZone) XXX_DiscardUnknown() {
+	xxx_messageInfo_HostZone.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HostZone proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("crescent.liquidstaking.v1beta1.ValidatorStatus", ValidatorStatus_name, ValidatorStatus_value)
 	proto.RegisterType((*Params)(nil), "crescent.liquidstaking.v1beta1.Params")
 	proto.RegisterType((*WhitelistedValidator)(nil), "crescent.liquidstaking.v1beta1.WhitelistedValidator")
 	proto.RegisterType((*LiquidValidator)(nil), "crescent.liquidstaking.v1beta1.LiquidValidator")
 	proto.RegisterType((*LiquidValidatorState)(nil), "crescent.liquidstaking.v1beta1.LiquidValidatorState")
+	proto.RegisterType((*UnstakingRecord)(nil), "crescent.liquidstaking.v1beta1.UnstakingRecord")
 	proto.RegisterType((*NetAmountState)(nil), "crescent.liquidstaking.v1beta1.NetAmountState")
+	proto.RegisterType((*NetAmountSnapshot)(nil), "crescent.liquidstaking.v1beta1.NetAmountSnapshot")
 	proto.RegisterType((*VotingPower)(nil), "crescent.liquidstaking.v1beta1.VotingPower")
+	proto.RegisterType((*HostZone)(nil), "crescent.liquidstaking.v1beta1.HostZone")
 }
 
 func init() {
@@ -358,76 +581,140 @@ func init() {
 }
 
 var fileDescriptor_f11ef7f6d0889fb0 = []byte{
-	// 1097 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcf, 0x4f, 0x1b, 0x47,
-	0x14, 0xf6, 0x82, 0x43, 0x60, 0x12, 0xc0, 0x6c, 0x0c, 0x18, 0x93, 0xda, 0xd6, 0x4a, 0xad, 0xa2,
-	0x4a, 0x78, 0x0b, 0x8d, 0x7a, 0x40, 0xaa, 0x54, 0x13, 0xa0, 0x75, 0x4a, 0x29, 0x5a, 0x1b, 0x68,
-	0x73, 0xc8, 0x76, 0xbc, 0x3b, 0x98, 0x09, 0xbb, 0x33, 0xdb, 0x9d, 0xb1, 0x1d, 0x2e, 0x3d, 0x47,
-	0x1c, 0xaa, 0x8a, 0x53, 0x2f, 0x48, 0x51, 0xab, 0xfe, 0x21, 0xbd, 0xe5, 0x52, 0x29, 0xc7, 0xaa,
-	0x07, 0xab, 0x82, 0x4a, 0xed, 0x99, 0x73, 0x0f, 0xd5, 0xce, 0x8c, 0x7f, 0xd3, 0x56, 0x38, 0xf8,
-	0x62, 0xcf, 0x9b, 0xf7, 0xbe, 0xef, 0xbd, 0xef, 0xbd, 0x99, 0x31, 0x58, 0x71, 0x42, 0xc4, 0x1c,
-	0x44, 0xb8, 0xe9, 0xe1, 0xaf, 0x6b, 0xd8, 0x65, 0x1c, 0x1e, 0x61, 0x52, 0x35, 0xeb, 0xcb, 0x15,
-	0xc4, 0xe1, 0x72, 0xaf, 0x35, 0x1f, 0x84, 0x94, 0x53, 0x3d, 0xd3, 0x8a, 0xc9, 0xf7, 0xee, 0xaa,
-	0x98, 0x74, 0xb2, 0x4a, 0xab, 0x54, 0xb8, 0x9a, 0xd1, 0x2f, 0x19, 0x95, 0x5e, 0x70, 0x28, 0xf3,
-	0x29, 0xb3, 0xe5, 0x86, 0x5c, 0xa8, 0xad, 0x8c, 0x5c, 0x99, 0x15, 0xc8, 0x50, 0x9b, 0xd9, 0xa1,
-	0x98, 0xa8, 0xfd, 0x6c, 0x95, 0xd2, 0xaa, 0x87, 0x4c, 0xb1, 0xaa, 0xd4, 0x0e, 0x4c, 0x8e, 0x7d,
-	0xc4, 0x38, 0xf4, 0x03, 0xe5, 0x20, 0xbf, 0x9c, 0xa5, 0x2a, 0x22, 0x4b, 0x34, 0x40, 0x04, 0x06,
-	0xb8, 0xbe, 0x62, 0xd2, 0x80, 0x63, 0x4a, 0x98, 0x09, 0x09, 0xa1, 0x1c, 0x8a, 0xdf, 0xd2, 0xd1,
-	0xf8, 0x36, 0x0e, 0xc6, 0x76, 0x60, 0x08, 0x7d, 0xa6, 0x7f, 0x02, 0x66, 0x64, 0x15, 0x76, 0x85,
-	0x12, 0xd7, 0x76, 0x11, 0xa1, 0x7e, 0x4a, 0xcb, 0x69, 0x0f, 0x26, 0xd6, 0xee, 0x5f, 0x36, 0xb3,
-	0xa9, 0x63, 0xe8, 0x7b, 0xab, 0xc6, 0x80, 0x8b, 0x61, 0x4d, 0x4b, 0xdb, 0x1a, 0x25, 0xee, 0x7a,
-	0x64, 0xd1, 0x4f, 0x35, 0x30, 0xd7, 0x38, 0xc4, 0x1c, 0x79, 0x98, 0x71, 0xe4, 0xda, 0x75, 0xe8,
-	0x61, 0x17, 0x72, 0x1a, 0xb2, 0xd4, 0x48, 0x6e, 0xf4, 0xc1, 0x9d, 0x95, 0x87, 0xf9, 0xff, 0x16,
-	0x2e, 0xbf, 0xdf, 0x89, 0xde, 0x6b, 0x05, 0xaf, 0xbd, 0xfd, 0xaa, 0x99, 0x8d, 0x5d, 0x36, 0xb3,
-	0x6f, 0xc9, 0x4c, 0xae, 0x66, 0x30, 0xac, 0xd9, 0xc6, 0x15, 0xc1, 0x4c, 0x67, 0x20, 0x51, 0x23,
-	0x11, 0x0f, 0xb2, 0x0f, 0x10, 0xb2, 0x43, 0xc8, 0x51, 0x6a, 0x54, 0x54, 0x57, 0x8c, 0x70, 0x7f,
-	0x6b, 0x66, 0xdf, 0xa9, 0x62, 0x7e, 0x58, 0xab, 0xe4, 0x1d, 0xea, 0xab, 0xae, 0xa8, 0xaf, 0x25,
-	0xe6, 0x1e, 0x99, 0xfc, 0x38, 0x40, 0x2c, 0xbf, 0x8e, 0x9c, 0xcb, 0x66, 0x76, 0x5e, 0x66, 0xd0,
-	0x8f, 0x67, 0x58, 0x53, 0xca, 0xb4, 0x89, 0x90, 0x05, 0x39, 0xd2, 0x7f, 0xd2, 0xc0, 0x82, 0x8f,
-	0x89, 0xad, 0x54, 0x53, 0x65, 0xda, 0xd0, 0xa7, 0x35, 0xc2, 0x53, 0xb7, 0x04, 0xfd, 0xb3, 0xd3,
-	0xc2, 0xec, 0xe3, 0x09, 0x63, 0xf9, 0x3d, 0xf1, 0x31, 0x7e, 0x18, 0xb9, 0xcd, 0xdc, 0xa3, 0x7c,
-	0x91, 0xf0, 0x6b, 0xa4, 0x55, 0x24, 0xfc, 0xb2, 0x99, 0xcd, 0xc9, 0xb4, 0xfe, 0x95, 0xd0, 0xb0,
-	0xe6, 0x7c, 0x4c, 0xb6, 0xc4, 0x56, 0x49, 0xee, 0x14, 0xc4, 0xc6, 0xea, 0xf8, 0x8b, 0x97, 0xd9,
-	0xd8, 0xf7, 0x2f, 0xb3, 0x31, 0xe3, 0x4f, 0x0d, 0x24, 0xaf, 0x52, 0x5f, 0x2f, 0x82, 0x99, 0xb6,
-	0xca, 0x36, 0x74, 0xdd, 0x10, 0x31, 0x36, 0x38, 0x1e, 0x03, 0x2e, 0x86, 0x95, 0x68, 0xdb, 0x0a,
-	0xd2, 0xa4, 0x7f, 0x03, 0x26, 0x39, 0x0c, 0xab, 0x88, 0xdb, 0x0d, 0x84, 0xab, 0x87, 0x3c, 0x35,
-	0x22, 0x60, 0xbe, 0x3c, 0x2d, 0x24, 0x1e, 0xc7, 0x8d, 0xe5, 0x37, 0xd2, 0x20, 0x29, 0xf3, 0xe8,
-	0xc1, 0x37, 0xac, 0xbb, 0x72, 0xbd, 0x2f, 0x96, 0xab, 0xf1, 0xa8, 0x5a, 0xc3, 0x01, 0xd3, 0x52,
-	0x8a, 0x4e, 0x8d, 0x9b, 0x20, 0x41, 0x03, 0x14, 0x5e, 0x51, 0xe2, 0x62, 0xa7, 0xeb, 0xfd, 0x1e,
-	0x86, 0x35, 0xdd, 0x32, 0xa9, 0x02, 0xa5, 0x9c, 0x7f, 0x45, 0x24, 0x3f, 0x8f, 0x82, 0x64, 0x1f,
-	0x4b, 0x89, 0x47, 0x93, 0x71, 0x43, 0x54, 0xfa, 0x33, 0x30, 0xd6, 0x23, 0xa2, 0x75, 0x13, 0x22,
-	0x4e, 0xaa, 0x13, 0xa6, 0xd4, 0x53, 0x0c, 0xfa, 0xc7, 0x60, 0x8c, 0x71, 0xc8, 0x6b, 0x4c, 0x1c,
-	0x9c, 0xa9, 0x15, 0xf3, 0xff, 0x8e, 0x71, 0x4f, 0xcd, 0x35, 0x66, 0xa9, 0x70, 0xfd, 0x33, 0x00,
-	0x5c, 0xe4, 0xd9, 0xec, 0x10, 0x86, 0x88, 0xa5, 0xe2, 0x22, 0xf1, 0xfc, 0xf5, 0x4e, 0xa1, 0x35,
-	0xe1, 0x22, 0xaf, 0x24, 0x00, 0xf4, 0x12, 0x98, 0x54, 0xf3, 0xce, 0xe9, 0x11, 0x22, 0x4c, 0x1d,
-	0xac, 0xfc, 0xf5, 0xea, 0xb6, 0xee, 0x4a, 0x90, 0xb2, 0xc0, 0xe8, 0xea, 0xe1, 0xdf, 0xb7, 0xc0,
-	0xd4, 0x36, 0xe2, 0xf2, 0xa8, 0xc8, 0xee, 0x7d, 0x0a, 0x26, 0x7c, 0x4c, 0xb8, 0xbc, 0x45, 0xb4,
-	0xa1, 0xf2, 0x1f, 0x8f, 0x00, 0xc4, 0x25, 0xf1, 0x14, 0xdc, 0xab, 0x88, 0xc4, 0x6d, 0x4e, 0x39,
-	0xf4, 0x6c, 0x56, 0x0b, 0x02, 0xef, 0x58, 0xf5, 0xf3, 0xba, 0x45, 0xcc, 0x48, 0xa8, 0x72, 0x84,
-	0x54, 0x12, 0x40, 0x91, 0xda, 0x04, 0xf1, 0xd6, 0xa5, 0x33, 0x3a, 0x9c, 0xda, 0xa4, 0x25, 0x80,
-	0xfe, 0x05, 0x48, 0xc8, 0x3c, 0xdf, 0xb8, 0x85, 0x53, 0x02, 0x67, 0xbd, 0xdd, 0xc7, 0xa7, 0xe0,
-	0x9e, 0x44, 0xbe, 0x89, 0x6e, 0xce, 0x08, 0xa8, 0xad, 0xae, 0x96, 0xea, 0x07, 0x60, 0x5e, 0xe2,
-	0x87, 0xc8, 0x87, 0x98, 0x44, 0x17, 0x63, 0x88, 0x1a, 0x30, 0x74, 0x59, 0x6a, 0x6c, 0xa8, 0x02,
-	0x66, 0x05, 0x9c, 0xd5, 0x42, 0xb3, 0x24, 0x58, 0x87, 0xa7, 0x46, 0xa2, 0x77, 0x32, 0xe2, 0xa9,
-	0x40, 0x0f, 0x12, 0x07, 0xa5, 0x6e, 0x0f, 0x55, 0x8b, 0xe4, 0xd9, 0x6d, 0xa1, 0xad, 0x49, 0x30,
-	0xfd, 0x09, 0x98, 0x09, 0x42, 0xfa, 0xfc, 0xd8, 0x86, 0x8e, 0xd3, 0x66, 0x18, 0x1f, 0x8a, 0x61,
-	0x5a, 0x00, 0x15, 0x1c, 0x47, 0x61, 0x8b, 0xf1, 0xd7, 0xc4, 0xf8, 0xff, 0x31, 0x02, 0xee, 0xec,
-	0x51, 0x8e, 0x49, 0x75, 0x87, 0x36, 0x50, 0xa8, 0x27, 0xc1, 0xad, 0x3a, 0xe5, 0x28, 0x94, 0x73,
-	0x6f, 0xc9, 0x85, 0xfe, 0x15, 0x48, 0xb6, 0x1e, 0x9b, 0xba, 0x70, 0xb6, 0x83, 0xc8, 0x7b, 0xc8,
-	0x29, 0xd6, 0x15, 0x56, 0x37, 0xaf, 0x0f, 0x16, 0xfb, 0x5e, 0xb5, 0x1e, 0xa2, 0xd1, 0xa1, 0x88,
-	0x52, 0x5e, 0xf7, 0x6b, 0xd8, 0x4d, 0xe7, 0x82, 0xb9, 0xce, 0x63, 0xd6, 0xc3, 0x14, 0x1f, 0x8a,
-	0x29, 0xd9, 0x46, 0xeb, 0x62, 0xe9, 0xdc, 0x32, 0xef, 0xfe, 0xa2, 0x81, 0xe9, 0xbe, 0xfb, 0x52,
-	0xff, 0x08, 0xdc, 0xdf, 0x2b, 0x6c, 0x15, 0xd7, 0x0b, 0xe5, 0xcf, 0x2d, 0xbb, 0x54, 0x2e, 0x94,
-	0x77, 0x4b, 0xf6, 0xee, 0x76, 0x69, 0x67, 0xe3, 0x51, 0x71, 0xb3, 0xb8, 0xb1, 0x9e, 0x88, 0xa5,
-	0x33, 0x27, 0x67, 0xb9, 0x74, 0x5f, 0xd8, 0x2e, 0x61, 0x01, 0x72, 0xf0, 0x01, 0x46, 0xae, 0xfe,
-	0x01, 0x98, 0x1f, 0x40, 0x28, 0x3c, 0x2a, 0x17, 0xf7, 0x36, 0x12, 0x5a, 0x7a, 0xe1, 0xe4, 0x2c,
-	0x37, 0xdb, 0x17, 0x5c, 0x70, 0x38, 0xae, 0x23, 0x7d, 0x15, 0x2c, 0x0c, 0xc4, 0x15, 0xb7, 0x55,
-	0xe4, 0x48, 0x7a, 0xf1, 0xe4, 0x2c, 0x37, 0xdf, 0x17, 0x59, 0x24, 0x50, 0xc4, 0xa6, 0xe3, 0x2f,
-	0x7e, 0xcc, 0xc4, 0xd6, 0xf6, 0x5f, 0x9d, 0x67, 0xb4, 0xd7, 0xe7, 0x19, 0xed, 0xf7, 0xf3, 0x8c,
-	0xf6, 0xdd, 0x45, 0x26, 0xf6, 0xfa, 0x22, 0x13, 0xfb, 0xf5, 0x22, 0x13, 0x7b, 0xf2, 0x61, 0xb7,
-	0x62, 0xea, 0x01, 0x59, 0x22, 0x88, 0x37, 0x68, 0x78, 0xd4, 0x36, 0x98, 0xf5, 0x87, 0xe6, 0xf3,
-	0xbe, 0xbf, 0xe2, 0x42, 0xcc, 0xca, 0x98, 0xf8, 0xe7, 0xfa, 0xfe, 0x3f, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0xfd, 0x48, 0xe5, 0xb0, 0xb1, 0x0b, 0x00, 0x00,
+	// 2124 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x59, 0xdd, 0x6f, 0x1b, 0x59,
+	0x15, 0x8f, 0xed, 0xd4, 0x8d, 0x6f, 0x5a, 0x7f, 0x4c, 0xd2, 0x64, 0xea, 0xb6, 0x9e, 0xec, 0x14,
+	0x96, 0xa8, 0xa2, 0x36, 0x09, 0x15, 0xa0, 0x48, 0x2b, 0x61, 0x27, 0x29, 0x75, 0x08, 0xa1, 0x5c,
+	0x27, 0x2d, 0x54, 0x62, 0x87, 0xeb, 0x99, 0x1b, 0x7b, 0x36, 0xe3, 0x3b, 0xde, 0x99, 0xeb, 0x24,
+	0x95, 0xb6, 0xbc, 0x21, 0xad, 0xca, 0xcb, 0xaa, 0x4f, 0xf0, 0x50, 0x69, 0x05, 0x42, 0xfc, 0x01,
+	0xfc, 0x01, 0x88, 0xb7, 0x7d, 0xdc, 0x47, 0xc4, 0x83, 0x41, 0x2d, 0x12, 0x3c, 0xf0, 0xe4, 0xbf,
+	0x00, 0xdd, 0x8f, 0xf9, 0xf0, 0x47, 0x5b, 0xd9, 0x69, 0x5f, 0xe2, 0x7b, 0x3e, 0x7e, 0xe7, 0x9e,
+	0x73, 0xee, 0x39, 0xf7, 0xcc, 0x2d, 0xd8, 0x34, 0x3d, 0xec, 0x9b, 0x98, 0xd0, 0x8a, 0x63, 0x7f,
+	0xda, 0xb3, 0x2d, 0x9f, 0xa2, 0x13, 0x9b, 0xb4, 0x2a, 0xa7, 0x1b, 0x4d, 0x4c, 0xd1, 0xc6, 0x30,
+	0xb5, 0xdc, 0xf5, 0x5c, 0xea, 0x2a, 0xa5, 0x40, 0xa7, 0x3c, 0xcc, 0x95, 0x3a, 0xc5, 0xe5, 0x96,
+	0xdb, 0x72, 0xb9, 0x68, 0x85, 0xfd, 0x12, 0x5a, 0xc5, 0xeb, 0xa6, 0xeb, 0x77, 0x5c, 0xdf, 0x10,
+	0x0c, 0xb1, 0x90, 0xac, 0x92, 0x58, 0x55, 0x9a, 0xc8, 0xc7, 0xa1, 0x65, 0xd3, 0xb5, 0x89, 0xe4,
+	0x6b, 0x2d, 0xd7, 0x6d, 0x39, 0xb8, 0xc2, 0x57, 0xcd, 0xde, 0x71, 0x85, 0xda, 0x1d, 0xec, 0x53,
+	0xd4, 0xe9, 0x4a, 0x01, 0xf1, 0xc7, 0xbc, 0xdb, 0xc2, 0xe4, 0xae, 0xdb, 0xc5, 0x04, 0x75, 0xed,
+	0xd3, 0xcd, 0x8a, 0xdb, 0xa5, 0xb6, 0x4b, 0xfc, 0x0a, 0x22, 0xc4, 0xa5, 0x88, 0xff, 0x16, 0x82,
+	0xfa, 0x5f, 0xb2, 0x20, 0xfd, 0x10, 0x79, 0xa8, 0xe3, 0x2b, 0x0f, 0x40, 0x41, 0x78, 0x61, 0x34,
+	0x5d, 0x62, 0x19, 0x16, 0x26, 0x6e, 0x47, 0x4d, 0xac, 0x25, 0xd6, 0x33, 0xb5, 0x9b, 0x83, 0xbe,
+	0xa6, 0x3e, 0x45, 0x1d, 0x67, 0x4b, 0x1f, 0x13, 0xd1, 0x61, 0x4e, 0xd0, 0x6a, 0x2e, 0xb1, 0x76,
+	0x18, 0x45, 0x79, 0x91, 0x00, 0x2b, 0x67, 0x6d, 0x9b, 0x62, 0xc7, 0xf6, 0x29, 0xb6, 0x8c, 0x53,
+	0xe4, 0xd8, 0x16, 0xa2, 0xae, 0xe7, 0xab, 0xc9, 0xb5, 0xd4, 0xfa, 0xe2, 0xe6, 0xbd, 0xf2, 0xdb,
+	0x03, 0x57, 0x7e, 0x1c, 0x69, 0x3f, 0x0a, 0x94, 0x6b, 0xdf, 0xfc, 0xaa, 0xaf, 0xcd, 0x0d, 0xfa,
+	0xda, 0x2d, 0xb1, 0x93, 0xc9, 0x16, 0x74, 0x78, 0xed, 0x6c, 0x82, 0xb2, 0xaf, 0xf8, 0x20, 0xdf,
+	0x23, 0xcc, 0x0e, 0x36, 0x8e, 0x31, 0x36, 0x3c, 0x44, 0xb1, 0x9a, 0xe2, 0xde, 0xd5, 0x19, 0xee,
+	0x3f, 0xfa, 0xda, 0x87, 0x2d, 0x9b, 0xb6, 0x7b, 0xcd, 0xb2, 0xe9, 0x76, 0x64, 0x56, 0xe4, 0x9f,
+	0xbb, 0xbe, 0x75, 0x52, 0xa1, 0x4f, 0xbb, 0xd8, 0x2f, 0xef, 0x60, 0x73, 0xd0, 0xd7, 0x56, 0xc5,
+	0x0e, 0x46, 0xf1, 0x74, 0x98, 0x95, 0xa4, 0xfb, 0x18, 0x43, 0x44, 0xb1, 0xf2, 0xa7, 0x04, 0xb8,
+	0xde, 0xb1, 0x89, 0x21, 0xa3, 0x26, 0xdd, 0x34, 0x50, 0xc7, 0xed, 0x11, 0xaa, 0x5e, 0xe2, 0xe6,
+	0x3f, 0x79, 0x51, 0xbd, 0xb6, 0x97, 0xd1, 0x37, 0xbe, 0xc3, 0xff, 0xe9, 0x7f, 0x48, 0x5e, 0xf6,
+	0xad, 0x93, 0x72, 0x9d, 0xd0, 0x29, 0xb6, 0x55, 0x27, 0x74, 0xd0, 0xd7, 0xd6, 0xc4, 0xb6, 0xde,
+	0x68, 0x50, 0x87, 0x2b, 0x1d, 0x9b, 0xec, 0x73, 0x56, 0x43, 0x70, 0xaa, 0x9c, 0xa1, 0x3c, 0x03,
+	0x4b, 0x1e, 0x6e, 0x22, 0x07, 0x11, 0x93, 0x89, 0x53, 0xcf, 0x6e, 0xb5, 0xb0, 0xa7, 0xa6, 0xf9,
+	0x06, 0xf7, 0xa7, 0x8e, 0x4f, 0x51, 0x6c, 0x64, 0x02, 0xa4, 0x0e, 0x95, 0x18, 0xf5, 0x50, 0x10,
+	0x95, 0x33, 0xf0, 0x41, 0x07, 0x9d, 0x1b, 0x1e, 0xb6, 0xb0, 0x83, 0x5b, 0xe2, 0x80, 0x1a, 0x5d,
+	0xec, 0x19, 0x31, 0x59, 0xf5, 0xf2, 0x5a, 0x62, 0xfd, 0x6a, 0xed, 0xdb, 0x83, 0xbe, 0xb6, 0x2e,
+	0xfd, 0x7c, 0x97, 0x8a, 0x0e, 0x4b, 0x1d, 0x74, 0x0e, 0xe3, 0x22, 0x0f, 0xb1, 0x07, 0x23, 0x01,
+	0xe5, 0x97, 0x40, 0xf5, 0xf0, 0x19, 0xf2, 0x2c, 0xc3, 0x74, 0x3b, 0x5d, 0xb7, 0x47, 0x2c, 0xb6,
+	0x57, 0xdc, 0x75, 0xcd, 0xb6, 0xba, 0xc0, 0xed, 0xdd, 0x1e, 0xf4, 0x35, 0x2d, 0x70, 0x67, 0xb2,
+	0xa4, 0x0e, 0x57, 0x04, 0x6b, 0x3b, 0xe2, 0xec, 0x32, 0x86, 0x72, 0x02, 0x6e, 0x11, 0x4c, 0x65,
+	0xf4, 0x0d, 0x9f, 0xa0, 0xae, 0xdf, 0x76, 0xa9, 0xe1, 0x61, 0x8a, 0x09, 0xdb, 0x8e, 0x9a, 0xe1,
+	0x36, 0xd6, 0x07, 0x7d, 0xed, 0x1b, 0xc2, 0xc6, 0x5b, 0xc5, 0x75, 0x58, 0x24, 0x98, 0x8a, 0x94,
+	0x35, 0x24, 0x17, 0x06, 0x4c, 0xe5, 0xb7, 0x09, 0xa0, 0x8a, 0xea, 0x77, 0x1d, 0xb6, 0xc9, 0x8e,
+	0xed, 0xfb, 0xb6, 0x4b, 0xc4, 0x49, 0x07, 0x3c, 0x93, 0x3f, 0x9b, 0x3a, 0x93, 0xd2, 0xf5, 0x37,
+	0xe1, 0xea, 0x70, 0x25, 0x60, 0x6d, 0x87, 0x1c, 0x7e, 0xf2, 0x3d, 0xa0, 0x4d, 0x52, 0xb2, 0xb0,
+	0x4f, 0x6d, 0xc2, 0x73, 0xa1, 0x2e, 0xf2, 0x3d, 0xdd, 0x19, 0xf4, 0xb5, 0x0f, 0xdf, 0x6c, 0x25,
+	0xa6, 0xa0, 0xc3, 0x5b, 0xe3, 0xc6, 0x76, 0x22, 0xbe, 0xf2, 0x19, 0x58, 0x62, 0x67, 0xdf, 0xc7,
+	0xce, 0xb1, 0x11, 0xe5, 0x5c, 0xbd, 0x32, 0xf5, 0x29, 0x16, 0xe5, 0x54, 0x8c, 0xca, 0x69, 0x04,
+	0x52, 0x87, 0x85, 0x8e, 0x4d, 0x1a, 0xd8, 0x39, 0xde, 0x09, 0x69, 0xdc, 0x3a, 0x3a, 0x1f, 0x8b,
+	0xfc, 0xd5, 0x8b, 0xd5, 0xd0, 0x04, 0x48, 0x66, 0x1d, 0x9d, 0x8f, 0xc4, 0xfb, 0x19, 0xb8, 0xfd,
+	0xa6, 0xf3, 0x69, 0xd8, 0x16, 0x3b, 0x22, 0xc7, 0x36, 0xf6, 0xd4, 0x2c, 0xdf, 0x4d, 0x79, 0xd0,
+	0xd7, 0xee, 0xbc, 0xfd, 0x50, 0xc7, 0x94, 0x74, 0xb8, 0x36, 0xf9, 0x7c, 0xd7, 0x43, 0x11, 0xc5,
+	0x06, 0x37, 0xe3, 0xd5, 0x3e, 0x66, 0x37, 0xc7, 0xed, 0x7e, 0x6b, 0xd0, 0xd7, 0x6e, 0x8f, 0xf7,
+	0x86, 0x71, 0x83, 0xc5, 0x18, 0x7b, 0xd4, 0xd4, 0x19, 0xf8, 0xc0, 0x26, 0x14, 0x7b, 0x66, 0x1b,
+	0x8d, 0x37, 0x3a, 0x4c, 0x50, 0xd3, 0xc1, 0x96, 0x9a, 0x5f, 0x4b, 0xac, 0x2f, 0xc4, 0x9b, 0xc5,
+	0x3b, 0x55, 0x74, 0x58, 0x8a, 0x64, 0x86, 0x7a, 0xe4, 0xae, 0x10, 0xd8, 0x5a, 0xf8, 0xfc, 0x4b,
+	0x6d, 0xee, 0x77, 0x5f, 0x6a, 0x73, 0xfa, 0x7f, 0x12, 0x60, 0x79, 0xd2, 0x15, 0xa5, 0xd4, 0x41,
+	0x21, 0xbc, 0x8a, 0x0c, 0x64, 0x59, 0x1e, 0xf6, 0xfd, 0xf1, 0x3b, 0x74, 0x4c, 0x44, 0x87, 0xf9,
+	0x90, 0x56, 0x15, 0x24, 0xe5, 0xd7, 0xe0, 0x2a, 0x45, 0x5e, 0x0b, 0x53, 0xe3, 0x0c, 0xdb, 0xad,
+	0x36, 0x55, 0x93, 0x1c, 0xe6, 0x17, 0x2f, 0xaa, 0xf9, 0xbd, 0x79, 0x7d, 0xe3, 0x42, 0x17, 0xc5,
+	0xb2, 0xd8, 0xc7, 0x10, 0xbe, 0x0e, 0xaf, 0x88, 0xf5, 0x63, 0xbe, 0xdc, 0x9a, 0x67, 0xde, 0xea,
+	0x7f, 0x4d, 0x80, 0x9c, 0x08, 0x46, 0xe4, 0xe4, 0x7d, 0x90, 0x77, 0xbb, 0xd8, 0x9b, 0xe0, 0xe3,
+	0x8d, 0xe8, 0x6e, 0x1c, 0x95, 0xd0, 0x61, 0x2e, 0x20, 0x05, 0x1e, 0x3e, 0x01, 0x69, 0x9f, 0x22,
+	0xda, 0xf3, 0xb9, 0x6b, 0xd9, 0xcd, 0xca, 0xbb, 0xa6, 0x82, 0x70, 0x0b, 0x0d, 0xae, 0x56, 0x2b,
+	0x0c, 0xfa, 0xda, 0x55, 0x61, 0x4e, 0x00, 0xe9, 0x50, 0x22, 0x8a, 0x5c, 0xfd, 0x97, 0x79, 0xf0,
+	0xb7, 0x14, 0x58, 0x1e, 0xf1, 0x80, 0xa9, 0xe3, 0xf7, 0xe6, 0xc6, 0x27, 0x20, 0x3d, 0x94, 0x21,
+	0xf8, 0x3e, 0x32, 0x24, 0xdd, 0x0a, 0x52, 0x23, 0x2d, 0x28, 0x3f, 0x0a, 0x43, 0x96, 0x9a, 0x29,
+	0x64, 0x41, 0x7c, 0x94, 0x9f, 0x00, 0x60, 0x61, 0xc7, 0xf0, 0xdb, 0xc8, 0xc3, 0xbe, 0x3a, 0x2f,
+	0xba, 0xc2, 0x74, 0x3d, 0x0a, 0x66, 0x2c, 0xec, 0x34, 0x38, 0x80, 0xd2, 0x00, 0x57, 0x65, 0x55,
+	0x51, 0xf7, 0x04, 0x13, 0x5f, 0xbd, 0x34, 0x35, 0x62, 0x9d, 0x50, 0x78, 0x45, 0x80, 0x1c, 0x72,
+	0x8c, 0x58, 0x0e, 0xff, 0x9c, 0x02, 0xb9, 0x23, 0x22, 0x7d, 0x83, 0xd8, 0x74, 0x3d, 0x4b, 0xc9,
+	0x82, 0xa4, 0x6d, 0xf1, 0x84, 0xcd, 0xc3, 0xa4, 0x6d, 0x29, 0x1f, 0x85, 0x5b, 0x60, 0x72, 0xd8,
+	0x93, 0xd9, 0x50, 0xa3, 0xe3, 0x3e, 0xc4, 0xd6, 0x03, 0x63, 0x0d, 0xbe, 0x9c, 0x5c, 0xb9, 0xa9,
+	0x99, 0x2a, 0x77, 0x1b, 0xe4, 0x4c, 0x0f, 0xf3, 0x4b, 0xc1, 0x68, 0x8b, 0x93, 0xc1, 0x02, 0x9c,
+	0xaa, 0x15, 0x07, 0x7d, 0x6d, 0x45, 0x00, 0x8d, 0x08, 0xe8, 0x30, 0x1b, 0x50, 0x1e, 0x88, 0x4c,
+	0xb7, 0x40, 0x8e, 0xf5, 0x64, 0x07, 0x73, 0x29, 0x36, 0xe6, 0xf3, 0x98, 0x2e, 0x6e, 0x16, 0xcb,
+	0xe2, 0x1b, 0xa0, 0x1c, 0x7c, 0x03, 0x94, 0x0f, 0x83, 0x6f, 0x80, 0x9a, 0x2e, 0x27, 0xe4, 0xc0,
+	0xc8, 0x30, 0x80, 0xfe, 0xc5, 0x3f, 0xb5, 0x04, 0xcc, 0x46, 0x54, 0xa6, 0xa8, 0xdc, 0x07, 0x69,
+	0x39, 0x8e, 0xa6, 0x67, 0xca, 0x99, 0xd4, 0x96, 0xfd, 0xe2, 0x7f, 0x69, 0x90, 0x3d, 0x08, 0x67,
+	0x14, 0x5e, 0x67, 0x3f, 0x06, 0x99, 0x8e, 0x4d, 0xa8, 0xb8, 0x0d, 0x13, 0x33, 0x9d, 0xb4, 0x05,
+	0x06, 0xc0, 0xaf, 0xb9, 0x8f, 0xc1, 0x52, 0x93, 0x1f, 0x31, 0x83, 0xba, 0x14, 0x39, 0x86, 0xdf,
+	0xeb, 0x76, 0x9d, 0xa7, 0x6a, 0x72, 0x6a, 0x58, 0xb6, 0xf5, 0x82, 0x80, 0x3a, 0x64, 0x48, 0x0d,
+	0x0e, 0xc4, 0xea, 0x22, 0x1a, 0xc1, 0xd4, 0xd4, 0xd4, 0xb0, 0xbc, 0x2e, 0xc2, 0x21, 0x4d, 0xf9,
+	0x39, 0xc8, 0x8b, 0x7d, 0x5e, 0xb8, 0xd8, 0xb2, 0x1c, 0x67, 0x27, 0xac, 0xb8, 0x8f, 0xc1, 0x92,
+	0x40, 0x7e, 0x1f, 0x75, 0x57, 0xe0, 0x50, 0xfb, 0xb1, 0xe2, 0x53, 0x8e, 0xc1, 0xaa, 0xc0, 0xf7,
+	0x70, 0x07, 0xd9, 0x84, 0x5d, 0x94, 0x62, 0x08, 0xf0, 0xd5, 0xf4, 0x4c, 0x0e, 0x5c, 0xe3, 0x70,
+	0x30, 0x40, 0x83, 0x02, 0x2c, 0xb2, 0xd3, 0x23, 0xec, 0x9b, 0x92, 0xd9, 0x11, 0x37, 0x3f, 0x56,
+	0x2f, 0x4f, 0x6d, 0x87, 0xf9, 0x22, 0xec, 0x1c, 0x05, 0x68, 0x35, 0x01, 0xa6, 0x3c, 0x01, 0x85,
+	0xae, 0xe7, 0x9e, 0x3f, 0x35, 0x90, 0x69, 0x86, 0x16, 0x16, 0x66, 0xb2, 0x90, 0xe3, 0x40, 0x55,
+	0xd3, 0x0c, 0xb0, 0x09, 0xb8, 0xd1, 0xc5, 0x62, 0xef, 0x13, 0x46, 0x58, 0x35, 0x33, 0xb5, 0x15,
+	0x16, 0xaf, 0xeb, 0x12, 0xf2, 0xe1, 0xd8, 0xc8, 0xcb, 0x1b, 0x63, 0x82, 0x37, 0xc6, 0xdf, 0xa7,
+	0x40, 0xe1, 0x60, 0xf4, 0x93, 0x40, 0x59, 0x01, 0x69, 0xd9, 0x77, 0x58, 0xb9, 0xa5, 0xa0, 0x5c,
+	0x29, 0x3f, 0x00, 0xf3, 0xbc, 0x91, 0x24, 0xdf, 0xd9, 0x48, 0x16, 0xd8, 0x66, 0x79, 0xbb, 0xe0,
+	0x1a, 0xef, 0xbb, 0x2c, 0x3e, 0x9b, 0x5c, 0xc5, 0xf3, 0x17, 0x1b, 0xd4, 0x27, 0x40, 0xea, 0x93,
+	0x6a, 0xdc, 0x88, 0x37, 0x24, 0x51, 0x30, 0xb5, 0xa9, 0xc7, 0xf3, 0x7c, 0xf8, 0x71, 0x40, 0xe5,
+	0x50, 0x1e, 0x36, 0x29, 0xd9, 0x0a, 0xff, 0x9d, 0x04, 0x8b, 0x8f, 0x5c, 0xca, 0x52, 0xe8, 0x9e,
+	0x61, 0x4f, 0x59, 0x06, 0x97, 0x4e, 0x5d, 0x8a, 0x3d, 0xd1, 0x03, 0xa1, 0x58, 0x28, 0xbf, 0x02,
+	0xcb, 0xc1, 0x20, 0x7a, 0xca, 0x85, 0x8d, 0x2e, 0x93, 0x9e, 0xb1, 0xa3, 0x29, 0x12, 0x2b, 0x6e,
+	0xb7, 0x03, 0x6e, 0x8c, 0x4c, 0xbc, 0x43, 0x86, 0x52, 0x33, 0x19, 0x52, 0x9d, 0xf8, 0x84, 0x1c,
+	0x37, 0x67, 0x81, 0x95, 0xe8, 0x96, 0x1c, 0xb2, 0x34, 0x3f, 0x93, 0xa5, 0xe5, 0x10, 0x2d, 0x66,
+	0x25, 0x36, 0x1b, 0x0c, 0x32, 0x60, 0xe1, 0x81, 0xeb, 0xd3, 0x27, 0x2e, 0xc1, 0x4a, 0x19, 0x2c,
+	0x88, 0x19, 0x5f, 0x8e, 0x06, 0x99, 0xda, 0xd2, 0xa0, 0xaf, 0xe5, 0xe4, 0x75, 0x28, 0x39, 0x3a,
+	0xbc, 0xcc, 0x7f, 0xd6, 0xf9, 0xd0, 0x60, 0xba, 0x84, 0x60, 0x93, 0x5f, 0x92, 0xb6, 0x35, 0x3e,
+	0x34, 0x0c, 0xb1, 0x75, 0x78, 0x25, 0x5a, 0xd7, 0x2d, 0xe5, 0x00, 0x2c, 0x51, 0x0f, 0x11, 0xff,
+	0x18, 0x7b, 0x86, 0xd9, 0x46, 0x84, 0x60, 0x87, 0x81, 0x88, 0x90, 0x96, 0xa2, 0x93, 0x39, 0x41,
+	0x48, 0x87, 0x85, 0x80, 0xba, 0x2d, 0x88, 0x75, 0x4b, 0xb9, 0x07, 0x40, 0xdb, 0xf5, 0xa9, 0x7c,
+	0x7b, 0x13, 0xf1, 0xba, 0x36, 0xe8, 0x6b, 0x05, 0x01, 0x13, 0xf1, 0x74, 0x98, 0x61, 0x0b, 0xf1,
+	0xdc, 0xb6, 0x01, 0x32, 0x76, 0xd3, 0x94, 0x4a, 0xe2, 0x3c, 0x2f, 0x47, 0x27, 0x34, 0x64, 0xe9,
+	0x70, 0xc1, 0x6e, 0x9a, 0x42, 0x65, 0x0b, 0x5c, 0x91, 0xd5, 0x22, 0xb4, 0x44, 0x4b, 0x5f, 0x1d,
+	0xf4, 0xb5, 0xa5, 0xa1, 0x5a, 0x92, 0x8a, 0x8b, 0x62, 0x29, 0x74, 0x27, 0x4e, 0x4a, 0x97, 0x67,
+	0x9d, 0x94, 0x2c, 0xdc, 0x75, 0x7d, 0x9b, 0x86, 0x40, 0xa2, 0x25, 0xc7, 0x26, 0xa5, 0x11, 0x01,
+	0x1d, 0x66, 0x25, 0x25, 0x00, 0xf9, 0x3e, 0x58, 0xb4, 0x4d, 0x14, 0x02, 0x88, 0x6e, 0xbb, 0x32,
+	0xe8, 0x6b, 0x8a, 0x0c, 0x40, 0xc4, 0xd4, 0x21, 0xb0, 0x4d, 0x14, 0x28, 0x3e, 0x8b, 0xb2, 0xe7,
+	0xc5, 0x5e, 0xe5, 0xc0, 0xc5, 0xba, 0xd0, 0x04, 0x48, 0x1d, 0x2a, 0x71, 0xaa, 0x6c, 0x82, 0x75,
+	0x10, 0x9e, 0x00, 0xc3, 0xc7, 0x9f, 0xf6, 0x30, 0xbb, 0x91, 0xd8, 0x9b, 0xc8, 0x7c, 0x3c, 0x8e,
+	0x63, 0x22, 0x3a, 0xcc, 0x07, 0xb4, 0x86, 0x24, 0x29, 0x18, 0x2c, 0xda, 0x96, 0x83, 0x03, 0x0f,
+	0xc4, 0x83, 0xc7, 0xce, 0xd4, 0x1e, 0x04, 0x01, 0x8b, 0xa0, 0x58, 0xc0, 0x2c, 0x07, 0xcb, 0x1d,
+	0x9f, 0x81, 0x42, 0xf0, 0x06, 0x12, 0x85, 0x4b, 0xbc, 0x6f, 0xec, 0x4d, 0x6d, 0x4c, 0x0d, 0xd2,
+	0x3b, 0x02, 0xa8, 0xc3, 0x7c, 0x44, 0x8b, 0x42, 0x25, 0x69, 0x38, 0x0a, 0x55, 0x76, 0x34, 0x54,
+	0x63, 0x22, 0x11, 0x14, 0x0e, 0x43, 0x45, 0x41, 0x48, 0xb3, 0x02, 0x17, 0x72, 0x53, 0x3f, 0x03,
+	0x0b, 0x17, 0x56, 0x87, 0xed, 0x5a, 0xa1, 0x07, 0xb9, 0x90, 0x24, 0x1d, 0x60, 0x6f, 0xeb, 0xc8,
+	0xa7, 0xac, 0x03, 0x53, 0x1c, 0x7c, 0x14, 0xe4, 0x47, 0x1d, 0x18, 0x13, 0x61, 0x6f, 0xeb, 0xc8,
+	0x17, 0x73, 0xf4, 0x83, 0xd8, 0x67, 0xf9, 0x9d, 0xdf, 0x24, 0x41, 0x6e, 0xe4, 0xd3, 0x4e, 0xf9,
+	0x21, 0xb8, 0xf9, 0xa8, 0xba, 0x5f, 0xdf, 0xa9, 0x1e, 0xfe, 0x14, 0x1a, 0x8d, 0xc3, 0xea, 0xe1,
+	0x51, 0xc3, 0x38, 0x3a, 0x68, 0x3c, 0xdc, 0xdd, 0xae, 0xdf, 0xaf, 0xef, 0xee, 0xe4, 0xe7, 0x8a,
+	0xa5, 0xe7, 0x2f, 0xd7, 0x8a, 0x23, 0x6a, 0x47, 0xc4, 0xef, 0x62, 0x93, 0xbd, 0xac, 0x58, 0xca,
+	0xf7, 0xc0, 0xea, 0x18, 0x42, 0x75, 0xfb, 0xb0, 0xfe, 0x68, 0x37, 0x9f, 0x28, 0x5e, 0x7f, 0xfe,
+	0x72, 0xed, 0xda, 0x88, 0x72, 0xd5, 0xa4, 0xf6, 0x29, 0x56, 0xb6, 0xc0, 0xf5, 0x31, 0xbd, 0xfa,
+	0x81, 0xd4, 0x4c, 0x16, 0x6f, 0x3c, 0x7f, 0xb9, 0xb6, 0x3a, 0xa2, 0x59, 0x27, 0x48, 0xe8, 0x4e,
+	0xb2, 0xb9, 0x57, 0xad, 0xef, 0xef, 0xee, 0xe4, 0x53, 0x13, 0x6d, 0xee, 0x21, 0xdb, 0xc1, 0x56,
+	0x71, 0xfe, 0xf3, 0x3f, 0x96, 0xe6, 0x6a, 0x8f, 0xbf, 0x7a, 0x55, 0x4a, 0x7c, 0xfd, 0xaa, 0x94,
+	0xf8, 0xd7, 0xab, 0x52, 0xe2, 0x8b, 0xd7, 0xa5, 0xb9, 0xaf, 0x5f, 0x97, 0xe6, 0xfe, 0xfe, 0xba,
+	0x34, 0xf7, 0xe4, 0xa3, 0x78, 0x16, 0xe5, 0x37, 0xf2, 0x5d, 0x82, 0xe9, 0x99, 0xeb, 0x9d, 0x84,
+	0x84, 0xca, 0xe9, 0xbd, 0xca, 0xf9, 0xc8, 0xff, 0xf7, 0xf0, 0x04, 0x37, 0xd3, 0x7c, 0x28, 0xfa,
+	0xee, 0xff, 0x07, 0x00, 0x38, 0xef, 0x63, 0x39, 0x16, 0x1a, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -450,6 +737,94 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.InterchainLiquidStakingEnabled {
+		i--
+		if m.InterchainLiquidStakingEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.RebalancingEpochIdentifier) > 0 {
+		i -= len(m.RebalancingEpochIdentifier)
+		copy(dAtA[i:], m.RebalancingEpochIdentifier)
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(len(m.RebalancingEpochIdentifier)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.RewardCompoundingEpochIdentifier) > 0 {
+		i -= len(m.RewardCompoundingEpochIdentifier)
+		copy(dAtA[i:], m.RewardCompoundingEpochIdentifier)
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(len(m.RewardCompoundingEpochIdentifier)))
+		i--
+		dAtA[i] = 0x72
+	}
+	{
+		size := m.MaxCommissionRate.Size()
+		i -= size
+		if _, err := m.MaxCommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	{
+		size := m.MinSelfDelegation.Size()
+		i -= size
+		if _, err := m.MinSelfDelegation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if len(m.ProtocolCommissionDestination) > 0 {
+		i -= len(m.ProtocolCommissionDestination)
+		copy(dAtA[i:], m.ProtocolCommissionDestination)
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(len(m.ProtocolCommissionDestination)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	{
+		size := m.ProtocolCommissionRate.Size()
+		i -= size
+		if _, err := m.ProtocolCommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if m.NetAmountSnapshotRetention != 0 {
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(m.NetAmountSnapshotRetention))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.RewardCompoundingEpoch != 0 {
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(m.RewardCompoundingEpoch))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MaxRedelegationsPerRebalancing != 0 {
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(m.MaxRedelegationsPerRebalancing))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.RebalancingTrigger.Size()
+		i -= size
+		if _, err := m.RebalancingTrigger.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
 	{
 		size := m.MinLiquidStakingAmount.Size()
 		i -= size
@@ -554,6 +929,11 @@ func (m *LiquidValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Status != 0 {
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.OperatorAddress) > 0 {
 		i -= len(m.OperatorAddress)
 		copy(dAtA[i:], m.OperatorAddress)
@@ -629,6 +1009,71 @@ func (m *LiquidValidatorState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *UnstakingRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnstakingRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnstakingRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintLiquidstaking(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
+	if m.CreationHeight != 0 {
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(m.CreationHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.LiquidStaker) > 0 {
+		i -= len(m.LiquidStaker)
+		copy(dAtA[i:], m.LiquidStaker)
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(len(m.LiquidStaker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *NetAmountState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -649,6 +1094,16 @@ func (m *NetAmountState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.PendingProtocolCommission.Size()
+		i -= size
+		if _, err := m.PendingProtocolCommission.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
 	{
 		size := m.ProxyAccBalance.Size()
 		i -= size
@@ -732,6 +1187,72 @@ func (m *NetAmountState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *NetAmountSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NetAmountSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NetAmountSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MintRate.Size()
+		i -= size
+		if _, err := m.MintRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.BtokenTotalSupply.Size()
+		i -= size
+		if _, err := m.BtokenTotalSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.NetAmount.Size()
+		i -= size
+		if _, err := m.NetAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintLiquidstaking(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *VotingPower) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -792,29 +1313,172 @@ func (m *VotingPower) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintLiquidstaking(dAtA []byte, offset int, v uint64) int {
-	offset -= sovLiquidstaking(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *HostZone) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *HostZone) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HostZone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.LiquidBondDenom)
-	if l > 0 {
-		n += 1 + l + sovLiquidstaking(uint64(l))
+	if m.LastStateHeight != 0 {
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(m.LastStateHeight))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
 	}
-	if len(m.WhitelistedValidators) > 0 {
-		for _, e := range m.WhitelistedValidators {
+	{
+		size := m.DelegatedAmount.Size()
+		i -= size
+		if _, err := m.DelegatedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	if m.DelegateSequence != 0 {
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(m.DelegateSequence))
+		i--
+		dAtA[i] = 0x70
+	}
+	{
+		size := m.DelegatingAmount.Size()
+		i -= size
+		if _, err := m.DelegatingAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	{
+		size := m.IdleAmount.Size()
+		i -= size
+		if _, err := m.IdleAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if m.TransferSequence != 0 {
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(m.TransferSequence))
+		i--
+		dAtA[i] = 0x58
+	}
+	{
+		size := m.TransferringAmount.Size()
+		i -= size
+		if _, err := m.TransferringAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if len(m.IcaAddress) > 0 {
+		i -= len(m.IcaAddress)
+		copy(dAtA[i:], m.IcaAddress)
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(len(m.IcaAddress)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.DepositAddress) > 0 {
+		i -= len(m.DepositAddress)
+		copy(dAtA[i:], m.DepositAddress)
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(len(m.DepositAddress)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.BtokenDenom) > 0 {
+		i -= len(m.BtokenDenom)
+		copy(dAtA[i:], m.BtokenDenom)
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(len(m.BtokenDenom)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.IbcDenom) > 0 {
+		i -= len(m.IbcDenom)
+		copy(dAtA[i:], m.IbcDenom)
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(len(m.IbcDenom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.HostDenom) > 0 {
+		i -= len(m.HostDenom)
+		copy(dAtA[i:], m.HostDenom)
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(len(m.HostDenom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TransferChannelId) > 0 {
+		i -= len(m.TransferChannelId)
+		copy(dAtA[i:], m.TransferChannelId)
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(len(m.TransferChannelId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintLiquidstaking(dAtA []byte, offset int, v uint64) int {
+	offset -= sovLiquidstaking(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LiquidBondDenom)
+	if l > 0 {
+		n += 1 + l + sovLiquidstaking(uint64(l))
+	}
+	if len(m.WhitelistedValidators) > 0 {
+		for _, e := range m.WhitelistedValidators {
 			l = e.Size()
 			n += 1 + l + sovLiquidstaking(uint64(l))
 		}
@@ -823,6 +1487,38 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovLiquidstaking(uint64(l))
 	l = m.MinLiquidStakingAmount.Size()
 	n += 1 + l + sovLiquidstaking(uint64(l))
+	l = m.RebalancingTrigger.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	if m.MaxRedelegationsPerRebalancing != 0 {
+		n += 1 + sovLiquidstaking(uint64(m.MaxRedelegationsPerRebalancing))
+	}
+	if m.RewardCompoundingEpoch != 0 {
+		n += 1 + sovLiquidstaking(uint64(m.RewardCompoundingEpoch))
+	}
+	if m.NetAmountSnapshotRetention != 0 {
+		n += 1 + sovLiquidstaking(uint64(m.NetAmountSnapshotRetention))
+	}
+	l = m.ProtocolCommissionRate.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	l = len(m.ProtocolCommissionDestination)
+	if l > 0 {
+		n += 1 + l + sovLiquidstaking(uint64(l))
+	}
+	l = m.MinSelfDelegation.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	l = m.MaxCommissionRate.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	l = len(m.RewardCompoundingEpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovLiquidstaking(uint64(l))
+	}
+	l = len(m.RebalancingEpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovLiquidstaking(uint64(l))
+	}
+	if m.InterchainLiquidStakingEnabled {
+		n += 3
+	}
 	return n
 }
 
@@ -851,6 +1547,9 @@ func (m *LiquidValidator) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovLiquidstaking(uint64(l))
 	}
+	if m.Status != 0 {
+		n += 1 + sovLiquidstaking(uint64(m.Status))
+	}
 	return n
 }
 
@@ -876,6 +1575,33 @@ func (m *LiquidValidatorState) Size() (n int) {
 	return n
 }
 
+func (m *UnstakingRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovLiquidstaking(uint64(m.Id))
+	}
+	l = len(m.LiquidStaker)
+	if l > 0 {
+		n += 1 + l + sovLiquidstaking(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidstaking(uint64(l))
+	}
+	if m.CreationHeight != 0 {
+		n += 1 + sovLiquidstaking(uint64(m.CreationHeight))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	l = m.Amount.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	return n
+}
+
 func (m *NetAmountState) Size() (n int) {
 	if m == nil {
 		return 0
@@ -898,6 +1624,28 @@ func (m *NetAmountState) Size() (n int) {
 	n += 1 + l + sovLiquidstaking(uint64(l))
 	l = m.ProxyAccBalance.Size()
 	n += 1 + l + sovLiquidstaking(uint64(l))
+	l = m.PendingProtocolCommission.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	return n
+}
+
+func (m *NetAmountSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovLiquidstaking(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	l = m.NetAmount.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	l = m.BtokenTotalSupply.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	l = m.MintRate.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
 	return n
 }
 
@@ -920,6 +1668,68 @@ func (m *VotingPower) Size() (n int) {
 	return n
 }
 
+func (m *HostZone) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstaking(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstaking(uint64(l))
+	}
+	l = len(m.TransferChannelId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstaking(uint64(l))
+	}
+	l = len(m.HostDenom)
+	if l > 0 {
+		n += 1 + l + sovLiquidstaking(uint64(l))
+	}
+	l = len(m.IbcDenom)
+	if l > 0 {
+		n += 1 + l + sovLiquidstaking(uint64(l))
+	}
+	l = len(m.BtokenDenom)
+	if l > 0 {
+		n += 1 + l + sovLiquidstaking(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidstaking(uint64(l))
+	}
+	l = len(m.DepositAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidstaking(uint64(l))
+	}
+	l = len(m.IcaAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidstaking(uint64(l))
+	}
+	l = m.TransferringAmount.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	if m.TransferSequence != 0 {
+		n += 1 + sovLiquidstaking(uint64(m.TransferSequence))
+	}
+	l = m.IdleAmount.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	l = m.DelegatingAmount.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	if m.DelegateSequence != 0 {
+		n += 1 + sovLiquidstaking(uint64(m.DelegateSequence))
+	}
+	l = m.DelegatedAmount.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	if m.LastStateHeight != 0 {
+		n += 2 + sovLiquidstaking(uint64(m.LastStateHeight))
+	}
+	return n
+}
+
 func sovLiquidstaking(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1089,59 +1899,9 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipLiquidstaking(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthLiquidstaking
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *WhitelistedValidator) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowLiquidstaking
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: WhitelistedValidator: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WhitelistedValidator: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RebalancingTrigger", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1169,13 +1929,15 @@ func (m *WhitelistedValidator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.RebalancingTrigger.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetWeight", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRedelegationsPerRebalancing", wireType)
 			}
-			var stringLen uint64
+			m.MaxRedelegationsPerRebalancing = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstaking
@@ -1185,23 +1947,1259 @@ func (m *WhitelistedValidator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MaxRedelegationsPerRebalancing |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLiquidstaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLiquidstaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardCompoundingEpoch", wireType)
 			}
-			if err := m.TargetWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RewardCompoundingEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardCompoundingEpoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetAmountSnapshotRetention", wireType)
+			}
+			m.NetAmountSnapshotRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NetAmountSnapshotRetention |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolCommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProtocolCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolCommissionDestination", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProtocolCommissionDestination = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSelfDelegation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinSelfDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardCompoundingEpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardCompoundingEpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RebalancingEpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RebalancingEpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InterchainLiquidStakingEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InterchainLiquidStakingEnabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WhitelistedValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WhitelistedValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WhitelistedValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TargetWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LiquidValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ValidatorStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LiquidValidatorState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidValidatorState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidValidatorState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ValidatorStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DelShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidTokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnstakingRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnstakingRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnstakingRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidStaker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiquidStaker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+			}
+			m.CreationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NetAmountState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NetAmountState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NetAmountState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MintRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtokenTotalSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BtokenTotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NetAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDelShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalDelShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalLiquidTokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalLiquidTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalRemainingRewards", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalRemainingRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalUnbondingBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalUnbondingBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProxyAccBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProxyAccBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingProtocolCommission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PendingProtocolCommission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1217,47 +3215,167 @@ func (m *WhitelistedValidator) Unmarshal(dAtA []byte) error {
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *LiquidValidator) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowLiquidstaking
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NetAmountSnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NetAmountSnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NetAmountSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NetAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtokenTotalSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.BtokenTotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: LiquidValidator: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LiquidValidator: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MintRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1285,7 +3403,9 @@ func (m *LiquidValidator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.MintRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1308,7 +3428,7 @@ func (m *LiquidValidator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LiquidValidatorState) Unmarshal(dAtA []byte) error {
+func (m *VotingPower) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1331,15 +3451,15 @@ func (m *LiquidValidatorState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LiquidValidatorState: wiretype end group for non-group")
+			return fmt.Errorf("proto: VotingPower: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LiquidValidatorState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VotingPower: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1367,11 +3487,11 @@ func (m *LiquidValidatorState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Voter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingVotingPower", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1399,32 +3519,13 @@ func (m *LiquidValidatorState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.StakingVotingPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLiquidstaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= ValidatorStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelShares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidStakingVotingPower", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1452,13 +3553,13 @@ func (m *LiquidValidatorState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DelShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LiquidStakingVotingPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidTokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorVotingPower", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1486,7 +3587,7 @@ func (m *LiquidValidatorState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LiquidTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ValidatorVotingPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1511,7 +3612,7 @@ func (m *LiquidValidatorState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NetAmountState) Unmarshal(dAtA []byte) error {
+func (m *HostZone) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1534,15 +3635,15 @@ func (m *NetAmountState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NetAmountState: wiretype end group for non-group")
+			return fmt.Errorf("proto: HostZone: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NetAmountState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HostZone: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1570,13 +3671,11 @@ func (m *NetAmountState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MintRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BtokenTotalSupply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1604,13 +3703,11 @@ func (m *NetAmountState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BtokenTotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferChannelId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1638,13 +3735,11 @@ func (m *NetAmountState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NetAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TransferChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalDelShares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HostDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1672,13 +3767,11 @@ func (m *NetAmountState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalDelShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.HostDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalLiquidTokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1706,13 +3799,11 @@ func (m *NetAmountState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalLiquidTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.IbcDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalRemainingRewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BtokenDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1740,13 +3831,11 @@ func (m *NetAmountState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalRemainingRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BtokenDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalUnbondingBalance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1774,13 +3863,11 @@ func (m *NetAmountState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalUnbondingBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProxyAccBalance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1808,63 +3895,43 @@ func (m *NetAmountState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ProxyAccBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DepositAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipLiquidstaking(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IcaAddress", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthLiquidstaking
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *VotingPower) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowLiquidstaking
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: VotingPower: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VotingPower: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.IcaAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferringAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1892,11 +3959,32 @@ func (m *VotingPower) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Voter = string(dAtA[iNdEx:postIndex])
+			if err := m.TransferringAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferSequence", wireType)
+			}
+			m.TransferSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TransferSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingVotingPower", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IdleAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1924,13 +4012,13 @@ func (m *VotingPower) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StakingVotingPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.IdleAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidStakingVotingPower", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatingAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1958,13 +4046,32 @@ func (m *VotingPower) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LiquidStakingVotingPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DelegatingAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegateSequence", wireType)
+			}
+			m.DelegateSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelegateSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorVotingPower", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatedAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1992,10 +4099,29 @@ func (m *VotingPower) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ValidatorVotingPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DelegatedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastStateHeight", wireType)
+			}
+			m.LastStateHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastStateHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLiquidstaking(dAtA[iNdEx:])
@@ -137,6 +137,48 @@ func TestNativeToBTokenTo(t *testing.T) {
 	}
 }
 
+func TestBTokenPegPrices(t *testing.T) {
+	testCases := []struct {
+		bTokenTotalSupplyAmount sdk.Int
+		netAmount               sdk.Dec
+		unstakeFeeRate          sdk.Dec
+		expectedRedeemPrice     sdk.Dec
+		expectedMintPrice       sdk.Dec
+	}{
+		{
+			bTokenTotalSupplyAmount: sdk.ZeroInt(),
+			netAmount:               sdk.ZeroDec(),
+			unstakeFeeRate:          sdk.ZeroDec(),
+			expectedRedeemPrice:     sdk.OneDec(),
+			expectedMintPrice:       sdk.OneDec(),
+		},
+		{
+			bTokenTotalSupplyAmount: sdk.NewInt(5000000000),
+			netAmount:               sdk.NewDec(5500000000),
+			unstakeFeeRate:          sdk.ZeroDec(),
+			expectedRedeemPrice:     sdk.MustNewDecFromStr("1.1"),
+			expectedMintPrice:       sdk.MustNewDecFromStr("1.1"),
+		},
+		{
+			bTokenTotalSupplyAmount: sdk.NewInt(5000000000),
+			netAmount:               sdk.NewDec(5500000000),
+			unstakeFeeRate:          sdk.MustNewDecFromStr("0.001"),
+			expectedRedeemPrice:     sdk.MustNewDecFromStr("1.0989"),
+			expectedMintPrice:       sdk.MustNewDecFromStr("1.1"),
+		},
+	}
+
+	for _, tc := range testCases {
+		nas := types.NetAmountState{
+			BtokenTotalSupply: tc.bTokenTotalSupplyAmount,
+			NetAmount:         tc.netAmount,
+		}
+		redeemPrice, mintPrice := nas.BTokenPegPrices(tc.unstakeFeeRate)
+		require.EqualValues(t, tc.expectedRedeemPrice, redeemPrice)
+		require.EqualValues(t, tc.expectedMintPrice, mintPrice)
+	}
+}
+
 func TestActiveCondition(t *testing.T) {
 	testCases := []struct {
 		validator      stakingtypes.Validator
@@ -157,12 +199,12 @@ func TestActiveCondition(t *testing.T) {
 			tombstoned:     false,
 			expectedOutput: true,
 		},
-		// active case 2
+		// active case 2 (unbonding)
 		{
 			validator: stakingtypes.Validator{
 				OperatorAddress: whitelistedValidators[0].ValidatorAddress,
-				Jailed:          true,
-				Status:          stakingtypes.Bonded,
+				Jailed:          false,
+				Status:          stakingtypes.Unbonding,
 				Tokens:          sdk.NewInt(100000000),
 				DelegatorShares: sdk.NewDec(100000000),
 			},
@@ -235,6 +277,19 @@ func TestActiveCondition(t *testing.T) {
 			tombstoned:     true,
 			expectedOutput: false,
 		},
+		// inactive case 6 (jailed)
+		{
+			validator: stakingtypes.Validator{
+				OperatorAddress: whitelistedValidators[0].ValidatorAddress,
+				Jailed:          true,
+				Status:          stakingtypes.Bonded,
+				Tokens:          sdk.NewInt(100000000),
+				DelegatorShares: sdk.NewDec(100000000),
+			},
+			whitelisted:    true,
+			tombstoned:     false,
+			expectedOutput: false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -8,12 +8,22 @@ import (
 var (
 	_ sdk.Msg = (*MsgLiquidStake)(nil)
 	_ sdk.Msg = (*MsgLiquidUnstake)(nil)
+	_ sdk.Msg = (*MsgArbLiquidStake)(nil)
+	_ sdk.Msg = (*MsgLiquidUnstakeInstant)(nil)
+	_ sdk.Msg = (*MsgInterchainLiquidStake)(nil)
+	_ sdk.Msg = (*MsgUpdateHostZoneState)(nil)
+	_ sdk.Msg = (*MsgUpdateWhitelistedValidatorWeight)(nil)
 )
 
 // Message types for the liquidstaking module
 const (
-	TypeMsgLiquidStake   = "liquid_stake"
-	TypeMsgLiquidUnstake = "liquid_unstake"
+	TypeMsgLiquidStake                      = "liquid_stake"
+	TypeMsgLiquidUnstake                    = "liquid_unstake"
+	TypeMsgArbLiquidStake                   = "arb_liquid_stake"
+	TypeMsgLiquidUnstakeInstant             = "liquid_unstake_instant"
+	TypeMsgInterchainLiquidStake            = "interchain_liquid_stake"
+	TypeMsgUpdateHostZoneState              = "update_host_zone_state"
+	TypeMsgUpdateWhitelistedValidatorWeight = "update_whitelisted_validator_weight"
 )
 
 // NewMsgLiquidStake creates a new MsgLiquidStake.
@@ -111,3 +121,269 @@ func (msg MsgLiquidUnstake) GetDelegator() sdk.AccAddress {
 	}
 	return addr
 }
+
+// NewMsgArbLiquidStake creates a new MsgArbLiquidStake.
+func NewMsgArbLiquidStake(
+	arbitrager sdk.AccAddress,
+	pairId uint64,
+	maxSpend sdk.Coin,
+) *MsgArbLiquidStake {
+	return &MsgArbLiquidStake{
+		ArbitragerAddress: arbitrager.String(),
+		PairId:            pairId,
+		MaxSpend:          maxSpend,
+	}
+}
+
+func (msg MsgArbLiquidStake) Route() string { return RouterKey }
+
+func (msg MsgArbLiquidStake) Type() string { return TypeMsgArbLiquidStake }
+
+func (msg MsgArbLiquidStake) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.ArbitragerAddress); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid arbitrager address %q: %v", msg.ArbitragerAddress, err)
+	}
+	if msg.PairId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "pair id must not be 0")
+	}
+	if ok := msg.MaxSpend.IsZero(); ok {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "max spend amount must not be zero")
+	}
+	if err := msg.MaxSpend.Validate(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (msg MsgArbLiquidStake) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgArbLiquidStake) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.ArbitragerAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgArbLiquidStake) GetArbitrager() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.ArbitragerAddress)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// NewMsgLiquidUnstakeInstant creates a new MsgLiquidUnstakeInstant.
+func NewMsgLiquidUnstakeInstant(
+	liquidStaker sdk.AccAddress,
+	pairId uint64,
+	amount sdk.Coin,
+	maxSlippage sdk.Dec,
+) *MsgLiquidUnstakeInstant {
+	return &MsgLiquidUnstakeInstant{
+		LiquidStaker: liquidStaker.String(),
+		PairId:       pairId,
+		Amount:       amount,
+		MaxSlippage:  maxSlippage,
+	}
+}
+
+func (msg MsgLiquidUnstakeInstant) Route() string { return RouterKey }
+
+func (msg MsgLiquidUnstakeInstant) Type() string { return TypeMsgLiquidUnstakeInstant }
+
+func (msg MsgLiquidUnstakeInstant) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.LiquidStaker); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid liquid staker address %q: %v", msg.LiquidStaker, err)
+	}
+	if msg.PairId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "pair id must not be 0")
+	}
+	if ok := msg.Amount.IsZero(); ok {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "unstaking amount must not be zero")
+	}
+	if err := msg.Amount.Validate(); err != nil {
+		return err
+	}
+	if msg.MaxSlippage.IsNil() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "max slippage must not be nil")
+	}
+	if msg.MaxSlippage.IsNegative() || msg.MaxSlippage.GTE(sdk.OneDec()) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "max slippage must be in range [0, 1): %s", msg.MaxSlippage)
+	}
+	return nil
+}
+
+func (msg MsgLiquidUnstakeInstant) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgLiquidUnstakeInstant) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.LiquidStaker)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgLiquidUnstakeInstant) GetLiquidStaker() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.LiquidStaker)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// NewMsgInterchainLiquidStake creates a new MsgInterchainLiquidStake.
+func NewMsgInterchainLiquidStake(
+	liquidStaker sdk.AccAddress,
+	chainID string,
+	amount sdk.Coin,
+) *MsgInterchainLiquidStake {
+	return &MsgInterchainLiquidStake{
+		DelegatorAddress: liquidStaker.String(),
+		ChainId:          chainID,
+		Amount:           amount,
+	}
+}
+
+func (msg MsgInterchainLiquidStake) Route() string { return RouterKey }
+
+func (msg MsgInterchainLiquidStake) Type() string { return TypeMsgInterchainLiquidStake }
+
+func (msg MsgInterchainLiquidStake) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DelegatorAddress); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid delegator address %q: %v", msg.DelegatorAddress, err)
+	}
+	if msg.ChainId == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "chain id must not be empty")
+	}
+	if ok := msg.Amount.IsZero(); ok {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "staking amount must not be zero")
+	}
+	if err := msg.Amount.Validate(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (msg MsgInterchainLiquidStake) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgInterchainLiquidStake) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgInterchainLiquidStake) GetDelegator() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// NewMsgUpdateHostZoneState creates a new MsgUpdateHostZoneState.
+func NewMsgUpdateHostZoneState(
+	updater sdk.AccAddress,
+	chainID string,
+	proofHeight uint64,
+	delegation, delegationProof, validator, validatorProof, balance, balanceProof []byte,
+) *MsgUpdateHostZoneState {
+	return &MsgUpdateHostZoneState{
+		Updater:         updater.String(),
+		ChainId:         chainID,
+		ProofHeight:     proofHeight,
+		Delegation:      delegation,
+		DelegationProof: delegationProof,
+		Validator:       validator,
+		ValidatorProof:  validatorProof,
+		Balance:         balance,
+		BalanceProof:    balanceProof,
+	}
+}
+
+func (msg MsgUpdateHostZoneState) Route() string { return RouterKey }
+
+func (msg MsgUpdateHostZoneState) Type() string { return TypeMsgUpdateHostZoneState }
+
+func (msg MsgUpdateHostZoneState) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Updater); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid updater address %q: %v", msg.Updater, err)
+	}
+	if msg.ChainId == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "chain id must not be empty")
+	}
+	if msg.ProofHeight == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "proof height must not be 0")
+	}
+	if len(msg.DelegationProof) == 0 || len(msg.BalanceProof) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "delegation proof and balance proof must not be empty")
+	}
+	if len(msg.Delegation) > 0 && (len(msg.Validator) == 0 || len(msg.ValidatorProof) == 0) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "validator and validator proof must be provided with the delegation")
+	}
+	return nil
+}
+
+func (msg MsgUpdateHostZoneState) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgUpdateHostZoneState) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Updater)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgUpdateWhitelistedValidatorWeight creates a new MsgUpdateWhitelistedValidatorWeight.
+func NewMsgUpdateWhitelistedValidatorWeight(
+	authority sdk.AccAddress,
+	valAddr sdk.ValAddress,
+	targetWeight sdk.Int,
+) *MsgUpdateWhitelistedValidatorWeight {
+	return &MsgUpdateWhitelistedValidatorWeight{
+		Authority:        authority.String(),
+		ValidatorAddress: valAddr.String(),
+		TargetWeight:     targetWeight,
+	}
+}
+
+func (msg MsgUpdateWhitelistedValidatorWeight) Route() string { return RouterKey }
+
+func (msg MsgUpdateWhitelistedValidatorWeight) Type() string {
+	return TypeMsgUpdateWhitelistedValidatorWeight
+}
+
+func (msg MsgUpdateWhitelistedValidatorWeight) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address %q: %v", msg.Authority, err)
+	}
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorAddress); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid validator address %q: %v", msg.ValidatorAddress, err)
+	}
+	if msg.TargetWeight.IsNil() || !msg.TargetWeight.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "target weight must be positive: %s", msg.TargetWeight)
+	}
+	return nil
+}
+
+func (msg MsgUpdateWhitelistedValidatorWeight) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgUpdateWhitelistedValidatorWeight) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
@@ -89,3 +89,252 @@ func TestMsgLiquidUnstake(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgArbLiquidStake(t *testing.T) {
+	arbitragerAddr := sdk.AccAddress(crypto.AddressHash([]byte("arbitragerAddr")))
+	maxSpend := sdk.NewCoin("token", sdk.NewInt(1))
+
+	testCases := []struct {
+		expectedErr string
+		msg         *types.MsgArbLiquidStake
+	}{
+		{
+			"", // empty means no error expected
+			types.NewMsgArbLiquidStake(arbitragerAddr, 1, maxSpend),
+		},
+		{
+			"invalid arbitrager address \"\": empty address string is not allowed: invalid address",
+			types.NewMsgArbLiquidStake(sdk.AccAddress{}, 1, maxSpend),
+		},
+		{
+			"pair id must not be 0: invalid request",
+			types.NewMsgArbLiquidStake(arbitragerAddr, 0, maxSpend),
+		},
+		{
+			"max spend amount must not be zero: invalid request",
+			types.NewMsgArbLiquidStake(arbitragerAddr, 1, sdk.NewCoin("token", sdk.NewInt(0))),
+		},
+	}
+
+	for _, tc := range testCases {
+		require.IsType(t, &types.MsgArbLiquidStake{}, tc.msg)
+		require.Equal(t, types.TypeMsgArbLiquidStake, tc.msg.Type())
+		require.Equal(t, types.RouterKey, tc.msg.Route())
+		require.Equal(t, sdk.MustSortJSON(types.ModuleCdc.MustMarshalJSON(tc.msg)), tc.msg.GetSignBytes())
+
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+			signers := tc.msg.GetSigners()
+			require.Len(t, signers, 1)
+			require.Equal(t, tc.msg.GetArbitrager(), signers[0])
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
+
+func TestMsgLiquidUnstakeInstant(t *testing.T) {
+	liquidStakerAddr := sdk.AccAddress(crypto.AddressHash([]byte("liquidStakerAddr")))
+	amount := sdk.NewCoin("btoken", sdk.NewInt(1))
+	maxSlippage := sdk.NewDecWithPrec(1, 2)
+
+	testCases := []struct {
+		expectedErr string
+		msg         *types.MsgLiquidUnstakeInstant
+	}{
+		{
+			"", // empty means no error expected
+			types.NewMsgLiquidUnstakeInstant(liquidStakerAddr, 1, amount, maxSlippage),
+		},
+		{
+			"", // empty means no error expected
+			types.NewMsgLiquidUnstakeInstant(liquidStakerAddr, 1, amount, sdk.ZeroDec()),
+		},
+		{
+			"invalid liquid staker address \"\": empty address string is not allowed: invalid address",
+			types.NewMsgLiquidUnstakeInstant(sdk.AccAddress{}, 1, amount, maxSlippage),
+		},
+		{
+			"pair id must not be 0: invalid request",
+			types.NewMsgLiquidUnstakeInstant(liquidStakerAddr, 0, amount, maxSlippage),
+		},
+		{
+			"unstaking amount must not be zero: invalid request",
+			types.NewMsgLiquidUnstakeInstant(liquidStakerAddr, 1, sdk.NewCoin("btoken", sdk.NewInt(0)), maxSlippage),
+		},
+		{
+			"max slippage must not be nil: invalid request",
+			types.NewMsgLiquidUnstakeInstant(liquidStakerAddr, 1, amount, sdk.Dec{}),
+		},
+		{
+			"max slippage must be in range [0, 1): -0.010000000000000000: invalid request",
+			types.NewMsgLiquidUnstakeInstant(liquidStakerAddr, 1, amount, maxSlippage.Neg()),
+		},
+		{
+			"max slippage must be in range [0, 1): 1.000000000000000000: invalid request",
+			types.NewMsgLiquidUnstakeInstant(liquidStakerAddr, 1, amount, sdk.OneDec()),
+		},
+	}
+
+	for _, tc := range testCases {
+		require.IsType(t, &types.MsgLiquidUnstakeInstant{}, tc.msg)
+		require.Equal(t, types.TypeMsgLiquidUnstakeInstant, tc.msg.Type())
+		require.Equal(t, types.RouterKey, tc.msg.Route())
+
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+			require.Equal(t, sdk.MustSortJSON(types.ModuleCdc.MustMarshalJSON(tc.msg)), tc.msg.GetSignBytes())
+			signers := tc.msg.GetSigners()
+			require.Len(t, signers, 1)
+			require.Equal(t, tc.msg.GetLiquidStaker(), signers[0])
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
+
+func TestMsgInterchainLiquidStake(t *testing.T) {
+	delegatorAddr := sdk.AccAddress(crypto.AddressHash([]byte("delegatorAddr")))
+	stakingCoin := sdk.NewCoin("ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", sdk.NewInt(1))
+
+	testCases := []struct {
+		expectedErr string
+		msg         *types.MsgInterchainLiquidStake
+	}{
+		{
+			"", // empty means no error expected
+			types.NewMsgInterchainLiquidStake(delegatorAddr, "cosmoshub-4", stakingCoin),
+		},
+		{
+			"invalid delegator address \"\": empty address string is not allowed: invalid address",
+			types.NewMsgInterchainLiquidStake(sdk.AccAddress{}, "cosmoshub-4", stakingCoin),
+		},
+		{
+			"chain id must not be empty: invalid request",
+			types.NewMsgInterchainLiquidStake(delegatorAddr, "", stakingCoin),
+		},
+		{
+			"staking amount must not be zero: invalid request",
+			types.NewMsgInterchainLiquidStake(delegatorAddr, "cosmoshub-4", sdk.NewCoin(stakingCoin.Denom, sdk.NewInt(0))),
+		},
+	}
+
+	for _, tc := range testCases {
+		require.IsType(t, &types.MsgInterchainLiquidStake{}, tc.msg)
+		require.Equal(t, types.TypeMsgInterchainLiquidStake, tc.msg.Type())
+		require.Equal(t, types.RouterKey, tc.msg.Route())
+		require.Equal(t, sdk.MustSortJSON(types.ModuleCdc.MustMarshalJSON(tc.msg)), tc.msg.GetSignBytes())
+
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+			signers := tc.msg.GetSigners()
+			require.Len(t, signers, 1)
+			require.Equal(t, tc.msg.GetDelegator(), signers[0])
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
+
+func TestMsgUpdateHostZoneState(t *testing.T) {
+	updaterAddr := sdk.AccAddress(crypto.AddressHash([]byte("updaterAddr")))
+	value, proof := []byte("value"), []byte("proof")
+
+	testCases := []struct {
+		expectedErr string
+		msg         *types.MsgUpdateHostZoneState
+	}{
+		{
+			"", // empty means no error expected
+			types.NewMsgUpdateHostZoneState(updaterAddr, "cosmoshub-4", 1, value, proof, value, proof, value, proof),
+		},
+		{
+			"", // empty means no error expected
+			types.NewMsgUpdateHostZoneState(updaterAddr, "cosmoshub-4", 1, nil, proof, nil, nil, nil, proof),
+		},
+		{
+			"invalid updater address \"\": empty address string is not allowed: invalid address",
+			types.NewMsgUpdateHostZoneState(sdk.AccAddress{}, "cosmoshub-4", 1, value, proof, value, proof, value, proof),
+		},
+		{
+			"chain id must not be empty: invalid request",
+			types.NewMsgUpdateHostZoneState(updaterAddr, "", 1, value, proof, value, proof, value, proof),
+		},
+		{
+			"proof height must not be 0: invalid request",
+			types.NewMsgUpdateHostZoneState(updaterAddr, "cosmoshub-4", 0, value, proof, value, proof, value, proof),
+		},
+		{
+			"delegation proof and balance proof must not be empty: invalid request",
+			types.NewMsgUpdateHostZoneState(updaterAddr, "cosmoshub-4", 1, value, nil, value, proof, value, proof),
+		},
+		{
+			"validator and validator proof must be provided with the delegation: invalid request",
+			types.NewMsgUpdateHostZoneState(updaterAddr, "cosmoshub-4", 1, value, proof, value, nil, value, proof),
+		},
+	}
+
+	for _, tc := range testCases {
+		require.IsType(t, &types.MsgUpdateHostZoneState{}, tc.msg)
+		require.Equal(t, types.TypeMsgUpdateHostZoneState, tc.msg.Type())
+		require.Equal(t, types.RouterKey, tc.msg.Route())
+		require.Equal(t, sdk.MustSortJSON(types.ModuleCdc.MustMarshalJSON(tc.msg)), tc.msg.GetSignBytes())
+
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+			signers := tc.msg.GetSigners()
+			require.Len(t, signers, 1)
+			require.Equal(t, updaterAddr, signers[0])
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
+
+func TestMsgUpdateWhitelistedValidatorWeight(t *testing.T) {
+	authorityAddr := sdk.AccAddress(crypto.AddressHash([]byte("authorityAddr")))
+	valAddr := sdk.ValAddress(crypto.AddressHash([]byte("valAddr")))
+
+	testCases := []struct {
+		expectedErr string
+		msg         *types.MsgUpdateWhitelistedValidatorWeight
+	}{
+		{
+			"", // empty means no error expected
+			types.NewMsgUpdateWhitelistedValidatorWeight(authorityAddr, valAddr, sdk.NewInt(10)),
+		},
+		{
+			"invalid authority address \"\": empty address string is not allowed: invalid address",
+			types.NewMsgUpdateWhitelistedValidatorWeight(sdk.AccAddress{}, valAddr, sdk.NewInt(10)),
+		},
+		{
+			"invalid validator address \"\": empty address string is not allowed: invalid address",
+			types.NewMsgUpdateWhitelistedValidatorWeight(authorityAddr, sdk.ValAddress{}, sdk.NewInt(10)),
+		},
+		{
+			"target weight must be positive: 0: invalid request",
+			types.NewMsgUpdateWhitelistedValidatorWeight(authorityAddr, valAddr, sdk.ZeroInt()),
+		},
+	}
+
+	for _, tc := range testCases {
+		require.IsType(t, &types.MsgUpdateWhitelistedValidatorWeight{}, tc.msg)
+		require.Equal(t, types.TypeMsgUpdateWhitelistedValidatorWeight, tc.msg.Type())
+		require.Equal(t, types.RouterKey, tc.msg.Route())
+		require.Equal(t, sdk.MustSortJSON(types.ModuleCdc.MustMarshalJSON(tc.msg)), tc.msg.GetSignBytes())
+
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+			signers := tc.msg.GetSigners()
+			require.Len(t, signers, 1)
+			require.Equal(t, authorityAddr, signers[0])
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
@@ -14,10 +14,21 @@ import (
 
 // Parameter store keys
 var (
-	KeyLiquidBondDenom        = []byte("LiquidBondDenom")
-	KeyWhitelistedValidators  = []byte("WhitelistedValidators")
-	KeyUnstakeFeeRate         = []byte("UnstakeFeeRate")
-	KeyMinLiquidStakingAmount = []byte("MinLiquidStakingAmount")
+	KeyLiquidBondDenom                  = []byte("LiquidBondDenom")
+	KeyWhitelistedValidators            = []byte("WhitelistedValidators")
+	KeyUnstakeFeeRate                   = []byte("UnstakeFeeRate")
+	KeyMinLiquidStakingAmount           = []byte("MinLiquidStakingAmount")
+	KeyRebalancingTrigger               = []byte("RebalancingTrigger")
+	KeyMaxRedelegationsPerRebalancing   = []byte("MaxRedelegationsPerRebalancing")
+	KeyRewardCompoundingEpoch           = []byte("RewardCompoundingEpoch")
+	KeyNetAmountSnapshotRetention       = []byte("NetAmountSnapshotRetention")
+	KeyProtocolCommissionRate           = []byte("ProtocolCommissionRate")
+	KeyProtocolCommissionDestination    = []byte("ProtocolCommissionDestination")
+	KeyMinSelfDelegation                = []byte("MinSelfDelegation")
+	KeyMaxCommissionRate                = []byte("MaxCommissionRate")
+	KeyRewardCompoundingEpochIdentifier = []byte("RewardCompoundingEpochIdentifier")
+	KeyRebalancingEpochIdentifier       = []byte("RebalancingEpochIdentifier")
+	KeyInterchainLiquidStakingEnabled   = []byte("InterchainLiquidStakingEnabled")
 
 	DefaultLiquidBondDenom = "bstake"
 
@@ -27,10 +38,50 @@ var (
 	// DefaultMinLiquidStakingAmount is the default minimum liquid staking amount.
 	DefaultMinLiquidStakingAmount = sdk.NewInt(1000000)
 
-	// Const variables
+	// DefaultRebalancingTrigger is the default rebalancing trigger.
+	// If the maximum difference and needed each redelegation amount exceeds it, asset rebalancing will be executed.
+	DefaultRebalancingTrigger = sdk.NewDecWithPrec(1, 3) // "0.001000000000000000"
+
+	// DefaultMaxRedelegationsPerRebalancing is the default maximum number of redelegations in a single rebalancing.
+	DefaultMaxRedelegationsPerRebalancing = uint32(20)
+
+	// DefaultRewardCompoundingEpoch is the default number of blocks between reward compoundings.
+	// Reward compounding is disabled by default, and rewards are re-staked only when exceeding RewardTrigger.
+	DefaultRewardCompoundingEpoch = uint32(0)
+
+	// DefaultNetAmountSnapshotRetention is the default number of recent blocks whose net amount snapshots are kept.
+	// Net amount snapshots are disabled by default.
+	DefaultNetAmountSnapshotRetention = uint32(0)
+
+	// DefaultProtocolCommissionRate is the default rate of the delegation rewards taken as the protocol commission.
+	// No protocol commission is taken by default.
+	DefaultProtocolCommissionRate = sdk.ZeroDec()
+
+	// DefaultProtocolCommissionDestination is the default destination of the protocol commission.
+	DefaultProtocolCommissionDestination = ProtocolCommissionDestinationFeeCollector
+
+	// DefaultMinSelfDelegation is the default minimum self-delegation of whitelisted validators.
+	// The self-delegation requirement is disabled by default.
+	DefaultMinSelfDelegation = sdk.ZeroInt()
+
+	// DefaultMaxCommissionRate is the default maximum commission rate of whitelisted validators.
+	// The commission rate cap is disabled by default.
+	DefaultMaxCommissionRate = sdk.OneDec()
+
+	// DefaultRewardCompoundingEpochIdentifier is the default identifier of the epoch at the end of which rewards are
+	// compounded. Empty by default, which keeps the block-based RewardCompoundingEpoch as an unknown identifier does.
+	DefaultRewardCompoundingEpochIdentifier = ""
 
-	// RebalancingTrigger if the maximum difference and needed each redelegation amount exceeds it, asset rebalacing will be executed.
-	RebalancingTrigger = sdk.NewDecWithPrec(1, 3) // "0.001000000000000000"
+	// DefaultRebalancingEpochIdentifier is the default identifier of the epoch at the end of which the liquid
+	// validators are rebalanced. Empty by default, which keeps the rebalancing of every begin block as an unknown
+	// identifier does.
+	DefaultRebalancingEpochIdentifier = ""
+
+	// DefaultInterchainLiquidStakingEnabled is the default value of whether interchain liquid staking is enabled.
+	// The bTokens of host zones cannot be unstaked yet, so it is disabled by default.
+	DefaultInterchainLiquidStakingEnabled = false
+
+	// Const variables
 
 	// RewardTrigger If the sum of balance and the upcoming rewards of LiquidStakingProxyAcc exceeds it, the reward is automatically withdrawn and re-stake according to the weights.
 	RewardTrigger = sdk.NewDecWithPrec(1, 3) // "0.001000000000000000"
@@ -39,6 +90,12 @@ var (
 	LiquidStakingProxyAcc = farmingtypes.DeriveAddress(farmingtypes.AddressType32Bytes, ModuleName, "LiquidStakingProxyAcc")
 )
 
+// Protocol commission destinations
+const (
+	ProtocolCommissionDestinationFeeCollector  = "fee_collector"
+	ProtocolCommissionDestinationCommunityPool = "community_pool"
+)
+
 var _ paramstypes.ParamSet = (*Params)(nil)
 
 // ParamKeyTable returns the parameter key table.
@@ -49,10 +106,21 @@ func ParamKeyTable() paramstypes.KeyTable {
 // DefaultParams returns the default liquidstaking module parameters.
 func DefaultParams() Params {
 	return Params{
-		WhitelistedValidators:  []WhitelistedValidator{},
-		LiquidBondDenom:        DefaultLiquidBondDenom,
-		UnstakeFeeRate:         DefaultUnstakeFeeRate,
-		MinLiquidStakingAmount: DefaultMinLiquidStakingAmount,
+		WhitelistedValidators:            []WhitelistedValidator{},
+		LiquidBondDenom:                  DefaultLiquidBondDenom,
+		UnstakeFeeRate:                   DefaultUnstakeFeeRate,
+		MinLiquidStakingAmount:           DefaultMinLiquidStakingAmount,
+		RebalancingTrigger:               DefaultRebalancingTrigger,
+		MaxRedelegationsPerRebalancing:   DefaultMaxRedelegationsPerRebalancing,
+		RewardCompoundingEpoch:           DefaultRewardCompoundingEpoch,
+		NetAmountSnapshotRetention:       DefaultNetAmountSnapshotRetention,
+		ProtocolCommissionRate:           DefaultProtocolCommissionRate,
+		ProtocolCommissionDestination:    DefaultProtocolCommissionDestination,
+		MinSelfDelegation:                DefaultMinSelfDelegation,
+		MaxCommissionRate:                DefaultMaxCommissionRate,
+		RewardCompoundingEpochIdentifier: DefaultRewardCompoundingEpochIdentifier,
+		RebalancingEpochIdentifier:       DefaultRebalancingEpochIdentifier,
+		InterchainLiquidStakingEnabled:   DefaultInterchainLiquidStakingEnabled,
 	}
 }
 
@@ -63,6 +131,17 @@ func (p *Params) ParamSetPairs() paramstypes.ParamSetPairs {
 		paramstypes.NewParamSetPair(KeyWhitelistedValidators, &p.WhitelistedValidators, validateWhitelistedValidators),
 		paramstypes.NewParamSetPair(KeyUnstakeFeeRate, &p.UnstakeFeeRate, validateUnstakeFeeRate),
 		paramstypes.NewParamSetPair(KeyMinLiquidStakingAmount, &p.MinLiquidStakingAmount, validateMinLiquidStakingAmount),
+		paramstypes.NewParamSetPair(KeyRebalancingTrigger, &p.RebalancingTrigger, validateRebalancingTrigger),
+		paramstypes.NewParamSetPair(KeyMaxRedelegationsPerRebalancing, &p.MaxRedelegationsPerRebalancing, validateMaxRedelegationsPerRebalancing),
+		paramstypes.NewParamSetPair(KeyRewardCompoundingEpoch, &p.RewardCompoundingEpoch, validateRewardCompoundingEpoch),
+		paramstypes.NewParamSetPair(KeyNetAmountSnapshotRetention, &p.NetAmountSnapshotRetention, validateNetAmountSnapshotRetention),
+		paramstypes.NewParamSetPair(KeyProtocolCommissionRate, &p.ProtocolCommissionRate, validateProtocolCommissionRate),
+		paramstypes.NewParamSetPair(KeyProtocolCommissionDestination, &p.ProtocolCommissionDestination, validateProtocolCommissionDestination),
+		paramstypes.NewParamSetPair(KeyMinSelfDelegation, &p.MinSelfDelegation, validateMinSelfDelegation),
+		paramstypes.NewParamSetPair(KeyMaxCommissionRate, &p.MaxCommissionRate, validateMaxCommissionRate),
+		paramstypes.NewParamSetPair(KeyRewardCompoundingEpochIdentifier, &p.RewardCompoundingEpochIdentifier, validateRewardCompoundingEpochIdentifier),
+		paramstypes.NewParamSetPair(KeyRebalancingEpochIdentifier, &p.RebalancingEpochIdentifier, validateRebalancingEpochIdentifier),
+		paramstypes.NewParamSetPair(KeyInterchainLiquidStakingEnabled, &p.InterchainLiquidStakingEnabled, validateInterchainLiquidStakingEnabled),
 	}
 }
 
@@ -86,6 +165,17 @@ func (p Params) Validate() error {
 		{p.WhitelistedValidators, validateWhitelistedValidators},
 		{p.UnstakeFeeRate, validateUnstakeFeeRate},
 		{p.MinLiquidStakingAmount, validateMinLiquidStakingAmount},
+		{p.RebalancingTrigger, validateRebalancingTrigger},
+		{p.MaxRedelegationsPerRebalancing, validateMaxRedelegationsPerRebalancing},
+		{p.RewardCompoundingEpoch, validateRewardCompoundingEpoch},
+		{p.NetAmountSnapshotRetention, validateNetAmountSnapshotRetention},
+		{p.ProtocolCommissionRate, validateProtocolCommissionRate},
+		{p.ProtocolCommissionDestination, validateProtocolCommissionDestination},
+		{p.MinSelfDelegation, validateMinSelfDelegation},
+		{p.MaxCommissionRate, validateMaxCommissionRate},
+		{p.RewardCompoundingEpochIdentifier, validateRewardCompoundingEpochIdentifier},
+		{p.RebalancingEpochIdentifier, validateRebalancingEpochIdentifier},
+		{p.InterchainLiquidStakingEnabled, validateInterchainLiquidStakingEnabled},
 	} {
 		if err := v.validator(v.value); err != nil {
 			return err
@@ -176,3 +266,164 @@ func validateMinLiquidStakingAmount(i interface{}) error {
 
 	return nil
 }
+
+func validateRebalancingTrigger(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("rebalancing trigger must not be nil")
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("rebalancing trigger must not be negative: %s", v)
+	}
+
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("rebalancing trigger too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateMaxRedelegationsPerRebalancing(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("max redelegations per rebalancing must be positive")
+	}
+
+	return nil
+}
+
+func validateRewardCompoundingEpoch(i interface{}) error {
+	_, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateNetAmountSnapshotRetention(i interface{}) error {
+	_, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateProtocolCommissionRate(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("protocol commission rate must not be nil")
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("protocol commission rate must not be negative: %s", v)
+	}
+
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("protocol commission rate too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateProtocolCommissionDestination(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	switch v {
+	case ProtocolCommissionDestinationFeeCollector, ProtocolCommissionDestinationCommunityPool:
+	default:
+		return fmt.Errorf("invalid protocol commission destination: %q", v)
+	}
+
+	return nil
+}
+
+func validateMinSelfDelegation(i interface{}) error {
+	v, ok := i.(sdk.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("min self delegation must not be nil")
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("min self delegation must not be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateMaxCommissionRate(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("max commission rate must not be nil")
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("max commission rate must not be negative: %s", v)
+	}
+
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("max commission rate too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateRewardCompoundingEpochIdentifier(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if strings.TrimSpace(v) != v {
+		return fmt.Errorf("reward compounding epoch identifier must not have leading or trailing whitespaces: %q", v)
+	}
+
+	return nil
+}
+
+func validateRebalancingEpochIdentifier(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if strings.TrimSpace(v) != v {
+		return fmt.Errorf("rebalancing epoch identifier must not have leading or trailing whitespaces: %q", v)
+	}
+
+	return nil
+}
+
+func validateInterchainLiquidStakingEnabled(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
@@ -20,6 +20,17 @@ func TestParams(t *testing.T) {
 whitelisted_validators: []
 unstake_fee_rate: "0.001000000000000000"
 min_liquid_staking_amount: "1000000"
+rebalancing_trigger: "0.001000000000000000"
+max_redelegations_per_rebalancing: 20
+reward_compounding_epoch: 0
+net_amount_snapshot_retention: 0
+protocol_commission_rate: "0.000000000000000000"
+protocol_commission_destination: fee_collector
+min_self_delegation: "0"
+max_commission_rate: "1.000000000000000000"
+reward_compounding_epoch_identifier: ""
+rebalancing_epoch_identifier: ""
+interchain_liquid_staking_enabled: false
 `
 	require.Equal(t, paramsStr, params.String())
 
@@ -35,6 +46,17 @@ whitelisted_validators:
   target_weight: "10"
 unstake_fee_rate: "0.001000000000000000"
 min_liquid_staking_amount: "1000000"
+rebalancing_trigger: "0.001000000000000000"
+max_redelegations_per_rebalancing: 20
+reward_compounding_epoch: 0
+net_amount_snapshot_retention: 0
+protocol_commission_rate: "0.000000000000000000"
+protocol_commission_destination: fee_collector
+min_self_delegation: "0"
+max_commission_rate: "1.000000000000000000"
+reward_compounding_epoch_identifier: ""
+rebalancing_epoch_identifier: ""
+interchain_liquid_staking_enabled: false
 `
 	require.Equal(t, paramsStr, params.String())
 }
@@ -183,6 +205,125 @@ func TestValidateWhitelistedValidators(t *testing.T) {
 			},
 			"min liquid staking amount must not be negative: -1",
 		},
+		{
+			"nil rebalancing trigger",
+			func(params *types.Params) {
+				params.RebalancingTrigger = sdk.Dec{}
+			},
+			"rebalancing trigger must not be nil",
+		},
+		{
+			"negative rebalancing trigger",
+			func(params *types.Params) {
+				params.RebalancingTrigger = sdk.NewDec(-1)
+			},
+			"rebalancing trigger must not be negative: -1.000000000000000000",
+		},
+		{
+			"too large rebalancing trigger",
+			func(params *types.Params) {
+				params.RebalancingTrigger = sdk.MustNewDecFromStr("1.0000001")
+			},
+			"rebalancing trigger too large: 1.000000100000000000",
+		},
+		{
+			"zero max redelegations per rebalancing",
+			func(params *types.Params) {
+				params.MaxRedelegationsPerRebalancing = 0
+			},
+			"max redelegations per rebalancing must be positive",
+		},
+		{
+			"nil protocol commission rate",
+			func(params *types.Params) {
+				params.ProtocolCommissionRate = sdk.Dec{}
+			},
+			"protocol commission rate must not be nil",
+		},
+		{
+			"negative protocol commission rate",
+			func(params *types.Params) {
+				params.ProtocolCommissionRate = sdk.NewDec(-1)
+			},
+			"protocol commission rate must not be negative: -1.000000000000000000",
+		},
+		{
+			"too large protocol commission rate",
+			func(params *types.Params) {
+				params.ProtocolCommissionRate = sdk.MustNewDecFromStr("1.0000001")
+			},
+			"protocol commission rate too large: 1.000000100000000000",
+		},
+		{
+			"community pool protocol commission destination",
+			func(params *types.Params) {
+				params.ProtocolCommissionDestination = types.ProtocolCommissionDestinationCommunityPool
+			},
+			"",
+		},
+		{
+			"invalid protocol commission destination",
+			func(params *types.Params) {
+				params.ProtocolCommissionDestination = "burn"
+			},
+			"invalid protocol commission destination: \"burn\"",
+		},
+		{
+			"nil min self delegation",
+			func(params *types.Params) {
+				params.MinSelfDelegation = sdk.Int{}
+			},
+			"min self delegation must not be nil",
+		},
+		{
+			"negative min self delegation",
+			func(params *types.Params) {
+				params.MinSelfDelegation = sdk.NewInt(-1)
+			},
+			"min self delegation must not be negative: -1",
+		},
+		{
+			"negative max commission rate",
+			func(params *types.Params) {
+				params.MaxCommissionRate = sdk.NewDec(-1)
+			},
+			"max commission rate must not be negative: -1.000000000000000000",
+		},
+		{
+			"too large max commission rate",
+			func(params *types.Params) {
+				params.MaxCommissionRate = sdk.MustNewDecFromStr("1.0000001")
+			},
+			"max commission rate too large: 1.000000100000000000",
+		},
+		{
+			"reward compounding epoch identifier",
+			func(params *types.Params) {
+				params.RewardCompoundingEpochIdentifier = "day"
+			},
+			"",
+		},
+		{
+			"reward compounding epoch identifier with whitespaces",
+			func(params *types.Params) {
+				params.RewardCompoundingEpochIdentifier = " day"
+			},
+			"reward compounding epoch identifier must not have leading or trailing whitespaces: \" day\"",
+		},
+		{
+			"rebalancing epoch identifier",
+			func(params *types.Params) {
+				params.RebalancingEpochIdentifier = "week"
+			},
+			"",
+		},
+		{
+			"rebalancing epoch identifier with whitespaces",
+			func(params *types.Params) {
+				params.RebalancingEpochIdentifier = "week "
+			},
+			"rebalancing epoch identifier must not have leading or trailing whitespaces: \"week \"",
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			params := types.DefaultParams()
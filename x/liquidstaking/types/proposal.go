@@ -0,0 +1,126 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	gov "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	ProposalTypeLiquidStakingWhitelist string = "LiquidStakingWhitelist"
+	ProposalTypeRegisterHostZone       string = "RegisterHostZone"
+)
+
+var (
+	_ gov.Content = &LiquidStakingWhitelistProposal{}
+	_ gov.Content = &RegisterHostZoneProposal{}
+)
+
+func init() {
+	gov.RegisterProposalType(ProposalTypeLiquidStakingWhitelist)
+	gov.RegisterProposalTypeCodec(&LiquidStakingWhitelistProposal{}, "crescent/LiquidStakingWhitelistProposal")
+	gov.RegisterProposalType(ProposalTypeRegisterHostZone)
+	gov.RegisterProposalTypeCodec(&RegisterHostZoneProposal{}, "crescent/RegisterHostZoneProposal")
+}
+
+// NewLiquidStakingWhitelistProposal returns a new LiquidStakingWhitelistProposal.
+func NewLiquidStakingWhitelistProposal(
+	title, description string, whitelistedVals []WhitelistedValidator, delistedVals []string) *LiquidStakingWhitelistProposal {
+	return &LiquidStakingWhitelistProposal{
+		Title:                 title,
+		Description:           description,
+		WhitelistedValidators: whitelistedVals,
+		DelistedValidators:    delistedVals,
+	}
+}
+
+func (p *LiquidStakingWhitelistProposal) GetTitle() string       { return p.Title }
+func (p *LiquidStakingWhitelistProposal) GetDescription() string { return p.Description }
+func (p *LiquidStakingWhitelistProposal) ProposalRoute() string  { return RouterKey }
+func (p *LiquidStakingWhitelistProposal) ProposalType() string {
+	return ProposalTypeLiquidStakingWhitelist
+}
+
+func (p *LiquidStakingWhitelistProposal) ValidateBasic() error {
+	if len(p.WhitelistedValidators) == 0 && len(p.DelistedValidators) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "whitelisted validators and delisted validators must not be both empty")
+	}
+	if err := validateWhitelistedValidators(p.WhitelistedValidators); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	valsMap := GetWhitelistedValsMap(p.WhitelistedValidators)
+	delistedValsMap := map[string]struct{}{}
+	for _, valAddr := range p.DelistedValidators {
+		if _, err := sdk.ValAddressFromBech32(valAddr); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid delisted validator address %q: %v", valAddr, err)
+		}
+		if _, ok := delistedValsMap[valAddr]; ok {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "delisted validator cannot be duplicated: %s", valAddr)
+		}
+		if _, ok := valsMap[valAddr]; ok {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "validator cannot be both whitelisted and delisted: %s", valAddr)
+		}
+		delistedValsMap[valAddr] = struct{}{}
+	}
+	return gov.ValidateAbstract(p)
+}
+
+func (p LiquidStakingWhitelistProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Liquid Staking Whitelist Proposal:
+  Title:       %s
+  Description: %s
+  Whitelisted Validators:
+`, p.Title, p.Description))
+	for _, wv := range p.WhitelistedValidators {
+		b.WriteString(fmt.Sprintf("    %s: %s\n", wv.ValidatorAddress, wv.TargetWeight))
+	}
+	b.WriteString("  Delisted Validators:\n")
+	for _, valAddr := range p.DelistedValidators {
+		b.WriteString(fmt.Sprintf("    %s\n", valAddr))
+	}
+	return b.String()
+}
+
+// NewRegisterHostZoneProposal returns a new RegisterHostZoneProposal.
+func NewRegisterHostZoneProposal(
+	title, description, chainID, connectionID, transferChannelID, hostDenom, bTokenDenom, valAddr string) *RegisterHostZoneProposal {
+	return &RegisterHostZoneProposal{
+		Title:             title,
+		Description:       description,
+		ChainId:           chainID,
+		ConnectionId:      connectionID,
+		TransferChannelId: transferChannelID,
+		HostDenom:         hostDenom,
+		BtokenDenom:       bTokenDenom,
+		ValidatorAddress:  valAddr,
+	}
+}
+
+func (p *RegisterHostZoneProposal) GetTitle() string       { return p.Title }
+func (p *RegisterHostZoneProposal) GetDescription() string { return p.Description }
+func (p *RegisterHostZoneProposal) ProposalRoute() string  { return RouterKey }
+func (p *RegisterHostZoneProposal) ProposalType() string   { return ProposalTypeRegisterHostZone }
+
+func (p *RegisterHostZoneProposal) ValidateBasic() error {
+	if err := NewHostZone(p).Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return gov.ValidateAbstract(p)
+}
+
+func (p RegisterHostZoneProposal) String() string {
+	return fmt.Sprintf(`Register Host Zone Proposal:
+  Title:               %s
+  Description:         %s
+  Chain Id:            %s
+  Connection Id:       %s
+  Transfer Channel Id: %s
+  Host Denom:          %s
+  BToken Denom:        %s
+  Validator Address:   %s
+`, p.Title, p.Description, p.ChainId, p.ConnectionId, p.TransferChannelId, p.HostDenom, p.BtokenDenom, p.ValidatorAddress)
+}
@@ -0,0 +1,177 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+
+	"github.com/crescent-network/crescent/v4/x/liquidstaking/types"
+)
+
+func TestLiquidStakingWhitelistProposal_ValidateBasic(t *testing.T) {
+	valAddr1 := sdk.ValAddress(crypto.AddressHash([]byte("valAddr1"))).String()
+	valAddr2 := sdk.ValAddress(crypto.AddressHash([]byte("valAddr2"))).String()
+
+	for _, tc := range []struct {
+		name        string
+		malleate    func(p *types.LiquidStakingWhitelistProposal)
+		expectedErr string
+	}{
+		{
+			"happy case",
+			func(p *types.LiquidStakingWhitelistProposal) {},
+			"",
+		},
+		{
+			"only delisted validators",
+			func(p *types.LiquidStakingWhitelistProposal) {
+				p.WhitelistedValidators = nil
+			},
+			"",
+		},
+		{
+			"empty validators",
+			func(p *types.LiquidStakingWhitelistProposal) {
+				p.WhitelistedValidators = nil
+				p.DelistedValidators = nil
+			},
+			"whitelisted validators and delisted validators must not be both empty: invalid request",
+		},
+		{
+			"invalid target weight",
+			func(p *types.LiquidStakingWhitelistProposal) {
+				p.WhitelistedValidators[0].TargetWeight = sdk.ZeroInt()
+			},
+			"liquidstaking validator target weight must be positive: 0: invalid request",
+		},
+		{
+			"duplicate whitelisted validator",
+			func(p *types.LiquidStakingWhitelistProposal) {
+				p.WhitelistedValidators = append(p.WhitelistedValidators, p.WhitelistedValidators[0])
+			},
+			"liquidstaking validator cannot be duplicated: " + valAddr1 + ": invalid request",
+		},
+		{
+			"invalid delisted validator address",
+			func(p *types.LiquidStakingWhitelistProposal) {
+				p.DelistedValidators = []string{"invalidaddr"}
+			},
+			"invalid delisted validator address \"invalidaddr\": decoding bech32 failed: invalid separator index -1: invalid address",
+		},
+		{
+			"duplicate delisted validator",
+			func(p *types.LiquidStakingWhitelistProposal) {
+				p.DelistedValidators = []string{valAddr2, valAddr2}
+			},
+			"delisted validator cannot be duplicated: " + valAddr2 + ": invalid request",
+		},
+		{
+			"whitelisted and delisted",
+			func(p *types.LiquidStakingWhitelistProposal) {
+				p.DelistedValidators = []string{valAddr1}
+			},
+			"validator cannot be both whitelisted and delisted: " + valAddr1 + ": invalid request",
+		},
+		{
+			"empty title",
+			func(p *types.LiquidStakingWhitelistProposal) {
+				p.Title = ""
+			},
+			"proposal title cannot be blank: invalid proposal content",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			p := types.NewLiquidStakingWhitelistProposal("title", "description",
+				[]types.WhitelistedValidator{{ValidatorAddress: valAddr1, TargetWeight: sdk.NewInt(1)}},
+				[]string{valAddr2})
+			tc.malleate(p)
+			err := p.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestRegisterHostZoneProposal_ValidateBasic(t *testing.T) {
+	hostValAddr, err := bech32.ConvertAndEncode("cosmosvaloper", crypto.AddressHash([]byte("hostValAddr")))
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		name        string
+		malleate    func(p *types.RegisterHostZoneProposal)
+		expectedErr string
+	}{
+		{
+			"happy case",
+			func(p *types.RegisterHostZoneProposal) {},
+			"",
+		},
+		{
+			"empty chain id",
+			func(p *types.RegisterHostZoneProposal) {
+				p.ChainId = ""
+			},
+			"chain id must not be empty: invalid request",
+		},
+		{
+			"invalid connection id",
+			func(p *types.RegisterHostZoneProposal) {
+				p.ConnectionId = "c"
+			},
+			"invalid connection id c: identifier c has invalid length: 1, must be between 10-64 characters: invalid identifier",
+		},
+		{
+			"invalid transfer channel id",
+			func(p *types.RegisterHostZoneProposal) {
+				p.TransferChannelId = "c"
+			},
+			"invalid transfer channel id c: identifier c has invalid length: 1, must be between 8-64 characters: invalid identifier",
+		},
+		{
+			"invalid host denom",
+			func(p *types.RegisterHostZoneProposal) {
+				p.HostDenom = "1uatom"
+			},
+			"invalid host denom: invalid denom: 1uatom: invalid request",
+		},
+		{
+			"invalid btoken denom",
+			func(p *types.RegisterHostZoneProposal) {
+				p.BtokenDenom = ""
+			},
+			"invalid btoken denom: invalid denom: : invalid request",
+		},
+		{
+			"invalid validator address",
+			func(p *types.RegisterHostZoneProposal) {
+				p.ValidatorAddress = "invalidaddr"
+			},
+			"invalid validator address invalidaddr: decoding bech32 failed: invalid separator index -1: invalid request",
+		},
+		{
+			"empty title",
+			func(p *types.RegisterHostZoneProposal) {
+				p.Title = ""
+			},
+			"proposal title cannot be blank: invalid proposal content",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			p := types.NewRegisterHostZoneProposal(
+				"title", "description", "cosmoshub-4", "connection-0", "channel-0", "uatom", "batom", hostValAddr)
+			tc.malleate(p)
+			err := p.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
@@ -6,6 +6,9 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -366,610 +369,3932 @@ func (m *QueryVotingPowerResponse) GetVotingPower() VotingPower {
 	return VotingPower{}
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "crescent.liquidstaking.v1beta1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "crescent.liquidstaking.v1beta1.QueryParamsResponse")
-	proto.RegisterType((*QueryLiquidValidatorsRequest)(nil), "crescent.liquidstaking.v1beta1.QueryLiquidValidatorsRequest")
-	proto.RegisterType((*QueryLiquidValidatorsResponse)(nil), "crescent.liquidstaking.v1beta1.QueryLiquidValidatorsResponse")
-	proto.RegisterType((*QueryStatesRequest)(nil), "crescent.liquidstaking.v1beta1.QueryStatesRequest")
-	proto.RegisterType((*QueryStatesResponse)(nil), "crescent.liquidstaking.v1beta1.QueryStatesResponse")
-	proto.RegisterType((*QueryVotingPowerRequest)(nil), "crescent.liquidstaking.v1beta1.QueryVotingPowerRequest")
-	proto.RegisterType((*QueryVotingPowerResponse)(nil), "crescent.liquidstaking.v1beta1.QueryVotingPowerResponse")
+// QueryUnstakingRecordsRequest is the request type for the Query/UnstakingRecords RPC method.
+type QueryUnstakingRecordsRequest struct {
+	LiquidStaker string `protobuf:"bytes,1,opt,name=liquid_staker,json=liquidStaker,proto3" json:"liquid_staker,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("crescent/liquidstaking/v1beta1/query.proto", fileDescriptor_a37bd8b89a8d11ee)
+func (m *QueryUnstakingRecordsRequest) Reset()         { *m = QueryUnstakingRecordsRequest{} }
+func (m *QueryUnstakingRecordsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUnstakingRecordsRequest) ProtoMessage()    {}
+func (*QueryUnstakingRecordsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a37bd8b89a8d11ee, []int{8}
 }
-
-var fileDescriptor_a37bd8b89a8d11ee = []byte{
-	// 755 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x4f, 0x6b, 0x13, 0x4f,
-	0x18, 0xce, 0xa6, 0xbf, 0x16, 0x7e, 0x13, 0x91, 0xba, 0x16, 0x2c, 0x4b, 0x5d, 0x87, 0x1c, 0x6a,
-	0xad, 0xed, 0x0e, 0x4d, 0x83, 0x8a, 0xda, 0x43, 0x8a, 0x78, 0x51, 0xa4, 0x46, 0xa9, 0xa0, 0x60,
-	0x98, 0x24, 0xaf, 0x9b, 0xa5, 0xc9, 0xcc, 0x76, 0x67, 0x36, 0x6d, 0x11, 0x2f, 0x9e, 0x3c, 0x4a,
-	0x3d, 0x0a, 0xde, 0xfc, 0x0c, 0x7e, 0x03, 0x29, 0x78, 0x29, 0x78, 0x50, 0x10, 0x44, 0x5a, 0xaf,
-	0x7e, 0x06, 0x65, 0x67, 0x67, 0xd3, 0xfc, 0xb1, 0x6e, 0x2a, 0x42, 0x4f, 0x9d, 0xbe, 0x3b, 0xcf,
-	0xfb, 0x3c, 0xf3, 0xcc, 0xbc, 0x0f, 0x41, 0xb3, 0xb5, 0x00, 0x44, 0x0d, 0x98, 0x24, 0x4d, 0x6f,
-	0x3d, 0xf4, 0xea, 0x42, 0xd2, 0x35, 0x8f, 0xb9, 0xa4, 0xbd, 0x50, 0x05, 0x49, 0x17, 0xc8, 0x7a,
-	0x08, 0xc1, 0x96, 0xe3, 0x07, 0x5c, 0x72, 0xd3, 0x4e, 0xf6, 0x3a, 0x3d, 0x7b, 0x1d, 0xbd, 0xd7,
-	0x9a, 0x72, 0x39, 0x77, 0x9b, 0x40, 0xa8, 0xef, 0x11, 0xca, 0x18, 0x97, 0x54, 0x7a, 0x9c, 0x89,
-	0x18, 0x6d, 0x15, 0x52, 0x98, 0x7a, 0x7b, 0xc6, 0x98, 0x09, 0x97, 0xbb, 0x5c, 0x2d, 0x49, 0xb4,
-	0xd2, 0xd5, 0xf8, 0x4f, 0x6d, 0xde, 0x05, 0x36, 0xcf, 0x7d, 0x60, 0xd4, 0xf7, 0xda, 0x05, 0xc2,
-	0x7d, 0xc5, 0x36, 0xc8, 0x9c, 0x9f, 0x40, 0xe6, 0xdd, 0xe8, 0x18, 0x2b, 0x34, 0xa0, 0x2d, 0x51,
-	0x86, 0xf5, 0x10, 0x84, 0xcc, 0x3f, 0x42, 0xa7, 0x7b, 0xaa, 0xc2, 0xe7, 0x4c, 0x80, 0x79, 0x03,
-	0x8d, 0xf9, 0xaa, 0x32, 0x69, 0x60, 0x63, 0x26, 0x57, 0x98, 0x76, 0xfe, 0x7c, 0x6a, 0x27, 0xc6,
-	0x2f, 0xff, 0xb7, 0xf3, 0xf5, 0x5c, 0xa6, 0xac, 0xb1, 0x79, 0x1b, 0x4d, 0xa9, 0xe6, 0xb7, 0x15,
-	0x64, 0x95, 0x36, 0xbd, 0x3a, 0x95, 0x3c, 0xe8, 0x90, 0xbf, 0x30, 0xd0, 0xd9, 0x43, 0x36, 0x68,
-	0x1d, 0x2e, 0x3a, 0x15, 0xf3, 0x55, 0xda, 0x9d, 0x8f, 0x93, 0x06, 0x1e, 0x99, 0xc9, 0x15, 0x8a,
-	0x69, 0x92, 0xfa, 0x9a, 0xde, 0x93, 0x54, 0x82, 0x16, 0x38, 0xde, 0xec, 0x23, 0xec, 0xb8, 0xa3,
-	0x76, 0x75, 0x04, 0x86, 0xda, 0x9d, 0xa4, 0xaa, 0x55, 0x3d, 0x46, 0xe3, 0x0c, 0x64, 0x85, 0xb6,
-	0x78, 0xc8, 0x64, 0x45, 0x44, 0x1f, 0xb5, 0x4f, 0x4e, 0x9a, 0xa8, 0x3b, 0x20, 0x4b, 0x0a, 0xd6,
-	0x2d, 0xe7, 0x24, 0xeb, 0xa9, 0xe6, 0x09, 0x3a, 0xa3, 0x68, 0x57, 0xb9, 0xf4, 0x98, 0xbb, 0xc2,
-	0x37, 0x20, 0xd0, 0x8a, 0xcc, 0x09, 0x34, 0xda, 0xe6, 0x12, 0x02, 0xc5, 0xf7, 0x7f, 0x39, 0xfe,
-	0x27, 0xef, 0xa3, 0xc9, 0x41, 0x80, 0x16, 0x7b, 0x1f, 0x9d, 0x68, 0xab, 0x72, 0xc5, 0x8f, 0xea,
-	0x5a, 0xe8, 0xc5, 0x34, 0xa1, 0x5d, 0xad, 0xb4, 0xca, 0x5c, 0xfb, 0xa0, 0x54, 0x78, 0x9f, 0x43,
-	0xa3, 0x8a, 0xd2, 0xfc, 0x90, 0x45, 0x63, 0xf1, 0xed, 0x9b, 0x85, 0xb4, 0xa6, 0x83, 0x0f, 0xd0,
-	0x5a, 0x3c, 0x12, 0x26, 0x3e, 0x53, 0xfe, 0x93, 0xb1, 0x5d, 0x7a, 0x6b, 0x58, 0xc5, 0x32, 0xc8,
-	0x30, 0x60, 0x02, 0xd3, 0x66, 0x13, 0xab, 0x37, 0x07, 0x12, 0x02, 0x81, 0xf9, 0x13, 0x2c, 0x1b,
-	0x80, 0xe3, 0x7e, 0x58, 0x37, 0xc4, 0x2d, 0x5e, 0x0f, 0x9b, 0xe0, 0xe4, 0x5b, 0xc8, 0xbe, 0xe9,
-	0xb1, 0x3a, 0xe6, 0xa1, 0xc4, 0x2d, 0x1e, 0x00, 0xa6, 0xd5, 0x68, 0x19, 0x21, 0xe2, 0x77, 0x6b,
-	0xde, 0x6a, 0x48, 0xe9, 0x8b, 0xab, 0x84, 0xb8, 0x9e, 0x6c, 0x84, 0x55, 0xa7, 0xc6, 0x5b, 0x24,
-	0x51, 0x39, 0xcf, 0x40, 0x6e, 0xf0, 0x60, 0xad, 0x53, 0x20, 0x32, 0x00, 0x20, 0x2d, 0xea, 0x31,
-	0xb2, 0xd9, 0x37, 0xd4, 0xc2, 0x87, 0xda, 0xf3, 0x8f, 0xdf, 0x5f, 0x65, 0x67, 0xcc, 0x69, 0x92,
-	0x32, 0xf8, 0x9a, 0xfa, 0x67, 0x16, 0x8d, 0xf7, 0x4f, 0x83, 0x79, 0x7d, 0x28, 0x8f, 0x0e, 0x99,
-	0x32, 0x6b, 0xe9, 0x2f, 0xd1, 0xda, 0xeb, 0x1f, 0xc6, 0x76, 0xe9, 0x9d, 0x61, 0x5d, 0xeb, 0xf6,
-	0x5a, 0x3b, 0x7b, 0x30, 0x93, 0x29, 0x96, 0x6f, 0xa2, 0x0b, 0x87, 0x59, 0x3e, 0xd0, 0xea, 0xdf,
-	0xbb, 0x3f, 0x67, 0xce, 0xa6, 0xb9, 0xdf, 0x45, 0xff, 0x66, 0x04, 0xe5, 0xba, 0x1e, 0xbf, 0x79,
-	0x79, 0x28, 0xfb, 0x06, 0x47, 0xd5, 0xba, 0x72, 0x74, 0xa0, 0xb6, 0xfc, 0x75, 0x76, 0xbb, 0xf4,
-	0xc5, 0xb0, 0x2a, 0x89, 0xe5, 0xf1, 0xe0, 0x61, 0x35, 0xbf, 0x91, 0xd3, 0x89, 0xbd, 0x94, 0xd5,
-	0x7f, 0xef, 0xf8, 0xf9, 0xce, 0x85, 0xa8, 0x7c, 0xc0, 0xb2, 0x41, 0x25, 0xae, 0x51, 0x86, 0xab,
-	0x80, 0x61, 0x13, 0x82, 0x9a, 0x27, 0xa0, 0x7e, 0xdc, 0xd7, 0x72, 0xc9, 0x2c, 0xa6, 0x5e, 0x4b,
-	0x57, 0x70, 0x91, 0xa7, 0xea, 0x2c, 0xcf, 0x54, 0xe0, 0xc4, 0x81, 0x3c, 0x64, 0xe0, 0xf4, 0x64,
-	0xfa, 0x90, 0x81, 0xd3, 0x9b, 0xf8, 0x49, 0xe0, 0xcc, 0x25, 0x37, 0xa2, 0x32, 0x3f, 0xed, 0xd5,
-	0x87, 0x68, 0x3a, 0xc5, 0x5e, 0x8d, 0x38, 0x96, 0xc0, 0x89, 0x8f, 0xb0, 0xfc, 0x60, 0x67, 0xcf,
-	0x36, 0x76, 0xf7, 0x6c, 0xe3, 0xdb, 0x9e, 0x6d, 0xbc, 0xdc, 0xb7, 0x33, 0xbb, 0xfb, 0x76, 0xe6,
-	0xf3, 0xbe, 0x9d, 0x79, 0xb8, 0x34, 0x94, 0x98, 0x76, 0x71, 0x40, 0x85, 0xdc, 0xf2, 0x41, 0x54,
-	0xc7, 0xd4, 0xcf, 0x8e, 0xc5, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x3a, 0xa0, 0x9b, 0x95, 0x5c,
-	0x09, 0x00, 0x00,
+func (m *QueryUnstakingRecordsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnstakingRecordsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnstakingRecordsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUnstakingRecordsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnstakingRecordsRequest.Merge(m, src)
+}
+func (m *QueryUnstakingRecordsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnstakingRecordsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnstakingRecordsRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryUnstakingRecordsRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params returns parameters of the liquidstaking module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// LiquidValidators returns liquid validators with states of the liquidstaking module.
-	LiquidValidators(ctx context.Context, in *QueryLiquidValidatorsRequest, opts ...grpc.CallOption) (*QueryLiquidValidatorsResponse, error)
-	// VotingPower returns voting power of staking and liquid staking module's of the voter that can be exercised.
-	VotingPower(ctx context.Context, in *QueryVotingPowerRequest, opts ...grpc.CallOption) (*QueryVotingPowerResponse, error)
-	// States returns states of the liquidstaking module.
-	States(ctx context.Context, in *QueryStatesRequest, opts ...grpc.CallOption) (*QueryStatesResponse, error)
+func (m *QueryUnstakingRecordsRequest) GetLiquidStaker() string {
+	if m != nil {
+		return m.LiquidStaker
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+// QueryUnstakingRecordsResponse is the response type for the Query/UnstakingRecords RPC method.
+type QueryUnstakingRecordsResponse struct {
+	UnstakingRecords []UnstakingRecord `protobuf:"bytes,1,rep,name=unstaking_records,json=unstakingRecords,proto3" json:"unstaking_records"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryUnstakingRecordsResponse) Reset()         { *m = QueryUnstakingRecordsResponse{} }
+func (m *QueryUnstakingRecordsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUnstakingRecordsResponse) ProtoMessage()    {}
+func (*QueryUnstakingRecordsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a37bd8b89a8d11ee, []int{9}
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/crescent.liquidstaking.v1beta1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryUnstakingRecordsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnstakingRecordsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnstakingRecordsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryUnstakingRecordsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnstakingRecordsResponse.Merge(m, src)
+}
+func (m *QueryUnstakingRecordsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnstakingRecordsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnstakingRecordsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) LiquidValidators(ctx context.Context, in *QueryLiquidValidatorsRequest, opts ...grpc.CallOption) (*QueryLiquidValidatorsResponse, error) {
-	out := new(QueryLiquidValidatorsResponse)
-	err := c.cc.Invoke(ctx, "/crescent.liquidstaking.v1beta1.Query/LiquidValidators", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryUnstakingRecordsResponse proto.InternalMessageInfo
+
+func (m *QueryUnstakingRecordsResponse) GetUnstakingRecords() []UnstakingRecord {
+	if m != nil {
+		return m.UnstakingRecords
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) VotingPower(ctx context.Context, in *QueryVotingPowerRequest, opts ...grpc.CallOption) (*QueryVotingPowerResponse, error) {
-	out := new(QueryVotingPowerResponse)
-	err := c.cc.Invoke(ctx, "/crescent.liquidstaking.v1beta1.Query/VotingPower", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryNetAmountRequest is the request type for the Query/NetAmount RPC method.
+type QueryNetAmountRequest struct {
+	// height specifies the height of the snapshot to query. Zero means the current block.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
 }
 
-func (c *queryClient) States(ctx context.Context, in *QueryStatesRequest, opts ...grpc.CallOption) (*QueryStatesResponse, error) {
-	out := new(QueryStatesResponse)
-	err := c.cc.Invoke(ctx, "/crescent.liquidstaking.v1beta1.Query/States", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryNetAmountRequest) Reset()         { *m = QueryNetAmountRequest{} }
+func (m *QueryNetAmountRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNetAmountRequest) ProtoMessage()    {}
+func (*QueryNetAmountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a37bd8b89a8d11ee, []int{10}
+}
+func (m *QueryNetAmountRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNetAmountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNetAmountRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryNetAmountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNetAmountRequest.Merge(m, src)
+}
+func (m *QueryNetAmountRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNetAmountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNetAmountRequest.DiscardUnknown(m)
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params returns parameters of the liquidstaking module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// LiquidValidators returns liquid validators with states of the liquidstaking module.
-	LiquidValidators(context.Context, *QueryLiquidValidatorsRequest) (*QueryLiquidValidatorsResponse, error)
-	// VotingPower returns voting power of staking and liquid staking module's of the voter that can be exercised.
-	VotingPower(context.Context, *QueryVotingPowerRequest) (*QueryVotingPowerResponse, error)
-	// States returns states of the liquidstaking module.
-	States(context.Context, *QueryStatesRequest) (*QueryStatesResponse, error)
+var xxx_messageInfo_QueryNetAmountRequest proto.InternalMessageInfo
+
+func (m *QueryNetAmountRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// QueryNetAmountResponse is the response type for the Query/NetAmount RPC method.
+type QueryNetAmountResponse struct {
+	NetAmountSnapshot NetAmountSnapshot `protobuf:"bytes,1,opt,name=net_amount_snapshot,json=netAmountSnapshot,proto3" json:"net_amount_snapshot"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryNetAmountResponse) Reset()         { *m = QueryNetAmountResponse{} }
+func (m *QueryNetAmountResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNetAmountResponse) ProtoMessage()    {}
+func (*QueryNetAmountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a37bd8b89a8d11ee, []int{11}
 }
-func (*UnimplementedQueryServer) LiquidValidators(ctx context.Context, req *QueryLiquidValidatorsRequest) (*QueryLiquidValidatorsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LiquidValidators not implemented")
+func (m *QueryNetAmountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) VotingPower(ctx context.Context, req *QueryVotingPowerRequest) (*QueryVotingPowerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method VotingPower not implemented")
+func (m *QueryNetAmountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNetAmountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) States(ctx context.Context, req *QueryStatesRequest) (*QueryStatesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method States not implemented")
+func (m *QueryNetAmountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNetAmountResponse.Merge(m, src)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryNetAmountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNetAmountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNetAmountResponse.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/crescent.liquidstaking.v1beta1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+var xxx_messageInfo_QueryNetAmountResponse proto.InternalMessageInfo
+
+func (m *QueryNetAmountResponse) GetNetAmountSnapshot() NetAmountSnapshot {
+	if m != nil {
+		return m.NetAmountSnapshot
 	}
-	return interceptor(ctx, in, info, handler)
+	return NetAmountSnapshot{}
 }
 
-func _Query_LiquidValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryLiquidValidatorsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).LiquidValidators(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/crescent.liquidstaking.v1beta1.Query/LiquidValidators",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LiquidValidators(ctx, req.(*QueryLiquidValidatorsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryNetAmountSnapshotsRequest is the request type for the Query/NetAmountSnapshots RPC method.
+type QueryNetAmountSnapshotsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_VotingPower_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryVotingPowerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).VotingPower(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/crescent.liquidstaking.v1beta1.Query/VotingPower",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).VotingPower(ctx, req.(*QueryVotingPowerRequest))
+func (m *QueryNetAmountSnapshotsRequest) Reset()         { *m = QueryNetAmountSnapshotsRequest{} }
+func (m *QueryNetAmountSnapshotsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNetAmountSnapshotsRequest) ProtoMessage()    {}
+func (*QueryNetAmountSnapshotsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a37bd8b89a8d11ee, []int{12}
+}
+func (m *QueryNetAmountSnapshotsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNetAmountSnapshotsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNetAmountSnapshotsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryNetAmountSnapshotsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNetAmountSnapshotsRequest.Merge(m, src)
+}
+func (m *QueryNetAmountSnapshotsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNetAmountSnapshotsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNetAmountSnapshotsRequest.DiscardUnknown(m)
 }
 
-func _Query_States_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryStatesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_QueryNetAmountSnapshotsRequest proto.InternalMessageInfo
+
+func (m *QueryNetAmountSnapshotsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).States(ctx, in)
+	return nil
+}
+
+// QueryNetAmountSnapshotsResponse is the response type for the Query/NetAmountSnapshots RPC method.
+type QueryNetAmountSnapshotsResponse struct {
+	NetAmountSnapshots []NetAmountSnapshot `protobuf:"bytes,1,rep,name=net_amount_snapshots,json=netAmountSnapshots,proto3" json:"net_amount_snapshots"`
+	Pagination         *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryNetAmountSnapshotsResponse) Reset()         { *m = QueryNetAmountSnapshotsResponse{} }
+func (m *QueryNetAmountSnapshotsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNetAmountSnapshotsResponse) ProtoMessage()    {}
+func (*QueryNetAmountSnapshotsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a37bd8b89a8d11ee, []int{13}
+}
+func (m *QueryNetAmountSnapshotsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNetAmountSnapshotsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNetAmountSnapshotsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/crescent.liquidstaking.v1beta1.Query/States",
+}
+func (m *QueryNetAmountSnapshotsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNetAmountSnapshotsResponse.Merge(m, src)
+}
+func (m *QueryNetAmountSnapshotsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNetAmountSnapshotsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNetAmountSnapshotsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNetAmountSnapshotsResponse proto.InternalMessageInfo
+
+func (m *QueryNetAmountSnapshotsResponse) GetNetAmountSnapshots() []NetAmountSnapshot {
+	if m != nil {
+		return m.NetAmountSnapshots
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).States(ctx, req.(*QueryStatesRequest))
+	return nil
+}
+
+func (m *QueryNetAmountSnapshotsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "crescent.liquidstaking.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "LiquidValidators",
-			Handler:    _Query_LiquidValidators_Handler,
-		},
-		{
-			MethodName: "VotingPower",
-			Handler:    _Query_VotingPower_Handler,
-		},
-		{
-			MethodName: "States",
-			Handler:    _Query_States_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "crescent/liquidstaking/v1beta1/query.proto",
+// QueryHostZonesRequest is the request type for the Query/HostZones RPC method.
+type QueryHostZonesRequest struct {
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryHostZonesRequest) Reset()         { *m = QueryHostZonesRequest{} }
+func (m *QueryHostZonesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHostZonesRequest) ProtoMessage()    {}
+func (*QueryHostZonesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a37bd8b89a8d11ee, []int{14}
+}
+func (m *QueryHostZonesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHostZonesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHostZonesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryHostZonesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHostZonesRequest.Merge(m, src)
 }
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryHostZonesRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryHostZonesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHostZonesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryHostZonesRequest proto.InternalMessageInfo
+
+// QueryHostZonesResponse is the response type for the Query/HostZones RPC method.
+type QueryHostZonesResponse struct {
+	HostZones []HostZone `protobuf:"bytes,1,rep,name=host_zones,json=hostZones,proto3" json:"host_zones"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryHostZonesResponse) Reset()         { *m = QueryHostZonesResponse{} }
+func (m *QueryHostZonesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHostZonesResponse) ProtoMessage()    {}
+func (*QueryHostZonesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a37bd8b89a8d11ee, []int{15}
+}
+func (m *QueryHostZonesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHostZonesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHostZonesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryHostZonesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHostZonesResponse.Merge(m, src)
+}
+func (m *QueryHostZonesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHostZonesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHostZonesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryLiquidValidatorsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryHostZonesResponse proto.InternalMessageInfo
+
+func (m *QueryHostZonesResponse) GetHostZones() []HostZone {
+	if m != nil {
+		return m.HostZones
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryLiquidValidatorsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryHostZoneRequest is the request type for the Query/HostZone RPC method.
+type QueryHostZoneRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func (m *QueryLiquidValidatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryHostZoneRequest) Reset()         { *m = QueryHostZoneRequest{} }
+func (m *QueryHostZoneRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHostZoneRequest) ProtoMessage()    {}
+func (*QueryHostZoneRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a37bd8b89a8d11ee, []int{16}
+}
+func (m *QueryHostZoneRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHostZoneRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHostZoneRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHostZoneRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHostZoneRequest.Merge(m, src)
+}
+func (m *QueryHostZoneRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHostZoneRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHostZoneRequest.DiscardUnknown(m)
 }
 
-func (m *QueryLiquidValidatorsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryHostZoneRequest proto.InternalMessageInfo
+
+func (m *QueryHostZoneRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryLiquidValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryHostZoneResponse is the response type for the Query/HostZone RPC method.
+type QueryHostZoneResponse struct {
+	HostZone HostZone `protobuf:"bytes,1,opt,name=host_zone,json=hostZone,proto3" json:"host_zone"`
+	// btoken_total_supply is the total supply of the bToken of the host zone
+	BtokenTotalSupply github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=btoken_total_supply,json=btokenTotalSupply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"btoken_total_supply"`
+	// net_amount is the amount of the deposits and the tokens staked on the host chain
+	NetAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=net_amount,json=netAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"net_amount"`
 }
 
-func (m *QueryLiquidValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.LiquidValidators) > 0 {
-		for iNdEx := len(m.LiquidValidators) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.LiquidValidators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryHostZoneResponse) Reset()         { *m = QueryHostZoneResponse{} }
+func (m *QueryHostZoneResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHostZoneResponse) ProtoMessage()    {}
+func (*QueryHostZoneResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a37bd8b89a8d11ee, []int{17}
+}
+func (m *QueryHostZoneResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHostZoneResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHostZoneResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryHostZoneResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHostZoneResponse.Merge(m, src)
+}
+func (m *QueryHostZoneResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHostZoneResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHostZoneResponse.DiscardUnknown(m)
 }
 
-func (m *QueryStatesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryHostZoneResponse proto.InternalMessageInfo
+
+func (m *QueryHostZoneResponse) GetHostZone() HostZone {
+	if m != nil {
+		return m.HostZone
 	}
-	return dAtA[:n], nil
+	return HostZone{}
 }
 
-func (m *QueryStatesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryModuleAccountsRequest is the request type for the Query/ModuleAccounts RPC method.
+type QueryModuleAccountsRequest struct {
 }
 
-func (m *QueryStatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryModuleAccountsRequest) Reset()         { *m = QueryModuleAccountsRequest{} }
+func (m *QueryModuleAccountsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryModuleAccountsRequest) ProtoMessage()    {}
+func (*QueryModuleAccountsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a37bd8b89a8d11ee, []int{18}
 }
-
-func (m *QueryStatesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryModuleAccountsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryModuleAccountsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryModuleAccountsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryModuleAccountsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryModuleAccountsRequest.Merge(m, src)
+}
+func (m *QueryModuleAccountsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryModuleAccountsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryModuleAccountsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryStatesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryModuleAccountsRequest proto.InternalMessageInfo
+
+// QueryModuleAccountsResponse is the response type for the Query/ModuleAccounts RPC method.
+type QueryModuleAccountsResponse struct {
+	Accounts []ModuleAccountResponse `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts"`
 }
 
-func (m *QueryStatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.NetAmountState.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryModuleAccountsResponse) Reset()         { *m = QueryModuleAccountsResponse{} }
+func (m *QueryModuleAccountsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryModuleAccountsResponse) ProtoMessage()    {}
+func (*QueryModuleAccountsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a37bd8b89a8d11ee, []int{19}
+}
+func (m *QueryModuleAccountsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryModuleAccountsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryModuleAccountsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryModuleAccountsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryModuleAccountsResponse.Merge(m, src)
+}
+func (m *QueryModuleAccountsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryModuleAccountsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryModuleAccountsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryVotingPowerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryModuleAccountsResponse proto.InternalMessageInfo
+
+func (m *QueryModuleAccountsResponse) GetAccounts() []ModuleAccountResponse {
+	if m != nil {
+		return m.Accounts
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryVotingPowerRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ModuleAccountResponse defines an account managed by the module.
+type ModuleAccountResponse struct {
+	// name identifies the account, such as "host_zone_deposit/cosmoshub-4"
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// purpose describes what the account is used for
+	Purpose  string                                   `protobuf:"bytes,3,opt,name=purpose,proto3" json:"purpose,omitempty"`
+	Balances github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=balances,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"balances"`
+	// blocked is true if the bank module rejects sending coins to the account
+	Blocked bool `protobuf:"varint,5,opt,name=blocked,proto3" json:"blocked,omitempty"`
 }
 
-func (m *QueryVotingPowerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Voter) > 0 {
-		i -= len(m.Voter)
-		copy(dAtA[i:], m.Voter)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Voter)))
-		i--
-		dAtA[i] = 0xa
+func (m *ModuleAccountResponse) Reset()         { *m = ModuleAccountResponse{} }
+func (m *ModuleAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*ModuleAccountResponse) ProtoMessage()    {}
+func (*ModuleAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a37bd8b89a8d11ee, []int{20}
+}
+func (m *ModuleAccountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ModuleAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ModuleAccountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ModuleAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModuleAccountResponse.Merge(m, src)
+}
+func (m *ModuleAccountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ModuleAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModuleAccountResponse.DiscardUnknown(m)
 }
 
-func (m *QueryVotingPowerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ModuleAccountResponse proto.InternalMessageInfo
+
+func (m *ModuleAccountResponse) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryVotingPowerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ModuleAccountResponse) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
 
-func (m *QueryVotingPowerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.VotingPower.MarshalToSizedBuffer(dAtA[:i])
+func (m *ModuleAccountResponse) GetPurpose() string {
+	if m != nil {
+		return m.Purpose
+	}
+	return ""
+}
+
+func (m *ModuleAccountResponse) GetBalances() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Balances
+	}
+	return nil
+}
+
+func (m *ModuleAccountResponse) GetBlocked() bool {
+	if m != nil {
+		return m.Blocked
+	}
+	return false
+}
+
+// QueryRewardsEstimateRequest is the request type for the Query/RewardsEstimate RPC method.
+type QueryRewardsEstimateRequest struct {
+}
+
+func (m *QueryRewardsEstimateRequest) Reset()         { *m = QueryRewardsEstimateRequest{} }
+func (m *QueryRewardsEstimateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardsEstimateRequest) ProtoMessage()    {}
+func (*QueryRewardsEstimateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a37bd8b89a8d11ee, []int{21}
+}
+func (m *QueryRewardsEstimateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRewardsEstimateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardsEstimateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryRewardsEstimateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardsEstimateRequest.Merge(m, src)
+}
+func (m *QueryRewardsEstimateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRewardsEstimateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardsEstimateRequest.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryRewardsEstimateRequest proto.InternalMessageInfo
+
+// QueryRewardsEstimateResponse is the response type for the Query/RewardsEstimate RPC method.
+type QueryRewardsEstimateResponse struct {
+	// staking_apr is the annual provisions of the mint module net of the community tax divided by the bonded tokens
+	StakingApr github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=staking_apr,json=stakingApr,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"staking_apr"`
+	// commission_rate is the commission rate of the active liquid validators weighted by their liquid tokens
+	CommissionRate         github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=commission_rate,json=commissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"commission_rate"`
+	ProtocolCommissionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=protocol_commission_rate,json=protocolCommissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"protocol_commission_rate"`
+	UnstakeFeeRate         github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=unstake_fee_rate,json=unstakeFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"unstake_fee_rate"`
+	// gross_apr is the APR of the liquid staked tokens net of the validator and protocol commissions
+	GrossApr github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=gross_apr,json=grossApr,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"gross_apr"`
+	// net_apr is the APR of liquid staking for a year net of the unstake fee paid when unstaking after the year
+	NetApr github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=net_apr,json=netApr,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"net_apr"`
+}
+
+func (m *QueryRewardsEstimateResponse) Reset()         { *m = QueryRewardsEstimateResponse{} }
+func (m *QueryRewardsEstimateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardsEstimateResponse) ProtoMessage()    {}
+func (*QueryRewardsEstimateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a37bd8b89a8d11ee, []int{22}
+}
+func (m *QueryRewardsEstimateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRewardsEstimateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardsEstimateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	dAtA[offset] = uint8(v)
-	return base
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryRewardsEstimateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardsEstimateResponse.Merge(m, src)
+}
+func (m *QueryRewardsEstimateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRewardsEstimateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardsEstimateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRewardsEstimateResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "crescent.liquidstaking.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "crescent.liquidstaking.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QueryLiquidValidatorsRequest)(nil), "crescent.liquidstaking.v1beta1.QueryLiquidValidatorsRequest")
+	proto.RegisterType((*QueryLiquidValidatorsResponse)(nil), "crescent.liquidstaking.v1beta1.QueryLiquidValidatorsResponse")
+	proto.RegisterType((*QueryStatesRequest)(nil), "crescent.liquidstaking.v1beta1.QueryStatesRequest")
+	proto.RegisterType((*QueryStatesResponse)(nil), "crescent.liquidstaking.v1beta1.QueryStatesResponse")
+	proto.RegisterType((*QueryVotingPowerRequest)(nil), "crescent.liquidstaking.v1beta1.QueryVotingPowerRequest")
+	proto.RegisterType((*QueryVotingPowerResponse)(nil), "crescent.liquidstaking.v1beta1.QueryVotingPowerResponse")
+	proto.RegisterType((*QueryUnstakingRecordsRequest)(nil), "crescent.liquidstaking.v1beta1.QueryUnstakingRecordsRequest")
+	proto.RegisterType((*QueryUnstakingRecordsResponse)(nil), "crescent.liquidstaking.v1beta1.QueryUnstakingRecordsResponse")
+	proto.RegisterType((*QueryNetAmountRequest)(nil), "crescent.liquidstaking.v1beta1.QueryNetAmountRequest")
+	proto.RegisterType((*QueryNetAmountResponse)(nil), "crescent.liquidstaking.v1beta1.QueryNetAmountResponse")
+	proto.RegisterType((*QueryNetAmountSnapshotsRequest)(nil), "crescent.liquidstaking.v1beta1.QueryNetAmountSnapshotsRequest")
+	proto.RegisterType((*QueryNetAmountSnapshotsResponse)(nil), "crescent.liquidstaking.v1beta1.QueryNetAmountSnapshotsResponse")
+	proto.RegisterType((*QueryHostZonesRequest)(nil), "crescent.liquidstaking.v1beta1.QueryHostZonesRequest")
+	proto.RegisterType((*QueryHostZonesResponse)(nil), "crescent.liquidstaking.v1beta1.QueryHostZonesResponse")
+	proto.RegisterType((*QueryHostZoneRequest)(nil), "crescent.liquidstaking.v1beta1.QueryHostZoneRequest")
+	proto.RegisterType((*QueryHostZoneResponse)(nil), "crescent.liquidstaking.v1beta1.QueryHostZoneResponse")
+	proto.RegisterType((*QueryModuleAccountsRequest)(nil), "crescent.liquidstaking.v1beta1.QueryModuleAccountsRequest")
+	proto.RegisterType((*QueryModuleAccountsResponse)(nil), "crescent.liquidstaking.v1beta1.QueryModuleAccountsResponse")
+	proto.RegisterType((*ModuleAccountResponse)(nil), "crescent.liquidstaking.v1beta1.ModuleAccountResponse")
+	proto.RegisterType((*QueryRewardsEstimateRequest)(nil), "crescent.liquidstaking.v1beta1.QueryRewardsEstimateRequest")
+	proto.RegisterType((*QueryRewardsEstimateResponse)(nil), "crescent.liquidstaking.v1beta1.QueryRewardsEstimateResponse")
+}
+
+func init() {
+	proto.RegisterFile("crescent/liquidstaking/v1beta1/query.proto", fileDescriptor_a37bd8b89a8d11ee)
+}
+
+var fileDescriptor_a37bd8b89a8d11ee = []byte{
+	// 1882 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0x4d, 0x8c, 0x1c, 0x47,
+	0x15, 0x76, 0xcf, 0xae, 0xd7, 0xbb, 0xb5, 0xc1, 0xb1, 0xcb, 0x1b, 0x67, 0xd2, 0x38, 0xe3, 0x52,
+	0x23, 0xad, 0x17, 0xe3, 0x9d, 0xce, 0xae, 0xd7, 0x0e, 0x72, 0x62, 0xd0, 0xd8, 0xc1, 0x21, 0x32,
+	0x01, 0x33, 0x36, 0x31, 0x0a, 0x52, 0x46, 0x35, 0xdd, 0x95, 0x9e, 0xd6, 0xce, 0x54, 0xb5, 0xab,
+	0xaa, 0x67, 0x6d, 0x2c, 0x1f, 0x80, 0x03, 0x88, 0x03, 0x42, 0x93, 0x63, 0x24, 0x90, 0x90, 0x10,
+	0x82, 0x1b, 0x12, 0x82, 0x2b, 0xc7, 0x48, 0x5c, 0x22, 0xfe, 0x05, 0x51, 0x82, 0x6c, 0xae, 0x08,
+	0x89, 0x03, 0x17, 0x0e, 0xa0, 0xae, 0xae, 0xea, 0x99, 0xee, 0x99, 0x75, 0xcf, 0x8c, 0x8c, 0xf6,
+	0x34, 0xd3, 0x55, 0xf5, 0xbe, 0xf7, 0xbd, 0xef, 0xd5, 0xdf, 0x2b, 0x70, 0xd6, 0xe3, 0x44, 0x78,
+	0x84, 0x4a, 0xb7, 0x1b, 0xde, 0x89, 0x43, 0x5f, 0x48, 0xbc, 0x1b, 0xd2, 0xc0, 0xed, 0x6f, 0xb5,
+	0x89, 0xc4, 0x5b, 0xee, 0x9d, 0x98, 0xf0, 0x7b, 0xf5, 0x88, 0x33, 0xc9, 0x60, 0xcd, 0x8c, 0xad,
+	0xe7, 0xc6, 0xd6, 0xf5, 0x58, 0xfb, 0x54, 0xc0, 0x58, 0xd0, 0x25, 0x2e, 0x8e, 0x42, 0x17, 0x53,
+	0xca, 0x24, 0x96, 0x21, 0xa3, 0x22, 0xb5, 0xb6, 0xcf, 0x7a, 0x4c, 0xf4, 0x98, 0x70, 0xdb, 0x58,
+	0x90, 0x14, 0x36, 0x73, 0x12, 0xe1, 0x20, 0xa4, 0x6a, 0xb0, 0x1e, 0x5b, 0x1b, 0x1d, 0x6b, 0x46,
+	0x79, 0x2c, 0x34, 0xfd, 0xdb, 0x25, 0xac, 0xf3, 0xfc, 0x52, 0x9b, 0xb5, 0x80, 0x05, 0x4c, 0xfd,
+	0x75, 0x93, 0x7f, 0xba, 0x35, 0xfd, 0xf1, 0x36, 0x03, 0x42, 0x37, 0x59, 0x44, 0x28, 0x8e, 0xc2,
+	0xfe, 0xb6, 0xcb, 0x22, 0xc5, 0x7c, 0x3c, 0x0a, 0x67, 0x0d, 0xc0, 0x2f, 0x27, 0xdc, 0x6f, 0x60,
+	0x8e, 0x7b, 0xa2, 0x49, 0xee, 0xc4, 0x44, 0x48, 0xe7, 0x6b, 0xe0, 0x44, 0xae, 0x55, 0x44, 0x8c,
+	0x0a, 0x02, 0x5f, 0x01, 0x4b, 0x91, 0x6a, 0xa9, 0x5a, 0xc8, 0xda, 0x58, 0xdd, 0x5e, 0xaf, 0x3f,
+	0x5e, 0xc1, 0x7a, 0x6a, 0x7f, 0x65, 0xf1, 0xbd, 0x0f, 0x4f, 0x1f, 0x6a, 0x6a, 0x5b, 0xa7, 0x06,
+	0x4e, 0x29, 0xf0, 0x2f, 0x28, 0x93, 0x37, 0x70, 0x37, 0xf4, 0xb1, 0x64, 0x3c, 0x73, 0xfe, 0x1d,
+	0x0b, 0x3c, 0xbf, 0xcf, 0x00, 0xcd, 0x23, 0x00, 0xc7, 0x53, 0x7f, 0xad, 0x7e, 0xd6, 0x59, 0xb5,
+	0xd0, 0xc2, 0xc6, 0xea, 0xf6, 0x4e, 0x19, 0xa5, 0x02, 0xe8, 0x4d, 0x89, 0x25, 0xd1, 0x04, 0x8f,
+	0x75, 0x0b, 0x0e, 0x33, 0x75, 0xd4, 0xa8, 0x8c, 0x60, 0x0c, 0x4e, 0xe4, 0x5a, 0x35, 0xab, 0xb7,
+	0xc0, 0x31, 0x4a, 0x64, 0x0b, 0xf7, 0x58, 0x4c, 0x65, 0x4b, 0x24, 0x9d, 0x5a, 0xa7, 0x7a, 0x19,
+	0xa9, 0x2f, 0x12, 0xd9, 0x50, 0x66, 0xa3, 0x74, 0x8e, 0xd2, 0x5c, 0xab, 0xe3, 0x82, 0x67, 0x95,
+	0xdb, 0x37, 0x98, 0x0c, 0x69, 0x70, 0x83, 0xed, 0x11, 0xae, 0x19, 0xc1, 0x35, 0x70, 0xb8, 0xcf,
+	0x24, 0xe1, 0xca, 0xdf, 0x4a, 0x33, 0xfd, 0x70, 0x22, 0x50, 0x1d, 0x37, 0xd0, 0x64, 0x6f, 0x81,
+	0xa7, 0xfa, 0xaa, 0xb9, 0x15, 0xb1, 0x3d, 0x6d, 0xb8, 0xba, 0xfd, 0xa9, 0x32, 0xa2, 0x23, 0x50,
+	0x9a, 0xe5, 0x6a, 0x7f, 0xd8, 0xe4, 0x5c, 0xd5, 0xa9, 0xfd, 0x0a, 0xd5, 0x86, 0x4d, 0xe2, 0x31,
+	0xee, 0x1b, 0xe5, 0xe0, 0x27, 0xc0, 0xc7, 0x74, 0xe2, 0x92, 0xfe, 0x8c, 0xef, 0x53, 0x69, 0xe3,
+	0x4d, 0xd5, 0xe6, 0x7c, 0xcb, 0xe4, 0x7f, 0x1c, 0x45, 0x93, 0x6f, 0x83, 0xe3, 0xb1, 0xe9, 0x6b,
+	0xf1, 0xb4, 0x53, 0xe7, 0xdf, 0x2d, 0x8b, 0xa0, 0x00, 0x6a, 0x52, 0x1f, 0x17, 0x7c, 0x39, 0x2e,
+	0x78, 0x46, 0x91, 0xc8, 0x52, 0x63, 0x62, 0x38, 0x09, 0x96, 0x3a, 0x24, 0x0c, 0x3a, 0x52, 0x91,
+	0x5f, 0x68, 0xea, 0x2f, 0xe7, 0x1b, 0x16, 0x38, 0x59, 0xb4, 0xc8, 0xe6, 0xeb, 0x89, 0xd1, 0x99,
+	0x41, 0x71, 0x24, 0x3a, 0x4c, 0x6a, 0xcd, 0xb7, 0xa6, 0x9f, 0x1c, 0xda, 0x50, 0x73, 0x3e, 0x4e,
+	0x8b, 0x1d, 0x4e, 0x07, 0xd4, 0xf2, 0x14, 0x4c, 0x4f, 0x96, 0x81, 0x6b, 0x00, 0x0c, 0x77, 0xa7,
+	0xe1, 0x32, 0x56, 0xdb, 0x53, 0x3d, 0xd9, 0x9e, 0xea, 0xe9, 0x0e, 0x39, 0x5c, 0xc1, 0x01, 0xd1,
+	0xb6, 0xcd, 0x11, 0x4b, 0xe7, 0x0f, 0x16, 0x38, 0xbd, 0xaf, 0x2b, 0x1d, 0x76, 0x08, 0xd6, 0x26,
+	0x84, 0x6d, 0x32, 0x35, 0x77, 0xdc, 0x70, 0x2c, 0x6e, 0x01, 0x5f, 0xcd, 0x85, 0x55, 0x51, 0x61,
+	0x9d, 0x29, 0x0d, 0x2b, 0xe5, 0x99, 0x8b, 0xeb, 0x59, 0x9d, 0xf6, 0xcf, 0x33, 0x21, 0xdf, 0x64,
+	0x74, 0xb8, 0xe8, 0x03, 0x70, 0xb2, 0xd8, 0xa1, 0xc3, 0x7c, 0x1d, 0x80, 0x0e, 0x13, 0xb2, 0xf5,
+	0xf5, 0xa4, 0x55, 0x07, 0xb7, 0x51, 0x16, 0x9c, 0x81, 0xd1, 0x31, 0xad, 0x74, 0x0c, 0xac, 0xb3,
+	0x05, 0xd6, 0x72, 0x8e, 0x4c, 0xe6, 0x9e, 0x03, 0xcb, 0x5e, 0x07, 0x87, 0xb4, 0x15, 0xfa, 0x7a,
+	0xd9, 0x1c, 0x51, 0xdf, 0xaf, 0xf9, 0xce, 0x3b, 0x95, 0x02, 0xeb, 0x8c, 0xdb, 0x75, 0xb0, 0x92,
+	0x71, 0xd3, 0xd9, 0x9e, 0x95, 0xda, 0xb2, 0xa1, 0x06, 0xdf, 0x02, 0x27, 0xda, 0x92, 0xed, 0x12,
+	0xda, 0x92, 0x4c, 0xe2, 0x6e, 0x4b, 0xc4, 0x51, 0xd4, 0xbd, 0xa7, 0xd4, 0x5e, 0xb9, 0x52, 0x4f,
+	0x06, 0xff, 0xe5, 0xc3, 0xd3, 0xeb, 0x41, 0x28, 0x3b, 0x71, 0xbb, 0xee, 0xb1, 0x9e, 0xab, 0x4f,
+	0xbd, 0xf4, 0x67, 0x53, 0xf8, 0xbb, 0xae, 0xbc, 0x17, 0x11, 0x51, 0x7f, 0x8d, 0xca, 0xe6, 0xf1,
+	0x14, 0xea, 0x56, 0x82, 0x74, 0x53, 0x01, 0x25, 0x42, 0x0e, 0xe7, 0x4b, 0x75, 0x61, 0x2e, 0xd8,
+	0x95, 0x6c, 0x72, 0x38, 0xa7, 0x80, 0xad, 0x44, 0x79, 0x9d, 0xf9, 0x71, 0x97, 0x34, 0x3c, 0x2f,
+	0x69, 0xcd, 0xf2, 0xd9, 0x07, 0x1f, 0x9f, 0xd8, 0xab, 0x85, 0xbb, 0x0d, 0x96, 0xb1, 0x6e, 0xd3,
+	0x29, 0xbd, 0x50, 0xa6, 0x5b, 0x0e, 0xc9, 0x00, 0x19, 0x11, 0x0d, 0x98, 0xf3, 0xd0, 0x02, 0xcf,
+	0x4c, 0x1c, 0x09, 0x21, 0x58, 0xa4, 0xb8, 0x47, 0x74, 0x72, 0xd5, 0x7f, 0x58, 0x05, 0x47, 0xb0,
+	0xef, 0x73, 0x22, 0x44, 0x2a, 0x73, 0xd3, 0x7c, 0x26, 0x3d, 0x51, 0xcc, 0x23, 0x26, 0x48, 0xaa,
+	0x54, 0xd3, 0x7c, 0xc2, 0x00, 0x2c, 0xb7, 0x71, 0x17, 0x53, 0x8f, 0x88, 0xea, 0xa2, 0xa2, 0xfe,
+	0x5c, 0x6e, 0x25, 0x18, 0xbe, 0x57, 0x59, 0x48, 0xaf, 0xbc, 0x90, 0xd0, 0xfb, 0xd9, 0x47, 0xa7,
+	0x37, 0xa6, 0xd0, 0x37, 0x31, 0x10, 0xcd, 0x0c, 0x3c, 0xa1, 0xd0, 0xee, 0x32, 0x6f, 0x97, 0xf8,
+	0xd5, 0xc3, 0xc8, 0xda, 0x58, 0x6e, 0x9a, 0x4f, 0xe7, 0x79, 0x2d, 0x6e, 0x93, 0xec, 0x61, 0xee,
+	0x8b, 0xcf, 0x09, 0x19, 0xf6, 0xb0, 0x34, 0x53, 0xd9, 0xf9, 0xd1, 0x22, 0x38, 0x35, 0xb9, 0x5f,
+	0x4b, 0xf1, 0x25, 0xb0, 0x6a, 0xb6, 0x77, 0x1c, 0xe9, 0x53, 0x62, 0xa6, 0xa9, 0xf0, 0x0a, 0xf1,
+	0x9a, 0x40, 0x43, 0x34, 0x22, 0x0e, 0x6f, 0x83, 0xa7, 0x3d, 0xd6, 0xeb, 0x85, 0x42, 0x84, 0x8c,
+	0xb6, 0x78, 0x72, 0x34, 0x57, 0xe6, 0x02, 0x3d, 0x3a, 0x84, 0x69, 0x62, 0x49, 0x60, 0x07, 0x54,
+	0xd3, 0x1b, 0x17, 0xeb, 0xb6, 0x8a, 0x1e, 0x16, 0xe6, 0xf2, 0x70, 0xd2, 0xe0, 0x5d, 0xcd, 0x7b,
+	0xfa, 0x2a, 0xd0, 0x87, 0x14, 0x69, 0xbd, 0x4d, 0x48, 0xea, 0x61, 0x71, 0xbe, 0x18, 0x34, 0xce,
+	0x35, 0x42, 0x14, 0xf2, 0x75, 0xb0, 0x12, 0x70, 0x26, 0x84, 0xd2, 0xfa, 0xf0, 0x5c, 0x90, 0xcb,
+	0x0a, 0x20, 0x51, 0xfa, 0x55, 0x70, 0x44, 0x2d, 0xe2, 0x88, 0x57, 0x97, 0xe6, 0x82, 0x5a, 0x4a,
+	0x56, 0x70, 0xc4, 0xb7, 0x7f, 0x8d, 0xc0, 0x61, 0x35, 0x49, 0xe0, 0x6f, 0x2a, 0x60, 0x29, 0xbd,
+	0x49, 0xc2, 0xed, 0xb2, 0x45, 0x38, 0x7e, 0x99, 0xb5, 0xcf, 0xcf, 0x64, 0x93, 0xce, 0x40, 0xe7,
+	0x4f, 0xd6, 0xa0, 0xf1, 0x63, 0xcb, 0xde, 0x69, 0x12, 0x19, 0x73, 0x2a, 0x10, 0xee, 0x76, 0x91,
+	0xba, 0xbf, 0x12, 0x49, 0xb8, 0x40, 0xec, 0x6d, 0x24, 0x3b, 0x04, 0xa5, 0x78, 0x48, 0x03, 0xa2,
+	0x9e, 0x5a, 0xd7, 0x75, 0xa7, 0x07, 0x6a, 0xd7, 0x42, 0xea, 0x23, 0x16, 0x4b, 0xd4, 0x63, 0x9c,
+	0x20, 0xdc, 0x4e, 0xfe, 0x26, 0x16, 0x51, 0x1a, 0xc7, 0xf5, 0x8e, 0x94, 0x91, 0xb8, 0xe4, 0xba,
+	0xa3, 0xa2, 0x68, 0x96, 0x9b, 0x94, 0xc8, 0x3d, 0xc6, 0x77, 0xb3, 0x06, 0x57, 0x72, 0x42, 0xdc,
+	0x1e, 0x0e, 0xa9, 0x7b, 0xb7, 0x50, 0x20, 0x88, 0x88, 0x78, 0xdf, 0xfc, 0xdd, 0xdf, 0xdf, 0xa9,
+	0x6c, 0xc0, 0x75, 0xb7, 0xa4, 0x88, 0xd0, 0xae, 0xff, 0x5b, 0x01, 0xc7, 0x8a, 0x37, 0x6b, 0xf8,
+	0xf2, 0x54, 0x1a, 0xed, 0x73, 0x63, 0xb7, 0x2f, 0xcf, 0x69, 0xad, 0xb5, 0xfe, 0x87, 0x35, 0x68,
+	0xfc, 0xca, 0xb2, 0x5f, 0x1a, 0xd5, 0x5a, 0x2b, 0x3b, 0xbc, 0xdf, 0x97, 0x48, 0x7e, 0x17, 0x7c,
+	0x72, 0x3f, 0xc9, 0xc7, 0xa0, 0x9e, 0xbc, 0xfa, 0xe7, 0xe0, 0xd9, 0x32, 0xf5, 0x47, 0xdc, 0xff,
+	0x60, 0x01, 0xac, 0x8e, 0x5c, 0xa4, 0xe1, 0x8b, 0x53, 0xc9, 0x37, 0x7e, 0xed, 0xb7, 0x3f, 0x3d,
+	0xbb, 0xa1, 0x96, 0xfc, 0xdd, 0xca, 0xa0, 0xf1, 0x57, 0xcb, 0x6e, 0x19, 0xc9, 0xd3, 0x4b, 0x3c,
+	0x52, 0xb5, 0x40, 0xa2, 0xb4, 0x91, 0x17, 0x53, 0x7f, 0xb2, 0xe2, 0x67, 0xb2, 0x84, 0xa8, 0x5a,
+	0x03, 0xc9, 0x0e, 0x96, 0xc8, 0xc3, 0x14, 0xb5, 0x09, 0x22, 0x77, 0x09, 0xf7, 0x42, 0x41, 0xfc,
+	0x83, 0x4e, 0xcb, 0x45, 0xb8, 0x53, 0x9a, 0x96, 0x91, 0x22, 0xc8, 0xbd, 0xaf, 0x62, 0x79, 0xa0,
+	0x36, 0x9c, 0xb4, 0xb8, 0x9b, 0x72, 0xc3, 0xc9, 0xd5, 0x87, 0xf6, 0xf9, 0x99, 0x6c, 0xf2, 0x1b,
+	0xce, 0x39, 0x93, 0x11, 0x55, 0x3f, 0x96, 0xcd, 0xfa, 0x18, 0xac, 0x97, 0xc8, 0xab, 0x2d, 0x0e,
+	0x64, 0xc3, 0x49, 0x43, 0x80, 0x3f, 0x5f, 0x00, 0xc7, 0x8a, 0xa5, 0xdc, 0x94, 0x1b, 0xce, 0x3e,
+	0x75, 0xa4, 0x7d, 0x79, 0x4e, 0x6b, 0xad, 0xf5, 0x0f, 0x2b, 0x83, 0xc6, 0x6f, 0x2d, 0xfb, 0xb6,
+	0xd1, 0x3a, 0xa4, 0x9b, 0x11, 0x67, 0x01, 0x27, 0x42, 0xa0, 0x98, 0xb6, 0x19, 0xf5, 0x43, 0x1a,
+	0x4c, 0xd2, 0x9e, 0x70, 0x14, 0xd2, 0x50, 0x86, 0x58, 0x12, 0x1f, 0xc9, 0x0e, 0x67, 0x71, 0xd0,
+	0x31, 0xfd, 0x59, 0x05, 0x59, 0x77, 0xf6, 0xc0, 0x46, 0x49, 0x5a, 0x62, 0xfa, 0x7f, 0x4b, 0xcc,
+	0x55, 0xd8, 0x28, 0x4b, 0xcc, 0x58, 0xf1, 0xec, 0xde, 0xcf, 0x95, 0xe5, 0x0f, 0xe0, 0xb7, 0x17,
+	0xc0, 0x4a, 0x56, 0x7f, 0xc1, 0x0b, 0x53, 0xc9, 0x5d, 0xac, 0x94, 0xed, 0x8b, 0xb3, 0x9a, 0xe9,
+	0xf4, 0x7c, 0xb7, 0x32, 0x68, 0xfc, 0x7e, 0x64, 0x73, 0x4a, 0x74, 0xa3, 0x44, 0xa2, 0xb4, 0x30,
+	0x38, 0x87, 0xda, 0xb7, 0x92, 0xca, 0x01, 0xa9, 0x22, 0x04, 0xa5, 0x45, 0x88, 0xda, 0xa8, 0x7a,
+	0x21, 0x95, 0x88, 0x63, 0x49, 0x4c, 0xe6, 0xbc, 0x98, 0x73, 0x42, 0x25, 0x52, 0xd7, 0x52, 0xc4,
+	0x38, 0xc2, 0x28, 0xc2, 0x42, 0x7f, 0xd7, 0x9d, 0x3b, 0xc0, 0xd9, 0x2f, 0x4d, 0x43, 0x77, 0x07,
+	0x72, 0x58, 0x0c, 0xcb, 0x20, 0xf8, 0xbd, 0x05, 0x00, 0xc7, 0x6b, 0x6c, 0xf8, 0x99, 0xd9, 0xb4,
+	0x2d, 0xbe, 0x03, 0xd8, 0x9f, 0x9d, 0xdb, 0x5e, 0x27, 0xe9, 0xdf, 0xd6, 0xa0, 0xf1, 0x4b, 0xcb,
+	0x6e, 0x8c, 0x1e, 0xda, 0x42, 0x32, 0x4e, 0xfc, 0x11, 0xf1, 0x50, 0x56, 0xf4, 0x23, 0xc6, 0x7d,
+	0x92, 0x74, 0xb6, 0xef, 0x25, 0x02, 0x87, 0x1c, 0xa5, 0xcf, 0x26, 0xe2, 0xc0, 0xd2, 0x30, 0xc5,
+	0xe1, 0x30, 0xe9, 0xf5, 0x02, 0x7e, 0x50, 0x01, 0x2b, 0xd9, 0x23, 0xc0, 0x94, 0x4b, 0xa3, 0xf8,
+	0x9a, 0x60, 0x5f, 0x9c, 0xd5, 0x4c, 0xab, 0xfe, 0x91, 0x35, 0x68, 0xfc, 0xb4, 0x70, 0x2d, 0x4d,
+	0xea, 0x73, 0xa4, 0x1e, 0x1f, 0x4a, 0x4e, 0x8b, 0xc7, 0x08, 0x3d, 0xc4, 0x38, 0x90, 0xf9, 0x3e,
+	0x7c, 0x3f, 0x81, 0x7f, 0xac, 0x80, 0x65, 0x13, 0x37, 0xdc, 0x99, 0x49, 0x26, 0x23, 0xee, 0x85,
+	0x19, 0xad, 0xb4, 0xb6, 0x1f, 0x58, 0x83, 0xc6, 0xbb, 0x96, 0xbd, 0x3e, 0xba, 0xed, 0x64, 0xba,
+	0x64, 0x5b, 0x4a, 0x07, 0x87, 0x14, 0x85, 0xfe, 0x81, 0xa9, 0xf9, 0x22, 0xbc, 0x30, 0xbd, 0x9a,
+	0xee, 0x7d, 0xf3, 0x64, 0xf4, 0x00, 0xfe, 0xa7, 0x02, 0x8e, 0xe6, 0x1f, 0x3b, 0xe0, 0xa5, 0xa9,
+	0x84, 0x9a, 0xf8, 0x7e, 0x62, 0xbf, 0x34, 0x97, 0xad, 0x96, 0xfa, 0x9f, 0xd6, 0xa0, 0xf1, 0x0b,
+	0xcb, 0xbe, 0x34, 0x2a, 0xb5, 0x79, 0x21, 0x41, 0x3d, 0x4c, 0x71, 0x90, 0xed, 0x14, 0xfb, 0x4d,
+	0xe6, 0x3e, 0x38, 0xb3, 0x9f, 0xfc, 0xe9, 0x90, 0x0c, 0xf0, 0xc9, 0xe7, 0x60, 0x0b, 0xba, 0x65,
+	0x39, 0x48, 0x39, 0xb4, 0x32, 0x0e, 0xff, 0xaa, 0x80, 0xa7, 0x0b, 0xaf, 0x1d, 0x70, 0x3a, 0x09,
+	0x27, 0xbf, 0xa1, 0xd8, 0x2f, 0xcf, 0x67, 0xac, 0x13, 0xf0, 0xc8, 0x1a, 0x34, 0x7e, 0x32, 0x72,
+	0xdb, 0x4c, 0x64, 0x23, 0x7a, 0x90, 0x8f, 0x1a, 0x37, 0x9a, 0xc9, 0x7c, 0xcf, 0x2b, 0xff, 0xd8,
+	0x6b, 0x0d, 0x4f, 0xfd, 0x64, 0x18, 0x4f, 0x5e, 0xf3, 0x6d, 0xf8, 0x42, 0x99, 0xe6, 0x9a, 0x44,
+	0xcb, 0x90, 0xb8, 0x72, 0xfb, 0xbd, 0x87, 0x35, 0xeb, 0xfd, 0x87, 0x35, 0xeb, 0x6f, 0x0f, 0x6b,
+	0xd6, 0xf7, 0x1f, 0xd5, 0x0e, 0xbd, 0xff, 0xa8, 0x76, 0xe8, 0xcf, 0x8f, 0x6a, 0x87, 0xde, 0xbc,
+	0x3c, 0x15, 0xad, 0xfe, 0xce, 0x18, 0x1f, 0xf5, 0x4e, 0xd1, 0x5e, 0x52, 0x6f, 0x34, 0xe7, 0xff,
+	0x37, 0x00, 0x84, 0x57, 0x22, 0xbd, 0x6e, 0x1c, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params returns parameters of the liquidstaking module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// LiquidValidators returns liquid validators with states of the liquidstaking module.
+	LiquidValidators(ctx context.Context, in *QueryLiquidValidatorsRequest, opts ...grpc.CallOption) (*QueryLiquidValidatorsResponse, error)
+	// VotingPower returns voting power of staking and liquid staking module's of the voter that can be exercised.
+	VotingPower(ctx context.Context, in *QueryVotingPowerRequest, opts ...grpc.CallOption) (*QueryVotingPowerResponse, error)
+	// States returns states of the liquidstaking module.
+	States(ctx context.Context, in *QueryStatesRequest, opts ...grpc.CallOption) (*QueryStatesResponse, error)
+	// UnstakingRecords returns in-progress unbondings of the liquid staker initiated through liquid unstaking.
+	UnstakingRecords(ctx context.Context, in *QueryUnstakingRecordsRequest, opts ...grpc.CallOption) (*QueryUnstakingRecordsResponse, error)
+	// NetAmount returns the net amount, bToken total supply and mint rate of the current block, or of a past block from
+	// the stored net amount snapshots.
+	NetAmount(ctx context.Context, in *QueryNetAmountRequest, opts ...grpc.CallOption) (*QueryNetAmountResponse, error)
+	// NetAmountSnapshots returns all stored net amount snapshots ordered by their heights.
+	NetAmountSnapshots(ctx context.Context, in *QueryNetAmountSnapshotsRequest, opts ...grpc.CallOption) (*QueryNetAmountSnapshotsResponse, error)
+	// HostZones returns all host chains where the liquid staking module stakes tokens through interchain accounts.
+	HostZones(ctx context.Context, in *QueryHostZonesRequest, opts ...grpc.CallOption) (*QueryHostZonesResponse, error)
+	// HostZone returns the host chain with the bToken supply and the net amount staked on the host chain.
+	HostZone(ctx context.Context, in *QueryHostZoneRequest, opts ...grpc.CallOption) (*QueryHostZoneResponse, error)
+	// ModuleAccounts returns the module account and the accounts derived by the module, such as the proxy account and
+	// the deposit accounts of host zones, with their balances.
+	ModuleAccounts(ctx context.Context, in *QueryModuleAccountsRequest, opts ...grpc.CallOption) (*QueryModuleAccountsResponse, error)
+	// RewardsEstimate returns the estimated APR of liquid staking from the inflation of the mint module, the commission
+	// rates of the active liquid validators and the unstake fee rate.
+	RewardsEstimate(ctx context.Context, in *QueryRewardsEstimateRequest, opts ...grpc.CallOption) (*QueryRewardsEstimateResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/crescent.liquidstaking.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) LiquidValidators(ctx context.Context, in *QueryLiquidValidatorsRequest, opts ...grpc.CallOption) (*QueryLiquidValidatorsResponse, error) {
+	out := new(QueryLiquidValidatorsResponse)
+	err := c.cc.Invoke(ctx, "/crescent.liquidstaking.v1beta1.Query/LiquidValidators", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryLiquidValidatorsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) VotingPower(ctx context.Context, in *QueryVotingPowerRequest, opts ...grpc.CallOption) (*QueryVotingPowerResponse, error) {
+	out := new(QueryVotingPowerResponse)
+	err := c.cc.Invoke(ctx, "/crescent.liquidstaking.v1beta1.Query/VotingPower", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *QueryLiquidValidatorsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) States(ctx context.Context, in *QueryStatesRequest, opts ...grpc.CallOption) (*QueryStatesResponse, error) {
+	out := new(QueryStatesResponse)
+	err := c.cc.Invoke(ctx, "/crescent.liquidstaking.v1beta1.Query/States", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.LiquidValidators) > 0 {
-		for _, e := range m.LiquidValidators {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *queryClient) UnstakingRecords(ctx context.Context, in *QueryUnstakingRecordsRequest, opts ...grpc.CallOption) (*QueryUnstakingRecordsResponse, error) {
+	out := new(QueryUnstakingRecordsResponse)
+	err := c.cc.Invoke(ctx, "/crescent.liquidstaking.v1beta1.Query/UnstakingRecords", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryStatesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) NetAmount(ctx context.Context, in *QueryNetAmountRequest, opts ...grpc.CallOption) (*QueryNetAmountResponse, error) {
+	out := new(QueryNetAmountResponse)
+	err := c.cc.Invoke(ctx, "/crescent.liquidstaking.v1beta1.Query/NetAmount", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *QueryStatesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) NetAmountSnapshots(ctx context.Context, in *QueryNetAmountSnapshotsRequest, opts ...grpc.CallOption) (*QueryNetAmountSnapshotsResponse, error) {
+	out := new(QueryNetAmountSnapshotsResponse)
+	err := c.cc.Invoke(ctx, "/crescent.liquidstaking.v1beta1.Query/NetAmountSnapshots", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.NetAmountState.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryVotingPowerRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) HostZones(ctx context.Context, in *QueryHostZonesRequest, opts ...grpc.CallOption) (*QueryHostZonesResponse, error) {
+	out := new(QueryHostZonesResponse)
+	err := c.cc.Invoke(ctx, "/crescent.liquidstaking.v1beta1.Query/HostZones", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Voter)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) HostZone(ctx context.Context, in *QueryHostZoneRequest, opts ...grpc.CallOption) (*QueryHostZoneResponse, error) {
+	out := new(QueryHostZoneResponse)
+	err := c.cc.Invoke(ctx, "/crescent.liquidstaking.v1beta1.Query/HostZone", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ModuleAccounts(ctx context.Context, in *QueryModuleAccountsRequest, opts ...grpc.CallOption) (*QueryModuleAccountsResponse, error) {
+	out := new(QueryModuleAccountsResponse)
+	err := c.cc.Invoke(ctx, "/crescent.liquidstaking.v1beta1.Query/ModuleAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RewardsEstimate(ctx context.Context, in *QueryRewardsEstimateRequest, opts ...grpc.CallOption) (*QueryRewardsEstimateResponse, error) {
+	out := new(QueryRewardsEstimateResponse)
+	err := c.cc.Invoke(ctx, "/crescent.liquidstaking.v1beta1.Query/RewardsEstimate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params returns parameters of the liquidstaking module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// LiquidValidators returns liquid validators with states of the liquidstaking module.
+	LiquidValidators(context.Context, *QueryLiquidValidatorsRequest) (*QueryLiquidValidatorsResponse, error)
+	// VotingPower returns voting power of staking and liquid staking module's of the voter that can be exercised.
+	VotingPower(context.Context, *QueryVotingPowerRequest) (*QueryVotingPowerResponse, error)
+	// States returns states of the liquidstaking module.
+	States(context.Context, *QueryStatesRequest) (*QueryStatesResponse, error)
+	// UnstakingRecords returns in-progress unbondings of the liquid staker initiated through liquid unstaking.
+	UnstakingRecords(context.Context, *QueryUnstakingRecordsRequest) (*QueryUnstakingRecordsResponse, error)
+	// NetAmount returns the net amount, bToken total supply and mint rate of the current block, or of a past block from
+	// the stored net amount snapshots.
+	NetAmount(context.Context, *QueryNetAmountRequest) (*QueryNetAmountResponse, error)
+	// NetAmountSnapshots returns all stored net amount snapshots ordered by their heights.
+	NetAmountSnapshots(context.Context, *QueryNetAmountSnapshotsRequest) (*QueryNetAmountSnapshotsResponse, error)
+	// HostZones returns all host chains where the liquid staking module stakes tokens through interchain accounts.
+	HostZones(context.Context, *QueryHostZonesRequest) (*QueryHostZonesResponse, error)
+	// HostZone returns the host chain with the bToken supply and the net amount staked on the host chain.
+	HostZone(context.Context, *QueryHostZoneRequest) (*QueryHostZoneResponse, error)
+	// ModuleAccounts returns the module account and the accounts derived by the module, such as the proxy account and
+	// the deposit accounts of host zones, with their balances.
+	ModuleAccounts(context.Context, *QueryModuleAccountsRequest) (*QueryModuleAccountsResponse, error)
+	// RewardsEstimate returns the estimated APR of liquid staking from the inflation of the mint module, the commission
+	// rates of the active liquid validators and the unstake fee rate.
+	RewardsEstimate(context.Context, *QueryRewardsEstimateRequest) (*QueryRewardsEstimateResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) LiquidValidators(ctx context.Context, req *QueryLiquidValidatorsRequest) (*QueryLiquidValidatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiquidValidators not implemented")
+}
+func (*UnimplementedQueryServer) VotingPower(ctx context.Context, req *QueryVotingPowerRequest) (*QueryVotingPowerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VotingPower not implemented")
+}
+func (*UnimplementedQueryServer) States(ctx context.Context, req *QueryStatesRequest) (*QueryStatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method States not implemented")
+}
+func (*UnimplementedQueryServer) UnstakingRecords(ctx context.Context, req *QueryUnstakingRecordsRequest) (*QueryUnstakingRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnstakingRecords not implemented")
+}
+func (*UnimplementedQueryServer) NetAmount(ctx context.Context, req *QueryNetAmountRequest) (*QueryNetAmountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NetAmount not implemented")
+}
+func (*UnimplementedQueryServer) NetAmountSnapshots(ctx context.Context, req *QueryNetAmountSnapshotsRequest) (*QueryNetAmountSnapshotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NetAmountSnapshots not implemented")
+}
+func (*UnimplementedQueryServer) HostZones(ctx context.Context, req *QueryHostZonesRequest) (*QueryHostZonesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HostZones not implemented")
+}
+func (*UnimplementedQueryServer) HostZone(ctx context.Context, req *QueryHostZoneRequest) (*QueryHostZoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HostZone not implemented")
+}
+func (*UnimplementedQueryServer) ModuleAccounts(ctx context.Context, req *QueryModuleAccountsRequest) (*QueryModuleAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModuleAccounts not implemented")
+}
+func (*UnimplementedQueryServer) RewardsEstimate(ctx context.Context, req *QueryRewardsEstimateRequest) (*QueryRewardsEstimateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewardsEstimate not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crescent.liquidstaking.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LiquidValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLiquidValidatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LiquidValidators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crescent.liquidstaking.v1beta1.Query/LiquidValidators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LiquidValidators(ctx, req.(*QueryLiquidValidatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VotingPower_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVotingPowerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VotingPower(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crescent.liquidstaking.v1beta1.Query/VotingPower",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VotingPower(ctx, req.(*QueryVotingPowerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_States_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).States(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crescent.liquidstaking.v1beta1.Query/States",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).States(ctx, req.(*QueryStatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UnstakingRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUnstakingRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UnstakingRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crescent.liquidstaking.v1beta1.Query/UnstakingRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UnstakingRecords(ctx, req.(*QueryUnstakingRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NetAmount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNetAmountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NetAmount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crescent.liquidstaking.v1beta1.Query/NetAmount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NetAmount(ctx, req.(*QueryNetAmountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NetAmountSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNetAmountSnapshotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NetAmountSnapshots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crescent.liquidstaking.v1beta1.Query/NetAmountSnapshots",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NetAmountSnapshots(ctx, req.(*QueryNetAmountSnapshotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_HostZones_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHostZonesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HostZones(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crescent.liquidstaking.v1beta1.Query/HostZones",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HostZones(ctx, req.(*QueryHostZonesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_HostZone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHostZoneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HostZone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crescent.liquidstaking.v1beta1.Query/HostZone",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HostZone(ctx, req.(*QueryHostZoneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ModuleAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryModuleAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ModuleAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crescent.liquidstaking.v1beta1.Query/ModuleAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ModuleAccounts(ctx, req.(*QueryModuleAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RewardsEstimate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRewardsEstimateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RewardsEstimate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crescent.liquidstaking.v1beta1.Query/RewardsEstimate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RewardsEstimate(ctx, req.(*QueryRewardsEstimateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "crescent.liquidstaking.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "LiquidValidators",
+			Handler:    _Query_LiquidValidators_Handler,
+		},
+		{
+			MethodName: "VotingPower",
+			Handler:    _Query_VotingPower_Handler,
+		},
+		{
+			MethodName: "States",
+			Handler:    _Query_States_Handler,
+		},
+		{
+			MethodName: "UnstakingRecords",
+			Handler:    _Query_UnstakingRecords_Handler,
+		},
+		{
+			MethodName: "NetAmount",
+			Handler:    _Query_NetAmount_Handler,
+		},
+		{
+			MethodName: "NetAmountSnapshots",
+			Handler:    _Query_NetAmountSnapshots_Handler,
+		},
+		{
+			MethodName: "HostZones",
+			Handler:    _Query_HostZones_Handler,
+		},
+		{
+			MethodName: "HostZone",
+			Handler:    _Query_HostZone_Handler,
+		},
+		{
+			MethodName: "ModuleAccounts",
+			Handler:    _Query_ModuleAccounts_Handler,
+		},
+		{
+			MethodName: "RewardsEstimate",
+			Handler:    _Query_RewardsEstimate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "crescent/liquidstaking/v1beta1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLiquidValidatorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiquidValidatorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiquidValidatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLiquidValidatorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiquidValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiquidValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LiquidValidators) > 0 {
+		for iNdEx := len(m.LiquidValidators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LiquidValidators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.NetAmountState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVotingPowerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVotingPowerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVotingPowerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVotingPowerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVotingPowerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVotingPowerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.VotingPower.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnstakingRecordsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnstakingRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnstakingRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LiquidStaker) > 0 {
+		i -= len(m.LiquidStaker)
+		copy(dAtA[i:], m.LiquidStaker)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.LiquidStaker)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnstakingRecordsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnstakingRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnstakingRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UnstakingRecords) > 0 {
+		for iNdEx := len(m.UnstakingRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnstakingRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNetAmountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNetAmountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNetAmountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNetAmountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNetAmountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNetAmountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.NetAmountSnapshot.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNetAmountSnapshotsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNetAmountSnapshotsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNetAmountSnapshotsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNetAmountSnapshotsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNetAmountSnapshotsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNetAmountSnapshotsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NetAmountSnapshots) > 0 {
+		for iNdEx := len(m.NetAmountSnapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NetAmountSnapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHostZonesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHostZonesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHostZonesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHostZonesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHostZonesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHostZonesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.HostZones) > 0 {
+		for iNdEx := len(m.HostZones) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HostZones[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHostZoneRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHostZoneRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHostZoneRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHostZoneResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHostZoneResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHostZoneResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.NetAmount.Size()
+		i -= size
+		if _, err := m.NetAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.BtokenTotalSupply.Size()
+		i -= size
+		if _, err := m.BtokenTotalSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.HostZone.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryModuleAccountsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryModuleAccountsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryModuleAccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryModuleAccountsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryModuleAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryModuleAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ModuleAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ModuleAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModuleAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Blocked {
+		i--
+		if m.Blocked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Balances) > 0 {
+		for iNdEx := len(m.Balances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Purpose) > 0 {
+		i -= len(m.Purpose)
+		copy(dAtA[i:], m.Purpose)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Purpose)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardsEstimateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardsEstimateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardsEstimateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardsEstimateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardsEstimateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardsEstimateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.NetApr.Size()
+		i -= size
+		if _, err := m.NetApr.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.GrossApr.Size()
+		i -= size
+		if _, err := m.GrossApr.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.UnstakeFeeRate.Size()
+		i -= size
+		if _, err := m.UnstakeFeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.ProtocolCommissionRate.Size()
+		i -= size
+		if _, err := m.ProtocolCommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.CommissionRate.Size()
+		i -= size
+		if _, err := m.CommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.StakingApr.Size()
+		i -= size
+		if _, err := m.StakingApr.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryLiquidValidatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryLiquidValidatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LiquidValidators) > 0 {
+		for _, e := range m.LiquidValidators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryStatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryStatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.NetAmountState.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryVotingPowerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVotingPowerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.VotingPower.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryUnstakingRecordsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LiquidStaker)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUnstakingRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.UnstakingRecords) > 0 {
+		for _, e := range m.UnstakingRecords {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryNetAmountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryNetAmountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.NetAmountSnapshot.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryNetAmountSnapshotsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNetAmountSnapshotsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.NetAmountSnapshots) > 0 {
+		for _, e := range m.NetAmountSnapshots {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHostZonesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryHostZonesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.HostZones) > 0 {
+		for _, e := range m.HostZones {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryHostZoneRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHostZoneResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.HostZone.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.BtokenTotalSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.NetAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryModuleAccountsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryModuleAccountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for _, e := range m.Accounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ModuleAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Purpose)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Balances) > 0 {
+		for _, e := range m.Balances {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Blocked {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryRewardsEstimateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryRewardsEstimateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.StakingApr.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.CommissionRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ProtocolCommissionRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.UnstakeFeeRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.GrossApr.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.NetApr.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLiquidValidatorsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLiquidValidatorsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLiquidValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLiquidValidatorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLiquidValidatorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLiquidValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidValidators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiquidValidators = append(m.LiquidValidators, LiquidValidatorState{})
+			if err := m.LiquidValidators[len(m.LiquidValidators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStatesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStatesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStatesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStatesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetAmountState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NetAmountState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVotingPowerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVotingPowerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVotingPowerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVotingPowerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVotingPowerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVotingPowerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VotingPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUnstakingRecordsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUnstakingRecordsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUnstakingRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidStaker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiquidStaker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUnstakingRecordsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUnstakingRecordsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUnstakingRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnstakingRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnstakingRecords = append(m.UnstakingRecords, UnstakingRecord{})
+			if err := m.UnstakingRecords[len(m.UnstakingRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNetAmountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNetAmountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNetAmountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNetAmountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNetAmountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNetAmountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetAmountSnapshot", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NetAmountSnapshot.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNetAmountSnapshotsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNetAmountSnapshotsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNetAmountSnapshotsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNetAmountSnapshotsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNetAmountSnapshotsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNetAmountSnapshotsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetAmountSnapshots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NetAmountSnapshots = append(m.NetAmountSnapshots, NetAmountSnapshot{})
+			if err := m.NetAmountSnapshots[len(m.NetAmountSnapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHostZonesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHostZonesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHostZonesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHostZonesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHostZonesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHostZonesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostZones", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostZones = append(m.HostZones, HostZone{})
+			if err := m.HostZones[len(m.HostZones)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHostZoneRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHostZoneRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHostZoneRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *QueryVotingPowerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.VotingPower.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
+func (m *QueryHostZoneResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHostZoneResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHostZoneResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostZone", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.HostZone.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtokenTotalSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BtokenTotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NetAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryModuleAccountsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -992,10 +4317,10 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryModuleAccountsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryModuleAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1019,7 +4344,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryModuleAccountsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1042,15 +4367,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryModuleAccountsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryModuleAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1077,7 +4402,8 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Accounts = append(m.Accounts, ModuleAccountResponse{})
+			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1102,7 +4428,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLiquidValidatorsRequest) Unmarshal(dAtA []byte) error {
+func (m *ModuleAccountResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1125,65 +4451,111 @@ func (m *QueryLiquidValidatorsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLiquidValidatorsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ModuleAccountResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLiquidValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ModuleAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryLiquidValidatorsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Purpose", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLiquidValidatorsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLiquidValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Purpose = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidValidators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1210,11 +4582,31 @@ func (m *QueryLiquidValidatorsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LiquidValidators = append(m.LiquidValidators, LiquidValidatorState{})
-			if err := m.LiquidValidators[len(m.LiquidValidators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Balances = append(m.Balances, types.Coin{})
+			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocked", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Blocked = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1236,7 +4628,7 @@ func (m *QueryLiquidValidatorsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStatesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRewardsEstimateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1259,10 +4651,10 @@ func (m *QueryStatesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStatesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRewardsEstimateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRewardsEstimateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1286,7 +4678,7 @@ func (m *QueryStatesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStatesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRewardsEstimateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1309,17 +4701,17 @@ func (m *QueryStatesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStatesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRewardsEstimateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRewardsEstimateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetAmountState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingApr", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1329,78 +4721,63 @@ func (m *QueryStatesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NetAmountState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.StakingApr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRate", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryVotingPowerRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.CommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVotingPowerRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVotingPowerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolCommissionRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1428,63 +4805,83 @@ func (m *QueryVotingPowerRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Voter = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.ProtocolCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnstakeFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryVotingPowerResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			if err := m.UnstakeFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GrossApr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.GrossApr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVotingPowerResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVotingPowerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NetApr", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1494,22 +4891,23 @@ func (m *QueryVotingPowerResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.VotingPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NetApr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,37 @@
+package types
+
+import (
+	"bytes"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+)
+
+// DecodeStateChange decodes a KV pair of the liquidstaking store streamed by
+// ADR-038 streaming services into a LiquidValidatorStateChange.
+// It returns nil if the KV pair is of another store or of other states.
+func DecodeStateChange(cdc codec.BinaryCodec, kvPair storetypes.StoreKVPair) (proto.Message, error) {
+	if kvPair.StoreKey != StoreKey || len(kvPair.Key) < 2 {
+		return nil, nil
+	}
+	key := kvPair.Key
+	switch {
+	case bytes.HasPrefix(key, LiquidValidatorsKey) && len(key) == 2+int(key[1]):
+		change := &LiquidValidatorStateChange{
+			OperatorAddress: sdk.ValAddress(key[2:]).String(),
+			Deleted:         kvPair.Delete,
+		}
+		if !kvPair.Delete {
+			val, err := UnmarshalLiquidValidator(cdc, kvPair.Value)
+			if err != nil {
+				return nil, err
+			}
+			change.LiquidValidator = &val
+		}
+		return change, nil
+	default:
+		return nil, nil
+	}
+}
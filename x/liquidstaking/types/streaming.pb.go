@@ -0,0 +1,413 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: crescent/liquidstaking/v1beta1/streaming.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// LiquidValidatorStateChange is the payload decoded from a change of a liquid
+// validator in the liquidstaking store streamed by ADR-038 streaming
+// services, so that indexers can follow the liquid validators without
+// replaying events.
+// See DecodeStateChange for how the payload is decoded.
+type LiquidValidatorStateChange struct {
+	OperatorAddress string `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
+	// deleted is true if the liquid validator is deleted from the store.
+	Deleted bool `protobuf:"varint,2,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	// liquid_validator is the liquid validator after the change. It is nil if
+	// the liquid validator is deleted.
+	LiquidValidator *LiquidValidator `protobuf:"bytes,3,opt,name=liquid_validator,json=liquidValidator,proto3" json:"liquid_validator,omitempty"`
+}
+
+func (m *LiquidValidatorStateChange) Reset()         { *m = LiquidValidatorStateChange{} }
+func (m *LiquidValidatorStateChange) String() string { return proto.CompactTextString(m) }
+func (*LiquidValidatorStateChange) ProtoMessage()    {}
+func (*LiquidValidatorStateChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1dda047587f3f492, []int{0}
+}
+func (m *LiquidValidatorStateChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LiquidValidatorStateChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LiquidValidatorStateChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LiquidValidatorStateChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LiquidValidatorStateChange.Merge(m, src)
+}
+func (m *LiquidValidatorStateChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *LiquidValidatorStateChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_LiquidValidatorStateChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LiquidValidatorStateChange proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*LiquidValidatorStateChange)(nil), "crescent.liquidstaking.v1beta1.LiquidValidatorStateChange")
+}
+
+func init() {
+	proto.RegisterFile("crescent/liquidstaking/v1beta1/streaming.proto", fileDescriptor_1dda047587f3f492)
+}
+
+var fileDescriptor_1dda047587f3f492 = []byte{
+	// 283 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x90, 0xbd, 0x4e, 0xf3, 0x30,
+	0x14, 0x86, 0xe3, 0xef, 0x93, 0xf8, 0x09, 0x43, 0xab, 0x88, 0x21, 0xea, 0x60, 0x55, 0x4c, 0x65,
+	0xc0, 0x56, 0x0b, 0x2b, 0x03, 0xb0, 0x32, 0x05, 0x89, 0xa1, 0x0c, 0x95, 0x13, 0x1f, 0xb9, 0x56,
+	0xd3, 0x38, 0xd8, 0xa7, 0x01, 0xee, 0x82, 0x1b, 0x62, 0xef, 0xd8, 0x91, 0x11, 0x92, 0x1b, 0x41,
+	0x4d, 0x08, 0x52, 0x3a, 0xc0, 0x66, 0x3f, 0x7a, 0x8f, 0x1f, 0x9f, 0xd7, 0x67, 0x89, 0x05, 0x97,
+	0x40, 0x86, 0x3c, 0xd5, 0x8f, 0x2b, 0x2d, 0x1d, 0x8a, 0x85, 0xce, 0x14, 0x2f, 0xc6, 0x31, 0xa0,
+	0x18, 0x73, 0x87, 0x16, 0xc4, 0x52, 0x67, 0x8a, 0xe5, 0xd6, 0xa0, 0x09, 0x68, 0x9b, 0x67, 0x9d,
+	0x3c, 0xfb, 0xce, 0x0f, 0x8e, 0x95, 0x51, 0xa6, 0x8e, 0xf2, 0xed, 0xa9, 0x99, 0x1a, 0x4c, 0xfe,
+	0xb0, 0x74, 0xdf, 0xaa, 0x67, 0x4e, 0xde, 0x88, 0x3f, 0xb8, 0xad, 0xf9, 0xbd, 0x48, 0xb5, 0x14,
+	0x68, 0xec, 0x1d, 0x0a, 0x84, 0x9b, 0xb9, 0xc8, 0x14, 0x04, 0xa7, 0x7e, 0xdf, 0xe4, 0x60, 0xb7,
+	0x78, 0x26, 0xa4, 0xb4, 0xe0, 0x5c, 0x48, 0x86, 0x64, 0x74, 0x18, 0xf5, 0x5a, 0x7e, 0xd5, 0xe0,
+	0x20, 0xf4, 0xf7, 0x25, 0xa4, 0x80, 0x20, 0xc3, 0x7f, 0x43, 0x32, 0x3a, 0x88, 0xda, 0x6b, 0x30,
+	0xf5, 0xfb, 0x8d, 0x7a, 0x56, 0xb4, 0x8e, 0xf0, 0xff, 0x90, 0x8c, 0x8e, 0x26, 0x9c, 0xfd, 0xbe,
+	0x28, 0xdb, 0xf9, 0x5a, 0xd4, 0x4b, 0xbb, 0xe0, 0xfa, 0x61, 0xfd, 0x49, 0xbd, 0x75, 0x49, 0xc9,
+	0xa6, 0xa4, 0xe4, 0xa3, 0xa4, 0xe4, 0xb5, 0xa2, 0xde, 0xa6, 0xa2, 0xde, 0x7b, 0x45, 0xbd, 0xe9,
+	0xa5, 0xd2, 0x38, 0x5f, 0xc5, 0x2c, 0x31, 0x4b, 0xde, 0x9a, 0xce, 0x32, 0xc0, 0x27, 0x63, 0x17,
+	0x3f, 0x80, 0x17, 0x17, 0xfc, 0x79, 0xa7, 0x32, 0x7c, 0xc9, 0xc1, 0xc5, 0x7b, 0x75, 0x47, 0xe7,
+	0x5f, 0x03, 0x00, 0x0d, 0x84, 0x0d, 0xde, 0xbf, 0x01, 0x00, 0x00,
+}
+
+func (m *LiquidValidatorStateChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LiquidValidatorStateChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquidValidatorStateChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LiquidValidator != nil {
+		{
+			size, err := m.LiquidValidator.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStreaming(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Deleted {
+		i--
+		if m.Deleted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintStreaming(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStreaming(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStreaming(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *LiquidValidatorStateChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStreaming(uint64(l))
+	}
+	if m.Deleted {
+		n += 2
+	}
+	if m.LiquidValidator != nil {
+		l = m.LiquidValidator.Size()
+		n += 1 + l + sovStreaming(uint64(l))
+	}
+	return n
+}
+
+func sovStreaming(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStreaming(x uint64) (n int) {
+	return sovStreaming(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *LiquidValidatorStateChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStreaming
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidValidatorStateChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidValidatorStateChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deleted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Deleted = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidValidator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LiquidValidator == nil {
+				m.LiquidValidator = &LiquidValidator{}
+			}
+			if err := m.LiquidValidator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStreaming(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipStreaming(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowStreaming
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthStreaming
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupStreaming
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthStreaming
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthStreaming        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowStreaming          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupStreaming = fmt.Errorf("proto: unexpected end of group")
+)
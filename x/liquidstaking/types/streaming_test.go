@@ -0,0 +1,57 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	farmingtypes "github.com/crescent-network/crescent/v4/x/farming/types"
+	"github.com/crescent-network/crescent/v4/x/liquidstaking/types"
+)
+
+func TestDecodeStateChange(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	valAddr := sdk.ValAddress(farmingtypes.DeriveAddress(farmingtypes.AddressType20Bytes, types.ModuleName, "valoper"))
+	lv := types.LiquidValidator{
+		OperatorAddress: valAddr.String(),
+		Status:          types.ValidatorStatusActive,
+	}
+
+	change, err := types.DecodeStateChange(cdc, storetypes.StoreKVPair{
+		StoreKey: types.StoreKey,
+		Key:      types.GetLiquidValidatorKey(valAddr),
+		Value:    types.MustMarshalLiquidValidator(cdc, &lv),
+	})
+	require.NoError(t, err)
+	require.Equal(t, &types.LiquidValidatorStateChange{OperatorAddress: valAddr.String(), LiquidValidator: &lv}, change)
+
+	change, err = types.DecodeStateChange(cdc, storetypes.StoreKVPair{
+		StoreKey: types.StoreKey,
+		Key:      types.GetLiquidValidatorKey(valAddr),
+		Delete:   true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, &types.LiquidValidatorStateChange{OperatorAddress: valAddr.String(), Deleted: true}, change)
+
+	// Other states are not decoded.
+	change, err = types.DecodeStateChange(cdc, storetypes.StoreKVPair{
+		StoreKey: types.StoreKey,
+		Key:      types.GetNetAmountSnapshotKey(1),
+		Value:    []byte{0x01},
+	})
+	require.NoError(t, err)
+	require.Nil(t, change)
+
+	change, err = types.DecodeStateChange(cdc, storetypes.StoreKVPair{
+		StoreKey: "staking",
+		Key:      types.GetLiquidValidatorKey(valAddr),
+		Value:    types.MustMarshalLiquidValidator(cdc, &lv),
+	})
+	require.NoError(t, err)
+	require.Nil(t, change)
+}
@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
@@ -39,6 +40,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type MsgLiquidStake struct {
 	DelegatorAddress string     `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty" yaml:"delegator_address"`
 	Amount           types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+	// farm specifies whether to farm the minted bToken in the lpfarm module in the same transaction
+	Farm bool `protobuf:"varint,3,opt,name=farm,proto3" json:"farm,omitempty" yaml:"farm"`
 }
 
 func (m *MsgLiquidStake) Reset()         { *m = MsgLiquidStake{} }
@@ -196,11 +199,464 @@ func (m *MsgLiquidUnstakeResponse) GetCompletionTime() time.Time {
 	return time.Time{}
 }
 
+// MsgArbLiquidStake defines a SDK message for performing an arbitrage between
+// the bToken price on the DEX and the liquid staking rates.
+type MsgArbLiquidStake struct {
+	ArbitragerAddress string `protobuf:"bytes,1,opt,name=arbitrager_address,json=arbitragerAddress,proto3" json:"arbitrager_address,omitempty" yaml:"arbitrager_address"`
+	// pair_id specifies the pair of bToken(base) and bond denom(quote) on the DEX
+	PairId uint64 `protobuf:"varint,2,opt,name=pair_id,json=pairId,proto3" json:"pair_id,omitempty" yaml:"pair_id"`
+	// max_spend specifies the maximum amount of bond denom coin to spend
+	MaxSpend types.Coin `protobuf:"bytes,3,opt,name=max_spend,json=maxSpend,proto3" json:"max_spend" yaml:"max_spend"`
+}
+
+func (m *MsgArbLiquidStake) Reset()         { *m = MsgArbLiquidStake{} }
+func (m *MsgArbLiquidStake) String() string { return proto.CompactTextString(m) }
+func (*MsgArbLiquidStake) ProtoMessage()    {}
+func (*MsgArbLiquidStake) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9fe270968086aea1, []int{4}
+}
+func (m *MsgArbLiquidStake) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgArbLiquidStake) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgArbLiquidStake.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgArbLiquidStake) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgArbLiquidStake.Merge(m, src)
+}
+func (m *MsgArbLiquidStake) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgArbLiquidStake) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgArbLiquidStake.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgArbLiquidStake proto.InternalMessageInfo
+
+// MsgArbLiquidStakeResponse defines the Msg/ArbLiquidStake response type.
+type MsgArbLiquidStakeResponse struct {
+	// order_id specifies the id of the order made on the DEX
+	OrderId uint64 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+}
+
+func (m *MsgArbLiquidStakeResponse) Reset()         { *m = MsgArbLiquidStakeResponse{} }
+func (m *MsgArbLiquidStakeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgArbLiquidStakeResponse) ProtoMessage()    {}
+func (*MsgArbLiquidStakeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9fe270968086aea1, []int{5}
+}
+func (m *MsgArbLiquidStakeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgArbLiquidStakeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgArbLiquidStakeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgArbLiquidStakeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgArbLiquidStakeResponse.Merge(m, src)
+}
+func (m *MsgArbLiquidStakeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgArbLiquidStakeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgArbLiquidStakeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgArbLiquidStakeResponse proto.InternalMessageInfo
+
+func (m *MsgArbLiquidStakeResponse) GetOrderId() uint64 {
+	if m != nil {
+		return m.OrderId
+	}
+	return 0
+}
+
+// MsgLiquidUnstakeInstant defines a SDK message for selling bToken through a pair on the DEX
+// to get the bond denom coin immediately.
+type MsgLiquidUnstakeInstant struct {
+	LiquidStaker string `protobuf:"bytes,1,opt,name=liquid_staker,json=liquidStaker,proto3" json:"liquid_staker,omitempty" yaml:"liquid_staker"`
+	// pair_id specifies the pair of bToken(base) and bond denom(quote) on the DEX
+	PairId uint64 `protobuf:"varint,2,opt,name=pair_id,json=pairId,proto3" json:"pair_id,omitempty" yaml:"pair_id"`
+	// amount specifies the amount of bToken to sell
+	Amount types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+	// max_slippage specifies the maximum rate of the order price below the last price of the pair
+	MaxSlippage github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=max_slippage,json=maxSlippage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_slippage" yaml:"max_slippage"`
+}
+
+func (m *MsgLiquidUnstakeInstant) Reset()         { *m = MsgLiquidUnstakeInstant{} }
+func (m *MsgLiquidUnstakeInstant) String() string { return proto.CompactTextString(m) }
+func (*MsgLiquidUnstakeInstant) ProtoMessage()    {}
+func (*MsgLiquidUnstakeInstant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9fe270968086aea1, []int{6}
+}
+func (m *MsgLiquidUnstakeInstant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgLiquidUnstakeInstant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgLiquidUnstakeInstant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgLiquidUnstakeInstant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgLiquidUnstakeInstant.Merge(m, src)
+}
+func (m *MsgLiquidUnstakeInstant) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgLiquidUnstakeInstant) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgLiquidUnstakeInstant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgLiquidUnstakeInstant proto.InternalMessageInfo
+
+// MsgLiquidUnstakeInstantResponse defines the Msg/LiquidUnstakeInstant response type.
+type MsgLiquidUnstakeInstantResponse struct {
+	// order_id specifies the id of the order made on the DEX
+	OrderId uint64 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+}
+
+func (m *MsgLiquidUnstakeInstantResponse) Reset()         { *m = MsgLiquidUnstakeInstantResponse{} }
+func (m *MsgLiquidUnstakeInstantResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgLiquidUnstakeInstantResponse) ProtoMessage()    {}
+func (*MsgLiquidUnstakeInstantResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9fe270968086aea1, []int{7}
+}
+func (m *MsgLiquidUnstakeInstantResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgLiquidUnstakeInstantResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgLiquidUnstakeInstantResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgLiquidUnstakeInstantResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgLiquidUnstakeInstantResponse.Merge(m, src)
+}
+func (m *MsgLiquidUnstakeInstantResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgLiquidUnstakeInstantResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgLiquidUnstakeInstantResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgLiquidUnstakeInstantResponse proto.InternalMessageInfo
+
+func (m *MsgLiquidUnstakeInstantResponse) GetOrderId() uint64 {
+	if m != nil {
+		return m.OrderId
+	}
+	return 0
+}
+
+// MsgInterchainLiquidStake defines a SDK message for depositing tokens of a host chain to be staked on the host chain.
+type MsgInterchainLiquidStake struct {
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty" yaml:"delegator_address"`
+	// chain_id specifies the chain id of the host chain
+	ChainId string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty" yaml:"chain_id"`
+	// amount specifies the amount of the IBC denom of the host chain's staking denom to deposit
+	Amount types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *MsgInterchainLiquidStake) Reset()         { *m = MsgInterchainLiquidStake{} }
+func (m *MsgInterchainLiquidStake) String() string { return proto.CompactTextString(m) }
+func (*MsgInterchainLiquidStake) ProtoMessage()    {}
+func (*MsgInterchainLiquidStake) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9fe270968086aea1, []int{8}
+}
+func (m *MsgInterchainLiquidStake) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgInterchainLiquidStake) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgInterchainLiquidStake.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgInterchainLiquidStake) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgInterchainLiquidStake.Merge(m, src)
+}
+func (m *MsgInterchainLiquidStake) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgInterchainLiquidStake) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgInterchainLiquidStake.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgInterchainLiquidStake proto.InternalMessageInfo
+
+// MsgInterchainLiquidStakeResponse defines the Msg/InterchainLiquidStake response type.
+type MsgInterchainLiquidStakeResponse struct {
+	// minted_amount specifies the amount of bToken minted
+	MintedAmount types.Coin `protobuf:"bytes,1,opt,name=minted_amount,json=mintedAmount,proto3" json:"minted_amount"`
+}
+
+func (m *MsgInterchainLiquidStakeResponse) Reset()         { *m = MsgInterchainLiquidStakeResponse{} }
+func (m *MsgInterchainLiquidStakeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgInterchainLiquidStakeResponse) ProtoMessage()    {}
+func (*MsgInterchainLiquidStakeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9fe270968086aea1, []int{9}
+}
+func (m *MsgInterchainLiquidStakeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgInterchainLiquidStakeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgInterchainLiquidStakeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgInterchainLiquidStakeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgInterchainLiquidStakeResponse.Merge(m, src)
+}
+func (m *MsgInterchainLiquidStakeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgInterchainLiquidStakeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgInterchainLiquidStakeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgInterchainLiquidStakeResponse proto.InternalMessageInfo
+
+func (m *MsgInterchainLiquidStakeResponse) GetMintedAmount() types.Coin {
+	if m != nil {
+		return m.MintedAmount
+	}
+	return types.Coin{}
+}
+
+// MsgUpdateHostZoneState defines a SDK message for updating the staking state of a host chain.
+// The values are the raw store values on the host chain, which are proven against the consensus state of the host
+// chain's light client at proof_height. An empty value must come with a proof of its absence.
+type MsgUpdateHostZoneState struct {
+	Updater string `protobuf:"bytes,1,opt,name=updater,proto3" json:"updater,omitempty"`
+	// chain_id specifies the chain id of the host chain
+	ChainId string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty" yaml:"chain_id"`
+	// proof_height specifies the revision height of the consensus state to verify the proofs against
+	ProofHeight uint64 `protobuf:"varint,3,opt,name=proof_height,json=proofHeight,proto3" json:"proof_height,omitempty" yaml:"proof_height"`
+	// delegation is the delegation of the interchain account to the validator
+	Delegation      []byte `protobuf:"bytes,4,opt,name=delegation,proto3" json:"delegation,omitempty"`
+	DelegationProof []byte `protobuf:"bytes,5,opt,name=delegation_proof,json=delegationProof,proto3" json:"delegation_proof,omitempty" yaml:"delegation_proof"`
+	// validator is the validator to which the interchain account delegates
+	Validator      []byte `protobuf:"bytes,6,opt,name=validator,proto3" json:"validator,omitempty"`
+	ValidatorProof []byte `protobuf:"bytes,7,opt,name=validator_proof,json=validatorProof,proto3" json:"validator_proof,omitempty" yaml:"validator_proof"`
+	// balance is the balance of the interchain account for the host denom
+	Balance      []byte `protobuf:"bytes,8,opt,name=balance,proto3" json:"balance,omitempty"`
+	BalanceProof []byte `protobuf:"bytes,9,opt,name=balance_proof,json=balanceProof,proto3" json:"balance_proof,omitempty" yaml:"balance_proof"`
+}
+
+func (m *MsgUpdateHostZoneState) Reset()         { *m = MsgUpdateHostZoneState{} }
+func (m *MsgUpdateHostZoneState) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateHostZoneState) ProtoMessage()    {}
+func (*MsgUpdateHostZoneState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9fe270968086aea1, []int{10}
+}
+func (m *MsgUpdateHostZoneState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateHostZoneState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateHostZoneState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateHostZoneState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateHostZoneState.Merge(m, src)
+}
+func (m *MsgUpdateHostZoneState) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateHostZoneState) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateHostZoneState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateHostZoneState proto.InternalMessageInfo
+
+// MsgUpdateHostZoneStateResponse defines the Msg/UpdateHostZoneState response type.
+type MsgUpdateHostZoneStateResponse struct {
+}
+
+func (m *MsgUpdateHostZoneStateResponse) Reset()         { *m = MsgUpdateHostZoneStateResponse{} }
+func (m *MsgUpdateHostZoneStateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateHostZoneStateResponse) ProtoMessage()    {}
+func (*MsgUpdateHostZoneStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9fe270968086aea1, []int{11}
+}
+func (m *MsgUpdateHostZoneStateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateHostZoneStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateHostZoneStateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateHostZoneStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateHostZoneStateResponse.Merge(m, src)
+}
+func (m *MsgUpdateHostZoneStateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateHostZoneStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateHostZoneStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateHostZoneStateResponse proto.InternalMessageInfo
+
+// MsgUpdateWhitelistedValidatorWeight defines a SDK message for adjusting the target weight of a whitelisted
+// validator by the authority.
+type MsgUpdateWhitelistedValidatorWeight struct {
+	// authority specifies the bech32-encoded address of the authority, which is the gov module account by default
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// validator_address specifies the bech32-encoded operator address of the whitelisted validator
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty" yaml:"validator_address"`
+	// target_weight specifies the new target weight of the validator
+	TargetWeight github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=target_weight,json=targetWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"target_weight" yaml:"target_weight"`
+}
+
+func (m *MsgUpdateWhitelistedValidatorWeight) Reset()         { *m = MsgUpdateWhitelistedValidatorWeight{} }
+func (m *MsgUpdateWhitelistedValidatorWeight) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateWhitelistedValidatorWeight) ProtoMessage()    {}
+func (*MsgUpdateWhitelistedValidatorWeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9fe270968086aea1, []int{12}
+}
+func (m *MsgUpdateWhitelistedValidatorWeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateWhitelistedValidatorWeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateWhitelistedValidatorWeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateWhitelistedValidatorWeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateWhitelistedValidatorWeight.Merge(m, src)
+}
+func (m *MsgUpdateWhitelistedValidatorWeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateWhitelistedValidatorWeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateWhitelistedValidatorWeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateWhitelistedValidatorWeight proto.InternalMessageInfo
+
+// MsgUpdateWhitelistedValidatorWeightResponse defines the Msg/UpdateWhitelistedValidatorWeight response type.
+type MsgUpdateWhitelistedValidatorWeightResponse struct {
+}
+
+func (m *MsgUpdateWhitelistedValidatorWeightResponse) Reset() {
+	*m = MsgUpdateWhitelistedValidatorWeightResponse{}
+}
+func (m *MsgUpdateWhitelistedValidatorWeightResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgUpdateWhitelistedValidatorWeightResponse) ProtoMessage() {}
+func (*MsgUpdateWhitelistedValidatorWeightResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9fe270968086aea1, []int{13}
+}
+func (m *MsgUpdateWhitelistedValidatorWeightResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateWhitelistedValidatorWeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateWhitelistedValidatorWeightResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateWhitelistedValidatorWeightResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateWhitelistedValidatorWeightResponse.Merge(m, src)
+}
+func (m *MsgUpdateWhitelistedValidatorWeightResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateWhitelistedValidatorWeightResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateWhitelistedValidatorWeightResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateWhitelistedValidatorWeightResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgLiquidStake)(nil), "crescent.liquidstaking.v1beta1.MsgLiquidStake")
 	proto.RegisterType((*MsgLiquidStakeResponse)(nil), "crescent.liquidstaking.v1beta1.MsgLiquidStakeResponse")
 	proto.RegisterType((*MsgLiquidUnstake)(nil), "crescent.liquidstaking.v1beta1.MsgLiquidUnstake")
 	proto.RegisterType((*MsgLiquidUnstakeResponse)(nil), "crescent.liquidstaking.v1beta1.MsgLiquidUnstakeResponse")
+	proto.RegisterType((*MsgArbLiquidStake)(nil), "crescent.liquidstaking.v1beta1.MsgArbLiquidStake")
+	proto.RegisterType((*MsgArbLiquidStakeResponse)(nil), "crescent.liquidstaking.v1beta1.MsgArbLiquidStakeResponse")
+	proto.RegisterType((*MsgLiquidUnstakeInstant)(nil), "crescent.liquidstaking.v1beta1.MsgLiquidUnstakeInstant")
+	proto.RegisterType((*MsgLiquidUnstakeInstantResponse)(nil), "crescent.liquidstaking.v1beta1.MsgLiquidUnstakeInstantResponse")
+	proto.RegisterType((*MsgInterchainLiquidStake)(nil), "crescent.liquidstaking.v1beta1.MsgInterchainLiquidStake")
+	proto.RegisterType((*MsgInterchainLiquidStakeResponse)(nil), "crescent.liquidstaking.v1beta1.MsgInterchainLiquidStakeResponse")
+	proto.RegisterType((*MsgUpdateHostZoneState)(nil), "crescent.liquidstaking.v1beta1.MsgUpdateHostZoneState")
+	proto.RegisterType((*MsgUpdateHostZoneStateResponse)(nil), "crescent.liquidstaking.v1beta1.MsgUpdateHostZoneStateResponse")
+	proto.RegisterType((*MsgUpdateWhitelistedValidatorWeight)(nil), "crescent.liquidstaking.v1beta1.MsgUpdateWhitelistedValidatorWeight")
+	proto.RegisterType((*MsgUpdateWhitelistedValidatorWeightResponse)(nil), "crescent.liquidstaking.v1beta1.MsgUpdateWhitelistedValidatorWeightResponse")
 }
 
 func init() {
@@ -208,36 +664,79 @@ func init() {
 }
 
 var fileDescriptor_9fe270968086aea1 = []byte{
-	// 450 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x93, 0x3f, 0x6f, 0xd4, 0x30,
-	0x18, 0xc6, 0x63, 0x40, 0x55, 0xf1, 0x89, 0x52, 0x22, 0x84, 0xd2, 0x08, 0x39, 0x55, 0x16, 0xba,
-	0x60, 0xd3, 0x03, 0x01, 0xaa, 0xc4, 0xc0, 0x31, 0x21, 0x71, 0xcb, 0x01, 0x42, 0x62, 0x39, 0x39,
-	0x39, 0x63, 0xac, 0x4b, 0xfc, 0x86, 0xd8, 0x29, 0xad, 0xf8, 0x02, 0x8c, 0xfd, 0x04, 0xe8, 0x3e,
-	0x4e, 0xc7, 0x8e, 0x4c, 0x05, 0xdd, 0x2d, 0xcc, 0x6c, 0x6c, 0x28, 0x7f, 0x21, 0x45, 0x42, 0x74,
-	0x63, 0xcb, 0xfb, 0x3e, 0xcf, 0xeb, 0xf7, 0xe7, 0x3c, 0x09, 0xbe, 0x15, 0xe7, 0xc2, 0xc4, 0x42,
-	0x5b, 0x96, 0xa8, 0x77, 0x85, 0x9a, 0x19, 0xcb, 0xe7, 0x4a, 0x4b, 0xb6, 0xbf, 0x1b, 0x09, 0xcb,
-	0x77, 0x99, 0x3d, 0xa0, 0x59, 0x0e, 0x16, 0x5c, 0xd2, 0x1a, 0x69, 0xcf, 0x48, 0x1b, 0xa3, 0x7f,
-	0x5d, 0x82, 0x84, 0xca, 0xca, 0xca, 0xa7, 0x7a, 0xca, 0xdf, 0x8a, 0xc1, 0xa4, 0x60, 0xa6, 0xb5,
-	0x50, 0x17, 0x8d, 0x44, 0xea, 0x8a, 0x45, 0xdc, 0x88, 0x6e, 0x5d, 0x0c, 0x4a, 0x37, 0x7a, 0x20,
-	0x01, 0x64, 0x22, 0x58, 0x55, 0x45, 0xc5, 0x1b, 0x66, 0x55, 0x2a, 0x8c, 0xe5, 0x69, 0x56, 0x1b,
-	0xc2, 0x4f, 0x08, 0x6f, 0x8c, 0x8d, 0x7c, 0x56, 0xe1, 0x3c, 0xb7, 0x7c, 0x2e, 0xdc, 0xa7, 0xf8,
-	0xda, 0x4c, 0x24, 0x42, 0x72, 0x0b, 0xf9, 0x94, 0xcf, 0x66, 0xb9, 0x30, 0xc6, 0x43, 0xdb, 0x68,
-	0xe7, 0xf2, 0xe8, 0xe6, 0xf7, 0xd3, 0xc0, 0x3b, 0xe4, 0x69, 0xb2, 0x17, 0xfe, 0x61, 0x09, 0x27,
-	0x9b, 0x5d, 0xef, 0x71, 0xdd, 0x72, 0x1f, 0xe0, 0x35, 0x9e, 0x42, 0xa1, 0xad, 0x77, 0x61, 0x1b,
-	0xed, 0x0c, 0x86, 0x5b, 0xb4, 0xa1, 0x2f, 0x79, 0xdb, 0x5b, 0xd3, 0x27, 0xa0, 0xf4, 0xe8, 0xd2,
-	0xf1, 0x69, 0xe0, 0x4c, 0x1a, 0xfb, 0xde, 0xfa, 0xc7, 0x45, 0xe0, 0x7c, 0x5b, 0x04, 0x4e, 0xe8,
-	0xe1, 0x1b, 0x7d, 0xbe, 0x89, 0x30, 0x19, 0x68, 0x23, 0xc2, 0x05, 0xc2, 0x9b, 0x9d, 0xf4, 0x52,
-	0x9b, 0xff, 0x10, 0x5e, 0x61, 0xef, 0x2c, 0x61, 0x8b, 0xef, 0x8e, 0xf1, 0xd5, 0x18, 0xd2, 0x2c,
-	0x11, 0x56, 0x81, 0x9e, 0x96, 0xb9, 0x54, 0x9c, 0x83, 0xa1, 0x4f, 0xeb, 0xd0, 0x68, 0x1b, 0x1a,
-	0x7d, 0xd1, 0x86, 0x36, 0x5a, 0x2f, 0x17, 0x1d, 0x7d, 0x09, 0xd0, 0x64, 0xe3, 0xd7, 0x70, 0x29,
-	0x0f, 0x7f, 0x20, 0x7c, 0x71, 0x6c, 0xa4, 0x5b, 0xe0, 0xc1, 0xef, 0x61, 0x52, 0xfa, 0xf7, 0x4f,
-	0x8e, 0xf6, 0x5f, 0xae, 0x7f, 0xff, 0x7c, 0xfe, 0xee, 0x36, 0x1f, 0xf0, 0x95, 0x7e, 0x10, 0x77,
-	0xfe, 0xf9, 0xa0, 0x66, 0xc2, 0x7f, 0x78, 0xde, 0x89, 0x76, 0xf9, 0xe8, 0xd5, 0xf1, 0x92, 0xa0,
-	0x93, 0x25, 0x41, 0x5f, 0x97, 0x04, 0x1d, 0xad, 0x88, 0x73, 0xb2, 0x22, 0xce, 0xe7, 0x15, 0x71,
-	0x5e, 0x3f, 0x92, 0xca, 0xbe, 0x2d, 0x22, 0x1a, 0x43, 0xca, 0xda, 0xd3, 0x6f, 0x6b, 0x61, 0xdf,
-	0x43, 0x3e, 0xef, 0x1a, 0x6c, 0xff, 0x1e, 0x3b, 0x38, 0xf3, 0xeb, 0xda, 0xc3, 0x4c, 0x98, 0x68,
-	0xad, 0x8a, 0xe0, 0xee, 0xcf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xcc, 0x32, 0x0a, 0xe3, 0xe1, 0x03,
-	0x00, 0x00,
+	// 1150 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0x4f, 0x6f, 0xe3, 0x44,
+	0x14, 0x8f, 0xdb, 0xd0, 0x24, 0x2f, 0xe9, 0x9f, 0x75, 0xcb, 0xd6, 0x0d, 0x25, 0x8e, 0xbc, 0x12,
+	0x54, 0x5a, 0xd5, 0xa1, 0x05, 0xed, 0x42, 0xc5, 0x02, 0x4d, 0x97, 0xd5, 0x46, 0x6c, 0xa5, 0x95,
+	0xcb, 0x52, 0x69, 0x2f, 0xd1, 0x24, 0x9e, 0x3a, 0x56, 0x63, 0x8f, 0xb1, 0x27, 0xdd, 0x56, 0x48,
+	0xdc, 0x90, 0xb8, 0x20, 0x55, 0xe2, 0x0b, 0xf4, 0x33, 0x20, 0xbe, 0x01, 0x97, 0x3d, 0x70, 0xd8,
+	0xe3, 0x8a, 0x83, 0x41, 0xed, 0x05, 0x38, 0xe6, 0x13, 0x20, 0x8f, 0xc7, 0x7f, 0x92, 0xa6, 0x6c,
+	0x52, 0x40, 0xe2, 0x94, 0x79, 0xff, 0xdf, 0xfb, 0xbd, 0x79, 0xcf, 0x13, 0x78, 0xbb, 0xed, 0x62,
+	0xaf, 0x8d, 0x6d, 0x5a, 0xeb, 0x9a, 0x5f, 0xf6, 0x4c, 0xdd, 0xa3, 0xe8, 0xd0, 0xb4, 0x8d, 0xda,
+	0xd1, 0x46, 0x0b, 0x53, 0xb4, 0x51, 0xa3, 0xc7, 0xaa, 0xe3, 0x12, 0x4a, 0xc4, 0x4a, 0xa4, 0xa8,
+	0x0e, 0x28, 0xaa, 0x5c, 0xb1, 0xbc, 0x64, 0x10, 0x83, 0x30, 0xd5, 0x5a, 0x70, 0x0a, 0xad, 0xca,
+	0x2b, 0x6d, 0xe2, 0x59, 0xc4, 0x6b, 0x86, 0x82, 0x90, 0xe0, 0xa2, 0x4a, 0x48, 0xd5, 0x5a, 0xc8,
+	0xc3, 0x71, 0xb8, 0x36, 0x31, 0x6d, 0x2e, 0x97, 0x0d, 0x42, 0x8c, 0x2e, 0xae, 0x31, 0xaa, 0xd5,
+	0x3b, 0xa8, 0x51, 0xd3, 0xc2, 0x1e, 0x45, 0x96, 0x13, 0x2a, 0x28, 0x3f, 0x09, 0x30, 0xb7, 0xeb,
+	0x19, 0x8f, 0x58, 0x3a, 0x7b, 0x14, 0x1d, 0x62, 0xb1, 0x01, 0x37, 0x74, 0xdc, 0xc5, 0x06, 0xa2,
+	0xc4, 0x6d, 0x22, 0x5d, 0x77, 0xb1, 0xe7, 0x49, 0x42, 0x55, 0x58, 0x2b, 0xd4, 0x57, 0xfb, 0xbe,
+	0x2c, 0x9d, 0x20, 0xab, 0xbb, 0xa5, 0x5c, 0x52, 0x51, 0xb4, 0x85, 0x98, 0xb7, 0x1d, 0xb2, 0xc4,
+	0xbb, 0x30, 0x83, 0x2c, 0xd2, 0xb3, 0xa9, 0x34, 0x55, 0x15, 0xd6, 0x8a, 0x9b, 0x2b, 0x2a, 0xcf,
+	0x3e, 0xc8, 0x37, 0xaa, 0x5a, 0xdd, 0x21, 0xa6, 0x5d, 0xcf, 0x3e, 0xf7, 0xe5, 0x8c, 0xc6, 0xd5,
+	0xc5, 0x5b, 0x90, 0x3d, 0x40, 0xae, 0x25, 0x4d, 0x57, 0x85, 0xb5, 0x7c, 0x7d, 0xbe, 0xef, 0xcb,
+	0xc5, 0x30, 0x6c, 0xc0, 0x55, 0x34, 0x26, 0xdc, 0xca, 0x7f, 0x7b, 0x26, 0x67, 0x7e, 0x3f, 0x93,
+	0x33, 0x8a, 0x04, 0x37, 0x07, 0x8b, 0xd0, 0xb0, 0xe7, 0x10, 0xdb, 0xc3, 0xca, 0x99, 0x00, 0x0b,
+	0xb1, 0xe8, 0x89, 0xed, 0xfd, 0x5f, 0x2a, 0x4c, 0x25, 0x6f, 0x82, 0x34, 0x9c, 0x61, 0x94, 0xbe,
+	0xb8, 0x0b, 0xf3, 0x6d, 0x62, 0x39, 0x5d, 0x4c, 0x4d, 0x62, 0x37, 0x83, 0xe6, 0xb1, 0x3c, 0x8b,
+	0x9b, 0x65, 0x35, 0xec, 0xac, 0x1a, 0x75, 0x56, 0xfd, 0x3c, 0xea, 0x6c, 0x3d, 0x1f, 0x04, 0x3a,
+	0xfd, 0x55, 0x16, 0xb4, 0xb9, 0xc4, 0x38, 0x10, 0x2b, 0x7f, 0x0a, 0x70, 0x63, 0xd7, 0x33, 0xb6,
+	0xdd, 0x56, 0xba, 0xe1, 0x8f, 0x40, 0x44, 0x6e, 0xcb, 0xa4, 0x2e, 0x32, 0xf0, 0x30, 0x1e, 0x6f,
+	0xf6, 0x7d, 0x79, 0x25, 0xc4, 0xe3, 0xb2, 0x8e, 0xa2, 0xdd, 0x48, 0x98, 0x11, 0x22, 0xb7, 0x21,
+	0xe7, 0x20, 0xd3, 0x6d, 0x9a, 0x3a, 0x83, 0x24, 0x5b, 0x17, 0xfb, 0xbe, 0x3c, 0x17, 0xba, 0xe0,
+	0x02, 0x45, 0x9b, 0x09, 0x4e, 0x0d, 0x5d, 0x7c, 0x0c, 0x05, 0x0b, 0x1d, 0x37, 0x3d, 0x07, 0xdb,
+	0xba, 0x34, 0xfd, 0x2a, 0x04, 0xa5, 0xa0, 0xb0, 0xbe, 0x2f, 0x2f, 0x84, 0xde, 0x62, 0x4b, 0x45,
+	0xcb, 0x5b, 0xe8, 0x78, 0x2f, 0x38, 0xa6, 0x70, 0xbd, 0x03, 0x2b, 0x97, 0x6a, 0x8d, 0x81, 0x5d,
+	0x81, 0x3c, 0x71, 0x75, 0xcc, 0xd2, 0x0c, 0x2a, 0xcd, 0x6a, 0x39, 0x46, 0x37, 0x74, 0xe5, 0x87,
+	0x29, 0x58, 0x1e, 0x6e, 0x48, 0x23, 0xf8, 0xb1, 0xa9, 0x78, 0x0f, 0x66, 0xc3, 0xc9, 0x6d, 0x32,
+	0xb6, 0xcb, 0x51, 0x92, 0xfa, 0xbe, 0xbc, 0x14, 0x26, 0x35, 0x20, 0x56, 0xb4, 0x52, 0x37, 0x09,
+	0xee, 0x4e, 0x86, 0x4d, 0x72, 0xb5, 0xa6, 0x27, 0x1b, 0x9e, 0x0e, 0x94, 0x18, 0x34, 0x5d, 0xd3,
+	0x71, 0x90, 0x81, 0xa5, 0x2c, 0xcb, 0xf1, 0xd3, 0x40, 0xe7, 0x17, 0x5f, 0x7e, 0xcb, 0x30, 0x69,
+	0xa7, 0xd7, 0x52, 0xdb, 0xc4, 0xe2, 0xbb, 0x84, 0xff, 0xac, 0x7b, 0xfa, 0x61, 0x8d, 0x9e, 0x38,
+	0xd8, 0x53, 0xef, 0xe3, 0x76, 0xdf, 0x97, 0x17, 0x53, 0x30, 0x73, 0x5f, 0x8a, 0x56, 0x0c, 0x90,
+	0xe6, 0x54, 0x0a, 0xec, 0x0f, 0x41, 0xbe, 0x02, 0xb3, 0x71, 0x20, 0x7f, 0x29, 0xb0, 0x19, 0x68,
+	0xd8, 0x14, 0xbb, 0xed, 0x0e, 0x32, 0xed, 0xff, 0x68, 0x1f, 0xa9, 0x90, 0x67, 0xee, 0xa3, 0x06,
+	0x14, 0xea, 0x8b, 0x7d, 0x5f, 0x9e, 0x0f, 0x3d, 0x44, 0x12, 0x45, 0xcb, 0xb1, 0xe3, 0x3f, 0x68,
+	0x41, 0x0a, 0x98, 0x0e, 0x54, 0xaf, 0xaa, 0x2c, 0x46, 0xe6, 0x3e, 0xcc, 0x5a, 0xa6, 0x4d, 0xb1,
+	0xde, 0xe4, 0xd1, 0x84, 0xf1, 0xa2, 0x95, 0x42, 0xab, 0x6d, 0x66, 0xa4, 0xfc, 0x3c, 0xcd, 0xb6,
+	0xe0, 0x13, 0x47, 0x47, 0x14, 0x3f, 0x24, 0x1e, 0x7d, 0x4a, 0x6c, 0xbc, 0x47, 0x11, 0xc5, 0xa2,
+	0x04, 0xb9, 0x1e, 0x63, 0xf3, 0x0b, 0xab, 0x45, 0xe4, 0xc4, 0x88, 0x6c, 0x41, 0xc9, 0x71, 0x09,
+	0x39, 0x68, 0x76, 0xb0, 0x69, 0x74, 0x42, 0x5c, 0xb2, 0xf5, 0xe5, 0xe4, 0xb6, 0xa4, 0xa5, 0x8a,
+	0x56, 0x64, 0xe4, 0x43, 0x46, 0x89, 0x15, 0x00, 0xde, 0x11, 0x93, 0xd8, 0xec, 0x56, 0x96, 0xb4,
+	0x14, 0x47, 0x7c, 0x00, 0x0b, 0x09, 0xd5, 0x64, 0x96, 0xd2, 0x6b, 0x81, 0x56, 0xfd, 0x8d, 0xbe,
+	0x2f, 0x2f, 0x0f, 0xf4, 0x39, 0xd6, 0x50, 0xb4, 0xf9, 0x84, 0xf5, 0x38, 0xe0, 0x88, 0xab, 0x50,
+	0x38, 0x42, 0x5d, 0x53, 0x0f, 0x3a, 0x2f, 0xcd, 0xb0, 0x30, 0x09, 0x43, 0xdc, 0x81, 0xf9, 0x98,
+	0xe0, 0x41, 0x72, 0x2c, 0x48, 0xb9, 0xef, 0xcb, 0x37, 0xc3, 0x20, 0x43, 0x0a, 0x8a, 0x36, 0x17,
+	0x73, 0xc2, 0x10, 0x12, 0xe4, 0x5a, 0xa8, 0x8b, 0xec, 0x36, 0x96, 0xf2, 0x2c, 0x40, 0x44, 0x06,
+	0x1b, 0x82, 0x1f, 0xb9, 0xf3, 0x02, 0x73, 0x9e, 0xda, 0x10, 0x03, 0x62, 0x45, 0x2b, 0x71, 0x9a,
+	0x39, 0x4e, 0x5d, 0x9c, 0x2a, 0x54, 0x46, 0x77, 0x33, 0xfe, 0xb6, 0x7d, 0x33, 0x05, 0xb7, 0x62,
+	0x95, 0xfd, 0x8e, 0x49, 0x71, 0xd7, 0xf4, 0x28, 0xd6, 0xbf, 0x88, 0x72, 0xdd, 0x0f, 0x71, 0x5f,
+	0x85, 0x02, 0xea, 0xd1, 0x0e, 0x71, 0x4d, 0x7a, 0xc2, 0xfb, 0x9f, 0x30, 0x82, 0xf1, 0x4a, 0xca,
+	0x8d, 0xc6, 0x6b, 0x6a, 0x78, 0xbc, 0x2e, 0xa9, 0x28, 0xda, 0x42, 0xcc, 0x8b, 0xc6, 0xeb, 0x10,
+	0x66, 0x29, 0x72, 0x0d, 0x4c, 0x9b, 0xcf, 0x92, 0xdb, 0x51, 0xa8, 0x3f, 0x98, 0x60, 0xf3, 0x34,
+	0x6c, 0x9a, 0x20, 0x35, 0xe0, 0x4c, 0xd1, 0x4a, 0x21, 0x1d, 0x56, 0x95, 0x42, 0x6a, 0x1d, 0x6e,
+	0x8f, 0x01, 0x43, 0x04, 0xdb, 0xe6, 0x1f, 0x39, 0x98, 0xde, 0xf5, 0x0c, 0xb1, 0x07, 0xc5, 0xf4,
+	0x9a, 0x51, 0xd5, 0xbf, 0x7f, 0x9c, 0xa9, 0x83, 0x2f, 0x8c, 0xf2, 0x9d, 0xc9, 0xf4, 0xe3, 0x61,
+	0xff, 0x0a, 0x66, 0x07, 0x5f, 0x23, 0xef, 0x8c, 0xed, 0x88, 0x5b, 0x94, 0xdf, 0x9f, 0xd4, 0x22,
+	0x0e, 0xfe, 0x35, 0xcc, 0x0d, 0x7d, 0xfc, 0x37, 0xc6, 0xf0, 0x35, 0x68, 0x52, 0xfe, 0x60, 0x62,
+	0x93, 0x38, 0xfe, 0xa9, 0x00, 0x4b, 0x23, 0x3f, 0xac, 0x77, 0x27, 0x2d, 0x89, 0x1b, 0x96, 0x3f,
+	0xbe, 0xa6, 0x61, 0x9c, 0xd2, 0xf7, 0x02, 0xbc, 0x3e, 0xfa, 0xc3, 0x33, 0x0e, 0xcc, 0x23, 0x2d,
+	0xcb, 0x9f, 0x5c, 0xd7, 0x32, 0xce, 0xea, 0x3b, 0x01, 0x16, 0x47, 0x6d, 0xf2, 0x71, 0x6e, 0xdd,
+	0x08, 0xbb, 0xf2, 0x47, 0xd7, 0xb3, 0x8b, 0xf3, 0xf9, 0x51, 0x80, 0xea, 0x2b, 0x17, 0xcd, 0xce,
+	0xd8, 0x41, 0xae, 0x76, 0x52, 0xfe, 0xec, 0x5f, 0x70, 0x12, 0xa5, 0x5d, 0xdf, 0x7f, 0x7e, 0x5e,
+	0x11, 0x5e, 0x9c, 0x57, 0x84, 0xdf, 0xce, 0x2b, 0xc2, 0xe9, 0x45, 0x25, 0xf3, 0xe2, 0xa2, 0x92,
+	0x79, 0x79, 0x51, 0xc9, 0x3c, 0xbd, 0x97, 0x5e, 0x46, 0x3c, 0xe0, 0xba, 0x8d, 0xe9, 0x33, 0xe2,
+	0x1e, 0xc6, 0x8c, 0xda, 0xd1, 0x7b, 0xb5, 0xe3, 0xa1, 0x3f, 0x75, 0x6c, 0x4f, 0xb5, 0x66, 0xd8,
+	0xbb, 0xfb, 0xdd, 0xbf, 0x06, 0x00, 0xe3, 0x5d, 0x02, 0xef, 0xfb, 0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -258,6 +757,22 @@ type MsgClient interface {
 	// LiquidUnstake defines a method for performing an undelegation of liquid staking from a
 	// delegate.
 	LiquidUnstake(ctx context.Context, in *MsgLiquidUnstake, opts ...grpc.CallOption) (*MsgLiquidUnstakeResponse, error)
+	// ArbLiquidStake defines a method for stabilizing the bToken price on the DEX
+	// by buying bToken below the redemption rate or minting and selling bToken above
+	// the mint rate.
+	ArbLiquidStake(ctx context.Context, in *MsgArbLiquidStake, opts ...grpc.CallOption) (*MsgArbLiquidStakeResponse, error)
+	// LiquidUnstakeInstant defines a method for selling bToken through a pair on the DEX
+	// instead of waiting for the unbonding period.
+	LiquidUnstakeInstant(ctx context.Context, in *MsgLiquidUnstakeInstant, opts ...grpc.CallOption) (*MsgLiquidUnstakeInstantResponse, error)
+	// InterchainLiquidStake defines a method for depositing tokens of a host chain to be staked on the host chain
+	// through an interchain account, minting the bToken of the host chain.
+	InterchainLiquidStake(ctx context.Context, in *MsgInterchainLiquidStake, opts ...grpc.CallOption) (*MsgInterchainLiquidStakeResponse, error)
+	// UpdateHostZoneState defines a method for updating the staking state of a host chain with proofs of the host chain
+	// state.
+	UpdateHostZoneState(ctx context.Context, in *MsgUpdateHostZoneState, opts ...grpc.CallOption) (*MsgUpdateHostZoneStateResponse, error)
+	// UpdateWhitelistedValidatorWeight defines a method for adjusting the target weight of a whitelisted validator
+	// by the authority without replacing the whole whitelist.
+	UpdateWhitelistedValidatorWeight(ctx context.Context, in *MsgUpdateWhitelistedValidatorWeight, opts ...grpc.CallOption) (*MsgUpdateWhitelistedValidatorWeightResponse, error)
 }
 
 type msgClient struct {
@@ -286,6 +801,51 @@ func (c *msgClient) LiquidUnstake(ctx context.Context, in *MsgLiquidUnstake, opt
 	return out, nil
 }
 
+func (c *msgClient) ArbLiquidStake(ctx context.Context, in *MsgArbLiquidStake, opts ...grpc.CallOption) (*MsgArbLiquidStakeResponse, error) {
+	out := new(MsgArbLiquidStakeResponse)
+	err := c.cc.Invoke(ctx, "/crescent.liquidstaking.v1beta1.Msg/ArbLiquidStake", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) LiquidUnstakeInstant(ctx context.Context, in *MsgLiquidUnstakeInstant, opts ...grpc.CallOption) (*MsgLiquidUnstakeInstantResponse, error) {
+	out := new(MsgLiquidUnstakeInstantResponse)
+	err := c.cc.Invoke(ctx, "/crescent.liquidstaking.v1beta1.Msg/LiquidUnstakeInstant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) InterchainLiquidStake(ctx context.Context, in *MsgInterchainLiquidStake, opts ...grpc.CallOption) (*MsgInterchainLiquidStakeResponse, error) {
+	out := new(MsgInterchainLiquidStakeResponse)
+	err := c.cc.Invoke(ctx, "/crescent.liquidstaking.v1beta1.Msg/InterchainLiquidStake", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateHostZoneState(ctx context.Context, in *MsgUpdateHostZoneState, opts ...grpc.CallOption) (*MsgUpdateHostZoneStateResponse, error) {
+	out := new(MsgUpdateHostZoneStateResponse)
+	err := c.cc.Invoke(ctx, "/crescent.liquidstaking.v1beta1.Msg/UpdateHostZoneState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateWhitelistedValidatorWeight(ctx context.Context, in *MsgUpdateWhitelistedValidatorWeight, opts ...grpc.CallOption) (*MsgUpdateWhitelistedValidatorWeightResponse, error) {
+	out := new(MsgUpdateWhitelistedValidatorWeightResponse)
+	err := c.cc.Invoke(ctx, "/crescent.liquidstaking.v1beta1.Msg/UpdateWhitelistedValidatorWeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// LiquidStake defines a method for performing a delegation of coins
@@ -294,6 +854,22 @@ type MsgServer interface {
 	// LiquidUnstake defines a method for performing an undelegation of liquid staking from a
 	// delegate.
 	LiquidUnstake(context.Context, *MsgLiquidUnstake) (*MsgLiquidUnstakeResponse, error)
+	// ArbLiquidStake defines a method for stabilizing the bToken price on the DEX
+	// by buying bToken below the redemption rate or minting and selling bToken above
+	// the mint rate.
+	ArbLiquidStake(context.Context, *MsgArbLiquidStake) (*MsgArbLiquidStakeResponse, error)
+	// LiquidUnstakeInstant defines a method for selling bToken through a pair on the DEX
+	// instead of waiting for the unbonding period.
+	LiquidUnstakeInstant(context.Context, *MsgLiquidUnstakeInstant) (*MsgLiquidUnstakeInstantResponse, error)
+	// InterchainLiquidStake defines a method for depositing tokens of a host chain to be staked on the host chain
+	// through an interchain account, minting the bToken of the host chain.
+	InterchainLiquidStake(context.Context, *MsgInterchainLiquidStake) (*MsgInterchainLiquidStakeResponse, error)
+	// UpdateHostZoneState defines a method for updating the staking state of a host chain with proofs of the host chain
+	// state.
+	UpdateHostZoneState(context.Context, *MsgUpdateHostZoneState) (*MsgUpdateHostZoneStateResponse, error)
+	// UpdateWhitelistedValidatorWeight defines a method for adjusting the target weight of a whitelisted validator
+	// by the authority without replacing the whole whitelist.
+	UpdateWhitelistedValidatorWeight(context.Context, *MsgUpdateWhitelistedValidatorWeight) (*MsgUpdateWhitelistedValidatorWeightResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -306,8 +882,23 @@ func (*UnimplementedMsgServer) LiquidStake(ctx context.Context, req *MsgLiquidSt
 func (*UnimplementedMsgServer) LiquidUnstake(ctx context.Context, req *MsgLiquidUnstake) (*MsgLiquidUnstakeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LiquidUnstake not implemented")
 }
-
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+func (*UnimplementedMsgServer) ArbLiquidStake(ctx context.Context, req *MsgArbLiquidStake) (*MsgArbLiquidStakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArbLiquidStake not implemented")
+}
+func (*UnimplementedMsgServer) LiquidUnstakeInstant(ctx context.Context, req *MsgLiquidUnstakeInstant) (*MsgLiquidUnstakeInstantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiquidUnstakeInstant not implemented")
+}
+func (*UnimplementedMsgServer) InterchainLiquidStake(ctx context.Context, req *MsgInterchainLiquidStake) (*MsgInterchainLiquidStakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InterchainLiquidStake not implemented")
+}
+func (*UnimplementedMsgServer) UpdateHostZoneState(ctx context.Context, req *MsgUpdateHostZoneState) (*MsgUpdateHostZoneStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateHostZoneState not implemented")
+}
+func (*UnimplementedMsgServer) UpdateWhitelistedValidatorWeight(ctx context.Context, req *MsgUpdateWhitelistedValidatorWeight) (*MsgUpdateWhitelistedValidatorWeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWhitelistedValidatorWeight not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
 }
 
@@ -347,6 +938,96 @@ func _Msg_LiquidUnstake_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ArbLiquidStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgArbLiquidStake)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ArbLiquidStake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crescent.liquidstaking.v1beta1.Msg/ArbLiquidStake",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ArbLiquidStake(ctx, req.(*MsgArbLiquidStake))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_LiquidUnstakeInstant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLiquidUnstakeInstant)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).LiquidUnstakeInstant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crescent.liquidstaking.v1beta1.Msg/LiquidUnstakeInstant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).LiquidUnstakeInstant(ctx, req.(*MsgLiquidUnstakeInstant))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_InterchainLiquidStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgInterchainLiquidStake)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).InterchainLiquidStake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crescent.liquidstaking.v1beta1.Msg/InterchainLiquidStake",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).InterchainLiquidStake(ctx, req.(*MsgInterchainLiquidStake))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateHostZoneState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateHostZoneState)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateHostZoneState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crescent.liquidstaking.v1beta1.Msg/UpdateHostZoneState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateHostZoneState(ctx, req.(*MsgUpdateHostZoneState))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateWhitelistedValidatorWeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateWhitelistedValidatorWeight)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateWhitelistedValidatorWeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crescent.liquidstaking.v1beta1.Msg/UpdateWhitelistedValidatorWeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateWhitelistedValidatorWeight(ctx, req.(*MsgUpdateWhitelistedValidatorWeight))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "crescent.liquidstaking.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -359,6 +1040,26 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "LiquidUnstake",
 			Handler:    _Msg_LiquidUnstake_Handler,
 		},
+		{
+			MethodName: "ArbLiquidStake",
+			Handler:    _Msg_ArbLiquidStake_Handler,
+		},
+		{
+			MethodName: "LiquidUnstakeInstant",
+			Handler:    _Msg_LiquidUnstakeInstant_Handler,
+		},
+		{
+			MethodName: "InterchainLiquidStake",
+			Handler:    _Msg_InterchainLiquidStake_Handler,
+		},
+		{
+			MethodName: "UpdateHostZoneState",
+			Handler:    _Msg_UpdateHostZoneState_Handler,
+		},
+		{
+			MethodName: "UpdateWhitelistedValidatorWeight",
+			Handler:    _Msg_UpdateWhitelistedValidatorWeight_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "crescent/liquidstaking/v1beta1/tx.proto",
@@ -384,6 +1085,16 @@ func (m *MsgLiquidStake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Farm {
+		i--
+		if m.Farm {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	{
 		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -498,105 +1209,1779 @@ func (m *MsgLiquidUnstakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgArbLiquidStake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgLiquidStake) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgArbLiquidStake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgArbLiquidStake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.MaxSpend.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	i--
+	dAtA[i] = 0x1a
+	if m.PairId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PairId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ArbitragerAddress) > 0 {
+		i -= len(m.ArbitragerAddress)
+		copy(dAtA[i:], m.ArbitragerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ArbitragerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgLiquidStakeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgArbLiquidStakeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgArbLiquidStakeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgArbLiquidStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if m.OrderId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgLiquidUnstake) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgLiquidUnstakeInstant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLiquidUnstakeInstant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLiquidUnstakeInstant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size := m.MaxSlippage.Size()
+		i -= size
+		if _, err := m.MaxSlippage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.PairId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PairId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.LiquidStaker) > 0 {
+		i -= len(m.LiquidStaker)
+		copy(dAtA[i:], m.LiquidStaker)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.LiquidStaker)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgLiquidUnstakeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgLiquidUnstakeInstantResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLiquidUnstakeInstantResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLiquidUnstakeInstantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime)
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	if m.OrderId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MsgInterchainLiquidStake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *MsgInterchainLiquidStake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *MsgLiquidStake) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
+
+func (m *MsgInterchainLiquidStake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgInterchainLiquidStakeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgInterchainLiquidStakeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInterchainLiquidStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.MintedAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateHostZoneState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateHostZoneState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateHostZoneState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BalanceProof) > 0 {
+		i -= len(m.BalanceProof)
+		copy(dAtA[i:], m.BalanceProof)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BalanceProof)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Balance) > 0 {
+		i -= len(m.Balance)
+		copy(dAtA[i:], m.Balance)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Balance)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.ValidatorProof) > 0 {
+		i -= len(m.ValidatorProof)
+		copy(dAtA[i:], m.ValidatorProof)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorProof)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.DelegationProof) > 0 {
+		i -= len(m.DelegationProof)
+		copy(dAtA[i:], m.DelegationProof)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegationProof)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Delegation) > 0 {
+		i -= len(m.Delegation)
+		copy(dAtA[i:], m.Delegation)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Delegation)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ProofHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProofHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Updater) > 0 {
+		i -= len(m.Updater)
+		copy(dAtA[i:], m.Updater)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Updater)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateHostZoneStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateHostZoneStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateHostZoneStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateWhitelistedValidatorWeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateWhitelistedValidatorWeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateWhitelistedValidatorWeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TargetWeight.Size()
+		i -= size
+		if _, err := m.TargetWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateWhitelistedValidatorWeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateWhitelistedValidatorWeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateWhitelistedValidatorWeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgLiquidStake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Farm {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgLiquidStakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgLiquidUnstake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgLiquidUnstakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgArbLiquidStake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ArbitragerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PairId != 0 {
+		n += 1 + sovTx(uint64(m.PairId))
+	}
+	l = m.MaxSpend.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgArbLiquidStakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		n += 1 + sovTx(uint64(m.OrderId))
+	}
+	return n
+}
+
+func (m *MsgLiquidUnstakeInstant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LiquidStaker)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PairId != 0 {
+		n += 1 + sovTx(uint64(m.PairId))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MaxSlippage.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgLiquidUnstakeInstantResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		n += 1 + sovTx(uint64(m.OrderId))
+	}
+	return n
+}
+
+func (m *MsgInterchainLiquidStake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgInterchainLiquidStakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MintedAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateHostZoneState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Updater)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ProofHeight != 0 {
+		n += 1 + sovTx(uint64(m.ProofHeight))
+	}
+	l = len(m.Delegation)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DelegationProof)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorProof)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Balance)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BalanceProof)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateHostZoneStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateWhitelistedValidatorWeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.TargetWeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateWhitelistedValidatorWeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgLiquidStake) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLiquidStake: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLiquidStake: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Farm", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Farm = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLiquidStakeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLiquidStakeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLiquidStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLiquidUnstake) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLiquidUnstake: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLiquidUnstake: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLiquidUnstakeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLiquidUnstakeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLiquidUnstakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgArbLiquidStake) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgArbLiquidStake: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgArbLiquidStake: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArbitragerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ArbitragerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
+			}
+			m.PairId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PairId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSpend", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSpend.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgArbLiquidStakeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgArbLiquidStakeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgArbLiquidStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLiquidUnstakeInstant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLiquidUnstakeInstant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLiquidUnstakeInstant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidStaker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiquidStaker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
+			}
+			m.PairId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PairId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSlippage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSlippage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLiquidUnstakeInstantResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLiquidUnstakeInstantResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLiquidUnstakeInstantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInterchainLiquidStake) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInterchainLiquidStake: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInterchainLiquidStake: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInterchainLiquidStakeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInterchainLiquidStakeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInterchainLiquidStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintedAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MintedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateHostZoneState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateHostZoneState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateHostZoneState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Updater", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLiquidStake: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLiquidStake: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Updater = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -624,13 +3009,32 @@ func (m *MsgLiquidStake) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+			}
+			m.ProofHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProofHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegation", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -640,23 +3044,194 @@ func (m *MsgLiquidStake) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Delegation = append(m.Delegation[:0], dAtA[iNdEx:postIndex]...)
+			if m.Delegation == nil {
+				m.Delegation = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationProof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegationProof = append(m.DelegationProof[:0], dAtA[iNdEx:postIndex]...)
+			if m.DelegationProof == nil {
+				m.DelegationProof = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = append(m.Validator[:0], dAtA[iNdEx:postIndex]...)
+			if m.Validator == nil {
+				m.Validator = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorProof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorProof = append(m.ValidatorProof[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorProof == nil {
+				m.ValidatorProof = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balance = append(m.Balance[:0], dAtA[iNdEx:postIndex]...)
+			if m.Balance == nil {
+				m.Balance = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BalanceProof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BalanceProof = append(m.BalanceProof[:0], dAtA[iNdEx:postIndex]...)
+			if m.BalanceProof == nil {
+				m.BalanceProof = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -680,7 +3255,7 @@ func (m *MsgLiquidStake) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLiquidStakeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateHostZoneStateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -703,10 +3278,10 @@ func (m *MsgLiquidStakeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLiquidStakeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateHostZoneStateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLiquidStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateHostZoneStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -730,7 +3305,7 @@ func (m *MsgLiquidStakeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLiquidUnstake) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateWhitelistedValidatorWeight) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -753,15 +3328,15 @@ func (m *MsgLiquidUnstake) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLiquidUnstake: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateWhitelistedValidatorWeight: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLiquidUnstake: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateWhitelistedValidatorWeight: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -789,13 +3364,13 @@ func (m *MsgLiquidUnstake) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -805,22 +3380,55 @@ func (m *MsgLiquidUnstake) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TargetWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -845,7 +3453,7 @@ func (m *MsgLiquidUnstake) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLiquidUnstakeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateWhitelistedValidatorWeightResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -868,45 +3476,12 @@ func (m *MsgLiquidUnstakeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLiquidUnstakeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateWhitelistedValidatorWeightResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLiquidUnstakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateWhitelistedValidatorWeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
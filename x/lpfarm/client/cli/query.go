@@ -34,6 +34,8 @@ func GetQueryCmd() *cobra.Command {
 		NewQueryHistoricalRewardsCmd(),
 		NewQueryTotalRewardsCmd(),
 		NewQueryRewardsCmd(),
+		NewQuerySpendSchedulesCmd(),
+		NewQuerySpendScheduleCmd(),
 	)
 
 	return cmd
@@ -365,3 +367,80 @@ $ %s query %s rewards cosmos1... pool1
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// NewQuerySpendSchedulesCmd implements the spend schedules query cmd.
+func NewQuerySpendSchedulesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "spend-schedules",
+		Args:  cobra.NoArgs,
+		Short: "Query all spend schedules",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all spend schedules.
+
+Example:
+$ %s query %s spend-schedules
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			res, err := queryClient.SpendSchedules(cmd.Context(), &types.QuerySpendSchedulesRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "spend-schedules")
+	return cmd
+}
+
+// NewQuerySpendScheduleCmd implements the spend schedule query cmd.
+func NewQuerySpendScheduleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "spend-schedule [spend-schedule-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query a specific spend schedule",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query a specific spend schedule.
+
+Example:
+$ %s query %s spend-schedule 1
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			scheduleId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid spend schedule id: %w", err)
+			}
+			res, err := queryClient.SpendSchedule(cmd.Context(), &types.QuerySpendScheduleRequest{
+				SpendScheduleId: scheduleId,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
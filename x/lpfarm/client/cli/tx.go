@@ -34,6 +34,7 @@ func GetTxCmd() *cobra.Command {
 		NewFarmCmd(),
 		NewUnfarmCmd(),
 		NewHarvestCmd(),
+		NewExecuteSpendScheduleCmd(),
 	)
 
 	return cmd
@@ -220,6 +221,45 @@ $ %s tx %s harvest pool1 --from mykey
 	return cmd
 }
 
+func NewExecuteSpendScheduleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "execute-spend-schedule [spend-schedule-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Execute the next period of a spend schedule",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Execute the next period of a spend schedule.
+The amount per period of the spend schedule is transferred from the community pool
+to the recipient address of the spend schedule.
+Only the authority of the spend schedule can execute it.
+
+Example:
+$ %s tx %s execute-spend-schedule 1 --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			scheduleId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid spend schedule id: %w", err)
+			}
+
+			msg := types.NewMsgExecuteSpendSchedule(clientCtx.GetFromAddress(), scheduleId)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func NewCmdSubmitFarmingPlanProposal() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "farming-plan [proposal-file]",
@@ -312,3 +352,81 @@ Where proposal.json contains:
 
 	return cmd
 }
+
+func NewCmdSubmitSpendScheduleProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "spend-schedule [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a spend schedule proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a spend schedule proposal along with an initial deposit.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal spend-schedule <path/to/proposal.json> --from=<key_or_address> --deposit=<deposit_amount>
+
+Where proposal.json contains:
+
+{
+  "title": "Spend Schedule Proposal",
+  "description": "Top up the farming pool every 30 days",
+  "create_spend_schedule_requests": [
+    {
+      "description": "Monthly farming pool top-up",
+      "authority": "cre1zaavvzxez0elundtn32qnk9lkm8kmcszxclz6p",
+      "recipient_address": "cre1mzgucqnfr2l8cj5apvdpllhzt4zeuh2c5l33n3",
+      "amount_per_period": [
+        {
+          "denom": "stake",
+          "amount": "3000000000"
+        }
+      ],
+      "period": "2592000s",
+      "start_time": "2022-01-01T00:00:00Z",
+      "end_time": "2023-01-01T00:00:00Z"
+    }
+  ],
+  "terminate_spend_schedule_requests": [
+    {
+      "spend_schedule_id": "1"
+    }
+  ]
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(cli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			content, err := ParseSpendScheduleProposal(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			msg, err := gov.NewMsgSubmitProposal(&content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(cli.FlagDeposit, "", "deposit of proposal")
+
+	return cmd
+}
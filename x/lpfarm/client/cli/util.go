@@ -22,3 +22,18 @@ func ParseFarmingPlanProposal(cdc codec.JSONCodec, proposalFile string) (types.F
 
 	return proposal, nil
 }
+
+func ParseSpendScheduleProposal(cdc codec.JSONCodec, proposalFile string) (types.SpendScheduleProposal, error) {
+	proposal := types.SpendScheduleProposal{}
+
+	contents, err := os.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err = cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
@@ -7,8 +7,11 @@ import (
 	"github.com/crescent-network/crescent/v4/x/lpfarm/client/rest"
 )
 
-// ProposalHandler is the public plan command handler.
-// Note that rest.ProposalRESTHandler will be deprecated in the future.
+// ProposalHandler is the public plan command handler and
+// SpendScheduleProposalHandler is the spend schedule command handler.
+// Note that rest.ProposalRESTHandler and rest.SpendScheduleProposalRESTHandler
+// will be deprecated in the future.
 var (
-	ProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitFarmingPlanProposal, rest.ProposalRESTHandler)
+	ProposalHandler              = govclient.NewProposalHandler(cli.NewCmdSubmitFarmingPlanProposal, rest.ProposalRESTHandler)
+	SpendScheduleProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitSpendScheduleProposal, rest.SpendScheduleProposalRESTHandler)
 )
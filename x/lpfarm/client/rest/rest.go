@@ -14,6 +14,13 @@ func ProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
 	}
 }
 
+func SpendScheduleProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "spend_schedule",
+		Handler:  postProposalHandlerFn(clientCtx),
+	}
+}
+
 func postProposalHandlerFn(_ client.Context) http.HandlerFunc {
 	return func(_ http.ResponseWriter, _ *http.Request) {
 	}
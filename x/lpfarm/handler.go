@@ -29,6 +29,9 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgHarvest:
 			res, err := msgServer.Harvest(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgExecuteSpendSchedule:
+			res, err := msgServer.ExecuteSpendSchedule(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", types.ModuleName, msg)
 		}
@@ -40,6 +43,8 @@ func NewFarmingPlanProposalHandler(k keeper.Keeper) govtypes.Handler {
 		switch c := content.(type) {
 		case *types.FarmingPlanProposal:
 			return keeper.HandleFarmingPlanProposal(ctx, k, c)
+		case *types.SpendScheduleProposal:
+			return keeper.HandleSpendScheduleProposal(ctx, k, c)
 		default:
 			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized lpfarm proposal content type: %T", c)
 		}
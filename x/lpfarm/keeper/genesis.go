@@ -31,6 +31,12 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 	for _, hist := range genState.HistoricalRewards {
 		k.SetHistoricalRewards(ctx, hist.Denom, hist.Period, hist.HistoricalRewards)
 	}
+	if genState.LastSpendScheduleId > 0 {
+		k.SetLastSpendScheduleId(ctx, genState.LastSpendScheduleId)
+	}
+	for _, schedule := range genState.SpendSchedules {
+		k.SetSpendSchedule(ctx, schedule)
+	}
 }
 
 // ExportGenesis returns the module's exported genesis.
@@ -75,7 +81,15 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 			return false
 		})
 
+	lastSpendScheduleId, _ := k.GetLastSpendScheduleId(ctx)
+
+	schedules := []types.SpendSchedule{}
+	k.IterateAllSpendSchedules(ctx, func(schedule types.SpendSchedule) (stop bool) {
+		schedules = append(schedules, schedule)
+		return false
+	})
+
 	return types.NewGenesisState(
 		k.GetParams(ctx), lastBlockTimePtr, lastPlanId, k.GetNumPrivatePlans(ctx),
-		plans, farms, positions, hists)
+		plans, farms, positions, hists, lastSpendScheduleId, schedules)
 }
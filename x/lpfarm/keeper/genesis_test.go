@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"time"
+
 	utils "github.com/crescent-network/crescent/v4/types"
 	"github.com/crescent-network/crescent/v4/x/lpfarm/types"
 )
@@ -17,6 +19,10 @@ func (s *KeeperTestSuite) TestImportExportGenesis() {
 	s.nextBlock()
 	s.harvest(farmerAddr, "pool1")
 	s.nextBlock()
+	_, err := s.keeper.CreateSpendSchedule(
+		s.ctx, "", utils.TestAddress(1), utils.TestAddress(2),
+		utils.ParseCoins("100_000000stake"), 24*time.Hour, sampleStartTime, sampleEndTime)
+	s.Require().NoError(err)
 
 	genState := s.keeper.ExportGenesis(s.ctx)
 	bz := s.app.AppCodec().MustMarshalJSON(genState)
@@ -204,3 +204,37 @@ func (k Querier) Rewards(c context.Context, req *types.QueryRewardsRequest) (*ty
 		Rewards: k.Keeper.Rewards(ctx, farmerAddr, req.Denom),
 	}, nil
 }
+
+func (k Querier) SpendSchedules(c context.Context, req *types.QuerySpendSchedulesRequest) (*types.QuerySpendSchedulesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(k.storeKey)
+	scheduleStore := prefix.NewStore(store, types.SpendScheduleKeyPrefix)
+	var schedules []types.SpendSchedule
+	pageRes, err := query.Paginate(scheduleStore, req.Pagination, func(key, value []byte) error {
+		var schedule types.SpendSchedule
+		if err := k.cdc.Unmarshal(value, &schedule); err != nil {
+			return err
+		}
+		schedules = append(schedules, schedule)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QuerySpendSchedulesResponse{SpendSchedules: schedules, Pagination: pageRes}, nil
+}
+
+func (k Querier) SpendSchedule(c context.Context, req *types.QuerySpendScheduleRequest) (*types.QuerySpendScheduleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	schedule, found := k.GetSpendSchedule(ctx, req.SpendScheduleId)
+	if !found {
+		return nil, status.Error(codes.NotFound, "spend schedule not found")
+	}
+	return &types.QuerySpendScheduleResponse{SpendSchedule: schedule}, nil
+}
@@ -19,6 +19,7 @@ type Keeper struct {
 
 	accountKeeper   types.AccountKeeper
 	bankKeeper      types.BankKeeper
+	distrKeeper     types.DistrKeeper
 	liquidityKeeper types.LiquidityKeeper
 }
 
@@ -29,6 +30,7 @@ func NewKeeper(
 	paramSpace paramstypes.Subspace,
 	accountKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
+	distrKeeper types.DistrKeeper,
 	liquidityKeeper types.LiquidityKeeper,
 ) Keeper {
 	if !paramSpace.HasKeyTable() {
@@ -41,6 +43,7 @@ func NewKeeper(
 		paramSpace:      paramSpace,
 		accountKeeper:   accountKeeper,
 		bankKeeper:      bankKeeper,
+		distrKeeper:     distrKeeper,
 		liquidityKeeper: liquidityKeeper,
 	}
 }
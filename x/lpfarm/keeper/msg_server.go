@@ -97,3 +97,23 @@ func (k msgServer) Harvest(goCtx context.Context, msg *types.MsgHarvest) (*types
 		WithdrawnRewards: withdrawnRewards,
 	}, nil
 }
+
+// ExecuteSpendSchedule defines a method for executing the next period of
+// a spend schedule.
+func (k msgServer) ExecuteSpendSchedule(goCtx context.Context, msg *types.MsgExecuteSpendSchedule) (*types.MsgExecuteSpendScheduleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityAddr, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return nil, err
+	}
+
+	spentCoins, err := k.Keeper.ExecuteSpendSchedule(ctx, authorityAddr, msg.SpendScheduleId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgExecuteSpendScheduleResponse{
+		SpentCoins: spentCoins,
+	}, nil
+}
@@ -32,3 +32,27 @@ func HandleFarmingPlanProposal(ctx sdk.Context, k Keeper, p *types.FarmingPlanPr
 	}
 	return nil
 }
+
+// HandleSpendScheduleProposal is a handler for executing a spend schedule proposal.
+func HandleSpendScheduleProposal(ctx sdk.Context, k Keeper, p *types.SpendScheduleProposal) error {
+	for _, req := range p.CreateSpendScheduleRequests {
+		authorityAddr, _ := sdk.AccAddressFromBech32(req.Authority)
+		recipientAddr, _ := sdk.AccAddressFromBech32(req.RecipientAddress)
+		if _, err := k.CreateSpendSchedule(
+			ctx, req.Description, authorityAddr, recipientAddr,
+			req.AmountPerPeriod, req.Period, req.StartTime, req.EndTime); err != nil {
+			return err
+		}
+	}
+	for _, req := range p.TerminateSpendScheduleRequests {
+		schedule, found := k.GetSpendSchedule(ctx, req.SpendScheduleId)
+		if !found {
+			return sdkerrors.Wrapf(
+				sdkerrors.ErrNotFound, "spend schedule %d not found", req.SpendScheduleId)
+		}
+		if err := k.TerminateSpendSchedule(ctx, schedule); err != nil {
+			return err
+		}
+	}
+	return nil
+}
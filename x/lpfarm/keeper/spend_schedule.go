@@ -0,0 +1,101 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/crescent-network/crescent/v4/x/lpfarm/types"
+)
+
+// CreateSpendSchedule creates a new spend schedule, which allows the
+// authority to transfer coins from the community pool to the recipient
+// periodically.
+func (k Keeper) CreateSpendSchedule(
+	ctx sdk.Context, description string, authorityAddr, recipientAddr sdk.AccAddress,
+	amtPerPeriod sdk.Coins, period time.Duration, startTime, endTime time.Time,
+) (types.SpendSchedule, error) {
+	// Check if end time > block time
+	if !endTime.After(ctx.BlockTime()) {
+		return types.SpendSchedule{}, sdkerrors.Wrap(
+			sdkerrors.ErrInvalidRequest, "end time is past")
+	}
+
+	// Generate the next spend schedule id and update the last spend schedule id.
+	id, _ := k.GetLastSpendScheduleId(ctx)
+	id++
+	k.SetLastSpendScheduleId(ctx, id)
+
+	schedule := types.NewSpendSchedule(
+		id, description, authorityAddr, recipientAddr,
+		amtPerPeriod, period, startTime, endTime)
+	k.SetSpendSchedule(ctx, schedule)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventCreateSpendSchedule{
+		SpendScheduleId:  schedule.Id,
+		Authority:        schedule.Authority,
+		RecipientAddress: schedule.RecipientAddress,
+	}); err != nil {
+		return types.SpendSchedule{}, err
+	}
+
+	return schedule, nil
+}
+
+// TerminateSpendSchedule deletes the spend schedule so that no more
+// periods can be executed.
+func (k Keeper) TerminateSpendSchedule(ctx sdk.Context, schedule types.SpendSchedule) error {
+	k.DeleteSpendSchedule(ctx, schedule.Id)
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventTerminateSpendSchedule{
+		SpendScheduleId: schedule.Id,
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ExecuteSpendSchedule executes the next period of the spend schedule,
+// transferring the amount per period from the community pool to the
+// recipient.
+// The spend schedule is deleted after its last period is executed.
+func (k Keeper) ExecuteSpendSchedule(
+	ctx sdk.Context, authorityAddr sdk.AccAddress, scheduleId uint64) (spentCoins sdk.Coins, err error) {
+	schedule, found := k.GetSpendSchedule(ctx, scheduleId)
+	if !found {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "spend schedule %d not found", scheduleId)
+	}
+	if schedule.Authority != authorityAddr.String() {
+		return nil, sdkerrors.Wrapf(
+			sdkerrors.ErrUnauthorized, "%s is not the authority of the spend schedule", authorityAddr)
+	}
+	if !schedule.CanExecuteAt(ctx.BlockTime()) {
+		return nil, sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest, "spend schedule is not executable until %s",
+			schedule.NextExecutionTime().UTC().Format(time.RFC3339))
+	}
+
+	spentCoins = schedule.AmountPerPeriod
+	if err := k.distrKeeper.DistributeFromFeePool(
+		ctx, spentCoins, schedule.GetRecipientAddress()); err != nil {
+		return nil, err
+	}
+
+	schedule.NumExecutions++
+	if schedule.IsFinished() {
+		k.DeleteSpendSchedule(ctx, schedule.Id)
+	} else {
+		k.SetSpendSchedule(ctx, schedule)
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventExecuteSpendSchedule{
+		SpendScheduleId:  schedule.Id,
+		RecipientAddress: schedule.RecipientAddress,
+		SpentCoins:       spentCoins,
+		NumExecutions:    schedule.NumExecutions,
+	}); err != nil {
+		return nil, err
+	}
+
+	return spentCoins, nil
+}
@@ -0,0 +1,121 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	utils "github.com/crescent-network/crescent/v4/types"
+	"github.com/crescent-network/crescent/v4/x/lpfarm/types"
+)
+
+func (s *KeeperTestSuite) fundCommunityPool(amt sdk.Coins) {
+	s.T().Helper()
+	s.fundAddr(helperAddr, amt)
+	s.Require().NoError(s.app.DistrKeeper.FundCommunityPool(s.ctx, amt, helperAddr))
+}
+
+func (s *KeeperTestSuite) TestSpendScheduleProposalHandler() {
+	authorityAddr := utils.TestAddress(0)
+	recipientAddr := utils.TestAddress(1)
+
+	createReq := types.NewCreateSpendScheduleRequest(
+		"Monthly top-up", authorityAddr, recipientAddr,
+		utils.ParseCoins("1000_000000stake"), 30*24*time.Hour,
+		utils.ParseTime("2022-01-01T00:00:00Z"), utils.ParseTime("2023-01-01T00:00:00Z"))
+	proposal := types.NewSpendScheduleProposal(
+		"Create a new spend schedule", "Description",
+		[]types.CreateSpendScheduleRequest{createReq}, nil)
+	s.handleProposal(proposal)
+
+	schedule, found := s.keeper.GetSpendSchedule(s.ctx, 1)
+	s.Require().True(found)
+	s.Require().Equal(authorityAddr.String(), schedule.Authority)
+	s.Require().Equal(recipientAddr.String(), schedule.RecipientAddress)
+	s.Require().EqualValues(0, schedule.NumExecutions)
+
+	terminateReq := types.NewTerminateSpendScheduleRequest(1)
+	proposal = types.NewSpendScheduleProposal(
+		"Terminate the spend schedule", "Description",
+		nil, []types.TerminateSpendScheduleRequest{terminateReq})
+	s.handleProposal(proposal)
+
+	_, found = s.keeper.GetSpendSchedule(s.ctx, 1)
+	s.Require().False(found)
+
+	// Terminating the spend schedule again fails.
+	s.Require().NoError(proposal.ValidateBasic())
+	s.Require().ErrorIs(s.govHandler(s.ctx, proposal), sdkerrors.ErrNotFound)
+
+	// Spend schedules which end in the past cannot be created.
+	createReq = types.NewCreateSpendScheduleRequest(
+		"", authorityAddr, recipientAddr,
+		utils.ParseCoins("1000_000000stake"), 30*24*time.Hour,
+		utils.ParseTime("2021-01-01T00:00:00Z"), utils.ParseTime("2021-12-01T00:00:00Z"))
+	proposal = types.NewSpendScheduleProposal(
+		"Create a past spend schedule", "Description",
+		[]types.CreateSpendScheduleRequest{createReq}, nil)
+	s.Require().NoError(proposal.ValidateBasic())
+	s.Require().ErrorIs(s.govHandler(s.ctx, proposal), sdkerrors.ErrInvalidRequest)
+}
+
+func (s *KeeperTestSuite) TestExecuteSpendSchedule() {
+	authorityAddr := utils.TestAddress(0)
+	recipientAddr := utils.TestAddress(1)
+	s.fundCommunityPool(utils.ParseCoins("10000_000000stake"))
+
+	// 3 periods in total, and the last period is shorter than others.
+	schedule, err := s.keeper.CreateSpendSchedule(
+		s.ctx, "", authorityAddr, recipientAddr,
+		utils.ParseCoins("1000_000000stake"), 30*24*time.Hour,
+		utils.ParseTime("2022-01-01T00:00:00Z"), utils.ParseTime("2022-03-15T00:00:00Z"))
+	s.Require().NoError(err)
+
+	// Only the authority can execute the spend schedule.
+	_, err = s.keeper.ExecuteSpendSchedule(s.ctx, recipientAddr, schedule.Id)
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	spentCoins, err := s.keeper.ExecuteSpendSchedule(s.ctx, authorityAddr, schedule.Id)
+	s.Require().NoError(err)
+	s.assertEq(utils.ParseCoins("1000_000000stake"), spentCoins)
+	s.assertEq(utils.ParseCoins("1000_000000stake"), s.getBalances(recipientAddr))
+
+	// The next period has not started yet.
+	_, err = s.keeper.ExecuteSpendSchedule(s.ctx, authorityAddr, schedule.Id)
+	s.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	// Both the second and the last period can be executed after the last
+	// period started, one by one.
+	s.ctx = s.ctx.WithBlockTime(utils.ParseTime("2022-03-02T00:00:00Z"))
+	_, err = s.keeper.ExecuteSpendSchedule(s.ctx, authorityAddr, schedule.Id)
+	s.Require().NoError(err)
+	schedule, _ = s.keeper.GetSpendSchedule(s.ctx, schedule.Id)
+	s.Require().EqualValues(2, schedule.NumExecutions)
+	_, err = s.keeper.ExecuteSpendSchedule(s.ctx, authorityAddr, schedule.Id)
+	s.Require().NoError(err)
+	s.assertEq(utils.ParseCoins("3000_000000stake"), s.getBalances(recipientAddr))
+
+	// The spend schedule is deleted after its last period is executed.
+	_, found := s.keeper.GetSpendSchedule(s.ctx, schedule.Id)
+	s.Require().False(found)
+	_, err = s.keeper.ExecuteSpendSchedule(s.ctx, authorityAddr, schedule.Id)
+	s.Require().ErrorIs(err, sdkerrors.ErrNotFound)
+}
+
+func (s *KeeperTestSuite) TestExecuteSpendSchedule_InsufficientCommunityPool() {
+	authorityAddr := utils.TestAddress(0)
+	recipientAddr := utils.TestAddress(1)
+	s.fundCommunityPool(utils.ParseCoins("500_000000stake"))
+
+	schedule, err := s.keeper.CreateSpendSchedule(
+		s.ctx, "", authorityAddr, recipientAddr,
+		utils.ParseCoins("1000_000000stake"), 30*24*time.Hour,
+		utils.ParseTime("2022-01-01T00:00:00Z"), utils.ParseTime("2023-01-01T00:00:00Z"))
+	s.Require().NoError(err)
+
+	_, err = s.keeper.ExecuteSpendSchedule(s.ctx, authorityAddr, schedule.Id)
+	s.Require().Error(err)
+	schedule, _ = s.keeper.GetSpendSchedule(s.ctx, schedule.Id)
+	s.Require().EqualValues(0, schedule.NumExecutions)
+}
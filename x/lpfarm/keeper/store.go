@@ -194,3 +194,50 @@ func (k Keeper) IterateAllHistoricalRewards(ctx sdk.Context, cb func(denom strin
 		}
 	}
 }
+
+func (k Keeper) GetLastSpendScheduleId(ctx sdk.Context) (id uint64, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LastSpendScheduleIdKey)
+	if bz == nil {
+		return
+	}
+	return sdk.BigEndianToUint64(bz), true
+}
+
+func (k Keeper) SetLastSpendScheduleId(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LastSpendScheduleIdKey, sdk.Uint64ToBigEndian(id))
+}
+
+func (k Keeper) GetSpendSchedule(ctx sdk.Context, id uint64) (schedule types.SpendSchedule, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetSpendScheduleKey(id))
+	if bz == nil {
+		return
+	}
+	k.cdc.MustUnmarshal(bz, &schedule)
+	return schedule, true
+}
+
+func (k Keeper) SetSpendSchedule(ctx sdk.Context, schedule types.SpendSchedule) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetSpendScheduleKey(schedule.Id), k.cdc.MustMarshal(&schedule))
+}
+
+func (k Keeper) DeleteSpendSchedule(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetSpendScheduleKey(id))
+}
+
+func (k Keeper) IterateAllSpendSchedules(ctx sdk.Context, cb func(schedule types.SpendSchedule) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.SpendScheduleKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var schedule types.SpendSchedule
+		k.cdc.MustUnmarshal(iter.Value(), &schedule)
+		if cb(schedule) {
+			break
+		}
+	}
+}
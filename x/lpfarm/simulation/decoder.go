@@ -37,6 +37,12 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvB.Value, &hB)
 			return fmt.Sprintf("%v\n%v", hA, hB)
 
+		case bytes.Equal(kvA.Key[:1], types.SpendScheduleKeyPrefix):
+			var sA, sB types.SpendSchedule
+			cdc.MustUnmarshal(kvA.Value, &sA)
+			cdc.MustUnmarshal(kvB.Value, &sB)
+			return fmt.Sprintf("%v\n%v", sA, sB)
+
 		default:
 			panic(fmt.Sprintf("invalid lpfarm key prefix %X", kvA.Key[:1]))
 		}
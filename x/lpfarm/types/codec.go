@@ -16,7 +16,9 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgFarm{}, "lpfarm/MsgFarm", nil)
 	cdc.RegisterConcrete(&MsgUnfarm{}, "lpfarm/MsgUnfarm", nil)
 	cdc.RegisterConcrete(&MsgHarvest{}, "lpfarm/MsgHarvest", nil)
+	cdc.RegisterConcrete(&MsgExecuteSpendSchedule{}, "lpfarm/MsgExecuteSpendSchedule", nil)
 	cdc.RegisterConcrete(&FarmingPlanProposal{}, "lpfarm/FarmingPlanProposal", nil)
+	cdc.RegisterConcrete(&SpendScheduleProposal{}, "lpfarm/SpendScheduleProposal", nil)
 }
 
 // RegisterInterfaces registers the x/lpfarm interfaces types with the
@@ -28,10 +30,12 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgFarm{},
 		&MsgUnfarm{},
 		&MsgHarvest{},
+		&MsgExecuteSpendSchedule{},
 	)
 	registry.RegisterImplementations(
 		(*govtypes.Content)(nil),
 		&FarmingPlanProposal{},
+		&SpendScheduleProposal{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -218,12 +218,131 @@ func (m *EventTerminatePlan) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_EventTerminatePlan proto.InternalMessageInfo
 
+type EventCreateSpendSchedule struct {
+	SpendScheduleId  uint64 `protobuf:"varint,1,opt,name=spend_schedule_id,json=spendScheduleId,proto3" json:"spend_schedule_id,omitempty"`
+	Authority        string `protobuf:"bytes,2,opt,name=authority,proto3" json:"authority,omitempty"`
+	RecipientAddress string `protobuf:"bytes,3,opt,name=recipient_address,json=recipientAddress,proto3" json:"recipient_address,omitempty"`
+}
+
+func (m *EventCreateSpendSchedule) Reset()         { *m = EventCreateSpendSchedule{} }
+func (m *EventCreateSpendSchedule) String() string { return proto.CompactTextString(m) }
+func (*EventCreateSpendSchedule) ProtoMessage()    {}
+func (*EventCreateSpendSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d74bdb17e60e7c6f, []int{5}
+}
+func (m *EventCreateSpendSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCreateSpendSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCreateSpendSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCreateSpendSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCreateSpendSchedule.Merge(m, src)
+}
+func (m *EventCreateSpendSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCreateSpendSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCreateSpendSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCreateSpendSchedule proto.InternalMessageInfo
+
+type EventExecuteSpendSchedule struct {
+	SpendScheduleId  uint64                                   `protobuf:"varint,1,opt,name=spend_schedule_id,json=spendScheduleId,proto3" json:"spend_schedule_id,omitempty"`
+	RecipientAddress string                                   `protobuf:"bytes,2,opt,name=recipient_address,json=recipientAddress,proto3" json:"recipient_address,omitempty"`
+	SpentCoins       github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=spent_coins,json=spentCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spent_coins"`
+	NumExecutions    uint64                                   `protobuf:"varint,4,opt,name=num_executions,json=numExecutions,proto3" json:"num_executions,omitempty"`
+}
+
+func (m *EventExecuteSpendSchedule) Reset()         { *m = EventExecuteSpendSchedule{} }
+func (m *EventExecuteSpendSchedule) String() string { return proto.CompactTextString(m) }
+func (*EventExecuteSpendSchedule) ProtoMessage()    {}
+func (*EventExecuteSpendSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d74bdb17e60e7c6f, []int{6}
+}
+func (m *EventExecuteSpendSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventExecuteSpendSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventExecuteSpendSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventExecuteSpendSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventExecuteSpendSchedule.Merge(m, src)
+}
+func (m *EventExecuteSpendSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventExecuteSpendSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventExecuteSpendSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventExecuteSpendSchedule proto.InternalMessageInfo
+
+type EventTerminateSpendSchedule struct {
+	SpendScheduleId uint64 `protobuf:"varint,1,opt,name=spend_schedule_id,json=spendScheduleId,proto3" json:"spend_schedule_id,omitempty"`
+}
+
+func (m *EventTerminateSpendSchedule) Reset()         { *m = EventTerminateSpendSchedule{} }
+func (m *EventTerminateSpendSchedule) String() string { return proto.CompactTextString(m) }
+func (*EventTerminateSpendSchedule) ProtoMessage()    {}
+func (*EventTerminateSpendSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d74bdb17e60e7c6f, []int{7}
+}
+func (m *EventTerminateSpendSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventTerminateSpendSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventTerminateSpendSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventTerminateSpendSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventTerminateSpendSchedule.Merge(m, src)
+}
+func (m *EventTerminateSpendSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventTerminateSpendSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventTerminateSpendSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventTerminateSpendSchedule proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*EventCreatePrivatePlan)(nil), "crescent.lpfarm.v1beta1.EventCreatePrivatePlan")
 	proto.RegisterType((*EventFarm)(nil), "crescent.lpfarm.v1beta1.EventFarm")
 	proto.RegisterType((*EventUnfarm)(nil), "crescent.lpfarm.v1beta1.EventUnfarm")
 	proto.RegisterType((*EventHarvest)(nil), "crescent.lpfarm.v1beta1.EventHarvest")
 	proto.RegisterType((*EventTerminatePlan)(nil), "crescent.lpfarm.v1beta1.EventTerminatePlan")
+	proto.RegisterType((*EventCreateSpendSchedule)(nil), "crescent.lpfarm.v1beta1.EventCreateSpendSchedule")
+	proto.RegisterType((*EventExecuteSpendSchedule)(nil), "crescent.lpfarm.v1beta1.EventExecuteSpendSchedule")
+	proto.RegisterType((*EventTerminateSpendSchedule)(nil), "crescent.lpfarm.v1beta1.EventTerminateSpendSchedule")
 }
 
 func init() {
@@ -231,35 +350,43 @@ func init() {
 }
 
 var fileDescriptor_d74bdb17e60e7c6f = []byte{
-	// 442 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x53, 0xcd, 0x6e, 0x13, 0x31,
-	0x10, 0x5e, 0xd3, 0x90, 0x2a, 0x2e, 0x07, 0xb0, 0xa2, 0x36, 0xf4, 0xb0, 0xad, 0x22, 0x0e, 0xb9,
-	0x64, 0xdd, 0x52, 0xc4, 0x9d, 0x56, 0x20, 0xb8, 0x55, 0xab, 0x72, 0xe1, 0x12, 0x39, 0xeb, 0x69,
-	0xba, 0xea, 0xae, 0x67, 0x65, 0x9b, 0x4d, 0x39, 0xf0, 0x0e, 0x3c, 0x07, 0x0f, 0xc0, 0x33, 0xe4,
-	0x58, 0x21, 0x0e, 0x9c, 0xf8, 0x49, 0x5e, 0x04, 0xd9, 0xde, 0x8d, 0x72, 0x81, 0x2b, 0x52, 0x4f,
-	0xde, 0xf1, 0x7c, 0x9e, 0xef, 0xfb, 0x56, 0xdf, 0xd0, 0x27, 0x99, 0x06, 0x93, 0x81, 0xb2, 0xbc,
-	0xa8, 0x2e, 0x85, 0x2e, 0x79, 0x7d, 0x3c, 0x05, 0x2b, 0x8e, 0x39, 0xd4, 0xa0, 0xac, 0x49, 0x2a,
-	0x8d, 0x16, 0xd9, 0x5e, 0x8b, 0x4a, 0x02, 0x2a, 0x69, 0x50, 0xfb, 0xfd, 0x19, 0xce, 0xd0, 0x63,
-	0xb8, 0xfb, 0x0a, 0xf0, 0xfd, 0x38, 0x43, 0x53, 0xa2, 0xe1, 0x53, 0x61, 0x60, 0x3d, 0x30, 0xc3,
-	0x5c, 0x85, 0xfe, 0xf0, 0x23, 0xdd, 0x7d, 0xe9, 0xc6, 0x9f, 0x69, 0x10, 0x16, 0xce, 0x75, 0x5e,
-	0xbb, 0xa3, 0x10, 0x8a, 0x0d, 0xe8, 0x76, 0xe6, 0x2e, 0x51, 0x0f, 0xc8, 0x21, 0x19, 0xf5, 0xd2,
-	0xb6, 0x64, 0x7b, 0x74, 0xbb, 0x2a, 0x84, 0x9a, 0xe4, 0x72, 0x70, 0xef, 0x90, 0x8c, 0x3a, 0x69,
-	0xd7, 0x95, 0x6f, 0x24, 0x3b, 0xa2, 0x7d, 0x27, 0x29, 0x57, 0xb3, 0x49, 0x85, 0x58, 0x4c, 0x84,
-	0x94, 0x1a, 0x8c, 0x19, 0x6c, 0xf9, 0xf7, 0xac, 0xe9, 0x9d, 0x23, 0x16, 0x2f, 0x42, 0x67, 0xf8,
-	0x95, 0xd0, 0x9e, 0xe7, 0x7f, 0x25, 0x74, 0xc9, 0x76, 0x69, 0xd7, 0x61, 0xa0, 0x65, 0x6c, 0x2a,
-	0x76, 0x42, 0x3b, 0x4e, 0xb2, 0x67, 0xdb, 0x79, 0xfa, 0x38, 0x09, 0x9e, 0x12, 0xe7, 0xa9, 0xb5,
-	0x9f, 0x9c, 0x61, 0xae, 0x4e, 0x3b, 0x8b, 0x1f, 0x07, 0x51, 0xea, 0xc1, 0xec, 0x86, 0x3e, 0x9a,
-	0xe7, 0xf6, 0x4a, 0x6a, 0x31, 0x57, 0x13, 0x0d, 0x73, 0xa1, 0xa5, 0x53, 0xb2, 0xf5, 0xef, 0x09,
-	0x47, 0x6e, 0xc2, 0xe7, 0x9f, 0x07, 0xa3, 0x59, 0x6e, 0xaf, 0xde, 0x4f, 0x93, 0x0c, 0x4b, 0xde,
-	0xfc, 0xc2, 0x70, 0x8c, 0x8d, 0xbc, 0xe6, 0xf6, 0x43, 0x05, 0xc6, 0x3f, 0x30, 0xe9, 0xc3, 0x35,
-	0x4b, 0x1a, 0x48, 0x86, 0xdf, 0x08, 0xdd, 0xf1, 0xa6, 0xde, 0xaa, 0xcb, 0x3b, 0x64, 0xeb, 0x0b,
-	0xa1, 0x0f, 0xbc, 0xad, 0xd7, 0x42, 0xd7, 0x60, 0xec, 0x5f, 0x7d, 0xf5, 0xe9, 0x7d, 0x09, 0x0a,
-	0x4b, 0x6f, 0xac, 0x97, 0x86, 0xe2, 0x3f, 0x0a, 0x1f, 0x53, 0xe6, 0x75, 0x5f, 0x80, 0xcb, 0x5f,
-	0x9b, 0xef, 0x8d, 0x14, 0x93, 0xcd, 0x14, 0x9f, 0x5e, 0x2c, 0x7e, 0xc7, 0xd1, 0x62, 0x19, 0x93,
-	0xdb, 0x65, 0x4c, 0x7e, 0x2d, 0x63, 0xf2, 0x69, 0x15, 0x47, 0xb7, 0xab, 0x38, 0xfa, 0xbe, 0x8a,
-	0xa3, 0x77, 0xcf, 0x37, 0x85, 0x34, 0xab, 0x38, 0x56, 0x60, 0xe7, 0xa8, 0xaf, 0xd7, 0x17, 0xbc,
-	0x7e, 0xc6, 0x6f, 0xda, 0x35, 0xf6, 0xe2, 0xa6, 0x5d, 0xbf, 0x6f, 0x27, 0x7f, 0x02, 0x00, 0x00,
-	0xff, 0xff, 0x2b, 0x6d, 0x47, 0x3e, 0xe6, 0x03, 0x00, 0x00,
+	// 574 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0x8e, 0xd3, 0x90, 0x2a, 0x1b, 0x7e, 0x9a, 0x55, 0xd4, 0xba, 0x05, 0xb9, 0x55, 0x04, 0x52,
+	0x04, 0x8a, 0xdd, 0x52, 0xc4, 0x9d, 0x56, 0x41, 0xe4, 0x56, 0xb9, 0xe5, 0xc2, 0xc5, 0xda, 0xd8,
+	0xd3, 0xc4, 0xaa, 0xbd, 0x6b, 0xed, 0xae, 0x93, 0xf4, 0xc0, 0x03, 0x70, 0x83, 0xd7, 0xe0, 0x01,
+	0x78, 0x86, 0x1c, 0x2b, 0xc4, 0x81, 0x13, 0x3f, 0xc9, 0x8b, 0xa0, 0x5d, 0xdb, 0x69, 0x40, 0x85,
+	0x03, 0x08, 0x21, 0x71, 0xda, 0x9d, 0x99, 0x6f, 0x66, 0xbe, 0x6f, 0x77, 0x76, 0xd1, 0x5d, 0x9f,
+	0x83, 0xf0, 0x81, 0x4a, 0x27, 0x4a, 0x4e, 0x09, 0x8f, 0x9d, 0xd1, 0x5e, 0x1f, 0x24, 0xd9, 0x73,
+	0x60, 0x04, 0x54, 0x0a, 0x3b, 0xe1, 0x4c, 0x32, 0xbc, 0x51, 0xa0, 0xec, 0x0c, 0x65, 0xe7, 0xa8,
+	0xad, 0xe6, 0x80, 0x0d, 0x98, 0xc6, 0x38, 0x6a, 0x97, 0xc1, 0xb7, 0x2c, 0x9f, 0x89, 0x98, 0x09,
+	0xa7, 0x4f, 0x04, 0x2c, 0x0a, 0xfa, 0x2c, 0xa4, 0x59, 0xbc, 0xf5, 0x12, 0xad, 0x77, 0x55, 0xf9,
+	0x43, 0x0e, 0x44, 0xc2, 0x11, 0x0f, 0x47, 0x6a, 0x89, 0x08, 0xc5, 0x26, 0x5a, 0xf5, 0x95, 0x93,
+	0x71, 0xd3, 0xd8, 0x31, 0xda, 0x35, 0xb7, 0x30, 0xf1, 0x06, 0x5a, 0x4d, 0x22, 0x42, 0xbd, 0x30,
+	0x30, 0xcb, 0x3b, 0x46, 0xbb, 0xe2, 0x56, 0x95, 0xd9, 0x0b, 0xf0, 0x2e, 0x6a, 0x2a, 0x4a, 0x21,
+	0x1d, 0x78, 0x09, 0x63, 0x91, 0x47, 0x82, 0x80, 0x83, 0x10, 0xe6, 0x8a, 0xce, 0xc7, 0x79, 0xec,
+	0x88, 0xb1, 0xe8, 0x49, 0x16, 0x69, 0xbd, 0x37, 0x50, 0x4d, 0xf7, 0x7f, 0x4a, 0x78, 0x8c, 0xd7,
+	0x51, 0x55, 0x61, 0xa0, 0xe8, 0x98, 0x5b, 0x78, 0x1f, 0x55, 0x14, 0x65, 0xdd, 0xad, 0xfe, 0x70,
+	0xd3, 0xce, 0x34, 0xd9, 0x4a, 0x53, 0x21, 0xdf, 0x3e, 0x64, 0x21, 0x3d, 0xa8, 0x4c, 0x3f, 0x6d,
+	0x97, 0x5c, 0x0d, 0xc6, 0x13, 0xd4, 0x18, 0x87, 0x72, 0x18, 0x70, 0x32, 0xa6, 0x1e, 0x87, 0x31,
+	0xe1, 0x81, 0x62, 0xb2, 0xf2, 0xeb, 0x0a, 0xbb, 0xaa, 0xc2, 0xdb, 0xcf, 0xdb, 0xed, 0x41, 0x28,
+	0x87, 0x69, 0xdf, 0xf6, 0x59, 0xec, 0xe4, 0x47, 0x98, 0x2d, 0x1d, 0x11, 0x9c, 0x39, 0xf2, 0x3c,
+	0x01, 0xa1, 0x13, 0x84, 0xbb, 0xb6, 0xe8, 0xe2, 0x66, 0x4d, 0x5a, 0x1f, 0x0c, 0x54, 0xd7, 0xa2,
+	0x9e, 0xd3, 0xd3, 0xff, 0x48, 0xd6, 0x3b, 0x03, 0x5d, 0xd7, 0xb2, 0x9e, 0x11, 0x3e, 0x02, 0x21,
+	0x7f, 0xaa, 0xab, 0x89, 0xae, 0x05, 0x40, 0x59, 0xac, 0x85, 0xd5, 0xdc, 0xcc, 0xf8, 0x87, 0xc4,
+	0x3b, 0x08, 0x6b, 0xde, 0x27, 0xa0, 0xe6, 0xaf, 0x98, 0xef, 0xa5, 0x29, 0x36, 0x96, 0xa7, 0xb8,
+	0xf5, 0xc6, 0x40, 0xe6, 0xd2, 0x9b, 0x38, 0x4e, 0x80, 0x06, 0xc7, 0xfe, 0x10, 0x82, 0x34, 0x02,
+	0x7c, 0x1f, 0x35, 0x84, 0x72, 0x78, 0x22, 0xf7, 0x5c, 0xe6, 0xdf, 0x12, 0xcb, 0xc8, 0x5e, 0x80,
+	0xef, 0xa0, 0x1a, 0x49, 0xe5, 0x90, 0xf1, 0x50, 0x9e, 0xe7, 0x67, 0x71, 0xe9, 0xc0, 0x0f, 0x50,
+	0x83, 0x83, 0x1f, 0x26, 0x21, 0x50, 0xf9, 0xc3, 0x4b, 0x59, 0x5b, 0x04, 0x8a, 0x77, 0xf2, 0xaa,
+	0x8c, 0x36, 0x35, 0xa7, 0xee, 0x04, 0xfc, 0xf4, 0x4f, 0x48, 0x5d, 0xd9, 0xb6, 0x7c, 0x75, 0x5b,
+	0x1c, 0xa1, 0xba, 0xca, 0x97, 0x9e, 0x1a, 0xbd, 0xbf, 0x72, 0x5b, 0x48, 0xd7, 0xd7, 0x7b, 0x7c,
+	0x0f, 0xdd, 0xa4, 0x69, 0xec, 0x81, 0x96, 0x18, 0x32, 0x2a, 0xcc, 0x8a, 0xd6, 0x70, 0x83, 0xa6,
+	0x71, 0x77, 0xe1, 0x6c, 0xf5, 0xd0, 0xed, 0xef, 0xaf, 0xf3, 0xb7, 0x0f, 0xe3, 0xe0, 0x64, 0xfa,
+	0xd5, 0x2a, 0x4d, 0x67, 0x96, 0x71, 0x31, 0xb3, 0x8c, 0x2f, 0x33, 0xcb, 0x78, 0x3d, 0xb7, 0x4a,
+	0x17, 0x73, 0xab, 0xf4, 0x71, 0x6e, 0x95, 0x5e, 0x3c, 0x5e, 0x56, 0x91, 0xff, 0xba, 0x1d, 0x0a,
+	0x72, 0xcc, 0xf8, 0xd9, 0xc2, 0xe1, 0x8c, 0x1e, 0x39, 0x93, 0xe2, 0xc7, 0xd6, 0xca, 0xfa, 0x55,
+	0xfd, 0xb5, 0xee, 0x7f, 0x1b, 0x00, 0xca, 0x1a, 0x18, 0xf9, 0xd1, 0x05, 0x00, 0x00,
 }
 
 func (m *EventCreatePrivatePlan) Marshal() (dAtA []byte, err error) {
@@ -491,6 +618,130 @@ func (m *EventTerminatePlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventCreateSpendSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCreateSpendSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCreateSpendSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RecipientAddress) > 0 {
+		i -= len(m.RecipientAddress)
+		copy(dAtA[i:], m.RecipientAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RecipientAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SpendScheduleId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SpendScheduleId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventExecuteSpendSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventExecuteSpendSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventExecuteSpendSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumExecutions != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.NumExecutions))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.SpentCoins) > 0 {
+		for iNdEx := len(m.SpentCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpentCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.RecipientAddress) > 0 {
+		i -= len(m.RecipientAddress)
+		copy(dAtA[i:], m.RecipientAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RecipientAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SpendScheduleId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SpendScheduleId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventTerminateSpendSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventTerminateSpendSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventTerminateSpendSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SpendScheduleId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SpendScheduleId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -599,6 +850,63 @@ func (m *EventTerminatePlan) Size() (n int) {
 	return n
 }
 
+func (m *EventCreateSpendSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpendScheduleId != 0 {
+		n += 1 + sovEvents(uint64(m.SpendScheduleId))
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.RecipientAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventExecuteSpendSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpendScheduleId != 0 {
+		n += 1 + sovEvents(uint64(m.SpendScheduleId))
+	}
+	l = len(m.RecipientAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.SpentCoins) > 0 {
+		for _, e := range m.SpentCoins {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if m.NumExecutions != 0 {
+		n += 1 + sovEvents(uint64(m.NumExecutions))
+	}
+	return n
+}
+
+func (m *EventTerminateSpendSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpendScheduleId != 0 {
+		n += 1 + sovEvents(uint64(m.SpendScheduleId))
+	}
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1253,6 +1561,362 @@ func (m *EventTerminatePlan) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventCreateSpendSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCreateSpendSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCreateSpendSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendScheduleId", wireType)
+			}
+			m.SpendScheduleId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpendScheduleId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecipientAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventExecuteSpendSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventExecuteSpendSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventExecuteSpendSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendScheduleId", wireType)
+			}
+			m.SpendScheduleId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpendScheduleId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecipientAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpentCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpentCoins = append(m.SpentCoins, types.Coin{})
+			if err := m.SpentCoins[len(m.SpentCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumExecutions", wireType)
+			}
+			m.NumExecutions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumExecutions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventTerminateSpendSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventTerminateSpendSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventTerminateSpendSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendScheduleId", wireType)
+			}
+			m.SpendScheduleId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpendScheduleId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -10,22 +10,25 @@ import (
 func NewGenesisState(
 	params Params, lastBlockTime *time.Time, lastPlanId, numPrivatePlans uint64,
 	plans []Plan, farms []FarmRecord, positions []Position, hists []HistoricalRewardsRecord,
+	lastSpendScheduleId uint64, spendSchedules []SpendSchedule,
 ) *GenesisState {
 	return &GenesisState{
-		Params:            params,
-		LastBlockTime:     lastBlockTime,
-		LastPlanId:        lastPlanId,
-		NumPrivatePlans:   numPrivatePlans,
-		Plans:             plans,
-		Farms:             farms,
-		Positions:         positions,
-		HistoricalRewards: hists,
+		Params:              params,
+		LastBlockTime:       lastBlockTime,
+		LastPlanId:          lastPlanId,
+		NumPrivatePlans:     numPrivatePlans,
+		Plans:               plans,
+		Farms:               farms,
+		Positions:           positions,
+		HistoricalRewards:   hists,
+		LastSpendScheduleId: lastSpendScheduleId,
+		SpendSchedules:      spendSchedules,
 	}
 }
 
 // DefaultGenesis returns the default genesis state for the module.
 func DefaultGenesis() *GenesisState {
-	return NewGenesisState(DefaultParams(), nil, 0, 0, nil, nil, nil, nil)
+	return NewGenesisState(DefaultParams(), nil, 0, 0, nil, nil, nil, nil, 0, nil)
 }
 
 func (genState GenesisState) Validate() error {
@@ -113,5 +116,20 @@ func (genState GenesisState) Validate() error {
 		}
 		histKeySet[key] = struct{}{}
 	}
+	scheduleIdSet := map[uint64]struct{}{}
+	for _, schedule := range genState.SpendSchedules {
+		if err := schedule.Validate(); err != nil {
+			return fmt.Errorf("invalid spend schedule: %w", err)
+		}
+		if schedule.Id > genState.LastSpendScheduleId {
+			return fmt.Errorf(
+				"spend schedule id must not exceed last spend schedule id: %d > %d",
+				schedule.Id, genState.LastSpendScheduleId)
+		}
+		if _, ok := scheduleIdSet[schedule.Id]; ok {
+			return fmt.Errorf("duplicate spend schedule: %d", schedule.Id)
+		}
+		scheduleIdSet[schedule.Id] = struct{}{}
+	}
 	return nil
 }
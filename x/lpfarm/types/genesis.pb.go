@@ -28,14 +28,16 @@ var _ = time.Kitchen
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type GenesisState struct {
-	Params            Params                    `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	LastBlockTime     *time.Time                `protobuf:"bytes,2,opt,name=last_block_time,json=lastBlockTime,proto3,stdtime" json:"last_block_time,omitempty"`
-	LastPlanId        uint64                    `protobuf:"varint,3,opt,name=last_plan_id,json=lastPlanId,proto3" json:"last_plan_id,omitempty"`
-	NumPrivatePlans   uint64                    `protobuf:"varint,4,opt,name=num_private_plans,json=numPrivatePlans,proto3" json:"num_private_plans,omitempty"`
-	Plans             []Plan                    `protobuf:"bytes,5,rep,name=plans,proto3" json:"plans"`
-	Farms             []FarmRecord              `protobuf:"bytes,6,rep,name=farms,proto3" json:"farms"`
-	Positions         []Position                `protobuf:"bytes,7,rep,name=positions,proto3" json:"positions"`
-	HistoricalRewards []HistoricalRewardsRecord `protobuf:"bytes,8,rep,name=historical_rewards,json=historicalRewards,proto3" json:"historical_rewards"`
+	Params              Params                    `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	LastBlockTime       *time.Time                `protobuf:"bytes,2,opt,name=last_block_time,json=lastBlockTime,proto3,stdtime" json:"last_block_time,omitempty"`
+	LastPlanId          uint64                    `protobuf:"varint,3,opt,name=last_plan_id,json=lastPlanId,proto3" json:"last_plan_id,omitempty"`
+	NumPrivatePlans     uint64                    `protobuf:"varint,4,opt,name=num_private_plans,json=numPrivatePlans,proto3" json:"num_private_plans,omitempty"`
+	Plans               []Plan                    `protobuf:"bytes,5,rep,name=plans,proto3" json:"plans"`
+	Farms               []FarmRecord              `protobuf:"bytes,6,rep,name=farms,proto3" json:"farms"`
+	Positions           []Position                `protobuf:"bytes,7,rep,name=positions,proto3" json:"positions"`
+	HistoricalRewards   []HistoricalRewardsRecord `protobuf:"bytes,8,rep,name=historical_rewards,json=historicalRewards,proto3" json:"historical_rewards"`
+	LastSpendScheduleId uint64                    `protobuf:"varint,9,opt,name=last_spend_schedule_id,json=lastSpendScheduleId,proto3" json:"last_spend_schedule_id,omitempty"`
+	SpendSchedules      []SpendSchedule           `protobuf:"bytes,10,rep,name=spend_schedules,json=spendSchedules,proto3" json:"spend_schedules"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -159,39 +161,43 @@ func init() {
 }
 
 var fileDescriptor_bde94e9c4fff4001 = []byte{
-	// 512 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xcf, 0x6e, 0xd3, 0x30,
-	0x1c, 0xc7, 0x13, 0x9a, 0x16, 0xe6, 0x0d, 0x4d, 0xb3, 0x26, 0x16, 0x55, 0x5a, 0x5a, 0x0a, 0x48,
-	0xd5, 0x24, 0x12, 0x36, 0x10, 0x88, 0x03, 0x42, 0xaa, 0x04, 0x8c, 0x5b, 0x15, 0x76, 0x82, 0x43,
-	0xe4, 0x24, 0x5e, 0x6a, 0x2d, 0xb1, 0x23, 0xdb, 0xed, 0xe0, 0x29, 0xd8, 0x63, 0xf0, 0x28, 0x3d,
-	0xee, 0xc8, 0x89, 0x3f, 0xed, 0x8b, 0xa0, 0xd8, 0xce, 0x26, 0x10, 0x46, 0xdc, 0x6a, 0xfb, 0xf3,
-	0xfd, 0xe3, 0x5f, 0x1d, 0xf0, 0x20, 0xe3, 0x58, 0x64, 0x98, 0xca, 0xa8, 0xac, 0x4f, 0x11, 0xaf,
-	0xa2, 0xc5, 0x61, 0x8a, 0x25, 0x3a, 0x8c, 0x0a, 0x4c, 0xb1, 0x20, 0x22, 0xac, 0x39, 0x93, 0x0c,
-	0xee, 0xb5, 0x58, 0xa8, 0xb1, 0xd0, 0x60, 0xfd, 0xdd, 0x82, 0x15, 0x4c, 0x31, 0x51, 0xf3, 0x4b,
-	0xe3, 0xfd, 0xfb, 0x36, 0x57, 0xa3, 0xd6, 0xd4, 0xa0, 0x60, 0xac, 0x28, 0x71, 0xa4, 0x56, 0xe9,
-	0xfc, 0x34, 0x92, 0xa4, 0xc2, 0x42, 0xa2, 0xaa, 0xd6, 0xc0, 0xe8, 0xb3, 0x07, 0xb6, 0xde, 0xe8,
-	0x1e, 0xef, 0x24, 0x92, 0x18, 0xbe, 0x00, 0xbd, 0x1a, 0x71, 0x54, 0x09, 0xdf, 0x1d, 0xba, 0xe3,
-	0xcd, 0xa3, 0x41, 0x68, 0xe9, 0x15, 0x4e, 0x15, 0x36, 0xf1, 0x96, 0xdf, 0x06, 0x4e, 0x6c, 0x44,
-	0xf0, 0x18, 0x6c, 0x97, 0x48, 0xc8, 0x24, 0x2d, 0x59, 0x76, 0x96, 0x34, 0x69, 0xfe, 0x0d, 0xe5,
-	0xd3, 0x0f, 0x75, 0x95, 0xb0, 0xad, 0x12, 0x9e, 0xb4, 0x55, 0x26, 0xde, 0xc5, 0xf7, 0x81, 0x1b,
-	0xdf, 0x6e, 0x84, 0x93, 0x46, 0xd7, 0x9c, 0xc0, 0x21, 0xd8, 0x52, 0x4e, 0x75, 0x89, 0x68, 0x42,
-	0x72, 0xbf, 0x33, 0x74, 0xc7, 0x5e, 0x0c, 0x9a, 0xbd, 0x69, 0x89, 0xe8, 0xdb, 0x1c, 0x1e, 0x80,
-	0x1d, 0x3a, 0xaf, 0x92, 0x9a, 0x93, 0x05, 0x92, 0x58, 0x81, 0xc2, 0xf7, 0x14, 0xb6, 0x4d, 0xe7,
-	0xd5, 0x54, 0xef, 0x37, 0xb0, 0x80, 0xcf, 0x41, 0x57, 0x9f, 0x77, 0x87, 0x9d, 0xf1, 0xe6, 0xd1,
-	0xbe, 0xfd, 0x56, 0x25, 0xa2, 0xe6, 0x4e, 0x5a, 0x01, 0x5f, 0x82, 0x6e, 0x43, 0x08, 0xbf, 0xa7,
-	0xa4, 0xf7, 0xac, 0xd2, 0xd7, 0x88, 0x57, 0x31, 0xce, 0x18, 0xcf, 0x5b, 0x03, 0xa5, 0x83, 0xaf,
-	0xc0, 0x46, 0xcd, 0x04, 0x91, 0x84, 0x51, 0xe1, 0xdf, 0x54, 0x26, 0x77, 0xed, 0xf9, 0x86, 0x34,
-	0x16, 0xd7, 0x4a, 0x88, 0x01, 0x9c, 0x11, 0x21, 0x19, 0x27, 0x19, 0x2a, 0x13, 0x8e, 0xcf, 0x11,
-	0xcf, 0x85, 0x7f, 0x4b, 0xf9, 0x3d, 0xb2, 0xfa, 0x1d, 0x5f, 0x49, 0x62, 0xad, 0xf8, 0xad, 0xe1,
-	0xce, 0xec, 0xcf, 0xe3, 0xd1, 0x07, 0x00, 0xae, 0x2f, 0x02, 0x77, 0x41, 0x37, 0xc7, 0x94, 0x55,
-	0xea, 0x35, 0x6c, 0xc4, 0x7a, 0x01, 0x9f, 0x01, 0xaf, 0x09, 0x31, 0x7f, 0xed, 0xfe, 0x3f, 0x27,
-	0x62, 0x92, 0x94, 0x60, 0xf4, 0xc5, 0x05, 0x7b, 0x96, 0x46, 0x96, 0xa8, 0x3b, 0xa0, 0x57, 0x63,
-	0x4e, 0x58, 0xae, 0xc2, 0xbc, 0xd8, 0xac, 0x60, 0xf2, 0xd7, 0x69, 0x74, 0x54, 0xa1, 0x83, 0xff,
-	0x9f, 0x86, 0x75, 0x0e, 0x93, 0x93, 0xe5, 0xcf, 0xc0, 0x59, 0xae, 0x02, 0xf7, 0x72, 0x15, 0xb8,
-	0x3f, 0x56, 0x81, 0x7b, 0xb1, 0x0e, 0x9c, 0xcb, 0x75, 0xe0, 0x7c, 0x5d, 0x07, 0xce, 0xfb, 0xa7,
-	0x05, 0x91, 0xb3, 0x79, 0x1a, 0x66, 0xac, 0x8a, 0xda, 0xb0, 0x87, 0x14, 0xcb, 0x73, 0xc6, 0xcf,
-	0xae, 0x36, 0xa2, 0xc5, 0x93, 0xe8, 0x63, 0xfb, 0x7d, 0xca, 0x4f, 0x35, 0x16, 0x69, 0x4f, 0x3d,
-	0xff, 0xc7, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0xc4, 0x68, 0x33, 0x3b, 0x15, 0x04, 0x00, 0x00,
+	// 565 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xcb, 0x6e, 0xd3, 0x40,
+	0x14, 0x86, 0x63, 0xea, 0x04, 0x32, 0x2d, 0x44, 0x1d, 0xaa, 0xd6, 0x8a, 0x54, 0x27, 0x84, 0x8b,
+	0xa2, 0x4a, 0xd8, 0xb4, 0x45, 0x20, 0x16, 0x08, 0x29, 0x12, 0xd0, 0xee, 0x22, 0xa7, 0x6c, 0x60,
+	0x61, 0x4d, 0xec, 0xa9, 0x63, 0xd5, 0x9e, 0xb1, 0x66, 0x26, 0x29, 0xbc, 0x45, 0xf7, 0xbc, 0x00,
+	0x8f, 0x92, 0x65, 0x97, 0xac, 0xb8, 0x24, 0x2f, 0x82, 0xe6, 0xe2, 0x96, 0x20, 0x8c, 0xd8, 0x65,
+	0xe6, 0x7c, 0xff, 0x7f, 0x7e, 0x9f, 0xe3, 0x18, 0x3c, 0x8c, 0x18, 0xe6, 0x11, 0x26, 0xc2, 0xcf,
+	0x8a, 0x53, 0xc4, 0x72, 0x7f, 0xb6, 0x3f, 0xc6, 0x02, 0xed, 0xfb, 0x09, 0x26, 0x98, 0xa7, 0xdc,
+	0x2b, 0x18, 0x15, 0x14, 0xee, 0x94, 0x98, 0xa7, 0x31, 0xcf, 0x60, 0xed, 0xad, 0x84, 0x26, 0x54,
+	0x31, 0xbe, 0xfc, 0xa5, 0xf1, 0xf6, 0x83, 0x2a, 0x57, 0xa3, 0xd6, 0x54, 0x27, 0xa1, 0x34, 0xc9,
+	0xb0, 0xaf, 0x4e, 0xe3, 0xe9, 0xa9, 0x2f, 0xd2, 0x1c, 0x73, 0x81, 0xf2, 0x42, 0x03, 0xbd, 0xcf,
+	0x75, 0xb0, 0xf1, 0x56, 0xe7, 0x18, 0x09, 0x24, 0x30, 0x7c, 0x09, 0x1a, 0x05, 0x62, 0x28, 0xe7,
+	0x8e, 0xd5, 0xb5, 0xfa, 0xeb, 0x07, 0x1d, 0xaf, 0x22, 0x97, 0x37, 0x54, 0xd8, 0xc0, 0x9e, 0x7f,
+	0xeb, 0xd4, 0x02, 0x23, 0x82, 0x47, 0xa0, 0x95, 0x21, 0x2e, 0xc2, 0x71, 0x46, 0xa3, 0xb3, 0x50,
+	0x76, 0x73, 0x6e, 0x28, 0x9f, 0xb6, 0xa7, 0xa3, 0x78, 0x65, 0x14, 0xef, 0xa4, 0x8c, 0x32, 0xb0,
+	0x2f, 0xbe, 0x77, 0xac, 0xe0, 0xb6, 0x14, 0x0e, 0xa4, 0x4e, 0x56, 0x60, 0x17, 0x6c, 0x28, 0xa7,
+	0x22, 0x43, 0x24, 0x4c, 0x63, 0x67, 0xad, 0x6b, 0xf5, 0xed, 0x00, 0xc8, 0xbb, 0x61, 0x86, 0xc8,
+	0x71, 0x0c, 0xf7, 0xc0, 0x26, 0x99, 0xe6, 0x61, 0xc1, 0xd2, 0x19, 0x12, 0x58, 0x81, 0xdc, 0xb1,
+	0x15, 0xd6, 0x22, 0xd3, 0x7c, 0xa8, 0xef, 0x25, 0xcc, 0xe1, 0x0b, 0x50, 0xd7, 0xf5, 0x7a, 0x77,
+	0xad, 0xbf, 0x7e, 0xb0, 0x5b, 0xfd, 0x54, 0x19, 0x22, 0xe6, 0x99, 0xb4, 0x02, 0xbe, 0x02, 0x75,
+	0x49, 0x70, 0xa7, 0xa1, 0xa4, 0xf7, 0x2b, 0xa5, 0x6f, 0x10, 0xcb, 0x03, 0x1c, 0x51, 0x16, 0x97,
+	0x06, 0x4a, 0x07, 0x5f, 0x83, 0x66, 0x41, 0x79, 0x2a, 0x52, 0x4a, 0xb8, 0x73, 0x53, 0x99, 0xdc,
+	0xab, 0xee, 0x6f, 0x48, 0x63, 0x71, 0xad, 0x84, 0x18, 0xc0, 0x49, 0xca, 0x05, 0x65, 0x69, 0x84,
+	0xb2, 0x90, 0xe1, 0x73, 0xc4, 0x62, 0xee, 0xdc, 0x52, 0x7e, 0x4f, 0x2a, 0xfd, 0x8e, 0xae, 0x24,
+	0x81, 0x56, 0xac, 0x24, 0xdc, 0x9c, 0xfc, 0x59, 0x86, 0x87, 0x60, 0x5b, 0xcd, 0x9d, 0x17, 0x98,
+	0xc4, 0x21, 0x8f, 0x26, 0x38, 0x9e, 0x66, 0x58, 0x6e, 0xa0, 0xa9, 0x46, 0x7b, 0x57, 0x56, 0x47,
+	0xb2, 0x38, 0x32, 0xb5, 0xe3, 0x18, 0xbe, 0x03, 0xad, 0x55, 0x9e, 0x3b, 0x40, 0x05, 0x7b, 0x54,
+	0x19, 0x6c, 0xc5, 0xc2, 0xc4, 0xb9, 0xc3, 0x7f, 0xbf, 0xe4, 0xbd, 0x0f, 0x00, 0x5c, 0x0f, 0x15,
+	0x6e, 0x81, 0x7a, 0x8c, 0x09, 0xcd, 0xd5, 0x9b, 0xd9, 0x0c, 0xf4, 0x01, 0x3e, 0x07, 0xb6, 0xf4,
+	0x35, 0xaf, 0xd9, 0xee, 0x3f, 0xb7, 0x63, 0xda, 0x28, 0x41, 0xef, 0x8b, 0x05, 0x76, 0x2a, 0xa6,
+	0x53, 0xd1, 0x6a, 0x1b, 0x34, 0x0a, 0xcc, 0x52, 0x1a, 0xab, 0x66, 0x76, 0x60, 0x4e, 0x30, 0xfc,
+	0xeb, 0x66, 0xd6, 0x54, 0xa0, 0xbd, 0xff, 0xdf, 0x4c, 0xe5, 0x4e, 0x06, 0x27, 0xf3, 0x9f, 0x6e,
+	0x6d, 0xbe, 0x70, 0xad, 0xcb, 0x85, 0x6b, 0xfd, 0x58, 0xb8, 0xd6, 0xc5, 0xd2, 0xad, 0x5d, 0x2e,
+	0xdd, 0xda, 0xd7, 0xa5, 0x5b, 0x7b, 0xff, 0x2c, 0x49, 0xc5, 0x64, 0x3a, 0xf6, 0x22, 0x9a, 0xfb,
+	0x65, 0xb3, 0xc7, 0x04, 0x8b, 0x73, 0xca, 0xce, 0xae, 0x2e, 0xfc, 0xd9, 0x53, 0xff, 0x63, 0xf9,
+	0xad, 0x10, 0x9f, 0x0a, 0xcc, 0xc7, 0x0d, 0xf5, 0x57, 0x3c, 0xfc, 0x35, 0x00, 0x1d, 0x88, 0x27,
+	0x3a, 0xa1, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -214,6 +220,25 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SpendSchedules) > 0 {
+		for iNdEx := len(m.SpendSchedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendSchedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.LastSpendScheduleId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastSpendScheduleId))
+		i--
+		dAtA[i] = 0x48
+	}
 	if len(m.HistoricalRewards) > 0 {
 		for iNdEx := len(m.HistoricalRewards) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -441,6 +466,15 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if m.LastSpendScheduleId != 0 {
+		n += 1 + sovGenesis(uint64(m.LastSpendScheduleId))
+	}
+	if len(m.SpendSchedules) > 0 {
+		for _, e := range m.SpendSchedules {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -755,6 +789,59 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSpendScheduleId", wireType)
+			}
+			m.LastSpendScheduleId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastSpendScheduleId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendSchedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendSchedules = append(m.SpendSchedules, SpendSchedule{})
+			if err := m.SpendSchedules[len(m.SpendSchedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
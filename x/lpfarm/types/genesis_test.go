@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
@@ -37,6 +38,11 @@ func TestGenesisState_Validate(t *testing.T) {
 		CumulativeUnitRewards: utils.ParseDecCoins("5.5stake"),
 		ReferenceCount:        1,
 	}
+	validSchedule := types.NewSpendSchedule(
+		1, "Spend Schedule", utils.TestAddress(3), utils.TestAddress(4),
+		utils.ParseCoins("100_000000stake"), 24*time.Hour,
+		utils.ParseTime("2022-01-01T00:00:00Z"),
+		utils.ParseTime("2023-01-01T00:00:00Z"))
 
 	for _, tc := range []struct {
 		name        string
@@ -226,6 +232,31 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			"duplicate historical rewards: pool1, 1",
 		},
+		{
+			"invalid spend schedule",
+			func(genState *types.GenesisState) {
+				schedule := validSchedule
+				schedule.Period = 0
+				genState.LastSpendScheduleId = 1
+				genState.SpendSchedules = []types.SpendSchedule{schedule}
+			},
+			"invalid spend schedule: period must be positive: 0s",
+		},
+		{
+			"spend schedule id exceeding last spend schedule id",
+			func(genState *types.GenesisState) {
+				genState.SpendSchedules = []types.SpendSchedule{validSchedule}
+			},
+			"spend schedule id must not exceed last spend schedule id: 1 > 0",
+		},
+		{
+			"duplicate spend schedule",
+			func(genState *types.GenesisState) {
+				genState.LastSpendScheduleId = 1
+				genState.SpendSchedules = []types.SpendSchedule{validSchedule, validSchedule}
+			},
+			"duplicate spend schedule: 1",
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			lastBlockTime := utils.ParseTime("2022-01-01T00:00:00Z")
@@ -31,12 +31,18 @@ var (
 	FarmKeyPrefix              = []byte{0xd4}
 	PositionKeyPrefix          = []byte{0xd5}
 	HistoricalRewardsKeyPrefix = []byte{0xd6}
+	LastSpendScheduleIdKey     = []byte{0xd7}
+	SpendScheduleKeyPrefix     = []byte{0xd8}
 )
 
 func GetPlanKey(id uint64) []byte {
 	return append(PlanKeyPrefix, sdk.Uint64ToBigEndian(id)...)
 }
 
+func GetSpendScheduleKey(id uint64) []byte {
+	return append(SpendScheduleKeyPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
 func GetFarmKey(denom string) []byte {
 	return append(FarmKeyPrefix, denom...)
 }
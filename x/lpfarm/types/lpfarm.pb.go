@@ -274,6 +274,58 @@ func (m *HistoricalRewards) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_HistoricalRewards proto.InternalMessageInfo
 
+// SpendSchedule defines a schedule of periodic, rate-limited transfers from
+// the community pool into an incentive reserve, which is pre-approved by
+// governance.
+type SpendSchedule struct {
+	Id          uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// authority is the address allowed to execute the schedule
+	Authority string `protobuf:"bytes,3,opt,name=authority,proto3" json:"authority,omitempty"`
+	// recipient_address is the address of the incentive reserve, e.g. the
+	// farming pool of a plan or the market maker incentive budget
+	RecipientAddress string                                   `protobuf:"bytes,4,opt,name=recipient_address,json=recipientAddress,proto3" json:"recipient_address,omitempty"`
+	AmountPerPeriod  github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=amount_per_period,json=amountPerPeriod,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount_per_period"`
+	Period           time.Duration                            `protobuf:"bytes,6,opt,name=period,proto3,stdduration" json:"period"`
+	StartTime        time.Time                                `protobuf:"bytes,7,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	EndTime          time.Time                                `protobuf:"bytes,8,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+	// num_executions is the number of periods executed so far
+	NumExecutions uint64 `protobuf:"varint,9,opt,name=num_executions,json=numExecutions,proto3" json:"num_executions,omitempty"`
+}
+
+func (m *SpendSchedule) Reset()         { *m = SpendSchedule{} }
+func (m *SpendSchedule) String() string { return proto.CompactTextString(m) }
+func (*SpendSchedule) ProtoMessage()    {}
+func (*SpendSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a35ee56b16793e84, []int{6}
+}
+func (m *SpendSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpendSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpendSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SpendSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpendSchedule.Merge(m, src)
+}
+func (m *SpendSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpendSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpendSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpendSchedule proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Params)(nil), "crescent.lpfarm.v1beta1.Params")
 	proto.RegisterType((*Plan)(nil), "crescent.lpfarm.v1beta1.Plan")
@@ -281,6 +333,7 @@ func init() {
 	proto.RegisterType((*Farm)(nil), "crescent.lpfarm.v1beta1.Farm")
 	proto.RegisterType((*Position)(nil), "crescent.lpfarm.v1beta1.Position")
 	proto.RegisterType((*HistoricalRewards)(nil), "crescent.lpfarm.v1beta1.HistoricalRewards")
+	proto.RegisterType((*SpendSchedule)(nil), "crescent.lpfarm.v1beta1.SpendSchedule")
 }
 
 func init() {
@@ -288,68 +341,74 @@ func init() {
 }
 
 var fileDescriptor_a35ee56b16793e84 = []byte{
-	// 962 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4d, 0x6f, 0x1b, 0x45,
-	0x18, 0xce, 0xda, 0x8e, 0x13, 0x4f, 0x6b, 0xa7, 0x9d, 0x7c, 0x6d, 0x23, 0xb0, 0x2d, 0x83, 0xa8,
-	0x01, 0x75, 0xb7, 0x69, 0x11, 0x57, 0x14, 0x3b, 0x8a, 0xda, 0x0b, 0x72, 0x97, 0xf4, 0xc2, 0x81,
-	0x61, 0xbc, 0xfb, 0xda, 0x19, 0x65, 0x77, 0x67, 0x35, 0x33, 0xeb, 0x26, 0x1c, 0x39, 0x20, 0x71,
-	0xeb, 0x91, 0xdf, 0xc0, 0x0f, 0xe0, 0xc4, 0x15, 0x29, 0xc7, 0x1e, 0x38, 0x20, 0x0e, 0x2d, 0x24,
-	0x57, 0x7e, 0x04, 0x9a, 0xd9, 0x1d, 0xc7, 0x0d, 0x20, 0x05, 0x44, 0x4f, 0xc9, 0xbc, 0x9f, 0xcf,
-	0xfb, 0xbc, 0xcf, 0xcc, 0x1a, 0xbd, 0x1b, 0x0a, 0x90, 0x21, 0xa4, 0xca, 0x8f, 0xb3, 0x09, 0x15,
-	0x89, 0x3f, 0xdb, 0x1d, 0x83, 0xa2, 0xbb, 0xe5, 0xd1, 0xcb, 0x04, 0x57, 0x1c, 0x6f, 0xdb, 0x28,
-	0xaf, 0x34, 0x97, 0x51, 0x3b, 0x1b, 0x53, 0x3e, 0xe5, 0x26, 0xc6, 0xd7, 0xff, 0x15, 0xe1, 0x3b,
-	0xed, 0x90, 0xcb, 0x84, 0x4b, 0x7f, 0x4c, 0x25, 0xcc, 0x0b, 0x86, 0x9c, 0xa5, 0xa5, 0xbf, 0x33,
-	0xe5, 0x7c, 0x1a, 0x83, 0x6f, 0x4e, 0xe3, 0x7c, 0xe2, 0x2b, 0x96, 0x80, 0x54, 0x34, 0xc9, 0x6c,
-	0x81, 0xab, 0x01, 0x51, 0x2e, 0xa8, 0x62, 0xbc, 0x2c, 0xd0, 0xfb, 0xb1, 0x82, 0xea, 0x23, 0x2a,
-	0x68, 0x22, 0xf1, 0x37, 0x0e, 0xba, 0x93, 0x09, 0x36, 0xa3, 0x0a, 0x48, 0x16, 0xd3, 0x94, 0x84,
-	0x02, 0x4c, 0x28, 0x99, 0x00, 0xb8, 0x4e, 0xb7, 0xda, 0xbf, 0xf1, 0xe0, 0x8e, 0x57, 0x00, 0xf2,
-	0x34, 0x20, 0x8b, 0xdd, 0x1b, 0x72, 0x96, 0x0e, 0xee, 0x9f, 0xbd, 0xec, 0x2c, 0x7d, 0xff, 0xaa,
-	0xd3, 0x9f, 0x32, 0x75, 0x94, 0x8f, 0xbd, 0x90, 0x27, 0x7e, 0x89, 0xbe, 0xf8, 0x73, 0x4f, 0x46,
-	0xc7, 0xbe, 0x3a, 0xcd, 0x40, 0x9a, 0x04, 0x19, 0x6c, 0x95, 0xdd, 0x46, 0x31, 0x4d, 0x87, 0x65,
-	0xaf, 0x03, 0x00, 0xfc, 0x0e, 0x6a, 0x4e, 0x00, 0x48, 0xc8, 0xe3, 0x18, 0x42, 0xc5, 0x85, 0x5b,
-	0xe9, 0x3a, 0xfd, 0x46, 0x70, 0x73, 0x02, 0x30, 0xb4, 0x36, 0xbc, 0x8b, 0x36, 0x13, 0x7a, 0x42,
-	0xd2, 0x3c, 0x21, 0x8b, 0xa0, 0xa5, 0x5b, 0xed, 0x3a, 0xfd, 0x66, 0x80, 0x13, 0x7a, 0xf2, 0x69,
-	0x9e, 0x8c, 0x2e, 0x3b, 0x48, 0xfc, 0x04, 0x69, 0x2b, 0x19, 0xc7, 0x3c, 0x3c, 0x26, 0x96, 0x07,
-	0xb7, 0xd6, 0x75, 0xcc, 0x60, 0x05, 0x51, 0x9e, 0x25, 0xca, 0xdb, 0x2f, 0x03, 0x06, 0xab, 0x7a,
-	0xb0, 0xef, 0x5e, 0x75, 0x9c, 0xe0, 0x56, 0x42, 0x4f, 0x06, 0x3a, 0xdb, 0xfa, 0x7a, 0x3f, 0x55,
-	0x51, 0x4d, 0x17, 0xc7, 0x2d, 0x54, 0x61, 0x91, 0xeb, 0x74, 0x9d, 0x7e, 0x2d, 0xa8, 0xb0, 0x08,
-	0x77, 0xd1, 0x8d, 0x08, 0x64, 0x28, 0x58, 0x66, 0x9a, 0x14, 0x13, 0x2c, 0x9a, 0xf0, 0x7d, 0xb4,
-	0xa1, 0x05, 0xc0, 0xd2, 0x29, 0xc9, 0x38, 0x8f, 0x09, 0x8d, 0x22, 0x01, 0xb2, 0xc0, 0xdf, 0x08,
-	0x70, 0xe9, 0x1b, 0x71, 0x1e, 0xef, 0x15, 0x1e, 0xec, 0xa3, 0x75, 0x05, 0xda, 0x5a, 0x6c, 0xc5,
-	0x26, 0xd4, 0x8a, 0x84, 0x05, 0x97, 0x4d, 0xf8, 0x02, 0x61, 0x01, 0xcf, 0xa8, 0x88, 0x08, 0x8d,
-	0x63, 0x1e, 0x1a, 0x9f, 0x74, 0x97, 0xcd, 0x26, 0xdf, 0xf7, 0xfe, 0x41, 0x89, 0x5e, 0x60, 0x52,
-	0xf6, 0xe6, 0x19, 0x83, 0x9a, 0x26, 0x20, 0xb8, 0x2d, 0xae, 0xd8, 0x25, 0x1e, 0x22, 0x24, 0x15,
-	0x15, 0x8a, 0x68, 0xd5, 0xb9, 0x75, 0x43, 0xe4, 0xce, 0x5f, 0x88, 0x3c, 0xb4, 0x92, 0x2c, 0x98,
-	0x7c, 0xae, 0x99, 0x6c, 0x98, 0x3c, 0xed, 0xc1, 0x9f, 0xa0, 0x55, 0x48, 0xa3, 0xa2, 0xc4, 0xca,
-	0xbf, 0x28, 0xb1, 0x02, 0x69, 0x64, 0x0a, 0xbc, 0x8d, 0x10, 0x93, 0x56, 0x04, 0xee, 0x6a, 0xd7,
-	0xe9, 0xaf, 0x06, 0x0d, 0x26, 0xcb, 0xd5, 0x6b, 0x35, 0x31, 0x49, 0x2c, 0x3b, 0x10, 0xb9, 0x0d,
-	0x13, 0x71, 0x93, 0xc9, 0xc3, 0xb9, 0xad, 0xf7, 0x83, 0x83, 0x6e, 0x5d, 0x9d, 0x1b, 0x6f, 0xa0,
-	0xe5, 0x08, 0x52, 0x9e, 0x98, 0xb5, 0x36, 0x82, 0xe2, 0x80, 0xb7, 0xd1, 0x4a, 0x46, 0x99, 0x20,
-	0x2c, 0x32, 0x5b, 0xad, 0x05, 0x75, 0x7d, 0x7c, 0x1c, 0x61, 0x89, 0xd6, 0x0a, 0x8a, 0x24, 0xc9,
-	0x40, 0x90, 0x88, 0x9e, 0xba, 0xd5, 0xff, 0xff, 0xd2, 0x34, 0xcb, 0x1e, 0x23, 0x10, 0xfb, 0xf4,
-	0xb4, 0xf7, 0x73, 0x15, 0xd5, 0x0e, 0xa8, 0x48, 0xf0, 0x97, 0x68, 0x43, 0x71, 0x45, 0x63, 0x62,
-	0x45, 0x45, 0x13, 0x9e, 0xa7, 0xaa, 0xc0, 0x3e, 0xf0, 0x74, 0x9f, 0x5f, 0x5f, 0x76, 0xde, 0xbb,
-	0x46, 0x9f, 0xc7, 0xa9, 0x0a, 0xb0, 0xa9, 0x75, 0x50, 0x94, 0xda, 0x33, 0x95, 0xf0, 0x57, 0x68,
-	0x2d, 0xcc, 0x85, 0x80, 0x54, 0x91, 0x12, 0x83, 0x5b, 0x31, 0xf3, 0xbd, 0xf5, 0xb7, 0xf3, 0xed,
-	0x43, 0x68, 0x46, 0x7c, 0x58, 0x8e, 0xf8, 0xe1, 0x35, 0x5a, 0x97, 0x39, 0x32, 0x68, 0x95, 0x9d,
-	0x8a, 0x9d, 0x48, 0xfc, 0xb5, 0x83, 0xd6, 0x79, 0xae, 0xa4, 0xa2, 0x69, 0xa4, 0x87, 0xb3, 0x00,
-	0xaa, 0x6f, 0x0a, 0x00, 0x5e, 0xe8, 0x66, 0x41, 0x6c, 0xa1, 0x7a, 0x06, 0x82, 0xf1, 0xc8, 0x5c,
-	0x39, 0xbd, 0x78, 0x73, 0xc2, 0x4f, 0x50, 0x2b, 0x13, 0x30, 0x63, 0x3c, 0x97, 0x44, 0x1e, 0x51,
-	0x01, 0xee, 0xb2, 0x21, 0xfd, 0x83, 0x6b, 0x12, 0xbe, 0x0f, 0x61, 0xd0, 0xb4, 0x15, 0x3e, 0xd3,
-	0x05, 0x7a, 0x7f, 0x38, 0x68, 0x75, 0xc4, 0x25, 0x33, 0x3a, 0xdc, 0x42, 0x75, 0xbd, 0x54, 0x10,
-	0xa5, 0x10, 0xcb, 0xd3, 0xa5, 0x3e, 0x2b, 0x8b, 0xfa, 0x7c, 0x8a, 0x5a, 0x57, 0x24, 0x50, 0xfd,
-	0x4f, 0x12, 0x68, 0x4e, 0x5e, 0xdb, 0xfe, 0x5d, 0xb4, 0x36, 0x1f, 0xf2, 0x35, 0x16, 0xe6, 0xb3,
-	0x8f, 0x0a, 0x36, 0x1e, 0xa0, 0x4d, 0x73, 0xb9, 0x35, 0x80, 0xe2, 0xa9, 0x3d, 0x02, 0x36, 0x3d,
-	0x52, 0x86, 0x94, 0x6a, 0xb0, 0x6e, 0x9d, 0xe6, 0x21, 0x7d, 0x64, 0x5c, 0xbd, 0x33, 0x07, 0xdd,
-	0x7e, 0xc4, 0xa4, 0xe2, 0x82, 0x85, 0x34, 0xb6, 0x7c, 0x7f, 0xeb, 0xa0, 0xed, 0x30, 0x4f, 0xf2,
-	0x98, 0x2a, 0x36, 0x03, 0x92, 0xa7, 0xec, 0x52, 0x79, 0xce, 0x9b, 0x5a, 0xfc, 0xe6, 0x65, 0xc7,
-	0xa7, 0x29, 0x9b, 0x0b, 0xf0, 0xae, 0xbe, 0xdc, 0x13, 0x10, 0x90, 0x86, 0xfa, 0xcb, 0xa4, 0x69,
-	0xad, 0x98, 0x0f, 0x4d, 0x6b, 0x6e, 0x1e, 0x6a, 0xeb, 0xe0, 0xf0, 0xec, 0xf7, 0xf6, 0xd2, 0xd9,
-	0x79, 0xdb, 0x79, 0x71, 0xde, 0x76, 0x7e, 0x3b, 0x6f, 0x3b, 0xcf, 0x2f, 0xda, 0x4b, 0x2f, 0x2e,
-	0xda, 0x4b, 0xbf, 0x5c, 0xb4, 0x97, 0x3e, 0xff, 0x78, 0x11, 0x4a, 0xf9, 0xfe, 0xde, 0x4b, 0x41,
-	0x3d, 0xe3, 0xe2, 0x78, 0x6e, 0xf0, 0x67, 0x1f, 0xf9, 0x27, 0xf6, 0x57, 0x84, 0x81, 0x37, 0xae,
-	0x9b, 0xa7, 0xf0, 0xe1, 0x9f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x49, 0x26, 0x39, 0xd2, 0x65, 0x08,
-	0x00, 0x00,
+	// 1071 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0xda, 0x8e, 0x63, 0x4f, 0x6b, 0xa7, 0x99, 0x7c, 0x6d, 0xa3, 0xe2, 0x58, 0xe6, 0xa3,
+	0x86, 0xaa, 0xde, 0xa6, 0x45, 0x5c, 0x38, 0xa0, 0x38, 0x21, 0x6a, 0x2f, 0xc8, 0xdd, 0xa4, 0x17,
+	0x0e, 0x2c, 0xe3, 0xdd, 0x67, 0x7b, 0x94, 0xdd, 0x9d, 0xd5, 0xcc, 0x6c, 0x3e, 0x38, 0x72, 0x40,
+	0xe2, 0xd6, 0x23, 0x7f, 0x03, 0x7f, 0x00, 0x27, 0xae, 0x48, 0x39, 0xf6, 0xc0, 0x01, 0x71, 0x68,
+	0x21, 0xb9, 0x22, 0xf1, 0x2f, 0xa0, 0x99, 0x9d, 0xdd, 0x38, 0x01, 0xa4, 0x10, 0xda, 0x93, 0x3d,
+	0xef, 0xfb, 0xfd, 0xde, 0x6f, 0xe6, 0x2d, 0x7a, 0xc7, 0xe7, 0x20, 0x7c, 0x88, 0xa5, 0x13, 0x26,
+	0x23, 0xc2, 0x23, 0xe7, 0x60, 0x63, 0x08, 0x92, 0x6c, 0x98, 0x63, 0x2f, 0xe1, 0x4c, 0x32, 0xbc,
+	0x9a, 0x5b, 0xf5, 0x8c, 0xd8, 0x58, 0xad, 0x2d, 0x8d, 0xd9, 0x98, 0x69, 0x1b, 0x47, 0xfd, 0xcb,
+	0xcc, 0xd7, 0x5a, 0x3e, 0x13, 0x11, 0x13, 0xce, 0x90, 0x08, 0x28, 0x02, 0xfa, 0x8c, 0xc6, 0x46,
+	0xbf, 0x3e, 0x66, 0x6c, 0x1c, 0x82, 0xa3, 0x4f, 0xc3, 0x74, 0xe4, 0x48, 0x1a, 0x81, 0x90, 0x24,
+	0x4a, 0xf2, 0x00, 0x97, 0x0d, 0x82, 0x94, 0x13, 0x49, 0x99, 0x09, 0xd0, 0xf9, 0xb1, 0x84, 0xaa,
+	0x03, 0xc2, 0x49, 0x24, 0xf0, 0x37, 0x16, 0xba, 0x9d, 0x70, 0x7a, 0x40, 0x24, 0x78, 0x49, 0x48,
+	0x62, 0xcf, 0xe7, 0xa0, 0x4d, 0xbd, 0x11, 0x80, 0x6d, 0xb5, 0xcb, 0xdd, 0x1b, 0x0f, 0x6f, 0xf7,
+	0xb2, 0x82, 0x7a, 0xaa, 0xa0, 0xbc, 0xf6, 0xde, 0x16, 0xa3, 0x71, 0xff, 0xc1, 0xc9, 0xcb, 0xf5,
+	0x99, 0xef, 0x5f, 0xad, 0x77, 0xc7, 0x54, 0x4e, 0xd2, 0x61, 0xcf, 0x67, 0x91, 0x63, 0xaa, 0xcf,
+	0x7e, 0xee, 0x8b, 0x60, 0xdf, 0x91, 0xc7, 0x09, 0x08, 0xed, 0x20, 0xdc, 0x15, 0x93, 0x6d, 0x10,
+	0x92, 0x78, 0xcb, 0xe4, 0xda, 0x01, 0xc0, 0x6f, 0xa3, 0xc6, 0x08, 0xc0, 0xf3, 0x59, 0x18, 0x82,
+	0x2f, 0x19, 0xb7, 0x4b, 0x6d, 0xab, 0x5b, 0x77, 0x6f, 0x8e, 0x00, 0xb6, 0x72, 0x19, 0xde, 0x40,
+	0xcb, 0x11, 0x39, 0xf2, 0xe2, 0x34, 0xf2, 0xa6, 0x8b, 0x16, 0x76, 0xb9, 0x6d, 0x75, 0x1b, 0x2e,
+	0x8e, 0xc8, 0xd1, 0x67, 0x69, 0x34, 0x38, 0xcf, 0x20, 0xf0, 0x53, 0xa4, 0xa4, 0xde, 0x30, 0x64,
+	0xfe, 0xbe, 0x97, 0xe3, 0x60, 0x57, 0xda, 0x96, 0x6e, 0x2c, 0x03, 0xaa, 0x97, 0x03, 0xd5, 0xdb,
+	0x36, 0x06, 0xfd, 0x9a, 0x6a, 0xec, 0xbb, 0x57, 0xeb, 0x96, 0x7b, 0x2b, 0x22, 0x47, 0x7d, 0xe5,
+	0x9d, 0xeb, 0x3a, 0x3f, 0x95, 0x51, 0x45, 0x05, 0xc7, 0x4d, 0x54, 0xa2, 0x81, 0x6d, 0xb5, 0xad,
+	0x6e, 0xc5, 0x2d, 0xd1, 0x00, 0xb7, 0xd1, 0x8d, 0x00, 0x84, 0xcf, 0x69, 0xa2, 0x93, 0x64, 0x1d,
+	0x4c, 0x8b, 0xf0, 0x03, 0xb4, 0xa4, 0x08, 0x40, 0xe3, 0xb1, 0x97, 0x30, 0x16, 0x7a, 0x24, 0x08,
+	0x38, 0x88, 0xac, 0xfe, 0xba, 0x8b, 0x8d, 0x6e, 0xc0, 0x58, 0xb8, 0x99, 0x69, 0xb0, 0x83, 0x16,
+	0x25, 0x28, 0x69, 0x36, 0x95, 0xdc, 0xa1, 0x92, 0x39, 0x4c, 0xa9, 0x72, 0x87, 0x2f, 0x10, 0xe6,
+	0x70, 0x48, 0x78, 0xe0, 0x91, 0x30, 0x64, 0xbe, 0xd6, 0x09, 0x7b, 0x56, 0x4f, 0xf2, 0xfd, 0xde,
+	0xbf, 0x30, 0xb1, 0xe7, 0x6a, 0x97, 0xcd, 0xc2, 0xa3, 0x5f, 0x51, 0x00, 0xb8, 0x0b, 0xfc, 0x92,
+	0x5c, 0xe0, 0x2d, 0x84, 0x84, 0x24, 0x5c, 0x7a, 0x8a, 0x75, 0x76, 0x55, 0x03, 0xb9, 0xf6, 0x37,
+	0x20, 0xf7, 0x72, 0x4a, 0x66, 0x48, 0x3e, 0x57, 0x48, 0xd6, 0xb5, 0x9f, 0xd2, 0xe0, 0x4f, 0x50,
+	0x0d, 0xe2, 0x20, 0x0b, 0x31, 0xf7, 0x1f, 0x42, 0xcc, 0x41, 0x1c, 0xe8, 0x00, 0x6f, 0x21, 0x44,
+	0x45, 0x4e, 0x02, 0xbb, 0xd6, 0xb6, 0xba, 0x35, 0xb7, 0x4e, 0x85, 0x19, 0xbd, 0x62, 0x13, 0x15,
+	0x5e, 0x8e, 0x0e, 0x04, 0x76, 0x5d, 0x5b, 0xdc, 0xa4, 0x62, 0xaf, 0x90, 0x75, 0x7e, 0xb0, 0xd0,
+	0xad, 0xcb, 0x7d, 0xe3, 0x25, 0x34, 0x1b, 0x40, 0xcc, 0x22, 0x3d, 0xd6, 0xba, 0x9b, 0x1d, 0xf0,
+	0x2a, 0x9a, 0x4b, 0x08, 0xe5, 0x1e, 0x0d, 0xf4, 0x54, 0x2b, 0x6e, 0x55, 0x1d, 0x9f, 0x04, 0x58,
+	0xa0, 0xf9, 0x0c, 0x22, 0xe1, 0x25, 0xc0, 0xbd, 0x80, 0x1c, 0xdb, 0xe5, 0xd7, 0x7f, 0x69, 0x1a,
+	0x26, 0xc7, 0x00, 0xf8, 0x36, 0x39, 0xee, 0xfc, 0x5c, 0x46, 0x95, 0x1d, 0xc2, 0x23, 0xfc, 0x25,
+	0x5a, 0x92, 0x4c, 0x92, 0xd0, 0xcb, 0x49, 0x45, 0x22, 0x96, 0xc6, 0x32, 0xab, 0xbd, 0xdf, 0x53,
+	0x79, 0x7e, 0x7d, 0xb9, 0xfe, 0xde, 0x15, 0xf2, 0x3c, 0x89, 0xa5, 0x8b, 0x75, 0xac, 0x9d, 0x2c,
+	0xd4, 0xa6, 0x8e, 0x84, 0xbf, 0x42, 0xf3, 0x7e, 0xca, 0x39, 0xc4, 0xd2, 0x33, 0x35, 0xd8, 0x25,
+	0xdd, 0xdf, 0x9d, 0x7f, 0xec, 0x6f, 0x1b, 0x7c, 0xdd, 0xe2, 0x23, 0xd3, 0xe2, 0xbd, 0x2b, 0xa4,
+	0x36, 0x3e, 0xc2, 0x6d, 0x9a, 0x4c, 0xd9, 0x4c, 0x04, 0xfe, 0xda, 0x42, 0x8b, 0x2c, 0x95, 0x42,
+	0x92, 0x38, 0x50, 0xcd, 0xe5, 0x05, 0x94, 0xdf, 0x54, 0x01, 0x78, 0x2a, 0x5b, 0x5e, 0xc4, 0x0a,
+	0xaa, 0x26, 0xc0, 0x29, 0x0b, 0xec, 0x8a, 0x19, 0xbc, 0x3e, 0xe1, 0xa7, 0xa8, 0x99, 0x70, 0x38,
+	0xa0, 0x2c, 0x15, 0x9e, 0x98, 0x10, 0x0e, 0xf6, 0xac, 0x06, 0xfd, 0x83, 0x2b, 0x02, 0xbe, 0x0d,
+	0xbe, 0xdb, 0xc8, 0x23, 0xec, 0xaa, 0x00, 0x9d, 0x3f, 0x2c, 0x54, 0x1b, 0x30, 0x41, 0x35, 0x0f,
+	0x57, 0x50, 0x55, 0x0d, 0x15, 0xb8, 0x21, 0xa2, 0x39, 0x9d, 0xf3, 0xb3, 0x34, 0xcd, 0xcf, 0x67,
+	0xa8, 0x79, 0x89, 0x02, 0xe5, 0x6b, 0x51, 0xa0, 0x31, 0xba, 0x30, 0xfd, 0xbb, 0x68, 0xbe, 0x68,
+	0xf2, 0x02, 0x0a, 0x45, 0xef, 0x83, 0x0c, 0x8d, 0x87, 0x68, 0x59, 0x5f, 0x6e, 0x55, 0x40, 0xf6,
+	0xd4, 0x4e, 0x80, 0x8e, 0x27, 0x52, 0x83, 0x52, 0x76, 0x17, 0x73, 0xa5, 0x7e, 0x48, 0x1f, 0x6b,
+	0x55, 0xe7, 0xc4, 0x42, 0x0b, 0x8f, 0xa9, 0x90, 0x8c, 0x53, 0x9f, 0x84, 0x39, 0xde, 0xdf, 0x5a,
+	0x68, 0xd5, 0x4f, 0xa3, 0x34, 0x24, 0x92, 0x1e, 0x80, 0x97, 0xc6, 0xf4, 0x9c, 0x79, 0xd6, 0x9b,
+	0x1a, 0xfc, 0xf2, 0x79, 0xc6, 0x67, 0x31, 0x2d, 0x08, 0x78, 0x57, 0x5d, 0xee, 0x11, 0x70, 0x88,
+	0x7d, 0xb5, 0x99, 0x14, 0xac, 0x25, 0xbd, 0x68, 0x9a, 0x85, 0x78, 0x4b, 0x49, 0x3b, 0x7f, 0x96,
+	0x51, 0x63, 0x37, 0x81, 0x38, 0xd8, 0xf5, 0x27, 0x10, 0xa4, 0x21, 0x5c, 0x63, 0x35, 0xdc, 0x41,
+	0x75, 0x92, 0xca, 0x09, 0xe3, 0x54, 0x1e, 0x9b, 0x7d, 0x70, 0x2e, 0xc0, 0xf7, 0xd0, 0x02, 0x07,
+	0x9f, 0x26, 0x54, 0xdd, 0xc4, 0x8b, 0x4b, 0xe0, 0x56, 0xa1, 0xc8, 0x57, 0xc0, 0x21, 0x5a, 0xc8,
+	0x58, 0xa0, 0xdf, 0x24, 0x33, 0xb8, 0xd9, 0xd7, 0xff, 0x2c, 0xcd, 0x67, 0x59, 0x06, 0xc0, 0x0d,
+	0x0d, 0x3e, 0x2e, 0x2e, 0x4b, 0xf5, 0xea, 0x0b, 0x36, 0xbf, 0x51, 0x17, 0x17, 0xcb, 0xdc, 0xff,
+	0x5f, 0x2c, 0xb5, 0xeb, 0x2c, 0x96, 0x77, 0x51, 0x53, 0x7d, 0x5e, 0xc0, 0x11, 0xf8, 0x69, 0xb6,
+	0x3a, 0xeb, 0x7a, 0x88, 0x8d, 0x38, 0x8d, 0x3e, 0x2d, 0x84, 0xfd, 0xbd, 0x93, 0xdf, 0x5b, 0x33,
+	0x27, 0xa7, 0x2d, 0xeb, 0xc5, 0x69, 0xcb, 0xfa, 0xed, 0xb4, 0x65, 0x3d, 0x3f, 0x6b, 0xcd, 0xbc,
+	0x38, 0x6b, 0xcd, 0xfc, 0x72, 0xd6, 0x9a, 0xf9, 0xfc, 0xa3, 0x69, 0x08, 0xcd, 0xc6, 0xbd, 0x1f,
+	0x83, 0x3c, 0x64, 0x7c, 0xbf, 0x10, 0x38, 0x07, 0x1f, 0x3a, 0x47, 0xf9, 0x77, 0xa3, 0x86, 0x75,
+	0x58, 0xd5, 0x35, 0x3e, 0xfa, 0x6b, 0x00, 0x8b, 0xfb, 0x31, 0x72, 0x57, 0x0a, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -734,6 +793,98 @@ func (m *HistoricalRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SpendSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpendSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpendSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumExecutions != 0 {
+		i = encodeVarintLpfarm(dAtA, i, uint64(m.NumExecutions))
+		i--
+		dAtA[i] = 0x48
+	}
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintLpfarm(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x42
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintLpfarm(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x3a
+	n6, err6 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Period, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Period):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintLpfarm(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x32
+	if len(m.AmountPerPeriod) > 0 {
+		for iNdEx := len(m.AmountPerPeriod) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AmountPerPeriod[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLpfarm(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.RecipientAddress) > 0 {
+		i -= len(m.RecipientAddress)
+		copy(dAtA[i:], m.RecipientAddress)
+		i = encodeVarintLpfarm(dAtA, i, uint64(len(m.RecipientAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintLpfarm(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintLpfarm(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintLpfarm(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintLpfarm(dAtA []byte, offset int, v uint64) int {
 	offset -= sovLpfarm(v)
 	base := offset
@@ -904,6 +1055,45 @@ func (m *HistoricalRewards) Size() (n int) {
 	return n
 }
 
+func (m *SpendSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovLpfarm(uint64(m.Id))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovLpfarm(uint64(l))
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovLpfarm(uint64(l))
+	}
+	l = len(m.RecipientAddress)
+	if l > 0 {
+		n += 1 + l + sovLpfarm(uint64(l))
+	}
+	if len(m.AmountPerPeriod) > 0 {
+		for _, e := range m.AmountPerPeriod {
+			l = e.Size()
+			n += 1 + l + sovLpfarm(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Period)
+	n += 1 + l + sovLpfarm(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovLpfarm(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovLpfarm(uint64(l))
+	if m.NumExecutions != 0 {
+		n += 1 + sovLpfarm(uint64(m.NumExecutions))
+	}
+	return n
+}
+
 func sovLpfarm(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2014,6 +2204,323 @@ func (m *HistoricalRewards) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SpendSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLpfarm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpendSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpendSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLpfarm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLpfarm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLpfarm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLpfarm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLpfarm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLpfarm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLpfarm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLpfarm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLpfarm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLpfarm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecipientAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountPerPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLpfarm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLpfarm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLpfarm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AmountPerPeriod = append(m.AmountPerPeriod, types.Coin{})
+			if err := m.AmountPerPeriod[len(m.AmountPerPeriod)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLpfarm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLpfarm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLpfarm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Period, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLpfarm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLpfarm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLpfarm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLpfarm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLpfarm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLpfarm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumExecutions", wireType)
+			}
+			m.NumExecutions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLpfarm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumExecutions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLpfarm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLpfarm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipLpfarm(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -12,14 +12,16 @@ var (
 	_ sdk.Msg = (*MsgFarm)(nil)
 	_ sdk.Msg = (*MsgUnfarm)(nil)
 	_ sdk.Msg = (*MsgHarvest)(nil)
+	_ sdk.Msg = (*MsgExecuteSpendSchedule)(nil)
 )
 
 // Message types for the module
 const (
-	TypeMsgCreatePrivatePlan = "create_private_plan"
-	TypeMsgFarm              = "farm"
-	TypeMsgUnfarm            = "unfarm"
-	TypeMsgHarvest           = "harvest"
+	TypeMsgCreatePrivatePlan    = "create_private_plan"
+	TypeMsgFarm                 = "farm"
+	TypeMsgUnfarm               = "unfarm"
+	TypeMsgHarvest              = "harvest"
+	TypeMsgExecuteSpendSchedule = "execute_spend_schedule"
 )
 
 // NewMsgCreatePrivatePlan creates a new MsgCreatePrivatePlan.
@@ -202,3 +204,44 @@ func (msg MsgHarvest) GetFarmerAddress() sdk.AccAddress {
 	}
 	return addr
 }
+
+// NewMsgExecuteSpendSchedule creates a new MsgExecuteSpendSchedule.
+func NewMsgExecuteSpendSchedule(authorityAddr sdk.AccAddress, scheduleId uint64) *MsgExecuteSpendSchedule {
+	return &MsgExecuteSpendSchedule{
+		Authority:       authorityAddr.String(),
+		SpendScheduleId: scheduleId,
+	}
+}
+
+func (msg MsgExecuteSpendSchedule) Route() string { return RouterKey }
+func (msg MsgExecuteSpendSchedule) Type() string  { return TypeMsgExecuteSpendSchedule }
+
+func (msg MsgExecuteSpendSchedule) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgExecuteSpendSchedule) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgExecuteSpendSchedule) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address: %v", err)
+	}
+	if msg.SpendScheduleId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "spend schedule id must not be zero")
+	}
+	return nil
+}
+
+func (msg MsgExecuteSpendSchedule) GetAuthorityAddress() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
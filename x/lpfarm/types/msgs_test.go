@@ -155,3 +155,47 @@ func TestMsgHarvest(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgExecuteSpendSchedule(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		malleate    func(msg *types.MsgExecuteSpendSchedule)
+		expectedErr string // empty means no error
+	}{
+		{
+			"happy case",
+			func(msg *types.MsgExecuteSpendSchedule) {},
+			"",
+		},
+		{
+			"invalid authority",
+			func(msg *types.MsgExecuteSpendSchedule) {
+				msg.Authority = "invalidaddr"
+			},
+			"invalid authority address: decoding bech32 failed: invalid separator index -1: invalid address",
+		},
+		{
+			"zero spend schedule id",
+			func(msg *types.MsgExecuteSpendSchedule) {
+				msg.SpendScheduleId = 0
+			},
+			"spend schedule id must not be zero: invalid request",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := types.NewMsgExecuteSpendSchedule(utils.TestAddress(0), 1)
+			tc.malleate(msg)
+			require.Equal(t, types.TypeMsgExecuteSpendSchedule, msg.Type())
+			require.Equal(t, types.RouterKey, msg.Route())
+			err := msg.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+				signers := msg.GetSigners()
+				require.Len(t, signers, 1)
+				require.Equal(t, msg.GetAuthorityAddress(), signers[0])
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
@@ -10,16 +10,20 @@ import (
 )
 
 const (
-	ProposalTypeFarmingPlan string = "FarmingPlan"
+	ProposalTypeFarmingPlan   string = "FarmingPlan"
+	ProposalTypeSpendSchedule string = "SpendSchedule"
 )
 
 var (
 	_ gov.Content = &FarmingPlanProposal{}
+	_ gov.Content = &SpendScheduleProposal{}
 )
 
 func init() {
 	gov.RegisterProposalType(ProposalTypeFarmingPlan)
 	gov.RegisterProposalTypeCodec(&FarmingPlanProposal{}, "crescent/FarmingPlanProposal")
+	gov.RegisterProposalType(ProposalTypeSpendSchedule)
+	gov.RegisterProposalTypeCodec(&SpendScheduleProposal{}, "crescent/SpendScheduleProposal")
 }
 
 func NewFarmingPlanProposal(
@@ -98,3 +102,86 @@ func (req TerminatePlanRequest) Validate() error {
 	}
 	return nil
 }
+
+func NewSpendScheduleProposal(
+	title, description string,
+	createReqs []CreateSpendScheduleRequest,
+	terminateReqs []TerminateSpendScheduleRequest) *SpendScheduleProposal {
+	return &SpendScheduleProposal{
+		Title:                          title,
+		Description:                    description,
+		CreateSpendScheduleRequests:    createReqs,
+		TerminateSpendScheduleRequests: terminateReqs,
+	}
+}
+
+func (p *SpendScheduleProposal) GetTitle() string       { return p.Title }
+func (p *SpendScheduleProposal) GetDescription() string { return p.Description }
+func (p *SpendScheduleProposal) ProposalRoute() string  { return RouterKey }
+func (p *SpendScheduleProposal) ProposalType() string   { return ProposalTypeSpendSchedule }
+
+func (p *SpendScheduleProposal) ValidateBasic() error {
+	for _, req := range p.CreateSpendScheduleRequests {
+		if err := req.Validate(); err != nil {
+			return err
+		}
+	}
+	for _, req := range p.TerminateSpendScheduleRequests {
+		if err := req.Validate(); err != nil {
+			return err
+		}
+	}
+	return gov.ValidateAbstract(p)
+}
+
+func (p SpendScheduleProposal) String() string {
+	return fmt.Sprintf(`Spend Schedule Proposal:
+  Title:                          %s
+  Description:                    %s
+  CreateSpendScheduleRequests:    %v
+  TerminateSpendScheduleRequests: %v
+`, p.Title, p.Description, p.CreateSpendScheduleRequests, p.TerminateSpendScheduleRequests)
+}
+
+func NewCreateSpendScheduleRequest(
+	description string, authorityAddr, recipientAddr sdk.AccAddress,
+	amtPerPeriod sdk.Coins, period time.Duration, startTime, endTime time.Time) CreateSpendScheduleRequest {
+	return CreateSpendScheduleRequest{
+		Description:      description,
+		Authority:        authorityAddr.String(),
+		RecipientAddress: recipientAddr.String(),
+		AmountPerPeriod:  amtPerPeriod,
+		Period:           period,
+		StartTime:        startTime,
+		EndTime:          endTime,
+	}
+}
+
+func (req CreateSpendScheduleRequest) Validate() error {
+	authorityAddr, err := sdk.AccAddressFromBech32(req.Authority)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address: %v", err)
+	}
+	recipientAddr, err := sdk.AccAddressFromBech32(req.RecipientAddress)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address: %v", err)
+	}
+	dummySchedule := NewSpendSchedule(
+		1, req.Description, authorityAddr, recipientAddr,
+		req.AmountPerPeriod, req.Period, req.StartTime, req.EndTime)
+	if err := dummySchedule.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return nil
+}
+
+func NewTerminateSpendScheduleRequest(scheduleId uint64) TerminateSpendScheduleRequest {
+	return TerminateSpendScheduleRequest{SpendScheduleId: scheduleId}
+}
+
+func (req TerminateSpendScheduleRequest) Validate() error {
+	if req.SpendScheduleId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "spend schedule id must not be zero")
+	}
+	return nil
+}
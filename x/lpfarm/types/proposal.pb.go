@@ -5,9 +5,12 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -144,10 +147,132 @@ func (m *TerminatePlanRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TerminatePlanRequest proto.InternalMessageInfo
 
+type SpendScheduleProposal struct {
+	Title                          string                          `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description                    string                          `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	CreateSpendScheduleRequests    []CreateSpendScheduleRequest    `protobuf:"bytes,3,rep,name=create_spend_schedule_requests,json=createSpendScheduleRequests,proto3" json:"create_spend_schedule_requests"`
+	TerminateSpendScheduleRequests []TerminateSpendScheduleRequest `protobuf:"bytes,4,rep,name=terminate_spend_schedule_requests,json=terminateSpendScheduleRequests,proto3" json:"terminate_spend_schedule_requests"`
+}
+
+func (m *SpendScheduleProposal) Reset()      { *m = SpendScheduleProposal{} }
+func (*SpendScheduleProposal) ProtoMessage() {}
+func (*SpendScheduleProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f08a1ded86706e2d, []int{3}
+}
+func (m *SpendScheduleProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpendScheduleProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpendScheduleProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SpendScheduleProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpendScheduleProposal.Merge(m, src)
+}
+func (m *SpendScheduleProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpendScheduleProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpendScheduleProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpendScheduleProposal proto.InternalMessageInfo
+
+type CreateSpendScheduleRequest struct {
+	Description      string                                   `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+	Authority        string                                   `protobuf:"bytes,2,opt,name=authority,proto3" json:"authority,omitempty"`
+	RecipientAddress string                                   `protobuf:"bytes,3,opt,name=recipient_address,json=recipientAddress,proto3" json:"recipient_address,omitempty"`
+	AmountPerPeriod  github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=amount_per_period,json=amountPerPeriod,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount_per_period"`
+	Period           time.Duration                            `protobuf:"bytes,5,opt,name=period,proto3,stdduration" json:"period"`
+	StartTime        time.Time                                `protobuf:"bytes,6,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	EndTime          time.Time                                `protobuf:"bytes,7,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+}
+
+func (m *CreateSpendScheduleRequest) Reset()         { *m = CreateSpendScheduleRequest{} }
+func (m *CreateSpendScheduleRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateSpendScheduleRequest) ProtoMessage()    {}
+func (*CreateSpendScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f08a1ded86706e2d, []int{4}
+}
+func (m *CreateSpendScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateSpendScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateSpendScheduleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CreateSpendScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateSpendScheduleRequest.Merge(m, src)
+}
+func (m *CreateSpendScheduleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateSpendScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateSpendScheduleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateSpendScheduleRequest proto.InternalMessageInfo
+
+type TerminateSpendScheduleRequest struct {
+	SpendScheduleId uint64 `protobuf:"varint,1,opt,name=spend_schedule_id,json=spendScheduleId,proto3" json:"spend_schedule_id,omitempty"`
+}
+
+func (m *TerminateSpendScheduleRequest) Reset()         { *m = TerminateSpendScheduleRequest{} }
+func (m *TerminateSpendScheduleRequest) String() string { return proto.CompactTextString(m) }
+func (*TerminateSpendScheduleRequest) ProtoMessage()    {}
+func (*TerminateSpendScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f08a1ded86706e2d, []int{5}
+}
+func (m *TerminateSpendScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TerminateSpendScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TerminateSpendScheduleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TerminateSpendScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TerminateSpendScheduleRequest.Merge(m, src)
+}
+func (m *TerminateSpendScheduleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *TerminateSpendScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TerminateSpendScheduleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TerminateSpendScheduleRequest proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*FarmingPlanProposal)(nil), "crescent.lpfarm.v1beta1.FarmingPlanProposal")
 	proto.RegisterType((*CreatePlanRequest)(nil), "crescent.lpfarm.v1beta1.CreatePlanRequest")
 	proto.RegisterType((*TerminatePlanRequest)(nil), "crescent.lpfarm.v1beta1.TerminatePlanRequest")
+	proto.RegisterType((*SpendScheduleProposal)(nil), "crescent.lpfarm.v1beta1.SpendScheduleProposal")
+	proto.RegisterType((*CreateSpendScheduleRequest)(nil), "crescent.lpfarm.v1beta1.CreateSpendScheduleRequest")
+	proto.RegisterType((*TerminateSpendScheduleRequest)(nil), "crescent.lpfarm.v1beta1.TerminateSpendScheduleRequest")
 }
 
 func init() {
@@ -155,38 +280,54 @@ func init() {
 }
 
 var fileDescriptor_f08a1ded86706e2d = []byte{
-	// 488 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xbb, 0x8e, 0xd3, 0x40,
-	0x14, 0xb5, 0xb3, 0xde, 0xd7, 0xa4, 0xda, 0xc1, 0x28, 0x51, 0x0a, 0x27, 0x8a, 0x10, 0x0a, 0x48,
-	0xeb, 0x61, 0x17, 0x44, 0x41, 0x83, 0x36, 0x2b, 0x21, 0xd1, 0x45, 0x56, 0x2a, 0x0a, 0xac, 0xb1,
-	0x7d, 0x63, 0xac, 0x1d, 0x7b, 0xcc, 0xcc, 0x64, 0x17, 0xfe, 0x62, 0x4b, 0x4a, 0x0a, 0x3e, 0x26,
-	0x0d, 0xd2, 0x96, 0x54, 0x3c, 0x92, 0x1f, 0x41, 0x9e, 0xb1, 0x57, 0x28, 0x8f, 0x82, 0xce, 0xf7,
-	0x9e, 0x73, 0xee, 0xe3, 0xcc, 0x35, 0x7a, 0x1c, 0x0b, 0x90, 0x31, 0x14, 0x8a, 0xb0, 0x72, 0x46,
-	0x45, 0x4e, 0xae, 0xcf, 0x22, 0x50, 0xf4, 0x8c, 0x94, 0x82, 0x97, 0x5c, 0x52, 0xe6, 0x97, 0x82,
-	0x2b, 0x8e, 0x3b, 0x0d, 0xcf, 0x37, 0x3c, 0xbf, 0xe6, 0xf5, 0xdc, 0x94, 0xa7, 0x5c, 0x73, 0x48,
-	0xf5, 0x65, 0xe8, 0xbd, 0x47, 0xbb, 0xca, 0xd6, 0x6a, 0xc3, 0xea, 0xa7, 0x9c, 0xa7, 0x0c, 0x88,
-	0x8e, 0xa2, 0xf9, 0x8c, 0xa8, 0x2c, 0x07, 0xa9, 0x68, 0x5e, 0x1a, 0xc2, 0xf0, 0x5b, 0x0b, 0x3d,
-	0x78, 0x43, 0x45, 0x9e, 0x15, 0xe9, 0x84, 0xd1, 0x62, 0x52, 0xcf, 0x84, 0x5d, 0xb4, 0xaf, 0x32,
-	0xc5, 0xa0, 0x6b, 0x0f, 0xec, 0xd1, 0x71, 0x60, 0x02, 0x3c, 0x40, 0xed, 0x04, 0x64, 0x2c, 0xb2,
-	0x52, 0x65, 0xbc, 0xe8, 0xb6, 0x34, 0xf6, 0x6f, 0x0a, 0x47, 0xc8, 0x8d, 0x05, 0x50, 0x05, 0x61,
-	0xc9, 0x68, 0x11, 0x0a, 0xf8, 0x38, 0x07, 0xa9, 0x64, 0x77, 0x6f, 0xb0, 0x37, 0x6a, 0x9f, 0x3f,
-	0xf5, 0x77, 0x2c, 0xe9, 0x5f, 0x6a, 0x51, 0x35, 0x42, 0x60, 0x24, 0x63, 0x67, 0xf1, 0xb3, 0x6f,
-	0x05, 0x38, 0x5e, 0x07, 0x24, 0xbe, 0x42, 0x1d, 0x05, 0xd5, 0xc8, 0x9b, 0x6d, 0x1c, 0xdd, 0xe6,
-	0x74, 0x67, 0x9b, 0x69, 0xa3, 0xdb, 0xec, 0xf4, 0x50, 0x6d, 0xc1, 0xe4, 0x2b, 0xe7, 0xcb, 0xd7,
-	0xbe, 0x35, 0xfc, 0xde, 0x42, 0x27, 0x1b, 0x23, 0xae, 0xdb, 0x61, 0x6f, 0xda, 0xf1, 0x0c, 0xb9,
-	0x33, 0xe3, 0x6e, 0x58, 0x72, 0xce, 0x42, 0x9a, 0x24, 0x02, 0xa4, 0xac, 0x9d, 0xc3, 0x35, 0x36,
-	0xe1, 0x9c, 0x5d, 0x18, 0x04, 0xbf, 0x47, 0x58, 0xc0, 0x0d, 0x15, 0x49, 0x48, 0x19, 0xe3, 0x31,
-	0xad, 0xca, 0x34, 0xf6, 0x3d, 0xd9, 0xb9, 0x57, 0xa0, 0x25, 0x17, 0xf7, 0x8a, 0x7a, 0xa7, 0x13,
-	0xb1, 0x96, 0x97, 0xf8, 0x12, 0x21, 0xa9, 0xa8, 0x50, 0x61, 0x75, 0x09, 0x5d, 0x67, 0x60, 0x8f,
-	0xda, 0xe7, 0x3d, 0xdf, 0x9c, 0x89, 0xdf, 0x9c, 0x89, 0x3f, 0x6d, 0xce, 0x64, 0x7c, 0x54, 0x15,
-	0xba, 0xfd, 0xd5, 0xb7, 0x83, 0x63, 0xad, 0xab, 0x10, 0xfc, 0x1a, 0x1d, 0x41, 0x91, 0x98, 0x12,
-	0xfb, 0xff, 0x51, 0xe2, 0x10, 0x8a, 0xa4, 0xca, 0x0f, 0x09, 0x72, 0xb7, 0x3d, 0x05, 0xee, 0xa0,
-	0x43, 0xfd, 0xa0, 0x59, 0xa2, 0xdd, 0x74, 0x82, 0x83, 0x2a, 0x7c, 0x9b, 0x8c, 0xa7, 0x8b, 0x3f,
-	0x9e, 0xb5, 0x58, 0x7a, 0xf6, 0xdd, 0xd2, 0xb3, 0x7f, 0x2f, 0x3d, 0xfb, 0x76, 0xe5, 0x59, 0x77,
-	0x2b, 0xcf, 0xfa, 0xb1, 0xf2, 0xac, 0x77, 0x2f, 0xd3, 0x4c, 0x7d, 0x98, 0x47, 0x7e, 0xcc, 0x73,
-	0xd2, 0x58, 0x74, 0x5a, 0x80, 0xba, 0xe1, 0xe2, 0xea, 0x3e, 0x41, 0xae, 0x5f, 0x90, 0x4f, 0xcd,
-	0xdf, 0xa2, 0x3e, 0x97, 0x20, 0xa3, 0x03, 0x3d, 0xed, 0xf3, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0x24, 0x96, 0x5b, 0x9b, 0xa4, 0x03, 0x00, 0x00,
+	// 738 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x3b, 0x4f, 0x1b, 0x4b,
+	0x14, 0xf6, 0xda, 0xc6, 0xc0, 0x50, 0x70, 0x3d, 0xd7, 0x08, 0xe3, 0x7b, 0xb3, 0x76, 0xac, 0x28,
+	0x72, 0x88, 0xd8, 0xe5, 0x11, 0x51, 0x24, 0x45, 0x84, 0x89, 0x22, 0xa1, 0x34, 0x96, 0xa1, 0x4a,
+	0x91, 0xd5, 0x78, 0x67, 0x30, 0x2b, 0xd6, 0x3b, 0x9b, 0x99, 0x31, 0x84, 0x26, 0x6d, 0x5a, 0x4a,
+	0xca, 0x14, 0xa9, 0xf2, 0x3f, 0x22, 0xd1, 0x44, 0xa2, 0x4c, 0x15, 0x12, 0xf8, 0x23, 0xd1, 0x3c,
+	0xd6, 0x80, 0xcd, 0x42, 0x12, 0x0a, 0xcb, 0x9e, 0x73, 0xbe, 0xf3, 0xfa, 0xe6, 0x3b, 0x63, 0xf0,
+	0xd0, 0x67, 0x84, 0xfb, 0x24, 0x12, 0x6e, 0x18, 0x6f, 0x23, 0xd6, 0x73, 0xf7, 0x96, 0x3a, 0x44,
+	0xa0, 0x25, 0x37, 0x66, 0x34, 0xa6, 0x1c, 0x85, 0x4e, 0xcc, 0xa8, 0xa0, 0x70, 0x36, 0xc1, 0x39,
+	0x1a, 0xe7, 0x18, 0x5c, 0xa5, 0xd4, 0xa5, 0x5d, 0xaa, 0x30, 0xae, 0xfc, 0xa5, 0xe1, 0x95, 0x07,
+	0x69, 0x69, 0x4d, 0xb4, 0x46, 0xd9, 0x3e, 0xe5, 0x3d, 0xca, 0xdd, 0x0e, 0xe2, 0x64, 0x80, 0xf0,
+	0x69, 0x10, 0x19, 0x7f, 0xb5, 0x4b, 0x69, 0x37, 0x24, 0xae, 0x3a, 0x75, 0xfa, 0xdb, 0xae, 0x08,
+	0x7a, 0x84, 0x0b, 0xd4, 0x8b, 0x93, 0x04, 0xc3, 0x00, 0xdc, 0x67, 0x48, 0x04, 0xd4, 0x24, 0xa8,
+	0x7f, 0xca, 0x82, 0x7f, 0x5f, 0x22, 0xd6, 0x0b, 0xa2, 0x6e, 0x2b, 0x44, 0x51, 0xcb, 0xcc, 0x04,
+	0x4b, 0x60, 0x4c, 0x04, 0x22, 0x24, 0x65, 0xab, 0x66, 0x35, 0x26, 0xdb, 0xfa, 0x00, 0x6b, 0x60,
+	0x0a, 0x13, 0xee, 0xb3, 0x20, 0x96, 0x29, 0xca, 0x59, 0xe5, 0xbb, 0x6c, 0x82, 0x1d, 0x50, 0xf2,
+	0x19, 0x41, 0x82, 0x78, 0x71, 0x88, 0x22, 0x8f, 0x91, 0xb7, 0x7d, 0xc2, 0x05, 0x2f, 0xe7, 0x6a,
+	0xb9, 0xc6, 0xd4, 0xf2, 0xbc, 0x93, 0x42, 0x92, 0xb3, 0xae, 0x82, 0x64, 0x0b, 0x6d, 0x1d, 0xd2,
+	0xcc, 0x1f, 0x7f, 0xaf, 0x66, 0xda, 0xd0, 0x1f, 0x76, 0x70, 0xb8, 0x0b, 0x66, 0x05, 0x91, 0x2d,
+	0x8f, 0x96, 0xc9, 0xab, 0x32, 0x0b, 0xa9, 0x65, 0xb6, 0x92, 0xb8, 0xd1, 0x4a, 0x33, 0xe2, 0x1a,
+	0x1f, 0x7f, 0x9a, 0x3f, 0xfa, 0x58, 0xcd, 0xd4, 0xbf, 0x66, 0x41, 0x71, 0xa4, 0xc5, 0x61, 0x3a,
+	0xac, 0x51, 0x3a, 0x16, 0x41, 0x69, 0x5b, 0xb3, 0xeb, 0xc5, 0x94, 0x86, 0x1e, 0xc2, 0x98, 0x11,
+	0xce, 0x0d, 0x73, 0xd0, 0xf8, 0x5a, 0x94, 0x86, 0x6b, 0xda, 0x03, 0xdf, 0x00, 0xc8, 0xc8, 0x3e,
+	0x62, 0xd8, 0x43, 0x61, 0x48, 0x7d, 0x75, 0x57, 0x09, 0x7d, 0x8f, 0x52, 0xe7, 0x6a, 0xab, 0x90,
+	0xb5, 0x41, 0x84, 0x99, 0xa9, 0xc8, 0x86, 0xec, 0x1c, 0xae, 0x03, 0xc0, 0x05, 0x62, 0xc2, 0x93,
+	0x4a, 0x29, 0xe7, 0x6b, 0x56, 0x63, 0x6a, 0xb9, 0xe2, 0x68, 0x95, 0x38, 0x89, 0x4a, 0x9c, 0xad,
+	0x44, 0x46, 0xcd, 0x09, 0x99, 0xe8, 0xf0, 0xb4, 0x6a, 0xb5, 0x27, 0x55, 0x9c, 0xf4, 0xc0, 0xe7,
+	0x60, 0x82, 0x44, 0x58, 0xa7, 0x18, 0xfb, 0x83, 0x14, 0xe3, 0x24, 0xc2, 0xd2, 0x5e, 0x77, 0x41,
+	0xe9, 0xba, 0xab, 0x80, 0xb3, 0x60, 0x5c, 0x5d, 0x68, 0x80, 0x15, 0x9b, 0xf9, 0x76, 0x41, 0x1e,
+	0x37, 0x70, 0xfd, 0x34, 0x0b, 0x66, 0x36, 0x63, 0x12, 0xe1, 0x4d, 0x7f, 0x87, 0xe0, 0x7e, 0x48,
+	0xee, 0xac, 0xd4, 0xf7, 0xc0, 0x36, 0x4a, 0xe5, 0x32, 0xaf, 0xc7, 0x4d, 0xe2, 0x61, 0xcd, 0xae,
+	0xdc, 0xa2, 0xd9, 0x2b, 0x5d, 0x5d, 0x95, 0xd4, 0x7f, 0x7e, 0x2a, 0x82, 0xc3, 0x0f, 0x16, 0xb8,
+	0x7f, 0x21, 0xe3, 0xb4, 0x1e, 0xb4, 0xa0, 0x57, 0x6f, 0x17, 0xf4, 0x0d, 0x6d, 0xd8, 0xe2, 0x26,
+	0x50, 0x22, 0xf1, 0x2f, 0x39, 0x50, 0x49, 0x9f, 0xe8, 0x37, 0xb4, 0xfe, 0x3f, 0x98, 0x44, 0x7d,
+	0xb1, 0x43, 0x59, 0x20, 0x0e, 0x0c, 0xe1, 0x17, 0x06, 0xf8, 0x18, 0x14, 0x19, 0xf1, 0x83, 0x38,
+	0x20, 0x91, 0x18, 0xac, 0x41, 0x4e, 0xa1, 0xfe, 0x19, 0x38, 0x92, 0x25, 0xd8, 0x07, 0x45, 0xd4,
+	0xa3, 0xfd, 0x48, 0x78, 0x31, 0x61, 0xf2, 0x13, 0x50, 0x6c, 0xa8, 0x98, 0x73, 0xf4, 0x93, 0xe8,
+	0xc8, 0x27, 0xf1, 0xe2, 0x2a, 0x68, 0x10, 0x35, 0x17, 0xe5, 0xb4, 0x9f, 0x4f, 0xab, 0x8d, 0x6e,
+	0x20, 0x76, 0xfa, 0x1d, 0xc7, 0xa7, 0x3d, 0xd7, 0xbc, 0x9f, 0xfa, 0x6b, 0x81, 0xe3, 0x5d, 0x57,
+	0x1c, 0xc4, 0x84, 0xab, 0x00, 0xde, 0x9e, 0xd6, 0x55, 0x5a, 0x84, 0xb5, 0x54, 0x0d, 0xf8, 0x0c,
+	0x14, 0x4c, 0x35, 0x2d, 0xeb, 0xb9, 0x11, 0x59, 0xbf, 0x30, 0xef, 0xa7, 0x56, 0xf5, 0x91, 0x54,
+	0xb5, 0x09, 0x19, 0x5a, 0xad, 0xc2, 0xdd, 0x57, 0x6b, 0xfc, 0x6f, 0x56, 0xeb, 0x15, 0xb8, 0x77,
+	0xa3, 0x28, 0xe0, 0x3c, 0x28, 0x0e, 0xa9, 0x6d, 0xb0, 0x6d, 0xd3, 0xfc, 0x72, 0xc0, 0x06, 0x6e,
+	0x6e, 0x1d, 0xff, 0xb4, 0x33, 0xc7, 0x67, 0xb6, 0x75, 0x72, 0x66, 0x5b, 0x3f, 0xce, 0x6c, 0xeb,
+	0xf0, 0xdc, 0xce, 0x9c, 0x9c, 0xdb, 0x99, 0x6f, 0xe7, 0x76, 0xe6, 0xf5, 0xea, 0x65, 0xa2, 0x8d,
+	0x40, 0x17, 0x22, 0x22, 0xf6, 0x29, 0xdb, 0x1d, 0x18, 0xdc, 0xbd, 0x27, 0xee, 0xbb, 0xe4, 0x4f,
+	0x4e, 0x91, 0xdf, 0x29, 0xa8, 0x49, 0x56, 0x7e, 0x0d, 0x00, 0x76, 0x30, 0x3e, 0xcc, 0x5b, 0x07,
+	0x00, 0x00,
 }
 
 func (m *FarmingPlanProposal) Marshal() (dAtA []byte, err error) {
@@ -349,6 +490,181 @@ func (m *TerminatePlanRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SpendScheduleProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpendScheduleProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpendScheduleProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TerminateSpendScheduleRequests) > 0 {
+		for iNdEx := len(m.TerminateSpendScheduleRequests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TerminateSpendScheduleRequests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProposal(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.CreateSpendScheduleRequests) > 0 {
+		for iNdEx := len(m.CreateSpendScheduleRequests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CreateSpendScheduleRequests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProposal(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CreateSpendScheduleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateSpendScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateSpendScheduleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintProposal(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x3a
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintProposal(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x32
+	n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Period, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Period):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintProposal(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.AmountPerPeriod) > 0 {
+		for iNdEx := len(m.AmountPerPeriod) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AmountPerPeriod[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProposal(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.RecipientAddress) > 0 {
+		i -= len(m.RecipientAddress)
+		copy(dAtA[i:], m.RecipientAddress)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.RecipientAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TerminateSpendScheduleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TerminateSpendScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TerminateSpendScheduleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SpendScheduleId != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.SpendScheduleId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintProposal(dAtA []byte, offset int, v uint64) int {
 	offset -= sovProposal(v)
 	base := offset
@@ -428,6 +744,80 @@ func (m *TerminatePlanRequest) Size() (n int) {
 	return n
 }
 
+func (m *SpendScheduleProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if len(m.CreateSpendScheduleRequests) > 0 {
+		for _, e := range m.CreateSpendScheduleRequests {
+			l = e.Size()
+			n += 1 + l + sovProposal(uint64(l))
+		}
+	}
+	if len(m.TerminateSpendScheduleRequests) > 0 {
+		for _, e := range m.TerminateSpendScheduleRequests {
+			l = e.Size()
+			n += 1 + l + sovProposal(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CreateSpendScheduleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.RecipientAddress)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if len(m.AmountPerPeriod) > 0 {
+		for _, e := range m.AmountPerPeriod {
+			l = e.Size()
+			n += 1 + l + sovProposal(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Period)
+	n += 1 + l + sovProposal(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovProposal(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovProposal(uint64(l))
+	return n
+}
+
+func (m *TerminateSpendScheduleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpendScheduleId != 0 {
+		n += 1 + sovProposal(uint64(m.SpendScheduleId))
+	}
+	return n
+}
+
 func sovProposal(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -899,6 +1289,536 @@ func (m *TerminatePlanRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SpendScheduleProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpendScheduleProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpendScheduleProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateSpendScheduleRequests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreateSpendScheduleRequests = append(m.CreateSpendScheduleRequests, CreateSpendScheduleRequest{})
+			if err := m.CreateSpendScheduleRequests[len(m.CreateSpendScheduleRequests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TerminateSpendScheduleRequests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TerminateSpendScheduleRequests = append(m.TerminateSpendScheduleRequests, TerminateSpendScheduleRequest{})
+			if err := m.TerminateSpendScheduleRequests[len(m.TerminateSpendScheduleRequests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateSpendScheduleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateSpendScheduleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateSpendScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecipientAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountPerPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AmountPerPeriod = append(m.AmountPerPeriod, types.Coin{})
+			if err := m.AmountPerPeriod[len(m.AmountPerPeriod)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Period, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TerminateSpendScheduleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TerminateSpendScheduleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TerminateSpendScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendScheduleId", wireType)
+			}
+			m.SpendScheduleId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpendScheduleId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipProposal(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -932,6 +932,190 @@ func (m *HistoricalRewardsResponse) GetReferenceCount() uint32 {
 	return 0
 }
 
+type QuerySpendSchedulesRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySpendSchedulesRequest) Reset()         { *m = QuerySpendSchedulesRequest{} }
+func (m *QuerySpendSchedulesRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySpendSchedulesRequest) ProtoMessage()    {}
+func (*QuerySpendSchedulesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8516c7b94395f5e, []int{19}
+}
+func (m *QuerySpendSchedulesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySpendSchedulesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySpendSchedulesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySpendSchedulesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySpendSchedulesRequest.Merge(m, src)
+}
+func (m *QuerySpendSchedulesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySpendSchedulesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySpendSchedulesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySpendSchedulesRequest proto.InternalMessageInfo
+
+func (m *QuerySpendSchedulesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QuerySpendSchedulesResponse struct {
+	SpendSchedules []SpendSchedule     `protobuf:"bytes,1,rep,name=spend_schedules,json=spendSchedules,proto3" json:"spend_schedules"`
+	Pagination     *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySpendSchedulesResponse) Reset()         { *m = QuerySpendSchedulesResponse{} }
+func (m *QuerySpendSchedulesResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySpendSchedulesResponse) ProtoMessage()    {}
+func (*QuerySpendSchedulesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8516c7b94395f5e, []int{20}
+}
+func (m *QuerySpendSchedulesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySpendSchedulesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySpendSchedulesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySpendSchedulesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySpendSchedulesResponse.Merge(m, src)
+}
+func (m *QuerySpendSchedulesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySpendSchedulesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySpendSchedulesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySpendSchedulesResponse proto.InternalMessageInfo
+
+func (m *QuerySpendSchedulesResponse) GetSpendSchedules() []SpendSchedule {
+	if m != nil {
+		return m.SpendSchedules
+	}
+	return nil
+}
+
+func (m *QuerySpendSchedulesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QuerySpendScheduleRequest struct {
+	SpendScheduleId uint64 `protobuf:"varint,1,opt,name=spend_schedule_id,json=spendScheduleId,proto3" json:"spend_schedule_id,omitempty"`
+}
+
+func (m *QuerySpendScheduleRequest) Reset()         { *m = QuerySpendScheduleRequest{} }
+func (m *QuerySpendScheduleRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySpendScheduleRequest) ProtoMessage()    {}
+func (*QuerySpendScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8516c7b94395f5e, []int{21}
+}
+func (m *QuerySpendScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySpendScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySpendScheduleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySpendScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySpendScheduleRequest.Merge(m, src)
+}
+func (m *QuerySpendScheduleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySpendScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySpendScheduleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySpendScheduleRequest proto.InternalMessageInfo
+
+func (m *QuerySpendScheduleRequest) GetSpendScheduleId() uint64 {
+	if m != nil {
+		return m.SpendScheduleId
+	}
+	return 0
+}
+
+type QuerySpendScheduleResponse struct {
+	SpendSchedule SpendSchedule `protobuf:"bytes,1,opt,name=spend_schedule,json=spendSchedule,proto3" json:"spend_schedule"`
+}
+
+func (m *QuerySpendScheduleResponse) Reset()         { *m = QuerySpendScheduleResponse{} }
+func (m *QuerySpendScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySpendScheduleResponse) ProtoMessage()    {}
+func (*QuerySpendScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8516c7b94395f5e, []int{22}
+}
+func (m *QuerySpendScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySpendScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySpendScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySpendScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySpendScheduleResponse.Merge(m, src)
+}
+func (m *QuerySpendScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySpendScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySpendScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySpendScheduleResponse proto.InternalMessageInfo
+
+func (m *QuerySpendScheduleResponse) GetSpendSchedule() SpendSchedule {
+	if m != nil {
+		return m.SpendSchedule
+	}
+	return SpendSchedule{}
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "crescent.lpfarm.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "crescent.lpfarm.v1beta1.QueryParamsResponse")
@@ -952,6 +1136,10 @@ func init() {
 	proto.RegisterType((*QueryRewardsRequest)(nil), "crescent.lpfarm.v1beta1.QueryRewardsRequest")
 	proto.RegisterType((*QueryRewardsResponse)(nil), "crescent.lpfarm.v1beta1.QueryRewardsResponse")
 	proto.RegisterType((*HistoricalRewardsResponse)(nil), "crescent.lpfarm.v1beta1.HistoricalRewardsResponse")
+	proto.RegisterType((*QuerySpendSchedulesRequest)(nil), "crescent.lpfarm.v1beta1.QuerySpendSchedulesRequest")
+	proto.RegisterType((*QuerySpendSchedulesResponse)(nil), "crescent.lpfarm.v1beta1.QuerySpendSchedulesResponse")
+	proto.RegisterType((*QuerySpendScheduleRequest)(nil), "crescent.lpfarm.v1beta1.QuerySpendScheduleRequest")
+	proto.RegisterType((*QuerySpendScheduleResponse)(nil), "crescent.lpfarm.v1beta1.QuerySpendScheduleResponse")
 }
 
 func init() {
@@ -959,72 +1147,81 @@ func init() {
 }
 
 var fileDescriptor_d8516c7b94395f5e = []byte{
-	// 1032 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x97, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xc7, 0x33, 0xae, 0xe3, 0x34, 0x2f, 0xfc, 0xca, 0x90, 0x26, 0xa9, 0xd5, 0x3a, 0xe9, 0x82,
-	0x12, 0x37, 0xc6, 0xbb, 0xd8, 0x86, 0x56, 0x15, 0xe2, 0x92, 0x94, 0x02, 0x12, 0x87, 0x62, 0x95,
-	0x0b, 0x20, 0x45, 0x9b, 0xf5, 0xd4, 0x59, 0xc5, 0xde, 0xd9, 0xee, 0xae, 0x13, 0xaa, 0x28, 0x17,
-	0xb8, 0x14, 0x09, 0x04, 0x12, 0x17, 0xae, 0x15, 0x12, 0xaa, 0xf8, 0x2f, 0xb8, 0xf5, 0x58, 0xc1,
-	0x85, 0x13, 0xa0, 0x84, 0x03, 0x7f, 0x06, 0xda, 0x99, 0x37, 0xeb, 0xb5, 0x93, 0xc9, 0x6e, 0x50,
-	0x10, 0xa7, 0x76, 0x66, 0xde, 0x8f, 0xcf, 0xf7, 0xcd, 0xf3, 0xbe, 0x09, 0xbc, 0xe2, 0x04, 0x2c,
-	0x74, 0x98, 0x17, 0x59, 0x3d, 0xff, 0xbe, 0x1d, 0xf4, 0xad, 0xdd, 0xc6, 0x16, 0x8b, 0xec, 0x86,
-	0xf5, 0x60, 0xc0, 0x82, 0x87, 0xa6, 0x1f, 0xf0, 0x88, 0xd3, 0x05, 0x65, 0x64, 0x4a, 0x23, 0x13,
-	0x8d, 0xca, 0x73, 0x5d, 0xde, 0xe5, 0xc2, 0xc6, 0x8a, 0xff, 0x27, 0xcd, 0xcb, 0x57, 0xba, 0x9c,
-	0x77, 0x7b, 0xcc, 0xb2, 0x7d, 0xd7, 0xb2, 0x3d, 0x8f, 0x47, 0x76, 0xe4, 0x72, 0x2f, 0xc4, 0xd3,
-	0x8a, 0xc3, 0xc3, 0x3e, 0x0f, 0xad, 0x2d, 0x3b, 0x64, 0x49, 0x36, 0x87, 0xbb, 0x1e, 0x9e, 0xaf,
-	0xa5, 0xcf, 0x05, 0x45, 0x62, 0xe5, 0xdb, 0x5d, 0xd7, 0x13, 0xc1, 0xd0, 0xf6, 0x55, 0x1d, 0x3d,
-	0x72, 0x0a, 0x2b, 0x63, 0x0e, 0xe8, 0x87, 0x71, 0x9c, 0xbb, 0x76, 0x60, 0xf7, 0xc3, 0x36, 0x7b,
-	0x30, 0x60, 0x61, 0x64, 0xdc, 0x83, 0x97, 0x47, 0x76, 0x43, 0x9f, 0x7b, 0x21, 0xa3, 0x6f, 0x43,
-	0xc9, 0x17, 0x3b, 0x8b, 0x64, 0x99, 0x54, 0x67, 0x9a, 0x4b, 0xa6, 0x46, 0xbc, 0x29, 0x1d, 0xd7,
-	0x8b, 0x4f, 0x7f, 0x5f, 0x9a, 0x68, 0xa3, 0x93, 0xf1, 0x09, 0xcc, 0xca, 0xa8, 0x3d, 0xdb, 0x53,
-	0xa9, 0xe8, 0x1d, 0x80, 0x21, 0x3a, 0xc6, 0x5d, 0x31, 0xa5, 0x4e, 0x33, 0xd6, 0x69, 0xca, 0x6a,
-	0x0f, 0x23, 0x77, 0x19, 0xfa, 0xb6, 0x53, 0x9e, 0xc6, 0xf7, 0x44, 0x29, 0x91, 0xd1, 0x11, 0xf9,
-	0x16, 0x4c, 0xfa, 0xf1, 0xc6, 0x22, 0x59, 0xbe, 0x50, 0x9d, 0x69, 0x5e, 0xd5, 0x13, 0xf7, 0x6c,
-	0x0f, 0x79, 0xa5, 0x07, 0x7d, 0x77, 0x84, 0xac, 0x20, 0xc8, 0x56, 0x33, 0xc9, 0x64, 0xde, 0x11,
-	0xb4, 0x1a, 0xbc, 0x94, 0x90, 0x29, 0xd9, 0x0b, 0x30, 0x15, 0x67, 0xd9, 0x74, 0x3b, 0x42, 0x73,
-	0xb1, 0x5d, 0x8a, 0x97, 0xef, 0x77, 0x8c, 0x0f, 0x52, 0x45, 0x4a, 0x54, 0xdc, 0x84, 0x62, 0x7c,
-	0x8c, 0xe5, 0xc9, 0x25, 0x42, 0x38, 0x18, 0x55, 0x4c, 0x7d, 0xc7, 0x0e, 0xfa, 0x2a, 0xf5, 0x1c,
-	0x4c, 0x76, 0x98, 0xc7, 0xfb, 0x22, 0xda, 0x74, 0x5b, 0x2e, 0x92, 0xbc, 0xd2, 0x72, 0x98, 0x37,
-	0x8e, 0x9f, 0x99, 0x37, 0x76, 0x52, 0x79, 0xe3, 0x03, 0x63, 0x0f, 0x2e, 0x49, 0x15, 0x3c, 0x74,
-	0x45, 0x83, 0xab, 0xe4, 0xf3, 0x50, 0x8a, 0x0d, 0x58, 0x80, 0xd9, 0x71, 0x35, 0xd6, 0x06, 0x85,
-	0x7f, 0xdd, 0x06, 0x4f, 0x08, 0xcc, 0x8f, 0x67, 0x46, 0x31, 0xef, 0xc0, 0xb4, 0xaf, 0x36, 0xb1,
-	0x1d, 0xae, 0xe9, 0x2b, 0x89, 0x96, 0xa8, 0x6a, 0xe8, 0x79, 0x7e, 0x6d, 0x71, 0x1b, 0xe6, 0x46,
-	0x48, 0xb3, 0x4a, 0x94, 0xdc, 0x5b, 0x21, 0x7d, 0x6f, 0x9f, 0x8e, 0x55, 0x3a, 0x91, 0xbb, 0x01,
-	0x17, 0x15, 0x34, 0xde, 0x5f, 0x6e, 0xb5, 0x89, 0xa3, 0x71, 0x00, 0x57, 0x45, 0xf4, 0xf7, 0xdc,
-	0x30, 0xe2, 0x81, 0xeb, 0xd8, 0xbd, 0x36, 0xdb, 0xb3, 0x83, 0x4e, 0x78, 0x6a, 0x33, 0x9d, 0xdb,
-	0x6d, 0xfe, 0x42, 0xa0, 0xa2, 0xcb, 0x8f, 0x32, 0xbb, 0x40, 0xb7, 0x93, 0xc3, 0xcd, 0x40, 0x9e,
-	0xe2, 0xf5, 0x36, 0xb5, 0x82, 0xb5, 0xf1, 0xb0, 0x02, 0xb3, 0xdb, 0xe3, 0x06, 0xe7, 0x77, 0xef,
-	0x4d, 0x58, 0x14, 0x9a, 0xee, 0xf1, 0xe8, 0x58, 0x39, 0x35, 0x77, 0x6f, 0x3c, 0x22, 0x70, 0xf9,
-	0x04, 0x27, 0xac, 0xc1, 0x0e, 0x4c, 0x8d, 0x0a, 0xbf, 0x32, 0xc2, 0xa5, 0x88, 0x6e, 0x33, 0x67,
-	0x83, 0xbb, 0xde, 0x7a, 0x2b, 0x96, 0xf8, 0xd3, 0x1f, 0x4b, 0xb5, 0xae, 0x1b, 0x6d, 0x0f, 0xb6,
-	0x4c, 0x87, 0xf7, 0x2d, 0x1c, 0x2c, 0xf2, 0x9f, 0x7a, 0xd8, 0xd9, 0xb1, 0xa2, 0x87, 0x3e, 0x0b,
-	0x95, 0x4f, 0xd8, 0x56, 0x19, 0x8c, 0x0d, 0x9c, 0x0d, 0xf9, 0xc8, 0x35, 0x5d, 0xfb, 0x05, 0xc1,
-	0xe6, 0xff, 0x5f, 0xa5, 0xfc, 0x4d, 0xe0, 0xb2, 0xbe, 0xb3, 0xe6, 0xa1, 0xe4, 0xb3, 0xc0, 0xe5,
-	0xc3, 0x2f, 0xb4, 0x58, 0xd1, 0x2f, 0x09, 0x2c, 0x38, 0x83, 0xfe, 0xa0, 0x67, 0x47, 0xee, 0x2e,
-	0xdb, 0x1c, 0x78, 0x6e, 0x94, 0xf4, 0x5d, 0xe1, 0xbf, 0x62, 0xbe, 0x34, 0xcc, 0xf8, 0x91, 0xe7,
-	0x46, 0xaa, 0x29, 0x57, 0xe1, 0xc5, 0x80, 0xdd, 0x67, 0x01, 0xf3, 0x1c, 0xb6, 0xe9, 0xf0, 0x81,
-	0x17, 0x2d, 0x5e, 0x58, 0x26, 0xd5, 0xe7, 0xdb, 0x2f, 0x24, 0xdb, 0x1b, 0xf1, 0x6e, 0xf3, 0x87,
-	0x19, 0x98, 0x14, 0x05, 0xa7, 0x5f, 0x11, 0x28, 0xc9, 0xf1, 0x4c, 0x6b, 0xda, 0xdf, 0xc7, 0xf1,
-	0x37, 0x41, 0xf9, 0xb5, 0x7c, 0xc6, 0xb2, 0x78, 0xc6, 0xea, 0xe7, 0xbf, 0xfe, 0xf5, 0x5d, 0xe1,
-	0x1a, 0x5d, 0xb2, 0x74, 0xcf, 0x10, 0xf9, 0x28, 0xa0, 0x8f, 0x08, 0x4c, 0x8a, 0x91, 0x4d, 0xd7,
-	0x32, 0x12, 0xa4, 0x5e, 0x0d, 0xe5, 0x5a, 0x2e, 0x5b, 0x64, 0x59, 0x11, 0x2c, 0xcb, 0xb4, 0xa2,
-	0x67, 0x11, 0x00, 0xdf, 0x10, 0x28, 0xc6, 0x9e, 0xf4, 0x7a, 0x76, 0x74, 0x05, 0xb2, 0x96, 0xc7,
-	0x14, 0x39, 0x5e, 0x17, 0x1c, 0x6b, 0xb4, 0x7a, 0x3a, 0x87, 0xb5, 0x8f, 0x2f, 0x83, 0x03, 0xfa,
-	0x35, 0x81, 0x62, 0x3c, 0x5b, 0xb3, 0x88, 0x52, 0xe3, 0x3d, 0x8b, 0x28, 0x3d, 0xdf, 0x0d, 0x53,
-	0x10, 0x55, 0xe9, 0x8a, 0x96, 0x28, 0x5e, 0x84, 0xd6, 0xbe, 0xf8, 0xd5, 0x1e, 0xd0, 0xc7, 0x04,
-	0xa6, 0x93, 0xc1, 0x4a, 0xcd, 0x0c, 0xed, 0x63, 0xb3, 0xbf, 0x6c, 0xe5, 0xb6, 0x47, 0xbc, 0x96,
-	0xc0, 0xab, 0xd3, 0x9a, 0xbe, 0x60, 0xca, 0xc7, 0xda, 0x97, 0xdf, 0x9b, 0x03, 0xfa, 0x23, 0x81,
-	0x8b, 0x2a, 0x14, 0xad, 0xe7, 0x4b, 0xa9, 0x08, 0xcd, 0xbc, 0xe6, 0x08, 0xf8, 0x96, 0x00, 0x7c,
-	0x93, 0xb6, 0xce, 0x00, 0x98, 0x14, 0xf3, 0x67, 0x02, 0xb3, 0xc7, 0xbe, 0x3e, 0xf4, 0xc6, 0xe9,
-	0x08, 0xba, 0x41, 0x5c, 0xbe, 0x79, 0x66, 0xbf, 0xdc, 0x1a, 0x8e, 0xcf, 0xd7, 0x44, 0xc3, 0x13,
-	0x02, 0xcf, 0xa5, 0x47, 0x12, 0x6d, 0x9c, 0x8e, 0x71, 0xc2, 0xcc, 0x2b, 0x37, 0xcf, 0xe2, 0x82,
-	0xd0, 0x0d, 0x01, 0x5d, 0xa3, 0xd7, 0xb5, 0xd0, 0x09, 0xa9, 0xea, 0x8b, 0xc7, 0x04, 0xa6, 0x14,
-	0x65, 0xc6, 0xb7, 0x6c, 0x0c, 0xb0, 0x9e, 0xd3, 0x1a, 0xd9, 0x6e, 0x09, 0xb6, 0x16, 0x6d, 0xe4,
-	0x66, 0x53, 0xe5, 0x5c, 0xbf, 0xfb, 0xf4, 0xb0, 0x42, 0x9e, 0x1d, 0x56, 0xc8, 0x9f, 0x87, 0x15,
-	0xf2, 0xed, 0x51, 0x65, 0xe2, 0xd9, 0x51, 0x65, 0xe2, 0xb7, 0xa3, 0xca, 0xc4, 0xc7, 0x37, 0xd2,
-	0xc3, 0x02, 0xc3, 0xd6, 0x3d, 0x16, 0xed, 0xf1, 0x60, 0x67, 0x98, 0x67, 0xf7, 0x0d, 0xeb, 0x33,
-	0x95, 0x4c, 0x0c, 0x90, 0xad, 0x92, 0xf8, 0x33, 0xaf, 0xf5, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x8a, 0xb5, 0xed, 0xc1, 0xcc, 0x0e, 0x00, 0x00,
+	// 1179 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x98, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xc7, 0x33, 0xa9, 0xe3, 0x34, 0xaf, 0xc4, 0x21, 0x83, 0x9b, 0xa4, 0x4b, 0xeb, 0xa4, 0x0b,
+	0x4a, 0x5c, 0x1b, 0xef, 0x62, 0xbb, 0xb4, 0xaa, 0xf8, 0x71, 0x48, 0x42, 0x4a, 0x25, 0x0e, 0xc5,
+	0x69, 0x2f, 0x80, 0x64, 0x6d, 0xd6, 0x53, 0x67, 0x15, 0x7b, 0x67, 0xb3, 0xbb, 0x4e, 0xa8, 0x22,
+	0x5f, 0xe0, 0x52, 0x24, 0x10, 0x48, 0x70, 0xe0, 0xda, 0x0b, 0xaa, 0x90, 0xf8, 0x13, 0x40, 0xe2,
+	0xd6, 0x63, 0x05, 0x17, 0x4e, 0x80, 0x12, 0x0e, 0xfc, 0x19, 0x68, 0x67, 0x67, 0xd6, 0x5e, 0xdb,
+	0x6b, 0xaf, 0x91, 0x51, 0x4f, 0xc9, 0xce, 0xbc, 0xf7, 0xbe, 0x9f, 0xf7, 0x66, 0x76, 0xdf, 0x93,
+	0xe1, 0x15, 0xdd, 0x26, 0x8e, 0x4e, 0x4c, 0x57, 0x6d, 0x58, 0x0f, 0x34, 0xbb, 0xa9, 0x1e, 0x15,
+	0xf7, 0x88, 0xab, 0x15, 0xd5, 0xc3, 0x16, 0xb1, 0x1f, 0x2a, 0x96, 0x4d, 0x5d, 0x8a, 0x97, 0x85,
+	0x91, 0xe2, 0x1b, 0x29, 0xdc, 0x48, 0x4a, 0xd7, 0x69, 0x9d, 0x32, 0x1b, 0xd5, 0xfb, 0xcf, 0x37,
+	0x97, 0x2e, 0xd7, 0x29, 0xad, 0x37, 0x88, 0xaa, 0x59, 0x86, 0xaa, 0x99, 0x26, 0x75, 0x35, 0xd7,
+	0xa0, 0xa6, 0xc3, 0x77, 0x33, 0x3a, 0x75, 0x9a, 0xd4, 0x51, 0xf7, 0x34, 0x87, 0x04, 0x6a, 0x3a,
+	0x35, 0x4c, 0xbe, 0x9f, 0xeb, 0xde, 0x67, 0x14, 0x81, 0x95, 0xa5, 0xd5, 0x0d, 0x93, 0x05, 0xe3,
+	0xb6, 0xaf, 0x46, 0xd1, 0x73, 0x4e, 0x66, 0x25, 0xa7, 0x01, 0x7f, 0xe0, 0xc5, 0xb9, 0xab, 0xd9,
+	0x5a, 0xd3, 0xa9, 0x90, 0xc3, 0x16, 0x71, 0x5c, 0xf9, 0x1e, 0xbc, 0x14, 0x5a, 0x75, 0x2c, 0x6a,
+	0x3a, 0x04, 0xbf, 0x0d, 0x49, 0x8b, 0xad, 0xac, 0xa0, 0x35, 0x94, 0xbd, 0x50, 0x5a, 0x55, 0x22,
+	0x92, 0x57, 0x7c, 0xc7, 0xcd, 0xc4, 0xd3, 0x3f, 0x56, 0xa7, 0x2a, 0xdc, 0x49, 0xfe, 0x08, 0x16,
+	0xfd, 0xa8, 0x0d, 0xcd, 0x14, 0x52, 0x78, 0x07, 0xa0, 0x83, 0xce, 0xe3, 0xae, 0x2b, 0x7e, 0x9e,
+	0x8a, 0x97, 0xa7, 0xe2, 0x57, 0xbb, 0x13, 0xb9, 0x4e, 0xb8, 0x6f, 0xa5, 0xcb, 0x53, 0xfe, 0x0e,
+	0x01, 0xee, 0x8e, 0xce, 0x91, 0x6f, 0xc1, 0x8c, 0xe5, 0x2d, 0xac, 0xa0, 0xb5, 0x73, 0xd9, 0x0b,
+	0xa5, 0x2b, 0xd1, 0xc4, 0x0d, 0xcd, 0xe4, 0xbc, 0xbe, 0x07, 0xbe, 0x1d, 0x22, 0x9b, 0x66, 0x64,
+	0x1b, 0x23, 0xc9, 0x7c, 0xdd, 0x10, 0x5a, 0x1e, 0x5e, 0x0c, 0xc8, 0x44, 0xda, 0xcb, 0x30, 0xeb,
+	0xa9, 0x54, 0x8d, 0x1a, 0xcb, 0x39, 0x51, 0x49, 0x7a, 0x8f, 0x77, 0x6a, 0xf2, 0xfb, 0x5d, 0x45,
+	0x0a, 0xb2, 0xb8, 0x09, 0x09, 0x6f, 0x9b, 0x97, 0x27, 0x56, 0x12, 0xcc, 0x41, 0xce, 0x72, 0xe9,
+	0x1d, 0xcd, 0x6e, 0x0a, 0xe9, 0x34, 0xcc, 0xd4, 0x88, 0x49, 0x9b, 0x2c, 0xda, 0x5c, 0xc5, 0x7f,
+	0x08, 0x74, 0x7d, 0xcb, 0x8e, 0xae, 0x17, 0x7f, 0xa4, 0xae, 0xe7, 0x24, 0x74, 0xbd, 0x0d, 0xf9,
+	0x18, 0x2e, 0xfa, 0x59, 0x50, 0xc7, 0x60, 0x17, 0x5c, 0x88, 0x2f, 0x41, 0xd2, 0x33, 0x20, 0x36,
+	0x57, 0xe7, 0x4f, 0x78, 0x67, 0x40, 0xb1, 0xff, 0xcb, 0x35, 0x78, 0x82, 0x60, 0xa9, 0x57, 0x99,
+	0x27, 0xf3, 0x2e, 0xcc, 0x59, 0x62, 0x91, 0x5f, 0x87, 0xab, 0xd1, 0x95, 0xe4, 0x96, 0x3c, 0xab,
+	0x8e, 0xe7, 0xe4, 0xae, 0xc5, 0x36, 0xa4, 0x43, 0xa4, 0xa3, 0x4a, 0x14, 0x9c, 0xdb, 0x74, 0xf7,
+	0xb9, 0x7d, 0xdc, 0x53, 0xe9, 0x20, 0xdd, 0x2d, 0x38, 0x2f, 0xa0, 0xf9, 0xf9, 0xc5, 0xce, 0x36,
+	0x70, 0x94, 0xdb, 0x70, 0x85, 0x45, 0x7f, 0xcf, 0x70, 0x5c, 0x6a, 0x1b, 0xba, 0xd6, 0xa8, 0x90,
+	0x63, 0xcd, 0xae, 0x39, 0x43, 0x2f, 0xd3, 0xc4, 0x4e, 0xf3, 0x57, 0x04, 0x99, 0x28, 0x7d, 0x9e,
+	0x66, 0x1d, 0xf0, 0x7e, 0xb0, 0x59, 0xb5, 0xfd, 0x5d, 0x7e, 0xbc, 0xa5, 0xc8, 0x84, 0x23, 0xe3,
+	0xf1, 0x0a, 0x2c, 0xee, 0xf7, 0x1a, 0x4c, 0xee, 0xdc, 0x4b, 0xb0, 0xc2, 0x72, 0xba, 0x47, 0xdd,
+	0xbe, 0x72, 0x46, 0x9c, 0xbd, 0xfc, 0x08, 0xc1, 0xa5, 0x01, 0x4e, 0xbc, 0x06, 0x07, 0x30, 0x1b,
+	0x4e, 0xfc, 0x72, 0x88, 0x4b, 0x10, 0x6d, 0x13, 0x7d, 0x8b, 0x1a, 0xe6, 0x66, 0xd9, 0x4b, 0xf1,
+	0x87, 0x3f, 0x57, 0xf3, 0x75, 0xc3, 0xdd, 0x6f, 0xed, 0x29, 0x3a, 0x6d, 0xaa, 0xbc, 0xb1, 0xf8,
+	0x7f, 0x0a, 0x4e, 0xed, 0x40, 0x75, 0x1f, 0x5a, 0xc4, 0x11, 0x3e, 0x4e, 0x45, 0x28, 0xc8, 0x5b,
+	0xbc, 0x37, 0xc4, 0x23, 0x8f, 0xb8, 0xb5, 0x9f, 0x21, 0x48, 0x87, 0xa3, 0x3c, 0x8f, 0x54, 0xfe,
+	0x41, 0x70, 0x29, 0xfa, 0x66, 0x2d, 0x41, 0xd2, 0x22, 0xb6, 0x41, 0x3b, 0x5f, 0x68, 0xf6, 0x84,
+	0x3f, 0x47, 0xb0, 0xac, 0xb7, 0x9a, 0xad, 0x86, 0xe6, 0x1a, 0x47, 0xa4, 0xda, 0x32, 0x0d, 0x37,
+	0xb8, 0x77, 0xd3, 0xff, 0x17, 0xf3, 0xc5, 0x8e, 0xe2, 0x7d, 0xd3, 0x70, 0xc5, 0xa5, 0xdc, 0x80,
+	0x05, 0x9b, 0x3c, 0x20, 0x36, 0x31, 0x75, 0x52, 0xd5, 0x69, 0xcb, 0x74, 0x57, 0xce, 0xad, 0xa1,
+	0xec, 0x7c, 0x25, 0x15, 0x2c, 0x6f, 0x79, 0xab, 0x72, 0x0d, 0x24, 0x56, 0xef, 0x5d, 0x8b, 0x98,
+	0xb5, 0x5d, 0x7d, 0x9f, 0xd4, 0x5a, 0x0d, 0x32, 0xf1, 0x26, 0xfc, 0x13, 0x82, 0x97, 0x07, 0xca,
+	0xf0, 0x92, 0xde, 0x87, 0x05, 0xc7, 0xdb, 0xa9, 0x3a, 0x62, 0x8b, 0x9f, 0xf2, 0x7a, 0xe4, 0x9b,
+	0x1a, 0x8a, 0xc4, 0xdf, 0xce, 0x94, 0x13, 0x0a, 0x3f, 0xb9, 0x57, 0xf3, 0x36, 0x7f, 0xcb, 0x42,
+	0xa2, 0xa2, 0x48, 0x39, 0x58, 0x0c, 0xc3, 0x77, 0x9a, 0xf7, 0x42, 0x08, 0xe8, 0x4e, 0x4d, 0x3e,
+	0x1c, 0x54, 0xee, 0xa0, 0x0c, 0xbb, 0x90, 0x0a, 0x47, 0xea, 0x94, 0x7c, 0x8c, 0x2a, 0xcc, 0x87,
+	0x44, 0x4b, 0xdf, 0xa6, 0x60, 0x86, 0x69, 0xe2, 0x2f, 0x10, 0x24, 0xfd, 0x01, 0x0c, 0xe7, 0x23,
+	0x23, 0xf6, 0x4f, 0x7d, 0xd2, 0x6b, 0xf1, 0x8c, 0xfd, 0x24, 0xe4, 0x8d, 0x4f, 0x7f, 0xfb, 0xfb,
+	0x9b, 0xe9, 0xab, 0x78, 0x55, 0x8d, 0x1a, 0x34, 0xfd, 0xb1, 0x0f, 0x3f, 0x42, 0x30, 0xc3, 0x86,
+	0x32, 0x9c, 0x1b, 0x21, 0xd0, 0x35, 0x17, 0x4a, 0xf9, 0x58, 0xb6, 0x9c, 0x65, 0x9d, 0xb1, 0xac,
+	0xe1, 0x4c, 0x34, 0x0b, 0x03, 0xf8, 0x0a, 0x41, 0xc2, 0xf3, 0xc4, 0xd7, 0x46, 0x47, 0x17, 0x20,
+	0xb9, 0x38, 0xa6, 0x9c, 0xe3, 0x75, 0xc6, 0x91, 0xc3, 0xd9, 0xe1, 0x1c, 0xea, 0x09, 0x9f, 0xfd,
+	0xda, 0xf8, 0x4b, 0x04, 0x09, 0x6f, 0x7a, 0x1a, 0x45, 0xd4, 0x35, 0xc0, 0x49, 0xb9, 0x38, 0xa6,
+	0x9c, 0x48, 0x61, 0x44, 0x59, 0xbc, 0x1e, 0x49, 0xe4, 0x3d, 0x38, 0xea, 0x09, 0xfb, 0x2e, 0xb7,
+	0xf1, 0x63, 0x04, 0x73, 0xc1, 0xe8, 0x84, 0x95, 0x11, 0xb9, 0xf7, 0x4c, 0x77, 0x92, 0x1a, 0xdb,
+	0x9e, 0xe3, 0x95, 0x19, 0x5e, 0x01, 0xe7, 0xa3, 0x0b, 0x26, 0x7c, 0xd4, 0x13, 0xbf, 0xa3, 0xb4,
+	0xf1, 0xf7, 0x08, 0xce, 0x8b, 0x50, 0xb8, 0x10, 0x4f, 0x52, 0x10, 0x2a, 0x71, 0xcd, 0x39, 0xe0,
+	0x9b, 0x0c, 0xf0, 0x0d, 0x5c, 0x1e, 0x03, 0x30, 0x28, 0xe6, 0x2f, 0x08, 0x16, 0xfb, 0xfa, 0x0b,
+	0xbe, 0x31, 0x1c, 0x21, 0x6a, 0xd4, 0x92, 0x6e, 0x8e, 0xed, 0x17, 0x3b, 0x87, 0xfe, 0x09, 0x2a,
+	0xc8, 0xe1, 0x09, 0x82, 0x17, 0xba, 0x87, 0x0e, 0x5c, 0x1c, 0x8e, 0x31, 0x60, 0xaa, 0x91, 0x4a,
+	0xe3, 0xb8, 0x70, 0xe8, 0x22, 0x83, 0xce, 0xe3, 0x6b, 0x91, 0xd0, 0x01, 0xa9, 0xb8, 0x17, 0x8f,
+	0x11, 0xcc, 0x0a, 0xca, 0x11, 0xdf, 0xb2, 0x1e, 0xc0, 0x42, 0x4c, 0x6b, 0xce, 0x76, 0x8b, 0xb1,
+	0x95, 0x71, 0x31, 0x36, 0x5b, 0x50, 0xce, 0x1f, 0x11, 0xa4, 0xc2, 0xcd, 0x11, 0x97, 0x87, 0x8b,
+	0x0f, 0xec, 0xd8, 0xd2, 0xf5, 0xf1, 0x9c, 0x62, 0x7f, 0x9f, 0x7a, 0xda, 0x33, 0xfe, 0x19, 0xc1,
+	0x7c, 0x28, 0x18, 0x2e, 0x8d, 0xa1, 0x2c, 0x68, 0xcb, 0x63, 0xf9, 0x70, 0xd8, 0x6d, 0x06, 0xfb,
+	0x0e, 0x7e, 0x2b, 0x2e, 0xac, 0x7a, 0xd2, 0xd7, 0x9f, 0xdb, 0x9b, 0x77, 0x9f, 0x9e, 0x66, 0xd0,
+	0xb3, 0xd3, 0x0c, 0xfa, 0xeb, 0x34, 0x83, 0xbe, 0x3e, 0xcb, 0x4c, 0x3d, 0x3b, 0xcb, 0x4c, 0xfd,
+	0x7e, 0x96, 0x99, 0xfa, 0xf0, 0x46, 0xf7, 0xfc, 0xc5, 0x15, 0x0a, 0x26, 0x71, 0x8f, 0xa9, 0x7d,
+	0xd0, 0x91, 0x3c, 0xba, 0xae, 0x7e, 0x22, 0x74, 0xd9, 0x4c, 0xb6, 0x97, 0x64, 0xbf, 0x9c, 0x94,
+	0xff, 0x1d, 0x00, 0x04, 0x36, 0x17, 0x42, 0x1f, 0x12, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1048,6 +1245,8 @@ type QueryClient interface {
 	HistoricalRewards(ctx context.Context, in *QueryHistoricalRewardsRequest, opts ...grpc.CallOption) (*QueryHistoricalRewardsResponse, error)
 	TotalRewards(ctx context.Context, in *QueryTotalRewardsRequest, opts ...grpc.CallOption) (*QueryTotalRewardsResponse, error)
 	Rewards(ctx context.Context, in *QueryRewardsRequest, opts ...grpc.CallOption) (*QueryRewardsResponse, error)
+	SpendSchedules(ctx context.Context, in *QuerySpendSchedulesRequest, opts ...grpc.CallOption) (*QuerySpendSchedulesResponse, error)
+	SpendSchedule(ctx context.Context, in *QuerySpendScheduleRequest, opts ...grpc.CallOption) (*QuerySpendScheduleResponse, error)
 }
 
 type queryClient struct {
@@ -1139,6 +1338,24 @@ func (c *queryClient) Rewards(ctx context.Context, in *QueryRewardsRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) SpendSchedules(ctx context.Context, in *QuerySpendSchedulesRequest, opts ...grpc.CallOption) (*QuerySpendSchedulesResponse, error) {
+	out := new(QuerySpendSchedulesResponse)
+	err := c.cc.Invoke(ctx, "/crescent.lpfarm.v1beta1.Query/SpendSchedules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SpendSchedule(ctx context.Context, in *QuerySpendScheduleRequest, opts ...grpc.CallOption) (*QuerySpendScheduleResponse, error) {
+	out := new(QuerySpendScheduleResponse)
+	err := c.cc.Invoke(ctx, "/crescent.lpfarm.v1beta1.Query/SpendSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
@@ -1150,6 +1367,8 @@ type QueryServer interface {
 	HistoricalRewards(context.Context, *QueryHistoricalRewardsRequest) (*QueryHistoricalRewardsResponse, error)
 	TotalRewards(context.Context, *QueryTotalRewardsRequest) (*QueryTotalRewardsResponse, error)
 	Rewards(context.Context, *QueryRewardsRequest) (*QueryRewardsResponse, error)
+	SpendSchedules(context.Context, *QuerySpendSchedulesRequest) (*QuerySpendSchedulesResponse, error)
+	SpendSchedule(context.Context, *QuerySpendScheduleRequest) (*QuerySpendScheduleResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1183,6 +1402,12 @@ func (*UnimplementedQueryServer) TotalRewards(ctx context.Context, req *QueryTot
 func (*UnimplementedQueryServer) Rewards(ctx context.Context, req *QueryRewardsRequest) (*QueryRewardsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Rewards not implemented")
 }
+func (*UnimplementedQueryServer) SpendSchedules(ctx context.Context, req *QuerySpendSchedulesRequest) (*QuerySpendSchedulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SpendSchedules not implemented")
+}
+func (*UnimplementedQueryServer) SpendSchedule(ctx context.Context, req *QuerySpendScheduleRequest) (*QuerySpendScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SpendSchedule not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1350,6 +1575,42 @@ func _Query_Rewards_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SpendSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySpendSchedulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SpendSchedules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crescent.lpfarm.v1beta1.Query/SpendSchedules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SpendSchedules(ctx, req.(*QuerySpendSchedulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SpendSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySpendScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SpendSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crescent.lpfarm.v1beta1.Query/SpendSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SpendSchedule(ctx, req.(*QuerySpendScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "crescent.lpfarm.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1390,6 +1651,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Rewards",
 			Handler:    _Query_Rewards_Handler,
 		},
+		{
+			MethodName: "SpendSchedules",
+			Handler:    _Query_SpendSchedules_Handler,
+		},
+		{
+			MethodName: "SpendSchedule",
+			Handler:    _Query_SpendSchedule_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "crescent/lpfarm/v1beta1/query.proto",
@@ -2099,70 +2368,215 @@ func (m *HistoricalRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySpendSchedulesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QuerySpendSchedulesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPlansRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QuerySpendSchedulesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPlansResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySpendSchedulesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySpendSchedulesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySpendSchedulesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Plans) > 0 {
-		for _, e := range m.Plans {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.SpendSchedules) > 0 {
+		for iNdEx := len(m.SpendSchedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendSchedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPlanRequest) Size() (n int) {
+func (m *QuerySpendScheduleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySpendScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySpendScheduleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SpendScheduleId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SpendScheduleId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySpendScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySpendScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySpendScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.SpendSchedule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPlansRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPlansResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Plans) > 0 {
+		for _, e := range m.Plans {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPlanRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2390,6 +2804,61 @@ func (m *HistoricalRewardsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QuerySpendSchedulesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySpendSchedulesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SpendSchedules) > 0 {
+		for _, e := range m.SpendSchedules {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySpendScheduleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpendScheduleId != 0 {
+		n += 1 + sovQuery(uint64(m.SpendScheduleId))
+	}
+	return n
+}
+
+func (m *QuerySpendScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SpendSchedule.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4211,6 +4680,364 @@ func (m *HistoricalRewardsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QuerySpendSchedulesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySpendSchedulesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySpendSchedulesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySpendSchedulesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySpendSchedulesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySpendSchedulesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendSchedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendSchedules = append(m.SpendSchedules, SpendSchedule{})
+			if err := m.SpendSchedules[len(m.SpendSchedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySpendScheduleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySpendScheduleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySpendScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendScheduleId", wireType)
+			}
+			m.SpendScheduleId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpendScheduleId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySpendScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySpendScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySpendScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpendSchedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,105 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewSpendSchedule creates a new SpendSchedule.
+func NewSpendSchedule(
+	id uint64, description string, authorityAddr, recipientAddr sdk.AccAddress,
+	amtPerPeriod sdk.Coins, period time.Duration, startTime, endTime time.Time) SpendSchedule {
+	return SpendSchedule{
+		Id:               id,
+		Description:      description,
+		Authority:        authorityAddr.String(),
+		RecipientAddress: recipientAddr.String(),
+		AmountPerPeriod:  amtPerPeriod,
+		Period:           period,
+		StartTime:        startTime,
+		EndTime:          endTime,
+		NumExecutions:    0,
+	}
+}
+
+// NumPeriods returns the total number of periods of the schedule.
+// A period starting before the end time is counted even if it ends after
+// the end time.
+func (schedule SpendSchedule) NumPeriods() uint64 {
+	d := schedule.EndTime.Sub(schedule.StartTime)
+	n := d / schedule.Period
+	if d%schedule.Period > 0 {
+		n++
+	}
+	return uint64(n)
+}
+
+// NextExecutionTime returns the time from which the next period of the
+// schedule can be executed.
+func (schedule SpendSchedule) NextExecutionTime() time.Time {
+	return schedule.StartTime.Add(time.Duration(schedule.NumExecutions) * schedule.Period)
+}
+
+// IsFinished returns whether all periods of the schedule have been executed.
+func (schedule SpendSchedule) IsFinished() bool {
+	return schedule.NumExecutions >= schedule.NumPeriods()
+}
+
+// CanExecuteAt returns whether the next period of the schedule can be
+// executed at given time t.
+// Periods that were not executed in time can be executed later one by one,
+// so the total amount spent never exceeds the amount scheduled until t.
+func (schedule SpendSchedule) CanExecuteAt(t time.Time) bool {
+	return !schedule.IsFinished() && !schedule.NextExecutionTime().After(t)
+}
+
+func (schedule SpendSchedule) Validate() error {
+	if schedule.Id == 0 {
+		return fmt.Errorf("spend schedule id must be positive")
+	}
+	if len(schedule.Description) > MaxPlanDescriptionLen {
+		return fmt.Errorf("too long spend schedule description, maximum %d", MaxPlanDescriptionLen)
+	}
+	if _, err := sdk.AccAddressFromBech32(schedule.Authority); err != nil {
+		return fmt.Errorf("invalid authority address: %w", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(schedule.RecipientAddress); err != nil {
+		return fmt.Errorf("invalid recipient address: %w", err)
+	}
+	if err := schedule.AmountPerPeriod.Validate(); err != nil {
+		return fmt.Errorf("invalid amount per period: %w", err)
+	}
+	if schedule.AmountPerPeriod.IsZero() {
+		return fmt.Errorf("amount per period must not be empty")
+	}
+	if schedule.Period <= 0 {
+		return fmt.Errorf("period must be positive: %s", schedule.Period)
+	}
+	if !schedule.StartTime.Before(schedule.EndTime) {
+		return fmt.Errorf("end time must be after start time")
+	}
+	if schedule.NumExecutions > schedule.NumPeriods() {
+		return fmt.Errorf(
+			"number of executions must not exceed number of periods: %d > %d",
+			schedule.NumExecutions, schedule.NumPeriods())
+	}
+	return nil
+}
+
+func (schedule SpendSchedule) GetAuthority() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(schedule.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+func (schedule SpendSchedule) GetRecipientAddress() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(schedule.RecipientAddress)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
@@ -0,0 +1,125 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	utils "github.com/crescent-network/crescent/v4/types"
+	"github.com/crescent-network/crescent/v4/x/lpfarm/types"
+)
+
+func TestSpendSchedule_Validate(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		malleate    func(schedule *types.SpendSchedule)
+		expectedErr string
+	}{
+		{
+			"happy case",
+			func(schedule *types.SpendSchedule) {},
+			"",
+		},
+		{
+			"zero id",
+			func(schedule *types.SpendSchedule) {
+				schedule.Id = 0
+			},
+			"spend schedule id must be positive",
+		},
+		{
+			"too long description",
+			func(schedule *types.SpendSchedule) {
+				schedule.Description = strings.Repeat("x", types.MaxPlanDescriptionLen+1)
+			},
+			"too long spend schedule description, maximum 200",
+		},
+		{
+			"invalid authority address",
+			func(schedule *types.SpendSchedule) {
+				schedule.Authority = "invalidaddr"
+			},
+			"invalid authority address: decoding bech32 failed: invalid separator index -1",
+		},
+		{
+			"invalid recipient address",
+			func(schedule *types.SpendSchedule) {
+				schedule.RecipientAddress = "invalidaddr"
+			},
+			"invalid recipient address: decoding bech32 failed: invalid separator index -1",
+		},
+		{
+			"empty amount per period",
+			func(schedule *types.SpendSchedule) {
+				schedule.AmountPerPeriod = nil
+			},
+			"amount per period must not be empty",
+		},
+		{
+			"zero period",
+			func(schedule *types.SpendSchedule) {
+				schedule.Period = 0
+			},
+			"period must be positive: 0s",
+		},
+		{
+			"invalid start/end time",
+			func(schedule *types.SpendSchedule) {
+				schedule.EndTime = schedule.StartTime
+			},
+			"end time must be after start time",
+		},
+		{
+			"too many executions",
+			func(schedule *types.SpendSchedule) {
+				schedule.NumExecutions = 4
+			},
+			"number of executions must not exceed number of periods: 4 > 3",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			schedule := types.NewSpendSchedule(
+				1, "Monthly top-up", utils.TestAddress(0), utils.TestAddress(1),
+				utils.ParseCoins("1000_000000stake"), 30*24*time.Hour,
+				utils.ParseTime("2022-01-01T00:00:00Z"), utils.ParseTime("2022-03-15T00:00:00Z"))
+			tc.malleate(&schedule)
+			err := schedule.Validate()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestSpendSchedule_CanExecuteAt(t *testing.T) {
+	schedule := types.NewSpendSchedule(
+		1, "", utils.TestAddress(0), utils.TestAddress(1),
+		utils.ParseCoins("1000_000000stake"), 30*24*time.Hour,
+		utils.ParseTime("2022-01-01T00:00:00Z"), utils.ParseTime("2022-03-15T00:00:00Z"))
+	// The last period is shorter than others, but still counted.
+	require.EqualValues(t, 3, schedule.NumPeriods())
+
+	for _, tc := range []struct {
+		numExecutions uint64
+		t             string
+		expected      bool
+	}{
+		{0, "2021-12-31T23:59:59Z", false},
+		{0, "2022-01-01T00:00:00Z", true},
+		{1, "2022-01-30T23:59:59Z", false},
+		{1, "2022-01-31T00:00:00Z", true},
+		// Missed periods can be executed later.
+		{1, "2022-03-15T00:00:00Z", true},
+		{2, "2022-03-15T00:00:00Z", true},
+		{3, "2022-03-15T00:00:00Z", false},
+	} {
+		t.Run("", func(t *testing.T) {
+			schedule.NumExecutions = tc.numExecutions
+			require.Equal(t, tc.expected, schedule.CanExecuteAt(utils.ParseTime(tc.t)))
+		})
+	}
+}
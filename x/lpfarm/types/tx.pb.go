@@ -338,6 +338,81 @@ func (m *MsgHarvestResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgHarvestResponse proto.InternalMessageInfo
 
+type MsgExecuteSpendSchedule struct {
+	Authority       string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	SpendScheduleId uint64 `protobuf:"varint,2,opt,name=spend_schedule_id,json=spendScheduleId,proto3" json:"spend_schedule_id,omitempty"`
+}
+
+func (m *MsgExecuteSpendSchedule) Reset()         { *m = MsgExecuteSpendSchedule{} }
+func (m *MsgExecuteSpendSchedule) String() string { return proto.CompactTextString(m) }
+func (*MsgExecuteSpendSchedule) ProtoMessage()    {}
+func (*MsgExecuteSpendSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf380b18e59baef2, []int{8}
+}
+func (m *MsgExecuteSpendSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgExecuteSpendSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgExecuteSpendSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgExecuteSpendSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExecuteSpendSchedule.Merge(m, src)
+}
+func (m *MsgExecuteSpendSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgExecuteSpendSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExecuteSpendSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgExecuteSpendSchedule proto.InternalMessageInfo
+
+type MsgExecuteSpendScheduleResponse struct {
+	SpentCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=spent_coins,json=spentCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spent_coins"`
+}
+
+func (m *MsgExecuteSpendScheduleResponse) Reset()         { *m = MsgExecuteSpendScheduleResponse{} }
+func (m *MsgExecuteSpendScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgExecuteSpendScheduleResponse) ProtoMessage()    {}
+func (*MsgExecuteSpendScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf380b18e59baef2, []int{9}
+}
+func (m *MsgExecuteSpendScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgExecuteSpendScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgExecuteSpendScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgExecuteSpendScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExecuteSpendScheduleResponse.Merge(m, src)
+}
+func (m *MsgExecuteSpendScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgExecuteSpendScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExecuteSpendScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgExecuteSpendScheduleResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgCreatePrivatePlan)(nil), "crescent.lpfarm.v1beta1.MsgCreatePrivatePlan")
 	proto.RegisterType((*MsgCreatePrivatePlanResponse)(nil), "crescent.lpfarm.v1beta1.MsgCreatePrivatePlanResponse")
@@ -347,54 +422,62 @@ func init() {
 	proto.RegisterType((*MsgUnfarmResponse)(nil), "crescent.lpfarm.v1beta1.MsgUnfarmResponse")
 	proto.RegisterType((*MsgHarvest)(nil), "crescent.lpfarm.v1beta1.MsgHarvest")
 	proto.RegisterType((*MsgHarvestResponse)(nil), "crescent.lpfarm.v1beta1.MsgHarvestResponse")
+	proto.RegisterType((*MsgExecuteSpendSchedule)(nil), "crescent.lpfarm.v1beta1.MsgExecuteSpendSchedule")
+	proto.RegisterType((*MsgExecuteSpendScheduleResponse)(nil), "crescent.lpfarm.v1beta1.MsgExecuteSpendScheduleResponse")
 }
 
 func init() { proto.RegisterFile("crescent/lpfarm/v1beta1/tx.proto", fileDescriptor_cf380b18e59baef2) }
 
 var fileDescriptor_cf380b18e59baef2 = []byte{
-	// 663 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0xcf, 0x6e, 0xd3, 0x4e,
-	0x10, 0x8e, 0x9b, 0x34, 0x69, 0x27, 0x87, 0xdf, 0x2f, 0xab, 0x88, 0x06, 0x0b, 0x39, 0x91, 0xe1,
-	0x10, 0x40, 0xb5, 0xdb, 0x14, 0x38, 0x70, 0x41, 0x6d, 0x25, 0x04, 0x87, 0x48, 0x95, 0x55, 0x50,
-	0x05, 0x12, 0xd1, 0xc6, 0xde, 0xba, 0x56, 0x6d, 0xaf, 0xb5, 0xbb, 0x4d, 0xda, 0x67, 0xa8, 0x40,
-	0x7d, 0x0e, 0x9e, 0x80, 0x47, 0xe8, 0xb1, 0x47, 0x4e, 0x14, 0x9a, 0x17, 0x41, 0xbb, 0xfe, 0x43,
-	0x05, 0x4d, 0x4a, 0x25, 0x24, 0x38, 0x39, 0x33, 0xf3, 0x7d, 0x9f, 0x3d, 0xdf, 0xcc, 0x6e, 0xa0,
-	0xe3, 0x32, 0xc2, 0x5d, 0x12, 0x0b, 0x3b, 0x4c, 0x76, 0x31, 0x8b, 0xec, 0xd1, 0xea, 0x90, 0x08,
-	0xbc, 0x6a, 0x8b, 0x43, 0x2b, 0x61, 0x54, 0x50, 0xb4, 0x94, 0x23, 0xac, 0x14, 0x61, 0x65, 0x08,
-	0xbd, 0xe9, 0x53, 0x9f, 0x2a, 0x8c, 0x2d, 0x7f, 0xa5, 0x70, 0xdd, 0x70, 0x29, 0x8f, 0x28, 0xb7,
-	0x87, 0x98, 0x93, 0x42, 0xcc, 0xa5, 0x41, 0x9c, 0xd5, 0xdb, 0x3e, 0xa5, 0x7e, 0x48, 0x6c, 0x15,
-	0x0d, 0x0f, 0x76, 0x6d, 0x11, 0x44, 0x84, 0x0b, 0x1c, 0x25, 0x19, 0xe0, 0xde, 0xb4, 0x2f, 0xca,
-	0x5e, 0xaf, 0x50, 0xe6, 0xa7, 0x39, 0x68, 0xf6, 0xb9, 0xbf, 0xc9, 0x08, 0x16, 0x64, 0x8b, 0x05,
-	0x23, 0xf9, 0x08, 0x71, 0x8c, 0x5a, 0x50, 0x73, 0x65, 0x92, 0xb2, 0x96, 0xd6, 0xd1, 0xba, 0x8b,
-	0x4e, 0x1e, 0xa2, 0x0e, 0xd4, 0x3d, 0xc2, 0x5d, 0x16, 0x24, 0x22, 0xa0, 0x71, 0x6b, 0x4e, 0x55,
-	0x2f, 0xa7, 0xd0, 0x3b, 0x40, 0x8c, 0x8c, 0x31, 0xf3, 0x06, 0x38, 0x0c, 0xa9, 0x8b, 0x65, 0x92,
-	0xb7, 0xca, 0x9d, 0x72, 0xb7, 0xde, 0xbb, 0x6f, 0x4d, 0xf1, 0xc1, 0x72, 0x14, 0x65, 0xbd, 0x60,
-	0x6c, 0x54, 0x4e, 0xbf, 0xb4, 0x4b, 0x4e, 0x83, 0xfd, 0x94, 0xe7, 0x68, 0x13, 0x80, 0x0b, 0xcc,
-	0xc4, 0x40, 0xf6, 0xdc, 0xaa, 0x74, 0xb4, 0x6e, 0xbd, 0xa7, 0x5b, 0xa9, 0x21, 0x56, 0x6e, 0x88,
-	0xb5, 0x9d, 0x1b, 0xb2, 0xb1, 0x20, 0x85, 0x4e, 0xce, 0xdb, 0x9a, 0xb3, 0xa8, 0x78, 0xb2, 0x82,
-	0x9e, 0xc1, 0x02, 0x89, 0xbd, 0x54, 0x62, 0xfe, 0x06, 0x12, 0x35, 0x12, 0x7b, 0x32, 0x6f, 0x06,
-	0x70, 0xe7, 0x2a, 0xe7, 0x1c, 0xc2, 0x13, 0x1a, 0x73, 0x82, 0x96, 0xa0, 0x96, 0x84, 0x38, 0x1e,
-	0x04, 0x9e, 0x72, 0xb0, 0xe2, 0x54, 0x65, 0xf8, 0xd2, 0x43, 0x2b, 0xd0, 0x94, 0x8d, 0x07, 0xb1,
-	0x3f, 0x48, 0x28, 0x0d, 0x07, 0xd8, 0xf3, 0x18, 0xe1, 0x3c, 0x73, 0x12, 0x65, 0xb5, 0x2d, 0x4a,
-	0xc3, 0xf5, 0xb4, 0x62, 0xbe, 0x86, 0x5a, 0x9f, 0xfb, 0xcf, 0x31, 0x8b, 0xd0, 0x2d, 0xa8, 0x4a,
-	0x00, 0xc9, 0xc7, 0x92, 0x45, 0x68, 0x0d, 0x2a, 0x72, 0x3b, 0x94, 0x48, 0xbd, 0x77, 0xdb, 0x4a,
-	0xd7, 0xc7, 0x92, 0xeb, 0x53, 0x38, 0xbc, 0x49, 0x83, 0xdc, 0x55, 0x05, 0x36, 0x8f, 0x35, 0xf8,
-	0x2f, 0x13, 0x2e, 0x3e, 0xfb, 0x10, 0x1a, 0xe3, 0x40, 0xec, 0x79, 0x0c, 0x8f, 0xe3, 0x41, 0xea,
-	0x3d, 0x6f, 0x69, 0x6a, 0x76, 0x33, 0x54, 0x57, 0xa4, 0xea, 0xc7, 0xf3, 0x76, 0xd7, 0x0f, 0xc4,
-	0xde, 0xc1, 0xd0, 0x72, 0x69, 0x64, 0x67, 0x1b, 0x9c, 0x3e, 0x96, 0xb9, 0xb7, 0x6f, 0x8b, 0xa3,
-	0x84, 0x70, 0x45, 0xe0, 0xce, 0xff, 0xc5, 0x5b, 0xd2, 0xc1, 0x73, 0x73, 0x07, 0x16, 0xfb, 0xdc,
-	0x7f, 0x15, 0xef, 0xfe, 0xf1, 0x3e, 0xdf, 0x6b, 0xd0, 0x28, 0xa4, 0xff, 0x81, 0x4e, 0x9f, 0x02,
-	0xf4, 0xb9, 0xff, 0x02, 0xb3, 0x11, 0xe1, 0x62, 0x6a, 0xab, 0x4d, 0x98, 0xf7, 0x48, 0x4c, 0xa3,
-	0x6c, 0x31, 0xd2, 0xc0, 0xfc, 0xa0, 0x01, 0xfa, 0x41, 0xfe, 0xfb, 0xcd, 0xf4, 0x8e, 0xcb, 0x50,
-	0xee, 0x73, 0x1f, 0x1d, 0x41, 0xe3, 0xd7, 0x6b, 0x64, 0x79, 0xea, 0x71, 0xbf, 0xea, 0xec, 0xe8,
-	0x8f, 0x6f, 0x04, 0x2f, 0x9a, 0x77, 0xa0, 0xa2, 0x0e, 0x47, 0x67, 0x16, 0x5d, 0x22, 0xf4, 0xee,
-	0x75, 0x88, 0x42, 0x73, 0x07, 0xaa, 0xd9, 0x2a, 0x9a, 0xb3, 0x38, 0x29, 0x46, 0x7f, 0x70, 0x3d,
-	0xa6, 0x50, 0x7e, 0x0b, 0xb5, 0x7c, 0xf4, 0x77, 0x67, 0xd1, 0x32, 0x90, 0xfe, 0xf0, 0x37, 0x40,
-	0xb9, 0xf8, 0xc6, 0xf6, 0xe9, 0x37, 0xa3, 0x74, 0x7a, 0x61, 0x68, 0x67, 0x17, 0x86, 0xf6, 0xf5,
-	0xc2, 0xd0, 0x4e, 0x26, 0x46, 0xe9, 0x6c, 0x62, 0x94, 0x3e, 0x4f, 0x8c, 0xd2, 0x9b, 0x27, 0x97,
-	0xe7, 0x9c, 0x89, 0x2e, 0xc7, 0x44, 0x8c, 0x29, 0xdb, 0x2f, 0x12, 0xf6, 0xe8, 0x91, 0x7d, 0x98,
-	0xff, 0x6b, 0xa8, 0xd9, 0x0f, 0xab, 0xea, 0x4a, 0x5c, 0xfb, 0x1e, 0x00, 0x00, 0xff, 0xff, 0x2e,
-	0xdb, 0x9c, 0x99, 0xe7, 0x06, 0x00, 0x00,
+	// 757 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0xcd, 0x6e, 0xf3, 0x44,
+	0x14, 0x8d, 0x9b, 0x7c, 0x49, 0x73, 0xb3, 0x28, 0x19, 0x45, 0x34, 0x58, 0x95, 0x13, 0x19, 0x16,
+	0xa1, 0xa8, 0x76, 0x9b, 0x02, 0x42, 0x6c, 0x50, 0x5b, 0x81, 0xe8, 0x22, 0x52, 0xe5, 0x16, 0x54,
+	0x81, 0x84, 0x35, 0xb1, 0xa7, 0x8e, 0x55, 0xc7, 0x63, 0xcd, 0x4c, 0x7e, 0xba, 0x65, 0x8b, 0x80,
+	0x3e, 0x07, 0x4f, 0xc0, 0x23, 0x74, 0xd9, 0x25, 0x2b, 0x0a, 0xed, 0x8b, 0xa0, 0x19, 0xff, 0xb4,
+	0x82, 0x24, 0xa5, 0x12, 0x48, 0x5d, 0xd9, 0xf7, 0xde, 0x73, 0xce, 0xf8, 0x9e, 0xb9, 0x33, 0x86,
+	0xae, 0xc7, 0x08, 0xf7, 0x48, 0x2c, 0xec, 0x28, 0xb9, 0xc0, 0x6c, 0x6c, 0x4f, 0xf7, 0x86, 0x44,
+	0xe0, 0x3d, 0x5b, 0xcc, 0xad, 0x84, 0x51, 0x41, 0xd1, 0x66, 0x8e, 0xb0, 0x52, 0x84, 0x95, 0x21,
+	0xf4, 0x56, 0x40, 0x03, 0xaa, 0x30, 0xb6, 0x7c, 0x4b, 0xe1, 0xba, 0xe1, 0x51, 0x3e, 0xa6, 0xdc,
+	0x1e, 0x62, 0x4e, 0x0a, 0x31, 0x8f, 0x86, 0x71, 0x56, 0xef, 0x04, 0x94, 0x06, 0x11, 0xb1, 0x55,
+	0x34, 0x9c, 0x5c, 0xd8, 0x22, 0x1c, 0x13, 0x2e, 0xf0, 0x38, 0xc9, 0x00, 0xef, 0x2d, 0xfb, 0xa2,
+	0x6c, 0x79, 0x85, 0x32, 0x7f, 0x5d, 0x83, 0xd6, 0x80, 0x07, 0x47, 0x8c, 0x60, 0x41, 0x4e, 0x58,
+	0x38, 0x95, 0x8f, 0x08, 0xc7, 0xa8, 0x0d, 0x35, 0x4f, 0x26, 0x29, 0x6b, 0x6b, 0x5d, 0xad, 0x57,
+	0x77, 0xf2, 0x10, 0x75, 0xa1, 0xe1, 0x13, 0xee, 0xb1, 0x30, 0x11, 0x21, 0x8d, 0xdb, 0x6b, 0xaa,
+	0xfa, 0x34, 0x85, 0xbe, 0x03, 0xc4, 0xc8, 0x0c, 0x33, 0xdf, 0xc5, 0x51, 0x44, 0x3d, 0x2c, 0x93,
+	0xbc, 0x5d, 0xee, 0x96, 0x7b, 0x8d, 0xfe, 0xfb, 0xd6, 0x12, 0x1f, 0x2c, 0x47, 0x51, 0x0e, 0x0a,
+	0xc6, 0x61, 0xe5, 0xe6, 0xf7, 0x4e, 0xc9, 0x69, 0xb2, 0xbf, 0xe5, 0x39, 0x3a, 0x02, 0xe0, 0x02,
+	0x33, 0xe1, 0xca, 0x9e, 0xdb, 0x95, 0xae, 0xd6, 0x6b, 0xf4, 0x75, 0x2b, 0x35, 0xc4, 0xca, 0x0d,
+	0xb1, 0xce, 0x72, 0x43, 0x0e, 0xd7, 0xa5, 0xd0, 0xf5, 0x5d, 0x47, 0x73, 0xea, 0x8a, 0x27, 0x2b,
+	0xe8, 0x33, 0x58, 0x27, 0xb1, 0x9f, 0x4a, 0xbc, 0x79, 0x81, 0x44, 0x8d, 0xc4, 0xbe, 0xcc, 0x9b,
+	0x21, 0x6c, 0x2d, 0x72, 0xce, 0x21, 0x3c, 0xa1, 0x31, 0x27, 0x68, 0x13, 0x6a, 0x49, 0x84, 0x63,
+	0x37, 0xf4, 0x95, 0x83, 0x15, 0xa7, 0x2a, 0xc3, 0x63, 0x1f, 0xed, 0x42, 0x4b, 0x36, 0x1e, 0xc6,
+	0x81, 0x9b, 0x50, 0x1a, 0xb9, 0xd8, 0xf7, 0x19, 0xe1, 0x3c, 0x73, 0x12, 0x65, 0xb5, 0x13, 0x4a,
+	0xa3, 0x83, 0xb4, 0x62, 0x7e, 0x0d, 0xb5, 0x01, 0x0f, 0xbe, 0xc0, 0x6c, 0x8c, 0xde, 0x86, 0xaa,
+	0x04, 0x90, 0x7c, 0x5b, 0xb2, 0x08, 0xed, 0x43, 0x45, 0x4e, 0x87, 0x12, 0x69, 0xf4, 0xdf, 0xb1,
+	0xd2, 0xf1, 0xb1, 0xe4, 0xf8, 0x14, 0x0e, 0x1f, 0xd1, 0x30, 0x77, 0x55, 0x81, 0xcd, 0x1f, 0x34,
+	0xd8, 0xc8, 0x84, 0x8b, 0xcf, 0x9e, 0x43, 0x73, 0x16, 0x8a, 0x91, 0xcf, 0xf0, 0x2c, 0x76, 0x53,
+	0xef, 0x79, 0x5b, 0xeb, 0x96, 0x57, 0xab, 0xee, 0x4a, 0xd5, 0x5f, 0xee, 0x3a, 0xbd, 0x20, 0x14,
+	0xa3, 0xc9, 0xd0, 0xf2, 0xe8, 0xd8, 0xce, 0x26, 0x38, 0x7d, 0xec, 0x70, 0xff, 0xd2, 0x16, 0x57,
+	0x09, 0xe1, 0x8a, 0xc0, 0x9d, 0xb7, 0x8a, 0x55, 0xd2, 0x8d, 0xe7, 0xe6, 0x39, 0xd4, 0x07, 0x3c,
+	0xf8, 0x2a, 0xbe, 0xf8, 0xcf, 0xfb, 0xfc, 0x51, 0x83, 0x66, 0x21, 0xfd, 0x0a, 0x3a, 0xfd, 0x14,
+	0x60, 0xc0, 0x83, 0x2f, 0x31, 0x9b, 0x12, 0x2e, 0x96, 0xb6, 0xda, 0x82, 0x37, 0x3e, 0x89, 0xe9,
+	0x38, 0x1b, 0x8c, 0x34, 0x30, 0x7f, 0xd2, 0x00, 0x3d, 0x92, 0x5f, 0x41, 0x33, 0x1e, 0x6c, 0x0e,
+	0x78, 0xf0, 0xf9, 0x9c, 0x78, 0x13, 0x41, 0x4e, 0x13, 0x12, 0xfb, 0xa7, 0xde, 0x88, 0xf8, 0x93,
+	0x88, 0xa0, 0x2d, 0xa8, 0xe3, 0x89, 0x18, 0x51, 0x16, 0x8a, 0xab, 0xac, 0xb9, 0xc7, 0x04, 0xda,
+	0x86, 0x26, 0x97, 0x70, 0x97, 0x67, 0x78, 0x79, 0x54, 0xd6, 0xd4, 0x51, 0xd9, 0xe0, 0x4f, 0x75,
+	0x8e, 0x7d, 0xf3, 0x67, 0x0d, 0x3a, 0x4b, 0x56, 0x29, 0x2c, 0x88, 0xa0, 0x21, 0x69, 0xc2, 0x95,
+	0x7b, 0xfe, 0xbf, 0x34, 0x0f, 0x4a, 0x5f, 0xbd, 0xf7, 0xaf, 0x2b, 0x50, 0x1e, 0xf0, 0x00, 0x5d,
+	0x41, 0xf3, 0x9f, 0xb7, 0xe7, 0xce, 0xd2, 0x5b, 0x6e, 0xd1, 0x95, 0xa1, 0x7f, 0xf4, 0x22, 0x78,
+	0xd1, 0xb0, 0x03, 0x15, 0x75, 0x27, 0x74, 0x57, 0xd1, 0x25, 0x42, 0xef, 0x3d, 0x87, 0x28, 0x34,
+	0xcf, 0xa1, 0x9a, 0x9d, 0x40, 0x73, 0x15, 0x27, 0xc5, 0xe8, 0xdb, 0xcf, 0x63, 0x0a, 0xe5, 0x6f,
+	0xa1, 0x96, 0x4f, 0xfc, 0xbb, 0xab, 0x68, 0x19, 0x48, 0xff, 0xe0, 0x5f, 0x80, 0x0a, 0xf1, 0xef,
+	0x35, 0x68, 0x2d, 0x1c, 0xc1, 0xdd, 0x55, 0x2a, 0x8b, 0x18, 0xfa, 0x27, 0x2f, 0x65, 0xe4, 0x1f,
+	0x71, 0x78, 0x76, 0xf3, 0xa7, 0x51, 0xba, 0xb9, 0x37, 0xb4, 0xdb, 0x7b, 0x43, 0xfb, 0xe3, 0xde,
+	0xd0, 0xae, 0x1f, 0x8c, 0xd2, 0xed, 0x83, 0x51, 0xfa, 0xed, 0xc1, 0x28, 0x7d, 0xf3, 0xf1, 0xd3,
+	0x31, 0xcb, 0x56, 0xd8, 0x89, 0x89, 0x98, 0x51, 0x76, 0x59, 0x24, 0xec, 0xe9, 0x87, 0xf6, 0x3c,
+	0xff, 0x63, 0xab, 0xd1, 0x1b, 0x56, 0xd5, 0xef, 0x68, 0xff, 0xaf, 0x01, 0x00, 0xb7, 0xce, 0x12,
+	0x62, 0x63, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -413,6 +496,7 @@ type MsgClient interface {
 	Farm(ctx context.Context, in *MsgFarm, opts ...grpc.CallOption) (*MsgFarmResponse, error)
 	Unfarm(ctx context.Context, in *MsgUnfarm, opts ...grpc.CallOption) (*MsgUnfarmResponse, error)
 	Harvest(ctx context.Context, in *MsgHarvest, opts ...grpc.CallOption) (*MsgHarvestResponse, error)
+	ExecuteSpendSchedule(ctx context.Context, in *MsgExecuteSpendSchedule, opts ...grpc.CallOption) (*MsgExecuteSpendScheduleResponse, error)
 }
 
 type msgClient struct {
@@ -459,12 +543,22 @@ func (c *msgClient) Harvest(ctx context.Context, in *MsgHarvest, opts ...grpc.Ca
 	return out, nil
 }
 
+func (c *msgClient) ExecuteSpendSchedule(ctx context.Context, in *MsgExecuteSpendSchedule, opts ...grpc.CallOption) (*MsgExecuteSpendScheduleResponse, error) {
+	out := new(MsgExecuteSpendScheduleResponse)
+	err := c.cc.Invoke(ctx, "/crescent.lpfarm.v1beta1.Msg/ExecuteSpendSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	CreatePrivatePlan(context.Context, *MsgCreatePrivatePlan) (*MsgCreatePrivatePlanResponse, error)
 	Farm(context.Context, *MsgFarm) (*MsgFarmResponse, error)
 	Unfarm(context.Context, *MsgUnfarm) (*MsgUnfarmResponse, error)
 	Harvest(context.Context, *MsgHarvest) (*MsgHarvestResponse, error)
+	ExecuteSpendSchedule(context.Context, *MsgExecuteSpendSchedule) (*MsgExecuteSpendScheduleResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -483,6 +577,9 @@ func (*UnimplementedMsgServer) Unfarm(ctx context.Context, req *MsgUnfarm) (*Msg
 func (*UnimplementedMsgServer) Harvest(ctx context.Context, req *MsgHarvest) (*MsgHarvestResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Harvest not implemented")
 }
+func (*UnimplementedMsgServer) ExecuteSpendSchedule(ctx context.Context, req *MsgExecuteSpendSchedule) (*MsgExecuteSpendScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecuteSpendSchedule not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -560,6 +657,24 @@ func _Msg_Harvest_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ExecuteSpendSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgExecuteSpendSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ExecuteSpendSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crescent.lpfarm.v1beta1.Msg/ExecuteSpendSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ExecuteSpendSchedule(ctx, req.(*MsgExecuteSpendSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "crescent.lpfarm.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -580,6 +695,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Harvest",
 			Handler:    _Msg_Harvest_Handler,
 		},
+		{
+			MethodName: "ExecuteSpendSchedule",
+			Handler:    _Msg_ExecuteSpendSchedule_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "crescent/lpfarm/v1beta1/tx.proto",
@@ -915,6 +1034,78 @@ func (m *MsgHarvestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgExecuteSpendSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExecuteSpendSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExecuteSpendSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SpendScheduleId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SpendScheduleId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExecuteSpendScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExecuteSpendScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExecuteSpendScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SpentCoins) > 0 {
+		for iNdEx := len(m.SpentCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpentCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1061,6 +1252,37 @@ func (m *MsgHarvestResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgExecuteSpendSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SpendScheduleId != 0 {
+		n += 1 + sovTx(uint64(m.SpendScheduleId))
+	}
+	return n
+}
+
+func (m *MsgExecuteSpendScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SpentCoins) > 0 {
+		for _, e := range m.SpentCoins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1978,6 +2200,191 @@ func (m *MsgHarvestResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgExecuteSpendSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExecuteSpendSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExecuteSpendSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendScheduleId", wireType)
+			}
+			m.SpendScheduleId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpendScheduleId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExecuteSpendScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExecuteSpendScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExecuteSpendScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpentCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpentCoins = append(m.SpentCoins, types.Coin{})
+			if err := m.SpentCoins[len(m.SpentCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -6,8 +6,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
-	utils "github.com/crescent-network/crescent/v4/types"
-
 	"github.com/crescent-network/crescent/v4/x/mint/keeper"
 	"github.com/crescent-network/crescent/v4/x/mint/types"
 )
@@ -26,19 +24,22 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 		return
 	}
 
-	inflationSchedules := k.GetInflationSchedules(ctx)
 	blockInflation := sdk.ZeroInt()
-	var blockDurationForInflation time.Duration
-	for _, schedule := range inflationSchedules {
-		if utils.DateRangeIncludes(schedule.StartTime, schedule.EndTime, ctx.BlockTime()) {
-			blockDurationForInflation = ctx.BlockTime().Sub(*lastBlockTime)
-			if blockDurationForInflation > params.BlockTimeThreshold {
-				blockDurationForInflation = params.BlockTimeThreshold
-			}
-			// blockInflation = InflationAmountThisPeriod * min(CurrentBlockTime-LastBlockTime,BlockTimeThreshold)/(InflationPeriodEndDate-InflationPeriodStartDate)
-			blockInflation = schedule.Amount.MulRaw(blockDurationForInflation.Nanoseconds()).QuoRaw(schedule.EndTime.Sub(schedule.StartTime).Nanoseconds())
-			break
+	// theoreticalInflation is the inflation for the whole elapsed block duration,
+	// which is not minted when the block duration exceeds BlockTimeThreshold
+	// (e.g. the first block after a chain halt).
+	theoreticalInflation := sdk.ZeroInt()
+	var blockDuration, blockDurationForInflation time.Duration
+	if schedule, found := k.GetCurrentInflationSchedule(ctx); found {
+		blockDuration = ctx.BlockTime().Sub(*lastBlockTime)
+		blockDurationForInflation = blockDuration
+		if blockDurationForInflation > params.BlockTimeThreshold {
+			blockDurationForInflation = params.BlockTimeThreshold
 		}
+		scheduleDuration := schedule.EndTime.Sub(schedule.StartTime).Nanoseconds()
+		// blockInflation = InflationAmountThisPeriod * min(CurrentBlockTime-LastBlockTime,BlockTimeThreshold)/(InflationPeriodEndDate-InflationPeriodStartDate)
+		blockInflation = schedule.Amount.MulRaw(blockDurationForInflation.Nanoseconds()).QuoRaw(scheduleDuration)
+		theoreticalInflation = schedule.Amount.MulRaw(blockDuration.Nanoseconds()).QuoRaw(scheduleDuration)
 	}
 
 	if blockInflation.IsPositive() {
@@ -49,8 +50,8 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 			panic(err)
 		}
 
-		// send the minted coins to the mint pool
-		err = k.SendInflationToMintPool(ctx, mintedCoins)
+		// send the minted coins to the mint pool or the distribution addresses
+		err = k.DistributeInflation(ctx, mintedCoins)
 		if err != nil {
 			panic(err)
 		}
@@ -63,6 +64,8 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 				types.EventTypeMint,
 				sdk.NewAttribute(sdk.AttributeKeyAmount, mintedCoin.Amount.String()),
 				sdk.NewAttribute(types.AttributeKeyBlockDuration, blockDurationForInflation.String()),
+				sdk.NewAttribute(types.AttributeKeyTheoreticalAmount, theoreticalInflation.String()),
+				sdk.NewAttribute(types.AttributeKeyElapsedDuration, blockDuration.String()),
 			),
 		)
 	}
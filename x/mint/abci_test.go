@@ -9,6 +9,8 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/tendermint/budget/x/budget"
+	budgettypes "github.com/tendermint/budget/x/budget/types"
 	abcitypes "github.com/tendermint/tendermint/abci/types"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
@@ -238,3 +240,104 @@ func (s *ModuleTestSuite) TestImportExportGenesisEmpty() {
 	s.Require().Equal(*genState, genState2)
 	s.Require().EqualValues(genState2, *genState3)
 }
+
+func (s *ModuleTestSuite) TestClampedInflationEvent() {
+	ctx := s.ctx.WithBlockTime(utils.ParseTime("2022-01-01T00:00:00Z"))
+	mint.BeginBlocker(ctx, s.keeper)
+
+	// The chain halted for an hour.
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour)).WithEventManager(sdk.NewEventManager())
+	mint.BeginBlocker(ctx, s.keeper)
+
+	var found bool
+	for _, ev := range ctx.EventManager().ABCIEvents() {
+		if ev.Type != types.EventTypeMint {
+			continue
+		}
+		found = true
+		attrs := map[string]string{}
+		for _, attr := range ev.Attributes {
+			attrs[string(attr.Key)] = string(attr.Value)
+		}
+		// 300000000000000 * 10s / 365days
+		s.Require().Equal("95129375", attrs[sdk.AttributeKeyAmount])
+		s.Require().Equal("10s", attrs[types.AttributeKeyBlockDuration])
+		// 300000000000000 * 1h / 365days
+		s.Require().Equal("34246575342", attrs[types.AttributeKeyTheoreticalAmount])
+		s.Require().Equal("1h0m0s", attrs[types.AttributeKeyElapsedDuration])
+	}
+	s.Require().True(found)
+}
+
+func (s *ModuleTestSuite) TestDistributeInflation() {
+	ctx := s.ctx.WithBlockTime(utils.ParseTime("2022-01-01T00:00:00Z"))
+	mint.BeginBlocker(ctx, s.keeper)
+
+	params := s.keeper.GetParams(ctx)
+	params.DistributionProportions = []types.DistributionProportion{
+		{Address: s.addrs[0].String(), Proportion: utils.ParseDec("0.5")},
+		{Address: s.addrs[1].String(), Proportion: utils.ParseDec("0.3")},
+		{Address: s.addrs[2].String(), Proportion: utils.ParseDec("0.2")},
+	}
+	s.keeper.SetParams(ctx, params)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(5 * time.Second)).WithEventManager(sdk.NewEventManager())
+	mint.BeginBlocker(ctx, s.keeper)
+
+	// 47564687 is minted for 5 seconds, and the remainder of the truncated
+	// amounts is sent to the last address.
+	expected := []sdk.Int{sdk.NewInt(23782343), sdk.NewInt(14269406), sdk.NewInt(9512938)}
+	for i, amt := range expected {
+		balance := s.app.BankKeeper.GetBalance(ctx, s.addrs[i], sdk.DefaultBondDenom)
+		s.Require().Equal(initialBalances.AmountOf(sdk.DefaultBondDenom).Add(amt), balance.Amount)
+	}
+	s.Require().True(s.app.BankKeeper.GetBalance(
+		ctx, types.DefaultMintPoolAddress, sdk.DefaultBondDenom).Amount.IsZero())
+
+	var recipients []string
+	for _, ev := range ctx.EventManager().ABCIEvents() {
+		if ev.Type != types.EventTypeDistributeInflation {
+			continue
+		}
+		for _, attr := range ev.Attributes {
+			if string(attr.Key) == types.AttributeKeyRecipient {
+				recipients = append(recipients, string(attr.Value))
+			}
+		}
+	}
+	s.Require().Equal([]string{s.addrs[0].String(), s.addrs[1].String(), s.addrs[2].String()}, recipients)
+}
+
+func (s *ModuleTestSuite) TestBudgetOfMintedCoins() {
+	ctx := s.ctx.WithBlockTime(utils.ParseTime("2022-01-01T00:00:00Z"))
+	mint.BeginBlocker(ctx, s.keeper)
+
+	// A budget steers half of the coins in the fee collector, which is the
+	// default mint pool, to the ecosystem fund.
+	ecosystemFund := s.addrs[0]
+	budgetParams := s.app.BudgetKeeper.GetParams(ctx)
+	budgetParams.EpochBlocks = 1
+	budgetParams.Budgets = []budgettypes.Budget{
+		{
+			Name:               "ecosystem-fund",
+			Rate:               utils.ParseDec("0.5"),
+			SourceAddress:      types.DefaultMintPoolAddress.String(),
+			DestinationAddress: ecosystemFund.String(),
+			StartTime:          utils.ParseTime("0001-01-01T00:00:00Z"),
+			EndTime:            utils.ParseTime("9999-12-31T00:00:00Z"),
+		},
+	}
+	s.app.BudgetKeeper.SetParams(ctx, budgetParams)
+
+	// The budget module collects budgets after the mint module in the same
+	// begin block.
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(5 * time.Second))
+	mint.BeginBlocker(ctx, s.keeper)
+	budget.BeginBlocker(ctx, s.app.BudgetKeeper)
+
+	// 47564687 is minted for 5 seconds.
+	balance := s.app.BankKeeper.GetBalance(ctx, ecosystemFund, sdk.DefaultBondDenom)
+	s.Require().Equal(initialBalances.AmountOf(sdk.DefaultBondDenom).AddRaw(23782343), balance.Amount)
+	balance = s.app.BankKeeper.GetBalance(ctx, types.DefaultMintPoolAddress, sdk.DefaultBondDenom)
+	s.Require().Equal(sdk.NewInt(23782344), balance.Amount)
+}
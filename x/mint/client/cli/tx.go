@@ -0,0 +1,88 @@
+package cli
+
+// DONTCOVER
+// client is excluded from test coverage in MVP version
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	gov "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// NewCmdSubmitInflationScheduleProposal implements a command handler for submitting an inflation schedule proposal.
+func NewCmdSubmitInflationScheduleProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "inflation-schedule [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit an inflation schedule proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit an inflation schedule proposal along with an initial deposit.
+The proposal appends the inflation schedules to the existing ones, or replaces
+the inflation schedules which have not started yet with them if replace is true.
+Inflation schedules which have already started are kept as they are, thus the new
+inflation schedules cannot start before the time the proposal passes.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal inflation-schedule <path/to/proposal.json> --from=<key_or_address> --deposit=<deposit_amount>
+
+Where proposal.json contains:
+
+{
+  "title": "Inflation Schedule Proposal",
+  "description": "Let's set the inflation schedule for the next year",
+  "replace": false,
+  "inflation_schedules": [
+    {
+      "start_time": "2024-01-01T00:00:00Z",
+      "end_time": "2025-01-01T00:00:00Z",
+      "amount": "100000000000000"
+    }
+  ]
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			content, err := ParseInflationScheduleProposal(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			msg, err := gov.NewMsgSubmitProposal(&content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+
+	return cmd
+}
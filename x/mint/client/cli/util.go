@@ -0,0 +1,25 @@
+package cli
+
+import (
+	"os"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	"github.com/crescent-network/crescent/v4/x/mint/types"
+)
+
+// ParseInflationScheduleProposal reads and parses an InflationScheduleProposal from a file.
+func ParseInflationScheduleProposal(cdc codec.JSONCodec, proposalFile string) (types.InflationScheduleProposal, error) {
+	proposal := types.InflationScheduleProposal{}
+
+	contents, err := os.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err = cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
@@ -0,0 +1,14 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+
+	"github.com/crescent-network/crescent/v4/x/mint/client/cli"
+	"github.com/crescent-network/crescent/v4/x/mint/client/rest"
+)
+
+// ProposalHandler is the inflation schedule command handler.
+// Note that rest.ProposalRESTHandler will be deprecated in the future.
+var (
+	ProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitInflationScheduleProposal, rest.ProposalRESTHandler)
+)
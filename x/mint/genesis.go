@@ -16,6 +16,9 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, ak types.AccountKeeper,
 	if data.Params.InflationSchedules == nil || len(data.Params.InflationSchedules) == 0 {
 		data.Params.InflationSchedules = []types.InflationSchedule{}
 	}
+	if len(data.Params.DistributionProportions) == 0 {
+		data.Params.DistributionProportions = []types.DistributionProportion{}
+	}
 	keeper.SetParams(ctx, data.Params)
 	if data.LastBlockTime != nil {
 		keeper.SetLastBlockTime(ctx, *data.LastBlockTime)
@@ -31,5 +34,8 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 	if params.InflationSchedules == nil || len(params.InflationSchedules) == 0 {
 		params.InflationSchedules = []types.InflationSchedule{}
 	}
+	if len(params.DistributionProportions) == 0 {
+		params.DistributionProportions = []types.DistributionProportion{}
+	}
 	return types.NewGenesisState(params, lastBlockTime)
 }
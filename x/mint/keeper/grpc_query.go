@@ -24,3 +24,17 @@ func (k Keeper) LastBlockTime(c context.Context, _ *types.QueryLastBlockTimeRequ
 
 	return &types.QueryLastBlockTimeResponse{LastBlockTime: k.GetLastBlockTime(ctx)}, nil
 }
+
+// Inflation returns the current inflation rate.
+func (k Keeper) Inflation(c context.Context, _ *types.QueryInflationRequest) (*types.QueryInflationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryInflationResponse{Inflation: k.GetInflation(ctx)}, nil
+}
+
+// AnnualProvisions returns the current annual provisions.
+func (k Keeper) AnnualProvisions(c context.Context, _ *types.QueryAnnualProvisionsRequest) (*types.QueryAnnualProvisionsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryAnnualProvisionsResponse{AnnualProvisions: k.GetAnnualProvisions(ctx)}, nil
+}
@@ -11,6 +11,7 @@ import (
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	chain "github.com/crescent-network/crescent/v4/app"
+	utils "github.com/crescent-network/crescent/v4/types"
 	"github.com/crescent-network/crescent/v4/x/mint/types"
 )
 
@@ -44,6 +45,42 @@ func (suite *MintTestSuite) TestGRPCParams() {
 	suite.Require().Equal(params.Params, app.MintKeeper.GetParams(ctx))
 }
 
+func (suite *MintTestSuite) TestGRPCInflation() {
+	app := suite.app
+
+	params := app.MintKeeper.GetParams(suite.ctx)
+	params.InflationSchedules = []types.InflationSchedule{
+		{
+			StartTime: utils.ParseTime("2022-01-01T00:00:00Z"),
+			EndTime:   utils.ParseTime("2022-07-02T12:00:00Z"), // half a year
+			Amount:    sdk.NewInt(100000000000000),
+		},
+	}
+	app.MintKeeper.SetParams(suite.ctx, params)
+	suite.Require().NoError(app.BankKeeper.MintCoins(
+		suite.ctx, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(params.MintDenom, 1000000000000000))))
+	supply := app.BankKeeper.GetSupply(suite.ctx, params.MintDenom).Amount
+
+	suite.ctx = suite.ctx.WithBlockTime(utils.ParseTime("2022-03-01T00:00:00Z"))
+	resp, err := app.MintKeeper.AnnualProvisions(sdk.WrapSDKContext(suite.ctx), &types.QueryAnnualProvisionsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDec(200000000000000), resp.AnnualProvisions)
+
+	resp2, err := app.MintKeeper.Inflation(sdk.WrapSDKContext(suite.ctx), &types.QueryInflationRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDec(200000000000000).QuoInt(supply), resp2.Inflation)
+
+	// no inflation schedule in progress
+	suite.ctx = suite.ctx.WithBlockTime(utils.ParseTime("2023-01-01T00:00:00Z"))
+	resp, err = app.MintKeeper.AnnualProvisions(sdk.WrapSDKContext(suite.ctx), &types.QueryAnnualProvisionsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().True(resp.AnnualProvisions.IsZero())
+
+	resp2, err = app.MintKeeper.Inflation(sdk.WrapSDKContext(suite.ctx), &types.QueryInflationRequest{})
+	suite.Require().NoError(err)
+	suite.Require().True(resp2.Inflation.IsZero())
+}
+
 func TestMintTestSuite(t *testing.T) {
 	suite.Run(t, new(MintTestSuite))
 }
@@ -6,6 +6,7 @@ import (
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/tendermint/tendermint/libs/log"
 
+	utils "github.com/crescent-network/crescent/v4/types"
 	"github.com/crescent-network/crescent/v4/x/mint/types"
 )
 
@@ -78,12 +79,94 @@ func (k Keeper) SendInflationToMintPool(ctx sdk.Context, inflation sdk.Coins) er
 		inflation)
 }
 
+// DistributeInflation sends inflation to the addresses of params.DistributionProportions
+// according to their proportions, It to be used in BeginBlocker.
+// The remainder of the truncated amounts is sent to the last address.
+// If no distribution proportions are set, inflation is sent to params.MintPoolAddress.
+func (k Keeper) DistributeInflation(ctx sdk.Context, inflation sdk.Coins) error {
+	proportions := k.GetDistributionProportions(ctx)
+	if len(proportions) == 0 {
+		return k.SendInflationToMintPool(ctx, inflation)
+	}
+
+	mintAcc := k.accountKeeper.GetModuleAddress(types.ModuleName)
+	remaining := inflation
+	for i, dp := range proportions {
+		var amt sdk.Coins
+		if i == len(proportions)-1 {
+			amt = remaining
+		} else {
+			var coins []sdk.Coin
+			for _, coin := range inflation {
+				coins = append(coins, sdk.NewCoin(coin.Denom, dp.Proportion.MulInt(coin.Amount).TruncateInt()))
+			}
+			amt = sdk.NewCoins(coins...)
+			remaining = remaining.Sub(amt)
+		}
+		if amt.IsZero() {
+			continue
+		}
+
+		addr, err := sdk.AccAddressFromBech32(dp.Address)
+		if err != nil {
+			return err
+		}
+		if err := k.bankKeeper.SendCoins(ctx, mintAcc, addr, amt); err != nil {
+			return err
+		}
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeDistributeInflation,
+				sdk.NewAttribute(types.AttributeKeyRecipient, dp.Address),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, amt.String()),
+			),
+		)
+	}
+	return nil
+}
+
 // GetInflationSchedules return inflation schedules set on app
 func (k Keeper) GetInflationSchedules(ctx sdk.Context) (res []types.InflationSchedule) {
 	k.paramSpace.Get(ctx, types.KeyInflationSchedules, &res)
 	return
 }
 
+// GetCurrentInflationSchedule returns the inflation schedule including the current block time.
+func (k Keeper) GetCurrentInflationSchedule(ctx sdk.Context) (schedule types.InflationSchedule, found bool) {
+	for _, schedule := range k.GetInflationSchedules(ctx) {
+		if utils.DateRangeIncludes(schedule.StartTime, schedule.EndTime, ctx.BlockTime()) {
+			return schedule, true
+		}
+	}
+	return
+}
+
+// GetAnnualProvisions returns the amount of the current inflation schedule
+// scaled to a year. It returns zero if there is no inflation schedule in progress.
+func (k Keeper) GetAnnualProvisions(ctx sdk.Context) sdk.Dec {
+	schedule, found := k.GetCurrentInflationSchedule(ctx)
+	if !found {
+		return sdk.ZeroDec()
+	}
+	// annualProvisions = InflationAmountThisPeriod * Year / (InflationPeriodEndDate-InflationPeriodStartDate)
+	return schedule.Amount.ToDec().MulInt64(int64(types.Year)).QuoInt64(int64(schedule.EndTime.Sub(schedule.StartTime)))
+}
+
+// GetInflation returns the annual provisions divided by the current supply of the mint denom.
+func (k Keeper) GetInflation(ctx sdk.Context) sdk.Dec {
+	supply := k.bankKeeper.GetSupply(ctx, k.GetParams(ctx).MintDenom)
+	if !supply.Amount.IsPositive() {
+		return sdk.ZeroDec()
+	}
+	return k.GetAnnualProvisions(ctx).QuoInt(supply.Amount)
+}
+
+// GetDistributionProportions return distribution proportions set on app
+func (k Keeper) GetDistributionProportions(ctx sdk.Context) (res []types.DistributionProportion) {
+	k.paramSpace.Get(ctx, types.KeyDistributionProportions, &res)
+	return
+}
+
 // GetMintPoolAddress return mint pool address on app
 func (k Keeper) GetMintPoolAddress(ctx sdk.Context) (acc sdk.AccAddress) {
 	var addr string
@@ -4,6 +4,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	v2 "github.com/crescent-network/crescent/v4/x/mint/legacy/v2"
+	v3 "github.com/crescent-network/crescent/v4/x/mint/legacy/v3"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -20,3 +21,8 @@ func NewMigrator(keeper Keeper) Migrator {
 func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 	return v2.MigrateStore(ctx, m.keeper.paramSpace)
 }
+
+// Migrate2to3 migrates from version 2 to 3.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return v3.MigrateStore(ctx, m.keeper.paramSpace)
+}
@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"sort"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/crescent-network/crescent/v4/x/mint/types"
+)
+
+// HandleInflationScheduleProposal is a handler for executing an inflation schedule proposal.
+func HandleInflationScheduleProposal(ctx sdk.Context, k Keeper, p *types.InflationScheduleProposal) error {
+	return k.UpdateInflationSchedules(ctx, p.Replace, p.InflationSchedules)
+}
+
+// UpdateInflationSchedules appends the schedules to the inflation schedules,
+// or replaces the inflation schedules which have not started yet with them
+// if replace is true.
+// Schedules which have already started, including the one in progress, are
+// kept as they are so that the inflation rate of the current period doesn't
+// change in the middle of it. Thus the new schedules cannot start before the
+// current block time.
+func (k Keeper) UpdateInflationSchedules(ctx sdk.Context, replace bool, schedules []types.InflationSchedule) error {
+	for _, schedule := range schedules {
+		if schedule.StartTime.Before(ctx.BlockTime()) {
+			return sdkerrors.Wrapf(
+				sdkerrors.ErrInvalidRequest, "inflation schedule cannot start before the current block time %s: %s",
+				ctx.BlockTime().Format(time.RFC3339), schedule.StartTime.Format(time.RFC3339))
+		}
+	}
+
+	params := k.GetParams(ctx)
+	var newSchedules []types.InflationSchedule
+	for _, schedule := range params.InflationSchedules {
+		if replace && schedule.StartTime.After(ctx.BlockTime()) {
+			continue
+		}
+		newSchedules = append(newSchedules, schedule)
+	}
+	newSchedules = append(newSchedules, schedules...)
+	sort.SliceStable(newSchedules, func(i, j int) bool {
+		return newSchedules[i].StartTime.Before(newSchedules[j].StartTime)
+	})
+
+	params.InflationSchedules = newSchedules
+	if err := params.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	k.SetParams(ctx, params)
+
+	return nil
+}
@@ -0,0 +1,89 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	utils "github.com/crescent-network/crescent/v4/types"
+	"github.com/crescent-network/crescent/v4/x/mint"
+	"github.com/crescent-network/crescent/v4/x/mint/types"
+)
+
+func (suite *MintTestSuite) TestInflationScheduleProposal() {
+	app, ctx := suite.app, suite.ctx
+
+	params := app.MintKeeper.GetParams(ctx)
+	params.InflationSchedules = []types.InflationSchedule{
+		{
+			StartTime: utils.ParseTime("2023-01-01T00:00:00Z"),
+			EndTime:   utils.ParseTime("2024-01-01T00:00:00Z"),
+			Amount:    sdk.NewInt(200000000000000),
+		},
+		{
+			StartTime: utils.ParseTime("2024-01-01T00:00:00Z"),
+			EndTime:   utils.ParseTime("2025-01-01T00:00:00Z"),
+			Amount:    sdk.NewInt(100000000000000),
+		},
+	}
+	app.MintKeeper.SetParams(ctx, params)
+	ctx = ctx.WithBlockTime(utils.ParseTime("2023-06-01T00:00:00Z"))
+
+	handler := mint.NewProposalHandler(app.MintKeeper)
+	nextSchedule := types.InflationSchedule{
+		StartTime: utils.ParseTime("2025-01-01T00:00:00Z"),
+		EndTime:   utils.ParseTime("2026-01-01T00:00:00Z"),
+		Amount:    sdk.NewInt(50000000000000),
+	}
+
+	// Append a schedule after the existing ones.
+	p := types.NewInflationScheduleProposal("title", "description", false, []types.InflationSchedule{nextSchedule})
+	suite.Require().NoError(p.ValidateBasic())
+	cacheCtx, _ := ctx.CacheContext()
+	suite.Require().NoError(handler(cacheCtx, p))
+	suite.Require().Equal(
+		append(params.InflationSchedules, nextSchedule), app.MintKeeper.GetInflationSchedules(cacheCtx))
+
+	// Appending an overlapping schedule fails.
+	p = types.NewInflationScheduleProposal("title", "description", false, []types.InflationSchedule{
+		{
+			StartTime: utils.ParseTime("2024-06-01T00:00:00Z"),
+			EndTime:   utils.ParseTime("2025-06-01T00:00:00Z"),
+			Amount:    sdk.NewInt(100000000000000),
+		},
+	})
+	suite.Require().NoError(p.ValidateBasic())
+	suite.Require().ErrorContains(handler(ctx, p), "inflation periods cannot be overlapped")
+
+	// Replace the schedule which has not started yet.
+	// The schedule in progress is kept.
+	replacingSchedule := types.InflationSchedule{
+		StartTime: utils.ParseTime("2024-01-01T00:00:00Z"),
+		EndTime:   utils.ParseTime("2026-01-01T00:00:00Z"),
+		Amount:    sdk.NewInt(150000000000000),
+	}
+	p = types.NewInflationScheduleProposal("title", "description", true, []types.InflationSchedule{replacingSchedule})
+	suite.Require().NoError(p.ValidateBasic())
+	cacheCtx, _ = ctx.CacheContext()
+	suite.Require().NoError(handler(cacheCtx, p))
+	suite.Require().Equal(
+		[]types.InflationSchedule{params.InflationSchedules[0], replacingSchedule},
+		app.MintKeeper.GetInflationSchedules(cacheCtx))
+
+	// Replacing with no schedules cancels the schedules which have not started yet.
+	p = types.NewInflationScheduleProposal("title", "description", true, nil)
+	suite.Require().NoError(p.ValidateBasic())
+	cacheCtx, _ = ctx.CacheContext()
+	suite.Require().NoError(handler(cacheCtx, p))
+	suite.Require().Equal(params.InflationSchedules[:1], app.MintKeeper.GetInflationSchedules(cacheCtx))
+
+	// The schedule in progress cannot be replaced.
+	p = types.NewInflationScheduleProposal("title", "description", true, []types.InflationSchedule{
+		{
+			StartTime: utils.ParseTime("2023-01-01T00:00:00Z"),
+			EndTime:   utils.ParseTime("2024-01-01T00:00:00Z"),
+			Amount:    sdk.NewInt(300000000000000),
+		},
+	})
+	suite.Require().NoError(p.ValidateBasic())
+	suite.Require().ErrorContains(handler(ctx, p), "inflation schedule cannot start before the current block time")
+	suite.Require().Equal(params.InflationSchedules, app.MintKeeper.GetInflationSchedules(ctx))
+}
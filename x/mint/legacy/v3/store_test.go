@@ -0,0 +1,37 @@
+package v3_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/crescent-network/crescent/v4/app"
+	v3 "github.com/crescent-network/crescent/v4/x/mint/legacy/v3"
+	"github.com/crescent-network/crescent/v4/x/mint/types"
+)
+
+func TestStoreMigration(t *testing.T) {
+	encCfg := app.MakeTestEncodingConfig()
+	key := sdk.NewKVStoreKey(types.ModuleName)
+	tKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(key, tKey)
+	paramSpace := paramtypes.NewSubspace(encCfg.Marshaler, encCfg.Amino, key, tKey, types.ModuleName)
+
+	// Check no params
+	require.False(t, paramSpace.Has(ctx, types.KeyDistributionProportions))
+
+	// Run migrations.
+	paramSpace.WithKeyTable(types.ParamKeyTable())
+	err := v3.MigrateStore(ctx, paramSpace)
+	require.NoError(t, err)
+
+	// Make sure the new params are set.
+	require.True(t, paramSpace.Has(ctx, types.KeyDistributionProportions))
+	var distrProportions []types.DistributionProportion
+	paramSpace.Get(ctx, types.KeyDistributionProportions, &distrProportions)
+	require.Empty(t, distrProportions)
+}
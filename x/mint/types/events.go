@@ -2,6 +2,11 @@ package types
 
 // Mint module event types
 const (
-	EventTypeMint             = ModuleName
-	AttributeKeyBlockDuration = "block_duration"
+	EventTypeMint                = ModuleName
+	EventTypeDistributeInflation = "distribute_inflation"
+	AttributeKeyBlockDuration    = "block_duration"
+
+	AttributeKeyTheoreticalAmount = "theoretical_amount"
+	AttributeKeyElapsedDuration   = "elapsed_duration"
+	AttributeKeyRecipient         = "recipient"
 )
@@ -10,6 +10,9 @@ const (
 	// StoreKey is the default store key for mint
 	StoreKey = ModuleName
 
+	// RouterKey is the message router key for the mint module
+	RouterKey = ModuleName
+
 	// QuerierRoute is the querier route for the mint store.
 	QuerierRoute = StoreKey
 
@@ -42,6 +42,9 @@ type Params struct {
 	BlockTimeThreshold time.Duration `protobuf:"bytes,3,opt,name=block_time_threshold,json=blockTimeThreshold,proto3,stdduration" json:"block_time_threshold"`
 	// inflation_schedules defines a list of inflation schedules
 	InflationSchedules []InflationSchedule `protobuf:"bytes,4,rep,name=inflation_schedules,json=inflationSchedules,proto3" json:"inflation_schedules"`
+	// distribution_proportions defines the proportions of the minted coins to be sent to each address every block.
+	// The proportions must sum to 1. If empty, all minted coins are sent to mint_pool_address.
+	DistributionProportions []DistributionProportion `protobuf:"bytes,5,rep,name=distribution_proportions,json=distributionProportions,proto3" json:"distribution_proportions" yaml:"distribution_proportions"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -105,6 +108,13 @@ func (m *Params) GetInflationSchedules() []InflationSchedule {
 	return nil
 }
 
+func (m *Params) GetDistributionProportions() []DistributionProportion {
+	if m != nil {
+		return m.DistributionProportions
+	}
+	return nil
+}
+
 // InflationSchedule defines the start and end time of the inflation period, and the amount of inflation during that
 // period.
 type InflationSchedule struct {
@@ -163,45 +173,100 @@ func (m *InflationSchedule) GetEndTime() time.Time {
 	return time.Time{}
 }
 
+// DistributionProportion defines the destination address of the minted coins and the proportion of the minted coins
+// to be sent to the address.
+type DistributionProportion struct {
+	// address defines the address to receive the minted coins
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// proportion defines the proportion of the minted coins to be sent to the address
+	Proportion github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=proportion,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"proportion"`
+}
+
+func (m *DistributionProportion) Reset()         { *m = DistributionProportion{} }
+func (m *DistributionProportion) String() string { return proto.CompactTextString(m) }
+func (*DistributionProportion) ProtoMessage()    {}
+func (*DistributionProportion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe08af702efa1523, []int{2}
+}
+func (m *DistributionProportion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DistributionProportion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DistributionProportion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DistributionProportion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DistributionProportion.Merge(m, src)
+}
+func (m *DistributionProportion) XXX_Size() int {
+	return m.Size()
+}
+func (m *DistributionProportion) XXX_DiscardUnknown() {
+	xxx_messageInfo_DistributionProportion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DistributionProportion proto.InternalMessageInfo
+
+func (m *DistributionProportion) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "crescent.mint.v1beta1.Params")
 	proto.RegisterType((*InflationSchedule)(nil), "crescent.mint.v1beta1.InflationSchedule")
+	proto.RegisterType((*DistributionProportion)(nil), "crescent.mint.v1beta1.DistributionProportion")
 }
 
 func init() { proto.RegisterFile("crescent/mint/v1beta1/mint.proto", fileDescriptor_fe08af702efa1523) }
 
 var fileDescriptor_fe08af702efa1523 = []byte{
-	// 470 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0x4f, 0x6b, 0x13, 0x41,
-	0x18, 0xc6, 0xb3, 0x69, 0x89, 0xcd, 0xe4, 0x50, 0x32, 0x56, 0x88, 0x91, 0x6e, 0x42, 0x0e, 0x12,
-	0x84, 0xce, 0xd0, 0xd8, 0x93, 0x37, 0x97, 0x22, 0xf4, 0x22, 0x65, 0x8d, 0x20, 0x5e, 0x96, 0xd9,
-	0x9d, 0xe9, 0x66, 0xc9, 0xce, 0xbc, 0x61, 0x67, 0xb6, 0xda, 0x8f, 0x20, 0x5e, 0x7a, 0xf4, 0x23,
-	0xf5, 0xd8, 0xa3, 0x78, 0x88, 0x92, 0x7c, 0x03, 0x3f, 0x81, 0xcc, 0xec, 0xae, 0x4a, 0x2b, 0x78,
-	0x4a, 0xe6, 0x79, 0x9f, 0xe7, 0xf7, 0xfe, 0x61, 0xd1, 0x38, 0x29, 0x84, 0x4e, 0x84, 0x32, 0x54,
-	0x66, 0xca, 0xd0, 0xcb, 0xe3, 0x58, 0x18, 0x76, 0xec, 0x1e, 0x64, 0x55, 0x80, 0x01, 0xfc, 0xa8,
-	0x71, 0x10, 0x27, 0xd6, 0x8e, 0xe1, 0x41, 0x0a, 0x29, 0x38, 0x07, 0xb5, 0xff, 0x2a, 0xf3, 0xd0,
-	0x4f, 0x01, 0xd2, 0x5c, 0x50, 0xf7, 0x8a, 0xcb, 0x0b, 0xca, 0xcb, 0x82, 0x99, 0x0c, 0x54, 0x5d,
-	0x1f, 0xdd, 0xad, 0x9b, 0x4c, 0x0a, 0x6d, 0x98, 0x5c, 0x55, 0x86, 0xc9, 0xe7, 0x36, 0xea, 0x9c,
-	0xb3, 0x82, 0x49, 0x8d, 0x0f, 0x11, 0xb2, 0x1d, 0x23, 0x2e, 0x14, 0xc8, 0x81, 0x37, 0xf6, 0xa6,
-	0xdd, 0xb0, 0x6b, 0x95, 0x53, 0x2b, 0xe0, 0x67, 0xa8, 0xef, 0xca, 0x2b, 0x80, 0x3c, 0x62, 0x9c,
-	0x17, 0x42, 0xeb, 0x41, 0xdb, 0xb9, 0xf6, 0x6d, 0xe1, 0x1c, 0x20, 0x7f, 0x59, 0xc9, 0xf8, 0x2d,
-	0x3a, 0x88, 0x73, 0x48, 0x96, 0x91, 0x6d, 0x17, 0x99, 0x45, 0x21, 0xf4, 0x02, 0x72, 0x3e, 0xd8,
-	0x19, 0x7b, 0xd3, 0xde, 0xec, 0x31, 0xa9, 0xa6, 0x22, 0xcd, 0x54, 0xe4, 0xb4, 0x9e, 0x3a, 0xd8,
-	0xbb, 0x59, 0x8f, 0x5a, 0x5f, 0xbe, 0x8f, 0xbc, 0x10, 0x3b, 0xc0, 0x3c, 0x93, 0x62, 0xde, 0xc4,
-	0x71, 0x84, 0x1e, 0x66, 0xea, 0x22, 0x77, 0xd6, 0x48, 0x27, 0x0b, 0xc1, 0xcb, 0x5c, 0xe8, 0xc1,
-	0xee, 0x78, 0x67, 0xda, 0x9b, 0x4d, 0xc9, 0x3f, 0x0f, 0x47, 0xce, 0x9a, 0xc4, 0x9b, 0x3a, 0x10,
-	0xec, 0xda, 0x26, 0x21, 0xce, 0xee, 0x16, 0xf4, 0xe4, 0x53, 0x1b, 0xf5, 0xef, 0xf9, 0xf1, 0x3b,
-	0x84, 0xb4, 0x61, 0x85, 0x71, 0xdb, 0xb8, 0xc3, 0xf4, 0x66, 0xc3, 0x7b, 0x3b, 0xcc, 0x9b, 0xcb,
-	0x06, 0x87, 0x96, 0xff, 0x73, 0x3d, 0xea, 0x5f, 0x31, 0x99, 0xbf, 0x98, 0xfc, 0xc9, 0x4e, 0xae,
-	0xed, 0x66, 0x5d, 0x27, 0x58, 0x3b, 0x0e, 0xd1, 0x9e, 0x50, 0xbc, 0xe2, 0xb6, 0xff, 0xcb, 0x7d,
-	0x52, 0x73, 0xf7, 0x2b, 0x6e, 0x93, 0xac, 0xa8, 0x0f, 0x84, 0xe2, 0x8e, 0xf9, 0x0a, 0x75, 0x98,
-	0x84, 0x52, 0x19, 0x77, 0xed, 0x6e, 0x40, 0x6c, 0xea, 0xdb, 0x7a, 0xf4, 0x34, 0xcd, 0xcc, 0xa2,
-	0x8c, 0x49, 0x02, 0x92, 0x26, 0xa0, 0x25, 0xe8, 0xfa, 0xe7, 0x48, 0xf3, 0x25, 0x35, 0x57, 0x2b,
-	0xa1, 0xc9, 0x99, 0x32, 0x61, 0x9d, 0x0e, 0x5e, 0xdf, 0x6c, 0x7c, 0xef, 0x76, 0xe3, 0x7b, 0x3f,
-	0x36, 0xbe, 0x77, 0xbd, 0xf5, 0x5b, 0xb7, 0x5b, 0xbf, 0xf5, 0x75, 0xeb, 0xb7, 0xde, 0x9f, 0xfc,
-	0x4d, 0xaa, 0x6f, 0x7e, 0xa4, 0x84, 0xf9, 0x00, 0xc5, 0xf2, 0xb7, 0x40, 0x2f, 0x4f, 0xe8, 0xc7,
-	0xea, 0x23, 0x77, 0xec, 0xb8, 0xe3, 0x36, 0x7a, 0xfe, 0x2b, 0x00, 0x00, 0xff, 0xff, 0x9e, 0xf3,
-	0xfb, 0x63, 0x02, 0x03, 0x00, 0x00,
+	// 548 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x3f, 0x6f, 0xd3, 0x40,
+	0x14, 0x8f, 0x93, 0x92, 0x36, 0x97, 0xa1, 0xca, 0x51, 0xc0, 0x04, 0xd5, 0x8e, 0x3c, 0x40, 0x84,
+	0x14, 0x5b, 0x0d, 0x9d, 0xd8, 0xb0, 0x22, 0xa4, 0x2e, 0x55, 0x64, 0x82, 0x84, 0x58, 0x2c, 0xff,
+	0xb9, 0x26, 0x56, 0x6c, 0x3f, 0xeb, 0xee, 0x5c, 0xe8, 0xca, 0xc6, 0x56, 0x36, 0x36, 0xbe, 0x4e,
+	0xc7, 0x8e, 0x88, 0x21, 0xa0, 0xe4, 0x1b, 0xf0, 0x09, 0xd0, 0x9d, 0xed, 0x36, 0x6a, 0x53, 0xa1,
+	0x4e, 0xb9, 0x7b, 0xef, 0xf7, 0xe7, 0xe5, 0x77, 0xcf, 0xa8, 0x17, 0x50, 0xc2, 0x02, 0x92, 0x72,
+	0x2b, 0x89, 0x52, 0x6e, 0x9d, 0x1e, 0xf8, 0x84, 0x7b, 0x07, 0xf2, 0x62, 0x66, 0x14, 0x38, 0xe0,
+	0x47, 0x15, 0xc2, 0x94, 0xc5, 0x12, 0xd1, 0xdd, 0x9b, 0xc2, 0x14, 0x24, 0xc2, 0x12, 0xa7, 0x02,
+	0xdc, 0xd5, 0xa6, 0x00, 0xd3, 0x98, 0x58, 0xf2, 0xe6, 0xe7, 0x27, 0x56, 0x98, 0x53, 0x8f, 0x47,
+	0x90, 0x96, 0x7d, 0xfd, 0x66, 0x9f, 0x47, 0x09, 0x61, 0xdc, 0x4b, 0xb2, 0x02, 0x60, 0xfc, 0x68,
+	0xa0, 0xe6, 0xd8, 0xa3, 0x5e, 0xc2, 0xf0, 0x3e, 0x42, 0xc2, 0xd1, 0x0d, 0x49, 0x0a, 0x89, 0xaa,
+	0xf4, 0x94, 0x7e, 0xcb, 0x69, 0x89, 0xca, 0x48, 0x14, 0xf0, 0x4b, 0xd4, 0x91, 0xed, 0x0c, 0x20,
+	0x76, 0xbd, 0x30, 0xa4, 0x84, 0x31, 0xb5, 0x2e, 0x51, 0xbb, 0xa2, 0x31, 0x06, 0x88, 0xdf, 0x14,
+	0x65, 0xfc, 0x1e, 0xed, 0xf9, 0x31, 0x04, 0x73, 0x57, 0xd8, 0xb9, 0x7c, 0x46, 0x09, 0x9b, 0x41,
+	0x1c, 0xaa, 0x8d, 0x9e, 0xd2, 0x6f, 0x0f, 0x9f, 0x9a, 0xc5, 0x54, 0x66, 0x35, 0x95, 0x39, 0x2a,
+	0xa7, 0xb6, 0x77, 0x2e, 0x16, 0x7a, 0xed, 0xfb, 0x6f, 0x5d, 0x71, 0xb0, 0x14, 0x98, 0x44, 0x09,
+	0x99, 0x54, 0x74, 0xec, 0xa2, 0x87, 0x51, 0x7a, 0x12, 0x4b, 0xa8, 0xcb, 0x82, 0x19, 0x09, 0xf3,
+	0x98, 0x30, 0x75, 0xab, 0xd7, 0xe8, 0xb7, 0x87, 0x7d, 0x73, 0x63, 0x70, 0xe6, 0x51, 0xc5, 0x78,
+	0x57, 0x12, 0xec, 0x2d, 0x61, 0xe2, 0xe0, 0xe8, 0x66, 0x83, 0xe1, 0x6f, 0x0a, 0x52, 0xc3, 0x88,
+	0x71, 0x1a, 0xf9, 0xb9, 0x34, 0xc9, 0x28, 0x64, 0x40, 0xc5, 0x91, 0xa9, 0x0f, 0xa4, 0xcd, 0xe0,
+	0x0e, 0x9b, 0xd1, 0x1a, 0x6d, 0x7c, 0xc5, 0xb2, 0x5f, 0x08, 0xaf, 0xbf, 0x0b, 0x5d, 0x3f, 0xf3,
+	0x92, 0xf8, 0xb5, 0x71, 0x97, 0xb8, 0xe1, 0x3c, 0x09, 0x37, 0x0a, 0x30, 0xe3, 0x6b, 0x1d, 0x75,
+	0x6e, 0xfd, 0x07, 0xfc, 0x01, 0x21, 0xc6, 0x3d, 0xca, 0x65, 0xc2, 0xf2, 0xb1, 0xda, 0xc3, 0xee,
+	0xad, 0x5c, 0x27, 0xd5, 0x6b, 0xdb, 0xfb, 0xe5, 0x1c, 0x9d, 0x62, 0x8e, 0x6b, 0xae, 0x71, 0x2e,
+	0xd2, 0x6e, 0xc9, 0x82, 0x80, 0x63, 0x07, 0xed, 0x90, 0x34, 0x2c, 0x74, 0xeb, 0xff, 0xd5, 0x7d,
+	0x56, 0xea, 0xee, 0x16, 0xba, 0x15, 0xb3, 0x50, 0xdd, 0x26, 0x69, 0x28, 0x35, 0xdf, 0xa2, 0xa6,
+	0x97, 0x40, 0x9e, 0x72, 0xb9, 0x01, 0x2d, 0xdb, 0x14, 0xac, 0x5f, 0x0b, 0xfd, 0xf9, 0x34, 0xe2,
+	0xb3, 0xdc, 0x37, 0x03, 0x48, 0xac, 0x00, 0x58, 0x02, 0xac, 0xfc, 0x19, 0xb0, 0x70, 0x6e, 0xf1,
+	0xb3, 0x8c, 0x30, 0xf3, 0x28, 0xe5, 0x4e, 0xc9, 0x36, 0xbe, 0x28, 0xe8, 0xf1, 0xe6, 0xa0, 0xb1,
+	0x8a, 0xb6, 0xab, 0xa5, 0x2c, 0x56, 0xb7, 0xba, 0xe2, 0x63, 0x84, 0xae, 0x93, 0x56, 0xeb, 0xf7,
+	0x1e, 0x60, 0x44, 0x02, 0x67, 0x4d, 0xc1, 0x3e, 0xbe, 0x58, 0x6a, 0xca, 0xe5, 0x52, 0x53, 0xfe,
+	0x2c, 0x35, 0xe5, 0x7c, 0xa5, 0xd5, 0x2e, 0x57, 0x5a, 0xed, 0xe7, 0x4a, 0xab, 0x7d, 0x3c, 0x5c,
+	0x57, 0x2b, 0xb7, 0x64, 0x90, 0x12, 0xfe, 0x09, 0xe8, 0xfc, 0xaa, 0x60, 0x9d, 0x1e, 0x5a, 0x9f,
+	0x8b, 0xaf, 0x5f, 0xea, 0xfb, 0x4d, 0x19, 0xeb, 0xab, 0x7f, 0x03, 0x00, 0x6f, 0xff, 0x1c, 0xad,
+	0x1b, 0x04, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -224,6 +289,20 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DistributionProportions) > 0 {
+		for iNdEx := len(m.DistributionProportions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DistributionProportions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.InflationSchedules) > 0 {
 		for iNdEx := len(m.InflationSchedules) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -312,6 +391,46 @@ func (m *InflationSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DistributionProportion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DistributionProportion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DistributionProportion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Proportion.Size()
+		i -= size
+		if _, err := m.Proportion.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMint(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMint(v)
 	base := offset
@@ -345,6 +464,12 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovMint(uint64(l))
 		}
 	}
+	if len(m.DistributionProportions) > 0 {
+		for _, e := range m.DistributionProportions {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -363,6 +488,21 @@ func (m *InflationSchedule) Size() (n int) {
 	return n
 }
 
+func (m *DistributionProportion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = m.Proportion.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
 func sovMint(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -529,6 +669,40 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionProportions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistributionProportions = append(m.DistributionProportions, DistributionProportion{})
+			if err := m.DistributionProportions[len(m.DistributionProportions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMint(dAtA[iNdEx:])
@@ -700,6 +874,122 @@ func (m *InflationSchedule) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DistributionProportion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DistributionProportion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DistributionProportion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proportion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Proportion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipMint(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -13,6 +13,10 @@ import (
 	utils "github.com/crescent-network/crescent/v4/types"
 )
 
+// Year is the duration of a year used to scale the amount of the inflation
+// schedule in progress to annual provisions.
+const Year = 365 * 24 * time.Hour
+
 // Parameter store keys
 var (
 	KeyMintDenom          = []byte("MintDenom")
@@ -20,6 +24,8 @@ var (
 	KeyBlockTimeThreshold = []byte("BlockTimeThreshold")
 	KeyInflationSchedules = []byte("InflationSchedules")
 
+	KeyDistributionProportions = []byte("DistributionProportions")
+
 	DefaultBlockTimeThreshold = 10 * time.Second
 
 	// DefaultMintPoolAddress is the fee collector of the auth module such as the mint module of the original cosmos-sdk
@@ -57,6 +63,8 @@ func DefaultParams() Params {
 		MintPoolAddress:    DefaultMintPoolAddress.String(),
 		BlockTimeThreshold: DefaultBlockTimeThreshold,
 		InflationSchedules: DefaultInflationSchedules,
+		// all minted coins are sent to the mint pool by default
+		DistributionProportions: []DistributionProportion{},
 	}
 }
 
@@ -74,6 +82,9 @@ func (p Params) Validate() error {
 	if err := validateInflationSchedules(p.InflationSchedules); err != nil {
 		return err
 	}
+	if err := validateDistributionProportions(p.DistributionProportions); err != nil {
+		return err
+	}
 	return nil
 
 }
@@ -85,6 +96,7 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyMintPoolAddress, &p.MintPoolAddress, validateMintPoolAddress),
 		paramtypes.NewParamSetPair(KeyBlockTimeThreshold, &p.BlockTimeThreshold, validateBlockTimeThreshold),
 		paramtypes.NewParamSetPair(KeyInflationSchedules, &p.InflationSchedules, validateInflationSchedules),
+		paramtypes.NewParamSetPair(KeyDistributionProportions, &p.DistributionProportions, validateDistributionProportions),
 	}
 }
 
@@ -153,3 +165,32 @@ func validateInflationSchedules(i interface{}) error {
 	}
 	return nil
 }
+
+func validateDistributionProportions(i interface{}) error {
+	v, ok := i.([]DistributionProportion)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if len(v) == 0 {
+		return nil
+	}
+	total := sdk.ZeroDec()
+	addrs := map[string]struct{}{}
+	for _, dp := range v {
+		if _, err := sdk.AccAddressFromBech32(dp.Address); err != nil {
+			return fmt.Errorf("invalid distribution address %q: %w", dp.Address, err)
+		}
+		if _, ok := addrs[dp.Address]; ok {
+			return fmt.Errorf("duplicate distribution address: %s", dp.Address)
+		}
+		addrs[dp.Address] = struct{}{}
+		if dp.Proportion.IsNil() || !dp.Proportion.IsPositive() {
+			return fmt.Errorf("distribution proportion must be positive: %s", dp.Proportion)
+		}
+		total = total.Add(dp.Proportion)
+	}
+	if !total.Equal(sdk.OneDec()) {
+		return fmt.Errorf("sum of distribution proportions must be 1: %s", total)
+	}
+	return nil
+}
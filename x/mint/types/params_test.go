@@ -184,6 +184,55 @@ func TestParamsValidate(t *testing.T) {
 			},
 			"",
 		},
+		{
+			"valid distribution proportions",
+			func(params *types.Params) {
+				params.DistributionProportions = []types.DistributionProportion{
+					{Address: "cosmos17xpfvakm2amg962yls6f84z3kell8c5lserqta", Proportion: utils.ParseDec("0.7")},
+					{Address: "cosmos1m3h30wlvsf8llruxtpukdvsy0km2kum8g38c8q", Proportion: utils.ParseDec("0.3")},
+				}
+			},
+			"",
+		},
+		{
+			"invalid distribution address",
+			func(params *types.Params) {
+				params.DistributionProportions = []types.DistributionProportion{
+					{Address: "abc123", Proportion: utils.ParseDec("1.0")},
+				}
+			},
+			"invalid distribution address \"abc123\": decoding bech32 failed: invalid bech32 string length 6",
+		},
+		{
+			"duplicate distribution address",
+			func(params *types.Params) {
+				params.DistributionProportions = []types.DistributionProportion{
+					{Address: "cosmos17xpfvakm2amg962yls6f84z3kell8c5lserqta", Proportion: utils.ParseDec("0.5")},
+					{Address: "cosmos17xpfvakm2amg962yls6f84z3kell8c5lserqta", Proportion: utils.ParseDec("0.5")},
+				}
+			},
+			"duplicate distribution address: cosmos17xpfvakm2amg962yls6f84z3kell8c5lserqta",
+		},
+		{
+			"zero distribution proportion",
+			func(params *types.Params) {
+				params.DistributionProportions = []types.DistributionProportion{
+					{Address: "cosmos17xpfvakm2amg962yls6f84z3kell8c5lserqta", Proportion: utils.ParseDec("1.0")},
+					{Address: "cosmos1m3h30wlvsf8llruxtpukdvsy0km2kum8g38c8q", Proportion: sdk.ZeroDec()},
+				}
+			},
+			"distribution proportion must be positive: 0.000000000000000000",
+		},
+		{
+			"distribution proportions not summing to 1",
+			func(params *types.Params) {
+				params.DistributionProportions = []types.DistributionProportion{
+					{Address: "cosmos17xpfvakm2amg962yls6f84z3kell8c5lserqta", Proportion: utils.ParseDec("0.7")},
+					{Address: "cosmos1m3h30wlvsf8llruxtpukdvsy0km2kum8g38c8q", Proportion: utils.ParseDec("0.2")},
+				}
+			},
+			"sum of distribution proportions must be 1: 0.900000000000000000",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,66 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	gov "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	ProposalTypeInflationSchedule string = "InflationSchedule"
+)
+
+var (
+	_ gov.Content = &InflationScheduleProposal{}
+)
+
+func init() {
+	gov.RegisterProposalType(ProposalTypeInflationSchedule)
+	gov.RegisterProposalTypeCodec(&InflationScheduleProposal{}, "crescent/InflationScheduleProposal")
+}
+
+// NewInflationScheduleProposal returns a new InflationScheduleProposal.
+func NewInflationScheduleProposal(
+	title, description string, replace bool, schedules []InflationSchedule) *InflationScheduleProposal {
+	return &InflationScheduleProposal{
+		Title:              title,
+		Description:        description,
+		Replace:            replace,
+		InflationSchedules: schedules,
+	}
+}
+
+func (p *InflationScheduleProposal) GetTitle() string       { return p.Title }
+func (p *InflationScheduleProposal) GetDescription() string { return p.Description }
+func (p *InflationScheduleProposal) ProposalRoute() string  { return RouterKey }
+func (p *InflationScheduleProposal) ProposalType() string   { return ProposalTypeInflationSchedule }
+
+func (p *InflationScheduleProposal) ValidateBasic() error {
+	// An empty replacing proposal cancels all inflation schedules which have
+	// not started yet.
+	if !p.Replace && len(p.InflationSchedules) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "inflation schedules must not be empty")
+	}
+	if err := validateInflationSchedules(p.InflationSchedules); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return gov.ValidateAbstract(p)
+}
+
+func (p InflationScheduleProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Inflation Schedule Proposal:
+  Title:       %s
+  Description: %s
+  Replace:     %t
+  Inflation Schedules:
+`, p.Title, p.Description, p.Replace))
+	for _, schedule := range p.InflationSchedules {
+		b.WriteString(fmt.Sprintf("    %s ~ %s: %s\n",
+			schedule.StartTime.Format(time.RFC3339), schedule.EndTime.Format(time.RFC3339), schedule.Amount))
+	}
+	return b.String()
+}
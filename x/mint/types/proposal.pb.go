@@ -0,0 +1,458 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: crescent/mint/v1beta1/proposal.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// InflationScheduleProposal defines a proposal to replace or append inflation
+// schedules without a full parameter change proposal.
+type InflationScheduleProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// replace specifies whether to replace the inflation schedules which have
+	// not started yet with the given schedules instead of appending the given
+	// schedules to the existing ones.
+	// Schedules which have already started are kept in both cases.
+	Replace bool `protobuf:"varint,3,opt,name=replace,proto3" json:"replace,omitempty"`
+	// inflation_schedules specifies the inflation schedules to add
+	InflationSchedules []InflationSchedule `protobuf:"bytes,4,rep,name=inflation_schedules,json=inflationSchedules,proto3" json:"inflation_schedules"`
+}
+
+func (m *InflationScheduleProposal) Reset()      { *m = InflationScheduleProposal{} }
+func (*InflationScheduleProposal) ProtoMessage() {}
+func (*InflationScheduleProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_66efc5610029be17, []int{0}
+}
+func (m *InflationScheduleProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InflationScheduleProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InflationScheduleProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InflationScheduleProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InflationScheduleProposal.Merge(m, src)
+}
+func (m *InflationScheduleProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *InflationScheduleProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_InflationScheduleProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InflationScheduleProposal proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*InflationScheduleProposal)(nil), "crescent.mint.v1beta1.InflationScheduleProposal")
+}
+
+func init() {
+	proto.RegisterFile("crescent/mint/v1beta1/proposal.proto", fileDescriptor_66efc5610029be17)
+}
+
+var fileDescriptor_66efc5610029be17 = []byte{
+	// 292 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0xbd, 0x4e, 0xf3, 0x30,
+	0x14, 0x86, 0xed, 0xaf, 0xfd, 0xf8, 0x71, 0x37, 0x53, 0xa4, 0xd0, 0xc1, 0x8d, 0x10, 0x43, 0x16,
+	0x6c, 0x15, 0x3a, 0x31, 0x76, 0x63, 0x43, 0x61, 0x63, 0xa9, 0x52, 0xd7, 0xa4, 0x16, 0x69, 0x6c,
+	0xd9, 0x6e, 0x81, 0xbb, 0x60, 0x64, 0xe4, 0x72, 0x32, 0x76, 0x60, 0x60, 0x42, 0x90, 0xdc, 0x08,
+	0xca, 0x1f, 0x42, 0xd0, 0xcd, 0xe7, 0x9c, 0xe7, 0xf8, 0xb1, 0x5f, 0x74, 0xc2, 0x8d, 0xb0, 0x5c,
+	0xa4, 0x8e, 0x2d, 0x65, 0xea, 0xd8, 0x7a, 0x34, 0x13, 0x2e, 0x1a, 0x31, 0x6d, 0x94, 0x56, 0x36,
+	0x4a, 0xa8, 0x36, 0xca, 0x29, 0x7c, 0xd8, 0x52, 0xb4, 0xa4, 0x68, 0x43, 0x0d, 0xfa, 0xb1, 0x8a,
+	0x55, 0x45, 0xb0, 0xf2, 0x54, 0xc3, 0x03, 0x7f, 0xfb, 0x95, 0xd5, 0x66, 0x45, 0x1c, 0xbf, 0x42,
+	0x74, 0x74, 0x99, 0xde, 0x26, 0x91, 0x93, 0x2a, 0xbd, 0xe6, 0x0b, 0x31, 0x5f, 0x25, 0xe2, 0xaa,
+	0x51, 0xe2, 0x3e, 0xfa, 0xef, 0xa4, 0x4b, 0x84, 0x07, 0x7d, 0x18, 0xec, 0x87, 0x75, 0x81, 0x7d,
+	0xd4, 0x9b, 0x0b, 0xcb, 0x8d, 0xd4, 0xe5, 0x92, 0xf7, 0xaf, 0x9a, 0xfd, 0x6c, 0x61, 0x0f, 0xed,
+	0x1a, 0xa1, 0x93, 0x88, 0x0b, 0xaf, 0xe3, 0xc3, 0x60, 0x2f, 0x6c, 0x4b, 0x3c, 0x45, 0x07, 0xb2,
+	0xd5, 0x4d, 0x6d, 0xe3, 0xb3, 0x5e, 0xd7, 0xef, 0x04, 0xbd, 0xb3, 0x80, 0x6e, 0xfd, 0x1c, 0xfd,
+	0xf3, 0xc0, 0x49, 0x37, 0x7b, 0x1f, 0x82, 0x10, 0xcb, 0xdf, 0x03, 0x7b, 0xd1, 0x7d, 0x7e, 0x19,
+	0x82, 0x49, 0x98, 0x7d, 0x12, 0x90, 0xe5, 0x04, 0x6e, 0x72, 0x02, 0x3f, 0x72, 0x02, 0x9f, 0x0a,
+	0x02, 0x36, 0x05, 0x01, 0x6f, 0x05, 0x01, 0x37, 0xe3, 0x58, 0xba, 0xc5, 0x6a, 0x46, 0xb9, 0x5a,
+	0xb2, 0xd6, 0x78, 0x9a, 0x0a, 0x77, 0xaf, 0xcc, 0xdd, 0x77, 0x83, 0xad, 0xc7, 0xec, 0xa1, 0xce,
+	0xcd, 0x3d, 0x6a, 0x61, 0x67, 0x3b, 0x55, 0x62, 0xe7, 0x5f, 0x03, 0x00, 0x72, 0x2d, 0x51, 0x53,
+	0xa8, 0x01, 0x00, 0x00,
+}
+
+func (m *InflationScheduleProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InflationScheduleProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InflationScheduleProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.InflationSchedules) > 0 {
+		for iNdEx := len(m.InflationSchedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InflationSchedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProposal(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Replace {
+		i--
+		if m.Replace {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintProposal(dAtA []byte, offset int, v uint64) int {
+	offset -= sovProposal(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *InflationScheduleProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if m.Replace {
+		n += 2
+	}
+	if len(m.InflationSchedules) > 0 {
+		for _, e := range m.InflationSchedules {
+			l = e.Size()
+			n += 1 + l + sovProposal(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovProposal(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozProposal(x uint64) (n int) {
+	return sovProposal(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *InflationScheduleProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InflationScheduleProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InflationScheduleProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replace", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Replace = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationSchedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InflationSchedules = append(m.InflationSchedules, InflationSchedule{})
+			if err := m.InflationSchedules[len(m.InflationSchedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipProposal(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthProposal
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupProposal
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthProposal
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthProposal        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowProposal          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupProposal = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,90 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	utils "github.com/crescent-network/crescent/v4/types"
+	"github.com/crescent-network/crescent/v4/x/mint/types"
+)
+
+func TestInflationScheduleProposal_ValidateBasic(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		malleate    func(p *types.InflationScheduleProposal)
+		expectedErr string
+	}{
+		{
+			"happy case",
+			func(p *types.InflationScheduleProposal) {},
+			"",
+		},
+		{
+			"empty schedules",
+			func(p *types.InflationScheduleProposal) {
+				p.InflationSchedules = nil
+			},
+			"inflation schedules must not be empty: invalid request",
+		},
+		{
+			"empty schedules with replace",
+			func(p *types.InflationScheduleProposal) {
+				p.Replace = true
+				p.InflationSchedules = nil
+			},
+			"",
+		},
+		{
+			"non-positive amount",
+			func(p *types.InflationScheduleProposal) {
+				p.InflationSchedules[0].Amount = sdk.ZeroInt()
+			},
+			"inflation schedule amount must be positive: 0: invalid request",
+		},
+		{
+			"end time before start time",
+			func(p *types.InflationScheduleProposal) {
+				p.InflationSchedules[0].EndTime = utils.ParseTime("2023-12-01T00:00:00Z")
+			},
+			"inflation end time 2023-12-01T00:00:00Z must be greater than start time 2024-01-01T00:00:00Z: invalid request",
+		},
+		{
+			"overlapping schedules",
+			func(p *types.InflationScheduleProposal) {
+				p.InflationSchedules = append(p.InflationSchedules, types.InflationSchedule{
+					StartTime: utils.ParseTime("2024-06-01T00:00:00Z"),
+					EndTime:   utils.ParseTime("2025-06-01T00:00:00Z"),
+					Amount:    sdk.NewInt(100000000000000),
+				})
+			},
+			"inflation periods cannot be overlapped 2024-01-01T00:00:00Z ~ 2025-01-01T00:00:00Z with 2024-06-01T00:00:00Z ~ 2025-06-01T00:00:00Z: invalid request",
+		},
+		{
+			"empty title",
+			func(p *types.InflationScheduleProposal) {
+				p.Title = ""
+			},
+			"proposal title cannot be blank: invalid proposal content",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			p := types.NewInflationScheduleProposal("title", "description", false,
+				[]types.InflationSchedule{
+					{
+						StartTime: utils.ParseTime("2024-01-01T00:00:00Z"),
+						EndTime:   utils.ParseTime("2025-01-01T00:00:00Z"),
+						Amount:    sdk.NewInt(100000000000000),
+					},
+				})
+			tc.malleate(p)
+			err := p.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
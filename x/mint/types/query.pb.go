@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -198,44 +199,211 @@ func (m *QueryLastBlockTimeResponse) GetLastBlockTime() *time.Time {
 	return nil
 }
 
+// QueryInflationRequest is the request type for the Query/Inflation RPC method.
+type QueryInflationRequest struct {
+}
+
+func (m *QueryInflationRequest) Reset()         { *m = QueryInflationRequest{} }
+func (m *QueryInflationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryInflationRequest) ProtoMessage()    {}
+func (*QueryInflationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d489ab0cf384bb65, []int{4}
+}
+func (m *QueryInflationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInflationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInflationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInflationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInflationRequest.Merge(m, src)
+}
+func (m *QueryInflationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInflationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInflationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInflationRequest proto.InternalMessageInfo
+
+// QueryInflationResponse is the response type for the Query/Inflation RPC method.
+type QueryInflationResponse struct {
+	// inflation is the annual provisions divided by the current supply of the mint denom
+	Inflation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=inflation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflation"`
+}
+
+func (m *QueryInflationResponse) Reset()         { *m = QueryInflationResponse{} }
+func (m *QueryInflationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryInflationResponse) ProtoMessage()    {}
+func (*QueryInflationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d489ab0cf384bb65, []int{5}
+}
+func (m *QueryInflationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInflationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInflationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInflationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInflationResponse.Merge(m, src)
+}
+func (m *QueryInflationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInflationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInflationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInflationResponse proto.InternalMessageInfo
+
+// QueryAnnualProvisionsRequest is the request type for the Query/AnnualProvisions RPC method.
+type QueryAnnualProvisionsRequest struct {
+}
+
+func (m *QueryAnnualProvisionsRequest) Reset()         { *m = QueryAnnualProvisionsRequest{} }
+func (m *QueryAnnualProvisionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAnnualProvisionsRequest) ProtoMessage()    {}
+func (*QueryAnnualProvisionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d489ab0cf384bb65, []int{6}
+}
+func (m *QueryAnnualProvisionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAnnualProvisionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAnnualProvisionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAnnualProvisionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAnnualProvisionsRequest.Merge(m, src)
+}
+func (m *QueryAnnualProvisionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAnnualProvisionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAnnualProvisionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAnnualProvisionsRequest proto.InternalMessageInfo
+
+// QueryAnnualProvisionsResponse is the response type for the Query/AnnualProvisions RPC method.
+type QueryAnnualProvisionsResponse struct {
+	// annual_provisions is the amount of the inflation schedule in progress scaled to a year
+	AnnualProvisions github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=annual_provisions,json=annualProvisions,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"annual_provisions" yaml:"annual_provisions"`
+}
+
+func (m *QueryAnnualProvisionsResponse) Reset()         { *m = QueryAnnualProvisionsResponse{} }
+func (m *QueryAnnualProvisionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAnnualProvisionsResponse) ProtoMessage()    {}
+func (*QueryAnnualProvisionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d489ab0cf384bb65, []int{7}
+}
+func (m *QueryAnnualProvisionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAnnualProvisionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAnnualProvisionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAnnualProvisionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAnnualProvisionsResponse.Merge(m, src)
+}
+func (m *QueryAnnualProvisionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAnnualProvisionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAnnualProvisionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAnnualProvisionsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "crescent.mint.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "crescent.mint.v1beta1.QueryParamsResponse")
 	proto.RegisterType((*QueryLastBlockTimeRequest)(nil), "crescent.mint.v1beta1.QueryLastBlockTimeRequest")
 	proto.RegisterType((*QueryLastBlockTimeResponse)(nil), "crescent.mint.v1beta1.QueryLastBlockTimeResponse")
+	proto.RegisterType((*QueryInflationRequest)(nil), "crescent.mint.v1beta1.QueryInflationRequest")
+	proto.RegisterType((*QueryInflationResponse)(nil), "crescent.mint.v1beta1.QueryInflationResponse")
+	proto.RegisterType((*QueryAnnualProvisionsRequest)(nil), "crescent.mint.v1beta1.QueryAnnualProvisionsRequest")
+	proto.RegisterType((*QueryAnnualProvisionsResponse)(nil), "crescent.mint.v1beta1.QueryAnnualProvisionsResponse")
 }
 
 func init() { proto.RegisterFile("crescent/mint/v1beta1/query.proto", fileDescriptor_d489ab0cf384bb65) }
 
 var fileDescriptor_d489ab0cf384bb65 = []byte{
-	// 419 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x51, 0xbd, 0xce, 0xd3, 0x30,
-	0x14, 0x8d, 0x2b, 0xe8, 0x60, 0x54, 0x21, 0x99, 0x82, 0x20, 0xd0, 0xa4, 0x44, 0x02, 0x15, 0x24,
-	0x6c, 0x5a, 0x3a, 0xc1, 0x96, 0x19, 0x21, 0x88, 0x3a, 0xb1, 0x54, 0x4e, 0x64, 0x42, 0xd4, 0x24,
-	0x4e, 0x63, 0xa7, 0xd0, 0x0d, 0x31, 0x30, 0x57, 0xe2, 0x15, 0x78, 0x07, 0x5e, 0xa1, 0x63, 0x25,
-	0x16, 0xa6, 0x82, 0x5a, 0x9e, 0x80, 0x27, 0x40, 0x71, 0x1c, 0xa0, 0x25, 0x45, 0xdf, 0xb7, 0x25,
-	0xbe, 0xe7, 0xdc, 0xf3, 0x73, 0xe1, 0xed, 0x20, 0x67, 0x22, 0x60, 0xa9, 0x24, 0x49, 0x94, 0x4a,
-	0xb2, 0x18, 0xfa, 0x4c, 0xd2, 0x21, 0x99, 0x17, 0x2c, 0x5f, 0xe2, 0x2c, 0xe7, 0x92, 0xa3, 0xab,
-	0x35, 0x04, 0x97, 0x10, 0xac, 0x21, 0x66, 0x37, 0xe4, 0x21, 0x57, 0x08, 0x52, 0x7e, 0x55, 0x60,
-	0xf3, 0x56, 0xc8, 0x79, 0x18, 0x33, 0x42, 0xb3, 0x88, 0xd0, 0x34, 0xe5, 0x92, 0xca, 0x88, 0xa7,
-	0x42, 0x4f, 0x6d, 0x3d, 0x55, 0x7f, 0x7e, 0xf1, 0x8a, 0xc8, 0x28, 0x61, 0x42, 0xd2, 0x24, 0xd3,
-	0x80, 0x7e, 0xb3, 0x1d, 0x25, 0xac, 0x10, 0x4e, 0x17, 0xa2, 0x17, 0xa5, 0xb9, 0xe7, 0x34, 0xa7,
-	0x89, 0xf0, 0xd8, 0xbc, 0x60, 0x42, 0x3a, 0x1e, 0xbc, 0x72, 0xf0, 0x2a, 0x32, 0x9e, 0x0a, 0x86,
-	0x9e, 0xc0, 0x76, 0xa6, 0x5e, 0xae, 0x83, 0x3e, 0x18, 0x5c, 0x1a, 0xf5, 0x70, 0x63, 0x16, 0x5c,
-	0xd1, 0xdc, 0x0b, 0xeb, 0xad, 0x6d, 0x78, 0x9a, 0xe2, 0xdc, 0x84, 0x37, 0xd4, 0xce, 0xa7, 0x54,
-	0x48, 0x37, 0xe6, 0xc1, 0x6c, 0x12, 0x25, 0xac, 0x16, 0x7c, 0x07, 0xa0, 0xd9, 0x34, 0xd5, 0xc2,
-	0x3e, 0xbc, 0x1c, 0x53, 0x21, 0xa7, 0x7e, 0x39, 0x99, 0x96, 0x29, 0xb5, 0x03, 0x13, 0x57, 0x15,
-	0xe0, 0xba, 0x02, 0x3c, 0xa9, 0x2b, 0x70, 0xad, 0x9f, 0x5b, 0xfb, 0xda, 0x92, 0x26, 0xf1, 0x63,
-	0xe7, 0x88, 0xec, 0xac, 0xbe, 0xd9, 0xc0, 0xeb, 0xc4, 0x7f, 0x6b, 0x8d, 0x3e, 0xb7, 0xe0, 0x45,
-	0x65, 0x01, 0x7d, 0x00, 0xb0, 0x5d, 0x45, 0x40, 0xf7, 0x4e, 0x24, 0xfc, 0xb7, 0x33, 0xf3, 0xfe,
-	0x59, 0xa0, 0x55, 0x1e, 0xe7, 0xce, 0xfb, 0x2f, 0x3f, 0x3e, 0xb6, 0x6c, 0xd4, 0x23, 0xcd, 0x07,
-	0xaa, 0x2a, 0x43, 0x9f, 0x00, 0xec, 0x1c, 0x14, 0x82, 0x1e, 0xfe, 0x4f, 0xa4, 0xa9, 0x59, 0x73,
-	0x78, 0x0e, 0x86, 0x76, 0x87, 0x95, 0xbb, 0x01, 0xba, 0x7b, 0xc2, 0xdd, 0x51, 0x9b, 0xee, 0xb3,
-	0xf5, 0xce, 0x02, 0x9b, 0x9d, 0x05, 0xbe, 0xef, 0x2c, 0xb0, 0xda, 0x5b, 0xc6, 0x66, 0x6f, 0x19,
-	0x5f, 0xf7, 0x96, 0xf1, 0x72, 0x1c, 0x46, 0xf2, 0x75, 0xe1, 0xe3, 0x80, 0x27, 0xbf, 0x77, 0x3d,
-	0x48, 0x99, 0x7c, 0xc3, 0xf3, 0xd9, 0x9f, 0xe5, 0x8b, 0x31, 0x79, 0x5b, 0x29, 0xc8, 0x65, 0xc6,
-	0x84, 0xdf, 0x56, 0xc7, 0x7c, 0xf4, 0x2b, 0x00, 0x00, 0xff, 0xff, 0xd0, 0x79, 0x0f, 0xc7, 0x4d,
-	0x03, 0x00, 0x00,
+	// 596 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x31, 0x6f, 0xd3, 0x4e,
+	0x18, 0xc6, 0x73, 0x7f, 0xf5, 0x5f, 0xa9, 0x87, 0x2a, 0xca, 0xd1, 0x96, 0x62, 0x5a, 0xbb, 0x58,
+	0xa2, 0x0a, 0x15, 0x39, 0x37, 0x6d, 0x26, 0x98, 0x88, 0x58, 0x40, 0x15, 0x2a, 0x56, 0x27, 0x96,
+	0xe8, 0x6c, 0x2e, 0xc1, 0x8a, 0xed, 0x73, 0x7d, 0x97, 0x94, 0x6c, 0x88, 0x81, 0xb9, 0x82, 0x85,
+	0x0f, 0xc0, 0xce, 0xd7, 0xe8, 0x58, 0x89, 0x05, 0x31, 0x04, 0x94, 0xf0, 0x09, 0xfa, 0x01, 0x10,
+	0xf2, 0xf9, 0x9c, 0xb6, 0x4e, 0x1c, 0x35, 0x53, 0x92, 0x7b, 0x9f, 0xf7, 0x7d, 0x7e, 0xf7, 0xfa,
+	0x89, 0xe1, 0x7d, 0x37, 0xa6, 0xdc, 0xa5, 0xa1, 0xb0, 0x02, 0x2f, 0x14, 0x56, 0xb7, 0xea, 0x50,
+	0x41, 0xaa, 0xd6, 0x51, 0x87, 0xc6, 0x3d, 0x1c, 0xc5, 0x4c, 0x30, 0xb4, 0x92, 0x49, 0x70, 0x22,
+	0xc1, 0x4a, 0xa2, 0x2d, 0xb7, 0x58, 0x8b, 0x49, 0x85, 0x95, 0x7c, 0x4b, 0xc5, 0xda, 0x7a, 0x8b,
+	0xb1, 0x96, 0x4f, 0x2d, 0x12, 0x79, 0x16, 0x09, 0x43, 0x26, 0x88, 0xf0, 0x58, 0xc8, 0x55, 0xd5,
+	0x50, 0x55, 0xf9, 0xcb, 0xe9, 0x34, 0x2d, 0xe1, 0x05, 0x94, 0x0b, 0x12, 0x44, 0x4a, 0xb0, 0x39,
+	0x19, 0x47, 0x1a, 0x4b, 0x85, 0xb9, 0x0c, 0xd1, 0xab, 0x04, 0xee, 0x80, 0xc4, 0x24, 0xe0, 0x36,
+	0x3d, 0xea, 0x50, 0x2e, 0x4c, 0x1b, 0xde, 0xbe, 0x72, 0xca, 0x23, 0x16, 0x72, 0x8a, 0x9e, 0xc0,
+	0xf9, 0x48, 0x9e, 0xac, 0x81, 0x4d, 0x50, 0xbe, 0xb1, 0xbb, 0x81, 0x27, 0xde, 0x05, 0xa7, 0x6d,
+	0xf5, 0xb9, 0xd3, 0xbe, 0x51, 0xb2, 0x55, 0x8b, 0x79, 0x0f, 0xde, 0x95, 0x33, 0xf7, 0x09, 0x17,
+	0x75, 0x9f, 0xb9, 0xed, 0x43, 0x2f, 0xa0, 0x99, 0xe1, 0x7b, 0x00, 0xb5, 0x49, 0x55, 0x65, 0xec,
+	0xc0, 0x9b, 0x3e, 0xe1, 0xa2, 0xe1, 0x24, 0x95, 0x46, 0x72, 0x4b, 0x45, 0xa0, 0xe1, 0x74, 0x05,
+	0x38, 0x5b, 0x01, 0x3e, 0xcc, 0x56, 0x50, 0xd7, 0xcf, 0xfb, 0xc6, 0x6a, 0x8f, 0x04, 0xfe, 0x63,
+	0x33, 0xd7, 0x6c, 0x9e, 0xfc, 0x32, 0x80, 0xbd, 0xe8, 0x5f, 0xf6, 0x32, 0xef, 0xc0, 0x15, 0x49,
+	0xf0, 0x3c, 0x6c, 0xfa, 0x72, 0xcb, 0x19, 0x5b, 0x13, 0xae, 0xe6, 0x0b, 0x0a, 0x6b, 0x1f, 0x2e,
+	0x78, 0xd9, 0xa1, 0x04, 0x5a, 0xa8, 0xe3, 0xe4, 0xce, 0x3f, 0xfb, 0xc6, 0x56, 0xcb, 0x13, 0x6f,
+	0x3b, 0x0e, 0x76, 0x59, 0x60, 0xb9, 0x8c, 0x07, 0x8c, 0xab, 0x8f, 0x0a, 0x7f, 0xd3, 0xb6, 0x44,
+	0x2f, 0xa2, 0x1c, 0x3f, 0xa3, 0xae, 0x7d, 0x31, 0xc0, 0xd4, 0xe1, 0xba, 0xf4, 0x79, 0x1a, 0x86,
+	0x1d, 0xe2, 0x1f, 0xc4, 0xac, 0xeb, 0xf1, 0xe4, 0x61, 0x67, 0x1c, 0x5f, 0x00, 0xdc, 0x28, 0x10,
+	0x28, 0x9e, 0x63, 0x78, 0x8b, 0xc8, 0x5a, 0x23, 0x1a, 0x15, 0x15, 0xd7, 0x8b, 0xd9, 0xb8, 0xce,
+	0xfb, 0xc6, 0x5a, 0xba, 0xba, 0xb1, 0x81, 0xa6, 0xbd, 0x44, 0x72, 0x00, 0xbb, 0x7f, 0xe7, 0xe0,
+	0xff, 0x12, 0x0d, 0x7d, 0x04, 0x70, 0x3e, 0x7d, 0xfc, 0xe8, 0x61, 0x41, 0x3a, 0xc6, 0xf3, 0xa6,
+	0x6d, 0x5f, 0x47, 0x9a, 0x5e, 0xd2, 0x7c, 0xf0, 0xe1, 0xfb, 0x9f, 0xcf, 0xff, 0x19, 0x68, 0xc3,
+	0x9a, 0x1c, 0xee, 0x34, 0x6e, 0xe8, 0x2b, 0x80, 0x8b, 0x57, 0xc2, 0x84, 0x76, 0xa6, 0x99, 0x4c,
+	0x4a, 0xa5, 0x56, 0x9d, 0xa1, 0x43, 0xd1, 0x61, 0x49, 0x57, 0x46, 0x5b, 0x05, 0x74, 0xb9, 0x24,
+	0xa2, 0x4f, 0x00, 0x2e, 0x8c, 0x82, 0x85, 0x1e, 0x4d, 0x33, 0xcc, 0x07, 0x53, 0xab, 0x5c, 0x53,
+	0xad, 0xd0, 0xca, 0x12, 0xcd, 0x44, 0x9b, 0x05, 0x68, 0xa3, 0x24, 0xa2, 0x6f, 0x00, 0x2e, 0xe5,
+	0x43, 0x86, 0xf6, 0xa6, 0xb9, 0x15, 0x64, 0x56, 0xab, 0xcd, 0xd6, 0xa4, 0x48, 0x77, 0x24, 0xe9,
+	0x36, 0x2a, 0x17, 0x90, 0x8e, 0x65, 0xb2, 0xfe, 0xf2, 0x74, 0xa0, 0x83, 0xb3, 0x81, 0x0e, 0x7e,
+	0x0f, 0x74, 0x70, 0x32, 0xd4, 0x4b, 0x67, 0x43, 0xbd, 0xf4, 0x63, 0xa8, 0x97, 0x5e, 0xd7, 0x2e,
+	0x07, 0x5e, 0x4d, 0xab, 0x84, 0x54, 0x1c, 0xb3, 0xb8, 0x7d, 0x31, 0xbe, 0x5b, 0xb3, 0xde, 0xa5,
+	0x1e, 0xf2, 0x2f, 0xe0, 0xcc, 0xcb, 0xf7, 0xc9, 0xde, 0xbf, 0x01, 0x00, 0xb9, 0xa5, 0x61, 0xa7,
+	0xd0, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -254,6 +422,10 @@ type QueryClient interface {
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// LastBlockTime returns the last block time.
 	LastBlockTime(ctx context.Context, in *QueryLastBlockTimeRequest, opts ...grpc.CallOption) (*QueryLastBlockTimeResponse, error)
+	// Inflation returns the current inflation rate derived from the inflation schedule in progress.
+	Inflation(ctx context.Context, in *QueryInflationRequest, opts ...grpc.CallOption) (*QueryInflationResponse, error)
+	// AnnualProvisions returns the annual provisions derived from the inflation schedule in progress.
+	AnnualProvisions(ctx context.Context, in *QueryAnnualProvisionsRequest, opts ...grpc.CallOption) (*QueryAnnualProvisionsResponse, error)
 }
 
 type queryClient struct {
@@ -282,12 +454,34 @@ func (c *queryClient) LastBlockTime(ctx context.Context, in *QueryLastBlockTimeR
 	return out, nil
 }
 
+func (c *queryClient) Inflation(ctx context.Context, in *QueryInflationRequest, opts ...grpc.CallOption) (*QueryInflationResponse, error) {
+	out := new(QueryInflationResponse)
+	err := c.cc.Invoke(ctx, "/crescent.mint.v1beta1.Query/Inflation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AnnualProvisions(ctx context.Context, in *QueryAnnualProvisionsRequest, opts ...grpc.CallOption) (*QueryAnnualProvisionsResponse, error) {
+	out := new(QueryAnnualProvisionsResponse)
+	err := c.cc.Invoke(ctx, "/crescent.mint.v1beta1.Query/AnnualProvisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params returns the total set of minting parameters.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// LastBlockTime returns the last block time.
 	LastBlockTime(context.Context, *QueryLastBlockTimeRequest) (*QueryLastBlockTimeResponse, error)
+	// Inflation returns the current inflation rate derived from the inflation schedule in progress.
+	Inflation(context.Context, *QueryInflationRequest) (*QueryInflationResponse, error)
+	// AnnualProvisions returns the annual provisions derived from the inflation schedule in progress.
+	AnnualProvisions(context.Context, *QueryAnnualProvisionsRequest) (*QueryAnnualProvisionsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -300,6 +494,12 @@ func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsReq
 func (*UnimplementedQueryServer) LastBlockTime(ctx context.Context, req *QueryLastBlockTimeRequest) (*QueryLastBlockTimeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LastBlockTime not implemented")
 }
+func (*UnimplementedQueryServer) Inflation(ctx context.Context, req *QueryInflationRequest) (*QueryInflationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Inflation not implemented")
+}
+func (*UnimplementedQueryServer) AnnualProvisions(ctx context.Context, req *QueryAnnualProvisionsRequest) (*QueryAnnualProvisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnnualProvisions not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -341,6 +541,42 @@ func _Query_LastBlockTime_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Inflation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInflationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Inflation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crescent.mint.v1beta1.Query/Inflation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Inflation(ctx, req.(*QueryInflationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AnnualProvisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAnnualProvisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AnnualProvisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crescent.mint.v1beta1.Query/AnnualProvisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AnnualProvisions(ctx, req.(*QueryAnnualProvisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "crescent.mint.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -353,6 +589,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "LastBlockTime",
 			Handler:    _Query_LastBlockTime_Handler,
 		},
+		{
+			MethodName: "Inflation",
+			Handler:    _Query_Inflation_Handler,
+		},
+		{
+			MethodName: "AnnualProvisions",
+			Handler:    _Query_AnnualProvisions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "crescent/mint/v1beta1/query.proto",
@@ -470,6 +714,118 @@ func (m *QueryLastBlockTimeResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryInflationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInflationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInflationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInflationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInflationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInflationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Inflation.Size()
+		i -= size
+		if _, err := m.Inflation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAnnualProvisionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAnnualProvisionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAnnualProvisionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAnnualProvisionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAnnualProvisionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAnnualProvisionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.AnnualProvisions.Size()
+		i -= size
+		if _, err := m.AnnualProvisions.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -523,6 +879,46 @@ func (m *QueryLastBlockTimeResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryInflationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryInflationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Inflation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAnnualProvisionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAnnualProvisionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AnnualProvisions.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -798,6 +1194,274 @@ func (m *QueryLastBlockTimeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryInflationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInflationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInflationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInflationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInflationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInflationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAnnualProvisionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAnnualProvisionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAnnualProvisionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAnnualProvisionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAnnualProvisionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAnnualProvisionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnualProvisions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AnnualProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0